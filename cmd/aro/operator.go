@@ -14,9 +14,13 @@ import (
 	securityclient "github.com/openshift/client-go/security/clientset/versioned"
 	maoclient "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned"
 	mcoclient "github.com/openshift/machine-config-operator/pkg/generated/clientset/versioned"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	"github.com/Azure/ARO-RP/pkg/env"
 	pkgoperator "github.com/Azure/ARO-RP/pkg/operator"
@@ -26,12 +30,16 @@ import (
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/checker"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/clusteroperatoraro"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/dnsmasq"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/featuregates"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/genevalogging"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/monitoring"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/node"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/noderemediation"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/proxy"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/pullsecret"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/rbac"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/routefix"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/specdrift"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/workaround"
 	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
 	utillog "github.com/Azure/ARO-RP/pkg/util/log"
@@ -65,102 +73,194 @@ func operator(ctx context.Context, log *logrus.Entry) error {
 		return err
 	}
 
-	kubernetescli, err := kubernetes.NewForConfig(restConfig)
-	if err != nil {
-		return err
-	}
-	securitycli, err := securityclient.NewForConfig(restConfig)
-	if err != nil {
-		return err
-	}
-	configcli, err := configclient.NewForConfig(restConfig)
+	arocli, err := aroclient.NewForConfig(restConfig)
 	if err != nil {
 		return err
 	}
-	maocli, err := maoclient.NewForConfig(restConfig)
+
+	gates := controllers.NewGates(log.WithField("controller", controllers.FeatureGatesControllerName), arocli)
+	err = gates.Refresh(ctx)
 	if err != nil {
 		return err
 	}
-	mcocli, err := mcoclient.NewForConfig(restConfig)
-	if err != nil {
-		return err
+
+	if err = (featuregates.NewReconciler(
+		log.WithField("controller", controllers.FeatureGatesControllerName),
+		arocli, gates, role)).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("unable to create controller FeatureGates: %v", err)
 	}
-	arocli, err := aroclient.NewForConfig(restConfig)
+
+	// discovery is shared between the controllers' dynamic helpers
+	gvrResolver, err := dynamichelper.NewGVRResolver(log, restConfig)
 	if err != nil {
 		return err
 	}
-	dh, err := dynamichelper.New(log, restConfig)
-	if err != nil {
-		return err
+
+	// each controller gets its own clients so that its mode can be honoured
+	// by its rest config; see controllers.Gates
+	clients := func(name string) (*operatorClients, error) {
+		return newOperatorClients(log, gates.RestConfig(name, restConfig), gvrResolver)
 	}
 
 	if role == pkgoperator.RoleMaster {
+		specKey, err := specdrift.PublicKeyFromEnv()
+		if err != nil {
+			return err
+		}
+		c, err := clients(controllers.GenevaLoggingControllerName)
+		if err != nil {
+			return err
+		}
 		if err = (genevalogging.NewReconciler(
 			log.WithField("controller", controllers.GenevaLoggingControllerName),
-			kubernetescli, securitycli, arocli,
-			restConfig)).SetupWithManager(mgr); err != nil {
+			c.kubernetescli, c.securitycli, c.arocli,
+			c.restConfig, specKey)).SetupWithManager(mgr, gates); err != nil {
 			return fmt.Errorf("unable to create controller Genevalogging: %v", err)
 		}
+		c, err = clients(controllers.ClusterOperatorAROName)
+		if err != nil {
+			return err
+		}
 		if err = (clusteroperatoraro.NewReconciler(
 			log.WithField("controller", controllers.ClusterOperatorAROName),
-			arocli, configcli)).SetupWithManager(mgr); err != nil {
+			c.arocli, c.configcli)).SetupWithManager(mgr, gates); err != nil {
 			return fmt.Errorf("unable to create controller ClusterOperatorARO: %v", err)
 		}
+		c, err = clients(controllers.PullSecretControllerName)
+		if err != nil {
+			return err
+		}
 		if err = (pullsecret.NewReconciler(
 			log.WithField("controller", controllers.PullSecretControllerName),
-			kubernetescli)).SetupWithManager(mgr); err != nil {
+			c.kubernetescli)).SetupWithManager(mgr, gates); err != nil {
 			return fmt.Errorf("unable to create controller PullSecret: %v", err)
 		}
+		c, err = clients(controllers.AlertwebhookControllerName)
+		if err != nil {
+			return err
+		}
 		if err = (alertwebhook.NewReconciler(
 			log.WithField("controller", controllers.AlertwebhookControllerName),
-			kubernetescli)).SetupWithManager(mgr); err != nil {
+			c.kubernetescli)).SetupWithManager(mgr, gates); err != nil {
 			return fmt.Errorf("unable to create controller AlertWebhook: %v", err)
 		}
+		c, err = clients(controllers.WorkaroundControllerName)
+		if err != nil {
+			return err
+		}
 		if err = (workaround.NewReconciler(
 			log.WithField("controller", controllers.WorkaroundControllerName),
-			kubernetescli, configcli, mcocli, arocli, restConfig)).SetupWithManager(mgr); err != nil {
+			c.kubernetescli, c.configcli, c.mcocli, c.arocli, c.restConfig)).SetupWithManager(mgr, gates); err != nil {
 			return fmt.Errorf("unable to create controller Workaround: %v", err)
 		}
+		c, err = clients(controllers.RouteFixControllerName)
+		if err != nil {
+			return err
+		}
 		if err = (routefix.NewReconciler(
 			log.WithField("controller", controllers.RouteFixControllerName),
-			kubernetescli, securitycli, arocli, restConfig)).SetupWithManager(mgr); err != nil {
+			c.kubernetescli, c.securitycli, c.arocli, c.restConfig)).SetupWithManager(mgr, gates); err != nil {
 			return fmt.Errorf("unable to create controller RouteFix: %v", err)
 		}
+		c, err = clients(controllers.MonitoringControllerName)
+		if err != nil {
+			return err
+		}
 		if err = (monitoring.NewReconciler(
 			log.WithField("controller", controllers.MonitoringControllerName),
-			kubernetescli, arocli)).SetupWithManager(mgr); err != nil {
+			c.kubernetescli, c.arocli)).SetupWithManager(mgr, gates); err != nil {
 			return fmt.Errorf("unable to create controller Monitoring: %v", err)
 		}
+		c, err = clients(controllers.ServiceMonitorControllerName)
+		if err != nil {
+			return err
+		}
+		if err = (monitoring.NewServiceMonitorReconciler(
+			log.WithField("controller", controllers.ServiceMonitorControllerName),
+			c.arocli, c.dynamiccli)).SetupWithManager(mgr, gates); err != nil {
+			return fmt.Errorf("unable to create controller ServiceMonitor: %v", err)
+		}
+		c, err = clients(controllers.RBACControllerName)
+		if err != nil {
+			return err
+		}
 		if err = (rbac.NewReconciler(
 			log.WithField("controller", controllers.RBACControllerName),
-			arocli, dh)).SetupWithManager(mgr); err != nil {
+			c.arocli, c.dh)).SetupWithManager(mgr, gates); err != nil {
 			return fmt.Errorf("unable to create controller RBAC: %v", err)
 		}
+		c, err = clients(controllers.DnsmasqClusterControllerName)
+		if err != nil {
+			return err
+		}
 		if err = (dnsmasq.NewClusterReconciler(
 			log.WithField("controller", controllers.DnsmasqClusterControllerName),
-			arocli, mcocli, dh)).SetupWithManager(mgr); err != nil {
+			c.arocli, c.mcocli, c.dh)).SetupWithManager(mgr, gates); err != nil {
 			return fmt.Errorf("unable to create controller DnsmasqCluster: %v", err)
 		}
+		c, err = clients(controllers.DnsmasqMachineConfigControllerName)
+		if err != nil {
+			return err
+		}
 		if err = (dnsmasq.NewMachineConfigReconciler(
 			log.WithField("controller", controllers.DnsmasqMachineConfigControllerName),
-			arocli, mcocli, dh)).SetupWithManager(mgr); err != nil {
+			c.arocli, c.mcocli, c.dh)).SetupWithManager(mgr, gates); err != nil {
 			return fmt.Errorf("unable to create controller DnsmasqMachineConfig: %v", err)
 		}
+		c, err = clients(controllers.DnsmasqMachineConfigPoolControllerName)
+		if err != nil {
+			return err
+		}
 		if err = (dnsmasq.NewMachineConfigPoolReconciler(
 			log.WithField("controller", controllers.DnsmasqMachineConfigPoolControllerName),
-			arocli, mcocli, dh)).SetupWithManager(mgr); err != nil {
+			c.arocli, c.mcocli, c.dh)).SetupWithManager(mgr, gates); err != nil {
 			return fmt.Errorf("unable to create controller DnsmasqMachineConfigPool: %v", err)
 		}
+		c, err = clients(controllers.NodeControllerName)
+		if err != nil {
+			return err
+		}
 		if err = (node.NewNodeReconciler(
 			log.WithField("controller", controllers.NodeControllerName),
-			kubernetescli)).SetupWithManager(mgr); err != nil {
+			c.kubernetescli)).SetupWithManager(mgr, gates); err != nil {
 			return fmt.Errorf("unable to create controller Node: %v", err)
 		}
+		c, err = clients(controllers.NodeRemediationControllerName)
+		if err != nil {
+			return err
+		}
+		if err = (noderemediation.NewReconciler(
+			log.WithField("controller", controllers.NodeRemediationControllerName),
+			c.kubernetescli, c.maocli, c.arocli)).SetupWithManager(mgr, gates); err != nil {
+			return fmt.Errorf("unable to create controller NodeRemediation: %v", err)
+		}
+		c, err = clients(controllers.ProxyControllerName)
+		if err != nil {
+			return err
+		}
+		if err = (proxy.NewReconciler(
+			log.WithField("controller", controllers.ProxyControllerName),
+			c.kubernetescli, c.arocli, c.configcli)).SetupWithManager(mgr, gates); err != nil {
+			return fmt.Errorf("unable to create controller Proxy: %v", err)
+		}
+		c, err = clients(controllers.SpecDriftControllerName)
+		if err != nil {
+			return err
+		}
+		if err = (specdrift.NewReconciler(
+			log.WithField("controller", controllers.SpecDriftControllerName),
+			c.kubernetescli, c.arocli, specKey)).SetupWithManager(mgr, gates); err != nil {
+			return fmt.Errorf("unable to create controller SpecDrift: %v", err)
+		}
 	}
 
+	c, err := clients(controllers.CheckerControllerName)
+	if err != nil {
+		return err
+	}
 	if err = (checker.NewReconciler(
 		log.WithField("controller", controllers.CheckerControllerName),
-		maocli, arocli, kubernetescli, role, isLocalDevelopmentMode)).SetupWithManager(mgr); err != nil {
+		c.maocli, c.arocli, c.kubernetescli, role, isLocalDevelopmentMode)).SetupWithManager(mgr, gates); err != nil {
 		return fmt.Errorf("unable to create controller InternetChecker: %v", err)
 	}
 
@@ -173,9 +273,69 @@ func operator(ctx context.Context, log *logrus.Entry) error {
 		return err
 	}
 
+	// serve the controller metrics on /metrics and answer everything else
+	// (e.g. the liveness probe) with 200
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(crmetrics.Registry, promhttp.HandlerOpts{}))
+	mux.HandleFunc("/", func(http.ResponseWriter, *http.Request) {})
+
 	go func() {
-		_ = http.Serve(l, http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+		_ = http.Serve(l, mux)
 	}()
 
 	return mgr.Start(ctrl.SetupSignalHandler())
 }
+
+type operatorClients struct {
+	restConfig    *rest.Config
+	kubernetescli kubernetes.Interface
+	securitycli   securityclient.Interface
+	configcli     configclient.Interface
+	maocli        maoclient.Interface
+	mcocli        mcoclient.Interface
+	arocli        aroclient.Interface
+	dynamiccli    dynamic.Interface
+	dh            dynamichelper.Interface
+}
+
+func newOperatorClients(log *logrus.Entry, restConfig *rest.Config, gvrResolver dynamichelper.GVRResolver) (*operatorClients, error) {
+	c := &operatorClients{
+		restConfig: restConfig,
+	}
+
+	var err error
+	c.kubernetescli, err = kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	c.securitycli, err = securityclient.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	c.configcli, err = configclient.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	c.maocli, err = maoclient.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	c.mcocli, err = mcoclient.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	c.arocli, err = aroclient.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	c.dynamiccli, err = dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	c.dh, err = dynamichelper.NewWithGVRResolver(log, restConfig, gvrResolver)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
@@ -9,7 +9,9 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 
 	"github.com/gofrs/uuid"
 	"github.com/sirupsen/logrus"
@@ -46,6 +48,7 @@ func portal(ctx context.Context, log *logrus.Entry, audit *logrus.Entry) error {
 		"AZURE_PORTAL_CLIENT_ID",
 		"AZURE_PORTAL_ACCESS_GROUP_IDS",
 		"AZURE_PORTAL_ELEVATED_GROUP_IDS",
+		"AZURE_PORTAL_AUDITOR_GROUP_IDS",
 	} {
 		if _, found := os.LookupEnv(key); !found {
 			return fmt.Errorf("environment variable %q unset", key)
@@ -62,6 +65,11 @@ func portal(ctx context.Context, log *logrus.Entry, audit *logrus.Entry) error {
 		return err
 	}
 
+	auditorGroupIDs, err := parseGroupIDs(os.Getenv("AZURE_PORTAL_AUDITOR_GROUP_IDS"))
+	if err != nil {
+		return err
+	}
+
 	rpKVAuthorizer, err := _env.NewRPAuthorizer(_env.Environment().ResourceIdentifiers.KeyVault)
 	if err != nil {
 		return err
@@ -102,6 +110,11 @@ func portal(ctx context.Context, log *logrus.Entry, audit *logrus.Entry) error {
 		return err
 	}
 
+	dbAsyncOperations, err := database.NewAsyncOperations(ctx, _env.IsLocalDevelopmentMode(), dbc)
+	if err != nil {
+		return err
+	}
+
 	portalKeyvaultURI, err := keyvault.URI(_env, env.PortalKeyvaultSuffix)
 	if err != nil {
 		return err
@@ -166,9 +179,31 @@ func portal(ctx context.Context, log *logrus.Entry, audit *logrus.Entry) error {
 
 	log.Print("listening")
 
-	p := pkgportal.NewPortal(_env, audit, log.WithField("component", "portal"), log.WithField("component", "portal-access"), l, sshl, verifier, hostname, servingKey, servingCerts, clientID, clientKey, clientCerts, sessionKey, sshKey, groupIDs, elevatedGroupIDs, dbOpenShiftClusters, dbPortal, dialer)
+	p := pkgportal.NewPortal(_env, audit, log.WithField("component", "portal"), log.WithField("component", "portal-access"), l, sshl, verifier, hostname, servingKey, servingCerts, clientID, clientKey, clientCerts, sessionKey, sshKey, groupIDs, elevatedGroupIDs, auditorGroupIDs, dbOpenShiftClusters, dbPortal, dbAsyncOperations, dialer)
+
+	// When SIGTERM is received, the portal stops serving and writes the audit
+	// records still queued for its local store before exiting
+	sigterm := make(chan os.Signal, 1)
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	signal.Notify(sigterm, syscall.SIGTERM)
+
+	errch := make(chan error, 1)
+	go func() {
+		errch <- p.Run(ctx, stop, done)
+	}()
+
+	select {
+	case err := <-errch:
+		return err
+	case <-sigterm:
+	}
+
+	log.Print("received SIGTERM")
+	close(stop)
+	<-done
 
-	return p.Run(ctx)
+	return nil
 }
 
 func parseGroupIDs(_groupIDs string) ([]string, error) {
@@ -121,6 +121,14 @@ import_certs_secrets() {
         --vault-name "$KEYVAULT_PREFIX-svc" \
         --name fe-encryption-key \
         --value "$(openssl rand -base64 32)" >/dev/null
+    az keyvault secret list \
+        --vault-name "$KEYVAULT_PREFIX-svc" \
+        --query '[].name' \
+        -o tsv | grep -q ^cluster-spec-signing$ || \
+    az keyvault secret set \
+        --vault-name "$KEYVAULT_PREFIX-svc" \
+        --name cluster-spec-signing \
+        --value "$(openssl genpkey -algorithm rsa -pkeyopt rsa_keygen_bits:2048 -outform der | base64 -w0)" >/dev/null
     az keyvault secret list \
         --vault-name "$KEYVAULT_PREFIX-por" \
         --query '[].name' \
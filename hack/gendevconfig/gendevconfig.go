@@ -23,6 +23,7 @@ func run(ctx context.Context, log *logrus.Entry) error {
 		"AZURE_SERVICE_PRINCIPAL_ID",
 		"AZURE_FP_SERVICE_PRINCIPAL_ID",
 		"AZURE_PORTAL_ACCESS_GROUP_IDS",
+		"AZURE_PORTAL_AUDITOR_GROUP_IDS",
 		"AZURE_PORTAL_CLIENT_ID",
 		"AZURE_PORTAL_ELEVATED_GROUP_IDS",
 		"HOME",
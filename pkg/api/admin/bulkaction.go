@@ -0,0 +1,77 @@
+package admin
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"time"
+)
+
+// BulkAction represents an admin action run across a selection of clusters.
+type BulkAction struct {
+	// The ID of the bulk action, used to poll for its results.
+	ID string `json:"id,omitempty"`
+
+	// The admin action to run against each selected cluster.
+	Action BulkActionType `json:"action,omitempty"`
+
+	// The parameters passed to the admin action for each selected cluster.
+	// For RedeployVM, the string "{infraId}" in the vmName parameter is
+	// replaced with each cluster's infrastructure ID.
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// The selector for the clusters to act on.
+	Selector BulkActionSelector `json:"selector,omitempty"`
+
+	// The maximum number of clusters acted on in parallel.
+	Concurrency int `json:"concurrency,omitempty"`
+
+	CreatedBy string          `json:"createdBy,omitempty"`
+	State     BulkActionState `json:"state,omitempty"`
+	StartTime time.Time       `json:"startTime,omitempty"`
+	EndTime   *time.Time      `json:"endTime,omitempty"`
+	Error     string          `json:"error,omitempty"`
+
+	// The result of the admin action for each selected cluster.
+	Results []*BulkActionResult `json:"results,omitempty"`
+}
+
+// BulkActionType represents the admin action run by a bulk action.
+type BulkActionType string
+
+// BulkActionType constants
+const (
+	BulkActionTypeKubernetesObjectsDelete BulkActionType = "KubernetesObjectsDelete"
+	BulkActionTypeRedeployVM              BulkActionType = "RedeployVM"
+	BulkActionTypeUpgrade                 BulkActionType = "Upgrade"
+)
+
+// BulkActionSelector selects the clusters a bulk action runs against.  All
+// non-empty fields must match.
+type BulkActionSelector struct {
+	SubscriptionID    string            `json:"subscriptionId,omitempty"`
+	ProvisioningState ProvisioningState `json:"provisioningState,omitempty"`
+	Version           string            `json:"version,omitempty"`
+	Tags              map[string]string `json:"tags,omitempty"`
+}
+
+// BulkActionState represents the state of a bulk action or of one of its
+// results.
+type BulkActionState string
+
+// BulkActionState constants
+const (
+	BulkActionStatePending   BulkActionState = "Pending"
+	BulkActionStateRunning   BulkActionState = "Running"
+	BulkActionStateSucceeded BulkActionState = "Succeeded"
+	BulkActionStateFailed    BulkActionState = "Failed"
+)
+
+// BulkActionResult represents the outcome of a bulk action on one cluster.
+type BulkActionResult struct {
+	ResourceID string          `json:"resourceId,omitempty"`
+	State      BulkActionState `json:"state,omitempty"`
+	Error      string          `json:"error,omitempty"`
+	StartTime  *time.Time      `json:"startTime,omitempty"`
+	EndTime    *time.Time      `json:"endTime,omitempty"`
+}
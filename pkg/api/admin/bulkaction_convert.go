@@ -0,0 +1,100 @@
+package admin
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+type bulkActionConverter struct{}
+
+// ToExternal returns a new external representation of the internal object,
+// reading from the subset of the internal object's fields that appear in the
+// external representation.  ToExternal does not modify its argument; there is
+// no pointer aliasing between the passed and returned objects
+func (c *bulkActionConverter) ToExternal(id string, ba *api.BulkAction) interface{} {
+	out := &BulkAction{
+		ID:          id,
+		Action:      BulkActionType(ba.Action),
+		Concurrency: ba.Concurrency,
+		Selector: BulkActionSelector{
+			SubscriptionID:    ba.Selector.SubscriptionID,
+			ProvisioningState: ProvisioningState(ba.Selector.ProvisioningState),
+			Version:           ba.Selector.Version,
+		},
+		CreatedBy: ba.CreatedBy,
+		State:     BulkActionState(ba.State),
+		StartTime: ba.StartTime,
+		Error:     ba.Error,
+	}
+
+	if ba.EndTime != nil {
+		endTime := *ba.EndTime
+		out.EndTime = &endTime
+	}
+
+	if ba.Parameters != nil {
+		out.Parameters = make(map[string]string, len(ba.Parameters))
+		for k, v := range ba.Parameters {
+			out.Parameters[k] = v
+		}
+	}
+
+	if ba.Selector.Tags != nil {
+		out.Selector.Tags = make(map[string]string, len(ba.Selector.Tags))
+		for k, v := range ba.Selector.Tags {
+			out.Selector.Tags[k] = v
+		}
+	}
+
+	if ba.Results != nil {
+		out.Results = make([]*BulkActionResult, 0, len(ba.Results))
+		for _, r := range ba.Results {
+			result := &BulkActionResult{
+				ResourceID: r.ResourceID,
+				State:      BulkActionState(r.State),
+				Error:      r.Error,
+			}
+			if r.StartTime != nil {
+				startTime := *r.StartTime
+				result.StartTime = &startTime
+			}
+			if r.EndTime != nil {
+				endTime := *r.EndTime
+				result.EndTime = &endTime
+			}
+			out.Results = append(out.Results, result)
+		}
+	}
+
+	return out
+}
+
+// ToInternal overwrites in place a pre-existing internal object, setting (only)
+// the fields which may be set by the caller of the admin API.  ToInternal
+// modifies its argument; there is no pointer aliasing between the passed and
+// returned objects
+func (c *bulkActionConverter) ToInternal(_ba interface{}, out *api.BulkAction) {
+	ba := _ba.(*BulkAction)
+
+	out.Action = api.BulkActionType(ba.Action)
+	out.Concurrency = ba.Concurrency
+	out.Parameters = nil
+	if ba.Parameters != nil {
+		out.Parameters = make(map[string]string, len(ba.Parameters))
+		for k, v := range ba.Parameters {
+			out.Parameters[k] = v
+		}
+	}
+	out.Selector.SubscriptionID = ba.Selector.SubscriptionID
+	out.Selector.ProvisioningState = api.ProvisioningState(ba.Selector.ProvisioningState)
+	out.Selector.Version = ba.Selector.Version
+	out.Selector.Tags = nil
+	if ba.Selector.Tags != nil {
+		out.Selector.Tags = make(map[string]string, len(ba.Selector.Tags))
+		for k, v := range ba.Selector.Tags {
+			out.Selector.Tags[k] = v
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package admin
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"time"
+)
+
+// MustGather represents a must-gather collection, which completes
+// asynchronously.  The tarball is encrypted with XChaCha20-Poly1305 in chunks
+// of 64KiB of plaintext, each preceded by its own 24 byte nonce.  The
+// additional data of each chunk is its 8 byte big-endian sequence number
+// followed by a byte which is 1 for the last chunk and 0 otherwise.
+type MustGather struct {
+	// A read-only link to the encrypted tarball, valid until the expiry time.
+	URL string `json:"url,omitempty"`
+
+	// The base64 encoded key which decrypts the tarball.  The key is not
+	// stored by the RP.
+	Key string `json:"key,omitempty"`
+
+	ExpiryTime time.Time `json:"expiryTime,omitempty"`
+}
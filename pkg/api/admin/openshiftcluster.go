@@ -40,11 +40,14 @@ type OpenShiftClusterProperties struct {
 	ConsoleProfile          ConsoleProfile          `json:"consoleProfile,omitempty"`
 	ServicePrincipalProfile ServicePrincipalProfile `json:"servicePrincipalProfile,omitempty"`
 	NetworkProfile          NetworkProfile          `json:"networkProfile,omitempty"`
+	ProxyProfile            ProxyProfile            `json:"proxyProfile,omitempty"`
+	LoggingProfile          LoggingProfile          `json:"loggingProfile,omitempty" mutable:"true"`
 	MasterProfile           MasterProfile           `json:"masterProfile,omitempty"`
 	WorkerProfiles          []WorkerProfile         `json:"workerProfiles,omitempty"`
 	APIServerProfile        APIServerProfile        `json:"apiserverProfile,omitempty"`
 	IngressProfiles         []IngressProfile        `json:"ingressProfiles,omitempty"`
 	Install                 *Install                `json:"install,omitempty"`
+	OperatorStatus          *OperatorStatus         `json:"operatorStatus,omitempty"`
 	StorageSuffix           string                  `json:"storageSuffix,omitempty"`
 	RegistryProfiles        []RegistryProfile       `json:"registryProfiles,omitempty"`
 }
@@ -88,6 +91,19 @@ type NetworkProfile struct {
 	APIServerPrivateEndpointIP string `json:"privateEndpointIp,omitempty"`
 }
 
+// ProxyProfile represents an outbound proxy profile.
+type ProxyProfile struct {
+	HTTPProxy  string `json:"httpProxy,omitempty"`
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+	NoProxy    string `json:"noProxy,omitempty"`
+	TrustedCA  string `json:"trustedCa,omitempty"`
+}
+
+// LoggingProfile represents the log forwarding approved by the RP.
+type LoggingProfile struct {
+	SinkDestinations []string `json:"sinkDestinations,omitempty"`
+}
+
 // MasterProfile represents a master profile.
 type MasterProfile struct {
 	VMSize   VMSize `json:"vmSize,omitempty"`
@@ -170,6 +186,36 @@ const (
 	InstallPhaseRemoveBootstrap
 )
 
+// OperatorStatus represents the health reported by the ARO operator on the
+// cluster.  It is read-only.
+type OperatorStatus struct {
+	Version      string               `json:"version,omitempty"`
+	Conditions   []OperatorCondition  `json:"conditions,omitempty"`
+	Controllers  []OperatorController `json:"controllers,omitempty"`
+	Deployments  []OperatorDeployment `json:"deployments,omitempty"`
+	LastSyncTime *time.Time           `json:"lastSyncTime,omitempty"`
+}
+
+// OperatorCondition represents a condition set by the ARO operator's checkers.
+type OperatorCondition struct {
+	Type   string `json:"type,omitempty"`
+	Status string `json:"status,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// OperatorController represents the mode an ARO operator controller is
+// running in.
+type OperatorController struct {
+	Name string `json:"name,omitempty"`
+	Mode string `json:"mode,omitempty"`
+}
+
+// OperatorDeployment represents the readiness of an ARO operator deployment.
+type OperatorDeployment struct {
+	Name  string `json:"name,omitempty"`
+	Ready bool   `json:"ready"`
+}
+
 // RegistryProfile represents a registry profile
 type RegistryProfile struct {
 	Name     string `json:"name,omitempty"`
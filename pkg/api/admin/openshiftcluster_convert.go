@@ -45,6 +45,12 @@ func (c *openShiftClusterConverter) ToExternal(oc *api.OpenShiftCluster) interfa
 				ServiceCIDR:                oc.Properties.NetworkProfile.ServiceCIDR,
 				APIServerPrivateEndpointIP: oc.Properties.NetworkProfile.APIServerPrivateEndpointIP,
 			},
+			ProxyProfile: ProxyProfile{
+				HTTPProxy:  oc.Properties.ProxyProfile.HTTPProxy,
+				HTTPSProxy: oc.Properties.ProxyProfile.HTTPSProxy,
+				NoProxy:    oc.Properties.ProxyProfile.NoProxy,
+				TrustedCA:  oc.Properties.ProxyProfile.TrustedCA,
+			},
 			MasterProfile: MasterProfile{
 				VMSize:   VMSize(oc.Properties.MasterProfile.VMSize),
 				SubnetID: oc.Properties.MasterProfile.SubnetID,
@@ -59,6 +65,11 @@ func (c *openShiftClusterConverter) ToExternal(oc *api.OpenShiftCluster) interfa
 		},
 	}
 
+	if oc.Properties.LoggingProfile.SinkDestinations != nil {
+		out.Properties.LoggingProfile.SinkDestinations = make([]string, len(oc.Properties.LoggingProfile.SinkDestinations))
+		copy(out.Properties.LoggingProfile.SinkDestinations, oc.Properties.LoggingProfile.SinkDestinations)
+	}
+
 	if oc.Properties.WorkerProfiles != nil {
 		out.Properties.WorkerProfiles = make([]WorkerProfile, 0, len(oc.Properties.WorkerProfiles))
 		for _, p := range oc.Properties.WorkerProfiles {
@@ -90,6 +101,44 @@ func (c *openShiftClusterConverter) ToExternal(oc *api.OpenShiftCluster) interfa
 		}
 	}
 
+	if oc.Properties.OperatorStatus != nil {
+		out.Properties.OperatorStatus = &OperatorStatus{
+			Version:      oc.Properties.OperatorStatus.Version,
+			LastSyncTime: oc.Properties.OperatorStatus.LastSyncTime,
+		}
+
+		if oc.Properties.OperatorStatus.Conditions != nil {
+			out.Properties.OperatorStatus.Conditions = make([]OperatorCondition, 0, len(oc.Properties.OperatorStatus.Conditions))
+			for _, c := range oc.Properties.OperatorStatus.Conditions {
+				out.Properties.OperatorStatus.Conditions = append(out.Properties.OperatorStatus.Conditions, OperatorCondition{
+					Type:   c.Type,
+					Status: c.Status,
+					Reason: c.Reason,
+				})
+			}
+		}
+
+		if oc.Properties.OperatorStatus.Controllers != nil {
+			out.Properties.OperatorStatus.Controllers = make([]OperatorController, 0, len(oc.Properties.OperatorStatus.Controllers))
+			for _, c := range oc.Properties.OperatorStatus.Controllers {
+				out.Properties.OperatorStatus.Controllers = append(out.Properties.OperatorStatus.Controllers, OperatorController{
+					Name: c.Name,
+					Mode: c.Mode,
+				})
+			}
+		}
+
+		if oc.Properties.OperatorStatus.Deployments != nil {
+			out.Properties.OperatorStatus.Deployments = make([]OperatorDeployment, 0, len(oc.Properties.OperatorStatus.Deployments))
+			for _, d := range oc.Properties.OperatorStatus.Deployments {
+				out.Properties.OperatorStatus.Deployments = append(out.Properties.OperatorStatus.Deployments, OperatorDeployment{
+					Name:  d.Name,
+					Ready: d.Ready,
+				})
+			}
+		}
+	}
+
 	if oc.Tags != nil {
 		out.Tags = make(map[string]string, len(oc.Tags))
 		for k, v := range oc.Tags {
@@ -157,6 +206,15 @@ func (c *openShiftClusterConverter) ToInternal(_oc interface{}, out *api.OpenShi
 	out.Properties.NetworkProfile.PodCIDR = oc.Properties.NetworkProfile.PodCIDR
 	out.Properties.NetworkProfile.ServiceCIDR = oc.Properties.NetworkProfile.ServiceCIDR
 	out.Properties.NetworkProfile.APIServerPrivateEndpointIP = oc.Properties.NetworkProfile.APIServerPrivateEndpointIP
+	out.Properties.ProxyProfile.HTTPProxy = oc.Properties.ProxyProfile.HTTPProxy
+	out.Properties.ProxyProfile.HTTPSProxy = oc.Properties.ProxyProfile.HTTPSProxy
+	out.Properties.ProxyProfile.NoProxy = oc.Properties.ProxyProfile.NoProxy
+	out.Properties.ProxyProfile.TrustedCA = oc.Properties.ProxyProfile.TrustedCA
+	out.Properties.LoggingProfile.SinkDestinations = nil
+	if oc.Properties.LoggingProfile.SinkDestinations != nil {
+		out.Properties.LoggingProfile.SinkDestinations = make([]string, len(oc.Properties.LoggingProfile.SinkDestinations))
+		copy(out.Properties.LoggingProfile.SinkDestinations, oc.Properties.LoggingProfile.SinkDestinations)
+	}
 	out.Properties.MasterProfile.VMSize = api.VMSize(oc.Properties.MasterProfile.VMSize)
 	out.Properties.MasterProfile.SubnetID = oc.Properties.MasterProfile.SubnetID
 	out.Properties.StorageSuffix = oc.Properties.StorageSuffix
@@ -193,6 +251,9 @@ func (c *openShiftClusterConverter) ToInternal(_oc interface{}, out *api.OpenShi
 		}
 	}
 
+	// out.Properties.OperatorStatus is not converted.  The field is read-only:
+	// it is synced from the cluster by the monitor.
+
 	// out.Properties.RegistryProfiles is not converted. The field is immutable and does not have to be converted.
 	// Other fields are converted and this breaks the pattern, however this converting this field creates an issue
 	// with filling the out.Properties.RegistryProfiles[i].Password as default is "" which erases the original value.
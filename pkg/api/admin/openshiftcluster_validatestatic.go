@@ -4,12 +4,17 @@ package admin
 // Licensed under the Apache License 2.0.
 
 import (
+	"fmt"
 	"net/http"
+	"regexp"
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/util/immutable"
 )
 
+// rxSinkDestination matches a storage account or host name
+var rxSinkDestination = regexp.MustCompile(`^[a-z0-9]([-.a-z0-9]*[a-z0-9])?$`)
+
 type openShiftClusterStaticValidator struct{}
 
 // Validate validates an OpenShift cluster
@@ -19,9 +24,25 @@ func (sv *openShiftClusterStaticValidator) Static(_oc interface{}, _current *api
 	}
 
 	oc := _oc.(*OpenShiftCluster)
+
+	err := sv.validateLoggingProfile("properties.loggingProfile", &oc.Properties.LoggingProfile)
+	if err != nil {
+		return err
+	}
+
 	return sv.validateDelta(oc, (&openShiftClusterConverter{}).ToExternal(_current).(*OpenShiftCluster))
 }
 
+func (sv *openShiftClusterStaticValidator) validateLoggingProfile(path string, lp *LoggingProfile) error {
+	for i, d := range lp.SinkDestinations {
+		if !rxSinkDestination.MatchString(d) {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("%s.sinkDestinations[%d]", path, i), "The provided sink destination '%s' is invalid: it must be a lower case storage account or host name.", d)
+		}
+	}
+
+	return nil
+}
+
 func (sv *openShiftClusterStaticValidator) validateDelta(oc, current *OpenShiftCluster) error {
 	err := immutable.Validate("", oc, current)
 	if err != nil {
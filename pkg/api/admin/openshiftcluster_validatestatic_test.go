@@ -486,6 +486,18 @@ func TestOpenShiftClusterStaticValidateDelta(t *testing.T) {
 			},
 			wantErr: "400: PropertyChangeNotAllowed: properties.install.now.ext: Changing property 'properties.install.now.ext' is not allowed.",
 		},
+		{
+			name: "operatorStatus change is not allowed",
+			oc: func() *OpenShiftCluster {
+				return &OpenShiftCluster{}
+			},
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.OperatorStatus = &OperatorStatus{
+					Version: "invalid",
+				}
+			},
+			wantErr: "400: PropertyChangeNotAllowed: properties.operatorStatus: Changing property 'properties.operatorStatus' is not allowed.",
+		},
 		{
 			name: "storageSuffix change is not allowed",
 			oc: func() *OpenShiftCluster {
@@ -540,6 +552,25 @@ func TestOpenShiftClusterStaticValidateDelta(t *testing.T) {
 			},
 			wantErr: "400: PropertyChangeNotAllowed: properties.provisionedBy: Changing property 'properties.provisionedBy' is not allowed.",
 		},
+		{
+			name: "loggingProfile change is allowed",
+			oc: func() *OpenShiftCluster {
+				return &OpenShiftCluster{}
+			},
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.LoggingProfile.SinkDestinations = []string{"account", "logs.example.com"}
+			},
+		},
+		{
+			name: "invalid sink destination",
+			oc: func() *OpenShiftCluster {
+				return &OpenShiftCluster{}
+			},
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.LoggingProfile.SinkDestinations = []string{"account", "https://logs.example.com/"}
+			},
+			wantErr: "400: InvalidParameter: properties.loggingProfile.sinkDestinations[1]: The provided sink destination 'https://logs.example.com/' is invalid: it must be a lower case storage account or host name.",
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,95 @@
+package api
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"strings"
+	"time"
+)
+
+// BulkAction represents an admin action run across a selection of clusters
+type BulkAction struct {
+	MissingFields
+
+	// Action is the admin action to run against each selected cluster
+	Action BulkActionType `json:"action,omitempty"`
+
+	// Parameters are passed to the admin action for each selected cluster
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	Selector BulkActionSelector `json:"selector,omitempty"`
+
+	// Concurrency is the maximum number of clusters acted on in parallel
+	Concurrency int `json:"concurrency,omitempty"`
+
+	// CreatedBy is the admin API client which requested the action
+	CreatedBy string `json:"createdBy,omitempty"`
+
+	State BulkActionState `json:"state,omitempty"`
+
+	StartTime time.Time  `json:"startTime,omitempty"`
+	EndTime   *time.Time `json:"endTime,omitempty"`
+
+	// Error is set if the bulk action could not select its clusters, or if it
+	// repeatedly failed to run
+	Error string `json:"error,omitempty"`
+
+	Results []*BulkActionResult `json:"results,omitempty"`
+}
+
+// BulkActionType is the admin action run by a BulkAction
+type BulkActionType string
+
+// BulkActionType constants
+const (
+	BulkActionTypeKubernetesObjectsDelete BulkActionType = "KubernetesObjectsDelete"
+	BulkActionTypeRedeployVM              BulkActionType = "RedeployVM"
+	BulkActionTypeUpgrade                 BulkActionType = "Upgrade"
+)
+
+// BulkActionVMName expands the {infraId} placeholder in a RedeployVM vmName
+// parameter
+func BulkActionVMName(vmName, infraID string) string {
+	return strings.ReplaceAll(vmName, "{infraId}", infraID)
+}
+
+// BulkActionSelector selects the clusters a BulkAction runs against.  All
+// non-empty fields must match.
+type BulkActionSelector struct {
+	MissingFields
+
+	SubscriptionID    string            `json:"subscriptionId,omitempty"`
+	ProvisioningState ProvisioningState `json:"provisioningState,omitempty"`
+	Version           string            `json:"version,omitempty"`
+	Tags              map[string]string `json:"tags,omitempty"`
+}
+
+// BulkActionState represents the state of a BulkAction or of one of its
+// results
+type BulkActionState string
+
+// BulkActionState constants
+const (
+	BulkActionStatePending   BulkActionState = "Pending"
+	BulkActionStateRunning   BulkActionState = "Running"
+	BulkActionStateSucceeded BulkActionState = "Succeeded"
+	BulkActionStateFailed    BulkActionState = "Failed"
+)
+
+// IsTerminal returns true if state is Terminal
+func (s BulkActionState) IsTerminal() bool {
+	return s == BulkActionStateSucceeded || s == BulkActionStateFailed
+}
+
+// BulkActionResult represents the outcome of a BulkAction on one cluster
+type BulkActionResult struct {
+	MissingFields
+
+	ResourceID string          `json:"resourceId,omitempty"`
+	State      BulkActionState `json:"state,omitempty"`
+	Error      string          `json:"error,omitempty"`
+
+	StartTime *time.Time `json:"startTime,omitempty"`
+	EndTime   *time.Time `json:"endTime,omitempty"`
+}
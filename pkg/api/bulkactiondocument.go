@@ -0,0 +1,41 @@
+package api
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+// BulkActionDocuments represents bulk action documents.
+// pkg/database/cosmosdb requires its definition.
+type BulkActionDocuments struct {
+	Count               int                   `json:"_count,omitempty"`
+	ResourceID          string                `json:"_rid,omitempty"`
+	BulkActionDocuments []*BulkActionDocument `json:"Documents,omitempty"`
+}
+
+func (c *BulkActionDocuments) String() string {
+	return encodeJSON(c)
+}
+
+// BulkActionDocument represents a bulk action document.
+// pkg/database/cosmosdb requires its definition.
+type BulkActionDocument struct {
+	MissingFields
+
+	ID          string                 `json:"id,omitempty"`
+	ResourceID  string                 `json:"_rid,omitempty"`
+	Timestamp   int                    `json:"_ts,omitempty"`
+	Self        string                 `json:"_self,omitempty"`
+	ETag        string                 `json:"_etag,omitempty"`
+	Attachments string                 `json:"_attachments,omitempty"`
+	LSN         int                    `json:"_lsn,omitempty"`
+	Metadata    map[string]interface{} `json:"_metadata,omitempty"`
+
+	LeaseOwner   string `json:"leaseOwner,omitempty"`
+	LeaseExpires int    `json:"leaseExpires,omitempty"`
+	Dequeues     int    `json:"dequeues,omitempty"`
+
+	BulkAction *BulkAction `json:"bulkAction,omitempty"`
+}
+
+func (c *BulkActionDocument) String() string {
+	return encodeJSON(c)
+}
@@ -88,6 +88,10 @@ type OpenShiftClusterProperties struct {
 
 	NetworkProfile NetworkProfile `json:"networkProfile,omitempty"`
 
+	ProxyProfile ProxyProfile `json:"proxyProfile,omitempty"`
+
+	LoggingProfile LoggingProfile `json:"loggingProfile,omitempty"`
+
 	MasterProfile MasterProfile `json:"masterProfile,omitempty"`
 
 	WorkerProfiles []WorkerProfile `json:"workerProfiles,omitempty"`
@@ -99,6 +103,10 @@ type OpenShiftClusterProperties struct {
 	// Install is non-nil only when an install is in progress
 	Install *Install `json:"install,omitempty"`
 
+	// OperatorStatus is the health reported by the ARO operator on the
+	// cluster.  It is synced from the cluster by the monitor.
+	OperatorStatus *OperatorStatus `json:"operatorStatus,omitempty"`
+
 	StorageSuffix string `json:"storageSuffix,omitempty"`
 
 	InfraID              string       `json:"infraId,omitempty"`
@@ -169,6 +177,31 @@ type NetworkProfile struct {
 	APIServerPrivateEndpointIP string `json:"privateEndpointIp,omitempty"`
 }
 
+// ProxyProfile represents the outbound proxy the cluster sends its egress
+// traffic through
+type ProxyProfile struct {
+	MissingFields
+
+	HTTPProxy  string `json:"httpProxy,omitempty"`
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+	NoProxy    string `json:"noProxy,omitempty"`
+
+	// TrustedCA is a PEM bundle of the CA certificates which an inspecting
+	// proxy signs its certificates with
+	TrustedCA string `json:"trustedCa,omitempty"`
+}
+
+// LoggingProfile represents the log forwarding approved by the RP.  Log sinks
+// are set in the cluster, but the operator only forwards logs to
+// SinkDestinations.
+type LoggingProfile struct {
+	MissingFields
+
+	// SinkDestinations are the storage accounts and host names which log
+	// sinks may forward cluster logs to
+	SinkDestinations []string `json:"sinkDestinations,omitempty"`
+}
+
 // MasterProfile represents a master profile
 type MasterProfile struct {
 	MissingFields
@@ -262,6 +295,49 @@ type Install struct {
 	Phase InstallPhase `json:"phase"`
 }
 
+// OperatorStatus represents the health reported by the ARO operator
+type OperatorStatus struct {
+	MissingFields
+
+	Version     string               `json:"version,omitempty"`
+	Conditions  []OperatorCondition  `json:"conditions,omitempty"`
+	Controllers []OperatorController `json:"controllers,omitempty"`
+	Deployments []OperatorDeployment `json:"deployments,omitempty"`
+
+	// LastSyncTime is when the monitor last wrote the status.  It is
+	// refreshed periodically even if nothing has changed, so an old value
+	// means that the status is stale.
+	LastSyncTime *time.Time `json:"lastSyncTime,omitempty"`
+}
+
+// OperatorCondition represents a condition set by the ARO operator's
+// checkers.  Messages and transition times are deliberately not recorded:
+// they change too often to be synced into the cluster document.
+type OperatorCondition struct {
+	MissingFields
+
+	Type   string `json:"type,omitempty"`
+	Status string `json:"status,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// OperatorController represents the mode an ARO operator controller is
+// running in
+type OperatorController struct {
+	MissingFields
+
+	Name string `json:"name,omitempty"`
+	Mode string `json:"mode,omitempty"`
+}
+
+// OperatorDeployment represents the readiness of an ARO operator deployment
+type OperatorDeployment struct {
+	MissingFields
+
+	Name  string `json:"name,omitempty"`
+	Ready bool   `json:"ready"`
+}
+
 // InstallPhase represents an install phase
 type InstallPhase int
 
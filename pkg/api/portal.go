@@ -14,13 +14,57 @@ type Portal struct {
 
 	SSH        *SSH        `json:"ssh,omitempty"`
 	Kubeconfig *Kubeconfig `json:"kubeconfig,omitempty"`
+
+	SSHRecording      *SSHRecording      `json:"sshRecording,omitempty"`
+	SSHRecordingChunk *SSHRecordingChunk `json:"sshRecordingChunk,omitempty"`
+
+	AccessRequest *AccessRequest `json:"accessRequest,omitempty"`
+
+	AuditRecord *AuditRecord `json:"auditRecord,omitempty"`
 }
 
 type SSH struct {
 	MissingFields
 
-	Master        int  `json:"master"`
+	Master int `json:"master"`
+
+	// Node is the name of the node being accessed, if it was requested by
+	// name.  If the node is not a master, Address is its internal IP address,
+	// which is reached via a master.
+	Node    string `json:"node,omitempty"`
+	Address string `json:"address,omitempty"`
+
 	Authenticated bool `json:"authenticated,omitempty"`
+
+	// Expiry is the Unix time at which the elevated access which the session
+	// was created under expires; the session is closed then
+	Expiry int64 `json:"expiry,omitempty"`
+}
+
+// SSHRecording describes the recording of an SSH session channel, or of a web
+// terminal session if Terminal is set.  The recording itself, in asciicast v2
+// format, is held in SSHRecordingChunks.
+type SSHRecording struct {
+	MissingFields
+
+	// SessionID is the ID of the PortalDocument which authenticated the
+	// session
+	SessionID string `json:"sessionId"`
+	Master    int    `json:"master"`
+	Node      string `json:"node,omitempty"`
+	Terminal  bool   `json:"terminal,omitempty"`
+
+	StartTime int64 `json:"startTime"`
+	EndTime   int64 `json:"endTime,omitempty"`
+}
+
+// SSHRecordingChunk is part of a recording.  Chunks are numbered from 0.
+type SSHRecordingChunk struct {
+	MissingFields
+
+	RecordingID string      `json:"recordingId"`
+	Index       int         `json:"index"`
+	Data        SecureBytes `json:"data"`
 }
 
 type Kubeconfig struct {
@@ -28,3 +72,54 @@ type Kubeconfig struct {
 
 	Elevated bool `json:"elevated,omitempty"`
 }
+
+// AccessRequest is a request by Username for elevated access to the cluster
+// ID.  Elevated access is only granted once the request has been approved by
+// somebody else, and until ExpiryTime.
+type AccessRequest struct {
+	MissingFields
+
+	Justification string `json:"justification"`
+	TicketID      string `json:"ticketId"`
+
+	State       AccessRequestState `json:"state"`
+	RequestTime int64              `json:"requestTime"`
+
+	Approver     string `json:"approver,omitempty"`
+	DecisionTime int64  `json:"decisionTime,omitempty"`
+	ExpiryTime   int64  `json:"expiryTime,omitempty"`
+}
+
+// AccessRequestState represents the state of an AccessRequest
+type AccessRequestState string
+
+// AccessRequestState constants
+const (
+	AccessRequestStatePending  AccessRequestState = "Pending"
+	AccessRequestStateApproved AccessRequestState = "Approved"
+	AccessRequestStateDenied   AccessRequestState = "Denied"
+)
+
+// AuditRecord is a copy of an audit log record written by the portal, kept so
+// that it can be reviewed in the portal by auditors.  The Username and ID of
+// the Portal are the caller and the resourceID of the cluster accessed, if any.
+// Time is in UTC and formatted as AuditRecordTimeFormat, so that records can be
+// compared as strings.
+type AuditRecord struct {
+	MissingFields
+
+	Time          string `json:"time"`
+	Category      string `json:"category"`
+	OperationName string `json:"operationName"`
+
+	TargetResourceType string `json:"targetResourceType,omitempty"`
+	TargetResourceName string `json:"targetResourceName,omitempty"`
+
+	ResultType        string `json:"resultType"`
+	ResultDescription string `json:"resultDescription,omitempty"`
+
+	IPAddress string `json:"ipAddress,omitempty"`
+}
+
+// AuditRecordTimeFormat is the format of the Time of an AuditRecord
+const AuditRecordTimeFormat = "2006-01-02T15:04:05Z"
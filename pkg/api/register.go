@@ -17,11 +17,17 @@ type OpenShiftClusterCredentialsConverter interface {
 	ToExternal(*OpenShiftCluster) interface{}
 }
 
+type BulkActionConverter interface {
+	ToExternal(string, *BulkAction) interface{}
+	ToInternal(interface{}, *BulkAction)
+}
+
 // Version is a set of endpoints implemented by each API version
 type Version struct {
 	OpenShiftClusterConverter            func() OpenShiftClusterConverter
 	OpenShiftClusterStaticValidator      func(string, string, bool, string) OpenShiftClusterStaticValidator
 	OpenShiftClusterCredentialsConverter func() OpenShiftClusterCredentialsConverter
+	BulkActionConverter                  func() BulkActionConverter
 }
 
 // APIs is the map of registered API versions
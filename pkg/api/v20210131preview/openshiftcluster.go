@@ -53,6 +53,9 @@ type OpenShiftClusterProperties struct {
 	// The cluster network profile.
 	NetworkProfile NetworkProfile `json:"networkProfile,omitempty"`
 
+	// The cluster proxy profile.
+	ProxyProfile ProxyProfile `json:"proxyProfile,omitempty"`
+
 	// The cluster master profile.
 	MasterProfile MasterProfile `json:"masterProfile,omitempty"`
 
@@ -118,6 +121,23 @@ type NetworkProfile struct {
 	ServiceCIDR string `json:"serviceCidr,omitempty"`
 }
 
+// ProxyProfile represents an outbound proxy profile.
+type ProxyProfile struct {
+	// The URL of the proxy for HTTP requests.
+	HTTPProxy string `json:"httpProxy,omitempty"`
+
+	// The URL of the proxy for HTTPS requests.
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+
+	// A comma-separated list of destination domain names, domains, IP
+	// addresses or other network CIDRs which bypass the proxy.
+	NoProxy string `json:"noProxy,omitempty"`
+
+	// A PEM bundle of the CA certificates which an inspecting proxy signs
+	// its certificates with.
+	TrustedCA string `json:"trustedCa,omitempty"`
+}
+
 // MasterProfile represents a master profile.
 type MasterProfile struct {
 	// The size of the master VMs.
@@ -38,6 +38,12 @@ func (c *openShiftClusterConverter) ToExternal(oc *api.OpenShiftCluster) interfa
 				PodCIDR:     oc.Properties.NetworkProfile.PodCIDR,
 				ServiceCIDR: oc.Properties.NetworkProfile.ServiceCIDR,
 			},
+			ProxyProfile: ProxyProfile{
+				HTTPProxy:  oc.Properties.ProxyProfile.HTTPProxy,
+				HTTPSProxy: oc.Properties.ProxyProfile.HTTPSProxy,
+				NoProxy:    oc.Properties.ProxyProfile.NoProxy,
+				TrustedCA:  oc.Properties.ProxyProfile.TrustedCA,
+			},
 			MasterProfile: MasterProfile{
 				VMSize:   VMSize(oc.Properties.MasterProfile.VMSize),
 				SubnetID: oc.Properties.MasterProfile.SubnetID,
@@ -127,6 +133,10 @@ func (c *openShiftClusterConverter) ToInternal(_oc interface{}, out *api.OpenShi
 	out.Properties.ServicePrincipalProfile.ClientSecret = api.SecureString(oc.Properties.ServicePrincipalProfile.ClientSecret)
 	out.Properties.NetworkProfile.PodCIDR = oc.Properties.NetworkProfile.PodCIDR
 	out.Properties.NetworkProfile.ServiceCIDR = oc.Properties.NetworkProfile.ServiceCIDR
+	out.Properties.ProxyProfile.HTTPProxy = oc.Properties.ProxyProfile.HTTPProxy
+	out.Properties.ProxyProfile.HTTPSProxy = oc.Properties.ProxyProfile.HTTPSProxy
+	out.Properties.ProxyProfile.NoProxy = oc.Properties.ProxyProfile.NoProxy
+	out.Properties.ProxyProfile.TrustedCA = oc.Properties.ProxyProfile.TrustedCA
 	out.Properties.MasterProfile.VMSize = api.VMSize(oc.Properties.MasterProfile.VMSize)
 	out.Properties.MasterProfile.SubnetID = oc.Properties.MasterProfile.SubnetID
 	out.Properties.WorkerProfiles = nil
@@ -15,6 +15,7 @@ import (
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/api/validate"
 	"github.com/Azure/ARO-RP/pkg/util/immutable"
+	utilpem "github.com/Azure/ARO-RP/pkg/util/pem"
 	"github.com/Azure/ARO-RP/pkg/util/pullsecret"
 	"github.com/Azure/ARO-RP/pkg/util/subnet"
 	"github.com/Azure/ARO-RP/pkg/util/version"
@@ -93,6 +94,9 @@ func (sv *openShiftClusterStaticValidator) validateProperties(path string, p *Op
 	if err := sv.validateNetworkProfile(path+".networkProfile", &p.NetworkProfile); err != nil {
 		return err
 	}
+	if err := sv.validateProxyProfile(path+".proxyProfile", &p.ProxyProfile); err != nil {
+		return err
+	}
 	if err := sv.validateMasterProfile(path+".masterProfile", &p.MasterProfile); err != nil {
 		return err
 	}
@@ -211,6 +215,42 @@ func (sv *openShiftClusterStaticValidator) validateNetworkProfile(path string, n
 	return nil
 }
 
+func (sv *openShiftClusterStaticValidator) validateProxyProfile(path string, pp *ProxyProfile) error {
+	if pp.HTTPProxy != "" {
+		u, err := url.Parse(pp.HTTPProxy)
+		if err != nil || u.Scheme != "http" || u.Host == "" {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".httpProxy", "The provided HTTP proxy '%s' is invalid: must be an http URL.", pp.HTTPProxy)
+		}
+	}
+	if pp.HTTPSProxy != "" {
+		u, err := url.Parse(pp.HTTPSProxy)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".httpsProxy", "The provided HTTPS proxy '%s' is invalid: must be an http or https URL.", pp.HTTPSProxy)
+		}
+	}
+	if pp.NoProxy != "" {
+		if pp.HTTPProxy == "" && pp.HTTPSProxy == "" {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".noProxy", "The provided no proxy list is invalid: must be empty when no proxy is set.")
+		}
+		for _, np := range strings.Split(pp.NoProxy, ",") {
+			if strings.TrimSpace(np) == "" {
+				return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".noProxy", "The provided no proxy list '%s' is invalid.", pp.NoProxy)
+			}
+		}
+	}
+	if pp.TrustedCA != "" {
+		if pp.HTTPProxy == "" && pp.HTTPSProxy == "" {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".trustedCa", "The provided trusted CA bundle is invalid: must be empty when no proxy is set.")
+		}
+		key, certs, err := utilpem.Parse([]byte(pp.TrustedCA))
+		if err != nil || key != nil || len(certs) == 0 {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".trustedCa", "The provided trusted CA bundle is invalid: must be PEM encoded certificates.")
+		}
+	}
+
+	return nil
+}
+
 func (sv *openShiftClusterStaticValidator) validateMasterProfile(path string, mp *MasterProfile) error {
 	if !validate.VMSizeIsValid(api.VMSize(mp.VMSize), sv.requireD2sV3Workers, true) {
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".vmSize", "The provided master VM size '%s' is invalid.", mp.VMSize)
@@ -13,6 +13,7 @@ import (
 	"github.com/gofrs/uuid"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	utiltls "github.com/Azure/ARO-RP/pkg/util/tls"
 	"github.com/Azure/ARO-RP/pkg/util/version"
 	"github.com/Azure/ARO-RP/test/validate"
 )
@@ -448,6 +449,81 @@ func TestOpenShiftClusterStaticValidateNetworkProfile(t *testing.T) {
 	runTests(t, testModeUpdate, tests)
 }
 
+func TestOpenShiftClusterStaticValidateProxyProfile(t *testing.T) {
+	_, cacerts, err := utiltls.GenerateKeyAndCertificate("proxy-ca", nil, nil, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	trustedCA, err := utiltls.CertAsBytes(cacerts[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []*validateTest{
+		{
+			name: "valid",
+		},
+		{
+			name: "valid proxy",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ProxyProfile = ProxyProfile{
+					HTTPProxy:  "http://proxy.example.com:3128",
+					HTTPSProxy: "https://proxy.example.com:3129",
+					NoProxy:    ".example.com,10.0.0.0/8",
+					TrustedCA:  string(trustedCA),
+				}
+			},
+		},
+		{
+			name: "httpProxy invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ProxyProfile.HTTPProxy = "https://proxy.example.com:3128"
+			},
+			wantErr: "400: InvalidParameter: properties.proxyProfile.httpProxy: The provided HTTP proxy 'https://proxy.example.com:3128' is invalid: must be an http URL.",
+		},
+		{
+			name: "httpsProxy invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ProxyProfile.HTTPSProxy = "proxy.example.com:3128"
+			},
+			wantErr: "400: InvalidParameter: properties.proxyProfile.httpsProxy: The provided HTTPS proxy 'proxy.example.com:3128' is invalid: must be an http or https URL.",
+		},
+		{
+			name: "noProxy without proxy",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ProxyProfile.NoProxy = ".example.com"
+			},
+			wantErr: "400: InvalidParameter: properties.proxyProfile.noProxy: The provided no proxy list is invalid: must be empty when no proxy is set.",
+		},
+		{
+			name: "noProxy invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ProxyProfile.HTTPProxy = "http://proxy.example.com:3128"
+				oc.Properties.ProxyProfile.NoProxy = ".example.com,,10.0.0.0/8"
+			},
+			wantErr: "400: InvalidParameter: properties.proxyProfile.noProxy: The provided no proxy list '.example.com,,10.0.0.0/8' is invalid.",
+		},
+		{
+			name: "trustedCa without proxy",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ProxyProfile.TrustedCA = string(trustedCA)
+			},
+			wantErr: "400: InvalidParameter: properties.proxyProfile.trustedCa: The provided trusted CA bundle is invalid: must be empty when no proxy is set.",
+		},
+		{
+			name: "trustedCa invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ProxyProfile.HTTPProxy = "http://proxy.example.com:3128"
+				oc.Properties.ProxyProfile.TrustedCA = "not a certificate"
+			},
+			wantErr: "400: InvalidParameter: properties.proxyProfile.trustedCa: The provided trusted CA bundle is invalid: must be PEM encoded certificates.",
+		},
+	}
+
+	runTests(t, testModeCreate, tests)
+}
+
 func TestOpenShiftClusterStaticValidateMasterProfile(t *testing.T) {
 	tests := []*validateTest{
 		{
@@ -773,6 +849,11 @@ func TestOpenShiftClusterStaticValidateDelta(t *testing.T) {
 			modify:  func(oc *OpenShiftCluster) { oc.Properties.NetworkProfile.ServiceCIDR = "0.0.0.0/0" },
 			wantErr: "400: PropertyChangeNotAllowed: properties.networkProfile.serviceCidr: Changing property 'properties.networkProfile.serviceCidr' is not allowed.",
 		},
+		{
+			name:    "httpProxy change",
+			modify:  func(oc *OpenShiftCluster) { oc.Properties.ProxyProfile.HTTPProxy = "http://proxy.example.com:3128" },
+			wantErr: "400: PropertyChangeNotAllowed: properties.proxyProfile.httpProxy: Changing property 'properties.proxyProfile.httpProxy' is not allowed.",
+		},
 		{
 			name: "master subnetId change",
 			modify: func(oc *OpenShiftCluster) {
@@ -32,6 +32,7 @@ type backend struct {
 	dbBilling           database.Billing
 	dbOpenShiftClusters database.OpenShiftClusters
 	dbSubscriptions     database.Subscriptions
+	dbBulkActions       database.BulkActions
 
 	aead    encryption.AEAD
 	m       metrics.Interface
@@ -44,6 +45,7 @@ type backend struct {
 
 	ocb *openShiftClusterBackend
 	sb  *subscriptionBackend
+	bab *bulkActionBackend
 }
 
 // Runnable represents a runnable object
@@ -52,18 +54,19 @@ type Runnable interface {
 }
 
 // NewBackend returns a new runnable backend
-func NewBackend(ctx context.Context, log *logrus.Entry, env env.Interface, dbAsyncOperations database.AsyncOperations, dbBilling database.Billing, dbOpenShiftClusters database.OpenShiftClusters, dbSubscriptions database.Subscriptions, aead encryption.AEAD, m metrics.Interface) (Runnable, error) {
-	b, err := newBackend(ctx, log, env, dbAsyncOperations, dbBilling, dbOpenShiftClusters, dbSubscriptions, aead, m)
+func NewBackend(ctx context.Context, log *logrus.Entry, env env.Interface, dbAsyncOperations database.AsyncOperations, dbBilling database.Billing, dbOpenShiftClusters database.OpenShiftClusters, dbSubscriptions database.Subscriptions, dbBulkActions database.BulkActions, aead encryption.AEAD, m metrics.Interface) (Runnable, error) {
+	b, err := newBackend(ctx, log, env, dbAsyncOperations, dbBilling, dbOpenShiftClusters, dbSubscriptions, dbBulkActions, aead, m)
 	if err != nil {
 		return nil, err
 	}
 
 	b.ocb = newOpenShiftClusterBackend(b)
 	b.sb = newSubscriptionBackend(b)
+	b.bab = newBulkActionBackend(b)
 	return b, nil
 }
 
-func newBackend(ctx context.Context, log *logrus.Entry, env env.Interface, dbAsyncOperations database.AsyncOperations, dbBilling database.Billing, dbOpenShiftClusters database.OpenShiftClusters, dbSubscriptions database.Subscriptions, aead encryption.AEAD, m metrics.Interface) (*backend, error) {
+func newBackend(ctx context.Context, log *logrus.Entry, env env.Interface, dbAsyncOperations database.AsyncOperations, dbBilling database.Billing, dbOpenShiftClusters database.OpenShiftClusters, dbSubscriptions database.Subscriptions, dbBulkActions database.BulkActions, aead encryption.AEAD, m metrics.Interface) (*backend, error) {
 	billing, err := billing.NewManager(env, dbBilling, dbSubscriptions, log)
 	if err != nil {
 		return nil, err
@@ -77,6 +80,7 @@ func newBackend(ctx context.Context, log *logrus.Entry, env env.Interface, dbAsy
 		dbBilling:           dbBilling,
 		dbOpenShiftClusters: dbOpenShiftClusters,
 		dbSubscriptions:     dbSubscriptions,
+		dbBulkActions:       dbBulkActions,
 
 		billing: billing,
 		aead:    aead,
@@ -125,7 +129,12 @@ func (b *backend) Run(ctx context.Context, stop <-chan struct{}, done chan<- str
 			b.baseLog.Error(err)
 		}
 
-		if !(ocbDidWork || sbDidWork) {
+		babDidWork, err := b.bab.try(ctx)
+		if err != nil {
+			b.baseLog.Error(err)
+		}
+
+		if !(ocbDidWork || sbDidWork || babDidWork) {
 			<-t.C
 		}
 	}
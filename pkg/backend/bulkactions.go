@@ -0,0 +1,434 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
+	"github.com/Azure/ARO-RP/pkg/util/recover"
+)
+
+const (
+	// maxBulkActionWorkers is the number of bulk actions which one backend
+	// runs at once
+	maxBulkActionWorkers = 5
+
+	// bulkActionClusterTimeout bounds the time spent acting on any one
+	// cluster
+	bulkActionClusterTimeout = 30 * time.Minute
+)
+
+type bulkActionBackend struct {
+	*backend
+
+	newKubeActions  func(*logrus.Entry, env.Interface, *api.OpenShiftCluster) (adminactions.KubeActions, error)
+	newAzureActions func(*logrus.Entry, env.Interface, *api.OpenShiftCluster, *api.SubscriptionDocument) (adminactions.AzureActions, error)
+
+	// bulkActionWorkers is the number of bulk actions being run
+	bulkActionWorkers int32
+
+	now func() time.Time
+}
+
+func newBulkActionBackend(b *backend) *bulkActionBackend {
+	return &bulkActionBackend{
+		backend:         b,
+		newKubeActions:  adminactions.NewKubeActions,
+		newAzureActions: adminactions.NewAzureActions,
+		now:             time.Now,
+	}
+}
+
+// try tries to dequeue a BulkActionDocument for work, and works it on a new
+// goroutine.  It returns a boolean to the caller indicating whether it
+// succeeded in dequeuing anything - if this is false, the caller should sleep
+// before calling again.  Bulk actions are leased while they run, so that if a
+// backend exits part way through one, another backend resumes it once the
+// lease expires.
+func (bab *bulkActionBackend) try(ctx context.Context) (bool, error) {
+	if atomic.LoadInt32(&bab.bulkActionWorkers) >= maxBulkActionWorkers {
+		return false, nil
+	}
+
+	doc, err := bab.dbBulkActions.Dequeue(ctx)
+	if err != nil || doc == nil {
+		return false, err
+	}
+
+	log := bab.baseLog.WithField("bulk_action_id", doc.ID)
+	if doc.Dequeues > maxDequeueCount {
+		log.Errorf("dequeued %d times, failing", doc.Dequeues)
+		bab.endBulkAction(ctx, log, doc.ID, fmt.Errorf("the bulk action was dequeued %d times", doc.Dequeues))
+		_, err = bab.dbBulkActions.EndLease(ctx, doc.ID)
+		return true, err
+	}
+
+	log.Print("dequeued")
+	atomic.AddInt32(&bab.workers, 1)
+	atomic.AddInt32(&bab.bulkActionWorkers, 1)
+	bab.m.EmitGauge("backend.bulkactions.workers.count", int64(atomic.LoadInt32(&bab.bulkActionWorkers)), nil)
+
+	go func() {
+		defer recover.Panic(log)
+
+		t := time.Now()
+
+		defer func() {
+			atomic.AddInt32(&bab.bulkActionWorkers, -1)
+			atomic.AddInt32(&bab.workers, -1)
+			bab.m.EmitGauge("backend.bulkactions.workers.count", int64(atomic.LoadInt32(&bab.bulkActionWorkers)), nil)
+			bab.cond.Signal()
+
+			log.WithField("duration", time.Since(t).Seconds()).Print("done")
+		}()
+
+		err := bab.handle(context.Background(), log, doc.ID)
+		if err != nil {
+			log.Error(err)
+		}
+	}()
+
+	return true, nil
+}
+
+// handle runs a leased bulk action, renewing the lease while it runs
+func (bab *bulkActionBackend) handle(ctx context.Context, log *logrus.Entry, id string) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stop := bab.heartbeat(ctx, cancel, log, id)
+	defer stop()
+
+	bab.run(ctx, log, id)
+
+	stop()
+
+	_, err := bab.dbBulkActions.EndLease(ctx, id)
+	return err
+}
+
+func (bab *bulkActionBackend) heartbeat(ctx context.Context, cancel context.CancelFunc, log *logrus.Entry, id string) func() {
+	var stopped bool
+	stop, done := make(chan struct{}), make(chan struct{})
+
+	go func() {
+		defer recover.Panic(log)
+
+		defer close(done)
+
+		t := time.NewTicker(10 * time.Second)
+		defer t.Stop()
+
+		for {
+			_, err := bab.dbBulkActions.Lease(ctx, id)
+			if err != nil {
+				log.Error(err)
+				cancel()
+				return
+			}
+
+			select {
+			case <-t.C:
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		if !stopped {
+			close(stop)
+			<-done
+			stopped = true
+		}
+	}
+}
+
+// run runs a leased bulk action, recording progress in the bulk action
+// document.  A pending bulk action first selects the clusters it matches; a
+// running bulk action was interrupted, and resumes on the clusters which it
+// had not yet started acting on.  Clusters which were being acted on when it
+// was interrupted are failed rather than acted on again, as it is not known
+// how far the action got.  All updates are made under the lease, so that a
+// backend which loses the lease cannot overwrite the progress of its
+// successor.
+func (bab *bulkActionBackend) run(ctx context.Context, log *logrus.Entry, id string) {
+	doc, err := bab.dbBulkActions.Get(ctx, id)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	if doc.BulkAction.State == api.BulkActionStatePending {
+		ocs, err := bab.selectClusters(ctx, &doc.BulkAction.Selector)
+		if err != nil {
+			log.Error(err)
+			bab.endBulkAction(ctx, log, id, err)
+			return
+		}
+
+		log.Infof("running %s on %d clusters", doc.BulkAction.Action, len(ocs))
+
+		doc, err = bab.dbBulkActions.PatchWithLease(ctx, id, func(doc *api.BulkActionDocument) error {
+			doc.BulkAction.State = api.BulkActionStateRunning
+			doc.BulkAction.Results = make([]*api.BulkActionResult, 0, len(ocs))
+			for _, oc := range ocs {
+				doc.BulkAction.Results = append(doc.BulkAction.Results, &api.BulkActionResult{
+					ResourceID: oc.ID,
+					State:      api.BulkActionStatePending,
+				})
+			}
+			return nil
+		})
+		if err != nil {
+			log.Error(err)
+			return
+		}
+	} else {
+		log.Infof("resuming %s", doc.BulkAction.Action)
+
+		endTime := bab.now().UTC()
+
+		doc, err = bab.dbBulkActions.PatchWithLease(ctx, id, func(doc *api.BulkActionDocument) error {
+			for _, r := range doc.BulkAction.Results {
+				if r.State == api.BulkActionStateRunning {
+					r.State = api.BulkActionStateFailed
+					r.Error = "interrupted"
+					r.EndTime = &endTime
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			log.Error(err)
+			return
+		}
+	}
+
+	sem := make(chan struct{}, doc.BulkAction.Concurrency)
+	var wg sync.WaitGroup
+
+	for i, r := range doc.BulkAction.Results {
+		if r.State != api.BulkActionStatePending {
+			continue
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func(i int, resourceID string) {
+			defer recover.Panic(log)
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			bab.runOnCluster(ctx, log.WithField("resource_id", resourceID), id, i, doc.BulkAction, resourceID)
+		}(i, r.ResourceID)
+	}
+
+	wg.Wait()
+
+	// if the lease was lost, leave the bulk action to the backend which holds
+	// it now
+	if ctx.Err() != nil {
+		return
+	}
+
+	bab.endBulkAction(ctx, log, id, nil)
+}
+
+func (bab *bulkActionBackend) selectClusters(ctx context.Context, selector *api.BulkActionSelector) ([]*api.OpenShiftCluster, error) {
+	partitionKey, q := bulkActionQuery(selector)
+
+	i, err := bab.dbOpenShiftClusters.ListByQuery(partitionKey, q, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var ocs []*api.OpenShiftCluster
+	for {
+		docs, err := i.Next(ctx, -1)
+		if err != nil {
+			return nil, err
+		}
+		if docs == nil {
+			break
+		}
+
+		for _, doc := range docs.OpenShiftClusterDocuments {
+			ocs = append(ocs, doc.OpenShiftCluster)
+		}
+	}
+
+	return ocs, nil
+}
+
+// bulkActionQuery returns the partition key and database query corresponding
+// to the selector
+func bulkActionQuery(selector *api.BulkActionSelector) (string, *database.OpenShiftClustersQuery) {
+	var partitionKey string
+	q := &database.OpenShiftClustersQuery{}
+
+	if selector.SubscriptionID != "" {
+		partitionKey = strings.ToLower(selector.SubscriptionID)
+		q.Prefix = "/subscriptions/" + partitionKey + "/"
+	}
+
+	if selector.ProvisioningState != "" {
+		q.Conditions = append(q.Conditions, database.OpenShiftClustersQueryCondition{
+			Field:    "provisioningState",
+			Operator: database.OpenShiftClustersQueryOperatorEq,
+			Value:    string(selector.ProvisioningState),
+		})
+	}
+
+	if selector.Version != "" {
+		q.Conditions = append(q.Conditions, database.OpenShiftClustersQueryCondition{
+			Field:    "version",
+			Operator: database.OpenShiftClustersQueryOperatorEq,
+			Value:    selector.Version,
+		})
+	}
+
+	for k, v := range selector.Tags {
+		q.Conditions = append(q.Conditions, database.OpenShiftClustersQueryCondition{
+			Field:    "tags/" + k,
+			Operator: database.OpenShiftClustersQueryOperatorEq,
+			Value:    v,
+		})
+	}
+
+	return partitionKey, q
+}
+
+func (bab *bulkActionBackend) runOnCluster(ctx context.Context, log *logrus.Entry, id string, i int, ba *api.BulkAction, resourceID string) {
+	startTime := bab.now().UTC()
+
+	_, err := bab.dbBulkActions.PatchWithLease(ctx, id, func(doc *api.BulkActionDocument) error {
+		doc.BulkAction.Results[i].State = api.BulkActionStateRunning
+		doc.BulkAction.Results[i].StartTime = &startTime
+		return nil
+	})
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, bulkActionClusterTimeout)
+	defer cancel()
+
+	// the cluster is fetched afresh, as a resumed bulk action may have been
+	// created some time ago
+	doc, actionErr := bab.dbOpenShiftClusters.Get(timeoutCtx, strings.ToLower(resourceID))
+	if actionErr == nil {
+		actionErr = bab.actOnCluster(timeoutCtx, log, ba, doc.OpenShiftCluster)
+	}
+	if actionErr != nil {
+		log.Warn(actionErr)
+	}
+
+	endTime := bab.now().UTC()
+
+	_, err = bab.dbBulkActions.PatchWithLease(ctx, id, func(doc *api.BulkActionDocument) error {
+		doc.BulkAction.Results[i].State = api.BulkActionStateSucceeded
+		if actionErr != nil {
+			doc.BulkAction.Results[i].State = api.BulkActionStateFailed
+			doc.BulkAction.Results[i].Error = actionErr.Error()
+		}
+		doc.BulkAction.Results[i].EndTime = &endTime
+		return nil
+	})
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+func (bab *bulkActionBackend) actOnCluster(ctx context.Context, log *logrus.Entry, ba *api.BulkAction, oc *api.OpenShiftCluster) error {
+	switch ba.Action {
+	case api.BulkActionTypeKubernetesObjectsDelete:
+		k, err := bab.newKubeActions(log, bab.env, oc)
+		if err != nil {
+			return err
+		}
+
+		return k.KubeDelete(ctx, ba.Parameters["kind"], ba.Parameters["namespace"], ba.Parameters["name"])
+
+	case api.BulkActionTypeRedeployVM:
+		// don't race with a backend worker which is changing the cluster
+		if !oc.Properties.ProvisioningState.IsTerminal() {
+			return fmt.Errorf("the cluster is in provisioningState %q", oc.Properties.ProvisioningState)
+		}
+
+		r, err := azure.ParseResourceID(oc.ID)
+		if err != nil {
+			return err
+		}
+
+		subscriptionDoc, err := bab.dbSubscriptions.Get(ctx, r.SubscriptionID)
+		if err != nil {
+			return err
+		}
+
+		a, err := bab.newAzureActions(log, bab.env, oc, subscriptionDoc)
+		if err != nil {
+			return err
+		}
+
+		return a.VMRedeployAndWait(ctx, api.BulkActionVMName(ba.Parameters["vmName"], oc.Properties.InfraID))
+
+	case api.BulkActionTypeUpgrade:
+		if !oc.Properties.ProvisioningState.IsTerminal() {
+			return fmt.Errorf("the cluster is in provisioningState %q", oc.Properties.ProvisioningState)
+		}
+
+		k, err := bab.newKubeActions(log, bab.env, oc)
+		if err != nil {
+			return err
+		}
+
+		return k.Upgrade(ctx, ba.Parameters["upgradeY"] == "true")
+	}
+
+	return fmt.Errorf("unknown action %q", ba.Action)
+}
+
+// endBulkAction marks the bulk action terminal.  The bulk action has failed if
+// bulkActionErr is set or if it failed on any cluster.
+func (bab *bulkActionBackend) endBulkAction(ctx context.Context, log *logrus.Entry, id string, bulkActionErr error) {
+	endTime := bab.now().UTC()
+
+	_, err := bab.dbBulkActions.PatchWithLease(ctx, id, func(doc *api.BulkActionDocument) error {
+		doc.BulkAction.State = api.BulkActionStateSucceeded
+		doc.BulkAction.EndTime = &endTime
+
+		if bulkActionErr != nil {
+			doc.BulkAction.State = api.BulkActionStateFailed
+			doc.BulkAction.Error = bulkActionErr.Error()
+		}
+
+		for _, r := range doc.BulkAction.Results {
+			if r.State != api.BulkActionStateSucceeded {
+				doc.BulkAction.State = api.BulkActionStateFailed
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Error(err)
+	}
+}
@@ -0,0 +1,420 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	mock_adminactions "github.com/Azure/ARO-RP/pkg/util/mocks/adminactions"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestBulkActionRun(t *testing.T) {
+	ctx := context.Background()
+	log := logrus.NewEntry(logrus.StandardLogger())
+
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	mockID := "00000000-0000-0000-0000-000000000001"
+	mockCurrentTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dbOpenShiftClusters, clientOpenShiftClusters := testdatabase.NewFakeOpenShiftClusters()
+	dbBulkActions, _ := testdatabase.NewFakeBulkActions()
+
+	// the fake database only evaluates queries it knows about
+	dbQuery := `SELECT * FROM OpenShiftClusters doc WHERE doc.openShiftCluster.properties.provisioningState = @p0`
+	clientOpenShiftClusters.SetQueryHandler(dbQuery, func(client cosmosdb.OpenShiftClusterDocumentClient, q *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.OpenShiftClusterDocumentRawIterator {
+		all, err := client.ListAll(context.Background(), nil)
+		if err != nil {
+			return cosmosdb.NewFakeOpenShiftClusterDocumentErroringRawIterator(err)
+		}
+
+		var docs []*api.OpenShiftClusterDocument
+		for _, doc := range all.OpenShiftClusterDocuments {
+			if string(doc.OpenShiftCluster.Properties.ProvisioningState) == q.Parameters[0].Value {
+				docs = append(docs, doc)
+			}
+		}
+		return cosmosdb.NewFakeOpenShiftClusterDocumentIterator(docs, 0)
+	})
+
+	f := testdatabase.NewFixture().WithOpenShiftClusters(dbOpenShiftClusters).WithBulkActions(dbBulkActions)
+	for _, name := range []string{"failed1", "failed2", "succeeded"} {
+		state := api.ProvisioningStateFailed
+		if name == "succeeded" {
+			state = api.ProvisioningStateSucceeded
+		}
+
+		f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+			Key: strings.ToLower(testdatabase.GetResourcePath(mockSubID, name)),
+			OpenShiftCluster: &api.OpenShiftCluster{
+				ID:   testdatabase.GetResourcePath(mockSubID, name),
+				Name: name,
+				Properties: api.OpenShiftClusterProperties{
+					ProvisioningState: state,
+				},
+			},
+		})
+	}
+
+	f.AddBulkActionDocuments(&api.BulkActionDocument{
+		ID: mockID,
+		BulkAction: &api.BulkAction{
+			Action: api.BulkActionTypeKubernetesObjectsDelete,
+			Parameters: map[string]string{
+				"kind":      "Pod",
+				"namespace": "openshift-azure-logging",
+				"name":      "mdsd-abcde",
+			},
+			Selector: api.BulkActionSelector{
+				ProvisioningState: api.ProvisioningStateFailed,
+			},
+			Concurrency: 1,
+			State:       api.BulkActionStatePending,
+			StartTime:   mockCurrentTime,
+		},
+	})
+
+	err := f.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	k := mock_adminactions.NewMockKubeActions(controller)
+	gomock.InOrder(
+		k.EXPECT().KubeDelete(gomock.Any(), "Pod", "openshift-azure-logging", "mdsd-abcde").Return(nil),
+		k.EXPECT().KubeDelete(gomock.Any(), "Pod", "openshift-azure-logging", "mdsd-abcde").Return(errors.New("random error")),
+	)
+
+	b, err := newBackend(ctx, log, nil, nil, nil, dbOpenShiftClusters, nil, dbBulkActions, nil, &noop.Noop{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b.bab = &bulkActionBackend{
+		backend: b,
+		newKubeActions: func(*logrus.Entry, env.Interface, *api.OpenShiftCluster) (adminactions.KubeActions, error) {
+			return k, nil
+		},
+		now: func() time.Time { return mockCurrentTime },
+	}
+
+	worked, err := b.bab.try(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !worked {
+		t.Fatal("didnt do work")
+	}
+
+	// wait on the workers to finish their tasks
+	b.waitForWorkerCompletion()
+
+	doc, err := dbBulkActions.Get(ctx, mockID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if doc.LeaseOwner != "" {
+		t.Error(doc.LeaseOwner)
+	}
+
+	for _, diff := range deep.Equal(doc.BulkAction, &api.BulkAction{
+		Action: api.BulkActionTypeKubernetesObjectsDelete,
+		Parameters: map[string]string{
+			"kind":      "Pod",
+			"namespace": "openshift-azure-logging",
+			"name":      "mdsd-abcde",
+		},
+		Selector: api.BulkActionSelector{
+			ProvisioningState: api.ProvisioningStateFailed,
+		},
+		Concurrency: 1,
+		State:       api.BulkActionStateFailed,
+		StartTime:   mockCurrentTime,
+		EndTime:     &mockCurrentTime,
+		Results: []*api.BulkActionResult{
+			{
+				ResourceID: testdatabase.GetResourcePath(mockSubID, "failed1"),
+				State:      api.BulkActionStateSucceeded,
+				StartTime:  &mockCurrentTime,
+				EndTime:    &mockCurrentTime,
+			},
+			{
+				ResourceID: testdatabase.GetResourcePath(mockSubID, "failed2"),
+				State:      api.BulkActionStateFailed,
+				Error:      "random error",
+				StartTime:  &mockCurrentTime,
+				EndTime:    &mockCurrentTime,
+			},
+		},
+	}) {
+		t.Error(diff)
+	}
+}
+
+func TestBulkActionResume(t *testing.T) {
+	ctx := context.Background()
+	log := logrus.NewEntry(logrus.StandardLogger())
+
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	mockID := "00000000-0000-0000-0000-000000000001"
+	mockCurrentTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockStartTime := mockCurrentTime.Add(-time.Hour)
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dbOpenShiftClusters, _ := testdatabase.NewFakeOpenShiftClusters()
+	dbBulkActions, _ := testdatabase.NewFakeBulkActions()
+
+	f := testdatabase.NewFixture().WithOpenShiftClusters(dbOpenShiftClusters).WithBulkActions(dbBulkActions)
+	for _, name := range []string{"failed1", "failed2", "failed3"} {
+		f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+			Key: strings.ToLower(testdatabase.GetResourcePath(mockSubID, name)),
+			OpenShiftCluster: &api.OpenShiftCluster{
+				ID:   testdatabase.GetResourcePath(mockSubID, name),
+				Name: name,
+				Properties: api.OpenShiftClusterProperties{
+					ProvisioningState: api.ProvisioningStateFailed,
+				},
+			},
+		})
+	}
+
+	// the backend running the bulk action exited while it was acting on
+	// failed2
+	f.AddBulkActionDocuments(&api.BulkActionDocument{
+		ID: mockID,
+		BulkAction: &api.BulkAction{
+			Action: api.BulkActionTypeKubernetesObjectsDelete,
+			Parameters: map[string]string{
+				"kind":      "Pod",
+				"namespace": "openshift-azure-logging",
+				"name":      "mdsd-abcde",
+			},
+			Selector: api.BulkActionSelector{
+				ProvisioningState: api.ProvisioningStateFailed,
+			},
+			Concurrency: 1,
+			State:       api.BulkActionStateRunning,
+			StartTime:   mockStartTime,
+			Results: []*api.BulkActionResult{
+				{
+					ResourceID: testdatabase.GetResourcePath(mockSubID, "failed1"),
+					State:      api.BulkActionStateSucceeded,
+					StartTime:  &mockStartTime,
+					EndTime:    &mockStartTime,
+				},
+				{
+					ResourceID: testdatabase.GetResourcePath(mockSubID, "failed2"),
+					State:      api.BulkActionStateRunning,
+					StartTime:  &mockStartTime,
+				},
+				{
+					ResourceID: testdatabase.GetResourcePath(mockSubID, "failed3"),
+					State:      api.BulkActionStatePending,
+				},
+			},
+		},
+	})
+
+	err := f.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	k := mock_adminactions.NewMockKubeActions(controller)
+	k.EXPECT().KubeDelete(gomock.Any(), "Pod", "openshift-azure-logging", "mdsd-abcde").Return(nil)
+
+	b, err := newBackend(ctx, log, nil, nil, nil, dbOpenShiftClusters, nil, dbBulkActions, nil, &noop.Noop{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b.bab = &bulkActionBackend{
+		backend: b,
+		newKubeActions: func(_ *logrus.Entry, _ env.Interface, oc *api.OpenShiftCluster) (adminactions.KubeActions, error) {
+			if oc.Name != "failed3" {
+				t.Errorf("unexpected cluster %s", oc.Name)
+			}
+			return k, nil
+		},
+		now: func() time.Time { return mockCurrentTime },
+	}
+
+	worked, err := b.bab.try(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !worked {
+		t.Fatal("didnt do work")
+	}
+
+	// wait on the workers to finish their tasks
+	b.waitForWorkerCompletion()
+
+	doc, err := dbBulkActions.Get(ctx, mockID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, diff := range deep.Equal(doc.BulkAction, &api.BulkAction{
+		Action: api.BulkActionTypeKubernetesObjectsDelete,
+		Parameters: map[string]string{
+			"kind":      "Pod",
+			"namespace": "openshift-azure-logging",
+			"name":      "mdsd-abcde",
+		},
+		Selector: api.BulkActionSelector{
+			ProvisioningState: api.ProvisioningStateFailed,
+		},
+		Concurrency: 1,
+		State:       api.BulkActionStateFailed,
+		StartTime:   mockStartTime,
+		EndTime:     &mockCurrentTime,
+		Results: []*api.BulkActionResult{
+			{
+				ResourceID: testdatabase.GetResourcePath(mockSubID, "failed1"),
+				State:      api.BulkActionStateSucceeded,
+				StartTime:  &mockStartTime,
+				EndTime:    &mockStartTime,
+			},
+			{
+				ResourceID: testdatabase.GetResourcePath(mockSubID, "failed2"),
+				State:      api.BulkActionStateFailed,
+				Error:      "interrupted",
+				StartTime:  &mockStartTime,
+				EndTime:    &mockCurrentTime,
+			},
+			{
+				ResourceID: testdatabase.GetResourcePath(mockSubID, "failed3"),
+				State:      api.BulkActionStateSucceeded,
+				StartTime:  &mockCurrentTime,
+				EndTime:    &mockCurrentTime,
+			},
+		},
+	}) {
+		t.Error(diff)
+	}
+}
+
+func TestBulkActionDequeuedTooManyTimes(t *testing.T) {
+	ctx := context.Background()
+	log := logrus.NewEntry(logrus.StandardLogger())
+
+	mockID := "00000000-0000-0000-0000-000000000001"
+	mockCurrentTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	dbBulkActions, _ := testdatabase.NewFakeBulkActions()
+
+	f := testdatabase.NewFixture().WithBulkActions(dbBulkActions)
+	f.AddBulkActionDocuments(&api.BulkActionDocument{
+		ID:       mockID,
+		Dequeues: maxDequeueCount,
+		BulkAction: &api.BulkAction{
+			Action: api.BulkActionTypeKubernetesObjectsDelete,
+			Selector: api.BulkActionSelector{
+				ProvisioningState: api.ProvisioningStateFailed,
+			},
+			Concurrency: 1,
+			State:       api.BulkActionStatePending,
+			StartTime:   mockCurrentTime,
+		},
+	})
+
+	err := f.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := newBackend(ctx, log, nil, nil, nil, nil, nil, dbBulkActions, nil, &noop.Noop{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b.bab = &bulkActionBackend{
+		backend: b,
+		now:     func() time.Time { return mockCurrentTime },
+	}
+
+	worked, err := b.bab.try(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !worked {
+		t.Error(worked)
+	}
+
+	doc, err := dbBulkActions.Get(ctx, mockID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if doc.BulkAction.State != api.BulkActionStateFailed {
+		t.Error(doc.BulkAction.State)
+	}
+	if doc.BulkAction.Error != "the bulk action was dequeued 6 times" {
+		t.Error(doc.BulkAction.Error)
+	}
+	if doc.LeaseOwner != "" {
+		t.Error(doc.LeaseOwner)
+	}
+
+	// a terminal bulk action is not dequeued again
+	worked, err = b.bab.try(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if worked {
+		t.Error(worked)
+	}
+}
+
+func TestBulkActionActOnClusterNotTerminal(t *testing.T) {
+	ctx := context.Background()
+	log := logrus.NewEntry(logrus.StandardLogger())
+
+	for _, action := range []api.BulkActionType{
+		api.BulkActionTypeRedeployVM,
+		api.BulkActionTypeUpgrade,
+	} {
+		t.Run(string(action), func(t *testing.T) {
+			bab := &bulkActionBackend{
+				newKubeActions: func(*logrus.Entry, env.Interface, *api.OpenShiftCluster) (adminactions.KubeActions, error) {
+					t.Error("unexpected call")
+					return nil, nil
+				},
+				newAzureActions: func(*logrus.Entry, env.Interface, *api.OpenShiftCluster, *api.SubscriptionDocument) (adminactions.AzureActions, error) {
+					t.Error("unexpected call")
+					return nil, nil
+				},
+			}
+
+			err := bab.actOnCluster(ctx, log, &api.BulkAction{Action: action}, &api.OpenShiftCluster{
+				Properties: api.OpenShiftClusterProperties{
+					ProvisioningState: api.ProvisioningStateUpdating,
+				},
+			})
+			if err == nil || err.Error() != `the cluster is in provisioningState "Updating"` {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -294,7 +294,7 @@ func TestBackendTry(t *testing.T) {
 				return manager, nil
 			}
 
-			b, err := newBackend(ctx, log, nil, nil, nil, dbOpenShiftClusters, dbSubscriptions, nil, &noop.Noop{})
+			b, err := newBackend(ctx, log, nil, nil, nil, dbOpenShiftClusters, dbSubscriptions, nil, nil, &noop.Noop{})
 			if err != nil {
 				t.Fatal(err)
 			}
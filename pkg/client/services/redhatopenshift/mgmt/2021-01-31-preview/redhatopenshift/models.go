@@ -408,6 +408,8 @@ type OpenShiftClusterProperties struct {
 	ServicePrincipalProfile *ServicePrincipalProfile `json:"servicePrincipalProfile,omitempty"`
 	// NetworkProfile - The cluster network profile.
 	NetworkProfile *NetworkProfile `json:"networkProfile,omitempty"`
+	// ProxyProfile - The cluster proxy profile.
+	ProxyProfile *ProxyProfile `json:"proxyProfile,omitempty"`
 	// MasterProfile - The cluster master profile.
 	MasterProfile *MasterProfile `json:"masterProfile,omitempty"`
 	// WorkerProfiles - The cluster worker profiles.
@@ -721,6 +723,18 @@ func NewOperationListPage(cur OperationList, getNextPage func(context.Context, O
 	}
 }
 
+// ProxyProfile proxyProfile represents an outbound proxy profile.
+type ProxyProfile struct {
+	// HTTPProxy - The URL of the proxy for HTTP requests.
+	HTTPProxy *string `json:"httpProxy,omitempty"`
+	// HTTPSProxy - The URL of the proxy for HTTPS requests.
+	HTTPSProxy *string `json:"httpsProxy,omitempty"`
+	// NoProxy - A comma-separated list of destination domain names, domains, IP addresses or other network CIDRs which bypass the proxy.
+	NoProxy *string `json:"noProxy,omitempty"`
+	// TrustedCa - A PEM bundle of the CA certificates which an inspecting proxy signs its certificates with.
+	TrustedCa *string `json:"trustedCa,omitempty"`
+}
+
 // ProxyResource the resource model definition for a ARM proxy resource. It will have everything other than
 // required location and tags
 type ProxyResource struct {
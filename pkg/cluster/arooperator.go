@@ -5,6 +5,10 @@ package cluster
 
 import (
 	"context"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/Azure/ARO-RP/pkg/operator/deploy"
 )
@@ -17,6 +21,16 @@ func (m *manager) ensureAROOperator(ctx context.Context) error {
 	return dep.CreateOrUpdate(ctx)
 }
 
+// updateAROOperatorSpec pushes the Cluster spec to the operator without
+// rolling out a new operator image
+func (m *manager) updateAROOperatorSpec(ctx context.Context) error {
+	dep, err := deploy.New(m.log, m.env, m.doc.OpenShiftCluster, m.kubernetescli, m.extensionscli, m.arocli)
+	if err != nil {
+		return err
+	}
+	return dep.UpdateSpec(ctx)
+}
+
 func (m *manager) aroDeploymentReady(ctx context.Context) (bool, error) {
 	dep, err := deploy.New(m.log, m.env, m.doc.OpenShiftCluster, m.kubernetescli, m.extensionscli, m.arocli)
 	if err != nil {
@@ -24,3 +38,95 @@ func (m *manager) aroDeploymentReady(ctx context.Context) (bool, error) {
 	}
 	return dep.IsReady(ctx)
 }
+
+// aroOperatorSettleTime is how long the operator is watched for errors after
+// it becomes ready following a rollout
+const aroOperatorSettleTime = 2 * time.Minute
+
+// aroDeploymentReadyOrRollback waits for the operator to become ready.  If
+// ensureAROOperator rolled out a new operator image, it then watches the
+// operator's conditions for errors for a while.  If the new image does not
+// become ready, or reports errors, the operator is rolled back to the image it
+// was running before and we wait for that to become ready instead; otherwise
+// the rollout ends and can no longer be rolled back.  There is nothing to roll
+// back to after the first rollout to a cluster.
+func (m *manager) aroDeploymentReadyOrRollback(ctx context.Context) error {
+	dep, err := deploy.New(m.log, m.env, m.doc.OpenShiftCluster, m.kubernetescli, m.extensionscli, m.arocli)
+	if err != nil {
+		return err
+	}
+
+	rollingOut, err := dep.RollingOut(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !rollingOut {
+		return m.waitForAROOperator(ctx, dep)
+	}
+
+	since := time.Now()
+
+	err = m.waitForAROOperator(ctx, dep)
+	switch err {
+	case nil:
+		var degraded []string
+		degraded, err = m.waitForAROOperatorErrors(ctx, dep, since)
+		if err != nil {
+			return err
+		}
+		if len(degraded) == 0 {
+			return dep.EndRollout(ctx)
+		}
+
+		m.log.Errorf("aro operator reported errors, rolling back: %s", strings.Join(degraded, "; "))
+
+	case wait.ErrWaitTimeout:
+		m.log.Errorf("aro operator did not become ready, rolling back")
+
+	default:
+		return err
+	}
+
+	rolledBack, rollbackErr := dep.Rollback(ctx)
+	if rollbackErr != nil {
+		return rollbackErr
+	}
+
+	if !rolledBack {
+		// a ready operator reporting errors is left for the operator's own
+		// conditions to surface
+		m.log.Info("aro operator has no previous version to roll back to")
+		return err
+	}
+
+	return m.waitForAROOperator(ctx, dep)
+}
+
+func (m *manager) waitForAROOperator(ctx context.Context, dep deploy.Operator) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, 20*time.Minute)
+	defer cancel()
+
+	return wait.PollImmediateUntil(10*time.Second, func() (bool, error) {
+		return dep.IsReady(ctx)
+	}, timeoutCtx.Done())
+}
+
+// waitForAROOperatorErrors returns the errors reported by the operator since
+// the rollout, waiting up to aroOperatorSettleTime for them to appear
+func (m *manager) waitForAROOperatorErrors(ctx context.Context, dep deploy.Operator, since time.Time) ([]string, error) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, aroOperatorSettleTime)
+	defer cancel()
+
+	var degraded []string
+	err := wait.PollImmediateUntil(10*time.Second, func() (bool, error) {
+		var err error
+		degraded, err = dep.Degraded(ctx, since)
+		return len(degraded) > 0, err
+	}, timeoutCtx.Done())
+	if err == wait.ErrWaitTimeout {
+		err = nil
+	}
+
+	return degraded, err
+}
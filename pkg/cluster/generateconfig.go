@@ -201,6 +201,18 @@ func (m *manager) generateInstallConfig(ctx context.Context) (*installconfig.Ins
 		installConfig.Config.Publish = types.InternalPublishingStrategy
 	}
 
+	// the installer sets the cluster Proxy configuration and trusts the proxy
+	// CA from the start, so that the bootstrap node and masters can pull
+	// images and reach Azure through the proxy during install
+	if pp := m.doc.OpenShiftCluster.Properties.ProxyProfile; pp.HTTPProxy != "" || pp.HTTPSProxy != "" {
+		installConfig.Config.Proxy = &types.Proxy{
+			HTTPProxy:  pp.HTTPProxy,
+			HTTPSProxy: pp.HTTPSProxy,
+			NoProxy:    pp.NoProxy,
+		}
+		installConfig.Config.AdditionalTrustBundle = pp.TrustedCA
+	}
+
 	installConfig.Config.Azure.Image, err = getRHCOSImage(ctx)
 	if err != nil {
 		return nil, nil, err
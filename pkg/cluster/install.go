@@ -44,10 +44,11 @@ func (m *manager) AdminUpdate(ctx context.Context) error {
 		steps.Action(m.fixMCSCert),
 		steps.Action(m.fixMCSUserData),
 		steps.Action(m.ensureAROOperator),
-		steps.Condition(m.aroDeploymentReady, 20*time.Minute),
+		steps.Action(m.aroDeploymentReadyOrRollback),
 		steps.Action(m.configureAPIServerCertificate),
 		steps.Action(m.configureIngressCertificate),
 		steps.Action(m.removePrivateDNSZone),
+		steps.Action(m.removeExpiredMustGathers),
 		steps.Action(m.updateProvisionedBy), // Run this last so we capture the resource provider only once the upgrade has been fully performed
 	}
 
@@ -65,6 +66,7 @@ func (m *manager) Update(ctx context.Context) error {
 		steps.Action(m.createOrUpdateDenyAssignment),
 		steps.Action(m.updateOpenShiftSecret),
 		steps.Action(m.updateAROSecret),
+		steps.Action(m.updateAROOperatorSpec),
 	}
 
 	return m.runSteps(ctx, steps)
@@ -0,0 +1,28 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"time"
+
+	mgmtstorage "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+
+	"github.com/Azure/ARO-RP/pkg/util/mustgather"
+	"github.com/Azure/ARO-RP/pkg/util/stringutils"
+)
+
+// removeExpiredMustGathers deletes must-gathers whose download link has
+// expired, in case no further must-gather was collected to clean them up
+func (m *manager) removeExpiredMustGathers(ctx context.Context) error {
+	resourceGroup := stringutils.LastTokenByte(m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID, '/')
+	account := "cluster" + m.doc.OpenShiftCluster.Properties.StorageSuffix
+
+	blobService, err := m.storage.BlobService(ctx, resourceGroup, account, mgmtstorage.L+mgmtstorage.D, mgmtstorage.SignedResourceTypesC+mgmtstorage.SignedResourceTypesO)
+	if err != nil {
+		return err
+	}
+
+	return mustgather.DeleteExpired(blobService.GetContainerReference(mustgather.Container), time.Now())
+}
@@ -13,6 +13,10 @@ import (
 	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
 )
 
+const (
+	AsyncOperationsListByClusterKeyQuery = `SELECT * FROM AsyncOperations doc WHERE doc.openShiftClusterKey = @openShiftClusterKey`
+)
+
 type asyncOperations struct {
 	c cosmosdb.AsyncOperationDocumentClient
 }
@@ -22,6 +26,7 @@ type AsyncOperations interface {
 	Create(context.Context, *api.AsyncOperationDocument) (*api.AsyncOperationDocument, error)
 	Get(context.Context, string) (*api.AsyncOperationDocument, error)
 	Patch(context.Context, string, func(*api.AsyncOperationDocument) error) (*api.AsyncOperationDocument, error)
+	ListByClusterKey(context.Context, string) (*api.AsyncOperationDocuments, error)
 }
 
 // NewAsyncOperations returns a new AsyncOperations
@@ -84,3 +89,21 @@ func (c *asyncOperations) Patch(ctx context.Context, id string, f func(*api.Asyn
 
 	return doc, err
 }
+
+// ListByClusterKey returns the asynchronous operations of the cluster with the
+// given key
+func (c *asyncOperations) ListByClusterKey(ctx context.Context, key string) (*api.AsyncOperationDocuments, error) {
+	if key != strings.ToLower(key) {
+		return nil, fmt.Errorf("key %q is not lower case", key)
+	}
+
+	return c.c.QueryAll(ctx, "", &cosmosdb.Query{
+		Query: AsyncOperationsListByClusterKeyQuery,
+		Parameters: []cosmosdb.Parameter{
+			{
+				Name:  "@openShiftClusterKey",
+				Value: key,
+			},
+		},
+	}, nil)
+}
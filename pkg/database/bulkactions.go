@@ -0,0 +1,176 @@
+package database
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+)
+
+const BulkActionsDequeueQuery string = `SELECT * FROM BulkActions doc WHERE doc.bulkAction.state IN ("Pending", "Running") AND (doc.leaseExpires ?? 0) < GetCurrentTimestamp() / 1000`
+
+type bulkActions struct {
+	c    cosmosdb.BulkActionDocumentClient
+	uuid string
+}
+
+// BulkActions is the database interface for BulkActionDocuments
+type BulkActions interface {
+	Create(context.Context, *api.BulkActionDocument) (*api.BulkActionDocument, error)
+	Get(context.Context, string) (*api.BulkActionDocument, error)
+	Patch(context.Context, string, func(*api.BulkActionDocument) error) (*api.BulkActionDocument, error)
+	PatchWithLease(context.Context, string, func(*api.BulkActionDocument) error) (*api.BulkActionDocument, error)
+	Dequeue(context.Context) (*api.BulkActionDocument, error)
+	Lease(context.Context, string) (*api.BulkActionDocument, error)
+	EndLease(context.Context, string) (*api.BulkActionDocument, error)
+}
+
+// NewBulkActions returns a new BulkActions
+func NewBulkActions(ctx context.Context, isLocalDevelopmentMode bool, dbc cosmosdb.DatabaseClient) (BulkActions, error) {
+	dbid, err := databaseName(isLocalDevelopmentMode)
+	if err != nil {
+		return nil, err
+	}
+
+	collc := cosmosdb.NewCollectionClient(dbc, dbid)
+
+	triggers := []*cosmosdb.Trigger{
+		{
+			ID:               "renewLease",
+			TriggerOperation: cosmosdb.TriggerOperationAll,
+			TriggerType:      cosmosdb.TriggerTypePre,
+			Body: `function trigger() {
+	var request = getContext().getRequest();
+	var body = request.getBody();
+	var date = new Date();
+	body["leaseExpires"] = Math.floor(date.getTime() / 1000) + 60;
+	request.setBody(body);
+}`,
+		},
+	}
+
+	triggerc := cosmosdb.NewTriggerClient(collc, collBulkActions)
+	for _, trigger := range triggers {
+		_, err := triggerc.Create(ctx, trigger)
+		if err != nil && !cosmosdb.IsErrorStatusCode(err, http.StatusConflict) {
+			return nil, err
+		}
+	}
+
+	client := cosmosdb.NewBulkActionDocumentClient(collc, collBulkActions)
+	return NewBulkActionsWithProvidedClient(client), nil
+}
+
+func NewBulkActionsWithProvidedClient(client cosmosdb.BulkActionDocumentClient) BulkActions {
+	return &bulkActions{
+		c:    client,
+		uuid: uuid.Must(uuid.NewV4()).String(),
+	}
+}
+
+func (c *bulkActions) Create(ctx context.Context, doc *api.BulkActionDocument) (*api.BulkActionDocument, error) {
+	if doc.ID != strings.ToLower(doc.ID) {
+		return nil, fmt.Errorf("id %q is not lower case", doc.ID)
+	}
+
+	doc, err := c.c.Create(ctx, doc.ID, doc, nil)
+
+	if err, ok := err.(*cosmosdb.Error); ok && err.StatusCode == http.StatusConflict {
+		err.StatusCode = http.StatusPreconditionFailed
+	}
+
+	return doc, err
+}
+
+func (c *bulkActions) Get(ctx context.Context, id string) (*api.BulkActionDocument, error) {
+	if id != strings.ToLower(id) {
+		return nil, fmt.Errorf("id %q is not lower case", id)
+	}
+
+	return c.c.Get(ctx, id, id, nil)
+}
+
+func (c *bulkActions) Patch(ctx context.Context, id string, f func(*api.BulkActionDocument) error) (*api.BulkActionDocument, error) {
+	return c.patch(ctx, id, f, nil)
+}
+
+func (c *bulkActions) patch(ctx context.Context, id string, f func(*api.BulkActionDocument) error, options *cosmosdb.Options) (*api.BulkActionDocument, error) {
+	var doc *api.BulkActionDocument
+
+	err := cosmosdb.RetryOnPreconditionFailed(func() (err error) {
+		doc, err = c.Get(ctx, id)
+		if err != nil {
+			return
+		}
+
+		err = f(doc)
+		if err != nil {
+			return
+		}
+
+		doc, err = c.c.Replace(ctx, doc.ID, doc, options)
+		return
+	})
+
+	return doc, err
+}
+
+func (c *bulkActions) PatchWithLease(ctx context.Context, id string, f func(*api.BulkActionDocument) error) (*api.BulkActionDocument, error) {
+	return c.patchWithLease(ctx, id, f, nil)
+}
+
+func (c *bulkActions) patchWithLease(ctx context.Context, id string, f func(*api.BulkActionDocument) error, options *cosmosdb.Options) (*api.BulkActionDocument, error) {
+	return c.patch(ctx, id, func(doc *api.BulkActionDocument) error {
+		if doc.LeaseOwner != c.uuid {
+			return fmt.Errorf("lost lease")
+		}
+
+		return f(doc)
+	}, options)
+}
+
+func (c *bulkActions) Dequeue(ctx context.Context) (*api.BulkActionDocument, error) {
+	i := c.c.Query("", &cosmosdb.Query{Query: BulkActionsDequeueQuery}, nil)
+
+	for {
+		docs, err := i.Next(ctx, -1)
+		if err != nil {
+			return nil, err
+		}
+		if docs == nil {
+			return nil, nil
+		}
+
+		for _, doc := range docs.BulkActionDocuments {
+			doc.LeaseOwner = c.uuid
+			doc.Dequeues++
+			doc, err = c.c.Replace(ctx, doc.ID, doc, &cosmosdb.Options{PreTriggers: []string{"renewLease"}})
+			if cosmosdb.IsErrorStatusCode(err, http.StatusPreconditionFailed) { // someone else got there first
+				continue
+			}
+			return doc, err
+		}
+	}
+}
+
+func (c *bulkActions) Lease(ctx context.Context, id string) (*api.BulkActionDocument, error) {
+	return c.patchWithLease(ctx, id, func(doc *api.BulkActionDocument) error {
+		return nil
+	}, &cosmosdb.Options{PreTriggers: []string{"renewLease"}})
+}
+
+func (c *bulkActions) EndLease(ctx context.Context, id string) (*api.BulkActionDocument, error) {
+	return c.patchWithLease(ctx, id, func(doc *api.BulkActionDocument) error {
+		doc.LeaseOwner = ""
+		doc.LeaseExpires = 0
+		return nil
+	}, nil)
+}
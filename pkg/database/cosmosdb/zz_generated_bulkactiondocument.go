@@ -0,0 +1,313 @@
+// Code generated by github.com/jim-minter/go-cosmosdb, DO NOT EDIT.
+
+package cosmosdb
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	pkg "github.com/Azure/ARO-RP/pkg/api"
+)
+
+type bulkActionDocumentClient struct {
+	*databaseClient
+	path string
+}
+
+// BulkActionDocumentClient is a bulkActionDocument client
+type BulkActionDocumentClient interface {
+	Create(context.Context, string, *pkg.BulkActionDocument, *Options) (*pkg.BulkActionDocument, error)
+	List(*Options) BulkActionDocumentIterator
+	ListAll(context.Context, *Options) (*pkg.BulkActionDocuments, error)
+	Get(context.Context, string, string, *Options) (*pkg.BulkActionDocument, error)
+	Replace(context.Context, string, *pkg.BulkActionDocument, *Options) (*pkg.BulkActionDocument, error)
+	Delete(context.Context, string, *pkg.BulkActionDocument, *Options) error
+	Query(string, *Query, *Options) BulkActionDocumentRawIterator
+	QueryAll(context.Context, string, *Query, *Options) (*pkg.BulkActionDocuments, error)
+	ChangeFeed(*Options) BulkActionDocumentIterator
+}
+
+type bulkActionDocumentChangeFeedIterator struct {
+	*bulkActionDocumentClient
+	continuation string
+	options      *Options
+}
+
+type bulkActionDocumentListIterator struct {
+	*bulkActionDocumentClient
+	continuation string
+	done         bool
+	options      *Options
+}
+
+type bulkActionDocumentQueryIterator struct {
+	*bulkActionDocumentClient
+	partitionkey string
+	query        *Query
+	continuation string
+	done         bool
+	options      *Options
+}
+
+// BulkActionDocumentIterator is a bulkActionDocument iterator
+type BulkActionDocumentIterator interface {
+	Next(context.Context, int) (*pkg.BulkActionDocuments, error)
+	Continuation() string
+}
+
+// BulkActionDocumentRawIterator is a bulkActionDocument raw iterator
+type BulkActionDocumentRawIterator interface {
+	BulkActionDocumentIterator
+	NextRaw(context.Context, int, interface{}) error
+}
+
+// NewBulkActionDocumentClient returns a new bulkActionDocument client
+func NewBulkActionDocumentClient(collc CollectionClient, collid string) BulkActionDocumentClient {
+	return &bulkActionDocumentClient{
+		databaseClient: collc.(*collectionClient).databaseClient,
+		path:           collc.(*collectionClient).path + "/colls/" + collid,
+	}
+}
+
+func (c *bulkActionDocumentClient) all(ctx context.Context, i BulkActionDocumentIterator) (*pkg.BulkActionDocuments, error) {
+	allbulkActionDocuments := &pkg.BulkActionDocuments{}
+
+	for {
+		bulkActionDocuments, err := i.Next(ctx, -1)
+		if err != nil {
+			return nil, err
+		}
+		if bulkActionDocuments == nil {
+			break
+		}
+
+		allbulkActionDocuments.Count += bulkActionDocuments.Count
+		allbulkActionDocuments.ResourceID = bulkActionDocuments.ResourceID
+		allbulkActionDocuments.BulkActionDocuments = append(allbulkActionDocuments.BulkActionDocuments, bulkActionDocuments.BulkActionDocuments...)
+	}
+
+	return allbulkActionDocuments, nil
+}
+
+func (c *bulkActionDocumentClient) Create(ctx context.Context, partitionkey string, newbulkActionDocument *pkg.BulkActionDocument, options *Options) (bulkActionDocument *pkg.BulkActionDocument, err error) {
+	headers := http.Header{}
+	headers.Set("X-Ms-Documentdb-Partitionkey", `["`+partitionkey+`"]`)
+
+	if options == nil {
+		options = &Options{}
+	}
+	options.NoETag = true
+
+	err = c.setOptions(options, newbulkActionDocument, headers)
+	if err != nil {
+		return
+	}
+
+	err = c.do(ctx, http.MethodPost, c.path+"/docs", "docs", c.path, http.StatusCreated, &newbulkActionDocument, &bulkActionDocument, headers)
+	return
+}
+
+func (c *bulkActionDocumentClient) List(options *Options) BulkActionDocumentIterator {
+	continuation := ""
+	if options != nil {
+		continuation = options.Continuation
+	}
+
+	return &bulkActionDocumentListIterator{bulkActionDocumentClient: c, options: options, continuation: continuation}
+}
+
+func (c *bulkActionDocumentClient) ListAll(ctx context.Context, options *Options) (*pkg.BulkActionDocuments, error) {
+	return c.all(ctx, c.List(options))
+}
+
+func (c *bulkActionDocumentClient) Get(ctx context.Context, partitionkey, bulkActionDocumentid string, options *Options) (bulkActionDocument *pkg.BulkActionDocument, err error) {
+	headers := http.Header{}
+	headers.Set("X-Ms-Documentdb-Partitionkey", `["`+partitionkey+`"]`)
+
+	err = c.setOptions(options, nil, headers)
+	if err != nil {
+		return
+	}
+
+	err = c.do(ctx, http.MethodGet, c.path+"/docs/"+bulkActionDocumentid, "docs", c.path+"/docs/"+bulkActionDocumentid, http.StatusOK, nil, &bulkActionDocument, headers)
+	return
+}
+
+func (c *bulkActionDocumentClient) Replace(ctx context.Context, partitionkey string, newbulkActionDocument *pkg.BulkActionDocument, options *Options) (bulkActionDocument *pkg.BulkActionDocument, err error) {
+	headers := http.Header{}
+	headers.Set("X-Ms-Documentdb-Partitionkey", `["`+partitionkey+`"]`)
+
+	err = c.setOptions(options, newbulkActionDocument, headers)
+	if err != nil {
+		return
+	}
+
+	err = c.do(ctx, http.MethodPut, c.path+"/docs/"+newbulkActionDocument.ID, "docs", c.path+"/docs/"+newbulkActionDocument.ID, http.StatusOK, &newbulkActionDocument, &bulkActionDocument, headers)
+	return
+}
+
+func (c *bulkActionDocumentClient) Delete(ctx context.Context, partitionkey string, bulkActionDocument *pkg.BulkActionDocument, options *Options) (err error) {
+	headers := http.Header{}
+	headers.Set("X-Ms-Documentdb-Partitionkey", `["`+partitionkey+`"]`)
+
+	err = c.setOptions(options, bulkActionDocument, headers)
+	if err != nil {
+		return
+	}
+
+	err = c.do(ctx, http.MethodDelete, c.path+"/docs/"+bulkActionDocument.ID, "docs", c.path+"/docs/"+bulkActionDocument.ID, http.StatusNoContent, nil, nil, headers)
+	return
+}
+
+func (c *bulkActionDocumentClient) Query(partitionkey string, query *Query, options *Options) BulkActionDocumentRawIterator {
+	continuation := ""
+	if options != nil {
+		continuation = options.Continuation
+	}
+
+	return &bulkActionDocumentQueryIterator{bulkActionDocumentClient: c, partitionkey: partitionkey, query: query, options: options, continuation: continuation}
+}
+
+func (c *bulkActionDocumentClient) QueryAll(ctx context.Context, partitionkey string, query *Query, options *Options) (*pkg.BulkActionDocuments, error) {
+	return c.all(ctx, c.Query(partitionkey, query, options))
+}
+
+func (c *bulkActionDocumentClient) ChangeFeed(options *Options) BulkActionDocumentIterator {
+	continuation := ""
+	if options != nil {
+		continuation = options.Continuation
+	}
+
+	return &bulkActionDocumentChangeFeedIterator{bulkActionDocumentClient: c, options: options, continuation: continuation}
+}
+
+func (c *bulkActionDocumentClient) setOptions(options *Options, bulkActionDocument *pkg.BulkActionDocument, headers http.Header) error {
+	if options == nil {
+		return nil
+	}
+
+	if bulkActionDocument != nil && !options.NoETag {
+		if bulkActionDocument.ETag == "" {
+			return ErrETagRequired
+		}
+		headers.Set("If-Match", bulkActionDocument.ETag)
+	}
+	if len(options.PreTriggers) > 0 {
+		headers.Set("X-Ms-Documentdb-Pre-Trigger-Include", strings.Join(options.PreTriggers, ","))
+	}
+	if len(options.PostTriggers) > 0 {
+		headers.Set("X-Ms-Documentdb-Post-Trigger-Include", strings.Join(options.PostTriggers, ","))
+	}
+	if len(options.PartitionKeyRangeID) > 0 {
+		headers.Set("X-Ms-Documentdb-PartitionKeyRangeID", options.PartitionKeyRangeID)
+	}
+
+	return nil
+}
+
+func (i *bulkActionDocumentChangeFeedIterator) Next(ctx context.Context, maxItemCount int) (bulkActionDocuments *pkg.BulkActionDocuments, err error) {
+	headers := http.Header{}
+	headers.Set("A-IM", "Incremental feed")
+
+	headers.Set("X-Ms-Max-Item-Count", strconv.Itoa(maxItemCount))
+	if i.continuation != "" {
+		headers.Set("If-None-Match", i.continuation)
+	}
+
+	err = i.setOptions(i.options, nil, headers)
+	if err != nil {
+		return
+	}
+
+	err = i.do(ctx, http.MethodGet, i.path+"/docs", "docs", i.path, http.StatusOK, nil, &bulkActionDocuments, headers)
+	if IsErrorStatusCode(err, http.StatusNotModified) {
+		err = nil
+	}
+	if err != nil {
+		return
+	}
+
+	i.continuation = headers.Get("Etag")
+
+	return
+}
+
+func (i *bulkActionDocumentChangeFeedIterator) Continuation() string {
+	return i.continuation
+}
+
+func (i *bulkActionDocumentListIterator) Next(ctx context.Context, maxItemCount int) (bulkActionDocuments *pkg.BulkActionDocuments, err error) {
+	if i.done {
+		return
+	}
+
+	headers := http.Header{}
+	headers.Set("X-Ms-Max-Item-Count", strconv.Itoa(maxItemCount))
+	if i.continuation != "" {
+		headers.Set("X-Ms-Continuation", i.continuation)
+	}
+
+	err = i.setOptions(i.options, nil, headers)
+	if err != nil {
+		return
+	}
+
+	err = i.do(ctx, http.MethodGet, i.path+"/docs", "docs", i.path, http.StatusOK, nil, &bulkActionDocuments, headers)
+	if err != nil {
+		return
+	}
+
+	i.continuation = headers.Get("X-Ms-Continuation")
+	i.done = i.continuation == ""
+
+	return
+}
+
+func (i *bulkActionDocumentListIterator) Continuation() string {
+	return i.continuation
+}
+
+func (i *bulkActionDocumentQueryIterator) Next(ctx context.Context, maxItemCount int) (bulkActionDocuments *pkg.BulkActionDocuments, err error) {
+	err = i.NextRaw(ctx, maxItemCount, &bulkActionDocuments)
+	return
+}
+
+func (i *bulkActionDocumentQueryIterator) NextRaw(ctx context.Context, maxItemCount int, raw interface{}) (err error) {
+	if i.done {
+		return
+	}
+
+	headers := http.Header{}
+	headers.Set("X-Ms-Max-Item-Count", strconv.Itoa(maxItemCount))
+	headers.Set("X-Ms-Documentdb-Isquery", "True")
+	headers.Set("Content-Type", "application/query+json")
+	if i.partitionkey != "" {
+		headers.Set("X-Ms-Documentdb-Partitionkey", `["`+i.partitionkey+`"]`)
+	} else {
+		headers.Set("X-Ms-Documentdb-Query-Enablecrosspartition", "True")
+	}
+	if i.continuation != "" {
+		headers.Set("X-Ms-Continuation", i.continuation)
+	}
+
+	err = i.setOptions(i.options, nil, headers)
+	if err != nil {
+		return
+	}
+
+	err = i.do(ctx, http.MethodPost, i.path+"/docs", "docs", i.path, http.StatusOK, &i.query, &raw, headers)
+	if err != nil {
+		return
+	}
+
+	i.continuation = headers.Get("X-Ms-Continuation")
+	i.done = i.continuation == ""
+
+	return
+}
+
+func (i *bulkActionDocumentQueryIterator) Continuation() string {
+	return i.continuation
+}
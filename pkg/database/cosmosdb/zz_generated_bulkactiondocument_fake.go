@@ -0,0 +1,361 @@
+// Code generated by github.com/jim-minter/go-cosmosdb, DO NOT EDIT.
+
+package cosmosdb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/ugorji/go/codec"
+
+	pkg "github.com/Azure/ARO-RP/pkg/api"
+)
+
+type fakeBulkActionDocumentTriggerHandler func(context.Context, *pkg.BulkActionDocument) error
+type fakeBulkActionDocumentQueryHandler func(BulkActionDocumentClient, *Query, *Options) BulkActionDocumentRawIterator
+
+var _ BulkActionDocumentClient = &FakeBulkActionDocumentClient{}
+
+// NewFakeBulkActionDocumentClient returns a FakeBulkActionDocumentClient
+func NewFakeBulkActionDocumentClient(h *codec.JsonHandle) *FakeBulkActionDocumentClient {
+	return &FakeBulkActionDocumentClient{
+		jsonHandle:          h,
+		bulkActionDocuments: make(map[string]*pkg.BulkActionDocument),
+		triggerHandlers:     make(map[string]fakeBulkActionDocumentTriggerHandler),
+		queryHandlers:       make(map[string]fakeBulkActionDocumentQueryHandler),
+	}
+}
+
+// FakeBulkActionDocumentClient is a FakeBulkActionDocumentClient
+type FakeBulkActionDocumentClient struct {
+	lock                sync.RWMutex
+	jsonHandle          *codec.JsonHandle
+	bulkActionDocuments map[string]*pkg.BulkActionDocument
+	triggerHandlers     map[string]fakeBulkActionDocumentTriggerHandler
+	queryHandlers       map[string]fakeBulkActionDocumentQueryHandler
+	sorter              func([]*pkg.BulkActionDocument)
+	etag                int
+
+	// returns true if documents conflict
+	conflictChecker func(*pkg.BulkActionDocument, *pkg.BulkActionDocument) bool
+
+	// err, if not nil, is an error to return when attempting to communicate
+	// with this Client
+	err error
+}
+
+// SetError sets or unsets an error that will be returned on any
+// FakeBulkActionDocumentClient method invocation
+func (c *FakeBulkActionDocumentClient) SetError(err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.err = err
+}
+
+// SetSorter sets or unsets a sorter function which will be used to sort values
+// returned by List() for test stability
+func (c *FakeBulkActionDocumentClient) SetSorter(sorter func([]*pkg.BulkActionDocument)) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.sorter = sorter
+}
+
+// SetConflictChecker sets or unsets a function which can be used to validate
+// additional unique keys in a BulkActionDocument
+func (c *FakeBulkActionDocumentClient) SetConflictChecker(conflictChecker func(*pkg.BulkActionDocument, *pkg.BulkActionDocument) bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.conflictChecker = conflictChecker
+}
+
+// SetTriggerHandler sets or unsets a trigger handler
+func (c *FakeBulkActionDocumentClient) SetTriggerHandler(triggerName string, trigger fakeBulkActionDocumentTriggerHandler) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.triggerHandlers[triggerName] = trigger
+}
+
+// SetQueryHandler sets or unsets a query handler
+func (c *FakeBulkActionDocumentClient) SetQueryHandler(queryName string, query fakeBulkActionDocumentQueryHandler) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.queryHandlers[queryName] = query
+}
+
+func (c *FakeBulkActionDocumentClient) deepCopy(bulkActionDocument *pkg.BulkActionDocument) (*pkg.BulkActionDocument, error) {
+	var b []byte
+	err := codec.NewEncoderBytes(&b, c.jsonHandle).Encode(bulkActionDocument)
+	if err != nil {
+		return nil, err
+	}
+
+	bulkActionDocument = nil
+	err = codec.NewDecoderBytes(b, c.jsonHandle).Decode(&bulkActionDocument)
+	if err != nil {
+		return nil, err
+	}
+
+	return bulkActionDocument, nil
+}
+
+func (c *FakeBulkActionDocumentClient) apply(ctx context.Context, partitionkey string, bulkActionDocument *pkg.BulkActionDocument, options *Options, isCreate bool) (*pkg.BulkActionDocument, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	bulkActionDocument, err := c.deepCopy(bulkActionDocument) // copy now because pretriggers can mutate bulkActionDocument
+	if err != nil {
+		return nil, err
+	}
+
+	if options != nil {
+		err := c.processPreTriggers(ctx, bulkActionDocument, options)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	existingBulkActionDocument, exists := c.bulkActionDocuments[bulkActionDocument.ID]
+	if isCreate && exists {
+		return nil, &Error{
+			StatusCode: http.StatusConflict,
+			Message:    "Entity with the specified id already exists in the system",
+		}
+	}
+	if !isCreate {
+		if !exists {
+			return nil, &Error{StatusCode: http.StatusNotFound}
+		}
+
+		if bulkActionDocument.ETag != existingBulkActionDocument.ETag {
+			return nil, &Error{StatusCode: http.StatusPreconditionFailed}
+		}
+	}
+
+	if c.conflictChecker != nil {
+		for _, bulkActionDocumentToCheck := range c.bulkActionDocuments {
+			if c.conflictChecker(bulkActionDocumentToCheck, bulkActionDocument) {
+				return nil, &Error{
+					StatusCode: http.StatusConflict,
+					Message:    "Entity with the specified id already exists in the system",
+				}
+			}
+		}
+	}
+
+	bulkActionDocument.ETag = fmt.Sprint(c.etag)
+	c.etag++
+
+	c.bulkActionDocuments[bulkActionDocument.ID] = bulkActionDocument
+
+	return c.deepCopy(bulkActionDocument)
+}
+
+// Create creates a BulkActionDocument in the database
+func (c *FakeBulkActionDocumentClient) Create(ctx context.Context, partitionkey string, bulkActionDocument *pkg.BulkActionDocument, options *Options) (*pkg.BulkActionDocument, error) {
+	return c.apply(ctx, partitionkey, bulkActionDocument, options, true)
+}
+
+// Replace replaces a BulkActionDocument in the database
+func (c *FakeBulkActionDocumentClient) Replace(ctx context.Context, partitionkey string, bulkActionDocument *pkg.BulkActionDocument, options *Options) (*pkg.BulkActionDocument, error) {
+	return c.apply(ctx, partitionkey, bulkActionDocument, options, false)
+}
+
+// List returns a BulkActionDocumentIterator to list all BulkActionDocuments in the database
+func (c *FakeBulkActionDocumentClient) List(*Options) BulkActionDocumentIterator {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if c.err != nil {
+		return NewFakeBulkActionDocumentErroringRawIterator(c.err)
+	}
+
+	bulkActionDocuments := make([]*pkg.BulkActionDocument, 0, len(c.bulkActionDocuments))
+	for _, bulkActionDocument := range c.bulkActionDocuments {
+		bulkActionDocument, err := c.deepCopy(bulkActionDocument)
+		if err != nil {
+			return NewFakeBulkActionDocumentErroringRawIterator(err)
+		}
+		bulkActionDocuments = append(bulkActionDocuments, bulkActionDocument)
+	}
+
+	if c.sorter != nil {
+		c.sorter(bulkActionDocuments)
+	}
+
+	return NewFakeBulkActionDocumentIterator(bulkActionDocuments, 0)
+}
+
+// ListAll lists all BulkActionDocuments in the database
+func (c *FakeBulkActionDocumentClient) ListAll(ctx context.Context, options *Options) (*pkg.BulkActionDocuments, error) {
+	iter := c.List(options)
+	return iter.Next(ctx, -1)
+}
+
+// Get gets a BulkActionDocument from the database
+func (c *FakeBulkActionDocumentClient) Get(ctx context.Context, partitionkey string, id string, options *Options) (*pkg.BulkActionDocument, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	bulkActionDocument, exists := c.bulkActionDocuments[id]
+	if !exists {
+		return nil, &Error{StatusCode: http.StatusNotFound}
+	}
+
+	return c.deepCopy(bulkActionDocument)
+}
+
+// Delete deletes a BulkActionDocument from the database
+func (c *FakeBulkActionDocumentClient) Delete(ctx context.Context, partitionKey string, bulkActionDocument *pkg.BulkActionDocument, options *Options) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.err != nil {
+		return c.err
+	}
+
+	_, exists := c.bulkActionDocuments[bulkActionDocument.ID]
+	if !exists {
+		return &Error{StatusCode: http.StatusNotFound}
+	}
+
+	delete(c.bulkActionDocuments, bulkActionDocument.ID)
+	return nil
+}
+
+// ChangeFeed is unimplemented
+func (c *FakeBulkActionDocumentClient) ChangeFeed(*Options) BulkActionDocumentIterator {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if c.err != nil {
+		return NewFakeBulkActionDocumentErroringRawIterator(c.err)
+	}
+
+	return NewFakeBulkActionDocumentErroringRawIterator(ErrNotImplemented)
+}
+
+func (c *FakeBulkActionDocumentClient) processPreTriggers(ctx context.Context, bulkActionDocument *pkg.BulkActionDocument, options *Options) error {
+	for _, triggerName := range options.PreTriggers {
+		if triggerHandler := c.triggerHandlers[triggerName]; triggerHandler != nil {
+			c.lock.Unlock()
+			err := triggerHandler(ctx, bulkActionDocument)
+			c.lock.Lock()
+			if err != nil {
+				return err
+			}
+		} else {
+			return ErrNotImplemented
+		}
+	}
+
+	return nil
+}
+
+// Query calls a query handler to implement database querying
+func (c *FakeBulkActionDocumentClient) Query(name string, query *Query, options *Options) BulkActionDocumentRawIterator {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if c.err != nil {
+		return NewFakeBulkActionDocumentErroringRawIterator(c.err)
+	}
+
+	if queryHandler := c.queryHandlers[query.Query]; queryHandler != nil {
+		c.lock.RUnlock()
+		i := queryHandler(c, query, options)
+		c.lock.RLock()
+		return i
+	}
+
+	return NewFakeBulkActionDocumentErroringRawIterator(ErrNotImplemented)
+}
+
+// QueryAll calls a query handler to implement database querying
+func (c *FakeBulkActionDocumentClient) QueryAll(ctx context.Context, partitionkey string, query *Query, options *Options) (*pkg.BulkActionDocuments, error) {
+	iter := c.Query("", query, options)
+	return iter.Next(ctx, -1)
+}
+
+func NewFakeBulkActionDocumentIterator(bulkActionDocuments []*pkg.BulkActionDocument, continuation int) BulkActionDocumentRawIterator {
+	return &fakeBulkActionDocumentIterator{bulkActionDocuments: bulkActionDocuments, continuation: continuation}
+}
+
+type fakeBulkActionDocumentIterator struct {
+	bulkActionDocuments []*pkg.BulkActionDocument
+	continuation        int
+	done                bool
+}
+
+func (i *fakeBulkActionDocumentIterator) NextRaw(ctx context.Context, maxItemCount int, out interface{}) error {
+	return ErrNotImplemented
+}
+
+func (i *fakeBulkActionDocumentIterator) Next(ctx context.Context, maxItemCount int) (*pkg.BulkActionDocuments, error) {
+	if i.done {
+		return nil, nil
+	}
+
+	var bulkActionDocuments []*pkg.BulkActionDocument
+	if maxItemCount == -1 {
+		bulkActionDocuments = i.bulkActionDocuments[i.continuation:]
+		i.continuation = len(i.bulkActionDocuments)
+		i.done = true
+	} else {
+		max := i.continuation + maxItemCount
+		if max > len(i.bulkActionDocuments) {
+			max = len(i.bulkActionDocuments)
+		}
+		bulkActionDocuments = i.bulkActionDocuments[i.continuation:max]
+		i.continuation += max
+		i.done = i.Continuation() == ""
+	}
+
+	return &pkg.BulkActionDocuments{
+		BulkActionDocuments: bulkActionDocuments,
+		Count:               len(bulkActionDocuments),
+	}, nil
+}
+
+func (i *fakeBulkActionDocumentIterator) Continuation() string {
+	if i.continuation >= len(i.bulkActionDocuments) {
+		return ""
+	}
+	return fmt.Sprintf("%d", i.continuation)
+}
+
+// NewFakeBulkActionDocumentErroringRawIterator returns a BulkActionDocumentRawIterator which
+// whose methods return the given error
+func NewFakeBulkActionDocumentErroringRawIterator(err error) BulkActionDocumentRawIterator {
+	return &fakeBulkActionDocumentErroringRawIterator{err: err}
+}
+
+type fakeBulkActionDocumentErroringRawIterator struct {
+	err error
+}
+
+func (i *fakeBulkActionDocumentErroringRawIterator) Next(ctx context.Context, maxItemCount int) (*pkg.BulkActionDocuments, error) {
+	return nil, i.err
+}
+
+func (i *fakeBulkActionDocumentErroringRawIterator) NextRaw(context.Context, int, interface{}) error {
+	return i.err
+}
+
+func (i *fakeBulkActionDocumentErroringRawIterator) Continuation() string {
+	return ""
+}
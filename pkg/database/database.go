@@ -27,6 +27,7 @@ import (
 const (
 	collAsyncOperations   = "AsyncOperations"
 	collBilling           = "Billing"
+	collBulkActions       = "BulkActions"
 	collMonitors          = "Monitors"
 	collOpenShiftClusters = "OpenShiftClusters"
 	collPortal            = "Portal"
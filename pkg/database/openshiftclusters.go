@@ -44,6 +44,7 @@ type OpenShiftClusters interface {
 	List(string) cosmosdb.OpenShiftClusterDocumentIterator
 	ListAll(context.Context) (*api.OpenShiftClusterDocuments, error)
 	ListByPrefix(string, string, string) (cosmosdb.OpenShiftClusterDocumentIterator, error)
+	ListByQuery(string, *OpenShiftClustersQuery, string) (cosmosdb.OpenShiftClusterDocumentIterator, error)
 	Dequeue(context.Context) (*api.OpenShiftClusterDocument, error)
 	Lease(context.Context, string) (*api.OpenShiftClusterDocument, error)
 	EndLease(context.Context, string, api.ProvisioningState, api.ProvisioningState, *string) (*api.OpenShiftClusterDocument, error)
@@ -270,6 +271,30 @@ func (c *openShiftClusters) ListByPrefix(subscriptionID, prefix, continuation st
 	), nil
 }
 
+// ListByQuery returns the documents in partitionKey matching q.  If
+// partitionKey is empty, the query runs across all partitions; the database
+// does not support ordering results in this case.
+func (c *openShiftClusters) ListByQuery(partitionKey string, q *OpenShiftClustersQuery, continuation string) (cosmosdb.OpenShiftClusterDocumentIterator, error) {
+	if q == nil {
+		q = &OpenShiftClustersQuery{}
+	}
+
+	if partitionKey == "" && q.IsEmpty() {
+		return c.List(continuation), nil
+	}
+
+	if partitionKey == "" && q.OrderBy != "" {
+		return nil, fmt.Errorf("ordering is not supported across partitions")
+	}
+
+	query, err := q.build()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.c.Query(partitionKey, query, &cosmosdb.Options{Continuation: continuation}), nil
+}
+
 func (c *openShiftClusters) Dequeue(ctx context.Context) (*api.OpenShiftClusterDocument, error) {
 	i := c.c.Query("", &cosmosdb.Query{
 		Query: OpenShiftClustersDequeueQuery,
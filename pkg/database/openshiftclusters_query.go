@@ -0,0 +1,146 @@
+package database
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+)
+
+// OpenShiftClustersQueryOperator is a comparison supported by
+// OpenShiftClustersQuery conditions
+type OpenShiftClustersQueryOperator string
+
+// OpenShiftClustersQueryOperator constants
+const (
+	OpenShiftClustersQueryOperatorEq         OpenShiftClustersQueryOperator = "eq"
+	OpenShiftClustersQueryOperatorNe         OpenShiftClustersQueryOperator = "ne"
+	OpenShiftClustersQueryOperatorStartsWith OpenShiftClustersQueryOperator = "startswith"
+
+	// OpenShiftClustersQueryOperatorContains matches case-insensitively
+	OpenShiftClustersQueryOperatorContains OpenShiftClustersQueryOperator = "contains"
+)
+
+// openShiftClustersQueryFields maps the field names which may be used in an
+// OpenShiftClustersQuery to their location in the OpenShiftClusterDocument.
+// Tags are handled separately as "tags/<name>".
+var openShiftClustersQueryFields = map[string]string{
+	"name":              "doc.openShiftCluster.name",
+	"location":          "doc.openShiftCluster.location",
+	"provisioningstate": "doc.openShiftCluster.properties.provisioningState",
+	"version":           "doc.openShiftCluster.properties.clusterProfile.version",
+}
+
+var rxOpenShiftClustersQueryTag = regexp.MustCompile(`(?i)^tags/([a-z0-9_.-]{1,512})$`)
+
+// OpenShiftClustersQueryCondition restricts the results of an
+// OpenShiftClustersQuery to documents whose Field compares to Value
+type OpenShiftClustersQueryCondition struct {
+	Field    string
+	Operator OpenShiftClustersQueryOperator
+	Value    string
+}
+
+// OpenShiftClustersQuery describes a listing of OpenShiftClusterDocuments which
+// is filtered and ordered by the database.  All conditions must match.
+type OpenShiftClustersQuery struct {
+	Prefix     string
+	Conditions []OpenShiftClustersQueryCondition
+	OrderBy    string
+	Descending bool
+}
+
+// IsEmpty returns true if q does not restrict or order the listing in any way
+func (q *OpenShiftClustersQuery) IsEmpty() bool {
+	return q == nil || (q.Prefix == "" && len(q.Conditions) == 0 && q.OrderBy == "")
+}
+
+// IsValidOpenShiftClustersQueryField returns true if field may be used in an
+// OpenShiftClustersQuery condition or ordering
+func IsValidOpenShiftClustersQueryField(field string) bool {
+	_, err := openShiftClustersQueryFieldPath(field)
+	return err == nil
+}
+
+func openShiftClustersQueryFieldPath(field string) (string, error) {
+	if path, ok := openShiftClustersQueryFields[strings.ToLower(field)]; ok {
+		return path, nil
+	}
+
+	if m := rxOpenShiftClustersQueryTag.FindStringSubmatch(field); m != nil {
+		// the tag name is restricted by rxOpenShiftClustersQueryTag, so it is
+		// safe to quote it directly into the query
+		return fmt.Sprintf("doc.openShiftCluster.tags[%q]", m[1]), nil
+	}
+
+	return "", fmt.Errorf("field %q is not supported", field)
+}
+
+// build returns the cosmosdb query corresponding to q.  Condition values are
+// always passed as query parameters.
+func (q *OpenShiftClustersQuery) build() (*cosmosdb.Query, error) {
+	if q.Prefix != strings.ToLower(q.Prefix) {
+		return nil, fmt.Errorf("prefix %q is not lower case", q.Prefix)
+	}
+
+	query := &cosmosdb.Query{}
+	var where []string
+
+	if q.Prefix != "" {
+		where = append(where, "STARTSWITH(doc.key, @prefix)")
+		query.Parameters = append(query.Parameters, cosmosdb.Parameter{
+			Name:  "@prefix",
+			Value: q.Prefix,
+		})
+	}
+
+	for i, c := range q.Conditions {
+		path, err := openShiftClustersQueryFieldPath(c.Field)
+		if err != nil {
+			return nil, err
+		}
+
+		name := fmt.Sprintf("@p%d", i)
+
+		switch c.Operator {
+		case OpenShiftClustersQueryOperatorEq:
+			where = append(where, path+" = "+name)
+		case OpenShiftClustersQueryOperatorNe:
+			where = append(where, path+" != "+name)
+		case OpenShiftClustersQueryOperatorStartsWith:
+			where = append(where, "STARTSWITH("+path+", "+name+")")
+		case OpenShiftClustersQueryOperatorContains:
+			where = append(where, "CONTAINS("+path+", "+name+", true)")
+		default:
+			return nil, fmt.Errorf("operator %q is not supported", c.Operator)
+		}
+
+		query.Parameters = append(query.Parameters, cosmosdb.Parameter{
+			Name:  name,
+			Value: c.Value,
+		})
+	}
+
+	query.Query = "SELECT * FROM OpenShiftClusters doc"
+	if len(where) > 0 {
+		query.Query += " WHERE " + strings.Join(where, " AND ")
+	}
+
+	if q.OrderBy != "" {
+		path, err := openShiftClustersQueryFieldPath(q.OrderBy)
+		if err != nil {
+			return nil, err
+		}
+
+		query.Query += " ORDER BY " + path
+		if q.Descending {
+			query.Query += " DESC"
+		}
+	}
+
+	return query, nil
+}
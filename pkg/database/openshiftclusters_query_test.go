@@ -0,0 +1,129 @@
+package database
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+)
+
+func TestOpenShiftClustersQueryBuild(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		q       *OpenShiftClustersQuery
+		want    *cosmosdb.Query
+		wantErr string
+	}{
+		{
+			name: "prefix only matches the prefix query",
+			q: &OpenShiftClustersQuery{
+				Prefix: "/subscriptions/sub/",
+			},
+			want: &cosmosdb.Query{
+				Query: OpenshiftClustersPrefixQuery,
+				Parameters: []cosmosdb.Parameter{
+					{Name: "@prefix", Value: "/subscriptions/sub/"},
+				},
+			},
+		},
+		{
+			name: "conditions and ordering",
+			q: &OpenShiftClustersQuery{
+				Prefix: "/subscriptions/sub/",
+				Conditions: []OpenShiftClustersQueryCondition{
+					{Field: "provisioningState", Operator: OpenShiftClustersQueryOperatorEq, Value: "Failed"},
+					{Field: "version", Operator: OpenShiftClustersQueryOperatorStartsWith, Value: "4.6."},
+					{Field: "tags/env", Operator: OpenShiftClustersQueryOperatorNe, Value: "prod"},
+				},
+				OrderBy:    "name",
+				Descending: true,
+			},
+			want: &cosmosdb.Query{
+				Query: `SELECT * FROM OpenShiftClusters doc WHERE STARTSWITH(doc.key, @prefix) AND doc.openShiftCluster.properties.provisioningState = @p0 AND STARTSWITH(doc.openShiftCluster.properties.clusterProfile.version, @p1) AND doc.openShiftCluster.tags["env"] != @p2 ORDER BY doc.openShiftCluster.name DESC`,
+				Parameters: []cosmosdb.Parameter{
+					{Name: "@prefix", Value: "/subscriptions/sub/"},
+					{Name: "@p0", Value: "Failed"},
+					{Name: "@p1", Value: "4.6."},
+					{Name: "@p2", Value: "prod"},
+				},
+			},
+		},
+		{
+			name: "no prefix",
+			q: &OpenShiftClustersQuery{
+				Conditions: []OpenShiftClustersQueryCondition{
+					{Field: "location", Operator: OpenShiftClustersQueryOperatorEq, Value: "eastus"},
+				},
+			},
+			want: &cosmosdb.Query{
+				Query: `SELECT * FROM OpenShiftClusters doc WHERE doc.openShiftCluster.location = @p0`,
+				Parameters: []cosmosdb.Parameter{
+					{Name: "@p0", Value: "eastus"},
+				},
+			},
+		},
+		{
+			name: "contains",
+			q: &OpenShiftClustersQuery{
+				Conditions: []OpenShiftClustersQueryCondition{
+					{Field: "name", Operator: OpenShiftClustersQueryOperatorContains, Value: "Prod"},
+				},
+			},
+			want: &cosmosdb.Query{
+				Query: `SELECT * FROM OpenShiftClusters doc WHERE CONTAINS(doc.openShiftCluster.name, @p0, true)`,
+				Parameters: []cosmosdb.Parameter{
+					{Name: "@p0", Value: "Prod"},
+				},
+			},
+		},
+		{
+			name: "invalid field",
+			q: &OpenShiftClustersQuery{
+				Conditions: []OpenShiftClustersQueryCondition{
+					{Field: "properties.servicePrincipalProfile.clientSecret", Operator: OpenShiftClustersQueryOperatorEq, Value: "x"},
+				},
+			},
+			wantErr: `field "properties.servicePrincipalProfile.clientSecret" is not supported`,
+		},
+		{
+			name: "invalid tag name",
+			q: &OpenShiftClustersQuery{
+				Conditions: []OpenShiftClustersQueryCondition{
+					{Field: `tags/"] OR 1=1`, Operator: OpenShiftClustersQueryOperatorEq, Value: "x"},
+				},
+			},
+			wantErr: `field "tags/\"] OR 1=1" is not supported`,
+		},
+		{
+			name: "invalid operator",
+			q: &OpenShiftClustersQuery{
+				Conditions: []OpenShiftClustersQueryCondition{
+					{Field: "name", Operator: "gt", Value: "x"},
+				},
+			},
+			wantErr: `operator "gt" is not supported`,
+		},
+		{
+			name: "upper case prefix",
+			q: &OpenShiftClustersQuery{
+				Prefix: "/subscriptions/SUB/",
+			},
+			wantErr: `prefix "/subscriptions/SUB/" is not lower case`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.q.build()
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%#v", got)
+			}
+		})
+	}
+}
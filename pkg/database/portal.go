@@ -7,12 +7,132 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
 )
 
+const (
+	PortalSSHRecordingsQuery  = `SELECT * FROM Portal doc WHERE doc.portal.id = @id AND IS_DEFINED(doc.portal.sshRecording)`
+	PortalAccessRequestsQuery = `SELECT * FROM Portal doc WHERE IS_DEFINED(doc.portal.accessRequest)`
+	PortalSessionsQuery       = `SELECT * FROM Portal doc WHERE IS_DEFINED(doc.portal.ssh) OR IS_DEFINED(doc.portal.kubeconfig)`
+	PortalAuditRecordsQuery   = `SELECT * FROM Portal doc WHERE IS_DEFINED(doc.portal.auditRecord) AND doc.portal.auditRecord.time >= @start AND doc.portal.auditRecord.time < @end`
+)
+
+// PortalAccessRequestsFilter selects the access requests to be listed.  Empty
+// fields match all access requests.  ExpiresAfter, if set, selects the access
+// requests whose ExpiryTime is at or after it.
+type PortalAccessRequestsFilter struct {
+	Username     string
+	ResourceID   string
+	State        api.AccessRequestState
+	ExpiresAfter int64
+}
+
+// Query returns the database query selecting the access requests matching f.
+// Conditions are only added for the fields which are set, as the database
+// client omits empty parameter values.
+func (f *PortalAccessRequestsFilter) Query() *cosmosdb.Query {
+	query := &cosmosdb.Query{
+		Query: PortalAccessRequestsQuery,
+	}
+
+	if f.Username != "" {
+		query.Query += " AND doc.portal.username = @username"
+		query.Parameters = append(query.Parameters, cosmosdb.Parameter{
+			Name:  "@username",
+			Value: f.Username,
+		})
+	}
+
+	if f.ResourceID != "" {
+		query.Query += " AND doc.portal.id = @id"
+		query.Parameters = append(query.Parameters, cosmosdb.Parameter{
+			Name:  "@id",
+			Value: f.ResourceID,
+		})
+	}
+
+	if f.State != "" {
+		query.Query += " AND doc.portal.accessRequest.state = @state"
+		query.Parameters = append(query.Parameters, cosmosdb.Parameter{
+			Name:  "@state",
+			Value: string(f.State),
+		})
+	}
+
+	// parameter values are strings, so the expiry is converted back for the
+	// comparison
+	if f.ExpiresAfter != 0 {
+		query.Query += " AND doc.portal.accessRequest.expiryTime >= StringToNumber(@expiresAfter)"
+		query.Parameters = append(query.Parameters, cosmosdb.Parameter{
+			Name:  "@expiresAfter",
+			Value: strconv.FormatInt(f.ExpiresAfter, 10),
+		})
+	}
+
+	return query
+}
+
+// PortalAuditRecordsFilter selects the audit records to be listed.  Empty
+// Username, ResourceID and OperationName fields match all records.  Start
+// (inclusive) and End (exclusive) are required and are formatted as the Time
+// of an AuditRecord.
+type PortalAuditRecordsFilter struct {
+	Username      string
+	ResourceID    string
+	OperationName string
+	Start         string
+	End           string
+}
+
+// Query returns the database query selecting the audit records matching f.
+// Conditions are only added for the fields which are set, as the database
+// client omits empty parameter values.
+func (f *PortalAuditRecordsFilter) Query() *cosmosdb.Query {
+	query := &cosmosdb.Query{
+		Query: PortalAuditRecordsQuery,
+		Parameters: []cosmosdb.Parameter{
+			{
+				Name:  "@start",
+				Value: f.Start,
+			},
+			{
+				Name:  "@end",
+				Value: f.End,
+			},
+		},
+	}
+
+	if f.Username != "" {
+		query.Query += " AND STRINGEQUALS(doc.portal.username, @username, true)"
+		query.Parameters = append(query.Parameters, cosmosdb.Parameter{
+			Name:  "@username",
+			Value: f.Username,
+		})
+	}
+
+	if f.ResourceID != "" {
+		query.Query += " AND doc.portal.id = @id"
+		query.Parameters = append(query.Parameters, cosmosdb.Parameter{
+			Name:  "@id",
+			Value: f.ResourceID,
+		})
+	}
+
+	if f.OperationName != "" {
+		query.Query += " AND CONTAINS(doc.portal.auditRecord.operationName, @operationName, true)"
+		query.Parameters = append(query.Parameters, cosmosdb.Parameter{
+			Name:  "@operationName",
+			Value: f.OperationName,
+		})
+	}
+
+	return query
+}
+
 type portals struct {
 	c cosmosdb.PortalDocumentClient
 }
@@ -22,6 +142,11 @@ type Portal interface {
 	Create(context.Context, *api.PortalDocument) (*api.PortalDocument, error)
 	Get(context.Context, string) (*api.PortalDocument, error)
 	Patch(context.Context, string, func(*api.PortalDocument) error) (*api.PortalDocument, error)
+	Delete(context.Context, *api.PortalDocument) error
+	ListSSHRecordings(context.Context, string) (*api.PortalDocuments, error)
+	ListAccessRequests(context.Context, *PortalAccessRequestsFilter) (*api.PortalDocuments, error)
+	ListSessions(context.Context) (*api.PortalDocuments, error)
+	ListAuditRecords(*PortalAuditRecordsFilter, string) (cosmosdb.PortalDocumentIterator, error)
 }
 
 // NewPortal returns a new Portal
@@ -85,3 +210,57 @@ func (c *portals) Patch(ctx context.Context, id string, f func(*api.PortalDocume
 
 	return doc, err
 }
+
+func (c *portals) Delete(ctx context.Context, doc *api.PortalDocument) error {
+	if doc.ID != strings.ToLower(doc.ID) {
+		return fmt.Errorf("id %q is not lower case", doc.ID)
+	}
+
+	return c.c.Delete(ctx, doc.ID, doc, &cosmosdb.Options{NoETag: true})
+}
+
+// ListSSHRecordings returns the SSH recording documents of the cluster with
+// the given resource ID.  Their chunks are not included.
+func (c *portals) ListSSHRecordings(ctx context.Context, resourceID string) (*api.PortalDocuments, error) {
+	if resourceID != strings.ToLower(resourceID) {
+		return nil, fmt.Errorf("resourceID %q is not lower case", resourceID)
+	}
+
+	return c.c.QueryAll(ctx, "", &cosmosdb.Query{
+		Query: PortalSSHRecordingsQuery,
+		Parameters: []cosmosdb.Parameter{
+			{
+				Name:  "@id",
+				Value: resourceID,
+			},
+		},
+	}, nil)
+}
+
+// ListAccessRequests returns the access request documents matching f
+func (c *portals) ListAccessRequests(ctx context.Context, f *PortalAccessRequestsFilter) (*api.PortalDocuments, error) {
+	if f.ResourceID != strings.ToLower(f.ResourceID) {
+		return nil, fmt.Errorf("resourceID %q is not lower case", f.ResourceID)
+	}
+
+	return c.c.QueryAll(ctx, "", f.Query(), nil)
+}
+
+// ListSessions returns the kubeconfig and SSH session documents of all
+// clusters
+func (c *portals) ListSessions(ctx context.Context) (*api.PortalDocuments, error) {
+	return c.c.QueryAll(ctx, "", &cosmosdb.Query{
+		Query: PortalSessionsQuery,
+	}, nil)
+}
+
+// ListAuditRecords returns the audit record documents matching f, starting
+// from continuation.  The query runs across all partitions, so the database
+// does not order the results.
+func (c *portals) ListAuditRecords(f *PortalAuditRecordsFilter, continuation string) (cosmosdb.PortalDocumentIterator, error) {
+	if f.ResourceID != strings.ToLower(f.ResourceID) {
+		return nil, fmt.Errorf("resourceID %q is not lower case", f.ResourceID)
+	}
+
+	return c.c.Query("", f.Query(), &cosmosdb.Options{Continuation: continuation}), nil
+}
@@ -91,7 +91,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _clusterPredeployJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x55\xd1\x6b\xdb\x3e\x10\x7e\xcf\x5f\x61\xfc\xfb\x81\x53\x48\x6c\xa7\x30\xc6\xfa\x36\xb6\x31\x06\x5b\x57\xd6\xd2\x97\x90\x07\x55\xba\xa4\x5a\x65\x49\x48\xe7\x6c\x5d\xc9\xff\x3e\x54\xd9\x6e\x6c\xcb\x59\xd6\x25\x30\x46\x9e\x22\x9d\xbe\xbb\xfb\xee\xfb\xce\x0f\xa3\x28\x8a\xa2\xf8\x7f\x4b\x6f\xa1\x20\xf1\x59\x14\xdf\x22\x6a\x7b\x96\x65\xfe\x24\x2d\x88\x24\x2b\x28\x40\x62\x4a\x7e\x94\x06\x52\xaa\x8a\xea\xce\x66\xa7\xf9\xec\xc5\x34\x9f\x4d\xf3\x59\xc6\x40\x0b\x75\xef\xe2\xae\xa0\xd0\x82\x20\xa4\x5f\xad\x92\xff\xc5\x13\x9f\x81\x2a\x89\x20\xf1\x1a\x8c\xe5\x4a\xba\x44\xb3\x34\x77\xbf\x3a\x40\x13\x43\x0a\x40\x30\x36\x3e\x8b\x7c\x59\xfe\x21\x6f\xfd\x7f\x3c\xc3\x7b\x0d\x0e\xe2\x46\x29\x51\xbd\x6f\xee\x18\x2c\x49\x29\xf0\x9a\x88\xd2\xc5\x2c\x89\xb0\xd0\x44\x6c\x26\x5b\xc0\xa2\xb4\x08\xe6\x9c\x14\x30\x9c\xc1\xa2\xe1\x72\x15\xef\x02\xb8\x04\xb3\xe6\x14\x2e\x0c\x97\x94\x6b\x22\x3e\xb0\xe7\xc1\x2d\xf5\xa1\x90\x0a\xe2\xea\x7a\xcd\x98\x01\x6b\x2f\x0c\x2c\xf9\xf7\xe7\x01\xad\x25\xe0\x01\x60\xbe\x29\x73\xf7\xfc\x7a\x46\x5b\x70\xb1\x01\xab\x4a\x43\xc1\x89\x64\xde\xc4\x74\xa0\xb4\x51\x1a\x0c\x72\xb0\xbd\x34\x8f\xf7\xc4\x57\x72\xa9\x09\xed\x8f\xbe\x1b\xe5\xeb\xed\x24\xec\x05\xcf\x9f\xe4\x3b\x4e\x7a\xb4\x25\x27\x8b\x38\xf8\x76\xd1\x3b\xdd\xb4\x4e\x36\x1d\x71\x4b\x2f\xd6\x98\xc1\x7a\xea\xb2\x74\xc5\x5f\x93\xf8\x89\x53\xa3\xac\x5a\x62\x7a\x0e\xe8\xe8\xcf\xd6\xdc\x60\x49\x44\xf5\xd7\x76\x1f\x0a\x45\x09\x56\xc6\x9c\xd7\x24\xbf\x37\xaa\xd4\xe3\x93\xb4\xbe\x5c\x74\x5f\x51\x25\x19\x6f\x9e\x6d\x53\x40\xb9\xeb\xb9\x13\x4e\x34\xdf\xf2\xff\x69\x3e\x7b\x35\xcd\x5f\x4e\xf3\x59\x50\x35\x0f\xe1\xce\xe7\x54\x49\x4a\x70\xdc\x4a\xf6\xe4\xe4\xe4\x64\x12\x25\x53\x83\x81\xec\xc3\xdc\x18\x55\x22\x5c\x91\x1b\x01\x07\xe2\xe5\x4f\x1a\xdd\x53\xbc\x8d\x8d\xda\xbc\x07\xac\xdf\xa7\xe2\x11\xe7\xa9\xe9\x61\x0b\x70\xd6\xea\x9b\xb3\x71\xb2\x93\xbd\x64\x12\xed\x3b\x9e\x60\x51\x7e\x4e\x64\xe5\x5a\x97\xa5\x10\x7d\x77\x04\x1a\xb1\x7e\x6b\xbe\x93\x4c\x2b\x2e\x71\xd8\xa8\xe1\x26\xb7\x31\xda\xf2\x78\xa3\x24\x12\x2e\xc1\x7c\x81\x15\xb7\x68\xee\xc3\x1e\xde\xf4\x4e\x17\x81\x2a\xb5\xe1\x6b\x82\xf0\x91\xcb\xbb\x6a\xcd\x57\xfc\x5d\x28\xc1\xa9\x9f\x76\xfc\x96\x5b\xc7\x23\x8b\xf7\x5a\x02\xb5\x15\x92\x7a\x1b\x64\xc9\x24\xda\x49\xbc\x57\xc7\x6f\x79\xa3\xb3\x37\x32\x5b\xde\x48\xc0\x9e\x4f\x06\x15\xdf\xfb\x3a\x6b\x90\xcc\x7e\x96\xc1\x29\xfd\x4a\x6a\x9d\x62\x92\x49\xd4\xf4\x3e\xa0\xf2\x63\x69\x77\xb4\x63\xde\xfb\x2e\x8d\xa3\x2f\x83\xc0\x77\x77\x80\xa6\xbf\xd0\x43\x47\xb2\x80\xe7\xe4\x9f\xb1\x40\x5d\x4c\xcb\x0a\xfb\x29\xb9\x5e\x06\x07\x57\xf3\xc8\xcf\x6f\x33\xfa\x19\x00\x00\xff\xff\xc5\xd9\x72\xcd\x5d\x0c\x00\x00")
+var _clusterPredeployJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x55\x5d\x6b\xdb\x3c\x14\xbe\xf7\xaf\x10\x7a\x5f\x70\x02\x8e\xed\x14\xc6\x58\xef\xc6\x36\xc6\x60\xeb\xca\x5a\x7a\x13\x72\xa1\x4a\x27\xa9\x56\x59\x12\xd2\x71\xb6\xae\xf4\xbf\x0f\xe1\x38\x89\xbf\xb2\x2c\x4b\x61\x0c\xe9\x26\xd6\xf9\x7c\xce\xf3\x9c\x3c\x46\x84\x10\x42\xff\xf7\xfc\x0e\x0a\x46\xcf\x09\xbd\x43\xb4\xfe\x3c\xcb\xaa\x2f\x69\xc1\x34\x5b\x42\x01\x1a\x53\xf6\xa3\x74\x90\x72\x53\xac\xdf\x7c\x76\x96\x4f\x5f\x4c\xf2\xe9\x24\x9f\x66\x02\xac\x32\x0f\xc1\xee\x1a\x0a\xab\x18\x42\xfa\xd5\x1b\xfd\x1f\x4d\xaa\x0c\xdc\x68\x04\x8d\x37\xe0\xbc\x34\x3a\x24\x9a\xa6\x79\x38\xb5\x81\x65\x8e\x15\x80\xe0\x3c\x3d\x27\x55\x59\xe1\x50\x2e\x1b\xbf\xc3\xa5\xf8\x60\x21\x84\xb8\x35\x46\xd1\xa4\xf9\x26\x60\xc1\x4a\x85\x37\x4c\x95\xc1\x66\xc1\x94\x87\x8d\xc5\xd3\xd6\x98\x72\x55\x7a\x04\x77\xc1\x0a\x18\xce\xe0\xd1\x49\xbd\xa4\xfb\x02\x5c\x81\x5b\x49\x0e\x97\x4e\x6a\x2e\x2d\x53\x1f\xc4\x71\xe1\x16\xf6\x54\x91\x0a\x16\xea\x7a\x2d\x84\x03\xef\x2f\x1d\x2c\xe4\xf7\xe3\x02\xad\x34\xe0\x09\xc2\x7c\x33\xee\xfe\xf8\x7a\xa2\x9d\x70\xd4\x81\x37\xa5\xe3\x10\x48\x32\xdb\xd8\xb4\x42\x59\x67\x2c\x38\x94\xd0\xa4\x52\x7d\x28\xab\x5a\xba\xb2\x8c\x77\x47\xdf\xb6\xaa\xf0\x6b\x25\x6c\x1f\x3a\xdb\xd2\x77\x14\x77\x60\x8b\xc7\x73\x1a\xf5\x39\xce\x3b\x5f\x9f\x1a\x5f\x76\x50\x0c\x97\xea\x8a\xac\x54\xc0\x6a\x12\xb2\xd0\xa4\x1f\xc4\x4f\x92\x3b\xe3\xcd\x02\xd3\x0b\xc0\x00\x7f\xb6\x92\x0e\x4b\xa6\xd6\x3f\x7d\xdb\x51\x19\xce\x70\x2d\xcc\x59\x0d\xf2\x7b\x67\x4a\x3b\x1a\xa7\xf5\xe3\xbc\xed\xc5\x8d\x16\x72\xe3\xb6\x0b\x01\x97\xf1\xb8\x63\xce\xac\xdc\xd1\xff\x59\x3e\x7d\x35\xc9\x5f\x4e\xf2\xe9\xce\xb0\xb7\x1e\x8f\xfd\x9d\xcf\xb8\xd1\x9c\xe1\xa8\x91\x6c\xab\xe4\x78\x9c\x90\x78\xe2\xb0\x27\xfb\x30\x36\xce\x94\x08\xd7\xec\x56\xc1\x89\x70\xf9\x93\x46\x0f\x24\xef\x46\x46\x4d\xdc\x7b\xa4\xdf\x85\x22\x1c\xba\x6d\x7a\x58\x02\x52\x34\xfa\x96\x62\x14\xef\x45\x2f\x4e\xc8\xa1\xe3\xe9\x2d\x2a\x5c\x8a\x6c\x19\xc4\xa6\x4b\xa5\x3a\x06\x2d\x3d\x84\x4b\x7d\xb5\x35\xdf\x69\x61\x8d\xd4\x38\x2c\xd4\xfe\x26\x77\x63\x34\xe9\xf1\xc6\x68\x64\x52\x83\xfb\x02\x4b\xe9\xd1\x3d\xd0\xa8\xcf\xbb\xa9\xd8\x70\xe6\x3d\x55\x5a\x27\x57\x0c\xe1\xa3\xd4\xf7\xeb\x35\xbf\xc6\xef\xd2\x28\xc9\xab\x55\x45\xdf\x4a\x1f\x70\x14\x34\xda\xd3\x74\x47\x0a\x71\xbd\x0d\xb2\x38\x21\x7b\x81\xaf\xd8\xf1\x5b\xda\x68\xed\x8d\xcc\x97\xb7\x1a\xd0\x1f\xcc\xf8\x96\x9d\x00\x0b\x5a\xf8\xcf\xba\x77\x4a\xbf\xa2\x5a\xab\x98\x38\x21\x9b\xde\x07\x58\xfe\x5c\xdc\x8d\xf6\xcc\xfb\xd0\xa5\x11\xf5\x0c\xf8\xa4\xcb\xa0\xe7\x7f\x77\x00\xa6\xbf\x50\x43\xcf\x24\x81\x0a\x93\x7f\x46\x02\x75\x31\x0d\x29\x1c\xc6\xe4\x7a\x19\x9c\x9c\xcd\x11\x21\x84\xcc\xa3\xa7\xe8\xe7\x00\xc5\xd9\x72\xcd\x5d\x0c\x00\x00")
 
 func clusterPredeployJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -111,7 +111,7 @@ func clusterPredeployJson() (*asset, error) {
 	return a, nil
 }
 
-var _databasesDevelopmentJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5d\x4f\xdb\x30\x14\x7d\xef\xaf\x88\xbc\x49\x69\xa5\x36\x1f\x68\x4c\xac\x6f\x30\xa4\x0d\x21\x06\x1a\x68\x2f\x55\x1f\x8c\x73\x21\x1e\x89\x6d\xec\xeb\x87\x6e\xe2\xbf\x4f\x6e\x9a\xb6\x69\xd2\x02\x12\x1f\x6b\x15\xf3\x84\x7d\x7c\xee\xf5\xbd\xe7\xd8\x51\xff\x76\x3c\xcf\xf3\xc8\x47\xc3\x52\xc8\x29\x19\x7a\x24\x45\x54\x66\x18\x86\xc5\x4c\x90\x53\x41\x6f\x21\x07\x81\x01\xfd\x63\x35\x04\x4c\xe6\xb3\x35\x13\xee\x45\xf1\xfe\x20\x8a\x07\x51\x1c\x26\xa0\x32\x39\x71\xb8\x2b\xc8\x55\x46\x11\x82\xdf\x46\x8a\x0f\xa4\x5f\x44\x60\x52\x20\x08\xfc\x05\xda\x70\x29\x5c\xa0\x38\x88\xdc\x5f\x09\x50\x54\xd3\x1c\x10\xb4\x21\x43\xaf\x48\x6b\x3a\x9f\x50\xa4\xd7\xd4\xc0\x21\x63\xd2\x0a\xfc\x41\x73\xa8\x00\xa6\x20\x9c\x28\x37\x4b\x0c\x6a\x2e\x6e\xc9\x7c\xf1\xa1\x5f\x27\x7a\x26\x43\x67\x89\x87\x68\x30\xd2\x6a\x06\x2e\xc7\xd1\x1c\xb3\x42\xa5\xb4\x54\xa0\x91\x83\xa9\x85\xa9\x90\x34\xae\x4e\x11\x3c\x71\xa9\x8c\x16\x25\xe9\xfa\xcb\xd9\xfb\xbd\x31\xa9\x6d\x5c\x3a\xea\x9c\x47\x2a\xe4\x52\x34\xa7\x51\x9c\x3a\xd5\xd2\xde\xa6\xca\xa2\x0b\xb8\x1f\x45\x0d\xbc\x9d\x0d\x51\x88\x28\x8a\x49\x46\x4c\x0a\x46\xb1\xdb\x94\xf2\x52\xe7\xfc\x5e\xdf\xf3\x43\xbf\xef\xad\x3f\x5a\x6f\x4c\xfa\xcd\xad\x39\xe3\x4c\x4b\x23\x6f\x30\x38\x96\xcc\x3a\xa9\x1d\x1f\x85\x2b\x41\x4c\x68\xee\xb3\xe3\xd9\x9c\x59\x65\xca\x24\xa3\x38\x93\xdf\xa8\x6c\xc3\x37\x2d\xad\xea\xf6\x82\x72\xb1\x16\x9f\x2a\xbe\x24\xdb\xbd\x28\xfe\x32\x88\x0e\x06\x51\xdc\x28\xb3\x57\xd2\xc2\xa1\x99\x08\x76\xae\x40\xd3\xa2\xa3\xf5\x66\x7b\x33\x13\x21\x77\x88\x53\x98\xac\xa5\x9c\x21\x31\xad\xaa\xb8\x11\x16\xf2\xa4\x2e\x89\x72\x8c\x9b\xb3\x98\x6e\xbc\xe3\x62\x9a\xf8\x77\x6a\xd2\x66\x86\x06\xc1\x4e\x77\x26\x70\x43\x6d\x86\x57\x98\x91\xa1\xf7\x39\xfa\x74\x10\x45\xcf\x15\xfb\x5b\x6b\xd6\x01\x56\x3a\xe4\xbf\xa4\x8e\x43\x77\x7b\x52\x2e\xdc\xe5\xf8\xba\x92\x5e\xc1\x25\xa0\x40\x24\xe6\x5c\x34\x2a\x65\x11\xf0\x24\xe9\xfa\xcf\x3f\xd6\x9a\x9a\xae\xd4\x7e\xe3\x55\x51\xc9\x69\xfc\x86\x86\x3c\xe2\x59\xe6\x1e\x8a\x5d\x30\xe2\x36\xd8\x6b\x56\xef\xd6\x56\xbb\x6d\xab\x33\x29\x38\xca\x5a\x3f\x16\x71\xb6\xc9\x57\x4f\x79\xe0\x06\xf1\x36\xb8\xaf\x6c\x4b\x6b\xbf\xdd\xb6\xdf\xb9\x02\x71\x99\xf2\x1b\xfc\x9a\x59\x83\xf5\xc6\x2c\x02\xbe\xb8\x0f\x2b\x8c\x6f\xec\x48\x2b\xf8\xbd\x85\x53\x98\x5c\xc8\x8c\xb3\x47\x0e\x34\x07\x3f\x7e\xaa\xf5\x2c\x73\xb6\xa7\x95\x67\x0e\x0f\xef\x36\x55\xa7\x1c\xe3\x8d\x88\x35\x55\x28\xc7\x2b\x24\xcd\x0a\x35\xfd\x5c\x36\xf2\x49\xb2\xb1\xd1\xe5\xf8\xff\x8e\xc2\x41\xe0\xcb\x24\xbf\x5e\xe5\xdb\xfb\x9d\x56\xbb\x41\xda\x27\x63\xb7\x9f\x8c\x0b\xa9\x91\x66\xed\xf7\x5a\x75\xcf\x3b\xbb\xb0\x68\x4a\x6b\xbd\xdd\xb6\xde\xa5\xbd\x36\x4c\x73\xb5\x3b\x3f\x09\x6e\x83\xb7\x2a\x55\x6f\x2d\xf6\xae\x16\xeb\x14\xff\x3f\x74\xfe\x05\x00\x00\xff\xff\x1b\x33\xdf\x6d\xc8\x1a\x00\x00")
+var _databasesDevelopmentJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x5f\x4f\xdb\x3a\x1c\x7d\xf7\xa7\xb0\x7c\xaf\x94\x56\x4a\xf3\x07\x5d\xae\x58\xdf\x60\x48\x1b\x42\x0c\x34\xd0\x5e\xaa\x3e\x18\xc7\x10\x8f\xc4\x36\xb6\xf3\xd0\x4d\xfd\xee\x93\x49\x53\xd2\xc4\x2d\xad\x44\xd7\x52\x25\xee\x93\x7d\xfc\xfb\x7b\x8e\xed\xfe\x06\x10\x42\x88\xfe\xd5\x24\xa5\x39\x46\x43\x88\x52\x63\xa4\x1e\x86\x61\x39\x13\xe4\x98\xe3\x47\x9a\x53\x6e\x02\xfc\xab\x50\x34\x20\x22\x9f\xad\xe9\xf0\x28\x8a\x8f\x07\x51\x3c\x88\xe2\x30\xa1\x32\x13\x13\x8b\xbb\xa3\xb9\xcc\xb0\xa1\xc1\x4f\x2d\xf8\x3f\xc8\x2f\x3d\x10\xc1\x0d\xe5\xe6\x07\x55\x9a\x09\x6e\x1d\xc5\x41\x64\x47\x05\x90\x58\xe1\x9c\x1a\xaa\x34\x1a\xc2\x32\x2c\x3b\x50\x82\x0d\xbe\xc7\x9a\x9e\x12\x22\x0a\x6e\xbe\xe1\x9c\x2e\x00\xec\x0f\x99\x89\xb4\xb3\x48\x1b\xc5\xf8\x23\x9a\x2f\x4e\xfd\xb6\xa1\x0d\x2d\x80\x9a\x1d\xa4\xa8\x16\x85\x22\xd4\xc6\x38\x9a\x63\x1a\xa6\xa4\x12\x92\x2a\xc3\xe8\x62\x26\xd5\x98\x1b\x71\xae\xda\x1f\x62\x89\x0d\x65\xf4\x5a\x92\x9e\x57\x8f\xde\xeb\x8f\x11\x68\xec\xa9\x42\xac\x7f\x48\x48\xc3\x04\x77\x87\x61\x07\x32\xa9\x12\xc5\x63\x2a\x0b\x63\x1d\x1e\x47\x91\xc3\x2e\x58\xe1\x05\xf1\xb2\x98\x68\x44\x04\x27\xd8\xf4\x5c\x21\xd7\x3a\xe7\xf5\x7d\xe8\x85\x9e\x0f\x97\xa7\xd6\x1f\xa3\x86\x8f\xaa\x35\x57\x8c\x28\xa1\xc5\x83\x09\xce\x05\x29\x2c\xd5\xce\xcf\xc2\x86\x13\x1d\xea\xe7\xec\x7c\x36\xa7\x9b\x96\x32\x41\xb0\x99\xd1\x6f\x54\xb5\xe1\x8b\x12\x85\xec\xf5\x83\x6a\xb1\xe5\x1f\x4b\x56\xa3\xed\x51\x14\x7f\x1a\x44\x27\x83\x28\x46\xc0\x51\x95\xc5\x42\xbf\x1b\x17\x4e\xf5\x84\x93\x6b\x49\xd5\x4b\xfc\xcd\xc4\xaa\x0f\x49\xac\x0c\xb3\x88\x4b\x3a\x59\x6a\x72\x86\x34\xe9\x22\x8b\x5d\x1f\x0a\x59\x82\xc0\x92\x45\x38\x76\x47\x61\x07\x7a\x62\xfc\x85\xc4\x5f\xb1\x4e\xdd\x16\xa6\xbe\x73\x1a\x25\xf4\x01\x17\x99\xb9\x33\x19\x1a\xc2\xff\xa3\xff\x4e\xa2\x08\xac\xb1\xb7\x4e\xf6\x29\x58\x01\xde\x02\x67\x2d\xa0\xd1\x21\xef\x3d\x79\x1c\xda\xd3\x13\x33\x6e\x0f\xc7\xed\x52\xba\x81\x4b\xa8\xa4\x3c\xd1\xd7\xdc\xc9\x94\x57\x87\x17\x49\xcf\xdb\x3c\xad\x25\x35\x6d\xd4\x7e\x79\xd9\x9b\xc7\xe0\x18\x38\x5a\xbe\x25\x41\x9e\x15\xd9\xd3\x29\xe9\xc4\xb8\x9f\x62\xac\x75\xa7\x13\xe2\x81\x0b\x91\x65\x99\x7d\xf3\xf9\xc0\x81\xf9\x60\x22\x6c\xcd\xee\xa3\xb4\xca\x7a\x77\xb2\x3a\x6c\x59\x5d\x09\xce\x8c\x68\xf5\xe3\x63\xea\x6a\x9d\xcb\x6d\x10\x83\x35\xf6\xed\x5a\x7d\x55\x5b\x3a\xf9\x1d\xb6\xfc\xae\x25\xe5\xb7\x29\x7b\x30\x9f\xb3\x42\x9b\x76\x63\xb6\xa8\xc3\x05\x8b\x7f\x59\x91\x05\x67\xcf\x05\xbd\xa4\x93\x1b\x91\x31\xf2\x46\x42\x73\xf0\xdb\x59\x2d\xb7\xb2\x61\x79\xaa\x81\xc2\xa7\x55\xd5\x69\x53\x66\x83\x2a\x6c\x31\x68\x52\xb2\xe9\xfb\x8c\x85\x2f\xff\x13\x2f\x92\x95\x8d\xde\xdf\x54\x18\xe5\xe6\x7d\x82\x07\x9b\xed\x9b\x82\x35\xd2\xdf\xf5\x4d\xd1\x3a\x41\xba\x2b\xe3\xb0\xaf\x8c\x1b\xa1\x0c\xce\x90\xef\xc6\x74\xef\xb5\x9d\xbc\xd7\xca\xa6\x74\xd2\x3b\x6c\xe9\xdd\x16\xf7\x9a\x28\x36\xa3\x9a\x0f\x1c\xc8\x0f\xa6\xc0\xd6\xec\x1e\x6a\x6b\xa1\xea\x9d\xc4\x76\x2a\x31\x00\x21\x84\x63\x30\x05\x7f\x06\x00\x59\xa1\x1c\xd3\x51\x1e\x00\x00")
 
 func databasesDevelopmentJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -131,7 +131,7 @@ func databasesDevelopmentJson() (*asset, error) {
 	return a, nil
 }
 
-var _envDevelopmentJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7b\x6b\x6f\xa3\x48\xf6\xf7\xfb\xf9\x14\x51\x9e\x95\xd2\xad\xa7\xd3\x01\x1c\x67\x9a\x91\xe6\x05\xc6\x36\xc6\xc6\xc4\x80\xb9\xee\x8e\x46\x45\x15\xc1\xd8\xc5\x65\xa1\xc0\xc1\xab\xf9\xee\x7f\x81\x2f\xf1\xdd\x4e\x5f\xa4\xd5\x4e\xd3\x4a\x27\x86\x73\x4e\x55\x9d\x3a\xb7\x3a\x3f\xfc\x9f\x5f\x6e\x6e\x6e\x6e\x6e\xff\x91\xc1\x89\x17\x82\xdb\xdf\x6e\x6e\x27\x84\x24\xd9\x6f\x0f\x0f\xcb\x3b\x9f\x43\x10\x01\xdf\x0b\xbd\x88\x7c\x06\x8b\x3c\xf5\x3e\xc3\x38\x5c\x3d\xcb\x1e\x18\x8a\x6e\xde\x53\xf4\x3d\x45\x3f\x20\x2f\xc1\x71\x59\xd1\x8d\xbd\x30\xc1\x80\x78\x9f\xa7\x59\x1c\xfd\xbf\xdb\x4f\xcb\x11\x60\x1c\x11\x2f\x22\x86\x97\x66\x41\x1c\x55\x03\xd1\x9f\xa9\xea\xdf\x9a\x20\x01\x29\x08\x3d\xe2\xa5\xd9\xed\x6f\x37\xcb\x69\x2d\x19\x03\x6e\x91\x8c\xe3\x99\x17\xed\xdc\xaf\x9f\x91\x32\xf1\x2a\x51\x19\x49\x83\xc8\x5f\x49\xda\x3c\x45\xde\x0b\xc8\x31\x31\x00\xce\x6b\xaa\xdb\xcd\xe3\xbf\x3e\x6d\xcb\xe7\x41\x02\x60\x40\xca\xd3\xf2\x83\x88\x5c\x10\x4e\x9d\x90\x3d\x8a\x63\x2c\x83\xd0\xfb\x31\x73\x4f\xd2\xf8\xb5\xe4\xbd\x94\x5c\x14\x7f\x86\x1d\x07\x5e\x44\xbe\x51\x48\x3b\x0e\x41\x10\x55\x0b\x95\x80\xeb\xe1\x6f\x90\x24\x86\xc0\xbf\xac\xad\x0b\xfc\x5c\x4e\x26\x67\x64\x78\x30\x4f\xbd\x8b\x92\x06\xde\x19\x8b\xb8\x42\x46\xee\xe2\x00\x8a\x23\x0e\xa1\xd4\xcb\x32\x0e\xe3\x18\x02\x12\xc4\xd1\xd0\x23\x93\x18\x7d\xab\x41\x68\x04\x90\x00\x5e\x35\xb4\x36\xcb\xbf\x87\x09\x6a\x04\x44\x08\xa4\xe8\xf8\x98\x59\x36\x19\xd5\xc3\x9e\x57\xdb\x19\x85\x15\x49\xc4\x73\x95\x1d\x06\x2f\x01\x04\xe4\x7b\xba\xcc\x2f\x5b\x63\xdd\xa6\x5e\x16\xe7\x29\xf4\xaa\x38\xf3\xcf\x0d\xcd\xde\x50\xd9\x2c\x3f\x18\xbf\x7e\x10\x2d\x35\x79\xfb\xcf\xb7\x78\xf5\xe1\xee\xb8\xc2\xef\x3e\xfe\x71\xbb\x23\xe0\xaf\xbd\x19\x27\x69\x9c\x54\xeb\xf5\xb2\xe3\x63\x6d\xc4\x1e\xda\xce\xd9\xf1\xf7\xe9\x2f\x4e\x64\xbd\x28\xe4\x15\xf7\x45\x12\xdd\x27\x41\xb2\xaf\xdd\xb5\xee\x87\x01\x4c\xe3\x2c\x7e\x21\x9f\x65\x8f\xcc\xe3\x74\xf6\xb0\x37\xb8\x97\xed\xb3\xae\x27\x53\x4f\x7b\xad\x7d\x21\x8d\xf3\xe4\xc3\xc7\xcf\xeb\x87\x7f\xec\x73\xc1\x38\x42\xc1\x86\xcd\xfb\x77\x0e\x70\xf6\x61\x7b\xd1\x6f\x81\xfb\xee\xe3\xa7\x1b\xea\xe3\x81\x04\x90\x04\x5b\xe9\x86\xa1\x68\xf6\x9e\xfa\xf5\x9e\xa2\x8f\x9a\xdf\x7f\xde\xb7\x35\x60\xb5\xd3\x09\x80\x87\x86\xba\x4f\x35\x4a\xbd\x97\xe0\x75\xcf\xe0\x0e\x88\x69\x6a\x99\x10\x1f\x98\xc6\xed\x51\xaa\x3f\x0e\xee\xee\xed\x63\x2d\x26\xcb\xdd\xc8\x23\xa7\xc7\x3a\x3e\xd5\x9b\x2b\x16\x7d\x7a\x69\x75\x3a\xdf\x4c\xff\xf1\xf8\xf4\x4f\x4c\x78\x23\x71\x6d\x84\x02\x20\xde\x1c\x94\x5a\xbd\x8e\xe3\xa2\x4e\x88\xf9\xa1\x4b\xa3\x97\x4b\x3b\xbd\x80\xe5\x22\x96\x4e\xa1\x55\xe9\x21\x20\x65\x6d\xe5\x17\x47\xac\x39\x03\xb4\xe3\x1f\x22\xfa\x70\x77\xe8\x6b\xc7\xc4\x67\x77\x9f\x6e\xee\xd2\xe4\x3e\xca\xfc\xbb\x03\x27\x38\x3a\x14\x01\x7e\xa5\x86\x28\xc7\xf8\x2c\xf1\x5f\xdf\xb4\x8f\xe3\x38\xc6\x41\xe4\x9f\xdd\xc7\x83\xbb\x7f\x5c\x1f\xa7\x2a\xa9\x57\x07\xa9\x22\x48\x49\x0e\xf0\xea\xe3\x77\x0a\x51\x3f\x32\xc0\x04\x09\x1f\x47\x2f\x81\x9f\xa7\xf5\xd8\x3f\xdc\x9f\x97\x61\xe3\x3b\x9a\xea\x9e\xc6\x1f\x56\x71\xa9\x32\xd6\xf5\xf6\x55\x7f\xef\x78\xfb\x41\xa2\xda\xbf\xce\xd8\xdd\xcd\x91\xca\xe7\x3b\x2e\xe7\x20\xcb\x6d\x16\xb2\xcc\x97\x97\xa7\xfe\x4d\xce\xb4\x2a\x6e\xae\x76\xa3\x23\x89\xa1\x48\xa2\xf1\xca\x3f\xd4\x38\x27\x5e\x0b\x64\x1e\x3a\x12\x2e\x4e\xd6\x3e\x3b\x13\x32\x92\x48\x98\xd3\x27\xa2\xcd\x2d\x09\xbc\x74\x8b\xea\xaa\xc4\x55\xd5\x7f\xcb\xb3\xc8\xb6\xe1\x9f\x9e\xc9\x86\x7e\xb5\x25\xd5\x51\xeb\xec\x86\xbf\x2b\x1d\xd7\x0c\x34\xcb\x7c\xa6\x9f\xbe\x7c\x66\x9a\xcd\x0b\x89\xed\x30\x37\x9f\x58\xe6\xee\xd4\xd5\x38\x26\x5b\x25\xef\xf9\x29\x5d\xf0\xe0\x77\x78\xfb\xcd\x9b\xaf\x54\xa3\xb7\x01\x01\x07\x35\xe5\x7e\x39\xfe\xcd\xbe\xb9\x5f\x64\x42\x70\xa6\x4e\x38\x5e\x00\x5d\x52\xe7\x28\x8d\x49\x0c\x63\x7c\xa1\xd2\x7a\x4e\xbc\xc8\x18\xc9\x57\x57\x59\xef\xa9\x9d\xbf\x36\x25\xad\xe2\xe0\x7f\x7f\xf1\x7c\x70\xea\x4a\xbc\x08\x65\xcf\xd1\x51\x95\x7f\x8f\xa8\x7a\x24\x54\xbc\x2f\xf5\xec\xa4\x9c\x7d\x2b\xfe\xe3\x8a\x5c\x7d\xf1\x30\xb8\x3e\x1a\xff\xd9\x66\xb2\x3f\x8b\xc6\xb1\x29\xaf\x23\xe2\xe6\x14\x7d\x84\x06\xbe\x35\xa2\x76\x7d\x71\x7b\xb7\xbe\xf1\x4c\x99\x27\x7e\x0a\x90\x37\x8a\x71\x00\x0f\x0f\xea\x1b\xb2\x30\x46\x4b\xb3\x05\x51\x0e\xf0\x95\x01\x7c\xa9\xf6\x21\x80\x93\x20\xf2\x46\x69\xfc\x12\xe0\x33\x87\xa3\x38\xbb\x44\xb2\x54\x4a\x1c\x26\x39\xf1\xd2\xea\x44\xfd\x56\x92\xc3\xe0\xfe\x4c\xa5\x7b\x0b\x50\x18\x44\x7a\xe6\xa5\xeb\x1d\x82\x38\xce\xd1\x7d\x9e\x79\xe9\x39\x36\x1c\x44\xf9\xeb\x75\xe9\x67\xc3\x83\x82\x0c\xb8\xd8\x1b\x81\x2c\x9b\xc7\x29\xe2\x72\x32\xf1\x22\x12\x6c\xbc\x96\xa4\xb9\x77\x21\x32\x66\xd9\x61\xb7\xea\x28\x61\xb2\xee\xb0\x5c\xce\x5d\xeb\xeb\xb2\xd4\x37\xe9\xa0\xee\x9a\xdd\x3e\x4c\xe2\xd0\x7b\x78\xd3\xd8\xc3\xe7\x2c\x9b\x3c\x80\x9c\x4c\xe2\x34\x58\x78\xe8\xcf\x99\x77\x10\xa7\xce\x0a\x9e\x79\xe5\xd1\x14\xb3\xdd\x33\xba\x98\x5e\xd6\xd7\xe9\x3a\x6a\x7d\x1d\xcf\xc5\x97\xf9\x8f\x3f\x39\x95\xc3\x33\x12\xa7\xc0\xbf\x68\xe6\x35\x6d\x10\x02\xdf\x53\xbd\x17\x2f\xf5\xa2\x33\xfd\x82\x0d\x7d\xbd\xcf\xd9\x64\x19\x31\x54\x0f\xf5\xc0\xfe\x51\xe7\x80\x25\x7e\x79\x59\x91\xf7\x3a\xd2\x25\xe2\x65\x40\xbb\xfd\xf5\x5e\x32\x86\x97\x68\x8b\xb7\x2c\x80\xab\x12\xe5\x44\x0d\x7a\x73\xa1\x90\x8d\xb3\x76\x90\xcd\x2e\x2f\x1d\xa6\x1e\x20\xde\x73\xb2\x4e\x5e\xdd\x34\x0e\x97\x3d\xe1\x0b\xf3\x5c\x02\x15\xe8\xaa\x51\x6e\xb6\x36\x90\x83\x30\xce\x23\xb2\x2e\x8d\x47\xa9\x17\x06\x79\xf8\xa7\xa4\x6a\x5f\x5d\xcf\xbf\xcb\x8e\x56\x47\xfa\xab\xec\x68\x45\x2b\x46\xc4\x4b\x5f\x00\xf4\xae\x3c\x22\xae\xaf\x2b\x94\xb2\x89\x97\xc1\x7d\x11\x66\xd9\x7d\x14\xc0\x6b\x3a\x0a\xef\xac\x3d\x57\x3c\x41\x08\xd2\xf2\xaa\x10\xb9\x61\xba\xfa\x58\x7c\xec\xba\x3e\x16\xde\x1c\xd3\x45\x90\xc0\x7a\xec\x77\x84\xbe\x9b\xaf\x54\xce\x0e\xff\x3b\x8e\xe6\x47\xf9\xbf\xe7\x71\x7d\xa7\xa9\x73\x75\xcc\xde\xbe\x2e\x1c\x13\x8e\xae\xe0\x6b\x4c\x65\x57\xc0\x6e\x9d\xf9\xbe\x14\x7f\x52\xea\xbe\x81\x1c\xb6\xcd\xaf\x9f\xe0\xb7\xd9\xc8\x46\x0e\x8a\x32\xcd\x23\x24\x88\xfc\x6f\x13\xb4\x14\x76\x80\xea\xdd\x82\x34\xbe\x87\xc1\xfb\x77\x7d\x7d\x5d\xce\xde\xdf\x87\xeb\x7d\x1c\xd7\x53\x5f\x47\x79\xbe\x02\xb9\x2c\xe7\xf4\xd3\xf7\xf5\x19\xbc\x57\xe2\x45\x55\xf6\xbe\x2a\xbb\x6c\xa8\x7f\x48\x26\x81\xd9\xa5\x14\x7e\xf3\xd5\x99\x64\xbb\x5c\x7a\x0b\x6d\x5c\xfd\xbe\x42\xe7\x6d\x55\x57\xe6\x98\xf5\x91\x9d\xcf\x33\x12\x87\x1a\x4c\x83\xe4\x52\xf9\xb5\xc3\xdb\x03\x11\xc2\x5e\xba\x7d\x7c\xde\xbc\xe6\x70\x51\x00\xc8\x49\xac\x2f\xcf\x68\xc3\x20\x8a\xb7\xa4\xbc\x23\x4b\x66\x5b\x21\xe0\xca\x78\x5b\x29\x9e\x78\x90\x78\xe8\xab\xe2\xc7\x6d\xb6\x54\x53\x95\x68\x5c\x90\x79\x4f\x8f\x1f\x60\x1c\x41\x40\x3e\x2c\x3f\x8d\x63\xad\x06\x6d\x3f\xdc\x41\xc6\xa0\x44\x9e\xc6\xbc\x1f\xff\x7e\xf7\xf1\xd3\x1d\x2f\x72\xce\x68\xfc\x3c\xe8\xc8\xbf\xdf\xdd\xdd\x7d\xda\x3d\xf6\xae\x5f\xfd\xa8\x08\xef\xee\xfe\x15\xdd\x55\xf4\xa3\xe7\x67\x49\xe6\x86\x9d\x23\xf4\xeb\xd7\x2d\xb6\xe8\xab\xff\xf6\xe7\xc0\x47\x98\x76\x35\x8e\x78\x5a\x8b\x86\x82\x3a\x41\x82\xee\x4b\x96\xef\x1b\x54\x77\x08\xcc\x26\xed\x75\xba\x91\x63\x36\x29\xde\x4f\x32\x14\x1a\x8f\x48\x30\x72\x87\xe7\x88\xcb\x73\xa9\x3c\xe6\xb0\x8a\xfb\x5d\x55\xe3\x0a\x47\x30\x18\xa9\xd1\x2f\xdc\x86\xca\x38\x25\xcb\xd8\x56\x3f\x43\x7e\xf2\xe8\x44\xf2\x8b\xd3\xe8\x17\x88\x71\x16\x22\x5f\xdd\x17\x07\x7c\xf8\xca\x38\xd6\x84\x72\xcc\xe6\x4c\xe4\xe9\x4c\xe4\xb3\xd7\x61\xfb\xa4\xac\xd8\x65\x68\xec\xf6\xec\x81\x27\x38\x0b\x8b\x41\xa5\xdb\x40\x21\x2c\xb9\x02\x08\x2c\x71\x94\x78\x00\xa3\x16\x11\x79\x8a\x00\x93\x9e\xbb\x8d\x3e\x25\x0a\x13\x0a\xf5\x5a\x8b\xe7\xe0\x4b\xe1\x08\xf3\xdc\x09\x8d\x99\xdb\xe8\x4f\x60\xaf\x5f\x80\xd0\x98\x22\xbe\x59\xc0\x10\x16\xb0\x67\x04\x12\x63\xcc\x1d\x73\x5e\xe8\xb8\x25\x4b\x3a\x52\xd4\x92\x96\x54\x63\x46\x54\xa3\xd5\x1d\xf3\x54\x83\x8f\xfa\xf3\x4a\x3f\x92\x89\x09\x14\xd8\x12\xf1\xad\x18\xf5\xd4\x39\x5c\xc4\x85\xd4\x68\x4d\x6c\x86\x4c\x1c\xc6\x58\x48\x21\x9d\xd8\x8d\x7e\x01\x19\x36\x44\x7c\x73\xea\x32\x54\x01\x18\xa3\x09\x4b\x96\x00\x53\x2e\xdd\x86\x5c\x38\x91\x92\xdb\x96\x3c\xe5\xfd\xa4\x89\x4c\xca\x97\xac\x99\x0f\xcc\xe6\x02\x09\xdd\xcc\xdd\x96\xcb\xa8\x99\x14\x3a\xd8\x11\xd8\xd2\xb6\x5a\xa5\xcb\x24\xd8\x6e\x28\xb9\xdb\xe8\x47\x52\xa3\x45\xdb\x01\x8b\xa1\x60\x64\xab\xb9\x13\x18\x1a\x99\x63\x76\x17\x8e\x46\x67\xb6\xa5\x62\xd8\x50\x88\x5c\x36\x73\x97\xe9\x96\x36\xe3\xe7\x95\x7e\x78\x3f\x99\xda\x96\xe2\x8f\x02\x16\x23\x61\x58\x78\x96\x41\xa4\xa8\x8f\xa1\xc0\x2e\xa4\x50\x29\x6c\x2b\xa1\x61\xa8\xe7\x30\x34\xe6\x6e\xc9\x7d\x19\xf1\xa8\x3b\xa6\xec\x88\xc7\xd5\x21\xde\x28\x1d\x8d\x9e\xba\x02\x46\x7c\xd8\x9c\xb8\xa6\xce\xae\xe8\x89\xcd\xbc\x26\x7c\xd8\x9f\x40\xc6\xa0\x61\x38\x67\x41\x4f\xa5\x60\x6f\xf8\x24\x95\xec\xdc\x36\xe5\xd4\x36\x11\x86\x65\x73\x57\x07\x0c\x4b\xa4\x06\xa6\x5d\x6b\x35\x3e\xd3\x7d\x42\x56\x1f\x4b\xa6\x9c\x01\x25\xc1\x6e\xd8\x0d\x5c\xc1\x98\x8d\x2c\x8c\xe1\x3c\x89\xa0\x80\xa6\x40\x30\xa6\x60\x41\x37\x1d\x6b\x38\x50\x75\x56\xd8\xe8\x50\xa3\xd7\xf4\xa5\x63\xb5\x8a\x91\xd5\x8f\x91\x39\x23\x30\x9c\x60\x97\xa7\x1a\x92\x25\x63\x18\x39\x18\x06\x74\x09\x7a\x46\x22\x99\xec\x1c\x09\xb8\x70\xc3\x6e\x26\x59\xfd\xb9\x6b\x0d\xf7\xf7\x61\x6b\xbd\x33\xdf\x11\xd8\x29\x60\x8c\x52\x14\x92\x57\x51\x40\x53\xbb\x6c\x95\x80\xa7\x23\x60\x29\xe5\xb3\x56\xff\x3d\xf7\x2c\x35\x76\x99\xc7\x85\x1c\xb4\x28\xd7\x6a\x35\x6c\x4b\x9d\x02\xbe\x95\xa1\x9e\x9a\x3b\x25\x4d\xc3\x86\x38\xe0\x67\x68\x64\xd1\x4e\x57\xc7\x72\x7f\x4c\x3d\xb2\x43\xed\xf1\x55\xe6\x1f\x9b\x7c\x28\x57\x7a\xf3\xb7\xf4\x36\x73\xf9\x66\xe4\x32\x6c\xe4\x0a\xfa\x52\x57\x0c\x2a\xaa\x9f\x7e\x8f\xf4\xc6\x34\x6b\xaa\x46\x7f\xac\xe9\xec\xf3\x8b\xd6\xcc\x6a\x1f\xe5\xe9\x89\x6b\x2a\x8c\xcc\x37\x29\xdb\x12\x73\xa7\x11\xfb\x2f\x7c\xab\xfa\xdb\x97\xf4\xa1\x2f\x35\x8c\x05\x0c\xd8\xcc\x65\xe4\x89\xcb\x73\xc4\xeb\xc5\x03\x57\x78\xf4\x25\x6b\xff\x19\x1b\xb9\x25\x1b\x00\xf3\xb1\x18\x04\x5c\x81\x2c\xb9\x94\x98\xd7\xc2\x66\xba\x99\xc4\xf4\x13\xd7\x8f\x07\x06\x96\x75\x9d\x66\x5b\x2a\x65\x3c\x1b\xdd\xb7\xb9\x8c\xc6\x62\x3e\x1c\xfb\xaf\xd2\xb4\x33\x70\x4d\x32\x03\xd5\xd8\xcc\xa4\x70\x4d\xbd\xb0\x99\xd7\x02\x99\x0a\x41\xd5\x5e\x04\x6c\xe5\x2f\x39\xe2\x92\x39\xb2\xe4\xd8\xe1\x57\x7e\xac\xb1\x53\x57\x60\x69\x87\xa7\x69\xc8\x18\xa5\xc4\xac\xe2\xce\xa1\x7e\x18\xd8\x50\x17\x95\x5d\xb9\x91\xba\xb1\x31\x29\x5c\xda\x90\x63\xaa\x91\xa3\x35\x73\xc7\x52\x8a\x25\x8d\x52\xe9\xcd\xd4\x69\x75\x6c\x51\xdd\x9e\xaa\x37\xf5\xb7\x7d\x68\xb2\x52\xc3\x59\xa0\xde\x90\xac\x68\x89\x2b\xe0\x1c\x59\x3e\xf1\xda\x36\x25\x69\xea\xaf\xa7\xd6\xfb\xa2\x1d\xd1\xb5\x35\x79\xe2\x43\x39\x76\x1b\x28\x17\x79\x5a\x13\x05\x39\x73\x1b\xc6\x4c\xb2\x8c\x85\x63\x89\x4f\xbb\x7a\xe0\x72\xde\x8f\xf3\x5a\xaf\x01\x9b\xb8\x91\x4c\xd9\xe6\x6b\xe6\x08\x95\x4f\x37\x67\x8e\xd9\x9c\x02\xd3\x58\x48\x91\x1c\xf3\x91\x4c\x3b\xc2\x17\x5f\xb2\x74\x7f\x5f\x86\xc4\xc8\x85\x1b\x3a\x89\x53\x36\x17\x80\xe7\x88\x64\x19\xb9\x6d\xa9\xd4\x52\x86\x52\xf9\xc7\x81\x7d\x39\x96\x9d\xaf\xfd\x7d\x69\x5f\xf4\x62\xf5\xb9\xa2\x9f\x20\x4b\x8d\xc5\x5e\x6b\x82\x6a\x79\x6a\x15\xc7\x72\x91\x17\x67\x0a\x85\x5b\x26\x6e\xe9\x63\x8a\x74\xc7\x81\x58\xd1\xce\x5d\x86\xcd\x56\xcf\x94\x31\xc5\x0e\xc7\xb3\xae\xac\x6a\xf5\xb3\xe5\x3e\xf3\x5c\xa0\x18\xfd\x91\x64\xf4\x45\x55\x9f\x93\x7e\x67\x32\xd2\x69\xf5\x59\xd1\xe9\xae\x18\x54\xf2\xab\x18\xf0\x3a\xb1\x19\x7d\x20\x05\xec\x02\x85\xc3\x1c\x32\xfe\xb6\x3f\xee\xd8\x05\x1f\xb5\x0a\x28\x28\x03\x3e\x18\xfa\xae\x69\x94\x0e\xa3\xfb\xc0\x7c\xf4\x25\x86\x9d\x23\x9e\x2d\x41\xfd\x43\xfd\x5b\x62\x8c\xdc\x36\xfb\x99\xa3\x6c\xe2\x5e\x9d\x47\x24\x66\x67\x4f\x36\x36\x22\x45\xad\x09\x12\x7c\x7f\xd4\x9e\x47\x55\x7c\xe9\xcf\xe3\xc2\x6d\xb4\x28\xa9\xd1\x8f\xeb\x1f\xab\xd5\x44\xc2\xa4\x70\xa7\xc3\x55\x6e\x52\x56\xfe\xd1\x4f\xdc\xe9\x1e\xad\x89\x12\xd4\x16\x9b\xc7\xe8\x0e\x7c\x6a\x73\xef\xc8\xf3\x86\x5c\xf9\xe2\xd3\xca\x47\x17\x76\x88\xf3\xe7\x13\xeb\x90\xc2\x5d\x99\xc7\x7d\xaa\xf2\xe1\xa4\x8a\xeb\x03\x3e\x94\xab\xfd\xfd\xff\x67\x7d\x94\xff\x92\x3b\x66\x93\x11\xdb\xf3\x2f\x7d\xca\x18\xa9\x01\x1c\x8c\x3b\xea\xcb\xb8\x83\x79\x7d\xd6\xd5\x4c\x83\x55\x14\x43\x15\x47\x1a\x5b\xc0\x9e\x52\xc0\xd0\xaf\x7e\x08\xec\x61\x0a\x08\x6c\x3e\x5c\xd8\x05\x0c\xd9\x02\xf1\x6c\x15\x03\x0b\x57\xc0\x81\x3c\x55\x9e\xb6\xf6\xaa\xce\x79\x4e\x5b\xa4\x76\x75\xf5\x9a\xd8\x53\x9b\xe2\x67\x74\x6b\x8c\x5b\x2d\xa3\xe3\xb3\xbb\xfb\xbb\x1d\x73\xd9\xd2\x65\x58\x6a\xad\x23\x20\x74\x4b\x47\x63\x89\x5d\xe9\x6d\x97\x67\x15\xb3\x4f\xd2\x0f\xf4\x8e\xa1\x8d\xdb\xc6\x50\xd3\xc5\xfd\xf1\xce\xf1\xce\x1d\xab\x9f\xc9\x1a\xcb\x54\x3e\xe7\x36\xfa\x2f\x50\x30\x4a\x97\x4b\x14\x8d\x42\x2f\x0a\xc5\x3e\xab\x33\xdc\xb3\xe8\x77\xad\x23\x03\xa6\xc8\xc8\x3c\x3b\x07\x0c\x9a\xba\x4c\x33\x04\x26\x1c\x98\x1d\xb5\x67\x51\x6a\xcb\xe8\x74\x5f\xd4\x0e\xd6\xf4\x05\x75\x9d\x8d\x36\xe4\xd8\xb6\xfa\xf8\x39\x58\xef\x03\x3b\xb5\xcd\x79\x01\x99\xc9\x04\x86\xfa\xd3\xce\x7a\x94\x95\x7d\x44\x32\xae\xea\x3c\xa0\x71\xc4\xd1\xb8\x08\x96\xac\x37\xa6\x5b\x7a\x15\xfb\xf4\x05\x15\x59\x3c\x8c\x24\x8a\x55\x8c\x0e\x1e\x8d\xf1\x90\xed\xd3\xf3\xa8\x5f\xd2\xbd\xbd\x98\xe7\x4b\x25\xf4\x25\xc6\xa0\xec\x92\x5d\x78\x96\xbc\x5e\x4b\xb1\xce\xab\xbc\x9f\x54\xf7\x29\xc7\xa4\xa7\x48\x98\xfb\x8e\xd9\x9c\xd8\xe1\x2b\x16\x05\xb5\xb0\x19\x82\xa1\x1f\x0f\x6c\xa6\x4b\x89\xed\xc7\xc2\xb1\xd4\xa9\xc4\xc8\xa5\xcb\x3c\xe6\x40\x60\x69\x18\xbe\x36\xa5\x86\x4a\x60\x0f\x4d\x90\x20\xc7\xdb\x76\x2a\x96\x9d\xa2\xf2\x17\x89\xa9\x6a\x13\x7f\xc0\x87\xc6\xa3\x63\x56\xf9\xae\xf6\xa9\x02\x09\xf4\x1c\x31\x5d\xca\x66\x7c\x7f\x38\x55\x63\x91\x67\x29\xd7\xe2\x96\xb5\x45\x15\xc3\x4d\x76\x26\xf2\xe4\x51\xe4\x97\xf5\x52\x55\xdb\xb9\x41\x33\x76\x1b\x46\xe9\xf6\x66\xdb\xfc\x03\x3e\x98\x2c\x5c\xc1\xc0\x90\xe7\x16\xc3\x76\xe6\xc3\xd0\x08\xaa\xbd\x1c\x68\x5c\xc8\xfb\xbf\xff\x7e\xf7\xf1\xe3\xf7\x6b\xe9\x7f\xe7\xe3\xf2\x55\x70\x56\x5c\x78\x69\x92\xc6\x45\xb0\x3a\x7f\xbd\x00\x9c\x79\x57\x41\xc1\xab\x23\xef\x65\x28\x98\x5f\x62\x5b\x0f\xbb\xc8\x99\x06\x01\xf6\x34\x8f\xfc\x10\x2c\xd8\xaf\xfb\xeb\xe9\xb7\x81\xc1\x0c\x75\x4f\x3d\x7d\x77\x30\xf8\x7f\x00\xb6\xa5\x7f\x62\xb3\xcb\x37\x21\xea\x37\xe8\x7f\x42\xb4\x3f\x21\xda\xe3\xd7\x4f\x88\xf6\x27\x44\xfb\x13\xa2\x3d\x72\xbd\xa3\xb1\xfe\x16\x66\x7f\x22\xb5\xc7\x55\xf2\x77\x05\x6c\xd3\x64\x83\xd7\xa6\xc9\x7d\xf6\x13\xab\x3d\x6d\x22\x7f\x03\xc8\x76\xf7\x9b\x62\x47\xbe\xad\xf9\x55\xa6\xb1\xbe\x7e\x02\xba\xd7\xc8\xf9\x5f\x02\x74\xf7\x3c\xe8\x27\xae\x7b\x95\x80\xbf\x0d\xae\xeb\x71\xf1\xe0\xee\xe3\xa7\xbb\x91\xfa\x6c\xd9\xe2\x90\x13\x3a\xbf\xff\x63\x45\x7c\x73\x8f\x6e\xfe\x95\x53\x54\x03\x6e\xff\x7f\x77\xb7\x06\x68\x3f\x1c\x44\xaa\xba\xac\xbc\xfb\x58\xa3\xb9\x1f\x6b\x38\xf7\x4d\x2c\xa7\x8f\x7b\xdf\x2a\xba\x3a\x0a\x1e\x8a\xe7\x3b\xea\xf8\x00\x5c\xde\x7c\xd7\x7e\x0b\x5b\x5e\x52\x4b\x62\x47\x1e\x9f\xe1\xd9\x7c\xc1\x7e\x9f\x73\xd0\xb1\x8f\xb3\xd4\x87\xad\xb3\x08\xb6\x67\x19\x44\xe4\xe9\xa6\xd8\x33\xe6\x8e\xd0\xa5\x1c\x8d\x23\x1e\xdf\xb2\x14\xfd\x35\x71\x23\xe3\x51\x59\x76\xf8\x07\x1b\x3a\x01\xe7\xb0\xa1\x4e\x5c\x61\xee\x6f\x75\x21\x43\x60\xf5\x31\x62\xba\x99\xcb\xd3\x53\x77\x89\x9c\x4c\x1c\x41\x59\xa2\xbe\x6d\x8a\x92\xdb\xc3\x02\x09\xf2\x5c\xe4\x29\x52\x77\x78\xcd\x6e\xbe\x42\xc6\x09\x60\xd4\x04\x06\xdc\xaa\xdb\xfd\x25\xdf\xc8\x5d\x77\xd9\x57\xdd\x58\x29\x5c\x75\x35\x03\x76\xdd\x09\xcd\x41\x24\x17\x6e\xc0\x7d\x19\x75\x8c\x91\xea\x27\xbf\xf2\xfe\x2c\xb0\x2d\x83\x02\xbd\x61\xf0\x1c\xb4\xaa\xcf\x7d\x31\x50\x7f\xd5\xbb\xfd\x91\xd9\xc5\xfd\xb1\xde\xed\xa9\x9a\x8e\xa5\x32\x61\xc5\x69\xec\x7b\xf3\xb8\xcf\x2b\x2b\x1e\x5e\x7c\x12\x79\x71\xb6\x43\xab\x77\x0d\xa3\xe3\x07\x95\x9c\x17\x25\xee\xbf\x28\x09\xcb\xcf\xea\xb1\x76\x3a\xaf\x95\x4e\x60\xa4\x6c\x74\x22\x45\x35\x72\x9a\xd8\x8c\x3e\xd8\xa0\xa0\xbd\x16\x5d\xe9\x4d\x0c\x54\x45\x9f\xb1\xb6\xa9\x63\x59\xd1\x51\x57\xf4\xe3\x2d\xd4\xaf\xee\xf6\xce\x61\xc8\x3e\x7a\x4a\x12\xd8\x96\x8c\xe5\xa9\xe2\x4b\xa6\xe2\x8f\xda\xf3\x2f\x6f\xbc\x72\x57\xc7\x4a\xb0\xe9\xec\x36\x5a\xa5\xdb\x98\x34\x37\xbf\x43\xb9\x44\xdc\x39\x7e\xd2\x35\x35\x71\x83\x68\xc3\x5e\xbf\xf0\x7a\xb3\xf5\xef\x1c\x30\x46\x73\x89\x4c\xeb\x8c\xcc\x73\xc4\xe1\xb9\x2f\xa3\xf6\x3c\xe8\x77\x5b\xda\x98\x9e\x38\x0a\xf5\xda\x57\xf5\xa6\xae\x50\x86\x66\xf0\x27\xe5\xd4\x08\x77\xb5\xcf\xab\xf9\x4c\x81\xc0\x36\xdc\x80\x23\x7a\xc0\xbd\x0e\xdb\xdc\xfc\x79\xda\x99\x0f\xdb\xdc\xfe\xba\xa7\x40\xa0\x0b\x87\xe7\xe6\xf2\x94\x9b\xaf\xbb\xc8\x47\xe7\xb8\x87\xcc\xc3\x06\x5e\xd8\x0c\x9b\x3b\x21\x8e\xd6\xba\x10\xdb\xf3\x2f\x75\x67\x1a\x2f\xe7\x6e\x51\x4b\xbd\x8f\x34\xb8\xbb\xd7\x1a\x5c\xa3\xe3\x07\x32\x91\x60\x10\x87\x67\x57\x7b\x4e\x6d\xe6\x00\x19\xa3\x44\x21\x9e\x3a\xda\x0e\xea\x6f\xb8\x21\xa6\x2c\x25\x69\x39\x91\x8a\xe1\x94\x9e\xb9\x8c\x9c\x3a\x96\xf8\x46\xaf\x24\x9a\x63\x75\xe9\xca\x6f\xe0\xe2\xd8\xf3\x78\x60\xd2\x6b\x1b\x32\x10\x3f\x33\x72\x14\xe2\xd2\x65\x9a\xa4\xd2\xa7\x1a\xe2\xcc\x19\x53\xab\xbd\x97\x9b\x70\x83\x64\x6e\x74\xd8\xf5\x04\x63\xaa\xd7\x76\xa9\x2a\x30\xd4\x59\x49\x5b\x22\x18\xcb\xce\xfe\xda\x97\x5a\xa5\xab\x71\xc4\x09\x38\xec\xfa\xdb\x3c\x0a\xbb\x42\x36\xea\x8e\xff\x9b\x0d\xf7\x69\x77\x89\x3c\xd6\x6f\x8d\xb8\x61\x97\x38\x1a\x87\xab\x7b\x90\xe7\x2a\x3f\x7f\x7a\x6e\x2b\xd4\xb0\xe4\x08\x0a\xb8\x1d\xdb\x7c\x0e\xd8\x85\x63\xca\xa5\x63\xa9\x0b\x91\x5f\xd9\xa1\xc1\x2e\x75\xff\x36\xdf\x02\xb6\xa9\x37\x54\x2f\x60\xd7\xba\xa9\x7c\xac\x80\x7c\x3d\x4f\xcd\xb1\x64\xca\xb6\xfa\xd4\xc8\xec\x66\x88\xe9\x36\xe1\xfc\xed\x9e\xce\x18\xd3\xd1\xb8\x33\x58\xad\x7d\x08\x4c\x3a\x41\x1d\x9c\x23\xa1\xd2\x6d\x37\x1b\x8d\xb9\x01\x8f\x49\x7f\x8d\x1a\x5b\x4a\x62\xd9\x66\x93\x72\x4c\x95\xf7\xc6\x34\x41\xe6\x2b\x05\xb4\x15\xea\x17\xa9\x13\x18\x22\x8c\xb8\x0d\xb2\xd3\x84\x0d\x15\xbb\xa6\x4c\xb9\x7c\x6b\xf5\xf6\x84\xee\x1f\xd8\x83\x12\x0f\x06\x3d\x39\x73\x4c\x63\x2e\xb6\x87\xf3\xe7\xb2\x55\x3a\x66\x1d\xc7\x12\x91\xb7\x07\x7f\x63\x54\x63\xb7\xee\xfb\x2f\x01\x37\x4e\x22\x13\xbf\xec\xaa\xe5\x8f\x5f\xfe\xfa\xe5\xff\x02\x00\x00\xff\xff\xa9\x6a\xdd\x57\xec\x48\x00\x00")
+var _envDevelopmentJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x7b\x73\xa2\xca\xb6\xff\xdf\x4f\x91\xe2\x9e\xaa\xcc\xd4\x9d\x4c\x00\xe3\xec\x61\x57\xed\x3f\x90\x28\xa2\x48\x04\xe4\x79\x4e\x6a\x17\x74\x13\x44\x79\x1d\x68\x30\x78\x6a\xbe\xfb\xad\x56\x34\xbe\x62\xcc\x63\x4e\xed\xba\x7b\x84\xcc\x44\x58\xbd\x7a\xbd\x17\xdd\x3f\xf2\x9f\xc6\xc5\xc5\xc5\x05\xf1\x8f\x1c\x4c\xbc\xc8\x21\x7e\xbf\x20\x26\x08\xa5\xf9\xef\xd7\xd7\xab\x2b\x5f\x23\x27\x76\x7c\x2f\xf2\x62\xf4\xd5\x59\x14\x99\xf7\x15\x24\x51\x7d\x2f\xbf\xa6\x49\xaa\x75\x45\x52\x57\x24\x75\x0d\xbd\x34\x4c\x2a\x4c\x37\xf6\xa2\x34\x74\x90\xf7\x75\x9a\x27\xf1\xff\x10\x5f\x56\x33\x80\x24\x46\x5e\x8c\x74\x2f\xcb\x83\x24\xc6\x13\x51\x5f\x49\x7c\xac\x09\x52\x27\x73\x22\x0f\x79\x59\x4e\xfc\x7e\xb1\x12\x0b\x1f\x04\x08\xd8\x45\x3a\x4e\x66\x5e\xbc\x73\x1d\x1f\x04\xaa\x52\x0f\xb3\xca\x51\x16\xc4\x3e\xf1\x65\xf7\x2e\xf4\x1e\x9c\x22\x44\xba\x13\x16\x4b\x2a\x62\x73\xfb\xc7\x13\x25\x01\x02\xce\x49\x1d\x10\xa0\xea\x79\xfe\x41\x8c\x5e\x60\x4e\x3e\xc3\x7b\x94\x24\xa1\xe4\x44\xde\xcf\x91\x3d\xcd\x92\xc7\x8a\xf3\x32\xf4\x22\xfb\x13\xc3\xc3\xc0\x8b\xd1\x3b\x99\xdc\x26\x91\x13\xc4\x58\x51\xd1\x71\xbd\xf0\x1d\x9c\x84\xc8\xf1\xbd\xf7\x8e\x67\x0b\x34\x39\xc1\xc3\x03\x45\xe6\xbd\xc8\x69\xe0\x9d\x88\x88\x33\x78\x14\x6e\x18\x00\x61\xc4\x42\x98\x79\x79\xce\x86\x61\x02\x1c\x14\x24\xf1\xd0\x43\x93\x04\xbe\x37\x20\x54\xe4\xa0\x00\x9c\x35\xb5\x3a\x2b\x3e\x22\x04\x55\xe4\xc4\xd0\xc9\xe0\xf1\x39\xf3\x7c\x32\x5a\x6a\x7c\xda\x6c\x27\x0c\x56\xa6\x31\xc7\xe2\x38\x0c\x1e\x02\xe0\xa0\x77\xcb\xbb\x35\x4b\x63\x6b\x2e\x22\xf3\xf2\xa4\xc8\x80\x87\xeb\xcc\x3f\x37\x34\x7b\x53\xe5\xb3\xe2\x60\x7e\x7c\x12\xf1\xca\x92\xc4\x3f\x9f\xea\xd5\xa7\xcb\xe3\x06\xbf\xfc\x7c\xff\x24\xc3\x9e\xb6\xeb\x30\x4b\xb1\xbe\xde\x6e\xc9\x3b\xf4\xe3\x61\xec\x9c\x9c\x7f\x9f\xfe\x45\x41\xd6\x4a\x41\xaf\xbc\x2a\xd3\xf8\x2a\x0d\x52\xe2\xcb\x71\xdb\x0f\x03\x90\x25\x79\xf2\x80\xbe\x4a\x1e\x9a\x27\xd9\xec\x7a\x6f\x72\x2f\xdf\x1f\xba\x16\x06\x0f\xff\xe7\xda\xfa\x7c\x96\x14\xe9\xa7\xcf\x5f\xd7\x37\xef\xf7\x47\x81\x24\x86\xc1\x66\x98\xf7\xef\xc2\x09\xf3\x4f\xdb\x4a\x3f\x15\xee\xcb\xcf\x5f\x2e\xc8\xcf\x07\x1c\x9c\x34\xd8\x6a\x37\x34\x49\x31\x57\xe4\x6f\x57\x24\x45\x34\x8e\xd8\xe1\x3f\xaf\x73\x8d\x53\x7b\x3a\x75\xc0\x61\xa0\xae\x3f\x6b\xaa\x51\xe6\x3d\x04\x8f\x7b\x01\xb7\x7f\x10\xd4\xb2\x1d\x7e\x25\xaf\xe9\x26\xd1\x38\x42\x70\x71\x7f\x70\x75\xcf\x8f\xf8\x24\xf2\xc2\x8d\x3d\xf4\xfc\x5c\xc7\x45\x3d\x47\xe9\xe7\x55\x23\x7e\xdf\x16\xff\xe6\xb8\xf8\xcf\x08\x7c\x90\x59\xbc\x83\xbc\xb9\x53\xa9\x4b\x3d\x88\xc6\x2b\xd8\xfc\x54\xd5\xa8\x95\x6a\xcf\x2b\x80\x0f\x22\x5e\x25\x85\x8a\xdb\x43\x80\xaa\x65\x94\xbf\x38\x23\x3e\x89\x00\xee\xe4\x87\x00\x3f\x5d\x1e\xe6\xda\x31\xf6\xf9\xe5\x97\x8b\xcb\x2c\xbd\x8a\x73\xff\xf2\x20\x09\x8e\x7d\x08\xe4\xf8\xd8\x0c\x71\x11\x86\x27\x89\x7f\xbc\xcb\x8f\xe3\x24\x09\x83\xd8\x3f\xe9\xc7\xc6\xe9\x18\xdf\x9b\x66\xc3\x7a\x59\xa7\x70\x74\x9c\x5d\xa4\xca\x20\x43\x85\x13\xd6\x5f\x3f\xa8\x44\xfd\xcc\x02\x13\xa4\x5c\x12\x3f\x04\x7e\x91\x2d\x05\xfb\xe9\xf9\xbc\x2a\x1b\x1f\x18\xaa\x7b\x16\xbf\xae\xeb\x12\x0e\xd6\xb5\xfb\xf0\xef\x3b\xd9\x7e\xd0\xa8\xf6\x8f\xbd\x80\x78\xbe\x63\xae\x52\xf8\x03\xd5\x39\xe8\x72\x1b\x45\x56\xfd\xf2\x65\xd1\x1b\x6f\x50\x6a\x2b\xe2\x97\x0f\x63\x44\xe3\x3c\xd6\xf7\x87\x2c\x89\x32\x8d\xc7\x75\x7e\x28\x49\x81\xbc\xb6\x93\x7b\x70\x2f\xa4\x4f\x3e\xfb\xec\x08\xa4\xa7\x31\x3f\xa7\x8e\x0c\xc7\x27\x81\x02\x2f\xdb\xa2\x6a\x9c\xa1\x32\x96\xaf\x5e\x8b\x6c\x07\xfe\xf3\x92\x6c\xe8\x6b\x97\xe0\xa5\xd6\xb3\xd4\xaf\x6e\xc7\xf8\x24\x28\x86\xfe\x4a\x7d\xfb\xfe\x95\x6e\xb5\x5e\x68\x6c\xf7\x8d\x57\x78\xf6\x49\x74\x25\x49\xd0\xd6\x23\xef\x69\x91\x9e\xd7\xec\xb5\xd9\xbe\x95\x2b\x78\xf6\x5b\x07\x39\x07\xcf\x94\xfb\x8f\xe3\xef\xce\xcd\xa7\x50\x5e\x25\x0d\x70\x88\xc6\xeb\x92\xe5\xfe\x25\x73\x8e\xb2\x04\x25\x20\x09\x4f\xdb\x91\xb8\x4b\xbd\x58\x1f\x49\x44\xe3\x3c\x4f\xfe\x68\x9c\x50\x73\x2b\x43\x97\x6a\xbd\xb5\x25\xd5\x75\xf0\xaf\xff\xf0\xbc\x47\x07\xbd\xd4\x8b\x61\x7e\x17\x1f\x35\xf9\x47\x54\xd5\x2f\xaf\xe6\xba\xd7\x7a\x36\x3c\x8f\x75\x98\xfb\xc6\x11\xc7\xfe\xa7\x71\x56\x41\xdc\xf8\x7e\xbd\x34\xfe\xf3\x96\xce\xff\x2c\x9b\xc7\x44\x5e\x57\xc4\xcd\x2a\xfa\x08\x0d\xa8\x5d\x72\x90\x8b\xdb\xde\xba\x27\x4e\x86\xe3\x0b\x55\x80\x28\x52\x3f\x73\xa0\x37\x4a\xc2\x00\x1c\x2e\xd4\xd7\x1f\x22\x4a\xe0\x52\xb3\xa1\x13\x17\x4e\xb8\x3b\xe5\x91\x69\xf1\x49\xd4\x66\x1f\x3a\x60\x12\xc4\xde\x28\x4b\x1e\x82\xf0\xc4\xe2\x28\xc9\x5f\x22\xa9\x43\x39\x4a\x0b\xe4\x65\x78\x45\xfd\xf4\x48\x0e\x82\x2b\xe2\xcb\xf3\x83\x1c\x18\x05\xb1\x96\x7b\xd9\xda\x43\x20\x4c\x0a\x78\x55\xe4\x5e\x76\x6a\x58\x18\xc4\xc5\xe3\x79\xed\x67\xfd\x21\x60\x90\x3b\x6e\xe8\x8d\x9c\x3c\x9f\x27\x19\xc4\x5b\x4f\x5e\x8c\x82\x4d\xd6\xa2\xac\xf0\x9e\x9f\x72\xbd\x77\xf2\x9a\x92\x3d\xf0\xaa\xd3\x05\x6e\xfb\xf3\x32\xd7\xf5\x87\x48\x9d\xe5\xae\x19\x71\x3d\x49\x22\xef\xfa\xc9\x62\xd7\x5f\xf3\x7c\x72\xed\x14\x68\x92\x64\xc1\xc2\x83\x7f\xce\xb0\x00\x5f\x1a\x67\xf0\x5c\x9e\xc4\xcc\xab\x8e\xb6\x98\xed\x3d\xa3\x83\xc0\x7e\x5d\x6b\x38\x9e\xd2\xaf\x1b\x7f\xfc\xce\x91\x48\xc7\x27\x91\xa3\x24\x73\xfc\x17\xc3\x1c\x9f\x44\x80\x37\x35\x15\xef\xc1\xcb\xbc\xf8\xc4\x7e\xc1\xfa\x58\xb5\xe6\x7c\xb2\xaa\x18\x8a\x07\x7b\xce\xfe\x52\x67\xff\x43\x24\x0f\x0f\x35\x79\xaf\x23\xbe\x44\xbc\x2a\x68\xc4\x6f\x57\xa2\x3e\x7c\x89\xb6\x7c\xea\x02\x78\x57\x3f\x7f\x66\x29\x77\xc2\x54\x75\xb6\xdf\x06\xf9\xec\x65\xd5\x41\xe6\x39\xc8\xbb\x4b\xeb\xec\x21\xba\x59\x12\x2d\xf7\x74\x5f\x92\x73\x05\x54\xc0\xb3\x66\xd9\x76\x20\x0b\x40\x52\xc4\x68\xfd\x68\x3c\xca\xbc\x28\x28\xa2\x3f\x45\x45\x25\xfe\x2b\x71\x54\x2f\xe9\xcf\x8a\xa3\x9a\x56\x88\x91\x97\x3d\x38\xc0\xdb\x29\x55\x2f\xd7\x84\x33\x8c\xb2\xa9\x97\xc1\x55\x19\xe5\xf9\x55\x1c\x80\x17\x0c\xff\x96\x67\xcf\x7a\x4c\x10\x39\x59\x75\x56\x89\x7c\xfd\xb2\xf8\x6d\xfa\x1f\xed\xee\x6b\x5b\x04\x29\x58\xce\x7d\x86\x41\xde\x6b\x9c\x23\x3b\x7a\x6f\x1a\xfb\xe1\xcb\xf5\x9d\x4d\x9d\xb3\x6b\xf6\x19\x79\xf0\xe1\xa1\x72\xa4\x71\x6e\x9e\x33\x77\x62\xe8\xed\x86\xdd\x0f\x90\xc3\x6d\xf3\xff\x56\x8c\xac\x3f\x04\x8c\x73\xd5\x43\x28\x88\xfd\xf7\x31\xc2\x27\x01\x0f\x50\x3d\xc2\xc9\x92\x2b\x10\x10\x8d\x37\xb2\x3c\x51\x35\x3f\x76\xd4\x8f\xc6\xcf\xa1\x3e\x8f\xf2\xbe\xf1\x3e\x3e\x3f\x1a\xaf\xe3\xfc\x5c\x6f\xf1\x1e\x91\x17\xe3\xee\x7d\x56\x77\xd9\x50\xff\x94\x4e\x02\x72\xef\x8c\xe4\x78\x4b\x22\xec\x3e\x2e\x3d\x95\x36\x76\xf9\xbe\x42\xe7\x49\xab\x97\xa7\xdf\x59\xb2\x73\x45\x8e\x92\x48\x05\x59\x90\xa2\xd7\x8c\xed\x39\x31\x0c\xbd\x6c\x7b\xf9\xbc\x79\xcd\xe1\xa5\x83\x70\x0a\x94\x68\xab\x35\xda\x30\x88\x93\x2d\x2e\xaf\xe8\x92\xf9\x56\x09\x38\xb3\xde\x62\xc3\x23\x0f\x20\x0f\xbe\xa9\x7e\x10\xf9\xca\x4c\xb8\xd1\xb8\x4e\xee\x7d\xbb\xf9\x04\x92\x18\x38\xe8\xd3\xea\xdb\x38\x51\x97\xa0\xed\xa7\x4b\x40\xeb\xa4\xc0\x51\x21\xe7\x27\x7f\x5c\x7e\xfe\x72\xc9\x09\xac\x3d\x1a\xdf\x0d\x3a\xd2\x1f\x97\x97\x97\x5f\x76\x97\xbd\xeb\x57\x3f\x30\xe1\xe5\xe5\xbf\xe2\x4b\x4c\x3f\xba\xbb\x13\x25\x76\xd8\x39\x42\xbf\x7e\xdd\x62\x8b\x1e\xff\xb3\x2f\x03\x17\x87\x94\xab\xb2\xc8\x53\xdb\x14\xe0\x95\x09\xe4\x35\x5f\x34\x7d\x5f\x27\xbb\x43\xc7\x68\x51\x5e\xa7\x1b\xdb\x46\x8b\xe4\xfc\x34\x87\x91\x7e\x03\x79\xbd\xb0\x39\x16\xb9\x1c\x9b\x49\x63\x36\x54\xc2\x7e\x57\x51\xd9\xd2\xe6\x75\x5a\x6c\xf6\x4b\xb7\xa9\xd0\x76\xc5\xd0\x96\xd9\xcf\xa1\x9f\xde\xd8\xb1\xf4\x60\x37\xfb\x25\xa4\xed\x85\xc0\xe1\xeb\xc2\x80\x8b\x1e\x69\xdb\x9c\x90\xb6\xd1\x9a\x09\x1c\x95\x0b\x5c\xfe\x38\xbc\x7d\x96\x57\xe2\xd2\x54\xe8\xf6\xac\x81\xc7\xdb\x0b\x93\x86\x95\xdb\x84\x11\xa8\xd8\xd2\xe1\x19\x64\xcb\xc9\x00\xc4\x6d\x24\x70\x24\x72\x0c\x6a\xee\x36\xfb\xa4\xc0\x4f\x48\xd8\x6b\x2f\xee\x82\xef\xa5\xcd\xcf\x0b\x3b\xd2\x67\x6e\xb3\x3f\x01\xbd\x7e\xe9\x44\xfa\x14\x72\xad\x12\x44\xa0\x04\x3d\x3d\x10\x69\x7d\x6e\x1b\xf3\x52\x0b\xdb\x92\xa8\x41\x59\xa9\x28\x51\xd1\x67\x48\xd1\xdb\xdd\x31\x47\x36\xb9\xb8\x3f\x77\x55\x16\x89\x46\x88\x00\xcf\x54\x90\x6b\x27\xb0\xa7\xcc\xc1\x22\x29\xc5\x66\x7b\x62\xd1\x68\x62\xd3\xfa\x42\x8c\xa8\xd4\x6a\xf6\x4b\x40\x33\x11\xe4\x5a\x53\x97\x26\x4b\x87\xd6\x5b\xa0\x62\x90\x63\x48\x95\xdb\x94\x4a\x3b\x96\x0b\xcb\x94\xa6\x9c\x9f\xb6\xa0\x41\xfa\xa2\x39\xf3\x1d\xa3\xb5\x80\x7c\x37\x77\xb7\xf9\xd2\x4a\x2e\x46\x76\x68\xf3\x4c\x65\x99\xed\xca\xa5\xd3\xd0\x6a\xca\x85\xdb\xec\xc7\x62\xb3\x4d\x59\x01\x13\x02\x5e\xcf\x6b\xd9\x11\x88\xf4\xdc\x36\xba\x0b\x5b\xa5\x72\xcb\x54\x42\xd0\x94\x91\x54\xb5\x0a\x97\xee\x56\x16\xed\x17\xd8\x3e\x9c\x9f\x4e\x2d\x53\xf6\x47\x01\x13\x42\x7e\x58\x7a\xa6\x8e\xc4\xb8\x1f\x02\x9e\x59\x88\x91\x5c\x5a\x66\x4a\x81\x48\x2b\x40\xa4\xcf\xdd\x8a\xfd\x3e\xe2\x60\x77\x4c\x5a\x31\x17\xe2\x45\xbc\x5e\xd9\x2a\x35\x75\xf9\x10\x72\x51\x6b\xe2\x1a\x1a\x53\xd3\x23\x8b\x7e\x4c\xb9\xa8\x3f\x01\xb4\x4e\x81\x68\xce\x38\x3d\x85\x04\xbd\xe1\x37\xb1\x62\xe6\x96\x21\x65\x96\x01\x43\x50\xb5\x76\x6d\x40\x33\x48\x6c\x86\x94\x6b\xd6\xf3\xd3\xdd\x6f\xd0\xec\x87\xa2\x21\xe5\x8e\x9c\x86\x6e\xd4\x0d\x5c\x5e\x9f\x8d\xcc\x30\x04\xf3\x34\x06\x3c\x9c\x3a\xbc\x3e\x75\x16\x54\xcb\x36\x87\x03\x45\x63\xf8\x8d\x0d\x55\x6a\x4d\x5f\xd9\x66\xbb\x1c\x99\xfd\x04\x1a\x33\x04\xa2\x49\xe8\x72\x64\x53\x34\xa5\x10\xc4\x76\x08\x02\xaa\x72\x7a\x7a\x2a\x1a\xcc\x1c\xf2\x61\xe9\x46\xdd\x5c\x34\xfb\x73\xd7\x1c\xee\xfb\x61\x4b\xdf\x99\x6f\xf3\xcc\xd4\xa1\xf5\x4a\xe0\xd3\x47\x81\x87\x53\xab\x6a\x57\x0e\x47\xc5\x8e\x29\x57\x77\xea\xf2\xf7\xb9\x67\x2a\x89\x4b\xdf\x2c\xa4\xa0\x4d\xba\x66\xbb\x69\x99\xca\xd4\xe1\xda\x39\xec\x29\x85\x5d\x51\x14\x68\x0a\x03\x6e\x06\x47\x26\x65\x77\xb5\x50\xea\x8f\xc9\x1b\x66\xa8\xde\x3c\x4a\xdc\x4d\x8b\x8b\x24\x6c\x37\x7f\xcb\x6e\x33\x97\x6b\xc5\x2e\xcd\xc4\x2e\xaf\xad\x6c\x45\xc3\x52\xa4\x61\xd9\xef\xa1\xde\x98\x62\x0c\x45\xef\x8f\x55\x8d\xb9\x7b\x50\x5b\xf9\x32\x47\x39\x6a\xe2\x1a\x32\x2d\x71\x2d\xd2\x32\x85\xc2\x6e\x26\xfe\x03\xd7\xc6\xbf\xfb\xa2\x36\xf4\xc5\xa6\xbe\x00\x01\x93\xbb\xb4\x34\x71\x39\x16\x79\xbd\x64\xe0\xf2\x37\xbe\x68\xee\xdf\x63\x62\xb7\x62\x02\xc7\xb8\x29\x07\x01\x5b\x42\x53\xaa\x44\xfa\xb1\xb4\xe8\x6e\x2e\xd2\xfd\xd4\xf5\x93\x81\x1e\x4a\x9a\x46\x31\x6d\x85\xd4\xef\xf4\xee\x93\x2c\xa3\xb1\x50\x0c\xc7\xfe\xa3\x38\xed\x0c\x5c\x03\xcd\x1c\x3c\x37\x3d\x29\x5d\x43\x2b\x2d\xfa\xb1\x84\x86\x8c\x20\xf6\x45\xc0\xe0\x7c\x29\x20\x9b\xce\xa1\x29\x25\x36\x57\xe7\xb1\xca\x4c\x5d\x9e\xa1\x6c\x8e\xa2\x00\xad\x57\x22\x5d\xd7\x9d\x43\xfb\xd0\xa0\xa9\x2c\x70\x5c\xb9\xb1\xb2\x89\x31\x31\x5a\xc5\x90\x6d\x28\xb1\xad\xb6\x0a\xdb\x94\xcb\x15\x8d\x8c\xed\x66\x68\x94\x32\x36\xc9\x6e\x4f\xd1\x5a\xda\x93\x1f\x5a\x8c\xd8\xb4\x17\xb0\x37\x44\x35\x2d\x72\xf9\xb0\x80\xa6\x8f\xbc\x5b\x8b\x14\x55\xe5\xb7\xe7\xf4\x7d\x50\x8f\xd8\xda\x9c\x7c\xe3\x22\x29\x71\x9b\xb0\x10\x38\x4a\x15\x78\x29\x77\x9b\xfa\x4c\x34\xf5\x85\x6d\x0a\xdf\x76\xed\xc0\x16\x9c\x9f\x14\x4b\xbb\x06\x4c\xea\xc6\x12\x69\x19\x8f\xb9\xcd\xe3\x9c\x6e\xcd\x6c\xa3\x35\x75\x0c\x7d\x21\xc6\x52\xc2\xc5\x12\x65\xf3\xdf\x7d\xd1\xd4\xfc\x7d\x1e\x22\x2d\x95\x6e\x64\xa7\x76\xd5\x5a\x38\x1c\x8b\x44\x53\x2f\x2c\x53\x21\x57\x3c\x64\x9c\x1f\x07\xf1\x65\x9b\x56\xb1\xce\xf7\x55\x7c\x51\x8b\xfa\x3b\xa6\x9f\x40\x53\x49\x84\x5e\x7b\x02\x97\xfc\x14\x5c\xc7\x0a\x81\x13\x66\x32\x19\xb6\x8d\xb0\xad\x8d\x49\xd4\x1d\x07\x02\xa6\x9d\xbb\x34\x93\xd7\xf7\xe4\x31\xc9\x0c\xc7\xb3\xae\xa4\xa8\xcb\x7b\x2b\x3f\x73\x6c\x20\xeb\xfd\x91\xa8\xf7\x05\x45\x9b\xa3\x7e\x67\x32\xd2\x28\xe5\x4e\xd6\xa8\xae\x10\x60\xfe\xb8\x06\x3c\x4e\x2c\x5a\x1b\x88\x01\xb3\x80\xd1\xb0\x00\xb4\xbf\x9d\x8f\x3b\x71\xc1\xc5\xed\x12\xf0\xf2\x80\x0b\x86\xbe\x6b\xe8\x95\x4d\x6b\xbe\x63\xdc\xf8\x22\xcd\xcc\x21\xc7\x54\xce\xf2\x87\xfc\xb7\x48\xeb\x85\x65\xf4\x73\x5b\xde\xd4\xbd\x65\x1f\x11\xe9\x1d\x9f\x6c\x62\x44\x8c\xdb\x13\xc8\xfb\xfe\xe8\x76\x1e\xe3\xfa\xd2\x9f\x27\xa5\xdb\x6c\x93\x62\xb3\x9f\x2c\x7f\xcc\x76\x0b\xf2\x93\xd2\x9d\x0e\xeb\xde\x24\xd7\xf9\xd1\x4f\xdd\xe9\x1e\xad\x01\x53\x78\x2b\xb4\x8e\xd1\x1d\xe4\xd4\x34\x79\xfe\x7e\x53\xc2\xb9\xf8\xad\xce\xd1\x85\x15\x85\xc5\xdd\x33\x7a\x88\xd1\x2e\xcf\xe3\x39\x85\x73\x38\xc5\x75\x7d\xc0\x45\x12\xf6\xef\xff\x9e\xcc\x51\xee\x7b\x61\x1b\x2d\x5a\xb8\x9d\x7f\xef\x93\xfa\x48\x09\xc0\x60\xdc\x51\x1e\xc6\x9d\x90\xd3\x66\x5d\xd5\xd0\x19\x59\xd6\x15\x61\xa4\x32\x25\xe8\xc9\x25\x88\x7c\xfc\x83\x40\x2f\x24\x1d\x9e\x29\x86\x0b\xab\x04\x11\x53\x42\x8e\xc1\x35\xb0\x74\xf9\x30\x90\xa6\xf2\xb7\x2d\x5f\x2d\x7b\x9e\x7d\x2b\x90\xbb\xb6\x7a\x4c\xad\xa9\x45\x72\x33\xaa\x3d\x0e\xdb\x6d\xbd\xe3\x33\xbb\xfe\xdd\xae\xb9\x4c\xe5\xd2\x0c\xb9\xb6\x91\xc3\x77\x2b\x5b\x65\x90\x65\xdc\x6c\xcf\xb3\x55\xb3\x9f\xa5\x1f\x68\x1d\x5d\x1d\xdf\xea\x43\x55\x13\x98\x57\x8c\x9d\xdb\x66\x3f\x97\x54\x86\xc6\x39\xe7\x36\xfb\x0f\x80\xd7\x2b\x97\x4d\x65\x95\x84\x0f\x32\xc9\xdc\x29\xb3\xb0\x67\x52\xaf\xd2\x23\x77\x0c\x81\x96\x38\x66\xee\xd0\x70\xea\xd2\xad\xc8\x31\xc0\xc0\xe8\x28\x3d\x93\x54\xda\x7a\xa7\xfb\xa0\x74\x42\x55\x5b\x90\xe7\xc5\x68\x53\x4a\x2c\xb3\x1f\xde\x05\x6b\x3f\x30\x53\xcb\x98\x97\x80\x9e\x4c\x40\xa4\x6d\xe2\x6b\x69\x0b\xb9\x8e\x8f\x58\x0a\xf1\x73\x9e\xa3\xb2\xc8\x56\xd9\x18\x54\x8c\x37\xa6\xda\x1a\xae\x7d\xda\x82\x8c\x4d\x0e\xc4\x22\xc9\xc8\x7a\x27\x1c\x8d\xc3\x21\xd3\xa7\xe6\x71\xbf\xa2\x7a\x7b\x35\xcf\x17\x2b\xe0\x8b\xb4\x4e\x5a\x15\xb3\xf0\x4c\x69\xad\x4b\xb9\xee\xab\x9c\x9f\xe2\xeb\xa4\x6d\x50\x53\xc8\xcf\x7d\xdb\x68\x4d\xac\xe8\x31\x14\x78\xa5\xb4\x68\x14\x02\x3f\x19\x58\x74\x97\x14\x6e\x6f\x4a\xdb\x54\xa6\x22\x2d\x55\x2e\x7d\x53\x38\x3c\x43\x81\xe8\xb1\x25\x36\x15\x04\x7a\x70\x02\x79\x29\xd9\x8e\x53\xa1\xea\x94\x56\x14\xe2\xfa\x3a\x01\xb4\x3f\xe0\x22\xfd\xc6\x36\x70\xbf\x5b\xe6\x54\x09\x79\x6a\x0e\xe9\x2e\x69\xd1\xbe\x3f\x9c\x2a\x89\xc0\x31\xa4\x6b\xb2\xab\x67\x8b\x48\x4a\x5c\x83\x99\x09\x1c\xba\x11\xb8\xd5\xf3\x92\x85\xed\x19\xb4\x12\xb7\xa9\x57\x6e\x6f\xb6\x3d\x7e\xc0\x05\x93\x85\xcb\xeb\x21\xe0\xd8\xc5\xf0\x36\xf7\x41\xa4\x07\xd8\x97\x03\x95\x8d\x38\xff\x8f\x3f\x2e\x3f\x7f\xfe\xb8\x2d\xfd\x1f\x8d\xb7\xdd\xbd\x6f\x9c\x47\x7f\x64\x91\x44\x24\xa5\x97\xa5\x59\x52\x06\xf5\xfa\xeb\xc1\x09\x73\xaf\x71\x62\xd4\xfe\x92\x77\x6f\xc9\xb7\x59\x57\x3e\xad\x50\xb9\x15\xb6\x75\xbd\x8b\x9c\xa9\xc0\x09\x3d\xd5\x43\x07\x0c\x3e\x02\x0b\xf6\x97\xfb\xeb\xd9\xfb\xc0\x60\x9a\xbc\x22\xbf\x7d\x38\x18\xfc\xff\x00\xb6\xa5\x4e\xc6\xc7\xdf\x04\x9b\xc5\x58\xfb\xf2\x0d\xfa\x5f\x10\xed\x2f\x88\xf6\x17\x44\xfb\x0b\xa2\xfd\x05\xd1\xfe\x0c\x88\xf6\xa9\xcc\xfe\x42\x6a\x6b\xa4\x76\xcf\x24\x7f\x57\xc0\x36\x4b\x37\x78\x6d\x96\x5e\xe5\xbf\xb0\xda\x2d\xac\x76\x2f\x44\xfe\x06\x90\xed\x4e\x3f\x3f\xf6\xd7\x9a\x6f\x0a\x8d\xd3\x95\xf4\xe3\x47\xfd\x68\xfc\x1c\xea\xf3\x28\xef\x1b\xef\xe3\xf3\xa3\xf1\x3a\xce\x7f\x65\x40\x77\x2f\x83\x7e\xe1\xba\xbf\x70\xdd\x6d\x5c\xd7\x63\x93\x01\x86\x5f\x47\xca\x9d\x69\x09\x43\x96\xef\xfc\xf1\x8f\x9a\xf8\xe2\x0a\x5e\xfc\xab\x20\xc9\x26\xd8\xfe\x17\xa3\xb8\x35\xeb\x83\x4a\xb5\x7c\xf3\xef\xf2\xf3\x12\xcd\xfd\x8c\x91\xdc\x2d\xb6\xac\x36\xee\xbd\x97\x35\x7e\x5b\xf7\x90\x3d\xd7\x51\xc6\x07\xe0\xf2\xe6\x6f\xed\xb7\xb0\xe5\xa5\x30\x9c\x28\x74\xa4\xf1\x89\x31\x9b\x3f\xb0\xdf\x1f\x39\xe8\x58\xc7\x87\x2c\x17\x5b\x27\x11\x6c\x8c\x64\x0a\x1c\xd5\x12\x7a\xfa\xdc\xe6\xbb\xa4\x8d\xd1\x6c\xae\x6d\xca\xda\x63\xea\xc6\xfa\x8d\xbc\xda\xe1\x1f\x6c\xe8\xf8\xb0\x00\x4d\x65\xe2\xe2\xdd\xc6\xa7\x5d\xc8\xc8\x31\xfb\x21\xa4\xbb\xb9\xcb\x51\x53\x77\x85\x9c\x4c\x6c\x5e\x5e\xa1\xbe\xb7\x24\x29\xdd\x0e\x4b\xc8\x4b\x73\x8c\x34\x2f\x77\x78\x8d\x6e\x51\x23\xe3\xc8\xa1\x95\x14\x04\x6c\xbd\xdb\xfd\xbd\xd8\xf0\x5d\xef\xb2\xd7\xbb\xb1\x62\x54\xef\x6a\x06\xcc\x7a\x27\xb4\x70\x62\xa9\x74\x03\xf6\xfb\xa8\xa3\x8f\x14\x3f\xfd\x8d\xf3\x67\x81\x65\xea\xa4\xd3\x1b\x06\x77\x41\x1b\x7f\xef\x0b\x81\xf2\x9b\xd6\xed\x8f\x8c\x6e\xd8\x1f\x6b\xdd\x9e\xa2\x6a\xa1\x58\xa5\x8c\x30\x4d\x7c\x6f\x9e\xf4\x39\xb9\x1e\xc3\x09\xdf\x30\x1a\xb3\x43\xab\x75\x75\xbd\xe3\x07\x98\xcf\x83\x9c\xf4\x1f\xe4\x94\xe1\x66\xcb\xb9\x76\x76\x5e\xb1\x4d\x40\x2c\x6f\x6c\x22\xc6\x4b\xe4\x34\xc5\xc8\xcc\xfa\x9a\xd0\x6b\x53\xd8\x6e\x42\xa0\xc8\xda\x8c\xb1\x0c\x2d\x94\x64\x0d\x76\x05\x3f\xd9\x42\xfd\x96\xbb\xbd\x73\x10\x31\x37\x9e\x9c\x06\x96\x29\x85\xd2\x54\xf6\x45\x43\xc6\xe8\xca\xf7\xa7\xb1\x52\x57\x0b\xe5\x60\xb3\xb3\xdb\x6c\x57\x6e\x73\xd2\xda\xfc\x1f\x49\x15\x64\x4f\x8d\x47\x5d\x43\x15\x36\x88\x36\x46\xf7\xbd\xde\x6c\xfd\x7f\xe1\xd0\x7a\x6b\x85\x4c\x6b\xb4\xc4\xb1\xc8\xe6\xd8\xef\xa3\xdb\x79\xd0\xef\xb6\xd5\x31\x35\xb1\x65\xf2\xb1\x8f\xd1\x40\x99\xd4\x55\x9d\x7b\x96\xcf\x12\xe1\xc6\x7e\xae\xe5\x99\x3a\x3c\xd3\x74\x03\x16\x69\x01\x8b\xdf\x5a\x98\xdf\x4d\x3b\xf3\xe1\x2d\xbb\xaf\xf7\xd4\xe1\xa9\xd2\xe6\xd8\xb9\x34\x65\xe7\x02\x77\x94\xf7\x4a\xc6\x3d\x64\x1e\x34\xc3\x85\x45\x33\x85\x1d\x85\xf1\xda\x16\x78\x67\x7b\xb9\x33\x1d\xae\x64\x37\xc9\x95\xdd\x47\x2a\xd8\xf5\xb5\x0a\xd6\xe8\xf8\x01\x4f\xc8\xeb\xc8\xe6\x98\xda\xe7\xe4\x46\x06\x8c\x0c\xc1\x28\x9c\xda\xea\x0e\xea\xaf\xbb\x51\x48\x9a\x72\xda\xb6\x63\x25\x04\x53\x6a\xe6\xd2\x52\x66\x9b\xc2\x13\xbd\x9c\xaa\xb6\xd9\xa5\x70\xde\x80\xc5\xb1\xfb\xc9\xc0\xa0\xd6\x31\xa4\x43\x6e\xa6\x17\x30\x0a\x2b\x97\x6e\x21\x6c\x4f\x25\x0a\x73\x7b\x4c\xd6\xbe\x97\x5a\x60\x83\x64\x6e\x6c\xd8\xf5\x78\x7d\xaa\x2d\xe3\x52\x91\x41\xa4\x31\xa2\xba\x42\x30\x56\x3b\xfb\xeb\x5c\x6a\x57\xf8\x0d\x16\x3b\x60\x43\xd7\xdf\x1e\x23\x33\x35\xb2\xb1\xdc\xf1\x7f\x8a\xe1\x3e\x85\xfd\x27\x9a\xfd\xe5\x5b\x23\x6e\xd4\xc5\x08\x47\x88\xaf\x01\x8e\xc5\x79\xfe\xed\xee\x56\x26\x87\x15\x8b\x60\xc0\xee\xc4\xe6\x5d\xc0\x2c\x6c\x43\xaa\x6c\x53\x59\x08\x5c\x1d\x87\x3a\xb3\xb2\xfd\x93\xbc\x25\xb8\x25\x6b\x24\x6b\x89\xee\xae\x6d\xe3\x03\x7c\x8f\x5b\xca\xa9\xda\xa6\x44\x5a\x66\x9f\x1c\x19\xdd\x1c\xd2\xdd\x16\x98\x3f\x5d\xd3\x68\x7d\x3a\x1a\x77\x06\xb5\xee\x43\xc7\xa0\x52\xd8\x09\x0b\xc8\x63\xdb\x76\xf3\xd1\x98\x1d\x70\x21\xea\xaf\x51\x63\x53\x4e\x4d\xcb\x68\x91\xb6\xa1\x70\xde\x98\x42\xd0\x78\x24\x1d\xb5\x46\xfd\x62\x65\x02\x22\x18\x42\x76\x83\xec\xb4\x40\x53\x09\x5d\x43\x22\x5d\xae\x5d\xbf\x3d\xa1\xf9\x07\xf1\x20\x27\x83\x41\x4f\xca\x6d\x43\x9f\x0b\xb7\xc3\xf9\x5d\xd5\xae\x6c\x63\x59\xc7\x52\x81\xb3\x06\x7f\x63\x54\x63\xf7\xb9\xef\x2f\x02\x6e\x3c\x8b\x4c\x6c\xc8\x7e\x34\x2e\x2e\x2e\x2e\xee\x1b\x3f\x1a\xff\x37\x00\xa9\x6a\xdd\x57\xec\x48\x00\x00")
 
 func envDevelopmentJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -151,7 +151,7 @@ func envDevelopmentJson() (*asset, error) {
 	return a, nil
 }
 
-var _rbacDevelopmentJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x96\x5b\x6f\xda\x30\x14\xc7\xdf\xf9\x14\x56\x36\x09\x2a\x61\x92\x98\x40\x42\xdf\xd0\xaa\x4e\x7d\xe8\x8a\xda\x6e\x2f\x88\x07\xc7\x3e\x50\x4f\x89\x6d\xd9\x0e\x55\x3b\xf1\xdd\xa7\x84\x4b\xb9\xa4\x97\x55\x55\xb5\x6a\x83\x17\x88\x8f\xff\xfe\xfb\x9c\xdf\x39\xca\xaf\x06\x42\x08\x79\x9f\x2d\xbb\x81\x9c\x7a\xc7\xc8\xbb\x71\x4e\xdb\x63\xdf\x5f\x3e\xe9\xe4\x54\xd2\x19\xe4\x20\x5d\x87\xde\x17\x06\x3a\x4c\xe5\xab\x35\xeb\x93\x20\xec\xe1\x20\xc4\x41\xe8\x73\xd0\x99\xba\x2b\xe3\xae\x21\xd7\x19\x75\xd0\xf9\x69\x95\xfc\xe4\xb5\x97\x27\x30\x25\x1d\x48\xf7\x03\x8c\x15\x4a\x96\x07\x85\x9d\xa0\xfc\xae\x03\x34\x35\x34\x07\x07\xc6\x7a\xc7\x68\x69\xab\x7a\x4e\x4d\x7e\x05\x66\x2e\x18\x8c\x8c\x90\x4c\x68\x9a\x9d\xf1\x9d\x90\x2a\xcc\xdd\x69\x28\x55\xad\x33\x42\xce\xbc\xcd\xe2\xa2\xfd\x20\x35\xd5\x6f\xa5\xc4\x61\x5e\x27\xf5\x52\xa5\xc6\x96\x9e\x67\xc0\xaa\xc2\x30\x28\xef\x3d\xde\xc4\xec\x49\x49\x9a\x57\x52\xf1\x00\x78\x14\x47\x14\xc7\xa4\x1f\xe3\x68\x3a\x4d\x70\x4a\x48\x1f\x0f\xfa\x61\x14\xa4\x10\xf4\x09\x25\x5e\xbb\xde\xc6\xb9\x60\x46\x59\x35\x75\x9d\x61\xe1\x6e\x94\x11\xf7\xd4\x09\x25\x7d\xa3\x32\x38\x81\xa9\x90\xa2\xfc\x6b\xf7\xb7\x6b\xa3\x34\x18\x27\xc0\x1e\xe4\x6a\xe9\x5f\x65\xf0\x6d\x65\x6f\x78\x79\x81\xe6\x11\x3a\x81\x39\x64\x4a\x97\x34\xa0\x53\x61\xac\x43\x23\x6a\xdc\x1d\xba\x2a\x52\xcb\x8c\xd0\xe5\x39\x7b\xc7\x2c\x8f\x02\x93\x0b\x6b\x2b\x17\xdb\xc9\xd8\xfe\x1c\x3a\xd8\x6c\xa7\xcc\x3d\xb9\x75\x13\xf8\x90\x89\xcb\x75\xf2\x7d\xbb\xe5\xcd\xfa\xeb\xa2\x7c\x35\xaa\xd0\xd6\xbf\x35\xc2\x81\xf7\xa8\xe8\xa4\x76\x65\x71\xf0\x74\x52\x73\x67\x6a\xad\x98\x49\x9a\x66\x70\xc5\x94\x86\xc7\xdd\x7b\xe3\x6d\x8b\xad\xa3\x8e\xe0\x93\x43\x4b\xbb\x56\x16\x7b\xb5\xa4\x5a\x6c\x75\x20\x09\xc2\x64\xd9\xbe\x58\x1b\x98\x0b\xb8\xad\xa5\xfd\x11\x14\xc7\xb3\x42\xf0\xd6\x81\xa7\x36\x6a\x9e\x8e\x90\x8f\x56\x28\x9c\x8e\x76\xaa\xde\x3c\x9a\xbc\x06\xcf\x61\x95\xa4\x92\xa7\x03\x3c\x39\x68\x90\xdc\x5e\xc8\xda\xc4\xed\x26\x6d\x5d\xed\x33\xde\x6a\xbe\xb0\x1b\x9a\x6d\xd4\x7c\x49\xd3\x95\x17\x6b\x3c\x51\xeb\x67\xdb\xc8\x96\xd5\xaf\xf2\x5a\x53\xe6\x1a\x6e\x76\x7d\x56\x83\xec\x5d\x2f\x5b\xd7\xbe\x3b\x63\xd5\x1b\x3f\x8c\xf4\x56\xb3\x6e\xf6\x3e\x27\x73\xbd\x22\x63\x7f\xa7\xf7\xc7\x8c\x0f\xde\x9c\xf1\xe1\xe5\x39\xf2\xd1\x77\x0b\x06\x0d\x19\x03\x6b\xd1\x90\xe7\x42\x0a\xeb\x0c\x75\xca\xbc\x3d\xe7\x1f\x85\x9f\x30\xe1\x31\x4f\x12\x8e\x79\xb7\x07\x38\x9a\xa6\x3d\x4c\x7b\xac\x8b\xe3\x38\xee\x32\x12\xd0\x98\xf0\xc1\x2b\xf8\xa9\x7d\x0d\xf8\xc8\x00\x9d\xc0\x1c\xf9\xe8\x8b\x92\xce\x88\xb4\xf8\xa7\x91\x49\x49\x34\x48\x12\xca\x70\x3f\x4c\x02\x1c\x11\x1a\x60\x9a\x26\x09\x26\xc1\x34\xee\x26\x84\x73\x12\xb1\x57\x20\x53\xfb\x92\xf6\xf1\x91\xf9\x3f\x73\xde\x6b\xe6\xfc\x9d\x00\x55\xbf\x26\x8d\x45\xe3\x77\x00\x00\x00\xff\xff\xa7\x46\xc2\xc5\xbc\x0d\x00\x00")
+var _rbacDevelopmentJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x96\xcf\x6f\xea\x38\x10\xc7\xef\xf9\x2b\x2c\xef\x4a\x50\x09\x93\x1f\x04\x12\x7a\x43\x5b\x75\xd5\x43\xb7\xa8\xed\xee\x05\x71\x70\xec\x81\x7a\x45\x6c\xcb\x36\x54\xed\xaa\xff\xfb\xca\x85\xd0\x04\xa2\xb6\x5b\x75\x9f\x5e\xf5\x5e\xec\x03\xc4\x9e\xf1\xd7\x33\x9f\x19\xe5\x9f\x00\x21\x84\xf0\xaf\x96\xdd\x41\x49\xf1\x29\xc2\x77\xce\x69\x7b\x1a\x86\xdb\x37\xfd\x92\x4a\xba\x84\x12\xa4\xeb\xd3\xc7\xb5\x81\x3e\x53\xe5\x6e\xcd\x86\x49\x14\x0f\x49\x14\x93\x28\x0e\x39\xe8\x95\x7a\xf0\xfb\x6e\xa1\xd4\x2b\xea\xa0\xff\xb7\x55\xf2\x17\xdc\xdb\x9e\xc0\x94\x74\x20\xdd\x5f\x60\xac\x50\xd2\x1f\x14\xf7\x23\x3f\xaa\x0d\x9a\x1a\x5a\x82\x03\x63\xf1\x29\xda\xca\xf2\x03\x53\x53\xde\x80\xd9\x08\x06\x53\x23\x24\x13\x9a\xae\x2e\x78\x63\x8b\x9f\xd8\x3d\x68\xf0\x5e\xad\x33\x42\x2e\xf1\x7e\xf1\xa9\xb7\xff\x89\x17\xfa\xb3\x3c\x71\xd8\xb4\xb9\x7a\xaf\xa7\xa0\xe6\x0f\x1b\xb0\x6a\x6d\x18\xf8\x7b\xcf\xf6\x7b\x0e\x5c\x49\x5a\x3e\xbb\xca\xc6\xc0\xd3\x2c\xa5\x24\x4b\x46\x19\x49\x17\x8b\x9c\x14\x49\x32\x22\xe3\x51\x9c\x46\x05\x44\xa3\x84\x26\xb8\xd7\xb4\xad\x64\x5c\x0a\x66\x94\x55\x0b\xd7\x9f\xac\xdd\x9d\x32\xe2\x91\x3a\xa1\x64\x68\xd4\x0a\xce\x60\x21\xa4\xf0\x7f\xed\xa1\xb9\x36\x4a\x83\x71\x02\x9a\x89\xa9\x06\xf6\xe6\x7f\xec\xe4\x4d\xae\xaf\xd0\x26\x45\x67\xb0\x81\x95\xd2\x9e\x06\x74\x2e\x8c\x75\x68\x4a\x8d\x7b\x40\x37\xeb\xc2\x32\x23\xb4\x3f\xe7\xe0\x18\x3f\xb1\x06\x53\x0a\xeb\x01\x69\x06\xa3\xfe\x1c\x2b\xa8\x1e\x4c\x99\x7b\xd5\xb4\x7a\x6a\x91\xb8\xae\x82\x1f\xda\x9a\x36\x1b\x56\x49\xf9\xdd\xa8\xb5\xb6\xe1\xbd\x11\x0e\x70\xd0\xea\x0d\x21\x34\x6f\x5d\x79\x3a\x7a\x3b\x6f\xb9\x33\xb5\x56\x2c\x25\x2d\x56\x70\xc3\x94\x3e\xa0\xa0\x3e\xf0\xac\x2e\xb1\x7b\xd2\x17\x7c\x8e\x83\xd7\xa5\xd4\x98\xf5\x13\x53\x2d\x6a\x15\x98\x44\x71\xbe\x2d\x5f\xa2\x0d\x6c\x04\xdc\xe3\xa0\xc5\xb2\x19\xf1\x3d\x8a\xb3\xe5\x5a\xf0\xee\x91\xa6\x1e\xea\x9c\x4f\x51\x88\x76\x28\x9c\x4f\x1b\x59\xef\x9c\xcc\x3f\x82\xe7\xe4\x39\x48\x9e\xa7\x23\x3c\x39\x68\x90\xdc\x5e\xc9\xd6\xc0\x35\x83\x56\x65\xfb\x82\x77\x3b\xef\xac\x86\x4e\x0f\x75\xde\x53\x74\xfe\x62\xc1\x2b\xb9\x7e\xb3\x8c\x2c\x53\xdb\x38\xb4\xa5\xb9\xd7\x5e\x76\x2f\x3a\x2f\xf8\x91\xe9\xff\x7c\xd9\x16\x49\xba\xd1\x56\xf1\xec\xa5\xa5\x77\x3b\x6d\xbd\xf7\x2d\x37\xb7\x3b\x32\x0e\x2d\xf1\x7f\x66\x7c\xfc\xe9\x8c\x4f\xae\x2f\x51\x88\xfe\xb4\x60\xd0\x84\x31\xb0\x16\x4d\x78\x29\xa4\xb0\xce\x50\xa7\xcc\xe7\x73\xfe\x55\xf8\x89\x73\x9e\xf1\x3c\xe7\x84\x0f\x86\x40\xd2\x45\x31\x24\x74\xc8\x06\x24\xcb\xb2\x01\x4b\x22\x9a\x25\x7c\xfc\x01\x7e\x5a\x3f\x03\xbe\x32\x40\x67\xb0\x41\x21\xfa\x4d\x49\x67\x44\xb1\xfe\xa1\x91\x29\x92\x74\x9c\xe7\x94\x91\x51\x9c\x47\x24\x4d\x68\x44\x68\x91\xe7\x24\x89\x16\xd9\x20\x4f\x38\x4f\x52\xf6\x01\x64\x5a\x3f\xd2\xbe\x3e\x32\x3f\x7b\xce\xb7\xea\x39\xdf\x27\x40\x01\x42\x08\xcd\x83\xa7\xe0\xdf\x01\x00\xa7\x46\xc2\xc5\xbc\x0d\x00\x00")
 
 func rbacDevelopmentJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -171,7 +171,7 @@ func rbacDevelopmentJson() (*asset, error) {
 	return a, nil
 }
 
-var _rpDevelopmentPredeployJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x6d\x4f\xe3\x46\x10\xfe\x9e\x5f\x61\xb9\x95\x92\x54\x89\x63\xa7\xd0\xaa\xf9\x86\x54\xa9\x42\x6d\xef\x22\x40\x7c\x89\x10\xda\xac\x27\xc9\x82\xbd\xbb\x9a\x1d\x03\xb9\x13\xff\xfd\x64\x3b\x6f\x7e\xcb\x0b\x17\x4e\xc0\x39\x7c\x40\xf2\xcc\x3c\xb3\x33\xfb\x78\x9e\xf1\xd7\x86\x65\x59\x96\xfd\xab\xe1\x33\x08\x99\x3d\xb0\xec\x19\x91\x36\x83\x5e\x2f\x7d\xe2\x84\x4c\xb2\x29\x84\x20\xc9\x61\x5f\x22\x04\x87\xab\x70\x61\x33\xbd\xbe\xeb\x9d\x76\x5d\xaf\xeb\x7a\x3d\x1f\x74\xa0\xe6\xb1\xdf\x15\x84\x3a\x60\x04\xce\x9d\x51\xf2\x17\xbb\x93\x66\xe0\x4a\x12\x48\xba\x06\x34\x42\xc9\x38\x91\xe7\xb8\xf1\xdf\xd2\x41\x33\x64\x21\x10\xa0\xb1\x07\x56\x7a\xac\xe4\x39\xf3\x43\x21\x3f\x8f\xef\x80\xd3\xb9\x9f\x31\x25\x66\x9a\x6b\x88\xd1\x0c\xa1\x90\x53\x7b\x65\x7c\xee\xac\x21\x26\xfa\x12\xf0\x41\x70\x18\xa2\x90\x5c\x68\x16\xbc\x14\xe9\x1e\xe6\x0f\x2c\x0a\x68\x88\x30\x11\x4f\x3b\x31\x3a\x59\x6b\xc8\x9e\xfe\x03\x39\xa5\x99\x3d\xb0\xfa\x6e\x69\x02\xfc\xbe\xa3\x36\x36\xf0\x6c\x04\xa3\x22\xe4\x10\x37\x74\xb4\xf2\xc9\x41\x69\x54\x1a\x90\x04\x98\x42\x9a\xc4\x6e\x80\x47\x28\x68\x7e\x11\x05\x39\xa0\xcd\x5f\x31\x70\xdf\x04\x79\x5f\x52\x5c\x05\x71\x6d\x57\x5c\xe7\xfa\x57\x3c\x5b\x52\xde\x50\x21\x5d\x30\x39\x4d\x3a\xf2\xdb\xae\x18\x1f\x0c\x09\xc9\x48\x28\x99\x09\x3c\x39\xf9\x7d\xbf\x74\x67\xbe\x8f\x60\xcc\x8a\x01\x07\xa5\x3c\x3c\x98\x71\x0e\x26\x6e\x9d\x7d\x16\x04\xea\x71\x97\xbb\x46\xa1\xe2\xeb\xb2\x07\x96\xd7\x77\x77\x1d\x4c\x20\x70\x5a\xbc\x8e\xe7\x72\xac\x22\xe9\xdb\x95\x21\xcf\xd5\x68\xb6\x64\x61\xd2\x45\xd4\xb7\x42\xde\x32\x0c\xcb\x61\x2a\x20\xde\x3f\x7b\xfa\xfd\x0f\x47\x9e\xd3\x1f\x4e\x1e\x63\x66\xb7\x42\x56\x30\xa7\xf0\xf4\xa6\xb1\x05\x7f\x83\x90\x5d\x69\x0a\x83\x78\x39\x3f\xff\x17\x1c\x95\x51\x13\x72\x3e\x01\x3d\x2a\xbc\xef\xc9\xf4\xff\xe5\x62\xea\xfd\x83\x2a\xd2\x26\x1f\x1e\x28\xce\x96\x95\x8f\x96\x53\x36\x71\x6d\xb5\x9d\xa5\xf1\x26\x1f\xc5\xb4\xd8\x50\xbf\xbe\xeb\xfd\xd5\x75\xff\xec\xba\x5e\xa9\xd2\x6c\x9d\xd2\x5b\x8a\xd5\xf0\x01\xeb\x2d\xaa\x12\x81\x64\x32\xdd\x07\xec\x91\x89\xc6\x86\xa3\xd0\xf1\x29\x5a\x6d\x67\x69\xcb\x1f\x28\x09\x34\xf7\x51\xe5\x20\xb1\x27\x2c\x14\xc1\x3c\x79\x5d\x2a\x5e\x95\x35\x57\x89\x49\x9f\x61\x09\xe3\x4b\x98\xbe\x78\x0f\x87\x2a\x10\x5c\xbc\x4c\x45\x5f\x5a\xf0\x0a\x40\xad\x37\x28\x7b\xb4\xde\xb5\x5a\xcd\xb2\xe5\xa8\xd9\xde\x8a\xa5\x01\x43\x61\xe2\x9b\xdd\x63\x2a\x1b\xe0\x08\x54\x5d\x74\xc6\x79\x0a\x54\x3d\x43\xe2\xdf\xcd\x8e\xa9\xc4\x63\xd6\x4c\x04\x67\xb4\xa5\xcf\xd9\x08\x04\x46\xb0\x63\x34\x5a\xe9\x28\x0e\x60\x3f\xcf\xb8\x8c\x3d\xdc\x22\xed\xc7\xa9\xb7\x17\x5c\x3d\x52\x8f\x24\xb1\xaf\x47\xad\xb2\x65\xb6\xa6\x56\x99\x67\x4d\xad\x72\x80\x4a\x6a\x65\xbe\x0a\x8f\xc9\xa9\xc3\x6f\x79\xcf\x1b\x09\x84\xd9\x45\xc0\x43\xef\xa3\xb8\x13\x95\x68\x0f\x48\x36\x0e\xe0\x52\x4d\xe8\xef\x94\x64\x03\x8b\x30\x82\xbd\xb6\xa7\x11\x57\x92\x33\x6a\x6d\x76\x3e\xfb\x09\xdc\x6c\x77\xac\x66\x97\x07\xa6\x78\x07\x25\x5b\xc7\xbf\x30\xbf\x8e\x63\x7b\x09\xc2\x6b\xed\x19\x7f\x74\x3d\xb7\xde\x33\xde\xda\x9e\xf1\xce\xc4\xa0\x9e\x8d\x45\xe7\x83\x5a\x6c\x8e\x36\x16\x8f\xae\xcb\x47\x57\x5b\x11\x6a\x85\x3f\xbd\x0a\x68\x85\xb5\x0a\x64\x02\x6b\x15\xa8\x55\xa0\x56\x81\x5a\x05\xf2\x9e\x1f\x59\x05\xcc\x03\x7f\xd3\x2a\xd0\x48\xdb\xfb\xdc\xf8\x16\x00\x00\xff\xff\x53\xe5\xd5\xcd\xfb\x1c\x00\x00")
+var _rpDevelopmentPredeployJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x5f\x8f\xda\x46\x10\x7f\xf7\xa7\xb0\xa6\x95\xee\xae\x02\x63\xd3\xa4\x55\x79\x3b\xa9\x52\x15\xf5\x1f\xca\x45\x79\x41\xe8\xb4\xac\x07\xd8\x9c\xbd\xbb\x9a\x5d\x5f\x42\x23\xbe\x7b\xb5\x80\x39\x1b\x8c\xc1\x57\xae\x22\x89\x77\x2c\x81\x3c\x7f\x76\x67\xe6\xb7\x33\xe3\xcf\x9e\xef\xfb\x3e\x7c\x6f\xf8\x1c\x53\x06\x03\x1f\xe6\xd6\x6a\x33\xe8\xf5\xd6\x6f\x82\x94\x49\x36\xc3\x14\xa5\x0d\xd8\x3f\x19\x61\xc0\x55\xba\xe1\x99\x5e\x3f\x8c\x5e\x77\xc3\xa8\x1b\x46\xbd\x18\x75\xa2\x16\x4e\xee\x1d\xa6\x3a\x61\x16\x83\x0f\x46\xc9\xef\xa0\xb3\xde\x81\x2b\x69\x51\xda\xf7\x48\x46\x28\xe9\x36\x8a\x82\xd0\x51\x2e\xa0\x19\xb1\x14\x2d\x92\x81\x81\xbf\x3e\x96\x23\x60\x71\x2a\xe4\xdf\x93\x0f\xc8\xed\x9b\xb8\xc4\x72\x0f\xd8\x85\x46\x67\xcd\x58\x12\x72\x06\x5b\xe6\xb2\xb3\xfd\x0b\x53\x7d\x87\xf4\x28\x38\x0e\x49\x48\x2e\x34\x4b\x9e\x6b\xe9\x01\x17\x8f\x2c\x4b\xec\x90\x70\x2a\x3e\x1d\xb5\xf1\xa4\xe9\x08\x52\xf6\xe9\x0f\x94\x33\x3b\x87\x81\xdf\x0f\x2b\x37\xa0\xff\x76\x54\xaf\x60\x0f\x08\x8d\xca\x88\xa3\x0b\xe8\x68\x2b\xb3\x63\x4a\x93\xd2\x48\x56\x60\x39\xec\x39\x81\x41\x9e\x91\xb0\x8b\xb7\x59\xb2\x63\xa8\x48\xfb\x8a\xf9\x3a\xb6\x41\x91\x9c\xac\x55\x5c\x25\x2e\x84\xef\xb8\xde\x40\xe3\x10\xc1\xda\xbd\xa1\x22\xfb\x96\xc9\xd9\x2a\x22\x3f\x1c\xd3\x89\xd1\x58\x21\x99\x15\x4a\x96\x14\x5f\xbd\xfa\xf1\xb4\xed\x6e\xe3\x98\xd0\x98\x2d\x02\x1a\x6d\xd9\x5c\x99\x71\x8e\xc6\x05\x1e\x6e\x93\x44\x7d\x3c\x26\xae\x49\x28\x97\x2e\x18\xf8\x51\x3f\x3c\x22\x1c\x0b\x42\x6e\x37\xd7\xf1\x8d\x9c\xa8\x4c\xc6\xe0\x55\xca\x96\x61\xba\xbb\x40\xb2\x74\x15\x45\xd2\xf7\x42\xde\x33\x4a\xc1\x6b\x60\xe2\xcb\x47\x4f\xbf\xff\xd5\x81\xe7\xf5\xff\x0e\x1e\x63\xe6\xf7\x42\x1e\x40\xce\xde\xdb\xb1\x57\x63\xbf\x00\xc8\xae\x34\x7b\x85\x38\xaf\x9f\x7f\x0a\x4e\xca\xa8\xa9\x0d\xfe\x42\xfb\x51\xd1\x43\x4f\xae\x7f\xef\x36\x55\xef\x37\x52\x99\x36\xbb\xea\x89\xe2\x2c\xf7\x7c\x94\x57\xd9\x95\xe8\xf5\x4d\x90\x33\xc7\xbb\x5a\x4c\x8b\x42\xf7\xeb\x87\xd1\x2f\xdd\xf0\xe7\x6e\x18\x81\x57\xe1\xc4\x67\xaf\xe6\x1a\xd4\x38\xab\xf1\x2b\xf4\xb7\xc4\x73\x0f\x58\x94\x4c\xae\xe7\x01\x18\x99\x6c\x62\x38\x09\xed\x4e\x71\x7d\x13\xe4\xbc\xdd\x03\x39\x02\xf3\x90\x1d\x2c\x24\x30\x65\xa9\x48\x16\x2e\x50\xb7\x15\xba\xa5\x50\x1b\xcb\x64\xcc\xa8\x02\xf1\x3b\xc9\x29\xdc\xc3\xa1\x4a\x04\x17\xcf\xeb\xa2\xcf\x75\x38\x5f\xa0\x9e\x26\x28\x18\x3d\xcd\x5a\xd7\x57\x55\xc3\xd1\xd5\x4d\xad\x2d\x8d\x94\x0a\xe3\xe6\xb8\x13\xaa\xb2\x41\x4e\x68\x0f\x3b\x5d\x5c\x30\x43\x0b\x5e\x9d\xc8\xf8\xf0\xb1\x1c\x01\x77\xa3\xc6\x54\x70\x66\x6b\xe2\x5c\x24\xe0\x84\xcc\x22\x74\x8e\x4b\xc6\x98\xe0\x69\x92\xce\x8d\x13\xc4\x32\x1d\xbb\xad\x6b\x05\xc7\xde\x21\xd6\xb2\x92\xb3\xec\x5c\x0e\xb4\xa8\x85\x56\x0b\xad\x97\x81\x56\xe9\xab\xf0\x9c\x98\x6a\x9e\xe5\x13\x33\x92\x08\x73\x0c\x80\x4d\xf3\xe1\x9d\x00\x61\x40\xc9\x26\x09\xde\xa9\xa9\xfd\x75\x5d\xbf\x06\xbe\xa5\x0c\xbd\x9a\xcc\x6e\xbb\xdc\x88\x2b\xc9\x99\xbd\x2e\x46\xbe\xfc\x09\x7c\x75\xd3\xf1\xaf\xba\x3c\x31\xfb\x39\xa8\x98\x3a\x7e\xc7\xc5\x7b\xa7\xdb\x5b\x7d\x44\xbf\xd4\x9c\xf1\x53\x37\x0a\xdb\x39\xe3\xd2\xe6\x8c\x2f\xac\x19\x34\xbd\x8b\x9d\xcb\x89\xf4\x8b\xd5\xc6\x46\x21\x36\x67\x2b\x8b\x9d\x73\x57\xec\xb3\x77\x5b\x91\x6a\x45\xe7\x72\xb7\x29\xf2\x2e\xa6\x0b\x68\x45\x6d\x17\x68\xbb\x40\xdb\x05\xda\x2e\xd0\x76\x81\x6f\xb6\x0b\x98\x47\x7e\xd1\x5d\xc0\xf3\x7d\xdf\x1f\x7b\x4b\xef\xdf\x01\x00\x53\xe5\xd5\xcd\xfb\x1c\x00\x00")
 
 func rpDevelopmentPredeployJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -191,7 +191,7 @@ func rpDevelopmentPredeployJson() (*asset, error) {
 	return a, nil
 }
 
-var _rpDevelopmentJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x41\x6f\xdb\x38\x13\xbd\xe7\x57\x08\xfa\x3e\x40\x09\x60\xda\x92\xac\xc4\x72\x6e\xd9\xa6\x2d\x0a\x74\xd3\x6c\x1c\xf4\xb0\x45\x0f\x14\x39\x72\xb9\x95\x49\x82\xa4\x9c\xa6\x45\xff\xfb\x42\x92\x1d\x5b\x12\x65\x27\x8e\x13\x34\xbb\x0b\x9f\x2c\x0d\x67\x46\x6f\xde\x1b\x91\xa3\x1f\x07\x8e\xe3\x38\xee\xff\x35\xf9\x02\x33\xec\x9e\x3a\xee\x17\x63\xa4\x3e\x1d\x0c\xaa\x2b\xfd\x19\xe6\x78\x0a\x33\xe0\xa6\x8f\xbf\xe7\x0a\xfa\x44\xcc\x16\xf7\xf4\x20\xf4\x83\x63\xe4\x07\xc8\x0f\x06\x14\x64\x26\x6e\x0b\xbb\x6b\x98\xc9\x0c\x1b\xe8\xff\xa5\x05\xff\x9f\xdb\xab\x22\x10\xc1\x0d\x70\xf3\x11\x94\x66\x82\x17\x81\x82\xbe\x5f\xfc\x96\x06\x12\x2b\x3c\x03\x03\x4a\xbb\xa7\x4e\x95\x56\xb5\x30\xcb\xb5\x01\x75\x89\x15\x70\x73\x2e\x66\x98\xf1\x0b\x3c\x83\x9a\x51\x69\x68\x6e\x65\x71\xd5\xd5\x46\x31\x3e\x75\xef\x6e\xfe\xec\xad\x9c\x51\x6c\x70\x82\x35\x9c\x11\x22\x72\x6e\x76\x77\x94\xca\x09\xa8\x39\x23\x70\xa9\x18\x27\x4c\xe2\xec\x1d\xdd\xcd\x93\x7a\x9c\xa7\x83\x35\x7f\xae\x02\x2d\x72\x45\xa0\x80\xf0\xd3\x9d\x4d\xc3\x95\x54\x42\x82\x32\xac\xb4\xfa\xb1\x96\x4a\x79\x97\x57\x90\xb8\x9f\x88\xe0\x04\x9b\xc3\xa5\xcb\xb7\x4a\xe4\xf2\xf0\xa8\x9f\x09\x82\x0d\x13\xbc\xe7\x78\x7d\xaf\xe7\xac\xaa\x76\xe8\x75\x54\xca\x3b\x3a\xfa\xec\xf6\xec\x8f\xf3\x3b\x23\x4a\x68\x91\x9a\xfe\x05\x98\x1b\xa1\xbe\x0e\x28\xd7\x7f\x0a\x0e\xba\xb9\x62\x19\xb7\x58\x35\xcd\x44\x82\xb3\xa6\x05\x96\x6c\x8d\x5d\xa1\x1f\xc4\xc8\x2f\xd8\x69\x85\x7d\x23\x26\xb5\x7b\x95\x6f\x4a\x15\x68\x3d\x91\x98\xb4\x09\xd3\xb4\xba\x54\x90\xb2\x6f\x8d\x22\xb4\x8c\x03\xbf\xe2\xff\x20\x8c\x5c\xab\xd5\xe7\xd6\xd5\x46\xad\x4a\x37\x3a\x4f\x38\x98\xee\x58\xf6\x54\x9d\x7b\x3c\x74\xf7\xa3\x95\xea\x5d\x4b\xbf\x9d\x56\x6d\x2d\xaf\x4a\x3b\x01\x92\x2b\x66\x6e\x4b\x26\x6d\x8d\x58\xae\x64\xb4\xa4\xe2\x92\x83\xef\xe8\xa1\xd7\x66\x8c\xcd\xbd\xf6\x7a\x8e\xa7\x24\xe2\x7a\xea\xb5\xd8\x67\x0d\x65\xf0\xb4\x80\x81\xe7\x59\xb6\xd1\xf8\x67\xe7\x5d\x4b\x75\x56\x10\x2c\x54\xa5\x24\xaa\x0a\x66\x2f\x79\xdb\x79\x9d\x04\x5d\x62\x55\x12\xcd\x0b\xa7\xf7\x56\xd9\x9c\x29\x93\xe3\x6c\xf1\x77\xa3\xd8\x3e\x75\x75\x80\x96\xa8\x5b\x02\x1c\x23\x7f\xf4\x8b\x0b\x30\x2a\x18\x1c\xbe\x58\x01\x2e\xd2\xff\x75\x05\x28\xe1\x29\x34\xb8\xe5\x79\xa5\x62\x73\x6c\xe0\x35\xa7\x52\x30\x6e\x16\x79\x5e\x8a\x8c\x91\x0a\x6b\xf7\x9c\x69\x9c\x64\x40\xed\x85\xdf\x12\x63\x5d\x76\x12\x9e\x4a\xd0\x12\x4a\x4d\x23\xdf\x0f\xfe\xc9\xba\xce\x32\x71\xf3\xb1\x96\xf5\x19\x21\xa0\x0b\x73\xa3\x72\xb0\x08\xae\x5c\xf2\x46\xa8\x1b\xac\x28\xd0\x6b\x85\xd3\x94\x91\x2d\xe6\x6f\xb1\x81\x1b\x7c\x7b\xad\x30\xd7\xcc\xb8\xa7\x4e\x8a\x33\x6d\xb3\xce\x35\x5c\xc1\x4c\x18\x58\xac\xd0\x1b\x6c\x55\x69\x58\x4f\xbe\xbb\x37\xdd\x4b\x4a\x8d\xfa\xad\x44\xb4\xa4\x42\xa1\xa4\x76\x5b\x7a\xc8\x4b\x62\x20\x01\x8a\x3d\x24\xda\x13\xc7\x1a\xff\x2f\x2b\xef\x2d\xea\x75\x92\xa8\x61\x47\x41\x02\xa7\xfa\x03\xb7\x36\xd4\xdd\xf0\x2b\x9e\xd1\xde\x82\xf6\x5f\x8f\xcf\xbb\x49\xee\x3f\x29\x3d\x8f\x94\x96\x54\xd8\x5d\x43\x6b\xc5\x5f\x97\xd2\xa3\xf6\x5f\x2f\x40\x43\x0d\xce\xef\x4b\x4a\xd6\x7a\x3c\x9d\x86\xbe\x32\x5e\x72\xe7\x6d\x79\x82\x3c\x17\x24\x9f\x15\x67\xd5\xdf\x9a\x08\x6e\xd3\x1a\x11\x5c\x33\x6d\x80\x93\xdb\x72\x5b\x71\xdb\x4d\x56\x0a\x29\xce\x33\xf3\x6a\xb5\xe2\x3d\xcc\x21\x2b\xb2\x98\x18\x25\xd6\x0f\xf3\x96\xfc\x5b\x18\xec\xb4\xd1\x5c\x2e\xbe\x58\x1e\xee\xef\x01\x62\x2d\xa1\xf6\x36\xc6\x92\x62\x63\xb8\xf2\x21\x4d\x41\x5d\x2f\x24\x30\x31\x98\x53\xac\x1a\x1b\xae\xce\xe1\xc3\xfa\x58\xc1\x32\xb3\x69\x33\xd0\xa2\xb5\x55\x75\x07\x0d\x17\x7b\xda\x17\x2d\xb6\xab\x16\x7a\x2c\x6a\xfe\xfa\x9b\x04\xc5\x80\x97\x47\x16\xf7\x95\x50\xe0\x1c\x4e\xfe\x78\x7f\xb4\x19\x04\x9b\xca\xe3\x7b\x6e\xb7\xee\x00\x9c\xe6\x8c\xb6\x66\x37\x8c\xd6\x07\x36\xb6\xd1\x93\x77\xd4\x73\xbc\xab\x4b\x67\xe0\x5c\x01\xa6\xa0\xee\x05\xf5\x59\x6e\xbe\x08\xc5\xbe\x97\x38\x0d\x94\xc8\xe0\x4c\x6b\x36\xe5\x05\xfc\x2d\xb0\xb7\x69\x4b\x13\x21\xed\x2c\x65\xd4\xda\x78\x8a\x78\xe7\x90\x32\xce\x8a\xf0\xef\xaa\x97\x83\xce\x13\x4d\x14\x93\xc5\xa5\x2b\x6b\x63\x6a\x27\xbd\x72\x52\xb6\x27\x4c\x28\x1d\x85\x78\x84\x86\xc3\xf8\x18\x45\x31\xa4\x28\xa1\x51\x88\xd2\x13\xff\x24\x4d\x70\x1c\x60\x18\x75\xf4\x42\x59\x9b\xe5\xd5\xf9\x6c\x47\x7d\xb3\x9b\x3b\x19\x35\x56\x3e\x90\x49\x31\xf2\xc7\xc8\x0f\x90\x54\x30\x67\x70\xb3\x1f\x46\x79\x6f\x0a\xb6\x2c\xfa\xba\xf3\x4a\x70\xa3\x58\x92\x1b\xf1\x6f\xa6\x4e\x44\xc7\xa3\x64\x1c\x27\x28\xa0\x51\x8a\xa2\x51\x3c\x42\x38\x1c\x07\x88\x9c\x8c\xe2\x61\x44\xc3\x20\xdc\x89\x3a\xb6\xa9\xf3\x0b\xa0\xce\x62\x94\xbc\xb5\xa9\xf7\x1c\x6f\xd0\x05\xb2\xd7\x73\x6a\x0c\xac\x17\x64\x43\xaf\x6f\x0c\xa9\xad\x81\x8f\x1e\xd2\x17\x57\xb1\x9c\x85\x93\x3a\xe9\x1f\xf9\x6e\x1a\x48\x25\xe6\x8c\x82\xd2\xfb\x57\xc3\x7e\x41\x7b\x56\x45\x1d\x27\x74\x4c\x68\x1c\xa3\x14\xa2\x63\x14\x85\xc1\x09\x1a\x0f\xe3\x04\xa5\xe3\x51\x34\x1c\x41\x70\x1c\x1d\xfb\x2f\xbb\x19\xef\x65\x13\xff\xf8\x9a\xd6\x77\xe2\x3b\xc8\x7c\x1f\x5f\x8c\x76\xef\x04\xcd\xef\x48\x5e\xcf\xd9\xe7\x97\xac\xa3\xe5\xfb\xee\xfc\x62\xe2\x14\x01\x1e\xae\xfd\x66\x86\xcf\x27\xf8\xa7\xc6\xe6\x59\x1b\x42\x02\x29\xa4\xd8\x0f\x50\x88\xc3\x31\x8a\x82\xf1\x08\xc5\x43\x1c\xa3\x70\x14\xa6\xe9\x70\x48\x60\x18\x44\x2f\xfb\x15\xbb\xd7\x53\xfd\xd3\xd5\xbc\xab\x61\x1c\x54\xff\x7f\x1e\xfc\x1d\x00\x00\xff\xff\x3a\x8e\x51\x0e\x64\x20\x00\x00")
+var _rpDevelopmentJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x4d\x6f\xdb\x38\x13\xbe\xeb\x57\x08\x7c\x5f\x40\x31\x20\xd9\x92\xac\xc4\x72\x6e\xd9\xa6\x2d\x0a\x74\xdb\x6c\x1c\xf4\xb0\x41\x0e\x14\x39\x72\xb9\x95\x49\x82\xa4\x9c\xa6\x45\xfe\xfb\x82\xf2\x47\x6c\x59\x72\x1c\xd7\xe9\x6e\x76\x17\xe4\xc1\x16\x87\x33\xc3\x99\xe7\x19\xd3\xa3\xef\x8e\xeb\xba\x2e\xfa\xbf\x26\x9f\x61\x82\xd1\xa9\x8b\x3e\x1b\x23\xf5\x69\xaf\x37\x7b\xd2\x9d\x60\x8e\xc7\x30\x01\x6e\xba\xf8\x5b\xa9\xa0\x4b\xc4\x64\xbe\xa6\x7b\x71\x18\x1d\x07\x61\x14\x84\x51\x8f\x82\x2c\xc4\x9d\x95\xbb\x82\x89\x2c\xb0\x81\xee\x1f\x5a\xf0\xff\x21\x7f\x66\x81\x08\x6e\x80\x9b\x4f\xa0\x34\x13\xdc\x1a\x8a\xba\xa1\x1d\x0b\x01\x89\x15\x9e\x80\x01\xa5\xd1\xa9\x3b\x73\xcb\x0e\x44\x8a\x52\x1b\x50\x17\x58\x01\x37\xe7\x62\x82\x19\xff\x80\x27\xb0\x26\x64\x27\x32\x77\xd2\x3e\x45\xda\x28\xc6\xc7\x68\xb9\x78\xef\x2f\x3f\x22\x8a\x0d\xce\xb0\x86\x33\x42\x44\xc9\xcd\xfe\x8a\x72\x39\x02\x35\x65\x04\x2e\x14\xe3\x84\x49\x5c\xbc\xa3\xfb\x69\x52\x3f\xa6\xc9\x59\xd1\x87\x14\x68\x51\x2a\x02\x36\x84\xd7\x4b\x99\x9a\x2a\xa9\x84\x04\x65\x58\x25\xf5\x7d\xc5\x15\x3b\x11\x9f\x85\x04\x5d\x13\xc1\x09\x36\x47\x0b\x95\x6f\x95\x28\xe5\x51\xa7\x5b\x08\x82\x0d\x13\xdc\x77\xbd\xae\xe7\xbb\x0f\x59\x3b\xf2\x5a\x32\xe5\x75\x3a\x37\xa8\x66\x65\x71\x9c\x5f\x19\x51\x42\x8b\xdc\x74\x3f\x80\xb9\x15\xea\x4b\x8f\x72\xfd\xbb\xe0\xa0\xeb\x3b\x16\x76\xed\xae\x71\x21\x32\x5c\xd4\x25\xb0\x64\x2b\xe8\x8a\xc3\x28\x0d\x42\x8b\xce\xc6\xb0\x6f\x8d\xc9\xda\x9a\x9d\x08\x53\xaa\x40\xeb\x91\xc4\x64\x13\x30\x75\xa9\x0b\x05\x39\xfb\x5a\x4b\x42\x7d\xa0\xa8\x42\x7f\x37\xec\xc5\x09\x72\x9a\x44\x6e\x36\x9e\xd6\x72\x65\x27\xd2\x65\xc6\xc1\xb4\xdb\x6a\x76\x75\x97\x43\xb7\x1f\x0d\x9d\xae\xbb\xef\x3b\x2d\xdb\xaa\x89\xf8\x2c\xb5\x23\x20\xa5\x62\xe6\xae\x42\xd2\xa3\x16\xed\x44\xcc\x32\x01\x5d\x2f\x30\xf8\x8e\x1e\x79\x9b\x88\x69\x52\xaf\x3d\xdf\xf5\x94\x0c\xb8\x1e\x7b\x1b\xe8\x6b\x1a\xc8\xe0\xb1\x0d\x03\x2f\x8b\x62\xab\xf0\x7d\xeb\xea\xbd\xdf\xba\xb4\x64\x95\x92\xc1\x2c\x61\xc8\xd9\x4d\xf9\x8d\xb3\xc5\xc4\xaa\xda\x29\x07\xb3\x3b\xcb\xa6\x4c\x99\x12\x17\xf3\xaf\x5b\xc9\x76\xdd\x56\x01\x6e\x1e\x25\xe0\x30\x08\x07\x7f\x73\x02\x26\x16\xc1\xf1\x8b\x25\xe0\xdc\x7d\xdf\x69\xd9\xf6\x97\x13\x50\xc2\x73\x70\xf0\x91\xf3\x4a\xc5\xa6\xd8\xc0\x6b\x4e\xa5\x60\xdc\xcc\xfd\xbc\x10\x05\x23\xb3\x58\xa3\x73\xa6\x71\x56\x00\x45\xce\x1e\x36\x56\x69\x27\xe1\xb9\x08\x2d\xa1\xe2\x74\x10\x86\xd1\x3f\x99\xd7\x45\x21\x6e\x3f\xad\x79\x7d\x46\x08\x68\x2b\x6e\x54\x09\x7e\xcb\x96\x37\x42\xdd\x62\x45\x81\x5e\x29\x9c\xe7\x8c\x3c\x22\xfe\x16\x1b\xb8\xc5\x77\x57\x0a\x73\xcd\x0c\x3a\x75\x73\x5c\xe8\x26\xe9\x52\xc3\x25\x4c\x84\x81\xf9\x0e\xbd\x45\x56\x55\x82\xeb\xce\xb7\xd2\x6a\x37\x2a\xd5\xf2\xf7\x40\xa2\x05\x14\x2c\x93\x9c\xed\x28\xdb\x82\x29\xab\xa5\x27\x01\xec\x6d\x34\x38\x10\xc6\x6a\xdf\x2f\x66\xda\xf5\xce\x20\xaa\xc9\x51\x90\xc0\xa9\xfe\xc8\x1b\x0b\xea\x7e\xf1\xb3\x67\x6c\x2e\x41\x87\xcf\xc7\xcd\x7e\x94\x73\x1a\xb2\xf7\x1f\x95\x0e\x4f\xa5\x05\x14\xf6\xe7\xd0\x4a\xf2\x57\xa9\xf4\x43\xf7\xaf\x17\xc0\xa1\x1a\xe6\xfd\x03\xa9\x6d\xcc\xc7\xf3\x71\xe8\x0b\xe3\x15\x76\xde\x56\xff\x20\xcf\x05\x29\x6d\xc7\xe2\xfc\x17\xe4\x3f\x8d\x6b\x44\x70\xcd\xb4\x01\x4e\xee\xaa\x6b\xc5\x5d\x3b\x58\x29\xe4\xb8\x2c\xcc\xab\x87\x1d\xef\x61\x0a\x85\xf5\x62\x64\x94\x58\xfd\x33\xdf\xe0\xff\x46\x0c\xf6\xba\x68\x2e\x36\xcf\xfb\x1d\x3b\x05\xb1\x9d\x1c\x0d\x29\x6a\x6a\xae\x7c\xcc\x73\x50\x57\x73\x0a\x8c\x0c\xe6\x14\xab\xda\x85\xab\x8d\x66\xd7\xab\x6d\x85\x86\x9e\xcd\x26\x02\x1b\xb8\xf6\x90\xdd\x5e\x4d\xc5\x06\xb1\x76\x04\x58\xdd\xe4\xec\xba\xfa\xdd\x69\xcb\xf9\xeb\xaf\x12\x14\x03\x5e\xf5\x0c\xd0\x2b\xa1\xc0\x3d\x1a\xfd\xf6\xbe\xb3\x3d\x08\x4d\x2c\x4f\x77\xbc\x6e\x2d\x03\x38\x2e\x19\xdd\xe8\xdd\x30\xba\xde\xb0\x69\x6a\x3d\x79\x1d\xdf\xf5\x2e\x2f\xdc\x9e\x7b\x09\x98\x82\xda\x29\xd4\x67\xa5\xf9\x2c\x14\xfb\x56\xc5\xa9\xa7\x44\x01\x67\x5a\xb3\x31\x9f\x40\x43\xb0\x1f\xe3\x96\x26\x42\x36\xa3\x94\xd1\xba\x2f\x76\x20\x6b\xef\x1c\x72\xc6\x99\x35\x5f\xf5\xe1\xd0\xb5\x2e\x33\x4d\x14\x93\xf6\xd1\x65\x63\x61\xda\x74\xfa\x41\x49\x55\x9e\x30\xa1\x74\x10\xe3\x41\xd0\xef\xa7\xc7\x41\x92\x42\x1e\x64\x34\x89\x83\xfc\x24\x3c\xc9\x33\x9c\x46\x18\x06\x9b\xe1\x99\x9f\x71\x19\xd0\x0d\x3c\x37\x47\x7d\xbb\x9a\x25\x8d\x6a\x3b\x9f\x88\xa4\x34\x08\x87\xb6\x5f\x2b\x15\x4c\x19\xdc\x1e\x06\x51\xde\x1b\x8b\x96\x79\x5d\x77\x5f\x09\x6e\x14\xcb\x4a\x23\xfe\xcd\xd0\x49\xe8\x70\x90\x0d\xd3\x2c\x88\x68\x92\x07\xc9\x20\x1d\x04\x38\x1e\x46\x01\x39\x19\xa4\xfd\x84\xc6\x51\xbc\x17\x74\x9a\xba\xce\x2f\x00\x3a\xf3\x56\xf2\xa3\x45\xdd\x77\xbd\x5e\x5b\x90\x3d\xdf\x5d\x43\xe0\x7a\x42\xb6\xd4\xfa\x5a\x93\xba\xd1\x70\xe7\x29\x75\xf1\xc1\x96\x3b\x57\xb2\x0e\xfa\x5d\x50\xbf\xc5\xdf\x9e\x54\x62\xca\x28\x28\x7d\x78\x36\x1c\x36\x68\x3f\x95\x51\xc7\x19\x1d\x12\x9a\xa6\x41\x0e\xc9\x71\x90\xc4\xd1\x49\x30\xec\xa7\x59\x90\x0f\x07\x49\x7f\x00\xd1\x71\x72\x1c\xbe\xec\x62\x7c\x90\x4b\xfc\x8f\xe7\xd4\x69\xee\x56\xed\x4e\xf3\x7a\xe9\xdd\xe7\x8d\xd1\xfe\x95\xa0\xfe\x1e\xc9\xf3\xdd\x03\xfa\xd5\xe9\x2c\x7e\xef\xce\x3f\x8c\x5c\xfb\xa2\xea\xe9\xdc\xaf\x7b\xf8\xf3\x08\xff\xdc\xb1\xf9\xa9\x05\x21\x83\x1c\x72\x1c\x46\x41\x8c\xe3\x61\x90\x44\xc3\x41\x90\xf6\x71\x1a\xc4\x83\x38\xcf\xfb\x7d\x02\xfd\x28\x79\xd9\x3f\xb1\x07\x29\x08\xcf\x9f\xf3\xb6\x82\xe1\xb8\xae\xeb\xde\x38\xf7\xce\x9f\x03\x00\x3a\x8e\x51\x0e\x64\x20\x00\x00")
 
 func rpDevelopmentJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -211,7 +211,7 @@ func rpDevelopmentJson() (*asset, error) {
 	return a, nil
 }
 
-var _rpProductionGlobalAcrReplicationJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\x4f\x6b\xe3\x30\x10\xc5\xef\xfe\x14\x42\xbb\x60\x1b\xfc\x37\xb0\x97\x5c\xf7\x94\xc3\xb2\x10\x4a\x2f\x21\x87\xa9\x3c\x49\x54\x6c\x49\x68\x26\x6d\xd3\x92\xef\x5e\x14\xe5\x8f\x93\x86\x52\x0c\x46\xe8\x3d\xff\x9e\xde\x58\x1f\x89\x10\x42\xc8\xdf\xa4\x36\x38\x80\x9c\x0a\xb9\x61\x76\x34\xad\xeb\xb8\x53\x0d\x60\x60\x8d\x03\x1a\xae\xe0\x7d\xeb\xb1\x52\x76\x38\x6a\x54\x4f\x9a\xf6\x4f\xd9\xb4\x65\xd3\xd6\x1d\xba\xde\xee\x82\xef\x01\x07\xd7\x03\x63\xf5\x4c\xd6\xfc\x92\x45\x4c\x50\xd6\x30\x1a\x7e\x44\x4f\xda\x9a\x10\xd4\x56\x4d\x78\x4e\x06\x07\x1e\x06\x64\xf4\x24\xa7\x22\x1e\xeb\xb0\x0f\xca\xcf\x91\xec\xd6\x2b\x9c\x75\x57\xd2\x41\xe6\x9d\xc3\x40\x23\xf6\xda\xac\xe5\x59\xdc\x17\x17\x44\x6f\x15\x70\x4c\xfd\xe9\xd7\xc9\x88\x21\xfd\x31\x3f\x9c\x6c\x71\xf6\xdc\xa0\x0c\x0c\x07\xd4\x42\x59\xa3\x80\x33\xda\x3e\x45\x6a\x76\x69\x96\xa5\x57\x6d\xd2\xbc\x10\xd0\x75\x59\x0f\xc4\x33\xd3\xe1\xdb\xff\xd5\xf7\xe6\xb4\x0e\xef\x36\x8f\xcb\x42\x8c\xcd\xa7\x92\x69\x9e\x2f\x65\x71\xbf\xe6\x3f\xad\xbc\x25\xbb\xe2\xea\xaf\x35\x0c\xda\xa0\x9f\xe3\x5a\x13\xfb\x5d\xed\xe3\x42\x23\xd5\x1e\x5d\xaf\x23\x8d\x6e\x51\xa3\x59\xca\xc5\xfd\xfc\x2f\xf1\xe0\xf4\xe8\xbf\x4f\x9a\x49\x53\xb6\xe1\xd2\x94\xce\xe3\x8b\xc6\xd7\xdb\xb9\x2f\x93\x7d\xf2\x19\x00\x00\xff\xff\xa4\x6a\xad\x47\x99\x02\x00\x00")
+var _rpProductionGlobalAcrReplicationJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\xcd\x4a\xc3\x40\x10\xbe\xe7\x29\x96\x55\x48\x02\xf9\x2d\x78\xe9\xd5\x53\x0f\x22\x14\xf1\x52\x7a\x18\x37\xd3\x76\x25\xd9\x5d\x76\xa6\x6a\x95\xbe\xbb\xac\xdb\xd6\xb4\x8a\xc8\x84\x30\x64\xbe\x7c\x3f\xb3\xfb\x91\x08\x21\x84\xbc\x26\xb5\xc1\x01\xe4\x54\xc8\x0d\xb3\xa3\x69\x5d\xc7\x2f\xd5\x00\x06\xd6\x38\xa0\xe1\x0a\xde\xb7\x1e\x2b\x65\x87\xc3\x8c\xea\x49\xd3\xde\x94\x4d\x5b\x36\x6d\xdd\xa1\xeb\xed\x2e\xe0\x1e\x70\x70\x3d\x30\x56\xcf\x64\xcd\x95\x2c\xa2\x82\xb2\x86\xd1\xf0\x23\x7a\xd2\xd6\x04\xa1\xb6\x6a\x42\x1d\x01\x0e\x3c\x0c\xc8\xe8\x49\x4e\x45\xb4\x15\x4a\x82\xf2\x73\x24\xbb\xf5\x0a\x67\xdd\xd9\x28\x3c\x92\x77\x0e\x03\x1b\xb1\xd7\x66\x2d\x4f\xc3\x7d\x71\x6a\x65\x6f\x15\x70\x54\xfd\xef\xdf\xc9\x88\x43\xfa\x83\x7e\x70\xb6\x38\x61\x2e\xa8\x0c\x0c\x5f\x54\x0b\x65\x8d\x02\xce\x68\xfb\x14\x3d\x65\xdf\xc9\xb2\xf4\x2c\x4d\x9a\x17\x02\xba\x2e\xeb\x81\x78\x66\x3a\x7c\xbb\x5f\xfd\x0d\x4e\xeb\xf0\x6e\xf3\xd8\x16\x62\x0c\x3e\x86\x4c\xf3\x7c\x29\x8b\x73\x6f\xc7\x98\x77\x5a\x79\x4b\x76\xc5\xd5\xad\x35\x0c\xda\xa0\x9f\xe3\x5a\x13\xfb\x5d\xed\x63\xa3\x91\x6a\x8f\xae\xd7\x91\x8d\x2e\xa9\x46\xbb\x94\x8b\xdf\xf5\x7f\xc8\x83\xd3\xa3\x73\x9f\x34\x93\xa6\x6c\xc3\xa5\x29\x9d\xc7\x17\x8d\xaf\x97\x7b\x5f\x26\xfb\xe4\x73\x00\xa4\x6a\xad\x47\x99\x02\x00\x00")
 
 func rpProductionGlobalAcrReplicationJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -231,7 +231,7 @@ func rpProductionGlobalAcrReplicationJson() (*asset, error) {
 	return a, nil
 }
 
-var _rpProductionGlobalSubscriptionJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x94\xcf\x6e\xdb\x30\x0c\xc6\xef\x79\x0a\xc3\xdb\x61\x03\x66\xcb\xce\xbf\x25\xb9\x15\xdd\xb5\x1b\xd0\x16\xbb\x14\x39\x30\x36\x9b\x70\xb3\x25\x81\xa4\x5b\xa4\x45\xde\x7d\x90\x9d\xa5\x69\x92\xee\x30\xa4\xf2\x45\xa6\xc8\xef\xfb\x81\xa6\xf5\xdc\x8b\xa2\x28\x8a\x3f\x4a\xb1\xc2\x1a\xe2\x59\x14\xaf\x54\xbd\xcc\x8c\xe9\x22\x69\x0d\x16\x96\x58\xa3\xd5\x14\x9e\x1a\xc6\xb4\x70\xf5\xf6\x4c\x4c\x3f\xcb\x47\x49\x96\x27\x59\x6e\x4a\xf4\x95\x5b\x87\xbc\x5b\xac\x7d\x05\x8a\xe9\x2f\x71\xf6\x43\xfc\xa5\x73\x28\x9c\x55\xb4\xfa\x13\x59\xc8\xd9\x60\x94\xa7\x59\x78\xfe\x26\x30\x8a\x6b\xb8\x40\x89\x67\xd1\x5d\x1b\x0a\xeb\x79\xb7\x6b\x93\x2c\xd4\x18\x6a\x87\x93\xe9\x64\x30\x1a\x0c\x93\x41\x99\x8d\x93\x61\x59\x2c\x12\x18\x8d\xc7\x49\x36\x81\xf1\x74\x88\x8b\xbc\xff\x75\xba\x15\xde\xd5\xea\xda\xb7\xb5\x57\x54\xb0\x13\x77\xaf\xe9\x45\xa3\x2b\xc7\xf4\x04\x4a\xce\x1a\x76\x15\x7e\xc3\x7b\xb2\x14\x5e\xe5\xb0\xdc\xb3\xf3\xc8\x4a\x2d\xe0\x6b\xac\x8e\xdf\x55\xf8\x7d\x8b\x77\x71\xfd\x23\x7a\x18\x46\x97\xce\x2a\x90\x45\xbe\xc6\x25\x89\xf2\x3a\xba\x75\xbf\xd1\xb6\x71\xa6\x45\xa3\x8e\x0f\x5c\x3a\x27\xe4\x9a\x44\x5a\x88\xfd\x5e\xec\xaf\x63\x80\x5d\x39\x14\xfa\xcf\xd2\x5d\xe2\x4b\x23\x8e\x40\x0d\x77\x1b\x42\x31\x4b\xb4\xc8\xa0\x78\xc9\x58\xa2\x55\x82\x4a\x4c\xe7\x71\x02\xfe\x7f\x0c\xa4\x70\x1e\xaf\xc0\x8b\x61\x84\xf2\x4c\xa2\x1a\x3a\x2d\xa6\xc4\x0a\x15\xcf\xab\x19\xe6\xa0\x1d\x99\x1b\x05\x6d\x04\xdf\x83\xfb\xfc\x8a\x8f\x4c\x8a\xf1\x9b\x8a\xf3\x93\x27\x9b\xa3\xe8\xfc\xc4\xc0\x82\x08\x2d\x2d\x2c\x2a\xbc\x09\x9f\xf2\xed\xd1\x8b\xef\xa4\x59\x48\xc1\xe4\x43\xfb\x3e\x7d\x4e\xa9\x9c\x1f\x23\xbd\x46\xd9\x1c\xfc\x87\xe0\x69\xef\x12\xe9\x67\xf9\xa4\xbb\x81\x12\xcf\xf8\x40\xf8\xf8\xa2\xd7\xc1\xcf\x7b\x9b\xde\x9f\x00\x00\x00\xff\xff\x30\xe9\xb0\x3d\xe6\x04\x00\x00")
+var _rpProductionGlobalSubscriptionJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x54\x4d\x6f\xdb\x30\x0c\xbd\xe7\x57\x08\xda\x0e\x1b\x50\x5b\x76\xbe\x96\xe4\x56\x74\xd7\x6e\x40\x5b\xec\x52\xe4\x20\xdb\x6c\xc2\xcd\x96\x04\x92\x6e\x91\x16\xf9\xef\x83\xec\x66\x4b\x9a\xb6\x87\x21\x23\x75\x90\x29\x3d\xbe\x87\x67\x42\x4f\x03\xa5\x94\xd2\x1f\xb9\x5c\x43\x63\xf5\x42\xe9\xb5\x48\xe0\x85\x31\x7d\x25\x6d\xac\xb3\x2b\x68\xc0\x49\x6a\x1f\x5b\x82\xb4\xf4\xcd\xf3\x19\x9b\x61\x96\x4f\x92\x2c\x4f\xb2\xdc\x54\x10\x6a\xbf\x89\xf7\x6e\xa0\x09\xb5\x15\x48\x7f\xb2\x77\x1f\xf4\x59\xcf\x50\x7a\x27\xe0\xe4\x07\x10\xa3\x77\x91\x28\x4f\xb3\x98\xbb\x0b\x04\xec\x5b\x2a\x81\xf5\x42\xdd\x76\xa5\xb8\x9e\xfe\xec\x62\x6a\x67\x1b\x88\xd8\xf1\x6c\x3e\x1b\x4d\x46\xe3\x64\x54\x65\xd3\x64\x5c\x95\x45\x62\x27\xd3\x69\x92\xcd\xec\x74\x3e\x86\x22\x1f\x7e\x99\xeb\xb3\x43\xac\x6c\x42\x87\xbd\xc4\x92\x3c\xfb\x3b\x49\xcf\x5b\x59\x7b\xc2\x47\x2b\xe8\x9d\x21\x5f\xc3\x57\xb8\x43\x87\xf1\x93\x5f\xc2\x03\xf9\x00\x24\xd8\x09\x3c\x94\x15\x53\x47\xf8\xb7\x67\x79\xe7\x57\xdf\xd5\xfd\x58\x5d\x78\x27\x16\x1d\xd0\x15\xac\x90\x85\x36\xea\xc6\xff\x02\xd7\xd5\x09\x8b\x56\x3c\xbd\x60\x89\x4b\x07\xa0\x06\x39\xda\x74\xe8\xc5\x7e\x1c\x0b\xd8\x85\xb6\xa5\xbc\x0b\xdd\xc5\x9e\x11\x47\x42\x0d\xf5\x1b\x04\x36\x2b\x70\x40\x56\xe0\x82\xa0\x02\x27\x68\x6b\x36\x3d\xc7\x2b\xe2\xff\x85\x80\x4b\x1f\xe0\xd2\x06\x36\x04\xb6\x3a\x51\x53\x89\x4e\xb3\xa9\xa0\x06\x81\xd3\xf6\x8c\x73\xd0\x8d\xcc\xb5\x58\x69\x19\xfe\x87\xee\xd3\x77\x7c\x20\x14\xd0\x6f\x76\x5c\xbe\x7a\xb2\x3d\xaa\x2e\x8f\x45\x69\xcb\x8c\x2b\x67\x8b\x1a\xae\xe3\xaf\x7c\x7b\xf4\xf4\x2d\xb7\x05\x97\x84\x21\xda\xf7\xe9\x73\x8a\xd5\x52\x0f\xde\x97\xb2\x3d\x24\xd4\x36\xe0\xde\x23\x32\xcc\xf2\x59\xff\x02\x25\x81\xe0\x1e\xe1\xe1\x6f\xbf\xed\x40\x29\xa5\x96\x83\xed\xe0\xf7\x00\x30\xe9\xb0\x3d\xe6\x04\x00\x00")
 
 func rpProductionGlobalSubscriptionJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -251,7 +251,7 @@ func rpProductionGlobalSubscriptionJson() (*asset, error) {
 	return a, nil
 }
 
-var _rpProductionGlobalJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x6d\x6f\xdb\x36\x10\xfe\x9e\x5f\x21\x68\x03\x64\x03\x96\x25\xf9\x2d\x76\xbe\x79\x2b\x36\x14\xe8\x1a\xc3\x09\xfa\x61\x41\x31\x50\xe4\xd9\xe1\x42\x91\xda\x91\x72\x96\x16\xf9\xef\x83\x5e\xec\x5a\x2f\x96\xbd\x34\x19\xba\x22\x08\x20\x28\xe4\xe9\xf8\xdc\xdd\x73\x0f\x49\x7f\x3e\xb3\x2c\xcb\xb2\x7f\xd4\xf4\x16\x22\x62\x5f\x58\xf6\xad\x31\xb1\xbe\xf0\xbc\x7c\xa4\x1f\x11\x49\xd6\x10\x81\x34\x7d\xf2\x29\x41\xe8\x53\x15\x15\x73\xda\x1b\xf8\xc1\xd8\xf5\x03\xd7\x0f\x3c\x06\xb1\x50\x0f\xa9\xdd\x35\x44\xb1\x20\x06\xfa\x7f\x6a\x25\x7f\xb0\x7b\xf9\x0a\x54\x49\x03\xd2\x7c\x00\xd4\x5c\xc9\x74\xa1\xa0\xef\xa7\x7f\x5b\x83\x98\x20\x89\xc0\x00\x6a\xfb\xc2\xca\x61\x65\xe3\x84\xe2\x3b\x45\x89\xe1\x4a\x5e\x6e\x00\x91\x33\x28\x19\x64\x46\xe6\x21\x4e\x47\x6d\x6d\x90\xcb\x75\xe1\x72\x37\xcb\x60\x45\x12\x61\x3e\x10\x91\x64\x56\xf6\x6e\xfa\xb1\x57\x5a\x68\x09\x5a\x25\x48\xe1\x2d\x3b\xba\x44\xa3\x0b\x2a\x12\x6d\x00\x17\x04\x41\x9a\x37\x2a\x22\x5c\xbe\x27\xd1\x71\xbc\x8d\xce\x56\xf1\x15\xe0\x86\x53\x58\x20\x97\x94\xc7\x44\x3c\x15\x16\xc6\xcf\x83\x08\x9f\x11\x51\x41\x84\x2b\xa3\x90\xac\x61\x4e\xa9\x4a\xa4\xf9\x97\xd0\xce\xf6\xdc\xda\x58\xd4\x2e\xa5\xcf\xcd\xce\xa6\xe2\x4a\xdf\x25\x35\xff\xd9\x84\xcc\x57\xb6\x17\x08\x11\x4f\x22\xbb\x64\xf1\x58\xe1\xd3\xd6\xf8\x46\x27\x61\x0e\xaa\xf3\x85\xbd\x1d\xa7\x44\x24\xa7\xdb\xb3\x08\x63\x1d\x41\xb4\x79\x2b\x19\xfc\x7d\xb9\x6a\x37\x76\xbc\xf4\x19\x74\xbb\x1f\xab\x34\xde\xe6\xe1\x37\x4e\x51\x69\xb5\x32\xfd\x9f\x95\x34\x84\x4b\xc0\x25\xac\xb9\x36\xf8\xe0\x61\xfe\xc2\x41\x57\xbf\x16\x45\x13\x65\xc0\xf9\xaa\x03\x7f\x25\x44\xe8\x2a\x96\x6a\xab\x65\x88\xd2\xc7\x36\xbd\xbf\xa2\x4a\xe2\x4e\xb7\xbf\x75\xd7\xb3\x8e\x7a\xa8\x47\x42\x62\xbe\x27\x04\x03\x7f\xe0\xbb\x41\xaa\x22\x6e\x8c\xb0\xe1\x70\xdf\x48\x9a\x4a\x29\x63\x54\x31\xa0\xe1\x59\xc1\x3f\x1f\x2c\xd1\x3e\xba\x7a\x1f\x38\xa7\x64\xf9\x3d\x98\x7b\x85\x77\x1e\x93\xfa\x77\x25\xdb\x33\xbb\x16\x2a\x24\xe2\x58\xbc\xc1\xd4\xf5\x53\xe1\x7c\xa1\x38\x0f\xc8\xd0\xff\x20\xd8\x5d\x38\x54\x49\x4a\x4c\xe7\x45\x1b\x2c\x7b\x4d\x1f\x5f\xa2\x9f\x27\xe6\x56\x21\xff\x94\x85\x98\x4e\xae\x13\xce\x3a\x05\x98\x56\xaf\x65\x9e\xd5\x75\x32\x5b\x79\xb9\xb0\x3c\x6b\x4e\x71\x91\x08\xe1\x74\xbf\xba\xc5\xbd\x18\xd5\x86\x33\x40\xed\xa1\x12\x30\xd7\x9a\xaf\x65\xba\x05\xd7\xaa\x56\x26\x51\x5d\xfe\x34\x55\xf9\xc2\x37\xb8\x8b\xaa\xe3\x9c\x86\xc2\xe9\x59\x2f\x5a\xa6\x5a\x96\x32\xc4\x69\xc0\x6f\x60\xc5\x25\x4f\x4b\x95\xed\x43\xb9\x1e\x53\xe4\x71\x3a\xb4\x6c\x0c\xa4\x5c\xe0\xb2\x93\x34\x12\xe7\x7c\x35\x1b\x07\x8c\x11\x77\x04\x6c\xe8\x8e\x26\x53\xdf\x25\xe7\x94\xb8\xa3\xe1\x0a\x82\xf3\x01\x1b\x0f\xa7\xac\xde\x48\x45\x92\xf7\x77\xc5\xaa\xf4\x34\x51\xa2\xdd\xcd\x75\x41\x86\xea\x97\xed\x9b\x53\x53\xfb\xcd\xf6\xb5\xb5\x76\x38\x8a\x41\x32\x7d\x29\x4b\xfb\xe6\x6e\xfa\xdb\x24\x44\x09\xe7\xc7\xef\x5a\x58\x9c\x5f\x32\xd1\x58\x5e\x5a\x9b\x91\x55\x4b\xbb\x75\xad\xee\x40\x66\xe3\xc8\xc3\xc4\x28\x7c\xd5\x95\x6f\x52\x57\x46\xd3\xd9\x74\x38\x1e\x8e\xdc\x21\xf3\x27\xee\x88\xd1\xd0\x25\xe3\xc9\xc4\xf5\xa7\x64\x32\x1b\x41\x18\x0c\xce\x67\x4f\xd0\x95\xa6\x4b\xc2\xab\xae\xfc\x77\xba\x72\xf4\x42\x71\x65\x88\x64\x04\xd9\x1f\xef\x96\x57\xed\x09\x3e\xd6\x4b\x44\x08\x75\xff\x93\x50\xe1\x22\x09\x05\xa7\x73\x4a\x41\xa7\xa6\x06\x13\x68\x75\x5c\x3a\xf9\x1f\x3a\xc7\xd7\x24\xe3\xc0\x09\xfa\xe0\xbd\xed\xa4\xd3\x65\xf1\x99\xa7\x4b\x9f\xd7\x64\xa5\xc6\xb5\x99\xeb\x8f\x9e\x74\x5e\x6e\x68\x83\x72\xf6\xec\x1d\xe3\x9a\x7a\x26\x02\x43\x18\x31\xc4\xbe\xb0\x64\x22\xc4\x69\xb7\xc2\x06\x55\x6f\xcd\x5b\x4a\xc1\xe2\x27\x0a\x0f\xe3\x4d\x6e\xf8\x35\xe9\xf4\x42\xa1\xc2\xa2\xbb\xb5\x47\xb7\x01\x9e\x9e\xe6\x67\x69\xe5\x03\xe0\x9c\xea\x59\xb9\x25\x33\x2d\x1d\x79\x96\xff\xff\x78\xf6\x4f\x00\x00\x00\xff\xff\x0a\x32\xa4\x93\xc2\x12\x00\x00")
+var _rpProductionGlobalJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\xdd\x6e\xe3\x36\x13\xbd\xf7\x53\x10\xfc\x3e\x40\x0e\x20\x59\x52\xec\x24\x76\xee\xdc\x2e\x5a\x04\xd8\x6e\x0c\x27\xd8\x8b\x1a\x41\x41\x93\x63\x87\x0d\x45\xaa\x43\xca\x69\x76\xb1\xef\x5e\xd0\x96\x63\x4b\xfe\x6d\x36\x41\xb7\xc5\xda\x84\x60\x88\xc3\xe1\xcc\x99\x39\x87\xb2\x3e\x37\x08\x21\x84\xfe\xdf\xf2\x7b\xc8\x18\xbd\x24\xf4\xde\xb9\xdc\x5e\xc6\xf1\xe2\x4e\x2b\x63\x9a\x4d\x21\x03\xed\x5a\xec\x53\x81\xd0\xe2\x26\x2b\xe7\x6c\x7c\x9a\xa4\x67\x51\x92\x46\x49\x1a\x0b\xc8\x95\x79\xf2\x76\xb7\x90\xe5\x8a\x39\x68\xfd\x6e\x8d\xfe\x1f\x0d\x17\x3b\x70\xa3\x1d\x68\xf7\x11\xd0\x4a\xa3\xfd\x46\x69\x2b\xf1\xdf\xa5\x41\xce\x90\x65\xe0\x00\x2d\xbd\x24\x8b\xb0\xfc\x97\x32\x8e\xef\x0d\x67\x4e\x1a\x7d\x3d\x03\x44\x29\xa0\x62\xe0\x07\x75\x4f\xb9\xbf\x4b\xad\x43\xa9\xa7\x34\xac\xce\x0a\x98\xb0\x42\xb9\x8f\x4c\x15\x73\x2b\xfa\x3c\xfd\x65\x65\xe9\x37\x1a\x82\x35\x05\x72\xb8\x12\x07\xb7\xd8\xea\x82\xab\xc2\x3a\xc0\x01\x43\xd0\xee\x9d\xc9\x98\xd4\x1f\x58\x06\x2f\x73\x36\xc9\x6f\x00\x67\x92\xc3\x00\xa5\xe6\x32\x67\xea\xa5\x61\x61\xfe\x3a\x11\xe1\x2b\x46\x54\x36\xc2\x8d\x33\xc8\xa6\xd0\xe7\xdc\x14\xda\xfd\xcd\xd0\x1a\x6b\x6e\x29\x96\xb5\xf3\xed\x33\x7a\xb6\xa9\xb9\xb2\x0f\xc5\x86\x7f\x3f\xa8\x5e\xec\x4c\x07\x08\x99\x2c\xb2\xd5\x26\xb5\xc8\x2b\xc6\x23\x5b\x8c\x17\x41\x35\x57\xdd\xdb\x0c\x2a\x8d\x14\x9c\x84\x84\x09\xd1\x54\xcc\xba\x2b\x2d\xe0\xcf\xeb\xc9\x7e\xe3\x20\xf6\xd7\xf4\xe4\xe4\x8e\x86\xdb\x71\xf8\x45\x72\x34\xd6\x4c\x5c\xeb\x47\xa3\x1d\x93\x1a\x70\x08\x53\x69\x1d\x3e\xc5\xb8\xf8\x21\xc1\xd6\x57\xab\x92\x44\xde\xc3\x48\x4e\x9a\xf0\x47\xc1\x94\xad\xc7\x52\xa7\x9a\x8f\x25\xf0\x97\x25\xbc\x3f\xa3\x29\xf2\xe6\x49\x6b\xe9\x2e\x24\x07\x3d\x6c\x66\xc2\x72\xb9\x26\x04\xa7\xc9\x69\x12\xa5\x5e\x45\xa2\x1c\x61\x26\xe1\x71\xad\xc6\xe1\xae\x52\xe6\x68\x72\x40\xe7\x33\xbd\x24\x9f\x77\x96\x68\x3d\xba\x4d\x1e\x04\xc7\xa0\xfc\x01\xdc\xa3\xc1\x87\x58\x68\xfb\xab\xd1\xfb\x91\x9d\x2a\x33\x66\xea\x50\xbe\x69\x37\x4a\xbc\x70\xbe\x51\x9e\x3b\x64\xe8\x5f\x90\xec\x73\x3a\xdc\x68\xce\x5c\xf3\x4d\x09\x36\xff\xe9\x2f\xab\xec\xfb\x85\xbb\x37\x28\x3f\xcd\x53\x8c\x83\x90\x4c\x0b\x29\x9a\x65\x30\x7b\xbd\xae\x4f\x6e\xd3\x49\xbf\x67\x30\x1c\x90\x98\xf4\x39\x0e\x0a\xa5\x82\x93\xaf\xa6\x78\x9c\xa3\x99\x49\x01\x68\x63\x34\x0a\xfa\xd6\xca\xa9\xf6\x47\xf0\x46\xd5\xaa\x4d\x54\x99\xf3\x83\x5a\x6e\x16\x5d\x3f\x5a\xf2\xfc\x4a\x34\x83\xe3\xa2\x08\x42\xf2\xa6\x65\xda\x40\xc9\x0f\xea\x13\x7e\x07\x13\xa9\xa5\x2f\xd5\xfc\x64\x5c\xe8\x31\x47\x99\xfb\x5b\xc3\xad\x89\x54\x0b\x5c\x75\x62\x83\x90\x04\x17\x93\xde\x59\x2a\x04\x8b\x3a\x20\xda\x51\xe7\xbc\x9b\x44\xec\x82\xb3\xa8\xd3\x9e\x40\x7a\x71\x2a\xce\xda\x5d\xb1\x49\xa4\x12\xe4\xf5\x53\x91\x8e\x0e\xb7\xc4\x7e\x37\xb7\x65\x33\xd4\x57\xae\x88\x54\x23\xd3\x4e\xfa\xf5\xd6\xb5\xb5\x66\x2f\x20\x07\x2d\xec\xb5\xae\x9c\x9b\xcb\xcf\x37\xda\x10\x95\x38\xef\x1a\x5b\xb0\xf8\xcf\x08\x4b\xf0\xd3\x5c\x34\x86\xd7\x64\xd6\x21\x1b\xb0\x93\x5b\xf3\x00\x7a\x7e\x1f\xe5\xb8\x70\x06\xbf\xeb\xca\x37\xa9\x2b\x9d\x6e\xaf\xdb\x3e\x6b\x77\xa2\xb6\x48\xce\xa3\x8e\xe0\xe3\x88\x9d\x9d\x9f\x47\x49\x97\x9d\xf7\x3a\x30\x4e\x4f\x2f\x7a\x2f\xd0\x95\xc9\x77\x5d\xf9\x47\x75\xe5\xe0\x1f\x8a\x1b\xc7\xb4\x60\x28\x7e\x7b\x3f\xbc\xd9\x0f\xf0\x21\x2e\x31\xa5\xcc\xe3\x0f\xca\x8c\x07\xc5\x58\x49\xde\xe7\x1c\xac\xa7\x9d\xc3\x02\xf6\x3a\x5e\x7f\x64\x1b\xed\x7a\x8e\xaf\xb7\xcc\x4a\x31\xd7\xc1\xdb\xf3\xbf\x2d\x38\x46\x75\xca\x65\xb1\xad\x2c\xb7\xf4\x50\xaf\xf5\xa2\xa4\xf3\xa2\xe7\xe5\xca\x9c\x1f\x34\xaf\xa2\x47\x9f\x3b\xae\x16\x84\x1f\x34\x03\xc7\x04\x73\xfe\xc5\x88\x2e\x94\xda\x0b\x73\xfd\x88\x39\x1a\x37\xaf\x49\xe5\x2b\x8a\x18\xf3\xd9\x82\x64\x5f\x03\x67\x3c\x56\x66\x5c\xb2\xdb\xc6\x7c\x99\xe0\xf1\x30\xbf\x0a\x95\x77\x04\x17\x84\xe4\x68\x64\xf6\x30\xb2\x41\x08\x21\x77\x8d\x2f\x8d\xbf\x06\x00\x0a\x32\xa4\x93\xc2\x12\x00\x00")
 
 func rpProductionGlobalJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -271,7 +271,7 @@ func rpProductionGlobalJson() (*asset, error) {
 	return a, nil
 }
 
-var _rpProductionManagedIdentityJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\x41\x4b\x03\x31\x10\x85\xef\xfd\x15\x21\x0a\x6d\xa1\xc9\x26\x15\x41\x7a\xf3\x24\x1e\x7a\x13\x2f\xa5\x87\x90\x8e\x6d\xa4\xc9\x84\xcc\xec\x61\x2d\xfd\xef\xb2\xe9\xee\x22\x8a\xc9\x65\x78\xf3\xde\x37\xf0\x2e\x33\x21\x84\x90\xf7\xe4\x4f\x10\x9d\xdc\x08\x79\x62\xce\xb4\x69\x9a\x9b\xa2\xa3\x4b\xee\x08\x11\x12\x6b\xf7\xd5\x16\xd0\x1e\xe3\xb0\xa3\x66\x6d\xec\xa3\x32\x56\x19\xdb\x1c\x20\x9f\xb1\xeb\x7d\x6f\x10\xf3\xd9\x31\xe8\x4f\xc2\x74\x27\x57\xb7\x0b\x1e\x13\x43\xe2\x77\x28\x14\x30\xf5\x87\xac\x36\xfd\x1f\x0d\x05\x08\xdb\xe2\x81\xe4\x46\xec\xaa\xd4\xbf\xcb\x34\x55\xd3\x19\xbd\xe3\x21\xbf\x1b\x13\x2f\x05\xdb\xbc\x58\xea\x71\xb9\x1f\x90\x53\x2a\xb9\x08\x35\xe1\x31\x79\xc7\x8b\xb9\x2b\xa8\x4a\x56\xf3\x95\xf8\x8f\xb1\xfc\x03\xe1\x2e\x57\xc8\x36\xf8\x82\x84\x1f\xac\xb7\xb5\x9a\xc3\xeb\x01\x12\x07\xee\x9a\x96\xa0\x3c\x13\x85\x63\x9a\xc4\x00\xf4\x9b\xe3\x72\xf8\x51\xc2\xda\xd8\x27\x65\xad\x7a\x30\x72\xb2\x5d\xeb\xb4\x9f\x5d\x67\xdf\x01\x00\x00\xff\xff\x2c\x44\xd2\x17\x9e\x01\x00\x00")
+var _rpProductionManagedIdentityJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\xbf\x6a\x33\x31\x10\xc4\xfb\x7b\x0a\xa1\xef\x03\xdb\x60\xe9\x24\x87\x40\x70\x97\x2a\xa4\x70\x17\xd2\x18\x17\x42\xde\xd8\x0a\x96\x56\x68\xf7\x8a\x8b\xf1\xbb\x07\x9d\x7d\x47\xfe\x10\x56\x85\x98\x9d\xf9\x0d\xec\xb9\x11\x42\x08\xf9\x9f\xfc\x11\xa2\x93\x6b\x21\x8f\xcc\x99\xd6\x6d\x7b\x55\x74\x74\xc9\x1d\x20\x42\x62\xed\x3e\xba\x02\xda\x63\xbc\xed\xa8\x5d\x19\x7b\xaf\x8c\x55\xc6\xb6\x7b\xc8\x27\xec\xab\xef\x05\x62\x3e\x39\x06\xfd\x4e\x98\xfe\xc9\xe5\xb5\xc1\x63\x62\x48\xfc\x0a\x85\x02\xa6\x5a\x64\xb5\xa9\x33\x1a\x0a\x10\x76\xc5\x03\xc9\xb5\xd8\x0e\x52\x7d\xe7\xe9\x57\x47\x9e\xd0\x3b\xbe\xe5\xb7\x63\xe2\xa9\x60\x97\xe7\x0b\x3d\x2e\x77\x72\xf9\x3d\x95\x5c\x84\xda\xb8\xf5\x98\xbc\xe3\xf9\xcc\x15\x54\x25\xab\xd9\x52\xfc\xc5\x58\xfc\x82\x70\x9f\x07\xc8\x26\xf8\x82\x84\x6f\xac\x37\xc3\x69\xf6\xcf\x7b\x48\x1c\xb8\x6f\x3b\x82\xf2\x48\x14\x0e\x69\x12\x03\xd0\x4f\x8e\xcb\xe1\xcb\x11\x56\xc6\x3e\x28\x6b\xd5\x9d\x91\x93\xed\xd2\x08\x21\xc4\xae\xb9\x34\x9f\x03\x00\x2c\x44\xd2\x17\x9e\x01\x00\x00")
 
 func rpProductionManagedIdentityJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -291,7 +291,7 @@ func rpProductionManagedIdentityJson() (*asset, error) {
 	return a, nil
 }
 
-var _rpProductionParametersJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x95\x4d\x6f\x1a\x3d\x10\x80\xef\xfc\x8a\xd5\xbe\xef\x31\xe1\x2b\xea\x85\x1b\x59\x48\x8b\xaa\x56\xab\xa2\xe6\x1a\x0d\xf6\x00\x6e\xfd\xa5\x99\xf1\x2a\xa4\xca\x7f\xaf\x60\x4b\xda\x48\x25\xea\x1a\x84\xc4\xc1\xf6\xf3\xcc\x68\x3c\x9e\xfd\xd1\x2b\x8a\xa2\x28\xff\x67\xb5\x45\x07\xe5\xa4\x28\xb7\x22\x91\x27\x83\x41\xbb\xd2\x77\xe0\x61\x83\x0e\xbd\xf4\xe1\x29\x11\xf6\x55\x70\xbf\xf6\x78\x30\x1e\x8e\xde\x5d\x0f\x47\xd7\xc3\xd1\x40\x63\xb4\x61\xb7\x3f\x57\x03\x81\x43\x41\xe2\xfe\x37\x0e\xfe\xbf\xf2\xaa\x8d\xa1\x82\x17\xf4\x72\x8f\xc4\x26\xf8\x7d\xa8\x51\x7f\xb8\xff\x1d\x0f\xc4\x17\xb0\x9c\x14\x6d\x62\x87\x75\x50\xf4\x05\x39\x24\x52\xb8\xd0\xaf\xb6\x0e\xdb\x0d\xd8\x84\x7b\x5d\xf9\xb2\xfe\x7c\xf5\x07\xad\x9d\xf1\xd3\x68\x2a\xb8\x4d\x5e\x5b\xcc\x17\x58\x83\x5e\x2a\x24\xa9\x82\x73\xc1\x7f\x06\x97\x21\x23\x77\x56\x2e\x2d\x7e\xa1\x4c\x5a\x4f\x46\x4d\x57\xc6\x5a\xe3\x37\xf3\xf1\x7c\x29\x81\x60\x83\x53\xa5\x42\x3a\x47\xb5\x44\x6a\x8c\xc2\x9a\x8c\x57\x26\x82\xcd\x30\x29\x9b\x58\x90\x3e\x69\xd6\x55\xf0\x6b\xb3\xf9\xdd\x69\x59\xa2\x1a\x08\xbd\xcc\x82\x03\x93\x57\x61\x0d\x02\x2b\xe0\x63\x71\xb2\x1c\xe8\x15\xed\xa2\x98\xe0\xa7\xf2\x21\xb0\x74\x17\x3c\x0a\x41\x15\xd8\x05\x9e\xdd\x2e\x6a\xee\x2c\x58\xc7\xec\x36\x59\xc7\x0b\x5c\xeb\x77\xdc\x35\x90\xac\xd4\x84\x6b\xf3\xd8\x19\x77\xda\xdd\xd1\x61\xf2\xe8\xaf\x64\x33\x70\xd6\x73\xdf\x18\x0a\x7e\x3f\xdb\x3a\xf3\x31\x90\x80\x9d\x2a\x85\xcc\xef\x29\xa4\xb8\xd0\xdd\xaf\xa0\x95\x64\x5f\x43\x8b\xcf\x2d\x36\x20\xa8\xb3\xb3\xa0\x78\x87\x20\x89\x30\x07\x5d\x38\xd8\x74\xef\x7e\x8a\xe7\xbf\x66\x8a\x67\x3f\x64\xba\x44\x1f\x53\xbc\x77\xcc\x15\x44\x50\x46\x76\xa7\xf1\x9b\xbf\xd2\xcc\xdb\x3a\xad\xac\x51\x1f\xf1\x0d\xf6\x44\x68\x7e\x35\xa4\xdb\x42\x74\x97\xa4\x15\x2b\x32\x87\x59\x74\xfc\x1c\x1f\x7a\x29\xab\xa4\x8d\x5b\x9a\xa7\xb7\xb0\xa5\x80\xd7\x40\xfa\x61\x36\xe6\x87\xe6\xe6\x94\x85\xf9\xdf\xc3\xf7\xda\xff\xe7\xde\xcf\x00\x00\x00\xff\xff\x65\xd5\xaf\xfc\xf5\x08\x00\x00")
+var _rpProductionParametersJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x96\xcb\x6e\xdb\x3c\x10\x85\xf7\x7e\x0a\x41\xff\xbf\x4c\x7c\x49\xd0\x4d\x76\x8e\xe2\xb4\x46\xd1\x56\xa8\xd1\x6c\x83\x31\x39\x76\xd8\xf2\x86\x99\xa1\x10\xa5\xc8\xbb\x17\xb2\x62\x17\x01\x9a\xa2\xa2\x0d\x6a\x21\x90\x3c\xdf\x1c\x0c\xa9\x03\xfd\x1c\x15\x45\x51\x94\xff\xb3\x7a\x40\x07\xe5\x55\x51\x3e\x88\x44\xbe\x9a\x4c\xfa\x99\xb1\x03\x0f\x5b\x74\xe8\x65\x0c\x4f\x89\x70\xac\x82\x7b\x59\xe3\xc9\xc5\x74\xf6\xee\x7c\x3a\x3b\x9f\xce\x26\x1a\xa3\x0d\x6d\xb7\xaf\x06\x02\x87\x82\xc4\xe3\xef\x1c\xfc\x7f\xe5\x59\x5f\x43\x05\x2f\xe8\xe5\x0e\x89\x4d\xf0\x5d\xa9\xd9\x78\xda\x8d\xfd\x86\x78\x10\x96\x57\x45\x6f\xac\x1b\x25\x28\xfa\x8a\x1c\x12\x29\x5c\xea\x57\x4b\xdd\x53\x36\x60\x13\x76\xb8\xf2\x30\xff\x7c\x76\x78\x2d\x41\x3b\xe3\xe7\xd1\x54\x70\x9d\xbc\xb6\x98\x0f\xb0\x06\xbd\x54\x48\x52\x05\xe7\x82\xff\x0c\x2e\x03\x46\xee\x28\x2f\xe4\x4e\xe8\xa4\xe7\xe4\xf4\x94\xc2\x97\x88\x04\x12\xa8\x02\x0f\xd4\xd6\x48\x0a\xbd\xc0\x76\xb8\x8f\xb5\xb1\xd6\xf8\xed\xe2\x62\xb1\x92\x40\xb0\xc5\xb9\x52\x21\x65\xd9\x7a\x41\xad\x90\x1a\xa3\xb0\x26\xe3\x95\x89\x60\x33\x48\xca\x26\x16\xa4\x4f\x9a\x75\x15\xfc\xc6\x6c\x7f\xdf\xda\x2c\x50\x0d\x84\x5e\x6e\x82\x03\x93\x77\x5a\x1a\x04\xd6\xc0\xfb\xe6\x64\x31\xd0\x2b\x6a\xa3\x98\xe0\xe7\xf2\x21\xb0\x0c\x07\x3c\x0a\x41\x15\xd8\x05\xbe\xb9\x5e\xd6\x3c\x18\xb0\x89\xd9\x57\x6e\x13\x4f\x70\xac\x3f\xb0\x6d\x20\x59\xa9\x09\x37\xe6\x71\xb0\xdc\x69\x77\x4b\xbb\x14\xd3\xdf\xc8\x66\xc8\x59\x2f\x7c\x63\x28\xf8\x2e\x27\x07\xeb\x63\x20\x01\x3b\x57\x0a\x99\xdf\x53\x48\x71\xa9\x39\x17\x92\xb4\x91\x40\x47\x52\xb2\x0f\xb3\x37\xb1\xb0\xd8\x80\xa0\xce\x76\x41\xf1\x16\x41\x12\x61\x8e\x74\xe9\x72\xd2\x8a\xe2\xf1\x99\x40\xf1\xe8\x38\xa0\x53\x7c\x0d\x14\xef\x1c\x73\x05\x11\x94\x91\xf6\x6d\xf9\xe5\x1f\xd5\xcc\x0f\x75\x5a\x5b\xa3\x3e\x62\x3b\xb8\x34\xbf\x8a\xfa\xbe\x11\xc3\x21\x69\xcd\x8a\xcc\x2e\xd1\xf6\x3f\x08\xbb\xbb\x94\xd5\xd2\xc6\xad\xcc\xd3\xdf\x64\x2b\x01\xaf\x81\xf4\xfd\xcd\x05\xdf\x37\x97\x6f\x51\x98\xff\xbd\xfc\xa8\x28\x8a\xe2\x79\xf4\x3c\xfa\x35\x00\x5f\xca\x74\xec\x87\x09\x00\x00")
 
 func rpProductionParametersJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -311,7 +311,7 @@ func rpProductionParametersJson() (*asset, error) {
 	return a, nil
 }
 
-var _rpProductionPredeployParametersJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x8f\x4f\x4b\xc3\x40\x10\xc5\xef\xf9\x14\xcb\xea\xb1\xcd\x1f\xc1\x4b\x6e\xc5\x83\x88\x50\x02\x01\x2f\xe2\x61\xd8\x4c\xda\xd5\xcd\xee\x32\xb3\x09\xad\xd2\xef\x2e\xc9\xc6\x8a\x60\x05\x4b\x59\xd8\xc3\x7b\xf3\x7e\x33\xef\x23\x11\x42\x08\x79\xcd\x6a\x8b\x1d\xc8\x52\xc8\x6d\x08\x9e\xcb\x2c\x8b\x4a\xda\x81\x85\x0d\x76\x68\x43\x0a\xef\x3d\x61\xaa\x5c\x37\x7b\x9c\xdd\xe4\xc5\xed\x32\x2f\x96\x79\x91\x35\xe8\x8d\xdb\x8f\x73\x15\x10\x74\x18\x90\x38\x7d\x65\x67\xaf\xe4\x22\xee\x50\xce\x06\xb4\xe1\x09\x89\xb5\xb3\xe3\xaa\x22\xcd\xc7\xf7\x35\xe0\x8f\x41\x59\x8a\x78\xd8\xa4\x47\xf4\xba\xbe\xff\xa9\x4f\xde\x00\xa6\x47\x59\x8a\x16\x0c\xe3\xd1\x3a\x2c\xbe\xd3\xb8\x0b\x04\x77\xa6\xe7\x80\xf4\x88\xfb\x01\x7a\x13\x56\x4a\x21\x73\xe5\x8c\x56\x1a\xff\xa0\x3e\xbf\x9c\x46\x56\x8e\x02\x98\x4b\x12\x6b\xa4\x41\x2b\xbc\x10\xb2\xf5\x33\xaf\x22\x6d\x95\xf6\x60\x1e\x9a\xd3\x10\x29\x7f\x85\xbc\xcd\xb7\x54\x84\xad\xde\xfd\x3b\x4e\x7e\xcd\x9b\xda\xf5\xa4\x70\xd5\x34\x34\xd6\x99\x40\x67\xd4\xa1\xb3\xeb\x24\xf1\x3f\x24\x9f\x01\x00\x00\xff\xff\x8a\x39\x92\xe7\xec\x02\x00\x00")
+var _rpProductionPredeployParametersJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x90\x4d\x4b\xc4\x30\x10\x86\xef\xfd\x15\x21\x7a\xdc\xed\x87\xe0\xa5\xb7\xc5\x83\x88\xb0\x14\x0a\x5e\xc4\xc3\x90\x4e\x77\xa3\x69\x12\x66\xd2\xb2\xab\xf4\xbf\x4b\x6c\x2d\x08\xae\xe0\xb2\x4c\x0e\x61\x3e\x9e\x79\xdf\xf9\x48\x84\x10\x42\x5e\xb3\xda\x63\x07\xb2\x14\x72\x1f\x82\xe7\x32\xcb\xa6\x4c\xda\x81\x85\x1d\x76\x68\x43\x0a\xef\x3d\x61\xaa\x5c\x37\xd7\x38\xbb\xc9\x8b\xdb\x75\x5e\xac\xf3\x22\x6b\xd0\x1b\x77\x8c\x7d\x15\x10\x74\x18\x90\x38\x7d\x65\x67\xaf\xe4\x6a\xda\xa1\x9c\x0d\x68\xc3\x13\x12\x6b\x67\xe3\xaa\x22\xcd\x63\x7c\x37\xf8\x65\x50\x96\x62\x12\x16\x43\x4e\xe8\x6d\x7d\xff\x33\x1f\x9f\x1c\xc0\xf4\x28\x4b\xd1\x82\x61\x5c\x4a\xe3\x6a\xf9\x4a\x3c\x04\x82\x3b\xd3\x73\x40\x7a\xc4\xe3\x00\xbd\x09\x1b\xa5\x90\xb9\x72\x46\x2b\x8d\x7f\x50\x9f\x5f\x4e\x23\x2b\x47\x01\xcc\x25\x89\x35\xd2\xa0\x15\x5e\x08\xd9\xfa\x99\x57\x91\xb6\x4a\x7b\x30\x0f\xcd\x69\x88\x94\xbf\x42\xde\x66\x2d\x15\x61\xab\x0f\xff\x1e\x27\xbf\xe5\x5d\xed\x7a\x52\xb8\x69\x1a\x8a\x37\xff\x02\x9d\x61\x87\xce\xb6\x93\x08\x21\xc4\x98\x8c\xc9\xe7\x00\x8a\x39\x92\xe7\xec\x02\x00\x00")
 
 func rpProductionPredeployParametersJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -331,7 +331,7 @@ func rpProductionPredeployParametersJson() (*asset, error) {
 	return a, nil
 }
 
-var _rpProductionPredeployJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5b\x6f\xdb\x36\x14\x7e\xcf\xaf\x10\xb4\x01\xb6\x87\xd8\x96\xd3\x6e\xc3\xfc\x16\x6c\x40\x11\x74\x0d\x82\xb8\xc8\x8b\x61\x14\xc7\xd4\xb1\xc3\x86\x22\x89\x43\xca\x8d\x57\xe4\xbf\x0f\x94\x64\xd7\xb2\x28\x29\x2d\x72\x31\x50\x27\x0f\xb6\xc9\x73\xe7\x77\x2e\xe4\xd7\x93\x20\x08\x82\xf0\x57\xc3\x6e\x31\x81\x70\x1c\x84\xb7\xd6\x6a\x33\x1e\x0e\xf3\x95\x41\x02\x12\x96\x98\xa0\xb4\x03\xf8\x2f\x25\x1c\x30\x95\x14\x7b\x66\x78\x16\x8d\x7e\xef\x47\xa3\x7e\x34\x1a\xc6\xa8\x85\x5a\x3b\xba\x8f\x98\x68\x01\x16\x07\x9f\x8d\x92\xbf\x84\xa7\xb9\x06\xa6\xa4\x45\x69\x6f\x90\x0c\x57\xd2\x29\x1a\x0d\x22\xf7\xbf\x21\x58\x01\x71\x98\x0b\x34\xe1\x38\xc8\xad\xca\xf9\x44\x6a\x2c\xd2\x7b\x5c\xaf\x20\x15\xf6\x9c\x31\x34\xe6\x4a\x09\xce\x78\x46\x3a\xdd\x92\xba\xbf\xaf\xa5\x5f\x99\x00\x8b\x12\xa4\xbd\x88\x9d\xca\xa9\x49\xe7\x86\x11\xd7\x96\x2b\xd9\xed\x0d\x36\x7b\xb3\xc2\x88\x12\xa3\x9a\x7f\x46\xb6\x61\xd4\x40\x90\xa0\x45\x32\xdd\xce\x42\x4f\x90\x56\x9c\xe1\x15\x71\xc9\xb8\x06\x71\x11\x77\x7a\x5e\x19\x1a\x29\xe1\xc6\x79\x5c\x76\xab\x44\x64\x90\x11\xda\xaa\x33\x25\xa2\x25\xda\xd0\xbb\x3b\xab\xaa\xcd\x03\x87\x64\xf9\x82\x33\xb0\x9e\x38\x95\x29\x09\xc1\xa2\xc7\xfc\x2d\x45\x8c\x02\x9b\x29\x9c\x79\x0d\xdb\xa9\x8e\x9d\x0a\xbf\x03\x95\xd5\x87\xd2\xca\xc3\xe9\xcb\x1f\x31\x1d\x8f\xd8\x6b\xde\x73\x1d\xf1\xf6\xd7\x8e\xab\xa1\x56\x64\x41\x1c\x52\xe6\x1f\x18\x2c\x7e\x28\xaa\x26\xf7\xe0\x18\xd6\x27\x08\x6b\xf6\xad\xa8\x4f\xe1\x37\x8f\xca\x1d\x2c\xef\x8c\x97\x93\x77\x55\x8b\x43\xbb\xd6\xe8\xc2\x31\x57\x4a\xec\xb9\x1b\xc6\xb8\x70\x07\x74\x03\x22\x75\x34\x0b\x10\x06\xb7\x14\x3b\x45\x31\xc4\x7b\x4b\xf0\x77\x4b\x9f\xac\x51\x0c\x44\xb0\x6e\xd1\x3c\x9d\xd5\xab\xbd\x6a\xce\xd1\x67\xd2\x3a\x69\xc1\xf0\xd3\xab\xf5\xf5\xfc\x7a\x45\xc6\x12\x97\xcb\xd0\x2b\xe9\xae\x30\xfa\x8a\x70\xc1\xef\x5b\x65\xec\x59\x9b\xc0\xfd\xbf\x28\x97\xf6\x36\x1c\x07\x67\x91\x57\x01\xe9\x4b\xb3\x9c\xa8\x94\x18\x9e\xc7\x31\xb9\xc8\x64\xaa\x9e\x29\x32\xbe\xe4\xfd\x8e\xc8\x94\x52\x88\xd0\x64\x76\x97\x53\x77\x4f\x94\x26\xa5\x5d\xe3\xf3\xf8\x13\x14\xd9\x9f\x12\xb7\xeb\xeb\x54\x34\xb4\x45\x7f\xe9\x78\x8c\x82\x7d\x5a\xab\x98\x12\xce\xb7\x8f\x4c\x37\x34\xc8\xdc\xb6\xcc\x3d\x97\x34\xd7\x20\x97\x59\x44\x7e\x6b\xe3\x89\xd1\x58\x2e\xc1\x55\xd8\x12\xe3\xdb\xb7\x6f\x1e\xa7\xae\x84\x02\xc7\x79\xee\x06\xf9\xeb\x22\xd6\x1f\xb2\x01\x9f\xbe\xc3\x8a\x8a\xbc\x56\x17\x20\x4b\xd0\x4c\xb5\x10\xea\x4b\x1b\xb9\x26\xae\xdc\x09\x86\xe3\x60\x74\x16\xb5\x19\xc6\x09\x99\x2d\xee\x14\x17\x72\xae\x52\x19\xfb\x0b\x7b\x50\x1d\x26\x4b\x92\x24\x24\x59\x60\x49\x7f\xe2\xf2\x13\x50\xe2\x17\x53\x23\xe2\x67\x05\x54\xe6\xd4\x7e\x3f\xaf\xab\x40\xfe\xae\x5e\x67\xdd\x8b\x02\xed\xcd\x6b\x01\x6d\x89\x12\x57\x50\x83\xb5\xca\xea\xac\xe9\x6e\xb4\x23\xb9\x2f\x4d\xa5\x79\x6c\x8a\xf0\x07\xce\x48\x19\xb5\xb0\x83\x4b\xb4\x5f\x14\xdd\x0d\x65\xfe\x39\x29\x4a\xe7\x3b\x52\xa9\x36\xfb\xec\x42\x31\xd8\xf8\x3f\xdd\x94\xea\x8c\xb4\xdb\x1b\x6c\x36\xf7\xcf\xd7\x5d\xfc\x63\xbe\x65\xdb\x85\xc9\xb7\xc1\xa8\x0a\x8b\x10\x34\xdf\x79\x2b\x38\x8b\x46\x7f\xf5\xa3\x3f\xfb\xd1\xc8\xdb\x54\x1b\x3b\x44\x43\x8c\x34\x1e\xc3\x54\xdf\x48\x7f\x78\xd0\x37\x77\x69\xfd\x70\xbe\x80\x84\x8b\x75\x96\xa2\x35\xe9\xb9\x3d\x21\x63\x41\xc6\x40\x9e\x2c\xf3\x64\x57\x91\xfb\x3b\x53\x60\x38\x65\x4a\x32\xb0\xdd\xed\xd3\x52\xb7\xd3\xf8\x9c\xd4\xe9\x9d\x06\xbb\x91\x6f\x1f\xac\x3b\x3d\xff\x35\x05\xa5\xd3\x37\x51\x0b\xfb\x4f\x7e\xaf\x1e\x07\x96\x52\x7c\x54\xee\x6e\xac\xde\xb5\xa4\x3c\x34\x3a\x3b\x3b\x7d\x26\x7c\x80\xa8\x82\xf7\x3d\xae\x6f\x1c\xef\x30\x93\xf0\x44\x70\xad\xe0\xee\x8f\xfe\x28\x3a\xe2\xae\x16\x77\x4d\x6f\x19\x5e\xd8\x35\x5d\xac\x5e\x13\x75\x5a\xd1\x11\x75\x25\xc6\x03\x46\x5d\xe3\x5b\x8f\x17\x76\x8d\x37\xeb\xd7\xc4\x9d\x59\xb1\x83\xc6\x5d\xf6\x6d\x76\xf2\x70\xf2\x7f\x00\x00\x00\xff\xff\x04\x3d\x6a\xdf\x52\x19\x00\x00")
+var _rpProductionPredeployJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x6d\x6f\xdb\x36\x10\xfe\xae\x5f\x21\x70\x03\x6c\x0f\xb1\x2d\xa7\xdd\x86\xf9\x5b\xb0\x01\x45\xd0\x35\x08\xe2\x22\x5f\x0c\xa3\xa0\xa9\xb3\xc3\x86\x22\x89\x23\xe5\xc6\x2b\xf2\xdf\x07\xca\x96\xa3\x17\x4a\x5a\x87\xd4\xc9\x30\x8b\x06\x0c\x88\x77\x7c\xee\xe5\xb9\x23\xc5\xaf\x41\x18\x86\x21\xf9\xd1\xb0\x3b\x48\x28\x99\x86\xe4\xce\x5a\x6d\xa6\xe3\xf1\xee\xcd\x28\xa1\x92\xae\x21\x01\x69\x47\xf4\xaf\x14\x61\xc4\x54\xb2\x9f\x33\xe3\xf3\x68\xf2\xf3\x30\x9a\x0c\xa3\xc9\x38\x06\x2d\xd4\xd6\xc9\x7d\x84\x44\x0b\x6a\x61\xf4\xd9\x28\xf9\x03\x39\xdb\x21\x30\x25\x2d\x48\x7b\x0b\x68\xb8\x92\x0e\x68\x32\x8a\xdc\xc8\x05\x36\x14\x39\x5d\x0a\x30\x64\x1a\xee\xac\x72\x83\x30\x91\x1a\x0b\xf8\x1e\xb6\x1b\x9a\x0a\x7b\xc1\x18\x18\x73\xad\x04\x67\x3c\x13\x9d\x1f\x44\xdd\xef\x49\x31\x7f\x88\x05\x49\xa5\xbd\x8c\x1d\xe4\xdc\xa4\x4b\xc3\x90\x6b\xcb\x95\xec\x0f\x46\xf9\xdc\x62\x6f\x44\x71\x10\xb5\xfc\x0c\x2c\x57\xd4\x14\x69\x02\x16\xd0\xf4\x7b\x2b\x3d\x03\xdc\x70\x06\xd7\xc8\x25\xe3\x9a\x8a\xcb\xb8\x37\xf0\xae\xa1\x01\x13\x6e\x9c\xc7\x65\xb7\x8a\x0f\x31\xc0\x10\x6c\xdd\x99\xe2\x43\xd6\x60\x89\x77\x76\x51\x87\x75\x83\x30\x40\xcb\x57\x9c\x51\xeb\x89\x53\x71\x10\x86\x40\x2d\x90\xb3\x66\x89\x18\x04\xb4\x4b\x38\xf3\x5a\xa6\x53\x1d\x3b\x08\xaf\xc0\xa2\xf6\xf6\xb1\xf4\xe6\xf1\xec\xf8\x29\xc6\x53\x8a\x8f\x9a\xe2\xc0\xe3\x2a\xd1\x0a\x2d\x15\xaf\xa9\xf2\x5f\x19\x2d\xfe\x55\x54\xcd\x8e\xd8\xa7\xb0\x3e\x43\x58\x83\x42\x7f\x22\x4f\x1e\x95\x2c\x23\xbb\x9d\xf1\x6a\xf6\xae\x6e\x31\xb1\x5b\x0d\x2e\x8e\x4b\xa5\x44\xc5\x5d\x12\xc3\xca\x25\xe8\x96\x8a\xd4\xc9\xac\xa8\x30\x10\x78\x9a\x22\x81\x07\x8b\xf4\xf7\x8e\x7d\xb2\x01\x98\x22\xd2\x6d\x07\xf2\x7c\xd1\x0c\x7b\xdd\x5e\xa3\xdf\x09\x75\x4f\x97\x23\xc2\xfa\xf6\xfc\x66\x20\x63\x91\xcb\x35\xf1\xae\x74\xbf\x37\xfa\x1a\x61\xc5\x1f\x3a\xd7\x78\xd2\x74\x83\x24\xf4\xe1\x4f\x90\x6b\x7b\x47\xa6\xe1\x79\xe4\x05\x40\x7d\x65\xd6\x33\x95\x22\x83\x8b\x38\x46\x97\x90\x0c\xea\x3b\x45\xc6\x57\xbc\x9d\x5e\x1d\x26\x1f\x4b\x25\x84\x60\x32\xbb\xcb\xa5\x5b\x59\x4a\xa3\xd2\x6e\xe3\xf3\xf8\x93\x57\x7f\x8a\xdc\x6e\x6f\x52\xe1\x69\x68\xfe\x45\x8b\x4f\x17\x40\x71\x38\x59\xab\x98\x12\xce\xb7\x8f\x4c\x57\x42\x58\x1d\x64\xe7\x9e\x2b\x9a\x1b\x2a\xd7\x2e\xaa\xe4\xa7\x2e\x9d\x18\x8c\xe5\x92\xba\xf3\x4c\x49\xf1\xed\xdb\x37\xff\x0c\xae\xc4\x02\x07\x79\xe1\x0e\xf2\x37\xfb\x58\x7f\xc8\x0e\xf8\xf8\x0d\x56\xd4\xd6\xeb\x74\x81\x66\x7d\x21\x83\x16\x42\x7d\xe9\x12\xd7\xc8\x95\xcb\x20\x99\x86\x93\xf3\xa8\x43\x38\xe6\x08\xcc\xee\xbf\x29\x2e\xe5\x52\xa5\x32\xf6\x37\xf6\x0a\x73\xab\x83\x48\x9a\x64\x81\x45\xfd\x89\xcb\x4f\x14\x13\x12\x7c\xc3\x12\xff\x57\x42\x81\xf1\xec\xe7\x4d\x1d\xc8\xbf\xab\x37\x59\x77\x54\xa2\xbd\x79\x29\xa2\xad\x41\xc2\x86\x36\x70\xad\xf6\x76\x11\xb4\xa0\x14\x56\x1e\x4a\x53\xdb\x3c\xf2\x26\xfc\x81\x33\x54\x46\xad\xec\xe8\x0a\xec\x17\x85\xf7\x63\xb9\xfb\x9f\xed\x5b\xe7\x3b\x54\xa9\x36\x55\x75\xa1\x18\xcd\xfd\x9f\xe7\xad\x3a\x13\xed\x0f\x46\xf9\x64\x35\xbf\x84\x29\x19\xf3\x83\x5a\x91\x26\x4f\x07\xa3\x3a\x2d\x08\xd5\xbc\x70\x57\x70\x1e\x4d\x7e\x1b\x46\xbf\x0e\xa3\x09\x09\x3c\xbe\x7f\x0d\x5a\xea\xad\x25\x46\x1a\x4e\x61\xca\xc3\xf4\x8c\x07\x7d\x73\x9f\x36\x36\x3a\xb2\xa2\x09\x17\xae\xe2\xc8\x85\x47\xb7\x94\x21\x63\xa9\x8c\x29\x7a\xaa\xac\x92\xd3\x42\xed\x17\x4e\x81\x64\xce\x94\x64\xd4\xf6\x0f\x57\x4b\xfd\x5e\xeb\x75\x52\x6f\x70\x16\x16\x23\xdf\x7d\xb0\xee\x0d\x6a\x29\x71\x3f\x02\xd2\xe1\xcd\xd4\xca\xfe\xb1\xfb\xae\x9e\x86\x16\x53\x08\x5a\x7c\x38\x78\x9d\x5b\x5d\xb4\xa4\x7c\x68\x74\x76\xf6\x86\x4c\xf8\x08\x51\x27\xef\x7b\xd8\xde\x3a\xdd\x71\xe6\xf1\x33\xd1\xb5\xc6\xbb\x5f\x86\x93\xe8\xc4\xbb\x46\xde\xb5\xdd\x65\x78\x69\xd7\xf6\x61\xf5\x92\xac\xd3\x0a\x4f\xac\xfb\xaf\xb0\xae\xf5\xae\xc7\x4b\xbb\x59\xbb\xc6\xcb\xf1\xce\x6c\xd8\xab\xe6\x5d\x10\x86\x61\xb8\x08\x1e\x83\xbf\x07\x00\x04\x3d\x6a\xdf\x52\x19\x00\x00")
 
 func rpProductionPredeployJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -351,7 +351,7 @@ func rpProductionPredeployJson() (*asset, error) {
 	return a, nil
 }
 
-var _rpProductionSubscriptionJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x90\x41\x4f\x03\x21\x10\x85\xef\xfd\x15\x04\x3d\xba\xbb\x50\x63\x62\xf6\x0f\x34\x1e\xf4\xa2\xf1\x62\x3c\x4c\xe9\xb8\x60\x80\x21\x30\x7b\xa8\x4d\xff\xbb\x81\xd6\xc6\x2d\x5c\x26\xf3\x3e\xde\x23\xef\xb0\x12\x42\x08\x79\x5b\x8c\xc5\x00\x72\x14\xd2\x32\xa7\x32\x0e\xc3\x69\xd3\x07\x88\x30\x61\xc0\xc8\x3d\xfc\xcc\x19\x7b\x43\xe1\xac\x95\x61\xad\xf4\x43\xa7\x74\xa7\xf4\xb0\xc3\xe4\x69\x5f\xb9\x37\x0c\xc9\x03\x63\xff\x5d\x28\xde\xc8\xbb\x53\x82\xa1\xc8\x18\xf9\x1d\x73\x71\x14\x6b\x90\xee\x55\xbd\x7f\x40\xc6\x42\x73\x36\x58\xe4\x28\x3e\xda\xaa\x9e\xc3\x65\x6a\x50\xca\x94\x30\xb3\x6b\xd4\x52\x6b\xfa\x94\x69\x4e\xaf\x96\x32\xbf\x40\xc0\x9a\x92\x93\x45\xf0\x6c\xcf\x31\x0b\x1a\x23\x6c\x3d\xee\xe4\x28\x38\xcf\xb8\xd0\x8f\x4b\x5c\xc6\x8b\x5d\x77\xf2\xeb\x60\xba\xb2\x94\xbc\x4f\x8d\x79\x76\x26\x53\xa1\x2f\xee\x9f\x62\x71\x93\xe5\x32\x80\x61\x47\x71\x53\x7f\x57\xae\x9f\x79\x32\xc0\xe7\x4e\x36\x9e\xb6\xe0\xaf\x09\x48\xee\x5f\x6f\x6b\xa5\x1f\x3b\x75\xdf\x29\x2d\x2f\xd8\xb1\x4d\x9f\xab\xe3\xea\x37\x00\x00\xff\xff\xd4\xfe\xf8\x48\xd1\x01\x00\x00")
+var _rpProductionSubscriptionJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x90\xc1\x4e\xf3\x30\x10\x84\xef\x79\x0a\x6b\xff\xff\x48\x12\xa7\x08\x09\xe5\x05\x2a\x0e\x70\x01\x71\x41\x1c\xb6\xee\x12\x1b\xd9\x5e\xcb\xde\x1c\x4a\xd5\x77\x47\x6e\x4b\xd5\x46\xe3\x83\xb5\xf3\x79\xc6\xda\x7d\xa3\x94\x52\xf0\xbf\x18\x4b\x01\x61\x54\x60\x45\x52\x19\xfb\xfe\x34\xe9\x02\x46\x9c\x28\x50\x94\x0e\x7f\xe6\x4c\x9d\xe1\x70\xf6\x4a\xbf\xd2\xc3\x43\xab\x87\x56\x0f\xfd\x96\x92\xe7\x5d\xe5\xde\x28\x24\x8f\x42\xdd\x77\xe1\xf8\x0f\xee\x4e\x0d\x86\xa3\x50\x94\x77\xca\xc5\x71\xac\x45\x43\xa7\xab\xfe\x80\x4c\x85\xe7\x6c\xa8\xc0\xa8\x3e\x8e\xa3\x7a\xf6\x97\x5b\x15\xa4\xcc\x89\xb2\xb8\x23\x75\xeb\x55\xc1\x94\x79\x4e\xaf\x96\xb3\xbc\x60\xa0\xda\x92\x93\x25\xf4\x62\xcf\x35\xd7\x02\x8a\xb8\xf1\xb4\x85\x51\x49\x9e\xe9\xc6\x3f\xdc\xe2\x10\x2f\x71\xed\x29\xaf\xc5\x69\x11\x09\xb2\x4b\x47\xe6\xd9\x99\xcc\x85\xbf\xa4\x7b\x8a\xc5\x4d\x56\x4a\x8f\x46\x1c\xc7\x75\xfd\x5d\x59\x3e\xf3\x6c\x50\xce\x3b\x59\x7b\xde\xa0\x5f\x12\x98\xdc\xd5\xde\x56\x7a\x78\x6c\xf5\x7d\xab\x07\xb8\x60\x87\x46\x29\xa5\x3e\x9b\x43\xf3\x3b\x00\xd4\xfe\xf8\x48\xd1\x01\x00\x00")
 
 func rpProductionSubscriptionJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -371,7 +371,7 @@ func rpProductionSubscriptionJson() (*asset, error) {
 	return a, nil
 }
 
-var _rpProductionJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x69\x73\xa3\x4a\xb2\x30\xfc\x7d\x7e\x85\xa3\xdf\x1b\xe1\xd3\xef\xb4\x6d\x40\x56\xdb\x4c\xc4\x7c\x10\x48\x20\x90\x84\xc4\x56\x20\xe6\x4e\x4c\xb0\x09\x21\x8a\xe5\x00\x5a\x6f\xcc\x7f\x7f\xa2\x58\xb4\x59\xb2\x64\xb7\xfb\x9c\xee\x99\xd6\x89\xe9\x91\x45\x55\x56\x56\x56\x6e\x95\x99\x55\xfc\xdf\x5f\x6e\x6e\x6e\x6e\x3e\xfd\x4f\x66\x4f\xdd\xd0\xfc\xf4\xb7\x9b\x4f\xd3\x3c\x4f\xb2\xbf\x3d\x3c\x94\xbf\xdc\x87\x66\x64\x7a\x6e\xe8\x46\xf9\xbd\xb9\x99\xa7\xee\xbd\x1d\x87\xd5\xb3\xec\x81\xc0\xf0\xe6\x1d\x86\xdf\x61\xf8\x83\xe3\x26\x30\x5e\xa3\x76\x8a\x1b\x26\xd0\xcc\xdd\xfb\x59\x16\x47\xff\xdf\xa7\x2f\xe5\x08\x76\x1c\xe5\x6e\x94\x03\x37\xcd\xfc\x38\x42\x03\xe1\xf7\x18\xfa\xaf\x6e\x90\x98\xa9\x19\xba\xb9\x9b\x66\x9f\xfe\x76\x53\xa2\x55\xfc\x6e\xda\xa9\xe4\x66\xf1\x3c\xb5\x5d\xce\x39\x78\x54\x3c\xce\xd7\x89\x8b\xa0\x65\x79\xea\x47\xde\xa7\xed\xc3\x7f\x7f\xd9\x03\xe1\x84\x7e\xd4\x4a\x7c\xda\xa4\xe6\x91\x03\xdd\x6f\x84\x02\x7d\x37\xca\x69\x37\xcd\xe9\x38\x0c\xe3\x48\x30\xc3\xf7\x42\x4c\xc3\xb7\x60\xf5\xe5\xf0\xa9\xe3\x4e\xcc\x39\xcc\x81\x09\xe7\x45\xab\x57\xc7\x78\x0f\xce\xef\x1d\xaf\x1c\xec\x8a\xc5\x7a\xd7\x00\x96\x0f\xa1\x1f\x79\x1d\xa2\x23\xe7\x71\x6a\x7a\x6e\xcb\xb6\xe3\xf9\x77\x1f\x4f\x76\xd3\x85\x6f\xbb\xa3\xd4\x8f\x6c\x3f\x31\xe1\xf7\x1a\xce\x86\xf3\x2c\x77\xd3\x81\x93\x39\x74\x1c\x4d\x7c\x6f\x27\x31\xef\xe0\xb0\x0a\xda\xc8\x4c\xdd\x28\x6f\xc7\xa1\xe9\x7f\x03\xbb\x3a\x66\x6e\x5a\x66\x56\x13\xfc\xfd\x80\xdc\xc8\x4e\xd7\x49\xee\xc7\x51\x2b\xef\xc6\x59\x7e\x1e\x8a\x15\xc7\xf0\x0c\x8c\x55\x9e\x9a\x74\x9c\x85\x71\xd6\xa6\xb8\x51\xf6\x7d\x56\x63\x92\x5c\xcd\xcc\x67\xfa\xbf\x83\x6f\x4e\x42\x0a\xdc\xf5\x02\x21\x3c\x4a\xdd\x89\xbf\x7a\x1f\x8c\xd0\x09\x99\xb4\xd0\xc3\x8e\x9a\xc2\xf7\xc2\xc8\x9c\x4e\xb4\xf0\xd3\x38\x42\xca\xfe\x7d\x40\x92\x38\xcd\x4d\xd8\xb2\x6d\x37\xcb\xd8\x34\x9e\x27\x9c\x73\x79\x01\x5f\x81\xf4\x6d\x8b\x54\xc2\xe8\x40\x77\x61\xe6\xae\xf3\x6d\xf8\xa4\x09\xe3\x9a\xf9\x3c\x75\xbf\x13\x43\xa6\x09\x17\x9a\xde\x3b\xe5\x2e\x4d\x3e\x48\xad\xa4\xc9\xc7\x68\x94\xf4\xc3\xc4\x23\x4d\x40\x98\x65\xb4\x99\x98\xb6\x9f\xaf\xcf\xc3\xf0\xa3\xfc\x02\xe1\x1b\x27\xe1\x67\xd9\x74\x34\xb7\xa0\x6f\xf7\xdc\x57\xa0\xbf\x86\x61\x76\x60\xad\x4a\xca\xbd\x13\xd2\xdc\xca\xec\xd4\x2f\x14\x68\xed\x1a\x15\x5c\xfb\xfe\x85\x58\x84\xb2\xbf\xf9\x66\x8f\x40\xce\xcd\xc8\x31\x53\xe7\x5f\x6d\x22\xfb\xd7\xa2\x71\x6e\xa8\x2c\x7b\x23\xa2\x7f\xd9\x83\xf1\x29\xad\x66\x8c\x04\xec\x1f\xdb\x36\x47\xa0\xb2\x60\xfe\x02\x7e\xf1\x20\x2a\x47\xde\xa2\xfa\xe9\xa0\xc9\xbf\x8f\x26\x98\xa4\x71\xe2\xa6\xb9\x7f\x42\x9a\xcb\xe7\x05\x4b\x70\xa3\x16\x84\xb1\x6d\xa2\xf5\x18\xb8\xf9\x34\x76\xaa\x11\x72\xdf\x7e\x1d\x7e\x8d\x4d\x9a\xdc\x25\x7e\x72\x4c\xde\x9a\x1e\x03\xdf\x4e\xe3\x2c\x9e\xe4\xf7\x82\x9b\x2f\xe3\x34\x78\xd8\x8e\xeb\x38\xa9\x9b\x65\x6e\x76\xdc\xb5\x46\x07\x75\xff\x47\xba\xcf\x23\xbf\x7d\xbe\xaf\x1f\xfe\xf3\xb8\x97\x99\xf8\x7b\xfe\x39\x81\xe1\xe4\x1d\xf6\x74\x87\xe1\x27\x17\xf2\xe7\xa6\x78\xa9\xf3\x7f\x51\xfd\x3a\xaa\x4f\x2a\xaf\x81\x1b\x95\xc6\x63\x9e\x16\x53\x39\x14\xc1\xfd\xcf\x4b\x18\xd7\x8e\x75\xd8\xf6\x90\xe2\x17\x3b\x14\x9d\x7c\xe7\x80\xfe\x9c\xf3\xdb\xed\x15\x6b\x79\xfb\xe5\xe6\xb6\x14\xc3\xdb\xcf\xc7\x4b\x74\x72\x98\xdc\xf4\x10\x42\xd1\x1c\xc2\x57\x1b\xff\xfb\xec\xd3\x7f\x9f\x1f\x65\x5f\x33\xd4\xc4\xff\x74\xb2\xf5\x19\x20\x3f\xe7\x02\xec\xa4\xf2\x87\x5a\x84\x0a\xad\x0b\x0b\xf1\xe2\xd7\x7f\xbe\x04\xfd\xc9\x32\xed\xc0\x8d\x9c\x6a\xd6\xa3\x38\x86\xef\x12\xa2\x3d\xf6\xa8\x20\x7e\x0b\x52\x30\x36\x1d\xca\x84\x66\x64\xfb\x91\x27\xcd\xa1\xfb\xdd\x05\xfb\x8c\x42\xf9\x40\xfe\xda\xcd\xc9\x4d\xb3\x87\x73\x0a\xac\x92\x7a\x68\x55\x5f\xea\x76\x88\xfd\x5e\x67\xa8\xd7\x99\xf3\xc4\x3a\x7f\xb7\xb9\x9d\x60\xa9\xe3\x69\x55\x4d\xbe\x79\x56\x49\x1a\x5b\x2f\x1d\xb7\x8f\x9a\x48\x01\xfd\x05\xee\xc5\xaf\x1f\x81\x79\x1e\xdb\xc5\x2a\x7c\x52\xec\x63\xa3\xff\xa2\x39\x42\xac\xed\x23\x47\xd4\x9a\xd7\x16\xbd\x5d\x7a\xbb\x97\xba\xd6\x2c\x34\x8a\x53\xb4\x3b\x7e\x7c\x6c\x5c\xc7\x2a\xbb\xf6\xdf\x6a\x30\xa0\x95\xce\xa1\xfb\x67\x98\x8b\x1f\x52\xac\x8f\xb4\xf7\x2f\xd1\x3e\x89\xdd\x1f\x2e\xda\xb5\xad\x47\x4f\xee\x8a\xa4\xc3\x7f\x93\x90\x3f\x7e\x84\x43\xf2\x4b\xd0\x7f\x09\xfa\x9b\x67\xf5\xe7\x0a\x7a\x96\x4d\x7f\x5e\x31\x27\x88\x37\x49\x39\x41\x10\xc4\xc7\x89\x39\x22\xdd\xb7\x38\xf9\xe5\xca\x7c\xf7\x1d\xfb\xde\xda\x74\x91\x52\xbf\x44\xe2\xe4\x5a\x0d\x1a\xcd\x43\xcb\x4d\x87\x93\x51\x3d\x8f\x4b\x8b\x91\xba\xbf\xcf\xdd\x2c\x1f\x99\xf9\x14\x61\xf3\x30\x75\x4d\x98\x4f\x37\x0f\xa9\x6b\x3a\xeb\xf3\x1c\x78\xa5\x93\x55\x8a\xd1\x9f\xb1\x25\x7f\x37\x85\x1f\x7f\x22\x0a\xbf\xf4\x0e\xfe\x6c\x5a\x5f\xa1\x69\x92\x9d\xdc\xbf\x99\xd4\x1f\x47\xad\xb7\xe8\x89\xab\x62\xa5\x85\x26\xbf\x3e\x4c\x7a\x60\x10\xbe\x73\x88\xf4\x45\x42\x22\x71\x23\x27\x1b\x46\x27\xd5\xdc\x77\x09\x49\x7d\x63\xa0\xf1\x2f\xa7\x97\xe3\x1b\x22\xbe\xff\xd8\x15\xd2\xfc\x76\x5b\xe6\x75\xce\x60\x9e\xfb\x6e\x7a\x10\x23\x3e\xd1\xc6\xde\x65\xd4\x0e\x21\x1f\x26\xdc\x5e\x4c\xe5\xad\x11\xe6\x79\xe2\xa5\xa6\xe3\x8e\x62\xe8\xdb\x2f\x13\x6c\xdb\x66\x61\xec\x94\x5c\x67\x46\x73\x13\xbe\x64\xf3\x13\xc2\xf2\x69\xe1\xa7\xf9\xdc\x84\x03\xd3\x9e\xfa\x91\x3b\x4a\xe3\x89\x7f\xa2\xd8\x66\xdb\x3c\xce\x2e\x35\x29\x09\x13\x87\xc9\x3c\x77\x53\xc1\x0c\xdd\x6d\x4e\xfe\xd3\x3f\xec\x38\xb2\xcd\x1c\x91\xe7\xee\xf6\xcb\xcd\xe1\x52\x94\x79\xaf\xdb\xcf\x5f\x6e\x6e\xef\x5e\x8f\x6f\x96\xb5\x46\x6a\xe6\xa6\xf5\xaa\xda\x30\x9e\x3b\x77\xf3\xcc\x4d\x5f\xeb\x06\xfd\x68\xbe\x7a\x9b\x47\xfe\xc9\xf1\x33\xd3\x82\xee\xc8\xcc\xb2\x65\x9c\x3a\xad\x79\x3e\x75\xa3\xdc\xdf\x8a\x69\x9e\xce\xdd\x0b\xda\x0c\x69\x9c\xab\x1c\xca\xa4\x4e\xa1\x9e\xf7\x43\x8e\x3f\x97\xa1\xee\xa0\x6f\xed\x50\x1c\xba\x0f\x3b\x8a\x3d\xdc\x67\xd9\xf4\xc1\x9c\xe7\xd3\x38\xf5\x37\xae\xf3\xaf\x00\x21\x70\x39\xba\xbc\x05\x1c\xb8\xeb\xb6\x99\x9b\x2f\x64\x60\x3f\x29\x7c\xd1\xab\xad\x3f\xe7\x43\xd1\xf5\xe7\x9f\xef\x0d\x65\xbf\xc5\x42\xd6\xf9\xe8\xab\x98\xdd\x0f\x4d\xcf\x95\xdc\x89\x9b\xba\x91\x7d\x79\xf3\x50\xae\x73\x36\x2d\xd5\x8b\xe4\x3a\x5d\xf3\xa2\xab\x1d\x4f\x26\x55\xf3\x6e\xa7\x7f\xa9\x71\xa9\x04\x3f\x3d\xdd\xf5\xc1\xe0\x52\xdb\xc5\xce\x70\x40\x33\x77\xb3\xfc\x7d\x8e\x49\x9c\xb5\xfd\x2c\xb8\x3c\x75\x3b\x75\xcd\xdc\x1d\x26\xb5\x91\x63\xd2\x38\x2c\x4b\x36\x2e\xe0\x59\x16\x5a\x3a\x57\x8d\x72\x73\xf3\xa2\xa0\x40\xa9\xec\xf1\x28\x75\x43\x7f\x1e\xfe\xab\x2f\xc9\x17\x76\x59\x1f\xc4\x47\x51\x69\xe8\xae\xe2\xa3\xd2\x41\x1c\x5d\xb5\x03\xfd\x23\x23\xc8\xaf\xfa\x58\xe5\x48\x5c\x94\xbb\xe9\xc4\xb4\xdd\x2b\x33\xa0\xf5\xe7\x8a\x85\xdc\xf3\xb3\x90\x91\xb8\x8b\x7c\xfb\x9a\xdc\xd7\x1b\x1c\xd9\xbd\x3e\x7e\x68\xa6\xeb\xab\xd4\xfa\xb6\x93\x9f\xbc\x71\xce\xfb\x9f\xeb\xf5\xf7\xcd\x29\x5a\xf8\x89\x5d\x8c\xfd\x06\x75\x7d\xf3\x4e\xe2\x1c\xf4\xcf\xe6\x56\xe4\xbe\xac\x6e\xbb\xba\xff\x55\xcc\x5b\x79\x26\xd5\x9f\xd9\x43\x39\x68\xcd\xbf\x8b\xc8\xcd\xab\xaf\xe5\x83\xab\x4d\xcc\xfe\xe7\x42\x30\xe5\x24\xf2\xef\xe1\x92\x43\x00\x87\x4e\xef\xdb\x63\x84\x27\xa1\x1e\xf3\x46\xe2\x27\x7f\x0c\x3d\xf6\x95\x0c\xf5\x86\x04\xee\xa5\xcf\xfb\xe8\x70\xf3\xe7\xc7\x18\xcf\x7d\x2e\xfb\x36\xc7\x9f\xd7\x7d\x9d\xf7\xc3\xbf\xae\xe5\xe5\xd1\x5f\x87\x73\xfe\xe9\x69\xc8\x67\x7d\x31\xd7\x9e\xa7\x7e\xbe\xbe\xca\x88\x9e\xa8\x9e\x3e\x74\x4b\x8f\x1b\x9c\x5d\xce\x73\xe8\x38\xbe\xe9\x45\x71\x96\xfb\xf6\x75\x7b\x21\x2b\x8e\xf3\xf6\xae\xcf\x65\xd3\xee\x46\x68\xcb\xe1\x5c\xb9\xbb\x28\xfd\x1c\x35\xf5\x0f\x76\x58\xf5\x39\x95\xa3\x6d\xd6\xa9\x32\xcb\x62\xcb\xf5\xf0\xba\x07\xf0\x26\x02\xb9\xab\xdc\x8d\x90\x57\x79\xdd\x82\xd5\xad\xbf\x8b\xb7\x60\x67\x97\x5c\xcb\x9b\x77\x7b\x0b\xfb\x6e\xfc\x4e\xbd\xb4\x8a\x73\x40\x9d\xdd\xac\xae\xf4\x23\xea\x90\x11\x3d\xcf\xf2\x38\x94\x8b\x12\xd6\xb7\xf4\xed\x9a\x91\x03\xdd\x74\x3f\x12\xb4\x3d\x3e\x74\x11\x80\x39\xcf\x63\xb5\x0c\x32\x0c\xfc\x28\xde\x83\xf2\x06\x4f\x28\x73\xf3\xdc\x8f\x8a\x92\xa3\xff\xbb\xd2\x90\x14\x31\x44\xd7\xce\x5d\x47\xde\xeb\x7c\xfd\xde\xb3\xac\xf4\x2d\x38\xdf\x32\x33\xf7\xeb\xe3\x6f\x95\x00\x94\x7f\x29\xb1\x5c\x54\xcb\xfe\x76\x6b\x13\x00\xe3\x68\x1c\xba\xad\xb8\x77\xfb\xf9\xcb\x6d\x4b\x1a\xd0\x7d\xae\x23\x28\x5c\xfb\xef\xff\x53\xb5\xbe\xb9\x73\x6e\xfe\x77\x8e\x61\x0d\x7b\xff\xdf\xdb\xdb\xdb\x2f\x15\xec\x7d\x49\xda\x3b\xc9\x73\xfb\xf9\xf3\x97\xdb\xdb\xdb\xcf\xff\x1b\xdd\x7e\xb9\x1d\xb4\x07\x8c\x34\x14\x94\x8e\xd0\x56\xa5\xfe\xfb\x60\x1f\x9e\x44\x38\x02\x2f\xb7\x3b\x02\xe0\xa4\xa1\x30\xe8\x08\xca\x7b\xe1\x1f\x9c\x52\x38\x18\xa0\x45\x4b\x52\x47\x1e\xaa\x12\xdd\x79\x37\x69\xf6\xcf\xa4\x1d\x02\x6f\x0f\x38\xa1\x35\xe2\x4a\xd2\xd3\x1d\x49\xa1\x87\x83\xc1\x50\x10\x5a\x83\xce\x3b\xc7\x7a\xe5\xd8\xd9\xe1\xd0\xd2\xe0\x63\x07\x3e\x7b\x72\xec\x60\x58\x8a\xeb\xf7\x39\x81\xed\x10\x1d\x59\x19\x4a\x2d\xb6\xd3\xa2\xe9\xa1\xfa\x7e\xb6\x3b\x7f\xbe\xeb\x60\x58\xba\xaf\xca\x4a\x47\x42\xdc\x42\x0f\x05\x86\x63\x41\x47\x92\xb9\xa1\xf0\xbe\x41\xcf\x9d\xba\x3a\x35\xe4\xa8\x25\x75\x04\xa5\x3d\x1c\xb4\xb8\x6f\xa0\xee\x99\x93\x59\x07\x03\x32\xa3\x6f\x93\xe0\xdd\xe9\xa5\x03\xb0\xa3\xa1\xa4\xb4\xfa\x2d\x9a\xee\xc8\x32\x2b\x0d\xd5\x11\xd7\x96\xdf\x37\xc0\xa9\x73\x3c\x27\x86\xfa\xb6\x59\x1c\x1e\xf1\x39\x01\xbe\xd3\xef\x80\x96\xd2\x69\x7f\xc4\x5c\x8e\x4f\x01\x1d\x0c\x27\x8d\x98\x4e\x4b\x51\xa5\xce\x3b\x87\xd8\x1d\x0e\x3a\x02\xcb\x0d\x5a\xec\x3b\xf9\xa8\x3a\x10\x74\x04\xf0\x83\xc4\xe2\xc4\x81\xa1\xa3\x81\x3e\x46\x18\x5e\x9e\x27\x3a\x18\xa6\xdd\x52\x5a\x54\x4b\xae\x35\xcb\xfb\xc7\x39\x71\x82\xf1\x60\xa0\x5e\x67\x0c\x5a\x6a\x5f\x19\x49\x1d\x86\xd3\xdf\x37\xc6\xe1\x41\xbd\xd3\xc6\xa1\x45\xa9\x42\xbb\xdf\xf9\x3b\x02\x71\x52\xd7\x57\x47\x82\x6f\x8b\xce\xfb\xda\xfd\x6c\xcf\x83\xa3\xc4\x7b\xfd\x06\xed\x41\xc9\x5d\xb7\xb7\x0f\x9e\x1b\xb9\x0b\x33\x74\xc2\xbf\x85\x26\x52\x3e\xff\x22\x30\x02\xc7\x1e\x31\xfc\x1e\xaf\x5a\xf7\x87\x74\x4b\x79\x13\xbb\x9c\xcb\xe1\xed\x4d\x5b\x56\x29\x99\x96\xb8\x11\x02\xfc\x16\x25\xb0\x7f\xce\xe9\xb7\xcf\xf7\xfb\x7f\x72\xce\x3e\x17\x56\xd6\xbc\x90\xff\xb7\x31\xc7\x31\xf6\xc8\xb9\xde\x83\x8c\xfe\x39\xf6\xb3\xe8\x08\xe2\x96\xdc\xca\x5d\x99\xc2\x6d\x56\x9a\x3a\xac\xea\xf5\x75\xcf\x03\x18\x33\x30\xb5\x26\xee\x76\x98\xc8\xd0\x9a\x18\xed\x25\x99\x13\x82\x47\x87\x05\x73\x83\x6e\xe5\x16\xdd\x4a\x05\xa5\x05\x25\xc8\x33\x92\xdc\x5a\x18\x2c\x20\xfa\x0d\x7e\x61\x35\x24\xc2\x58\x93\x6b\x8b\x20\x31\xab\x3b\xee\xb9\xac\xb1\xd1\x09\x67\x6d\x35\x9c\xd0\x5e\xb7\x16\xa7\xe0\x0c\x94\xd6\x92\x57\x0d\x59\x52\x55\xaf\x4f\x48\xd0\xf1\xcb\xfe\x4e\x68\x2f\x9c\x90\x59\x9f\x82\x83\x7e\xa7\xbd\x78\xc6\xb1\x0c\x61\x11\x30\xe0\x68\x1e\xda\x11\xbf\xb0\x67\xb1\x67\xb0\x1c\xce\xb1\x60\x6d\x87\xe4\xba\x47\x63\x9b\x41\x3b\x20\x86\x72\xe0\x19\x11\xbf\xb0\x64\x2a\x18\x87\x60\xee\xf8\xd8\x5f\xad\x06\x05\xad\x59\xec\x89\x81\x44\x0f\xda\xad\xe6\x40\xa6\x3a\x22\x24\x35\x09\xf0\x8a\xac\x92\x43\x1d\xc3\x79\x15\xc3\x29\xd0\x11\xb8\xa1\x4f\x75\xc6\xba\x34\x1d\x87\xcc\xc6\x90\x29\x68\x45\x46\x62\x87\xe4\xdc\xd2\xc0\xdc\xa1\x29\xc2\xd0\xf9\x8d\xa9\x91\x73\x8e\xc5\x13\x9b\xc0\xa7\x0e\x2b\xc4\x9c\x97\xac\x11\x6d\x0d\xbf\xc4\xb7\x4f\xac\x92\xb1\x4f\xae\x6d\x16\x5b\xe8\x38\x19\x8c\xfd\xb8\x47\x47\xfc\x12\xb5\xe9\x6b\x30\xb7\x59\x72\xed\xd0\x54\xec\x74\xa5\xa5\xbd\x89\x17\x7d\x42\xca\xfa\xa1\x01\x0d\x96\x5c\x8f\x75\x6a\x6d\x11\x09\x1c\x37\xc4\xb9\xd5\xe0\xa3\x7e\x83\xc2\xc7\x3e\x09\x6d\x16\x64\x7d\x9c\x17\x15\x19\xef\xaa\x1d\x3b\x97\x31\x60\xf4\x55\x20\x4a\xea\x32\x17\x96\x09\x1a\xcb\xeb\xcb\x78\x62\xe9\xd4\xc2\x8e\x44\xcf\xec\x4a\x98\xdd\x1d\x7c\xed\xaf\xc9\xe5\x58\x13\xd2\xb1\xe6\x40\x7b\xdd\xcc\x4d\x4d\x58\x5b\x0d\x61\x61\x44\xe2\x7c\x4c\x90\x79\x9f\xc8\xa1\xab\x0f\x16\x96\x06\x67\x76\x48\x6e\x2c\xc2\xc0\xfa\x21\xb3\x19\x5f\x0f\x33\xb4\xba\x00\x5a\x91\xe4\x9b\xba\x38\x37\xb5\xe7\x85\x11\xae\x70\xc4\x4b\xe3\x10\x62\xfd\x30\x87\xae\x18\xf7\x8c\x90\x5c\x73\x2c\x83\x39\x2c\xc8\xed\xae\xe8\x99\xda\xa3\xe7\x6e\x3a\xf3\xfe\x0c\x90\xc3\x35\x15\x58\xcb\xd8\xe3\xba\x5b\x1e\x4d\xac\x48\xc0\xc6\xda\x2a\xe3\xd8\x29\xe6\x74\xa9\xcd\xd0\x7f\x5e\x18\xec\x72\x6e\x84\x20\xb0\x1a\xfc\xd4\xee\xf2\x0b\x33\x04\x33\x87\x6e\x2e\xec\xd0\x5e\xd8\x5d\xe0\xf7\x09\xb0\x34\xb4\xe5\xc2\xd0\x29\x68\xd1\xf8\xda\xd0\x56\x70\xac\x0b\xb0\xaf\xad\xa6\x0e\x0b\x36\x0e\x8d\x35\xfa\x61\x73\x31\xd6\xf9\x99\x49\x37\x8b\xf9\xf1\xfe\xd8\x1b\x47\x3c\x1c\x6b\x59\x8f\xa3\xa9\xc4\xf0\x29\x4b\x5b\xb7\x02\x97\xa8\x71\x95\x48\x8e\xc6\x33\x87\x6e\xe1\x1c\x83\x3b\xc3\x35\x85\x99\x2c\x98\x73\x5d\x21\x33\x34\xb0\xe4\xda\x9d\xe5\x70\x4d\x41\xab\x2b\x40\x8e\x05\x8f\xa6\x2e\x7a\x03\x25\xf3\x8c\x30\xe8\x19\x2c\x39\x37\xc4\xb8\x37\x26\x18\x8c\x6b\x3f\x2e\x0c\x5d\x9a\xf5\x1b\x68\x8e\xcd\xb5\x81\x68\xba\x6e\x06\x7d\x82\xf9\xea\xe8\x3c\xec\x47\x3c\xb4\xd9\x67\x6f\xd4\x5e\x46\x92\x4a\xb2\xfc\x32\xb1\xc6\x7a\x82\xdb\xa1\x9a\x8f\x89\x55\xa2\x8b\xc9\x7c\xac\xe1\x70\xa4\x55\xed\x35\x21\x33\xc5\xc4\x47\xf3\x73\x74\x3e\x1b\x69\x3b\x3a\xd9\x2c\x33\x33\x09\x26\x32\xf4\xc1\xfc\x70\x5d\x85\x85\x25\x93\x4d\x47\xc3\xab\xf1\xc9\xa9\x1b\x81\xb5\x21\xe3\x33\x8b\x0d\x7a\x86\xd6\x9c\x8e\xc3\x15\x34\xda\x78\xd3\xd0\x07\x3d\xa3\x41\x45\x63\x62\x0a\xc7\x44\x46\xba\x1a\xd8\xd0\x5e\x8d\x13\x98\x59\x0d\x1e\x1e\xe3\x34\x26\xc8\xb5\xf1\x51\x38\x69\xc2\xc2\x0e\xd5\x57\x71\xb2\xc2\xe7\x1e\xa2\x15\xed\x25\xb3\xb1\x2e\x7a\x23\x9f\x84\x0e\x3b\x58\xb8\x3a\xc8\x4b\x7a\x92\x9b\x7e\x28\x2e\x1c\x56\xcc\x11\xaf\x5a\x91\x98\x17\x3c\x79\x82\xd6\xc7\x6d\xb6\x73\xd3\xa5\xa0\xaf\x95\xba\xb1\xaf\xf1\x89\xd3\xba\x3c\xbf\x43\xfe\x87\x8b\x3e\x21\x20\xf9\x58\xd8\xeb\xe7\x46\x7f\x2d\x15\xfd\x0b\x1e\x6c\x25\xd0\x0a\x19\xdf\x62\x41\x30\xd2\x21\xb4\x97\x49\x64\xb3\xce\xcc\x64\xc1\xcc\xdc\x94\xf3\xad\xe6\x17\x5a\x0d\xce\x1b\xeb\x12\x66\x68\xf8\xd2\xa1\xa9\xc4\xf2\xa9\xa7\x81\xfc\x38\x17\x74\xec\x89\x63\xa5\x45\xad\xdf\xfb\x1a\x98\x8f\x35\x3e\x33\xf4\x62\x8e\xa4\x1d\x4e\x71\x53\xc6\xd7\x26\xd2\x1f\x8a\x9d\xdb\x04\x58\x3b\x21\x58\xf7\x75\x3e\x76\xb4\x20\xb7\x1a\x14\x86\xf4\xd9\x58\x5b\xe6\x76\x44\xe5\xf6\xfa\x58\xfe\x98\xaf\x36\x01\x66\x88\xdf\xc6\x1a\x3e\x75\x7c\x6a\xea\x46\x02\x1c\xaf\xf1\xdc\x22\x9a\x89\xc3\x16\x72\xbd\xe3\x49\x99\xaa\x79\x2a\x37\xba\x42\xb0\x7d\x86\xe6\xdc\x00\x6b\x53\x97\x9a\x08\xdf\x31\x91\x43\xdb\xa7\x16\x36\x0b\xe6\x76\x43\xc8\xfa\x3a\x05\xed\x70\xe9\x1d\xaf\x03\x47\x8f\x43\x8e\xe5\xd7\x86\xc6\xa4\xb4\xdf\xf2\x4c\x6d\xec\x69\x78\xe6\xf1\xdd\x7c\xea\x74\x25\x68\xe9\x14\x36\x91\x5b\xb9\xd5\x15\x3d\x41\xa6\x1c\x5d\xc9\x3c\x87\x9d\x42\xcb\xa7\x36\x16\x0b\xa0\x4d\xb7\x56\x83\x76\xe6\x19\xda\xaa\xd0\xe7\x2e\x0b\x31\xae\xdd\x79\xe2\x58\x23\xa1\x43\x69\x61\x85\xea\x56\x37\x1b\x72\x2b\xe8\x75\xcb\xef\xb6\xd6\xf1\x26\x34\x15\xd9\x21\x58\x72\x4c\x73\x3a\x8e\x78\xac\x2f\x07\x47\xb2\x2c\x34\x6d\x42\xc0\x2c\xba\x19\xf4\x37\xad\x55\x5f\x93\x12\x9b\x40\xeb\x89\x64\x96\x5c\x1b\x72\x73\x66\x11\xcd\x90\x6b\x2f\x9f\x79\x0c\x8c\x24\xdf\xee\x99\x04\x58\x5b\x21\xc8\x10\xdf\xdb\x21\x98\xd8\xa5\x4e\x5c\x5b\x7e\x8b\xe4\xba\xcb\xc5\x38\x84\xf3\x7e\x43\x5a\x3b\x9a\x5a\xae\x7d\x54\x8f\xd1\xca\xfb\xba\xd0\xb4\x1b\x12\xb4\x0a\x7d\x0a\xd7\x86\xee\x4c\x2d\x76\x99\x8f\x09\x3c\xe0\x68\x2c\x1f\x6b\x52\xd0\x2f\x75\x35\x29\xb4\xc5\x4d\xbf\x21\xcd\xec\xa2\x1f\xa2\x2d\x3e\xb5\x90\x3d\x6c\x25\xa1\xa9\xf3\xd0\x21\x98\xcc\xa2\xf1\x99\xa5\x89\x48\xc7\x4f\x0d\x56\x2c\xed\x52\x1b\xc3\x84\x36\x92\x19\x61\x89\x60\xda\x08\x37\x8d\x99\x17\xbe\x41\x88\x6c\x21\x68\x20\xbe\xe8\x6b\x42\x8e\xec\x7a\x5f\x63\x02\x83\xc6\x97\x56\x83\xc7\x46\x0a\xb7\x1e\xcc\xb8\xd3\x7d\x8f\x64\xf4\x85\x4c\x36\x8e\xe4\x8c\x7e\x49\x3b\x0d\x83\x43\x95\x01\xaa\x2e\xc6\xbc\x12\x32\xb9\x21\x53\x1b\x57\x17\x90\x4c\x04\xb4\x07\xd5\xb1\x66\x7b\x66\x48\xe2\x76\xd8\x9c\x5a\xac\xd8\xa3\x41\x45\x2f\x4d\x9a\x48\x21\xcc\x10\x9d\x39\x86\x6c\x2b\x18\x2e\x8c\x34\x66\x6d\x2d\xe3\x9e\x86\x19\xbc\xc2\x48\x8c\x0a\xb1\x1e\xad\x36\xa7\x96\xa6\x7a\x96\x46\x06\xa6\x66\x34\x69\x0f\x0a\x63\x5d\x9a\x99\x34\xf5\xbb\xd5\x40\xeb\xc6\x64\x46\x2b\xe6\xd5\x10\xe4\x56\xc3\x80\x7a\xc3\x49\x2c\x56\x9a\x8d\x75\x3e\xe0\x98\xe7\x1e\x0d\x78\x68\x69\x24\x61\xc8\x94\x2a\xab\x38\xa3\xe2\x12\xa5\x80\x56\x8f\x86\x39\x2b\xab\x2b\x55\x02\xbc\x43\x7b\x70\x88\xf4\x0a\xd7\xe5\xa1\xd3\xe0\x13\x87\x05\x13\x87\x65\xa2\xb3\x63\x45\x20\x43\x7c\xa9\x74\xc8\xae\x8c\xc1\xa1\x84\x6c\x54\x34\x9d\x3a\x9a\x94\x38\x87\xdf\xc3\x31\xe2\x71\x11\xcd\x89\x04\x80\xa1\x00\x60\x76\x73\x42\xf6\xd7\x21\x98\x35\x82\xa9\x68\x0c\x36\x26\x3c\xaf\xe7\xc5\xbc\x5a\xf8\x22\xad\xf5\x50\xe1\x36\x83\x56\xc2\x28\xd8\xb8\x47\x87\xcc\x57\x8e\x5d\x2d\x0c\x02\xce\x39\x1a\x4f\xca\xbf\x99\xd9\x98\x20\x71\x2b\x12\xbd\x2a\x66\xb7\xe1\x68\x2e\x50\x71\x40\xab\x98\x20\xcb\x00\xcd\x99\x1c\xca\xaa\xe8\xd3\x5e\x52\xaf\xcb\xcc\x61\x97\x9e\xdd\x90\xa6\xc8\x7f\x30\x58\x72\x86\xf8\xbf\x1f\x09\xd0\x8e\x8c\x64\x4c\xa8\xbd\xb1\x1e\x7b\x63\x4d\x58\xef\xc6\xc3\x72\xab\x58\xdb\x96\xcf\xd3\xd3\x8d\x81\xf8\x53\x53\x3d\xbe\x21\x3c\xf7\xfd\x78\x31\xe9\x2e\x23\xc4\x13\x23\x9a\x0b\x44\x55\x90\xd5\x00\x28\x0a\x0e\x64\x11\x03\xbc\x44\x73\x09\xe7\xc5\x3d\x45\x95\x04\x59\xc5\x29\x09\x53\x49\xce\x97\x9e\x54\x48\xf1\x8a\xca\x74\x25\x59\x85\xfd\x75\x42\xf6\xd7\xd2\xd3\x5e\x9b\x19\xb7\x8e\x17\x13\x99\xeb\xd5\xf8\x71\x5d\x0a\xb7\xd8\xa5\xc7\xf9\x92\x20\x75\xf0\xaa\xef\xc9\xe7\xb2\xda\x81\x82\xa8\x3a\x0c\x1a\x17\xcd\xc5\x62\xc9\xc8\x6a\x00\xc4\xef\xb9\x49\x48\x89\xed\xb7\x4a\x5d\x41\x30\x6b\x6b\x8d\xaf\xed\xca\x7e\x08\x33\x34\x2f\x11\xd9\xa0\x67\xce\x97\xa8\x62\x9c\x80\x11\x65\x55\xa0\x44\x08\x86\x52\x67\xc5\x70\x7e\xeb\xaf\x7d\x02\x60\xe3\x35\x39\xb5\xc3\xe7\xdc\x8e\x5a\x8b\xb1\x26\xe5\xa6\xf6\x98\x8f\x89\x4e\x3e\x8e\xc0\xdc\x60\x57\xb0\x1f\x51\xd0\x12\x93\xda\x77\xc9\x2d\xbf\xe5\xf3\x1d\x46\x56\xd4\x63\x78\x7b\xfa\xd1\x8b\x3d\x8e\xe5\xa7\x36\xa1\x12\x02\xdd\x42\xb2\xfc\x3c\x6a\x2f\xcf\xf4\x3b\x81\x87\xce\xe7\x7d\x4d\x98\xf6\x35\x1e\xb7\x42\x29\x33\xe4\xe6\xd2\xd0\xb0\x1e\xf2\x7b\xc6\xc4\x74\xe1\x10\x8f\x5e\x1f\x70\x1e\xf2\xf9\x07\xed\x78\x35\x68\xb7\x96\x1c\x5d\xca\xfe\x58\xe7\x17\x7d\x9d\x5f\x1e\xeb\x04\xbb\x01\x37\x63\x82\x9c\x1b\x21\x8c\xfa\x04\x1e\x58\x72\xeb\x79\xd4\x01\x23\xc9\x4b\xd0\x3a\xb0\x6a\x40\x0e\x41\x07\x0c\x25\x06\xc8\x4a\x1b\x8b\xf8\x0e\xde\x51\x54\x43\x56\xb0\xa6\x2a\xa9\xcd\x0e\x00\xfc\x80\x5f\x26\xbb\x35\x53\xea\x36\xe5\x1a\x55\xcf\x6a\x7e\x61\x14\x68\xf0\x08\xa6\xa2\x82\x21\x28\xe0\xad\x46\x22\xc6\x14\x7c\x7c\xd4\x56\x56\xb0\x15\x33\x42\x38\x07\x78\x47\x01\xc2\x08\x00\xbe\x2d\x01\x7e\xa4\x74\x00\xaf\x40\x41\x15\xd5\x66\xbb\x18\x8f\x9e\xc6\x56\x43\xc0\x4a\x1e\x0e\x22\x3a\x40\xf8\xc7\x3d\x4b\xcb\x03\x53\xe7\xbc\x7e\xc3\x98\xda\x68\xbe\x5d\xfb\xa5\x2d\x29\x74\x95\x58\xd0\x01\xf9\x9e\x25\x0d\x9a\x1b\x43\xe7\x09\x53\x13\xe0\x81\x2e\xc4\xc1\xdc\xd4\x25\x87\x0e\x98\x10\xe9\xb5\x91\x56\xdb\xd4\x5d\x7b\x1a\xf2\xd0\xd6\x01\xd2\xd9\x9b\x93\xcf\xbd\xc4\x52\x0b\x7f\x00\xce\x0c\x80\xf5\x24\xad\x49\x98\x3a\xbf\xb0\x42\x1c\xf9\x2b\xac\xa9\xad\xe0\x48\x3e\xb3\x36\x62\xc2\xb8\x2c\x98\xa9\x85\x6c\x4b\xa2\x1d\xaa\x64\x5f\x26\x71\xbb\xc1\x95\x36\x8c\xd8\xda\xf8\x7a\x6f\x04\x15\x6f\xbf\x8f\x48\xf6\x1b\x60\x63\xfb\xa4\x6f\x6a\x8f\x8b\x9d\x6c\xf1\xb8\xe5\x53\x36\xb2\xf5\x7d\xb9\xc0\x63\xed\xea\xd4\xc2\xd4\x9a\x18\x47\x97\xf0\x6d\x82\x4f\x2c\x9f\x14\x0c\x5d\x5a\x9b\x9a\xb0\x91\xf4\x29\x66\x68\xcd\x72\x5f\xc6\x2c\x7b\x5c\x61\x97\xa6\x0b\xbb\x21\x15\x3e\x1c\x47\x03\x6e\xf7\x7b\xa9\x0f\x79\xf5\xd1\xd3\x5b\xb1\x57\xd8\xa9\x10\xab\xbe\xe3\x39\xd7\xe6\xa3\xba\xad\xb3\x95\xdd\x62\x1d\x10\x7f\x7f\xad\xe4\x20\x37\x58\x6c\x6e\xb3\x20\xdf\x6f\x5b\xee\xfd\x00\xe6\x6c\xe2\xbd\xef\xc9\xd7\xaa\x4d\xb0\xa7\x73\xea\xf1\xda\x86\xce\x63\xc8\x36\x19\xf2\x8b\xb1\xea\x36\x2c\xda\x7f\x3a\x1d\x30\x37\x18\xb0\xb6\x2a\x38\x12\xe4\x47\x0a\x94\x18\x25\x90\x80\x1a\x2c\xeb\xb6\x03\x8b\x70\x22\x43\xe7\x3c\x91\x20\xe7\x36\x41\x66\x86\x5c\xd1\x52\x5d\x2d\x0c\x6c\x05\x9d\x10\x64\x1c\xe3\x4c\xed\xb0\x99\x58\xa1\x5d\xf7\x13\xed\x10\x12\x63\x5d\x82\x32\x01\x9a\x17\xf0\x51\x90\x7d\x1a\x13\x80\x39\xdc\x1b\x97\x78\xa9\x18\x09\xd4\x40\x60\x24\xb5\xa9\xc9\x48\x3e\x02\x9c\x51\xa0\x78\xdc\x57\xb6\x88\x15\xe4\x68\xe9\x85\x8c\xd5\xf4\x54\x09\x64\xc7\x05\xa8\x86\x64\x66\xa8\x70\x8e\x6c\x88\x15\x0a\x27\xfb\xc8\x6a\x53\x01\x1d\x66\x28\x62\x6a\x4f\xd2\xa7\x70\x8c\x0b\x98\xd5\x68\x9d\xe1\xaf\xe2\x19\x5a\xff\x9e\x1a\x82\x8d\xc3\x32\x6b\xa7\x8d\x4f\xad\xae\x33\x75\xf5\xc1\xee\x37\x46\x80\xe3\x0d\xb6\xa2\xa1\x80\x8d\x75\x1e\x53\x58\x98\x9b\xba\xc4\x5b\x91\x84\x6c\xd7\xd4\x6a\x63\x48\x7f\x59\xb2\xd6\x44\xed\x33\x8b\xc1\x7a\x80\x60\xe6\x0e\x0b\x02\x31\x0a\x48\x4b\x07\x99\xc3\x06\xb9\xa3\x0b\x48\xe6\x10\x8c\xc8\xd0\xc5\x93\xfb\x95\x43\xd9\xaa\xec\x04\xbd\xd5\x7d\x94\x88\xf3\x93\x3d\x3b\x37\x91\x55\x91\xe4\xd7\x12\xfa\x5d\x96\x6a\x5d\xa4\xc2\x0e\xbf\x4c\x4a\x3b\x02\x49\x4a\xed\xc0\x89\x88\xad\x78\x49\x6d\xaa\x3a\x26\x30\x2a\x94\x26\x22\x46\x0a\x4a\x11\xef\x68\x52\x8a\xaa\x16\x30\xf6\xec\xce\x40\x46\x7a\xb0\x53\xb4\x2d\x7c\x24\x05\x6b\x0e\x45\x15\x47\x7a\x93\x52\x03\x7c\x22\x02\x8a\xd7\xb1\xaa\x1d\x43\x22\xfd\x87\x60\x8f\x14\x15\x1f\x29\x90\x2c\xda\x8e\x64\x3b\x10\x01\x2f\xa0\xb6\xf5\xf8\x68\x9d\x40\xa7\x6a\x17\x14\x63\x47\x74\xc0\x98\x00\xf0\x8c\x8e\x31\xb2\x02\xc8\xb6\xd2\x81\x8c\x02\xa5\xed\xdc\xd4\x00\xaf\x7f\xe3\x25\xda\xee\x89\x20\x01\x6a\x00\x26\x12\xa4\xf6\xe6\x05\x3b\x68\x3c\x09\x52\x87\x6d\x03\x38\x50\x3a\x70\x28\xe1\x24\x33\x08\xc0\x44\xc5\xa5\x91\x1a\x30\x5d\x09\x90\x94\x88\x09\x23\xb0\xd7\x77\xdb\x16\x53\xd7\x12\x10\x54\x05\xe7\x29\x09\x03\xdb\x76\xb2\x2a\x46\x74\x20\x0c\x00\x10\x90\xff\x36\x51\x54\x49\x91\x0a\x1f\xb2\xc9\xca\xaa\x33\x01\x01\x90\x55\x0c\x8e\x94\x19\xb2\x1f\xdb\x76\x82\xc4\x08\x1d\x11\x23\x87\x52\x00\xbb\xdb\x36\xbe\xdd\x93\x3a\x8c\x2a\xaa\x3c\xa5\x62\x60\x22\xaa\x42\x5b\xc1\x0b\x5a\x6e\x69\xb7\xf7\x7c\x8b\x83\x12\x30\x82\x24\xa3\xbe\xa4\x20\xaa\x70\x7f\xfd\x06\x0a\x26\x50\xa0\x83\x60\x3f\x06\x22\xb6\x02\x2a\x8e\x7c\x59\x8a\x52\x03\xb4\x96\xd2\x48\x51\x19\x7e\x47\xf3\x9c\xd1\x80\x41\x01\x75\xa5\xea\x38\x25\x4b\xaa\xc1\x6b\x85\xdd\xdb\xfd\xae\x32\x3c\x23\x05\x70\x5c\xd9\xbf\x1d\x8e\x6d\x9c\x2a\xf6\x75\x80\x87\x46\x67\x3a\x75\x3a\xe4\xd2\xd0\x9a\x8a\xc9\xc2\xd0\x61\x78\xb1\xb4\x8b\xe5\x3c\x54\x9c\xa2\x44\x4c\x25\x55\xd8\xea\x55\xf4\x3a\x61\x6b\xf1\x8e\x8a\x49\x87\xbf\xd3\x76\x4f\x85\xd4\x44\x0a\x00\x05\x18\x20\x4b\x60\x80\xe6\x28\xab\x1d\x83\x11\x01\xd2\x73\x40\xe1\x97\xc9\xd6\xe7\x42\xf4\xda\xf9\x76\xf6\x59\xd9\x42\xb6\xd4\xa0\xc9\xca\x27\xc5\xb6\xfe\xc7\x9e\x1f\x7a\x10\x0b\x00\x5a\x33\x71\x18\xac\x27\x6a\x06\x66\xe8\x1c\x79\xca\x6f\x55\x43\xb0\x72\x34\xb4\x07\x1b\x9c\x7c\x4e\xc3\x5c\xa9\xed\xac\x2e\x26\x87\xba\xb2\x63\x24\x16\xab\x92\x95\x2d\x41\x78\x15\x7b\x1d\x53\xb3\x77\xbe\x51\x00\x1e\x0d\x4d\x50\x4a\x5d\x44\xad\x0d\x05\xcb\xcf\xd8\xcb\x9c\xa3\xf1\x90\xa3\xc1\xf0\xa0\x4f\x1b\x5b\x38\xba\xb0\xee\x97\xb6\x32\xb0\x08\x21\x45\x76\xc1\x8e\xc0\xb7\xda\x48\xc8\x75\x98\xb6\x0a\xc9\x9d\xee\x01\x24\x2f\xd1\x95\x9d\xd1\x54\x4f\x2c\xec\x5c\xf3\x2a\x7d\x51\x8f\x69\xc8\xd4\xd5\x3a\x66\xbf\x8f\x06\x11\x9f\x90\x65\xdf\x3d\xbd\x70\xa2\x0d\xab\x32\x07\x7a\x66\x6f\x3e\x3c\xaf\x74\x56\xbc\x12\x38\x13\x49\xe1\x19\x1d\xdf\xea\x81\x7d\x19\x3d\x9c\xe3\x56\xd6\xc8\x42\xd6\x0b\x1c\x03\x83\x97\xf0\x5d\x5c\x79\x0f\x87\x8e\x08\x24\x4a\x0c\x18\xe5\x50\x07\x15\x72\xb9\x87\x47\x25\xaf\x2f\xe7\xd9\x97\x00\xd4\x44\x00\x06\x80\x21\x45\x35\x00\xac\x0c\xbc\xbd\x7e\x85\xec\x6d\xe1\xee\xf5\x2b\x64\xb8\xd0\x1f\x40\x10\x45\x55\x60\x0e\x9e\x15\x78\x83\x21\x08\x20\xf2\xa1\x05\xe9\xb0\xaf\xac\x32\x24\x2b\xa9\x8c\x8a\xf4\xb4\xba\xae\xe7\x8e\x79\x83\x9d\xdf\xb0\xe4\xda\x22\x36\xd8\xc4\x8f\x42\x5b\xdc\xbc\xf4\x9b\x2a\x5b\xd6\x8e\x0f\xff\xae\xe9\xa8\x8f\xbd\x7e\x03\xf9\x7b\xe4\x76\x1f\xdf\x27\x92\x85\xa3\xf3\xf3\xb1\xb6\xfc\xfa\xca\xb3\x7a\x7c\x82\xa3\x49\x62\xac\x73\x08\x7e\x63\xe8\xef\x7d\x8f\xe2\xaa\xcd\xde\x1e\xad\xc0\x8f\x42\x7b\xb0\xda\x0f\x5d\xd8\xe7\xe4\x04\x3d\x43\x73\xa1\x5b\x1b\x61\x86\xf6\x2e\x60\x48\x43\x29\xb1\x34\xb0\x70\x74\x49\x71\x58\x72\xa9\x12\x60\x36\x52\x06\xc4\xa0\xdd\xfa\x91\x7d\x89\x5d\x9c\xae\xbd\x7c\x2e\xfa\x1c\xda\x33\x41\xc7\x98\xb6\x88\x91\x40\x81\x22\x29\x96\xf1\x5d\xd9\xd0\x24\x6e\xac\x4b\x23\x9b\x05\x73\x95\x98\x26\x46\x24\xb5\xad\x2e\x9a\x0f\x58\xbf\xec\x8f\xf8\x18\x28\x6a\x87\x69\x4b\x0a\x4e\x8b\x01\xf6\x4d\x76\x4e\xc6\x80\x01\x02\x06\x28\x8c\x34\xa9\xed\x11\xea\xb3\xf7\x7b\x2d\x03\x51\x65\x77\xb6\x30\x46\x6a\x19\x6f\x54\x43\x10\xc8\x2c\x83\x29\x0d\x0a\x5a\x50\x88\x4d\xcd\xc0\x2a\x7b\x74\x12\xdf\xad\x1d\x41\x7b\x38\x86\x17\x2b\x3f\x7d\xbb\x7f\xbb\xbc\x57\x3b\xa4\xf5\x0f\x6b\x5b\x34\x7c\x61\x85\x10\xb3\x1a\xdc\xd6\x57\x46\x3c\xa9\x76\x79\x38\x92\xcf\xc8\x42\x88\x79\x7d\x6d\x5c\xf8\xcc\xfb\x7d\x46\x67\xf6\x7b\x8e\xb6\xb3\x1b\x26\x4b\x6e\x1c\xb6\xb6\x27\xde\xf6\xf7\x2a\xc6\x03\x95\xdd\x5e\x6f\x6d\xc9\x67\xf4\xeb\xbe\x1f\x72\x4e\x07\x9f\xd7\x73\x57\xea\xde\x13\xbe\xd1\xde\x58\x47\x72\x72\xac\x7b\xf7\xfd\x9e\xb3\x7b\x49\x64\x7b\xf7\x79\x67\x1b\xab\x6c\xc7\xe7\x9f\x1d\xe8\xca\x6d\xec\xe0\xeb\xfe\x77\xb7\xa2\xe1\x1e\xdf\x96\x38\xb0\xbb\xb5\xfe\x81\xf5\x53\x11\x3b\x46\x3a\x7d\xd4\x5e\x15\xf1\xc7\xda\xd7\x57\x3b\xa4\x0c\x3a\xcc\xa0\xa4\x3b\x50\x54\x9c\xec\xaa\x01\x09\x54\x64\x8b\x99\xc2\x37\x14\x15\x9c\x57\x45\x75\x85\xd6\x95\x51\x71\xa1\x7c\xde\x81\x1d\x75\x6d\x9f\x80\xb3\xf5\x2b\x78\x09\xf9\xa0\x0c\x35\x52\xa1\x44\x29\x9d\x7a\xef\x53\xee\xa1\x2a\x7f\xa1\x86\x3d\x91\xd4\x15\x03\x02\x46\x95\x54\x69\x22\xe1\xfc\x08\x00\x6a\x22\xab\x92\x52\xf8\x9f\x15\xec\xbd\x36\x07\x38\xfc\x44\x7a\x50\xac\xd6\xa1\xb7\xa3\xd7\x74\xa4\xe2\xd2\x16\x57\xa5\x43\xb6\x45\x20\xf1\x0a\xf6\x38\xaf\xe2\x90\x73\xc4\x73\x6a\x87\x91\x91\xef\x50\xef\x4f\x6a\xfc\x3f\x48\xa7\xd6\xfc\x31\xb7\xeb\x58\x95\xdc\x7a\x1e\xd1\x0e\xe2\x95\x88\x86\x39\xb0\xca\x7c\x1d\x65\x20\x7e\x9d\xe1\xb5\xde\xda\xb5\x17\x13\xd9\xd0\x19\xdc\xd4\x79\x68\x6f\x4e\x3e\x2f\x7d\xe6\xce\x2a\xb1\x34\x88\xc9\x5a\x13\x43\xfa\x75\xac\x2d\xc9\x41\x2b\xee\x69\x78\xad\x7b\x81\x43\x07\x60\xee\x84\x70\x6d\x11\xcd\xdc\xd0\x9a\x65\xec\x46\xc1\xf6\xf3\x41\x0b\x2b\x34\x12\xa3\x8e\x95\xb2\xe4\x1a\xc9\xcc\x0f\xee\xcf\x97\x7b\x71\xbc\x92\x07\x86\xac\xe5\xe9\x80\xdf\x4f\xf8\xd4\x5b\x19\xb9\xe0\x7b\x5f\x94\xa5\x1f\x40\x5f\x43\x8e\xd9\xce\x9f\x53\xd0\x1e\xa0\xe0\xe3\xf3\x7e\xaf\xd0\x2e\xfc\x5e\xac\xc6\xdd\xa6\x5b\xeb\xc1\x8c\x5b\x0f\x67\x45\x1e\x6c\xdf\x47\x45\x36\x71\x7f\x2f\xba\xcd\xf1\x0c\xfd\xf3\xcf\xde\x12\x5b\xdc\xed\x83\x4b\x7c\xb7\x7c\x07\x79\x58\xe5\x5f\xc8\xb1\xb6\x6a\x8c\x75\xb8\xd9\xfd\x26\x29\x86\x36\x20\x07\x45\xce\xa8\x8a\xb1\xb1\x2b\x87\x86\xce\x14\xe9\x7e\xa3\xc3\x37\x47\x1a\x8e\x5b\x5d\x29\xe9\xeb\x60\x83\x64\x06\xf1\xa2\x41\x00\x6c\x27\xc3\xd3\x99\x55\xc5\xfa\xeb\x39\xe8\x0d\xf5\xab\x45\xf0\xbf\x1b\x9a\x80\xe9\x0d\xee\x2b\xc2\x57\x27\x56\x0b\x03\x27\xb1\x61\x34\x58\xd6\x3e\xbb\xd5\xa0\xb0\x3e\x81\x27\xe3\x06\xbf\xb0\x09\x32\x74\x68\x32\x2b\x6a\x91\x0a\xbb\xb5\xad\x47\xaa\xf3\x28\x88\xbe\xfb\xf5\x35\x8d\x71\x9d\x2f\xc4\xf6\x61\x34\xcb\x38\x85\xdc\xec\x1b\x3a\xd4\xc6\xc8\x36\xd1\x4d\xe4\xaf\xaf\x8d\xba\x0e\xa5\x8b\x6c\x27\x95\x58\x7e\xcb\xb7\x34\x69\x63\xd0\x9c\xc7\x85\x78\x60\xc9\x5c\x99\x4b\x0f\x85\xa9\x43\x6f\x73\x1e\xbb\xfd\x47\xad\xa3\x64\x32\xb0\x1a\xce\xdc\x62\xc9\xa9\x41\x63\x41\x81\x93\x26\xac\x0d\x4d\x82\x56\x24\x25\x63\x6d\xb5\x39\xf4\xff\x4a\xbb\xb6\x8b\xd9\x4b\xd0\x26\x84\xb5\xa9\x97\xb9\xf7\x11\xa0\xa0\x1d\xc2\x85\xc1\xc2\x19\x47\x4b\x88\x36\xde\xb8\xc1\x43\x83\x05\x73\x87\x85\x53\xab\x3b\xf0\xec\x10\x84\x76\x08\x36\xe6\xb1\x3e\x82\x52\xd3\x66\x55\xd2\x22\x9a\xd0\x26\xa6\x0b\xa7\x75\x32\xc6\x9e\x59\x84\x30\xb5\xe8\xad\xae\x69\x58\xe1\x6a\x31\xd6\xc4\xfc\x68\x9c\xb9\x4d\x78\x1e\x8f\xe8\xe3\xd5\xb9\xc0\x8f\xa0\x45\xb1\x6f\xda\xed\x3f\x76\x3a\xbb\x63\xe8\xc2\xcc\x0e\xe1\xd2\x61\xe1\xc2\x9a\xe1\xa2\xa1\xf3\x89\x45\x48\xc9\x78\xdd\x2a\x60\x71\xec\x21\x2c\xae\xcb\x43\x23\xe2\xa1\x4d\x78\xc8\x0f\x56\x4d\x0d\x87\x36\xc4\x7a\x0a\xd1\xa4\x2d\x82\xc4\xca\x3d\x19\x85\x6c\x13\xfa\xad\x3d\xd6\x56\xd0\x0a\xa5\xa9\x3d\xc3\x96\xfd\x4d\x67\x3d\x9c\xb5\x96\xe8\x7f\x74\xc0\xcc\xc6\x0d\xb0\x1e\x6b\x42\xb3\xec\x53\xd6\xb7\x9c\xf3\x71\x1c\x16\xe6\x86\xce\xa3\xb9\x4c\xed\xd0\x81\x4e\x95\x2b\x3d\xae\xf1\x29\x75\xf4\x6a\x31\x26\x98\x6c\xab\xab\xb7\xf4\xc1\x67\x76\x08\x02\xc4\xb3\xa6\xc6\x64\xf6\xba\xb9\x31\xb7\x71\xde\x78\xc6\xc9\xe5\xfa\x8c\x43\x66\x63\xb6\x92\x8d\xa1\x8b\x5e\x5f\x03\x38\xed\x25\x6d\x05\xc3\x45\x05\x6b\x32\x68\x8f\xc6\xc1\x65\x30\x90\xb9\x9e\xa1\x09\xb1\xb5\x6e\xf9\xd2\x6e\x3d\x3d\x9d\x96\xf6\xdb\x1e\xf3\x91\x4f\x7b\x89\x2a\xa9\xb8\xa8\x63\x12\xaf\xce\x70\x9b\xa7\xa7\xb9\x89\xd6\x51\x47\x3a\x4e\x4c\xe8\x10\x3c\x56\xf5\x69\xb5\xee\xde\xc6\x3a\xa5\x0e\x94\x47\x60\x19\xf4\x58\x3c\x2d\x6a\xa3\x8a\xbc\x61\xb0\xcd\x7b\x9a\xda\xa3\xd7\xd7\x82\x3a\xa7\x3b\xb3\x1a\x45\xcd\x5c\x39\x0f\x7d\xe0\x71\xbe\xa4\x00\x95\x57\x44\x9c\xe7\x55\x06\xf9\x11\x4d\x5e\xa2\xb9\x1e\x1d\x49\xeb\xb1\xde\xf2\xb8\x50\xc8\x0c\x8d\x99\x3b\x7a\xcb\xe7\x3a\x60\x2c\x03\x11\xf1\x5f\xfd\x5b\xdc\x93\xa9\x27\xa4\xdb\x0e\xf2\xac\x7e\xab\xa8\x07\x41\x34\x01\x1d\x20\xa8\x0c\xd9\x91\x01\xe7\x73\x6d\xfc\xaf\x1c\x4d\x62\x96\xde\x5a\xf4\xfd\xcc\xd3\x41\x59\xeb\x55\xec\x65\x74\x3e\xe4\x98\x83\xf6\x3d\x8e\x2e\x72\xa7\xbe\x4e\x4b\x27\xe7\xcd\xf9\x2d\x72\x82\xf4\x30\x8b\x2f\xfb\xeb\xc7\x94\x63\x70\xa7\xa8\x4d\x29\xed\xf5\xda\xf0\x29\x9b\xaf\xed\xe8\x2e\x16\x54\xc0\x9e\x88\x71\xaf\xa8\x5b\x29\xe1\xef\xaf\x8f\xcf\xb5\xb1\x5a\xf7\x78\x07\xb9\xd9\x8e\x00\x54\xc8\x17\xb1\xaa\x22\x7e\xce\x90\xac\xac\xae\x98\x91\xcc\x1d\xe7\x65\x7c\x3a\x04\xd9\x25\xf8\x36\x21\xfa\x1c\x83\x3d\x71\x5d\x29\x36\xb4\x47\x34\xdf\x36\x00\xbc\xbc\x9f\x1f\x90\x02\x38\x90\x14\xcc\xaf\xf6\x16\x99\xa9\x71\x0b\x87\x60\xa6\x06\x81\xd6\x91\x14\xf6\x6b\x07\xcb\x38\x81\x3a\x97\x09\xd0\x04\x21\x83\x5b\x5d\x71\x5e\xc4\x53\x42\x75\x51\xea\xd4\x1d\x6e\x36\xa1\xa2\xf1\xe0\x98\x98\x2e\xb8\x0e\x9c\x3b\x21\x93\x99\x9a\xe8\x39\xba\x30\x35\x08\xb5\x58\x17\x43\x9f\x26\x0e\xdd\x5a\xd1\xa1\x91\xd0\x5e\xa2\x48\xaa\x20\x4b\x40\x2a\xf7\x74\x0a\xe6\xdb\x51\x2b\xd7\x69\xe9\x49\xc4\x48\x41\xed\x90\x43\x84\xf7\x44\xe6\x7a\x4a\x00\xf4\x63\xfc\x75\x5a\xda\xf1\xb8\x4f\xda\x7c\x47\x18\x29\x80\x1a\x29\x01\xda\x3f\xd6\x78\x19\x0b\xdb\xa7\xb6\x35\x44\x1c\x0b\xe7\x5c\x37\x5b\xf5\xfd\x47\x7c\xa2\x64\x9e\xc1\x3e\x23\x9c\xa7\xae\x4f\xa5\x86\x0e\x89\xd2\x8e\x50\x1b\x03\xe9\x36\xbd\xa8\x65\xd8\xca\x5c\x5f\xc6\x43\x53\x5b\x41\xc4\x53\x2f\xf0\x29\x6b\x3e\x03\x8e\xe6\x77\xf5\x9e\x6b\x69\x2f\x9e\x57\xfb\x2e\xf8\xc6\x09\x07\x88\x36\x05\x2d\xab\xda\xbf\xb9\x15\x02\xac\xdf\x10\x20\x92\x63\xa7\x3b\x58\xe8\xb4\x74\x48\x1b\x99\x3b\x53\xc3\x48\xd9\xfc\x5e\xbd\x65\x5f\x5b\x61\x5c\x5b\x45\x72\x50\xf3\x80\x67\x13\x2b\x68\xe8\x2d\x6f\xa0\xb4\x9e\x38\xb6\x58\xa7\xed\x3a\x0c\xd7\x54\x68\x8a\x49\x80\xec\x0a\xed\x95\xb5\x05\x85\x5f\x7a\x72\x8e\xd4\xb6\x2e\x72\x5b\xa3\xf5\x26\x5e\x6c\x79\x1c\x2b\xc4\x48\x4f\x72\x5d\xe4\x43\xaf\x16\xc6\xa6\xa8\x21\xc9\x2c\xc2\x46\x3a\x6d\x28\x01\xe7\x40\x0e\xd0\x38\x46\x59\xef\xe2\xa1\xfe\x36\x01\x02\x8e\xc6\x13\x8e\xc6\xe7\x86\xdc\x8a\x06\xf2\x72\x21\xa9\xcd\x0e\x57\xe6\xb3\x78\x29\x80\x68\xef\xc2\xf4\xd7\xd4\x92\x47\xb4\xe9\x34\x19\x80\xd7\xf1\x65\x83\x57\x3a\x05\x8f\x86\x26\x82\x87\x70\xd1\xc8\x80\x6b\xb7\x88\x41\xbb\x75\x76\x7c\x4b\x1f\x9f\x7c\x86\x68\x24\xe2\x40\x2e\x72\x30\xcc\xe1\x18\x5b\x9c\x59\x30\x33\xd9\x67\x4f\x0a\x99\xc4\x62\x41\xc0\x75\xa5\xc5\x9e\x6d\xf3\xc6\x68\x6f\xc2\xc2\xd0\xd4\x84\xa2\x56\xbb\xf0\x5b\x10\xdc\x3d\xfe\x2f\x6b\xe3\xaa\x9a\x53\x31\x41\xeb\x55\xdb\xec\x12\xff\xae\x9a\xba\xc8\x67\x7a\xab\x4d\x6a\xc5\xbd\xda\x97\x1b\x37\xa4\x8c\x63\xab\x9a\x42\x99\xda\xef\x57\xd4\xf7\x9d\xb3\xf5\x45\xdd\x5a\xb1\x9f\x13\x30\x8b\xa6\xaa\x3a\x47\x75\x5f\x6e\x72\x4b\x93\xf2\xb3\xfd\xbd\xf8\x1d\xb6\x94\x2a\x70\xa2\xfd\x32\x2e\x65\xb1\xe4\x6c\xac\x2d\xb7\xf1\x29\x87\x68\x66\x16\xc1\x9c\xc0\x59\x88\x39\xb6\xa8\x69\xa8\xf2\x76\x40\x06\x1d\xc8\xca\xaa\x40\x81\x0e\x50\x44\xf5\x91\xe4\xe9\xe9\xb6\x56\x91\xeb\x7a\xf8\xa0\x1d\x78\x7d\x0d\xce\xb7\xbe\x2c\x0b\xfd\x7e\xc3\x99\x56\x35\x6d\x0b\x65\xbf\xc6\x35\xa8\x6a\x20\x83\x1c\xba\xc0\x98\x3a\xda\x0a\xeb\x43\x01\xb3\x1a\x3c\x44\x7a\xdc\x26\xc4\xb2\x96\x80\xc6\xe7\x16\x41\xe2\x0e\xda\x4b\x34\x80\x5f\xd6\x37\x53\xcf\x5c\x57\x80\x46\xb1\x37\x6a\x45\xf6\xfa\x79\xde\x0b\x84\xe1\x48\x7e\x5e\xf0\xeb\xa0\x47\x87\x78\x6a\xb0\x70\x5d\xd7\xd6\x9c\xca\x77\x55\xfa\x78\x5b\xf7\xd1\x0f\xc5\xab\x6a\x4f\x0a\xbc\xb6\x7b\xee\x66\xd0\x27\x48\xc2\xd0\xf9\xb5\xa9\x49\xb0\xa8\xdb\x0d\xc7\xaf\xc6\x36\x91\xee\x72\x08\x72\x6d\xae\x91\x3f\xbf\x4a\xac\x50\x3d\xb1\x7f\xd8\xc3\x83\x90\xa0\x51\xea\xbf\x0a\xe7\xbd\xfa\x1f\x46\xe8\xe8\x78\x51\x6f\xb3\x17\x0b\x29\x69\x5f\xc4\xce\x4a\x7c\x17\x06\x0b\xc2\x42\x57\xb7\xaa\x3e\x18\x29\x16\xf9\x51\x38\x20\x39\x1f\xa7\x38\x1a\x47\x7a\xa2\x90\xa5\x2a\x37\x59\xd4\x10\xec\xf6\x90\x5c\x6f\xcf\x07\x12\x90\x8f\x02\x3a\xa4\x2c\xab\xcd\xae\x8e\x39\x6d\x15\xbf\x3e\x97\x69\xe8\xd3\x22\x76\xc1\x75\xf0\x91\x12\x40\x55\xc1\xf9\x32\xc7\x84\x09\xca\x41\xcc\x1d\xf0\x23\x15\x52\x03\x8b\x70\x36\x17\xc6\x96\x25\xd5\xe1\x15\xec\xf1\x28\xf7\x7b\x71\x2c\x00\x3a\x53\xb4\xcf\x9e\x00\x06\x8a\x92\x82\x53\x8e\x2e\xc5\x7b\xb6\xfb\xca\xfe\x45\x6e\xfe\x9c\x8c\x44\x17\x70\x3f\xc8\x0d\x5f\x37\xe7\x97\x39\xb5\x2a\xe7\x7b\x36\xbf\x7e\x6e\x1e\x00\x08\x8c\x8e\x39\x8c\x12\x00\x4d\xdc\xf3\xed\x54\x34\x8f\x00\xb6\x25\x05\xc7\xec\x08\x20\x5b\x07\xdd\x6e\x79\xde\x41\x51\xc9\xa1\x0c\xa4\x91\x1a\x14\xb5\x04\xaa\xa4\x36\x29\xb4\x5e\xd7\xd2\x5e\x0d\xc8\x81\xa4\x14\x35\x7f\xe7\x60\x96\xbc\x87\xc1\x61\x51\x2b\x1a\x08\x45\x9c\xad\xc7\xee\xe2\x38\xbd\x6d\x5e\x3b\x9e\x21\x3d\xe0\x74\xa5\xa2\x6e\xe7\x08\x4e\x57\xc4\x85\x89\x08\x80\x2a\x17\xb1\x51\x52\xd5\x00\xc5\x8c\x54\x06\x77\xd8\xe9\xde\x3e\x18\xf9\x2f\x20\xb7\xd7\x14\x66\xad\xa9\x78\xac\x1b\x90\x63\x85\xa9\xa3\x23\xfd\x42\x55\xf5\xda\xcd\x85\xd3\x42\x63\x49\x0b\x8e\x9d\x2e\xac\x90\xc4\x6d\x9f\x52\x54\x6c\x75\x68\xe3\x58\x66\xee\xca\x54\x5e\xe8\x30\x9a\x8a\x0d\x8d\x21\x90\x4f\x61\x11\xcd\xcc\x95\xa9\xd4\x0a\xc9\x86\xbd\xa6\x1a\x66\x37\x98\xef\x74\x14\xbe\xac\x6b\xb8\x4a\x7d\x29\x6c\x2c\x9a\x9c\x19\x3a\x8f\xd9\xcb\x64\x66\x37\xa8\xcc\x2c\xf6\x3c\x63\xaf\xde\xb7\x22\x1f\xb4\xa8\x0f\x27\xd0\x9e\x57\xda\x94\xff\x2f\x22\x5d\xe9\x73\xb4\xba\x1c\x6c\x75\x67\xa5\xff\xd8\x3c\xe9\x37\xa4\xcc\x5e\x57\x70\xd7\xe4\x6c\x2c\xe3\xbe\xa3\x35\x03\x8b\x55\xe7\x63\xb4\x4e\x34\xe9\xf2\xf4\xf3\x8a\xeb\x66\xbf\x4f\x64\xb4\xb7\x2d\xce\x22\xcd\x1d\x6d\x95\xd1\xa1\x30\xb1\x43\x10\x8f\x75\x21\x3e\x8f\x6b\x1d\x47\xd8\xed\xf1\xd1\xde\xe7\x28\xbf\x52\xfe\x5d\xd4\x63\x4b\x53\x8b\xa6\xea\xda\x49\x6f\xac\x83\x85\xa5\x0f\xbc\xb1\x9e\x20\x7f\x32\xa8\x6b\xec\x2d\x8d\x9c\xef\xd6\x01\xf9\xf7\xd4\xcc\xec\xf2\x0b\x2b\x82\xc8\x3f\x38\xac\x8f\x6e\x6f\x7d\xd4\x83\x5a\xdb\xfa\xbc\x04\x47\x4b\x3b\x7d\xbf\x97\x3b\xaa\xf7\xab\x15\xfe\xb3\xb1\xf6\x58\xe2\x1e\x32\x1b\x43\x2b\x74\xb4\x37\x26\x56\x53\x4b\x63\x08\xae\x8b\x7c\x7d\xa9\x31\xd6\x79\xb8\x3d\xa3\x43\x9f\xb2\xad\x25\xfe\x06\x4d\xd5\xb9\xa6\x62\xbf\xc7\x57\xf0\xfb\x9a\xe8\xa9\x6d\xa6\xb3\xad\xb7\xf7\xa7\x75\x6d\xfe\x66\xd0\xce\x3c\x3b\x04\x3e\xda\xbb\xf7\xe4\x56\x48\x7b\x7f\xff\xfb\xed\xe7\xcf\x1f\x77\x2d\xdd\x07\x5f\x85\x72\xd5\xdd\x8d\xf1\xc2\x4d\x93\x34\x5e\xf8\xd5\x5d\x0d\x13\x13\x66\xee\xab\x17\x4d\xfa\x8e\x1b\xe5\xa7\x5e\x01\x77\xb3\x7f\x05\x85\x9a\xb9\x69\x2b\xcb\x7c\x2f\x72\x4f\x5e\x7b\x39\xdf\x7b\xce\x95\x10\x5f\xbb\x39\xe3\xdc\x45\x3c\x83\xf2\x4e\xb7\x0a\xc2\xfa\xe1\x34\xd8\xdb\x2f\x37\xf5\xa5\x26\x66\x1a\xdf\x95\x57\x47\x9e\x3d\x57\xf9\xf9\x9f\xc5\xed\x13\x2f\xc9\x77\xd5\x5d\xae\xfb\x17\x54\x16\xd7\x87\x9c\xbd\xa5\xf2\xc5\x05\x95\x27\xae\x7c\xa5\xcb\x4b\x30\x1f\x0e\xaf\xd8\x94\x6d\x13\xba\xb2\x9b\x7f\xa7\xcb\x5f\x09\xec\x0e\xfb\xfa\x61\x97\xbf\xb6\xaa\x6b\x21\x8b\xb1\x1f\xd2\x18\xba\xe5\x02\x85\x6e\x54\xdc\xc4\xe5\xcd\x7d\xe7\xc5\x31\x51\xdf\x39\xa4\xda\xa9\xf7\x18\x16\x97\xce\x48\xa3\x9b\x87\x1b\xc9\x35\x1d\x37\x3d\x41\xd1\xd7\xf0\x3a\x73\x47\xd8\xfe\xdd\x60\xef\x82\x77\x70\x27\xd4\xee\xfe\xa7\x37\xc1\xaa\x2e\x66\x78\x38\xbc\x6b\x07\x41\xcb\xe6\x56\xf9\xf6\xbe\xdf\xae\xb9\x93\x07\xfb\x72\xe3\x47\x8e\xbb\x1a\x4e\xae\x6a\x7e\x7b\x7f\x42\x9f\x7d\xc8\x9d\xb9\xdb\x17\x17\xbe\xb8\x35\xf1\x58\x8e\xde\xc7\xc2\x5b\xe9\xfb\xfe\xf4\xb9\x42\x6a\xcf\x2c\xdf\x35\x97\x2e\x3f\x5e\xf9\x5e\xba\x4b\xd7\xfd\x66\xee\xc2\x4d\x4b\x2d\x7d\xe2\xaa\xec\x8b\xf7\x43\x7d\xca\xec\x38\x79\xe5\x32\xfb\xf7\x0b\xc1\x0b\x70\xa7\xae\xd2\x77\x17\x26\x9c\x17\x2b\xcd\x14\x57\xa0\x47\xc5\x95\xc5\x9f\x46\x4a\xf3\xd4\x6d\xa4\x9f\x96\x7e\xe4\xc4\xcb\xea\x05\x9b\x9f\x46\x0a\x7e\xba\x59\x6e\xa6\x9e\x9b\xd7\xf7\xca\x28\xef\xba\x5f\xfb\xa6\xbc\x82\xd4\xcf\xdd\xd4\x37\xcf\x9b\x2b\x13\xc2\xe1\xe4\xd5\xcb\xa8\x2e\x5c\xe0\x85\x56\xd7\xcc\xe3\xe2\x62\xa8\xbe\x9b\x65\xca\xd4\x8c\x2e\x5d\x70\x9a\x4f\x53\x37\x9b\xc6\x10\x2d\x6b\x03\xbb\xd0\xb8\xe5\x38\x3e\x22\xb0\x09\x47\xfb\xac\x14\xcd\x21\xbc\x74\xb5\x7a\x25\x6b\xdd\xdd\x5d\xa3\xf4\xd4\xb5\x83\x8b\xf7\xaf\xba\x79\xea\xdb\xd5\x9b\x49\x3f\xb5\xfd\xa4\xb5\x30\x7d\x68\x5a\x3e\x44\x7c\x7a\x75\xe7\x2c\x31\x8b\x1b\x72\x3f\x85\xdb\x85\x8b\xae\x5c\xb8\x43\x6a\xf9\xa1\xdb\xf2\xbc\xd4\xf5\xb6\xfa\xa6\xb5\x70\xd3\x2b\x2e\x92\xad\x96\x3f\x8e\x6a\x16\x2a\xdf\x8b\xa9\xd4\xe4\xa7\xeb\xe7\x6f\xbd\x0d\xed\x84\x28\xdc\xbc\x6b\xad\x3e\xc5\x8e\x99\x9b\xf7\x2f\x35\x53\x79\xb3\xd8\x20\x8e\xfc\x3c\x4e\xef\x65\x3f\xf2\xa0\x5b\x0b\xc4\x60\x0e\x73\x3f\x81\xee\xa0\x20\x35\x5d\xf3\xf8\x55\x6e\xa4\x39\xcf\xe3\x81\x9f\xfb\x9e\x99\xbb\xe7\x95\x8a\x69\xbf\xfb\xa5\x96\x65\xd7\xea\xd2\x98\xe3\x9b\x19\x0f\x74\xf7\x6b\xef\xee\x2d\x94\xf8\x8e\x1a\x5c\x94\xf9\xde\x34\xcf\x1e\xf6\xa0\xd7\xda\xaa\xbc\x49\xf7\xce\xf4\x2e\x5c\x24\xbe\x74\xad\x6e\x1c\x07\x2f\x16\xe6\x4a\x9f\xfc\xba\x17\x04\xec\x3b\x95\xe6\x9e\xd4\xdc\x99\xd0\x4d\xf3\x57\xbd\xd9\x2b\xcc\xd5\x96\x0c\xa5\x90\xb5\x10\xcc\x57\x5d\x4b\x0f\xc6\x96\x09\x2f\x9a\xb3\xe7\x3b\xac\xf1\xe1\xef\x10\xb8\xd2\xb2\x5c\xe3\xb5\xbc\xc1\x86\x9e\x78\x77\xca\x4f\x6b\x43\xcf\x58\xc7\x63\x23\xfa\xb5\xfb\xdf\x6e\x43\xbf\x3e\xfd\xb2\xa1\xbf\x6c\xe8\x2f\x1b\xfa\x9f\x67\x43\x1d\xb7\xb8\xa7\xd4\xf9\x65\x3f\xff\x20\xfb\xf9\xdf\xb7\x07\xfd\xb5\x05\x6d\x5c\x7a\xe1\xdc\x9f\x6d\x3e\xc1\x2f\xf3\xf9\xe2\xf3\xcb\x7c\x9e\xa3\xd7\x2f\xf3\xb9\xcb\x6b\x44\x6e\xfe\xcb\x74\x7e\x8b\xe9\x3c\xa6\xea\xc5\x3b\x54\xbf\xdc\xdc\x3e\x9c\xcb\xa9\x1c\x27\x51\x0e\xb1\x6f\xc7\xf6\x3c\x74\xa3\xbc\x4d\x3d\x1c\x41\xce\x8e\x52\x53\x67\x2e\x6f\xbd\x39\x71\x79\xf4\xa9\x6c\xcc\xcd\x97\x9b\x5b\xaa\x7c\x7c\xf3\x70\xb3\x1b\xf6\xa6\x82\x77\x43\xc7\x51\xf9\x8e\xd8\xf8\x54\xae\xe6\x04\x77\xbc\x82\xfa\x43\x91\xb4\x74\xdc\x34\x3b\x4e\x29\x1d\x83\xbd\xe8\xa5\x20\x2f\xe3\x95\x77\x7d\x7c\x2b\xfd\x4e\xe6\x7d\x10\xce\x6d\x77\xe2\x47\x7e\x79\xd9\xea\x36\x77\x71\xac\x37\x2e\xe5\xd2\x76\x40\x0a\x8e\x6c\x5a\x0e\x69\x3b\xcf\xcf\x77\x13\xf7\xb1\x79\xf7\x48\xe0\x5f\xef\xc8\xc6\xb3\x75\x37\x21\x9f\x1e\x1b\x4f\x2e\xde\x7c\x6c\x62\x67\x52\x51\xc9\x6e\x2d\x5f\xbc\xf0\xe2\x95\x55\x7f\x1d\xd6\xd6\x3c\x1d\xf5\x7c\x3d\x07\x64\xc7\x51\x69\x6d\x0a\x44\xbc\xe2\x85\x5f\xe9\x6f\xd0\x8d\xbc\x7c\x7a\xea\x2a\xf3\x53\x78\x7d\xfe\x72\x83\xbd\xe4\xb1\x53\x8a\x82\xbc\xc3\xf0\xbb\x24\x75\x17\xbe\xbb\xfc\x18\x85\xf1\xed\x2c\xf3\x8d\xbe\xf8\x25\x25\x7e\x4e\x65\x17\x29\xae\x43\x0c\xcf\x5f\xa3\x7e\x85\x00\xd7\x9a\xd3\x89\x32\x23\x8e\xdc\x0f\xd2\xec\xcd\x0f\xca\x91\x99\xe5\x8b\x7a\xe4\xca\x9b\x3b\xe7\x47\x57\xaf\xf3\x29\x6e\x9b\x7e\x65\x3b\x52\x34\xc6\xb1\xfb\xe2\xbf\x07\xe2\xf1\xb4\xe7\xf5\xb2\x56\xe3\x94\x2f\x53\xbd\xa7\xea\x7b\xbf\x4b\xfa\x60\x6a\x88\xbe\xfb\xe8\x5f\x72\xc1\xcb\xa5\x95\xab\x17\xeb\x14\x9c\xf4\x81\x2f\x3d\x3f\x05\xbe\xb6\xb9\x51\x76\xc1\x23\xda\x0e\x95\x9b\xde\xab\xce\x50\xfd\xb9\xf4\x42\xf4\xac\x54\x30\x9d\xc8\x49\x62\x3f\x7a\x65\x61\xf6\x3f\x57\xbe\x03\xa6\x82\x7d\x28\x38\x3d\x77\x0d\xae\x78\x7f\xfa\x16\x48\x2d\x48\x6f\x7b\x55\xd6\xa7\xff\xff\xba\xd2\xa5\x2b\xde\xe2\x74\x19\xcf\x6f\x21\x47\xb1\x67\xa0\xe3\x2c\x8c\xb3\x36\xf5\xd3\xd0\xe4\xd5\x16\xe7\xfb\x5f\xf9\x7e\xf4\xea\x05\x7a\x1f\xea\xe4\x57\xbe\xfd\xf5\x8a\xfd\xa8\x78\xe6\x3b\xbf\x8c\xfa\x07\xd6\xf9\x8f\x48\x69\x12\x3f\xad\xce\xaf\xd0\xff\x71\x75\x7e\xe2\xfe\x09\x6a\x3f\x49\xfd\x85\x99\x6f\xd5\x7e\x85\x67\xf1\x0a\xed\x92\xd6\x9f\xda\xe5\xbb\x9d\x9d\x6f\x7b\x17\x7f\x39\xbf\xef\x24\xd0\x89\x5b\xee\xd7\xb1\x97\xdb\xe1\xff\x24\xb9\x86\x30\x5e\x82\x03\xac\xcb\xb7\xe6\xbc\x12\x0a\x42\x5d\x98\x38\x5d\x9a\xa9\xe3\x3a\x4a\x6a\x4e\x26\xbe\x7d\xa1\x39\x6b\xe6\xee\xd2\x5c\x2b\xa9\x19\x65\x7e\x5e\xd7\xcb\x9e\xae\x6f\x95\xdc\x30\xce\xdd\xaa\x47\xf6\x4a\xdb\xb4\x68\x78\x88\xfc\x79\xdd\xf4\x9e\x17\x9f\xee\x84\xa8\x66\x85\x93\x61\xf0\xeb\x2a\x5c\x2b\x23\xf1\x90\xb8\x6e\xea\x47\xde\xdd\x07\xf1\xd8\xd1\xdf\xa3\x12\xfa\x55\x45\x73\x4f\x1f\x1e\xea\xf9\xe8\xa2\xd0\xb7\xaf\xc7\x3f\xdf\x27\x72\xbf\x44\xe9\x8f\x11\xa5\xc5\xb9\xd7\x05\x5f\x2d\x43\x7b\x8b\xbf\x2f\x4a\xdf\xe4\x7f\xfd\x04\x32\x74\xc4\xf3\x1f\x25\x4a\x27\xd7\xe3\xfb\xc9\x50\xe0\x47\x05\xef\xb0\x45\xd0\x62\x17\xef\x79\x6b\xf0\xd1\x8e\xa3\xcc\xcf\x72\x37\xb2\xd7\x85\x5b\x71\xfa\x54\x45\xb5\x18\x13\xb4\x23\xa4\x77\x3d\xfa\xee\xc2\x85\x65\xf2\x27\x8d\x23\xef\xba\x34\xc9\xe5\x5d\xd1\x2b\x8e\x66\xdd\xb9\xce\xa0\x5d\x43\xc4\x03\x84\x5e\xba\x31\x27\x50\x3c\x0a\x8a\x0d\x27\x13\x37\xdd\xcb\x73\x15\x85\xe4\xa7\x18\xc7\x4f\x24\x33\xf2\x5c\xc6\x87\x79\xf9\x46\xd5\x6d\xce\x02\xc7\x1e\xef\x1f\x89\x7b\x9c\x6c\xde\x93\xc4\x97\x47\xec\xfe\xe9\xeb\x7d\xf3\xf1\x1e\x6f\xe0\x5f\x9a\xc4\x3d\xfe\xf4\xf5\xfe\xeb\x7d\x03\x2b\xbe\x7f\x25\xef\x9b\xd8\xfd\x63\xb3\xf8\xe3\xf9\xe9\x1e\x7f\x7e\xbc\x27\xbe\xde\x7e\xb9\xf1\x27\xbf\xb9\xbf\xcf\x4d\x98\x1d\x44\x20\xdd\x55\x9e\x9a\xf5\xce\x94\x1b\x65\x45\xa4\xbe\xfc\xe7\xcb\xcd\xed\x97\xdb\xa3\xf0\xf9\xcb\xe6\xa7\x65\xc0\xcf\x0e\x75\x58\x39\xa7\xce\xa5\x8c\xf9\xa1\x6c\x48\x73\xf8\xca\xde\xe5\x95\x65\xfe\x43\xdf\xad\x7e\x25\x4f\x94\x8e\x76\xcf\x5d\x53\x66\xe6\x3a\x03\x37\x37\x11\x9b\x68\xa9\x9f\xbb\x07\xd6\xe9\x2a\xf5\xfb\x8f\x8b\x31\xd8\x6f\xcb\x4e\x7c\x8c\xbf\x5c\x6d\x63\x4e\xa8\x8d\x4a\x17\x74\x56\x89\x9b\xfa\x6e\x54\x86\x4b\xe8\x38\x75\x6f\x7e\x93\xc5\xfe\xe7\xd7\xa3\xeb\xa7\xb4\xff\xf3\x1f\xec\x41\x1d\xaa\xe9\x0f\x70\x5a\x6a\x64\x2e\x1a\xfb\xdb\x96\x34\xbc\x3d\x65\xb7\x4f\x30\x5d\x9c\xd4\x9a\xf2\x0c\xcc\x7c\x9a\xc6\x73\x6f\x9a\xcc\x8b\xf7\x07\x37\x31\xec\xbd\xfe\xc0\x9b\xf2\x80\x88\x96\x68\x16\xdf\x9a\x42\xcb\x7e\x87\xed\xea\xb7\xef\xb8\xc3\xfb\x38\xd6\xfa\xd3\xd3\x2a\xef\x67\xbb\x56\xb6\x8e\xec\x61\x51\x50\xf3\xca\x0b\xbd\x3f\x25\x66\x9a\x17\x89\xaf\x9e\x7b\xde\x13\xa8\x5a\xe6\xd3\xcb\x81\xcd\x4f\x0f\xfe\x2b\xa1\x89\x33\xb5\x1e\x37\xfb\x0e\x4e\xd7\xcc\xa6\x6f\x7c\xb7\x7d\xa9\x9b\x94\x1c\xb9\x26\x5f\xb1\xc7\x67\x0c\x7b\xab\xb0\x7d\x47\x99\x41\xdf\x8f\x16\xe3\x43\xe5\xe8\xc1\x8e\xa3\xdc\xf4\xa3\x97\xd5\x3f\x3f\x9b\x48\x1d\x4c\xeb\x1a\xf9\x3a\xa7\x95\xde\x39\xfe\x8f\x2e\xd2\x55\xa9\xc3\x7f\x84\x28\xff\x60\x02\x5a\x91\xf6\x97\x60\xfe\x12\xcc\xbd\xe7\xd7\x0a\x66\x55\x5a\xf8\xdf\x63\x64\xef\xf0\x1f\x4c\x7e\xeb\x15\xf8\x25\xc0\xbf\x04\x78\xef\xf9\xb5\x02\x3c\x4c\xdc\x48\x9e\xfa\x93\x9c\x2e\x6b\x8f\xfe\x40\x49\x3e\x80\xf8\x07\xcb\xf4\x3c\xf2\x7f\x9f\xbb\x3d\xf7\x52\x24\xf0\xb0\xf1\xe5\x59\x5d\x91\x9e\xbd\x8e\x3c\xdb\xe6\x0f\xc1\x6b\xd4\xa9\x3f\xaf\x57\x48\x5c\xc8\xbd\x7e\x07\xa4\xab\x4a\xb6\x83\x8a\x6c\xce\x79\x75\xa1\xeb\xcf\x8f\x37\x15\xdf\x8d\xf2\x8f\x41\xfe\x3c\x97\xff\x14\xbe\xe2\x0b\x65\xf1\xcb\xe8\xfc\x32\x3a\x7b\xcf\xaf\x35\x3a\xa3\x38\xcd\x5f\x94\x9f\xee\x46\xf9\xe5\x33\x9e\x06\x7a\x18\x01\x7d\xfc\x83\x23\xa0\xe8\x7b\xb9\x70\xbf\xa4\xfe\x97\xd4\xef\x3d\xbf\x56\xea\xe5\xbd\x23\x17\xff\x19\x1b\xc6\x1f\xcc\x3c\x1f\x10\xf8\x97\x90\xfe\x57\x09\xe9\x96\x79\x3e\xf0\x8a\xbf\x2b\x18\xe8\xd5\x0b\x07\xbf\xfd\x74\xd8\x0e\xff\x3f\xf4\x64\x97\x69\x3b\xce\x13\x61\x3e\xdd\x35\x1a\xcf\xcd\xbb\xc7\x67\x77\x72\x67\x39\x8f\xc4\xdd\xe4\x2b\xf6\x75\x62\x99\xcf\xb8\xe9\x3e\xbd\xe3\x64\xd7\x69\xaa\x7f\x8f\x43\x5d\x17\x8f\x5e\x7d\x08\x47\xdd\x32\x88\x5b\xaa\xac\xf3\xe1\x89\xc3\xff\x5a\xd6\x79\x74\xc8\x27\x8b\x7c\xb6\xee\x70\xe7\x71\x72\xf7\xf8\xf4\xfc\x74\x67\x12\x24\x7e\x67\x7f\x7d\x7a\x6e\x3c\x3a\x04\x4e\xbc\x8b\x75\x26\x3f\x27\xeb\xfc\x6c\x27\x6e\x5f\xd7\x8b\xbf\x8e\xd9\xfe\x07\x1d\xb3\xfd\x81\x94\xf1\xcf\x5a\xb0\xf1\x3d\x4e\xba\xbe\x5f\x13\x1c\x9f\x7f\xdd\x5d\x44\xfd\x21\x27\x70\x3f\xd7\xf6\xae\x2d\xc8\x37\x68\x80\xb7\xcb\xfe\x31\x86\x7f\x9c\xc0\x7f\x6f\xda\xfc\xa1\x0a\xc1\x72\x27\xee\xc4\xc4\xf0\x3b\xc2\x24\xc8\xbb\x47\x9c\x7c\xba\x7b\x6e\x98\xcf\x77\xc4\x13\x31\x99\x34\x1a\xb6\xdb\xc0\x1f\x7f\x6e\x13\xfb\xa1\xc5\x81\xdf\x6f\xcd\xcf\x29\x8c\xbf\x94\x7f\xff\xfb\x2f\xff\x2f\x00\x00\xff\xff\x75\x87\x84\x45\x90\xd5\x00\x00")
+var _rpProductionJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x69\x73\xe2\xc6\xd6\x38\xfe\xde\x9f\xc2\xc5\xff\xa9\x72\xe6\x7f\xed\xb1\x24\xcc\x8c\x75\xab\x9e\x17\x20\x90\x90\x00\x81\xb6\x96\x50\x9e\xd4\x2d\x6d\x08\x59\xad\x25\x92\x00\xe3\xd4\x7c\xf7\x5f\xb5\x16\x36\xb3\x19\x7b\x92\x4c\xee\x80\x33\xb1\xa1\xfb\xf4\xe9\xb3\x77\x9f\xd3\xad\x3f\xae\xae\xaf\xaf\xaf\x6b\xff\x93\x5a\x53\x27\x30\x6a\xff\xbe\xae\x4d\xb3\x2c\x4e\xff\x7d\x7f\x5f\x7c\xf2\x39\x30\x42\xc3\x75\x02\x27\xcc\x3e\x1b\x2f\xb3\xc4\xf9\x6c\x45\x41\xf9\x5d\x7a\x4f\x60\x78\xe3\x0e\xc3\xef\x30\xfc\xde\x76\x62\x18\x2d\x51\x3b\xd9\x09\x62\x68\x64\xce\xe7\xa7\x34\x0a\xff\xbf\xda\x6d\x31\x82\x15\x85\x99\x13\x66\xc0\x49\x52\x2f\x0a\xd1\x40\xf8\x67\x0c\xbd\xab\x06\xb1\x91\x18\x81\x93\x39\x49\x5a\xfb\xf7\x75\x81\x16\x7a\xd7\x0c\x2b\x11\x9d\x34\x9a\x25\x96\xc3\xda\x5b\x5f\xa1\x9f\x5a\xb6\x8c\x1d\x04\x2d\xcd\x12\x2f\x74\x6b\xab\x2f\xbf\xdd\xae\x7e\xad\x19\x76\xe0\x85\xcd\xd8\xa3\x8c\xd6\x2c\xb4\xa1\xf3\x4e\x28\xd0\x73\xc2\x8c\x72\x92\x8c\x8a\x82\x20\x0a\x79\x23\xb8\x14\x62\x12\xbc\x05\xab\x75\x4f\xf4\xae\xd9\xce\xc4\x98\xc1\x0c\x18\x70\x96\xb7\x3a\x3a\xc6\x25\x38\x5f\x3a\x5e\x31\x18\x6b\x7f\xaf\x01\xa2\x61\xec\x24\x46\x16\x25\x94\x11\x1a\xc9\x72\xe4\x24\x96\x13\x66\x86\xfb\x9d\x66\x64\x7a\x10\x7a\xa1\xdb\x21\x3a\x52\x16\x25\x86\xeb\x34\x2d\x2b\x9a\x7d\xbf\x09\x96\xe3\x49\x4e\x32\xf7\x2c\x67\x94\x78\xa1\xe5\xc5\x06\xfc\x5e\xc3\x59\x70\x96\x66\x4e\x32\xb0\x53\x9b\x8a\xc2\x89\xe7\xae\x55\xf4\xf8\x68\xc7\xa0\x8d\x8c\xc4\x09\xb3\x76\x14\x18\xde\x3b\xf4\xc3\x36\x32\xc3\x34\xd2\x8a\xe0\x97\x03\x72\x42\x2b\x59\xc6\x99\x17\x85\xcd\xac\x1b\xa5\xd9\x61\x28\x66\x14\xc1\x03\x30\x9e\xb3\xc4\xa0\xa2\x34\x88\xd2\x76\x8b\x1d\xa5\x87\x61\x94\x98\x5c\xc4\x8d\x49\x7c\xb6\xf6\x1c\xe8\x7f\x81\xdc\xec\x85\xe4\x3b\xcb\x39\x42\x78\x94\x38\x13\xef\xf9\x32\x18\x81\x1d\xd0\x49\x6e\xf8\x6d\x25\x81\x97\xc2\x48\xed\x4e\x38\xf7\x92\x28\x44\xde\xe5\x32\x20\x71\x94\x64\x06\x6c\x5a\x96\x93\xa6\x4c\x12\xcd\x62\xd6\x4e\xdf\x05\x69\x66\x7b\x59\x94\x7c\x04\xa8\xf7\xf1\xbb\x80\xd1\x81\xce\xdc\xc8\x1c\xfb\x7d\xf8\x24\x31\xed\x18\xd9\x2c\x71\x4e\xf7\xbf\x48\xb6\x93\x98\x0d\xce\xb1\xd2\x07\x3a\x7f\x90\x85\x4a\xe2\x8f\x31\x4e\xc9\x87\x69\x5a\x12\x83\x20\x4d\x29\x23\x36\x2c\x2f\x5b\x1e\x86\xe1\x85\xd9\x09\xc2\xd7\xf7\xc2\x4f\xd3\xe9\x68\x66\x42\xcf\xea\x39\xcb\xcb\x30\x4c\xb7\x1c\x5f\x41\xb9\x0b\x21\xcd\xcc\xd4\x4a\xbc\xdc\x16\x57\x61\x5d\x2e\xb5\x97\x33\x62\x1e\x48\xde\xcb\xe9\xbe\xc7\x29\x57\x93\x32\x23\xb4\x8d\xc4\xfe\x4f\x9b\x48\xff\x33\xaf\x1f\x1a\x2a\x4d\xdf\x88\xe8\xd5\x06\x8c\x5a\x52\xce\x18\x29\xd8\xaf\xab\x36\x3b\xa0\x52\x7f\xf6\x0a\x3e\xfa\xa9\x85\x46\xb0\x85\xea\x7a\x94\x1d\x3c\xd1\x4f\x2d\x4e\xa2\xd8\x49\x32\x6f\x8f\x36\xa3\x9f\x5a\x9c\x8b\x04\x3b\x6a\x42\x18\x59\x06\xe2\xc7\xc0\xc9\xa6\x91\x5d\x8e\x90\x79\xd6\x71\xf8\x15\x36\x49\x7c\x17\x7b\x71\xed\x76\x3f\x3d\x06\x9e\x95\x44\x69\x34\xc9\x3e\xf3\x4e\xb6\x88\x12\xff\x7e\x35\xae\x6d\x27\x4e\x9a\x3a\xe9\x6e\xd7\x0a\x1d\xd4\xfd\xd7\x8a\x62\xb9\x8c\xfc\xf2\xe9\x73\xf5\xe5\x6f\xbb\xbd\x8c\xd8\x5b\x9b\x85\x1a\x81\xe1\xe4\x1d\xf6\xf5\x0e\xc3\x6b\x57\x7b\x26\xb0\x4d\x8e\x1f\x8d\xe2\x85\xcd\xff\x49\xf5\xf3\xa8\x3e\x29\x03\x10\x76\x54\x38\x8f\x59\x92\xcf\x73\x5b\x05\x37\x5f\xaf\x61\x9c\x3b\xd6\x7e\x6e\x17\x72\x7e\xb2\x03\xfa\xa9\x79\xf6\x16\xfd\x59\xfb\x97\x9b\x33\x78\x79\x73\x7b\x7d\x53\xa8\xe1\xcd\xa7\x5d\x16\xed\x7b\xd5\x32\xc3\x45\x33\x08\x67\x10\x1e\x6d\xfc\xed\xe0\xb7\x3b\x5c\xd8\xcb\xbf\x24\xbe\xab\x88\x5f\xbb\xda\xd3\x70\x97\x95\xd5\xeb\xc7\x64\xc0\x5a\x2b\xff\x56\x4c\x28\xd1\x3a\xc1\x88\x57\x9f\xfe\xf6\x1a\x74\xcd\x34\x2c\xdf\x09\xed\x72\xd6\xa3\x28\x82\x17\x29\xd1\x86\x78\x94\x10\xdf\x83\x14\x8c\x0c\xbb\x65\x40\x23\xb4\xbc\xd0\x15\x67\xd0\xf9\xee\x8a\x7d\xc0\xa0\x7c\xa0\x7c\xad\xe7\xe4\x24\xe9\xfd\x81\xf1\x2a\xad\x87\x66\xf9\x4b\xd5\x0e\x89\xdf\x51\x44\x8e\x88\xcd\x01\x3e\x7f\xb7\xb9\xbd\x1e\xea\xd5\xb4\xca\x26\xef\x9e\x55\x9c\x44\xe6\xeb\xc0\xed\xa3\x26\x92\x43\x7f\x85\x7b\xfe\xe9\x47\x60\x9e\x45\x56\x84\xd6\xcf\x35\xd9\xda\x75\xfa\xbb\xaf\x1a\x42\xac\xed\xa1\x40\xd4\x9c\x55\x1e\xbd\x5d\x44\xbb\xa7\xba\x56\x22\x34\x8a\x12\xb4\x47\xf2\xf0\x50\x3f\xd1\xa1\x64\xce\xba\xfd\xd5\x05\x93\xdc\x74\x18\xd0\x4c\x66\xd0\xa9\x5d\xbd\x01\xc4\x3f\x59\xad\x77\xac\xf7\xbb\x45\xe9\xb5\xbe\x7d\xb7\xf9\xbd\x1e\xea\x95\x7a\x94\x4d\x7e\x3c\xd5\x2e\xd9\x92\xab\xf7\x5d\x9e\x30\xf9\x6f\x52\xf2\x87\xab\x0b\x26\xb9\x1b\x90\xfc\x54\xf4\x9f\x8a\xfe\x83\x29\x7a\x9a\x4e\x7f\x5c\x35\x27\x88\x13\xed\xb7\xb5\x9c\x20\x08\xe2\xea\x82\x49\xee\x57\xf3\xbb\x34\x9d\xbe\x27\xc8\xcf\x0d\xed\x77\x0f\xec\x37\x79\xd3\x45\x46\xfd\x14\x89\xe3\x73\x2d\x68\x38\x0b\x4c\x27\x19\x4e\x46\xd5\x3c\x4e\x31\x23\x71\x7e\x9f\x39\x69\x36\x32\xb2\x29\xc2\xe6\x7e\xea\x18\x30\x9b\xbe\xdc\x27\x8e\x61\x2f\x6b\xef\x62\x4c\x15\x96\xd6\xae\xde\x00\xe1\x2f\xa7\xf0\xc3\x0f\x44\xe1\x2d\xa3\x91\x47\x07\x7f\x35\xad\xcf\xb0\x34\x25\xa5\x91\xde\xbf\x99\xd4\x1f\x47\xad\xb7\xd8\x89\xab\x23\x23\xad\xa0\xe7\x0e\xf6\xfc\x6d\xd2\x2d\x87\xf0\x9d\xb7\x48\x77\xda\xd9\x4e\xec\x84\x76\x3a\x0c\xf7\x9a\xb9\x53\x9e\xec\xa2\x2d\xa9\x77\x6e\x34\x5e\xed\x67\xc7\x06\x2b\xde\xba\xe3\xfb\xeb\xba\x08\xe8\x97\x9b\x22\xaf\x73\x00\xf3\xcc\x73\x92\xad\x3d\xe2\x3d\x6d\xac\x75\x46\x6d\x1b\xf2\x76\xc2\xed\xd5\x54\x76\x65\xe9\x84\xd6\xd5\x66\xb1\x9b\x18\xb6\x33\x8a\xa0\x67\xbd\x4e\xb0\x55\xaf\x5a\x10\xd9\xb9\x48\x0e\x8c\x70\x66\x6c\x14\x19\x1c\x18\x16\xfd\xd4\xe6\x5e\x92\xcd\x0c\x38\x30\xac\xa9\x17\x3a\xa3\x24\x9a\x78\x7b\x0a\x85\xaa\x77\x2d\x4a\x4f\x35\x41\xef\x9a\x15\x05\xf1\x2c\x73\x12\x94\xca\x5a\xa5\xf7\x6b\xbf\x5a\x51\x68\x19\x19\x22\xcf\xdd\xcd\xed\xf5\x36\x2b\x8a\xbc\xd7\xcd\xa7\xdb\xeb\x9b\xbb\xfd\x2c\xa9\x5e\x45\x9d\x94\x92\x3a\x49\xc5\x55\x0b\x46\x33\xfb\x6e\x96\x3a\xc9\xb1\x6e\xd0\x0b\x67\xcf\x6f\x8b\xc8\x6b\xb6\x97\x1a\x26\x74\x46\x46\x9a\x2e\xa2\xc4\x6e\xce\xb2\xa9\x13\x66\xde\x4a\x4d\xb3\x64\xe6\x1c\x1e\xb2\x4a\x8e\x9e\x1c\x67\x63\x3b\xb9\xe7\x2c\x0f\xc7\x21\xbb\xaf\xd3\x50\xab\x57\x2d\x5e\xf9\xa1\x28\x70\xee\xd7\x14\xbb\xff\x9c\xa6\xd3\x7b\x63\x96\x4d\xa3\xc4\x7b\x71\xec\xff\xf8\x08\x81\xdb\xab\x33\x60\xae\xca\x38\xda\x46\x66\xbc\xd2\x81\xcd\xa4\xf0\x2b\x0d\x38\xf4\xfe\x76\x75\xf4\xeb\x57\x56\xf9\xfc\xfe\xfb\xbf\xd9\xa3\x12\x9b\xf9\xe8\xb3\x84\xdd\x43\x35\x07\xa2\x33\x71\x12\x27\xb4\x9c\x33\xd3\x06\xe9\xb4\x30\x2f\xa2\x63\x77\x8d\x93\xa1\x76\x34\x99\x94\xcd\xbb\x9d\xfe\xa9\xc6\x45\xb2\xb1\xf6\xf5\xae\x0f\x06\xa7\xda\xce\xd7\x8e\x03\x55\x54\xa6\xd9\x61\x36\x1d\x20\x55\x69\x16\xda\x5e\xea\x9f\x9e\xba\x95\x38\x46\xe6\x0c\xe3\x52\x7b\x6a\x74\x12\x05\x45\xc9\xc6\x09\x3c\x8b\x22\x51\xfb\xac\x51\xf6\x14\x14\xc8\xa5\x3f\x1e\x25\x4e\xe0\xcd\x82\xff\xf4\x45\xa9\xf6\xa7\xc8\x51\x58\xac\x03\xcf\x92\xa3\x22\x40\x1c\x9d\xb5\x02\xfd\x33\x77\x90\x8f\x31\xbe\x9c\x1f\x1b\x66\x4e\x32\x31\x2c\x67\x7b\x03\xe2\xa4\x1d\x3b\x3e\xc9\xdd\x38\x0b\x39\x89\xbb\xd0\xb3\x4e\x08\xcb\x39\x2e\x75\xdf\xab\x16\x27\x5e\x60\x24\xcb\xb3\xcc\x7a\xf5\xaa\x79\xf1\x1b\xe7\xfc\xb6\xf9\x1f\xa5\x85\x17\x5b\xf9\xd8\x67\x10\xe4\xbd\xc4\xd9\x7c\xd5\xd2\x99\x19\x3a\xaf\x0b\xe5\xce\x7d\x9d\x27\xbc\x65\x64\x52\xfe\x99\xde\x17\x83\x56\xf2\x3b\x0f\x9d\xac\xfc\xb5\xf8\xe2\x6c\x17\x73\xa6\x68\x7f\xa8\x94\xec\xf1\xf3\xab\xa0\x77\x4b\x7c\x2e\xa7\xe9\xae\x6c\xa0\xe2\x8d\x3f\x85\x1e\x9b\x46\xa6\xf5\x7a\xa7\xee\x4d\xfa\xb0\xf9\xbe\x8c\x0e\x97\x1a\x47\xf3\x35\xe6\xbb\x96\xb2\x6c\x72\x91\xa0\x1d\xf7\x29\x97\xc5\x3a\x97\xc3\xff\x76\xf5\x31\xa3\x7f\xbb\xba\xec\xdb\xdf\xae\xde\x20\x7c\xb5\xd4\xb1\x66\x89\x97\x2d\xcf\x72\xa2\x7b\x0a\xb1\xb7\xc3\xd2\xdd\x06\x07\xd9\x79\x08\x1d\xdb\x33\xdc\x30\x4a\x33\xcf\x3a\x6f\x2d\x64\x46\x51\xd6\x5e\xf7\x39\xda\xb8\x9c\x02\x5a\x72\xd8\x67\x19\x98\x2a\xce\x51\x12\x6f\x6b\x85\x55\x9d\xb1\xd9\x59\x66\x6d\x47\x45\x45\x99\x65\xbe\xe4\xba\x3f\x2a\xd5\xdf\xde\x44\x20\xe7\x39\x73\x42\x14\x55\x9e\xc7\xb0\xaa\xf5\x69\x4b\xf1\xc7\xd5\x9b\xad\xa0\x95\x9e\x0a\x2d\x2f\x75\x88\xdb\x61\xfc\xda\xbc\x34\xf3\x33\x4c\x9d\xf5\xac\x4e\x0f\xbf\xb5\x65\x44\xcd\xd2\x2c\x0a\xa4\xbc\x84\xf5\x2d\x7d\xbb\x06\x3a\x78\x94\x6c\xee\x04\xad\x8e\x3e\x9d\x7a\xd7\x8c\x59\x16\x29\xc5\x26\xc3\xc0\x0b\xa3\x0d\x28\xe7\xfb\xb8\x5a\xea\x64\x99\x17\xe6\x75\x5f\x7f\x1c\x90\x8d\xdd\x37\x22\x7c\xe6\x58\x99\x63\x4b\x1b\x9d\xcf\xea\x8a\x7e\x6a\x45\xa5\x2f\x62\xc0\xaf\xe8\x18\xc7\x97\x87\x5f\x4a\x05\x28\xfe\x92\x23\x29\x2f\xcd\xfd\xe5\xc6\x22\x00\xc6\x52\x38\x74\x9a\x51\xef\xe6\xd3\xed\x4d\x53\x1c\x50\x7d\xb6\xc3\xcb\x6c\xfb\x7f\xff\xa7\x6c\x7d\x7d\x67\x5f\xff\xdf\x0c\xc3\xea\xd6\xe6\xbf\x37\x37\x37\xb7\x25\xec\x4d\x4d\xda\x38\x85\x74\xf3\xe9\xd3\xed\xcd\xcd\xcd\xa7\xff\x0b\x6f\x6e\x6f\x06\xed\x01\x2d\x0e\x79\xb9\xc3\xb7\x15\xb1\x7f\x19\xec\xed\x43\x0d\x3b\xe0\xa5\x76\x87\x07\xac\x38\xe4\x07\x1d\x5e\xbe\x14\xfe\xd6\x81\x87\xad\x01\x9a\x94\x28\x76\xa4\xa1\x22\x52\x9d\x8b\x49\xb3\x79\x9e\x6e\x1b\x78\x7b\xc0\xf2\xcd\x11\x5b\x90\x9e\xea\x88\x32\x35\x1c\x0c\x86\x3c\xdf\x1c\x74\x2e\x1c\xeb\xc8\x91\xb9\xed\xa1\xc5\xc1\xc7\x0e\x9c\x04\xe7\x0d\x3b\x1c\x8e\x3a\x62\x53\x1e\x8a\x54\x93\x6f\x8a\xe3\x51\x47\xa4\x3a\xbc\xdc\x64\x2e\x1e\xf7\xe0\xe1\xb4\xad\x81\x5b\x6c\xbf\xcf\xf2\x0c\x3a\x53\x26\x0f\xc5\x26\xd3\x69\x52\xd4\x50\xb9\x5c\xde\x0f\x9f\x51\xdb\x1a\x96\xea\x2b\x92\xdc\x11\x07\x6d\xa9\x4d\x0d\x79\x9a\x65\x40\x47\x94\xd8\x21\x7f\xd9\xa0\x87\x4e\x8e\xed\x1b\x72\xd4\x14\x3b\xbc\xdc\x1e\x0e\x9a\xec\x3b\xd8\x7a\xe0\x74\xd9\xd6\x80\xf4\xe8\x7d\xa6\x63\x7d\x02\x6b\x0b\xec\x68\x28\xca\xcd\x7e\x93\xa2\x3a\x92\xc4\x88\x43\x65\xc4\xb6\xa5\xcb\x06\xd8\x77\x16\x69\xdf\x50\x4a\x9b\x95\x87\xe2\x87\x8c\xb5\x7d\x5a\x69\xcf\x60\xef\x23\xd9\xf6\x41\xa6\x3d\xe0\x3b\xfd\x0e\x68\xca\x9d\xf6\x47\x4c\x66\xf7\xac\xd3\xd6\x70\xe2\x88\xee\x34\x65\x45\xec\x5c\x38\xc4\xfa\x08\xd4\x0e\x58\x76\x70\xb1\x4d\x28\x8f\x3d\xed\x00\xfc\x20\x1d\xdc\x73\x2c\x6a\x67\xa0\x8f\xd1\xbc\xd7\xa7\xa6\xb6\x86\x69\x37\xe5\x66\xab\x29\x55\x66\xec\xf2\x71\xf6\x1c\xf9\xdc\x1a\xa8\xd7\x19\x83\xa6\xd2\x97\x47\x62\x87\x66\xb5\xcb\xc6\xd8\x3e\xd9\xb8\xdf\x05\x36\x5b\x0a\xdf\xee\x77\xfe\x17\x91\x7d\xb3\xef\xee\x51\x72\x14\xad\xdc\xdc\x6c\xfa\xb0\x83\x3d\x93\x60\x7f\xbf\x41\x7b\x50\x48\xd7\xcd\xcd\xbd\xeb\x84\xce\xdc\x08\xec\xe0\xdf\x81\x81\xce\xd1\xfe\x87\xc0\x08\x1c\x7b\xc0\xf0\xcf\x78\xd9\xba\x3f\xa4\x9a\xf2\x9b\xc4\xe5\x50\xa6\x72\x63\xda\x92\xd2\x92\x28\x91\x1d\x21\xc0\x6f\x31\x02\x9b\xa7\xb9\x7e\xf9\xf4\x79\xf3\x4f\xd6\xde\x80\x5f\xc5\x2c\xb9\xfe\xbf\x4d\x38\x76\xb1\x47\x1b\x29\x1b\x90\xd1\x3f\xbb\xd1\x24\x15\x42\xdc\x94\x9a\x99\x23\xb5\x70\x8b\x11\xa7\x36\xa3\xb8\x7d\xcd\x75\x01\x46\x0f\x0c\xb5\x81\x3b\x1d\x3a\xd4\xd5\x06\x46\xb9\x71\x6a\x07\xe0\xc1\x66\xc0\x4c\xa7\x9a\x99\x49\x35\x13\x5e\x6e\x42\x11\x72\xb4\x28\x35\xe7\x3a\x03\x88\x7e\x9d\x9b\x9b\x75\x91\xd0\x97\xe4\xd2\x24\x48\xcc\xec\x8e\x7b\x0e\xa3\xbf\x68\x84\xbd\x34\xeb\x76\x60\x2d\x9b\xf3\x7d\x70\x06\x72\x73\xc1\x29\xba\x24\x2a\x8a\xdb\x27\x44\x68\x7b\x45\x7f\x3b\xb0\xe6\x76\x40\x2f\xf7\xc1\x41\x9f\x53\x6e\xf4\xc4\x32\x34\x61\x12\xd0\x67\x29\x0e\x5a\x21\x37\xb7\x9e\x22\x57\x67\x58\x9c\x65\xc0\xd2\x0a\xc8\x65\x8f\xc2\x5e\x06\x6d\x9f\x18\x4a\xbe\xab\x87\xdc\xdc\x94\x5a\xfe\x38\x00\x33\xdb\xc3\xfe\x65\xd6\x5b\xd0\x7c\x8a\x5c\xc1\x17\xa9\x41\xbb\xd9\x18\x48\xad\x8e\x00\x49\x55\x04\x9c\x2c\x29\xe4\x50\xc3\x70\x4e\xc1\xf0\x16\xe8\xf0\xec\xd0\x6b\x75\xc6\x9a\x38\x1d\x07\xf4\x8b\x2e\xb5\xa0\x19\xea\xb1\x15\x90\x33\x53\x05\x33\x9b\x6a\x11\xba\xc6\xbd\x18\x2a\x39\x63\x19\x3c\xb6\x08\x7c\x6a\x33\x7c\xc4\xba\xf1\x12\xd1\x56\xf7\x0a\x7c\xfb\xc4\x73\x3c\xf6\xc8\xa5\xc5\x60\x73\x0d\x27\xfd\xb1\x17\xf5\xa8\x90\x5b\xa0\x36\x7d\x15\x66\x16\x43\x2e\x6d\xaa\x15\xd9\x5d\x71\x61\xbd\x44\xf3\x3e\x21\xa6\xfd\x40\x87\x3a\x43\x2e\xc7\x5a\x6b\x69\x12\x31\x1c\xd7\x85\x99\x59\xe7\xc2\x7e\xbd\x85\x8f\x3d\x12\x5a\x0c\x48\xfb\x38\x27\xc8\x12\xde\x55\x3a\x56\x26\x61\x40\xef\x2b\x40\x10\x95\x45\xc6\x2f\x62\x34\x96\xdb\x97\xf0\xd8\xd4\x5a\x73\x2b\x14\x5c\xa3\x2b\x62\x56\x77\xf0\xa5\xbf\x24\x17\x63\x95\x4f\xc6\xaa\x0d\xad\x65\x23\x33\x54\x7e\x69\xd6\xf9\xb9\x1e\x0a\xb3\x31\x41\x66\x7d\x22\x83\x8e\x36\x98\x9b\x2a\x7c\xb2\x02\xf2\xc5\x24\x74\xac\x1f\xd0\x2f\xe3\xf3\x61\x06\x66\x17\x40\x33\x14\x3d\x43\x13\x66\x86\xfa\x38\xd7\x83\x67\x1c\xc9\xd2\x38\x80\x58\x3f\xc8\xa0\x23\x44\x3d\x3d\x20\x97\x2c\x43\x63\x36\x03\x32\xab\x2b\xb8\x86\xfa\xe0\x3a\x2f\x9d\x59\xff\x09\x90\xc3\x65\xcb\x37\x17\x91\xcb\x76\x57\x32\x1a\x9b\x21\x8f\x8d\xd5\xe7\x94\x65\xa6\x98\xdd\x6d\xbd\x0c\xbd\xc7\xb9\xce\x2c\x66\x7a\x00\x7c\xb3\xce\x4d\xad\x2e\x37\x37\x02\xf0\x64\x53\x8d\xb9\x15\x58\x73\xab\x0b\xbc\x3e\x01\x16\xba\xba\x98\xeb\x5a\x0b\x9a\x14\xbe\xd4\xd5\x67\x38\xd6\x78\xd8\x57\x9f\xa7\x36\x03\x5e\x6c\x0a\xab\xf7\x83\xc6\x7c\xac\x71\x4f\x06\xd5\xc8\xe7\xc7\x79\x63\x77\x1c\x72\x70\xac\xa6\x3d\x96\x6a\xc5\xba\xd7\x32\xd5\x65\xd3\x77\x88\x0a\x57\x91\x64\x29\x3c\xb5\xa9\x26\xce\xd2\xb8\x3d\x5c\xb6\x30\x83\x01\x33\xb6\xcb\xa7\xba\x0a\x16\x6c\xbb\xb3\x18\x2e\x5b\xd0\xec\xf2\x90\x65\xc0\x83\xa1\x09\xee\x40\x4e\x5d\x3d\xf0\x7b\x3a\x43\xce\x74\x21\xea\x8d\x09\x1a\x63\xdb\x0f\x73\x5d\x13\x9f\xfa\x75\x34\xc7\xc6\x52\x47\x34\x5d\x36\xfc\x3e\x41\x7f\xb1\x35\x0e\xf6\x43\x0e\x5a\xcc\xa3\x3b\x6a\x2f\x42\x51\x21\x19\x6e\x11\x9b\x63\x2d\xc6\xad\x40\xc9\xc6\xc4\x73\xac\x09\xf1\x6c\xac\xe2\x70\xa4\x96\xed\x55\x3e\x35\x84\xd8\x43\xf3\xb3\x35\x2e\x1d\xa9\x6b\x3a\x59\x0c\xfd\x64\x10\x74\xa8\x6b\x83\xd9\x36\x5f\xf9\xb9\x29\x91\x0d\x5b\xc5\xcb\xf1\xc9\xa9\x13\x82\xa5\x2e\xe1\x4f\x26\xe3\xf7\x74\xb5\x31\x1d\x07\xcf\x50\x6f\xe3\x0d\x5d\x1b\xf4\xf4\x7a\x2b\x1c\x13\x53\x38\x26\x52\xd2\x51\xc1\x0b\xe5\x56\x38\x81\x27\xb3\xce\xc1\x5d\x9c\xc6\x04\xb9\xd4\x3f\x0a\x27\x95\x9f\x5b\x81\x72\x14\x27\x33\x78\xec\x21\x5a\x51\x6e\xfc\x34\xd6\x04\x77\xe4\x91\xd0\x66\x06\x73\x47\x03\x59\x41\x4f\xf2\xa5\x1f\x08\x73\x9b\x11\x32\x24\xff\x66\x28\x64\xb9\x4c\xee\xa1\xf5\x6e\x9b\xd5\xdc\x34\xd1\xef\xab\x85\x6d\xec\xab\x5c\x6c\x37\x4f\xcf\x6f\x5b\xfe\xe1\xbc\x4f\xf0\x48\x3f\xe6\xd6\xf2\xb1\xde\x5f\x8a\x79\xff\x5c\x06\x9b\x31\x34\x03\xda\x33\x19\xe0\x8f\x34\x08\xad\x45\x1c\x5a\x8c\xfd\x64\x30\xe0\xc9\x78\x29\x78\x50\xce\x2f\x30\xeb\xac\x3b\xd6\x44\x4c\x57\xf1\x85\x4d\xb5\x62\xd3\x6b\x7d\x1d\x48\x0f\x33\x5e\xc3\xbe\xb2\x8c\x38\xaf\xec\x7b\x5f\x05\xb3\xb1\xca\xa5\xba\x96\xcf\x91\xb4\x82\x29\x6e\x48\xf8\xd2\x40\xf6\x43\xb6\x32\x8b\x00\x4b\x3b\x00\xcb\xbe\xc6\x45\xb6\xea\x67\x66\xbd\x85\x21\x7b\x36\x56\x17\x99\x15\xb6\x32\x6b\xb9\xab\x7f\xf4\x17\x8b\x00\x4f\x7d\x95\x4f\xc7\x2a\x3e\xb5\xbd\xd6\xd4\x09\x79\x38\x5e\xe2\x99\x49\x34\x62\x9b\xc9\xf5\x7a\x2d\x93\x52\xab\x92\xa9\x4c\xef\xf2\xfe\xea\x3b\x34\xe7\x3a\x58\x1a\x9a\xd8\x40\xf8\x8e\x89\x0c\x5a\x5e\x6b\x6e\x31\x60\x66\xd5\xf9\xb4\xaf\xb5\xa0\x15\x2c\xdc\x5d\x3e\xb0\xd4\x38\x60\x19\x6e\xa9\xab\x74\x42\x79\x4d\xd7\x50\xc7\xae\x8a\xa7\x2e\xd7\xcd\xa6\x76\x57\x84\xa6\xd6\xc2\x26\x52\x33\x33\xbb\x82\xcb\x4b\x2d\x5b\x93\x53\xd7\x66\xa6\xd0\xf4\x5a\x2f\x26\x03\xa0\x45\x35\x9f\x07\xed\xd4\xd5\xd5\xe7\xdc\x9e\x3b\x0c\xc4\xd8\x76\xe7\x2b\xcb\xe8\x31\x15\x88\x73\x33\x50\x56\xb6\x59\x97\x9a\x7e\xaf\x5b\xd8\x69\x4b\xed\xb8\x13\xaa\x15\x5a\x01\x58\xb0\x74\x63\x3a\x0e\x39\xac\x2f\xf9\x3b\xba\xcc\x37\x2c\x82\xc7\x4c\xaa\xe1\xf7\x5f\x9a\xcf\x7d\x55\x8c\x2d\x02\xf1\x13\xe9\x2c\xb9\xd4\xa5\xc6\x93\x49\x34\x02\xb6\xbd\x78\xe4\x30\x30\x12\x3d\xab\x67\x10\x60\x69\x06\x20\x45\xba\x68\x05\x60\x62\x15\x36\x71\x69\x7a\x4d\x92\xed\x2e\xe6\xe3\x00\xce\xfa\x75\x71\x69\xab\x4a\x21\xdb\x61\x35\x46\x33\xeb\x6b\x7c\xc3\xaa\x8b\xd0\xcc\xed\x29\x5c\xea\x9a\x3d\x35\x99\x45\x36\x26\x70\x9f\xa5\xb0\x6c\xac\x8a\x7e\x1f\xe9\x50\x28\x90\x7c\x5b\x78\xe9\xd7\xc5\x27\x2b\xef\x87\x68\x8b\x4f\x4d\xe4\x0f\x9b\x71\x60\x68\x1c\xb4\x09\x3a\x35\x29\xfc\xc9\x54\x05\x64\xe3\xa7\x3a\x23\x14\x7e\xa9\x8d\x61\x7c\x1b\xe9\x0c\xbf\x40\x30\x2d\x84\x9b\x4a\xcf\x90\x3c\x53\x01\xf2\x85\xa0\x8e\xe4\xa2\xaf\xf2\x19\xf2\xeb\x7d\x95\xf6\x75\x0a\x5f\x98\x75\x0e\x1b\xc9\xec\x72\xf0\xc4\xee\xef\xbb\xa3\xa3\xbb\x7c\xee\xd7\x77\xf4\x8c\x7a\x4d\x3b\x15\x83\x43\x85\x06\x8a\x26\x44\x9c\x1c\xd0\x99\x2e\xb5\x5e\x1c\x8d\x47\x3a\xe1\x53\x2e\x54\xc6\xaa\xe5\x1a\x01\x89\x5b\x41\x63\x6a\x32\x42\x8f\x02\x25\xbd\x54\x71\x22\x06\x30\xb5\x19\xb0\x64\x69\xb2\x2d\x63\x38\x3f\x52\xe9\xa5\xb9\x88\x7a\x2a\xa6\x73\x32\x2d\xd2\x0a\xc4\x7a\x94\xd2\x98\x9a\xaa\xe2\x9a\x2a\xe9\x1b\xaa\xde\xa0\x5c\xc8\x8f\x35\xf1\xc9\xa0\x5a\xbf\x9b\x75\xc4\x37\x3a\xd5\x9b\x11\xa7\x04\x20\x33\xeb\x3a\xd4\xea\x76\x6c\x32\xe2\xd3\x58\xe3\x7c\x96\x7e\xec\x51\x80\x83\xa6\x4a\x12\xba\xd4\x52\x24\x05\xa7\x15\x5c\x6c\xc9\xa0\xd9\xa3\x60\xc6\x48\xca\xb3\x22\x02\xce\xa6\x5c\x38\x44\x76\x85\xed\x72\xd0\xae\x73\xb1\xcd\x80\x89\xcd\xd0\xe1\xc1\xb1\x42\x90\x22\xb9\x94\x3b\x64\x57\xc2\xe0\x50\x44\x3e\x2a\x9c\x4e\x6d\x55\x8c\xed\xed\xdf\x83\x31\x92\x71\x01\xcd\x89\x04\x80\x6e\x01\x40\xaf\xe7\x84\xfc\xaf\x4d\xd0\x4b\x04\x53\x56\x69\x6c\x4c\xb8\x6e\xcf\x8d\x38\x05\xf1\x9c\x6a\x2e\x87\x32\xfb\x32\x68\xc6\xb4\x8c\x8d\x7b\x54\x40\x7f\x61\x99\xe7\xb9\x4e\xc0\x19\x4b\xe1\x71\xf1\x37\xfd\x34\x26\x48\xdc\x0c\x05\xb7\xdc\x99\x7c\x61\x29\xd6\x57\x70\x40\x29\x18\x2f\x49\x00\xcd\x99\x1c\x4a\x8a\xe0\x51\x6e\x5c\xf1\xe5\xc9\x66\x16\xae\x55\x17\xa7\x28\x26\xd1\x19\xf2\x09\xc9\x7f\x3f\xe4\xa1\x15\xea\xf1\x98\x50\x7a\x63\x2d\x72\xc7\x2a\xbf\x5c\x8f\x87\x65\x66\xce\xdb\xa6\xc7\x51\xd3\x17\x1d\xc9\xa7\xaa\xb8\x5c\x9d\x7f\xec\x7b\xd1\x7c\xd2\x5d\x84\x48\x26\x46\x14\xeb\x0b\x0a\x2f\x29\x3e\x90\x65\x1c\x48\x02\x06\x38\x91\x62\x63\xd6\x8d\x7a\xb2\x22\xf2\x92\x82\xb7\x44\x4c\x21\x59\x4f\xfc\xaa\xc0\x16\x27\x2b\x74\x57\x94\x14\xd8\x5f\xc6\x64\x7f\x29\x7e\xdd\x68\xf3\xc4\x2e\xa3\xf9\x44\x62\x7b\x15\x7e\x6c\xb7\x85\x9b\xcc\xc2\x65\x3d\x91\x17\x3b\x78\xd9\x77\xef\xf7\x92\xd2\x81\xbc\xa0\xd8\x34\x1a\x17\xcd\xc5\x64\xc8\xd0\xac\x03\x24\xef\x99\x41\x88\xb1\xe5\x35\x0b\x5b\x41\xd0\x4b\x73\x89\x2f\xad\xd2\x7f\xf0\x4f\x68\x5e\x02\xf2\x41\x8f\xac\x27\xb6\xf2\x71\x7c\x5a\x90\x14\xbe\x25\x40\x30\x14\x3b\xcf\x34\xeb\x35\xff\xd5\x27\x00\x36\x5e\x92\x53\x2b\x78\xcc\xac\xb0\x39\x1f\xab\x62\x66\xa8\x0f\xd9\x98\xe8\x64\xe3\x10\xcc\x74\xe6\x19\xf6\xc3\x16\x34\x85\xb8\x8a\x5d\x32\xd3\x6b\x7a\x5c\x87\x96\x64\x65\x17\xde\x86\x7d\x74\x23\x97\x65\xb8\xa9\x45\x28\x04\x4f\x35\x91\x2e\x3f\x8e\xda\x8b\x03\xfd\xf6\xe0\xa1\x71\x59\x5f\xe5\xa7\x7d\x95\xc3\xcd\x40\x4c\x75\xa9\xb1\xd0\x55\xac\x87\xe2\x9e\x31\x31\x9d\xdb\xc4\x83\xdb\x07\xac\x8b\x62\xfe\x41\x3b\x7a\x1e\xb4\x9b\x0b\x96\x2a\xfc\xf3\x58\xe3\xe6\x7d\x8d\x5b\xec\xda\x04\xab\x0e\x5f\xc6\x04\x39\xd3\x03\x18\xf6\x09\xdc\x37\xa5\xe6\xe3\xa8\x03\x46\xa2\x1b\x23\x3e\x30\x8a\x4f\x0e\x41\x07\x0c\x45\x1a\x48\x72\x1b\x0b\xb9\x0e\xde\x91\x15\x5d\x92\xb1\x86\x22\x2a\x8d\x0e\x00\xdc\x80\x5b\xc4\x6b\x9e\xc9\x55\x9b\x82\x47\xe5\x77\x95\xbc\xd0\x32\xd4\x39\x04\x53\x56\xc0\x10\xe4\xf0\x9e\x47\x02\x46\xe7\x72\xbc\xd3\x56\x92\xb1\x67\x7a\x84\x70\xf6\xf1\x8e\x0c\xf8\x11\x00\x5c\x5b\x04\xdc\x48\xee\x00\x4e\x86\xbc\x22\x28\x8d\x76\x3e\x1e\x35\x8d\xcc\x3a\x8f\x15\x32\xec\x87\x94\x8f\xf0\x8f\x7a\xa6\x9a\xf9\x86\xc6\xba\xfd\xba\x3e\xb5\x90\x0d\xec\x5a\xaf\x7d\x09\xb2\xed\xaa\x90\xd3\x01\xc5\x9e\x05\x0d\x1a\x2f\xba\xc6\x11\x86\xca\xc3\x2d\x5b\x88\x83\x99\xa1\x89\x36\xe5\xd3\x01\xb2\x6b\x23\xb5\xf2\xa9\xeb\xf6\x14\xe4\xa0\xa5\x01\x64\xb3\x5f\xf6\x7e\xef\xc6\xa6\x92\xc7\x03\xf0\x49\x07\x58\x4f\x54\x1b\x84\xa1\x71\x73\x33\xc0\x51\xbc\xc2\x18\xea\x33\x1c\x49\x07\x78\x23\xc4\xb4\xc3\x80\x27\x25\xd7\x6d\x51\xb0\x02\x85\xec\x4b\x24\x6e\xd5\xd9\xc2\x87\x11\xd5\x78\xad\x6a\x6d\x04\x65\x77\xb3\x8f\x40\xf6\xeb\xe0\xc5\xf2\x48\xcf\x50\x1f\xe6\x6b\xdd\xe2\x70\xd3\x6b\x59\xc8\xd7\xf7\xa5\x1c\x8f\xa5\xa3\xb5\xe6\x86\xda\xc0\x58\xaa\x80\x6f\x11\x5c\x6c\x7a\x24\xaf\x6b\xe2\xd2\x50\xf9\x17\x51\x9b\x62\xba\xda\x78\x41\x71\x0c\x4b\x2f\x7a\x6c\xee\x97\xa6\x73\xab\x2e\xe6\x31\x33\x4b\x01\x76\xfd\x79\x61\x0f\x39\xe5\xc1\xd5\x9a\x91\x8b\xec\x8d\x15\x60\xe5\xef\x78\xc6\xb6\xb9\xb0\x6a\x6b\xaf\x74\x37\xe7\x03\x92\xef\x2f\xa5\x1e\x64\x3a\x83\xcd\x2c\x06\x64\x9b\x6d\x8b\xb5\x1f\xc0\xec\x97\x68\xe3\xf7\xf8\x4b\xd9\xc6\xdf\xb0\x39\xd5\x78\x6d\x5d\xe3\x30\xe4\x9b\x74\xe9\xd5\x58\x55\x1b\x06\xad\x3f\xed\x0e\x98\xe9\x34\x58\x9a\x25\x1c\x11\x72\x23\x19\x8a\xb4\xec\x8b\x40\xf1\x17\x55\xdb\x81\x49\xd8\xa1\xae\xb1\xae\x40\x90\x33\x8b\x20\x53\x5d\x2a\x69\xa9\x3c\xcf\x75\xec\x19\xda\x01\x48\x59\xda\x9e\x5a\x41\x23\x36\x03\xab\xea\x27\x58\x01\x24\xc6\x9a\x08\x25\x02\x34\x4e\xe0\x23\x23\xff\x34\x26\x00\xbd\xbd\x36\x2e\xf0\x52\x30\x12\x28\x3e\x4f\x8b\x4a\x43\x95\x90\x7e\xf8\x38\x2d\x43\x61\xb7\xaf\x64\x12\xcf\x90\xa5\xc4\x57\x3a\x56\xd1\x53\x21\x90\x1f\xe7\xa1\x12\x90\xa9\xae\xc0\x19\xf2\x21\x66\xc0\xef\xed\x23\x29\x0d\x19\x74\xe8\xa1\x80\x29\x3d\x51\x9b\xc2\x31\xce\x63\x66\xbd\x79\x40\xbe\xf2\xef\x5c\x4e\x79\xe8\x29\x01\x78\xb1\x19\x7a\x69\xb7\xf1\xa9\xd9\xb5\xa7\x8e\x36\x58\x7f\x46\xf3\x70\xfc\x82\x3d\x53\x90\xc7\xc6\x1a\x87\xc9\x0c\xcc\x0c\x4d\xe4\xcc\x50\x44\xbe\x6b\x6a\xb6\x31\x64\xbf\x4c\x49\x6d\xa0\xf6\xa9\x49\x63\x3d\x40\xd0\x33\x9b\x01\xbe\x10\xfa\xa4\xa9\x81\xd4\x66\xfc\xcc\xd6\x78\x68\x79\x0d\x04\x23\xd4\x35\x61\xef\x7a\x65\x5b\xb7\x4a\x3f\x41\xad\x6c\x5f\x4b\xc0\xb9\xc9\x86\x9f\x9b\x48\x8a\x40\x72\x4b\x11\x7d\x2e\x89\x95\x2d\x52\x60\x87\x5b\xc4\x85\x1f\x81\x64\x4b\xe9\xc0\x89\x80\x3d\x73\xa2\xd2\x50\x34\x8c\xa7\x15\x28\x4e\x04\x8c\xe4\xe5\x7c\xbf\xa3\xd1\x92\x15\x25\x87\xb1\xe1\x77\x06\x12\xb2\x83\x9d\xbc\x6d\x1e\x23\xc9\x58\x63\x28\x28\x38\x8d\xe0\x2a\x3e\x3e\x11\x40\x8b\xd3\xb0\xb2\x1d\x4d\x22\xfb\x87\x60\x8f\x64\x05\x1f\xc9\x90\xcc\xdb\x8e\x24\xcb\x17\x00\xc7\xa3\xb6\xd5\xf8\x88\xb7\xa0\x53\xb6\xf3\xf3\xb1\x43\xca\xa7\x25\x19\x27\x47\x4a\x07\x48\x02\x10\x47\x0a\x24\xdb\x82\xd2\x68\x29\x10\x4e\xf2\xcf\x30\x84\x4b\x65\xc3\x69\x49\xc6\x48\x41\x04\x5c\x0b\x74\x48\x49\xc0\xe8\xa1\x00\x38\x7d\xab\x9d\x64\xf5\x04\x10\x03\xc5\x07\x13\x34\xfe\x06\x9e\x9c\xd8\x2e\x61\xac\xe6\x58\xd0\x4a\x85\x40\x12\x01\xc9\x28\x28\x26\xec\x40\x5a\x86\x62\x45\x5b\x46\xe9\x6c\xb5\xa5\x24\xe5\x79\x20\x29\x8d\xae\x86\x29\x4b\x11\x90\x72\x8e\x87\x62\xd3\x1a\x46\xb7\x05\x8c\x04\x1b\x7d\x57\x6d\x45\x99\x43\x31\xe0\x48\xf1\xe9\xae\xa8\xac\xda\x71\x22\x65\xf5\x04\xec\x19\x28\x38\x8a\x3b\x49\x5e\xa4\xf9\x4e\x4e\x47\x5f\xe7\x44\x7c\xbd\x2f\x95\xd3\xb2\x6a\xe7\xe3\x1d\x05\x13\xdb\x32\xd6\x60\x24\xc5\x5e\xb5\xe1\x16\x71\x47\x00\x62\x4b\xf0\x69\x59\x04\x64\x4b\xc0\xf8\x11\xc8\x79\xbe\xe2\xf1\xfa\xfb\x35\x0e\x15\x5f\x3b\x32\x86\xb7\x24\xa5\x31\x91\x7d\x9a\x17\xe5\x6d\x1f\xd8\xf7\xc4\xb6\x4c\x03\x19\x74\x80\xa4\x74\x68\x09\xf1\x52\xec\x90\xbc\xa0\xc0\x61\xde\x5e\xb2\x7a\x68\x1f\x0a\xf8\x34\x90\x69\x71\xa2\xd0\x1c\x2d\xfa\x70\x8c\xf0\xde\xf8\x5c\x50\x7c\xc0\x48\xc0\x0d\x0b\xdf\xb9\xc6\x71\xa4\x14\x6b\x43\x25\x00\xbe\xc4\xd0\x98\x8c\xf6\xe9\x20\x1f\x19\xaa\x8e\x29\xb0\x99\xc7\x71\xc5\x3c\x80\xac\x74\xe8\xb6\x28\xe3\x92\xd2\x8c\x4b\x7a\x81\x21\xf0\x21\xf2\xfd\x3c\xc2\x0b\x8d\x29\x2b\xa2\x2c\x76\xb6\x3f\xe7\x16\xb1\xa4\xd0\x24\x23\x2a\xb4\x02\x00\x47\x2b\x2f\x58\xc8\xd1\x9c\x20\xfa\xa0\x05\x68\xc4\xff\x41\x48\x41\x4e\x28\xed\x32\xe2\xfd\x2a\xa6\xe3\x16\x55\x3c\xcc\x4f\x6d\x6a\x15\x03\x55\xb1\xad\xbf\xf2\xd9\xd2\x2a\x2e\x9a\x59\x95\x3f\x45\xb1\x0b\x65\xa3\xfe\x21\x05\x33\x60\x16\x7b\x0a\x2d\x1d\xd9\x8f\x27\xdc\x37\x09\x3e\xd1\x35\x76\xdd\x5e\x88\x25\x5d\xa3\x71\xb4\x36\xb3\x5e\xf6\x7d\x1f\xf5\x54\xbc\x8a\x9b\x81\x4d\xf9\x60\x66\x07\x70\x69\x12\x8d\x4c\x57\x1b\x85\x0f\x91\xb1\xcd\x75\xe9\xdc\x0c\xf4\x58\x5f\xc7\x6c\x2b\xdb\x88\xec\x99\xd2\xe5\xe0\x48\xc2\xe6\xb6\xc6\x2f\xfb\x85\x3f\xad\xc6\x74\x91\x3f\xec\xab\xe3\xdc\x46\x6e\xf6\x19\x1d\xf0\xef\xb6\xba\xf6\xa5\x06\x43\xbe\xd8\x4c\xe5\x63\xdd\xd5\xe7\x65\x4c\x0f\xe5\xb5\x6f\x5f\x9a\x95\x6f\x52\x15\x57\x50\x78\x49\xc3\x39\xba\xf2\x1f\x1a\x06\x3b\x95\x2f\xd0\xa5\xd6\x5b\xec\x5a\x39\x26\x0e\x59\xa4\xf3\xe0\x82\x3e\xe7\xd8\xa5\x4d\xdc\x0f\xd9\x1d\xea\x75\x1b\x11\xb6\x36\x70\xd9\x9a\xe3\xb9\x36\x66\x03\x57\x7e\x00\x00\x8f\xd6\x95\x93\x5c\xf6\x91\x1d\x2b\xec\xc3\x04\xf8\x40\x52\x30\x38\x5a\xd1\x5b\x55\x5c\xb1\x43\x2b\x82\xc2\xb5\x14\x0c\x4c\x04\x85\x6f\xcb\x78\x6e\x1f\x73\x5b\xb0\x81\xc7\xb6\x4d\xd8\x98\xe7\x3e\x5d\xdf\xe8\x87\xfc\xf2\x1a\xee\xc6\xdc\x5f\xe9\xf1\x06\xcc\x12\xef\xed\x58\x7c\xa3\xaf\x02\x5b\x93\x0d\x5d\xdd\x1b\xa7\x59\x54\x13\xe3\xdb\x83\x2f\xc3\xb6\x80\x0d\x96\x65\x5f\x6d\xec\x96\xfa\x5a\xc9\xff\x97\x9d\xbf\x2b\x58\x04\x4b\x91\x4b\x5b\x7d\x58\xc5\xdd\x3a\x45\xae\xd6\xe0\x43\xef\xf0\x77\xd5\xf8\xe7\xc4\x7e\xeb\x35\x68\x81\xdf\xa6\x3e\x9e\x8a\x55\xfa\x68\x4f\xf9\x65\xbc\x18\x50\x79\x0c\xad\x18\x2a\x0e\xd1\x1a\x53\xa9\x8b\x73\xab\x88\x53\x5e\xf8\xa7\xe6\x22\x8f\xf7\xcb\xd8\x7a\xac\x3e\xd7\xc7\x1a\x7c\x59\x7f\x26\xca\xba\x3a\x20\x07\x42\x2c\xe7\xf1\x4d\xe7\x39\x36\x55\x88\x49\x6a\x03\x43\xeb\x81\xb1\xba\x20\x07\x4d\xb4\x77\x50\xc6\x5a\xcc\xb3\x4d\x41\x7b\x8a\xe2\x1d\xbd\xc3\x35\x46\x2a\x8e\x9b\x5d\x31\xee\x6b\xe0\x05\xd9\x25\x04\x43\x27\x00\x56\xad\x6f\xf6\xec\x8b\x6d\xd9\x1f\x53\x25\xd1\x7a\x65\x6e\x79\xab\xb8\x66\xe5\x5b\x34\xec\x7c\xbf\x20\x10\xcf\x38\xb2\xb9\xd6\xeb\xfe\xb9\x1c\x2b\x78\xab\x25\x60\x0a\x29\xf8\x1c\x4f\xf9\x62\x0b\x74\x68\x4a\x00\xfc\x96\x0e\xad\x7d\xdd\xfa\xfb\x95\xdc\x76\xaa\xf8\x24\xa3\x55\xa0\xb7\x80\xf2\xac\x68\x78\x4b\x12\x15\x9d\x53\xf3\x38\x62\xfd\x79\xa5\x03\xe5\x5a\x71\x2d\xfb\x6d\xbc\x95\xef\x81\x02\x0e\xea\x9d\xe9\xd4\xee\x90\x0b\x5d\x6d\xc8\x06\x03\x03\x9b\xe6\x04\xca\x3f\x80\xef\x07\xf9\xa1\x35\xad\xff\x8e\x6b\xc7\x3c\xbe\x5d\xed\x1d\x53\x3e\x78\xd0\x55\xbe\x90\x49\xba\xb5\xd4\x65\x2c\xdb\xaf\x0b\x5c\xc6\x52\x78\xc0\x52\x60\xb8\xd5\xa7\x7d\xc0\x87\x85\xe0\xbd\x6b\xc1\x5d\xfb\xca\xaf\x65\xe8\xa0\x0d\x3e\x68\xe7\xce\xb4\xbd\x7d\x11\x40\x55\x00\x60\x00\x68\xb2\x8a\x9b\x36\xc6\xda\xd6\x93\x8d\x7e\xb9\xed\xcd\xe5\x1a\xf0\x82\xa0\xf0\x2b\x98\xa6\xd4\x5c\xea\x9b\x36\xb1\x8e\xd6\xd7\x1b\xb2\x43\xc4\x73\x5b\xe3\x66\x63\x75\xf1\xe5\xc8\x77\x15\x0e\x04\x4b\x91\xc4\x58\x63\x51\x9c\x51\x1f\x7a\x1b\xbf\x87\x51\xd9\x66\x2d\xb7\x05\x0e\xad\x35\xaf\xff\xc6\xf6\x29\xdf\xfb\x66\xe8\x14\xe9\x49\xbe\x46\xf3\x69\x03\xc5\x8b\x1a\xde\x1a\x29\x50\x6c\xc9\x74\x4e\x77\x5a\xc1\xf9\x89\x88\x73\x23\x00\x5a\x13\x49\x11\x65\x14\x77\x2a\x1d\x52\x02\x1d\x7a\x80\xf8\x2a\x02\x5e\x2e\xbf\xe7\x44\x7a\x10\xee\x83\x03\x14\x91\x43\xeb\x07\x0d\xb3\x25\x19\x07\x02\x8a\x75\x8a\xb8\xb4\x6c\xd3\xa1\x81\xd8\x81\x8a\x8c\x73\x5d\xc5\x27\x81\xd2\x81\x1d\x65\xb9\x5e\xdf\x54\xe3\xbd\x5a\xe3\xac\xfa\x97\x9f\x6f\xaf\x73\x04\x19\xe7\x14\x41\x79\x9e\x88\xca\x33\x0d\x7c\x5a\x11\x15\xf1\xe0\x5c\x36\xda\x6c\xe1\x70\x4c\x8e\x11\x2d\x36\xbe\x5f\xc9\xe9\x5f\xb4\x56\x10\x50\x4e\x62\xac\x2e\x7a\x6b\x7a\x4d\x47\x0a\x2e\xae\x70\x95\x3b\x64\x5b\x00\x22\x27\x63\x0f\xb3\x72\x5f\x75\x86\xd6\x06\x87\xd6\x3a\x2b\x1f\x8e\xd6\x49\xf4\xca\x56\x87\x07\x65\xec\xd5\x7e\x5e\x6e\xfb\x16\x25\x5e\xdf\x71\xad\x70\x42\x87\xde\xb3\x8e\x28\xf5\xe4\xef\x6d\xb7\xcf\xd3\xdd\x0a\x1e\x5a\x5f\xec\xea\x88\x00\x40\x47\x2a\xe2\xff\x42\xfe\x51\x3c\x4f\x0f\x8e\x8d\xb1\xb9\x87\x70\xac\x1d\x2d\x77\x80\x2a\x00\x91\x16\xe9\x43\xb0\x8f\xc4\x2d\x1b\x3e\x65\x9f\x3e\xbd\x37\x1e\xdf\xa7\x2b\xfb\x7d\x49\xd3\xe5\xdb\x02\x36\x7c\x72\x51\xbe\xb0\xc2\x7d\xc1\xb6\xf3\x1c\xe0\x97\xc1\x13\xbb\x1c\x78\x7f\xa9\xdf\xa9\xf4\xec\xbc\x7d\xbe\x77\xec\xe5\x19\x0c\x3f\x37\xbd\x66\xa6\x78\xab\x7c\xe4\xc4\x96\xe3\xf9\x38\x40\x75\x3c\xe2\xc4\x7a\x8a\x89\xb1\xc6\x4d\x50\x6e\x48\xab\x0b\x5f\xac\x97\x66\x95\x07\x98\x5b\x5d\x61\xbb\xf6\x86\x78\x8e\xcd\x10\x3c\xa0\x18\xae\xcc\x8f\xf6\xa8\x00\x4f\x74\x06\x2e\x73\xfa\x96\x74\x30\x19\xe8\xf5\xeb\xf6\xb4\x6c\x33\x97\x37\x61\xf8\x65\xce\xdd\xcf\xa0\x03\xf4\xa9\xad\x3e\x63\x7d\x88\xd6\x12\x1c\xac\x6a\x09\xd0\x1a\x85\x65\x50\xbe\x8d\x2b\xf2\xf4\x5e\xd3\x33\x55\x31\x63\x9f\x52\x57\x67\x1e\xcf\xb0\x65\xe2\xdc\x26\x1a\xa9\x49\xd0\x7e\x5f\x12\x11\x4e\xd9\xb8\xce\x41\x9d\x01\x33\x9b\x81\x53\xb3\x3b\x58\xdb\xa3\x75\x1e\xc7\x04\x6a\x23\xb6\x69\xac\x27\x32\xe0\x65\x5c\xe7\x62\xab\x2b\xc6\x26\xf1\x40\x2a\x0c\x58\x1a\x79\xde\x75\xe0\x72\x5d\x7d\x6a\x79\xad\x27\x2b\x00\xbe\xae\x36\x30\x43\xa5\x53\x6b\xd9\x5a\xea\xaa\xbe\xd4\x35\x3e\xda\x8d\x3d\x41\x17\x2e\x74\x19\x9f\x9b\x01\x78\x31\x18\x12\xdb\x6f\x67\x9e\xe7\x63\x82\x4e\x57\xf6\xa6\x6e\xcf\x4c\x86\x9c\xea\x14\xbe\x33\x4e\xe3\xc5\xa0\x9a\x3e\xa2\xcf\xf9\x76\xfd\x34\x2d\x6c\x06\x66\xc8\xbe\x8d\xda\xcf\xc8\xce\x6f\xc4\xe0\x22\xb4\x08\x7e\x69\x68\x45\x1d\xc6\x08\xa0\x5c\x3d\x9c\xeb\x0c\x7c\x62\xa9\x1c\x96\xbb\x03\xcb\xb5\x02\x10\xa0\xb8\xdc\x40\xf1\x10\x2e\xc6\xa6\x0a\x96\x9a\x10\x8f\x4c\x3f\xaf\xcb\x2b\xe2\x28\x06\x8f\x4d\x17\x7d\xc6\x4f\x4d\x54\xcb\xc7\xd0\xcb\x91\xdc\x9c\x0f\x64\xf6\xcb\xa0\xdd\x44\xff\xf5\x04\x95\x7f\xb2\x35\x6e\x3a\xae\xc3\xbc\x0f\xaf\x0d\x50\xae\x9a\xab\x6a\x3f\x34\x21\xd6\xc6\x28\xfe\x52\x45\xca\x91\x71\x0c\xad\x3b\xad\x70\xb0\x13\x5b\xc5\xbe\x49\x34\xe0\xc6\x9e\x76\xbe\x47\x64\x32\xe4\xd3\x58\x2d\xeb\x19\x36\xe9\xa3\xf2\x4b\x5d\x15\x51\x5e\x27\x46\xf9\xea\x7e\xc8\x47\xab\x98\xcb\x1b\x4c\x4b\xfe\x78\x63\xc4\xe7\x90\x87\x36\xca\x4d\x6a\x4a\x8f\xf2\xf9\x91\x0c\x5a\x23\xd9\xcf\x73\x75\x9e\x46\x09\xcf\xac\x1b\x43\x94\x6b\x64\x29\xae\xb3\xe6\x67\xcb\xe2\x3a\x1b\x6d\xa9\x5d\x39\x62\x7b\x14\xca\x9b\x80\xd6\x44\xec\x40\x69\x04\x16\x7e\x8f\xc1\x13\xc4\x47\x54\x2b\xa1\x53\x7e\x4f\xd7\xa6\xb9\xed\x58\xd9\xee\xf5\xde\x6c\x47\x02\x2c\xa9\x51\x42\x64\xaa\x19\xb2\x55\x0b\x96\xc2\xfd\x9e\x10\x4f\x1d\xaf\x95\x9a\x84\x1d\x23\x3b\x60\x14\x7f\x4f\xc7\x04\x3f\xb7\x51\xde\xaa\x5b\xcc\xc3\x5a\x36\x3d\x8e\xe6\x81\x00\xf9\xb6\xe2\x43\x01\x74\xe0\x48\xf6\x61\x07\xe5\x8e\xed\x2e\x37\xb5\xa8\xa6\x37\x26\x9e\xe1\x58\x6d\xe0\x16\xc5\xba\x22\x98\x72\xa0\x19\xf5\xd6\x9f\xb9\x31\xdb\x4d\x91\x6d\xab\xc6\x9b\xdb\x9a\x80\xfe\x46\x79\x5f\x4f\xa3\x44\x45\x54\x70\x41\xc3\x44\x4e\xf1\x58\x77\xa4\x3d\xb8\xfd\xba\x98\x59\xd4\xe3\xac\xb7\x6c\xd9\x9a\xd4\x0c\xb8\x32\x1f\x67\x05\x63\x77\xab\x3d\xca\x07\xd3\x99\xc9\x52\x9c\xc5\xed\x9f\xb7\xc7\xb6\xf1\x7f\xb1\x14\x89\x99\x5a\x73\xde\xf7\x52\x57\x03\x98\xcb\x79\xe3\x62\xbf\x50\xe3\x02\x96\x5e\xf8\x55\x8c\x2a\x60\xe4\x50\xf4\x61\xb7\x80\x1d\x93\x94\x5b\xe4\xa7\x0b\xf8\x9b\xfc\x61\xdd\x91\x54\xda\x1e\xaf\xb5\xaa\xaf\x43\x3e\x46\xc0\x01\xca\xef\x6f\xe4\x1a\x74\x4e\xee\x28\x24\x5b\xe6\x4d\x50\x9f\x3c\xf7\x8d\x72\xf7\xea\xf3\x29\xf8\x2f\x3a\xc5\xba\x5b\xf9\xef\x0e\x0f\x14\xc8\xe5\x3e\x3b\xcf\x51\xd0\x24\xaa\xd3\xa0\x47\x12\x5b\xee\xe1\xe4\x75\x9c\xf5\x71\x55\x97\x82\xe1\xf1\xb8\xce\xcd\x2d\x82\x0c\x6c\xaa\x51\xac\xf1\xa5\x46\x5f\xd7\xa0\x3a\x46\x3e\x82\x6a\xc8\xa8\x1e\x4a\x97\xc8\xdc\xa6\x6e\xe0\x86\xea\x32\x5c\x16\xd5\x75\x31\x8f\xae\xa4\x36\x88\x31\xc2\x97\x6a\xe1\x16\x41\x87\x7a\xc1\x97\xaa\x36\x29\xcf\xa1\x53\x90\xa7\x05\x9c\xa3\xd7\xb1\x35\xb7\xb4\x28\xdc\xe2\xba\x19\xca\xcd\x08\x32\xd6\x40\x78\x93\xac\x1b\x0f\x45\x60\xef\xe0\xcf\x59\x1c\xbd\x96\xf1\x3e\xbe\xf0\xd1\x7e\xa7\xd2\x21\x87\x28\xae\x5d\xe1\x75\x4e\x4d\x26\x83\x6a\x3f\x50\x8d\x68\xe1\x47\xd8\x2e\x0f\x91\x3d\xb2\xa9\xd6\x86\x0d\x6d\x66\x7d\x55\x8f\x4d\x46\x41\x32\xf5\x0a\x1f\x14\x9f\x19\xaa\xe0\xb2\xc1\xba\x5e\x6e\xdf\x3e\x4a\x5f\xe3\x89\xf1\xb2\x41\x94\xb4\x2c\xeb\xc8\x1a\x33\x5d\x13\xe6\xa8\x06\xcd\x0a\x00\x66\x2d\x49\x8b\xa3\xb7\x69\xc3\x7a\x39\xfd\x3c\x2b\x00\x53\x23\xc7\x19\x06\xb9\x2c\xd3\x0b\x7f\x5d\x33\xf7\xce\x1a\xb1\x00\xc1\x4c\xf3\x7d\xf1\xbd\x73\xa4\xb1\xaf\x6c\x57\x8c\x74\xf5\x61\x55\x47\xfa\x26\x59\xa4\x9a\x6e\x55\x37\x81\xfc\x0a\x8a\x15\x86\x79\xed\xd7\xf3\x1c\xc5\x5c\x5c\xa7\x41\x03\x7c\x4b\x0f\xd0\x38\x79\xdd\x29\xd2\x15\x96\x6a\x95\xb5\x32\xbe\xdb\x57\x1b\x90\xa5\xac\xe7\x3e\x45\xd2\xb2\x2f\xb8\x39\x9e\x1d\xc8\xa0\x5a\x10\xd0\x51\xe6\x6c\xb7\x19\x22\x5d\x95\x7d\xa0\x55\xfb\xe1\xa2\x0f\x07\x62\x2e\xa3\x7a\x8c\xe0\x8d\x89\x69\x66\x12\x82\x3b\x68\xa3\x7d\xc7\x83\xe3\x67\xb6\xb7\xf7\x3b\x24\x07\x6d\x00\x38\x69\x73\xcf\xbd\x18\x23\x5e\xe1\xac\xab\x7c\x64\x2e\x5b\xcc\x58\x85\x29\xc2\xdd\x66\x1e\xd7\xbe\x8d\x6a\x3d\xa1\xdc\xb8\xa1\xa2\x7c\x07\x8d\xe9\x52\x2b\xaf\x81\x44\x70\xd7\xf2\x2f\xe4\xf6\x47\xd7\xa6\xb1\x4d\x35\x9f\x51\x7d\xda\xda\x67\x17\xf8\xdb\x52\xf6\xc0\x52\x6f\xf7\x49\xbb\x35\x4c\x55\x1d\x2a\xcb\x6c\xf6\xc3\x7d\x8b\x10\x0e\xf9\xfa\x5e\x15\x1f\x8c\xeb\x62\xca\x32\x65\x5d\xa6\xb4\xa9\x37\x38\xaa\x23\x38\xd8\x9f\xba\xc4\x97\x32\x39\x4e\xbd\x72\x2d\x96\x9a\x04\x3f\x35\xa9\xd5\x9a\xac\x6e\x06\xcf\xf3\xb1\xfa\x1a\x67\x8b\x70\x5d\x64\x4f\xa9\x32\xcf\x88\x78\x29\x29\x3a\x87\x72\x81\x22\xe0\x5b\xf2\x13\xe6\xf7\x98\x7c\x1f\xf3\xc5\x22\x16\xae\xd3\x56\x16\x43\xa9\x89\x6a\x91\xaa\x58\x36\x35\x54\x76\x6e\x13\xf4\x54\x27\x50\x3d\x00\xc9\x6f\xd6\x9a\x0b\x45\x2d\xf3\x0c\xd5\x17\x80\x80\xc6\xcd\xae\x30\xcb\xf7\xaf\x03\x65\x5e\xe8\x43\x51\x63\xd0\x57\x1b\x73\xb4\xb7\x8d\xea\xcb\x6c\x95\xfb\x5d\x57\x79\x8c\xed\x2e\x50\xcd\x99\x9f\xaf\x8d\x28\xfb\xa5\xbf\x7c\xf8\x5d\xc0\x1e\xc8\xfe\xf2\x31\xec\x09\x1b\xb5\x35\x87\xf7\x45\x4b\x7b\x5c\xad\x7b\x95\x99\xde\x7c\x9d\x83\x2f\xf7\xf7\xcb\xfe\x58\x85\xd7\x6a\xcf\x13\xd5\x12\xa3\xba\x3f\x2b\xe4\x62\x9d\x51\x66\x79\xad\x92\x77\x74\xed\x3e\x43\x7b\x63\x66\x9d\x4b\xfa\x2a\x39\x33\x19\x38\xd3\xa5\xe3\xb5\x00\x3a\x03\x82\xc2\xfe\x15\x3e\x64\xbd\x1f\x95\xf3\x65\xa2\xf8\xe4\x40\x04\xab\xbd\x41\xb2\xa4\x7d\x9e\xa3\x28\xf0\x25\x7d\x5d\x2d\x6c\x75\xd5\x47\xc6\x8b\xda\x3c\xe5\x05\xf3\xfa\x4a\x27\xaf\x3b\xeb\x6b\x2c\xd2\xd1\x22\x97\x8a\xe7\x75\x4c\xeb\x35\xa4\x1b\x6d\xc4\x40\x28\x67\x9f\xef\x45\x71\xb2\x6f\x4f\x44\x8c\x97\xdf\x90\x7b\x85\x4e\x37\xaf\x47\x75\x51\xae\x2d\x5f\x4f\xfb\x70\x28\xe2\x64\x57\xc0\xf9\xf5\xda\xb4\x8d\xb7\x14\x9f\x94\x94\xce\xf3\x5c\xaf\x0f\x8e\x8f\x8d\x73\xb4\x88\xf2\x5a\x4f\xaf\xea\xb5\x8e\x8f\x05\x80\x22\xe5\x6b\x78\x52\x51\x41\x8b\x1e\x29\x34\x6e\x33\xd3\xb5\xef\x6e\x9e\xd9\xbf\xac\x3b\xdb\xab\x23\x9e\x75\x82\x6e\x5b\xfb\x53\x67\xcd\x79\x15\x47\xe1\x3a\xad\x40\x1e\xed\x55\x95\xb9\x81\x83\xf5\x00\x87\xe6\x01\xd0\x9e\x9d\x88\x81\xa1\x08\xf4\xd6\x46\x6c\x27\x8b\x80\x53\x25\x85\xa7\x47\x5a\x51\x77\x4c\x05\xe0\xa1\x3c\xa3\xc2\xcb\x58\x23\xdf\xab\xcc\x6b\x1f\x70\x54\x6f\x44\xef\xab\x95\x3b\x34\xe6\xba\x6e\xee\x20\xcc\x42\xf6\x36\x6a\x78\x48\x6e\x29\x44\x1b\x79\xe4\x78\x95\xff\xf0\x06\xc8\x0e\x60\x36\xd2\xa3\xe5\x0e\x1c\xcc\x6e\x2b\x38\xd9\x02\x40\x64\xd1\x7e\xaa\x86\x8b\xba\xd2\x51\x48\x41\x03\x98\xd1\x59\xaf\x83\x51\xfc\xa2\xab\xf8\x0b\xdb\x15\xe7\x2c\x33\x9d\xda\x81\xe2\x8e\x09\x1a\xcf\xed\x4b\x61\x3b\x5d\x33\x20\x31\xca\x1b\xe4\xfe\xc8\x60\xc8\x19\xca\xf7\xb1\x34\x2f\xcb\xdb\x71\xa8\x3b\x56\x1b\x0d\x96\xc1\xd1\x39\x05\x74\x4e\x05\x8e\x35\x1d\x9a\x5e\x6b\x6e\x06\xcf\x0d\x96\xc9\x66\x66\xdd\x7e\x61\xbb\x76\xe4\x48\x0f\x6b\x1b\xa5\xa1\xb5\x7f\x61\x9b\x91\xbd\xcc\x6b\xdf\x09\x64\x97\xc4\x17\x2a\xe0\x5f\x2c\xe6\x19\xf9\xb2\xe2\x2c\x51\xb1\x6e\x45\x31\xe8\x8b\x45\x2c\xe6\x63\xb4\x9e\xee\x0e\x8a\xff\x53\xc8\x56\xb2\x2e\x27\x37\xd7\xb6\xb3\xb0\x7f\x0b\x83\xf0\xe7\x36\xf3\xfc\x52\xc1\xed\x13\x5b\x75\x9a\x4f\x48\x1f\xfb\xf8\x83\xdf\x7f\xe9\xb8\xce\x32\x26\xf3\xb5\x2d\x3a\xd7\x45\x34\x50\xdd\x6b\x6f\x8c\x93\x4b\x5d\x9d\x4e\x91\x4f\x38\x88\x6b\xb9\x8f\xb0\x5e\xe3\xb7\x62\xd3\x6b\xed\xe4\x9f\x5a\xdb\x7b\xeb\x4c\x55\xdb\xd4\x9a\xda\x2a\x99\x59\xcb\xf2\x2c\x01\x21\xb8\x63\x2d\x7e\xd1\x55\x1e\xe5\x0a\xd6\x7c\x40\xfb\x11\x0c\x1f\xa1\x1a\x33\x47\x15\x5e\x9d\x0d\x58\x9f\x1b\x2a\xed\xbc\xca\x63\x26\x8a\x89\xf2\x33\x15\x8a\xcb\x75\xd7\xf6\x7e\x85\xa7\xb0\x5a\xaf\x96\xf8\xf3\x53\xd3\xcb\x71\x47\x6b\x4e\x58\xd8\xe8\xd6\x93\xc9\xd0\xd9\x58\x1b\xbb\x79\xac\xdf\x45\xf5\x72\x4a\x71\xce\xc2\x6b\xba\xfb\x7c\x6b\x89\xbf\xcf\x32\xd5\x7e\x69\xbe\xde\xf3\x2d\xd4\xc6\x43\xeb\xca\x96\x30\x50\x84\xd5\x99\xa0\xde\xea\xfc\xd0\x00\x9d\x1f\x5a\xea\x2a\x5a\xbb\x0b\x31\x4b\x8d\x7b\x37\x9f\x3e\x1d\xbb\xda\x64\xf3\xf5\xed\xea\x7d\x2d\xde\x7c\xe1\xcd\xd5\x19\x57\xaa\xd4\xa2\xb9\x93\xc4\x49\x34\xf7\xca\x1b\x39\x26\x06\x4c\x9d\xab\x23\xbd\x6a\x9e\x8d\xee\xa8\xdc\xf3\xa0\xbf\xad\x8b\x46\xd0\x05\x9a\xcd\x34\xf5\xdc\xd0\xd9\x7b\xb9\xe9\x6c\xe3\x7b\xb6\x80\x78\xec\x7e\x94\x43\xd7\x2d\x0d\x8a\x9b\xfb\x4a\x08\xcb\xfb\xfd\x60\x6f\x6e\xaf\xab\xab\x6b\x8c\x24\xba\x2b\x2e\x08\x3d\x78\xae\xf4\xd3\x6f\x68\x6a\xdf\xae\x8e\x33\x60\x97\x2c\xd5\xdd\x30\x9b\xd7\x90\xe6\x97\xc4\x1c\xbc\x8b\xf4\xd5\x35\xa4\x2b\xe2\xad\xe7\x47\x15\x57\x9d\xde\x6f\x5f\xa4\x2a\x59\x06\x74\x24\x27\xfb\x4e\x57\xfc\x12\xd8\x1d\xf6\xe5\xc3\xae\xf8\x6d\x96\x97\x7f\xe6\x88\xdd\x27\x11\x74\x0a\x06\xa1\xa7\xa1\xa2\xfb\xd6\xdc\x99\x67\xbf\x3a\x26\xeb\xd9\xdb\x54\xdb\xf7\xb4\xca\xfc\x6a\x21\x71\x74\x7d\x7f\x2d\x3a\x86\xed\x24\x7b\x28\x7a\x0c\xaf\xea\xa6\xae\x92\xb4\xe5\x9f\x5b\x37\xc0\x5d\x04\x6f\xeb\xe6\xaf\x12\x1a\x34\xdf\x06\xab\xbc\x05\xe3\x7e\xfb\x46\x25\x84\x1b\x3a\xa3\x5c\xdc\x3a\xb3\x49\x9e\x43\x37\x2f\x61\xb7\xd7\x5e\x68\x3b\xcf\xc3\xc9\x59\xcd\x6f\x3e\xef\xb1\x67\xbf\x5d\xed\x11\xf9\x3f\xae\xf6\x5c\x0a\xfa\xc7\xd5\xc1\x1b\x93\xaa\x7b\x8e\x5f\xdf\x8d\xf9\xed\x43\x44\x78\xa5\x7d\xdf\x9f\x3e\x67\x68\xed\x01\xf6\x9d\xd2\x3b\x74\xb5\xf6\xc3\x99\x4f\x1f\x3c\x71\xa7\x54\x2d\x75\xe6\x0e\xba\xd6\x6c\xff\x55\xf2\x27\x6f\x01\xab\xa5\x56\x14\x3b\x87\x2f\xcd\xba\x5c\x09\xce\x7a\x60\x82\x33\x37\xe0\x2c\xe7\x34\x9d\x5f\x74\x1f\x5a\xc8\xdd\xd4\x46\x72\x63\xdf\x9d\xb3\xb5\x85\x17\xda\xd1\xa2\x7c\x8c\x6a\x6d\x24\xe3\xfb\x9b\x65\x46\xe2\x3a\x59\x75\x7b\x90\xfc\x8a\x6d\x7b\x91\xdf\x07\xc8\x4a\xbc\xcc\x49\x3c\x63\x2f\xe9\xd1\xbb\x66\x40\x38\x9c\x1c\xa4\xde\x6b\x7e\xee\xbe\x6a\x88\xbb\xe8\x19\xf1\x08\xc3\xbe\x93\xa6\xf2\xd4\x08\xf7\xa0\xb2\xf9\xae\x65\xd3\xc4\x49\xa7\x11\x44\x97\xbb\xd5\xb1\x13\x8d\x9b\xb6\xed\x21\x02\x1b\x70\xb4\x29\x4a\xe1\x0c\xc2\x13\x3d\x2b\x5d\xeb\xae\x6f\x94\xa5\xa6\x8e\xe5\x9f\x42\x2f\x70\xb2\xc4\xb3\xf8\xb2\x77\xdb\x8b\x9b\x73\xc3\x83\x86\xe9\x41\x24\xa7\x67\x77\x4e\x63\xc3\xca\xf5\x2d\x58\x31\x2e\x3c\x93\x71\x9b\xaf\x5a\xe6\x05\x4e\xd3\x75\x13\xc7\x5d\xd9\x9b\xe6\xdc\x41\x7a\x7f\xaa\x67\xc9\xfe\x28\xac\x44\xa8\x78\xfa\xa9\x5c\x91\x9f\xaa\xbe\xaf\x5d\x1d\x00\xb1\x27\x38\x3b\xa0\x0a\x97\xf1\xaa\x16\xa1\x8b\x48\x3e\xbf\xb6\x4c\xc5\xfd\x71\x83\x28\xf4\xb2\x28\xf9\x2c\x79\xa1\x0b\x9d\x4a\x21\x06\x33\x98\x79\x31\x74\x06\x39\xa9\xcb\x49\x18\xaf\xe7\xb0\x63\xb1\x57\x57\xbc\x0d\xbc\xcc\x73\x8d\xcc\x39\x6c\x54\x0c\x2b\x3b\x7a\x15\xdf\x61\x9d\x28\xbb\x96\x97\xe6\x6c\xf9\x06\xd6\xde\xb6\xdd\x1b\x97\x78\xbc\x7a\x42\x73\x6e\xc4\xd7\xd4\x60\xc3\xd4\x73\xa7\x59\x7a\xbf\x01\xbd\xb2\x56\xc5\x7d\xc9\x77\x86\xbb\xdf\x73\x57\xaf\xda\xc2\x31\xbb\x51\xe4\xbf\x62\xcc\xd5\x79\x6c\xff\xed\xea\x08\x69\xf7\x06\x95\xc6\x86\xd6\xdc\x19\xd0\x49\xb2\xa3\xd1\xec\x19\xee\x6a\x45\x86\x42\x43\x9b\x08\xe6\xd1\xd0\xd2\x85\x91\x69\xc0\x93\xee\xec\xf1\x0e\xab\x7f\xf8\x93\x22\xb6\x74\xfc\xb0\x67\xf9\xed\x63\x7d\x68\xfd\x1f\xe4\x43\xf1\xf3\x9c\xe8\x97\xee\x7f\xbb\x0f\xfd\xf2\xf5\xa7\x0f\xfd\xe9\x43\x7f\xfa\xd0\x7f\x9e\x0f\xb5\x9d\xfc\x36\x5a\xfb\xa7\xff\xfc\x93\xfc\xe7\x7f\xdf\x1a\xb4\x31\xf8\x6f\x77\x9f\xf5\xfa\xdf\xdc\x7d\x82\x9f\xee\xf3\xa7\xfb\xfc\xe9\x3e\xdf\xee\x3e\xd1\xe6\xf8\x4f\xd7\xf9\x1e\xd7\xb9\x4b\xd5\x93\x77\xc8\xa2\xa7\x28\x1c\xca\xa9\xec\x26\x51\xb6\xb1\x6f\x47\xd6\x0c\xe5\x5a\xda\xad\xfb\x1d\xc8\xe9\x4e\x6a\x6a\xef\xc0\x9f\x6e\xaf\xf7\xdc\xd4\xbd\x2f\x1b\x73\x7d\x7b\x7d\xd3\x2a\x2e\xf2\xbe\xbe\xbf\x5e\x0f\x7b\x5d\xc2\xbb\xa6\xa2\xb0\x78\xaa\x7f\xb4\x2f\x57\xb3\x47\x3a\x8e\xa0\x7e\x9f\x27\x2d\x6d\xf4\x70\x95\x9d\x94\x52\xed\xf6\x8d\x51\x0a\x8a\x32\x6a\xff\x3e\xc8\xfd\xf7\xd2\x6f\x77\x9e\xe8\x5d\x43\x38\xb7\x9d\x89\x17\xe6\x46\xb5\x34\x27\xfb\xec\xc6\xa9\x5c\xda\x1a\x08\xc2\xe6\xa6\x61\xda\xa4\x65\x3f\x3e\xde\x4d\x9c\x87\xc6\xdd\x03\x81\x7f\xb9\x23\xeb\x8f\xe6\xdd\x84\xfc\xfa\x50\xff\xea\xe0\x8d\x87\x06\xb6\xdf\x9a\xd4\xe2\x35\x2f\x5f\x3d\xd6\xe4\x08\xd7\x8f\xc3\x5a\xb9\xa7\x9d\x9e\xb5\xa3\x36\xc7\x8a\xc2\x22\x32\x40\x5d\x7f\x75\xf3\xc7\xba\x25\xbf\x40\x27\x74\xb3\xe9\x2f\x67\xe2\xf5\xe9\xf6\x1a\xfb\xf4\xdb\x39\x86\x82\xbc\xc3\xf0\xbb\x38\x71\xe6\x9e\xb3\xf8\x18\x83\xf1\x7e\x91\x79\xb3\x41\xd9\x96\xf2\x6f\xb7\xc7\xcd\xcd\x21\x93\x9d\xa7\xb8\x6e\xaf\xcf\xbb\xb3\xfe\x0c\x05\xae\x2c\xa7\x1d\xa6\x7a\x14\x3a\x1f\x64\xd9\x1b\x1f\x94\x23\x33\x8a\xc7\x31\x49\x65\x34\xf7\xc7\xd5\x81\xe7\x81\xe6\xad\x8a\xdb\xb6\x8f\x2c\x47\xd0\x4f\x0d\xc7\x3e\xe7\xef\x7b\xe2\xa1\x76\xb5\xa7\xc1\x06\x2f\xf7\xe0\xbf\xf3\x08\xb4\x8b\xa2\x94\x13\x93\x3e\x3c\xb5\xda\xbf\xb7\xd1\xbf\xbd\x3a\xd0\xad\x14\xa9\x82\xb5\x52\xf9\xf8\xa4\x5c\x92\x4e\x8e\x78\xfe\xf3\xb3\xf6\x81\xaf\x7c\x6e\x98\x9e\x88\x88\xaa\x77\x2d\x33\xdc\xa3\xc1\xd0\x11\x1e\x6c\xbe\x6b\x69\x61\xbe\x3a\xa1\x1d\x47\x5e\x78\x84\x31\x9b\xaf\xd3\xa4\xd8\x84\xbd\xad\x38\x3d\x67\x09\xce\x78\x4a\xfe\x2b\x45\x3a\x0f\xb3\xea\x55\xfb\xff\x6b\x57\x27\x1b\xed\x15\xda\x37\x12\xf0\xbd\xe4\xc8\xd3\x56\x54\x94\x06\x51\xda\x6e\xfd\x30\x34\xb9\xba\xac\xff\x11\x5a\xae\x0c\xf9\xea\xc1\x84\xb5\x0f\x0d\xf2\xcb\xd8\xfe\x7c\xc3\xbe\x53\x3c\xb3\xdb\xb1\x62\xc1\x96\xc2\xef\x3a\x9d\xdf\x4e\xda\xfc\xea\x91\xe3\x57\x7b\xf0\xff\x9b\xd8\xfc\x07\x64\x34\x89\xda\xd5\x79\xac\xfe\xdb\xd9\xfc\x12\xfd\xdb\xab\x03\xdd\xfe\x72\x9b\x1f\x3b\x7f\x81\xd9\x8f\x13\x6f\x6e\x64\x2b\xb3\x5f\xe2\x99\x3f\x28\xbd\xa0\x75\xad\x5d\x3c\xc1\xdb\xae\x5d\x5d\x30\xc6\xa6\xda\xc5\xce\xf7\x52\xe8\xd8\xc9\x75\xfa\x0e\xc3\xf0\x7f\xb2\x5e\x43\x18\x2d\xc0\x16\xd6\xc5\x23\x8a\x8e\x6c\x05\xa1\x2e\x74\x94\x2c\x8c\xc4\x76\x6c\x39\x31\x26\x13\xcf\x3a\xd1\x9c\x31\x32\x67\x61\x2c\xe5\xc4\x08\x53\x2f\xab\xea\x65\xf7\xb4\x9e\xa5\x8e\xe8\x04\x51\xe6\x94\x3d\xd2\x23\x6d\x93\xbc\xe1\x36\xf2\x07\xd5\xea\x3c\x55\xda\xe1\xdf\x5a\x89\x2a\x51\xd8\xbb\x0d\xfe\xed\x5c\x99\x42\x50\xee\x63\xc7\x41\x85\x88\x77\x1f\x24\x63\x3b\x7f\x8f\x0a\xe8\xe9\xd9\x42\xf4\x21\x2b\xb7\x03\xb8\xbd\xbb\x28\xf4\xed\xfc\xf8\xed\x32\x95\xbb\xda\xc3\xbd\x9f\xaa\xf4\xf1\xaa\x54\x89\xc2\xe5\x3a\xb4\xc1\xfc\x4d\x55\x7a\x57\xfc\xf5\x03\xe8\xd0\x8e\xcc\xdf\x7e\x10\xd8\xbd\xfc\xf8\x7e\x3a\xe4\x7b\x61\x2e\x3b\x4c\xbe\x69\xb1\xde\xef\xa9\xdd\xbe\x4d\xd7\xac\x28\x4c\xbd\x34\x43\xc9\xc5\x3c\xac\xd8\x7f\xaa\xa2\x64\xc6\x04\xad\x08\xa9\x75\x8f\xbe\x33\x77\x20\xc2\x42\xca\x92\x28\x74\xb7\xe7\xbe\x47\xfa\xb6\x68\x70\x51\xa0\x59\x75\xae\x32\x68\xe7\x10\xf1\xb0\x72\xec\x61\x11\xfa\xa9\xed\x6c\x8a\x0d\x27\x13\x27\xd9\xc8\x73\xe5\x85\xe4\x3b\x84\x2e\x1f\x96\x2f\x1a\xa1\xeb\xd0\x1e\xcc\x9c\x64\x73\xbb\xeb\x06\xc7\x1e\x3e\x3f\x10\x9f\x71\xb2\xf1\x99\x24\x6e\x1f\xb0\xcf\x5f\xbf\x7c\x6e\x3c\x7c\xc6\xeb\xf8\x6d\x83\xf8\x8c\x7f\xfd\xf2\xf9\xcb\xe7\x3a\x96\xff\xfe\x85\xfc\xdc\xc0\x3e\x3f\x34\xf2\x3f\x1e\xbf\x7e\xc6\x1f\x1f\x3e\x13\x5f\x6e\x6e\xaf\xbd\xc9\x2f\xce\xef\x33\x03\xa6\x5b\x3b\x90\xce\x73\x96\x18\xd5\xca\x94\x1d\xa5\x79\x7a\xa7\xf8\xe7\xf6\xfa\xe6\xf6\x66\x67\xfb\xfc\x75\xf3\xfd\x3a\xe0\xa5\xdb\x36\xac\x98\x53\xe7\x54\xc6\x7c\x5b\x37\xc4\x19\x74\x2e\x62\xf3\x25\x76\xf1\xe2\x27\xe8\x9f\x29\x13\x45\xa0\xdd\x73\x96\x2d\x23\x75\xec\x81\x93\x19\x48\x4c\x54\x94\x22\xdc\xf2\x4e\x57\x47\x14\x60\x65\x7e\x7f\x3d\xb9\x07\xfb\xdb\x69\x1b\x7c\x64\x97\x77\xb7\x73\xa5\x16\x27\x74\x66\x77\xc8\x62\x19\xf3\xc7\xd5\x21\x5b\xd0\x79\x8e\x9d\xc4\x73\xc2\x62\xbb\x84\x8a\x12\xe7\xfa\x17\x49\xe8\x7f\xaa\x1d\x25\xc2\x3e\xeb\xff\xf8\x27\x47\x50\x57\xfb\x97\x32\x1b\xa8\xfe\xf1\x36\x43\x5a\x21\x73\xd2\xd9\xdf\x34\xc5\xe1\xcd\x1e\x51\xdc\xa1\x52\x59\x01\x51\x59\xca\x03\x30\xb3\x69\x12\xcd\xdc\x69\x3c\x43\x4b\x81\x5a\x03\xc3\xf6\xc0\xbd\x3a\x32\xca\xab\x8d\xf9\x93\x72\x89\xf2\x80\x88\x96\x68\x16\xef\x14\xd2\xfb\xf4\x77\xd8\x2e\x3f\xfb\x20\x89\xfd\xbe\xa2\xf5\x97\xa7\x55\x2e\x17\xbb\x66\xba\x0c\xad\xe2\xd1\xca\x47\x1e\xdb\x5e\x8b\x8d\x04\x9d\x58\x8c\xc2\x9e\x73\x38\x12\x28\x5b\x66\xd3\xc3\xb6\xbd\x7a\xd5\xee\xbd\x23\x5b\x13\xbf\xdd\x1e\xfc\x6a\x15\xe0\x74\x8d\x74\xba\x1f\xc2\xb7\xdb\xbd\x1f\x57\xb6\x49\xce\x50\x68\xf2\x05\x7b\x78\xc4\xb0\xab\x33\xfa\x6e\x2a\xdb\xb7\xab\x23\x8d\xdf\xa7\x33\xe8\xf7\x1d\x66\x7c\xa8\x1e\xdd\x5b\x51\x98\x19\x5e\xe8\x24\x3f\xba\x4a\x6d\x4d\xeb\x1c\xfd\x3a\x64\x95\xfe\xa1\x2a\xdd\x9a\x41\xbf\x59\x56\x13\xdd\x5e\x1d\x50\xd2\x9f\xea\xfc\xfd\xd5\x79\x83\x11\x3f\x55\xf9\xa7\x2a\x5f\xa2\xca\x45\x19\xc9\x3f\x42\x8d\x5f\x7d\xfa\x17\x2b\x67\x41\xda\x9f\x8a\xf9\x53\x31\x2f\x50\xcc\xb2\x4a\x78\x97\xcd\x3f\xa6\x66\x9e\xe3\x60\xef\xf0\xab\x33\xfa\xfd\x89\xfa\x5b\x71\xe0\xa7\x02\xff\x54\xe0\x0b\x14\x78\x18\x3b\xa1\x34\xf5\x26\x19\x55\x94\x11\xfe\x89\x9a\xbc\x05\xf1\x4f\xd6\xe9\x59\xe8\xfd\x3e\x73\x7a\xce\xa9\x4d\xfd\xed\xc6\xa7\x67\x75\x18\xca\x1b\xc9\x53\xbd\x6b\xf7\xfe\x31\xea\xbc\x16\x99\x37\x50\xe1\x3b\x22\x5d\x16\xa5\x6e\x1d\xae\x60\xed\xa3\x8c\xfe\xfb\x4e\xc5\x73\xc2\xec\x63\x90\xbf\x7a\x5b\xbf\x6f\x57\x67\x4c\xff\x4f\xf4\x35\xaf\x8c\xc5\x4f\xa7\xf3\xd3\xe9\x5c\xe0\x74\x46\x51\x92\xbd\xaa\x24\xff\x8e\x9e\xe6\x87\x8b\x19\xcf\x49\x66\x3c\xfc\xc9\xc9\x0c\xb4\x58\x2c\x18\xf7\x53\xeb\x7f\x6a\xfd\x05\x5a\x2f\x6d\x9c\x9e\xda\xe5\xf5\x8f\xa9\xfc\xaf\x3e\x3d\xa1\xd6\xdf\x59\x3b\xb7\x08\xfc\x53\x49\xff\xab\x94\x74\x25\x3c\x1f\x78\x5b\xe7\x19\x02\xf4\xfa\xbc\xe3\x87\x1e\xf4\x5c\xe3\xff\xa7\x1e\xd2\x34\x2c\xdb\xfe\x4a\x18\x5f\xef\xea\xf5\xc7\xc6\xdd\xc3\xa3\x33\xb9\x33\xed\x07\xe2\x6e\xf2\x05\xfb\x32\x31\x8d\x47\xdc\x70\xbe\x9e\x3a\x58\xb9\xe7\x90\xe6\x7e\xaa\x7f\x8f\xf3\x99\x27\x4f\x51\x5e\xed\xe9\xf9\x66\x89\xba\xa1\xd1\xdd\xae\x65\x01\xc9\xf6\xe1\xe1\xff\x5a\xd1\x79\xb0\xc9\xaf\x26\xf9\x68\xde\xe1\xf6\xc3\xe4\xee\xe1\xeb\xe3\xd7\x3b\x83\x20\xf1\x3b\xeb\xcb\xd7\xc7\xfa\x83\x4d\xe0\xc4\x45\xa2\x33\xf9\x31\x45\xe7\x2d\x9e\xec\xef\x70\x78\xfe\xb8\x5d\xfc\x79\x62\xfe\x1f\x74\x62\xfe\x6f\x64\x8c\xbf\x77\xcc\x73\x26\x4f\x2f\x8f\x39\x4a\x35\xdf\x35\xbd\x97\x1c\x5a\xbf\xdc\x12\xec\x1e\x65\x5f\xdf\x29\xff\x11\x78\x7d\xfa\x54\xf9\xbb\x36\x2f\x5d\xa3\xb3\xf2\x6f\xd7\xfd\x5d\x0c\xff\x3c\x85\xff\xde\xb4\xf9\x53\x0d\x82\xe9\x4c\x9c\x89\x81\xe1\x77\x84\x41\x90\x77\x0f\x38\xf9\xf5\xee\xb1\x6e\x3c\xde\x11\x5f\x89\xc9\xa4\x5e\xb7\x9c\x3a\xfe\xf0\x63\xbb\xd8\x0f\x31\x08\xdf\x9f\xe7\x87\x0c\xc6\xd5\xf5\xf5\xf5\xf5\x6f\x57\xdf\xae\xfe\xdf\x00\x92\x29\x66\x21\xfd\xdb\x00\x00")
 
 func rpProductionJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -464,11 +464,13 @@ var _bindata = map[string]func() (*asset, error){
 // directory embedded in the file by go-bindata.
 // For example if you run go-bindata on data/... and data contains the
 // following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
+//
+//	data/
+//	  foo.txt
+//	  img/
+//	    a.png
+//	    b.png
+//
 // then AssetDir("data") would return []string{"foo.txt", "img"}
 // AssetDir("data/img") would return []string{"a.png", "b.png"}
 // AssetDir("foo.txt") and AssetDir("notexist") would return an error
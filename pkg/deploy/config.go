@@ -40,6 +40,7 @@ type Configuration struct {
 	ARMAPICABundle                     *string       `json:"armApiCaBundle,omitempty"`
 	ARMAPIClientCertCommonName         *string       `json:"armApiClientCertCommonName,omitempty"`
 	ARMClientID                        *string       `json:"armClientId,omitempty"`
+	AROOperatorCanaryPercentage        *int          `json:"aroOperatorCanaryPercentage,omitempty"`
 	BillingE2EStorageAccountID         *string       `json:"billingE2EStorageAccountId,omitempty"`
 	BillingServicePrincipalID          *string       `json:"billingServicePrincipalId,omitempty"`
 	ClusterMDSDConfigVersion           *string       `json:"clusterMdsdConfigVersion,omitempty" value:"required"`
@@ -59,6 +60,7 @@ type Configuration struct {
 	MDMFrontendURL                     *string       `json:"mdmFrontendUrl,omitempty" value:"required"`
 	MDSDEnvironment                    *string       `json:"mdsdEnvironment,omitempty" value:"required"`
 	PortalAccessGroupIDs               []string      `json:"portalAccessGroupIds,omitempty" value:"required"`
+	PortalAuditorGroupIDs              []string      `json:"portalAuditorGroupIds,omitempty" value:"required"`
 	PortalClientID                     *string       `json:"portalClientId,omitempty" value:"required"`
 	PortalElevatedGroupIDs             []string      `json:"portalElevatedGroupIds,omitempty" value:"required"`
 	RPFeatures                         []string      `json:"rpFeatures,omitempty"`
@@ -6,6 +6,7 @@ package deploy
 import (
 	"context"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/Azure/go-autorest/autorest/azure/auth"
@@ -129,8 +130,10 @@ func (d *deployer) getParameters(ps map[string]interface{}) *arm.Parameters {
 		}
 
 		switch p {
-		case "portalAccessGroupIds", "portalElevatedGroupIds", "rpFeatures":
+		case "portalAccessGroupIds", "portalAuditorGroupIds", "portalElevatedGroupIds", "rpFeatures":
 			v = strings.Join(v.([]string), ",")
+		case "aroOperatorCanaryPercentage":
+			v = strconv.Itoa(*v.(*int))
 		}
 
 		parameters.Parameters[p] = &arm.ParametersParameter{
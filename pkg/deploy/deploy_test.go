@@ -72,6 +72,22 @@ func TestGetParameters(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "canary percentage is passed as a string",
+			ps: map[string]interface{}{
+				"aroOperatorCanaryPercentage": nil,
+			},
+			config: Configuration{
+				AROOperatorCanaryPercentage: to.IntPtr(10),
+			},
+			want: arm.Parameters{
+				Parameters: map[string]*arm.ParametersParameter{
+					"aroOperatorCanaryPercentage": {
+						Value: "10",
+					},
+				},
+			},
+		},
 		{
 			name: "when nil slice parameter is present it is skipped",
 			ps: map[string]interface{}{
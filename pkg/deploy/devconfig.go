@@ -129,6 +129,9 @@ func DevConfig(_env env.Core) (*Config, error) {
 			PortalAccessGroupIDs: []string{
 				os.Getenv("AZURE_PORTAL_ACCESS_GROUP_IDS"),
 			},
+			PortalAuditorGroupIDs: []string{
+				os.Getenv("AZURE_PORTAL_AUDITOR_GROUP_IDS"),
+			},
 			PortalClientID: to.StringPtr(os.Getenv("AZURE_PORTAL_CLIENT_ID")),
 			PortalElevatedGroupIDs: []string{
 				os.Getenv("AZURE_PORTAL_ELEVATED_GROUP_IDS"),
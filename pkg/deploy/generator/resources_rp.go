@@ -320,11 +320,13 @@ func (g *generator) rpVMSS() *arm.Resource {
 		"acrResourceId",
 		"adminApiClientCertCommonName",
 		"armApiClientCertCommonName",
+		"aroOperatorCanaryPercentage",
 		"billingE2EStorageAccountId",
 		"clusterMdsdConfigVersion",
 		"clusterParentDomainName",
 		"fpClientId",
 		"portalAccessGroupIds",
+		"portalAuditorGroupIds",
 		"portalClientId",
 		"portalElevatedGroupIds",
 		"rpFeatures",
@@ -521,6 +523,7 @@ cat >/etc/sysconfig/aro-rp <<EOF
 ACR_RESOURCE_ID='$ACRRESOURCEID'
 ADMIN_API_CLIENT_CERT_COMMON_NAME='$ADMINAPICLIENTCERTCOMMONNAME'
 ARM_API_CLIENT_CERT_COMMON_NAME='$ARMAPICLIENTCERTCOMMONNAME'
+ARO_OPERATOR_CANARY_PERCENTAGE='$AROOPERATORCANARYPERCENTAGE'
 AZURE_ARM_CLIENT_ID='$ARMCLIENTID'
 AZURE_FP_CLIENT_ID='$FPCLIENTID'
 BILLING_E2E_STORAGE_ACCOUNT_ID='$BILLINGE2ESTORAGEACCOUNTID'
@@ -550,6 +553,7 @@ ExecStart=/usr/bin/docker run \
   -e ACR_RESOURCE_ID \
   -e ADMIN_API_CLIENT_CERT_COMMON_NAME \
   -e ARM_API_CLIENT_CERT_COMMON_NAME \
+  -e ARO_OPERATOR_CANARY_PERCENTAGE \
   -e AZURE_ARM_CLIENT_ID \
   -e AZURE_FP_CLIENT_ID \
   -e BILLING_E2E_STORAGE_ACCOUNT_ID \
@@ -621,6 +625,7 @@ EOF
 
 cat >/etc/sysconfig/aro-portal <<EOF
 AZURE_PORTAL_ACCESS_GROUP_IDS='$PORTALACCESSGROUPIDS'
+AZURE_PORTAL_AUDITOR_GROUP_IDS='$PORTALAUDITORGROUPIDS'
 AZURE_PORTAL_CLIENT_ID='$PORTALCLIENTID'
 AZURE_PORTAL_ELEVATED_GROUP_IDS='$PORTALELEVATEDGROUPIDS'
 DATABASE_ACCOUNT_NAME='$DATABASEACCOUNTNAME'
@@ -645,6 +650,7 @@ ExecStart=/usr/bin/docker run \
   --name %N \
   --rm \
   -e AZURE_PORTAL_ACCESS_GROUP_IDS \
+  -e AZURE_PORTAL_AUDITOR_GROUP_IDS \
   -e AZURE_PORTAL_CLIENT_ID \
   -e AZURE_PORTAL_ELEVATED_GROUP_IDS \
   -e DATABASE_ACCOUNT_NAME \
@@ -1251,6 +1257,30 @@ func (g *generator) database(databaseName string, addDependsOn bool) []*arm.Reso
 				"[resourceId('Microsoft.DocumentDB/databaseAccounts/sqlDatabases', parameters('databaseAccountName'), " + databaseName + ")]",
 			},
 		},
+		{
+			Resource: &mgmtdocumentdb.SQLContainerCreateUpdateParameters{
+				SQLContainerCreateUpdateProperties: &mgmtdocumentdb.SQLContainerCreateUpdateProperties{
+					Resource: &mgmtdocumentdb.SQLContainerResource{
+						ID: to.StringPtr("BulkActions"),
+						PartitionKey: &mgmtdocumentdb.ContainerPartitionKey{
+							Paths: &[]string{
+								"/id",
+							},
+							Kind: mgmtdocumentdb.PartitionKindHash,
+						},
+						DefaultTTL: to.Int32Ptr(7 * 86400), // 7 days
+					},
+					Options: map[string]*string{},
+				},
+				Name:     to.StringPtr("[concat(parameters('databaseAccountName'), '/', " + databaseName + ", '/BulkActions')]"),
+				Type:     to.StringPtr("Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers"),
+				Location: to.StringPtr("[resourceGroup().location]"),
+			},
+			APIVersion: azureclient.APIVersion("Microsoft.DocumentDB"),
+			DependsOn: []string{
+				"[resourceId('Microsoft.DocumentDB/databaseAccounts/sqlDatabases', parameters('databaseAccountName'), " + databaseName + ")]",
+			},
+		},
 		{
 			Resource: &mgmtdocumentdb.SQLContainerCreateUpdateParameters{
 				SQLContainerCreateUpdateProperties: &mgmtdocumentdb.SQLContainerCreateUpdateProperties{
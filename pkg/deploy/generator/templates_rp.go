@@ -35,6 +35,7 @@ func (g *generator) rpTemplate() *arm.Template {
 			"armApiCaBundle",
 			"armApiClientCertCommonName",
 			"armClientId",
+			"aroOperatorCanaryPercentage",
 			"billingE2EStorageAccountId",
 			"billingServicePrincipalId",
 			"clusterMdsdConfigVersion",
@@ -45,6 +46,7 @@ func (g *generator) rpTemplate() *arm.Template {
 			"mdmFrontendUrl",
 			"mdsdEnvironment",
 			"portalAccessGroupIds",
+			"portalAuditorGroupIds",
 			"portalClientId",
 			"portalElevatedGroupIds",
 			"rpFeatures",
@@ -68,6 +70,7 @@ func (g *generator) rpTemplate() *arm.Template {
 		case "armApiCaBundle",
 			"armApiClientCertCommonName",
 			"armClientId",
+			"aroOperatorCanaryPercentage",
 			"billingServicePrincipalId",
 			"billingE2EStorageAccountId",
 			"extraCosmosDBIPs",
@@ -365,6 +365,11 @@ func (d *deployer) configureServiceSecrets(ctx context.Context) error {
 		return err
 	}
 
+	err = d.ensureSecretKey(ctx, d.serviceKeyvault, env.ClusterSpecSigningSecretName)
+	if err != nil {
+		return err
+	}
+
 	err = d.ensureSecret(ctx, d.portalKeyvault, env.PortalServerSessionKeySecretName)
 	if err != nil {
 		return err
@@ -28,6 +28,7 @@ const (
 	FeatureDisableSignedCertificates
 	FeatureEnableDevelopmentAuthorizer
 	FeatureRequireD2sV3Workers
+	FeatureEnableSpecDriftRevert
 )
 
 const (
@@ -35,6 +36,7 @@ const (
 	RPFirstPartySecretName           = "rp-firstparty"
 	RPServerSecretName               = "rp-server"
 	ClusterLoggingSecretName         = "cluster-mdsd"
+	ClusterSpecSigningSecretName     = "cluster-spec-signing"
 	EncryptionSecretName             = "encryption-key"
 	FrontendEncryptionSecretName     = "fe-encryption-key"
 	RPLoggingSecretName              = "rp-mdsd"
@@ -69,6 +71,7 @@ type Interface interface {
 	ClusterGenevaLoggingEnvironment() string
 	ClusterGenevaLoggingSecret() (*rsa.PrivateKey, *x509.Certificate)
 	ClusterKeyvault() keyvault.Manager
+	ClusterSpecSigningKey() *rsa.PrivateKey
 	Domain() string
 	FeatureIsSet(Feature) bool
 	FPAuthorizer(string, string) (refreshable.Authorizer, error)
@@ -79,6 +82,7 @@ type Interface interface {
 	ACRResourceID() string
 	ACRDomain() string
 	AROOperatorImage() string
+	AROOperatorCanaryPercentage() int
 }
 
 func NewEnv(ctx context.Context, log *logrus.Entry) (Interface, error) {
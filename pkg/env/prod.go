@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/Azure/go-autorest/autorest"
@@ -48,6 +49,10 @@ type prod struct {
 	clusterGenevaLoggingConfigVersion string
 	clusterGenevaLoggingEnvironment   string
 
+	clusterSpecSigningKey *rsa.PrivateKey
+
+	aroOperatorCanaryPercentage int
+
 	log *logrus.Entry
 
 	features map[Feature]bool
@@ -96,6 +101,17 @@ func newProd(ctx context.Context, log *logrus.Entry) (*prod, error) {
 		log: log,
 
 		features: map[Feature]bool{},
+
+		aroOperatorCanaryPercentage: 100,
+	}
+
+	if s := os.Getenv("ARO_OPERATOR_CANARY_PERCENTAGE"); s != "" {
+		percentage, err := strconv.Atoi(s)
+		if err != nil || percentage < 0 || percentage > 100 {
+			return nil, fmt.Errorf("invalid ARO_OPERATOR_CANARY_PERCENTAGE %q: must be between 0 and 100", s)
+		}
+
+		p.aroOperatorCanaryPercentage = percentage
 	}
 
 	features := os.Getenv("RP_FEATURES")
@@ -154,6 +170,16 @@ func newProd(ctx context.Context, log *logrus.Entry) (*prod, error) {
 	p.clusterGenevaLoggingPrivateKey = clusterGenevaLoggingPrivateKey
 	p.clusterGenevaLoggingCertificate = clusterGenevaLoggingCertificates[0]
 
+	b, err := p.serviceKeyvault.GetBase64Secret(ctx, ClusterSpecSigningSecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	p.clusterSpecSigningKey, err = x509.ParsePKCS1PrivateKey(b)
+	if err != nil {
+		return nil, err
+	}
+
 	if p.ACRResourceID() != "" { // TODO: ugh!
 		acrResource, err := azure.ParseResourceID(p.ACRResourceID())
 		if err != nil {
@@ -222,6 +248,10 @@ func (p *prod) AROOperatorImage() string {
 	return fmt.Sprintf("%s/aro:%s", p.acrDomain, version.GitCommit)
 }
 
+func (p *prod) AROOperatorCanaryPercentage() int {
+	return p.aroOperatorCanaryPercentage
+}
+
 func (p *prod) populateZones(ctx context.Context, rpAuthorizer autorest.Authorizer) error {
 	c := compute.NewResourceSkusClient(p.Environment(), p.SubscriptionID(), rpAuthorizer)
 
@@ -264,6 +294,10 @@ func (p *prod) ClusterKeyvault() keyvault.Manager {
 	return p.clusterKeyvault
 }
 
+func (p *prod) ClusterSpecSigningKey() *rsa.PrivateKey {
+	return p.clusterSpecSigningKey
+}
+
 func (p *prod) Domain() string {
 	return os.Getenv("DOMAIN_NAME")
 }
@@ -1,15 +1,14 @@
 // Code generated by "enumer -type Feature -output zz_generated_feature_enumer.go"; DO NOT EDIT.
 
-//
 package env
 
 import (
 	"fmt"
 )
 
-const _FeatureName = "FeatureDisableDenyAssignmentsFeatureDisableSignedCertificatesFeatureEnableDevelopmentAuthorizerFeatureRequireD2sV3Workers"
+const _FeatureName = "FeatureDisableDenyAssignmentsFeatureDisableSignedCertificatesFeatureEnableDevelopmentAuthorizerFeatureRequireD2sV3WorkersFeatureEnableSpecDriftRevert"
 
-var _FeatureIndex = [...]uint8{0, 29, 61, 95, 121}
+var _FeatureIndex = [...]uint8{0, 29, 61, 95, 121, 149}
 
 func (i Feature) String() string {
 	if i < 0 || i >= Feature(len(_FeatureIndex)-1) {
@@ -18,13 +17,14 @@ func (i Feature) String() string {
 	return _FeatureName[_FeatureIndex[i]:_FeatureIndex[i+1]]
 }
 
-var _FeatureValues = []Feature{0, 1, 2, 3}
+var _FeatureValues = []Feature{0, 1, 2, 3, 4}
 
 var _FeatureNameToValueMap = map[string]Feature{
-	_FeatureName[0:29]:   0,
-	_FeatureName[29:61]:  1,
-	_FeatureName[61:95]:  2,
-	_FeatureName[95:121]: 3,
+	_FeatureName[0:29]:    0,
+	_FeatureName[29:61]:   1,
+	_FeatureName[61:95]:   2,
+	_FeatureName[95:121]:  3,
+	_FeatureName[121:149]: 4,
 }
 
 // FeatureString retrieves an enum value from the enum constants string name.
@@ -0,0 +1,163 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+const (
+	defaultBulkActionConcurrency = 5
+	maxBulkActionConcurrency     = 20
+)
+
+func (f *frontend) postAdminBulkAction(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+
+	body := r.Context().Value(middleware.ContextKeyBody).([]byte)
+	if len(body) == 0 || !json.Valid(body) {
+		api.WriteError(w, http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content was invalid and could not be deserialized.")
+		return
+	}
+
+	b, err := f._postAdminBulkAction(ctx, r, log, f.apis[admin.APIVersion].BulkActionConverter())
+
+	adminReply(log, w, nil, b, err)
+}
+
+func (f *frontend) _postAdminBulkAction(ctx context.Context, r *http.Request, log *logrus.Entry, converter api.BulkActionConverter) ([]byte, error) {
+	body := r.Context().Value(middleware.ContextKeyBody).([]byte)
+	correlationData := r.Context().Value(middleware.ContextKeyCorrelationData).(*api.CorrelationData)
+
+	var ext *admin.BulkAction
+	err := json.Unmarshal(body, &ext)
+	if err != nil {
+		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content was invalid and could not be deserialized: %q.", err)
+	}
+
+	doc := &api.BulkActionDocument{
+		ID: uuid.Must(uuid.NewV4()).String(),
+		BulkAction: &api.BulkAction{
+			CreatedBy: correlationData.ClientPrincipalName,
+			State:     api.BulkActionStatePending,
+			StartTime: f.now().UTC(),
+		},
+	}
+	converter.ToInternal(ext, doc.BulkAction)
+
+	if doc.BulkAction.Concurrency == 0 {
+		doc.BulkAction.Concurrency = defaultBulkActionConcurrency
+	}
+
+	err = validateBulkAction(doc.BulkAction)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err = f.dbBulkActions.Create(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.MarshalIndent(converter.ToExternal(doc.ID, doc.BulkAction), "", "    ")
+	if err != nil {
+		return nil, err
+	}
+
+	return b, statusCodeError(http.StatusAccepted)
+}
+
+func (f *frontend) getAdminBulkAction(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+
+	b, err := f._getAdminBulkAction(ctx, r, f.apis[admin.APIVersion].BulkActionConverter())
+
+	adminReply(log, w, nil, b, err)
+}
+
+func (f *frontend) _getAdminBulkAction(ctx context.Context, r *http.Request, converter api.BulkActionConverter) ([]byte, error) {
+	vars := mux.Vars(r)
+
+	_, err := uuid.FromString(vars["bulkActionId"])
+	if err != nil {
+		return nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeNotFound, "", "The entity was not found.")
+	}
+
+	doc, err := f.dbBulkActions.Get(ctx, vars["bulkActionId"])
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeNotFound, "", "The entity was not found.")
+	case err != nil:
+		return nil, err
+	}
+
+	return json.MarshalIndent(converter.ToExternal(doc.ID, doc.BulkAction), "", "    ")
+}
+
+func validateBulkAction(ba *api.BulkAction) error {
+	switch ba.Action {
+	case api.BulkActionTypeKubernetesObjectsDelete:
+		err := validateAdminKubernetesObjectsNonCustomer(http.MethodDelete, ba.Parameters["kind"], ba.Parameters["namespace"], ba.Parameters["name"])
+		if err != nil {
+			return err
+		}
+
+	case api.BulkActionTypeRedeployVM:
+		err := validateAdminVMName(api.BulkActionVMName(ba.Parameters["vmName"], "infraid"))
+		if err != nil {
+			return err
+		}
+
+	case api.BulkActionTypeUpgrade:
+		switch ba.Parameters["upgradeY"] {
+		case "", "true", "false":
+		default:
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "parameters.upgradeY", "The provided upgradeY '%s' is invalid.", ba.Parameters["upgradeY"])
+		}
+
+	default:
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "action", "The provided action '%s' is invalid.", ba.Action)
+	}
+
+	if ba.Concurrency < 1 || ba.Concurrency > maxBulkActionConcurrency {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "concurrency", "The provided concurrency '%d' is invalid: it must be between 1 and %d.", ba.Concurrency, maxBulkActionConcurrency)
+	}
+
+	if ba.Selector.SubscriptionID != "" {
+		_, err := uuid.FromString(ba.Selector.SubscriptionID)
+		if err != nil {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "selector.subscriptionId", "The provided subscriptionId '%s' is invalid.", ba.Selector.SubscriptionID)
+		}
+	}
+
+	for k := range ba.Selector.Tags {
+		if !database.IsValidOpenShiftClustersQueryField("tags/" + k) {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "selector.tags", "The provided tag name '%s' is invalid.", k)
+		}
+	}
+
+	// refuse to run against every cluster in the region by accident
+	if ba.Selector.SubscriptionID == "" &&
+		ba.Selector.ProvisioningState == "" &&
+		ba.Selector.Version == "" &&
+		len(ba.Selector.Tags) == 0 {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "selector", "The provided selector must not be empty.")
+	}
+
+	return nil
+}
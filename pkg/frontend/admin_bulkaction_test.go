@@ -0,0 +1,278 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestPostAdminBulkAction(t *testing.T) {
+	ctx := context.Background()
+	mockCurrentTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type test struct {
+		name           string
+		body           *admin.BulkAction
+		wantStatusCode int
+		wantResponse   *admin.BulkAction
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name: "valid request",
+			body: &admin.BulkAction{
+				Action: admin.BulkActionTypeKubernetesObjectsDelete,
+				Parameters: map[string]string{
+					"kind":      "Pod",
+					"namespace": "openshift-azure-logging",
+					"name":      "mdsd-abcde",
+				},
+				Selector: admin.BulkActionSelector{
+					ProvisioningState: admin.ProvisioningStateFailed,
+				},
+			},
+			wantStatusCode: http.StatusAccepted,
+			wantResponse: &admin.BulkAction{
+				Action: admin.BulkActionTypeKubernetesObjectsDelete,
+				Parameters: map[string]string{
+					"kind":      "Pod",
+					"namespace": "openshift-azure-logging",
+					"name":      "mdsd-abcde",
+				},
+				Selector: admin.BulkActionSelector{
+					ProvisioningState: admin.ProvisioningStateFailed,
+				},
+				Concurrency: defaultBulkActionConcurrency,
+				CreatedBy:   "sre@example.com",
+				State:       admin.BulkActionStatePending,
+				StartTime:   mockCurrentTime,
+			},
+		},
+		{
+			name: "invalid action",
+			body: &admin.BulkAction{
+				Action: "Delete",
+				Selector: admin.BulkActionSelector{
+					ProvisioningState: admin.ProvisioningStateFailed,
+				},
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: action: The provided action 'Delete' is invalid.",
+		},
+		{
+			name: "customer namespace",
+			body: &admin.BulkAction{
+				Action: admin.BulkActionTypeKubernetesObjectsDelete,
+				Parameters: map[string]string{
+					"kind":      "Pod",
+					"namespace": "customer",
+					"name":      "pod",
+				},
+				Selector: admin.BulkActionSelector{
+					ProvisioningState: admin.ProvisioningStateFailed,
+				},
+			},
+			wantStatusCode: http.StatusForbidden,
+			wantError:      "403: Forbidden: : Access to the provided namespace 'customer' is forbidden.",
+		},
+		{
+			name: "empty selector",
+			body: &admin.BulkAction{
+				Action: admin.BulkActionTypeUpgrade,
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: selector: The provided selector must not be empty.",
+		},
+		{
+			name: "invalid tag",
+			body: &admin.BulkAction{
+				Action: admin.BulkActionTypeUpgrade,
+				Selector: admin.BulkActionSelector{
+					Tags: map[string]string{
+						`"]`: "x",
+					},
+				},
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: InvalidParameter: selector.tags: The provided tag name '"]' is invalid.`,
+		},
+		{
+			name: "concurrency too high",
+			body: &admin.BulkAction{
+				Action:      admin.BulkActionTypeRedeployVM,
+				Parameters:  map[string]string{"vmName": "{infraId}-master-0"},
+				Concurrency: 100,
+				Selector: admin.BulkActionSelector{
+					Version: "4.6.17",
+				},
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: concurrency: The provided concurrency '100' is invalid: it must be between 1 and 20.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithBulkActions()
+			defer ti.done()
+
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.bulkActionsDatabase, api.APIs, &noop.Noop{}, nil, nil, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f.(*frontend).now = func() time.Time { return mockCurrentTime }
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodPost,
+				"https://server/admin/providers/Microsoft.RedHatOpenShift/openShiftClusters/bulkactions",
+				http.Header{
+					"Content-Type":               []string{"application/json"},
+					"X-Ms-Client-Principal-Name": []string{"sre@example.com"},
+				}, tt.body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantResponse == nil {
+				err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, nil)
+				if err != nil {
+					t.Error(err)
+				}
+				return
+			}
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Fatal(resp.StatusCode)
+			}
+
+			var ba *admin.BulkAction
+			err = json.Unmarshal(b, &ba)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if ba.ID == "" {
+				t.Error("expected ID to be set")
+			}
+			ba.ID = ""
+
+			for _, diff := range deep.Equal(ba, tt.wantResponse) {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestGetAdminBulkAction(t *testing.T) {
+	ctx := context.Background()
+	mockID := "00000000-0000-0000-0000-000000000001"
+	mockStartTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type test struct {
+		name           string
+		id             string
+		fixture        func(*testdatabase.Fixture)
+		wantStatusCode int
+		wantResponse   *admin.BulkAction
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name: "bulk action exists",
+			id:   mockID,
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddBulkActionDocuments(&api.BulkActionDocument{
+					ID: mockID,
+					BulkAction: &api.BulkAction{
+						Action:      api.BulkActionTypeUpgrade,
+						Concurrency: 5,
+						Selector: api.BulkActionSelector{
+							Version: "4.6.17",
+						},
+						State:     api.BulkActionStateRunning,
+						StartTime: mockStartTime,
+						Results: []*api.BulkActionResult{
+							{
+								ResourceID: "/subscriptions/sub/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster",
+								State:      api.BulkActionStateFailed,
+								Error:      "random error",
+							},
+						},
+					},
+				})
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: &admin.BulkAction{
+				ID:          mockID,
+				Action:      admin.BulkActionTypeUpgrade,
+				Concurrency: 5,
+				Selector: admin.BulkActionSelector{
+					Version: "4.6.17",
+				},
+				State:     admin.BulkActionStateRunning,
+				StartTime: mockStartTime,
+				Results: []*admin.BulkActionResult{
+					{
+						ResourceID: "/subscriptions/sub/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster",
+						State:      admin.BulkActionStateFailed,
+						Error:      "random error",
+					},
+				},
+			},
+		},
+		{
+			name:           "bulk action not found",
+			id:             mockID,
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "404: NotFound: : The entity was not found.",
+		},
+		{
+			name:           "invalid id",
+			id:             "invalid",
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "404: NotFound: : The entity was not found.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithBulkActions()
+			defer ti.done()
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.bulkActionsDatabase, api.APIs, &noop.Noop{}, nil, nil, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodGet,
+				"https://server/admin/providers/Microsoft.RedHatOpenShift/openShiftClusters/bulkactions/"+tt.id,
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, tt.wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
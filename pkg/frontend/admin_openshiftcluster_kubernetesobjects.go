@@ -10,13 +10,16 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/ghodss/yaml"
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
 	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+	"github.com/Azure/ARO-RP/pkg/util/log/audit"
 )
 
 func (f *frontend) getAdminKubernetesObjects(w http.ResponseWriter, r *http.Request) {
@@ -104,12 +107,19 @@ func (f *frontend) postAdminKubernetesObjects(w http.ResponseWriter, r *http.Req
 	r.URL.Path = filepath.Dir(r.URL.Path)
 
 	body := r.Context().Value(middleware.ContextKeyBody).([]byte)
-	if len(body) == 0 || !json.Valid(body) {
-		api.WriteError(w, http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content was invalid and could not be deserialized.")
-		return
-	}
 
-	err := f._postAdminKubernetesObjects(ctx, r, log)
+	var err error
+	switch patchType := types.PatchType(strings.SplitN(r.Header.Get("Content-Type"), ";", 2)[0]); patchType {
+	case types.MergePatchType, types.StrategicMergePatchType, types.ApplyPatchType:
+		err = f._patchAdminKubernetesObjects(ctx, r, log, patchType)
+	default:
+		if len(body) == 0 || !json.Valid(body) {
+			api.WriteError(w, http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content was invalid and could not be deserialized.")
+			return
+		}
+
+		err = f._postAdminKubernetesObjects(ctx, r, log)
+	}
 
 	adminReply(log, w, nil, nil, err)
 }
@@ -146,3 +156,94 @@ func (f *frontend) _postAdminKubernetesObjects(ctx context.Context, r *http.Requ
 
 	return k.KubeCreateOrUpdate(ctx, obj)
 }
+
+func (f *frontend) _patchAdminKubernetesObjects(ctx context.Context, r *http.Request, log *logrus.Entry, patchType types.PatchType) error {
+	body := r.Context().Value(middleware.ContextKeyBody).([]byte)
+	correlationData := r.Context().Value(middleware.ContextKeyCorrelationData).(*api.CorrelationData)
+	vars := mux.Vars(r)
+
+	groupKind, namespace, name := r.URL.Query().Get("kind"), r.URL.Query().Get("namespace"), r.URL.Query().Get("name")
+
+	err := validateAdminKubernetesObjectsNonCustomer(r.Method, groupKind, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	var force bool
+	switch r.URL.Query().Get("force") {
+	case "":
+	case "true":
+		force = true
+	case "false":
+	default:
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "force", "The provided force parameter '%s' is invalid.", r.URL.Query().Get("force"))
+	}
+
+	if force && patchType != types.ApplyPatchType {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "force", "The force parameter is only supported for server-side apply.")
+	}
+
+	if patchType == types.ApplyPatchType {
+		// server-side apply accepts YAML, but we only validate and log JSON
+		body, err = yaml.YAMLToJSON(body)
+		if err != nil {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content was invalid and could not be deserialized: %q.", err)
+		}
+
+		obj := &unstructured.Unstructured{}
+		err = obj.UnmarshalJSON(body)
+		if err != nil {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content was invalid and could not be deserialized: %q.", err)
+		}
+
+		if !strings.EqualFold(obj.GroupVersionKind().GroupKind().String(), groupKind) ||
+			obj.GetNamespace() != namespace ||
+			obj.GetName() != name {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content does not match the provided kind, namespace and name.")
+		}
+	}
+
+	if len(body) == 0 || !json.Valid(body) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content was invalid and could not be deserialized.")
+	}
+
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	doc, err := f.dbOpenShiftClusters.Get(ctx, resourceID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case err != nil:
+		return err
+	}
+
+	k, err := f.kubeActionsFactory(log, f.env, doc.OpenShiftCluster)
+	if err != nil {
+		return err
+	}
+
+	changes, err := k.KubePatch(ctx, groupKind, namespace, name, patchType, body, adminFieldManager(correlationData.ClientPrincipalName), force)
+	if err != nil {
+		return err
+	}
+
+	adminAudit(r, logrus.Fields{audit.MetadataChanges: string(changes)}, nil, "Patched %s %s/%s using %s.", groupKind, namespace, name, patchType)
+
+	return nil
+}
+
+// adminFieldManager returns the server-side field manager which attributes
+// changes made through the admin API to the calling principal
+func adminFieldManager(clientPrincipalName string) string {
+	fieldManager := "aro-admin"
+	if clientPrincipalName != "" {
+		fieldManager += ":" + clientPrincipalName
+	}
+
+	// the API server rejects field managers longer than 128 characters
+	if len(fieldManager) > 128 {
+		fieldManager = fieldManager[:128]
+	}
+
+	return fieldManager
+}
@@ -5,6 +5,7 @@ package frontend
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
@@ -13,11 +14,13 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/env"
 	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
 	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	"github.com/Azure/ARO-RP/pkg/util/log/audit"
 	mock_adminactions "github.com/Azure/ARO-RP/pkg/util/mocks/adminactions"
 )
 
@@ -174,7 +177,7 @@ func TestAdminKubernetesObjectsGetAndDelete(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, api.APIs, &noop.Noop{}, nil, func(*logrus.Entry, env.Interface, *api.OpenShiftCluster) (adminactions.KubeActions, error) {
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.bulkActionsDatabase, api.APIs, &noop.Noop{}, nil, func(*logrus.Entry, env.Interface, *api.OpenShiftCluster) (adminactions.KubeActions, error) {
 				return k, nil
 			}, nil, nil)
 			if err != nil {
@@ -392,7 +395,7 @@ func TestAdminPostKubernetesObjects(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, api.APIs, &noop.Noop{}, nil, func(*logrus.Entry, env.Interface, *api.OpenShiftCluster) (adminactions.KubeActions, error) {
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.bulkActionsDatabase, api.APIs, &noop.Noop{}, nil, func(*logrus.Entry, env.Interface, *api.OpenShiftCluster) (adminactions.KubeActions, error) {
 				return k, nil
 			}, nil, nil)
 			if err != nil {
@@ -417,3 +420,171 @@ func TestAdminPostKubernetesObjects(t *testing.T) {
 		})
 	}
 }
+
+func TestAdminPatchKubernetesObjects(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	resourceID := fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName", mockSubID)
+	ctx := context.Background()
+
+	type test struct {
+		name           string
+		contentType    string
+		query          string
+		body           json.RawMessage
+		mocks          func(*test, *mock_adminactions.MockKubeActions)
+		wantStatusCode int
+		wantChanges    string
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name:        "merge patch",
+			contentType: "application/merge-patch+json",
+			query:       "kind=ConfigMap&namespace=openshift-azure-logging&name=config",
+			body:        json.RawMessage(`{"data":{"key":"value"}}`),
+			mocks: func(tt *test, k *mock_adminactions.MockKubeActions) {
+				k.EXPECT().KubePatch(gomock.Any(), "ConfigMap", "openshift-azure-logging", "config", types.MergePatchType, []byte(tt.body), "aro-admin:sre@example.com", false).
+					Return([]byte(`{"data":{"key":"value"}}`), nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantChanges:    `{"data":{"key":"value"}}`,
+		},
+		{
+			name:        "server-side apply",
+			contentType: "application/apply-patch+yaml",
+			query:       "kind=ConfigMap&namespace=openshift-azure-logging&name=config&force=true",
+			body:        json.RawMessage(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config","namespace":"openshift-azure-logging"}}`),
+			mocks: func(tt *test, k *mock_adminactions.MockKubeActions) {
+				k.EXPECT().KubePatch(gomock.Any(), "ConfigMap", "openshift-azure-logging", "config", types.ApplyPatchType, []byte(tt.body), "aro-admin:sre@example.com", true).
+					Return([]byte(`{}`), nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantChanges:    `{}`,
+		},
+		{
+			name:           "server-side apply with mismatched object",
+			contentType:    "application/apply-patch+yaml",
+			query:          "kind=ConfigMap&namespace=openshift-azure-logging&name=config",
+			body:           json.RawMessage(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"other","namespace":"openshift-azure-logging"}}`),
+			mocks:          func(tt *test, k *mock_adminactions.MockKubeActions) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidRequestContent: : The request content does not match the provided kind, namespace and name.",
+		},
+		{
+			name:           "force with merge patch",
+			contentType:    "application/strategic-merge-patch+json",
+			query:          "kind=ConfigMap&namespace=openshift-azure-logging&name=config&force=true",
+			body:           json.RawMessage(`{"data":{"key":"value"}}`),
+			mocks:          func(tt *test, k *mock_adminactions.MockKubeActions) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: force: The force parameter is only supported for server-side apply.",
+		},
+		{
+			name:           "secret requested",
+			contentType:    "application/merge-patch+json",
+			query:          "kind=Secret&namespace=openshift-azure-logging&name=config",
+			body:           json.RawMessage(`{"data":{"key":"value"}}`),
+			mocks:          func(tt *test, k *mock_adminactions.MockKubeActions) {},
+			wantStatusCode: http.StatusForbidden,
+			wantError:      "403: Forbidden: : Access to secrets is forbidden.",
+		},
+		{
+			name:           "customer namespace",
+			contentType:    "application/merge-patch+json",
+			query:          "kind=ConfigMap&namespace=customer&name=config",
+			body:           json.RawMessage(`{"data":{"key":"value"}}`),
+			mocks:          func(tt *test, k *mock_adminactions.MockKubeActions) {},
+			wantStatusCode: http.StatusForbidden,
+			wantError:      "403: Forbidden: : Access to the provided namespace 'customer' is forbidden.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters()
+			defer ti.done()
+
+			k := mock_adminactions.NewMockKubeActions(ti.controller)
+			tt.mocks(tt, k)
+
+			ti.fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(resourceID),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID:   resourceID,
+					Name: "resourceName",
+					Type: "Microsoft.RedHatOpenShift/openshiftClusters",
+				},
+			})
+
+			err := ti.buildFixtures(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.bulkActionsDatabase, api.APIs, &noop.Noop{}, nil, func(*logrus.Entry, env.Interface, *api.OpenShiftCluster) (adminactions.KubeActions, error) {
+				return k, nil
+			}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodPost,
+				fmt.Sprintf("https://server/admin%s/kubernetesObjects?%s", resourceID, tt.query),
+				http.Header{
+					"Content-Type":               []string{tt.contentType},
+					"X-Ms-Client-Principal-Name": []string{"sre@example.com"},
+				}, tt.body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			var changes []string
+			for _, e := range ti.auditHook.AllEntries() {
+				if c, ok := e.Data[audit.MetadataChanges].(string); ok {
+					changes = append(changes, c)
+				}
+			}
+
+			if tt.wantChanges == "" && len(changes) != 0 ||
+				tt.wantChanges != "" && (len(changes) != 1 || changes[0] != tt.wantChanges) {
+				t.Error(changes)
+			}
+		})
+	}
+}
+
+func TestAdminFieldManager(t *testing.T) {
+	for _, tt := range []struct {
+		name                string
+		clientPrincipalName string
+		want                string
+	}{
+		{
+			name: "no principal",
+			want: "aro-admin",
+		},
+		{
+			name:                "principal",
+			clientPrincipalName: "sre@example.com",
+			want:                "aro-admin:sre@example.com",
+		},
+		{
+			name:                "long principal",
+			clientPrincipalName: strings.Repeat("x", 200),
+			want:                "aro-admin:" + strings.Repeat("x", 118),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := adminFieldManager(tt.clientPrincipalName)
+			if got != tt.want {
+				t.Error(got)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+	"github.com/Azure/ARO-RP/pkg/util/encryption"
+	"github.com/Azure/ARO-RP/pkg/util/mustgather"
+	"github.com/Azure/ARO-RP/pkg/util/recover"
+)
+
+// mustGatherTimeout bounds how long a must-gather may run.  An operation which
+// is still running after this was interrupted, e.g. by an RP restart.
+const mustGatherTimeout = time.Hour
+
+func (f *frontend) postAdminOpenShiftClusterMustGather(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	header := http.Header{}
+	b, err := f._postAdminOpenShiftClusterMustGather(ctx, r, log, header)
+
+	adminReply(log, w, header, b, err)
+}
+
+func (f *frontend) _postAdminOpenShiftClusterMustGather(ctx context.Context, r *http.Request, log *logrus.Entry, header http.Header) ([]byte, error) {
+	vars := mux.Vars(r)
+
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	doc, err := f.dbOpenShiftClusters.Get(ctx, resourceID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case err != nil:
+		return nil, err
+	}
+
+	subscriptionDoc, err := f.getSubscriptionDocument(ctx, doc.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := f.kubeActionsFactory(log, f.env, doc.OpenShiftCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := f.azureActionsFactory(log, f.env, doc.OpenShiftCluster, subscriptionDoc)
+	if err != nil {
+		return nil, err
+	}
+
+	// each tarball is encrypted with its own key, which is only returned to
+	// the caller
+	key := make([]byte, 32)
+	_, err = rand.Read(key)
+	if err != nil {
+		return nil, err
+	}
+
+	now := f.now().UTC()
+	mg := &admin.MustGather{
+		Key:        base64.StdEncoding.EncodeToString(key),
+		ExpiryTime: now.Add(mustgather.LinkValidity),
+	}
+
+	name := fmt.Sprintf("must-gather-%s-%s.tar.gz.enc", now.Format("20060102150405"), uuid.Must(uuid.NewV4()))
+
+	mg.URL, err = a.MustGatherURL(ctx, name, mg.ExpiryTime)
+	if err != nil {
+		return nil, err
+	}
+
+	id := uuid.Must(uuid.NewV4()).String()
+	operationPath := r.URL.Path + "/mustgather/" + id
+
+	_, err = f.dbAsyncOperations.Create(ctx, &api.AsyncOperationDocument{
+		ID:                  id,
+		OpenShiftClusterKey: doc.Key,
+		AsyncOperation: &api.AsyncOperation{
+			ID:                operationPath,
+			Name:              id,
+			ProvisioningState: api.ProvisioningStateUpdating,
+			StartTime:         now,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	go f.runMustGather(log, id, name, key, k, a)
+
+	adminAudit(r, nil, nil, "Started must-gather %s.", name)
+
+	header["Location"] = []string{operationPath}
+
+	b, err := json.MarshalIndent(mg, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+
+	return b, statusCodeError(http.StatusAccepted)
+}
+
+// runMustGather streams the must-gather through the encryption into its blob
+// and records the outcome on the async operation
+func (f *frontend) runMustGather(log *logrus.Entry, id, name string, key []byte, k adminactions.KubeActions, a adminactions.AzureActions) {
+	defer recover.Panic(log)
+
+	ctx, cancel := context.WithTimeout(context.Background(), mustGatherTimeout)
+	defer cancel()
+
+	pr, pw := io.Pipe()
+
+	go func() {
+		defer recover.Panic(log)
+
+		err := errors.New("must-gather collection did not complete")
+		defer func() {
+			pw.CloseWithError(err)
+		}()
+
+		err = encryptMustGather(ctx, pw, key, k)
+	}()
+
+	uploadErr := a.MustGatherUpload(ctx, name, pr)
+
+	// unblock the collection if the upload gave up early
+	pr.CloseWithError(uploadErr)
+
+	_, err := f.dbAsyncOperations.Patch(context.Background(), id, func(asyncdoc *api.AsyncOperationDocument) error {
+		now := f.now().UTC()
+		asyncdoc.AsyncOperation.EndTime = &now
+
+		if uploadErr == nil {
+			asyncdoc.AsyncOperation.ProvisioningState = api.ProvisioningStateSucceeded
+			return nil
+		}
+
+		asyncdoc.AsyncOperation.ProvisioningState = api.ProvisioningStateFailed
+
+		// if type is CloudError - we want to propagate it to the
+		// asyncOperations errors. Otherwise - return generic error
+		if err, ok := uploadErr.(*api.CloudError); ok {
+			log.Print(err)
+			asyncdoc.AsyncOperation.Error = err.CloudErrorBody
+		} else {
+			log.Error(uploadErr)
+			asyncdoc.AsyncOperation.Error = &api.CloudErrorBody{
+				Code:    api.CloudErrorCodeInternalServerError,
+				Message: "Internal server error.",
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+func encryptMustGather(ctx context.Context, w io.Writer, key []byte, k adminactions.KubeActions) error {
+	ew, err := encryption.NewXChaCha20Poly1305Writer(w, key)
+	if err != nil {
+		return err
+	}
+
+	err = k.KubeMustGather(ctx, ew)
+	if err != nil {
+		return err
+	}
+
+	return ew.Close()
+}
+
+func (f *frontend) getAdminOpenShiftClusterMustGather(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(filepath.Dir(r.URL.Path))
+
+	b, err := f._getAdminOpenShiftClusterMustGather(ctx, r)
+
+	adminReply(log, w, nil, b, err)
+}
+
+func (f *frontend) _getAdminOpenShiftClusterMustGather(ctx context.Context, r *http.Request) ([]byte, error) {
+	vars := mux.Vars(r)
+
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	asyncdoc, err := f.dbAsyncOperations.Get(ctx, vars["operationId"])
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeNotFound, "", "The entity was not found.")
+	case err != nil:
+		return nil, err
+	}
+
+	if asyncdoc.OpenShiftClusterKey != strings.ToLower(resourceID) {
+		return nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeNotFound, "", "The entity was not found.")
+	}
+
+	// the must-gather runs in the RP which accepted it; if that went away
+	// the operation will never complete
+	if !asyncdoc.AsyncOperation.ProvisioningState.IsTerminal() &&
+		f.now().After(asyncdoc.AsyncOperation.StartTime.Add(mustGatherTimeout+time.Minute)) {
+		asyncdoc.AsyncOperation.ProvisioningState = api.ProvisioningStateFailed
+		asyncdoc.AsyncOperation.Error = &api.CloudErrorBody{
+			Code:    api.CloudErrorCodeInternalServerError,
+			Message: "The must-gather was interrupted.",
+		}
+	}
+
+	asyncdoc.AsyncOperation.MissingFields = api.MissingFields{}
+
+	return json.MarshalIndent(asyncdoc.AsyncOperation, "", "    ")
+}
@@ -0,0 +1,353 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	"github.com/Azure/ARO-RP/pkg/util/encryption"
+	mock_adminactions "github.com/Azure/ARO-RP/pkg/util/mocks/adminactions"
+	"github.com/Azure/ARO-RP/pkg/util/mustgather"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestAdminMustGather(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	mockTenantID := "00000000-0000-0000-0000-000000000000"
+	mockCurrentTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	resourceID := testdatabase.GetResourcePath(mockSubID, "resourceName")
+	ctx := context.Background()
+
+	type test struct {
+		name                  string
+		fixture               func(*testdatabase.Fixture)
+		mocks                 func(*test, *mock_adminactions.MockKubeActions, *mock_adminactions.MockAzureActions, *[]byte)
+		wantStatusCode        int
+		wantError             string
+		wantProvisioningState api.ProvisioningState
+		wantOperationError    *api.CloudErrorBody
+	}
+
+	fixture := func(f *testdatabase.Fixture) {
+		f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+			Key: strings.ToLower(resourceID),
+			OpenShiftCluster: &api.OpenShiftCluster{
+				ID: resourceID,
+			},
+		})
+		f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+			ID: mockSubID,
+			Subscription: &api.Subscription{
+				State: api.SubscriptionStateRegistered,
+				Properties: &api.SubscriptionProperties{
+					TenantID: mockTenantID,
+				},
+			},
+		})
+	}
+
+	for _, tt := range []*test{
+		{
+			name:    "must-gather uploaded",
+			fixture: fixture,
+			mocks: func(tt *test, k *mock_adminactions.MockKubeActions, a *mock_adminactions.MockAzureActions, uploaded *[]byte) {
+				a.EXPECT().MustGatherURL(gomock.Any(), gomock.Any(), mockCurrentTime.Add(mustgather.LinkValidity)).
+					DoAndReturn(func(ctx context.Context, name string, expiry time.Time) (string, error) {
+						if !strings.HasPrefix(name, "must-gather-20210101000000-") {
+							return "", fmt.Errorf("unexpected name %s", name)
+						}
+						return "https://url", nil
+					})
+				k.EXPECT().KubeMustGather(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, w io.Writer) error {
+						_, err := w.Write([]byte("tarball"))
+						return err
+					})
+				a.EXPECT().MustGatherUpload(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, name string, r io.Reader) error {
+						b, err := ioutil.ReadAll(r)
+						*uploaded = b
+						return err
+					})
+			},
+			wantStatusCode:        http.StatusAccepted,
+			wantProvisioningState: api.ProvisioningStateSucceeded,
+		},
+		{
+			name:    "collection fails",
+			fixture: fixture,
+			mocks: func(tt *test, k *mock_adminactions.MockKubeActions, a *mock_adminactions.MockAzureActions, uploaded *[]byte) {
+				a.EXPECT().MustGatherURL(gomock.Any(), gomock.Any(), gomock.Any()).Return("https://url", nil)
+				k.EXPECT().KubeMustGather(gomock.Any(), gomock.Any()).Return(errors.New("random error"))
+				a.EXPECT().MustGatherUpload(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, name string, r io.Reader) error {
+						_, err := ioutil.ReadAll(r)
+						return err
+					})
+			},
+			wantStatusCode:        http.StatusAccepted,
+			wantProvisioningState: api.ProvisioningStateFailed,
+			wantOperationError: &api.CloudErrorBody{
+				Code:    api.CloudErrorCodeInternalServerError,
+				Message: "Internal server error.",
+			},
+		},
+		{
+			name:    "link fails",
+			fixture: fixture,
+			mocks: func(tt *test, k *mock_adminactions.MockKubeActions, a *mock_adminactions.MockAzureActions, uploaded *[]byte) {
+				a.EXPECT().MustGatherURL(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("random error"))
+			},
+			wantStatusCode: http.StatusInternalServerError,
+			wantError:      "500: InternalServerError: : Internal server error.",
+		},
+		{
+			name: "cluster not found",
+			mocks: func(tt *test, k *mock_adminactions.MockKubeActions, a *mock_adminactions.MockAzureActions, uploaded *[]byte) {
+			},
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "404: ResourceNotFound: : The Resource 'openshiftclusters/resourcename' under resource group 'resourcegroup' was not found.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions().WithAsyncOperations()
+			defer ti.done()
+
+			var uploaded []byte
+			k := mock_adminactions.NewMockKubeActions(ti.controller)
+			a := mock_adminactions.NewMockAzureActions(ti.controller)
+			tt.mocks(tt, k, a, &uploaded)
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.bulkActionsDatabase, api.APIs, &noop.Noop{}, nil, func(*logrus.Entry, env.Interface, *api.OpenShiftCluster) (adminactions.KubeActions, error) {
+				return k, nil
+			}, func(*logrus.Entry, env.Interface, *api.OpenShiftCluster, *api.SubscriptionDocument) (adminactions.AzureActions, error) {
+				return a, nil
+			}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f.(*frontend).now = func() time.Time { return mockCurrentTime }
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodPost,
+				fmt.Sprintf("https://server/admin%s/mustgather", resourceID),
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantError != "" {
+				err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, nil)
+				if err != nil {
+					t.Error(err)
+				}
+				return
+			}
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Fatal(resp.StatusCode)
+			}
+
+			var mg *admin.MustGather
+			err = json.Unmarshal(b, &mg)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if mg.URL != "https://url" {
+				t.Error(mg.URL)
+			}
+
+			if !mg.ExpiryTime.Equal(mockCurrentTime.Add(mustgather.LinkValidity)) {
+				t.Error(mg.ExpiryTime)
+			}
+
+			location := resp.Header.Get("Location")
+			if !strings.HasPrefix(location, "/admin"+strings.ToLower(resourceID)+"/mustgather/") {
+				t.Fatal(location)
+			}
+
+			// the must-gather completes after the response is sent
+			var op *api.AsyncOperation
+			for timeout := time.After(10 * time.Second); ; {
+				resp, b, err = ti.request(http.MethodGet, "https://server"+location, nil, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if resp.StatusCode != http.StatusOK {
+					t.Fatal(resp.StatusCode)
+				}
+
+				err = json.Unmarshal(b, &op)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if op.ProvisioningState.IsTerminal() {
+					break
+				}
+
+				select {
+				case <-timeout:
+					t.Fatal("timed out waiting for the must-gather")
+				case <-time.After(10 * time.Millisecond):
+				}
+			}
+
+			if op.ProvisioningState != tt.wantProvisioningState {
+				t.Error(op.ProvisioningState)
+			}
+
+			if !reflect.DeepEqual(op.Error, tt.wantOperationError) {
+				t.Error(op.Error)
+			}
+
+			if tt.wantProvisioningState != api.ProvisioningStateSucceeded {
+				return
+			}
+
+			// the uploaded tarball must be decryptable with the returned key
+			key, err := base64.StdEncoding.DecodeString(mg.Key)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r, err := encryption.NewXChaCha20Poly1305Reader(bytes.NewReader(uploaded), key)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			opened, err := ioutil.ReadAll(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(opened) != "tarball" {
+				t.Error(string(opened))
+			}
+		})
+	}
+}
+
+func TestAdminMustGatherStatus(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	mockCurrentTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockOperationID := "11111111-1111-1111-1111-111111111111"
+	resourceID := testdatabase.GetResourcePath(mockSubID, "resourceName")
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name                  string
+		resourceID            string
+		startTime             time.Time
+		wantStatusCode        int
+		wantError             string
+		wantProvisioningState api.ProvisioningState
+	}{
+		{
+			name:                  "running",
+			resourceID:            resourceID,
+			startTime:             mockCurrentTime.Add(-time.Minute),
+			wantStatusCode:        http.StatusOK,
+			wantProvisioningState: api.ProvisioningStateUpdating,
+		},
+		{
+			name:                  "interrupted",
+			resourceID:            resourceID,
+			startTime:             mockCurrentTime.Add(-2 * mustGatherTimeout),
+			wantStatusCode:        http.StatusOK,
+			wantProvisioningState: api.ProvisioningStateFailed,
+		},
+		{
+			name:           "other cluster",
+			resourceID:     testdatabase.GetResourcePath(mockSubID, "otherName"),
+			startTime:      mockCurrentTime,
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "404: NotFound: : The entity was not found.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithAsyncOperations()
+			defer ti.done()
+
+			err := ti.buildFixtures(func(f *testdatabase.Fixture) {
+				f.AddAsyncOperationDocuments(&api.AsyncOperationDocument{
+					ID:                  mockOperationID,
+					OpenShiftClusterKey: strings.ToLower(resourceID),
+					AsyncOperation: &api.AsyncOperation{
+						ProvisioningState: api.ProvisioningStateUpdating,
+						StartTime:         tt.startTime,
+					},
+				})
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.bulkActionsDatabase, api.APIs, &noop.Noop{}, nil, nil, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f.(*frontend).now = func() time.Time { return mockCurrentTime }
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodGet,
+				fmt.Sprintf("https://server/admin%s/mustgather/%s", tt.resourceID, mockOperationID),
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantError != "" {
+				err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, nil)
+				if err != nil {
+					t.Error(err)
+				}
+				return
+			}
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Fatal(resp.StatusCode)
+			}
+
+			var op *api.AsyncOperation
+			err = json.Unmarshal(b, &op)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if op.ProvisioningState != tt.wantProvisioningState {
+				t.Error(op.ProvisioningState)
+			}
+		})
+	}
+}
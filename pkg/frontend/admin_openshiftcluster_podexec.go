@@ -0,0 +1,81 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+// adminPodExecCommands are the diagnostic commands which may be run in a pod.
+// Commands are selected by name so that callers cannot supply arguments.
+var adminPodExecCommands = map[string][]string{
+	"df":          {"df", "-h"},
+	"ip-addr":     {"ip", "addr"},
+	"ip-route":    {"ip", "route"},
+	"mounts":      {"cat", "/proc/mounts"},
+	"ps":          {"ps", "auxww"},
+	"resolv-conf": {"cat", "/etc/resolv.conf"},
+	"ss":          {"ss", "-tanp"},
+	"top":         {"top", "-b", "-n", "1"},
+	"uptime":      {"uptime"},
+}
+
+func (f *frontend) postAdminOpenShiftClusterPodExec(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	sw := &streamResponseWriter{ResponseWriter: w}
+
+	err := f._postAdminOpenShiftClusterPodExec(ctx, sw, r, log)
+
+	adminStreamReply(log, sw, err)
+}
+
+func (f *frontend) _postAdminOpenShiftClusterPodExec(ctx context.Context, w http.ResponseWriter, r *http.Request, log *logrus.Entry) error {
+	vars := mux.Vars(r)
+
+	namespace, name, container := r.URL.Query().Get("namespace"), r.URL.Query().Get("name"), r.URL.Query().Get("container")
+
+	err := validateAdminPod(namespace, name, container)
+	if err != nil {
+		return err
+	}
+
+	command, ok := adminPodExecCommands[r.URL.Query().Get("command")]
+	if !ok {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "command", "The provided command '%s' is invalid.", r.URL.Query().Get("command"))
+	}
+
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	doc, err := f.dbOpenShiftClusters.Get(ctx, resourceID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case err != nil:
+		return err
+	}
+
+	k, err := f.kubeActionsFactory(log, f.env, doc.OpenShiftCluster)
+	if err != nil {
+		return err
+	}
+
+	err = k.KubePodExec(ctx, w, namespace, name, container, command)
+
+	adminAudit(r, nil, err, "Executed '%s' in pod %s/%s container '%s'.", strings.Join(command, " "), namespace, name, container)
+
+	return err
+}
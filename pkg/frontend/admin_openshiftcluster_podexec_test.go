@@ -0,0 +1,157 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	"github.com/Azure/ARO-RP/pkg/util/log/audit"
+	mock_adminactions "github.com/Azure/ARO-RP/pkg/util/mocks/adminactions"
+)
+
+func TestAdminPodExec(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	resourceID := fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName", mockSubID)
+	ctx := context.Background()
+
+	type test struct {
+		name           string
+		query          string
+		mocks          func(*test, *mock_adminactions.MockKubeActions)
+		wantStatusCode int
+		wantResponse   []byte
+		wantAudit      string
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name:  "allowed command",
+			query: "namespace=openshift-dns&name=dns-default-abcde&container=dns&command=resolv-conf",
+			mocks: func(tt *test, k *mock_adminactions.MockKubeActions) {
+				k.EXPECT().
+					KubePodExec(gomock.Any(), gomock.Any(), "openshift-dns", "dns-default-abcde", "dns", []string{"cat", "/etc/resolv.conf"}).
+					DoAndReturn(func(ctx context.Context, w http.ResponseWriter, namespace, name, container string, command []string) error {
+						_, err := w.Write([]byte("nameserver 168.63.129.16\n"))
+						return err
+					})
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse:   []byte("nameserver 168.63.129.16\n"),
+			wantAudit:      "Executed 'cat /etc/resolv.conf' in pod openshift-dns/dns-default-abcde container 'dns'.",
+		},
+		{
+			name:  "command fails after output",
+			query: "namespace=openshift-dns&name=dns-default-abcde&container=dns&command=ps",
+			mocks: func(tt *test, k *mock_adminactions.MockKubeActions) {
+				k.EXPECT().
+					KubePodExec(gomock.Any(), gomock.Any(), "openshift-dns", "dns-default-abcde", "dns", []string{"ps", "auxww"}).
+					DoAndReturn(func(ctx context.Context, w http.ResponseWriter, namespace, name, container string, command []string) error {
+						_, err := w.Write([]byte("USER PID\n"))
+						if err != nil {
+							return err
+						}
+						return fmt.Errorf("command terminated with exit code 1")
+					})
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse:   []byte("USER PID\n"),
+			wantAudit:      "Executed 'ps auxww' in pod openshift-dns/dns-default-abcde container 'dns'.",
+		},
+		{
+			name:           "command not allowed",
+			query:          "namespace=openshift-dns&name=dns-default-abcde&command=sh",
+			mocks:          func(tt *test, k *mock_adminactions.MockKubeActions) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: command: The provided command 'sh' is invalid.",
+		},
+		{
+			name:           "customer namespace",
+			query:          "namespace=customer&name=pod&command=ps",
+			mocks:          func(tt *test, k *mock_adminactions.MockKubeActions) {},
+			wantStatusCode: http.StatusForbidden,
+			wantError:      "403: Forbidden: : Access to the provided namespace 'customer' is forbidden.",
+		},
+		{
+			name:           "no namespace provided",
+			query:          "name=pod&command=ps",
+			mocks:          func(tt *test, k *mock_adminactions.MockKubeActions) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: : The provided namespace '' is invalid.",
+		},
+		{
+			name:           "invalid container",
+			query:          "namespace=openshift-dns&name=dns-default-abcde&container=$&command=ps",
+			mocks:          func(tt *test, k *mock_adminactions.MockKubeActions) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: : The provided container '$' is invalid.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters()
+			defer ti.done()
+
+			k := mock_adminactions.NewMockKubeActions(ti.controller)
+			tt.mocks(tt, k)
+
+			ti.fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(resourceID),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID:   resourceID,
+					Name: "resourceName",
+					Type: "Microsoft.RedHatOpenShift/openshiftClusters",
+				},
+			})
+
+			err := ti.buildFixtures(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.bulkActionsDatabase, api.APIs, &noop.Noop{}, nil, func(*logrus.Entry, env.Interface, *api.OpenShiftCluster) (adminactions.KubeActions, error) {
+				return k, nil
+			}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodPost,
+				fmt.Sprintf("https://server/admin%s/podexec?%s", resourceID, tt.query),
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, tt.wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+
+			if tt.wantAudit != "" {
+				var found bool
+				for _, e := range ti.auditHook.AllEntries() {
+					if strings.Contains(e.Data[audit.MetadataPayload].(string), tt.wantAudit) {
+						found = true
+					}
+				}
+				if !found {
+					t.Error("audit event not found")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+func (f *frontend) getAdminOpenShiftClusterPodLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	sw := &streamResponseWriter{ResponseWriter: w}
+
+	err := f._getAdminOpenShiftClusterPodLogs(ctx, sw, r, log)
+
+	adminStreamReply(log, sw, err)
+}
+
+func (f *frontend) _getAdminOpenShiftClusterPodLogs(ctx context.Context, w http.ResponseWriter, r *http.Request, log *logrus.Entry) error {
+	vars := mux.Vars(r)
+
+	namespace, name, container := r.URL.Query().Get("namespace"), r.URL.Query().Get("name"), r.URL.Query().Get("container")
+
+	err := validateAdminPod(namespace, name, container)
+	if err != nil {
+		return err
+	}
+
+	options, err := podLogOptions(container, r.URL.Query().Get("tail"), r.URL.Query().Get("since"), r.URL.Query().Get("follow"))
+	if err != nil {
+		return err
+	}
+
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	doc, err := f.dbOpenShiftClusters.Get(ctx, resourceID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case err != nil:
+		return err
+	}
+
+	k, err := f.kubeActionsFactory(log, f.env, doc.OpenShiftCluster)
+	if err != nil {
+		return err
+	}
+
+	err = k.KubePodLogs(ctx, w, namespace, name, options)
+
+	adminAudit(r, nil, err, "Read logs of pod %s/%s container '%s'.", namespace, name, container)
+
+	return err
+}
+
+func podLogOptions(container, tail, since, follow string) (*corev1.PodLogOptions, error) {
+	options := &corev1.PodLogOptions{
+		Container: container,
+	}
+
+	if tail != "" {
+		tailLines, err := strconv.ParseInt(tail, 10, 64)
+		if err != nil || tailLines < 0 {
+			return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "tail", "The provided tail '%s' is invalid.", tail)
+		}
+		options.TailLines = &tailLines
+	}
+
+	if since != "" {
+		d, err := time.ParseDuration(since)
+		if err != nil || d < time.Second {
+			return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "since", "The provided since '%s' is invalid.", since)
+		}
+		sinceSeconds := int64(d / time.Second)
+		options.SinceSeconds = &sinceSeconds
+	}
+
+	switch follow {
+	case "", "false":
+	case "true":
+		options.Follow = true
+	default:
+		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "follow", "The provided follow '%s' is invalid.", follow)
+	}
+
+	return options, nil
+}
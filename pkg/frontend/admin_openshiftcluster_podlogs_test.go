@@ -0,0 +1,210 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	mock_adminactions "github.com/Azure/ARO-RP/pkg/util/mocks/adminactions"
+)
+
+func TestAdminPodLogs(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	resourceID := fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName", mockSubID)
+	ctx := context.Background()
+
+	type test struct {
+		name           string
+		query          string
+		mocks          func(*test, *mock_adminactions.MockKubeActions)
+		wantStatusCode int
+		wantResponse   []byte
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name:  "logs",
+			query: "namespace=openshift-azure-logging&name=mdsd-abcde&container=mdsd&tail=10&since=1h&follow=true",
+			mocks: func(tt *test, k *mock_adminactions.MockKubeActions) {
+				tailLines, sinceSeconds := int64(10), int64(3600)
+				k.EXPECT().
+					KubePodLogs(gomock.Any(), gomock.Any(), "openshift-azure-logging", "mdsd-abcde", &corev1.PodLogOptions{
+						Container:    "mdsd",
+						TailLines:    &tailLines,
+						SinceSeconds: &sinceSeconds,
+						Follow:       true,
+					}).
+					DoAndReturn(func(ctx context.Context, w http.ResponseWriter, namespace, name string, options *corev1.PodLogOptions) error {
+						_, err := w.Write([]byte("log line\n"))
+						return err
+					})
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse:   []byte("log line\n"),
+		},
+		{
+			name:  "stream fails after output",
+			query: "namespace=openshift-azure-logging&name=mdsd-abcde",
+			mocks: func(tt *test, k *mock_adminactions.MockKubeActions) {
+				k.EXPECT().
+					KubePodLogs(gomock.Any(), gomock.Any(), "openshift-azure-logging", "mdsd-abcde", &corev1.PodLogOptions{}).
+					DoAndReturn(func(ctx context.Context, w http.ResponseWriter, namespace, name string, options *corev1.PodLogOptions) error {
+						_, err := w.Write([]byte("log line\n"))
+						if err != nil {
+							return err
+						}
+						return fmt.Errorf("unexpected EOF")
+					})
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse:   []byte("log line\n"),
+		},
+		{
+			name:  "stream fails before output",
+			query: "namespace=openshift-azure-logging&name=mdsd-abcde",
+			mocks: func(tt *test, k *mock_adminactions.MockKubeActions) {
+				k.EXPECT().
+					KubePodLogs(gomock.Any(), gomock.Any(), "openshift-azure-logging", "mdsd-abcde", &corev1.PodLogOptions{}).
+					Return(fmt.Errorf("sad"))
+			},
+			wantStatusCode: http.StatusInternalServerError,
+			wantError:      "500: InternalServerError: : Internal server error.",
+		},
+		{
+			name:           "customer namespace",
+			query:          "namespace=customer&name=pod",
+			mocks:          func(tt *test, k *mock_adminactions.MockKubeActions) {},
+			wantStatusCode: http.StatusForbidden,
+			wantError:      "403: Forbidden: : Access to the provided namespace 'customer' is forbidden.",
+		},
+		{
+			name:           "no name provided",
+			query:          "namespace=openshift-azure-logging",
+			mocks:          func(tt *test, k *mock_adminactions.MockKubeActions) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: : The provided name '' is invalid.",
+		},
+		{
+			name:           "invalid tail",
+			query:          "namespace=openshift-azure-logging&name=mdsd-abcde&tail=-1",
+			mocks:          func(tt *test, k *mock_adminactions.MockKubeActions) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: tail: The provided tail '-1' is invalid.",
+		},
+		{
+			name:           "invalid since",
+			query:          "namespace=openshift-azure-logging&name=mdsd-abcde&since=yesterday",
+			mocks:          func(tt *test, k *mock_adminactions.MockKubeActions) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: since: The provided since 'yesterday' is invalid.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters()
+			defer ti.done()
+
+			k := mock_adminactions.NewMockKubeActions(ti.controller)
+			tt.mocks(tt, k)
+
+			ti.fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(resourceID),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID:   resourceID,
+					Name: "resourceName",
+					Type: "Microsoft.RedHatOpenShift/openshiftClusters",
+				},
+			})
+
+			err := ti.buildFixtures(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.bulkActionsDatabase, api.APIs, &noop.Noop{}, nil, func(*logrus.Entry, env.Interface, *api.OpenShiftCluster) (adminactions.KubeActions, error) {
+				return k, nil
+			}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodGet,
+				fmt.Sprintf("https://server/admin%s/podlogs?%s", resourceID, tt.query),
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, tt.wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestPodLogOptions(t *testing.T) {
+	tailLines, sinceSeconds := int64(0), int64(90)
+
+	for _, tt := range []struct {
+		name    string
+		tail    string
+		since   string
+		follow  string
+		want    *corev1.PodLogOptions
+		wantErr string
+	}{
+		{
+			name: "defaults",
+			want: &corev1.PodLogOptions{},
+		},
+		{
+			name:   "all set",
+			tail:   "0",
+			since:  "90s",
+			follow: "false",
+			want: &corev1.PodLogOptions{
+				TailLines:    &tailLines,
+				SinceSeconds: &sinceSeconds,
+			},
+		},
+		{
+			name:    "since too short",
+			since:   "10ms",
+			wantErr: "400: InvalidParameter: since: The provided since '10ms' is invalid.",
+		},
+		{
+			name:    "invalid follow",
+			follow:  "yes",
+			wantErr: "400: InvalidParameter: follow: The provided follow 'yes' is invalid.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := podLogOptions("", tt.tail, tt.since, tt.follow)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			for _, diff := range deep.Equal(got, tt.want) {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -5,7 +5,9 @@ package adminactions
 
 import (
 	"context"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
@@ -23,6 +25,8 @@ type AzureActions interface {
 	ResourcesList(ctx context.Context) ([]byte, error)
 	VMRedeployAndWait(ctx context.Context, vmName string) error
 	VMSerialConsole(ctx context.Context, w http.ResponseWriter, log *logrus.Entry, vmName string) error
+	MustGatherUpload(ctx context.Context, name string, r io.Reader) error
+	MustGatherURL(ctx context.Context, name string, expiry time.Time) (string, error)
 }
 
 type azureActions struct {
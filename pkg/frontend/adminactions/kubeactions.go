@@ -5,14 +5,20 @@ package adminactions
 
 import (
 	"context"
+	"io"
 	"net/http"
 
+	jsonpatch "github.com/evanphx/json-patch"
 	configclient "github.com/openshift/client-go/config/clientset/versioned"
 	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/env"
@@ -26,6 +32,10 @@ type KubeActions interface {
 	KubeList(ctx context.Context, groupKind, namespace string) ([]byte, error)
 	KubeCreateOrUpdate(ctx context.Context, obj *unstructured.Unstructured) error
 	KubeDelete(ctx context.Context, groupKind, namespace, name string) error
+	KubePatch(ctx context.Context, groupKind, namespace, name string, patchType types.PatchType, data []byte, fieldManager string, force bool) ([]byte, error)
+	KubePodLogs(ctx context.Context, w http.ResponseWriter, namespace, name string, options *corev1.PodLogOptions) error
+	KubePodExec(ctx context.Context, w http.ResponseWriter, namespace, name, container string, command []string) error
+	KubeMustGather(ctx context.Context, w io.Writer) error
 	Upgrade(ctx context.Context, upgradeY bool) error
 }
 
@@ -35,8 +45,10 @@ type kubeActions struct {
 
 	gvrResolver dynamichelper.GVRResolver
 
-	dyn       dynamic.Interface
-	configcli configclient.Interface
+	restConfig *rest.Config
+	dyn        dynamic.Interface
+	kubecli    kubernetes.Interface
+	configcli  configclient.Interface
 }
 
 // NewKubeActions returns a kubeActions
@@ -56,6 +68,11 @@ func NewKubeActions(log *logrus.Entry, env env.Interface, oc *api.OpenShiftClust
 		return nil, err
 	}
 
+	kubecli, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	configcli, err := configclient.NewForConfig(restConfig)
 	if err != nil {
 		return nil, err
@@ -67,8 +84,10 @@ func NewKubeActions(log *logrus.Entry, env env.Interface, oc *api.OpenShiftClust
 
 		gvrResolver: gvrResolver,
 
-		dyn:       dyn,
-		configcli: configcli,
+		restConfig: restConfig,
+		dyn:        dyn,
+		kubecli:    kubecli,
+		configcli:  configcli,
 	}, nil
 }
 
@@ -130,3 +149,57 @@ func (k *kubeActions) KubeDelete(ctx context.Context, groupKind, namespace, name
 
 	return k.dyn.Resource(*gvr).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{})
 }
+
+// KubePatch patches an object using the given patch type and returns a JSON
+// merge patch describing the change which was made to the object.  force is
+// only honoured for server-side apply.
+func (k *kubeActions) KubePatch(ctx context.Context, groupKind, namespace, name string, patchType types.PatchType, data []byte, fieldManager string, force bool) ([]byte, error) {
+	gvr, err := k.gvrResolver.Resolve(groupKind, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// server-side apply may create the object, so it need not exist yet
+	before, err := k.dyn.Resource(*gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) && patchType == types.ApplyPatchType {
+		before, err = &unstructured.Unstructured{Object: map[string]interface{}{}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	options := metav1.PatchOptions{
+		FieldManager: fieldManager,
+	}
+	if patchType == types.ApplyPatchType {
+		options.Force = &force
+	}
+
+	after, err := k.dyn.Resource(*gvr).Namespace(namespace).Patch(ctx, name, patchType, data, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return diff(before, after)
+}
+
+// diff returns a JSON merge patch from before to after, ignoring metadata
+// fields which change on every write
+func diff(before, after *unstructured.Unstructured) ([]byte, error) {
+	var b [2][]byte
+
+	for i, o := range []*unstructured.Unstructured{before, after} {
+		o = o.DeepCopy()
+		unstructured.RemoveNestedField(o.Object, "metadata", "generation")
+		unstructured.RemoveNestedField(o.Object, "metadata", "managedFields")
+		unstructured.RemoveNestedField(o.Object, "metadata", "resourceVersion")
+
+		var err error
+		b[i], err = o.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return jsonpatch.CreateMergePatch(b[0], b[1])
+}
@@ -0,0 +1,82 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDiff(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		before map[string]interface{}
+		after  map[string]interface{}
+		want   string
+	}{
+		{
+			name: "changed field",
+			before: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":            "config",
+					"resourceVersion": "1",
+				},
+				"data": map[string]interface{}{
+					"key":     "old",
+					"removed": "value",
+				},
+			},
+			after: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":            "config",
+					"resourceVersion": "2",
+					"managedFields":   []interface{}{},
+				},
+				"data": map[string]interface{}{
+					"key": "new",
+				},
+			},
+			want: `{"data":{"key":"new","removed":null}}`,
+		},
+		{
+			name:   "created",
+			before: map[string]interface{}{},
+			after: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":       "config",
+					"generation": int64(1),
+				},
+			},
+			want: `{"metadata":{"name":"config"}}`,
+		},
+		{
+			name: "unchanged",
+			before: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":            "config",
+					"resourceVersion": "1",
+				},
+			},
+			after: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":            "config",
+					"resourceVersion": "1",
+				},
+			},
+			want: `{}`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := diff(&unstructured.Unstructured{Object: tt.before}, &unstructured.Unstructured{Object: tt.after})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(got) != tt.want {
+				t.Error(string(got))
+			}
+		})
+	}
+}
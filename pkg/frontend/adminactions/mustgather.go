@@ -0,0 +1,180 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	utilnamespace "github.com/Azure/ARO-RP/pkg/util/namespace"
+)
+
+// mustGatherResources are the kinds collected by KubeMustGather.  Namespaced
+// kinds are only collected from OpenShift namespaces, and secrets are never
+// collected.
+var mustGatherResources = []struct {
+	groupKind  string
+	namespaced bool
+}{
+	{groupKind: "ClusterVersion.config.openshift.io"},
+	{groupKind: "ClusterOperator.config.openshift.io"},
+	{groupKind: "Node"},
+	{groupKind: "MachineConfigPool.machineconfiguration.openshift.io"},
+	{groupKind: "Machine.machine.openshift.io", namespaced: true},
+	{groupKind: "Deployment.apps", namespaced: true},
+	{groupKind: "DaemonSet.apps", namespaced: true},
+	{groupKind: "Pod", namespaced: true},
+	{groupKind: "Event", namespaced: true},
+}
+
+// KubeMustGather writes a gzipped tarball of cluster, operator, node and event
+// data to w.  Failures to collect individual kinds are recorded in the
+// tarball rather than failing the gather.
+func (k *kubeActions) KubeMustGather(ctx context.Context, w io.Writer) error {
+	namespaces, err := k.kubecli.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	mg := newMustGatherWriter(w, time.Now())
+
+	for _, r := range mustGatherResources {
+		if !r.namespaced {
+			k.mustGatherList(ctx, mg, r.groupKind, "", path.Join("cluster-scoped-resources", r.groupKind+".json"))
+			continue
+		}
+
+		for _, ns := range namespaces.Items {
+			if ns.Name == "" || !utilnamespace.IsOpenShift(ns.Name) {
+				continue
+			}
+
+			k.mustGatherList(ctx, mg, r.groupKind, ns.Name, path.Join("namespaces", ns.Name, r.groupKind+".json"))
+		}
+	}
+
+	return mg.Close()
+}
+
+func (k *kubeActions) mustGatherList(ctx context.Context, mg *mustGatherWriter, groupKind, namespace, name string) {
+	ul, err := k.list(ctx, groupKind, namespace)
+	if err != nil {
+		mg.errorf("%s: %v", name, err)
+		return
+	}
+
+	if len(ul.Items) == 0 {
+		return
+	}
+
+	for i := range ul.Items {
+		ul.Items[i].SetManagedFields(nil)
+	}
+
+	b, err := ul.MarshalJSON()
+	if err != nil {
+		mg.errorf("%s: %v", name, err)
+		return
+	}
+
+	mg.add(name, b)
+}
+
+// list returns all objects of a kind, following continuations
+func (k *kubeActions) list(ctx context.Context, groupKind, namespace string) (*unstructured.UnstructuredList, error) {
+	gvr, err := k.gvrResolver.Resolve(groupKind, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var ul *unstructured.UnstructuredList
+	var cont string
+	for {
+		page, err := k.dyn.Resource(*gvr).Namespace(namespace).List(ctx, metav1.ListOptions{Limit: 500, Continue: cont})
+		if err != nil {
+			return nil, err
+		}
+
+		if ul == nil {
+			ul = page
+		} else {
+			ul.Items = append(ul.Items, page.Items...)
+		}
+
+		cont = page.GetContinue()
+		if cont == "" {
+			return ul, nil
+		}
+	}
+}
+
+// mustGatherWriter writes files to a gzipped tarball, remembering the first
+// write error and accumulating collection errors in errors.txt
+type mustGatherWriter struct {
+	gz     *gzip.Writer
+	tw     *tar.Writer
+	t      time.Time
+	errs   []byte
+	werr   error
+	prefix string
+}
+
+func newMustGatherWriter(w io.Writer, t time.Time) *mustGatherWriter {
+	gz := gzip.NewWriter(w)
+
+	return &mustGatherWriter{
+		gz:     gz,
+		tw:     tar.NewWriter(gz),
+		t:      t,
+		prefix: "must-gather",
+	}
+}
+
+func (mg *mustGatherWriter) add(name string, b []byte) {
+	if mg.werr != nil {
+		return
+	}
+
+	mg.werr = mg.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     path.Join(mg.prefix, name),
+		Mode:     0644,
+		Size:     int64(len(b)),
+		ModTime:  mg.t,
+	})
+	if mg.werr != nil {
+		return
+	}
+
+	_, mg.werr = mg.tw.Write(b)
+}
+
+func (mg *mustGatherWriter) errorf(format string, a ...interface{}) {
+	mg.errs = append(mg.errs, fmt.Sprintf(format+"\n", a...)...)
+}
+
+func (mg *mustGatherWriter) Close() error {
+	if len(mg.errs) > 0 {
+		mg.add("errors.txt", mg.errs)
+	}
+
+	if mg.werr != nil {
+		return mg.werr
+	}
+
+	err := mg.tw.Close()
+	if err != nil {
+		return err
+	}
+
+	return mg.gz.Close()
+}
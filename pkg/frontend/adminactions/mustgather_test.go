@@ -0,0 +1,57 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMustGatherWriter(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	mg := newMustGatherWriter(buf, time.Unix(0, 0))
+	mg.add("cluster-scoped-resources/Node.json", []byte(`{"items":[]}`))
+	mg.errorf("%s: %s", "namespaces/openshift-machine-api/Machine.machine.openshift.io.json", "forbidden")
+
+	err := mg.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gz, err := gzip.NewReader(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := map[string]string{}
+	tr := tar.NewReader(gz)
+	for {
+		h, err := tr.Next()
+		if err != nil {
+			break
+		}
+
+		b, err := ioutil.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		files[h.Name] = string(b)
+	}
+
+	want := map[string]string{
+		"must-gather/cluster-scoped-resources/Node.json": `{"items":[]}`,
+		"must-gather/errors.txt":                         "namespaces/openshift-machine-api/Machine.machine.openshift.io.json: forbidden\n",
+	}
+
+	if !reflect.DeepEqual(files, want) {
+		t.Error(files)
+	}
+}
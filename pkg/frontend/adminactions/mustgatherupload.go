@@ -0,0 +1,121 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+
+	mgmtstorage "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	azstorage "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	"github.com/Azure/ARO-RP/pkg/util/mustgather"
+	"github.com/Azure/ARO-RP/pkg/util/stringutils"
+)
+
+// mustGatherBlockSize is the size of the blocks a must-gather is uploaded in,
+// so that it is never held in memory in full
+const mustGatherBlockSize = 4 * 1024 * 1024
+
+// MustGatherURL returns a URL from which the must-gather blob alone can be
+// read until expiry
+func (a *azureActions) MustGatherURL(ctx context.Context, name string, expiry time.Time) (string, error) {
+	clusterRGName := stringutils.LastTokenByte(a.oc.Properties.ClusterProfile.ResourceGroupID, '/')
+	account := "cluster" + a.oc.Properties.StorageSuffix
+
+	// the download link is a service SAS scoped to the single blob, read only
+	sas, err := a.storageAccounts.ListServiceSAS(
+		ctx, clusterRGName, account, mgmtstorage.ServiceSasParameters{
+			CanonicalizedResource:  to.StringPtr(fmt.Sprintf("/blob/%s/%s/%s", account, mustgather.Container, name)),
+			Resource:               mgmtstorage.SignedResourceB,
+			Permissions:            mgmtstorage.R,
+			Protocols:              mgmtstorage.HTTPS,
+			SharedAccessStartTime:  &date.Time{Time: time.Now().UTC().Truncate(time.Second)},
+			SharedAccessExpiryTime: &date.Time{Time: expiry},
+		})
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("https://%s.blob.%s/%s/%s?%s", account, a.env.Environment().StorageEndpointSuffix, mustgather.Container, name, *sas.ServiceSasToken), nil
+}
+
+// MustGatherUpload streams r into the named blob in the cluster storage
+// account.  Expired must-gathers are deleted first.
+func (a *azureActions) MustGatherUpload(ctx context.Context, name string, r io.Reader) error {
+	clusterRGName := stringutils.LastTokenByte(a.oc.Properties.ClusterProfile.ResourceGroupID, '/')
+	account := "cluster" + a.oc.Properties.StorageSuffix
+
+	t := time.Now().UTC().Truncate(time.Second)
+	res, err := a.storageAccounts.ListAccountSAS(
+		ctx, clusterRGName, account, mgmtstorage.AccountSasParameters{
+			Services:               mgmtstorage.B,
+			ResourceTypes:          mgmtstorage.SignedResourceTypesC + mgmtstorage.SignedResourceTypesO,
+			Permissions:            mgmtstorage.C + mgmtstorage.W + mgmtstorage.L + mgmtstorage.D,
+			Protocols:              mgmtstorage.HTTPS,
+			SharedAccessStartTime:  &date.Time{Time: t},
+			SharedAccessExpiryTime: &date.Time{Time: t.Add(time.Hour)},
+		})
+	if err != nil {
+		return err
+	}
+
+	v, err := url.ParseQuery(*res.AccountSasToken)
+	if err != nil {
+		return err
+	}
+
+	blobService := azstorage.NewAccountSASClient(account, v, *a.env.Environment()).GetBlobService()
+
+	c := blobService.GetContainerReference(mustgather.Container)
+
+	_, err = c.CreateIfNotExists(nil)
+	if err != nil {
+		return err
+	}
+
+	err = mustgather.DeleteExpired(c, t)
+	if err != nil {
+		return err
+	}
+
+	blob := c.GetBlobReference(name)
+
+	var blocks []azstorage.Block
+	buf := make([]byte, mustGatherBlockSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if err == io.EOF {
+			break
+		}
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return err
+		}
+
+		// block IDs within a blob must all be the same length
+		id := make([]byte, 8)
+		binary.BigEndian.PutUint64(id, uint64(len(blocks)))
+
+		block := azstorage.Block{
+			ID:     base64.StdEncoding.EncodeToString(id),
+			Status: azstorage.BlockStatusUncommitted,
+		}
+
+		err = blob.PutBlock(block.ID, buf[:n], nil)
+		if err != nil {
+			return err
+		}
+
+		blocks = append(blocks, block)
+	}
+
+	return blob.PutBlockList(blocks, nil)
+}
@@ -0,0 +1,76 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// flushWriter flushes after every write so that followed logs and command
+// output reach the caller as they are produced.  It is safe for concurrent use
+// because exec streams stdout and stderr in parallel.
+type flushWriter struct {
+	mu sync.Mutex
+	w  http.ResponseWriter
+}
+
+func (fw *flushWriter) Write(b []byte) (int, error) {
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+
+	n, err := fw.w.Write(b)
+	if f, ok := fw.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return n, err
+}
+
+func (k *kubeActions) KubePodLogs(ctx context.Context, w http.ResponseWriter, namespace, name string, options *corev1.PodLogOptions) error {
+	rc, err := k.kubecli.CoreV1().Pods(namespace).GetLogs(name, options).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	w.Header().Add("Content-Type", "text/plain")
+
+	_, err = io.Copy(&flushWriter{w: w}, rc)
+	return err
+}
+
+func (k *kubeActions) KubePodExec(ctx context.Context, w http.ResponseWriter, namespace, name, container string, command []string) error {
+	req := k.kubecli.CoreV1().RESTClient().
+		Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	exec, err := remotecommand.NewSPDYExecutor(k.restConfig, http.MethodPost, req.URL())
+	if err != nil {
+		return err
+	}
+
+	w.Header().Add("Content-Type", "text/plain")
+
+	fw := &flushWriter{w: w}
+
+	return exec.Stream(remotecommand.StreamOptions{
+		Stdout: fw,
+		Stderr: fw,
+	})
+}
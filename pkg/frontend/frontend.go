@@ -29,6 +29,7 @@ import (
 	"github.com/Azure/ARO-RP/pkg/util/clusterdata"
 	"github.com/Azure/ARO-RP/pkg/util/encryption"
 	"github.com/Azure/ARO-RP/pkg/util/heartbeat"
+	"github.com/Azure/ARO-RP/pkg/util/log/audit"
 	"github.com/Azure/ARO-RP/pkg/util/recover"
 )
 
@@ -52,6 +53,7 @@ type frontend struct {
 	dbAsyncOperations   database.AsyncOperations
 	dbOpenShiftClusters database.OpenShiftClusters
 	dbSubscriptions     database.Subscriptions
+	dbBulkActions       database.BulkActions
 
 	apis map[string]*api.Version
 	m    metrics.Interface
@@ -85,6 +87,7 @@ func NewFrontend(ctx context.Context,
 	dbAsyncOperations database.AsyncOperations,
 	dbOpenShiftClusters database.OpenShiftClusters,
 	dbSubscriptions database.Subscriptions,
+	dbBulkActions database.BulkActions,
 	apis map[string]*api.Version,
 	m metrics.Interface,
 	aead encryption.AEAD,
@@ -98,6 +101,7 @@ func NewFrontend(ctx context.Context,
 		dbAsyncOperations:   dbAsyncOperations,
 		dbOpenShiftClusters: dbOpenShiftClusters,
 		dbSubscriptions:     dbSubscriptions,
+		dbBulkActions:       dbBulkActions,
 		apis:                apis,
 		m:                   m,
 		aead:                aead,
@@ -216,6 +220,18 @@ func (f *frontend) authenticatedRoutes(r *mux.Router) {
 	s.Methods(http.MethodPost).HandlerFunc(f.postAdminKubernetesObjects).Name("postAdminKubernetesObjects")
 	s.Methods(http.MethodDelete).HandlerFunc(f.deleteAdminKubernetesObjects).Name("deleteAdminKubernetesObjects")
 
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/podlogs").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.getAdminOpenShiftClusterPodLogs).Name("getAdminOpenShiftClusterPodLogs")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/podexec").
+		Subrouter()
+
+	s.Methods(http.MethodPost).HandlerFunc(f.postAdminOpenShiftClusterPodExec).Name("postAdminOpenShiftClusterPodExec")
+
 	s = r.
 		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/resources").
 		Subrouter()
@@ -234,6 +250,18 @@ func (f *frontend) authenticatedRoutes(r *mux.Router) {
 
 	s.Methods(http.MethodPost).HandlerFunc(f.postAdminOpenShiftClusterRedeployVM).Name("postAdminOpenShiftClusterRedeployVM")
 
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/mustgather").
+		Subrouter()
+
+	s.Methods(http.MethodPost).HandlerFunc(f.postAdminOpenShiftClusterMustGather).Name("postAdminOpenShiftClusterMustGather")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/mustgather/{operationId}").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.getAdminOpenShiftClusterMustGather).Name("getAdminOpenShiftClusterMustGather")
+
 	s = r.
 		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/upgrade").
 		Subrouter()
@@ -246,6 +274,18 @@ func (f *frontend) authenticatedRoutes(r *mux.Router) {
 
 	s.Methods(http.MethodGet).HandlerFunc(f.getAdminOpenShiftClusters).Name("getAdminOpenShiftClusters")
 
+	s = r.
+		Path("/admin/providers/{resourceProviderNamespace}/{resourceType}/bulkactions").
+		Subrouter()
+
+	s.Methods(http.MethodPost).HandlerFunc(f.postAdminBulkAction).Name("postAdminBulkAction")
+
+	s = r.
+		Path("/admin/providers/{resourceProviderNamespace}/{resourceType}/bulkactions/{bulkActionId}").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.getAdminBulkAction).Name("getAdminBulkAction")
+
 	// Operations
 	s = r.
 		Path("/providers/{resourceProviderNamespace}/operations").
@@ -350,6 +390,59 @@ func adminReply(log *logrus.Entry, w http.ResponseWriter, header http.Header, b
 	reply(log, w, header, b, err)
 }
 
+// streamResponseWriter records whether a streamed response has started
+type streamResponseWriter struct {
+	http.ResponseWriter
+	started bool
+}
+
+func (w *streamResponseWriter) WriteHeader(statusCode int) {
+	w.started = true
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *streamResponseWriter) Write(b []byte) (int, error) {
+	w.started = true
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *streamResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// adminStreamReply replies to an admin request whose response is streamed.
+// Once the stream has started the status code has been sent, so an error can
+// only be logged.
+func adminStreamReply(log *logrus.Entry, w *streamResponseWriter, err error) {
+	if err != nil && w.started {
+		log.Warn(err)
+		return
+	}
+
+	adminReply(log, w, nil, nil, err)
+}
+
+// adminAudit records the detail of an admin action in an additional audit
+// event, alongside the event which the log middleware emits for every request
+func adminAudit(r *http.Request, fields logrus.Fields, err error, format string, a ...interface{}) {
+	auditLog, ok := r.Context().Value(middleware.ContextKeyAuditLog).(*logrus.Entry)
+	if !ok {
+		return
+	}
+
+	resultType := audit.ResultTypeSuccess
+	if err != nil {
+		resultType = audit.ResultTypeFail
+	}
+
+	auditLog.WithFields(fields).WithField(audit.PayloadKeyResult, audit.Result{
+		ResultType:        resultType,
+		ResultDescription: fmt.Sprintf(format, a...),
+	}).Info(audit.DefaultLogMessage)
+}
+
 func reply(log *logrus.Entry, w http.ResponseWriter, header http.Header, b []byte, err error) {
 	for k, v := range header {
 		w.Header()[k] = v
@@ -24,7 +24,7 @@ func Body(h http.Handler) http.Handler {
 
 			contentType := strings.SplitN(r.Header.Get("Content-Type"), ";", 2)[0]
 
-			if contentType != "application/json" && !(len(body) == 0 && contentType == "") {
+			if !isSupportedContentType(r, contentType) && !(len(body) == 0 && contentType == "") {
 				api.WriteError(w, http.StatusUnsupportedMediaType, api.CloudErrorCodeUnsupportedMediaType, "", "The content media type '%s' is not supported. Only 'application/json' is supported.", r.Header.Get("Content-Type"))
 				return
 			}
@@ -35,3 +35,18 @@ func Body(h http.Handler) http.Handler {
 		h.ServeHTTP(w, r)
 	})
 }
+
+// isSupportedContentType returns true if the content type is allowed for the
+// request.  Admin requests may additionally send Kubernetes patch types.
+func isSupportedContentType(r *http.Request, contentType string) bool {
+	switch contentType {
+	case "application/json":
+		return true
+	case "application/merge-patch+json",
+		"application/strategic-merge-patch+json",
+		"application/apply-patch+yaml":
+		return isAdminOp(r)
+	}
+
+	return false
+}
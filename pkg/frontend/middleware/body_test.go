@@ -18,6 +18,7 @@ func TestBody(t *testing.T) {
 	tests := []struct {
 		name    string
 		isGet   bool
+		path    string
 		header  http.Header
 		body    []byte
 		wantErr string
@@ -59,6 +60,23 @@ func TestBody(t *testing.T) {
 			},
 			body: []byte("body"),
 		},
+		{
+			name: "non-GET request - patch media type allowed for admin",
+			path: "/admin/subscriptions/sub/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster/kubernetesobjects",
+			header: http.Header{
+				"Content-Type": []string{"application/merge-patch+json"},
+			},
+			body: []byte("body"),
+		},
+		{
+			name: "non-GET request - patch media type not allowed for non-admin",
+			path: "/subscriptions/sub/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster",
+			header: http.Header{
+				"Content-Type": []string{"application/merge-patch+json"},
+			},
+			body:    []byte("body"),
+			wantErr: "415: UnsupportedMediaType: : The content media type 'application/merge-patch+json' is not supported. Only 'application/json' is supported.",
+		},
 	}
 
 	for _, tt := range tests {
@@ -69,7 +87,7 @@ func TestBody(t *testing.T) {
 
 		for _, method := range methods {
 			t.Run(tt.name+"/"+method, func(t *testing.T) {
-				r, err := http.NewRequest(method, "", bytes.NewReader(tt.body))
+				r, err := http.NewRequest(method, tt.path, bytes.NewReader(tt.body))
 				if err != nil {
 					t.Fatal(err)
 				}
@@ -40,6 +40,12 @@ func (w *logResponseWriter) WriteHeader(statusCode int) {
 	w.statusCode = statusCode
 }
 
+func (w *logResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
 type logReadCloser struct {
 	io.ReadCloser
 
@@ -143,6 +149,9 @@ func Log(env env.Core, auditLog, baseLog *logrus.Entry) func(http.Handler) http.
 				},
 			})
 
+			// handlers may use the audit entry to record additional detail
+			r = r.WithContext(context.WithValue(r.Context(), ContextKeyAuditLog, auditEntry))
+
 			defer func() {
 				if r.URL.Path == "/healthz/ready" {
 					return
@@ -15,6 +15,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
 	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
 )
@@ -24,30 +25,35 @@ func (f *frontend) getOpenShiftClusters(w http.ResponseWriter, r *http.Request)
 	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
 	vars := mux.Vars(r)
 
-	b, err := f._getOpenShiftClusters(ctx, log, r, f.apis[vars["api-version"]].OpenShiftClusterConverter(), func(skipToken string) (cosmosdb.OpenShiftClusterDocumentIterator, error) {
-		prefix := "/subscriptions/" + vars["subscriptionId"] + "/"
+	b, err := f._getOpenShiftClusters(ctx, log, r, f.apis[vars["api-version"]].OpenShiftClusterConverter(), func(q *database.OpenShiftClustersQuery, skipToken string) (cosmosdb.OpenShiftClusterDocumentIterator, error) {
+		q.Prefix = "/subscriptions/" + vars["subscriptionId"] + "/"
 		if vars["resourceGroupName"] != "" {
-			prefix += "resourcegroups/" + vars["resourceGroupName"] + "/"
+			q.Prefix += "resourcegroups/" + vars["resourceGroupName"] + "/"
 		}
 
-		return f.dbOpenShiftClusters.ListByPrefix(vars["subscriptionId"], prefix, skipToken)
+		return f.dbOpenShiftClusters.ListByQuery(vars["subscriptionId"], q, skipToken)
 	})
 
 	reply(log, w, nil, b, err)
 }
 
-func (f *frontend) _getOpenShiftClusters(ctx context.Context, log *logrus.Entry, r *http.Request, converter api.OpenShiftClusterConverter, lister func(string) (cosmosdb.OpenShiftClusterDocumentIterator, error)) ([]byte, error) {
+func (f *frontend) _getOpenShiftClusters(ctx context.Context, log *logrus.Entry, r *http.Request, converter api.OpenShiftClusterConverter, lister func(*database.OpenShiftClustersQuery, string) (cosmosdb.OpenShiftClusterDocumentIterator, error)) ([]byte, error) {
 	skipToken, err := f.parseSkipToken(r.URL.String())
 	if err != nil {
 		return nil, err
 	}
 
-	i, err := lister(skipToken)
+	q, top, err := parseListQuery(r.URL.Query())
 	if err != nil {
 		return nil, err
 	}
 
-	docs, err := i.Next(ctx, 10)
+	i, err := lister(q, skipToken)
+	if err != nil {
+		return nil, err
+	}
+
+	docs, err := i.Next(ctx, top)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,254 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+)
+
+const (
+	defaultListPageSize = 10
+	maxListPageSize     = 100
+)
+
+// parseListQuery parses the OData $filter, $orderby and $top parameters of a
+// cluster list request.  The supported $filter grammar is a conjunction of
+// `<field> eq|ne '<value>'` and `startswith(<field>, '<value>')` terms.
+func parseListQuery(query url.Values) (*database.OpenShiftClustersQuery, int, error) {
+	q := &database.OpenShiftClustersQuery{}
+	top := defaultListPageSize
+
+	if filter := query.Get("$filter"); filter != "" {
+		conditions, err := parseListFilter(filter)
+		if err != nil {
+			return nil, 0, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "$filter", "The provided $filter '%s' is invalid: %s.", filter, err)
+		}
+		q.Conditions = conditions
+	}
+
+	if orderBy := query.Get("$orderby"); orderBy != "" {
+		fields := strings.Fields(orderBy)
+		if len(fields) == 0 || len(fields) > 2 || !database.IsValidOpenShiftClustersQueryField(fields[0]) {
+			return nil, 0, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "$orderby", "The provided $orderby '%s' is invalid.", orderBy)
+		}
+		q.OrderBy = fields[0]
+
+		if len(fields) == 2 {
+			switch strings.ToLower(fields[1]) {
+			case "asc":
+			case "desc":
+				q.Descending = true
+			default:
+				return nil, 0, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "$orderby", "The provided $orderby '%s' is invalid.", orderBy)
+			}
+		}
+	}
+
+	if s := query.Get("$top"); s != "" {
+		var err error
+		top, err = strconv.Atoi(s)
+		if err != nil || top < 1 || top > maxListPageSize {
+			return nil, 0, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "$top", "The provided $top '%s' is invalid: it must be between 1 and %d.", s, maxListPageSize)
+		}
+	}
+
+	return q, top, nil
+}
+
+func parseListFilter(filter string) ([]database.OpenShiftClustersQueryCondition, error) {
+	t := &filterTokenizer{s: filter}
+	var conditions []database.OpenShiftClustersQueryCondition
+
+	for {
+		c, err := t.condition()
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, *c)
+
+		tok, err := t.next()
+		if err != nil {
+			return nil, err
+		}
+		if tok == "" {
+			return conditions, nil
+		}
+		if !strings.EqualFold(tok, "and") {
+			return nil, errUnexpectedToken(tok)
+		}
+	}
+}
+
+type filterTokenizer struct {
+	s string
+}
+
+type errUnexpectedToken string
+
+func (err errUnexpectedToken) Error() string {
+	if err == "" {
+		return "unexpected end of filter"
+	}
+	return "unexpected token " + string(err)
+}
+
+// next returns the next token in the filter, or "" at the end.  String
+// literals are returned with their quotes.
+func (t *filterTokenizer) next() (string, error) {
+	t.s = strings.TrimLeft(t.s, " ")
+	if t.s == "" {
+		return "", nil
+	}
+
+	switch t.s[0] {
+	case '(', ')', ',':
+		tok := t.s[:1]
+		t.s = t.s[1:]
+		return tok, nil
+
+	case '\'':
+		for i := 1; i < len(t.s); i++ {
+			if t.s[i] != '\'' {
+				continue
+			}
+			if i+1 < len(t.s) && t.s[i+1] == '\'' { // escaped quote
+				i++
+				continue
+			}
+			tok := t.s[:i+1]
+			t.s = t.s[i+1:]
+			return tok, nil
+		}
+		return "", errUnexpectedToken(t.s)
+	}
+
+	i := strings.IndexAny(t.s, " (),'")
+	if i == -1 {
+		i = len(t.s)
+	}
+	tok := t.s[:i]
+	t.s = t.s[i:]
+	return tok, nil
+}
+
+func (t *filterTokenizer) expect(want string) error {
+	tok, err := t.next()
+	if err != nil {
+		return err
+	}
+	if tok != want {
+		return errUnexpectedToken(tok)
+	}
+	return nil
+}
+
+func (t *filterTokenizer) field() (string, error) {
+	tok, err := t.next()
+	if err != nil {
+		return "", err
+	}
+	if !database.IsValidOpenShiftClustersQueryField(tok) {
+		return "", errUnexpectedToken(tok)
+	}
+	return tok, nil
+}
+
+func (t *filterTokenizer) literal() (string, error) {
+	tok, err := t.next()
+	if err != nil {
+		return "", err
+	}
+	if len(tok) < 2 || tok[0] != '\'' {
+		return "", errUnexpectedToken(tok)
+	}
+	return strings.ReplaceAll(tok[1:len(tok)-1], "''", "'"), nil
+}
+
+func (t *filterTokenizer) condition() (*database.OpenShiftClustersQueryCondition, error) {
+	c := &database.OpenShiftClustersQueryCondition{}
+
+	tok, err := t.next()
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.EqualFold(tok, "startswith") {
+		c.Operator = database.OpenShiftClustersQueryOperatorStartsWith
+
+		err = t.expect("(")
+		if err != nil {
+			return nil, err
+		}
+
+		c.Field, err = t.field()
+		if err != nil {
+			return nil, err
+		}
+
+		err = t.expect(",")
+		if err != nil {
+			return nil, err
+		}
+
+		c.Value, err = t.literal()
+		if err != nil {
+			return nil, err
+		}
+
+		err = t.expect(")")
+		if err != nil {
+			return nil, err
+		}
+
+	} else {
+		if !database.IsValidOpenShiftClustersQueryField(tok) {
+			return nil, errUnexpectedToken(tok)
+		}
+		c.Field = tok
+
+		tok, err = t.next()
+		if err != nil {
+			return nil, err
+		}
+
+		switch strings.ToLower(tok) {
+		case "eq":
+			c.Operator = database.OpenShiftClustersQueryOperatorEq
+		case "ne":
+			c.Operator = database.OpenShiftClustersQueryOperatorNe
+		default:
+			return nil, errUnexpectedToken(tok)
+		}
+
+		c.Value, err = t.literal()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// provisioning states are stored in canonical case; accept any case from
+	// the caller
+	if strings.EqualFold(c.Field, "provisioningState") {
+		for _, s := range []api.ProvisioningState{
+			api.ProvisioningStateCreating,
+			api.ProvisioningStateUpdating,
+			api.ProvisioningStateAdminUpdating,
+			api.ProvisioningStateDeleting,
+			api.ProvisioningStateSucceeded,
+			api.ProvisioningStateFailed,
+		} {
+			if strings.EqualFold(c.Value, string(s)) {
+				c.Value = string(s)
+			}
+		}
+	}
+
+	return c, nil
+}
@@ -8,6 +8,8 @@ import (
 	"encoding/base64"
 	"fmt"
 	"net/http"
+	"net/url"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -15,6 +17,7 @@ import (
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	v20200430 "github.com/Azure/ARO-RP/pkg/api/v20200430"
+	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
 	"github.com/Azure/ARO-RP/pkg/metrics"
 	"github.com/Azure/ARO-RP/pkg/metrics/noop"
@@ -55,6 +58,7 @@ func TestListOpenShiftCluster(t *testing.T) {
 		fixture        func(*testdatabase.Fixture)
 		dbError        error
 		skipToken      string
+		query          string
 		wantEnriched   []string
 		wantStatusCode int
 		wantResponse   func() *v20200430.OpenShiftClusterList
@@ -155,6 +159,45 @@ func TestListOpenShiftCluster(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "request has $top",
+			fixture: func(f *testdatabase.Fixture) {
+				var docs []*api.OpenShiftClusterDocument
+				for i := 1; i <= 11; i++ {
+					docs = append(docs, makeDoc(i))
+				}
+				f.AddOpenShiftClusterDocuments(docs...)
+			},
+			query: "&%24top=2",
+			wantEnriched: []string{
+				testdatabase.GetResourcePath(mockSubID, "resourceName01"),
+				testdatabase.GetResourcePath(mockSubID, "resourceName02"),
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: func() *v20200430.OpenShiftClusterList {
+				return &v20200430.OpenShiftClusterList{
+					OpenShiftClusters: []*v20200430.OpenShiftCluster{
+						{
+							ID:   testdatabase.GetResourcePath(mockSubID, "resourceName01"),
+							Name: "resourceName01",
+							Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+						},
+						{
+							ID:   testdatabase.GetResourcePath(mockSubID, "resourceName02"),
+							Name: "resourceName02",
+							Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+						},
+					},
+					NextLink: "https://mockrefererhost/?%24skipToken=" + url.QueryEscape(base64.StdEncoding.EncodeToString([]byte("FAKE2"))),
+				}
+			},
+		},
+		{
+			name:           "invalid $filter",
+			query:          "&%24filter=clientSecret+eq+%27x%27",
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: InvalidParameter: $filter: The provided $filter 'clientSecret eq 'x'' is invalid: unexpected token clientSecret.`,
+		},
 		{
 			name:           "no clusters found in db",
 			wantStatusCode: http.StatusOK,
@@ -198,7 +241,7 @@ func TestListOpenShiftCluster(t *testing.T) {
 
 					aead := testdatabase.NewFakeAEAD()
 
-					f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, api.APIs, &noop.Noop{}, aead, nil, nil, func(log *logrus.Entry, dialer proxy.Dialer, m metrics.Interface) clusterdata.OpenShiftClusterEnricher {
+					f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.bulkActionsDatabase, api.APIs, &noop.Noop{}, aead, nil, nil, func(log *logrus.Entry, dialer proxy.Dialer, m metrics.Interface) clusterdata.OpenShiftClusterEnricher {
 						return ti.enricher
 					})
 					if err != nil {
@@ -208,7 +251,7 @@ func TestListOpenShiftCluster(t *testing.T) {
 					go f.Run(ctx, nil, nil)
 
 					resp, b, err := ti.request(http.MethodGet,
-						fmt.Sprintf("https://server%sproviders/Microsoft.RedHatOpenShift/openShiftClusters?api-version=2020-04-30&%%24skipToken=%s", listPrefix, tt.skipToken),
+						fmt.Sprintf("https://server%sproviders/Microsoft.RedHatOpenShift/openShiftClusters?api-version=2020-04-30&%%24skipToken=%s%s", listPrefix, tt.skipToken, tt.query),
 						http.Header{
 							"Referer": []string{"https://mockrefererhost/"},
 						}, nil)
@@ -235,3 +278,103 @@ func TestListOpenShiftCluster(t *testing.T) {
 		})
 	}
 }
+
+func TestParseListQuery(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		query   url.Values
+		want    *database.OpenShiftClustersQuery
+		wantTop int
+		wantErr string
+	}{
+		{
+			name:    "empty",
+			query:   url.Values{},
+			want:    &database.OpenShiftClustersQuery{},
+			wantTop: 10,
+		},
+		{
+			name: "filter, orderby and top",
+			query: url.Values{
+				"$filter":  []string{"provisioningState eq 'failed' and startswith(version, '4.6.') and tags/owner ne 'O''Brien'"},
+				"$orderby": []string{"name desc"},
+				"$top":     []string{"50"},
+			},
+			want: &database.OpenShiftClustersQuery{
+				Conditions: []database.OpenShiftClustersQueryCondition{
+					{Field: "provisioningState", Operator: database.OpenShiftClustersQueryOperatorEq, Value: "Failed"},
+					{Field: "version", Operator: database.OpenShiftClustersQueryOperatorStartsWith, Value: "4.6."},
+					{Field: "tags/owner", Operator: database.OpenShiftClustersQueryOperatorNe, Value: "O'Brien"},
+				},
+				OrderBy:    "name",
+				Descending: true,
+			},
+			wantTop: 50,
+		},
+		{
+			name: "unterminated literal",
+			query: url.Values{
+				"$filter": []string{"location eq 'eastus"},
+			},
+			wantErr: "400: InvalidParameter: $filter: The provided $filter 'location eq 'eastus' is invalid: unexpected token 'eastus.",
+		},
+		{
+			name: "missing value",
+			query: url.Values{
+				"$filter": []string{"location eq"},
+			},
+			wantErr: "400: InvalidParameter: $filter: The provided $filter 'location eq' is invalid: unexpected end of filter.",
+		},
+		{
+			name: "unsupported operator",
+			query: url.Values{
+				"$filter": []string{"location gt 'eastus'"},
+			},
+			wantErr: "400: InvalidParameter: $filter: The provided $filter 'location gt 'eastus'' is invalid: unexpected token gt.",
+		},
+		{
+			name: "or is not supported",
+			query: url.Values{
+				"$filter": []string{"location eq 'eastus' or location eq 'westus'"},
+			},
+			wantErr: "400: InvalidParameter: $filter: The provided $filter 'location eq 'eastus' or location eq 'westus'' is invalid: unexpected token or.",
+		},
+		{
+			name: "invalid orderby",
+			query: url.Values{
+				"$orderby": []string{"name sideways"},
+			},
+			wantErr: "400: InvalidParameter: $orderby: The provided $orderby 'name sideways' is invalid.",
+		},
+		{
+			name: "whitespace orderby",
+			query: url.Values{
+				"$orderby": []string{" "},
+			},
+			wantErr: "400: InvalidParameter: $orderby: The provided $orderby ' ' is invalid.",
+		},
+		{
+			name: "top too large",
+			query: url.Values{
+				"$top": []string{"1000"},
+			},
+			wantErr: "400: InvalidParameter: $top: The provided $top '1000' is invalid: it must be between 1 and 100.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			q, top, err := parseListQuery(tt.query)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(q, tt.want) {
+				t.Errorf("%#v", q)
+			}
+
+			if top != tt.wantTop {
+				t.Error(top)
+			}
+		})
+	}
+}
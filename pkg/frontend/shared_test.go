@@ -20,6 +20,7 @@ import (
 	"github.com/go-test/deep"
 	"github.com/golang/mock/gomock"
 	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/database"
@@ -63,6 +64,7 @@ type testInfra struct {
 	cli        *http.Client
 	enricher   clusterdata.TestEnricher
 	audit      *logrus.Entry
+	auditHook  *test.Hook
 	log        *logrus.Entry
 	fixture    *testdatabase.Fixture
 	checker    *testdatabase.Checker
@@ -75,6 +77,8 @@ type testInfra struct {
 	billingDatabase           database.Billing
 	subscriptionsClient       *cosmosdb.FakeSubscriptionDocumentClient
 	subscriptionsDatabase     database.Subscriptions
+	bulkActionsClient         *cosmosdb.FakeBulkActionDocumentClient
+	bulkActionsDatabase       database.BulkActions
 }
 
 func newTestInfra(t *testing.T) *testInfra {
@@ -100,7 +104,7 @@ func newTestInfra(t *testing.T) *testInfra {
 	_env.EXPECT().Listen().AnyTimes().Return(l, nil)
 	_env.EXPECT().FeatureIsSet(env.FeatureRequireD2sV3Workers).AnyTimes().Return(false)
 
-	_, auditEntry := testlog.NewAudit()
+	auditHook, auditEntry := testlog.NewAudit()
 	log := logrus.NewEntry(logrus.StandardLogger())
 
 	fixture := testdatabase.NewFixture()
@@ -116,6 +120,7 @@ func newTestInfra(t *testing.T) *testInfra {
 		fixture:    fixture,
 		checker:    checker,
 		audit:      auditEntry,
+		auditHook:  auditHook,
 		log:        log,
 		cli: &http.Client{
 			Transport: &http.Transport{
@@ -158,6 +163,12 @@ func (ti *testInfra) WithAsyncOperations() *testInfra {
 	return ti
 }
 
+func (ti *testInfra) WithBulkActions() *testInfra {
+	ti.bulkActionsDatabase, ti.bulkActionsClient = testdatabase.NewFakeBulkActions()
+	ti.fixture.WithBulkActions(ti.bulkActionsDatabase)
+	return ti
+}
+
 func (ti *testInfra) done() {
 	ti.controller.Finish()
 	ti.cli.CloseIdleConnections()
@@ -118,3 +118,23 @@ func validateAdminVMName(vmName string) error {
 
 	return nil
 }
+
+func validateAdminPod(namespace, name, container string) error {
+	if !utilnamespace.IsOpenShift(namespace) {
+		return api.NewCloudError(http.StatusForbidden, api.CloudErrorCodeForbidden, "", "Access to the provided namespace '%s' is forbidden.", namespace)
+	}
+
+	if namespace == "" || !rxKubernetesString.MatchString(namespace) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "", "The provided namespace '%s' is invalid.", namespace)
+	}
+
+	if name == "" || !rxKubernetesString.MatchString(name) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "", "The provided name '%s' is invalid.", name)
+	}
+
+	if !rxKubernetesString.MatchString(container) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "", "The provided container '%s' is invalid.", container)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+
+	"github.com/prometheus/common/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/util/portforward"
+)
+
+// aroOperatorMetricQueries summarise the operator metrics which the in-cluster
+// Prometheus scrapes via the operator's ServiceMonitor.  Counters are reported
+// over the last 10 minutes rather than since the operator started, so that
+// they show whether a controller is failing or flapping now; rate() and
+// increase() also take care of counter resets when the operator restarts.
+var aroOperatorMetricQueries = []struct {
+	metric string
+	query  string
+}{
+	{
+		metric: "arooperator.reconciles",
+		query:  `sum by (controller, result) (increase(controller_runtime_reconcile_total{namespace="openshift-azure-operator"}[10m])) > 0`,
+	},
+	{
+		metric: "arooperator.reconcile.errors",
+		query:  `sum by (controller) (increase(controller_runtime_reconcile_errors_total{namespace="openshift-azure-operator"}[10m])) > 0`,
+	},
+	{
+		metric: "arooperator.reconcile.duration",
+		query:  `1000 * sum by (controller) (rate(controller_runtime_reconcile_time_seconds_sum{namespace="openshift-azure-operator"}[10m])) / sum by (controller) (rate(controller_runtime_reconcile_time_seconds_count{namespace="openshift-azure-operator"}[10m]))`,
+	},
+	{
+		metric: "arooperator.controller.mode",
+		query:  `max by (controller, mode) (aro_operator_controller_mode{namespace="openshift-azure-operator"})`,
+	},
+	{
+		metric: "arooperator.workarounds",
+		query:  `max by (workaround, state) (aro_operator_workaround_state{namespace="openshift-azure-operator"})`,
+	},
+	{
+		metric: "arooperator.routefix.ready",
+		query:  `min(aro_operator_routefix_ready{namespace="openshift-azure-operator"})`,
+	},
+}
+
+// emitAroOperatorMetrics emits a summary of the operator controller metrics
+// from the in-cluster Prometheus.  It does nothing unless the operator's
+// ServiceMonitor is enabled, as otherwise Prometheus doesn't have them.
+func (mon *Monitor) emitAroOperatorMetrics(ctx context.Context) error {
+	cluster, err := mon.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if !cluster.Spec.Features.ServiceMonitors {
+		return nil
+	}
+
+	// all the queries share a single port-forward
+	hc := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+				return portforward.DialContext(ctx, mon.log, mon.restconfig, "openshift-monitoring", "prometheus-k8s-0", "9090")
+			},
+			MaxIdleConnsPerHost: 1,
+		},
+	}
+	defer hc.CloseIdleConnections()
+
+	return mon.emitAroOperatorMetricQueries(ctx, hc)
+}
+
+func (mon *Monitor) emitAroOperatorMetricQueries(ctx context.Context, hc *http.Client) error {
+	for _, q := range aroOperatorMetricQueries {
+		vector, err := queryPrometheus(ctx, hc, q.query)
+		if err != nil {
+			return err
+		}
+
+		for _, sample := range vector {
+			// e.g. the reconcile duration of a controller which didn't
+			// reconcile in the window
+			if math.IsNaN(float64(sample.Value)) {
+				continue
+			}
+
+			dims := map[string]string{}
+			for k, v := range sample.Metric {
+				dims[string(k)] = string(v)
+			}
+
+			mon.emitGauge(q.metric, int64(sample.Value), dims)
+		}
+	}
+
+	return nil
+}
+
+// queryPrometheus runs an instant query against the Prometheus API
+func queryPrometheus(ctx context.Context, hc *http.Client, query string) (model.Vector, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://prometheus-k8s-0:9090/api/v1/query?"+url.Values{"query": []string{query}}.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var qr struct {
+		Status string `json:"status"`
+		Error  string `json:"error"`
+		Data   struct {
+			ResultType string       `json:"resultType"`
+			Result     model.Vector `json:"result"`
+		} `json:"data"`
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&qr)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected response from Prometheus (status %d)", resp.StatusCode)
+	}
+
+	if qr.Status != "success" {
+		return nil, fmt.Errorf("query failed: %s", qr.Error)
+	}
+
+	return qr.Data.Result, nil
+}
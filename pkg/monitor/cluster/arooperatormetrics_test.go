@@ -0,0 +1,97 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+)
+
+func TestEmitAroOperatorMetricQueries(t *testing.T) {
+	ctx := context.Background()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := mock_metrics.NewMockInterface(controller)
+
+	mon := &Monitor{
+		m: m,
+	}
+
+	results := map[string]string{
+		"arooperator.reconciles":         `[{"metric":{"controller":"RouteFix","result":"success"},"value":[1609459200,"12"]}]`,
+		"arooperator.reconcile.errors":   `[{"metric":{"controller":"RouteFix"},"value":[1609459200,"3"]}]`,
+		"arooperator.reconcile.duration": `[{"metric":{"controller":"RouteFix"},"value":[1609459200,"125.5"]},{"metric":{"controller":"DNS"},"value":[1609459200,"NaN"]}]`,
+		"arooperator.controller.mode":    `[{"metric":{"controller":"RouteFix","mode":"Managed"},"value":[1609459200,"1"]}]`,
+		"arooperator.workarounds":        `[{"metric":{"workaround":"sysctl","state":"Applied"},"value":[1609459200,"1"]}]`,
+		"arooperator.routefix.ready":     `[{"metric":{},"value":[1609459200,"0"]}]`,
+	}
+
+	queries := map[string]string{}
+	for _, q := range aroOperatorMetricQueries {
+		queries[q.query] = results[q.metric]
+	}
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/query" {
+			http.NotFound(w, r)
+			return
+		}
+
+		result, ok := queries[r.URL.Query().Get("query")]
+		if !ok {
+			_, _ = w.Write([]byte(`{"status":"error","error":"unexpected query"}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":` + result + `}}`))
+	}))
+	defer s.Close()
+
+	hc := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "tcp", s.Listener.Addr().String())
+			},
+		},
+	}
+
+	m.EXPECT().EmitGauge("arooperator.reconciles", int64(12), map[string]string{
+		"controller": "RouteFix",
+		"result":     "success",
+	})
+
+	m.EXPECT().EmitGauge("arooperator.reconcile.errors", int64(3), map[string]string{
+		"controller": "RouteFix",
+	})
+
+	m.EXPECT().EmitGauge("arooperator.reconcile.duration", int64(125), map[string]string{
+		"controller": "RouteFix",
+	})
+
+	m.EXPECT().EmitGauge("arooperator.controller.mode", int64(1), map[string]string{
+		"controller": "RouteFix",
+		"mode":       "Managed",
+	})
+
+	m.EXPECT().EmitGauge("arooperator.workarounds", int64(1), map[string]string{
+		"workaround": "sysctl",
+		"state":      "Applied",
+	})
+
+	m.EXPECT().EmitGauge("arooperator.routefix.ready", int64(0), map[string]string{})
+
+	err := mon.emitAroOperatorMetricQueries(ctx, hc)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,76 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/util/ready"
+)
+
+var aroOperatorDeployments = map[string]struct{}{
+	"aro-operator-master": {},
+	"aro-operator-worker": {},
+}
+
+// collectAroOperatorStatus records the health reported by the ARO operator so
+// that it can be synced back to the cluster document
+func (mon *Monitor) collectAroOperatorStatus(ctx context.Context) error {
+	cluster, err := mon.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	aroDeployments, err := mon.listARODeployments(ctx)
+	if err != nil {
+		return err
+	}
+
+	status := &api.OperatorStatus{
+		Version: cluster.Status.OperatorVersion,
+	}
+
+	for _, c := range cluster.Status.Conditions {
+		status.Conditions = append(status.Conditions, api.OperatorCondition{
+			Type:   string(c.Type),
+			Status: string(c.Status),
+			Reason: string(c.Reason),
+		})
+	}
+	sort.Slice(status.Conditions, func(i, j int) bool { return status.Conditions[i].Type < status.Conditions[j].Type })
+
+	for _, c := range cluster.Status.Controllers {
+		status.Controllers = append(status.Controllers, api.OperatorController{
+			Name: c.Name,
+			Mode: string(c.Mode),
+		})
+	}
+
+	for _, d := range aroDeployments.Items {
+		if _, found := aroOperatorDeployments[d.Name]; !found {
+			continue
+		}
+
+		status.Deployments = append(status.Deployments, api.OperatorDeployment{
+			Name:  d.Name,
+			Ready: ready.DeploymentIsReady(&d),
+		})
+	}
+	sort.Slice(status.Deployments, func(i, j int) bool { return status.Deployments[i].Name < status.Deployments[j].Name })
+
+	mon.operatorStatus = status
+
+	return nil
+}
+
+// OperatorStatus returns the health reported by the ARO operator during the
+// last Monitor run, or nil if it could not be read
+func (mon *Monitor) OperatorStatus() *api.OperatorStatus {
+	return mon.operatorStatus
+}
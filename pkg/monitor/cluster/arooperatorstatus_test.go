@@ -0,0 +1,148 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+)
+
+func TestCollectAroOperatorStatus(t *testing.T) {
+	ctx := context.Background()
+
+	transitionTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cli := fake.NewSimpleClientset(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "aro-operator-worker",
+				Namespace:  "openshift-azure-operator",
+				Generation: 1,
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: to.Int32Ptr(1),
+			},
+			Status: appsv1.DeploymentStatus{
+				Replicas:           1,
+				AvailableReplicas:  1,
+				UpdatedReplicas:    1,
+				ObservedGeneration: 1,
+			},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "aro-operator-master",
+				Namespace:  "openshift-azure-operator",
+				Generation: 1,
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: to.Int32Ptr(1),
+			},
+			Status: appsv1.DeploymentStatus{
+				Replicas:            1,
+				UnavailableReplicas: 1,
+				ObservedGeneration:  1,
+			},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "other",
+				Namespace: "openshift-azure-operator",
+			},
+		},
+	)
+
+	arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arov1alpha1.SingletonClusterName,
+		},
+		Status: arov1alpha1.ClusterStatus{
+			OperatorVersion: "version",
+			Conditions: status.Conditions{
+				{
+					Type:               arov1alpha1.MachineValid,
+					Status:             corev1.ConditionTrue,
+					Reason:             "CheckDone",
+					LastTransitionTime: metav1.NewTime(transitionTime),
+				},
+				{
+					Type:               arov1alpha1.InternetReachableFromMaster,
+					Status:             corev1.ConditionFalse,
+					Reason:             "CheckFailed",
+					Message:            "failed",
+					LastTransitionTime: metav1.NewTime(transitionTime),
+				},
+			},
+			Controllers: []arov1alpha1.ControllerStatus{
+				{
+					Name: "Node",
+					Mode: arov1alpha1.ControllerModeDisabled,
+				},
+			},
+		},
+	})
+
+	mon := &Monitor{
+		cli:    cli,
+		arocli: arocli,
+	}
+
+	if mon.OperatorStatus() != nil {
+		t.Fatal("expected nil status before collection")
+	}
+
+	err := mon.collectAroOperatorStatus(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &api.OperatorStatus{
+		Version: "version",
+		Conditions: []api.OperatorCondition{
+			{
+				Type:   "InternetReachableFromMaster",
+				Status: "False",
+				Reason: "CheckFailed",
+			},
+			{
+				Type:   "MachineValid",
+				Status: "True",
+				Reason: "CheckDone",
+			},
+		},
+		Controllers: []api.OperatorController{
+			{
+				Name: "Node",
+				Mode: "Disabled",
+			},
+		},
+		Deployments: []api.OperatorDeployment{
+			{
+				Name:  "aro-operator-master",
+				Ready: false,
+			},
+			{
+				Name:  "aro-operator-worker",
+				Ready: true,
+			},
+		},
+	}
+
+	if got := mon.OperatorStatus(); !reflect.DeepEqual(got, want) {
+		t.Errorf("%#v", got)
+	}
+}
@@ -38,6 +38,8 @@ type Monitor struct {
 	m          metrics.Interface
 	arocli     aroclient.Interface
 
+	operatorStatus *api.OperatorStatus
+
 	// access below only via the helper functions in cache.go
 	cache struct {
 		cos   *configv1.ClusterOperatorList
@@ -121,6 +123,7 @@ func (mon *Monitor) Monitor(ctx context.Context) (errs []error) {
 	for _, f := range []func(context.Context) error{
 		mon.emitAroOperatorHeartbeat,
 		mon.emitAroOperatorConditions,
+		mon.collectAroOperatorStatus,
 		mon.emitClusterOperatorConditions,
 		mon.emitClusterOperatorVersions,
 		mon.emitClusterVersionConditions,
@@ -134,6 +137,7 @@ func (mon *Monitor) Monitor(ctx context.Context) (errs []error) {
 		mon.emitStatefulsetStatuses,
 		mon.emitJobConditions,
 		mon.emitSummary,
+		mon.emitAroOperatorMetrics,
 		mon.emitPrometheusAlerts, // at the end for now because it's the slowest/least reliable
 	} {
 		err = f(ctx)
@@ -42,6 +42,8 @@ type monitor struct {
 	lastBucketlist atomic.Value //time.Time
 	lastChangefeed atomic.Value //time.Time
 	startTime      time.Time
+
+	now func() time.Time
 }
 
 type Runnable interface {
@@ -66,6 +68,8 @@ func NewMonitor(log *logrus.Entry, dialer proxy.Dialer, dbMonitors database.Moni
 		buckets:     map[int]struct{}{},
 
 		startTime: time.Now(),
+
+		now: time.Now,
 	}
 }
 
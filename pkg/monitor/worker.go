@@ -5,6 +5,7 @@ package monitor
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"time"
 
@@ -18,6 +19,13 @@ import (
 	"github.com/Azure/ARO-RP/pkg/util/restconfig"
 )
 
+const (
+	operatorStatusMinSyncInterval = 10 * time.Minute
+	operatorStatusMaxSyncInterval = time.Hour
+)
+
+var errOperatorStatusInSync = errors.New("operator status in sync")
+
 // listBuckets reads our bucket allocation from the master
 func (mon *monitor) listBuckets(ctx context.Context) error {
 	buckets, err := mon.dbMonitors.ListBuckets(ctx)
@@ -205,4 +213,53 @@ func (mon *monitor) workOne(ctx context.Context, log *logrus.Entry, doc *api.Ope
 	}
 
 	c.Monitor(ctx)
+
+	err = mon.updateOperatorStatus(ctx, doc, c.OperatorStatus())
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+// updateOperatorStatus syncs the health reported by the ARO operator into the
+// cluster document.  To limit writes to the document, the status is written at
+// most every operatorStatusMinSyncInterval, and only if it has changed or was
+// last written more than operatorStatusMaxSyncInterval ago.
+func (mon *monitor) updateOperatorStatus(ctx context.Context, doc *api.OpenShiftClusterDocument, status *api.OperatorStatus) error {
+	if status == nil || !mon.operatorStatusNeedsSync(doc.OpenShiftCluster.Properties.OperatorStatus, status) {
+		return nil
+	}
+
+	// the cached document may be stale, so check again before writing
+	_, err := mon.dbOpenShiftClusters.Patch(ctx, doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		if !mon.operatorStatusNeedsSync(doc.OpenShiftCluster.Properties.OperatorStatus, status) {
+			return errOperatorStatusInSync
+		}
+
+		now := mon.now().UTC()
+
+		status := *status
+		status.LastSyncTime = &now
+		doc.OpenShiftCluster.Properties.OperatorStatus = &status
+		return nil
+	})
+	if err == errOperatorStatusInSync {
+		err = nil
+	}
+	return err
+}
+
+func (mon *monitor) operatorStatusNeedsSync(current, status *api.OperatorStatus) bool {
+	if current == nil || current.LastSyncTime == nil {
+		return true
+	}
+
+	age := mon.now().Sub(*current.LastSyncTime)
+	if age < operatorStatusMinSyncInterval {
+		return false
+	}
+
+	c := *current
+	c.LastSyncTime = nil
+
+	return age >= operatorStatusMaxSyncInterval || !reflect.DeepEqual(&c, status)
 }
@@ -0,0 +1,176 @@
+package monitor
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestUpdateOperatorStatus(t *testing.T) {
+	ctx := context.Background()
+
+	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName"
+
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	ago := func(d time.Duration) *time.Time {
+		t := now.Add(-d)
+		return &t
+	}
+
+	for _, tt := range []struct {
+		name        string
+		current     *api.OperatorStatus
+		status      *api.OperatorStatus
+		want        *api.OperatorStatus
+		wantPatched bool
+	}{
+		{
+			name:   "status not collected",
+			status: nil,
+			current: &api.OperatorStatus{
+				Version: "old",
+			},
+			want: &api.OperatorStatus{
+				Version: "old",
+			},
+		},
+		{
+			name: "status never synced",
+			status: &api.OperatorStatus{
+				Version: "version",
+			},
+			want: &api.OperatorStatus{
+				Version:      "version",
+				LastSyncTime: &now,
+			},
+			wantPatched: true,
+		},
+		{
+			name: "status unchanged",
+			current: &api.OperatorStatus{
+				Version:      "version",
+				LastSyncTime: ago(20 * time.Minute),
+			},
+			status: &api.OperatorStatus{
+				Version: "version",
+			},
+			want: &api.OperatorStatus{
+				Version:      "version",
+				LastSyncTime: ago(20 * time.Minute),
+			},
+		},
+		{
+			name: "status unchanged but stale",
+			current: &api.OperatorStatus{
+				Version:      "version",
+				LastSyncTime: ago(2 * time.Hour),
+			},
+			status: &api.OperatorStatus{
+				Version: "version",
+			},
+			want: &api.OperatorStatus{
+				Version:      "version",
+				LastSyncTime: &now,
+			},
+			wantPatched: true,
+		},
+		{
+			name: "status changed but recently synced",
+			current: &api.OperatorStatus{
+				Version:      "old",
+				LastSyncTime: ago(5 * time.Minute),
+			},
+			status: &api.OperatorStatus{
+				Version: "version",
+			},
+			want: &api.OperatorStatus{
+				Version:      "old",
+				LastSyncTime: ago(5 * time.Minute),
+			},
+		},
+		{
+			name: "status changed",
+			current: &api.OperatorStatus{
+				Version:      "old",
+				LastSyncTime: ago(20 * time.Minute),
+			},
+			status: &api.OperatorStatus{
+				Version: "version",
+				Deployments: []api.OperatorDeployment{
+					{
+						Name:  "aro-operator-master",
+						Ready: true,
+					},
+				},
+			},
+			want: &api.OperatorStatus{
+				Version: "version",
+				Deployments: []api.OperatorDeployment{
+					{
+						Name:  "aro-operator-master",
+						Ready: true,
+					},
+				},
+				LastSyncTime: &now,
+			},
+			wantPatched: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dbOpenShiftClusters, _ := testdatabase.NewFakeOpenShiftClusters()
+
+			fixture := testdatabase.NewFixture().WithOpenShiftClusters(dbOpenShiftClusters)
+			fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(resourceID),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID: resourceID,
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState: api.ProvisioningStateSucceeded,
+						OperatorStatus:    tt.current,
+					},
+				},
+			})
+
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err := dbOpenShiftClusters.Get(ctx, strings.ToLower(resourceID))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			mon := &monitor{
+				dbOpenShiftClusters: dbOpenShiftClusters,
+				now:                 func() time.Time { return now },
+			}
+
+			err = mon.updateOperatorStatus(ctx, doc, tt.status)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			updated, err := dbOpenShiftClusters.Get(ctx, strings.ToLower(resourceID))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(updated.OpenShiftCluster.Properties.OperatorStatus, tt.want) {
+				t.Errorf("%#v", updated.OpenShiftCluster.Properties.OperatorStatus)
+			}
+
+			if patched := updated.ETag != doc.ETag; patched != tt.wantPatched {
+				t.Error(patched)
+			}
+		})
+	}
+}
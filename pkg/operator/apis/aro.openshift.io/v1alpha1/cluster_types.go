@@ -6,6 +6,7 @@ package v1alpha1
 import (
 	"github.com/operator-framework/operator-sdk/pkg/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 const (
@@ -14,12 +15,13 @@ const (
 	InternetReachableFromWorker status.ConditionType = "InternetReachableFromWorker"
 	MachineValid                status.ConditionType = "MachineValid"
 	ServicePrincipalValid       status.ConditionType = "ServicePrincipalValid"
+	ClusterSpecSynced           status.ConditionType = "ClusterSpecSynced"
 )
 
 // AllConditionTypes is a operator conditions currently in use, any condition not in this list is not
 // added to the operator.status.conditions list
 func AllConditionTypes() []status.ConditionType {
-	return []status.ConditionType{InternetReachableFromMaster, InternetReachableFromWorker, MachineValid, ServicePrincipalValid}
+	return []status.ConditionType{InternetReachableFromMaster, InternetReachableFromWorker, MachineValid, ServicePrincipalValid, ClusterSpecSynced}
 }
 
 // ClusterChecksTypes represents checks performed on the cluster to verify basic functionality
@@ -32,13 +34,87 @@ type GenevaLoggingSpec struct {
 	ConfigVersion string `json:"configVersion,omitempty"`
 	// +kubebuilder:validation:Enum=DiagnosticsProd;Test
 	MonitoringGCSEnvironment string `json:"monitoringGCSEnvironment,omitempty"`
+
+	// SinkDestinations are the storage accounts and host names which the RP
+	// approves forwarding cluster logs to.  The operator reads them from the
+	// spec signed by the RP, so they cannot be extended in the cluster.
+	SinkDestinations []string `json:"sinkDestinations,omitempty"`
+
+	// Sinks are additional destinations which the cluster logs are forwarded
+	// to alongside Geneva.  They are set in the cluster, and a sink is only
+	// configured if its destination is in SinkDestinations.
+	Sinks []LogSink `json:"sinks,omitempty"`
+}
+
+// LogSink is a log forwarding destination.  Exactly one of AzureBlob, Syslog
+// and HTTP must be set.
+type LogSink struct {
+	// +kubebuilder:validation:Pattern:=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:MinItems=1
+	Logs []LogType `json:"logs"`
+
+	// SecretName is the name of a Secret in the openshift-azure-logging
+	// namespace whose "credential" key holds the storage account key for
+	// AzureBlob sinks, or the Authorization header value for HTTP sinks
+	SecretName string `json:"secretName,omitempty"`
+
+	AzureBlob *AzureBlobLogSink `json:"azureBlob,omitempty"`
+	Syslog    *SyslogLogSink    `json:"syslog,omitempty"`
+	HTTP      *HTTPLogSink      `json:"http,omitempty"`
+}
+
+// LogType is a class of cluster logs
+// +kubebuilder:validation:Enum=Audit;Journal
+type LogType string
+
+const (
+	// LogTypeAudit is the API server audit log
+	LogTypeAudit LogType = "Audit"
+	// LogTypeJournal is the systemd journal of each node
+	LogTypeJournal LogType = "Journal"
+)
+
+// AzureBlobLogSink appends logs to append blobs in an Azure storage account
+type AzureBlobLogSink struct {
+	StorageAccount string `json:"storageAccount"`
+	Container      string `json:"container"`
+}
+
+// SyslogLogSink sends logs to a syslog server in RFC 5424 format
+type SyslogLogSink struct {
+	Host string `json:"host"`
+
+	// Port defaults to 514
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int `json:"port,omitempty"`
+
+	// Mode defaults to TCP
+	// +kubebuilder:validation:Enum=TCP;UDP;TLS
+	Mode string `json:"mode,omitempty"`
+}
+
+// HTTPLogSink posts logs as JSON lines to an HTTPS endpoint
+type HTTPLogSink struct {
+	URL string `json:"url"`
 }
 
 type InternetCheckerSpec struct {
+	// URLs are the endpoints which the cluster must be able to reach.  If any
+	// of them cannot be reached, the InternetReachable conditions are false.
 	URLs []string `json:"urls,omitempty"`
+
+	// OptionalURLs are endpoints which the cluster may need to reach, for
+	// example for optional operators.  They are reported in the endpoint
+	// checks, but do not affect the InternetReachable conditions.
+	OptionalURLs []string `json:"optionalUrls,omitempty"`
 }
 
-// ClusterSpec defines the desired state of Cluster
+// ClusterSpec defines the desired state of Cluster.  It is set by the RP,
+// except for the fields copied by CopyClusterOwnedFields, which are set in the
+// cluster.
 type ClusterSpec struct {
 	// ResourceID is the Azure resourceId of the cluster
 	ResourceID      string              `json:"resourceId,omitempty"`
@@ -53,17 +129,250 @@ type ClusterSpec struct {
 	IngressIP       string              `json:"ingressIP,omitempty"`
 
 	Features FeaturesSpec `json:"features,omitempty"`
+
+	// Proxy is the outbound proxy the cluster sends its egress traffic
+	// through.  The operator applies it to the cluster Proxy configuration,
+	// the mdsd daemonset and its own checks.
+	Proxy ProxySpec `json:"proxy,omitempty"`
+
+	NodeRemediation NodeRemediationSpec `json:"nodeRemediation,omitempty"`
+
+	// Workarounds are workarounds shipped by the RP as data.  They are applied
+	// by the workaround controller alongside its built in workarounds.
+	Workarounds []WorkaroundSpec `json:"workarounds,omitempty"`
+}
+
+// CopyClusterOwnedFields copies the spec fields which are set in the cluster
+// rather than by the RP from src to dst: the controller modes and
+// ServiceMonitors feature gates, the node remediation policies and the log
+// sinks.  The RP does not sign or overwrite these fields, and the operator
+// does not treat changes to them as drift.
+func CopyClusterOwnedFields(dst, src *ClusterSpec) {
+	dst.Features.ServiceMonitors = src.Features.ServiceMonitors
+	dst.Features.Controllers = src.Features.Controllers
+	dst.NodeRemediation = src.NodeRemediation
+	dst.GenevaLogging.Sinks = src.GenevaLogging.Sinks
+}
+
+// WorkaroundSpec is a workaround for a known bug in a range of OpenShift
+// versions, made up of a set of manifests (e.g. MachineConfigs) which are
+// applied while the cluster is in the range and removed otherwise
+type WorkaroundSpec struct {
+	Name string `json:"name"`
+
+	// MinVersion is the first affected OpenShift version.  If empty, all
+	// versions before FixedVersion are affected.
+	MinVersion string `json:"minVersion,omitempty"`
+
+	// FixedVersion is the first OpenShift version which is no longer
+	// affected.  If empty, all versions from MinVersion are affected.
+	FixedVersion string `json:"fixedVersion,omitempty"`
+
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Manifests []runtime.RawExtension `json:"manifests,omitempty"`
+}
+
+// NodeRemediationSpec configures automatic remediation of unhealthy worker
+// nodes.  Remediation is off unless at least one policy is set.
+type NodeRemediationSpec struct {
+	Policies []NodeRemediationPolicy `json:"policies,omitempty"`
+
+	// MaxUnhealthy suspends remediation while more worker nodes than this are
+	// unhealthy.  Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	MaxUnhealthy int `json:"maxUnhealthy,omitempty"`
+
+	// MinInterval is the minimum time between two remediations.  Defaults to
+	// 10 minutes.
+	MinInterval metav1.Duration `json:"minInterval,omitempty"`
+}
+
+// NodeRemediationPolicy remediates a worker node with Action once it has been
+// in Condition for Timeout
+type NodeRemediationPolicy struct {
+	Name      string                   `json:"name"`
+	Condition NodeRemediationCondition `json:"condition"`
+	Timeout   metav1.Duration          `json:"timeout"`
+	Action    NodeRemediationAction    `json:"action"`
+}
+
+// NodeRemediationCondition is an unhealthy node state
+// +kubebuilder:validation:Enum=NotReady;ContainerRuntimeUnhealthy;DiskPressure;MemoryPressure;PIDPressure
+type NodeRemediationCondition string
+
+const (
+	// NodeRemediationConditionNotReady matches nodes whose Ready condition is
+	// False or Unknown
+	NodeRemediationConditionNotReady NodeRemediationCondition = "NotReady"
+	// NodeRemediationConditionContainerRuntimeUnhealthy matches nodes which
+	// are not ready because the kubelet reports the container runtime or PLEG
+	// as unhealthy
+	NodeRemediationConditionContainerRuntimeUnhealthy NodeRemediationCondition = "ContainerRuntimeUnhealthy"
+	NodeRemediationConditionDiskPressure              NodeRemediationCondition = "DiskPressure"
+	NodeRemediationConditionMemoryPressure            NodeRemediationCondition = "MemoryPressure"
+	NodeRemediationConditionPIDPressure               NodeRemediationCondition = "PIDPressure"
+)
+
+// NodeRemediationAction is the action taken on an unhealthy node
+// +kubebuilder:validation:Enum=Cordon;Drain;Replace
+type NodeRemediationAction string
+
+const (
+	// NodeRemediationActionCordon marks the node unschedulable
+	NodeRemediationActionCordon NodeRemediationAction = "Cordon"
+	// NodeRemediationActionDrain cordons the node and evicts its pods
+	NodeRemediationActionDrain NodeRemediationAction = "Drain"
+	// NodeRemediationActionReplace deletes the node's Machine so that its
+	// MachineSet creates a new one
+	NodeRemediationActionReplace NodeRemediationAction = "Replace"
+)
+
+// ProxySpec defines an outbound proxy
+type ProxySpec struct {
+	HTTPProxy  string `json:"httpProxy,omitempty"`
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+	NoProxy    string `json:"noProxy,omitempty"`
+
+	// TrustedCA is a PEM bundle of the CA certificates which an inspecting
+	// proxy signs its certificates with
+	TrustedCA string `json:"trustedCA,omitempty"`
 }
 
 // FeaturesSpec defines ARO operator feature gates
 type FeaturesSpec struct {
 	PersistentPrometheus bool `json:"persistentPrometheus,omitempty"`
+
+	// RevertSpecDrift makes the operator revert in-cluster edits of the
+	// Cluster spec to the spec last written by the RP.  Otherwise drift is
+	// only reported in the ClusterSpecSynced condition.
+	RevertSpecDrift bool `json:"revertSpecDrift,omitempty"`
+
+	// ServiceMonitors makes the monitoring controller create ServiceMonitors
+	// so that the in-cluster Prometheus scrapes the operator metrics
+	ServiceMonitors bool `json:"serviceMonitors,omitempty"`
+
+	// Controllers sets the mode of individual operator controllers, keyed by
+	// controller name.  Controllers which are not listed are enabled.
+	Controllers map[string]ControllerMode `json:"controllers,omitempty"`
+}
+
+// ControllerMode is the mode an operator controller runs in
+// +kubebuilder:validation:Enum=Enabled;Disabled;ObserveOnly
+type ControllerMode string
+
+const (
+	// ControllerModeEnabled controllers reconcile as normal
+	ControllerModeEnabled ControllerMode = "Enabled"
+	// ControllerModeDisabled controllers do not reconcile at all
+	ControllerModeDisabled ControllerMode = "Disabled"
+	// ControllerModeObserveOnly controllers reconcile, but all their writes
+	// except to status subresources are sent to the API server as dry runs
+	ControllerModeObserveOnly ControllerMode = "ObserveOnly"
+)
+
+// ControllerStatus reports the mode an operator controller is running in
+type ControllerStatus struct {
+	Name string         `json:"name"`
+	Mode ControllerMode `json:"mode"`
+}
+
+// WorkaroundState is the state of a workaround on the cluster
+// +kubebuilder:validation:Enum=Applied;NotRequired;Failed
+type WorkaroundState string
+
+const (
+	WorkaroundStateApplied     WorkaroundState = "Applied"
+	WorkaroundStateNotRequired WorkaroundState = "NotRequired"
+	WorkaroundStateFailed      WorkaroundState = "Failed"
+)
+
+// WorkaroundStatus reports the state of a workaround.  For workarounds from
+// spec.workarounds, Objects lists the objects which were applied so that they
+// can be removed if the workaround is later dropped.
+type WorkaroundStatus struct {
+	Name    string             `json:"name"`
+	State   WorkaroundState    `json:"state"`
+	Message string             `json:"message,omitempty"`
+	Objects []WorkaroundObject `json:"objects,omitempty"`
+}
+
+// WorkaroundObject identifies an object applied by a workaround
+type WorkaroundObject struct {
+	GroupKind string `json:"groupKind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// EndpointCheckResult is the outcome of checking egress to an endpoint
+// +kubebuilder:validation:Enum=Success;DNSFailure;TCPFailure;TLSInterception;TLSFailure;HTTPFailure
+type EndpointCheckResult string
+
+const (
+	EndpointCheckResultSuccess EndpointCheckResult = "Success"
+	// EndpointCheckResultDNSFailure means the endpoint's host name could not
+	// be resolved
+	EndpointCheckResultDNSFailure EndpointCheckResult = "DNSFailure"
+	// EndpointCheckResultTCPFailure means no TCP connection could be made to
+	// the endpoint
+	EndpointCheckResultTCPFailure EndpointCheckResult = "TCPFailure"
+	// EndpointCheckResultTLSInterception means the endpoint presented a
+	// certificate which is not trusted or does not match its host name,
+	// typically because a firewall is intercepting TLS
+	EndpointCheckResultTLSInterception EndpointCheckResult = "TLSInterception"
+	// EndpointCheckResultTLSFailure means the TLS handshake failed
+	EndpointCheckResultTLSFailure EndpointCheckResult = "TLSFailure"
+	// EndpointCheckResultHTTPFailure means the connection could be made but
+	// the HTTP request failed
+	EndpointCheckResultHTTPFailure EndpointCheckResult = "HTTPFailure"
+)
+
+// EndpointCheck reports the result of checking egress to an endpoint from
+// either the master or the worker nodes
+type EndpointCheck struct {
+	URL      string              `json:"url"`
+	Role     string              `json:"role"`
+	Optional bool                `json:"optional,omitempty"`
+	Result   EndpointCheckResult `json:"result"`
+	Message  string              `json:"message,omitempty"`
 }
 
 // ClusterStatus defines the observed state of Cluster
 type ClusterStatus struct {
-	OperatorVersion string            `json:"operatorVersion,omitempty"`
-	Conditions      status.Conditions `json:"conditions,omitempty"`
+	OperatorVersion string             `json:"operatorVersion,omitempty"`
+	Conditions      status.Conditions  `json:"conditions,omitempty"`
+	Controllers     []ControllerStatus `json:"controllers,omitempty"`
+	EndpointChecks  []EndpointCheck    `json:"endpointChecks,omitempty"`
+
+	LastNodeRemediation *NodeRemediation `json:"lastNodeRemediation,omitempty"`
+
+	Workarounds []WorkaroundStatus `json:"workarounds,omitempty"`
+
+	LogSinks []LogSinkStatus `json:"logSinks,omitempty"`
+}
+
+// LogSinkState is the state of a log sink on the cluster
+// +kubebuilder:validation:Enum=Configured;Invalid
+type LogSinkState string
+
+const (
+	LogSinkStateConfigured LogSinkState = "Configured"
+	LogSinkStateInvalid    LogSinkState = "Invalid"
+)
+
+// LogSinkStatus reports the state of a log sink.  Invalid sinks are not
+// configured; Message says why.
+type LogSinkStatus struct {
+	Name    string       `json:"name"`
+	State   LogSinkState `json:"state"`
+	Message string       `json:"message,omitempty"`
+}
+
+// NodeRemediation records a remediation of a worker node
+type NodeRemediation struct {
+	Node   string                `json:"node"`
+	Policy string                `json:"policy"`
+	Action NodeRemediationAction `json:"action"`
+	Time   metav1.Time           `json:"time"`
 }
 
 // +kubebuilder:object:root=true
@@ -9,6 +9,21 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureBlobLogSink) DeepCopyInto(out *AzureBlobLogSink) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBlobLogSink.
+func (in *AzureBlobLogSink) DeepCopy() *AzureBlobLogSink {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureBlobLogSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cluster) DeepCopyInto(out *Cluster) {
 	*out = *in
@@ -71,9 +86,18 @@ func (in *ClusterList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 	*out = *in
-	out.GenevaLogging = in.GenevaLogging
+	in.GenevaLogging.DeepCopyInto(&out.GenevaLogging)
 	in.InternetChecker.DeepCopyInto(&out.InternetChecker)
-	out.Features = in.Features
+	in.Features.DeepCopyInto(&out.Features)
+	out.Proxy = in.Proxy
+	in.NodeRemediation.DeepCopyInto(&out.NodeRemediation)
+	if in.Workarounds != nil {
+		in, out := &in.Workarounds, &out.Workarounds
+		*out = make([]WorkaroundSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSpec.
@@ -96,6 +120,33 @@ func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Controllers != nil {
+		in, out := &in.Controllers, &out.Controllers
+		*out = make([]ControllerStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.EndpointChecks != nil {
+		in, out := &in.EndpointChecks, &out.EndpointChecks
+		*out = make([]EndpointCheck, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastNodeRemediation != nil {
+		in, out := &in.LastNodeRemediation, &out.LastNodeRemediation
+		*out = new(NodeRemediation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Workarounds != nil {
+		in, out := &in.Workarounds, &out.Workarounds
+		*out = make([]WorkaroundStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LogSinks != nil {
+		in, out := &in.LogSinks, &out.LogSinks
+		*out = make([]LogSinkStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStatus.
@@ -108,9 +159,46 @@ func (in *ClusterStatus) DeepCopy() *ClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerStatus) DeepCopyInto(out *ControllerStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerStatus.
+func (in *ControllerStatus) DeepCopy() *ControllerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointCheck) DeepCopyInto(out *EndpointCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointCheck.
+func (in *EndpointCheck) DeepCopy() *EndpointCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeaturesSpec) DeepCopyInto(out *FeaturesSpec) {
 	*out = *in
+	if in.Controllers != nil {
+		in, out := &in.Controllers, &out.Controllers
+		*out = make(map[string]ControllerMode, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeaturesSpec.
@@ -126,6 +214,18 @@ func (in *FeaturesSpec) DeepCopy() *FeaturesSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GenevaLoggingSpec) DeepCopyInto(out *GenevaLoggingSpec) {
 	*out = *in
+	if in.SinkDestinations != nil {
+		in, out := &in.SinkDestinations, &out.SinkDestinations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make([]LogSink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenevaLoggingSpec.
@@ -138,6 +238,21 @@ func (in *GenevaLoggingSpec) DeepCopy() *GenevaLoggingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPLogSink) DeepCopyInto(out *HTTPLogSink) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPLogSink.
+func (in *HTTPLogSink) DeepCopy() *HTTPLogSink {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPLogSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InternetCheckerSpec) DeepCopyInto(out *InternetCheckerSpec) {
 	*out = *in
@@ -146,6 +261,11 @@ func (in *InternetCheckerSpec) DeepCopyInto(out *InternetCheckerSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.OptionalURLs != nil {
+		in, out := &in.OptionalURLs, &out.OptionalURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternetCheckerSpec.
@@ -157,3 +277,193 @@ func (in *InternetCheckerSpec) DeepCopy() *InternetCheckerSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogSink) DeepCopyInto(out *LogSink) {
+	*out = *in
+	if in.Logs != nil {
+		in, out := &in.Logs, &out.Logs
+		*out = make([]LogType, len(*in))
+		copy(*out, *in)
+	}
+	if in.AzureBlob != nil {
+		in, out := &in.AzureBlob, &out.AzureBlob
+		*out = new(AzureBlobLogSink)
+		**out = **in
+	}
+	if in.Syslog != nil {
+		in, out := &in.Syslog, &out.Syslog
+		*out = new(SyslogLogSink)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPLogSink)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogSink.
+func (in *LogSink) DeepCopy() *LogSink {
+	if in == nil {
+		return nil
+	}
+	out := new(LogSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogSinkStatus) DeepCopyInto(out *LogSinkStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogSinkStatus.
+func (in *LogSinkStatus) DeepCopy() *LogSinkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LogSinkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeRemediation) DeepCopyInto(out *NodeRemediation) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeRemediation.
+func (in *NodeRemediation) DeepCopy() *NodeRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeRemediationPolicy) DeepCopyInto(out *NodeRemediationPolicy) {
+	*out = *in
+	out.Timeout = in.Timeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeRemediationPolicy.
+func (in *NodeRemediationPolicy) DeepCopy() *NodeRemediationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeRemediationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeRemediationSpec) DeepCopyInto(out *NodeRemediationSpec) {
+	*out = *in
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]NodeRemediationPolicy, len(*in))
+		copy(*out, *in)
+	}
+	out.MinInterval = in.MinInterval
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeRemediationSpec.
+func (in *NodeRemediationSpec) DeepCopy() *NodeRemediationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeRemediationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxySpec) DeepCopyInto(out *ProxySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxySpec.
+func (in *ProxySpec) DeepCopy() *ProxySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyslogLogSink) DeepCopyInto(out *SyslogLogSink) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogLogSink.
+func (in *SyslogLogSink) DeepCopy() *SyslogLogSink {
+	if in == nil {
+		return nil
+	}
+	out := new(SyslogLogSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkaroundObject) DeepCopyInto(out *WorkaroundObject) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkaroundObject.
+func (in *WorkaroundObject) DeepCopy() *WorkaroundObject {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkaroundObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkaroundSpec) DeepCopyInto(out *WorkaroundSpec) {
+	*out = *in
+	if in.Manifests != nil {
+		in, out := &in.Manifests, &out.Manifests
+		*out = make([]runtime.RawExtension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkaroundSpec.
+func (in *WorkaroundSpec) DeepCopy() *WorkaroundSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkaroundSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkaroundStatus) DeepCopyInto(out *WorkaroundStatus) {
+	*out = *in
+	if in.Objects != nil {
+		in, out := &in.Objects, &out.Objects
+		*out = make([]WorkaroundObject, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkaroundStatus.
+func (in *WorkaroundStatus) DeepCopy() *WorkaroundStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkaroundStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -102,7 +102,7 @@ func (r *AlertWebhookReconciler) setAlertManagerWebhook(ctx context.Context, add
 }
 
 // SetupWithManager setup our manager
-func (r *AlertWebhookReconciler) SetupWithManager(mgr ctrl.Manager) error {
+func (r *AlertWebhookReconciler) SetupWithManager(mgr ctrl.Manager, gates *controllers.Gates) error {
 	r.log.Info("starting alertmanager sink")
 
 	isAlertManagerPredicate := predicate.NewPredicateFuncs(func(meta metav1.Object, object runtime.Object) bool {
@@ -112,5 +112,5 @@ func (r *AlertWebhookReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&corev1.Secret{}, builder.WithPredicates(isAlertManagerPredicate)).
 		Named(controllers.AlertwebhookControllerName).
-		Complete(r)
+		Complete(gates.Reconciler(controllers.AlertwebhookControllerName, r))
 }
@@ -77,7 +77,7 @@ func (r *CheckerController) Reconcile(request ctrl.Request) (ctrl.Result, error)
 }
 
 // SetupWithManager setup our manager
-func (r *CheckerController) SetupWithManager(mgr ctrl.Manager) error {
+func (r *CheckerController) SetupWithManager(mgr ctrl.Manager, gates *controllers.Gates) error {
 	aroClusterPredicate := predicate.NewPredicateFuncs(func(meta metav1.Object, object runtime.Object) bool {
 		return meta.GetName() == arov1alpha1.SingletonClusterName
 	})
@@ -90,5 +90,5 @@ func (r *CheckerController) SetupWithManager(mgr ctrl.Manager) error {
 		// equivalent to builder = builder.For(&machinev1beta1.Machine{}), but can't call For multiple times on one builder
 		builder = builder.Watches(&source.Kind{Type: &machinev1beta1.Machine{}}, &handler.EnqueueRequestForObject{})
 	}
-	return builder.Named(controllers.CheckerControllerName).Complete(r)
+	return builder.Named(controllers.CheckerControllerName).Complete(gates.Reconciler(controllers.CheckerControllerName, r))
 }
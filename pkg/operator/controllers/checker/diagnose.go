@@ -0,0 +1,83 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+type resolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+type dialer interface {
+	DialContext(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// diagnose works out why an HTTP request to rawurl failed with httpErr by
+// retracing the connection one stage at a time: DNS resolution, TCP connect
+// and (for https URLs) the TLS handshake.  If every stage succeeds, the
+// failure is attributed to HTTP.
+func (r *InternetChecker) diagnose(ctx context.Context, rawurl string, httpErr error) (arov1alpha1.EndpointCheckResult, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return arov1alpha1.EndpointCheckResultHTTPFailure, httpErr
+	}
+
+	host := u.Hostname()
+	port := u.Port()
+	if port == "" {
+		switch u.Scheme {
+		case "https":
+			port = "443"
+		default:
+			port = "80"
+		}
+	}
+
+	addrs, err := r.resolver.LookupHost(ctx, host)
+	if err != nil {
+		return arov1alpha1.EndpointCheckResultDNSFailure, fmt.Errorf("%s: %s", rawurl, err)
+	}
+
+	// dial the first resolved address so that a failure is attributable to
+	// TCP rather than to DNS
+	conn, err := r.dialer.DialContext(ctx, "tcp", net.JoinHostPort(addrs[0], port))
+	if err != nil {
+		return arov1alpha1.EndpointCheckResultTCPFailure, fmt.Errorf("%s: %s", rawurl, err)
+	}
+	defer conn.Close()
+
+	if u.Scheme != "https" {
+		return arov1alpha1.EndpointCheckResultHTTPFailure, httpErr
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		err = conn.SetDeadline(deadline)
+		if err != nil {
+			return arov1alpha1.EndpointCheckResultTCPFailure, fmt.Errorf("%s: %s", rawurl, err)
+		}
+	}
+
+	err = tls.Client(conn, &tls.Config{ServerName: host}).Handshake()
+	if err != nil {
+		var unknownAuthorityError x509.UnknownAuthorityError
+		var hostnameError x509.HostnameError
+		if errors.As(err, &unknownAuthorityError) || errors.As(err, &hostnameError) {
+			return arov1alpha1.EndpointCheckResultTLSInterception, fmt.Errorf("%s: %s", rawurl, err)
+		}
+
+		return arov1alpha1.EndpointCheckResultTLSFailure, fmt.Errorf("%s: %s", rawurl, err)
+	}
+
+	return arov1alpha1.EndpointCheckResultHTTPFailure, httpErr
+}
@@ -0,0 +1,106 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+)
+
+type fakeResolver map[string][]string
+
+func (r fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if addrs, found := r[host]; found {
+		return addrs, nil
+	}
+
+	return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+func TestDiagnose(t *testing.T) {
+	ctx := context.Background()
+
+	httpServer := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer httpServer.Close()
+
+	tlsServer := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer tlsServer.Close()
+
+	// find a port that nothing is listening on
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closedPort := l.Addr().(*net.TCPAddr).Port
+	l.Close()
+
+	port := func(s *httptest.Server) string {
+		return s.URL[strings.LastIndexByte(s.URL, ':')+1:]
+	}
+
+	httpErr := errors.New("http error")
+
+	for _, tt := range []struct {
+		name       string
+		url        string
+		wantResult arov1alpha1.EndpointCheckResult
+		wantErr    string
+	}{
+		{
+			name:       "dns failure",
+			url:        "https://unknown.example.com/",
+			wantResult: arov1alpha1.EndpointCheckResultDNSFailure,
+			wantErr:    "https://unknown.example.com/: lookup unknown.example.com: no such host",
+		},
+		{
+			name:       "tcp failure",
+			url:        "https://endpoint.example.com:" + strconv.Itoa(closedPort) + "/",
+			wantResult: arov1alpha1.EndpointCheckResultTCPFailure,
+		},
+		{
+			name:       "tls interception",
+			url:        "https://endpoint.example.com:" + port(tlsServer) + "/",
+			wantResult: arov1alpha1.EndpointCheckResultTLSInterception,
+		},
+		{
+			name:       "tls failure",
+			url:        "https://endpoint.example.com:" + port(httpServer) + "/",
+			wantResult: arov1alpha1.EndpointCheckResultTLSFailure,
+		},
+		{
+			name:       "http failure",
+			url:        "http://endpoint.example.com:" + port(httpServer) + "/",
+			wantResult: arov1alpha1.EndpointCheckResultHTTPFailure,
+			wantErr:    "http error",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &InternetChecker{
+				log: utillog.GetLogger(),
+				resolver: fakeResolver{
+					"endpoint.example.com": {"127.0.0.1"},
+				},
+				dialer: &net.Dialer{},
+			}
+
+			result, err := r.diagnose(ctx, tt.url, httpErr)
+			if result != tt.wantResult {
+				t.Errorf("got result %s, err %v", result, err)
+			}
+
+			if tt.wantErr != "" && err.Error() != tt.wantErr {
+				t.Error(err)
+			}
+		})
+	}
+}
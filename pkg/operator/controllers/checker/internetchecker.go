@@ -5,20 +5,28 @@ package checker
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"net"
 	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/operator-framework/operator-sdk/pkg/status"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
 
 	"github.com/Azure/ARO-RP/pkg/operator"
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
 	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/proxy"
 )
 
 // InternetChecker reconciles a Cluster object
@@ -26,6 +34,9 @@ type InternetChecker struct {
 	arocli aroclient.Interface
 	log    *logrus.Entry
 	role   string
+
+	resolver resolver
+	dialer   dialer
 }
 
 func NewInternetChecker(log *logrus.Entry, arocli aroclient.Interface, role string) *InternetChecker {
@@ -33,6 +44,9 @@ func NewInternetChecker(log *logrus.Entry, arocli aroclient.Interface, role stri
 		arocli: arocli,
 		log:    log,
 		role:   role,
+
+		resolver: net.DefaultResolver,
+		dialer:   &net.Dialer{},
 	}
 }
 
@@ -52,8 +66,21 @@ func (r *InternetChecker) Name() string {
 
 // Reconcile will keep checking that the cluster can connect to essential services.
 func (r *InternetChecker) Check(ctx context.Context) error {
+	instance, err := r.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	proxyFunc := proxy.Func(&instance.Spec.Proxy)
+
 	cli := &http.Client{
 		Transport: &http.Transport{
+			Proxy: proxyFunc,
+			// an inspecting proxy signs the certificates of the endpoints
+			// with its own CA
+			TLSClientConfig: &tls.Config{
+				RootCAs: proxy.RootCAs(&instance.Spec.Proxy),
+			},
 			// We set DisableKeepAlives for two reasons:
 			//
 			// 1. If we're talking HTTP/2 and the remote end blackholes traffic,
@@ -70,60 +97,124 @@ func (r *InternetChecker) Check(ctx context.Context) error {
 		},
 	}
 
-	instance, err := r.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	urls := append(append([]string{}, instance.Spec.InternetChecker.URLs...), instance.Spec.InternetChecker.OptionalURLs...)
+	checks := make([]arov1alpha1.EndpointCheck, len(urls))
+
+	var wg sync.WaitGroup
+	for i, url := range urls {
+		wg.Add(1)
+		go func(i int, urlToCheck string) {
+			defer wg.Done()
+			checks[i] = r.checkEndpoint(ctx, cli, proxyFunc, urlToCheck, time.Minute)
+			checks[i].Optional = i >= len(instance.Spec.InternetChecker.URLs)
+		}(i, url)
+	}
+	wg.Wait()
+
+	err = r.setEndpointChecks(ctx, checks)
 	if err != nil {
 		return err
 	}
 
-	ch := make(chan error)
-	checkCount := 0
-	for _, url := range instance.Spec.InternetChecker.URLs {
-		checkCount++
-		go func(urlToCheck string) {
-			ch <- r.checkWithRetry(cli, urlToCheck, time.Minute)
-		}(url)
+	condition := r.condition(checks, proxyFunc != nil)
+
+	err = controllers.SetCondition(ctx, r.arocli, condition, r.role)
+	if err != nil {
+		return err
+	}
+
+	if condition.Status != corev1.ConditionTrue {
+		return errRequeue
 	}
 
+	return nil
+}
+
+// condition returns the InternetReachable condition for the endpoint checks.
+// Failures to reach optional endpoints are only reported in the endpoint
+// checks.
+func (r *InternetChecker) condition(checks []arov1alpha1.EndpointCheck, proxied bool) *status.Condition {
 	sb := &strings.Builder{}
 	checkFailed := false
 
-	for i := 0; i < checkCount; i++ {
-		if err = <-ch; err != nil {
-			r.log.Infof("URL check failed with error %s", err)
-			fmt.Fprintf(sb, "%s\n", err)
-			checkFailed = true
+	for _, check := range checks {
+		if check.Result == arov1alpha1.EndpointCheckResultSuccess {
+			continue
 		}
+
+		if check.Optional {
+			r.log.Infof("optional URL check failed with %s: %s", check.Result, check.Message)
+			continue
+		}
+
+		r.log.Infof("URL check failed with %s: %s", check.Result, check.Message)
+		fmt.Fprintf(sb, "%s: %s\n", check.Result, check.Message)
+		checkFailed = true
 	}
 
-	var condition *status.Condition
+	// only the connection to the proxy is diagnosed, so what the proxy does
+	// with the traffic is not known
+	var proxyNote string
+	if proxied {
+		proxyNote = "Connections are made via the outbound proxy, so failures are diagnosed on the connection to the proxy only, and TLS interception by the proxy is not reported."
+	}
 
 	if checkFailed {
-		condition = &status.Condition{
+		return &status.Condition{
 			Type:    r.conditionType(),
 			Status:  corev1.ConditionFalse,
-			Message: sb.String(),
+			Message: sb.String() + proxyNote,
 			Reason:  "CheckFailed",
 		}
-	} else {
-		condition = &status.Condition{
-			Type:    r.conditionType(),
-			Status:  corev1.ConditionTrue,
-			Message: "Outgoing connection successful",
-			Reason:  "CheckDone",
-		}
+	}
 
+	message := "Outgoing connection successful"
+	if proxied {
+		message += ". " + proxyNote
 	}
 
-	err = controllers.SetCondition(ctx, r.arocli, condition, r.role)
-	if err != nil {
-		return err
+	return &status.Condition{
+		Type:    r.conditionType(),
+		Status:  corev1.ConditionTrue,
+		Message: message,
+		Reason:  "CheckDone",
 	}
+}
 
-	if checkFailed {
-		return errRequeue
+// checkEndpoint checks the URL and, if it cannot be reached, diagnoses at which
+// stage (DNS, TCP, TLS or HTTP) the connection is failing.  If the request
+// goes via an outbound proxy, it is the connection to the proxy which is
+// diagnosed.
+func (r *InternetChecker) checkEndpoint(ctx context.Context, client simpleHTTPClient, proxyFunc func(*http.Request) (*url.URL, error), url string, timeout time.Duration) arov1alpha1.EndpointCheck {
+	check := arov1alpha1.EndpointCheck{
+		URL:    url,
+		Role:   r.role,
+		Result: arov1alpha1.EndpointCheckResultSuccess,
 	}
 
-	return nil
+	err := r.checkWithRetry(client, url, timeout)
+	if err == nil {
+		return check
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout/6)
+	defer cancel()
+
+	diagnoseURL := url
+	if proxyFunc != nil {
+		req, err := http.NewRequest(http.MethodHead, url, nil)
+		if err == nil {
+			proxyURL, err := proxyFunc(req)
+			if err == nil && proxyURL != nil {
+				diagnoseURL = proxyURL.String()
+			}
+		}
+	}
+
+	check.Result, err = r.diagnose(ctx, diagnoseURL, err)
+	check.Message = err.Error()
+
+	return check
 }
 
 // check the URL, retrying a failed query a few times
@@ -163,6 +254,36 @@ func (r *InternetChecker) checkOnce(client simpleHTTPClient, url string, timeout
 	return nil
 }
 
+// setEndpointChecks replaces the endpoint checks for our role in the Cluster
+// status
+func (r *InternetChecker) setEndpointChecks(ctx context.Context, checks []arov1alpha1.EndpointCheck) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster, err := r.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		endpointChecks := make([]arov1alpha1.EndpointCheck, 0, len(cluster.Status.EndpointChecks)+len(checks))
+		for _, check := range cluster.Status.EndpointChecks {
+			if check.Role != r.role {
+				endpointChecks = append(endpointChecks, check)
+			}
+		}
+		endpointChecks = append(endpointChecks, checks...)
+
+		sort.SliceStable(endpointChecks, func(i, j int) bool { return endpointChecks[i].Role < endpointChecks[j].Role })
+
+		if reflect.DeepEqual(cluster.Status.EndpointChecks, endpointChecks) {
+			return nil
+		}
+
+		cluster.Status.EndpointChecks = endpointChecks
+
+		_, err = r.arocli.AroV1alpha1().Clusters().UpdateStatus(ctx, cluster, metav1.UpdateOptions{})
+		return err
+	})
+}
+
 func (r *InternetChecker) conditionType() (ctype status.ConditionType) {
 	switch r.role {
 	case operator.RoleMaster:
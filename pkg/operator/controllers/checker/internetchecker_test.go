@@ -10,10 +10,17 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"reflect"
 	"syscall"
 	"testing"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
 	utillog "github.com/Azure/ARO-RP/pkg/util/log"
 )
 
@@ -104,3 +111,186 @@ func TestInternetCheckerCheck(t *testing.T) {
 		})
 	}
 }
+
+func TestInternetCheckerCheckEndpoint(t *testing.T) {
+	ctx := context.Background()
+
+	r := &InternetChecker{
+		log:      utillog.GetLogger(),
+		role:     operator.RoleMaster,
+		resolver: fakeResolver{},
+		dialer:   &net.Dialer{},
+	}
+
+	check := r.checkEndpoint(ctx, &testClient{responses: []*fakeResponse{okResp}}, nil, urltocheck, 100*time.Millisecond)
+	if !reflect.DeepEqual(check, arov1alpha1.EndpointCheck{
+		URL:    urltocheck,
+		Role:   operator.RoleMaster,
+		Result: arov1alpha1.EndpointCheckResultSuccess,
+	}) {
+		t.Error(check)
+	}
+
+	check = r.checkEndpoint(ctx, &testClient{responses: []*fakeResponse{timedoutReq, timedoutReq, timedoutReq, timedoutReq, timedoutReq, timedoutReq}}, nil, urltocheck, 100*time.Millisecond)
+	if !reflect.DeepEqual(check, arov1alpha1.EndpointCheck{
+		URL:     urltocheck,
+		Role:    operator.RoleMaster,
+		Result:  arov1alpha1.EndpointCheckResultDNSFailure,
+		Message: urltocheck + ": lookup not-used-in-test.io: no such host",
+	}) {
+		t.Error(check)
+	}
+
+	// via a proxy, it is the connection to the proxy which is diagnosed
+	proxyFunc := func(*http.Request) (*url.URL, error) {
+		return url.Parse("http://proxy.example.com:3128")
+	}
+
+	check = r.checkEndpoint(ctx, &testClient{responses: []*fakeResponse{timedoutReq, timedoutReq, timedoutReq, timedoutReq, timedoutReq, timedoutReq}}, proxyFunc, urltocheck, 100*time.Millisecond)
+	if !reflect.DeepEqual(check, arov1alpha1.EndpointCheck{
+		URL:     urltocheck,
+		Role:    operator.RoleMaster,
+		Result:  arov1alpha1.EndpointCheckResultDNSFailure,
+		Message: "http://proxy.example.com:3128: lookup proxy.example.com: no such host",
+	}) {
+		t.Error(check)
+	}
+}
+
+func TestInternetCheckerCondition(t *testing.T) {
+	r := &InternetChecker{
+		log:  utillog.GetLogger(),
+		role: operator.RoleMaster,
+	}
+
+	for _, tt := range []struct {
+		name        string
+		checks      []arov1alpha1.EndpointCheck
+		proxied     bool
+		wantStatus  corev1.ConditionStatus
+		wantMessage string
+	}{
+		{
+			name: "success",
+			checks: []arov1alpha1.EndpointCheck{
+				{URL: "https://required/", Result: arov1alpha1.EndpointCheckResultSuccess},
+			},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "Outgoing connection successful",
+		},
+		{
+			name: "optional endpoint fails",
+			checks: []arov1alpha1.EndpointCheck{
+				{URL: "https://required/", Result: arov1alpha1.EndpointCheckResultSuccess},
+				{URL: "https://optional/", Optional: true, Result: arov1alpha1.EndpointCheckResultTCPFailure, Message: "optional failed"},
+			},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "Outgoing connection successful",
+		},
+		{
+			name: "required endpoint fails",
+			checks: []arov1alpha1.EndpointCheck{
+				{URL: "https://required/", Result: arov1alpha1.EndpointCheckResultTCPFailure, Message: "required failed"},
+				{URL: "https://optional/", Optional: true, Result: arov1alpha1.EndpointCheckResultTCPFailure, Message: "optional failed"},
+			},
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "TCPFailure: required failed\n",
+		},
+		{
+			name: "required endpoint fails via a proxy",
+			checks: []arov1alpha1.EndpointCheck{
+				{URL: "https://required/", Result: arov1alpha1.EndpointCheckResultTCPFailure, Message: "required failed"},
+			},
+			proxied:     true,
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "TCPFailure: required failed\nConnections are made via the outbound proxy, so failures are diagnosed on the connection to the proxy only, and TLS interception by the proxy is not reported.",
+		},
+		{
+			name: "success via a proxy",
+			checks: []arov1alpha1.EndpointCheck{
+				{URL: "https://required/", Result: arov1alpha1.EndpointCheckResultSuccess},
+			},
+			proxied:     true,
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "Outgoing connection successful. Connections are made via the outbound proxy, so failures are diagnosed on the connection to the proxy only, and TLS interception by the proxy is not reported.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			condition := r.condition(tt.checks, tt.proxied)
+
+			if condition.Type != arov1alpha1.InternetReachableFromMaster {
+				t.Error(condition.Type)
+			}
+			if condition.Status != tt.wantStatus {
+				t.Error(condition.Status)
+			}
+			if condition.Message != tt.wantMessage {
+				t.Errorf("%q", condition.Message)
+			}
+		})
+	}
+}
+
+func TestInternetCheckerSetEndpointChecks(t *testing.T) {
+	ctx := context.Background()
+
+	arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arov1alpha1.SingletonClusterName,
+		},
+		Status: arov1alpha1.ClusterStatus{
+			EndpointChecks: []arov1alpha1.EndpointCheck{
+				{
+					URL:    "https://old/",
+					Role:   operator.RoleMaster,
+					Result: arov1alpha1.EndpointCheckResultSuccess,
+				},
+				{
+					URL:    "https://worker/",
+					Role:   operator.RoleWorker,
+					Result: arov1alpha1.EndpointCheckResultTCPFailure,
+				},
+			},
+		},
+	})
+
+	r := &InternetChecker{
+		log:    utillog.GetLogger(),
+		arocli: arocli,
+		role:   operator.RoleMaster,
+	}
+
+	err := r.setEndpointChecks(ctx, []arov1alpha1.EndpointCheck{
+		{
+			URL:     "https://new/",
+			Role:    operator.RoleMaster,
+			Result:  arov1alpha1.EndpointCheckResultTLSInterception,
+			Message: "intercepted",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []arov1alpha1.EndpointCheck{
+		{
+			URL:     "https://new/",
+			Role:    operator.RoleMaster,
+			Result:  arov1alpha1.EndpointCheckResultTLSInterception,
+			Message: "intercepted",
+		},
+		{
+			URL:    "https://worker/",
+			Role:   operator.RoleWorker,
+			Result: arov1alpha1.EndpointCheckResultTCPFailure,
+		},
+	}
+	if !reflect.DeepEqual(cluster.Status.EndpointChecks, want) {
+		t.Error(cluster.Status.EndpointChecks)
+	}
+}
@@ -52,7 +52,7 @@ func NewReconciler(log *logrus.Entry, arocli aroclient.Interface, configcli conf
 }
 
 // SetupWithManager setup our manager
-func (r *ClusterOperatorAROReconciler) SetupWithManager(mgr ctrl.Manager) error {
+func (r *ClusterOperatorAROReconciler) SetupWithManager(mgr ctrl.Manager, gates *controllers.Gates) error {
 	aroClusterPredicate := predicate.NewPredicateFuncs(func(meta metav1.Object, object runtime.Object) bool {
 		return meta.GetName() == arov1alpha1.SingletonClusterName
 	})
@@ -61,7 +61,7 @@ func (r *ClusterOperatorAROReconciler) SetupWithManager(mgr ctrl.Manager) error
 		For(&arov1alpha1.Cluster{}, builder.WithPredicates(aroClusterPredicate)).
 		Owns(&configv1.ClusterOperator{}).
 		Named(controllers.ClusterOperatorAROName).
-		Complete(r)
+		Complete(gates.Reconciler(controllers.ClusterOperatorAROName, r))
 }
 
 func (r *ClusterOperatorAROReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
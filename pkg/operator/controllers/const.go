@@ -17,4 +17,9 @@ const (
 	DnsmasqMachineConfigControllerName     = "DnsmasqMachineConfig"
 	DnsmasqMachineConfigPoolControllerName = "DnsmasqMachineConfigPool"
 	NodeControllerName                     = "Node"
+	FeatureGatesControllerName             = "FeatureGates"
+	NodeRemediationControllerName          = "NodeRemediation"
+	ProxyControllerName                    = "Proxy"
+	SpecDriftControllerName                = "SpecDrift"
+	ServiceMonitorControllerName           = "ServiceMonitor"
 )
@@ -66,7 +66,7 @@ func (r *ClusterReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error)
 }
 
 // SetupWithManager setup our mananger
-func (r *ClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
+func (r *ClusterReconciler) SetupWithManager(mgr ctrl.Manager, gates *controllers.Gates) error {
 	aroClusterPredicate := predicate.NewPredicateFuncs(func(meta metav1.Object, object runtime.Object) bool {
 		return meta.GetName() == arov1alpha1.SingletonClusterName
 	})
@@ -74,7 +74,7 @@ func (r *ClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&arov1alpha1.Cluster{}, builder.WithPredicates(aroClusterPredicate)).
 		Named(controllers.DnsmasqClusterControllerName).
-		Complete(r)
+		Complete(gates.Reconciler(controllers.DnsmasqClusterControllerName, r))
 }
 
 func reconcileMachineConfigs(ctx context.Context, arocli aroclient.Interface, dh dynamichelper.Interface, roles ...string) error {
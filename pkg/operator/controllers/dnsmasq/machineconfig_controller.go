@@ -70,9 +70,9 @@ func (r *MachineConfigReconciler) Reconcile(request ctrl.Request) (ctrl.Result,
 }
 
 // SetupWithManager setup our mananger
-func (r *MachineConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+func (r *MachineConfigReconciler) SetupWithManager(mgr ctrl.Manager, gates *controllers.Gates) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&mcv1.MachineConfig{}).
 		Named(controllers.DnsmasqMachineConfigControllerName).
-		Complete(r)
+		Complete(gates.Reconciler(controllers.DnsmasqMachineConfigControllerName, r))
 }
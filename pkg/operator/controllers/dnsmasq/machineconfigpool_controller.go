@@ -61,9 +61,9 @@ func (r *MachineConfigPoolReconciler) Reconcile(request ctrl.Request) (ctrl.Resu
 }
 
 // SetupWithManager setup our mananger
-func (r *MachineConfigPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
+func (r *MachineConfigPoolReconciler) SetupWithManager(mgr ctrl.Manager, gates *controllers.Gates) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&mcv1.MachineConfigPool{}).
 		Named(controllers.DnsmasqMachineConfigPoolControllerName).
-		Complete(r)
+		Complete(gates.Reconciler(controllers.DnsmasqMachineConfigPoolControllerName, r))
 }
@@ -0,0 +1,88 @@
+package featuregates
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+)
+
+// FeatureGatesReconciler keeps the controller gates in step with
+// spec.features.controllers and, on the master, reports the mode of each
+// controller in status.controllers
+type FeatureGatesReconciler struct {
+	log    *logrus.Entry
+	arocli aroclient.Interface
+	gates  *controllers.Gates
+	role   string
+}
+
+func NewReconciler(log *logrus.Entry, arocli aroclient.Interface, gates *controllers.Gates, role string) *FeatureGatesReconciler {
+	return &FeatureGatesReconciler{
+		log:    log,
+		arocli: arocli,
+		gates:  gates,
+		role:   role,
+	}
+}
+
+// Reconcile updates the controller gates from the Cluster resource
+func (r *FeatureGatesReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): Reconcile will eventually be receiving a ctx (https://github.com/kubernetes-sigs/controller-runtime/blob/7ef2da0bc161d823f084ad21ff5f9c9bd6b0cc39/pkg/reconcile/reconcile.go#L93)
+	ctx := context.TODO()
+
+	instance, err := r.arocli.AroV1alpha1().Clusters().Get(ctx, request.Name, metav1.GetOptions{})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.gates.Update(instance)
+
+	if r.role != operator.RoleMaster {
+		return reconcile.Result{}, nil
+	}
+
+	return reconcile.Result{}, retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		instance, err := r.arocli.AroV1alpha1().Clusters().Get(ctx, request.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		status := r.gates.Status()
+		if reflect.DeepEqual(instance.Status.Controllers, status) {
+			return nil
+		}
+
+		instance.Status.Controllers = status
+
+		_, err = r.arocli.AroV1alpha1().Clusters().UpdateStatus(ctx, instance, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// SetupWithManager creates the controller
+func (r *FeatureGatesReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	aroClusterPredicate := predicate.NewPredicateFuncs(func(meta metav1.Object, object runtime.Object) bool {
+		return meta.GetName() == arov1alpha1.SingletonClusterName
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}, builder.WithPredicates(aroClusterPredicate)).
+		Named(controllers.FeatureGatesControllerName).
+		Complete(r)
+}
@@ -0,0 +1,90 @@
+package featuregates
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+)
+
+type noopReconciler struct{}
+
+func (noopReconciler) Reconcile(ctrl.Request) (ctrl.Result, error) {
+	return reconcile.Result{}, nil
+}
+
+func TestReconcile(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		role string
+		want []arov1alpha1.ControllerStatus
+	}{
+		{
+			name: "master reports status",
+			role: operator.RoleMaster,
+			want: []arov1alpha1.ControllerStatus{
+				{Name: controllers.NodeControllerName, Mode: arov1alpha1.ControllerModeDisabled},
+				{Name: controllers.RouteFixControllerName, Mode: arov1alpha1.ControllerModeEnabled},
+			},
+		},
+		{
+			name: "worker does not report status",
+			role: operator.RoleWorker,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					Features: arov1alpha1.FeaturesSpec{
+						Controllers: map[string]arov1alpha1.ControllerMode{
+							controllers.NodeControllerName: arov1alpha1.ControllerModeDisabled,
+						},
+					},
+				},
+			})
+
+			log := logrus.NewEntry(logrus.StandardLogger())
+			gates := controllers.NewGates(log, arocli)
+			gates.Reconciler(controllers.NodeControllerName, noopReconciler{})
+			gates.Reconciler(controllers.RouteFixControllerName, noopReconciler{})
+
+			r := NewReconciler(log, arocli, gates, tt.role)
+
+			_, err := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Name: arov1alpha1.SingletonClusterName}})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if mode := gates.Mode(controllers.NodeControllerName); mode != arov1alpha1.ControllerModeDisabled {
+				t.Error(mode)
+			}
+
+			instance, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(instance.Status.Controllers, tt.want) {
+				t.Error(instance.Status.Controllers)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package controllers
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/transport"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned"
+)
+
+// Gates tracks the mode each operator controller is configured to run in via
+// spec.features.controllers on the Cluster resource.  Controllers are
+// registered with Reconciler() and should build their clients with
+// RestConfig() so that both the disabled and observe-only modes take effect.
+type Gates struct {
+	log    *logrus.Entry
+	arocli aroclient.Interface
+
+	mu    sync.RWMutex
+	modes map[string]arov1alpha1.ControllerMode
+	names map[string]struct{}
+}
+
+func NewGates(log *logrus.Entry, arocli aroclient.Interface) *Gates {
+	return &Gates{
+		log:    log,
+		arocli: arocli,
+		modes:  map[string]arov1alpha1.ControllerMode{},
+		names:  map[string]struct{}{},
+	}
+}
+
+// Refresh reads the controller modes from the Cluster resource.  A missing
+// Cluster resource leaves every controller enabled.
+func (g *Gates) Refresh(ctx context.Context) error {
+	instance, err := g.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	g.Update(instance)
+	return nil
+}
+
+// Update sets the controller modes from the given Cluster resource
+func (g *Gates) Update(instance *arov1alpha1.Cluster) {
+	modes := map[string]arov1alpha1.ControllerMode{}
+	for name, mode := range instance.Spec.Features.Controllers {
+		switch mode {
+		case arov1alpha1.ControllerModeDisabled, arov1alpha1.ControllerModeObserveOnly:
+			modes[name] = mode
+		}
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for name := range g.names {
+		if g.mode(name) != modeOrEnabled(modes, name) {
+			g.log.Infof("controller %s mode changed to %s", name, modeOrEnabled(modes, name))
+		}
+	}
+
+	g.modes = modes
+
+	ControllerMode.Reset()
+	for name := range g.names {
+		ControllerMode.WithLabelValues(name, string(g.mode(name))).Set(1)
+	}
+}
+
+// Mode returns the mode controller name is running in
+func (g *Gates) Mode(name string) arov1alpha1.ControllerMode {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.mode(name)
+}
+
+func (g *Gates) mode(name string) arov1alpha1.ControllerMode {
+	return modeOrEnabled(g.modes, name)
+}
+
+func modeOrEnabled(modes map[string]arov1alpha1.ControllerMode, name string) arov1alpha1.ControllerMode {
+	if mode, found := modes[name]; found {
+		return mode
+	}
+
+	return arov1alpha1.ControllerModeEnabled
+}
+
+// Status returns the mode of each registered controller, sorted by name
+func (g *Gates) Status() []arov1alpha1.ControllerStatus {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	status := make([]arov1alpha1.ControllerStatus, 0, len(g.names))
+	for name := range g.names {
+		status = append(status, arov1alpha1.ControllerStatus{
+			Name: name,
+			Mode: g.mode(name),
+		})
+	}
+
+	sort.Slice(status, func(i, j int) bool { return status[i].Name < status[j].Name })
+
+	return status
+}
+
+// Reconciler registers controller name and wraps r so that it is skipped
+// while the controller is disabled
+func (g *Gates) Reconciler(name string, r reconcile.Reconciler) reconcile.Reconciler {
+	g.mu.Lock()
+	g.names[name] = struct{}{}
+	g.mu.Unlock()
+
+	return &gatedReconciler{
+		gates: g,
+		name:  name,
+		r:     r,
+	}
+}
+
+type gatedReconciler struct {
+	gates *Gates
+	name  string
+	r     reconcile.Reconciler
+}
+
+func (r *gatedReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	if r.gates.Mode(r.name) == arov1alpha1.ControllerModeDisabled {
+		r.gates.log.Debugf("controller %s is disabled, skipping %s", r.name, request)
+		return reconcile.Result{}, nil
+	}
+
+	return r.r.Reconcile(request)
+}
+
+// RestConfig returns a copy of restConfig whose mutating requests, other than
+// to status subresources, are sent as server-side dry runs while controller
+// name is observe-only
+func (g *Gates) RestConfig(name string, restConfig *rest.Config) *rest.Config {
+	restConfig = rest.CopyConfig(restConfig)
+	restConfig.WrapTransport = transport.Wrappers(restConfig.WrapTransport, func(rt http.RoundTripper) http.RoundTripper {
+		return &observeOnlyRoundTripper{
+			gates: g,
+			name:  name,
+			rt:    rt,
+		}
+	})
+
+	return restConfig
+}
+
+type observeOnlyRoundTripper struct {
+	gates *Gates
+	name  string
+	rt    http.RoundTripper
+}
+
+func (rt *observeOnlyRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return rt.rt.RoundTrip(req)
+	}
+
+	// status subresource writes are let through so that an observe-only
+	// controller still reports what it observed
+	if rt.gates.Mode(rt.name) != arov1alpha1.ControllerModeObserveOnly ||
+		strings.HasSuffix(req.URL.Path, "/status") {
+		return rt.rt.RoundTrip(req)
+	}
+
+	rt.gates.log.Infof("controller %s is observe-only, dry running %s %s", rt.name, req.Method, req.URL.Path)
+
+	req = req.Clone(req.Context())
+	q := req.URL.Query()
+	q.Set("dryRun", metav1.DryRunAll)
+	req.URL.RawQuery = q.Encode()
+
+	return rt.rt.RoundTrip(req)
+}
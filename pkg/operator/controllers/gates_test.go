@@ -0,0 +1,188 @@
+package controllers
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+)
+
+type fakeReconciler struct {
+	called bool
+}
+
+func (r *fakeReconciler) Reconcile(ctrl.Request) (ctrl.Result, error) {
+	r.called = true
+	return ctrl.Result{}, nil
+}
+
+func TestGates(t *testing.T) {
+	ctx := context.Background()
+
+	arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arov1alpha1.SingletonClusterName,
+		},
+		Spec: arov1alpha1.ClusterSpec{
+			Features: arov1alpha1.FeaturesSpec{
+				Controllers: map[string]arov1alpha1.ControllerMode{
+					"Disabled":    arov1alpha1.ControllerModeDisabled,
+					"ObserveOnly": arov1alpha1.ControllerModeObserveOnly,
+					"Invalid":     "invalid",
+				},
+			},
+		},
+	})
+
+	g := NewGates(logrus.NewEntry(logrus.StandardLogger()), arocli)
+
+	enabled := &fakeReconciler{}
+	disabled := &fakeReconciler{}
+	observeOnly := &fakeReconciler{}
+
+	for name, r := range map[string]*fakeReconciler{
+		"Enabled":     enabled,
+		"Disabled":    disabled,
+		"ObserveOnly": observeOnly,
+	} {
+		_, err := g.Reconciler(name, r).Reconcile(ctrl.Request{})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if !enabled.called || !disabled.called || !observeOnly.called {
+		t.Error("expected all controllers to run before refresh")
+	}
+
+	err := g.Refresh(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, r := range map[string]*fakeReconciler{
+		"Enabled":     enabled,
+		"Disabled":    disabled,
+		"ObserveOnly": observeOnly,
+	} {
+		r.called = false
+		_, err := g.Reconciler(name, r).Reconcile(ctrl.Request{})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if !enabled.called || disabled.called || !observeOnly.called {
+		t.Errorf("unexpected calls: enabled %v, disabled %v, observeOnly %v", enabled.called, disabled.called, observeOnly.called)
+	}
+
+	if mode := g.Mode("Invalid"); mode != arov1alpha1.ControllerModeEnabled {
+		t.Error(mode)
+	}
+
+	want := []arov1alpha1.ControllerStatus{
+		{Name: "Disabled", Mode: arov1alpha1.ControllerModeDisabled},
+		{Name: "Enabled", Mode: arov1alpha1.ControllerModeEnabled},
+		{Name: "ObserveOnly", Mode: arov1alpha1.ControllerModeObserveOnly},
+	}
+	if status := g.Status(); !reflect.DeepEqual(status, want) {
+		t.Error(status)
+	}
+}
+
+func TestGatesRefreshNotFound(t *testing.T) {
+	g := NewGates(logrus.NewEntry(logrus.StandardLogger()), arofake.NewSimpleClientset())
+
+	err := g.Refresh(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if mode := g.Mode("Node"); mode != arov1alpha1.ControllerModeEnabled {
+		t.Error(mode)
+	}
+}
+
+func TestGatesRestConfig(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		mode       arov1alpha1.ControllerMode
+		wantDryRun string
+	}{
+		{
+			name: "enabled",
+			mode: arov1alpha1.ControllerModeEnabled,
+		},
+		{
+			name:       "observe-only",
+			mode:       arov1alpha1.ControllerModeObserveOnly,
+			wantDryRun: metav1.DryRunAll,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotDryRun []string
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet {
+					gotDryRun = append(gotDryRun, r.URL.Query().Get("dryRun"))
+				} else if r.URL.Query().Get("dryRun") != "" {
+					t.Error("unexpected dryRun on GET")
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"test"}}`))
+			}))
+			defer s.Close()
+
+			g := NewGates(logrus.NewEntry(logrus.StandardLogger()), arofake.NewSimpleClientset())
+			g.Update(&arov1alpha1.Cluster{
+				Spec: arov1alpha1.ClusterSpec{
+					Features: arov1alpha1.FeaturesSpec{
+						Controllers: map[string]arov1alpha1.ControllerMode{
+							"Test": tt.mode,
+						},
+					},
+				},
+			})
+
+			cli, err := kubernetes.NewForConfig(g.RestConfig("Test", &rest.Config{Host: s.URL}))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ctx := context.Background()
+			ns, err := cli.CoreV1().Namespaces().Get(ctx, "test", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = cli.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = cli.CoreV1().Namespaces().Delete(ctx, "test", metav1.DeleteOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			// status writes are never dry run
+			_, err = cli.CoreV1().Namespaces().UpdateStatus(ctx, ns, metav1.UpdateOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(gotDryRun, []string{tt.wantDryRun, tt.wantDryRun, ""}) {
+				t.Error(gotDryRun)
+			}
+		})
+	}
+}
@@ -7,6 +7,12 @@ const (
 	kubeNamespace          = "openshift-azure-logging"
 	kubeServiceAccount     = "system:serviceaccount:" + kubeNamespace + ":geneva"
 	certificatesSecretName = "certificates"
+	trustedCABundleName    = "trusted-ca-bundle"
+	trustedCABundleKey     = "ca-bundle.crt"
+
+	// the cluster network operator keeps the cluster's trust bundle, including
+	// the CA of any inspecting proxy, in this namespace
+	managedTrustedCABundleNamespace = "openshift-config-managed"
 
 	ClusterLogsNamespace = "AROClusterLogs"
 	parsersConf          = `
@@ -103,7 +109,17 @@ const (
 
 [OUTPUT]
 	Name forward
-	Match *
+	Match journald
+	Port 24224
+
+[OUTPUT]
+	Name forward
+	Match containers
+	Port 24224
+
+[OUTPUT]
+	Name forward
+	Match audit
 	Port 24224
 `
 )
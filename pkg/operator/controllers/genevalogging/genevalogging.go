@@ -13,11 +13,13 @@ import (
 	securityv1 "github.com/openshift/api/security/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/proxy"
 	"github.com/Azure/ARO-RP/pkg/util/version"
 )
 
@@ -40,13 +42,13 @@ func (g *GenevaloggingReconciler) securityContextConstraints(ctx context.Context
 	return scc, nil
 }
 
-func (g *GenevaloggingReconciler) daemonset(cluster *arov1alpha1.Cluster) (*appsv1.DaemonSet, error) {
+func (g *GenevaloggingReconciler) daemonset(cluster *arov1alpha1.Cluster, sinkEnv []corev1.EnvVar) (*appsv1.DaemonSet, error) {
 	r, err := azure.ParseResourceID(cluster.Spec.ResourceID)
 	if err != nil {
 		return nil, err
 	}
 
-	return &appsv1.DaemonSet{
+	ds := &appsv1.DaemonSet{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "mdsd",
 			Namespace: kubeNamespace,
@@ -265,21 +267,103 @@ func (g *GenevaloggingReconciler) daemonset(cluster *arov1alpha1.Cluster) (*apps
 				},
 			},
 		},
+	}
+
+	// mdsd sends the logs to Geneva, so needs to go via any outbound proxy;
+	// fluentbit sends them to any additional sinks, using their credentials
+	for i, c := range ds.Spec.Template.Spec.Containers {
+		switch c.Name {
+		case "mdsd":
+			ds.Spec.Template.Spec.Containers[i].Env = append(c.Env, proxy.Env(&cluster.Spec.Proxy)...)
+		case "fluentbit":
+			ds.Spec.Template.Spec.Containers[i].Env = append(c.Env, sinkEnv...)
+		}
+	}
+
+	// an inspecting proxy signs the Geneva certificates with its own CA, so
+	// mdsd must trust the cluster's CA bundle, which includes it
+	if proxy.IsEnabled(&cluster.Spec.Proxy) && cluster.Spec.Proxy.TrustedCA != "" {
+		ds.Spec.Template.Spec.Volumes = append(ds.Spec.Template.Spec.Volumes, corev1.Volume{
+			Name: trustedCABundleName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: trustedCABundleName,
+					},
+				},
+			},
+		})
+
+		for i, c := range ds.Spec.Template.Spec.Containers {
+			if c.Name != "mdsd" {
+				continue
+			}
+
+			ds.Spec.Template.Spec.Containers[i].Env = append(ds.Spec.Template.Spec.Containers[i].Env, corev1.EnvVar{
+				Name:  "SSL_CERT_FILE",
+				Value: "/etc/mdsd.d/trusted-ca/" + trustedCABundleKey,
+			})
+			ds.Spec.Template.Spec.Containers[i].VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{
+				Name:      trustedCABundleName,
+				ReadOnly:  true,
+				MountPath: "/etc/mdsd.d/trusted-ca",
+			})
+		}
+	}
+
+	return ds, nil
+}
+
+// trustedCABundle returns a ConfigMap holding the CA bundle which mdsd trusts
+// when the cluster has an inspecting proxy.  This is a copy of the cluster's
+// managed trust bundle, which holds the system CAs together with the proxy's
+// CA.  Until the cluster has created it, only the proxy's CA is trusted.
+func (g *GenevaloggingReconciler) trustedCABundle(ctx context.Context, cluster *arov1alpha1.Cluster) (*corev1.ConfigMap, error) {
+	bundle := cluster.Spec.Proxy.TrustedCA
+
+	cm, err := g.kubernetescli.CoreV1().ConfigMaps(managedTrustedCABundleNamespace).Get(ctx, trustedCABundleName, metav1.GetOptions{})
+	switch {
+	case err == nil && cm.Data[trustedCABundleKey] != "":
+		bundle = cm.Data[trustedCABundleKey]
+	case err != nil && !kerrors.IsNotFound(err):
+		return nil, err
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      trustedCABundleName,
+			Namespace: kubeNamespace,
+		},
+		Data: map[string]string{
+			trustedCABundleKey: bundle,
+		},
 	}, nil
 }
 
-func (g *GenevaloggingReconciler) resources(ctx context.Context, cluster *arov1alpha1.Cluster, gcscert, gcskey []byte) ([]runtime.Object, error) {
+func (g *GenevaloggingReconciler) resources(ctx context.Context, cluster *arov1alpha1.Cluster, gcscert, gcskey []byte, sinks []arov1alpha1.LogSink) ([]runtime.Object, error) {
 	scc, err := g.securityContextConstraints(ctx, "privileged-genevalogging", kubeServiceAccount)
 	if err != nil {
 		return nil, err
 	}
 
-	daemonset, err := g.daemonset(cluster)
+	sinkConf, sinkEnv := sinkConfig(sinks)
+
+	daemonset, err := g.daemonset(cluster, sinkEnv)
 	if err != nil {
 		return nil, err
 	}
 
-	return []runtime.Object{
+	var resources []runtime.Object
+	if proxy.IsEnabled(&cluster.Spec.Proxy) && cluster.Spec.Proxy.TrustedCA != "" {
+		cm, err := g.trustedCABundle(ctx, cluster)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, cm)
+	}
+
+	return append(resources,
 		&corev1.Namespace{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:        kubeNamespace,
@@ -302,7 +386,7 @@ func (g *GenevaloggingReconciler) resources(ctx context.Context, cluster *arov1a
 				Namespace: kubeNamespace,
 			},
 			Data: map[string]string{
-				"fluent.conf":  fluentConf,
+				"fluent.conf":  fluentConf + sinkConf,
 				"parsers.conf": parsersConf,
 			},
 		},
@@ -314,5 +398,5 @@ func (g *GenevaloggingReconciler) resources(ctx context.Context, cluster *arov1a
 		},
 		scc,
 		daemonset,
-	}, nil
+	), nil
 }
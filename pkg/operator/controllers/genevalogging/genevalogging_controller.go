@@ -5,44 +5,58 @@ package genevalogging
 
 import (
 	"context"
+	"crypto/rsa"
+	"fmt"
+	"reflect"
+	"strings"
 
 	securityv1 "github.com/openshift/api/security/v1"
 	securityclient "github.com/openshift/client-go/security/clientset/versioned"
 	"github.com/sirupsen/logrus"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/Azure/ARO-RP/pkg/operator"
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
 	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/specdrift"
 	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
 )
 
-// GenevaloggingReconciler reconciles a Cluster object
+// GenevaloggingReconciler reconciles a Cluster object.  key is the RP's
+// public key, used to verify the log sink destinations which it approves (see
+// specdrift.PublicKeyEnv).
 type GenevaloggingReconciler struct {
 	kubernetescli kubernetes.Interface
 	securitycli   securityclient.Interface
 	arocli        aroclient.Interface
 	restConfig    *rest.Config
 	log           *logrus.Entry
+	key           *rsa.PublicKey
 }
 
-func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, securitycli securityclient.Interface, arocli aroclient.Interface, restConfig *rest.Config) *GenevaloggingReconciler {
+func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, securitycli securityclient.Interface, arocli aroclient.Interface, restConfig *rest.Config, key *rsa.PublicKey) *GenevaloggingReconciler {
 	return &GenevaloggingReconciler{
 		securitycli:   securitycli,
 		kubernetescli: kubernetescli,
 		arocli:        arocli,
 		restConfig:    restConfig,
 		log:           log,
+		key:           key,
 	}
 }
 
@@ -70,7 +84,13 @@ func (r *GenevaloggingReconciler) Reconcile(request ctrl.Request) (ctrl.Result,
 		return reconcile.Result{}, err
 	}
 
-	resources, err := r.resources(ctx, instance, mysec.Data[GenevaCertName], mysec.Data[GenevaKeyName])
+	sinks, statuses, err := r.validSinks(ctx, instance, r.sinkDestinations(mysec))
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	resources, err := r.resources(ctx, instance, mysec.Data[GenevaCertName], mysec.Data[GenevaKeyName], sinks)
 	if err != nil {
 		r.log.Error(err)
 		return reconcile.Result{}, err
@@ -94,17 +114,136 @@ func (r *GenevaloggingReconciler) Reconcile(request ctrl.Request) (ctrl.Result,
 		return reconcile.Result{}, err
 	}
 
+	err = r.updateStatus(ctx, statuses)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
 	return reconcile.Result{}, nil
 }
 
+// sinkDestinations returns the log sink destinations approved by the RP.  They
+// are read from the desired spec signed by the RP rather than from the Cluster
+// spec, which can be changed in the cluster.  If the desired spec cannot be
+// verified, no destinations are approved.
+func (r *GenevaloggingReconciler) sinkDestinations(s *corev1.Secret) map[string]bool {
+	b, sig := s.Data[specdrift.DesiredSpecName], s.Data[specdrift.DesiredSpecSignatureName]
+	if b == nil {
+		return nil
+	}
+
+	if r.key == nil {
+		r.log.Warn("no public key found to verify the desired spec, no log sink destinations are approved")
+		return nil
+	}
+
+	spec, err := specdrift.Verify(r.key, b, sig)
+	if err != nil {
+		r.log.Warnf("no log sink destinations are approved: %v", err)
+		return nil
+	}
+
+	destinations := map[string]bool{}
+	for _, d := range spec.GenevaLogging.SinkDestinations {
+		destinations[strings.ToLower(d)] = true
+	}
+
+	return destinations
+}
+
+// validSinks returns the log sinks in the Cluster spec which are valid and
+// sent to an approved destination, and the status of each sink.  Invalid sinks
+// are left out of the configuration rather than failing the reconcile, so that
+// they cannot stop logs from reaching Geneva.
+func (r *GenevaloggingReconciler) validSinks(ctx context.Context, instance *arov1alpha1.Cluster, destinations map[string]bool) ([]arov1alpha1.LogSink, []arov1alpha1.LogSinkStatus, error) {
+	var sinks []arov1alpha1.LogSink
+	var statuses []arov1alpha1.LogSinkStatus
+	names := map[string]struct{}{}
+
+	for _, sink := range instance.Spec.GenevaLogging.Sinks {
+		var secret *corev1.Secret
+		if sink.SecretName != "" {
+			s, err := r.kubernetescli.CoreV1().Secrets(kubeNamespace).Get(ctx, sink.SecretName, metav1.GetOptions{})
+			switch {
+			case err == nil:
+				secret = s
+			case !kerrors.IsNotFound(err):
+				return nil, nil, err
+			}
+		}
+
+		err := validateSink(&sink, secret)
+		if err == nil && !destinations[sinkDestination(&sink)] {
+			err = fmt.Errorf("destination %q is not approved by the RP", sinkDestination(&sink))
+		}
+		if err == nil {
+			if _, found := names[sink.Name]; found {
+				err = fmt.Errorf("duplicate name %q", sink.Name)
+			}
+		}
+		names[sink.Name] = struct{}{}
+
+		if err != nil {
+			r.log.Warnf("log sink %s: %v", sink.Name, err)
+			statuses = append(statuses, arov1alpha1.LogSinkStatus{
+				Name:    sink.Name,
+				State:   arov1alpha1.LogSinkStateInvalid,
+				Message: err.Error(),
+			})
+			continue
+		}
+
+		sinks = append(sinks, sink)
+		statuses = append(statuses, arov1alpha1.LogSinkStatus{
+			Name:  sink.Name,
+			State: arov1alpha1.LogSinkStateConfigured,
+		})
+	}
+
+	return sinks, statuses, nil
+}
+
+func (r *GenevaloggingReconciler) updateStatus(ctx context.Context, statuses []arov1alpha1.LogSinkStatus) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		instance, err := r.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if reflect.DeepEqual(instance.Status.LogSinks, statuses) {
+			return nil
+		}
+
+		instance.Status.LogSinks = statuses
+
+		_, err = r.arocli.AroV1alpha1().Clusters().UpdateStatus(ctx, instance, metav1.UpdateOptions{})
+		return err
+	})
+}
+
 // SetupWithManager setup our manager
-func (r *GenevaloggingReconciler) SetupWithManager(mgr ctrl.Manager) error {
+func (r *GenevaloggingReconciler) SetupWithManager(mgr ctrl.Manager, gates *controllers.Gates) error {
 	aroClusterPredicate := predicate.NewPredicateFuncs(func(meta metav1.Object, object runtime.Object) bool {
 		return meta.GetName() == arov1alpha1.SingletonClusterName
 	})
 
+	// reconcile the Cluster when a log sink's Secret is changed
+	sinkSecretHandler := &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(o handler.MapObject) []reconcile.Request {
+			if o.Meta.GetNamespace() != kubeNamespace {
+				return nil
+			}
+
+			return []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Name: arov1alpha1.SingletonClusterName}},
+			}
+		}),
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&arov1alpha1.Cluster{}, builder.WithPredicates(aroClusterPredicate)).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, sinkSecretHandler).
 		Owns(&appsv1.DaemonSet{}).
 		Owns(&corev1.ConfigMap{}).
 		Owns(&corev1.Namespace{}).
@@ -112,5 +251,5 @@ func (r *GenevaloggingReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&corev1.ServiceAccount{}).
 		Owns(&securityv1.SecurityContextConstraints{}).
 		Named(controllers.GenevaLoggingControllerName).
-		Complete(r)
+		Complete(gates.Reconciler(controllers.GenevaLoggingControllerName, r))
 }
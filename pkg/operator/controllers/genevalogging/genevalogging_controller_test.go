@@ -0,0 +1,179 @@
+package genevalogging
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"reflect"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/specdrift"
+)
+
+func TestSinkDestinations(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spec, sig, err := specdrift.Sign(key, &arov1alpha1.ClusterSpec{
+		GenevaLogging: arov1alpha1.GenevaLoggingSpec{
+			SinkDestinations: []string{"account", "Logs.example.com"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name string
+		key  *rsa.PublicKey
+		data map[string][]byte
+		want map[string]bool
+	}{
+		{
+			name: "approved",
+			key:  &key.PublicKey,
+			data: map[string][]byte{
+				specdrift.DesiredSpecName:          spec,
+				specdrift.DesiredSpecSignatureName: sig,
+			},
+			want: map[string]bool{
+				"account":          true,
+				"logs.example.com": true,
+			},
+		},
+		{
+			name: "no desired spec",
+			key:  &key.PublicKey,
+		},
+		{
+			name: "no public key",
+			data: map[string][]byte{
+				specdrift.DesiredSpecName:          spec,
+				specdrift.DesiredSpecSignatureName: sig,
+			},
+		},
+		{
+			name: "signed with another key",
+			key:  &otherKey.PublicKey,
+			data: map[string][]byte{
+				specdrift.DesiredSpecName:          spec,
+				specdrift.DesiredSpecSignatureName: sig,
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &GenevaloggingReconciler{
+				log: logrus.NewEntry(logrus.StandardLogger()),
+				key: tt.key,
+			}
+
+			got := r.sinkDestinations(&corev1.Secret{Data: tt.data})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Error(got)
+			}
+		})
+	}
+}
+
+func TestValidSinks(t *testing.T) {
+	ctx := context.Background()
+
+	r := &GenevaloggingReconciler{
+		log: logrus.NewEntry(logrus.StandardLogger()),
+		kubernetescli: fake.NewSimpleClientset(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "blob",
+				Namespace: kubeNamespace,
+			},
+			Data: map[string][]byte{
+				SinkCredentialName: []byte("key"),
+			},
+		}),
+	}
+
+	blob := arov1alpha1.LogSink{
+		Name:       "blob",
+		Logs:       []arov1alpha1.LogType{arov1alpha1.LogTypeAudit},
+		SecretName: "blob",
+		AzureBlob: &arov1alpha1.AzureBlobLogSink{
+			StorageAccount: "account",
+			Container:      "audit",
+		},
+	}
+
+	instance := &arov1alpha1.Cluster{
+		Spec: arov1alpha1.ClusterSpec{
+			GenevaLogging: arov1alpha1.GenevaLoggingSpec{
+				Sinks: []arov1alpha1.LogSink{
+					blob,
+					{
+						Name: "syslog",
+						Logs: []arov1alpha1.LogType{arov1alpha1.LogTypeJournal},
+						Syslog: &arov1alpha1.SyslogLogSink{
+							Host: "syslog.example.com",
+						},
+					},
+					{
+						Name:       "http",
+						Logs:       []arov1alpha1.LogType{arov1alpha1.LogTypeAudit},
+						SecretName: "missing",
+						HTTP: &arov1alpha1.HTTPLogSink{
+							URL: "https://logs.example.com/",
+						},
+					},
+					blob,
+				},
+			},
+		},
+	}
+
+	sinks, statuses, err := r.validSinks(ctx, instance, map[string]bool{"account": true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(sinks, []arov1alpha1.LogSink{blob}) {
+		t.Error(sinks)
+	}
+
+	wantStatuses := []arov1alpha1.LogSinkStatus{
+		{
+			Name:  "blob",
+			State: arov1alpha1.LogSinkStateConfigured,
+		},
+		{
+			Name:    "syslog",
+			State:   arov1alpha1.LogSinkStateInvalid,
+			Message: `destination "syslog.example.com" is not approved by the RP`,
+		},
+		{
+			Name:    "http",
+			State:   arov1alpha1.LogSinkStateInvalid,
+			Message: "secret openshift-azure-logging/missing not found",
+		},
+		{
+			Name:    "blob",
+			State:   arov1alpha1.LogSinkStateInvalid,
+			Message: `duplicate name "blob"`,
+		},
+	}
+	if !reflect.DeepEqual(statuses, wantStatuses) {
+		t.Error(statuses)
+	}
+}
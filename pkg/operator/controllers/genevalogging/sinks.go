@@ -0,0 +1,232 @@
+package genevalogging
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+// SinkCredentialName is the key of the credential in a sink's Secret
+const SinkCredentialName = "credential"
+
+// Values which are written into the fluent-bit configuration are restricted
+// so that a sink cannot inject configuration of its own
+var (
+	rxSinkName       = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+	rxHost           = regexp.MustCompile(`^[A-Za-z0-9]([-.A-Za-z0-9]*[A-Za-z0-9])?$`)
+	rxStorageAccount = regexp.MustCompile(`^[a-z0-9]{3,24}$`)
+	rxContainer      = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{1,61}[a-z0-9])$`)
+	rxURI            = regexp.MustCompile(`^/[!-~]*$`)
+)
+
+// tags are the fluent-bit tags of the inputs for each log type
+var tags = map[arov1alpha1.LogType]string{
+	arov1alpha1.LogTypeAudit:   "audit",
+	arov1alpha1.LogTypeJournal: "journald",
+}
+
+// validateSink checks that a sink is fully and correctly specified.  secret
+// is the sink's Secret, or nil if it does not exist.
+func validateSink(sink *arov1alpha1.LogSink, secret *corev1.Secret) error {
+	if !rxSinkName.MatchString(sink.Name) {
+		return fmt.Errorf("invalid name %q", sink.Name)
+	}
+
+	if len(sink.Logs) == 0 {
+		return fmt.Errorf("no logs specified")
+	}
+	for _, l := range sink.Logs {
+		if _, found := tags[l]; !found {
+			return fmt.Errorf("invalid log type %q", l)
+		}
+	}
+
+	var destinations int
+	for _, set := range []bool{sink.AzureBlob != nil, sink.Syslog != nil, sink.HTTP != nil} {
+		if set {
+			destinations++
+		}
+	}
+	if destinations != 1 {
+		return fmt.Errorf("exactly one of azureBlob, syslog and http must be set")
+	}
+
+	switch {
+	case sink.AzureBlob != nil:
+		if !rxStorageAccount.MatchString(sink.AzureBlob.StorageAccount) {
+			return fmt.Errorf("invalid storage account %q", sink.AzureBlob.StorageAccount)
+		}
+		if !rxContainer.MatchString(sink.AzureBlob.Container) {
+			return fmt.Errorf("invalid container %q", sink.AzureBlob.Container)
+		}
+		if sink.SecretName == "" {
+			return fmt.Errorf("secretName must be set")
+		}
+
+	case sink.Syslog != nil:
+		if !rxHost.MatchString(sink.Syslog.Host) {
+			return fmt.Errorf("invalid host %q", sink.Syslog.Host)
+		}
+		if sink.Syslog.Port < 0 || sink.Syslog.Port > 65535 {
+			return fmt.Errorf("invalid port %d", sink.Syslog.Port)
+		}
+		switch sink.Syslog.Mode {
+		case "", "TCP", "UDP", "TLS":
+		default:
+			return fmt.Errorf("invalid mode %q", sink.Syslog.Mode)
+		}
+
+	case sink.HTTP != nil:
+		u, err := url.Parse(sink.HTTP.URL)
+		if err != nil {
+			return fmt.Errorf("invalid url %q", sink.HTTP.URL)
+		}
+		if u.Scheme != "https" {
+			return fmt.Errorf("url %q must be https", sink.HTTP.URL)
+		}
+		if !rxHost.MatchString(u.Hostname()) {
+			return fmt.Errorf("invalid url host %q", u.Hostname())
+		}
+		if u.Port() != "" {
+			if _, err := strconv.ParseUint(u.Port(), 10, 16); err != nil {
+				return fmt.Errorf("invalid url port %q", u.Port())
+			}
+		}
+		if !rxURI.MatchString(u.RequestURI()) {
+			return fmt.Errorf("invalid url path %q", u.RequestURI())
+		}
+	}
+
+	if sink.SecretName != "" {
+		if secret == nil {
+			return fmt.Errorf("secret %s/%s not found", kubeNamespace, sink.SecretName)
+		}
+		if len(secret.Data[SinkCredentialName]) == 0 {
+			return fmt.Errorf("secret %s/%s has no %q key", kubeNamespace, sink.SecretName, SinkCredentialName)
+		}
+	}
+
+	return nil
+}
+
+// sinkDestination returns the storage account or host name which a valid sink
+// forwards logs to
+func sinkDestination(sink *arov1alpha1.LogSink) string {
+	switch {
+	case sink.AzureBlob != nil:
+		return sink.AzureBlob.StorageAccount
+	case sink.Syslog != nil:
+		return strings.ToLower(sink.Syslog.Host)
+	case sink.HTTP != nil:
+		u, _ := url.Parse(sink.HTTP.URL)
+		return strings.ToLower(u.Hostname())
+	}
+
+	return ""
+}
+
+// sinkEnvName is the name of the environment variable which passes a sink's
+// credential to fluent-bit
+func sinkEnvName(sink *arov1alpha1.LogSink) string {
+	return "LOGSINK_" + strings.ToUpper(strings.ReplaceAll(sink.Name, "-", "_")) + "_CREDENTIAL"
+}
+
+// sinkConfig returns the fluent-bit configuration which forwards logs to the
+// given sinks, which must be valid, and the environment variables which it
+// references
+func sinkConfig(sinks []arov1alpha1.LogSink) (string, []corev1.EnvVar) {
+	var sb strings.Builder
+	var env []corev1.EnvVar
+	var rawAudit bool
+
+	for i := range sinks {
+		sink := &sinks[i]
+
+		if sink.SecretName != "" {
+			env = append(env, corev1.EnvVar{
+				Name: sinkEnvName(sink),
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: sink.SecretName,
+						},
+						Key: SinkCredentialName,
+					},
+				},
+			})
+		}
+
+		for _, l := range sink.Logs {
+			sb.WriteString("\n[OUTPUT]\n")
+
+			switch {
+			case sink.AzureBlob != nil:
+				fmt.Fprintf(&sb, "\tName azure_blob\n\tMatch %s\n", tags[l])
+				fmt.Fprintf(&sb, "\taccount_name %s\n", sink.AzureBlob.StorageAccount)
+				fmt.Fprintf(&sb, "\tshared_key ${%s}\n", sinkEnvName(sink))
+				fmt.Fprintf(&sb, "\tcontainer_name %s\n", sink.AzureBlob.Container)
+				fmt.Fprintf(&sb, "\tblob_type appendblob\n\tauto_create_container on\n\ttls on\n")
+
+			case sink.Syslog != nil:
+				port := sink.Syslog.Port
+				if port == 0 {
+					port = 514
+				}
+
+				mode := strings.ToLower(sink.Syslog.Mode)
+				if mode == "" {
+					mode = "tcp"
+				}
+
+				// syslog messages are a single field: journal entries have
+				// one, but the parsed audit records do not, so audit logs are
+				// read again without the parser
+				tag, key := tags[l], "MESSAGE"
+				if l == arov1alpha1.LogTypeAudit {
+					tag, key = "audit-raw", "log"
+					rawAudit = true
+				}
+
+				fmt.Fprintf(&sb, "\tName syslog\n\tMatch %s\n", tag)
+				fmt.Fprintf(&sb, "\tHost %s\n\tPort %d\n\tMode %s\n", sink.Syslog.Host, port, mode)
+				fmt.Fprintf(&sb, "\tSyslog_Format rfc5424\n\tSyslog_Message_Key %s\n", key)
+
+			case sink.HTTP != nil:
+				u, _ := url.Parse(sink.HTTP.URL)
+
+				port := u.Port()
+				if port == "" {
+					port = "443"
+				}
+
+				fmt.Fprintf(&sb, "\tName http\n\tMatch %s\n", tags[l])
+				fmt.Fprintf(&sb, "\tHost %s\n\tPort %s\n\tURI %s\n", u.Hostname(), port, u.RequestURI())
+				fmt.Fprintf(&sb, "\tFormat json_lines\n\ttls on\n\ttls.verify on\n")
+				if sink.SecretName != "" {
+					fmt.Fprintf(&sb, "\tHeader Authorization ${%s}\n", sinkEnvName(sink))
+				}
+			}
+		}
+	}
+
+	if rawAudit {
+		return `
+[INPUT]
+	Name tail
+	Tag audit-raw
+	Path /var/log/kube-apiserver/audit*
+	DB /var/lib/fluent/audit-raw
+` + sb.String(), env
+	}
+
+	return sb.String(), env
+}
@@ -0,0 +1,275 @@
+package genevalogging
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+func TestValidateSink(t *testing.T) {
+	secret := &corev1.Secret{
+		Data: map[string][]byte{
+			SinkCredentialName: []byte("key"),
+		},
+	}
+
+	for _, tt := range []struct {
+		name    string
+		sink    arov1alpha1.LogSink
+		secret  *corev1.Secret
+		wantErr string
+	}{
+		{
+			name: "valid blob",
+			sink: arov1alpha1.LogSink{
+				Name:       "blob",
+				Logs:       []arov1alpha1.LogType{arov1alpha1.LogTypeAudit},
+				SecretName: "blob",
+				AzureBlob: &arov1alpha1.AzureBlobLogSink{
+					StorageAccount: "account",
+					Container:      "audit",
+				},
+			},
+			secret: secret,
+		},
+		{
+			name: "valid syslog",
+			sink: arov1alpha1.LogSink{
+				Name: "syslog",
+				Logs: []arov1alpha1.LogType{arov1alpha1.LogTypeJournal},
+				Syslog: &arov1alpha1.SyslogLogSink{
+					Host: "syslog.example.com",
+				},
+			},
+		},
+		{
+			name: "valid http",
+			sink: arov1alpha1.LogSink{
+				Name: "http",
+				Logs: []arov1alpha1.LogType{arov1alpha1.LogTypeAudit},
+				HTTP: &arov1alpha1.HTTPLogSink{
+					URL: "https://logs.example.com:8443/ingest?source=aro",
+				},
+			},
+		},
+		{
+			name: "no destination",
+			sink: arov1alpha1.LogSink{
+				Name: "none",
+				Logs: []arov1alpha1.LogType{arov1alpha1.LogTypeAudit},
+			},
+			wantErr: "exactly one of azureBlob, syslog and http must be set",
+		},
+		{
+			name: "two destinations",
+			sink: arov1alpha1.LogSink{
+				Name: "two",
+				Logs: []arov1alpha1.LogType{arov1alpha1.LogTypeAudit},
+				Syslog: &arov1alpha1.SyslogLogSink{
+					Host: "syslog.example.com",
+				},
+				HTTP: &arov1alpha1.HTTPLogSink{
+					URL: "https://logs.example.com/",
+				},
+			},
+			wantErr: "exactly one of azureBlob, syslog and http must be set",
+		},
+		{
+			name: "no logs",
+			sink: arov1alpha1.LogSink{
+				Name: "syslog",
+				Syslog: &arov1alpha1.SyslogLogSink{
+					Host: "syslog.example.com",
+				},
+			},
+			wantErr: "no logs specified",
+		},
+		{
+			name: "config injection",
+			sink: arov1alpha1.LogSink{
+				Name: "syslog",
+				Logs: []arov1alpha1.LogType{arov1alpha1.LogTypeJournal},
+				Syslog: &arov1alpha1.SyslogLogSink{
+					Host: "syslog.example.com\n\tMatch *",
+				},
+			},
+			wantErr: `invalid host "syslog.example.com\n\tMatch *"`,
+		},
+		{
+			name: "http not https",
+			sink: arov1alpha1.LogSink{
+				Name: "http",
+				Logs: []arov1alpha1.LogType{arov1alpha1.LogTypeAudit},
+				HTTP: &arov1alpha1.HTTPLogSink{
+					URL: "http://logs.example.com/",
+				},
+			},
+			wantErr: `url "http://logs.example.com/" must be https`,
+		},
+		{
+			name: "blob without secret",
+			sink: arov1alpha1.LogSink{
+				Name: "blob",
+				Logs: []arov1alpha1.LogType{arov1alpha1.LogTypeAudit},
+				AzureBlob: &arov1alpha1.AzureBlobLogSink{
+					StorageAccount: "account",
+					Container:      "audit",
+				},
+			},
+			wantErr: "secretName must be set",
+		},
+		{
+			name: "missing secret",
+			sink: arov1alpha1.LogSink{
+				Name:       "blob",
+				Logs:       []arov1alpha1.LogType{arov1alpha1.LogTypeAudit},
+				SecretName: "blob",
+				AzureBlob: &arov1alpha1.AzureBlobLogSink{
+					StorageAccount: "account",
+					Container:      "audit",
+				},
+			},
+			wantErr: "secret openshift-azure-logging/blob not found",
+		},
+		{
+			name: "secret without credential",
+			sink: arov1alpha1.LogSink{
+				Name:       "blob",
+				Logs:       []arov1alpha1.LogType{arov1alpha1.LogTypeAudit},
+				SecretName: "blob",
+				AzureBlob: &arov1alpha1.AzureBlobLogSink{
+					StorageAccount: "account",
+					Container:      "audit",
+				},
+			},
+			secret:  &corev1.Secret{},
+			wantErr: `secret openshift-azure-logging/blob has no "credential" key`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSink(&tt.sink, tt.secret)
+			if err == nil && tt.wantErr != "" ||
+				err != nil && err.Error() != tt.wantErr {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestSinkConfig(t *testing.T) {
+	conf, env := sinkConfig([]arov1alpha1.LogSink{
+		{
+			Name:       "audit-blob",
+			Logs:       []arov1alpha1.LogType{arov1alpha1.LogTypeAudit},
+			SecretName: "blob",
+			AzureBlob: &arov1alpha1.AzureBlobLogSink{
+				StorageAccount: "account",
+				Container:      "audit",
+			},
+		},
+		{
+			Name: "siem",
+			Logs: []arov1alpha1.LogType{arov1alpha1.LogTypeAudit, arov1alpha1.LogTypeJournal},
+			Syslog: &arov1alpha1.SyslogLogSink{
+				Host: "syslog.example.com",
+				Mode: "TLS",
+			},
+		},
+		{
+			Name:       "http",
+			Logs:       []arov1alpha1.LogType{arov1alpha1.LogTypeJournal},
+			SecretName: "http",
+			HTTP: &arov1alpha1.HTTPLogSink{
+				URL: "https://logs.example.com/ingest",
+			},
+		},
+	})
+
+	wantConf := `
+[INPUT]
+	Name tail
+	Tag audit-raw
+	Path /var/log/kube-apiserver/audit*
+	DB /var/lib/fluent/audit-raw
+
+[OUTPUT]
+	Name azure_blob
+	Match audit
+	account_name account
+	shared_key ${LOGSINK_AUDIT_BLOB_CREDENTIAL}
+	container_name audit
+	blob_type appendblob
+	auto_create_container on
+	tls on
+
+[OUTPUT]
+	Name syslog
+	Match audit-raw
+	Host syslog.example.com
+	Port 514
+	Mode tls
+	Syslog_Format rfc5424
+	Syslog_Message_Key log
+
+[OUTPUT]
+	Name syslog
+	Match journald
+	Host syslog.example.com
+	Port 514
+	Mode tls
+	Syslog_Format rfc5424
+	Syslog_Message_Key MESSAGE
+
+[OUTPUT]
+	Name http
+	Match journald
+	Host logs.example.com
+	Port 443
+	URI /ingest
+	Format json_lines
+	tls on
+	tls.verify on
+	Header Authorization ${LOGSINK_HTTP_CREDENTIAL}
+`
+	if conf != wantConf {
+		t.Error(conf)
+	}
+
+	wantEnv := []corev1.EnvVar{
+		{
+			Name: "LOGSINK_AUDIT_BLOB_CREDENTIAL",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: "blob",
+					},
+					Key: SinkCredentialName,
+				},
+			},
+		},
+		{
+			Name: "LOGSINK_HTTP_CREDENTIAL",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: "http",
+					},
+					Key: SinkCredentialName,
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(env, wantEnv) {
+		t.Error(env)
+	}
+
+	if conf, env := sinkConfig(nil); conf != "" || env != nil {
+		t.Error(conf, env)
+	}
+}
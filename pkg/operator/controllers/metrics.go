@@ -0,0 +1,42 @@
+package controllers
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Operator metrics.  These are served on /metrics alongside the per-controller
+// reconcile metrics (controller_runtime_reconcile_total,
+// controller_runtime_reconcile_errors_total and
+// controller_runtime_reconcile_time_seconds) which controller-runtime
+// registers.
+var (
+	// ControllerMode is 1 for the mode each controller is running in
+	ControllerMode = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "aro_operator_controller_mode",
+		Help: "Mode each operator controller is running in",
+	}, []string{"controller", "mode"})
+
+	// WorkaroundState is 1 for the current state of each workaround
+	WorkaroundState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "aro_operator_workaround_state",
+		Help: "State of each workaround",
+	}, []string{"workaround", "state"})
+
+	// RouteFixReady is 1 when the routefix daemonset is ready
+	RouteFixReady = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "aro_operator_routefix_ready",
+		Help: "Whether the routefix daemonset is ready",
+	})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		ControllerMode,
+		WorkaroundState,
+		RouteFixReady,
+	)
+}
@@ -163,7 +163,7 @@ func (r *Reconciler) monitoringConfigMap(ctx context.Context) (*corev1.ConfigMap
 }
 
 // SetupWithManager setup the manager
-func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, gates *controllers.Gates) error {
 	r.log.Info("starting starting cluster monitoring controller")
 
 	aroClusterPredicate := predicate.NewPredicateFuncs(func(meta metav1.Object, object runtime.Object) bool {
@@ -184,5 +184,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			builder.WithPredicates(monitoringConfigMapPredicate),
 		).
 		Named(controllers.MonitoringControllerName).
-		Complete(r)
+		Complete(gates.Reconciler(controllers.MonitoringControllerName, r))
 }
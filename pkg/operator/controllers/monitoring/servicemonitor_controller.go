@@ -0,0 +1,150 @@
+package monitoring
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/sirupsen/logrus"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+)
+
+// ServiceMonitors are not in our scheme, so are handled as unstructured
+var serviceMonitorResource = schema.GroupVersionResource{
+	Group:    "monitoring.coreos.com",
+	Version:  "v1",
+	Resource: "servicemonitors",
+}
+
+const serviceMonitorName = "aro-operator-master"
+
+// ServiceMonitorReconciler creates a ServiceMonitor for the operator metrics
+// while spec.features.serviceMonitors is set, and removes it otherwise
+type ServiceMonitorReconciler struct {
+	log        *logrus.Entry
+	arocli     aroclient.Interface
+	dynamiccli dynamic.Interface
+}
+
+func NewServiceMonitorReconciler(log *logrus.Entry, arocli aroclient.Interface, dynamiccli dynamic.Interface) *ServiceMonitorReconciler {
+	return &ServiceMonitorReconciler{
+		log:        log,
+		arocli:     arocli,
+		dynamiccli: dynamiccli,
+	}
+}
+
+func (r *ServiceMonitorReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): Reconcile will eventually be receiving a ctx (https://github.com/kubernetes-sigs/controller-runtime/blob/7ef2da0bc161d823f084ad21ff5f9c9bd6b0cc39/pkg/reconcile/reconcile.go#L93)
+	ctx := context.TODO()
+
+	instance, err := r.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	cli := r.dynamiccli.Resource(serviceMonitorResource).Namespace(operator.Namespace)
+
+	if !instance.Spec.Features.ServiceMonitors {
+		err = cli.Delete(ctx, serviceMonitorName, metav1.DeleteOptions{})
+		if kerrors.IsNotFound(err) {
+			err = nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	desired, err := serviceMonitor(instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		sm, err := cli.Get(ctx, serviceMonitorName, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			r.log.Infof("creating ServiceMonitor %s", serviceMonitorName)
+			_, err = cli.Create(ctx, desired, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		if reflect.DeepEqual(sm.Object["spec"], desired.Object["spec"]) {
+			return nil
+		}
+
+		r.log.Infof("updating ServiceMonitor %s", serviceMonitorName)
+		sm.Object["spec"] = desired.Object["spec"]
+
+		_, err = cli.Update(ctx, sm, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// serviceMonitor returns a ServiceMonitor which scrapes the master operator
+// metrics.  The types are those which the JSON decoder produces so that the
+// spec can be compared with the one read from the cluster.
+func serviceMonitor(instance *arov1alpha1.Cluster) (*unstructured.Unstructured, error) {
+	sm := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "monitoring.coreos.com/v1",
+			"kind":       "ServiceMonitor",
+			"metadata": map[string]interface{}{
+				"name":      serviceMonitorName,
+				"namespace": operator.Namespace,
+			},
+			"spec": map[string]interface{}{
+				"endpoints": []interface{}{
+					map[string]interface{}{
+						"port":     "http",
+						"path":     "/metrics",
+						"interval": "30s",
+					},
+				},
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app": "aro-operator-master",
+					},
+				},
+			},
+		},
+	}
+
+	err := controllerutil.SetControllerReference(instance, sm, scheme.Scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	return sm, nil
+}
+
+// SetupWithManager setup the manager
+func (r *ServiceMonitorReconciler) SetupWithManager(mgr ctrl.Manager, gates *controllers.Gates) error {
+	aroClusterPredicate := predicate.NewPredicateFuncs(func(meta metav1.Object, object runtime.Object) bool {
+		return meta.GetName() == arov1alpha1.SingletonClusterName
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}, builder.WithPredicates(aroClusterPredicate)).
+		Named(controllers.ServiceMonitorControllerName).
+		Complete(gates.Reconciler(controllers.ServiceMonitorControllerName, r))
+}
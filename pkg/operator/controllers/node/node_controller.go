@@ -132,11 +132,11 @@ func (r *NodeReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
 }
 
 // SetupWithManager setup our mananger
-func (r *NodeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+func (r *NodeReconciler) SetupWithManager(mgr ctrl.Manager, gates *controllers.Gates) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&corev1.Node{}).
 		Named(controllers.NodeControllerName).
-		Complete(r)
+		Complete(gates.Reconciler(controllers.NodeControllerName, r))
 }
 
 func getAnnotation(m *metav1.ObjectMeta, k string) string {
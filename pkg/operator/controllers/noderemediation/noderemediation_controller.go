@@ -0,0 +1,441 @@
+package noderemediation
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	maoclient "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/kubectl/pkg/drain"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+)
+
+const (
+	annotationMachine     = "machine.openshift.io/machine"
+	annotationRemediation = "aro.openshift.io/remediation"
+
+	defaultMaxUnhealthy = 1
+	defaultMinInterval  = 10 * time.Minute
+
+	// guardRetryInterval is how long to wait before retrying a remediation
+	// which was held back by the max unhealthy guard
+	guardRetryInterval = 5 * time.Minute
+)
+
+// NodeRemediationReconciler remediates worker nodes which have been unhealthy
+// for longer than allowed by the policies in spec.nodeRemediation
+type NodeRemediationReconciler struct {
+	log           *logrus.Entry
+	kubernetescli kubernetes.Interface
+	maocli        maoclient.Interface
+	arocli        aroclient.Interface
+
+	now func() time.Time
+}
+
+func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, maocli maoclient.Interface, arocli aroclient.Interface) *NodeRemediationReconciler {
+	return &NodeRemediationReconciler{
+		log:           log,
+		kubernetescli: kubernetescli,
+		maocli:        maocli,
+		arocli:        arocli,
+
+		now: time.Now,
+	}
+}
+
+// Reconcile remediates the node if a policy matches it
+func (r *NodeRemediationReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): Reconcile will eventually be receiving a ctx (https://github.com/kubernetes-sigs/controller-runtime/blob/7ef2da0bc161d823f084ad21ff5f9c9bd6b0cc39/pkg/reconcile/reconcile.go#L93)
+	ctx := context.TODO()
+
+	instance, err := r.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	node, err := r.kubernetescli.CoreV1().Nodes().Get(ctx, request.Name, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return reconcile.Result{}, nil
+	}
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// don't interfere with masters
+	if isMaster(node) {
+		return reconcile.Result{}, nil
+	}
+
+	spec := &instance.Spec.NodeRemediation
+
+	policy, requeueAfter := r.matchPolicy(spec.Policies, node)
+	if policy == nil {
+		if getAnnotation(&node.ObjectMeta, annotationRemediation) != "" && unhealthy(spec.Policies, node) == "" {
+			// the node has recovered: undo our cordon
+			return reconcile.Result{RequeueAfter: requeueAfter}, r.recovered(ctx, node)
+		}
+
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	if getAnnotation(&node.ObjectMeta, annotationRemediation) == string(policy.Action) {
+		// already remediated
+		return reconcile.Result{}, nil
+	}
+
+	// guard against remediating when many nodes are unhealthy: the problem is
+	// probably not with the nodes and replacing them will not help
+	maxUnhealthy := spec.MaxUnhealthy
+	if maxUnhealthy == 0 {
+		maxUnhealthy = defaultMaxUnhealthy
+	}
+
+	nodes, err := r.kubernetescli.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	var unhealthyCount int
+	for i := range nodes.Items {
+		if !isMaster(&nodes.Items[i]) && unhealthy(spec.Policies, &nodes.Items[i]) != "" {
+			unhealthyCount++
+		}
+	}
+
+	if unhealthyCount > maxUnhealthy {
+		r.log.Warnf("not remediating node %s: %d worker nodes are unhealthy, more than the maximum of %d", node.Name, unhealthyCount, maxUnhealthy)
+		return reconcile.Result{RequeueAfter: guardRetryInterval}, nil
+	}
+
+	// rate limit remediations across the cluster
+	minInterval := spec.MinInterval.Duration
+	if minInterval == 0 {
+		minInterval = defaultMinInterval
+	}
+
+	if instance.Status.LastNodeRemediation != nil {
+		next := instance.Status.LastNodeRemediation.Time.Add(minInterval)
+		if now := r.now(); next.After(now) {
+			return reconcile.Result{RequeueAfter: next.Sub(now)}, nil
+		}
+	}
+
+	if policy.Action == arov1alpha1.NodeRemediationActionReplace {
+		owned, err := r.machineOwnedByMachineSet(ctx, node)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if !owned {
+			// deleting a Machine which no MachineSet owns loses its capacity
+			// permanently
+			r.log.Warnf("not replacing node %s: its machine is not owned by a machineset", node.Name)
+			return reconcile.Result{}, nil
+		}
+	}
+
+	// the remediation is recorded before it is carried out so that the rate
+	// limit holds even if the action fails part way through, e.g. once a
+	// replaced node has gone away
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		instance, err := r.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		instance.Status.LastNodeRemediation = &arov1alpha1.NodeRemediation{
+			Node:   node.Name,
+			Policy: policy.Name,
+			Action: policy.Action,
+			Time:   metav1.NewTime(r.now()),
+		}
+
+		_, err = r.arocli.AroV1alpha1().Clusters().UpdateStatus(ctx, instance, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.log.Infof("remediating node %s with action %s (policy %s)", node.Name, policy.Action, policy.Name)
+
+	return reconcile.Result{}, r.remediate(ctx, node, policy.Action)
+}
+
+// machineOwnedByMachineSet returns true if the node's Machine is owned by a
+// MachineSet, which will replace it once it is deleted
+func (r *NodeRemediationReconciler) machineOwnedByMachineSet(ctx context.Context, node *corev1.Node) (bool, error) {
+	namespace, name, err := machineName(node)
+	if err != nil {
+		return false, err
+	}
+
+	machine, err := r.maocli.MachineV1beta1().Machines(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	for _, ref := range machine.OwnerReferences {
+		if ref.Kind == "MachineSet" {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// matchPolicy returns the first policy whose condition the node has been in
+// for longer than the policy's timeout.  If no policy matches yet, it returns
+// the time until the earliest one will.
+func (r *NodeRemediationReconciler) matchPolicy(policies []arov1alpha1.NodeRemediationPolicy, node *corev1.Node) (*arov1alpha1.NodeRemediationPolicy, time.Duration) {
+	now := r.now()
+
+	var requeueAfter time.Duration
+	for i, policy := range policies {
+		since, holds := conditionHolds(node, policy.Condition)
+		if !holds {
+			continue
+		}
+
+		deadline := since.Add(policy.Timeout.Duration)
+		if !deadline.After(now) {
+			return &policies[i], 0
+		}
+
+		if requeueAfter == 0 || deadline.Sub(now) < requeueAfter {
+			requeueAfter = deadline.Sub(now)
+		}
+	}
+
+	return nil, requeueAfter
+}
+
+func (r *NodeRemediationReconciler) remediate(ctx context.Context, node *corev1.Node, action arov1alpha1.NodeRemediationAction) error {
+	switch action {
+	case arov1alpha1.NodeRemediationActionCordon:
+		return r.cordon(ctx, node)
+
+	case arov1alpha1.NodeRemediationActionDrain:
+		// the node is only annotated as drained once the drain has succeeded,
+		// so that a failed drain is retried.  Until then it is annotated as
+		// cordoned so that it is uncordoned if it recovers.
+		err := r.cordon(ctx, node)
+		if err != nil {
+			return err
+		}
+
+		err = drain.RunNodeDrain(&drain.Helper{
+			Client:              r.kubernetescli,
+			GracePeriodSeconds:  -1,
+			IgnoreAllDaemonSets: true,
+			Timeout:             60 * time.Second,
+			DeleteLocalData:     true,
+			OnPodDeletedOrEvicted: func(pod *corev1.Pod, usingEviction bool) {
+				r.log.Printf("evicted pod %s/%s", pod.Namespace, pod.Name)
+			},
+			Out:    r.log.Writer(),
+			ErrOut: r.log.Writer(),
+		}, node.Name)
+		if err != nil {
+			return err
+		}
+
+		return r.annotate(ctx, node, action)
+
+	case arov1alpha1.NodeRemediationActionReplace:
+		// the machine API drains the node before deleting its VM
+		namespace, name, err := machineName(node)
+		if err != nil {
+			return err
+		}
+
+		err = r.maocli.MachineV1beta1().Machines(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+
+		// the node may already have gone away with its machine
+		err = r.annotate(ctx, node, action)
+		if kerrors.IsNotFound(err) {
+			err = nil
+		}
+		return err
+	}
+
+	r.log.Warnf("unknown node remediation action %s", action)
+	return nil
+}
+
+// cordon marks the node unschedulable and records that we did so
+func (r *NodeRemediationReconciler) cordon(ctx context.Context, node *corev1.Node) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		node, err := r.kubernetescli.CoreV1().Nodes().Get(ctx, node.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		node.Spec.Unschedulable = true
+		setAnnotation(&node.ObjectMeta, annotationRemediation, string(arov1alpha1.NodeRemediationActionCordon))
+
+		_, err = r.kubernetescli.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func (r *NodeRemediationReconciler) annotate(ctx context.Context, node *corev1.Node, action arov1alpha1.NodeRemediationAction) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		node, err := r.kubernetescli.CoreV1().Nodes().Get(ctx, node.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		setAnnotation(&node.ObjectMeta, annotationRemediation, string(action))
+
+		_, err = r.kubernetescli.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// recovered uncordons a node we cordoned once it is healthy again
+func (r *NodeRemediationReconciler) recovered(ctx context.Context, node *corev1.Node) error {
+	r.log.Infof("node %s has recovered", node.Name)
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		node, err := r.kubernetescli.CoreV1().Nodes().Get(ctx, node.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		switch arov1alpha1.NodeRemediationAction(getAnnotation(&node.ObjectMeta, annotationRemediation)) {
+		case arov1alpha1.NodeRemediationActionCordon, arov1alpha1.NodeRemediationActionDrain:
+			node.Spec.Unschedulable = false
+		}
+		delete(node.Annotations, annotationRemediation)
+
+		_, err = r.kubernetescli.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// SetupWithManager creates the controller
+func (r *NodeRemediationReconciler) SetupWithManager(mgr ctrl.Manager, gates *controllers.Gates) error {
+	// reconcile all nodes when the policies change
+	clusterHandler := &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(o handler.MapObject) []reconcile.Request {
+			if o.Meta.GetName() != arov1alpha1.SingletonClusterName {
+				return nil
+			}
+
+			nodes, err := r.kubernetescli.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+			if err != nil {
+				r.log.Error(err)
+				return nil
+			}
+
+			requests := make([]reconcile.Request, 0, len(nodes.Items))
+			for _, node := range nodes.Items {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: node.Name}})
+			}
+
+			return requests
+		}),
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Node{}).
+		Watches(&source.Kind{Type: &arov1alpha1.Cluster{}}, clusterHandler).
+		Named(controllers.NodeRemediationControllerName).
+		Complete(gates.Reconciler(controllers.NodeRemediationControllerName, r))
+}
+
+// unhealthy returns the name of the first policy whose condition the node is
+// in, regardless of how long it has been in it
+func unhealthy(policies []arov1alpha1.NodeRemediationPolicy, node *corev1.Node) string {
+	for _, policy := range policies {
+		if _, holds := conditionHolds(node, policy.Condition); holds {
+			return policy.Name
+		}
+	}
+
+	return ""
+}
+
+// conditionHolds returns whether the node is in the given unhealthy condition
+// and since when
+func conditionHolds(node *corev1.Node, condition arov1alpha1.NodeRemediationCondition) (time.Time, bool) {
+	for _, c := range node.Status.Conditions {
+		switch condition {
+		case arov1alpha1.NodeRemediationConditionNotReady:
+			if c.Type == corev1.NodeReady && c.Status != corev1.ConditionTrue {
+				return c.LastTransitionTime.Time, true
+			}
+
+		case arov1alpha1.NodeRemediationConditionContainerRuntimeUnhealthy:
+			if c.Type == corev1.NodeReady && c.Status != corev1.ConditionTrue &&
+				(strings.Contains(c.Message, "container runtime") || strings.Contains(c.Message, "PLEG")) {
+				return c.LastTransitionTime.Time, true
+			}
+
+		case arov1alpha1.NodeRemediationConditionDiskPressure:
+			if c.Type == corev1.NodeDiskPressure && c.Status == corev1.ConditionTrue {
+				return c.LastTransitionTime.Time, true
+			}
+
+		case arov1alpha1.NodeRemediationConditionMemoryPressure:
+			if c.Type == corev1.NodeMemoryPressure && c.Status == corev1.ConditionTrue {
+				return c.LastTransitionTime.Time, true
+			}
+
+		case arov1alpha1.NodeRemediationConditionPIDPressure:
+			if c.Type == corev1.NodePIDPressure && c.Status == corev1.ConditionTrue {
+				return c.LastTransitionTime.Time, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func isMaster(node *corev1.Node) bool {
+	_, ok := node.Labels["node-role.kubernetes.io/master"]
+	return ok
+}
+
+func machineName(node *corev1.Node) (string, string, error) {
+	return cache.SplitMetaNamespaceKey(getAnnotation(&node.ObjectMeta, annotationMachine))
+}
+
+func getAnnotation(m *metav1.ObjectMeta, k string) string {
+	if m.Annotations == nil {
+		return ""
+	}
+
+	return m.Annotations[k]
+}
+
+func setAnnotation(m *metav1.ObjectMeta, k, v string) {
+	if m.Annotations == nil {
+		m.Annotations = map[string]string{}
+	}
+
+	m.Annotations[k] = v
+}
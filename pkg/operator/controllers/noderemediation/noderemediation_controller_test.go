@@ -0,0 +1,293 @@
+package noderemediation
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	maofake "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned/fake"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	ktesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+)
+
+func TestReconcile(t *testing.T) {
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	newNode := func(name string, ready corev1.ConditionStatus, since time.Duration) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Annotations: map[string]string{
+					annotationMachine: "openshift-machine-api/" + name,
+				},
+			},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{
+					{
+						Type:               corev1.NodeReady,
+						Status:             ready,
+						LastTransitionTime: metav1.NewTime(now.Add(-since)),
+					},
+				},
+			},
+		}
+	}
+
+	policy := func(action arov1alpha1.NodeRemediationAction) []arov1alpha1.NodeRemediationPolicy {
+		return []arov1alpha1.NodeRemediationPolicy{
+			{
+				Name:      "notready",
+				Condition: arov1alpha1.NodeRemediationConditionNotReady,
+				Timeout:   metav1.Duration{Duration: 15 * time.Minute},
+				Action:    action,
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name              string
+		nodes             []*corev1.Node
+		spec              arov1alpha1.NodeRemediationSpec
+		last              *arov1alpha1.NodeRemediation
+		podsErr           error
+		unownedMachine    bool
+		wantErr           string
+		wantRequeue       bool
+		wantUnschedulable bool
+		wantAnnotation    string
+		wantRemediation   *arov1alpha1.NodeRemediation
+		wantMachine       bool
+	}{
+		{
+			name:        "no policies",
+			nodes:       []*corev1.Node{newNode("worker", corev1.ConditionFalse, time.Hour)},
+			wantMachine: true,
+		},
+		{
+			name:              "cordon after timeout",
+			nodes:             []*corev1.Node{newNode("worker", corev1.ConditionFalse, time.Hour)},
+			spec:              arov1alpha1.NodeRemediationSpec{Policies: policy(arov1alpha1.NodeRemediationActionCordon)},
+			wantUnschedulable: true,
+			wantAnnotation:    string(arov1alpha1.NodeRemediationActionCordon),
+			wantRemediation: &arov1alpha1.NodeRemediation{
+				Node:   "worker",
+				Policy: "notready",
+				Action: arov1alpha1.NodeRemediationActionCordon,
+				Time:   metav1.NewTime(now),
+			},
+			wantMachine: true,
+		},
+		{
+			name:        "within timeout",
+			nodes:       []*corev1.Node{newNode("worker", corev1.ConditionFalse, time.Minute)},
+			spec:        arov1alpha1.NodeRemediationSpec{Policies: policy(arov1alpha1.NodeRemediationActionCordon)},
+			wantRequeue: true,
+			wantMachine: true,
+		},
+		{
+			name:              "drain",
+			nodes:             []*corev1.Node{newNode("worker", corev1.ConditionFalse, time.Hour)},
+			spec:              arov1alpha1.NodeRemediationSpec{Policies: policy(arov1alpha1.NodeRemediationActionDrain)},
+			wantUnschedulable: true,
+			wantAnnotation:    string(arov1alpha1.NodeRemediationActionDrain),
+			wantRemediation: &arov1alpha1.NodeRemediation{
+				Node:   "worker",
+				Policy: "notready",
+				Action: arov1alpha1.NodeRemediationActionDrain,
+				Time:   metav1.NewTime(now),
+			},
+			wantMachine: true,
+		},
+		{
+			name:              "drain fails",
+			nodes:             []*corev1.Node{newNode("worker", corev1.ConditionFalse, time.Hour)},
+			spec:              arov1alpha1.NodeRemediationSpec{Policies: policy(arov1alpha1.NodeRemediationActionDrain)},
+			podsErr:           fmt.Errorf("sad"),
+			wantErr:           "sad",
+			wantUnschedulable: true,
+			wantAnnotation:    string(arov1alpha1.NodeRemediationActionCordon),
+			wantRemediation: &arov1alpha1.NodeRemediation{
+				Node:   "worker",
+				Policy: "notready",
+				Action: arov1alpha1.NodeRemediationActionDrain,
+				Time:   metav1.NewTime(now),
+			},
+			wantMachine: true,
+		},
+		{
+			name:           "replace",
+			nodes:          []*corev1.Node{newNode("worker", corev1.ConditionUnknown, time.Hour)},
+			spec:           arov1alpha1.NodeRemediationSpec{Policies: policy(arov1alpha1.NodeRemediationActionReplace)},
+			wantAnnotation: string(arov1alpha1.NodeRemediationActionReplace),
+			wantRemediation: &arov1alpha1.NodeRemediation{
+				Node:   "worker",
+				Policy: "notready",
+				Action: arov1alpha1.NodeRemediationActionReplace,
+				Time:   metav1.NewTime(now),
+			},
+		},
+		{
+			name:           "replace unowned machine",
+			nodes:          []*corev1.Node{newNode("worker", corev1.ConditionUnknown, time.Hour)},
+			spec:           arov1alpha1.NodeRemediationSpec{Policies: policy(arov1alpha1.NodeRemediationActionReplace)},
+			unownedMachine: true,
+			wantMachine:    true,
+		},
+		{
+			name: "too many unhealthy",
+			nodes: []*corev1.Node{
+				newNode("worker", corev1.ConditionFalse, time.Hour),
+				newNode("other", corev1.ConditionFalse, time.Hour),
+			},
+			spec:        arov1alpha1.NodeRemediationSpec{Policies: policy(arov1alpha1.NodeRemediationActionReplace)},
+			wantRequeue: true,
+			wantMachine: true,
+		},
+		{
+			name: "masters are not counted",
+			nodes: []*corev1.Node{
+				newNode("worker", corev1.ConditionFalse, time.Hour),
+				func() *corev1.Node {
+					node := newNode("master", corev1.ConditionFalse, time.Hour)
+					node.Labels = map[string]string{"node-role.kubernetes.io/master": ""}
+					return node
+				}(),
+			},
+			spec:              arov1alpha1.NodeRemediationSpec{Policies: policy(arov1alpha1.NodeRemediationActionCordon)},
+			wantUnschedulable: true,
+			wantAnnotation:    string(arov1alpha1.NodeRemediationActionCordon),
+			wantRemediation: &arov1alpha1.NodeRemediation{
+				Node:   "worker",
+				Policy: "notready",
+				Action: arov1alpha1.NodeRemediationActionCordon,
+				Time:   metav1.NewTime(now),
+			},
+			wantMachine: true,
+		},
+		{
+			name:  "rate limited",
+			nodes: []*corev1.Node{newNode("worker", corev1.ConditionFalse, time.Hour)},
+			spec:  arov1alpha1.NodeRemediationSpec{Policies: policy(arov1alpha1.NodeRemediationActionReplace)},
+			last: &arov1alpha1.NodeRemediation{
+				Node: "other",
+				Time: metav1.NewTime(now.Add(-time.Minute)),
+			},
+			wantRequeue: true,
+			wantRemediation: &arov1alpha1.NodeRemediation{
+				Node: "other",
+				Time: metav1.NewTime(now.Add(-time.Minute)),
+			},
+			wantMachine: true,
+		},
+		{
+			name: "recovered",
+			nodes: []*corev1.Node{
+				func() *corev1.Node {
+					node := newNode("worker", corev1.ConditionTrue, time.Minute)
+					node.Spec.Unschedulable = true
+					node.Annotations[annotationRemediation] = string(arov1alpha1.NodeRemediationActionCordon)
+					return node
+				}(),
+			},
+			spec:        arov1alpha1.NodeRemediationSpec{Policies: policy(arov1alpha1.NodeRemediationActionCordon)},
+			wantMachine: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var objects []runtime.Object
+			for _, node := range tt.nodes {
+				objects = append(objects, node)
+			}
+
+			kubernetescli := fake.NewSimpleClientset(objects...)
+			kubernetescli.PrependReactor("list", "pods", func(action ktesting.Action) (bool, runtime.Object, error) {
+				return tt.podsErr != nil, nil, tt.podsErr
+			})
+			machine := &machinev1beta1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "worker",
+					Namespace: "openshift-machine-api",
+				},
+			}
+			if !tt.unownedMachine {
+				machine.OwnerReferences = []metav1.OwnerReference{
+					{
+						APIVersion: "machine.openshift.io/v1beta1",
+						Kind:       "MachineSet",
+						Name:       "worker",
+					},
+				}
+			}
+			maocli := maofake.NewSimpleClientset(machine)
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					NodeRemediation: tt.spec,
+				},
+				Status: arov1alpha1.ClusterStatus{
+					LastNodeRemediation: tt.last,
+				},
+			})
+
+			r := NewReconciler(logrus.NewEntry(logrus.StandardLogger()), kubernetescli, maocli, arocli)
+			r.now = func() time.Time { return now }
+
+			result, err := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Name: "worker"}})
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			if (result.RequeueAfter > 0) != tt.wantRequeue {
+				t.Error(result.RequeueAfter)
+			}
+
+			node, err := kubernetescli.CoreV1().Nodes().Get(ctx, "worker", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if node.Spec.Unschedulable != tt.wantUnschedulable {
+				t.Error(node.Spec.Unschedulable)
+			}
+
+			if annotation := node.Annotations[annotationRemediation]; annotation != tt.wantAnnotation {
+				t.Error(annotation)
+			}
+
+			instance, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(instance.Status.LastNodeRemediation, tt.wantRemediation) {
+				t.Error(instance.Status.LastNodeRemediation)
+			}
+
+			_, err = maocli.MachineV1beta1().Machines("openshift-machine-api").Get(ctx, "worker", metav1.GetOptions{})
+			if kerrors.IsNotFound(err) == tt.wantMachine {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package proxy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+// defaultNoProxy are destinations which are always reached directly: the
+// local host, in-cluster services and the Azure instance metadata service
+var defaultNoProxy = []string{
+	"localhost",
+	"127.0.0.1",
+	".svc",
+	".cluster.local",
+	"169.254.169.254",
+}
+
+// IsEnabled returns true if an outbound proxy is configured
+func IsEnabled(spec *arov1alpha1.ProxySpec) bool {
+	return spec.HTTPProxy != "" || spec.HTTPSProxy != ""
+}
+
+// NoProxy returns the comma-separated list of destinations which should not be
+// proxied: the defaults, noProxy from the spec and any extra destinations
+func NoProxy(spec *arov1alpha1.ProxySpec, extra ...string) string {
+	noProxy := append([]string{}, defaultNoProxy...)
+
+	for _, s := range strings.Split(spec.NoProxy, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			noProxy = append(noProxy, s)
+		}
+	}
+
+	for _, s := range extra {
+		if s != "" {
+			noProxy = append(noProxy, s)
+		}
+	}
+
+	return strings.Join(noProxy, ",")
+}
+
+// Env returns the environment variables which configure a container to use
+// the outbound proxy.  It returns nil if no proxy is configured.
+func Env(spec *arov1alpha1.ProxySpec, extraNoProxy ...string) []corev1.EnvVar {
+	if !IsEnabled(spec) {
+		return nil
+	}
+
+	var env []corev1.EnvVar
+
+	if spec.HTTPProxy != "" {
+		env = append(env, corev1.EnvVar{
+			Name:  "HTTP_PROXY",
+			Value: spec.HTTPProxy,
+		})
+	}
+
+	if spec.HTTPSProxy != "" {
+		env = append(env, corev1.EnvVar{
+			Name:  "HTTPS_PROXY",
+			Value: spec.HTTPSProxy,
+		})
+	}
+
+	return append(env, corev1.EnvVar{
+		Name:  "NO_PROXY",
+		Value: NoProxy(spec, extraNoProxy...),
+	})
+}
+
+// RootCAs returns the system root CAs together with the CAs which an
+// inspecting proxy signs its certificates with.  It returns nil, meaning the
+// system root CAs, if no trusted CA is configured.
+func RootCAs(spec *arov1alpha1.ProxySpec) *x509.CertPool {
+	if !IsEnabled(spec) || spec.TrustedCA == "" {
+		return nil
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+
+	pool.AppendCertsFromPEM([]byte(spec.TrustedCA))
+
+	return pool
+}
+
+// Func returns a proxy function for use in an http.Transport which sends
+// requests via the outbound proxy.  It returns nil if no proxy is configured.
+func Func(spec *arov1alpha1.ProxySpec) func(*http.Request) (*url.URL, error) {
+	if !IsEnabled(spec) {
+		return nil
+	}
+
+	noProxy := strings.Split(NoProxy(spec), ",")
+
+	return func(req *http.Request) (*url.URL, error) {
+		if bypass(noProxy, req.URL.Hostname()) {
+			return nil, nil
+		}
+
+		proxy := spec.HTTPProxy
+		if req.URL.Scheme == "https" {
+			proxy = spec.HTTPSProxy
+		}
+
+		if proxy == "" {
+			return nil, nil
+		}
+
+		return url.Parse(proxy)
+	}
+}
+
+// bypass returns true if host matches an entry in noProxy.  Entries may be
+// "*", an IP address, a CIDR or a domain, which also matches its subdomains.
+func bypass(noProxy []string, host string) bool {
+	host = strings.ToLower(host)
+	ip := net.ParseIP(host)
+
+	for _, entry := range noProxy {
+		entry = strings.ToLower(entry)
+
+		switch {
+		case entry == "*":
+			return true
+
+		case ip != nil:
+			if _, ipnet, err := net.ParseCIDR(entry); err == nil && ipnet.Contains(ip) {
+				return true
+			}
+			if entryIP := net.ParseIP(entry); entryIP != nil && entryIP.Equal(ip) {
+				return true
+			}
+
+		default:
+			entry = strings.TrimPrefix(entry, ".")
+			if host == entry || strings.HasSuffix(host, "."+entry) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
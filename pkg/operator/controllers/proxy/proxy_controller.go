@@ -0,0 +1,178 @@
+package proxy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"strings"
+
+	configv1 "github.com/openshift/api/config/v1"
+	configclient "github.com/openshift/client-go/config/clientset/versioned"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+)
+
+const (
+	clusterProxyName = "cluster"
+
+	// the installer puts the additional trust bundle in this ConfigMap and
+	// points the cluster Proxy configuration at it
+	userCABundleNamespace = "openshift-config"
+	userCABundleName      = "user-ca-bundle"
+	userCABundleKey       = "ca-bundle.crt"
+)
+
+// ProxyReconciler applies the outbound proxy in spec.proxy to the cluster
+// Proxy configuration
+type ProxyReconciler struct {
+	log           *logrus.Entry
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.Interface
+	configcli     configclient.Interface
+}
+
+func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.Interface, configcli configclient.Interface) *ProxyReconciler {
+	return &ProxyReconciler{
+		log:           log,
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		configcli:     configcli,
+	}
+}
+
+// Reconcile makes sure that the cluster Proxy configuration matches
+// spec.proxy, and that the cluster trusts the proxy's CA.  If no proxy is
+// configured, the cluster Proxy configuration is left alone.
+func (r *ProxyReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): Reconcile will eventually be receiving a ctx (https://github.com/kubernetes-sigs/controller-runtime/blob/7ef2da0bc161d823f084ad21ff5f9c9bd6b0cc39/pkg/reconcile/reconcile.go#L93)
+	ctx := context.TODO()
+
+	instance, err := r.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !IsEnabled(&instance.Spec.Proxy) {
+		return reconcile.Result{}, nil
+	}
+
+	trustedCA := instance.Spec.Proxy.TrustedCA != ""
+	if trustedCA {
+		err = r.ensureUserCABundle(ctx, instance.Spec.Proxy.TrustedCA)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{}, retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		proxy, err := r.configcli.ConfigV1().Proxies().Get(ctx, clusterProxyName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if proxy.Spec.HTTPProxy == instance.Spec.Proxy.HTTPProxy &&
+			proxy.Spec.HTTPSProxy == instance.Spec.Proxy.HTTPSProxy &&
+			proxy.Spec.NoProxy == instance.Spec.Proxy.NoProxy &&
+			(!trustedCA || proxy.Spec.TrustedCA.Name == userCABundleName) {
+			return nil
+		}
+
+		r.log.Info("updating cluster proxy configuration")
+
+		proxy.Spec.HTTPProxy = instance.Spec.Proxy.HTTPProxy
+		proxy.Spec.HTTPSProxy = instance.Spec.Proxy.HTTPSProxy
+		proxy.Spec.NoProxy = instance.Spec.Proxy.NoProxy
+		if trustedCA {
+			proxy.Spec.TrustedCA.Name = userCABundleName
+		}
+
+		_, err = r.configcli.ConfigV1().Proxies().Update(ctx, proxy, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// ensureUserCABundle makes sure that the user CA bundle contains the proxy's
+// CA.  Any other CAs which the customer has added to the bundle are kept.
+func (r *ProxyReconciler) ensureUserCABundle(ctx context.Context, trustedCA string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := r.kubernetescli.CoreV1().ConfigMaps(userCABundleNamespace).Get(ctx, userCABundleName, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			r.log.Info("creating user CA bundle")
+
+			_, err = r.kubernetescli.CoreV1().ConfigMaps(userCABundleNamespace).Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      userCABundleName,
+					Namespace: userCABundleNamespace,
+				},
+				Data: map[string]string{
+					userCABundleKey: trustedCA,
+				},
+			}, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		if strings.Contains(cm.Data[userCABundleKey], strings.TrimSpace(trustedCA)) {
+			return nil
+		}
+
+		r.log.Info("adding the proxy CA to the user CA bundle")
+
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		bundle := cm.Data[userCABundleKey]
+		if bundle != "" && !strings.HasSuffix(bundle, "\n") {
+			bundle += "\n"
+		}
+		cm.Data[userCABundleKey] = bundle + trustedCA
+
+		_, err = r.kubernetescli.CoreV1().ConfigMaps(userCABundleNamespace).Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// SetupWithManager creates the controller
+func (r *ProxyReconciler) SetupWithManager(mgr ctrl.Manager, gates *controllers.Gates) error {
+	aroClusterPredicate := predicate.NewPredicateFuncs(func(meta metav1.Object, object runtime.Object) bool {
+		return meta.GetName() == arov1alpha1.SingletonClusterName
+	})
+
+	// reconcile the Cluster when the cluster Proxy configuration is changed
+	proxyHandler := &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(o handler.MapObject) []reconcile.Request {
+			if o.Meta.GetName() != clusterProxyName {
+				return nil
+			}
+
+			return []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Name: arov1alpha1.SingletonClusterName}},
+			}
+		}),
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}, builder.WithPredicates(aroClusterPredicate)).
+		Watches(&source.Kind{Type: &configv1.Proxy{}}, proxyHandler).
+		Named(controllers.ProxyControllerName).
+		Complete(gates.Reconciler(controllers.ProxyControllerName, r))
+}
@@ -0,0 +1,163 @@
+package proxy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	configfake "github.com/openshift/client-go/config/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+)
+
+func TestReconcile(t *testing.T) {
+	for _, tt := range []struct {
+		name           string
+		spec           arov1alpha1.ProxySpec
+		existing       configv1.ProxySpec
+		existingBundle *corev1.ConfigMap
+		want           configv1.ProxySpec
+		wantBundle     string
+	}{
+		{
+			name:     "no proxy configured",
+			existing: configv1.ProxySpec{HTTPProxy: "http://customer:3128"},
+			want:     configv1.ProxySpec{HTTPProxy: "http://customer:3128"},
+		},
+		{
+			name: "proxy configured",
+			spec: arov1alpha1.ProxySpec{
+				HTTPProxy:  "http://proxy:3128",
+				HTTPSProxy: "http://proxy:3128",
+				NoProxy:    "example.com",
+			},
+			existing: configv1.ProxySpec{
+				ReadinessEndpoints: []string{"http://www.microsoft.com"},
+			},
+			want: configv1.ProxySpec{
+				HTTPProxy:          "http://proxy:3128",
+				HTTPSProxy:         "http://proxy:3128",
+				NoProxy:            "example.com",
+				ReadinessEndpoints: []string{"http://www.microsoft.com"},
+			},
+		},
+		{
+			name: "proxy changed",
+			spec: arov1alpha1.ProxySpec{
+				HTTPSProxy: "http://newproxy:3128",
+			},
+			existing: configv1.ProxySpec{
+				HTTPSProxy: "http://proxy:3128",
+				NoProxy:    "example.com",
+			},
+			want: configv1.ProxySpec{
+				HTTPSProxy: "http://newproxy:3128",
+			},
+		},
+		{
+			name: "trusted CA configured",
+			spec: arov1alpha1.ProxySpec{
+				HTTPSProxy: "http://proxy:3128",
+				TrustedCA:  "proxy ca\n",
+			},
+			want: configv1.ProxySpec{
+				HTTPSProxy: "http://proxy:3128",
+				TrustedCA: configv1.ConfigMapNameReference{
+					Name: userCABundleName,
+				},
+			},
+			wantBundle: "proxy ca\n",
+		},
+		{
+			name: "trusted CA added to the customer's bundle",
+			spec: arov1alpha1.ProxySpec{
+				HTTPSProxy: "http://proxy:3128",
+				TrustedCA:  "proxy ca\n",
+			},
+			existing: configv1.ProxySpec{
+				HTTPSProxy: "http://proxy:3128",
+				TrustedCA: configv1.ConfigMapNameReference{
+					Name: userCABundleName,
+				},
+			},
+			existingBundle: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      userCABundleName,
+					Namespace: userCABundleNamespace,
+				},
+				Data: map[string]string{
+					userCABundleKey: "customer ca",
+				},
+			},
+			want: configv1.ProxySpec{
+				HTTPSProxy: "http://proxy:3128",
+				TrustedCA: configv1.ConfigMapNameReference{
+					Name: userCABundleName,
+				},
+			},
+			wantBundle: "customer ca\nproxy ca\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					Proxy: tt.spec,
+				},
+			})
+			configcli := configfake.NewSimpleClientset(&configv1.Proxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: clusterProxyName,
+				},
+				Spec: tt.existing,
+			})
+
+			kubernetescli := fake.NewSimpleClientset()
+			if tt.existingBundle != nil {
+				kubernetescli = fake.NewSimpleClientset(tt.existingBundle)
+			}
+
+			r := NewReconciler(utillog.GetLogger(), kubernetescli, arocli, configcli)
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: types.NamespacedName{Name: arov1alpha1.SingletonClusterName}})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			proxy, err := configcli.ConfigV1().Proxies().Get(ctx, clusterProxyName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(proxy.Spec, tt.want) {
+				t.Error(proxy.Spec)
+			}
+
+			cm, err := kubernetescli.CoreV1().ConfigMaps(userCABundleNamespace).Get(ctx, userCABundleName, metav1.GetOptions{})
+			switch {
+			case tt.wantBundle == "" && !kerrors.IsNotFound(err):
+				t.Error(err)
+			case tt.wantBundle != "" && err != nil:
+				t.Fatal(err)
+			case tt.wantBundle != "" && cm.Data[userCABundleKey] != tt.wantBundle:
+				t.Error(cm.Data[userCABundleKey])
+			}
+		})
+	}
+}
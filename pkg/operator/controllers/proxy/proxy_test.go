@@ -0,0 +1,145 @@
+package proxy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"crypto/x509"
+	"net/http"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	utiltls "github.com/Azure/ARO-RP/pkg/util/tls"
+)
+
+func TestEnv(t *testing.T) {
+	if env := Env(&arov1alpha1.ProxySpec{}); env != nil {
+		t.Error(env)
+	}
+
+	env := Env(&arov1alpha1.ProxySpec{
+		HTTPSProxy: "http://proxy:3128",
+		NoProxy:    "example.com, .internal",
+	}, "172.30.0.0/16")
+
+	want := []corev1.EnvVar{
+		{
+			Name:  "HTTPS_PROXY",
+			Value: "http://proxy:3128",
+		},
+		{
+			Name:  "NO_PROXY",
+			Value: "localhost,127.0.0.1,.svc,.cluster.local,169.254.169.254,example.com,.internal,172.30.0.0/16",
+		},
+	}
+	if !reflect.DeepEqual(env, want) {
+		t.Error(env)
+	}
+}
+
+func TestRootCAs(t *testing.T) {
+	cakey, cacerts, err := utiltls.GenerateKeyAndCertificate("proxy-ca", nil, nil, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, servercerts, err := utiltls.GenerateKeyAndCertificate("server", cakey, cacerts[0], false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	trustedCA, err := utiltls.CertAsBytes(cacerts[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pool := RootCAs(&arov1alpha1.ProxySpec{TrustedCA: string(trustedCA)}); pool != nil {
+		t.Error("expected system roots when no proxy is set")
+	}
+
+	pool := RootCAs(&arov1alpha1.ProxySpec{
+		HTTPSProxy: "http://proxy:3128",
+		TrustedCA:  string(trustedCA),
+	})
+
+	_, err = servercerts[0].Verify(x509.VerifyOptions{Roots: pool})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFunc(t *testing.T) {
+	if f := Func(&arov1alpha1.ProxySpec{}); f != nil {
+		t.Error("expected nil proxy func")
+	}
+
+	f := Func(&arov1alpha1.ProxySpec{
+		HTTPProxy:  "http://httpproxy:3128",
+		HTTPSProxy: "http://httpsproxy:3128",
+		NoProxy:    ".internal,10.0.0.0/8,example.com",
+	})
+
+	for _, tt := range []struct {
+		url  string
+		want string
+	}{
+		{
+			url:  "http://www.microsoft.com/",
+			want: "http://httpproxy:3128",
+		},
+		{
+			url:  "https://www.microsoft.com/",
+			want: "http://httpsproxy:3128",
+		},
+		{
+			url: "https://kubernetes.default.svc/",
+		},
+		{
+			url: "https://foo.internal/",
+		},
+		{
+			url: "https://example.com/",
+		},
+		{
+			url: "https://www.example.com/",
+		},
+		{
+			url:  "https://notexample.com/",
+			want: "http://httpsproxy:3128",
+		},
+		{
+			url: "https://10.1.2.3:6443/",
+		},
+		{
+			url: "http://169.254.169.254/metadata",
+		},
+		{
+			url:  "https://11.1.2.3/",
+			want: "http://httpsproxy:3128",
+		},
+	} {
+		t.Run(tt.url, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, tt.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			u, err := f(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got string
+			if u != nil {
+				got = u.String()
+			}
+
+			if got != tt.want {
+				t.Error(got)
+			}
+		})
+	}
+}
@@ -140,7 +140,7 @@ func (r *PullSecretReconciler) pullsecret(ctx context.Context) (*corev1.Secret,
 }
 
 // SetupWithManager setup our manager
-func (r *PullSecretReconciler) SetupWithManager(mgr ctrl.Manager) error {
+func (r *PullSecretReconciler) SetupWithManager(mgr ctrl.Manager, gates *controllers.Gates) error {
 	pullSecretPredicate := predicate.NewPredicateFuncs(func(meta metav1.Object, object runtime.Object) bool {
 		return (meta.GetName() == pullSecretName.Name && meta.GetNamespace() == pullSecretName.Namespace) ||
 			(meta.GetName() == operator.SecretName && meta.GetNamespace() == operator.Namespace)
@@ -160,5 +160,5 @@ func (r *PullSecretReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			builder.WithPredicates(pullSecretPredicate),
 		).
 		Named(controllers.PullSecretControllerName).
-		Complete(r)
+		Complete(gates.Reconciler(controllers.PullSecretControllerName, r))
 }
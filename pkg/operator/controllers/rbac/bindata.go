@@ -1,6 +1,9 @@
 // Code generated for package rbac by go-bindata DO NOT EDIT. (@generated)
 // sources:
+// staticresources/clusterrole-impersonator.yaml
 // staticresources/clusterrole.yaml
+// staticresources/clusterrolebinding-elevated.yaml
+// staticresources/clusterrolebinding-impersonator.yaml
 // staticresources/clusterrolebinding.yaml
 package rbac
 
@@ -78,7 +81,27 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _clusterroleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x59\x4f\xaf\xe3\xb8\x0d\xbf\xe7\x53\x18\xdb\xc3\x02\x05\x5e\x06\x45\x2f\xc5\xeb\x71\xb7\x28\x0a\x14\x5d\x60\x30\xed\x9d\x91\x19\x9b\x1b\x59\xd4\x48\x54\xde\xa4\x9f\xbe\x90\x2c\x39\x71\xe2\xc4\x8e\xd3\x99\x53\x2c\x8a\xe2\x8f\x94\x28\xfe\x51\xfe\x50\xfd\xc2\x35\x56\x0d\x1a\x74\x20\x58\x57\xbb\x53\xd5\x82\x3a\x7c\x6a\xd0\xd4\xe4\x15\x1f\xd1\x9d\x14\xa8\x16\xff\x5a\xfd\xfa\x5b\xf5\xaf\xdf\xbe\x54\x7f\xfb\xf5\x1f\x5f\xb6\x1b\xb0\xf4\x1f\x74\x9e\xd8\xbc\x57\x6e\x07\x6a\x0b\x41\x5a\x76\xf4\x5f\x10\x62\xb3\x3d\xfc\xc5\x6f\x89\x3f\x1d\xff\xb4\x39\x90\xa9\xdf\xab\x5f\x74\xf0\x82\xee\x33\x6b\xdc\x74\x28\x50\x83\xc0\xfb\xa6\xaa\x94\xc3\xb4\xe0\x0b\x75\xe8\x05\x3a\xfb\x5e\x99\xa0\xf5\xa6\xaa\x0c\x74\xf8\x5e\xf9\x93\x17\xec\xde\xc1\xf1\x9b\x77\xb8\x71\x41\xa3\x7f\xdf\xbc\x55\x60\xe9\xef\x8e\x83\xf5\x51\xc8\x5b\xf5\xd3\x4f\x9b\xaa\x72\xe8\x39\x38\x85\x99\xa6\xb8\xb3\x6c\xd0\x88\x17\x90\xe0\xd1\x6f\xaa\xea\x88\x6e\x97\xa7\x1b\x94\xf4\xab\xc9\xcb\x52\x81\x66\x4f\x4d\x07\xd6\xa7\x21\x9a\xda\x32\x19\xc9\xa3\x23\x96\x4f\x4d\x1d\x89\x03\xd3\x60\x3f\x8e\x96\x78\x0b\xaa\x0c\xb9\xce\x5f\x36\x6e\xa0\x17\x34\x72\x64\x1d\x3a\x54\x1a\xa8\x9b\x9e\xca\x54\xae\x87\x0f\xc1\xce\x6a\x90\x3c\xe3\xd0\x6a\x52\x69\x2b\x15\x1b\x71\xac\x35\xba\x32\xd5\x5b\xf1\x35\xb0\x40\x4f\xf2\xe8\x8e\xa4\x10\x94\xe2\x50\xb4\xce\xb4\x47\xbb\x14\x3f\x3e\x40\x54\xbb\x6c\xbf\xa2\xb6\x9f\x34\x37\xb7\x12\x6f\x96\x43\xdd\x91\x8f\xce\xe4\xb0\x21\x2f\xee\xd2\x89\x6e\x05\x77\x41\x40\xc8\x34\x1f\xb8\x6b\x99\x0f\xfd\xb9\x84\x7e\x51\x6f\xcc\x11\x34\xd5\x0f\x79\x56\xd8\x08\x96\xf0\x9b\xa0\x89\x7a\xfa\xbb\xca\xa9\xe0\x85\xbb\x42\xac\x71\x4f\x86\x5e\x03\x5d\xb4\x27\x60\xe9\xb5\x13\x04\x9b\xfc\xfa\xd6\xe7\xb3\x33\x39\x3c\x92\x1f\x36\xb8\x06\xec\xd8\x78\xcc\xce\x53\xa3\xd5\x7c\xea\x86\x2b\x90\xdd\x71\x98\x8f\x57\x10\xf7\x41\x67\xc2\x4a\xf5\xb6\x6c\xd1\xf8\x96\xf6\x32\xb9\x05\x67\x25\xfa\xd3\xfe\x81\x48\x4b\xdd\xdc\xf1\x8c\x64\xd5\xc7\xc9\xb5\xaa\x07\x69\xd1\x48\x0e\x04\x77\x7d\x45\xf8\x80\x26\x9e\x27\x7e\x5c\x01\xa5\x70\x8c\xd3\x82\xaf\x83\xfb\xad\x5c\x8f\x7a\xef\xc3\xee\x77\x54\x02\x4a\xa1\xf7\x67\x8c\xd1\x64\x8a\xe2\xa3\xb9\xe9\x45\x4f\x2b\xb6\x68\x6f\x1d\x6b\xdc\x91\xa9\xc9\x34\xfe\x9a\x9e\xbd\xf7\x9a\xa3\x4c\x2d\x4e\x1f\xcf\xa8\x55\x86\x13\x5b\xf6\x43\xb6\xe5\xc2\x5a\x87\x5e\x1c\xa9\x57\xc2\x55\x10\xf6\x0a\x34\x99\xe6\x16\x29\xa9\xc4\x46\x40\x5b\xae\x0b\xe7\x2b\xce\x5e\xa0\x96\x1d\xfc\x18\xf1\xad\xea\x40\xb5\x64\xf0\x65\x45\x76\x89\x7c\x8b\xea\xd8\xfc\xce\xbb\x1e\x2b\x7f\xac\x91\x1e\x48\xd7\x33\x06\x26\x9e\x73\xd0\xcb\x84\xef\x0d\xb8\x34\xea\x29\x74\x42\xfb\x18\x94\xf0\x41\xda\xbc\x60\xa2\xc6\x24\x67\xfc\x1a\xd0\xaf\xcd\x17\x4a\x73\xa8\x95\xc3\x3a\xc6\x43\xd0\x73\x1e\x32\x30\xfa\x17\x61\xd3\x21\xcc\xa0\xe5\x5c\x5d\xfc\x70\x1c\xb5\xaf\x0e\x70\xf0\x5e\xb6\xb1\x42\x67\x37\x22\x1e\xfb\x12\xdc\x17\x6c\x3f\xc4\xb0\xda\xf8\xfc\xb5\x47\x90\xe0\xb0\x19\x6a\x45\xea\xa0\x94\xa6\x64\xf6\x0e\xbc\xb8\xa0\x22\x4b\xa1\xc5\x38\x50\x56\x1b\x94\x0f\x76\x87\x7e\xc0\x51\xd5\xfc\x99\xd5\x69\x43\xf6\x70\xeb\x38\x06\xab\x61\xf0\x8d\xb2\x04\xaf\x5a\xac\xc3\xfa\xeb\x95\xcd\x9a\x3b\xc1\x9e\x4b\x69\xaa\xf9\xc3\x68\x86\x7a\xb4\x29\xb1\x70\x73\x06\xb4\xe6\x46\x93\x39\x8c\xe6\x6e\x08\x86\xb3\x2b\x5e\x6f\xed\x09\x3a\xed\xa1\xb3\x8f\xb3\xc1\x43\x5b\xd8\xd5\x64\x1e\xa7\x51\x8d\xf0\xb8\x5b\x79\x00\x30\xf4\x6c\x77\xa5\x0f\x6d\x8b\x5e\x5f\x2b\xf6\xcd\xce\x7d\x88\xd2\x0b\xad\x11\x3d\x54\xd8\xb7\x72\x2f\x3d\x73\x85\xe8\xbd\xe6\x8f\x5c\xcf\x6e\x87\x2b\x78\xd7\x88\xc8\x1d\x5d\xb7\x83\xe2\xd2\xc4\x8e\xe4\xa4\xf1\x88\xfa\xff\xd1\x4e\xb4\xa8\xbb\x19\xa7\x8e\x2c\xaa\x05\x27\x0e\x2d\x7b\x12\x76\xb4\xd6\xf8\x74\xe9\x67\xe0\x2e\x03\x43\xfa\x14\x87\xd0\x7d\x77\xc0\x84\x32\x60\xcf\x65\x93\x27\xe5\x0a\x34\x17\x16\xe5\xd1\xe2\x3a\x2e\x2d\xca\xfd\xd7\x69\x5b\x62\xde\x7c\x28\x1a\x12\x71\x12\x60\x5d\x30\xab\xc3\x5f\x76\xfa\xa5\xe0\xb5\xf1\x0e\x15\xbb\xb5\xd9\x3f\x5e\x07\x65\x68\xab\x8c\xda\x4f\x02\xe4\x84\xf0\x06\x22\xa0\xda\xd8\x03\xbd\xbd\xdc\xe4\xe6\x4a\x6c\xc6\xb4\xcc\xd5\x22\x68\x69\x55\x8b\xea\x30\xaa\xe3\xc6\x83\xd5\x8d\x66\x16\x30\xba\xe1\xf3\x07\x2e\x40\x06\x9d\x0b\x46\xa8\xc3\x4b\x07\x38\x37\xd0\x97\xd4\x43\xd8\xa1\x46\xb9\x24\x8d\x70\x2d\xb3\x9e\x20\xaf\x35\x09\x05\xf4\x9f\xa7\x0b\x39\x70\x98\xa6\x5b\xf6\xe7\xdc\xdd\x77\xfa\xb9\xfd\x59\x07\xe8\x48\xdd\x4f\x10\x17\x4f\x60\xfc\xd0\x51\x6f\x25\x53\x33\xf3\x10\xe2\x85\x5d\xba\xfd\x43\xbd\x93\x29\xb9\x56\x1a\x24\xac\xb5\x8d\x4d\x0a\xc4\xa6\xd9\x2a\x76\xc8\x7e\xab\xb8\x9b\xa8\xf1\x34\x3a\xe9\xc0\xc4\xf8\x31\x98\x9a\xd7\x0e\xfb\xbc\xc3\xe1\xb3\x43\x69\x31\xf8\x1b\x42\xea\x95\x7b\x33\xfa\xe7\x9d\x91\x0c\x69\xc1\x70\xe2\x59\x1b\x5e\xf2\x75\x5e\xd6\x41\x8d\x8a\x41\x4c\x71\x29\x93\x2c\x6b\x52\xa5\xe2\x4b\xbe\x14\x76\xa6\xbc\xfe\x18\x94\xd1\x1b\xe8\x6b\x6a\x2e\x89\x82\x85\xcd\x1e\xe8\x35\xc0\x78\xcc\xf7\x3c\x2d\x47\x66\xa5\x61\xa8\x96\x27\x6b\xe7\x8b\xbd\x59\xa3\x08\xd7\x78\x57\x85\x12\x70\x06\x15\x56\x00\x2c\xdc\xd2\xa9\x2e\xe5\xaa\xc9\x1a\xb5\x26\xca\x53\xed\x68\x68\x72\xae\x62\xe2\xb9\x51\x51\x9e\xbc\x01\xeb\x5b\x96\xeb\x97\xeb\x73\x0f\x83\xa2\xea\x8b\x84\x1a\x19\x63\x89\x99\xf4\x1b\xb9\x5e\x39\x93\x2b\x49\x31\xde\x5e\x75\x5d\x91\x74\x66\x1b\xdd\xd4\x38\x35\xea\x5a\x32\x69\x22\x8e\x94\x8b\x38\x6e\x93\xca\x46\x5e\x41\x0e\xf4\x3b\xb8\xf9\x86\x2b\x18\xc5\xad\x17\x8f\xd5\x3f\x0c\x53\x0a\x04\x34\x37\x99\x76\x79\x7e\x59\x99\x51\x87\x49\xc6\x0b\x68\x6d\x35\x98\x71\x17\xd8\x24\xe0\x31\x6e\x6f\x43\xd8\x79\xe5\xc8\xbe\x10\x70\x2d\xa8\x43\xdc\x86\xed\x32\x8b\x32\x7b\x07\x86\xf6\x33\x7d\xfc\x2d\x54\x74\xa5\xd3\xe4\x3f\x19\x35\x79\x17\x92\x19\xbb\x50\x37\x25\xb2\xc5\xf4\x85\x2a\xc4\xbe\xe0\xb5\x5b\x9e\xbb\xe7\x99\x3b\x98\xb9\xa6\x9f\x28\xee\x98\xf4\x8c\xe0\x95\xca\xa7\x7f\x96\x66\xdf\x3c\xac\x26\xac\xcb\x5b\xeb\xf5\x7f\x52\x8b\x8f\x68\x09\xd6\xb3\x20\x0f\x4c\xbb\xfb\xd7\xe6\x8f\x7c\x5e\x7e\xa4\x1f\x07\x99\xab\x9b\x13\xcf\x4a\xf9\xf9\xb5\xe3\xf9\xd6\x67\x0d\x56\x1f\x72\x1f\xa5\xdc\xd2\x84\xbf\x94\xf0\xca\x8d\xdd\x92\xe9\x1f\x85\xe6\xf6\x0f\x4c\x83\xa0\x35\xab\x57\xea\xc6\x01\xf5\x69\xb0\xf3\xda\x94\xf7\xbe\xc5\xf4\xe1\xc5\x01\xad\x7e\x64\x29\x09\x77\x9b\x53\xcc\xdd\xfd\xce\x7f\x3e\x97\xfc\x7c\x51\xe9\x5c\xcd\xe4\x84\x3c\x31\xb5\x56\xc5\x19\xcd\xae\xcb\x0b\x4f\xe7\xa6\x22\xaf\xbd\x55\xf7\xdc\xb3\xae\xd4\xaa\xfc\xe9\x3e\xf7\x3c\xee\xf8\x80\xae\x30\xa7\xac\x69\x4a\x7a\x7d\x4c\x5d\xab\x57\x30\x38\xf7\x66\x6f\x1d\xef\xa9\xc4\xa0\xb4\x60\x25\x58\xf0\x38\x17\x07\x2e\xea\x01\x4a\xc5\xa1\x94\x02\x2d\x2e\xfe\x7e\xb8\x91\x25\x03\x9e\x3a\xb0\x76\xba\x85\xbd\x91\xfc\xd1\xa2\x43\xd8\x71\x90\x99\xc7\x0f\xb2\xe7\xce\x9c\x8f\xe8\x74\x8f\x91\x2e\x2d\x59\x87\xb1\x68\x7a\x2a\x48\x18\x36\x9f\x33\xc4\xbf\x3f\xff\x33\x73\xff\xfc\xc7\x9f\x6f\x97\xff\x2f\x00\x00\xff\xff\xdb\x34\x03\x1c\xaa\x23\x00\x00")
+var _clusterroleImpersonatorYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x51\x4d\xaf\xd3\x40\x0c\xbc\xef\xaf\x18\xbd\x5c\x49\x9e\xb8\xa1\x5c\x11\x42\x5c\x38\x14\xc4\xdd\x49\xdc\x66\xd5\xcd\x3a\xb2\xbd\xa5\xe1\xd7\xa3\x2d\x95\x1a\xa0\xef\x36\xf2\x78\x77\x3e\xdc\xe0\xfb\xcc\x58\x45\x9d\x12\xce\x65\xe0\x51\xf2\x31\x9e\xb0\xaa\x5c\x37\x50\xf1\x99\xb3\xc7\x91\x9c\x0d\x64\xb0\xcd\x9c\x97\x9e\x54\x5a\x53\x06\xe5\x09\x71\x59\x59\x4d\x72\x5d\x09\x0d\xa2\x1b\xa7\x23\x62\x86\xcf\x8c\x2c\xb9\xe5\xc4\x17\x72\x9e\xf0\xed\xf0\x09\x27\x95\xb2\xbe\x03\xb9\x6b\x1c\x8a\xc7\x7c\x82\x3f\x0c\x14\x63\xc5\xcf\xe8\x73\x1d\x86\x06\x55\xe7\x8f\xb7\xb6\x52\x99\x16\x46\x05\xe0\xab\x2b\x75\xc0\x17\xc7\x52\xcc\x91\xc5\x31\x30\x68\x48\x0c\x97\xbd\x27\x50\xde\x06\x99\xb6\xd0\x80\x93\x71\x17\x68\x8d\x3f\x58\x2d\x4a\xee\xa1\x03\x8d\x5d\x0d\x29\x1a\x7f\x91\x47\xc9\xdd\xf9\x83\x75\x51\x5e\x2f\xef\xc3\x39\xe6\xa9\xc7\xc7\x54\xcc\x59\x0f\x92\x38\x2c\xec\x34\x91\x53\x1f\x80\xea\xa5\xff\xa7\x8f\xf6\xa1\x2b\x1a\xb4\x24\xb6\x3e\xb4\xa0\x35\x7e\xae\xb1\xad\xbe\x6b\xf1\xf2\x12\x00\x65\x93\xa2\x23\x7f\xa5\x85\xef\xf3\xbf\xff\xda\xed\xdc\xf9\x1a\xdc\x6e\xe8\x56\x62\x85\x17\xd6\xe1\xce\xee\x22\xff\x2f\xb9\xbb\xe3\x23\xe3\x73\x85\x5b\xb3\xf6\xfa\xa4\xf9\xb7\xf5\x7e\x0f\x00\xfa\x8f\x1e\x27\x45\x02\x00\x00")
+
+func clusterroleImpersonatorYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_clusterroleImpersonatorYaml,
+		"clusterrole-impersonator.yaml",
+	)
+}
+
+func clusterroleImpersonatorYaml() (*asset, error) {
+	bytes, err := clusterroleImpersonatorYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "clusterrole-impersonator.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _clusterroleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x59\x4f\xaf\xe3\xb8\x0d\xbf\xe7\x53\x18\xdb\xc3\x02\x05\x92\x41\xd1\x4b\xf1\x7a\xdc\x2d\x8a\x02\x45\x17\x18\x4c\x7b\x67\x64\xc6\xe6\x46\x16\x35\x94\x94\x37\xe9\xa7\x2f\xa4\x48\x8e\x1d\x27\x71\x9e\xdd\x99\x53\x62\x8a\xe2\x8f\xa2\x28\xfe\x91\xfe\x50\xfd\xc2\x35\x56\x0d\x1a\x14\xf0\x58\x57\xfb\x73\xd5\x82\x3a\x7e\x6a\xd0\xd4\xe4\x14\x9f\x50\xce\x0a\x54\x8b\x7f\xad\x7e\xfd\xad\xfa\xd7\x6f\x5f\xaa\xbf\xfd\xfa\x8f\x2f\xbb\x0d\x58\xfa\x0f\x8a\x23\x36\x6f\x95\xec\x41\xed\x20\xf8\x96\x85\xfe\x0b\x9e\xd8\xec\x8e\x7f\x71\x3b\xe2\x4f\xa7\x3f\x6d\x8e\x64\xea\xb7\xea\x17\x1d\x9c\x47\xf9\xcc\x1a\x37\x1d\x7a\xa8\xc1\xc3\xdb\xa6\xaa\x94\x60\x9a\xf0\x85\x3a\x74\x1e\x3a\xfb\x56\x99\xa0\xf5\xa6\xaa\x0c\x74\xf8\x56\xb9\xb3\xf3\xd8\xbd\x81\xf0\xd6\x09\x6e\x24\x68\x74\x6f\x9b\x6d\x05\x96\xfe\x2e\x1c\xac\x8b\x42\xb6\xd5\x4f\x3f\x6d\xaa\x4a\xd0\x71\x10\x85\x99\xa6\xb8\xb3\x6c\xd0\x78\xe7\xc1\x07\x87\x6e\x53\x55\x27\x94\x7d\x1e\x6e\xd0\xa7\x5f\x4d\xce\xbf\x2a\xd0\x1c\xa8\xe9\xc0\x46\x49\xdb\x0a\x4d\x6d\x99\x8c\xcf\x5f\x27\x2c\x7f\x35\x75\xe4\x05\x4c\x93\x20\xb7\x69\x25\xce\x82\x2a\x9f\x5c\xe7\x7f\x36\x1a\xd0\x79\x34\xfe\xc4\x3a\x74\xa8\x34\x50\x77\x7f\x28\x53\xb9\xee\xff\x78\xec\xac\x06\x9f\x47\x04\xad\x26\x95\x6c\xaf\xd8\x78\x61\xad\x51\xca\xd0\xc5\x2c\x5f\x03\x7b\xb8\x90\x1c\xca\x89\x14\x82\x52\x1c\x8c\x1f\xd1\x9e\x59\x29\xb2\xbd\x83\x57\xed\x6b\xf6\xb2\x5c\xbb\x4f\x9a\x9b\xa9\xc4\xc9\x74\xa8\x3b\x72\xd1\x99\x04\x1b\x72\x5e\x86\x4e\x34\x15\xdc\x05\x0f\x9e\x4c\xf3\x8e\xfb\x96\xf9\x78\xd9\x97\x70\x99\x14\xf5\xdf\x56\x27\xd0\x54\x3f\xe5\x59\xb0\x46\xb0\x84\xdf\x3c\x9a\xa8\xa7\x7b\xa8\x9c\x0a\xce\x73\x57\x88\x35\x1e\xc8\xd0\x3a\xd0\x97\x6c\x02\x96\xd6\xed\x20\x58\xeb\xa6\x62\xaf\xce\x24\x78\x22\x97\xd7\xb1\xad\x6a\xc0\x8e\x8d\xc3\xec\x3c\x35\x5a\xcd\xe7\xae\x3f\x02\xd9\x1d\xfb\xf1\x78\x04\xf1\x10\x74\x26\x2c\x54\x6f\xc7\x16\x8d\x6b\xe9\xe0\xef\x9a\xe0\xaa\xc4\x65\xb7\x7f\x20\xd2\xab\x6e\x2e\x3c\x23\x59\x5d\xe2\xe4\x52\xd5\x83\x6f\xd1\x78\x52\xcf\x7d\xc5\xf3\x11\x4d\xdc\x4f\x7c\xbf\x01\x4a\xe1\x18\xef\x0b\xbe\x0d\xee\x53\xb9\x0e\xf5\xc1\x85\xfd\xef\xa8\x3c\x28\x85\xce\x5d\x31\x46\x83\x29\x8a\x8f\xc6\xee\x4f\xfa\xb0\x62\x2f\xd9\x56\x58\xe3\x9e\x4c\x4d\xa6\x71\xb7\xf4\xec\xbd\xb7\x1c\x65\xe8\xd1\x96\xac\x52\xab\x7c\xde\x31\xd9\x0f\x31\xcb\xc0\x1e\x82\xce\x0b\xa9\x35\xe1\x2a\x78\x76\x0a\x34\x99\x66\x8a\x94\x54\x62\xe3\x41\x5b\xae\x0b\x27\xca\x7a\xa8\xd7\x36\x7e\x8c\xb8\xad\x3a\x50\x2d\x19\x5c\xad\xc8\x3e\x05\xd4\x29\xaa\xb0\xf9\x9d\xf7\x51\xe8\xb6\xca\x7f\x96\x48\x0f\xa4\xeb\x99\x05\x26\x9e\x6b\xd0\xcb\x84\xef\x0d\xf8\x6a\x72\x57\x28\x9e\x0e\x31\x28\xe1\x93\xb4\x39\x60\xa2\xc6\x24\x67\xfc\x1a\xd0\x2d\xcd\x17\x4a\x73\xa8\x95\x60\x1d\xe3\x21\xe8\x99\xf5\x5c\x19\xdd\x4a\xd8\xb4\x09\x33\x68\x39\x57\x17\x3f\x1c\x47\xed\x9b\x0d\xec\xbd\x97\x6d\xac\xd0\x59\x46\xc4\x13\xca\x35\x27\x2b\x36\xae\x8f\x61\xb5\x71\xf9\xdf\x01\xc1\x07\xc1\xa6\xaf\x15\xa9\x83\x52\x9a\x92\x39\x08\x38\x2f\x41\x45\x96\x42\x6b\x04\x5d\x99\x6d\xd0\xbf\xb3\x1c\x2f\x1f\x1c\x55\xcd\x7f\xb3\x3a\x6d\xc8\x1e\x6e\x85\x63\xb0\xea\x3f\xbe\x51\x96\xe0\x54\x8b\x75\x58\x7e\xbc\xf2\xb2\xe6\x76\xf0\xc2\xa5\x34\xd5\xfc\x6e\x34\x43\xb1\xde\x85\x1e\x0b\x37\x31\xa0\x35\x37\x9a\xcc\x71\x34\x36\x21\x18\xce\xae\x78\x6b\xda\x33\x74\xda\x41\x67\x35\x2e\x5e\x0b\x4b\x4d\xe6\x79\x1a\xd5\x08\x6e\x29\x40\xdf\xb3\x3d\x94\xde\xb7\x2d\x7a\x79\xad\x78\x69\x76\x1e\x43\x94\x5e\x68\x89\xe8\xbe\xc2\x9e\xca\x1d\x7a\xe6\x02\xd1\x07\xcd\xef\xb9\x9e\xdd\xf5\x47\xf0\xe1\x22\x22\x77\x74\xdd\x2e\xf7\x4c\x56\x88\x85\xfc\x59\xe3\x09\xf5\xff\xa3\x9d\x68\x51\x77\x33\x4e\x1d\x59\x54\x0b\xe2\x05\x2d\x3b\xf2\x2c\xb4\x74\xf1\xe9\xd0\xcf\xc0\x0d\x03\x43\xfa\xeb\x05\xa1\xfb\xee\x80\x09\xa5\xc7\xbe\x81\x5a\x2b\xd7\x43\x4e\x89\x09\x20\x7f\x3d\x5a\xce\x7d\xb0\xdc\x7f\x9d\x77\x25\x04\xcf\xa0\x0f\x13\x71\x42\xb5\x12\xcc\xe2\xf0\x97\x9d\xfe\x55\xf0\xda\x38\x41\xc5\x52\x2f\x84\x8b\xc7\x41\x19\xda\x29\xa3\x0e\x77\x01\x72\x42\xd8\x82\xf7\xa0\xda\xd8\x03\x6d\x57\x37\xb9\xb9\x12\x9b\x59\x5a\xe6\x6a\x11\xb4\x6f\x55\x8b\xea\xe8\x86\xf4\xf1\xc7\xe2\x46\x33\x0b\x18\x9d\xf0\x19\xc5\x62\x50\x01\x32\x28\x12\x8c\xa7\x0e\x87\x0e\x70\x6d\xa0\x87\xd4\x63\xd8\xa3\xc6\x41\x9f\x7a\x83\x6b\x99\xf5\x1d\xf2\xd2\x25\xa1\x07\xfd\xe7\xbb\xaa\xef\x41\x30\xde\xc7\xe9\x96\xdd\x35\x77\x5f\x3a\x7d\x32\x2b\x00\x85\xd4\xe3\x62\x6f\x70\x05\xc6\x4f\x1d\x75\x2a\x99\x9a\x99\x8b\x10\xe7\x59\x62\x28\xf1\x7d\xbd\x93\x29\xb9\x56\xea\x25\x2c\x5d\x1b\x9b\x14\x88\x4d\xb3\x53\x2c\xc8\x6e\xa7\xb8\x9b\xaa\x11\xfb\x09\xdf\x81\x81\xa6\x94\x79\x96\xeb\x3c\x37\x7f\x0b\xef\x8b\x15\x84\x3b\xf4\x2d\x06\x37\x21\xa4\x5e\x39\x37\xd0\xe9\x7a\x67\x24\xc3\xb7\x60\xd8\xc9\x8a\xea\x2a\x1f\xe7\x39\x0f\xbf\x94\x9b\x99\x39\xdf\x78\xa6\xb8\x94\x49\x96\x35\xa9\x52\xf1\x25\x5f\x0a\x7b\x53\x6e\x7f\x0c\xfa\xd1\x1d\xe8\x3a\x35\x5f\x89\x82\x85\xcd\x1e\x69\x1d\x20\x99\xe6\xa1\xa7\xe5\xc8\xac\x34\xf4\xd5\x72\x26\xa1\x1b\xca\x18\xd8\x66\x89\x22\x5c\xe3\x43\x15\x4a\xc0\xe9\x55\x58\x00\xf0\xa2\x49\xef\x75\x29\x37\x4d\x56\x21\x26\x5f\x51\x8e\x6a\xa1\x53\xf1\xfe\x61\xa8\xcb\xd5\x74\xfe\x70\xe4\x0c\x58\xd7\xb2\xbf\xc6\xca\xdb\x1e\x06\xbd\xca\xf5\x7c\x4a\xa8\x91\x31\x96\x98\x69\xcb\x47\xae\x57\xf6\xe4\x46\x52\x8c\xb7\x7d\xc9\x77\x25\x5d\xd9\x46\x27\x35\x0e\x8d\xba\x96\x4c\xba\x13\x47\x58\x46\x5b\xdd\xf7\x46\x17\x43\xde\x40\xf6\xf4\x07\xb8\xf9\x84\x2b\x70\xa3\x48\xb6\x6e\x5b\xdd\xd3\x30\xa5\xc0\x83\xe6\x26\xd3\x86\xfb\x97\x95\xc9\xab\x2d\xd6\x75\x1e\xb4\xb6\x1a\x8c\x1b\x81\x34\xc9\x9f\xc6\xb8\xe5\x0a\xcb\x29\x21\xbb\x22\xe0\x5a\x50\xc7\x18\xd0\x77\xaf\xad\x28\xb3\x77\x60\xe8\x30\xd3\xc7\x4f\xa1\xa2\x2b\x9d\xef\xc8\xe4\xba\x26\x27\x21\x2d\x63\x1f\xea\xa6\x44\xb6\xf8\xc4\x81\x2a\xc4\xbe\x60\xdd\x29\xcf\xdd\xf3\xcc\x19\xcc\x5c\xf7\xaf\x28\x1e\x2c\xe9\x23\x82\x17\x2a\x9f\x5e\x96\x66\x10\xc0\x5a\x4d\x58\x67\xe7\x9a\xbc\x49\x3d\x42\x5d\x84\xf5\x51\x90\x27\x4b\x7b\xf8\xb4\xf9\x18\xf5\x7a\x9d\xea\x6e\xe9\x0b\xaf\x97\x9f\xe9\xc7\xc1\xcf\x75\x43\x89\x67\xa1\xfc\x7c\xdb\xf1\x6a\xde\x5d\x55\xa6\xe6\x90\xfb\x2c\xe5\x96\x26\x7c\x55\xc2\x2b\x27\x76\x47\xe6\x72\x29\x34\x67\xbf\xf8\x94\x1b\xaf\x8e\xd4\x9a\xba\xb1\x47\xfd\x30\xd8\x75\x6e\xcc\x1b\xf8\x2d\xe6\xc9\xf8\x1e\x98\xdf\x9e\x97\xe8\x92\x13\xee\x2e\xa7\x98\x87\xf6\xce\x8f\xcf\x25\x3f\xf7\x56\x9f\x8c\xe4\x84\x7c\x67\x68\xa9\x8a\x33\x9a\xdd\x96\x17\x8e\xae\x4d\x45\x9e\x3b\x55\xf7\xda\xb3\x2e\xd4\xaa\x3c\xba\xcf\x78\xcc\x5e\xf8\x88\x52\x98\x53\xd6\x34\x25\xbd\x3e\xa7\x2e\xd5\x2b\x18\x9c\xbb\xb3\xb7\xc2\x07\x2a\x31\x28\x4d\x58\x08\x16\x1c\xce\xc5\x81\x41\x3d\x40\xa9\x38\xf4\xa5\x40\x8b\x93\xbf\x1f\x6e\x94\x9e\x01\xcf\x1d\x58\x9b\x43\xec\x0d\xd8\x44\xf2\x7b\x8b\x82\xb0\xe7\xe0\x67\x2e\x3f\xc8\x5e\x3b\xf3\x78\xcd\xaa\x2f\x18\xe9\xd0\x92\x15\x8c\x45\xd3\x87\x82\x84\x61\xf3\x39\x43\xfc\xfb\xf3\x3f\x33\xf7\xcf\x7f\xfc\x79\x3a\xfd\x7f\x03\x00\xdb\x34\x03\x1c\xaa\x23\x00\x00")
 
 func clusterroleYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -98,7 +121,47 @@ func clusterroleYaml() (*asset, error) {
 	return a, nil
 }
 
-var _clusterrolebindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xcd\xbd\x0a\xc2\x40\x0c\x07\xf0\xfd\x9e\x22\x2f\xd0\x8a\x9b\xdc\xa8\x83\x7b\x41\xf7\xb4\x8d\x1a\xdb\x26\x47\x92\x13\xf4\xe9\x45\x70\x93\x3a\xff\x3f\x7e\x58\xf8\x4c\xe6\xac\x92\xc1\x7a\x1c\x5a\xac\x71\x53\xe3\x17\x06\xab\xb4\xd3\xce\x5b\xd6\xcd\x63\x9b\x26\x96\x31\xc3\x61\xae\x1e\x64\x9d\xce\xb4\x67\x19\x59\xae\x69\xa1\xc0\x11\x03\x73\x02\x10\x5c\x28\x83\x3f\x3d\x68\xc9\x68\xda\xb8\x51\x32\x9d\xa9\xa3\xcb\x27\xc7\xc2\x47\xd3\x5a\xfe\x58\x09\xe0\x87\x5a\x7b\xf6\xda\xdf\x69\x08\xcf\xa9\xf9\x8e\x4e\x4e\xb6\xd6\x7e\x07\x00\x00\xff\xff\xc4\xb6\x1b\x05\xeb\x00\x00\x00")
+var _clusterrolebindingElevatedYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\xce\x31\x0e\xc2\x30\x0c\x85\xe1\x3d\xa7\xc8\x05\x52\xc4\x86\x32\xc2\xc0\xde\x81\xdd\x6d\x0c\x98\x26\x71\x65\x3b\x95\xe0\xf4\x08\x90\x58\x90\x40\xec\x4f\xef\xfb\x61\xa6\x03\x8a\x12\xd7\xe8\x65\x80\xb1\x83\x66\x67\x16\xba\x81\x11\xd7\x6e\xda\x68\x47\xbc\x5a\xd6\x6e\xa2\x9a\xa2\xdf\xe5\xa6\x86\xd2\x73\xc6\x2d\xd5\x44\xf5\xe4\x0a\x1a\x24\x30\x88\xce\xfb\x0a\x05\xa3\xd7\xab\x1a\x96\x08\xc2\x41\x05\x03\x66\x5c\xc0\x30\x39\xe1\x8c\x3d\x1e\x1f\x43\x98\x69\x2f\xdc\xe6\x2f\xa8\xf3\xfe\xc3\x7c\x13\xe3\xab\x23\x40\x2a\x54\x9d\xb6\xe1\x82\xa3\x69\x74\xe1\xaf\xe7\xe7\xf0\x67\xf6\x7d\x00\xd7\x46\x34\xf5\x23\x01\x00\x00")
+
+func clusterrolebindingElevatedYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_clusterrolebindingElevatedYaml,
+		"clusterrolebinding-elevated.yaml",
+	)
+}
+
+func clusterrolebindingElevatedYaml() (*asset, error) {
+	bytes, err := clusterrolebindingElevatedYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "clusterrolebinding-elevated.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _clusterrolebindingImpersonatorYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xcd\x31\x8e\xc2\x40\x0c\x85\xe1\x7e\x4e\xe1\x0b\x24\xab\xed\x56\x53\x2e\x05\x7d\x24\xe8\x9d\xc4\x80\x49\xc6\x1e\xd9\x1e\x24\x38\x3d\x42\xa2\x43\x20\xfa\xf7\xfe\x0f\x2b\xef\xc9\x9c\x55\x32\xd8\x88\x53\x8f\x2d\x4e\x6a\x7c\xc3\x60\x95\x7e\xf9\xf3\x9e\xf5\xe7\xf2\x9b\x16\x96\x39\xc3\x66\x6d\x1e\x64\x83\xae\xf4\xcf\x32\xb3\x1c\x53\xa1\xc0\x19\x03\x73\x02\x10\x2c\x94\xc1\xaf\x1e\x54\x32\x9a\x76\x6e\xd4\x71\xa9\x64\xae\x82\xa1\x96\x4c\x57\x1a\xe8\xf0\x18\x63\xe5\xad\x69\xab\x1f\xe0\x04\xf0\xe2\x7e\xc5\x78\x1b\xcf\x34\x85\xe7\xd4\x3d\x0b\x3b\x27\x7b\x73\x4d\xf7\x01\x00\x54\xeb\x0b\xc1\x05\x01\x00\x00")
+
+func clusterrolebindingImpersonatorYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_clusterrolebindingImpersonatorYaml,
+		"clusterrolebinding-impersonator.yaml",
+	)
+}
+
+func clusterrolebindingImpersonatorYaml() (*asset, error) {
+	bytes, err := clusterrolebindingImpersonatorYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "clusterrolebinding-impersonator.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _clusterrolebindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x8d\xb1\x0a\xc2\x50\x0c\x45\xf7\xf7\x15\xf9\x81\x56\xdc\xe4\x8d\x3a\xb8\x17\x74\x4f\xdb\xa8\xb1\x6d\x52\x92\x3c\x41\xbf\x5e\x14\x37\xa9\xe0\x7c\xcf\x3d\x07\x67\x3e\x92\x39\xab\x64\xb0\x16\xbb\x1a\x4b\x5c\xd4\xf8\x81\xc1\x2a\xf5\xb0\xf1\x9a\x75\x75\x5b\xa7\x81\xa5\xcf\xb0\x1b\x8b\x07\x59\xa3\x23\x6d\x59\x7a\x96\x73\x9a\x28\xb0\xc7\xc0\x9c\x00\x04\x27\xca\xe0\x77\x0f\x9a\x32\x9a\x56\x6e\x94\x4c\x47\x6a\xe8\xf4\xda\x71\xe6\xbd\x69\x99\x7f\xb4\x12\xc0\x57\x6a\xc9\xec\xa5\xbd\x52\x17\x9e\x53\xf5\x39\x1d\x9c\x6c\x89\xae\xfe\xca\xbf\xc1\x25\xd5\x73\x00\xc5\xf6\x59\xd8\x36\x01\x00\x00")
 
 func clusterrolebindingYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -170,19 +233,24 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"clusterrole.yaml":        clusterroleYaml,
-	"clusterrolebinding.yaml": clusterrolebindingYaml,
+	"clusterrole-impersonator.yaml":        clusterroleImpersonatorYaml,
+	"clusterrole.yaml":                     clusterroleYaml,
+	"clusterrolebinding-elevated.yaml":     clusterrolebindingElevatedYaml,
+	"clusterrolebinding-impersonator.yaml": clusterrolebindingImpersonatorYaml,
+	"clusterrolebinding.yaml":              clusterrolebindingYaml,
 }
 
 // AssetDir returns the file names below a certain
 // directory embedded in the file by go-bindata.
 // For example if you run go-bindata on data/... and data contains the
 // following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
+//
+//	data/
+//	  foo.txt
+//	  img/
+//	    a.png
+//	    b.png
+//
 // then AssetDir("data") would return []string{"foo.txt", "img"}
 // AssetDir("data/img") would return []string{"a.png", "b.png"}
 // AssetDir("foo.txt") and AssetDir("notexist") would return an error
@@ -215,8 +283,11 @@ type bintree struct {
 }
 
 var _bintree = &bintree{nil, map[string]*bintree{
-	"clusterrole.yaml":        {clusterroleYaml, map[string]*bintree{}},
-	"clusterrolebinding.yaml": {clusterrolebindingYaml, map[string]*bintree{}},
+	"clusterrole-impersonator.yaml":        {clusterroleImpersonatorYaml, map[string]*bintree{}},
+	"clusterrole.yaml":                     {clusterroleYaml, map[string]*bintree{}},
+	"clusterrolebinding-elevated.yaml":     {clusterrolebindingElevatedYaml, map[string]*bintree{}},
+	"clusterrolebinding-impersonator.yaml": {clusterrolebindingImpersonatorYaml, map[string]*bintree{}},
+	"clusterrolebinding.yaml":              {clusterrolebindingYaml, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory
@@ -86,7 +86,7 @@ func (r *RBACReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
 }
 
 // SetupWithManager setup our mananger
-func (r *RBACReconciler) SetupWithManager(mgr ctrl.Manager) error {
+func (r *RBACReconciler) SetupWithManager(mgr ctrl.Manager, gates *controllers.Gates) error {
 	aroClusterPredicate := predicate.NewPredicateFuncs(func(meta metav1.Object, object runtime.Object) bool {
 		return meta.GetName() == arov1alpha1.SingletonClusterName
 	})
@@ -96,5 +96,5 @@ func (r *RBACReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&rbacv1.ClusterRole{}).
 		Owns(&rbacv1.ClusterRoleBinding{}).
 		Named(controllers.RBACControllerName).
-		Complete(r)
+		Complete(gates.Reconciler(controllers.RBACControllerName, r))
 }
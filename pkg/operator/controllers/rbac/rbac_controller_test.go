@@ -0,0 +1,104 @@
+package rbac
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	rbacv1helpers "k8s.io/kubernetes/pkg/apis/rbac/v1"
+)
+
+func TestImpersonatorRole(t *testing.T) {
+	b, err := Asset("clusterrole-impersonator.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	o, _, err := scheme.Codecs.UniversalDeserializer().Decode(b, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	role := o.(*rbacv1.ClusterRole)
+
+	allows := func(apiGroup, resource, name string) bool {
+		for i := range role.Rules {
+			rule := &role.Rules[i]
+			if rbacv1helpers.VerbMatches(rule, "impersonate") &&
+				rbacv1helpers.APIGroupMatches(rule, apiGroup) &&
+				rbacv1helpers.ResourceMatches(rule, resource, "") &&
+				rbacv1helpers.ResourceNameMatches(rule, name) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, tt := range []struct {
+		name     string
+		apiGroup string
+		resource string
+		resName  string
+		want     bool
+	}{
+		{
+			name:     "own user",
+			resource: "users",
+			resName:  "system:aro-sre",
+			want:     true,
+		},
+		{
+			name:     "own group",
+			resource: "groups",
+			resName:  "system:aro-sre",
+			want:     true,
+		},
+		{
+			name:     "portal username extra",
+			apiGroup: "authentication.k8s.io",
+			resource: "userextras/aro-portal-username",
+			resName:  "username@example.com",
+			want:     true,
+		},
+		{
+			name:     "system:admin",
+			resource: "users",
+			resName:  "system:admin",
+		},
+		{
+			name:     "another user",
+			resource: "users",
+			resName:  "system:aro-sre:username",
+		},
+		{
+			name:     "service account",
+			resource: "serviceaccounts",
+			resName:  "default",
+		},
+		{
+			name:     "system:masters",
+			resource: "groups",
+			resName:  "system:masters",
+		},
+		{
+			name:     "elevated group",
+			resource: "groups",
+			resName:  "system:aro-sre-elevated",
+		},
+		{
+			name:     "scopes extra",
+			apiGroup: "authentication.k8s.io",
+			resource: "userextras/scopes.authorization.openshift.io",
+			resName:  "user:full",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := allows(tt.apiGroup, tt.resource, tt.resName); got != tt.want {
+				t.Error(got)
+			}
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers"
 	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
+	"github.com/Azure/ARO-RP/pkg/util/ready"
 )
 
 //RouteFixReconciler is the controller struct
@@ -89,11 +90,23 @@ func (r *RouteFixReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error
 		return reconcile.Result{}, err
 	}
 
+	ds, err := r.kubernetescli.AppsV1().DaemonSets(kubeNamespace).Get(ctx, kubeName, metav1.GetOptions{})
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	if ready.DaemonSetIsReady(ds) {
+		controllers.RouteFixReady.Set(1)
+	} else {
+		controllers.RouteFixReady.Set(0)
+	}
+
 	return reconcile.Result{}, nil
 }
 
 //SetupWithManager creates the controller
-func (r *RouteFixReconciler) SetupWithManager(mgr ctrl.Manager) error {
+func (r *RouteFixReconciler) SetupWithManager(mgr ctrl.Manager, gates *controllers.Gates) error {
 	aroClusterPredicate := predicate.NewPredicateFuncs(func(meta metav1.Object, object runtime.Object) bool {
 		return meta.GetName() == arov1alpha1.SingletonClusterName
 	})
@@ -103,5 +116,5 @@ func (r *RouteFixReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&corev1.Namespace{}).
 		Owns(&appsv1.DaemonSet{}).
 		Named(controllers.RouteFixControllerName).
-		Complete(r)
+		Complete(gates.Reconciler(controllers.RouteFixControllerName, r))
 }
@@ -0,0 +1,105 @@
+package specdrift
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"os"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+// The RP writes the desired Cluster spec and its signature into the operator
+// configuration secret.  It signs them with a key which it never gives to the
+// cluster, and passes the operator the public key which verifies them in the
+// PublicKeyEnv environment variable of the operator deployments.
+const (
+	DesiredSpecName          = "clusterspec.json"
+	DesiredSpecSignatureName = "clusterspec.sig"
+
+	PublicKeyEnv = "ARO_CLUSTER_SPEC_PUBLIC_KEY"
+)
+
+// MarshalPublicKey returns the public key in the PEM format expected in
+// PublicKeyEnv
+func MarshalPublicKey(key *rsa.PublicKey) ([]byte, error) {
+	b, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: b}), nil
+}
+
+// PublicKeyFromEnv returns the public key in PublicKeyEnv, or nil if it is
+// unset
+func PublicKeyFromEnv() (*rsa.PublicKey, error) {
+	s := os.Getenv(PublicKeyEnv)
+	if s == "" {
+		return nil, nil
+	}
+
+	return parsePublicKey([]byte(s))
+}
+
+func parsePublicKey(b []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(b)
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return nil, fmt.Errorf("no public key found")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("unsupported public key type %T", key)
+	}
+
+	return rsaKey, nil
+}
+
+// Sign returns the serialised spec and its signature
+func Sign(key *rsa.PrivateKey, spec *arov1alpha1.ClusterSpec) ([]byte, []byte, error) {
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	h := sha256.Sum256(b)
+
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, h[:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return b, sig, nil
+}
+
+// Verify checks the signature of the serialised spec and returns the spec
+func Verify(key *rsa.PublicKey, b, sig []byte) (*arov1alpha1.ClusterSpec, error) {
+	h := sha256.Sum256(b)
+
+	err := rsa.VerifyPKCS1v15(key, crypto.SHA256, h[:], sig)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec *arov1alpha1.ClusterSpec
+	err = json.Unmarshal(b, &spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return spec, nil
+}
@@ -0,0 +1,70 @@
+package specdrift
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"reflect"
+	"testing"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+func TestSignVerify(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spec := &arov1alpha1.ClusterSpec{
+		ResourceID: "id",
+		ACRDomain:  "acrdomain",
+	}
+
+	b, sig, err := Sign(key, spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verified, err := Verify(&key.PublicKey, b, sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(verified, spec) {
+		t.Error(verified)
+	}
+
+	_, err = Verify(&key.PublicKey, []byte(`{"resourceId":"id","acrDomain":"evil"}`), sig)
+	if err == nil || err.Error() != "crypto/rsa: verification error" {
+		t.Error(err)
+	}
+}
+
+func TestPublicKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := MarshalPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publicKey, err := parsePublicKey(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(publicKey, &key.PublicKey) {
+		t.Error(publicKey)
+	}
+
+	_, err = parsePublicKey([]byte("-----BEGIN CERTIFICATE-----\n-----END CERTIFICATE-----\n"))
+	if err == nil || err.Error() != "no public key found" {
+		t.Error(err)
+	}
+}
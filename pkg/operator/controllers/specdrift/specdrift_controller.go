@@ -0,0 +1,194 @@
+package specdrift
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+)
+
+// SpecDriftReconciler compares the Cluster spec with the desired spec signed
+// by the RP, reports any drift in the ClusterSpecSynced condition and, if
+// configured to, reverts it.  key is the RP's public key (see PublicKeyEnv).
+type SpecDriftReconciler struct {
+	log           *logrus.Entry
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.Interface
+	key           *rsa.PublicKey
+}
+
+func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.Interface, key *rsa.PublicKey) *SpecDriftReconciler {
+	return &SpecDriftReconciler{
+		log:           log,
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		key:           key,
+	}
+}
+
+// Reconcile checks the Cluster spec for drift
+func (r *SpecDriftReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): Reconcile will eventually be receiving a ctx (https://github.com/kubernetes-sigs/controller-runtime/blob/7ef2da0bc161d823f084ad21ff5f9c9bd6b0cc39/pkg/reconcile/reconcile.go#L93)
+	ctx := context.TODO()
+
+	instance, err := r.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	desired, err := r.desiredSpec(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, r.setCondition(ctx, corev1.ConditionUnknown, "InvalidSignature", err.Error())
+	}
+	if desired == nil {
+		// the RP has not yet written a desired spec
+		return reconcile.Result{}, nil
+	}
+
+	fields, err := drift(desired, &instance.Spec)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if len(fields) == 0 {
+		return reconcile.Result{}, r.setCondition(ctx, corev1.ConditionTrue, "AsExpected", "Cluster spec matches the spec set by the RP")
+	}
+
+	if !desired.Features.RevertSpecDrift {
+		return reconcile.Result{}, r.setCondition(ctx, corev1.ConditionFalse, "Drifted", fmt.Sprintf("Cluster spec fields differ from the spec set by the RP: %s", strings.Join(fields, ", ")))
+	}
+
+	r.log.Warnf("reverting drift in cluster spec fields: %s", strings.Join(fields, ", "))
+
+	return reconcile.Result{}, retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		instance, err := r.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		spec := *desired
+		arov1alpha1.CopyClusterOwnedFields(&spec, &instance.Spec)
+
+		instance.Spec = spec
+
+		_, err = r.arocli.AroV1alpha1().Clusters().Update(ctx, instance, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// desiredSpec returns the desired spec from the operator configuration secret
+// once its signature is verified.  It returns nil if the RP has not written a
+// desired spec.
+func (r *SpecDriftReconciler) desiredSpec(ctx context.Context) (*arov1alpha1.ClusterSpec, error) {
+	s, err := r.kubernetescli.CoreV1().Secrets(operator.Namespace).Get(ctx, operator.SecretName, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	b, sig := s.Data[DesiredSpecName], s.Data[DesiredSpecSignatureName]
+	if b == nil {
+		return nil, nil
+	}
+
+	if r.key == nil {
+		return nil, fmt.Errorf("no public key found to verify the desired spec")
+	}
+
+	return Verify(r.key, b, sig)
+}
+
+func (r *SpecDriftReconciler) setCondition(ctx context.Context, s corev1.ConditionStatus, reason status.ConditionReason, message string) error {
+	return controllers.SetCondition(ctx, r.arocli, &status.Condition{
+		Type:    arov1alpha1.ClusterSpecSynced,
+		Status:  s,
+		Reason:  reason,
+		Message: message,
+	}, operator.RoleMaster)
+}
+
+// drift returns the names of the top level spec fields which differ between
+// the desired and the actual spec.  Fields which are set in the cluster rather
+// than by the RP are ignored.
+func drift(desired, actual *arov1alpha1.ClusterSpec) ([]string, error) {
+	spec := *desired
+	arov1alpha1.CopyClusterOwnedFields(&spec, actual)
+	desired = &spec
+
+	var d, a map[string]json.RawMessage
+
+	for _, x := range []struct {
+		spec *arov1alpha1.ClusterSpec
+		m    *map[string]json.RawMessage
+	}{
+		{spec: desired, m: &d},
+		{spec: actual, m: &a},
+	} {
+		b, err := json.Marshal(x.spec)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(b, x.m)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var fields []string
+	for k := range d {
+		if string(d[k]) != string(a[k]) {
+			fields = append(fields, k)
+		}
+	}
+	for k := range a {
+		if _, found := d[k]; !found {
+			fields = append(fields, k)
+		}
+	}
+
+	sort.Strings(fields)
+
+	return fields, nil
+}
+
+// SetupWithManager creates the controller
+func (r *SpecDriftReconciler) SetupWithManager(mgr ctrl.Manager, gates *controllers.Gates) error {
+	aroClusterPredicate := predicate.NewPredicateFuncs(func(meta metav1.Object, object runtime.Object) bool {
+		return meta.GetName() == arov1alpha1.SingletonClusterName
+	})
+
+	// the configuration secret is owned by the Cluster, so updates to the
+	// desired spec also trigger a reconcile
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}, builder.WithPredicates(aroClusterPredicate)).
+		Owns(&corev1.Secret{}).
+		Named(controllers.SpecDriftControllerName).
+		Complete(gates.Reconciler(controllers.SpecDriftControllerName, r))
+}
@@ -0,0 +1,244 @@
+package specdrift
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+)
+
+func TestReconcile(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	desired := arov1alpha1.ClusterSpec{
+		ResourceID: "id",
+		ACRDomain:  "acrdomain",
+		IngressIP:  "1.2.3.4",
+	}
+
+	for _, tt := range []struct {
+		name          string
+		desired       arov1alpha1.ClusterSpec
+		actual        arov1alpha1.ClusterSpec
+		tamper        bool
+		signingKey    *rsa.PrivateKey
+		noPublicKey   bool
+		noDesiredSpec bool
+		wantSpec      arov1alpha1.ClusterSpec
+		wantCondition *corev1.ConditionStatus
+		wantMessage   string
+	}{
+		{
+			name:          "no desired spec",
+			actual:        desired,
+			noDesiredSpec: true,
+			wantSpec:      desired,
+		},
+		{
+			name:          "in sync",
+			desired:       desired,
+			actual:        desired,
+			wantSpec:      desired,
+			wantCondition: conditionStatus(corev1.ConditionTrue),
+			wantMessage:   "Cluster spec matches the spec set by the RP",
+		},
+		{
+			name:    "drifted",
+			desired: desired,
+			actual: arov1alpha1.ClusterSpec{
+				ResourceID: "id",
+				ACRDomain:  "otherdomain",
+				APIIntIP:   "5.6.7.8",
+			},
+			wantSpec: arov1alpha1.ClusterSpec{
+				ResourceID: "id",
+				ACRDomain:  "otherdomain",
+				APIIntIP:   "5.6.7.8",
+			},
+			wantCondition: conditionStatus(corev1.ConditionFalse),
+			wantMessage:   "Cluster spec fields differ from the spec set by the RP: acrDomain, apiIntIP, ingressIP",
+		},
+		{
+			name: "drift reverted",
+			desired: func() arov1alpha1.ClusterSpec {
+				spec := desired
+				spec.Features.RevertSpecDrift = true
+				return spec
+			}(),
+			actual: arov1alpha1.ClusterSpec{
+				ResourceID: "id",
+				ACRDomain:  "otherdomain",
+				NodeRemediation: arov1alpha1.NodeRemediationSpec{
+					MaxUnhealthy: 2,
+				},
+			},
+			wantSpec: func() arov1alpha1.ClusterSpec {
+				spec := desired
+				spec.Features.RevertSpecDrift = true
+				spec.NodeRemediation.MaxUnhealthy = 2
+				return spec
+			}(),
+		},
+		{
+			name:    "fields set in the cluster are not drift",
+			desired: desired,
+			actual: func() arov1alpha1.ClusterSpec {
+				spec := desired
+				spec.Features.ServiceMonitors = true
+				spec.GenevaLogging.Sinks = []arov1alpha1.LogSink{
+					{
+						Name: "sink",
+					},
+				}
+				return spec
+			}(),
+			wantSpec: func() arov1alpha1.ClusterSpec {
+				spec := desired
+				spec.Features.ServiceMonitors = true
+				spec.GenevaLogging.Sinks = []arov1alpha1.LogSink{
+					{
+						Name: "sink",
+					},
+				}
+				return spec
+			}(),
+			wantCondition: conditionStatus(corev1.ConditionTrue),
+			wantMessage:   "Cluster spec matches the spec set by the RP",
+		},
+		{
+			name:    "invalid signature",
+			desired: desired,
+			actual: arov1alpha1.ClusterSpec{
+				ACRDomain: "otherdomain",
+			},
+			tamper: true,
+			wantSpec: arov1alpha1.ClusterSpec{
+				ACRDomain: "otherdomain",
+			},
+			wantCondition: conditionStatus(corev1.ConditionUnknown),
+			wantMessage:   "crypto/rsa: verification error",
+		},
+		{
+			name:          "signed with another key",
+			desired:       desired,
+			actual:        desired,
+			signingKey:    otherKey,
+			wantSpec:      desired,
+			wantCondition: conditionStatus(corev1.ConditionUnknown),
+			wantMessage:   "crypto/rsa: verification error",
+		},
+		{
+			name:          "no public key",
+			desired:       desired,
+			actual:        desired,
+			noPublicKey:   true,
+			wantSpec:      desired,
+			wantCondition: conditionStatus(corev1.ConditionUnknown),
+			wantMessage:   "no public key found to verify the desired spec",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			signingKey := key
+			if tt.signingKey != nil {
+				signingKey = tt.signingKey
+			}
+
+			b, sig, err := Sign(signingKey, &tt.desired)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.tamper {
+				b, _, err = Sign(signingKey, &tt.actual)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      operator.SecretName,
+					Namespace: operator.Namespace,
+				},
+				Data: map[string][]byte{},
+			}
+			if !tt.noDesiredSpec {
+				secret.Data[DesiredSpecName] = b
+				secret.Data[DesiredSpecSignatureName] = sig
+			}
+
+			kubernetescli := fake.NewSimpleClientset(secret)
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: tt.actual,
+			})
+
+			publicKey := &key.PublicKey
+			if tt.noPublicKey {
+				publicKey = nil
+			}
+
+			r := NewReconciler(utillog.GetLogger(), kubernetescli, arocli, publicKey)
+
+			_, err = r.Reconcile(ctrl.Request{NamespacedName: types.NamespacedName{Name: arov1alpha1.SingletonClusterName}})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			instance, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(instance.Spec, tt.wantSpec) {
+				t.Error(instance.Spec)
+			}
+
+			cond := instance.Status.Conditions.GetCondition(arov1alpha1.ClusterSpecSynced)
+			switch {
+			case tt.wantCondition == nil && cond != nil:
+				t.Error(cond)
+			case tt.wantCondition != nil && cond == nil:
+				t.Error("expected condition")
+			case tt.wantCondition != nil:
+				if cond.Status != *tt.wantCondition {
+					t.Error(cond.Status)
+				}
+				if cond.Message != tt.wantMessage {
+					t.Error(cond.Message)
+				}
+			}
+		})
+	}
+}
+
+func conditionStatus(s corev1.ConditionStatus) *corev1.ConditionStatus {
+	return &s
+}
@@ -0,0 +1,107 @@
+package workaround
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
+	"github.com/Azure/ARO-RP/pkg/util/version"
+)
+
+// declarative is a workaround shipped by the RP in spec.workarounds
+type declarative struct {
+	log          *logrus.Entry
+	dh           dynamichelper.Interface
+	name         string
+	minVersion   *version.Version
+	fixedVersion *version.Version
+	objects      []runtime.Object
+	refs         []arov1alpha1.WorkaroundObject
+}
+
+var _ Workaround = &declarative{}
+
+func newDeclarative(log *logrus.Entry, dh dynamichelper.Interface, spec *arov1alpha1.WorkaroundSpec) (*declarative, error) {
+	d := &declarative{
+		log:  log,
+		dh:   dh,
+		name: spec.Name,
+	}
+
+	var err error
+	if spec.MinVersion != "" {
+		d.minVersion, err = version.ParseVersion(spec.MinVersion)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if spec.FixedVersion != "" {
+		d.fixedVersion, err = version.ParseVersion(spec.FixedVersion)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i, m := range spec.Manifests {
+		o, _, err := scheme.Codecs.UniversalDeserializer().Decode(m.Raw, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("manifest %d: %v", i, err)
+		}
+
+		acc, err := meta.Accessor(o)
+		if err != nil {
+			return nil, fmt.Errorf("manifest %d: %v", i, err)
+		}
+
+		d.objects = append(d.objects, o)
+		d.refs = append(d.refs, arov1alpha1.WorkaroundObject{
+			GroupKind: o.GetObjectKind().GroupVersionKind().GroupKind().String(),
+			Namespace: acc.GetNamespace(),
+			Name:      acc.GetName(),
+		})
+	}
+
+	return d, nil
+}
+
+func (d *declarative) Name() string {
+	return d.name
+}
+
+func (d *declarative) IsRequired(clusterVersion *version.Version) bool {
+	if d.minVersion != nil && clusterVersion.Lt(d.minVersion) {
+		return false
+	}
+
+	return d.fixedVersion == nil || clusterVersion.Lt(d.fixedVersion)
+}
+
+func (d *declarative) Ensure(ctx context.Context) error {
+	return d.dh.Ensure(ctx, d.objects...)
+}
+
+func (d *declarative) Remove(ctx context.Context) error {
+	for _, ref := range d.refs {
+		err := d.dh.EnsureDeleted(ctx, ref.GroupKind, ref.Namespace, ref.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Objects returns the objects the workaround is made up of
+func (d *declarative) Objects() []arov1alpha1.WorkaroundObject {
+	return d.refs
+}
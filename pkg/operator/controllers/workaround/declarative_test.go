@@ -0,0 +1,102 @@
+package workaround
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+	"github.com/Azure/ARO-RP/pkg/util/version"
+)
+
+const testMachineConfig = `{"apiVersion":"machineconfiguration.openshift.io/v1","kind":"MachineConfig","metadata":{"name":"99-worker-fix"}}`
+
+func TestDeclarativeIsRequired(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		minVersion   string
+		fixedVersion string
+		want         bool
+	}{
+		{
+			name: "no range",
+			want: true,
+		},
+		{
+			name:         "before fixed",
+			fixedVersion: "4.5.0",
+			want:         true,
+		},
+		{
+			name:         "after fixed",
+			fixedVersion: "4.4.10",
+		},
+		{
+			name:       "after min",
+			minVersion: "4.4.10",
+			want:       true,
+		},
+		{
+			name:       "before min",
+			minVersion: "4.4.11",
+		},
+		{
+			name:         "in range",
+			minVersion:   "4.4.0",
+			fixedVersion: "4.4.11",
+			want:         true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := newDeclarative(utillog.GetLogger(), nil, &arov1alpha1.WorkaroundSpec{
+				Name:         "test",
+				MinVersion:   tt.minVersion,
+				FixedVersion: tt.fixedVersion,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := d.IsRequired(version.NewVersion(4, 4, 10)); got != tt.want {
+				t.Error(got)
+			}
+		})
+	}
+}
+
+func TestNewDeclarative(t *testing.T) {
+	d, err := newDeclarative(utillog.GetLogger(), nil, &arov1alpha1.WorkaroundSpec{
+		Name: "test",
+		Manifests: []runtime.RawExtension{
+			{Raw: []byte(testMachineConfig)},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []arov1alpha1.WorkaroundObject{
+		{
+			GroupKind: "MachineConfig.machineconfiguration.openshift.io",
+			Name:      "99-worker-fix",
+		},
+	}
+	if !reflect.DeepEqual(d.Objects(), want) {
+		t.Error(d.Objects())
+	}
+
+	for _, spec := range []*arov1alpha1.WorkaroundSpec{
+		{Name: "bad version", FixedVersion: "four"},
+		{Name: "bad manifest", Manifests: []runtime.RawExtension{{Raw: []byte(`{"kind":"Unknown"}`)}}},
+	} {
+		_, err = newDeclarative(utillog.GetLogger(), nil, spec)
+		if err == nil {
+			t.Errorf("%s: expected error", spec.Name)
+		}
+	}
+}
@@ -5,13 +5,16 @@ package workaround
 
 import (
 	"context"
+	"reflect"
 	"time"
 
 	configclient "github.com/openshift/client-go/config/clientset/versioned"
 	mcoclient "github.com/openshift/machine-config-operator/pkg/generated/clientset/versioned"
 	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -29,6 +32,7 @@ type WorkaroundReconciler struct {
 	configcli     configclient.Interface
 	arocli        aroclient.Interface
 	restConfig    *rest.Config
+	dh            dynamichelper.Interface
 	workarounds   []Workaround
 	log           *logrus.Entry
 }
@@ -44,6 +48,7 @@ func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, config
 		configcli:     configcli,
 		arocli:        arocli,
 		restConfig:    restConfig,
+		dh:            dh,
 		workarounds:   []Workaround{NewSystemReserved(log, mcocli, dh), NewIfReload(log, kubernetescli), NewCleanFromPVCWorkaround(log, kubernetescli)},
 		log:           log,
 	}
@@ -54,31 +59,145 @@ func (r *WorkaroundReconciler) Reconcile(request ctrl.Request) (ctrl.Result, err
 	// TODO(mj): Reconcile will eventually be receiving a ctx (https://github.com/kubernetes-sigs/controller-runtime/blob/7ef2da0bc161d823f084ad21ff5f9c9bd6b0cc39/pkg/reconcile/reconcile.go#L93)
 	ctx := context.TODO()
 
+	instance, err := r.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	clusterVersion, err := version.GetClusterVersion(ctx, r.configcli)
 	if err != nil {
 		r.log.Errorf("error getting the OpenShift version: %v", err)
 		return reconcile.Result{}, err
 	}
 
-	for _, wa := range r.workarounds {
+	previous := map[string]arov1alpha1.WorkaroundStatus{}
+	for _, s := range instance.Status.Workarounds {
+		previous[s.Name] = s
+	}
+
+	workarounds := make([]Workaround, 0, len(r.workarounds)+len(instance.Spec.Workarounds))
+	workarounds = append(workarounds, r.workarounds...)
+
+	var statuses []arov1alpha1.WorkaroundStatus
+
+	// objects which should remain on the cluster: any other object recorded in
+	// the previous status belongs to a workaround which has been dropped or
+	// changed, and is deleted
+	keep := map[arov1alpha1.WorkaroundObject]struct{}{}
+
+	for i := range instance.Spec.Workarounds {
+		spec := &instance.Spec.Workarounds[i]
+
+		wa, err := newDeclarative(r.log, r.dh, spec)
+		if err != nil {
+			r.log.Errorf("workaround %s is invalid: %v", spec.Name, err)
+
+			// leave whatever the workaround last applied in place
+			objects := previous[spec.Name].Objects
+			for _, o := range objects {
+				keep[o] = struct{}{}
+			}
+
+			statuses = append(statuses, arov1alpha1.WorkaroundStatus{
+				Name:    spec.Name,
+				State:   arov1alpha1.WorkaroundStateFailed,
+				Message: err.Error(),
+				Objects: objects,
+			})
+			continue
+		}
+
+		if wa.IsRequired(clusterVersion) {
+			for _, o := range wa.Objects() {
+				keep[o] = struct{}{}
+			}
+		}
+
+		workarounds = append(workarounds, wa)
+	}
+
+	for _, s := range instance.Status.Workarounds {
+		for _, o := range s.Objects {
+			if _, found := keep[o]; found {
+				continue
+			}
+
+			r.log.Infof("workaround %s: removing stale %s %s/%s", s.Name, o.GroupKind, o.Namespace, o.Name)
+			err = r.dh.EnsureDeleted(ctx, o.GroupKind, o.Namespace, o.Name)
+			if err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+	}
+
+	var firstErr error
+	for _, wa := range workarounds {
+		s := arov1alpha1.WorkaroundStatus{
+			Name: wa.Name(),
+		}
+
 		if wa.IsRequired(clusterVersion) {
+			s.State = arov1alpha1.WorkaroundStateApplied
+			if d, ok := wa.(*declarative); ok {
+				s.Objects = d.Objects()
+			}
 			err = wa.Ensure(ctx)
 		} else {
+			s.State = arov1alpha1.WorkaroundStateNotRequired
 			err = wa.Remove(ctx)
 		}
 
 		if err != nil {
 			r.log.Errorf("workaround %s returned error %v", wa.Name(), err)
-			return reconcile.Result{}, err
+			s.State = arov1alpha1.WorkaroundStateFailed
+			s.Message = err.Error()
+			if firstErr == nil {
+				firstErr = err
+			}
 		}
+
+		statuses = append(statuses, s)
+	}
+
+	controllers.WorkaroundState.Reset()
+	for _, s := range statuses {
+		controllers.WorkaroundState.WithLabelValues(s.Name, string(s.State)).Set(1)
+	}
+
+	err = r.updateStatus(ctx, statuses)
+	if err != nil {
+		return reconcile.Result{}, err
 	}
+
+	if firstErr != nil {
+		return reconcile.Result{}, firstErr
+	}
+
 	return reconcile.Result{RequeueAfter: time.Hour, Requeue: true}, nil
 }
 
+func (r *WorkaroundReconciler) updateStatus(ctx context.Context, statuses []arov1alpha1.WorkaroundStatus) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		instance, err := r.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if reflect.DeepEqual(instance.Status.Workarounds, statuses) {
+			return nil
+		}
+
+		instance.Status.Workarounds = statuses
+
+		_, err = r.arocli.AroV1alpha1().Clusters().UpdateStatus(ctx, instance, metav1.UpdateOptions{})
+		return err
+	})
+}
+
 // SetupWithManager setup our manager
-func (r *WorkaroundReconciler) SetupWithManager(mgr ctrl.Manager) error {
+func (r *WorkaroundReconciler) SetupWithManager(mgr ctrl.Manager, gates *controllers.Gates) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&arov1alpha1.Cluster{}).
 		Named(controllers.WorkaroundControllerName).
-		Complete(r)
+		Complete(gates.Reconciler(controllers.WorkaroundControllerName, r))
 }
@@ -4,6 +4,7 @@ package workaround
 // Licensed under the Apache License 2.0.
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
@@ -13,10 +14,14 @@ import (
 	configv1 "github.com/openshift/api/config/v1"
 	configfake "github.com/openshift/client-go/config/clientset/versioned/fake"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
 	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+	mock_dynamichelper "github.com/Azure/ARO-RP/pkg/util/mocks/dynamichelper"
 	mock_workaround "github.com/Azure/ARO-RP/pkg/util/mocks/operator/controllers/workaround"
 )
 
@@ -66,7 +71,6 @@ func TestWorkaroundReconciler(t *testing.T) {
 			name: "has error",
 			mocker: func(mw *mock_workaround.MockWorkaround) {
 				mw.EXPECT().IsRequired(gomock.Any()).Return(true)
-				mw.EXPECT().Name().Return("test").AnyTimes()
 				mw.EXPECT().Ensure(gomock.Any()).Return(fmt.Errorf("oops"))
 			},
 			want:    ctrl.Result{},
@@ -79,7 +83,13 @@ func TestWorkaroundReconciler(t *testing.T) {
 			defer controller.Finish()
 
 			mwa := mock_workaround.NewMockWorkaround(controller)
+			mwa.EXPECT().Name().Return("test").AnyTimes()
 			r := &WorkaroundReconciler{
+				arocli: arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: arov1alpha1.SingletonClusterName,
+					},
+				}),
 				configcli:   configfake.NewSimpleClientset(clusterVersion("4.4.10")),
 				workarounds: []Workaround{mwa},
 				log:         utillog.GetLogger(),
@@ -96,3 +106,84 @@ func TestWorkaroundReconciler(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkaroundReconcilerDeclarative(t *testing.T) {
+	ctx := context.Background()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	stale := arov1alpha1.WorkaroundObject{
+		GroupKind: "MachineConfig.machineconfiguration.openshift.io",
+		Name:      "99-worker-old",
+	}
+	applied := arov1alpha1.WorkaroundObject{
+		GroupKind: "MachineConfig.machineconfiguration.openshift.io",
+		Name:      "99-worker-fix",
+	}
+
+	arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arov1alpha1.SingletonClusterName,
+		},
+		Spec: arov1alpha1.ClusterSpec{
+			Workarounds: []arov1alpha1.WorkaroundSpec{
+				{
+					Name:         "required",
+					FixedVersion: "4.5.0",
+					Manifests:    []runtime.RawExtension{{Raw: []byte(testMachineConfig)}},
+				},
+				{
+					Name:         "invalid",
+					FixedVersion: "four",
+				},
+			},
+		},
+		Status: arov1alpha1.ClusterStatus{
+			Workarounds: []arov1alpha1.WorkaroundStatus{
+				{
+					Name:    "dropped",
+					State:   arov1alpha1.WorkaroundStateApplied,
+					Objects: []arov1alpha1.WorkaroundObject{stale},
+				},
+			},
+		},
+	})
+
+	dh := mock_dynamichelper.NewMockInterface(controller)
+	c := dh.EXPECT().EnsureDeleted(gomock.Any(), stale.GroupKind, stale.Namespace, stale.Name).Return(nil)
+	dh.EXPECT().Ensure(gomock.Any(), gomock.Any()).After(c).Return(nil)
+
+	r := &WorkaroundReconciler{
+		arocli:    arocli,
+		configcli: configfake.NewSimpleClientset(clusterVersion("4.4.10")),
+		dh:        dh,
+		log:       utillog.GetLogger(),
+	}
+
+	_, err := r.Reconcile(reconcile.Request{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	instance, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []arov1alpha1.WorkaroundStatus{
+		{
+			Name:    "invalid",
+			State:   arov1alpha1.WorkaroundStateFailed,
+			Message: `could not parse version "four"`,
+		},
+		{
+			Name:    "required",
+			State:   arov1alpha1.WorkaroundStateApplied,
+			Objects: []arov1alpha1.WorkaroundObject{applied},
+		},
+	}
+	if !reflect.DeepEqual(instance.Status.Workarounds, want) {
+		t.Error(instance.Status.Workarounds)
+	}
+}
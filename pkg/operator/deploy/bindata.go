@@ -2,6 +2,8 @@
 // sources:
 // deploy/staticresources/aro.openshift.io_clusters.yaml
 // deploy/staticresources/master/deployment.yaml
+// deploy/staticresources/master/prometheus-role.yaml
+// deploy/staticresources/master/prometheus-rolebinding.yaml
 // deploy/staticresources/master/rolebinding.yaml
 // deploy/staticresources/master/service.yaml
 // deploy/staticresources/master/serviceaccount.yaml
@@ -86,7 +88,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _aroOpenshiftIo_clustersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x4b\x6f\xe3\xc8\x11\xbe\xeb\x57\x14\x9c\x83\x0f\xb1\xe4\x19\x04\x01\x12\xdd\x0c\xcf\x6e\x20\x64\x93\x35\xc6\xc6\x5c\xd6\x7b\x28\x35\x4b\x64\xc5\xcd\x6e\xa6\xba\x28\x8f\x26\xc8\x7f\x0f\xaa\x49\xea\x65\xd2\x1e\x0f\x10\x5e\x04\xf6\xa3\xde\xf5\xd5\x47\xcd\xe6\xf3\xf9\x0c\x1b\xfe\x42\x92\x38\x86\x25\x60\xc3\xf4\x55\x29\xd8\x5b\x5a\x3c\xfd\x25\x2d\x38\x5e\x6f\x3f\xce\x9e\x38\x14\x4b\xb8\x6d\x93\xc6\xfa\x33\xa5\xd8\x8a\xa3\x4f\xb4\xe1\xc0\xca\x31\xcc\x6a\x52\x2c\x50\x71\x39\x03\xc0\x10\xa2\xa2\x2d\x27\x7b\x05\x70\x31\xa8\x44\xef\x49\xe6\x25\x85\xc5\x53\xbb\xa6\x75\xcb\xbe\x20\xc9\xc2\x07\xd5\xdb\x0f\x8b\x3f\x2f\x3e\xcc\x00\x9c\x50\xbe\xfe\xc0\x35\x25\xc5\xba\x59\x42\x68\xbd\x9f\x01\x04\xac\x69\x09\xce\xb7\x49\x49\xd2\x02\x25\x2e\x62\x43\x21\x55\xbc\xd1\x05\xc7\x59\x6a\xc8\x99\xce\x52\x62\xdb\x2c\xe1\xc5\x7e\x27\xa1\x37\xab\x77\xa9\x13\x96\x57\x3c\x27\xfd\xfb\xf1\xea\x2f\x9c\x34\xef\x34\xbe\x15\xf4\x07\xd5\x79\x31\x71\x28\x5b\x8f\xb2\x5f\x9e\x01\x24\x17\x1b\x3a\x96\xda\xbb\x97\x75\xce\x7b\x07\xb6\x1f\xd1\x37\x15\x7e\xec\xa4\xb8\x8a\x6a\xec\x4c\x02\x30\x73\x6f\xee\x56\x5f\xfe\x74\x7f\xb2\x0c\x50\x50\x72\xc2\x8d\xe6\x50\xf5\xe2\x81\x13\x68\x45\xd0\x9d\x85\x4d\x94\xfc\x3a\x18\x09\x37\x77\xab\xfd\xfd\x46\x62\x43\xa2\x3c\x78\xdf\x3d\x47\xa9\x3f\x5a\x3d\xd3\x76\x69\x06\x75\xa7\xa0\xb0\x9c\x53\xa7\xb6\x77\x8d\x8a\xde\x07\x88\x1b\xd0\x8a\x13\x08\x35\x42\x89\x42\x57\x05\x27\x82\xc1\x0e\x61\x80\xb8\xfe\x17\x39\x5d\xc0\x3d\x89\x89\x81\x54\xc5\xd6\x17\x56\x2a\x5b\x12\x05\x21\x17\xcb\xc0\xdf\xf6\xb2\x13\x68\xcc\x4a\x3d\x2a\xf5\x49\x39\x3c\x1c\x94\x24\xa0\x87\x2d\xfa\x96\xae\x00\x43\x01\x35\xee\x40\xc8\xb4\x40\x1b\x8e\xe4\xe5\x23\x69\x01\xff\x88\x42\xc0\x61\x13\x97\x50\xa9\x36\x69\x79\x7d\x5d\xb2\x0e\x25\xef\x62\x5d\xb7\x81\x75\x77\x9d\xab\x97\xd7\xad\x46\x49\xd7\x05\x6d\xc9\x5f\x27\x2e\xe7\x28\xae\x62\x25\xa7\xad\xd0\x35\x36\x3c\xcf\xa6\x87\x5c\xf6\x8b\xba\xf8\x83\xf4\x4d\x92\x2e\x4f\x6c\xd5\x9d\x95\x47\x52\xe1\x50\x1e\x6d\xe4\x5a\x7c\x25\x03\x56\x95\x96\x6d\xec\xaf\x76\x5e\x1c\x02\x6d\x4b\x16\x9d\xcf\x3f\xdd\x3f\xc0\xa0\x3a\x27\xe3\x3c\xfa\x39\xee\x87\x8b\xe9\x90\x02\x0b\x18\x87\x0d\x49\x97\xc4\x8d\xc4\x3a\xcb\xa4\x50\x34\x91\x83\xf6\xb5\xc5\x14\xce\xc3\x9f\xda\x75\xcd\x6a\x79\xff\x77\x4b\x49\x2d\x57\x0b\xb8\xcd\x38\x00\x6b\x82\xb6\x29\x50\xa9\x58\xc0\x2a\xc0\x2d\xd6\xe4\x6f\x31\xd1\xff\x3d\x01\x16\xe9\x34\xb7\xc0\x7e\x5f\x0a\x8e\x21\xec\xfc\x70\x17\xb5\xa3\x8d\x01\x68\x26\xf2\xd5\xf7\xe7\x7d\x43\xee\xa4\x63\x0a\x4a\x2c\x56\xd3\x8a\x4a\xd6\x09\xc7\xe8\x33\x3c\xe3\x9d\x6a\x0f\x3a\xf9\x14\x6b\xe4\x70\xbe\x31\xe9\x14\x74\x3d\xbe\x0a\xba\xba\x7b\xdf\xa5\x6f\x3f\x85\x2d\x4b\x0c\x35\x05\x7d\xd7\xcd\xe2\xfd\x16\x6e\x08\x2d\x8b\x2f\xfc\x3d\x8b\xea\xcf\xfd\xb1\x93\xb0\xde\x7c\xfe\xd5\x40\x53\x50\xa3\x0c\x82\xa0\x34\x90\x78\x21\x6c\x3a\xb0\x79\xd7\xd0\x2c\x29\x05\xbd\x93\x58\x93\x56\xd4\x8e\x9e\x1b\x3c\x59\xc7\xe8\x09\xcf\xd1\x6d\xb2\x62\xec\x29\x29\xd0\x16\x7f\x89\x65\xc9\xa1\x7c\x29\xfb\x75\xfb\x5c\x0c\x1b\x2e\x47\xd1\x7a\x2f\x00\xd5\x70\x70\x09\x97\xbf\x7d\x98\xff\xf5\xf7\x3f\x2e\xba\x9f\xcb\x57\xbc\x18\xcd\x87\x3d\x75\x0c\xac\xd1\x36\xff\x76\x7b\xff\x6a\x2d\xd8\x43\xa1\xad\xc7\x77\xe6\xf0\x89\xb1\x0c\x31\x29\xbb\x74\x27\xb1\x98\x38\xf5\xf0\x12\xd5\xbf\xc3\xce\x57\x82\xcd\xa1\x14\x4a\xe9\x9d\x75\xdf\x4d\x12\xd2\xdb\x8a\xdc\x13\xc9\x7b\x93\xd4\x8a\x9f\x28\x1a\x56\xaa\x27\xb6\xde\xcc\xc5\x70\x00\x45\x70\xf7\x9e\x18\xf8\xe8\xf2\x08\x7e\x57\x08\x86\xf1\xb1\x2a\xde\xe8\xc7\x81\x07\xae\x3e\x0d\x44\xe4\xe6\x9b\x75\xdf\x41\x40\xc7\x08\xe8\x88\x1f\x7d\xb7\x15\xdb\x40\x3a\x66\xc1\xe4\x95\x29\xa4\x56\xd4\xf3\x46\x1e\xc5\xea\x7c\xee\x04\xad\xe3\x3a\xd9\x68\xfc\x61\xb8\x76\x31\x14\x7c\xc4\x83\xa7\x4d\xd8\x1f\xec\x67\x3c\x69\xd6\x36\x2c\x03\x87\xa4\x18\x1c\xa5\xc5\x0b\x41\x93\x75\x75\xa2\xe1\xe2\x20\xeb\x30\xfa\x3b\x1e\x66\x3e\xe6\x22\x39\x61\x66\x97\x2f\xe1\x73\x88\x26\x2d\x8e\x0d\x46\x21\xbb\xb5\xff\x68\x80\x9a\x5c\x85\x81\x53\x9d\x7b\x29\x14\x54\x18\x75\x33\x1a\x90\x68\xbc\xf9\x9f\x2b\x0a\xfd\x78\x54\x64\x9f\xf6\x86\x1c\x4c\x33\x2d\xc6\x26\x10\x1a\xe1\x28\x0c\x4f\x21\x3e\x07\x88\x02\xcf\xc6\x1b\x47\xc5\xe6\xf3\x4d\xe3\x77\xa6\x1f\xbd\x3f\x44\x31\x2b\x80\x92\xb7\x14\xc0\x98\xd5\x02\x1e\xc3\xb1\x4f\x1d\x19\x1d\x15\xba\x26\xc0\xa2\xf7\x89\xbe\x36\x9e\x1d\xab\xdf\x75\xbc\x75\x77\x54\x0b\xa0\x15\xaa\xb9\x2c\x29\xb3\x51\x17\xeb\x26\x06\x8b\xfa\xa8\x58\x97\xc3\xb8\x8e\xad\x82\xa0\x56\x99\x83\x61\xc8\x84\x8a\xa5\x23\x77\x31\xd1\x89\xfc\x1c\xd3\xcc\xd7\x64\x22\xae\x99\xc1\xc5\x2c\xed\x28\x96\x69\x01\xbf\x06\x47\x7d\xa5\x17\x57\x39\xf2\x35\x61\x30\x35\x39\x30\xfb\x48\x4c\x98\x1a\xa0\x27\x76\x96\xe8\x92\x0a\x40\x59\xb3\x0a\x0a\xfb\x1d\xcc\x81\x6d\xcf\xc5\x9a\x12\x34\x28\x3a\x60\xc0\xcd\xdd\x2a\x13\xf3\x51\xa1\x15\x76\x2d\x97\xb0\x26\x58\xa3\x7b\x7a\x46\x29\xd2\x3c\x87\x6e\x13\xa5\x7b\xb3\x18\xa2\xf2\x9a\x3d\x6b\x0e\xb9\x23\x09\x96\xcc\x51\x91\x18\x76\xbd\xf3\x67\x56\x2c\x2e\xc6\xa6\xff\xab\xb0\x0e\xe0\x31\xe9\x83\x60\x48\x3c\x7c\x99\x4e\x61\xf9\x26\x4a\x8d\xba\x04\xe3\xbc\x73\xe5\x9a\x7e\x14\xf3\x6b\x4a\x09\xcb\x49\x3d\x6f\xde\x17\xc2\x34\xc5\x14\xa6\x00\xe8\x73\xbe\x63\x28\x74\xd6\xbc\x08\x31\xd0\xfc\x39\x4a\x71\x75\xe0\xf0\x13\xa2\xe1\xec\x03\x70\x3f\x05\x50\xa9\x8c\xb2\xb3\x77\x87\x6d\xa2\xfd\x46\x2b\x42\x41\x7b\xac\x7e\x89\x71\xc3\xb3\xd2\x11\xcb\x0c\x56\x80\x43\xae\x07\x36\x99\xad\x36\xad\x5e\x41\x6a\x5d\x05\x98\xb2\xdd\x9e\xc3\xb4\xb1\x4f\xed\x9a\x9c\x7a\x28\x0d\x75\xfb\xcb\x56\x77\x1c\x20\xb5\x75\x8d\xc2\xdf\x72\x6b\xb8\xce\xcc\x1e\x3f\xb2\x03\x93\xb6\xbe\x99\x9c\xb1\xb1\xf4\x8e\xeb\xf9\xc0\xf7\x64\xf6\x00\xfc\x0f\xbb\x86\x86\x39\x6d\xd7\xf7\xc1\xdf\x4f\x86\xa9\xe6\xb4\xc7\x2e\xee\x1a\x76\xe8\xfd\xce\x20\x62\x28\x81\x02\xac\x26\x0c\x88\x53\x15\x45\xa1\xa9\x24\x7f\xdc\x1d\x03\xea\xa4\xd0\xfc\x89\x36\x7c\xfa\x73\x28\xd8\x2a\xa4\x9f\xb6\xdc\x8d\x84\xc7\x0b\x5c\x07\xeb\x28\x3f\x57\x69\xe9\xf1\x02\x9a\xe8\x51\x58\x77\xd3\x65\xf2\x73\x14\xa0\xaf\x58\x37\x9e\xae\x80\xcf\xbd\x1c\xf4\xa4\x6e\xee\xa0\x09\x64\xb7\xeb\x2a\x6b\x8b\x9e\x8b\xab\x69\x83\xb3\x45\x9c\x20\x9f\x7b\xbc\x00\x87\x29\x07\xb5\x91\xb8\xc6\xb5\x8d\x9a\xca\x06\x95\xd4\x57\x90\xe2\xa9\xe2\x49\xa1\xbd\xff\x86\xa7\xe8\x3d\x15\xf0\x78\xb1\x0a\xbd\x82\x51\xac\x82\xb7\x2b\xa4\x1b\x1c\x34\xc2\x9f\x8c\x6b\x77\xc5\x37\xba\x65\x72\x47\x36\x5e\xe1\x98\xaf\x91\xd3\xe1\xc3\x6c\xf2\xab\xe5\x7d\x6c\xee\xc5\x62\xc7\xcf\x96\x60\xa5\xd1\x2d\x68\x14\xc3\xcc\xa3\x95\x76\xbd\xff\x2b\x66\xd0\xdf\x37\x1f\xfc\xe7\xbf\xb3\x43\x1f\xa2\x73\xd4\x28\x15\xff\x3c\xff\x87\xf0\xe2\xe2\xe4\x2f\xc0\xfc\x7a\x44\xed\xe0\xb7\xdf\x67\x9d\x62\x2a\xbe\x0c\x7f\xf6\xd9\xe2\xff\x02\x00\x00\xff\xff\xd9\x04\x6c\x00\x5c\x15\x00\x00")
+var _aroOpenshiftIo_clustersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3c\x5d\x6f\x23\xbb\x75\xef\xfa\x15\x07\x6e\x81\x4d\x5a\xcf\xec\xdd\x26\x5b\xb4\xea\x43\xe1\xda\xbb\x89\x93\xf5\x5a\xb0\x7d\xd3\x87\xdd\x5b\x80\x9a\x39\x92\x58\x73\xc8\x29\xc9\xb1\xad\x2d\xfa\xdf\x8b\x43\x72\x3e\x24\x0f\x39\xb2\xec\xa4\x08\xd0\x2b\x03\x77\x35\x43\x1e\x92\xe7\xfb\x8b\x9a\x65\x59\x36\x63\x35\xff\x13\x6a\xc3\x95\x9c\x03\xab\x39\x3e\x59\x94\xf4\xcd\xe4\xf7\xff\x64\x72\xae\xde\x3f\x7c\x98\xdd\x73\x59\xce\xe1\xbc\x31\x56\x55\x37\x68\x54\xa3\x0b\xbc\xc0\x15\x97\xdc\x72\x25\x67\x15\x5a\x56\x32\xcb\xe6\x33\x00\x26\xa5\xb2\x8c\x1e\x1b\xfa\x0a\x50\x28\x69\xb5\x12\x02\x75\xb6\x46\x99\xdf\x37\x4b\x5c\x36\x5c\x94\xa8\x1d\xf0\x76\xe9\x87\x9f\xf2\x8f\xf9\x4f\x33\x80\x42\xa3\x9b\x7e\xc7\x2b\x34\x96\x55\xf5\x1c\x64\x23\xc4\x0c\x40\xb2\x0a\xe7\x50\x88\xc6\x58\xd4\x26\x67\x5a\xe5\xaa\x46\x69\x36\x7c\x65\x73\xae\x66\xa6\xc6\x82\xd6\x5c\x6b\xd5\xd4\x73\x78\xf6\xde\x43\x08\xdb\x0a\x47\xf2\xc0\xdc\x13\xc1\x8d\xfd\xe3\xf0\xe9\x17\x6e\xac\x7b\x53\x8b\x46\x33\xd1\x2f\xed\x1e\x1a\x2e\xd7\x8d\x60\xba\x7b\x3c\x03\x30\x85\xaa\x71\x08\x35\x1c\xcf\xad\x99\x85\x03\x3c\x7c\x60\xa2\xde\xb0\x0f\x1e\x4a\xb1\xc1\xca\x21\x8e\xbe\xd1\x76\xcf\x16\x97\x7f\xfa\xcd\xed\xce\x63\x80\x12\x4d\xa1\x79\x4d\x78\xe9\xc0\x03\x37\x60\x37\x08\x7e\x2c\xac\x94\x76\x5f\xdb\x4d\xc2\xd9\xe2\xb2\x9b\x5f\x6b\x55\xa3\xb6\xbc\x3d\xbd\xff\x0c\x48\x3f\x78\xba\xb7\xda\x3b\xda\x90\x1f\x05\x25\xd1\x1c\xfd\xb2\xe1\x68\x58\x86\x33\x80\x5a\x81\xdd\x70\x03\x1a\x6b\x8d\x06\xa5\xe7\x82\x1d\xc0\x40\x83\x98\x04\xb5\xfc\x4f\x2c\x6c\x0e\xb7\xa8\x09\x0c\x98\x8d\x6a\x44\x49\xac\xf2\x80\xda\x82\xc6\x42\xad\x25\xff\xd1\xc1\x36\x60\x95\x5b\x54\x30\x8b\x81\x28\xfd\x87\x4b\x8b\x5a\x32\x01\x0f\x4c\x34\x78\x0a\x4c\x96\x50\xb1\x2d\x68\xa4\x55\xa0\x91\x03\x78\x6e\x88\xc9\xe1\x4a\x69\x04\x2e\x57\x6a\x0e\x1b\x6b\x6b\x33\x7f\xff\x7e\xcd\x6d\xcb\xf2\x85\xaa\xaa\x46\x72\xbb\x7d\xef\xb8\x97\x2f\x1b\xab\xb4\x79\x5f\xe2\x03\x8a\xf7\x86\xaf\x33\xa6\x8b\x0d\xb7\x58\xd8\x46\xe3\x7b\x56\xf3\xcc\x6d\x5d\xd2\x81\x4d\x5e\x95\x7f\xa3\x83\x90\x98\x77\x3b\x7b\xb5\x5b\x62\x0f\x63\x35\x97\xeb\xc1\x0b\xc7\x8b\x09\x0a\x10\x57\x12\xb5\x59\x98\xea\x0f\xda\x23\x9a\x1e\x11\x76\x6e\x3e\xdd\xde\x41\xbb\xb4\x23\xc6\x0e\x50\x08\x78\xef\x27\x9a\x9e\x04\x84\x30\x2e\x57\x48\x4c\xc4\x0d\xac\xb4\xaa\x1c\xc6\x51\x96\xb5\xe2\xd2\x06\xde\xe2\x28\xf7\xd1\x6f\x9a\x65\xc5\x2d\xd1\xfd\xbf\x1a\x34\x96\x68\x95\xc3\xb9\xd3\x03\xb0\x44\x68\xea\x92\x59\x2c\x73\xb8\x94\x70\xce\x2a\x14\xe7\xcc\xe0\x9f\x9d\x00\x84\x69\x93\x11\x62\x0f\x23\xc1\x50\x85\xf5\xff\x11\x94\x79\xc0\xda\xe0\x45\xab\x68\x22\xf4\x0a\xf2\x79\x5b\x63\xb1\x23\x31\x25\x1a\xae\x89\xa7\x2d\xb3\x48\x92\x10\x06\xe6\x00\x97\xcf\x78\xda\x80\x41\x0b\xcb\xad\x9b\x79\xb3\x38\x05\x7c\x2a\xb0\xb6\x9d\x98\xaf\x38\x8a\xd2\x40\xa1\x6a\x8e\x25\x8d\x3b\x57\xf5\x36\x00\xbc\x7e\x94\x58\x7e\x76\x03\x4e\xf7\xe0\x3e\x6e\x78\xb1\x01\xa6\xd1\x81\xe7\x72\xa8\x32\xf2\x9d\xb1\xe3\x2a\x83\x3e\xac\xd0\x17\xaa\x62\x7c\x4f\x6b\x24\xb0\x1b\x94\xcd\xa5\xb4\x97\x8b\x97\x4d\xfa\xf1\x49\x3e\x70\xad\x64\x85\xd2\xbe\x68\x66\xf9\xf2\x1d\xae\x90\x91\x3c\x3f\x3b\xef\x1e\x79\x3f\x87\x61\x3b\xf4\x3d\xbb\xb9\x06\xc2\x17\xb3\x4a\xb7\x80\x60\x4d\xda\xea\x19\xb0\x38\x62\x77\xcd\xe5\xe8\x6b\x00\x56\x96\xce\xea\x32\xb1\x48\x02\x7a\xce\x96\x1d\xe4\x2b\x55\x62\x6b\x3d\x2a\xfa\x37\x93\xfd\xe6\xfb\x0d\x44\x80\x02\xe8\x46\x1a\xe0\x72\x36\xfa\x12\x50\x36\x55\x6c\x43\x19\x7c\x92\x6c\x29\xb0\x8c\xbe\xbf\xe0\x26\x3d\xe0\x7a\x69\xc8\x6a\x5c\x4b\xb1\x8d\x8c\x49\x90\x38\x89\x16\x27\x72\x03\xac\xa8\x15\x70\x59\xf2\x07\x5e\x36\x4c\x74\x08\x8a\x2c\x3a\xa0\xdb\x29\xdc\xe3\xd6\x0b\x65\xff\xd4\x19\xff\x1c\x76\x96\xeb\x84\x31\x02\x93\x34\x28\x79\x25\x58\x3a\x89\x45\x8f\xba\x7c\x36\x32\x34\xa6\xaa\xda\xff\x6a\xb2\xd6\xc6\xa2\xb4\x0b\xad\x2a\xb4\x1b\x6c\x22\x5c\xe3\x01\x2d\x95\x12\xc8\xc6\x48\xac\x91\x6c\x34\x31\xff\x85\xe6\x2b\x3b\x9f\xc6\xf0\xcd\xee\x0c\xa8\xd8\x7d\xd0\x88\x1d\xd3\x79\xa0\xc0\x65\x16\x74\xd1\x28\x54\x00\x2c\xc9\xd2\x38\x4f\x03\x3b\x3f\x88\xd4\x71\xeb\x20\xb8\x7f\x0b\x66\x2c\x3c\x6a\x6e\x2d\xca\x5e\x85\xe6\x00\xd7\x76\x83\xfa\x91\x1b\x8c\x80\x2f\xdd\xfe\xb8\x01\x25\xc5\x96\xcc\xa4\xd2\x16\x4b\xe0\x72\xb8\x1e\x1d\xfd\x76\x2b\x0b\x74\x0e\x8b\x17\xc6\xfc\x28\x54\x12\x23\xf3\x02\xaf\x94\xe4\x64\xde\x0e\x40\xe5\xed\xee\x8c\x01\x2a\x2b\xff\x88\x5c\x81\x01\xd3\x39\x67\x3a\x76\xda\x7d\x60\x86\x50\xc8\xbc\xa5\xef\x29\x01\x3d\xc3\x80\x29\x34\xab\xf7\x68\x17\x01\x5e\xa1\xd5\xbc\x30\x47\xe0\x25\xc1\xc9\x6b\x94\xf8\xc0\xbe\xa8\xf5\x9a\xcb\xf5\xfc\xe5\x9a\x75\xc5\xd7\xa3\x0e\x6f\xfb\xa9\x99\x25\x57\x72\x0e\xef\xbe\xfd\x94\xfd\xf3\x2f\x7f\x9f\xfb\xff\xbd\x9b\x8d\x8c\x9d\x52\x33\x3d\x41\x7e\x77\x7e\x9b\xb4\x62\x69\xa5\x99\xc1\x05\x67\x6b\xa9\x8c\xe5\x85\x59\x68\x35\xae\x19\x33\xb8\x7b\xee\x18\x1f\xb4\x4f\xc3\xe5\xfd\x05\x1a\xcb\xe5\x30\x6a\x4b\x73\xe1\xde\x14\xa7\x9d\x88\x6b\x8c\x55\x9a\xad\x11\x58\x51\xa8\x46\x5a\xe3\x5c\xf1\x8d\x8a\x6c\x2c\xc4\x63\x41\x0d\xd2\xfc\x9b\x05\xb0\xba\xd6\xea\x01\x0d\x39\x3a\x8f\x4c\x97\x8e\xa1\x03\x27\x0a\xb5\xf6\xde\x25\xdc\x6d\x62\x4c\x3d\x50\x29\xac\x74\x9c\x5a\xf5\xce\xac\xd3\x0e\x86\xaf\x25\x96\x43\xd7\xca\x31\x3e\xc6\xcc\x49\xd1\xb9\xb2\x2e\x36\x2e\x7b\x85\x10\x24\x64\x5c\xf8\xb9\xc5\x2a\x82\xcc\x49\x9a\xb4\x03\x98\xd6\x6c\x6c\x5b\x44\xb3\x43\x09\xe5\xa9\xd3\x7b\x0d\x50\x0e\x29\xd7\xe3\x3e\x1c\x66\x14\x28\x78\xd4\x13\xa0\x40\x16\x2c\x49\xe3\x32\xa1\xe4\xda\xf0\x12\xe1\x77\x4e\x32\x3d\x65\xb6\x7b\xfe\x65\x04\x64\x58\xd0\xc7\x6b\xcc\x9d\xa9\x53\xbd\x85\x92\x2b\xbe\x6e\xc8\x5b\xe6\x2b\x20\x9d\x3f\xd8\x35\x70\x13\x01\xc9\xe5\x33\xde\x3c\x82\x38\x3b\x28\xfc\xa2\xd6\xb7\x61\x67\x8c\xb0\x30\xe4\xcb\xc1\x9e\x72\x80\x4f\x4f\xac\xb0\x51\xa7\x04\x40\x49\xe7\xf4\x9f\xfd\x68\x34\xfe\x9b\x50\xcb\x53\xb8\xdd\x1a\x82\x48\xe7\xff\xfd\xdd\xdd\x02\xaa\xc6\xb8\x90\xc9\xa0\x1d\xdf\xf6\x94\x8a\x6b\x9d\xe6\xb0\x42\x7c\xc8\xde\x21\xbb\x3d\xb5\xa7\x65\x75\x8d\xb2\x34\xad\xc4\x85\xef\xb0\x14\x6a\x19\xc3\x7d\x47\x01\x26\x3d\xbc\x7d\x6d\x90\x98\x77\xc8\xa9\x5a\x27\x8b\x71\x89\x3a\x3d\xec\x00\xf9\x6a\x3f\x61\x8f\x67\x7e\x8b\x6f\x04\x96\x22\x61\x8a\xf4\x52\xe0\xb2\xfe\x30\xc9\x51\xbb\x1b\x4c\x0c\x9d\x70\x00\xfd\x1f\xe5\x3a\x0e\xe6\x0a\xe2\xc9\x96\x21\x6a\x45\x91\xbd\x63\x07\x66\xe0\x0f\xb7\xd7\x5f\x41\xb8\xc0\x87\x98\x43\xce\xa2\x00\x01\x1c\x98\xdb\x2e\x8f\xf0\x06\x5c\xd0\x68\xf1\x17\x25\x54\xa3\xc5\x6b\xf1\x4e\x88\x4b\x2d\x92\xd4\x47\x23\xa4\xf9\xa2\xd6\x77\xdb\xda\x05\x71\x0c\x0a\xc1\x8c\x73\x8a\x83\x46\x75\x52\x9b\x84\x95\x0a\xd1\xda\x53\x9f\x35\x25\x4f\x51\x8b\xc6\xfc\x41\x35\x94\x78\x9b\xbd\x01\x29\x2a\x2e\x2f\x1d\x12\xe0\x43\x62\x54\xda\x2c\xf6\x4e\x45\xea\x70\x9d\x93\xf7\x1f\xdf\x58\xf6\x83\x1c\xbc\x5f\x7d\xcb\xc2\xbf\xfe\xae\x7d\xf4\xeb\x7f\xfd\xdb\xd9\x2b\xcf\x64\xb0\xd0\x68\xbf\x4e\xec\x66\x87\xac\xb7\xdd\x94\x36\x3c\xa7\xc3\x10\x69\x59\x78\x97\x36\xa9\x9d\x0b\xe4\x93\xe2\x99\xb3\x04\x99\xf0\xfe\xb2\xf7\xb6\x6a\x56\x20\x3c\x6e\x94\x41\x38\x29\x34\x96\x94\x33\x63\xe2\x24\x09\xf0\x1e\xb7\xb0\x51\x94\x6e\x1a\x71\xf2\x28\xd8\x25\xa3\xd8\x1b\x11\x67\xca\xcd\xe9\x2c\x01\x11\x42\x16\xeb\xac\xb1\x1b\xa5\xf9\x0f\x67\x43\x61\x83\xac\x44\x1d\xb2\x9b\x04\x92\x54\x87\x87\xf6\x6a\x62\x38\x53\x7b\x38\x21\xdc\xf0\x56\xf9\x99\x5d\x5b\x18\x80\xb9\xf0\x2d\xa9\xbd\x9d\x39\xbc\xf9\x7c\x0e\x1f\x7f\xfb\x0f\xbf\x25\x1c\x55\xec\x2d\xf4\x1f\x39\xd4\xe9\x11\x07\x22\x85\xfe\x28\xd3\x31\x05\x6c\x07\x35\x2e\x79\x54\xe2\x8a\x35\xc2\xe5\x7a\xe1\xee\x7c\x31\x31\x7f\x5a\xdd\x90\x32\x99\x86\x93\xc1\xcf\x17\xd3\x63\xee\xbe\xdc\xbe\x15\x72\x6a\xa5\xed\x8b\x90\xb3\x50\xda\xee\x20\xe7\xe3\x87\xdf\x4e\xcc\xaf\xd8\x13\xaf\x9a\x6a\x0e\xff\xf8\xf1\xe3\x6f\x3e\x4e\x0d\xe6\xd2\x0f\xfe\x70\xd0\x11\xa9\x32\xb2\x46\xfd\x6a\x0b\x98\x08\xe1\x0e\x34\x81\x53\xeb\x64\x29\xa3\xe5\x2b\x67\xb3\x23\x17\x4f\xd9\x8c\xc4\x64\x2e\xd7\x1a\x8d\x79\x61\xc2\x9a\x30\xae\x25\xda\xf3\x0d\x16\xf7\x63\x7e\x6a\x5a\xc8\x95\x13\x32\x26\x7e\xd6\x22\xa2\x04\x76\xd8\xed\xba\x1d\x7e\xf3\xc5\x87\x79\xad\x97\x35\x12\xd8\x51\x49\x6c\x14\x22\x80\x44\x1f\xce\x69\x64\xc5\xe6\x94\x14\x15\xe0\x13\xab\x6a\xe1\xc2\xbd\x6e\x53\x5d\x6c\x6d\x06\x71\x5e\x04\xe4\x7e\xee\xac\xdd\x18\x14\x84\x18\x73\x0a\xcb\xc6\x42\xa9\x80\x22\x6b\xb6\x5a\x51\x55\x8a\x86\x5d\x06\xf4\xdd\xd0\x4e\x28\xdb\x19\x01\xdf\x25\xde\x4c\x3e\x7b\xb1\x3f\x35\x29\xfe\x69\x2f\xa3\x39\x8c\x36\x1d\x4d\xec\x66\x82\x2e\x3e\xea\x1b\x85\x08\x40\x48\xe8\x68\x43\x95\xa2\x15\x30\xb9\x0d\x29\xd0\x6a\x90\x9c\x70\x03\xb0\x3c\x3d\x12\x8f\x6e\xab\x2b\x26\x0c\xfe\xa5\x31\x9a\x90\x41\xa1\x0a\xe7\x19\xcc\x67\x2f\x58\x51\xaa\x12\x6f\xb0\xc2\x92\x47\xe6\xee\x90\xe9\xeb\xee\x68\x4a\xee\x86\x4c\x21\x95\x82\x80\x35\x56\x55\xcc\xf2\x02\x74\x3f\xe8\x19\x44\x20\x7a\x34\x72\x83\x4c\xd8\xcd\x16\x1e\x95\xbe\xa7\x8c\xbf\x2a\x91\x44\x65\x00\x9e\xdc\x3a\xb5\xa2\xb1\x02\x8d\x01\x66\x41\x20\x1b\xd5\xad\x94\x2d\xa8\x95\xe0\xc5\x36\xd4\x02\xf3\x17\xaa\x92\x8a\x3d\xfd\xdc\xee\xe8\x00\x76\xbd\x1a\x0c\x07\xd3\x18\x1f\xff\x0f\x0e\x4d\x1a\x45\x50\x65\x44\x63\x38\xe0\x28\x50\x8f\x7f\xf2\x14\x19\x49\x3e\x05\x28\x1a\x7b\xdc\xe4\x00\x17\x03\xf3\xf8\x21\x9f\x1d\x61\xe6\xa6\x8c\x1b\xc5\x12\x24\x00\x0f\x4c\x1c\x72\xf2\x7e\x74\x57\x14\xf3\x66\x16\x2c\xaf\x10\x96\x68\x1f\x11\x25\xd8\x47\x35\xc4\x87\x19\x1c\x65\x74\x11\x20\xb1\xfd\xf0\x13\xd9\xec\xc6\x62\x44\x51\x4d\x48\x8e\xe3\x80\x08\x81\x27\x64\x32\xc5\xe5\x0b\x02\xbb\xed\x4e\x43\x7c\x3e\x64\xda\x08\x44\x80\x47\x6e\x37\x70\x56\x10\x08\x50\xb2\x40\xe0\x16\x36\xcc\xc0\x92\xf0\xc3\x25\x15\xb7\xbc\x3a\x71\x76\x83\x3a\x78\x54\x33\xc6\xdc\xd3\xdc\x1b\x94\x5f\x31\x2e\xc1\x87\x9d\x32\x6c\x34\xd0\x94\xf9\x6f\x96\xdd\x63\x3a\x5f\xa1\x24\x30\x39\x90\xe6\x24\x46\xa6\x9d\xdb\x0c\xce\x95\x2e\x95\x4c\x0e\xb9\xd0\x2c\x5a\x4f\xa5\xbf\x0c\x6e\xb0\x16\xac\x48\x6d\x64\x82\x95\xf6\x14\xfe\xb1\x48\xed\x49\xcc\xcd\x0e\x9e\x12\xf0\xbc\x4e\xf0\x7d\x0f\xaf\xc2\xe4\x57\x45\x16\xad\xdc\xce\xa2\x63\x1c\xba\x43\x5e\xed\xa6\x91\x24\xc0\x9d\x5a\x4b\xce\xba\xe0\xe6\x7e\x41\x0e\x5f\x13\xf5\x69\xe8\x2f\x83\x2b\xac\x94\xde\x1e\x34\x74\x71\x79\x71\xc0\xb8\x83\xe8\x36\x95\xd4\x38\x08\x08\x61\x43\x35\xf6\x95\x70\xa6\x5d\x79\x2f\x6a\xd1\xd7\x1d\x0f\x1e\xe3\xee\x67\xed\x29\x22\xef\x13\xde\xc4\xab\x5c\x91\x5a\xab\xa7\x11\x3b\xba\x23\x2a\x0b\x1a\xd3\xda\x10\xd5\xd8\xa5\x6a\x64\x49\x69\xcd\xa7\xed\x8e\xc7\xe7\xf3\x09\x54\xd5\x40\x17\x63\x3c\x83\x0a\x60\x35\x5b\xad\x78\x01\x76\xa3\x55\xb3\xde\x78\x87\xbb\x2f\x6e\xb1\xba\x16\x1c\x09\x46\x5b\xf8\x6e\x61\xbb\x3d\x8c\x00\x6c\x7d\x1a\x67\xbb\xbc\x93\x58\x95\xa6\x84\x92\x61\xa5\x24\x35\x03\x51\x19\x82\xf6\xa4\x1e\x65\xf0\xd2\xf3\xd9\xcb\x94\x36\x65\x97\x17\xe3\x78\x3a\x80\xb7\x68\xb6\x39\x7e\xba\x54\xc7\xcf\xb5\x9a\x90\x57\x9e\x9f\xcd\x67\x93\xfa\xf0\xae\x1d\x4b\x84\x66\xb0\xf8\x74\x05\xcb\x46\x96\x02\xbb\xb6\x84\x33\x28\x08\x47\x2b\x5e\x8c\xf6\xff\xec\x34\x62\x91\xd9\xa4\xaa\xa4\xa5\x7a\x92\xe7\x14\xaa\x4f\x12\x65\xcd\x0e\x18\x67\x7b\x5f\x7e\xb6\x04\x47\xb7\x8d\x82\x97\xe5\x04\x5b\xb7\x1d\xbf\x97\x17\x2d\x6f\xbb\x54\x5f\xd7\x69\x78\x59\xb6\x47\x0f\x4c\x38\x7b\xc1\x16\x1f\x24\xda\xb1\x1d\x24\xa6\x90\xa7\xc2\x34\xc9\x96\x99\xd8\xf9\xbf\xf7\x23\x9d\x1b\x3a\x98\x09\x66\xc3\xeb\x7a\x58\x07\x06\x66\x80\x5a\x00\x43\x70\xfb\x0c\x32\x38\x10\x5e\xf2\xba\x69\x3d\xc4\x61\xdb\x45\x5f\x17\x25\x32\x2e\x1b\x2e\xec\x78\xbf\xd4\x60\x43\xf9\xec\x60\xf7\x2e\x72\x46\x17\xbb\xf0\xd6\x97\x0b\xbb\x22\x47\x8c\xc1\xbd\x24\xa9\x5e\x36\x6b\x8a\xc7\xd9\x08\x48\x00\xcd\xe4\xda\xf1\xf0\x75\x8d\xf2\x96\xd2\xc7\x5d\xf7\xf2\x29\x54\xac\x44\x68\x6a\x7a\xcd\x5c\x65\x57\xad\xa0\x62\x92\xaf\x5c\xc3\xe7\xaf\x30\x5f\x3f\xdf\x3e\x7d\xae\x58\xb1\xe1\x12\xcf\x9d\xf2\x31\xbf\x6e\xb9\x7e\x80\x47\x1f\x55\x0c\x35\x18\x37\xa9\x24\xb7\xdf\x26\xe9\x2a\x8d\x95\x7a\xc0\x12\x54\xa2\xa9\x67\xca\xc9\x5c\xf1\x27\x2c\x93\x2d\x21\x7b\xd8\xfe\x3c\x98\xd0\x4a\xc3\x8a\x6b\x63\x9f\xa3\xcd\x1f\x36\x02\xd4\x75\x78\x4a\x05\xc4\x28\xa8\x43\x0a\x84\xba\x64\x29\xc0\xc7\xaa\xb6\xdb\x53\x60\x42\x74\x24\xf0\x3d\x0c\x57\x5c\x86\xb5\xa3\x60\x1d\x8f\xb6\xd0\x22\xa3\x12\xb2\x15\x02\xa8\x96\xb4\x31\x9c\x24\x23\x8f\xa4\xd6\xe9\x3f\x4f\x19\xdd\x05\xa0\x64\x19\x9a\xcc\xb5\x8c\xeb\x07\xcc\x1a\xe9\x78\x35\xf3\xfd\xad\x73\xb0\xba\xc1\x59\x6a\x95\x71\x4b\xfe\x26\x4b\x54\x1d\xba\x0f\xe2\x8d\x9e\x3a\xbb\x9c\xd1\x12\xf7\x39\x8b\x38\x72\x47\x40\xc3\x28\x1b\x2c\x71\x45\x9d\xeb\x3b\x6c\xf8\x26\x24\x4f\x39\x98\x13\x93\x53\x0e\x61\xd4\x97\x4b\xb2\x48\x8c\xb2\x91\x49\x14\x58\xec\xb7\x33\xee\x50\xa6\xed\xdc\x73\xe3\x76\x7a\xb2\x95\xef\x26\x7d\xde\x94\x3d\x3b\x4c\x8d\x74\xbe\xec\xb3\x37\xfb\x5b\xe8\x06\x86\x4e\x7e\xaf\x44\x07\xe1\x94\x34\x96\xc9\x02\x8f\x36\x04\x27\x3d\xac\xbe\xc1\x9f\x42\x34\x7f\x46\xe7\xf8\xed\xdc\xbf\x78\x37\xee\x9b\x38\x4c\xe4\xc3\x0d\x3b\x06\x93\xd0\x5d\x0d\x82\x0a\x8b\x0d\x93\xdc\x54\x2e\x09\xe3\x5a\xa2\xac\xa2\xce\x95\xc6\x44\x3a\x77\x1f\x37\x94\x41\x71\x4d\xf0\x96\x71\x61\xba\x8d\xf4\x5b\xa3\x55\x28\x97\xc9\xa0\xd6\x5c\x69\x1e\x8c\x96\xd2\xf0\x48\xb7\x43\x46\xc1\xba\xf1\x75\x2d\xb6\xe4\x06\x93\xa0\x74\x58\x74\x0b\xc0\x9a\x3f\xa0\x04\xba\x3f\x91\xc3\xf7\x41\x7e\xa2\xbd\x72\x32\x0a\x74\x89\xd4\x64\xed\xcf\x84\x4f\x35\x65\x60\xac\xef\x49\x7a\xc0\xed\x80\x17\x28\xc7\x65\xa1\x31\x74\x7d\x82\x0c\x52\xa1\xaa\x5a\x49\xc2\xfa\x28\xd8\x82\x0e\xc8\x96\xaa\xb1\xa0\x19\xd9\x2c\x9a\x2f\x43\x38\x45\xee\x9f\x75\x55\xd9\x21\x7c\x87\x53\x77\x2b\x43\x47\xf0\xea\xcc\x82\xb3\x80\x43\x5c\x9a\x1c\xae\x29\x3d\xe3\x39\x3d\xe4\x84\x2b\x64\x92\x96\x71\x88\xe9\x30\x11\xd9\xaa\x84\x90\x56\x26\x42\xaf\x5d\x03\xf2\x92\x5b\xcd\x34\x17\x5b\xc8\x28\xee\x58\x62\xa1\xa8\x7b\xaf\x66\xda\xb6\xfe\xdf\xd9\xe2\xd2\xb5\x73\x8d\x02\xa5\x44\x11\x29\x45\x43\x25\xed\x25\x2b\xee\xa9\x8d\xca\x64\x34\xbe\x6d\xaa\x32\x19\xe1\x90\x59\xbe\xe4\x82\x5b\x87\xf2\x02\xb5\x24\x6e\x19\x05\xe9\x32\xe1\xee\xf0\x7b\xbb\xc8\xc7\x0a\xda\x3d\x66\xc7\x64\x09\x5c\xdb\xf0\x9d\x66\xd2\x38\xc4\x50\xf6\x6a\x7c\x1c\x84\x52\xee\x9c\xdc\x45\xcc\x28\x1e\x3d\x4e\x71\xd2\xb5\x13\x63\xd8\x1a\xe7\xc7\xce\xd7\xc8\xcc\x81\xd6\xa9\xa3\xf8\x8d\x9b\x43\x5a\x68\x4f\x78\x19\xb5\xa8\x65\x8f\x4a\x97\xa7\xfd\x4d\x9d\x08\x68\xe8\x75\x4c\xa7\x58\x08\xf7\x14\xab\xac\x95\x76\x05\x8a\x82\x35\xa6\x8b\x8a\x8a\x46\x6b\x94\xd6\xe9\xd9\x26\x92\x0c\xa5\xbf\x4b\x3b\xb2\x33\x52\x2b\xe4\x14\x12\x3f\x70\x82\xd9\xd8\xba\xb1\xa7\x60\x1a\xf2\x26\xa9\x67\x10\x33\x6a\x47\x8a\x42\x25\x37\xa0\xb0\x02\xd6\x68\xbb\xc9\xc4\x77\x5c\x82\x69\xaa\x8a\x69\xfe\xc3\x89\x46\xe1\xb7\x19\xf4\x87\x3b\x80\xc9\x8f\x25\xce\x98\x59\x7a\xc1\x74\x37\xe0\x10\xca\xf6\x8a\xbf\xed\x09\x22\x4a\xd0\xf4\x0e\xf9\xed\x80\xa8\x70\x06\x8f\xd4\x6e\x6b\x5e\x30\x21\xb6\xc0\x7a\x16\x28\x29\x9e\x28\xa9\x65\xc3\x6c\xa8\xbe\x5d\x6f\xb4\xbb\xc2\x35\x54\xa8\x51\xa0\xb4\x8d\xee\x82\x1f\x5d\xa7\x20\x0e\x09\xd6\x36\xd4\x06\xbf\x9f\xb0\xa5\x24\x89\x12\x19\xb9\x62\xdf\x4f\xa0\x56\x82\x69\x6e\xb7\x71\x36\xf9\xdc\xd7\x28\x4f\x81\xef\x9f\xb2\x5d\x87\xca\x6d\x28\x81\x0d\x2a\x29\x5c\x3e\x30\xc1\xcb\x78\x77\x0a\x41\xfa\x7e\xc2\x0d\xb5\xa1\xf0\xf2\xfb\x09\x14\xcc\xb8\x46\xab\x5a\xab\x25\x5b\x92\xa9\xd9\x90\xa1\xd2\x55\xdb\x38\xdc\x2f\x1c\x05\x1a\xce\xbf\x24\x01\x11\x02\x4b\xf8\x7e\x72\x29\xc3\x02\xa3\xba\xea\x00\x0e\x49\xbb\x5d\x84\xe1\x66\xcc\x10\x65\x8e\x71\xdf\xca\x23\x9b\xb8\xb0\x74\x98\xe7\xd2\xdf\x89\x09\x1e\x9a\xaf\x1e\x1f\x77\x41\x89\x6e\xa0\x36\xd2\x59\x3a\x2e\x8f\xb0\x02\xa9\x86\x98\xff\xbf\x4f\x35\x7a\x9f\xea\xcf\x16\x3b\x54\xe3\xa5\x97\xb7\x0d\x2a\xa0\xab\xce\xbb\xa6\x8d\xa3\xb9\xf8\xd3\x10\x4a\xb8\x3c\xe4\x59\x58\xa3\x69\x84\x73\x92\x5c\x5e\x95\x58\x33\x9a\xf8\x75\x55\x43\x26\xbb\x3d\xf9\xc0\x1f\x79\x70\x18\x11\x2a\x46\xe1\x49\xdb\x40\x37\x2c\x35\x1f\xc3\xec\xaf\xf4\x3f\xda\xd6\x90\x34\x80\xf8\x0d\x28\x08\xb8\x39\x48\xe0\x76\x10\x7c\xe3\x71\x1a\xa4\x4e\x35\x96\xbc\xd1\x21\x8a\x23\x10\x21\xa0\x7e\x0f\xcd\x47\xc9\xde\x6d\x53\x14\x31\x2a\x92\x68\x5d\x7c\xbd\xfd\xcc\xb8\x88\x57\x94\x5c\xd3\xdb\xe4\x90\x2f\xb7\xae\x3e\x4d\xd7\x7f\xe3\x36\xc6\x8d\x9b\x02\xf5\xfb\xbb\xbb\x45\x7a\xcc\x24\xc5\xb5\x12\xc7\xb3\x4b\xa2\x73\xfb\x55\x7a\xc2\x33\xd1\xf8\x2b\x35\xda\xfc\x12\xeb\xea\x3e\x52\x83\x50\xe8\xb0\x57\x28\x9d\xcf\x92\xcc\xbc\x37\xda\xfd\xe6\x81\xa6\x34\xf5\xb0\xc7\x80\xf8\x39\x5d\x99\x4f\x0b\x78\xaa\x7e\x9e\xda\x4d\xbc\x72\x0e\xce\x87\x1c\x05\x08\x87\xd4\xcc\xe3\x02\x95\xac\x93\xa7\x2a\xe4\xe9\xda\xf8\x04\x5f\xc9\xa8\xb5\x9f\x98\xe8\x3d\xca\xa3\xa6\xda\x68\x64\x79\x58\x5c\x99\x04\x1f\x17\x94\x68\x5d\x36\x1b\x27\x57\x16\xbc\xe6\x91\x17\xa3\x5b\x4b\x88\x8e\xf0\x8d\xd4\x47\x9b\xd6\xd0\x88\x3d\xe2\x1d\x76\x69\x3b\x7f\x39\x8b\x7a\xc5\x29\xa9\xea\x7d\xeb\x11\xb0\xe1\xee\x5c\x97\x6c\x6a\xcb\xa2\x58\xfe\x0b\x5c\x79\x63\x08\x86\x6d\x29\x70\xd8\xe6\xff\x07\x06\xf5\x15\xae\x54\xc8\xdc\xc5\x66\xc7\x10\xda\x79\xae\x23\xa8\x04\x15\x2f\xc4\x0c\xae\xf0\x1d\x65\x3c\xcf\x3b\xcc\x47\x87\xa4\xc8\xf8\x4a\x8b\x11\x71\x20\xb3\x68\x93\xca\x91\x86\xa1\x0d\x03\x42\xae\x7e\x3e\x7b\xc1\x29\x06\xc5\xc1\x63\x05\x67\x50\x1c\x4c\xca\x4e\xbf\x54\xee\x62\xeb\x11\xb8\x3b\xfb\xf1\x5e\x29\x15\xae\xf3\xc1\xd3\x53\xb8\x76\xae\xb7\x71\xbf\x5a\xd0\x26\xd8\xfd\x93\x78\x31\xec\x11\x07\xe5\xc0\xc1\x1d\xf4\xad\x4b\x43\xba\xce\x56\x5f\xe8\x0b\x61\x7e\xbf\x60\xec\x22\x28\xfd\x4a\x90\x86\x52\x2b\x2a\xf0\xfe\xd5\x49\x71\xc0\x58\x6c\x7e\x94\xf0\x49\xf2\x7b\xc2\x00\x77\xf7\x7d\x56\x94\xbc\xee\xca\x00\x2d\xee\xa3\x20\x81\x8a\xdd\x43\x1e\x89\x8e\x9c\xc2\x6b\xb8\xc5\x4f\xbf\xcf\xf5\xc7\x67\xbf\x79\xf4\x62\x44\x4d\xe3\xfa\xc5\x80\xdc\xf5\xa8\x37\x80\x96\x52\x3e\xad\xa6\xe9\xd0\x90\x18\x13\x51\x53\x07\xa8\xa4\x29\xc5\xf4\x52\x8b\xb1\xab\x49\xc6\x8c\xc6\x40\x2e\x95\x4c\xa6\xd7\x5e\x63\x36\xce\x92\xcc\x1a\x5a\x04\x3d\xf2\xa3\x63\x28\x04\x8a\xbe\xfe\x2b\x30\x2b\xa3\x93\x9e\x3d\x74\x69\x9d\x72\x50\xde\x0e\x17\xf7\x86\x4f\x9a\x65\xdb\xc4\xd3\x49\x6c\xc8\x5a\xc3\x7f\xff\xcf\xac\x4f\x60\xb3\x82\xa2\x4f\x2c\xbf\xee\xff\x80\xde\xc9\xc9\xce\x2f\xe4\xb9\xaf\x5d\x3a\xd4\xcc\xe1\xdb\x2f\xf4\xb3\x78\x56\xe9\xae\x5c\x6d\xe6\xf0\xed\x97\xd9\xff\x0e\x00\xd3\x01\x9b\xc7\x7b\x50\x00\x00")
 
 func aroOpenshiftIo_clustersYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -106,7 +108,7 @@ func aroOpenshiftIo_clustersYaml() (*asset, error) {
 	return a, nil
 }
 
-var _masterDeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x52\xc1\x6e\xdb\x30\x0c\xbd\xfb\x2b\x88\xde\xdd\xa4\xb7\x42\xb7\x62\x0d\x7a\x19\x82\x62\x59\x77\x67\x64\x26\x16\x22\x8b\x02\x49\x07\x75\xbf\x7e\x10\x92\x28\xce\x0a\x64\x3a\x19\x7c\x8f\xef\x3d\xd2\xc4\x1c\xfe\x90\x68\xe0\xe4\x00\x73\xd6\xc5\xf1\xa9\x39\x84\xd4\x39\x78\xa5\x1c\x79\x1a\x28\x59\x33\x90\x61\x87\x86\xae\x01\x88\xb8\xa5\xa8\xe5\x0b\x4a\x83\x03\x14\x6e\x39\x93\xa0\xb1\xb4\x03\xaa\x91\x34\x00\x09\x07\xba\x87\x69\x46\x4f\x0e\x38\x53\xd2\x3e\xec\xac\xc5\xaf\x51\xa8\x92\x1b\xcd\xe4\x8b\x89\x50\x8e\xc1\xa3\x3a\x78\x6a\x00\x94\x22\x79\x63\x39\xd9\x0f\x68\xbe\xff\x39\xcb\x73\x37\x91\x9a\xa0\xd1\x7e\x3a\x51\x85\x63\x0c\x69\xff\x91\x3b\x34\xba\x74\x0f\xf8\xb9\x19\x65\x4f\x27\xb3\x73\xe5\x23\xe1\x11\x43\xc4\x6d\x24\x07\xcb\x06\xc0\x68\xc8\xb1\x76\xcd\x77\x53\x5e\xbc\xc9\x73\x37\x11\xc0\x65\xca\xf2\x3c\x27\xc3\x90\x48\x6a\x73\x0b\x9e\x87\x01\x53\x77\x55\x6b\x8b\xd4\x55\x5b\xf6\x3a\xc7\xea\xf6\xae\xa5\x99\x59\x79\x61\xc0\x32\xde\xdb\x6a\xbd\xfa\xf5\xf2\x7b\xf5\x5a\x81\xef\xff\xab\x42\x99\xc5\x6e\x6c\x6a\xd2\x77\x16\x73\xf0\xbc\x7c\x5e\x56\xf4\xa2\xd4\x9b\xe5\x5a\x8c\xe1\x48\x89\x54\xdf\x85\xb7\xe4\x66\xdc\xc2\x7a\x23\x9b\x97\x00\x32\x5a\xef\x60\xd1\x13\x46\xeb\xbf\x16\x42\xd8\x4d\xb7\x84\x7f\x6d\x13\x77\xb4\xb9\x39\x8d\x4b\xb5\x15\x8e\xf4\x78\x18\xb7\x24\x89\x8c\xf4\x31\xf0\xe2\xb4\x12\x07\x0f\x0f\x67\xaa\x92\x1c\x83\xa7\x17\xef\x79\x4c\xb6\xbe\x73\xb9\xdf\xd9\xf7\x98\x59\x02\x4b\xb0\xe9\x47\x44\xd5\x93\xac\x4e\x6a\x34\xb4\x3e\x8e\x85\xd7\x7a\x09\x16\x3c\xc6\x73\x83\x71\x2c\x3a\x81\xd3\xec\x06\x0e\x34\xb9\xff\xcc\x52\x47\xbe\xe4\x70\xb0\xfa\x0c\x6a\x5a\x01\xda\xed\xc8\x9b\x83\x35\x6f\x7c\x4f\xdd\x18\xa9\xf9\x1b\x00\x00\xff\xff\x57\x5c\x5d\xa2\xfa\x03\x00\x00")
+var _masterDeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x52\x3d\x6f\xe3\x3c\x0c\xde\xfd\x2b\x88\xee\x6a\xd2\xad\xd0\x56\xbc\x0d\xba\xbc\x08\x8a\xcb\xf5\x76\x46\x66\x62\x21\x92\x28\x50\x74\x50\xf7\xd7\x1f\x84\xc4\x82\x73\x05\x52\x58\x83\xfd\x7c\xf0\xa1\x68\x62\xf6\x7f\x48\x8a\xe7\x64\x01\x73\x2e\xab\xf3\x53\x77\xf2\xa9\xb7\xf0\x4a\x39\xf0\x14\x29\x69\x17\x49\xb1\x47\x45\xdb\x01\x04\xdc\x53\x28\xf5\x0d\xaa\xc1\x02\x0a\x1b\xce\x24\xa8\x2c\x26\x62\x51\x92\x0e\x20\x61\xa4\x7b\x5c\xc9\xe8\xc8\x02\x67\x4a\x65\xf0\x07\x35\xf8\x35\x0a\x35\x71\x57\x32\xb9\x1a\x22\x94\x83\x77\x58\x2c\x3c\x75\x00\x85\x02\x39\x65\xa9\x0c\x40\x44\x75\xc3\xff\x8b\x7e\xee\x76\x54\x54\x50\xe9\x38\x5d\xbc\xc2\x21\xf8\x74\xfc\xc8\x3d\x2a\xcd\xee\x88\x9f\xbb\x51\x8e\x74\x09\xbb\x22\x1f\x09\xcf\xe8\x03\xee\x03\x59\x58\x77\x00\x4a\x31\x87\xe6\x5a\xce\x06\xe0\x76\x3e\x3f\x74\x04\x30\xdf\xb2\x3e\x8e\x93\xa2\x4f\x24\xcd\x6c\xc0\x71\x8c\x98\xfa\x19\x00\x30\xb5\x54\xfb\x42\x39\x2e\x92\x0c\xcc\x11\x0b\x68\x11\x56\x8f\x8f\x58\xaf\xf7\xb6\xd9\x6e\x7e\xbd\xfc\xde\xbc\x36\xe2\xfb\xff\x6a\x54\x66\xd1\x9b\x98\xd6\xe9\x3b\x8b\x5a\x78\x5e\x3f\xaf\x1b\x3b\x57\x1a\x54\x73\x03\x83\x3f\x53\xa2\x52\xde\x85\xf7\x6d\xd8\xf5\x54\xd5\x1b\xe9\x12\x02\xc8\xa8\x83\x85\xd5\x40\x18\x74\xf8\x5a\x09\x61\x3f\xdd\x0a\xfe\x8d\x4d\xdc\xd3\xee\x66\x35\x66\xd4\x08\x07\x7a\x3c\x8d\x7b\x92\x44\x4a\xe5\xd1\xf3\xea\x32\x12\x0b\x0f\x0f\x57\x69\x21\x39\x7b\x47\x2f\xce\xf1\x98\x74\x7b\x67\x73\xbf\xab\xef\x29\xb3\x78\x16\xaf\xd3\x7f\x01\x4b\xb9\x94\x2d\x53\x51\x8a\xc6\x85\xb1\x56\x34\x4e\xbc\x7a\x87\xe1\x6a\x50\x0e\xb5\x8e\xe7\xd4\xe6\x6d\xe0\x44\x93\xfd\xe1\x2e\x57\x2d\xb4\x05\xb0\xb0\xf9\xf4\x45\x4b\x23\xe8\x70\x20\xa7\x16\xb6\xbc\x73\x03\xf5\x63\xa0\xee\xef\x00\x57\x5c\x5d\xa2\xfa\x03\x00\x00")
 
 func masterDeploymentYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -126,7 +128,47 @@ func masterDeploymentYaml() (*asset, error) {
 	return a, nil
 }
 
-var _masterRolebindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8e\xb1\x4e\x03\x31\x0c\x40\x77\x7f\x85\x7f\x20\x87\xd8\x50\x36\x60\x60\x2f\x12\xbb\x9b\xb8\xd4\xf4\x62\x47\x8e\xd3\xa1\x5f\x8f\xaa\xa2\x5b\x90\x6e\xb5\xdf\xf3\x33\x75\xf9\x62\x1f\x62\x9a\xd1\x8f\x54\x16\x9a\x71\x36\x97\x1b\x85\x98\x2e\x97\x97\xb1\x88\x3d\x5d\x9f\xe1\x22\x5a\x33\xbe\xaf\x73\x04\xfb\xc1\x56\x7e\x13\xad\xa2\xdf\xd0\x38\xa8\x52\x50\x06\x44\xa5\xc6\x19\xc9\x2d\x59\x67\xa7\x30\x4f\x8d\xee\x02\xb8\xad\x7c\xe0\xd3\x1d\xa2\x2e\x1f\x6e\xb3\xef\x04\x01\xf1\x5f\x6f\x3b\x5f\x1e\xb3\x44\xb5\x89\xc2\x98\xc7\x1f\x2e\x31\x32\xa4\x3f\xe7\x93\xfd\x2a\x85\x5f\x4b\xb1\xa9\xb1\xfb\xd5\x63\x37\x3a\x15\xce\x68\x9d\x75\x9c\xe5\x14\x89\x6e\xd3\x79\x83\xe1\x37\x00\x00\xff\xff\x4f\x98\xa4\x7c\x24\x01\x00\x00")
+var _masterPrometheusRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\x8c\x31\x6e\xc3\x30\x0c\x45\x77\x9d\x82\xc8\x2e\x17\xdd\x02\x5d\xa0\x7b\x87\xee\x8c\xfc\x5b\x13\xb6\x45\x81\xa4\x5c\x20\xa7\x2f\xdc\x78\xe2\xe3\xc3\xc7\xe3\x2e\x5f\x30\x17\x6d\x85\xec\xc1\x75\xe2\x11\x8b\x9a\x3c\x39\x44\xdb\xb4\xde\x7d\x12\x7d\x3b\xde\xd3\x2a\x6d\x2e\xf4\xa9\x1b\xd2\x8e\xe0\x99\x83\x4b\x22\x6a\xbc\xa3\x50\x37\xdd\x11\x0b\x86\xe7\xf5\xee\x97\xf6\xce\x15\x85\xb4\xa3\xf9\x22\xdf\x91\xf9\x39\x0c\x59\x3b\x8c\x43\x2d\xd9\xd8\xe0\x25\x65\xe2\x2e\x1f\xa6\xa3\xfb\x19\xcc\x74\xbb\x25\x22\x83\xeb\xb0\x8a\xcb\xa1\xcd\x5d\xa5\xc5\xd9\xce\xd4\x75\x7e\x81\xc3\x0e\xa9\x38\x9f\x03\xf6\xb8\xc6\x3f\x88\xff\xbb\x89\xbf\xe0\x97\xa3\x2e\xe9\x6f\x00\xc6\x58\xdf\x9a\xeb\x00\x00\x00")
+
+func masterPrometheusRoleYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_masterPrometheusRoleYaml,
+		"master/prometheus-role.yaml",
+	)
+}
+
+func masterPrometheusRoleYaml() (*asset, error) {
+	bytes, err := masterPrometheusRoleYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "master/prometheus-role.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _masterPrometheusRolebindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\xce\xb1\x4e\xc4\x40\x0c\x04\xd0\x7e\xbf\xc2\x3f\xb0\x41\x74\xa7\xed\xa0\xa1\x3f\x24\x7a\xdf\xc6\x77\x31\xc9\xda\x2b\xdb\x9b\x22\x5f\x8f\x90\x22\x51\x81\x44\x3b\x1a\xcd\x3c\xec\xfc\x41\xe6\xac\x52\xc0\x6e\x58\x27\x1c\xb1\xa8\xf1\x81\xc1\x2a\xd3\x7a\xf1\x89\xf5\x69\x7f\x4e\x2b\xcb\x5c\xe0\xaa\x1b\xbd\xb2\xcc\x2c\x8f\xd4\x28\x70\xc6\xc0\x92\x00\x04\x1b\x15\xe8\xa6\x8d\x62\xa1\xe1\x79\xbd\xf8\x19\x7b\xc7\x4a\x05\xb4\x93\xf8\xc2\xf7\xc8\x78\x0c\xa3\xac\x9d\x0c\x43\x2d\x99\x6e\x74\xa5\xfb\xf7\x0a\x76\x7e\x33\x1d\xfd\x0f\x4a\x02\xf8\x91\xfc\x76\xec\xe3\xf6\x49\x35\xbc\xa4\x7c\xb6\xdf\xc9\x76\xae\xf4\x52\xab\x0e\x89\x7f\x82\x9b\x0a\x87\x1a\xcb\x23\x7d\x0d\x00\xd0\xcc\xf9\x06\x2f\x01\x00\x00")
+
+func masterPrometheusRolebindingYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_masterPrometheusRolebindingYaml,
+		"master/prometheus-rolebinding.yaml",
+	)
+}
+
+func masterPrometheusRolebindingYaml() (*asset, error) {
+	bytes, err := masterPrometheusRolebindingYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "master/prometheus-rolebinding.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _masterRolebindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8e\xb1\x4e\x43\x31\x0c\x45\xf7\x7c\x85\x7f\x20\x0f\xb1\xa1\x6c\xc0\xc0\x5e\x24\xf6\xdb\x3c\x97\x9a\xbe\xd8\x91\xe3\x74\xe8\xd7\xa3\xaa\x88\xa5\x52\x67\xfb\xdc\x73\xd0\xe5\x8b\x7d\x88\x69\x21\xdf\xa3\x2e\x98\x71\x34\x97\x0b\x42\x4c\x97\xd3\xcb\x58\xc4\x9e\xce\xcf\xe9\x24\xba\x16\x7a\xdf\xe6\x08\xf6\x9d\x6d\xfc\x26\xba\x8a\x7e\xa7\xc6\x81\x15\x81\x92\x88\x14\x8d\x0b\xc1\x2d\x5b\x67\x47\x98\xe7\x86\x2b\x90\xdc\x36\xde\xf1\xe1\xfa\x84\x2e\x1f\x6e\xb3\x3f\x10\x26\xa2\x3b\xdf\xff\x7c\xbd\x35\x64\xac\x4d\x34\x8d\xb9\xff\xe1\x1a\xa3\xa4\xfc\xc7\x7c\xb2\x9f\xa5\xf2\x6b\xad\x36\x35\x1e\x56\xdd\x6e\xa3\xa3\x72\x21\xeb\xac\xe3\x28\x87\xc8\xb8\x4c\xe7\x6c\x9d\x1d\x61\x9e\x7e\x07\x00\x4f\x98\xa4\x7c\x24\x01\x00\x00")
 
 func masterRolebindingYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -146,7 +188,7 @@ func masterRolebindingYaml() (*asset, error) {
 	return a, nil
 }
 
-var _masterServiceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8d\x41\xca\xc2\x40\x0c\x46\xf7\x73\x8a\x5c\x60\xa0\xff\xae\xcc\x29\x7e\x10\xdc\x87\xe9\xa7\x1d\xb4\x93\x90\xc4\x2e\x3c\xbd\xd4\x16\x5d\xb9\x0b\xef\x7b\xbc\xb0\xb6\x33\xcc\x9b\xf4\x42\xeb\x5f\xba\xb5\x3e\x15\x3a\xc1\xd6\x56\x91\x16\x04\x4f\x1c\x5c\x12\x51\xe7\x05\x85\xd8\x24\x8b\xc2\x38\xc4\xf2\xc2\x1e\xb0\x63\x73\xe5\x8a\x42\xa2\xe8\x3e\xb7\x4b\x64\x7e\x3e\x0c\x1f\x39\xb9\xa2\x6e\x1d\xc7\x1d\x35\xc4\xb6\x9b\x88\x55\x7f\x45\x55\x2c\x7c\xb7\xf2\xf1\x7d\x8e\xd0\x37\xd8\xd7\x42\xe3\x30\x0e\x07\x08\xb6\x2b\xe2\xff\x8b\x5f\x01\x00\x00\xff\xff\x10\x70\xf6\x36\xda\x00\x00\x00")
+var _masterServiceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x8e\x41\x8a\xc3\x30\x0c\x45\xf7\x3e\x85\x2e\x60\xf0\xec\x8c\x4f\x31\x30\x30\x7b\xd5\xf9\x6d\x4c\x13\x4b\xc8\x6a\x16\x3d\x7d\x49\x1b\xba\x6c\x77\xe2\x3d\xc1\xfb\xac\xed\x1f\x36\x9a\xf4\x42\xdb\x4f\xb8\xb6\x3e\x15\xfa\x83\x6d\xad\x22\xac\x70\x9e\xd8\xb9\x04\xa2\x85\x4f\x58\xc6\x7e\x11\xb1\x6a\x21\x36\x89\xa2\x30\x76\xb1\xb8\xf2\x70\x58\x20\xea\xbc\xe2\x93\x1b\xca\x15\x85\x44\xd1\xc7\xdc\xce\x1e\xf9\x7e\x33\xbc\x9f\xc3\x50\xd4\x3d\x32\xb0\xa0\xba\xd8\xd7\xa0\x8a\xf9\x31\x2b\x1e\xf5\xd9\x5d\x9f\xe0\x65\x0b\xe5\x94\xd3\x01\x9c\xed\x02\xff\x15\xf3\x42\x39\xe5\x14\x1e\x03\x00\xeb\x64\x78\x81\x01\x01\x00\x00")
 
 func masterServiceYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -166,7 +208,7 @@ func masterServiceYaml() (*asset, error) {
 	return a, nil
 }
 
-var _masterServiceaccountYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\xca\x31\x8e\x02\x31\x0c\x05\xd0\x3e\xa7\xf0\x05\x52\x6c\xeb\x6e\xcf\x80\x44\xff\x95\xf9\x08\x0b\xc5\x8e\x1c\xcf\x14\x9c\x9e\x06\x51\xbf\x87\x65\x77\xe6\xb6\x70\x95\xeb\xaf\xbd\xcc\x0f\x95\x1b\xf3\xb2\xc1\xff\x31\xe2\xf4\x6a\x93\x85\x03\x05\x6d\x22\x8e\x49\x15\x64\xf4\x58\x4c\x54\x64\x9f\xd8\xc5\xfc\xda\x5e\x18\x54\x89\x45\xdf\x4f\x7b\x54\xc7\xfb\x4c\xfe\x72\xfb\x04\x00\x00\xff\xff\xe4\xf5\x04\x25\x70\x00\x00\x00")
+var _masterServiceaccountYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x70\x00\x8f\xff\x61\x70\x69\x56\x65\x72\x73\x69\x6f\x6e\x3a\x20\x76\x31\x0a\x6b\x69\x6e\x64\x3a\x20\x53\x65\x72\x76\x69\x63\x65\x41\x63\x63\x6f\x75\x6e\x74\x0a\x6d\x65\x74\x61\x64\x61\x74\x61\x3a\x0a\x20\x20\x6e\x61\x6d\x65\x3a\x20\x61\x72\x6f\x2d\x6f\x70\x65\x72\x61\x74\x6f\x72\x2d\x6d\x61\x73\x74\x65\x72\x0a\x20\x20\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x3a\x20\x6f\x70\x65\x6e\x73\x68\x69\x66\x74\x2d\x61\x7a\x75\x72\x65\x2d\x6f\x70\x65\x72\x61\x74\x6f\x72\x0a\x03\x00\xe4\xf5\x04\x25\x70\x00\x00\x00")
 
 func masterServiceaccountYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -186,7 +228,7 @@ func masterServiceaccountYaml() (*asset, error) {
 	return a, nil
 }
 
-var _namespaceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x44\xca\xb1\x0d\x02\x31\x0c\x05\xd0\x3e\x53\x58\xd7\x07\x44\x9b\x21\x28\xe9\xbf\x2e\x1f\x61\x41\xec\x28\x36\x14\x4c\x8f\xa8\xae\x7f\x98\x7a\xe3\x0a\x75\x6b\xf2\xb9\x94\xa7\x5a\x6f\x72\xc5\x60\x4c\xec\x2c\x83\x89\x8e\x44\x2b\x22\x86\xc1\x26\x3e\x69\xf1\xd0\x7b\x56\x7c\xdf\x8b\xd5\x27\x17\xd2\x57\x11\x81\x99\x27\x52\xdd\xe2\xef\xe5\xb0\x27\xf5\xb3\x79\x67\x0d\xbe\xb8\xa7\xaf\x26\xdb\x56\x7e\x01\x00\x00\xff\xff\xc1\xaf\xa6\x4c\x7c\x00\x00\x00")
+var _namespaceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xca\x21\x0e\x42\x31\x0c\x06\x60\xbf\x53\x34\xf3\x83\x60\x77\x08\x24\xbe\xbc\xf7\x03\x0d\x5b\xbb\x74\x1d\x82\xd3\x13\x14\x06\xff\xf1\x90\x0b\x7c\x8a\x69\xa5\xd7\x29\x3d\x45\xf7\x4a\x67\xee\x98\x83\x37\xa4\x8e\xe0\x9d\x83\x6b\x22\x52\xee\xa8\x64\x03\x3a\x1f\x72\x8b\xc2\xef\xe5\x28\x36\xe0\x1c\xe6\x89\x88\x55\x2d\x38\xc4\x74\x7e\x3d\xfd\xec\x41\xec\xa8\xb6\xa3\x4c\x34\x6c\x61\x5e\x29\xe7\x44\xd4\xf8\x8a\xf6\x0f\x6f\x6d\xcd\x80\x97\x6e\x2a\x61\x2e\x7a\xaf\x94\xc3\x17\x72\xfa\x0c\x00\xa4\xeb\x1b\xcb\xb2\x00\x00\x00")
 
 func namespaceYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -206,7 +248,7 @@ func namespaceYaml() (*asset, error) {
 	return a, nil
 }
 
-var _workerDeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x52\xcb\x6e\xdb\x40\x0c\xbc\xeb\x2b\x88\xdc\x15\x27\xb7\x60\x6f\x41\x63\xe4\x52\x04\x45\xd3\xf4\x4e\xaf\xa6\xd6\xc2\xfb\x02\x49\xbb\x55\xbe\xbe\x10\x64\xcb\x32\x02\x88\x27\x61\x38\x9c\x19\x2e\xc5\x35\xfc\x86\x68\x28\xd9\x11\xd7\xaa\x9b\xd3\x63\x73\x08\xb9\x73\xf4\x82\x1a\xcb\x90\x90\xad\x49\x30\xee\xd8\xd8\x35\x44\x91\x77\x88\x3a\x7e\xd1\x38\xe0\x88\xa5\xb4\xa5\x42\xd8\x8a\xb4\x7f\x8b\x1c\x20\x0d\x51\xe6\x84\xb5\x9e\x56\xf6\x70\x54\x2a\xb2\xf6\xe1\x8f\xb5\xfc\x79\x14\xcc\xe4\x46\x2b\xfc\x68\x22\xa8\x31\x78\x56\x47\x8f\x0d\x91\x22\xc2\x5b\x91\xc9\x3e\xb1\xf9\xfe\xfb\x22\xcf\x6a\x22\x35\x61\xc3\x7e\x98\xa8\x52\x62\x0c\x79\xff\x51\x3b\x36\x5c\xa6\x13\xff\x7b\x3f\xca\x1e\x93\xd9\x19\xf9\xc8\x7c\xe2\x10\x79\x17\xe1\xe8\xa1\x21\x32\xa4\x1a\xe7\xa9\xe5\xdb\x8c\x15\x6f\xf2\xac\x26\x22\xba\x6c\x39\x96\x2f\xd9\x38\x64\xc8\x3c\xdc\x92\x2f\x29\x71\xee\xae\x6a\xed\x28\x75\xd5\x96\xbd\x2e\x7b\xf3\xeb\x5d\xa1\x85\xd9\x58\x21\xf1\xb8\xde\xeb\xf6\x6d\xfb\xf3\xf9\xd7\xf6\x65\x6e\x7c\xbd\xd7\xdc\x8a\xe1\x84\x0c\xd5\x1f\x52\x76\xb8\xda\x11\xf5\x66\xf5\x15\xb6\x84\x88\x2a\x5b\xef\x68\xd3\x83\xa3\xf5\x9f\x1b\x01\x77\xc3\x2d\xa1\x88\x39\x7a\x7a\x78\x7a\x38\xc3\xb9\x74\x78\xbf\x39\xec\x05\x6d\xa5\x44\xdc\x1f\x8e\x3b\x48\x86\x41\xef\x43\xd9\x4c\x0b\x39\xba\xbb\x3b\x53\x15\x72\x0a\x1e\xcf\xde\x97\x63\xb6\xb7\x95\xff\xee\x2b\x7b\x8d\x59\x25\x14\x09\x36\x7c\x8b\xac\x3a\xc9\xea\xa0\x86\xd4\xfa\x78\x54\x83\xb4\x5e\x82\x05\xcf\xb1\xf9\x1f\x00\x00\xff\xff\x4f\x57\x4a\x02\x45\x03\x00\x00")
+var _workerDeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x52\xcd\x6e\xdb\x30\x0c\xbe\xfb\x29\x88\xde\xd5\xa4\xb7\x42\xb7\x62\x0d\x7a\x19\x8a\x61\x5d\x77\x67\x64\x2e\x16\x22\x89\x02\x49\x67\x73\x9f\x7e\x10\x12\x1b\x0e\x0a\xa4\xb0\x0e\xf6\xf7\xa3\x8f\x3f\xc6\x1a\x7f\x93\x68\xe4\xe2\x01\x6b\xd5\xcd\xe9\xa1\x3b\xc6\xd2\x7b\x78\xa6\x9a\x78\xca\x54\xac\xcb\x64\xd8\xa3\xa1\xef\x00\x12\xee\x29\x69\x7b\x83\x66\xf0\x80\xc2\x8e\x2b\x09\x1a\x8b\xfb\xcb\x72\x24\xe9\x00\x0a\x66\xba\xc5\x69\xc5\x40\x1e\xb8\x52\xd1\x21\xfe\x31\x87\x1f\xa3\xd0\x22\xee\xb4\x52\x68\x21\x42\x35\xc5\x80\xea\xe1\xa1\x03\x50\x4a\x14\x8c\xa5\x31\x00\x19\x2d\x0c\xdf\x57\xf5\xdc\xac\x48\x4d\xd0\xe8\x30\x9d\xbd\xc2\x29\xc5\x72\x78\xaf\x3d\x1a\xcd\xee\x8c\xff\xde\x46\x39\xd0\x39\xec\x82\xbc\x17\x3c\x61\x4c\xb8\x4f\xe4\x61\xdb\x01\x18\xe5\x9a\x16\xd7\x7a\x36\x00\xd7\xf3\xf9\xa2\x22\x80\xb9\xcb\xf6\x04\x2e\x86\xb1\x90\x2c\x66\x07\x81\x73\xc6\xd2\xcf\x00\x80\x6b\x57\x2d\x5f\x28\x87\x55\x92\x83\x39\x62\x05\xad\xc2\xda\x89\x19\x5b\x7b\x2f\xbb\xd7\xdd\xcf\xa7\x5f\xbb\xe7\x85\xf8\xbc\xaf\x85\x4a\xf1\x44\x85\x54\x7f\x08\xef\x97\x51\xb5\x33\x98\xd5\x17\xb2\x35\x04\x50\xd1\x06\x0f\x9b\x81\x30\xd9\xf0\xb1\x11\xc2\x7e\xba\x16\xb0\x98\x87\xc7\xed\xe3\xf6\x02\x17\xee\xe9\xed\x6a\xb1\x33\xea\x84\x13\xdd\x1f\xc7\x3d\x49\x21\x23\xbd\x8f\xbc\x39\x37\xe4\xe1\xee\xee\x22\x55\x92\x53\x0c\xf4\x14\x02\x8f\xc5\x5e\x6f\xfc\x77\x9f\xd5\xb7\x94\x55\x22\x4b\xb4\xe9\x5b\x42\xd5\xf3\xb5\x3a\xa9\x51\x76\x21\x8d\x6a\x24\x2e\x48\xb4\x18\x30\x75\xff\x07\x00\x4f\x57\x4a\x02\x45\x03\x00\x00")
 
 func workerDeploymentYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -226,7 +268,7 @@ func workerDeploymentYaml() (*asset, error) {
 	return a, nil
 }
 
-var _workerRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x8e\xb1\x6e\x2c\x31\x08\x45\x7b\xbe\x82\x1f\xb0\x57\xaf\x7b\x72\x9b\x22\x7d\x14\xa5\x67\x3d\x24\x83\xc6\x63\x2c\xc0\xbb\x52\xbe\x3e\x9a\xd9\x6d\x53\xa5\xe2\x0a\x1d\x0e\x17\x52\x4a\x40\x43\x3e\xd8\x5c\xb4\x17\xb4\x2b\xd5\x4c\x33\x56\x35\xf9\xa6\x10\xed\x79\xfb\xef\x59\xf4\x72\xfb\x07\x9b\xf4\xa5\xe0\x4b\x9b\x1e\x6c\x6f\xda\x18\x76\x0e\x5a\x28\xa8\x00\x62\x35\x3e\x0f\xde\x65\x67\x0f\xda\x47\xc1\x3e\x5b\x03\xc4\x4e\x3b\x17\x24\xd3\xa4\x83\x8d\x42\x2d\xdd\xd5\x36\x36\xb0\xd9\xd8\x0b\x24\xa4\x21\xaf\xa6\x73\xf8\x61\x4a\x07\x9b\x75\x70\xf7\x55\x3e\x23\x8b\x02\xa2\xb1\xeb\xb4\xca\x4f\xa2\x3e\x5a\x38\x20\xde\xd8\xae\xcf\xed\x17\xc7\x39\x9b\xf8\x23\xdc\x29\xea\xfa\x17\xff\xc5\x83\x62\xfe\xf2\x66\x9c\xf6\x23\xcd\xb1\x50\x30\xfc\x04\x00\x00\xff\xff\x30\x78\x19\x41\x50\x01\x00\x00")
+var _workerRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x8e\xb1\x6e\x03\x31\x08\x86\x77\x9e\x82\x17\xb0\xa3\x6e\x95\xd7\x0e\xdd\xab\xaa\x3b\xf1\xd1\x1e\x3a\x9f\xb1\x00\x27\x52\x9f\xbe\xca\x25\x6b\xa7\x4c\x20\xf4\xf1\xfd\x3f\xa4\x94\x80\x86\x7c\xb1\xb9\x68\x2f\x68\x67\xaa\x99\x66\xac\x6a\xf2\x4b\x21\xda\xf3\xf6\xea\x59\xf4\x74\x79\x81\x4d\xfa\x52\xf0\xad\x4d\x0f\xb6\x0f\x6d\x0c\x3b\x07\x2d\x14\x54\x00\xb1\x1a\x1f\x0f\x9f\xb2\xb3\x07\xed\xa3\x60\x9f\xad\x01\x62\xa7\x9d\x0b\x92\x69\xd2\xc1\x46\xa1\x96\xae\x6a\x1b\x1b\xd8\x6c\xec\x05\x12\xd2\x90\x77\xd3\x39\xfc\x66\x4a\x37\x36\xeb\xe0\xee\xab\x7c\x47\x16\x05\x44\x63\xd7\x69\x95\x1f\x44\xbd\xb7\x70\x40\xbc\xb0\x9d\x1f\xd7\x1f\x8e\x63\x36\xf1\xfb\x72\xa5\xa8\xeb\x33\xfe\x93\x07\xc5\xfc\x27\x66\x1c\x76\xc4\x84\x73\x2c\x14\x0c\x7f\x03\x00\x30\x78\x19\x41\x50\x01\x00\x00")
 
 func workerRoleYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -246,7 +288,7 @@ func workerRoleYaml() (*asset, error) {
 	return a, nil
 }
 
-var _workerRolebindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8d\x31\x6e\xc3\x30\x0c\x45\x77\x9d\x82\x17\x90\x8b\x6e\x85\xb6\xb6\x43\x77\x17\xe8\x4e\xcb\x74\xcd\xda\x26\x05\x8a\x72\x01\x9f\x3e\x08\x12\x64\x09\xe0\xf9\xbf\xf7\x1f\x16\xfe\x21\xab\xac\x92\xc0\x06\xcc\x1d\x36\x9f\xd5\xf8\x40\x67\x95\x6e\x79\xab\x1d\xeb\xcb\xfe\x1a\x16\x96\x31\xc1\xe7\xda\xaa\x93\xf5\xba\xd2\x07\xcb\xc8\xf2\x1b\x36\x72\x1c\xd1\x31\x05\x00\xc1\x8d\x12\xa0\x69\xd4\x42\x86\xae\x16\xff\xd5\x16\xb2\x60\xba\x52\x4f\xd3\x15\xc2\xc2\x5f\xa6\xad\x9c\x04\x03\xc0\x53\xef\xf4\xbe\xb6\xe1\x8f\xb2\xd7\x14\xe2\xdd\xfc\x26\xdb\x39\xd3\x7b\xce\xda\xc4\x4f\xe5\xdb\x56\x0b\x66\x4a\xa0\x85\xa4\xce\x3c\x79\xc4\xa3\x19\x3d\xe0\x70\x09\x00\x00\xff\xff\x73\xce\x57\x9b\x2a\x01\x00\x00")
+var _workerRolebindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8d\x31\x4e\xc4\x30\x10\x45\x7b\x9f\x62\x2e\xe0\x20\x3a\xe4\x0e\x28\xe8\x17\x89\x7e\xd6\xf9\xcb\x0e\xc9\xce\x58\xe3\x71\x90\x72\x7a\x84\xa0\x5b\x29\xf5\xff\xef\x3d\x6e\xf2\x01\xef\x62\x5a\xc8\xcf\x5c\x27\x1e\x71\x35\x97\x9d\x43\x4c\xa7\xe5\xa9\x4f\x62\x0f\xdb\x63\x5a\x44\xe7\x42\xaf\xeb\xe8\x01\x3f\xd9\x8a\x17\xd1\x59\xf4\x33\xdd\x10\x3c\x73\x70\x49\x44\xca\x37\x14\x62\xb7\x6c\x0d\xce\x61\x9e\xbf\xcd\x17\x78\x72\x5b\x71\xc2\xe5\xf7\xc4\x4d\xde\xdc\x46\x3b\x08\x26\xa2\xbb\xde\xa1\xbe\x8f\xf3\x17\x6a\xf4\x92\xf2\x3f\xf9\x0e\xdf\xa4\xe2\xb9\x56\x1b\x1a\x87\xf0\xdf\xd6\x1b\x57\x14\xb2\x06\xed\x57\xb9\x44\xe6\x7d\x38\xb2\x35\x38\x87\x79\xfa\x19\x00\x73\xce\x57\x9b\x2a\x01\x00\x00")
 
 func workerRolebindingYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -266,7 +308,7 @@ func workerRolebindingYaml() (*asset, error) {
 	return a, nil
 }
 
-var _workerServiceaccountYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\xca\x31\x8a\xc3\x40\x0c\x05\xd0\x7e\x4e\xa1\x0b\x4c\xb1\xad\xba\x3d\x43\x20\xfd\x67\xfc\x43\x84\xb1\x34\x68\x64\x07\x72\xfa\x34\x21\xf5\x7b\x98\x76\x67\x2e\x0b\x57\xb9\xfe\xda\x6e\xbe\xa9\xdc\x98\x97\x0d\xfe\x8f\x11\xa7\x57\x3b\x58\xd8\x50\xd0\x26\xe2\x38\xa8\x82\x8c\x1e\x93\x89\x8a\xec\xaf\xc8\x9d\xf9\xb5\x35\x31\xa8\x12\x93\xbe\x9e\xf6\xa8\x8e\xf7\x99\xfc\xe5\xf6\x09\x00\x00\xff\xff\xe3\x3c\x43\x66\x70\x00\x00\x00")
+var _workerServiceaccountYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x70\x00\x8f\xff\x61\x70\x69\x56\x65\x72\x73\x69\x6f\x6e\x3a\x20\x76\x31\x0a\x6b\x69\x6e\x64\x3a\x20\x53\x65\x72\x76\x69\x63\x65\x41\x63\x63\x6f\x75\x6e\x74\x0a\x6d\x65\x74\x61\x64\x61\x74\x61\x3a\x0a\x20\x20\x6e\x61\x6d\x65\x3a\x20\x61\x72\x6f\x2d\x6f\x70\x65\x72\x61\x74\x6f\x72\x2d\x77\x6f\x72\x6b\x65\x72\x0a\x20\x20\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x3a\x20\x6f\x70\x65\x6e\x73\x68\x69\x66\x74\x2d\x61\x7a\x75\x72\x65\x2d\x6f\x70\x65\x72\x61\x74\x6f\x72\x0a\x03\x00\xe3\x3c\x43\x66\x70\x00\x00\x00")
 
 func workerServiceaccountYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -338,27 +380,31 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"aro.openshift.io_clusters.yaml": aroOpenshiftIo_clustersYaml,
-	"master/deployment.yaml":         masterDeploymentYaml,
-	"master/rolebinding.yaml":        masterRolebindingYaml,
-	"master/service.yaml":            masterServiceYaml,
-	"master/serviceaccount.yaml":     masterServiceaccountYaml,
-	"namespace.yaml":                 namespaceYaml,
-	"worker/deployment.yaml":         workerDeploymentYaml,
-	"worker/role.yaml":               workerRoleYaml,
-	"worker/rolebinding.yaml":        workerRolebindingYaml,
-	"worker/serviceaccount.yaml":     workerServiceaccountYaml,
+	"aro.openshift.io_clusters.yaml":     aroOpenshiftIo_clustersYaml,
+	"master/deployment.yaml":             masterDeploymentYaml,
+	"master/prometheus-role.yaml":        masterPrometheusRoleYaml,
+	"master/prometheus-rolebinding.yaml": masterPrometheusRolebindingYaml,
+	"master/rolebinding.yaml":            masterRolebindingYaml,
+	"master/service.yaml":                masterServiceYaml,
+	"master/serviceaccount.yaml":         masterServiceaccountYaml,
+	"namespace.yaml":                     namespaceYaml,
+	"worker/deployment.yaml":             workerDeploymentYaml,
+	"worker/role.yaml":                   workerRoleYaml,
+	"worker/rolebinding.yaml":            workerRolebindingYaml,
+	"worker/serviceaccount.yaml":         workerServiceaccountYaml,
 }
 
 // AssetDir returns the file names below a certain
 // directory embedded in the file by go-bindata.
 // For example if you run go-bindata on data/... and data contains the
 // following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
+//
+//	data/
+//	  foo.txt
+//	  img/
+//	    a.png
+//	    b.png
+//
 // then AssetDir("data") would return []string{"foo.txt", "img"}
 // AssetDir("data/img") would return []string{"a.png", "b.png"}
 // AssetDir("foo.txt") and AssetDir("notexist") would return an error
@@ -393,10 +439,12 @@ type bintree struct {
 var _bintree = &bintree{nil, map[string]*bintree{
 	"aro.openshift.io_clusters.yaml": {aroOpenshiftIo_clustersYaml, map[string]*bintree{}},
 	"master": {nil, map[string]*bintree{
-		"deployment.yaml":     {masterDeploymentYaml, map[string]*bintree{}},
-		"rolebinding.yaml":    {masterRolebindingYaml, map[string]*bintree{}},
-		"service.yaml":        {masterServiceYaml, map[string]*bintree{}},
-		"serviceaccount.yaml": {masterServiceaccountYaml, map[string]*bintree{}},
+		"deployment.yaml":             {masterDeploymentYaml, map[string]*bintree{}},
+		"prometheus-role.yaml":        {masterPrometheusRoleYaml, map[string]*bintree{}},
+		"prometheus-rolebinding.yaml": {masterPrometheusRolebindingYaml, map[string]*bintree{}},
+		"rolebinding.yaml":            {masterRolebindingYaml, map[string]*bintree{}},
+		"service.yaml":                {masterServiceYaml, map[string]*bintree{}},
+		"serviceaccount.yaml":         {masterServiceaccountYaml, map[string]*bintree{}},
 	}},
 	"namespace.yaml": {namespaceYaml, map[string]*bintree{}},
 	"worker": {nil, map[string]*bintree{
@@ -31,6 +31,9 @@ import (
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
 	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/genevalogging"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/proxy"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/specdrift"
+	"github.com/Azure/ARO-RP/pkg/operator/deploy/workarounds"
 	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
 	"github.com/Azure/ARO-RP/pkg/util/pullsecret"
 	"github.com/Azure/ARO-RP/pkg/util/ready"
@@ -42,7 +45,12 @@ import (
 
 type Operator interface {
 	CreateOrUpdate(context.Context) error
+	UpdateSpec(context.Context) error
 	IsReady(context.Context) (bool, error)
+	RollingOut(context.Context) (bool, error)
+	EndRollout(context.Context) error
+	Rollback(context.Context) (bool, error)
+	Degraded(context.Context, time.Time) ([]string, error)
 }
 
 type operator struct {
@@ -78,7 +86,15 @@ func New(log *logrus.Entry, env env.Interface, oc *api.OpenShiftCluster, cli kub
 	}, nil
 }
 
-func (o *operator) resources() ([]runtime.Object, error) {
+func (o *operator) resources(deployments map[string]*appsv1.Deployment) ([]runtime.Object, error) {
+	// the operator verifies the desired spec with the public half of the RP's
+	// spec signing key; the private half never leaves the RP
+	specKey := o.env.ClusterSpecSigningKey()
+	specPublicKey, err := specdrift.MarshalPublicKey(&specKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
 	// first static resources from Assets
 	results := []runtime.Object{}
 	for _, assetName := range AssetNames() {
@@ -98,15 +114,24 @@ func (o *operator) resources() ([]runtime.Object, error) {
 				d.Labels = map[string]string{}
 			}
 			d.Labels["version"] = version.GitCommit
-			for i := range d.Spec.Template.Spec.Containers {
-				d.Spec.Template.Spec.Containers[i].Image = o.env.AROOperatorImage()
+			o.rollout(d, deployments[d.Name])
 
+			for i := range d.Spec.Template.Spec.Containers {
 				if o.env.IsLocalDevelopmentMode() {
 					d.Spec.Template.Spec.Containers[i].Env = append(d.Spec.Template.Spec.Containers[i].Env, corev1.EnvVar{
 						Name:  "RP_MODE",
 						Value: "development",
 					})
 				}
+
+				d.Spec.Template.Spec.Containers[i].Env = append(d.Spec.Template.Spec.Containers[i].Env, corev1.EnvVar{
+					Name:  specdrift.PublicKeyEnv,
+					Value: string(specPublicKey),
+				})
+
+				// the operator reaches the API server on the service network
+				d.Spec.Template.Spec.Containers[i].Env = append(d.Spec.Template.Spec.Containers[i].Env,
+					proxy.Env(o.proxySpec(), o.oc.Properties.NetworkProfile.ServiceCIDR, o.oc.Properties.NetworkProfile.PodCIDR)...)
 			}
 		}
 
@@ -129,26 +154,65 @@ func (o *operator) resources() ([]runtime.Object, error) {
 		return nil, err
 	}
 
-	var monitoringEndpoint string
-	switch o.env.Environment().Name {
-	case azure.PublicCloud.Name:
-		monitoringEndpoint = "https://gcs.prod.monitoring.core.windows.net/"
-	case azure.USGovernmentCloud.Name:
-		monitoringEndpoint = "https://gcs.monitoring.core.usgovcloudapi.net/"
-	default:
-		return nil, fmt.Errorf("unsupported cloud environment")
+	internetCheckerURLs, err := o.internetCheckerURLs()
+	if err != nil {
+		return nil, err
 	}
 
+	internetCheckerOptionalURLs := o.internetCheckerOptionalURLs()
+
 	vnetID, _, err := subnet.Split(o.oc.Properties.MasterProfile.SubnetID)
 	if err != nil {
 		return nil, err
 	}
 
+	workarounds, err := workarounds.List()
+	if err != nil {
+		return nil, err
+	}
+
 	domain := o.oc.Properties.ClusterProfile.Domain
 	if !strings.ContainsRune(domain, '.') {
 		domain += "." + o.env.Domain()
 	}
 
+	cluster := &arov1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arov1alpha1.SingletonClusterName,
+		},
+		Spec: arov1alpha1.ClusterSpec{
+			ResourceID:    o.oc.ID,
+			Domain:        domain,
+			ACRDomain:     o.env.ACRDomain(),
+			AZEnvironment: o.env.Environment().Name,
+			Location:      o.env.Location(),
+			VnetID:        vnetID,
+			GenevaLogging: arov1alpha1.GenevaLoggingSpec{
+				ConfigVersion:            o.env.ClusterGenevaLoggingConfigVersion(),
+				MonitoringGCSEnvironment: o.env.ClusterGenevaLoggingEnvironment(),
+				SinkDestinations:         o.oc.Properties.LoggingProfile.SinkDestinations,
+			},
+			InternetChecker: arov1alpha1.InternetCheckerSpec{
+				URLs:         internetCheckerURLs,
+				OptionalURLs: internetCheckerOptionalURLs,
+			},
+			APIIntIP:  o.oc.Properties.APIServerProfile.IntIP,
+			IngressIP: o.oc.Properties.IngressProfiles[0].IP,
+			Features: arov1alpha1.FeaturesSpec{
+				PersistentPrometheus: false,
+				RevertSpecDrift:      o.env.FeatureIsSet(env.FeatureEnableSpecDriftRevert),
+			},
+			Proxy:       *o.proxySpec(),
+			Workarounds: workarounds,
+		},
+	}
+
+	// sign the spec so that the operator can detect in-cluster edits
+	spec, sig, err := specdrift.Sign(specKey, &cluster.Spec)
+	if err != nil {
+		return nil, err
+	}
+
 	// create a secret here for genevalogging, later we will copy it to
 	// the genevalogging namespace.
 	return append(results,
@@ -158,51 +222,179 @@ func (o *operator) resources() ([]runtime.Object, error) {
 				Namespace: pkgoperator.Namespace,
 			},
 			Data: map[string][]byte{
-				genevalogging.GenevaCertName: gcsCertBytes,
-				genevalogging.GenevaKeyName:  gcsKeyBytes,
-				corev1.DockerConfigJsonKey:   []byte(ps),
-			},
-		},
-		&arov1alpha1.Cluster{
-			ObjectMeta: metav1.ObjectMeta{
-				Name: arov1alpha1.SingletonClusterName,
-			},
-			Spec: arov1alpha1.ClusterSpec{
-				ResourceID:    o.oc.ID,
-				Domain:        domain,
-				ACRDomain:     o.env.ACRDomain(),
-				AZEnvironment: o.env.Environment().Name,
-				Location:      o.env.Location(),
-				VnetID:        vnetID,
-				GenevaLogging: arov1alpha1.GenevaLoggingSpec{
-					ConfigVersion:            o.env.ClusterGenevaLoggingConfigVersion(),
-					MonitoringGCSEnvironment: o.env.ClusterGenevaLoggingEnvironment(),
-				},
-				InternetChecker: arov1alpha1.InternetCheckerSpec{
-					URLs: []string{
-						fmt.Sprintf("https://%s/", o.env.ACRDomain()),
-						o.env.Environment().ActiveDirectoryEndpoint,
-						o.env.Environment().ResourceManagerEndpoint,
-						monitoringEndpoint,
-					},
-				},
-				APIIntIP:  o.oc.Properties.APIServerProfile.IntIP,
-				IngressIP: o.oc.Properties.IngressProfiles[0].IP,
-				Features: arov1alpha1.FeaturesSpec{
-					PersistentPrometheus: false,
-				},
+				genevalogging.GenevaCertName:       gcsCertBytes,
+				genevalogging.GenevaKeyName:        gcsKeyBytes,
+				corev1.DockerConfigJsonKey:         []byte(ps),
+				specdrift.DesiredSpecName:          spec,
+				specdrift.DesiredSpecSignatureName: sig,
 			},
 		},
+		cluster,
 	), nil
 }
 
+// internetCheckerURLs returns the endpoints which the cluster must be able to
+// reach: the ARO image registry, AAD, ARM, Geneva and the cluster storage
+// account
+func (o *operator) internetCheckerURLs() ([]string, error) {
+	var monitoringEndpoint string
+	switch o.env.Environment().Name {
+	case azure.PublicCloud.Name:
+		monitoringEndpoint = "https://gcs.prod.monitoring.core.windows.net/"
+	case azure.USGovernmentCloud.Name:
+		monitoringEndpoint = "https://gcs.monitoring.core.usgovcloudapi.net/"
+	default:
+		return nil, fmt.Errorf("unsupported cloud environment")
+	}
+
+	return []string{
+		fmt.Sprintf("https://%s/", o.env.ACRDomain()),
+		o.env.Environment().ActiveDirectoryEndpoint,
+		o.env.Environment().ResourceManagerEndpoint,
+		monitoringEndpoint,
+		fmt.Sprintf("https://cluster%s.blob.%s/", o.oc.Properties.StorageSuffix, o.env.Environment().StorageEndpointSuffix),
+	}, nil
+}
+
+// internetCheckerOptionalURLs returns endpoints which ARO does not need but
+// which customers commonly do: the Red Hat registries used for optional
+// operators and images
+func (o *operator) internetCheckerOptionalURLs() []string {
+	return []string{
+		"https://quay.io/",
+		"https://cdn.quay.io/",
+		"https://registry.redhat.io/",
+		"https://registry.access.redhat.com/",
+	}
+}
+
+func (o *operator) proxySpec() *arov1alpha1.ProxySpec {
+	return &arov1alpha1.ProxySpec{
+		HTTPProxy:  o.oc.Properties.ProxyProfile.HTTPProxy,
+		HTTPSProxy: o.oc.Properties.ProxyProfile.HTTPSProxy,
+		NoProxy:    o.oc.Properties.ProxyProfile.NoProxy,
+		TrustedCA:  o.oc.Properties.ProxyProfile.TrustedCA,
+	}
+}
+
+// CreateOrUpdate rolls out the operator, its static resources and the Cluster
+// spec together.  If the new operator image is held back from the cluster, the
+// static resources and the credentials in the operator Secret are still kept
+// up to date.
 func (o *operator) CreateOrUpdate(ctx context.Context) error {
-	resources, err := o.resources()
+	deployments, err := o.deployments(ctx)
+	if err != nil {
+		return err
+	}
+
+	resources, err := o.resources(deployments)
+	if err != nil {
+		return err
+	}
+
+	reason := o.heldBack(deployments)
+	if reason == "" {
+		return o.ensure(ctx, resources)
+	}
+
+	o.log.Infof("not rolling out %s or the Cluster spec: %s", o.env.AROOperatorImage(), reason)
+
+	resources, err = o.heldBackResources(ctx, resources)
 	if err != nil {
 		return err
 	}
 
-	err = dynamichelper.Prepare(resources)
+	err = o.ensure(ctx, resources)
+	if err != nil {
+		return err
+	}
+
+	return o.ensureSecretOwner(ctx)
+}
+
+// UpdateSpec updates the operator Secret and the Cluster spec without touching
+// the operator deployments or static resources.  The spec is not updated on
+// clusters from which the new operator image is held back, as their operator
+// may not understand it, but the credentials in the Secret are.
+func (o *operator) UpdateSpec(ctx context.Context) error {
+	deployments, err := o.deployments(ctx)
+	if err != nil {
+		return err
+	}
+
+	resources, err := o.resources(deployments)
+	if err != nil {
+		return err
+	}
+
+	reason := o.heldBack(deployments)
+	if reason != "" {
+		o.log.Infof("not updating the Cluster spec for %s: %s", o.env.AROOperatorImage(), reason)
+
+		resources, err = o.heldBackResources(ctx, resources)
+		if err != nil {
+			return err
+		}
+	}
+
+	var spec []runtime.Object
+	for _, resource := range resources {
+		switch resource.(type) {
+		case *corev1.Secret, *arov1alpha1.Cluster:
+			spec = append(spec, resource)
+		}
+	}
+
+	err = o.ensure(ctx, spec)
+	if err != nil {
+		return err
+	}
+
+	if reason != "" {
+		return o.ensureSecretOwner(ctx)
+	}
+
+	return nil
+}
+
+// heldBackResources filters the resources to ensure on a cluster from which
+// the new operator image is held back.  The operator deployments and the
+// Cluster spec are left as they are, and the operator Secret keeps the signed
+// copy of the current spec, so that the running operator does not see drift.
+func (o *operator) heldBackResources(ctx context.Context, resources []runtime.Object) ([]runtime.Object, error) {
+	var existingData map[string][]byte
+	existing, err := o.cli.CoreV1().Secrets(pkgoperator.Namespace).Get(ctx, pkgoperator.SecretName, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		existingData = existing.Data
+	case !kerrors.IsNotFound(err):
+		return nil, err
+	}
+
+	var results []runtime.Object
+	for _, resource := range resources {
+		switch r := resource.(type) {
+		case *appsv1.Deployment, *arov1alpha1.Cluster:
+			continue
+
+		case *corev1.Secret:
+			for _, k := range []string{specdrift.DesiredSpecName, specdrift.DesiredSpecSignatureName} {
+				if v, found := existingData[k]; found {
+					r.Data[k] = v
+				} else {
+					delete(r.Data, k)
+				}
+			}
+		}
+
+		results = append(results, resource)
+	}
+
+	return results, nil
+}
+
+func (o *operator) ensure(ctx context.Context, resources []runtime.Object) error {
+	err := dynamichelper.Prepare(resources)
 	if err != nil {
 		return err
 	}
@@ -243,40 +435,7 @@ func (o *operator) CreateOrUpdate(ctx context.Context) error {
 			}
 
 		case "Cluster.aro.openshift.io":
-			// add an owner reference onto our configuration secret.  This is
-			// can only be done once we've got the cluster UID.  It is needed to
-			// ensure that secret updates trigger updates of the appropriate
-			// controllers
-			err = retry.OnError(wait.Backoff{
-				Steps:    60,
-				Duration: time.Second,
-			}, func(err error) bool {
-				// IsForbidden here is intended to catch the following transient
-				// error: secrets "cluster" is forbidden: cannot set
-				// blockOwnerDeletion in this case because cannot find
-				// RESTMapping for APIVersion aro.openshift.io/v1alpha1 Kind
-				// Cluster: no matches for kind "Cluster" in version
-				// "aro.openshift.io/v1alpha1"
-				return kerrors.IsForbidden(err) || kerrors.IsConflict(err)
-			}, func() error {
-				cluster, err := o.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
-				if err != nil {
-					return err
-				}
-
-				s, err := o.cli.CoreV1().Secrets(pkgoperator.Namespace).Get(ctx, pkgoperator.SecretName, metav1.GetOptions{})
-				if err != nil {
-					return err
-				}
-
-				err = controllerutil.SetControllerReference(cluster, s, scheme.Scheme)
-				if err != nil {
-					return err
-				}
-
-				_, err = o.cli.CoreV1().Secrets(pkgoperator.Namespace).Update(ctx, s, metav1.UpdateOptions{})
-				return err
-			})
+			err = o.ensureSecretOwner(ctx)
 			if err != nil {
 				return err
 			}
@@ -285,6 +444,44 @@ func (o *operator) CreateOrUpdate(ctx context.Context) error {
 	return nil
 }
 
+// ensureSecretOwner adds an owner reference onto our configuration secret.
+// This can only be done once we've got the cluster UID.  It is needed to
+// ensure that secret updates trigger updates of the appropriate controllers
+func (o *operator) ensureSecretOwner(ctx context.Context) error {
+	return retry.OnError(wait.Backoff{
+		Steps:    60,
+		Duration: time.Second,
+	}, func(err error) bool {
+		// IsForbidden here is intended to catch the following transient
+		// error: secrets "cluster" is forbidden: cannot set
+		// blockOwnerDeletion in this case because cannot find
+		// RESTMapping for APIVersion aro.openshift.io/v1alpha1 Kind
+		// Cluster: no matches for kind "Cluster" in version
+		// "aro.openshift.io/v1alpha1"
+		return kerrors.IsForbidden(err) || kerrors.IsConflict(err)
+	}, func() error {
+		cluster, err := o.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		s, err := o.cli.CoreV1().Secrets(pkgoperator.Namespace).Get(ctx, pkgoperator.SecretName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		err = controllerutil.SetControllerReference(cluster, s, scheme.Scheme)
+		if err != nil {
+			return err
+		}
+
+		_, err = o.cli.CoreV1().Secrets(pkgoperator.Namespace).Update(ctx, s, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// IsReady returns true once the operator deployments are ready and the
+// master operator has reported the version it was deployed at
 func (o *operator) IsReady(ctx context.Context) (bool, error) {
 	ok, err := ready.CheckDeploymentIsReady(ctx, o.cli.AppsV1().Deployments(pkgoperator.Namespace), "aro-operator-master")()
 	if !ok || err != nil {
@@ -295,7 +492,23 @@ func (o *operator) IsReady(ctx context.Context) (bool, error) {
 		return ok, err
 	}
 
-	return true, nil
+	// in development the operator image is not necessarily built from the
+	// same commit as the RP
+	if o.env.IsLocalDevelopmentMode() {
+		return true, nil
+	}
+
+	d, err := o.cli.AppsV1().Deployments(pkgoperator.Namespace).Get(ctx, "aro-operator-master", metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	cluster, err := o.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	return cluster.Status.OperatorVersion == d.Labels["version"], nil
 }
 
 func isCRDEstablished(crd *extensionsv1.CustomResourceDefinition) bool {
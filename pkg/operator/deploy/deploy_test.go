@@ -0,0 +1,296 @@
+package deploy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/golang/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	pkgoperator "github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/genevalogging"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/specdrift"
+	"github.com/Azure/ARO-RP/pkg/operator/deploy/workarounds"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
+	utiltls "github.com/Azure/ARO-RP/pkg/util/tls"
+)
+
+// testOperator returns an operator for a test cluster whose environment has
+// the given features set
+func testOperator(t *testing.T, controller *gomock.Controller, features ...env.Feature) (*operator, *rsa.PrivateKey) {
+	specKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	genevaKey, genevaCerts, err := utiltls.GenerateKeyAndCertificate("geneva", nil, nil, false, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_env := mock_env.NewMockInterface(controller)
+	_env.EXPECT().ClusterSpecSigningKey().AnyTimes().Return(specKey)
+	_env.EXPECT().ClusterGenevaLoggingSecret().AnyTimes().Return(genevaKey, genevaCerts[0])
+	_env.EXPECT().ClusterGenevaLoggingConfigVersion().AnyTimes().Return("2.4")
+	_env.EXPECT().ClusterGenevaLoggingEnvironment().AnyTimes().Return("Test")
+	_env.EXPECT().IsLocalDevelopmentMode().AnyTimes().Return(false)
+	_env.EXPECT().AROOperatorImage().AnyTimes().Return("new")
+	_env.EXPECT().Environment().AnyTimes().Return(&azure.PublicCloud)
+	_env.EXPECT().ACRDomain().AnyTimes().Return("arosvc.azurecr.io")
+	_env.EXPECT().Domain().AnyTimes().Return("location.aroapp.io")
+	_env.EXPECT().Location().AnyTimes().Return("location")
+	_env.EXPECT().FeatureIsSet(gomock.Any()).AnyTimes().DoAndReturn(func(f env.Feature) bool {
+		for _, feature := range features {
+			if f == feature {
+				return true
+			}
+		}
+		return false
+	})
+
+	return &operator{
+		log: utillog.GetLogger(),
+		env: _env,
+		oc: &api.OpenShiftCluster{
+			ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName",
+			Properties: api.OpenShiftClusterProperties{
+				ClusterProfile: api.ClusterProfile{
+					Domain: "domain",
+				},
+				NetworkProfile: api.NetworkProfile{
+					PodCIDR:     "10.128.0.0/14",
+					ServiceCIDR: "172.30.0.0/16",
+				},
+				MasterProfile: api.MasterProfile{
+					SubnetID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/vnetResourceGroup/providers/Microsoft.Network/virtualNetworks/vnet/subnets/master",
+				},
+				APIServerProfile: api.APIServerProfile{
+					IntIP: "10.0.0.1",
+				},
+				IngressProfiles: []api.IngressProfile{
+					{
+						IP: "10.0.0.2",
+					},
+				},
+				StorageSuffix: "xxxxx",
+			},
+		},
+	}, specKey
+}
+
+func TestResources(t *testing.T) {
+	for _, tt := range []struct {
+		name                string
+		features            []env.Feature
+		wantRevertSpecDrift bool
+	}{
+		{
+			name: "default",
+		},
+		{
+			name:                "spec drift revert enabled",
+			features:            []env.Feature{env.FeatureEnableSpecDriftRevert},
+			wantRevertSpecDrift: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			o, specKey := testOperator(t, controller, tt.features...)
+
+			resources, err := o.resources(map[string]*appsv1.Deployment{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			publicKey, err := specdrift.MarshalPublicKey(&specKey.PublicKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var cluster *arov1alpha1.Cluster
+			var secret *corev1.Secret
+			var deployments int
+			for _, resource := range resources {
+				switch r := resource.(type) {
+				case *arov1alpha1.Cluster:
+					cluster = r
+				case *corev1.Secret:
+					secret = r
+				case *appsv1.Deployment:
+					deployments++
+					for _, c := range r.Spec.Template.Spec.Containers {
+						var found bool
+						for _, e := range c.Env {
+							if e.Name == specdrift.PublicKeyEnv && e.Value == string(publicKey) {
+								found = true
+							}
+						}
+						if !found {
+							t.Errorf("%s: public key not set", r.Name)
+						}
+					}
+				}
+			}
+
+			if deployments != 2 {
+				t.Error(deployments)
+			}
+
+			if cluster.Spec.Features.RevertSpecDrift != tt.wantRevertSpecDrift {
+				t.Error(cluster.Spec.Features.RevertSpecDrift)
+			}
+
+			wantWorkarounds, err := workarounds.List()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(cluster.Spec.Workarounds, wantWorkarounds) {
+				t.Error(cluster.Spec.Workarounds)
+			}
+
+			spec, err := specdrift.Verify(&specKey.PublicKey, secret.Data[specdrift.DesiredSpecName], secret.Data[specdrift.DesiredSpecSignatureName])
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(spec, &cluster.Spec) {
+				t.Error(spec)
+			}
+		})
+	}
+}
+
+func TestHeldBackResources(t *testing.T) {
+	ctx := context.Background()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	o, _ := testOperator(t, controller)
+	o.cli = fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pkgoperator.SecretName,
+			Namespace: pkgoperator.Namespace,
+		},
+		Data: map[string][]byte{
+			specdrift.DesiredSpecName:          []byte("current spec"),
+			specdrift.DesiredSpecSignatureName: []byte("current signature"),
+		},
+	})
+
+	resources, err := o.resources(map[string]*appsv1.Deployment{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resources, err = o.heldBackResources(ctx, resources)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var secret *corev1.Secret
+	for _, resource := range resources {
+		switch r := resource.(type) {
+		case *appsv1.Deployment, *arov1alpha1.Cluster:
+			t.Errorf("unexpected %T", r)
+		case *corev1.Secret:
+			secret = r
+		}
+	}
+
+	if secret == nil {
+		t.Fatal("secret not found")
+	}
+
+	// the credentials are updated, but not the signed spec
+	if len(secret.Data[genevalogging.GenevaCertName]) == 0 {
+		t.Error("geneva certificate not set")
+	}
+	if string(secret.Data[specdrift.DesiredSpecName]) != "current spec" {
+		t.Error(string(secret.Data[specdrift.DesiredSpecName]))
+	}
+	if string(secret.Data[specdrift.DesiredSpecSignatureName]) != "current signature" {
+		t.Error(string(secret.Data[specdrift.DesiredSpecSignatureName]))
+	}
+}
+
+func TestInternetCheckerURLs(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		environment *azure.Environment
+		want        []string
+		wantErr     string
+	}{
+		{
+			name:        "public cloud",
+			environment: &azure.PublicCloud,
+			want: []string{
+				"https://arosvc.azurecr.io/",
+				"https://login.microsoftonline.com/",
+				"https://management.azure.com/",
+				"https://gcs.prod.monitoring.core.windows.net/",
+				"https://clusterxxxxx.blob.core.windows.net/",
+			},
+		},
+		{
+			name:        "us government cloud",
+			environment: &azure.USGovernmentCloud,
+			want: []string{
+				"https://arosvc.azurecr.io/",
+				"https://login.microsoftonline.us/",
+				"https://management.usgovcloudapi.net/",
+				"https://gcs.monitoring.core.usgovcloudapi.net/",
+				"https://clusterxxxxx.blob.core.usgovcloudapi.net/",
+			},
+		},
+		{
+			name:        "unsupported cloud",
+			environment: &azure.ChinaCloud,
+			wantErr:     "unsupported cloud environment",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			_env := mock_env.NewMockInterface(controller)
+			_env.EXPECT().Environment().AnyTimes().Return(tt.environment)
+			_env.EXPECT().ACRDomain().AnyTimes().Return("arosvc.azurecr.io")
+
+			o := &operator{
+				env: _env,
+				oc: &api.OpenShiftCluster{
+					Properties: api.OpenShiftClusterProperties{
+						StorageSuffix: "xxxxx",
+					},
+				},
+			}
+
+			urls, err := o.internetCheckerURLs()
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(urls, tt.want) {
+				t.Error(urls)
+			}
+		})
+	}
+}
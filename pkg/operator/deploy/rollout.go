@@ -0,0 +1,253 @@
+package deploy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	pkgoperator "github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+const (
+	annotationPreviousImage   = "aro.openshift.io/previous-image"
+	annotationPreviousVersion = "aro.openshift.io/previous-version"
+	annotationFailedImage     = "aro.openshift.io/failed-image"
+)
+
+var deploymentNames = []string{"aro-operator-master", "aro-operator-worker"}
+
+// operatorConditionTypes are the conditions which report on the operator
+// itself.  The checker conditions report on the customer's environment, which
+// may change at any time regardless of the operator version, so they do not
+// count towards a rollback.
+var operatorConditionTypes = map[status.ConditionType]bool{
+	arov1alpha1.ClusterSpecSynced: true,
+}
+
+// isCanary returns true if the cluster falls within the given percentage of
+// clusters.  The decision is stable for a given cluster.
+func isCanary(resourceID string, percentage int) bool {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(strings.ToLower(resourceID)))
+
+	return int(h.Sum32()%100) < percentage
+}
+
+func deploymentImage(d *appsv1.Deployment) string {
+	if d == nil || len(d.Spec.Template.Spec.Containers) == 0 {
+		return ""
+	}
+
+	return d.Spec.Template.Spec.Containers[0].Image
+}
+
+// heldBack returns why the operator image must not be rolled out to the
+// cluster, or "" if it may be.  A new image is held back from clusters outside
+// the canary percentage, and from clusters on which it previously failed.
+// Clusters without an operator always get the new image.
+func (o *operator) heldBack(deployments map[string]*appsv1.Deployment) string {
+	image := o.env.AROOperatorImage()
+
+	for _, name := range deploymentNames {
+		existing := deployments[name]
+
+		existingImage := deploymentImage(existing)
+		if existingImage == "" || existingImage == image {
+			continue
+		}
+
+		if existing.Annotations[annotationFailedImage] == image {
+			return fmt.Sprintf("it previously failed on %s", name)
+		}
+
+		if !isCanary(o.oc.ID, o.env.AROOperatorCanaryPercentage()) {
+			return "the cluster is outside the canary percentage"
+		}
+	}
+
+	return ""
+}
+
+// deployments returns the operator deployments currently on the cluster,
+// keyed by name
+func (o *operator) deployments(ctx context.Context) (map[string]*appsv1.Deployment, error) {
+	deployments := map[string]*appsv1.Deployment{}
+
+	for _, name := range deploymentNames {
+		d, err := o.cli.AppsV1().Deployments(pkgoperator.Namespace).Get(ctx, name, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		deployments[name] = d
+	}
+
+	return deployments, nil
+}
+
+// rollout sets the image of a desired operator deployment.  When the image
+// changes, the running image is recorded so that Rollback can return to it
+// until EndRollout is called.
+func (o *operator) rollout(d *appsv1.Deployment, existing *appsv1.Deployment) {
+	image := o.env.AROOperatorImage()
+
+	if d.Annotations == nil {
+		d.Annotations = map[string]string{}
+	}
+
+	switch existingImage := deploymentImage(existing); existingImage {
+	case "":
+		// new deployment: nothing to roll back to
+
+	case image:
+		// no change: there is no rollout to roll back, but remember which
+		// image failed
+		if v, found := existing.Annotations[annotationFailedImage]; found {
+			d.Annotations[annotationFailedImage] = v
+		}
+
+	default:
+		d.Annotations[annotationPreviousImage] = existingImage
+		d.Annotations[annotationPreviousVersion] = existing.Labels["version"]
+	}
+
+	for i := range d.Spec.Template.Spec.Containers {
+		d.Spec.Template.Spec.Containers[i].Image = image
+	}
+}
+
+// RollingOut returns true if the operator deployments have been rolled out to
+// a new image and the rollout has not yet ended
+func (o *operator) RollingOut(ctx context.Context) (bool, error) {
+	deployments, err := o.deployments(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, d := range deployments {
+		if d.Annotations[annotationPreviousImage] != "" {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// EndRollout forgets the images the operator deployments were running before
+// the last rollout, once the new image has settled.  After this there is
+// nothing for Rollback to return to.
+func (o *operator) EndRollout(ctx context.Context) error {
+	for _, name := range deploymentNames {
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			d, err := o.cli.AppsV1().Deployments(pkgoperator.Namespace).Get(ctx, name, metav1.GetOptions{})
+			if kerrors.IsNotFound(err) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			if _, found := d.Annotations[annotationPreviousImage]; !found {
+				return nil
+			}
+
+			delete(d.Annotations, annotationPreviousImage)
+			delete(d.Annotations, annotationPreviousVersion)
+
+			_, err = o.cli.AppsV1().Deployments(pkgoperator.Namespace).Update(ctx, d, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Rollback returns the operator deployments to the image they were running
+// before the last rollout, and marks the current image as failed so that it
+// is not rolled out again.  It returns false if there was nothing to roll back
+// to, e.g. after the first rollout to a cluster.
+func (o *operator) Rollback(ctx context.Context) (bool, error) {
+	var rolledBack bool
+
+	for _, name := range deploymentNames {
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			d, err := o.cli.AppsV1().Deployments(pkgoperator.Namespace).Get(ctx, name, metav1.GetOptions{})
+			if kerrors.IsNotFound(err) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			previous := d.Annotations[annotationPreviousImage]
+			if previous == "" {
+				o.log.Infof("not rolling back %s: it has no previous image", name)
+				return nil
+			}
+
+			o.log.Infof("rolling back %s to %s", name, previous)
+
+			for i := range d.Spec.Template.Spec.Containers {
+				d.Annotations[annotationFailedImage] = d.Spec.Template.Spec.Containers[i].Image
+				d.Spec.Template.Spec.Containers[i].Image = previous
+			}
+			d.Labels["version"] = d.Annotations[annotationPreviousVersion]
+			delete(d.Annotations, annotationPreviousImage)
+			delete(d.Annotations, annotationPreviousVersion)
+
+			_, err = o.cli.AppsV1().Deployments(pkgoperator.Namespace).Update(ctx, d, metav1.UpdateOptions{})
+			if err != nil {
+				return err
+			}
+
+			rolledBack = true
+			return nil
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return rolledBack, nil
+}
+
+// Degraded returns the operator conditions (see operatorConditionTypes) which
+// have become false or unknown since the given time.  After a rollout, these
+// are errors reported by the new operator version.
+func (o *operator) Degraded(ctx context.Context, since time.Time) ([]string, error) {
+	cluster, err := o.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var degraded []string
+	for _, c := range cluster.Status.Conditions {
+		if operatorConditionTypes[c.Type] && c.Status != corev1.ConditionTrue && !c.LastTransitionTime.Time.Before(since) {
+			degraded = append(degraded, fmt.Sprintf("%s: %s", c.Type, c.Message))
+		}
+	}
+
+	sort.Strings(degraded)
+
+	return degraded, nil
+}
@@ -0,0 +1,351 @@
+package deploy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	pkgoperator "github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
+)
+
+func deployment(image, version string, annotations map[string]string) *appsv1.Deployment {
+	return namedDeployment("aro-operator-master", image, version, annotations)
+}
+
+func namedDeployment(name, image, version string, annotations map[string]string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   pkgoperator.Namespace,
+			Labels:      map[string]string{"version": version},
+			Annotations: annotations,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Image: image,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestIsCanary(t *testing.T) {
+	id := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName"
+
+	if isCanary(id, 0) {
+		t.Error("expected no cluster to be a canary at 0%")
+	}
+
+	if !isCanary(id, 100) {
+		t.Error("expected every cluster to be a canary at 100%")
+	}
+
+	if isCanary(id, 50) != isCanary(id, 50) {
+		t.Error("expected a stable result")
+	}
+}
+
+func TestHeldBack(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		percentage  int
+		deployments map[string]*appsv1.Deployment
+		want        string
+	}{
+		{
+			name:       "new cluster",
+			percentage: 0,
+			want:       "",
+		},
+		{
+			name:       "unchanged",
+			percentage: 0,
+			deployments: map[string]*appsv1.Deployment{
+				"aro-operator-master": deployment("new", "newversion", nil),
+			},
+			want: "",
+		},
+		{
+			name:       "inside canary",
+			percentage: 100,
+			deployments: map[string]*appsv1.Deployment{
+				"aro-operator-master": deployment("old", "oldversion", nil),
+			},
+			want: "",
+		},
+		{
+			name:       "outside canary",
+			percentage: 0,
+			deployments: map[string]*appsv1.Deployment{
+				"aro-operator-master": deployment("old", "oldversion", nil),
+			},
+			want: "the cluster is outside the canary percentage",
+		},
+		{
+			name:       "previously failed",
+			percentage: 100,
+			deployments: map[string]*appsv1.Deployment{
+				"aro-operator-master": deployment("old", "oldversion", nil),
+				"aro-operator-worker": namedDeployment("aro-operator-worker", "old", "oldversion", map[string]string{
+					annotationFailedImage: "new",
+				}),
+			},
+			want: "it previously failed on aro-operator-worker",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			env := mock_env.NewMockInterface(controller)
+			env.EXPECT().AROOperatorImage().AnyTimes().Return("new")
+			env.EXPECT().AROOperatorCanaryPercentage().AnyTimes().Return(tt.percentage)
+
+			o := &operator{
+				log: utillog.GetLogger(),
+				env: env,
+				oc:  &api.OpenShiftCluster{ID: "id"},
+			}
+
+			got := o.heldBack(tt.deployments)
+			if got != tt.want {
+				t.Error(got)
+			}
+		})
+	}
+}
+
+func TestRollout(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		existing *appsv1.Deployment
+		want     *appsv1.Deployment
+	}{
+		{
+			name: "new deployment",
+			want: deployment("new", "newversion", map[string]string{}),
+		},
+		{
+			name:     "upgrade",
+			existing: deployment("old", "oldversion", nil),
+			want: deployment("new", "newversion", map[string]string{
+				annotationPreviousImage:   "old",
+				annotationPreviousVersion: "oldversion",
+			}),
+		},
+		{
+			name: "unchanged",
+			existing: deployment("new", "newversion", map[string]string{
+				annotationPreviousImage:   "old",
+				annotationPreviousVersion: "oldversion",
+			}),
+			want: deployment("new", "newversion", map[string]string{}),
+		},
+		{
+			name: "unchanged after a rollback",
+			existing: deployment("new", "newversion", map[string]string{
+				annotationFailedImage: "newer",
+			}),
+			want: deployment("new", "newversion", map[string]string{
+				annotationFailedImage: "newer",
+			}),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			env := mock_env.NewMockInterface(controller)
+			env.EXPECT().AROOperatorImage().AnyTimes().Return("new")
+
+			o := &operator{
+				log: utillog.GetLogger(),
+				env: env,
+				oc:  &api.OpenShiftCluster{ID: "id"},
+			}
+
+			d := deployment("", "newversion", nil)
+			o.rollout(d, tt.existing)
+
+			if !reflect.DeepEqual(d, tt.want) {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestEndRollout(t *testing.T) {
+	ctx := context.Background()
+
+	cli := fake.NewSimpleClientset(deployment("new", "newversion", map[string]string{
+		annotationPreviousImage:   "old",
+		annotationPreviousVersion: "oldversion",
+	}))
+
+	o := &operator{
+		log: utillog.GetLogger(),
+		cli: cli,
+	}
+
+	rollingOut, err := o.RollingOut(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rollingOut {
+		t.Error("expected a rollout")
+	}
+
+	// the worker deployment is missing, so only the master is updated
+	err = o.EndRollout(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := cli.AppsV1().Deployments(pkgoperator.Namespace).Get(ctx, "aro-operator-master", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := deployment("new", "newversion", map[string]string{})
+	if !reflect.DeepEqual(d, want) {
+		t.Error(d)
+	}
+
+	rollingOut, err = o.RollingOut(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rollingOut {
+		t.Error("expected no rollout")
+	}
+
+	// there is nothing further to roll back to
+	rolledBack, err := o.Rollback(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rolledBack {
+		t.Error("expected no rollback")
+	}
+}
+
+func TestRollback(t *testing.T) {
+	ctx := context.Background()
+
+	cli := fake.NewSimpleClientset(deployment("new", "newversion", map[string]string{
+		annotationPreviousImage:   "old",
+		annotationPreviousVersion: "oldversion",
+	}))
+
+	o := &operator{
+		log: utillog.GetLogger(),
+		cli: cli,
+	}
+
+	// the worker deployment is missing, so only the master is rolled back
+	rolledBack, err := o.Rollback(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rolledBack {
+		t.Error("expected a rollback")
+	}
+
+	d, err := cli.AppsV1().Deployments(pkgoperator.Namespace).Get(ctx, "aro-operator-master", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := deployment("old", "oldversion", map[string]string{
+		annotationFailedImage: "new",
+	})
+	if !reflect.DeepEqual(d, want) {
+		t.Error(d)
+	}
+
+	// there is nothing further to roll back to
+	rolledBack, err = o.Rollback(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rolledBack {
+		t.Error("expected no rollback")
+	}
+}
+
+func TestDegraded(t *testing.T) {
+	ctx := context.Background()
+
+	since := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	before := metav1.NewTime(since.Add(-time.Minute))
+	after := metav1.NewTime(since.Add(time.Minute))
+
+	arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arov1alpha1.SingletonClusterName,
+		},
+		Status: arov1alpha1.ClusterStatus{
+			Conditions: status.Conditions{
+				{
+					Type:               arov1alpha1.InternetReachableFromMaster,
+					Status:             corev1.ConditionFalse,
+					Message:            "old failure",
+					LastTransitionTime: before,
+				},
+				{
+					Type:               arov1alpha1.InternetReachableFromWorker,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: after,
+				},
+				{
+					// checker conditions report on the customer's environment
+					Type:               arov1alpha1.MachineValid,
+					Status:             corev1.ConditionFalse,
+					Message:            "new failure",
+					LastTransitionTime: after,
+				},
+				{
+					Type:               arov1alpha1.ClusterSpecSynced,
+					Status:             corev1.ConditionUnknown,
+					Message:            "new failure",
+					LastTransitionTime: after,
+				},
+			},
+		},
+	})
+
+	o := &operator{
+		log:    utillog.GetLogger(),
+		arocli: arocli,
+	}
+
+	degraded, err := o.Degraded(ctx, since)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(degraded, []string{"ClusterSpecSynced: new failure"}) {
+		t.Error(degraded)
+	}
+}
@@ -0,0 +1,7 @@
+package workarounds
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+//go:generate go run ../../../../vendor/github.com/go-bindata/go-bindata/go-bindata -nometadata -pkg $GOPACKAGE -prefix staticresources -ignore README.md staticresources/...
+//go:generate gofmt -s -l -w bindata.go
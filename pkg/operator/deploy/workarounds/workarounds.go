@@ -0,0 +1,50 @@
+package workarounds
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ghodss/yaml"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+// List returns the declarative workarounds shipped by the RP, ordered by file
+// name.  See staticresources/README.md for how to add one.
+func List() ([]arov1alpha1.WorkaroundSpec, error) {
+	names := AssetNames()
+	sort.Strings(names)
+
+	var workarounds []arov1alpha1.WorkaroundSpec
+	seen := map[string]bool{}
+
+	for _, name := range names {
+		b, err := Asset(name)
+		if err != nil {
+			return nil, err
+		}
+
+		// converting through JSON leaves the manifests as JSON, which is what
+		// the Cluster spec holds
+		var w arov1alpha1.WorkaroundSpec
+		err = yaml.UnmarshalStrict(b, &w)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+
+		if w.Name == "" {
+			return nil, fmt.Errorf("%s: name is empty", name)
+		}
+		if seen[w.Name] {
+			return nil, fmt.Errorf("%s: duplicate workaround %q", name, w.Name)
+		}
+		seen[w.Name] = true
+
+		workarounds = append(workarounds, w)
+	}
+
+	return workarounds, nil
+}
@@ -0,0 +1,49 @@
+package workarounds
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+
+	"k8s.io/client-go/kubernetes/scheme"
+
+	_ "github.com/Azure/ARO-RP/pkg/util/scheme"
+	"github.com/Azure/ARO-RP/pkg/util/version"
+)
+
+// TestList checks that the shipped workarounds can be applied by the
+// operator's workaround controller
+func TestList(t *testing.T) {
+	workarounds, err := List()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, w := range workarounds {
+		if w.MinVersion != "" {
+			_, err = version.ParseVersion(w.MinVersion)
+			if err != nil {
+				t.Errorf("%s: %v", w.Name, err)
+			}
+		}
+
+		if w.FixedVersion != "" {
+			_, err = version.ParseVersion(w.FixedVersion)
+			if err != nil {
+				t.Errorf("%s: %v", w.Name, err)
+			}
+		}
+
+		if len(w.Manifests) == 0 {
+			t.Errorf("%s: no manifests", w.Name)
+		}
+
+		for i, m := range w.Manifests {
+			_, _, err = scheme.Codecs.UniversalDeserializer().Decode(m.Raw, nil, nil)
+			if err != nil {
+				t.Errorf("%s: manifest %d: %v", w.Name, i, err)
+			}
+		}
+	}
+}
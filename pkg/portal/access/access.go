@@ -0,0 +1,359 @@
+package access
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/validate"
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/portal/middleware"
+	"github.com/Azure/ARO-RP/pkg/util/log/audit"
+)
+
+// This file handles just-in-time elevated access.  Membership of an elevated
+// group makes a user eligible for elevated access, but to use it they must
+// request access to a specific cluster with a justification and ticket ID,
+// and somebody else who is eligible must approve the request.  Approved access
+// lasts for accessGrantTimeout.  Every step is audited.
+
+const (
+	accessRequestTimeout = 24 * time.Hour
+	accessGrantTimeout   = 4 * time.Hour
+
+	// minRemaining is the least time elevated access must have left to be
+	// used
+	minRemaining = time.Minute
+
+	maxJustificationLength = 1024
+)
+
+var rxTicketID = regexp.MustCompile(`^[A-Za-z0-9][-A-Za-z0-9_.#]{0,63}$`)
+
+var errNotPending = errors.New("access request is not pending")
+
+type access struct {
+	env   env.Core
+	log   *logrus.Entry
+	audit *logrus.Entry
+
+	elevatedGroupIDs []string
+
+	dbPortal database.Portal
+
+	newID func() string
+	now   func() time.Time
+}
+
+func New(env env.Core,
+	log *logrus.Entry,
+	audit *logrus.Entry,
+	elevatedGroupIDs []string,
+	dbPortal database.Portal,
+	aadAuthenticatedRouter *mux.Router) *access {
+	a := &access{
+		env:   env,
+		log:   log,
+		audit: audit,
+
+		elevatedGroupIDs: elevatedGroupIDs,
+
+		dbPortal: dbPortal,
+
+		newID: func() string { return uuid.Must(uuid.NewV4()).String() },
+		now:   time.Now,
+	}
+
+	aadAuthenticatedRouter.NewRoute().Methods(http.MethodPost).Path("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/microsoft.redhatopenshift/openshiftclusters/{resourceName}/access/new").HandlerFunc(a.new)
+	aadAuthenticatedRouter.NewRoute().Methods(http.MethodGet).Path("/api/access").HandlerFunc(a.list)
+	aadAuthenticatedRouter.NewRoute().Methods(http.MethodPost).Path("/api/access/{id}/approve").HandlerFunc(a.decide(api.AccessRequestStateApproved))
+	aadAuthenticatedRouter.NewRoute().Methods(http.MethodPost).Path("/api/access/{id}/deny").HandlerFunc(a.decide(api.AccessRequestStateDenied))
+
+	return a
+}
+
+// Expiry returns the time at which the approved elevated access of username
+// to the cluster with the given resource ID expires, or the zero time if the
+// user has no approved elevated access to the cluster.  Access with less than
+// minRemaining left is treated as expired, so that credentials are never
+// issued for less than that.
+func Expiry(ctx context.Context, dbPortal database.Portal, now time.Time, username, resourceID string) (time.Time, error) {
+	docs, err := dbPortal.ListAccessRequests(ctx, &database.PortalAccessRequestsFilter{
+		Username:     username,
+		ResourceID:   strings.ToLower(resourceID),
+		State:        api.AccessRequestStateApproved,
+		ExpiresAfter: now.Add(minRemaining).Unix(),
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var expiry int64
+	for _, doc := range docs.PortalDocuments {
+		if doc.Portal.AccessRequest.ExpiryTime > expiry {
+			expiry = doc.Portal.AccessRequest.ExpiryTime
+		}
+	}
+
+	if expiry == 0 {
+		return time.Time{}, nil
+	}
+
+	return time.Unix(expiry, 0), nil
+}
+
+type request struct {
+	Justification string `json:"justification,omitempty"`
+	TicketID      string `json:"ticketId,omitempty"`
+}
+
+type accessRequest struct {
+	ID            string `json:"id"`
+	Username      string `json:"username"`
+	ResourceID    string `json:"resourceId"`
+	Justification string `json:"justification"`
+	TicketID      string `json:"ticketId"`
+	State         string `json:"state"`
+	RequestTime   int64  `json:"requestTime"`
+	Approver      string `json:"approver,omitempty"`
+	DecisionTime  int64  `json:"decisionTime,omitempty"`
+	ExpiryTime    int64  `json:"expiryTime,omitempty"`
+}
+
+func newAccessRequest(doc *api.PortalDocument) *accessRequest {
+	return &accessRequest{
+		ID:            doc.ID,
+		Username:      doc.Portal.Username,
+		ResourceID:    doc.Portal.ID,
+		Justification: doc.Portal.AccessRequest.Justification,
+		TicketID:      doc.Portal.AccessRequest.TicketID,
+		State:         string(doc.Portal.AccessRequest.State),
+		RequestTime:   doc.Portal.AccessRequest.RequestTime,
+		Approver:      doc.Portal.AccessRequest.Approver,
+		DecisionTime:  doc.Portal.AccessRequest.DecisionTime,
+		ExpiryTime:    doc.Portal.AccessRequest.ExpiryTime,
+	}
+}
+
+func (a *access) eligible(r *http.Request) bool {
+	return len(middleware.GroupsIntersect(a.elevatedGroupIDs, r.Context().Value(middleware.ContextKeyGroups).([]string))) > 0
+}
+
+// new creates a pending request for elevated access to a cluster
+func (a *access) new(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	parts := strings.Split(r.URL.Path, "/")
+	if len(parts) < 9 {
+		http.Error(w, "invalid resourceId", http.StatusBadRequest)
+		return
+	}
+
+	resourceID := strings.Join(parts[:9], "/")
+	if !validate.RxClusterID.MatchString(resourceID) {
+		http.Error(w, fmt.Sprintf("invalid resourceId %q", resourceID), http.StatusBadRequest)
+		return
+	}
+
+	mediatype, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediatype != "application/json" {
+		http.Error(w, http.StatusText(http.StatusUnsupportedMediaType), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	var req *request
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil || req == nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	req.Justification = strings.TrimSpace(req.Justification)
+	if req.Justification == "" || len(req.Justification) > maxJustificationLength {
+		http.Error(w, "invalid justification", http.StatusBadRequest)
+		return
+	}
+
+	if !rxTicketID.MatchString(req.TicketID) {
+		http.Error(w, fmt.Sprintf("invalid ticketId %q", req.TicketID), http.StatusBadRequest)
+		return
+	}
+
+	if !a.eligible(r) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	doc := &api.PortalDocument{
+		ID:  a.newID(),
+		TTL: int(accessRequestTimeout / time.Second),
+		Portal: &api.Portal{
+			Username: ctx.Value(middleware.ContextKeyUsername).(string),
+			ID:       resourceID,
+			AccessRequest: &api.AccessRequest{
+				Justification: req.Justification,
+				TicketID:      req.TicketID,
+				State:         api.AccessRequestStatePending,
+				RequestTime:   a.now().Unix(),
+			},
+		},
+	}
+
+	doc, err = a.dbPortal.Create(ctx, doc)
+	if err != nil {
+		a.internalServerError(w, err)
+		return
+	}
+
+	a.auditLog(r, "RequestElevatedAccess", doc)
+
+	a.sendResponse(w, newAccessRequest(doc))
+}
+
+// list returns the access requests of all clusters, most recent first
+func (a *access) list(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if !a.eligible(r) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	docs, err := a.dbPortal.ListAccessRequests(ctx, &database.PortalAccessRequestsFilter{})
+	if err != nil {
+		a.internalServerError(w, err)
+		return
+	}
+
+	accessRequests := make([]*accessRequest, 0, len(docs.PortalDocuments))
+	for _, doc := range docs.PortalDocuments {
+		accessRequests = append(accessRequests, newAccessRequest(doc))
+	}
+
+	sort.Slice(accessRequests, func(i, j int) bool { return accessRequests[i].RequestTime > accessRequests[j].RequestTime })
+
+	a.sendResponse(w, accessRequests)
+}
+
+// decide approves or denies a pending access request.  Requests may not be
+// decided by their requester, except in local development.
+func (a *access) decide(state api.AccessRequestState) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		if !a.eligible(r) {
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+
+		username := ctx.Value(middleware.ContextKeyUsername).(string)
+		id := mux.Vars(r)["id"]
+
+		doc, err := a.dbPortal.Get(ctx, id)
+		switch {
+		case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		case err != nil:
+			a.internalServerError(w, err)
+			return
+		}
+
+		if doc.Portal.AccessRequest == nil {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+
+		if doc.Portal.Username == username && !a.env.IsLocalDevelopmentMode() {
+			http.Error(w, "access requests cannot be decided by their requester", http.StatusForbidden)
+			return
+		}
+
+		doc, err = a.dbPortal.Patch(ctx, id, func(doc *api.PortalDocument) error {
+			if doc.Portal.AccessRequest.State != api.AccessRequestStatePending {
+				return errNotPending
+			}
+
+			now := a.now()
+
+			doc.Portal.AccessRequest.State = state
+			doc.Portal.AccessRequest.Approver = username
+			doc.Portal.AccessRequest.DecisionTime = now.Unix()
+
+			if state == api.AccessRequestStateApproved {
+				doc.Portal.AccessRequest.ExpiryTime = now.Add(accessGrantTimeout).Unix()
+				doc.TTL = int(accessGrantTimeout / time.Second)
+			}
+
+			return nil
+		})
+		switch {
+		case err == errNotPending:
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		case err != nil:
+			a.internalServerError(w, err)
+			return
+		}
+
+		operation := "ApproveElevatedAccess"
+		if state == api.AccessRequestStateDenied {
+			operation = "DenyElevatedAccess"
+		}
+
+		a.auditLog(r, operation, doc)
+
+		a.sendResponse(w, newAccessRequest(doc))
+	}
+}
+
+// auditLog records a step of an access request in the audit log, in addition
+// to the audit log record of the HTTP request made by the log middleware
+func (a *access) auditLog(r *http.Request, operation string, doc *api.PortalDocument) {
+	middleware.Audit(a.env, a.audit, r, &middleware.AuditRecord{
+		Category:           audit.CategoryAuthorization,
+		OperationName:      operation,
+		TargetResourceName: doc.Portal.ID,
+		TargetResourceType: "access",
+		Description:        fmt.Sprintf("Access request %s: %s", doc.ID, doc.Portal.AccessRequest.State),
+		Fields: logrus.Fields{
+			"access_request_id": doc.ID,
+			"requester":         doc.Portal.Username,
+			"justification":     doc.Portal.AccessRequest.Justification,
+			"ticket_id":         doc.Portal.AccessRequest.TicketID,
+		},
+	})
+}
+
+func (a *access) sendResponse(w http.ResponseWriter, resp interface{}) {
+	b, err := json.MarshalIndent(resp, "", "    ")
+	if err != nil {
+		a.internalServerError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(b)
+}
+
+func (a *access) internalServerError(w http.ResponseWriter, err error) {
+	a.log.Warn(err)
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
@@ -0,0 +1,527 @@
+package access
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/portal/middleware"
+	"github.com/Azure/ARO-RP/pkg/portal/util/responsewriter"
+	"github.com/Azure/ARO-RP/pkg/util/log/audit"
+	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+func TestAccess(t *testing.T) {
+	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster"
+	elevatedGroupIDs := []string{"10000000-0000-0000-0000-000000000000"}
+	id := "20000000-0000-0000-0000-000000000000"
+	now := time.Unix(1600000000, 0)
+
+	pendingDocument := func() *api.PortalDocument {
+		return &api.PortalDocument{
+			ID:  id,
+			TTL: 86400,
+			Portal: &api.Portal{
+				Username: "requester",
+				ID:       resourceID,
+				AccessRequest: &api.AccessRequest{
+					Justification: "investigate node NotReady",
+					TicketID:      "IcM-1234",
+					State:         api.AccessRequestStatePending,
+					RequestTime:   now.Add(-time.Minute).Unix(),
+				},
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name               string
+		method             string
+		path               string
+		body               string
+		username           string
+		groups             []string
+		localDevelopment   bool
+		fixture            func(*testdatabase.Fixture)
+		checker            func(*testdatabase.Checker, *cosmosdb.FakePortalDocumentClient)
+		wantStatusCode     int
+		wantBody           string
+		wantAuditOperation string
+	}{
+		{
+			name:     "request",
+			method:   http.MethodPost,
+			path:     resourceID + "/access/new",
+			body:     `{"justification":" investigate node NotReady ","ticketId":"IcM-1234"}`,
+			username: "requester",
+			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				doc := pendingDocument()
+				doc.Portal.AccessRequest.RequestTime = now.Unix()
+				checker.AddPortalDocuments(doc)
+			},
+			wantStatusCode:     http.StatusOK,
+			wantBody:           "{\n    \"id\": \"20000000-0000-0000-0000-000000000000\",\n    \"username\": \"requester\",\n    \"resourceId\": \"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster\",\n    \"justification\": \"investigate node NotReady\",\n    \"ticketId\": \"IcM-1234\",\n    \"state\": \"Pending\",\n    \"requestTime\": 1600000000\n}",
+			wantAuditOperation: "RequestElevatedAccess",
+		},
+		{
+			name:           "request without justification",
+			method:         http.MethodPost,
+			path:           resourceID + "/access/new",
+			body:           `{"justification":" ","ticketId":"IcM-1234"}`,
+			username:       "requester",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "invalid justification\n",
+		},
+		{
+			name:           "request with bad ticket",
+			method:         http.MethodPost,
+			path:           resourceID + "/access/new",
+			body:           `{"justification":"investigate","ticketId":"IcM 1234"}`,
+			username:       "requester",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "invalid ticketId \"IcM 1234\"\n",
+		},
+		{
+			name:           "request junk",
+			method:         http.MethodPost,
+			path:           resourceID + "/access/new",
+			body:           "{{",
+			username:       "requester",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "Bad Request\n",
+		},
+		{
+			name:           "request bad path",
+			method:         http.MethodPost,
+			path:           "/subscriptions/BAD/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster/access/new",
+			body:           `{"justification":"investigate","ticketId":"IcM-1234"}`,
+			username:       "requester",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "invalid resourceId \"/subscriptions/BAD/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster\"\n",
+		},
+		{
+			name:           "request not eligible",
+			method:         http.MethodPost,
+			path:           resourceID + "/access/new",
+			body:           `{"justification":"investigate","ticketId":"IcM-1234"}`,
+			username:       "requester",
+			groups:         []string{},
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       "Forbidden\n",
+		},
+		{
+			name:     "request sad database",
+			method:   http.MethodPost,
+			path:     resourceID + "/access/new",
+			body:     `{"justification":"investigate","ticketId":"IcM-1234"}`,
+			username: "requester",
+			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				portalClient.SetError(fmt.Errorf("sad"))
+			},
+			wantStatusCode: http.StatusInternalServerError,
+			wantBody:       "Internal Server Error\n",
+		},
+		{
+			name:     "list",
+			method:   http.MethodGet,
+			path:     "/api/access",
+			username: "approver",
+			fixture: func(fixture *testdatabase.Fixture) {
+				fixture.AddPortalDocuments(pendingDocument(), &api.PortalDocument{
+					ID: "30000000-0000-0000-0000-000000000000",
+					Portal: &api.Portal{
+						Username:   "requester",
+						ID:         resourceID,
+						Kubeconfig: &api.Kubeconfig{},
+					},
+				})
+			},
+			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				checker.AddPortalDocuments(pendingDocument(), &api.PortalDocument{
+					ID: "30000000-0000-0000-0000-000000000000",
+					Portal: &api.Portal{
+						Username:   "requester",
+						ID:         resourceID,
+						Kubeconfig: &api.Kubeconfig{},
+					},
+				})
+			},
+			wantStatusCode: http.StatusOK,
+			wantBody:       "[\n    {\n        \"id\": \"20000000-0000-0000-0000-000000000000\",\n        \"username\": \"requester\",\n        \"resourceId\": \"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster\",\n        \"justification\": \"investigate node NotReady\",\n        \"ticketId\": \"IcM-1234\",\n        \"state\": \"Pending\",\n        \"requestTime\": 1599999940\n    }\n]",
+		},
+		{
+			name:           "list not eligible",
+			method:         http.MethodGet,
+			path:           "/api/access",
+			username:       "approver",
+			groups:         []string{},
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       "Forbidden\n",
+		},
+		{
+			name:     "approve",
+			method:   http.MethodPost,
+			path:     "/api/access/" + id + "/approve",
+			username: "approver",
+			fixture: func(fixture *testdatabase.Fixture) {
+				fixture.AddPortalDocuments(pendingDocument())
+			},
+			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				doc := pendingDocument()
+				doc.TTL = 14400
+				doc.Portal.AccessRequest.State = api.AccessRequestStateApproved
+				doc.Portal.AccessRequest.Approver = "approver"
+				doc.Portal.AccessRequest.DecisionTime = now.Unix()
+				doc.Portal.AccessRequest.ExpiryTime = now.Add(4 * time.Hour).Unix()
+				checker.AddPortalDocuments(doc)
+			},
+			wantStatusCode:     http.StatusOK,
+			wantBody:           "{\n    \"id\": \"20000000-0000-0000-0000-000000000000\",\n    \"username\": \"requester\",\n    \"resourceId\": \"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster\",\n    \"justification\": \"investigate node NotReady\",\n    \"ticketId\": \"IcM-1234\",\n    \"state\": \"Approved\",\n    \"requestTime\": 1599999940,\n    \"approver\": \"approver\",\n    \"decisionTime\": 1600000000,\n    \"expiryTime\": 1600014400\n}",
+			wantAuditOperation: "ApproveElevatedAccess",
+		},
+		{
+			name:     "deny",
+			method:   http.MethodPost,
+			path:     "/api/access/" + id + "/deny",
+			username: "approver",
+			fixture: func(fixture *testdatabase.Fixture) {
+				fixture.AddPortalDocuments(pendingDocument())
+			},
+			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				doc := pendingDocument()
+				doc.Portal.AccessRequest.State = api.AccessRequestStateDenied
+				doc.Portal.AccessRequest.Approver = "approver"
+				doc.Portal.AccessRequest.DecisionTime = now.Unix()
+				checker.AddPortalDocuments(doc)
+			},
+			wantStatusCode:     http.StatusOK,
+			wantBody:           "{\n    \"id\": \"20000000-0000-0000-0000-000000000000\",\n    \"username\": \"requester\",\n    \"resourceId\": \"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster\",\n    \"justification\": \"investigate node NotReady\",\n    \"ticketId\": \"IcM-1234\",\n    \"state\": \"Denied\",\n    \"requestTime\": 1599999940,\n    \"approver\": \"approver\",\n    \"decisionTime\": 1600000000\n}",
+			wantAuditOperation: "DenyElevatedAccess",
+		},
+		{
+			name:     "approve own request",
+			method:   http.MethodPost,
+			path:     "/api/access/" + id + "/approve",
+			username: "requester",
+			fixture: func(fixture *testdatabase.Fixture) {
+				fixture.AddPortalDocuments(pendingDocument())
+			},
+			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				checker.AddPortalDocuments(pendingDocument())
+			},
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       "access requests cannot be decided by their requester\n",
+		},
+		{
+			name:             "approve own request in local development",
+			method:           http.MethodPost,
+			path:             "/api/access/" + id + "/approve",
+			username:         "requester",
+			localDevelopment: true,
+			fixture: func(fixture *testdatabase.Fixture) {
+				fixture.AddPortalDocuments(pendingDocument())
+			},
+			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				doc := pendingDocument()
+				doc.TTL = 14400
+				doc.Portal.AccessRequest.State = api.AccessRequestStateApproved
+				doc.Portal.AccessRequest.Approver = "requester"
+				doc.Portal.AccessRequest.DecisionTime = now.Unix()
+				doc.Portal.AccessRequest.ExpiryTime = now.Add(4 * time.Hour).Unix()
+				checker.AddPortalDocuments(doc)
+			},
+			wantStatusCode:     http.StatusOK,
+			wantBody:           "{\n    \"id\": \"20000000-0000-0000-0000-000000000000\",\n    \"username\": \"requester\",\n    \"resourceId\": \"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster\",\n    \"justification\": \"investigate node NotReady\",\n    \"ticketId\": \"IcM-1234\",\n    \"state\": \"Approved\",\n    \"requestTime\": 1599999940,\n    \"approver\": \"requester\",\n    \"decisionTime\": 1600000000,\n    \"expiryTime\": 1600014400\n}",
+			wantAuditOperation: "ApproveElevatedAccess",
+		},
+		{
+			name:     "approve not eligible",
+			method:   http.MethodPost,
+			path:     "/api/access/" + id + "/approve",
+			username: "approver",
+			groups:   []string{},
+			fixture: func(fixture *testdatabase.Fixture) {
+				fixture.AddPortalDocuments(pendingDocument())
+			},
+			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				checker.AddPortalDocuments(pendingDocument())
+			},
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       "Forbidden\n",
+		},
+		{
+			name:     "approve decided request",
+			method:   http.MethodPost,
+			path:     "/api/access/" + id + "/approve",
+			username: "approver",
+			fixture: func(fixture *testdatabase.Fixture) {
+				doc := pendingDocument()
+				doc.Portal.AccessRequest.State = api.AccessRequestStateDenied
+				fixture.AddPortalDocuments(doc)
+			},
+			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				doc := pendingDocument()
+				doc.Portal.AccessRequest.State = api.AccessRequestStateDenied
+				checker.AddPortalDocuments(doc)
+			},
+			wantStatusCode: http.StatusConflict,
+			wantBody:       "access request is not pending\n",
+		},
+		{
+			name:           "approve missing request",
+			method:         http.MethodPost,
+			path:           "/api/access/" + id + "/approve",
+			username:       "approver",
+			wantStatusCode: http.StatusNotFound,
+			wantBody:       "Not Found\n",
+		},
+		{
+			name:     "approve other document",
+			method:   http.MethodPost,
+			path:     "/api/access/" + id + "/approve",
+			username: "approver",
+			fixture: func(fixture *testdatabase.Fixture) {
+				fixture.AddPortalDocuments(&api.PortalDocument{
+					ID: id,
+					Portal: &api.Portal{
+						Username:   "requester",
+						ID:         resourceID,
+						Kubeconfig: &api.Kubeconfig{},
+					},
+				})
+			},
+			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				checker.AddPortalDocuments(&api.PortalDocument{
+					ID: id,
+					Portal: &api.Portal{
+						Username:   "requester",
+						ID:         resourceID,
+						Kubeconfig: &api.Kubeconfig{},
+					},
+				})
+			},
+			wantStatusCode: http.StatusNotFound,
+			wantBody:       "Not Found\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			dbPortal, portalClient := testdatabase.NewFakePortal()
+
+			fixture := testdatabase.NewFixture().
+				WithPortal(dbPortal)
+
+			if tt.fixture != nil {
+				tt.fixture(fixture)
+			}
+
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			checker := testdatabase.NewChecker()
+
+			if tt.checker != nil {
+				tt.checker(checker, portalClient)
+			}
+
+			groups := elevatedGroupIDs
+			if tt.groups != nil {
+				groups = tt.groups
+			}
+
+			ctx = context.WithValue(ctx, middleware.ContextKeyUsername, tt.username)
+			ctx = context.WithValue(ctx, middleware.ContextKeyGroups, groups)
+			r, err := http.NewRequestWithContext(ctx, tt.method, "https://localhost:8444"+tt.path, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r.Header.Set("Content-Type", "application/json")
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			_env := mock_env.NewMockCore(ctrl)
+			_env.EXPECT().IsLocalDevelopmentMode().AnyTimes().Return(tt.localDevelopment)
+			_env.EXPECT().Environment().AnyTimes().Return(&azure.PublicCloud)
+			_env.EXPECT().Hostname().AnyTimes().Return("testhost")
+			_env.EXPECT().Location().AnyTimes().Return("eastus")
+
+			aadAuthenticatedRouter := &mux.Router{}
+
+			auditHook, auditLog := testlog.NewAudit()
+			_, log := testlog.New()
+
+			a := New(_env, log, auditLog, elevatedGroupIDs, dbPortal, aadAuthenticatedRouter)
+			a.newID = func() string { return id }
+			a.now = func() time.Time { return now }
+
+			w := responsewriter.New(r)
+
+			aadAuthenticatedRouter.ServeHTTP(w, r)
+
+			portalClient.SetError(nil)
+
+			for _, err = range checker.CheckPortals(portalClient) {
+				t.Error(err)
+			}
+
+			resp := w.Response()
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Error(resp.StatusCode)
+			}
+
+			wantContentType := "application/json"
+			if resp.StatusCode != http.StatusOK {
+				wantContentType = "text/plain; charset=utf-8"
+			}
+			if resp.Header.Get("Content-Type") != wantContentType {
+				t.Error(resp.Header.Get("Content-Type"))
+			}
+
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(b) != tt.wantBody {
+				t.Errorf("%q", string(b))
+			}
+
+			if tt.wantAuditOperation == "" {
+				if len(auditHook.AllEntries()) != 0 {
+					t.Error(len(auditHook.AllEntries()))
+				}
+				return
+			}
+
+			if len(auditHook.AllEntries()) != 1 {
+				t.Fatal(len(auditHook.AllEntries()))
+			}
+
+			entry := auditHook.AllEntries()[0]
+
+			var payload audit.Payload
+			err = json.Unmarshal([]byte(entry.Data[audit.MetadataPayload].(string)), &payload)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if payload.OperationName != tt.wantAuditOperation {
+				t.Error(payload.OperationName)
+			}
+			if payload.Category != audit.CategoryAuthorization {
+				t.Error(payload.Category)
+			}
+			if len(payload.CallerIdentities) != 1 || payload.CallerIdentities[0].CallerIdentityValue != tt.username {
+				t.Error(payload.CallerIdentities)
+			}
+			if len(payload.TargetResources) != 1 || payload.TargetResources[0].TargetResourceName != resourceID {
+				t.Error(payload.TargetResources)
+			}
+			if entry.Data["access_request_id"] != id ||
+				entry.Data["requester"] != "requester" ||
+				entry.Data["ticket_id"] != "IcM-1234" {
+				t.Error(entry.Data)
+			}
+		})
+	}
+}
+
+func TestExpiry(t *testing.T) {
+	ctx := context.Background()
+	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster"
+	now := time.Unix(1600000000, 0)
+
+	accessRequest := func(id, username, resourceID string, state api.AccessRequestState, expiryTime int64) *api.PortalDocument {
+		return &api.PortalDocument{
+			ID: id,
+			Portal: &api.Portal{
+				Username: username,
+				ID:       resourceID,
+				AccessRequest: &api.AccessRequest{
+					State:      state,
+					ExpiryTime: expiryTime,
+				},
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name       string
+		docs       []*api.PortalDocument
+		wantExpiry time.Time
+	}{
+		{
+			name: "no requests",
+		},
+		{
+			name: "latest approved request",
+			docs: []*api.PortalDocument{
+				accessRequest("00000000-0000-0000-0000-000000000001", "username", resourceID, api.AccessRequestStateApproved, 1600003600),
+				accessRequest("00000000-0000-0000-0000-000000000002", "username", resourceID, api.AccessRequestStateApproved, 1600007200),
+			},
+			wantExpiry: time.Unix(1600007200, 0),
+		},
+		{
+			name: "approved request with a minute left",
+			docs: []*api.PortalDocument{
+				accessRequest("00000000-0000-0000-0000-000000000001", "username", resourceID, api.AccessRequestStateApproved, 1600000060),
+			},
+			wantExpiry: time.Unix(1600000060, 0),
+		},
+		{
+			name: "ignored requests",
+			docs: []*api.PortalDocument{
+				accessRequest("00000000-0000-0000-0000-000000000001", "username", resourceID, api.AccessRequestStatePending, 0),
+				accessRequest("00000000-0000-0000-0000-000000000002", "username", resourceID, api.AccessRequestStateDenied, 0),
+				accessRequest("00000000-0000-0000-0000-000000000003", "username", resourceID, api.AccessRequestStateApproved, 1600000000),
+				accessRequest("00000000-0000-0000-0000-000000000006", "username", resourceID, api.AccessRequestStateApproved, 1600000059),
+				accessRequest("00000000-0000-0000-0000-000000000004", "other", resourceID, api.AccessRequestStateApproved, 1600003600),
+				accessRequest("00000000-0000-0000-0000-000000000005", "username", resourceID+"2", api.AccessRequestStateApproved, 1600003600),
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dbPortal, _ := testdatabase.NewFakePortal()
+
+			fixture := testdatabase.NewFixture().
+				WithPortal(dbPortal)
+			fixture.AddPortalDocuments(tt.docs...)
+
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expiry, err := Expiry(ctx, dbPortal, now, "username", resourceID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !expiry.Equal(tt.wantExpiry) {
+				t.Error(expiry)
+			}
+		})
+	}
+}
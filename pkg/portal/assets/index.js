@@ -17,18 +17,621 @@ $.extend({
     }
 });
 
-$(document).ready(function () {
+// replay plays back the output of an asciicast v2 recording as plain text.
+// Downloaded recordings can be played with full terminal emulation using
+// asciinema.
+var replayTimers = [];
+
+function replay(url) {
+    $.each(replayTimers, function (i, timer) {
+        clearTimeout(timer);
+    });
+    replayTimers = [];
+
+    var pre = $("#preReplay");
+    pre.text("").removeClass("d-none");
+
+    $.ajax({
+        url: url,
+        success: function (cast) {
+            var text = "";
+            var last = 0;
+            var at = 0;
+
+            $.each(cast.split("\n").slice(1), function (i, line) {
+                if (!line) {
+                    return;
+                }
+
+                var event = JSON.parse(line);
+                if (event[1] !== "o") {
+                    return;
+                }
+
+                // idle periods are shortened to at most two seconds
+                at += Math.min(event[0] - last, 2);
+                last = event[0];
+
+                replayTimers.push(setTimeout(function () {
+                    text += event[2].replace(/\x1b\[[0-9;?]*[ -\/]*[@-~]|\x1b\][^\x07]*\x07|\x1b[()][0-9A-Za-z]|\r/g, "");
+                    pre.text(text);
+                    pre.scrollTop(pre.prop("scrollHeight"));
+                }, at * 1000));
+            });
+        },
+        dataType: "text",
+    });
+}
+
+function alertMessage(template, message) {
+    var alert = $($(template).html());
+
+    alert.find("span[data-copy]").text(message);
+    $("#divAlerts").html(alert);
+}
+
+// appendRows adds a row to table for each of items, with a cell for each of the
+// values returned by columns.  Abnormal rows are highlighted.
+function appendRows(table, items, columns, abnormal) {
+    $.each(items, function (i, item) {
+        var row = $("<tr>");
+
+        $.each(columns(item), function (j, value) {
+            row.append($("<td>").text(value === undefined ? "" : value));
+        });
+
+        if (abnormal && abnormal(item)) {
+            row.addClass("table-warning");
+        }
+
+        table.find("tbody").append(row);
+    });
+}
+
+function details() {
+    $.ajax({
+        url: "/api/clusters" + $("#selResourceId").val(),
+        success: function (detail) {
+            var div = $($("#tmplDetails").html());
+
+            appendRows(div.find("table[data-table='properties']"), [
+                ["Resource ID", detail["resourceId"]],
+                ["Location", detail["location"]],
+                ["Version", detail["version"]],
+                ["Provisioning state", detail["provisioningState"] + (detail["failedProvisioningState"] ? " (" + detail["failedProvisioningState"] + ")" : "")],
+                ["Last admin update error", detail["lastAdminUpdateError"]],
+                ["Created", detail["createdAt"] && !detail["createdAt"].startsWith("0001-") ? new Date(detail["createdAt"]).toLocaleString() : ""],
+                ["Domain", detail["domain"]],
+                ["Console", detail["consoleUrl"]],
+                ["API server", detail["apiServerUrl"] + " (" + detail["apiServerVisibility"] + ", " + detail["apiServerIp"] + ")"],
+                ["Cluster resource group", detail["resourceGroupId"]],
+                ["Pod CIDR", detail["podCidr"]],
+                ["Service CIDR", detail["serviceCidr"]],
+                ["Master VM size", detail["masterVmSize"]],
+                ["Master subnet", detail["masterSubnetId"]],
+            ], function (property) {
+                return property;
+            });
+
+            appendRows(div.find("table[data-table='workerProfiles']"), detail["workerProfiles"], function (profile) {
+                return [profile["name"], profile["vmSize"], profile["diskSizeGB"], profile["count"], profile["subnetId"]];
+            });
+
+            appendRows(div.find("table[data-table='ingressProfiles']"), detail["ingressProfiles"], function (profile) {
+                return [profile["name"], profile["visibility"], profile["ip"]];
+            });
+
+            if (detail["clusterOperatorsError"]) {
+                div.find("div[data-error='clusterOperators']").text(detail["clusterOperatorsError"]).removeClass("d-none");
+            }
+            appendRows(div.find("table[data-table='clusterOperators']"), detail["clusterOperators"], function (operator) {
+                return [operator["name"], operator["version"], operator["available"], operator["progressing"], operator["degraded"], operator["message"]];
+            }, function (operator) {
+                return operator["available"] !== "True" || operator["degraded"] !== "False";
+            });
+
+            if (detail["nodesError"]) {
+                div.find("div[data-error='nodes']").text(detail["nodesError"]).removeClass("d-none");
+            }
+            appendRows(div.find("table[data-table='nodes']"), detail["nodes"], function (node) {
+                return [node["name"], node["roles"], node["ready"], node["unschedulable"] ? "False" : "True", node["kubeletVersion"], node["internalIp"]];
+            }, function (node) {
+                return node["ready"] !== "True";
+            });
+
+            appendRows(div.find("table[data-table='asyncOperations']"), detail["asyncOperations"], function (operation) {
+                return [new Date(operation["startTime"]).toLocaleString(), operation["endTime"] ? new Date(operation["endTime"]).toLocaleString() : "", operation["initialStatus"], operation["status"], operation["error"]];
+            }, function (operation) {
+                return operation["status"] === "Failed";
+            });
+
+            $("#divDetails").html(div);
+        },
+        error: function (xhr) {
+            alertMessage("#tmplSSHAlertError", xhr.responseText);
+        },
+        dataType: "json",
+    });
+}
+
+function accessRequests() {
+    $.ajax({
+        url: "/api/access",
+        success: function (requests) {
+            var table = $($("#tmplAccessRequests").html());
+
+            $.each(requests, function (i, request) {
+                var row = $("<tr>");
+                var state = request["state"];
+
+                if (state === "Approved") {
+                    state += " by " + request["approver"] + " until " + new Date(request["expiryTime"] * 1000).toLocaleString();
+                } else if (state === "Denied") {
+                    state += " by " + request["approver"];
+                }
+
+                row.append($("<td>").text(new Date(request["requestTime"] * 1000).toLocaleString()));
+                row.append($("<td>").text(request["username"]));
+                row.append($("<td>").text(request["resourceId"].split("/").pop()).attr("title", request["resourceId"]));
+                row.append($("<td>").text(request["ticketId"]));
+                row.append($("<td>").text(request["justification"]));
+                row.append($("<td>").text(state));
+
+                var actions = $("<td>");
+                if (request["state"] === "Pending") {
+                    $.each(["approve", "deny"], function (i, decision) {
+                        actions.append($("<button class='btn btn-sm btn-secondary mr-1'>").text(decision.charAt(0).toUpperCase() + decision.slice(1)).click(function () {
+                            $.ajax({
+                                method: "POST",
+                                url: "/api/access/" + request["id"] + "/" + decision,
+                                headers: {
+                                    "X-CSRF-Token": $("input[name='gorilla.csrf.Token']").val(),
+                                },
+                                success: accessRequests,
+                                error: function (xhr) {
+                                    alertMessage("#tmplSSHAlertError", xhr.responseText);
+                                },
+                            });
+                        }));
+                    });
+                }
+                row.append(actions);
+
+                table.find("tbody").append(row);
+            });
+
+            $("#divAccessRequests").html(table);
+        },
+        error: function (xhr) {
+            alertMessage("#tmplSSHAlertError", xhr.responseText);
+        },
+        dataType: "json",
+    });
+}
+
+// revokeSessions revokes the sessions matching filter, or the session with
+// the given id, then refreshes the session list
+function revokeSessions(id, filter) {
+    $.ajax({
+        method: "POST",
+        url: id ? "/api/sessions/" + id + "/revoke" : "/api/sessions/revoke",
+        headers: {
+            "X-CSRF-Token": $("input[name='gorilla.csrf.Token']").val(),
+        },
+        contentType: "application/json",
+        data: filter ? JSON.stringify(filter) : undefined,
+        success: sessions,
+        error: function (xhr) {
+            alertMessage("#tmplSSHAlertError", xhr.responseText);
+        },
+        dataType: "json",
+    });
+}
+
+// sessions lists the kubeconfig and SSH sessions of the selected cluster
+function sessions() {
+    var resourceId = $("#selResourceId").val();
+
+    $.ajax({
+        url: "/api/sessions",
+        data: {
+            "resourceId": resourceId,
+        },
+        success: function (sessions) {
+            var div = $($("#tmplSessions").html());
+
+            div.find("button[data-action='revokeCluster']").click(function () {
+                if (confirm("Revoke all sessions of " + resourceId.split("/").pop() + "?")) {
+                    revokeSessions(undefined, {"resourceId": resourceId});
+                }
+            });
+
+            $.each(sessions, function (i, session) {
+                var row = $("<tr>");
+                var type = session["type"];
+
+                if (type === "ssh") {
+                    type += " " + session["node"] + (session["authenticated"] ? " (connected)" : "");
+                }
+
+                row.append($("<td>").text(session["username"]));
+                row.append($("<td>").text(type));
+                row.append($("<td>").text(session["elevated"] ? "yes" : "no"));
+                row.append($("<td>").text(session["expiryTime"] ? new Date(session["expiryTime"] * 1000).toLocaleString() : ""));
+                row.append($("<td>").append(
+                    $("<button class='btn btn-sm btn-secondary mr-1'>").text("Revoke").click(function () {
+                        revokeSessions(session["id"]);
+                    }),
+                    $("<button class='btn btn-sm btn-danger'>").text("Revoke all of user").click(function () {
+                        if (confirm("Revoke all sessions of " + session["username"] + " on every cluster?")) {
+                            revokeSessions(undefined, {"username": session["username"]});
+                        }
+                    })
+                ));
+
+                div.find("tbody").append(row);
+            });
+
+            $("#divSessions").html(div);
+        },
+        error: function (xhr) {
+            alertMessage("#tmplSSHAlertError", xhr.responseText);
+        },
+        dataType: "json",
+    });
+}
+
+// The cluster search is run by the portal, which returns one page of clusters
+// at a time.  clusterPages holds the token of each page seen so far, so that
+// the user can page back as well as forward.
+var clusterSearch = {};
+var clusterPages = [];
+var clusterPage = 0;
+
+function searchClusters() {
+    clusterSearch = {};
+
+    $.each({
+        "name": "#inpSearchName",
+        "subscriptionId": "#inpSearchSubscriptionId",
+        "resourceGroup": "#inpSearchResourceGroup",
+        "version": "#inpSearchVersion",
+        "provisioningState": "#selSearchProvisioningState",
+    }, function (key, id) {
+        var value = $(id).val().trim();
+        if (value) {
+            clusterSearch[key] = value;
+        }
+    });
+
+    var tags = $.grep($.map($("#inpSearchTags").val().split(","), $.trim), Boolean);
+    if (tags.length) {
+        clusterSearch["tag"] = tags;
+    }
+
+    clusterPages = [""];
+    showClusterPage(0);
+}
+
+function showClusterPage(page) {
+    var data = $.extend({}, clusterSearch);
+    if (clusterPages[page]) {
+        data["page"] = clusterPages[page];
+    }
+
     $.ajax({
         url: "/api/clusters",
-        success: function (clusters) {
-            $.each(clusters, function (i, cluster) {
-                $("#selResourceId").append($("<option>").text(cluster));
+        data: data,
+        traditional: true,
+        success: function (result) {
+            var sel = $("#selResourceId");
+            var tbody = $("#tblClusters tbody");
+
+            sel.empty();
+            tbody.empty();
+
+            $.each(result["clusters"], function (i, cluster) {
+                var state = cluster["provisioningState"];
+                if (cluster["failedProvisioningState"]) {
+                    state += " (" + cluster["failedProvisioningState"] + ")";
+                }
+
+                sel.append($("<option>").text(cluster["resourceId"]));
+
+                var row = $("<tr style='cursor: pointer;'>").click(function () {
+                    sel.selectpicker("val", cluster["resourceId"]);
+                });
+
+                row.append($("<td>").text(cluster["name"]));
+                row.append($("<td>").text(cluster["subscriptionId"]));
+                row.append($("<td>").text(cluster["resourceGroup"]));
+                row.append($("<td>").text(cluster["location"]));
+                row.append($("<td>").text(cluster["version"] || ""));
+                row.append($("<td>").text(state));
+
+                tbody.append(row);
+            });
+
+            sel.selectpicker("refresh");
+
+            clusterPage = page;
+            clusterPages.length = page + 1;
+            if (result["nextPage"]) {
+                clusterPages.push(result["nextPage"]);
+            }
+
+            $("#btnPreviousPage").prop("disabled", page === 0);
+            $("#btnNextPage").prop("disabled", !result["nextPage"]);
+        },
+        error: function (xhr) {
+            alertMessage("#tmplSSHAlertError", xhr.responseText);
+        },
+        dataType: "json",
+    });
+}
+
+// sshRecordings lists the SSH session recordings of a cluster.  Only auditors
+// may review recordings.
+function sshRecordings(resourceId) {
+    $.ajax({
+        url: resourceId + "/ssh/recordings",
+        success: function (recordings) {
+            var table = $($("#tmplRecordings").html());
+
+            $.each(recordings, function (i, recording) {
+                var url = resourceId + "/ssh/recordings/" + recording["id"];
+                var row = $("<tr>");
+
+                row.append($("<td>").text(new Date(recording["startTime"] * 1000).toLocaleString()));
+                row.append($("<td>").text(recording["endTime"] ? new Date(recording["endTime"] * 1000).toLocaleString() : ""));
+                row.append($("<td>").text(recording["username"]));
+                row.append($("<td>").text(recording["terminal"] ? "web terminal" : recording["node"] || "master-" + recording["master"]));
+                row.append($("<td>").append(
+                    $("<button class='btn btn-sm btn-secondary mr-1'>").text("Replay").click(function () {
+                        replay(url);
+                    }),
+                    $("<a class='btn btn-sm btn-secondary'>").attr("href", url).text("Download")
+                ));
+
+                table.find("tbody").append(row);
             });
 
-            $("#selResourceId").selectpicker();
+            $("#divRecordings").html(table);
+        },
+        error: function (xhr) {
+            var alert = $($("#tmplSSHAlertError").html());
+
+            alert.find("span[data-copy='error']").text(xhr.responseText);
+            $("#divAlerts").html(alert);
+        },
+        dataType: "json",
+    });
+}
+
+// auditLog shows the portal audit log to auditors.  Like the cluster search,
+// results are returned one page at a time and auditPages holds the token of
+// each page seen so far.
+var auditSearch = {};
+var auditPages = [];
+var auditPage = 0;
+
+function auditLog() {
+    var div = $($("#tmplAuditLog").html());
+
+    div.find("input[data-search='resourceId']").val($("#selResourceId").val());
+
+    var search = function () {
+        auditSearch = {};
+
+        div.find("input[data-search]").each(function () {
+            var value = $(this).val().trim();
+            if (value) {
+                if ($(this).attr("type") === "datetime-local") {
+                    value = new Date(value).toISOString();
+                }
+                auditSearch[$(this).data("search")] = value;
+            }
+        });
+    };
+
+    div.find("button[data-action='search']").click(function () {
+        search();
+        auditPages = [""];
+        showAuditPage(div, 0);
+    });
+
+    div.find("button[data-action='previousPage']").click(function () {
+        showAuditPage(div, auditPage - 1);
+    });
+
+    div.find("button[data-action='nextPage']").click(function () {
+        showAuditPage(div, auditPage + 1);
+    });
+
+    div.find("button[data-action='recordings']").click(function () {
+        sshRecordings(div.find("input[data-search='resourceId']").val().trim());
+    });
+
+    $.each({"exportCSV": "csv", "exportJSON": "json"}, function (action, format) {
+        div.find("button[data-action='" + action + "']").click(function () {
+            search();
+            window.location = "/api/audit/export?" + $.param($.extend({"format": format}, auditSearch));
+        });
+    });
+
+    $("#divAuditLog").html(div);
+
+    search();
+    auditPages = [""];
+    showAuditPage(div, 0);
+}
+
+function showAuditPage(div, page) {
+    var data = $.extend({}, auditSearch);
+    if (auditPages[page]) {
+        data["page"] = auditPages[page];
+    }
+
+    $.ajax({
+        url: "/api/audit",
+        data: data,
+        success: function (result) {
+            var tbody = div.find("tbody");
+
+            tbody.empty();
+
+            appendRows(div.find("table"), result["records"], function (record) {
+                return [
+                    new Date(record["time"]).toLocaleString(),
+                    record["username"],
+                    record["operationName"],
+                    record["resultDescription"] || record["resultType"],
+                    record["ipAddress"],
+                ];
+            }, function (record) {
+                return record["resultType"] !== "Success";
+            });
+
+            auditPage = page;
+            auditPages.length = page + 1;
+            if (result["nextPage"]) {
+                auditPages.push(result["nextPage"]);
+            }
+
+            div.find("button[data-action='previousPage']").prop("disabled", page === 0);
+            div.find("button[data-action='nextPage']").prop("disabled", !result["nextPage"]);
+        },
+        error: function (xhr) {
+            alertMessage("#tmplSSHAlertError", xhr.responseText);
+        },
+        dataType: "json",
+    });
+}
+
+// dashboards shows the built-in dashboards of the selected cluster.  The
+// portal runs the dashboard queries against the cluster's Prometheus and
+// returns each panel rendered as HTML.
+function dashboards() {
+    $.ajax({
+        url: "/api/dashboards",
+        success: function (dashboards) {
+            var div = $($("#tmplDashboards").html());
+            var selDashboard = div.find("select[data-select='dashboard']");
+            var selRange = div.find("select[data-select='range']");
+
+            $.each(dashboards, function (i, dashboard) {
+                selDashboard.append($("<option>").val(dashboard["name"]).text(dashboard["title"]));
+            });
+
+            var refresh = function () {
+                dashboard(div.find("div[data-panels]"), selDashboard.val(), selRange.val());
+            };
+
+            selDashboard.change(refresh);
+            selRange.change(refresh);
+            div.find("button[data-action='refresh']").click(refresh);
+
+            $("#divDashboards").html(div);
+            refresh();
+        },
+        error: function (xhr) {
+            alertMessage("#tmplSSHAlertError", xhr.responseText);
+        },
+        dataType: "json",
+    });
+}
+
+function dashboard(div, name, range) {
+    div.text("Loading...");
+
+    $.ajax({
+        url: $("#selResourceId").val() + "/dashboards/" + name,
+        data: {
+            "range": range,
+        },
+        success: function (dashboard) {
+            div.empty();
+
+            $.each(dashboard["panels"], function (i, panel) {
+                var col = $($("#tmplDashboardPanel").html());
+
+                col.find("h6").text(panel["title"]).attr("title", panel["query"]);
+
+                if (panel["error"]) {
+                    col.find(".alert").text(panel["error"]).removeClass("d-none");
+                } else {
+                    // the chart HTML is rendered and escaped by the portal
+                    col.find("div[data-chart]").html(panel["html"]);
+                }
+
+                div.append(col);
+            });
+        },
+        error: function (xhr) {
+            div.empty();
+            alertMessage("#tmplSSHAlertError", xhr.responseText);
         },
         dataType: "json",
     });
+}
+
+// terminal runs oc commands against the selected cluster over a WebSocket.
+// The portal echoes each command and streams its output back.
+var terminalSocket = null;
+
+function terminal() {
+    if (terminalSocket) {
+        terminalSocket.close();
+    }
+
+    var pre = $("#preTerminal");
+    pre.text("");
+    $("#divTerminal").removeClass("d-none");
+
+    var decoder = new TextDecoder();
+    var socket = new WebSocket("wss://" + location.host + $("#selResourceId").val() + "/kubeconfig/terminal");
+    socket.binaryType = "arraybuffer";
+
+    socket.onmessage = function (event) {
+        pre.text(pre.text() + decoder.decode(event.data, {stream: true}).replace(/\r/g, ""));
+        pre.scrollTop(pre.prop("scrollHeight"));
+    };
+
+    socket.onclose = function () {
+        if (terminalSocket === socket) {
+            terminalSocket = null;
+            pre.text(pre.text() + "\n[disconnected]\n");
+        }
+    };
+
+    terminalSocket = socket;
+    $("#inpTerminal").focus();
+}
+
+function terminalSend(type, data) {
+    if (terminalSocket && terminalSocket.readyState === WebSocket.OPEN) {
+        terminalSocket.send(JSON.stringify({
+            "type": type,
+            "data": data,
+        }));
+    }
+}
+
+$(document).ready(function () {
+    $("#selResourceId").selectpicker();
+    searchClusters();
+
+    $("#btnSearch").click(function () {
+        searchClusters();
+    });
+
+    $("#btnPreviousPage").click(function () {
+        showClusterPage(clusterPage - 1);
+    });
+
+    $("#btnNextPage").click(function () {
+        showClusterPage(clusterPage + 1);
+    });
 
     $("#btnLogout").click(function () {
         $.redirect("/api/logout");
@@ -42,6 +645,69 @@ $(document).ready(function () {
         window.location = $("#selResourceId").val() + "/prometheus";
     });
 
+    $("#btnDashboards").click(dashboards);
+
+    $("#btnRequestAccess").click(function () {
+        $.ajax({
+            method: "POST",
+            url: $("#selResourceId").val() + "/access/new",
+            headers: {
+                "X-CSRF-Token": $("input[name='gorilla.csrf.Token']").val(),
+            },
+            contentType: "application/json",
+            data: JSON.stringify({
+                "justification": $("#inpJustification").val(),
+                "ticketId": $("#inpTicketId").val().trim(),
+            }),
+            success: function () {
+                alertMessage("#tmplAlert", "Elevated access requested.  It must be approved by somebody else before it can be used.");
+                accessRequests();
+            },
+            error: function (xhr) {
+                alertMessage("#tmplSSHAlertError", xhr.responseText);
+            },
+            dataType: "json",
+        });
+    });
+
+    $("#btnAccessRequests").click(accessRequests);
+
+    $("#btnSessions").click(sessions);
+
+    $("#btnAuditLog").click(auditLog);
+
+    $("#btnDetails").click(details);
+
+    $("#btnTerminal").click(terminal);
+
+    $("#inpTerminal").keydown(function (event) {
+        if (event.key === "Enter") {
+            terminalSend("command", $(this).val());
+            $(this).val("");
+        } else if (event.key === "c" && event.ctrlKey) {
+            terminalSend("interrupt");
+            event.preventDefault();
+        }
+    });
+
+    $("#btnTerminalInterrupt").click(function () {
+        terminalSend("interrupt");
+        $("#inpTerminal").focus();
+    });
+
+    $("#btnTerminalClose").click(function () {
+        var socket = terminalSocket;
+        terminalSocket = null;
+        if (socket) {
+            socket.close();
+        }
+        $("#divTerminal").addClass("d-none");
+    });
+
+    $("#btnSSHRecordings").click(function () {
+        sshRecordings($("#selResourceId").val());
+    });
+
     $("#btnSSH").click(function () {
         $.ajax({
             method: "POST",
@@ -52,6 +718,7 @@ $(document).ready(function () {
             contentType: "application/json",
             data: JSON.stringify({
                 "master": parseInt($("#selMaster").val()),
+                "node": $("#inpNode").val().trim() || undefined,
             }),
             success: function (reply) {
                 if (reply["error"]) {
@@ -0,0 +1,327 @@
+package auditlog
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/portal/middleware"
+)
+
+// This file lets auditors search and export the audit records kept by Store.
+// The records are read across all partitions of the Portal collection, so the
+// database does not order them: each page of results is ordered by time, but
+// pages are not ordered relative to each other.  Exports are fully ordered.
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 500
+
+	// maxExportRecords is the largest number of records which may be
+	// exported at once
+	maxExportRecords = 10000
+
+	// defaultRange is the time range searched if no start time is given
+	defaultRange = 24 * time.Hour
+)
+
+type auditLog struct {
+	log *logrus.Entry
+	now func() time.Time
+
+	auditorGroupIDs []string
+
+	dbPortal database.Portal
+}
+
+func New(log *logrus.Entry,
+	auditorGroupIDs []string,
+	dbPortal database.Portal,
+	aadAuthenticatedRouter *mux.Router) *auditLog {
+	a := &auditLog{
+		log: log,
+		now: time.Now,
+
+		auditorGroupIDs: auditorGroupIDs,
+
+		dbPortal: dbPortal,
+	}
+
+	aadAuthenticatedRouter.NewRoute().Methods(http.MethodGet).Path("/api/audit").HandlerFunc(a.list)
+	aadAuthenticatedRouter.NewRoute().Methods(http.MethodGet).Path("/api/audit/export").HandlerFunc(a.export)
+
+	return a
+}
+
+type record struct {
+	Time               string `json:"time"`
+	Username           string `json:"username"`
+	ResourceID         string `json:"resourceId,omitempty"`
+	Category           string `json:"category"`
+	OperationName      string `json:"operationName"`
+	TargetResourceType string `json:"targetResourceType,omitempty"`
+	TargetResourceName string `json:"targetResourceName,omitempty"`
+	ResultType         string `json:"resultType"`
+	ResultDescription  string `json:"resultDescription,omitempty"`
+	IPAddress          string `json:"ipAddress,omitempty"`
+}
+
+func newRecord(doc *api.PortalDocument) *record {
+	return &record{
+		Time:               doc.Portal.AuditRecord.Time,
+		Username:           doc.Portal.Username,
+		ResourceID:         doc.Portal.ID,
+		Category:           doc.Portal.AuditRecord.Category,
+		OperationName:      doc.Portal.AuditRecord.OperationName,
+		TargetResourceType: doc.Portal.AuditRecord.TargetResourceType,
+		TargetResourceName: doc.Portal.AuditRecord.TargetResourceName,
+		ResultType:         doc.Portal.AuditRecord.ResultType,
+		ResultDescription:  doc.Portal.AuditRecord.ResultDescription,
+		IPAddress:          doc.Portal.AuditRecord.IPAddress,
+	}
+}
+
+// csvHeader lists the columns of an export in CSV format
+var csvHeader = []string{"time", "username", "resourceId", "category", "operationName", "targetResourceType", "targetResourceName", "resultType", "resultDescription", "ipAddress"}
+
+func (r *record) csv() []string {
+	return []string{r.Time, r.Username, r.ResourceID, r.Category, r.OperationName, r.TargetResourceType, r.TargetResourceName, r.ResultType, r.ResultDescription, r.IPAddress}
+}
+
+type searchResult struct {
+	Records  []*record `json:"records"`
+	NextPage string    `json:"nextPage,omitempty"`
+}
+
+// parseFilter parses the username, resourceId, operation, start and end query
+// parameters of a search.  start and end are RFC3339 times; end defaults to
+// now and start to defaultRange before end.  The errors returned are suitable
+// for returning to the user.
+func (a *auditLog) parseFilter(query url.Values) (*database.PortalAuditRecordsFilter, error) {
+	f := &database.PortalAuditRecordsFilter{
+		Username:      strings.TrimSpace(query.Get("username")),
+		ResourceID:    strings.ToLower(strings.TrimSpace(query.Get("resourceId"))),
+		OperationName: strings.TrimSpace(query.Get("operation")),
+	}
+
+	end := a.now()
+	if s := query.Get("end"); s != "" {
+		var err error
+		end, err = time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end %q", s)
+		}
+	}
+
+	start := end.Add(-defaultRange)
+	if s := query.Get("start"); s != "" {
+		var err error
+		start, err = time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start %q", s)
+		}
+	}
+
+	if !start.Before(end) {
+		return nil, fmt.Errorf("start must be before end")
+	}
+
+	f.Start = start.UTC().Format(api.AuditRecordTimeFormat)
+	f.End = end.UTC().Format(api.AuditRecordTimeFormat)
+
+	return f, nil
+}
+
+func (a *auditLog) eligible(r *http.Request) bool {
+	return len(middleware.GroupsIntersect(a.auditorGroupIDs, r.Context().Value(middleware.ContextKeyGroups).([]string))) > 0
+}
+
+// list returns a page of the audit records matching the search given in the
+// query parameters (see parseFilter).  The records within the page are ordered
+// most recent first, but the database does not order pages relative to each
+// other; the export is fully ordered.  The nextPage token in the result is
+// passed back as the page parameter, together with the same search, to fetch
+// the next page.
+func (a *auditLog) list(w http.ResponseWriter, r *http.Request) {
+	if !a.eligible(r) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	query := r.URL.Query()
+
+	f, err := a.parseFilter(query)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	pageSize := defaultPageSize
+	if s := query.Get("pageSize"); s != "" {
+		pageSize, err = strconv.Atoi(s)
+		if err != nil || pageSize < 1 || pageSize > maxPageSize {
+			http.Error(w, fmt.Sprintf("invalid pageSize %q: must be between 1 and %d", s, maxPageSize), http.StatusBadRequest)
+			return
+		}
+	}
+
+	var continuation string
+	if page := query.Get("page"); page != "" {
+		b, err := base64.RawURLEncoding.DecodeString(page)
+		if err != nil {
+			http.Error(w, "invalid page", http.StatusBadRequest)
+			return
+		}
+		continuation = string(b)
+	}
+
+	i, err := a.dbPortal.ListAuditRecords(f, continuation)
+	if err != nil {
+		a.internalServerError(w, err)
+		return
+	}
+
+	docs, err := i.Next(r.Context(), pageSize)
+	if err != nil {
+		a.internalServerError(w, err)
+		return
+	}
+
+	result := &searchResult{
+		Records: []*record{},
+	}
+
+	if continuation := i.Continuation(); continuation != "" {
+		result.NextPage = base64.RawURLEncoding.EncodeToString([]byte(continuation))
+	}
+
+	if docs != nil {
+		for _, doc := range docs.PortalDocuments {
+			result.Records = append(result.Records, newRecord(doc))
+		}
+	}
+
+	sortRecords(result.Records)
+
+	a.sendResponse(w, result)
+}
+
+// export returns all the audit records matching the search given in the query
+// parameters (see parseFilter), most recent first, as a file in the format
+// given by the format parameter: csv (the default) or json.  At most
+// maxExportRecords may be exported at once.
+func (a *auditLog) export(w http.ResponseWriter, r *http.Request) {
+	if !a.eligible(r) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	query := r.URL.Query()
+
+	format := query.Get("format")
+	switch format {
+	case "":
+		format = "csv"
+	case "csv", "json":
+	default:
+		http.Error(w, fmt.Sprintf("invalid format %q", format), http.StatusBadRequest)
+		return
+	}
+
+	f, err := a.parseFilter(query)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	records := []*record{}
+
+	var continuation string
+	for {
+		i, err := a.dbPortal.ListAuditRecords(f, continuation)
+		if err != nil {
+			a.internalServerError(w, err)
+			return
+		}
+
+		docs, err := i.Next(r.Context(), maxPageSize)
+		if err != nil {
+			a.internalServerError(w, err)
+			return
+		}
+
+		if docs != nil {
+			for _, doc := range docs.PortalDocuments {
+				records = append(records, newRecord(doc))
+			}
+		}
+
+		if len(records) > maxExportRecords {
+			http.Error(w, fmt.Sprintf("more than %d records match: narrow the search", maxExportRecords), http.StatusBadRequest)
+			return
+		}
+
+		continuation = i.Continuation()
+		if continuation == "" {
+			break
+		}
+	}
+
+	sortRecords(records)
+
+	filename := fmt.Sprintf("audit-%s.%s", a.now().UTC().Format("20060102T150405Z"), format)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+
+	if format == "json" {
+		a.sendResponse(w, records)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv")
+
+	cw := csv.NewWriter(w)
+	_ = cw.Write(csvHeader)
+	for _, record := range records {
+		_ = cw.Write(record.csv())
+	}
+	cw.Flush()
+}
+
+// sortRecords orders records most recent first.  It orders a single page of
+// a search, or a whole export.
+func sortRecords(records []*record) {
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Time > records[j].Time
+	})
+}
+
+func (a *auditLog) sendResponse(w http.ResponseWriter, resp interface{}) {
+	b, err := json.MarshalIndent(resp, "", "    ")
+	if err != nil {
+		a.internalServerError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(b)
+}
+
+func (a *auditLog) internalServerError(w http.ResponseWriter, err error) {
+	a.log.Warn(err)
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
@@ -0,0 +1,268 @@
+package auditlog
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/portal/middleware"
+	"github.com/Azure/ARO-RP/pkg/portal/util/responsewriter"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+func TestAuditLog(t *testing.T) {
+	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster"
+	auditorGroupIDs := []string{"20000000-0000-0000-0000-000000000000"}
+	now := time.Date(2021, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	newDoc := func(id, username, resourceID, operationName, time string) *api.PortalDocument {
+		return &api.PortalDocument{
+			ID: id,
+			Portal: &api.Portal{
+				Username: username,
+				ID:       resourceID,
+				AuditRecord: &api.AuditRecord{
+					Time:               time,
+					Category:           "ResourceManagement",
+					OperationName:      operationName,
+					TargetResourceName: strings.SplitN(operationName, " ", 2)[1],
+					ResultType:         "Success",
+					ResultDescription:  "Status code: 200",
+					IPAddress:          "127.0.0.1:1234",
+				},
+			},
+		}
+	}
+
+	aliceKubeconfig := newDoc("00000000-0000-0000-0000-000000000001", "alice", resourceID, "POST "+resourceID+"/kubeconfig/new", "2021-01-02T10:00:00Z")
+	aliceClusters := newDoc("00000000-0000-0000-0000-000000000002", "Alice", "", "GET /api/clusters", "2021-01-02T11:00:00Z")
+	bobSSH := newDoc("00000000-0000-0000-0000-000000000003", "bob", resourceID, "POST "+resourceID+"/ssh/new", "2021-01-02T09:00:00Z")
+	bobOld := newDoc("00000000-0000-0000-0000-000000000004", "bob", resourceID, "POST "+resourceID+"/ssh/new", "2020-12-31T09:00:00Z")
+
+	type test struct {
+		name            string
+		path            string
+		groups          []string
+		dbError         error
+		wantStatusCode  int
+		wantContentType string
+		wantDisposition string
+		wantResult      interface{}
+		wantBody        string
+	}
+
+	for _, tt := range []*test{
+		{
+			name:           "list - last day",
+			path:           "/api/audit",
+			wantStatusCode: http.StatusOK,
+			wantResult: &searchResult{
+				Records: []*record{newRecord(aliceClusters), newRecord(aliceKubeconfig), newRecord(bobSSH)},
+			},
+		},
+		{
+			name:           "list - time range",
+			path:           "/api/audit?start=2020-12-31T00:00:00Z&end=2021-01-02T10:00:00Z",
+			wantStatusCode: http.StatusOK,
+			wantResult: &searchResult{
+				Records: []*record{newRecord(bobSSH), newRecord(bobOld)},
+			},
+		},
+		{
+			name:           "list - user, case-insensitive",
+			path:           "/api/audit?username=ALICE",
+			wantStatusCode: http.StatusOK,
+			wantResult: &searchResult{
+				Records: []*record{newRecord(aliceClusters), newRecord(aliceKubeconfig)},
+			},
+		},
+		{
+			name:           "list - cluster and operation",
+			path:           "/api/audit?resourceId=" + resourceID + "&operation=SSH",
+			wantStatusCode: http.StatusOK,
+			wantResult: &searchResult{
+				Records: []*record{newRecord(bobSSH)},
+			},
+		},
+		{
+			name:           "list - first page",
+			path:           "/api/audit?pageSize=2",
+			wantStatusCode: http.StatusOK,
+			wantResult: &searchResult{
+				Records:  []*record{newRecord(aliceClusters), newRecord(aliceKubeconfig)},
+				NextPage: "Mg",
+			},
+		},
+		{
+			name:           "list - next page",
+			path:           "/api/audit?pageSize=2&page=Mg",
+			wantStatusCode: http.StatusOK,
+			wantResult: &searchResult{
+				Records: []*record{newRecord(bobSSH)},
+			},
+		},
+		{
+			name:           "list - no records",
+			path:           "/api/audit?username=carol",
+			wantStatusCode: http.StatusOK,
+			wantResult: &searchResult{
+				Records: []*record{},
+			},
+		},
+		{
+			name:           "list - invalid start",
+			path:           "/api/audit?start=yesterday",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "invalid start \"yesterday\"\n",
+		},
+		{
+			name:           "list - start after end",
+			path:           "/api/audit?start=2021-01-02T00:00:00Z&end=2021-01-01T00:00:00Z",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "start must be before end\n",
+		},
+		{
+			name:           "list - invalid page size",
+			path:           "/api/audit?pageSize=1000",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "invalid pageSize \"1000\": must be between 1 and 500\n",
+		},
+		{
+			name:           "list - invalid page",
+			path:           "/api/audit?page=!",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "invalid page\n",
+		},
+		{
+			name:           "list - not eligible",
+			path:           "/api/audit",
+			groups:         []string{"10000000-0000-0000-0000-000000000000"},
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       "Forbidden\n",
+		},
+		{
+			name:           "list - database error",
+			path:           "/api/audit",
+			dbError:        fmt.Errorf("sad"),
+			wantStatusCode: http.StatusInternalServerError,
+			wantBody:       "Internal Server Error\n",
+		},
+		{
+			name:            "export - csv",
+			path:            "/api/audit/export?username=alice",
+			wantStatusCode:  http.StatusOK,
+			wantContentType: "text/csv",
+			wantDisposition: `attachment; filename="audit-20210102T120000Z.csv"`,
+			wantBody: "time,username,resourceId,category,operationName,targetResourceType,targetResourceName,resultType,resultDescription,ipAddress\n" +
+				"2021-01-02T11:00:00Z,Alice,,ResourceManagement,GET /api/clusters,,/api/clusters,Success,Status code: 200,127.0.0.1:1234\n" +
+				"2021-01-02T10:00:00Z,alice," + resourceID + ",ResourceManagement,POST " + resourceID + "/kubeconfig/new,," + resourceID + "/kubeconfig/new,Success,Status code: 200,127.0.0.1:1234\n",
+		},
+		{
+			name:            "export - json",
+			path:            "/api/audit/export?format=json&start=2020-12-01T00:00:00Z",
+			wantStatusCode:  http.StatusOK,
+			wantContentType: "application/json",
+			wantDisposition: `attachment; filename="audit-20210102T120000Z.json"`,
+			wantResult:      []*record{newRecord(aliceClusters), newRecord(aliceKubeconfig), newRecord(bobSSH), newRecord(bobOld)},
+		},
+		{
+			name:           "export - invalid format",
+			path:           "/api/audit/export?format=xml",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "invalid format \"xml\"\n",
+		},
+		{
+			name:           "export - not eligible",
+			path:           "/api/audit/export",
+			groups:         []string{},
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       "Forbidden\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			dbPortal, portalClient := testdatabase.NewFakePortal()
+
+			fixture := testdatabase.NewFixture().
+				WithPortal(dbPortal)
+			fixture.AddPortalDocuments(aliceKubeconfig, aliceClusters, bobSSH, bobOld)
+
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			portalClient.SetError(tt.dbError)
+
+			groups := auditorGroupIDs
+			if tt.groups != nil {
+				groups = tt.groups
+			}
+
+			ctx = context.WithValue(ctx, middleware.ContextKeyUsername, "auditor")
+			ctx = context.WithValue(ctx, middleware.ContextKeyGroups, groups)
+			r, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://localhost:8444"+tt.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			aadAuthenticatedRouter := &mux.Router{}
+
+			_, log := testlog.New()
+
+			a := New(log, auditorGroupIDs, dbPortal, aadAuthenticatedRouter)
+			a.now = func() time.Time { return now }
+
+			w := responsewriter.New(r)
+
+			aadAuthenticatedRouter.ServeHTTP(w, r)
+
+			resp := w.Response()
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Error(resp.StatusCode)
+			}
+
+			if tt.wantContentType != "" && resp.Header.Get("Content-Type") != tt.wantContentType {
+				t.Error(resp.Header.Get("Content-Type"))
+			}
+
+			if resp.Header.Get("Content-Disposition") != tt.wantDisposition {
+				t.Error(resp.Header.Get("Content-Disposition"))
+			}
+
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantResult != nil {
+				got := reflect.New(reflect.TypeOf(tt.wantResult))
+				err = json.Unmarshal(b, got.Interface())
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !reflect.DeepEqual(got.Elem().Interface(), tt.wantResult) {
+					t.Error(string(b))
+				}
+			} else if string(b) != tt.wantBody {
+				t.Errorf("%q", string(b))
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package auditlog
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/util/log/audit"
+	"github.com/Azure/ARO-RP/pkg/util/recover"
+)
+
+const (
+	// retention is how long audit records are kept in the local store
+	retention = 90 * 24 * time.Hour
+
+	// queueLength is the number of audit records which may be waiting to be
+	// stored before further records are dropped
+	queueLength = 1000
+)
+
+var rxClusterResourceID = regexp.MustCompile(`(?i)/subscriptions/[^/]+/resourcegroups/[^/]+/providers/microsoft\.redhatopenshift/openshiftclusters/[^/]+`)
+
+// rxAccessOperation matches the operation names of the requests which give or
+// change access to clusters
+var rxAccessOperation = regexp.MustCompile(`(?i)^[A-Z]+ (/subscriptions/[^/]+/resourcegroups/[^/]+/providers/microsoft\.redhatopenshift/openshiftclusters/[^/]+/(kubeconfig/new|kubeconfig/terminal|ssh/new|prometheus|access/new)|/api/access/[^/]+/(approve|deny)|/api/sessions(/[^/]+)?/revoke)$`)
+
+// Store is a logrus hook which keeps a copy of the access records written to
+// the portal audit log in the database, where they can be reviewed by
+// auditors: records of kubeconfig, SSH, terminal and Prometheus access, and of
+// changes to access and sessions.  Other records, such as those of static
+// assets and proxied requests, are only sent to Geneva.  It must be added to
+// the audit logger after the audit payload hook.  Records are written to the
+// database asynchronously by Run, so that logging is not held up by the
+// database; if the database falls behind, records are dropped from the local
+// store (but are still sent to Geneva).
+type Store struct {
+	log *logrus.Entry
+	now func() time.Time
+
+	dbPortal database.Portal
+
+	newID   func() string
+	records chan *api.PortalDocument
+}
+
+// NewStore returns a new Store
+func NewStore(log *logrus.Entry, dbPortal database.Portal) *Store {
+	return &Store{
+		log: log,
+		now: time.Now,
+
+		dbPortal: dbPortal,
+
+		newID:   func() string { return uuid.Must(uuid.NewV4()).String() },
+		records: make(chan *api.PortalDocument, queueLength),
+	}
+}
+
+func (*Store) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (s *Store) Fire(entry *logrus.Entry) error {
+	b, ok := entry.Data[audit.MetadataPayload].(string)
+	if !ok {
+		return nil
+	}
+
+	var payload *audit.Payload
+	err := json.Unmarshal([]byte(b), &payload)
+	if err != nil {
+		return err
+	}
+
+	if !isAccessRecord(payload) {
+		return nil
+	}
+
+	select {
+	case s.records <- s.newDocument(payload):
+	default:
+		s.log.Warn("audit store queue full, dropping record")
+	}
+
+	return nil
+}
+
+// Run writes queued audit records to the database until stop is closed.  It
+// then writes the records still queued and closes done.
+func (s *Store) Run(ctx context.Context, stop <-chan struct{}, done chan<- struct{}) {
+	defer recover.Panic(s.log)
+	defer close(done)
+
+	for {
+		select {
+		case doc := <-s.records:
+			s.create(ctx, doc)
+
+		case <-stop:
+			for {
+				select {
+				case doc := <-s.records:
+					s.create(ctx, doc)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *Store) create(ctx context.Context, doc *api.PortalDocument) {
+	_, err := s.dbPortal.Create(ctx, doc)
+	if err != nil {
+		s.log.Warn(err)
+	}
+}
+
+// isAccessRecord returns whether the audit record is one which the Store keeps
+func isAccessRecord(payload *audit.Payload) bool {
+	return payload.Category == audit.CategoryAuthorization ||
+		payload.OperationName == "TerminalCommand" ||
+		rxAccessOperation.MatchString(payload.OperationName)
+}
+
+func (s *Store) newDocument(payload *audit.Payload) *api.PortalDocument {
+	t, err := time.Parse(time.RFC3339, payload.EnvTime)
+	if err != nil {
+		t = s.now()
+	}
+
+	doc := &api.PortalDocument{
+		ID:  s.newID(),
+		TTL: int(retention / time.Second),
+		Portal: &api.Portal{
+			AuditRecord: &api.AuditRecord{
+				Time:              t.UTC().Format(api.AuditRecordTimeFormat),
+				Category:          payload.Category,
+				OperationName:     payload.OperationName,
+				ResultType:        payload.Result.ResultType,
+				ResultDescription: payload.Result.ResultDescription,
+			},
+		},
+	}
+
+	for _, id := range payload.CallerIdentities {
+		if id.CallerIdentityType == audit.CallerIdentityTypeUsername {
+			doc.Portal.Username = id.CallerIdentityValue
+			doc.Portal.AuditRecord.IPAddress = id.CallerIPAddress
+			break
+		}
+	}
+
+	if len(payload.TargetResources) > 0 {
+		doc.Portal.AuditRecord.TargetResourceType = payload.TargetResources[0].TargetResourceType
+		doc.Portal.AuditRecord.TargetResourceName = payload.TargetResources[0].TargetResourceName
+		doc.Portal.ID = strings.ToLower(rxClusterResourceID.FindString(payload.TargetResources[0].TargetResourceName))
+	}
+
+	return doc
+}
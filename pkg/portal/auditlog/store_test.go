@@ -0,0 +1,175 @@
+package auditlog
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/log/audit"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+
+	_, log := testlog.New()
+	_, auditLog := testlog.NewAudit()
+
+	dbPortal, _ := testdatabase.NewFakePortal()
+
+	s := NewStore(log, dbPortal)
+	s.newID = func() string { return "00000000-0000-0000-0000-000000000001" }
+	auditLog.Logger.AddHook(s)
+
+	// a record which is not an audit record is not stored
+	log.Logger.AddHook(s)
+	log.Info("not an audit record")
+
+	// records other than of access are not stored
+	auditLog.WithFields(logrus.Fields{
+		audit.PayloadKeyCategory:      audit.CategoryResourceManagement,
+		audit.PayloadKeyOperationName: "GET /api/clusters",
+	}).Info(audit.DefaultLogMessage)
+	auditLog.WithFields(logrus.Fields{
+		audit.PayloadKeyCategory:      audit.CategoryResourceManagement,
+		audit.PayloadKeyOperationName: "GET /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG/providers/Microsoft.RedHatOpenShift/openShiftClusters/Cluster/kubeconfig/proxy/api",
+	}).Info(audit.DefaultLogMessage)
+
+	auditLog.WithFields(logrus.Fields{
+		audit.MetadataCreatedTime:     "2021-01-02T10:00:00Z",
+		audit.PayloadKeyCategory:      audit.CategoryResourceManagement,
+		audit.PayloadKeyOperationName: "POST /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG/providers/Microsoft.RedHatOpenShift/openShiftClusters/Cluster/kubeconfig/new",
+		audit.PayloadKeyCallerIdentities: []audit.CallerIdentity{
+			{
+				CallerIdentityType:  audit.CallerIdentityTypeUsername,
+				CallerIdentityValue: "alice",
+				CallerIPAddress:     "127.0.0.1:1234",
+			},
+		},
+		audit.PayloadKeyTargetResources: []audit.TargetResource{
+			{
+				TargetResourceName: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG/providers/Microsoft.RedHatOpenShift/openShiftClusters/Cluster/kubeconfig/new",
+				TargetResourceType: "kubeconfig",
+			},
+		},
+		audit.PayloadKeyResult: audit.Result{
+			ResultType:        audit.ResultTypeSuccess,
+			ResultDescription: "Status code: 200",
+		},
+	}).Info(audit.DefaultLogMessage)
+
+	if len(s.records) != 1 {
+		t.Fatal(len(s.records))
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go s.Run(ctx, stop, make(chan struct{}))
+
+	var doc *api.PortalDocument
+	var err error
+	for i := 0; i < 100; i++ {
+		doc, err = dbPortal.Get(ctx, "00000000-0000-0000-0000-000000000001")
+		if err == nil {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &api.Portal{
+		Username: "alice",
+		ID:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster",
+		AuditRecord: &api.AuditRecord{
+			Time:               "2021-01-02T10:00:00Z",
+			Category:           audit.CategoryResourceManagement,
+			OperationName:      "POST /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG/providers/Microsoft.RedHatOpenShift/openShiftClusters/Cluster/kubeconfig/new",
+			TargetResourceType: "kubeconfig",
+			TargetResourceName: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG/providers/Microsoft.RedHatOpenShift/openShiftClusters/Cluster/kubeconfig/new",
+			ResultType:         audit.ResultTypeSuccess,
+			ResultDescription:  "Status code: 200",
+			IPAddress:          "127.0.0.1:1234",
+		},
+	}
+
+	if doc.TTL != int(retention/time.Second) {
+		t.Error(doc.TTL)
+	}
+
+	if !reflect.DeepEqual(doc.Portal, want) {
+		t.Error(doc.Portal)
+	}
+}
+
+func TestStoreQueueFull(t *testing.T) {
+	_, log := testlog.New()
+	_, auditLog := testlog.NewAudit()
+
+	dbPortal, _ := testdatabase.NewFakePortal()
+
+	s := NewStore(log, dbPortal)
+	s.records = make(chan *api.PortalDocument, 1)
+	auditLog.Logger.AddHook(s)
+
+	auditLog.WithField(audit.PayloadKeyCategory, audit.CategoryAuthorization).Info(audit.DefaultLogMessage)
+	auditLog.WithField(audit.PayloadKeyCategory, audit.CategoryAuthorization).Info(audit.DefaultLogMessage)
+
+	if len(s.records) != 1 {
+		t.Error(len(s.records))
+	}
+}
+
+func TestStoreStop(t *testing.T) {
+	ctx := context.Background()
+
+	_, log := testlog.New()
+	_, auditLog := testlog.NewAudit()
+
+	dbPortal, portalClient := testdatabase.NewFakePortal()
+
+	ids := []string{"00000000-0000-0000-0000-000000000001", "00000000-0000-0000-0000-000000000002"}
+
+	s := NewStore(log, dbPortal)
+	s.newID = func() string {
+		id := ids[0]
+		ids = ids[1:]
+		return id
+	}
+	auditLog.Logger.AddHook(s)
+
+	auditLog.WithField(audit.PayloadKeyCategory, audit.CategoryAuthorization).Info(audit.DefaultLogMessage)
+	auditLog.WithField(audit.PayloadKeyCategory, audit.CategoryAuthorization).Info(audit.DefaultLogMessage)
+
+	// records still queued when the store is stopped are written before done
+	// is closed
+	stop := make(chan struct{})
+	close(stop)
+	done := make(chan struct{})
+
+	s.Run(ctx, stop, done)
+
+	select {
+	case <-done:
+	default:
+		t.Error("done was not closed")
+	}
+
+	docs, err := portalClient.ListAll(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(docs.PortalDocuments) != 2 {
+		t.Error(len(docs.PortalDocuments))
+	}
+}
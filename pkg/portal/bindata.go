@@ -84,7 +84,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _indexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\xdf\x6f\xdb\xb6\x13\x7f\xf7\x5f\x71\x5f\x3e\x7c\xb1\x01\xa5\x7e\xc5\x6d\xd3\x4e\x12\xd0\x15\x1d\x3c\x6c\xc5\x8a\x18\xc8\x3b\x25\x9e\x2d\xb6\x14\xa9\x91\xb4\x1c\xa7\xc8\xff\x3e\x90\x92\x13\xdb\xb1\x57\x27\xdb\xcb\x86\xc1\x80\x49\x4a\xf7\xb9\xdf\xbc\xd3\xe5\xff\xe3\xba\x76\x9b\x0e\xa1\x71\xad\x2c\x27\xb9\x5f\x40\x32\xb5\x2c\x08\x2a\x52\x4e\x26\x79\x83\x8c\x97\x13\x00\x80\xbc\x45\xc7\xa0\x6e\x98\xb1\xe8\x0a\xb2\x72\x0b\x7a\x49\x76\x5f\x29\xd6\x62\x41\x7a\x81\xeb\x4e\x1b\x47\xa0\xd6\xca\xa1\x72\x05\x59\x0b\xee\x9a\x82\x63\x2f\x6a\xa4\xe1\xf0\x02\x84\x12\x4e\x30\x49\x6d\xcd\x24\x16\xe9\x0b\xb0\x8d\x11\xea\x0b\x75\x9a\x2e\x84\x2b\x94\xf6\xd2\x03\x6f\x29\xd4\x17\x30\x28\x0b\x62\xdd\x46\xa2\x6d\x10\x1d\x81\xc6\xe0\xa2\x20\x52\x54\x71\xa5\xb5\xb3\xce\xb0\x8e\x4e\xa3\x97\x51\x16\xb5\x42\x45\xb5\xb5\x5b\xd5\xce\x87\x5b\x94\x58\x3b\x9a\x46\xe9\x45\x94\x4e\x77\xf8\x0c\x8c\x9c\x70\x12\xcb\x77\x57\xbf\xc1\xfc\xea\x03\x78\x13\x99\x84\xef\xbe\x7e\x85\x48\xea\x9a\x39\xa1\x15\xdc\xdd\x7d\x9f\xc7\x03\xdd\x24\x8f\x07\xd7\x4d\xf2\x4a\xf3\xcd\xa8\x0c\x17\x3d\xd4\x92\x59\x5b\x10\xc5\xfa\x8a\x19\x18\x16\x2a\xc5\xb2\x71\x50\x2d\xc7\x8d\x6d\x18\xd7\x6b\x6a\xdb\xd1\x8a\xe3\x60\x5a\x19\xa6\xf8\x0e\x49\x20\xb3\xce\x68\xb5\x3c\x43\xd1\x91\xf0\x41\x40\xcc\x45\x3f\x5a\x1b\xce\xd5\xca\x39\xad\xb6\x32\x2b\xa7\xa0\x72\x8a\x5a\xac\xb5\xe2\xcc\x6c\x08\x08\x1e\x1e\xff\xaa\x97\x7a\xe5\x48\x39\xac\x79\x3c\xe0\x46\x93\x77\x98\xee\x5a\xe0\x93\x83\x09\x85\x06\xba\x0d\x9d\x9e\x30\x73\xa1\x4d\x4b\x97\x46\xaf\xba\x43\x23\x25\xab\x50\xc2\x42\x9b\x82\x58\x94\x57\x68\xf5\xca\xd4\xf8\x33\x27\xe5\x7b\xb9\xb2\x0e\xcd\xdb\x3c\x0e\x34\x07\xb8\x3d\x0d\x24\xb5\x2d\x4d\x93\x03\xde\x83\x13\x43\x2e\x00\x67\x8e\x51\x29\x7a\xa4\x16\x99\xa9\x9b\x82\x38\xb3\x42\xb2\xa7\x9f\xb7\xc4\xe8\xa0\xcc\xfd\xc1\x47\x2e\x78\xe7\x40\xb9\xc7\x82\xe2\x41\xd2\x81\x9a\x83\xd3\x4e\x06\xe6\x19\x2e\xfa\xc8\xbc\x57\x48\x39\xac\x7f\x8f\x77\x9e\xe2\x85\xad\xfc\x47\xcc\x02\x43\xdd\x85\xb4\xec\x99\x5c\x61\x41\x12\x52\xb6\x81\x9c\x26\x79\x3c\xbc\x3a\x0b\x97\xde\xe3\xd2\x27\xe1\xb2\x7b\x5c\x76\x1a\xf7\xbc\x48\x9d\x7b\x85\x3e\x19\xdd\xa2\x6b\x70\x65\x49\xf9\xb0\x7f\xb8\x4a\x4f\xe7\xf8\xcb\xaa\xf2\xcf\x16\x62\x49\xca\x87\xfd\x5f\xe1\x38\x9f\xcf\x48\x39\x9f\xcf\x8e\xf1\xd8\xc9\x9c\x70\x9f\x03\x86\x8b\xfe\x9d\x44\xe3\x2c\x29\x77\xfc\xb4\x57\x11\x1c\xb6\x9d\x64\x0e\x03\xbd\x6b\x3b\x39\x9f\xcf\x02\xe6\x44\x45\x60\xfe\x1d\x84\x7f\xda\x19\xd1\x32\xb3\x19\x4f\x5c\xd8\x56\x58\x2b\x2a\x89\xb0\x60\x1c\xc1\x36\x7a\x4d\xc0\x68\x89\x23\xec\xf0\x82\xec\x45\xee\x5c\x6f\x40\xad\xbb\x0d\x1d\x88\x4e\x65\xb3\xed\x97\x70\xd3\x4a\x65\x0b\xd2\x38\xd7\xbd\x8d\xe3\xf5\x7a\x1d\xad\x2f\x22\x6d\x96\x71\x96\x24\x49\x6c\xfb\x25\x01\xdf\x29\x7f\xd4\x37\x05\x49\x20\x81\x6c\x0a\xd9\x94\xc0\x42\x48\x59\x90\x75\x23\x1c\x12\x18\xfa\x26\x49\x2f\xbb\x1b\x02\x0d\xfa\xce\x30\x9e\x8e\x0b\x0e\xc2\x3b\xe6\x1a\xe0\x05\xf9\x98\x40\xd2\x64\xd3\x3e\x9b\xce\x92\xdb\x2d\x63\xa5\x15\x92\xf8\x1c\x74\xfa\x0a\xd2\xd9\xb4\xf6\xdd\x10\x12\x9a\x41\xf4\x86\x66\x90\xf5\xe9\xb4\xc9\xae\x2f\x9a\x34\xbb\x4e\x6f\xdb\x0b\x98\xce\x2e\x8f\x90\xd4\x09\xa4\x51\x1a\xbd\x81\xcc\xff\x9a\x34\xad\x03\x09\x64\xd4\x3f\xa3\xd9\xf5\xeb\x3a\xf1\x28\xea\x11\xfe\x77\xdb\x26\x90\xbe\x9a\x5d\x5e\xbf\x6e\xd2\xb4\x4f\xa7\xb7\xa7\x74\xcc\xbd\xe7\x8e\xdd\xcd\xdd\x96\xb3\x1f\x8b\x8e\xa9\xa1\x8c\xfb\xc0\xf9\xba\xd6\xb6\xa1\x69\xbe\x1f\x36\x6f\x21\xaf\x35\xc7\x32\x8f\xc7\xc5\x03\xfe\xf4\x8e\xc3\xe3\x7c\xcf\x8e\x15\xc9\xff\x12\xe9\x5f\x9d\x48\x1d\xb3\x76\xad\x0d\x27\xe5\xa7\x71\xf7\xcc\x54\x1a\xf3\xc4\x7f\x86\x17\x64\xcc\x87\xfb\x2e\x2c\xb5\x45\x32\x88\x1d\x0b\xdc\xb6\x98\x01\x33\x82\xd1\xd0\xc5\x0b\xf2\x3e\xd0\x9d\xd2\x3a\x50\x36\x82\x73\x54\xe3\x07\x4c\xf9\x7f\x27\x5a\xb4\x3f\x1c\xd7\xf1\xd0\x07\x7b\xc5\x7b\x5b\xb1\xbf\x59\xc1\x3f\x18\xa3\xcd\x19\x65\x9c\x33\xb5\x44\xf3\xec\x2a\x7e\x18\x17\x1c\xc4\x1e\xb5\xec\x9f\xed\x6a\xff\x05\x5f\x5b\xb3\xf8\x49\xa0\xe4\x70\x77\x37\x06\xc0\xd6\x46\x74\x0e\xac\xa9\x87\x81\xe6\xf3\xef\x2b\x34\x1b\x7a\x11\xbd\x8c\xd2\x30\xc4\x7c\x0e\xfd\x77\x20\x2b\x8f\x63\x3a\xdd\x75\xfe\xb3\x29\x4a\xb3\xe8\xcd\xb9\xa0\x63\x83\xd7\x93\x60\x47\x06\xae\x6f\xe0\x85\xe2\x78\x73\x40\x94\xc7\xc3\x80\xe5\x27\xae\x30\xc5\xfe\x11\x00\x00\xff\xff\x0a\x59\x6b\xa8\xd6\x0e\x00\x00")
+var _indexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3c\x6b\x6f\xdb\xb8\xb2\xdf\xfd\x2b\xe6\x12\x17\x17\x2d\x50\xf9\x15\x37\xed\x76\x65\x01\x39\x49\xf7\x24\xe7\xf6\x11\xc4\x3d\xb9\x9f\x69\x69\x6c\x71\x43\x91\x5a\x92\xb2\xe3\x2e\xfa\xdf\x2f\x48\x49\x7e\xca\xb6\x6c\x27\xcd\x62\x71\x62\x23\x96\xa8\x79\x90\xc3\x79\x90\x33\xb4\xfd\xff\x8a\x64\x68\x66\x29\x42\x6c\x12\x1e\x34\x7c\xfb\x01\x9c\x8a\x71\x9f\xa0\x20\x41\xa3\xe1\xc7\x48\xa3\xa0\x01\x00\xe0\x27\x68\x28\x84\x31\x55\x1a\x4d\x9f\x64\x66\xe4\xbd\x27\xcb\x8f\x04\x4d\xb0\x4f\x26\x0c\xa7\xa9\x54\x86\x40\x28\x85\x41\x61\xfa\x64\xca\x22\x13\xf7\x23\x9c\xb0\x10\x3d\x77\xf3\x06\x98\x60\x86\x51\xee\xe9\x90\x72\xec\x77\xde\x80\x8e\x15\x13\x0f\x9e\x91\xde\x88\x99\xbe\x90\x96\xbb\xa3\xcd\x99\x78\x00\x85\xbc\x4f\xb4\x99\x71\xd4\x31\xa2\x21\x10\x2b\x1c\xf5\x09\x67\xc3\xd6\x50\x4a\xa3\x8d\xa2\xa9\xd7\x6b\xbe\x6d\x76\x9b\x09\x13\xcd\x50\x6b\x12\x1c\x8a\xae\x91\x63\x68\xbc\x4e\xb3\x73\xd6\xec\xf4\x96\xe8\xe4\x84\x0c\x33\x1c\x83\x8b\xbb\xaf\x30\xb8\xfb\x08\x76\x88\x94\xc3\xab\x3f\xff\x84\x26\x97\x21\x35\x4c\x0a\xf8\xf1\xe3\xb5\xdf\xca\xe1\x1a\x7e\x2b\x17\x5d\xc3\x1f\xca\x68\x56\x74\x26\x62\x13\x08\x39\xd5\xba\x4f\x04\x9d\x0c\xa9\x82\xfc\xc3\xe3\x6c\x1c\x1b\x18\x8e\x8b\x0b\x1d\xd3\x48\x4e\x3d\x9d\x14\xa3\xa8\x46\xf6\x86\x8a\x8a\x68\x09\xc4\xbe\x7d\x6d\x94\x14\xe3\x1a\x1d\x2d\x00\x17\x0c\x5a\x11\x9b\x14\xa3\xb5\x6f\x7f\x98\x19\x23\x45\xc9\x73\x68\x04\x0c\x8d\xf0\x34\x86\x52\x44\x54\xcd\x08\xb0\xc8\x35\x7f\x92\x63\x99\x19\x12\xe4\x9f\x7e\x2b\xc7\x0b\x1a\xeb\x44\x97\x47\x60\x95\x83\x32\x81\x0a\xd2\x99\xd7\xdb\x32\xcc\x91\x54\x89\x37\x56\x32\x4b\xd7\x07\xc9\xe9\x10\x79\xf0\x1b\x13\x11\x84\x3c\xd3\x06\x95\xfe\xe0\xb7\xf2\xd6\x55\xc8\x15\x9e\xdc\xd3\x89\xd7\x69\xaf\x51\xab\x64\xab\xe4\x14\x92\xa1\xd7\xad\x80\xad\xa0\xbb\x05\xca\xbe\x7d\x26\xd2\xcc\x80\x35\xb3\x3e\x31\xf8\x68\xc8\x0a\x1f\x2b\x08\x25\x39\x2c\xdf\xd8\x89\x77\xc2\x65\x22\x1d\x20\x55\x61\xfc\x85\x26\x48\x20\xe5\x34\xc4\x58\xf2\x08\x95\xd5\x81\x04\xa1\x10\x63\xa9\xed\xeb\x7f\x85\xf4\xcb\xdb\x17\x1c\xc0\x20\x1b\xea\x50\xb1\xd4\xda\xc9\x4d\xb4\x36\x14\xbd\xf4\x10\x6e\xae\xfe\xf2\x83\xb9\x43\x2d\x33\x15\xe2\x3f\x9d\x6a\xae\x8e\x45\x15\xcf\xc0\xe9\x2d\xbc\x12\x88\x91\x86\xe5\x11\xbe\x3e\x6c\x7c\xdb\x9a\xff\xda\x0a\x7b\x8f\x4a\x33\x29\xd6\x26\x7a\x92\xb7\x82\x36\x54\x19\x0d\x53\x66\xe2\x37\x80\xcd\x71\x13\x7a\xcd\xf3\xc3\xc4\x72\xe0\x98\x72\xe7\x5e\x7f\x20\x1a\x79\x3e\x90\x5b\x25\x27\xcc\x76\x9a\x89\xf1\xc0\x50\x83\x3b\xb8\xd8\xb7\x2f\x73\x35\x9e\x50\x9e\x61\x9f\x90\xe0\x42\xcc\x20\xd3\x74\xc8\xd1\x0e\xdb\xa0\xdf\xca\x21\x6a\x91\x09\x06\x59\x18\x22\x46\x18\x1d\x86\xf6\xef\x34\xa2\x86\x89\xf1\x61\x58\x17\x51\xc2\xc4\x71\xa8\xbf\x51\xc6\x0f\xed\xe4\xa5\xc2\x23\x38\x5d\x21\xc7\x7a\x58\x7e\x2b\x9f\xf5\xe7\xd4\xab\xa7\xb0\x95\x6f\x74\xac\xd7\x0c\xc5\xd0\xb1\x2e\x2c\x03\xc5\xa4\x9f\x2a\x19\xbd\x91\x53\x81\xaa\x9f\x20\x39\x68\x3c\xdb\x9a\xb7\x04\xf7\x64\x4b\x8c\xcf\x7b\x4a\x82\xfc\x73\x35\xc6\x2f\xff\xf9\xc6\x69\x7a\x41\x36\xbf\x71\xff\x3d\x9d\x14\x17\xb1\x9c\xa0\x82\xc4\x78\xdd\x9c\xba\x19\xf2\xcb\x22\x86\x6f\x1b\x9a\x59\x2c\x43\xab\xfe\x7c\xa3\xb6\x3f\x2c\x08\x04\x36\x84\xfa\x2d\x13\xef\x87\x5c\x8e\x55\xf5\x30\xca\x80\x90\x3b\xfd\x7a\x38\x9f\x8a\xc5\x58\x3d\xe8\xc2\x9f\xd6\x03\x76\x7e\x6a\x37\xa8\xdf\xda\x26\x33\xbf\xb5\x43\xda\xbe\x71\xab\x59\xbf\x65\x16\xab\xda\xe5\x97\xdf\x72\x73\x7c\xba\xbe\xdd\x2a\x9c\x30\x99\xe9\x5b\x3a\x46\x02\x11\x73\x0e\x34\x0a\xca\xe6\x1d\x0a\x78\x20\xa3\x2f\xf8\x68\xd6\x98\xd8\xa6\x6a\x06\x6b\xc6\x54\xdc\x36\xaa\xfc\xc6\x9e\xb5\xab\x8d\x3a\x2e\xca\x94\xaa\x73\x13\x91\xa0\x30\x83\x13\x57\xb2\x45\xa8\x8b\xa8\xa1\x1e\x67\x13\xf4\xb4\xb3\xd9\x3e\x31\x2a\xc3\x03\xfc\xd3\x5a\xe7\x1a\xf5\xdc\xeb\x33\x88\xe8\x33\xb5\xce\x81\x04\x9f\xe9\xd3\x49\xe7\x10\x29\x94\xfc\x1b\x3b\xc2\x52\x19\xef\xdb\x24\x48\x1c\xb8\xd7\xde\x1d\xa0\xd6\xf0\x3a\x73\xbc\xce\x41\x78\xdd\x39\x5e\x77\x3b\xde\xcf\x99\x29\x26\xd2\x2f\x32\x42\x12\xd8\xff\x27\xce\xd2\x69\x61\xd5\x76\x60\x2d\xa0\x0a\x19\x21\xb8\x2d\xd3\x2b\x1b\x7e\x14\x8b\x50\x43\x2e\xb9\xd7\xe4\xd9\xe5\xf2\xaf\x4c\x1b\x36\x62\xb9\xc3\x27\xc1\xca\xed\x8b\x4a\x6a\xb5\x63\xab\x22\x9b\xc6\x33\x40\x8e\x13\x6a\x30\x02\x1a\x86\xa8\x35\x30\x0d\x76\x43\x83\xd1\xf3\xcb\xec\x1b\x0b\x1f\xd0\x58\xb7\x98\x5f\xc1\xcd\xd5\x8b\x8a\x6a\xd1\x9f\x83\x46\xbe\x25\x1c\x6d\xc4\xa1\x2b\x34\x94\x71\x4d\x82\xe2\x62\x11\x80\x0e\xa7\x75\x87\x7f\x64\xa8\xcd\x85\x9b\x33\x12\x14\xb7\xeb\x93\x79\x0a\x87\x9c\x74\x41\x58\x93\x20\xbf\x07\x55\x34\x9c\x42\x7a\x80\xda\x6e\xb7\xb4\x5d\x6b\xe6\x57\xa7\x50\xbb\x55\x32\x41\x13\x63\xa6\x49\xb0\xb8\x3e\x85\xe2\x15\xd5\xf1\x50\x52\x15\xd9\xb9\x9a\x5f\x9f\x42\xf1\x7f\xb3\xa1\x55\x87\x11\x1b\x93\x60\x71\x7d\x0a\xc5\x6f\xa8\x12\x26\x28\x27\x41\x79\x75\x0a\xb5\xc1\xe0\x9a\x04\x83\xc1\xf5\x89\x34\xee\x30\x94\x2a\x62\x62\x6c\xa7\x76\x70\x0d\x6a\x7e\x7f\x0a\xe1\x8b\x2c\x62\xe6\x93\x1c\x93\xc0\x5d\x01\x97\x95\x92\x5b\xf2\x11\x2e\xe1\xe8\xd0\x23\x36\xb9\xe0\xa8\x8c\x26\xc1\x86\xdd\x5a\x84\x02\x66\x6e\x9a\xbb\x80\xd6\x2d\x62\x17\xec\x42\xc5\x77\x41\x2d\x2b\xda\x2e\xb8\x65\xb9\xee\x82\x5b\x48\xaa\x12\xaa\x90\x4e\xe4\x09\x29\x90\x94\x48\x0b\x4d\x9a\x43\xdb\xb7\x9f\xaa\xf9\x26\x6f\x38\xf6\x22\xaa\x1e\xc0\xc6\xe9\x22\x7f\x9d\x7a\x5d\x9b\x3c\x6d\x13\x70\x49\xf7\x3e\x89\xd1\xb6\x7f\x80\xde\xfb\x76\xfa\xf8\x2b\xd8\x10\x3c\xe2\x72\xfa\x01\x68\x66\xe4\xaf\x39\xb7\x54\xe1\x82\x9b\xdf\x4a\x15\xae\xf1\x5c\xea\xa5\x5b\x1f\x54\x46\x91\x1d\x90\x5e\xaa\x30\xc5\x8d\x8c\x79\xf9\xf2\x75\x4a\x45\x15\x9a\x1d\x58\x3e\xba\x44\x0a\xa9\x53\x1a\x22\x09\xfe\xdb\x6f\x59\xf8\x0a\xe6\xab\x01\xe1\xc0\xe8\xb3\xc6\x66\x1e\x7c\x4a\xc1\xac\x86\x68\x19\xc2\x18\x0d\xa4\x32\xd2\xe0\x5d\x10\x27\xcd\x50\x26\x29\x47\x83\x7d\x22\x47\x23\x02\x3a\x45\xce\xc3\x18\xc3\x87\x3e\x19\x51\xae\xf1\x00\x79\xd1\x74\x97\xb8\xea\x5a\x68\x39\xa9\x37\xc2\xa0\x52\x59\x6a\x48\x70\x69\x14\xf7\x2e\x17\x76\x5a\xd2\x3c\x89\xfe\x25\x97\x76\x74\xee\x63\x3b\xe9\x42\xf7\x77\x34\x15\xb7\x8d\x03\x74\xbd\x34\x9a\x42\xdb\x13\xfa\xe8\xd5\xd4\xf8\x3b\x4c\x39\x9d\xad\xe8\xfb\x32\x7f\xdf\x60\x92\x72\x6a\xd0\x09\xd3\x24\x29\x5f\xf7\x33\x8d\x3a\x99\x97\xb5\x29\xdc\x96\x4f\xd9\x9a\x47\xb1\x49\x85\x82\x25\x46\xdb\x13\x0b\x16\xec\xdf\x1a\xd5\x6e\x88\x62\x97\xbb\x1b\x28\x5f\x6d\xed\x86\x59\x59\xbc\xee\x06\xdd\x93\x11\xb1\x20\xd5\x4f\x37\xf3\x24\x95\xf9\x91\x6d\x79\x91\x95\x7c\x88\xdf\x2a\x67\x73\xeb\xec\x2e\x22\xc3\x82\xc4\xa6\xbe\x6e\xb1\x8c\x3c\x97\x12\x51\x31\xb6\xf9\x35\x5b\xbb\xca\x93\x00\x34\xb4\xdb\x0e\x5b\x9a\x98\xc8\x07\x2c\xc4\x6f\x97\x85\xf6\x16\x28\xe7\xa0\x0b\xb6\x20\x47\x60\x62\xa6\xcb\xa2\x5a\xb5\x19\x1d\xa0\x69\xbb\xb4\x6d\x6f\xe6\x6e\xbf\x3a\x95\x50\xdf\x66\xe9\x9e\xdc\x9e\x85\xfa\x58\xac\x7f\x6b\x40\x3e\xa6\x4c\xa1\xde\x0f\xb8\x1d\xa2\x3a\xc1\xb6\x35\xb9\xb6\x2b\xb1\xb6\x91\x54\x2b\x3c\x44\x4d\x9d\x5a\xc4\xfd\x5d\x3a\x75\x40\x39\xa9\x5e\x7a\xfc\xd8\xed\x96\x53\xd9\x32\x65\x95\x69\x54\x62\xb3\xf6\x69\x9b\xab\x3a\xb6\xea\xc8\x5f\xa0\xbf\x65\xf5\x6f\xa3\xc4\x59\x18\x14\xcc\xcb\x83\x37\x57\x7f\xc9\x01\xc8\x14\x55\x55\x32\x20\xf7\x21\xf3\x7a\x73\x51\x9b\x78\x58\xda\xb9\xd4\x19\x4c\x55\xd3\xf3\x2a\x5e\x44\x0d\x1a\x96\xa0\x67\x0f\x69\xf0\x63\x24\xe2\x6a\x95\x04\xdc\xb1\x8e\x3e\x19\x29\x99\xc0\xab\x08\x47\x34\xe3\xe6\x03\x50\x88\xe8\x0c\x86\x38\x92\x0a\xc1\xc8\xd7\xf5\xc4\xf0\x62\x63\xb1\xab\xb9\x72\x24\x46\x2e\x8d\x43\xc8\xe9\xd3\xf7\x7d\x67\x98\x5a\x5a\xc7\xad\x04\x29\x5d\xb3\xd0\x74\x3c\x07\x7c\xb4\x87\x77\x2e\x07\xf7\x24\xf8\xe8\x2e\xe1\x72\x70\xff\x7c\x8c\xfe\x35\xf8\xfa\x65\xce\xc9\xde\x3c\x07\x2b\x55\x63\x6f\x5d\x72\xd8\x31\xb9\x55\x4d\x3f\x31\xd8\x7f\x63\xfb\x4a\x74\xf5\x97\x04\x17\x4e\x9f\xf6\xc3\xdd\xa1\xce\xb8\xd9\x0f\x77\x11\x45\x0a\xb5\x7e\x99\x80\x7f\xbc\x62\xa4\xc7\xd4\xd1\x8e\x63\x25\x0e\xaa\xa4\xe9\xc4\x2e\x3c\x0b\x0e\xce\x85\x2d\x6d\xb3\x33\x63\x33\xcc\x79\x62\x43\x03\x55\x08\x52\x45\xa8\x30\x82\xe1\x0c\xac\x3b\x77\x47\x47\x98\x00\xa4\x61\x0c\x29\x1d\xe3\x1b\x18\x66\xc6\x5d\xe5\xf0\x42\x9a\x39\x8e\x42\x4e\x0d\x9b\x58\x07\x9d\x23\x48\x13\xa3\x6a\x02\x14\x46\x69\x62\x84\xdc\xed\x58\xf7\x09\x14\x46\x19\xe7\xb3\x39\x3a\x97\xe3\xa6\xdf\x72\xfd\x0d\x1a\x15\x96\x52\x63\x09\xb6\x9c\xca\x59\x90\xd8\xb4\xb5\x25\xbf\xea\x44\xc2\x04\x67\x02\xb7\x86\xc3\xfa\xb5\x2c\x48\xd4\x7c\x3b\x90\x63\xd9\xd0\x58\x74\x8a\x04\xd5\x05\xa2\x27\xe0\xa1\xec\x66\x84\xd4\xaa\x64\x75\x62\x12\x7c\xa2\xda\x40\x2c\x33\x75\x50\x0d\xec\xac\xc4\x3c\x73\xb8\xfa\x20\xe4\xf3\x12\xf9\xfc\x08\xe4\x4e\xb7\xc4\xee\x74\x8f\x40\xef\xf6\x4a\xf4\x6e\xef\x08\xf4\x77\x51\x81\xfd\xce\xae\x42\xf4\xa1\xf5\xbf\xe3\x2d\x5d\xe1\x48\xa1\x8e\xad\x85\xba\x8b\x2d\x26\xde\xda\xa9\xe0\x4a\x4e\x0b\xb2\x29\x15\xc8\x75\x99\x95\xac\x40\x3f\xc4\xc2\x6e\x2d\xb1\x25\x8d\x5b\x66\x69\xab\x43\x7c\xec\x9d\x5b\x83\x3a\x5b\xd3\x4a\x3f\x3e\x0f\xfc\x56\x7c\xbe\xbd\xc3\xd4\xa6\x8a\xc1\xfd\x2f\x37\xd9\x45\xde\x67\xbd\xeb\x73\x4c\x37\x3c\x7b\x64\xda\x9c\x38\xba\x32\x03\xbd\xc0\x5f\xa1\x66\xdf\x7e\xfc\x76\x91\x5a\x89\xdf\x1e\x10\xc5\xf3\x59\x70\xb7\x36\x60\xd8\x2d\x80\x61\xb8\xcc\xed\xa0\xa0\xb5\xda\x1a\xbf\x0d\xfe\x4f\xaa\x07\x7b\xd0\x57\xc9\x11\xe3\xa8\x4f\xe9\xdd\xd4\x91\xba\x2d\x28\x3d\xf5\xfa\x63\xff\x11\x21\x0b\x75\xff\x19\x34\xfb\x5e\x03\xf0\x8a\xe9\x07\x07\x0a\xaf\xfe\xf9\x8f\xd7\xfb\xe1\x2f\x65\x26\x6a\xac\x44\x06\xd9\x50\xa0\xf9\xb9\x0b\x91\xd5\xd6\xf8\x6d\x70\x23\xc6\x76\x39\xf4\x24\x93\xca\x72\x5a\x2f\x3c\xab\x4c\xb3\x21\xe3\xcc\xcc\xf6\xc3\xde\xdc\xbe\xb0\xf4\x0b\x3b\x07\x6b\xaa\xd4\x48\x55\x25\xfe\x5a\x9e\x2b\x9f\x07\x54\x4a\x2e\x72\x14\x5f\x4b\xa2\xf3\x42\xd1\x71\xd3\xba\x49\xae\x51\x21\xcc\x67\x9f\xd7\x3a\x07\xe7\x2c\xe0\xc5\x84\x32\x6e\x7b\xbe\x1f\xf4\x56\x49\xa7\xb1\xee\x14\xea\x3e\xe0\x2b\x1c\x2b\x1a\xd5\xc9\x3a\x7e\x46\xad\xe9\x18\x5f\x58\xb7\xec\xe1\x99\xa7\xd1\x27\x7b\xe6\xe6\x54\x25\x2a\x68\x34\x2a\xe4\xf5\xdc\x9a\x73\x27\x79\x9d\x14\xf0\x1d\xd2\xa8\x86\xcf\x18\x84\x31\x46\x59\x4d\x05\xb3\xa7\x00\x38\x1a\x28\xce\xcb\xef\x47\x70\xb5\x35\x41\x39\xbc\xb8\x6b\xba\xc3\x10\x85\x29\x3c\x93\x2d\x65\x54\xa8\x52\xed\xd9\xa7\x7a\x26\xc2\xaf\x73\x52\x4f\xad\x07\x03\x9b\xda\xab\x55\x0f\x10\xb5\x0c\x78\xde\xd3\xfd\xa0\xb6\x20\x95\xd5\xd0\xae\x8f\xd6\x94\x7e\xee\x94\x2e\x1a\x0e\x59\xa2\xba\x83\x14\x4b\x13\xb4\xcd\x5f\xa4\x8a\x25\x54\xcd\x4a\xef\xc1\x74\xc2\xb4\x66\x56\x1f\x46\x34\x42\xd0\xb1\xdd\x16\x28\x69\x6d\x9f\xae\x91\x5c\x14\xe9\x9d\x87\x08\x65\x3a\xeb\x93\x24\x77\x9a\x24\xa8\xaa\xc7\x97\xdb\x9b\x3c\x17\x9c\xdf\xcc\xf3\xa6\xa1\x2d\x14\x17\xfa\x56\xf4\xa3\xe4\x09\x54\x31\xea\xb9\x83\xba\x7d\xe2\x0a\xca\x6b\xdd\x58\x74\xc5\x41\xc6\x2c\x8a\x50\x14\x27\x6c\x83\xff\xb1\x19\x0a\xfd\x6b\x65\x87\x36\x76\x4a\x87\x49\x79\xf9\xac\x47\xa3\x96\x3d\x05\x8d\x35\x95\xda\xa2\x18\x5b\x4c\xa5\x96\x99\xd4\x30\x91\xfd\x89\x3b\x0b\x61\x63\xcf\x6e\x88\xea\xa7\x9b\x66\x50\x69\x02\xdb\xd4\x7f\x45\xf5\x6b\xcc\xc2\x60\x70\xfd\xb3\xd4\x7d\xae\x1c\x55\x6a\xbd\xbe\x6b\x2f\xb7\xeb\x60\x4d\xc3\x2b\xd4\x7d\x13\xdd\xbe\x7c\x3d\x19\xc3\x63\xc2\x85\xee\x93\xd8\x98\xf4\x43\xab\x35\x9d\x4e\x9b\xd3\xb3\xa6\x54\xe3\x56\xb7\xdd\x6e\xb7\xf4\x64\x4c\xc0\x7e\x9d\xf7\x1f\xf2\xb1\x4f\xda\xd0\x86\x6e\x0f\xba\x3d\x02\x23\xc6\xb9\x3d\x1e\xca\x0c\x12\x70\xdf\xe7\xed\x93\xce\xfb\xf4\x91\x40\x7e\xe8\xa1\xb8\xab\x66\x6c\x5f\x7e\x4a\x4d\x0c\x51\x9f\x7c\x6e\x43\x3b\xee\xf6\x26\xdd\xde\x75\xfb\x7b\x49\xd8\x2d\x24\x5a\x75\xb0\x3b\xe7\xd0\xb9\xee\x85\xf6\x2b\xbb\xd0\xf6\xba\xd0\xfc\xc5\xeb\x42\x77\xd2\xe9\xc5\xdd\xfb\xb3\xb8\xd3\xbd\xef\x7c\x4f\xce\xa0\x77\xfd\xbe\x02\x24\x6c\x43\xa7\xd9\x69\xfe\x02\x5d\xfb\x8a\x3b\x9d\xd0\x81\x40\xd7\xb3\x6d\x5e\xf7\xfe\x5d\xd8\xb6\x58\x9e\xc5\xb0\xaf\xef\x49\x1b\x3a\xe7\xd7\xef\xef\xdf\xc5\x9d\xce\xa4\xd3\xfb\xbe\xad\x8f\xbe\x95\xdc\xe6\xa3\xea\x24\x49\xa5\x4f\x0b\x65\x92\xb8\x6f\xf6\x5e\xe6\x17\x1f\xc0\x0f\x65\x84\x81\xdf\x2a\x3e\xaa\x1c\xcb\x86\xa6\x2c\xab\x64\x3a\xab\xce\x20\xfe\x47\x91\xfe\xd6\x8a\x94\x52\xad\xa7\x52\x45\x24\xb8\x2d\xae\x8e\x54\xa5\xbf\x5b\x1c\x2d\x3d\xb8\x5b\x5e\xd5\x70\xe3\xc5\x2e\xe7\x58\x2f\xbe\x3e\x2f\x6e\xc3\xfd\xb7\x5c\xb2\xd8\xdf\x43\x08\xb5\x1a\xfd\xc6\x90\x47\xf0\xe3\x47\x31\x01\xf9\xd7\xbb\x41\xab\x30\xff\xd5\x85\xdf\xff\xc8\x50\xcd\xbc\xb3\xe6\xdb\x66\xc7\xfd\xd2\xc2\xef\x6e\xa3\x98\x83\x05\xd5\x38\xa9\x4c\x53\xfb\xfd\x98\x66\xa7\xdb\xfc\xa5\x2e\x52\xd5\xaf\x43\x1c\x84\x56\xf1\xab\x10\x7b\xf0\x99\x88\xf0\x71\x8d\x89\xdf\xca\x57\x1c\x0d\xbf\x15\x9b\x84\x07\x8d\xff\x1f\x00\x3b\x2c\x21\x4d\x7b\x43\x00\x00")
 
 func indexHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -104,7 +104,7 @@ func indexHtml() (*asset, error) {
 	return a, nil
 }
 
-var _indexJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x56\x5f\x6f\xe2\xb8\x17\x7d\xef\xa7\xb0\xfc\x43\x8a\xf3\x1b\x6a\x76\x5f\x69\xa9\xb4\x5b\xcd\x6a\xba\xff\x66\x54\xfa\xb0\x52\xd5\x07\x63\x5f\xc0\x83\x63\x47\xb6\x43\x89\x66\xf8\xee\x2b\x3b\x09\x84\x24\xd0\xee\x6e\x24\x44\x12\x9f\x7b\xee\xf1\xf1\xbd\x8e\x47\x14\x76\x1e\xb4\x20\xdf\xae\x10\x42\xc8\x82\x90\x16\xb8\x9f\xa2\x65\xa1\xb9\x97\x46\x23\xa2\x0c\x67\xe1\x6e\x8c\x98\x5d\xb9\x14\x55\xc8\x70\x6d\x99\x45\x4b\x63\x33\x34\x43\x23\x82\x6f\xe3\x6d\x06\x7e\x6d\xc4\x2c\xf9\xf2\x79\xfe\x94\x20\xe7\x4b\x05\xb3\x44\x48\x97\x2b\x56\x4e\x91\x36\x1a\x6e\x92\xbb\xdb\x49\xc0\xde\xe1\xf4\xe6\xc0\x15\x5e\x50\xe6\xbd\x25\x98\xc5\xc4\x78\x8c\x9a\xcc\xe9\xcd\xd5\x01\x37\xa2\xc0\xf8\x9a\x04\x29\xe8\xfb\x77\xf4\x6d\x3f\x6e\x49\xdd\x40\x39\x46\x5b\xa6\x0a\x68\xcb\x6c\xa4\x4a\x9d\x17\xbe\xd6\x5a\xdd\x6b\x96\xc1\x2c\x59\x4b\x21\x40\x07\x55\xf1\x6d\x94\x75\x12\x1c\x5f\xd7\xe2\x42\x08\x1e\xa3\x0d\x94\x2d\xf1\x5d\x50\x94\x80\x1b\x29\x1d\xb6\x6a\xa6\x79\x1e\x5c\x8f\x41\x2d\xa2\x7d\x1b\xdc\x06\x8e\x08\x8e\xd8\xe7\x4a\xf2\xca\x58\xa9\x14\xa3\xdc\xd9\x25\x7d\x32\x1b\xd0\xc9\x0b\x4e\xe9\x52\x5a\xe7\x49\xda\xb3\x35\x72\x3c\x19\x82\x17\x46\x94\x38\xa5\xae\x58\x64\xd2\x93\x1a\xb7\xbf\x8a\x69\x47\x44\x18\x5e\x64\xa0\x7d\x4a\x2d\x30\x51\x92\xa3\xb1\x8d\x9d\x23\xca\xbe\xb2\x1d\x39\x7a\x5b\x58\x35\x45\x78\xc2\x72\x39\xe1\xaa\x70\x1e\xac\xc3\xe3\xc3\xa8\x2b\x38\x07\xe7\xda\xd5\xd4\xa0\xba\x0b\x54\xaf\x6b\x33\xdc\x5e\x55\x39\x46\xf5\xeb\x6e\x50\x0c\x24\xf8\x7f\x0e\xd4\x23\x38\x53\x58\x0e\x0f\x02\xa7\x2d\xd3\x6e\x4d\x1e\x48\xee\x70\x4a\x3d\xec\x7c\xc3\x9f\x76\x16\x6f\xdf\x5d\xa4\x21\x56\x07\x0a\xb8\xcf\x25\xdf\x80\x25\xed\x45\x3b\x4e\x58\x30\xcf\x9e\xca\x1c\xa6\x08\x7f\x75\xa1\x86\xaf\x4e\xd8\x03\xeb\xc2\xeb\xdf\xcd\xca\x14\x1e\xa7\x94\x2b\xc9\x37\x03\x36\x57\x86\x34\xcd\x48\x2a\x7f\x55\x1d\x75\x33\xc8\xf9\x5b\xb1\x00\x6e\xf4\x52\xae\xde\xcf\x3b\x34\xc9\x2d\x53\x24\x45\x1f\x10\x9e\x6c\x0e\x8c\x13\x0d\xaf\xe7\xf2\x7e\xb1\x26\xb4\x3c\x14\xee\x8d\xbc\xaf\x52\x0b\xf3\x4a\x9b\x9e\xae\xfa\xf0\x42\xfa\xfc\x48\x3c\x9c\x79\x3e\xff\xf4\xe6\x54\x3b\xd5\x1a\xae\x6a\x83\x9a\x22\x1c\x76\xa8\x56\xad\x1e\xaa\xf9\xb2\x2c\xe7\xd6\xd1\x8e\xd3\xc0\x35\x30\x01\xd6\x4d\x07\xea\x13\xff\x75\x7d\x3f\x7f\xfc\xe5\x3a\x76\x29\x8e\xf4\xef\xe8\xe4\x98\xf0\x34\xc7\xfe\xf4\x91\x1b\xed\x41\xfb\xba\xda\x58\x9e\x2b\x59\x39\x3b\x69\x55\x5e\xbb\x2e\xa7\xe8\xd7\xf9\xe7\x3f\xa9\xf3\x56\xea\x95\x5c\x96\x64\x40\x6b\xc6\x42\x77\xe0\x29\xca\x99\x75\xf0\xa0\x0f\x35\xf2\x47\x35\x50\x0b\xeb\x2a\xeb\x3c\x0f\xb4\xbd\x85\x5c\x95\x43\xed\x2b\x97\xf5\xe0\x33\x06\x6b\x8d\xc5\x2f\x43\x28\x54\x6f\xe1\x1e\xb2\x5c\x31\x0f\x75\xf5\xf8\x2c\x57\xf3\xf9\xa7\x9f\x14\x58\xff\x31\x46\xa7\x74\xed\x33\x45\x3a\xed\xdd\xa6\x60\x01\x1c\xe3\x1b\xb2\x6e\xef\x37\x57\x44\xd2\xa5\xd4\x82\x60\x97\x33\xfd\x1c\x5c\xbc\xe6\x26\x2f\x67\x49\xd4\x1a\x57\x2a\x6e\x2b\x9d\x19\x0c\x67\x0f\x8a\x85\xdc\x46\xb5\xae\x51\x1a\x93\x9c\x53\x60\xc1\x17\x56\xf7\xd9\xf6\x7d\xf8\x1b\xee\x5c\x30\xe6\xfd\xa6\x5c\x32\x84\x9b\x2c\x63\x5a\x24\x2f\xe8\x0e\x71\x23\xa0\xe3\x4c\x3d\x3c\xe8\xcd\xbb\x68\xc3\xae\x1e\xbf\xad\x87\x41\x3c\x46\xff\x91\x3c\x67\xce\xbd\x1a\xdb\x17\x8d\xff\x5f\x5f\xf8\x5f\x33\x5e\xd2\xdb\xa0\x06\x05\xff\xb3\x2a\x19\x91\x84\x06\xf2\xeb\x45\xe1\xbd\xd1\xc9\xe5\x0d\xb1\x5f\x30\x3b\xcf\x2c\xb0\xfa\x50\x74\x78\xe4\x8a\x39\x37\x4b\xe2\xe9\x6d\x8a\xaa\xf3\xd1\x4d\x82\xa4\x98\x25\x0d\x26\x1c\x97\x9a\xfb\xbb\x21\x9b\xc2\xd5\x00\x2a\x57\x47\xc4\xaf\xa5\x4b\xa9\x0e\x0f\x7d\x7b\xba\xdf\xe4\x1e\x49\xf7\x18\x73\xa6\x69\x5a\x93\x6a\x0e\x34\x74\x05\xfe\xa3\x82\x70\xfb\x73\xf9\x20\x08\x6e\x30\x38\xbd\x9c\xb2\xfa\xe4\x9f\xdb\x4e\x5a\x30\x3f\x8f\x48\x69\xf4\x23\xd3\x2b\x20\x3f\x8c\x8f\xa3\xf1\x14\x48\x15\xe8\x95\x5f\xa3\x0f\xe8\xc7\x33\x6c\x07\xb1\xb0\x03\x7e\x5f\x95\x34\x49\x82\x35\xc9\x5b\x11\xc1\x0f\x6a\x21\x33\x5b\xb8\x5f\x4b\x25\x48\x93\xbb\x3f\xbd\x7d\xf7\xe4\xd3\xff\x50\xf4\x0f\x30\xed\xc0\xf0\x1f\x7e\x7f\x07\x00\x00\xff\xff\xf0\xd6\xa4\xb2\x3a\x0c\x00\x00")
+var _indexJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x7d\x7f\x77\xdb\x36\xb2\xe8\xff\xfa\x14\x28\xea\x53\x51\x8d\x4c\x27\xfb\xc7\x7b\xe7\x59\x55\xf3\xbc\x49\x77\x9b\xdd\xb4\xcd\x89\xbd\xdd\x77\x9e\xe2\x7b\x0e\x4c\xc2\x12\x6a\x8a\xe0\x02\xa0\x1d\x6d\xab\xfb\xd9\xef\x19\xfc\x22\x48\x82\x94\xec\xe4\xde\xdb\xbb\xa2\x4f\x62\x01\x83\xc1\x60\x30\x98\x19\x0c\x06\xf4\x49\x4a\x3f\x2a\x5a\xe6\xc9\xaf\x13\x84\x10\x12\x34\x67\x82\x66\xea\x1c\xdd\xd6\x65\xa6\x18\x2f\x51\x52\xf0\x8c\xc0\x6f\x73\x44\xc4\x5a\xce\x90\x81\x84\xe7\x9e\x08\x74\xcb\xc5\x16\x2d\xd1\x49\x82\xbf\xd1\xbf\x6e\xa9\xda\xf0\x7c\x39\x7d\xf7\xd3\xe5\xd5\x14\x49\xb5\x2b\xe8\x72\x9a\x33\x59\x15\x64\x77\x8e\x4a\x5e\xd2\xc5\xf4\xdb\x6f\xce\x00\xf6\x5b\x3c\x5b\x78\x5c\x50\x90\x12\xa5\x44\x82\x89\xee\x18\xcf\x91\xeb\x79\xb6\x98\x78\xb8\x93\x94\x92\x6c\x93\x00\x29\xe8\xb7\xdf\xd0\xaf\xfb\x79\x40\xea\x1d\xdd\xcd\xd1\x3d\x29\x6a\x1a\x92\xe9\x48\x65\x65\x55\x2b\x4b\xab\xf9\xbd\x24\x5b\xba\x9c\x6e\x58\x9e\xd3\x12\xa8\xd2\xa5\xdf\xe2\xb0\x3f\x78\x74\xb1\x25\x0e\x9a\xe0\x39\xba\xa3\xbb\xd9\x62\x10\x48\x93\x80\x1d\x29\x1d\x6c\x66\xa4\x55\x05\x5c\xd7\x98\x03\x44\xfb\x10\x38\x04\x3c\x49\xb0\x86\x5d\x41\xff\xcb\xe9\x9a\x0b\x56\x14\x24\xcd\xa4\xb8\x4d\xaf\xf8\x1d\x2d\xa7\xd7\x78\x96\xde\x32\x21\x55\x32\x9b\x2d\x62\x38\xae\x78\x82\x6f\x78\xbe\xc3\xb3\x54\xd6\x37\x5b\xa6\x12\x0b\xb7\x9f\xe8\x6e\xcf\xce\x90\xa0\x30\x4d\x08\xfe\x91\xe8\x86\x64\x77\x48\x6d\x28\xe2\xb5\x02\x66\xf1\x5b\x44\x4a\x44\x64\xc6\x58\x46\xa4\x42\xf7\x7f\x40\x82\x66\x5c\xe4\xac\x5c\x23\x22\xa1\x15\x2b\x91\xa2\x1f\x55\x3a\x39\x3b\x43\xaf\xf9\x43\x59\x70\x92\xd3\xbc\x01\x93\x28\x23\x25\xba\xa1\x00\xbb\xa3\x39\x7a\x60\x6a\x83\x6e\xeb\xa2\x40\x8a\x8a\x2d\x2b\x49\x81\xe8\xb6\x2e\xb4\xb8\xa1\x5a\xb2\x72\x0d\x98\x74\x97\x25\xdd\x92\x74\x02\xd3\x68\x88\xbc\x62\x5b\x2a\x24\x5a\xa2\xd5\xf5\x62\x32\xf1\x22\x60\x2a\x93\x5a\x14\x4e\x02\xac\xc0\x84\xad\x42\x91\x61\x73\xa4\x00\x55\x28\x30\x59\x41\x89\x00\x50\x5e\xab\xc4\xd4\x5a\x4e\xd9\xff\x63\x24\x38\x29\xab\x04\x35\x32\xf6\x65\x25\xe8\x7b\x0d\xe8\xe4\xbc\x12\x34\x05\xfe\x24\x18\xcf\x52\x41\xb7\xfc\x9e\xbe\x2a\x88\x94\x09\xce\x4f\x61\x65\x78\xc1\x3b\x49\xc9\x2f\xe4\xa3\x5d\x93\xf0\x53\x8b\xe2\x1c\xd5\xa2\x98\xfb\x12\x59\x67\x19\x95\x32\x5c\xa8\x30\x2b\x31\xb9\x87\x2e\xd1\x12\x61\xbc\xe8\x55\x15\x30\x91\x4b\xf4\xbc\x5f\x43\x6c\x79\xab\xc2\xf2\x12\x3a\x4a\x65\x55\x30\x95\xe0\x0f\x25\xc8\x53\xc1\x32\x9a\xbc\x98\x75\x18\x5b\xb0\xb2\xb7\x10\xe1\x87\xdd\xa2\xe4\x8b\xa1\x4a\x78\x04\x55\xb5\x28\xdb\x44\xc1\xb3\x6f\xaf\x24\x47\x2b\xbd\xa7\x25\x90\xfb\x97\xcb\x9f\x7e\x4c\x2b\x22\x24\x4d\x34\xf6\x3e\x02\xe8\x59\x43\xaf\x5e\x5c\xa3\x2f\x96\x4b\x84\x39\xfe\x0c\x44\x9c\x9d\x21\x96\x17\x14\x55\x54\x30\x9e\x4b\x44\x04\x45\x72\xc3\x85\xa2\x25\xcd\x91\xe2\x88\x28\xb4\xe5\x52\x21\xf5\xc0\x91\xa4\x19\x2f\x73\xd9\x43\x42\x14\x7a\xb6\x44\x3f\x10\xb5\x49\xb7\xac\xb4\x64\x3e\xbf\x46\xa7\x7a\x96\xe6\xe8\x0f\x56\x8a\xc2\xc7\xce\x9f\x83\xed\x4c\x57\x57\x54\xd3\xaa\x96\x9b\x44\x52\xe5\x64\xbb\x99\xad\x21\x26\x68\xd9\x79\xe6\x7a\xf8\xc3\x75\xaa\xf1\x65\x34\x39\xfb\xf0\xf1\xc5\xcd\x87\xd5\xea\xf9\xe9\xff\x59\xbc\xbc\xfe\x7a\x85\x4e\x3f\x9c\x5d\x7f\xbd\xfa\xbf\xa7\xff\x7e\xfd\x9b\xae\xba\x5e\xfd\xdb\x87\x8f\xcf\xff\xf7\xf5\xd7\xf0\xaf\x2e\x5a\x25\xb3\x6b\x80\xbf\x38\xfd\xff\xe4\xf4\x9f\xd7\xbf\x7d\x10\x67\xeb\x39\xc2\xa1\x15\x08\x3f\x7e\xb5\xc0\x3f\x23\x30\x32\x13\xbc\x28\xae\x78\x95\x40\x8b\x4a\xf0\x2a\xc1\xa6\xec\x7b\xca\xd6\x1b\x85\x43\x7d\xe8\x3e\xfb\x39\xcc\xc9\xd7\xe8\xc5\xf3\xe7\xcf\xbb\xf5\xfb\xe0\xfb\xbe\x59\x71\x39\x51\xe4\x6a\x57\xd1\x73\x84\x81\x22\x3c\xf7\x2a\x61\x1f\x28\x20\x52\x50\xa1\x7e\xa0\x52\x92\x35\x4d\x14\xdd\x56\x05\x51\x74\x8e\xb6\xa6\xc4\xb1\x19\xa4\x56\x43\x6a\x55\x71\xe2\x01\x67\xe9\x46\x6d\x0b\xad\xc1\x35\x76\x0d\x93\xde\xb2\x32\x4f\xb0\xac\x48\xb9\x02\x22\x4e\x33\x5e\xed\x40\xdd\x03\x19\x89\xc3\xbc\xd0\x0d\x4e\x12\xfc\x65\xce\xee\x2f\xa0\x9d\xc4\x16\x9d\xc6\x62\xe8\x04\x75\xaa\x2d\xca\x7b\xfe\x20\x11\xc9\x41\x58\x91\xe0\x0f\x20\xa4\x8a\xdc\x14\x14\x2c\x06\x82\x55\x8e\xf8\x2d\x62\x8a\x6e\xe5\xdc\xa8\x69\x82\x32\x5a\x14\xad\x6a\xb5\xa1\xa0\x9f\xb5\x95\x93\x76\xbd\xd0\x1c\xdd\xec\x50\xc6\x8b\x7a\x5b\xca\x14\xa1\x8b\x9b\x92\x8b\x2d\x29\xa0\x13\xb3\x30\x36\x6c\xbd\x29\x60\x62\x68\x9e\x06\x7c\xf3\x54\x25\x9a\x8e\xb9\xeb\xdc\xa2\x9a\x23\x62\x31\x75\x14\xbb\x05\xf3\x88\x12\x66\x9a\x3a\x30\xc7\x6e\x18\x24\x30\x1b\x7f\xa3\x44\xdb\xc4\x3b\xad\x66\x3a\x4a\x74\xe3\x10\xe1\x2f\x03\x3e\x85\xe0\x0f\x81\x79\xfe\x46\xe5\xdf\xba\x39\xd1\xe0\x68\xb9\x5c\xa2\xba\xcc\xe9\x2d\x03\x2d\xf0\x12\x61\x8c\xce\x2d\xa6\x50\xc2\x42\x52\x40\x3f\xb9\x71\xa2\xaf\xbe\xf2\x63\x36\x44\x45\x09\xc8\x73\x6b\x43\x34\xdb\x4e\x1f\x88\x28\x59\xb9\x0e\x17\x55\xa0\xb0\x34\x8c\x95\x27\x65\x9d\x01\x3b\x04\xc1\x1f\x66\x8b\xa8\x48\xe7\x54\x11\x56\x48\xaf\x23\xe2\xd6\x09\x9f\x91\x8a\x9d\x65\x45\x2d\x15\x15\x12\xa3\x67\xc0\xeb\x2f\x25\x2d\xde\x53\xc9\x6b\x91\xd1\x37\x39\x9e\xa5\xf7\xa4\x48\x66\xa3\x56\xcc\xf4\xd6\x1d\x2a\xcc\x60\xce\xee\xed\x72\xc1\x5f\xaa\x6d\x55\xbc\xd6\x90\x12\x77\x17\x8d\xfb\x34\x82\x9e\xe4\xec\xde\x8d\x1a\x38\x60\x96\x91\x66\xc6\x72\x0a\x1a\x83\x0a\xc5\xa8\x04\x1f\x6a\x8e\x56\x2d\x24\xf0\xb3\xc2\x6e\x0c\xe8\xcd\x6b\x3c\x47\x86\xc4\x15\x16\xcd\xc8\xae\xaf\xe7\x93\x4e\x2b\xb4\xc2\x6f\xad\x03\x1b\xb4\x71\x3e\xed\x40\x8b\x9f\xa9\x90\xed\x06\xf7\xb6\x24\x0e\xff\x4e\xf0\x7b\x06\xf5\xe0\x84\x49\x45\x14\x0d\x9a\x56\x41\xe5\xa5\xae\xbb\x46\xcf\x1c\x87\x57\xf8\x96\xb0\x82\xe6\xef\x22\x40\x2f\x11\x46\x09\x4c\xe1\x61\xd0\x67\x08\xcf\x40\xae\x31\x9e\x45\x09\x7c\x0b\x16\x8a\xe4\x5b\x56\xa2\xba\xca\x89\xa2\x88\x0a\xc1\x45\x40\x25\xd8\xb0\x0b\x00\xf8\x9b\xae\xff\x4e\x57\xc7\x47\xfb\x4a\x50\xa2\x68\x1e\x34\xce\x4c\xc9\x85\xc2\xd7\xb0\x5e\xbe\x88\x94\xa7\x52\x11\xa1\xe4\xdf\x99\xda\x24\xf8\xf9\xf3\xe7\x2f\x4e\xf1\x0c\xbd\x44\x25\x7d\x40\xaf\x89\xa2\x49\xa4\xc9\x2c\x55\x1c\xe6\xae\xa0\x97\x4a\xb0\x72\x9d\xcc\xf4\x08\xa3\x34\xbd\xe6\x5b\xc2\xc2\x09\xcb\x4d\xc1\xc0\x08\x78\x29\x79\x11\x4e\x52\x66\x4a\xfe\x26\x8a\x81\x26\x17\xef\xde\x20\x49\xc5\x3d\x0d\x99\x46\x2a\x76\xa9\xcb\x74\x3b\xf4\xac\x3b\x61\xbe\xfe\x67\x26\xd9\x0d\x2b\x98\xda\x99\xc9\x9a\xa3\x28\xd8\x9b\xca\xcd\x65\x9c\x6c\xb3\xac\x91\x93\x78\xb4\x16\xbc\xae\x22\x4b\xe1\xcf\x50\x3e\xb8\x1e\xde\xf1\x1c\xbd\x7a\xf3\xfa\x7d\xd0\xb0\xe2\xf9\x2b\x96\x0f\x4d\x38\x8c\x91\x65\xb4\xdb\x08\xd8\xc1\x32\x3a\xd2\xf0\x07\xa2\xe9\xfd\xf9\x07\x24\xd9\x3f\x43\x76\x6f\x75\xc5\xcf\xdb\x4b\x28\x1e\x6d\x2b\xeb\x9b\x92\xaa\x5e\xd3\x4b\x5d\x1c\x19\xe2\x75\x68\x33\xac\x52\xd9\x75\xf5\x58\xe3\x58\x22\x07\xd2\x28\xea\x9e\x39\x78\x84\x16\x7b\xe0\xe2\x8e\x8a\x77\x82\xdf\xb2\xc2\x69\x32\x47\x78\xbb\x0e\x77\x09\x85\x16\x23\x74\xae\x2c\xc8\x0a\xc3\xde\x13\x5a\xfb\x82\x7b\xcb\xc6\xa0\x28\x67\xf2\x0e\x0a\xff\xfc\xc7\x56\x71\xc6\xeb\x52\xb5\x4a\x64\xc3\xc7\xcf\xc3\x01\x56\xae\x05\x95\x32\xce\x82\x4e\xe5\xe7\xe4\x41\xb0\xc0\x82\x62\x56\x1d\x1e\x18\x58\x7b\x47\xa0\x35\x9c\x3f\x55\x54\x10\xc5\x85\xb4\x6a\x30\x46\x53\xc3\x84\x9c\xdd\x1b\x21\xd0\x3a\x75\x39\xed\x22\x99\x7a\x2f\xf1\x50\x37\x43\xdb\x51\xd7\x27\x3c\xfb\xa7\xcc\x4a\x8c\xa4\x39\x1a\x22\xa7\x3d\x2f\xdc\x16\x8f\x4d\x8c\x83\x69\x66\xa6\x29\xf1\xa6\x33\x2c\x24\xf7\x84\x15\x40\x5b\xbb\xb8\x12\x5c\x4b\x08\xf8\x4e\xad\x8a\x9c\xae\x05\x44\x31\xda\xa5\xd6\xeb\xee\xcf\xf1\x23\xe9\x8f\xd2\x65\x76\xa6\x57\xa2\xa6\x18\x62\x5c\x31\x5a\x0c\xc8\x9f\x48\x21\x29\x7e\x84\x94\x95\x3c\xa7\x4f\x13\x2d\xdd\xb2\x2f\x4f\x2d\x84\xff\x69\x42\xe4\x3b\x6f\x24\x47\x17\xb5\xc5\x05\x8a\x46\x58\xbd\x82\xfa\x46\x4c\xcc\x37\xc1\xad\x36\xb0\x5f\x29\xc9\x77\xcd\xd7\xba\x94\xd9\x86\xe6\xb5\x9b\x97\x97\x8e\xe5\xe0\x0e\xe8\xf9\x71\x90\x77\xf5\x0d\x2d\xa8\x72\xee\x9b\xc7\xc0\x4a\x45\x45\x49\x8a\x37\xd5\xb8\xa8\x1c\xa0\xbd\x45\x5d\x20\x1e\x07\xa6\xfe\x48\xf6\x12\xb9\x2b\x33\xa3\x79\x18\x2f\x3b\x8c\xee\x54\xc6\x56\x28\xe3\xe5\x28\xdf\x9d\xa7\xe5\xa1\x57\x58\xfb\x64\x10\x86\x88\x39\x5b\x6e\xa1\x69\x48\x5a\xe6\x06\x2e\xf4\xd9\x62\xf5\x7d\x3c\x30\x4b\xb8\x85\x8c\x95\x4c\x31\x52\x5c\x2a\xa2\x6a\xd9\xac\x68\x47\x52\xbf\x90\x5a\x6f\x74\x64\xea\x8e\xe1\x41\xa4\x1b\xbd\x3d\xc4\x7f\xd2\x3e\xf8\xa1\x25\x6c\x77\xf5\x9d\x0d\x4f\xce\xee\xe3\x91\x0a\x4d\x74\xb8\xa7\xfa\xb8\xe9\xe9\xa0\x56\x9c\xc2\xec\xa7\x2e\x2f\xbf\xd7\x71\x83\xef\xac\x83\xfe\x71\x23\x52\x41\x65\xc5\x4b\x49\xaf\xda\x31\x98\x78\x58\xe4\x17\xc9\xcb\xa1\xb0\x88\xde\xe7\xbd\xa7\xff\xa8\xa9\x54\xc7\x6d\x25\x4d\x13\x3c\xba\x59\x14\x16\x61\x77\x74\xb0\x5d\xd4\xc2\xdd\xda\x30\x5e\xb4\x88\x18\xdc\x37\xda\x78\x80\xc3\x1d\xce\x35\x9b\x23\x5b\xdc\xed\x71\x30\xcc\xe0\x2a\xdd\x07\x80\x24\x6c\xc6\xd0\xd2\xe1\xd2\xcb\x01\x76\x67\x1d\x42\x9c\xf6\xb6\xe0\x20\x2f\x17\x15\x6c\xea\x68\x3e\x18\xb5\x34\xb0\xcf\x96\x08\x43\x1c\x06\x7c\x7d\xdf\x09\x31\x6d\x85\xdd\x2f\xd4\xa5\x62\x85\xde\x0d\xf8\x65\xe5\x41\xe9\xc7\x8a\x89\x9d\x5d\x77\x36\x4a\xd6\x5b\x5e\xfd\xb1\xed\x11\x2d\x24\xed\xd2\xfc\x9a\x96\xec\x53\x29\x8e\xf4\xd5\xe7\xd5\x70\x40\xa6\x3f\x42\xfb\xcb\x81\x21\xc6\x42\x87\xc3\xbd\x78\xe4\xb5\x04\xa5\x0f\x98\x9f\x88\xc0\x6d\xa7\xc0\x3d\x76\x91\xf6\x33\x3c\x4b\x2b\x5e\x25\xb3\x99\x3d\x65\x52\x4c\xe9\x9d\x64\xb4\xd5\x13\x3b\x56\x2c\xbb\xa3\xea\x13\x10\xfc\x52\x4b\xc5\x6e\x99\x0b\x72\x3c\x0e\x8b\x16\xde\xde\x7a\x74\xab\xc6\x9c\x0b\x4a\xb7\xbc\xf2\xe8\xf2\x02\xd9\xeb\x2e\x2b\x23\x86\xef\x68\x09\x67\x50\x83\x72\x68\x97\xbd\x97\x3a\x3c\x47\x38\xa7\xe5\xae\x6d\xf1\x18\xb8\x20\x99\x8e\x82\x0c\x21\x82\xc7\xd2\x1a\x8e\xf3\xa6\x56\x8a\x97\x28\x03\xef\x68\x39\xbd\x51\x25\xba\x51\xe5\xa9\xdc\x9a\xff\xf4\xa1\x00\x11\x3b\xb4\x15\xa7\x2f\xa6\x9e\x23\xae\xaf\x34\xdb\x10\x71\xa1\x12\x2d\xa3\x7f\xab\x2a\x2a\x5e\x11\x49\x93\x99\xde\xcb\x5b\x10\x77\x0a\x33\x4b\xb3\x82\x65\x77\x47\x44\xf7\xdd\xd3\x53\xc3\x43\x8f\x39\xdd\x3d\x47\x18\x8e\x77\x03\xd5\x3c\xf4\xf4\xd4\xf9\x59\x6b\x7d\xb3\xdc\xe8\xa2\x33\x1c\x8c\xe3\x30\xd6\x0d\x25\x39\x15\xf2\xfc\xc0\xa8\xdc\x83\xff\xdf\xe9\xab\xcb\xf7\x7f\x3a\xd5\xe7\xa4\xf8\x1c\x1d\x77\x96\xda\x89\x54\x0e\x3d\x81\x25\x1c\x7a\xbc\xdd\x6a\x1b\xc1\xc3\x0d\x8f\xb1\xe3\x43\x9f\x4f\xb3\xef\x4f\x1c\x6d\x78\x72\xd2\xfd\xec\x63\xaa\x60\xa8\xd1\x7e\x4c\x69\xd8\xb5\x15\x53\x13\x47\xc5\xb7\x0f\xb9\x59\x71\x37\x41\xa3\x0e\x30\xfc\x2e\xfd\x2d\x7d\x86\x7f\xcf\xef\xe8\x25\x6c\x65\x41\x59\x9a\xaf\x52\x1f\xe4\x4b\x57\xb8\x25\x2a\xdb\x40\xc4\xf8\x96\x15\x8a\x8a\x39\xe2\x22\x04\xd0\x07\x3e\x70\xb2\x03\x65\x6b\x76\x4f\x4b\xc4\xf2\x39\x40\x94\x48\xd0\x5b\x41\xe5\xa6\x8d\x11\x15\x4c\xaa\xc6\xd9\x6b\x93\x90\x40\x5b\xd3\xd1\xa0\xdb\x37\xa4\x57\xb4\xfe\x60\xfa\xf0\x44\x2b\x11\xdb\x9f\xd4\x0a\x83\xe5\x5a\x77\x98\xde\x74\x0c\xba\x0d\x63\x2b\xe6\x93\x03\x7a\xe3\xb3\xe8\x87\x60\x86\x32\x5e\x2a\x5a\x2a\x3b\x49\xa4\xaa\x0a\x6b\x0e\xcf\x82\x09\x73\x53\x79\x6e\x59\x83\x5e\x9a\xe3\x6b\xa9\x3d\x0f\x76\xbb\x4b\x1c\xcb\xce\x9b\x43\xa4\xf9\xa4\xa7\x53\xdc\x68\x7f\xa7\xd2\xe8\xc8\xd3\x12\x62\x64\x06\xb6\xca\x19\x2f\x6f\xd9\x1a\x91\x32\x47\x97\x97\xdf\x3b\x31\x92\xf6\x50\x11\x49\x5a\xd0\x4c\xd1\x1c\xd9\xf8\x50\x23\x5a\x0e\xd2\x9b\x34\x70\x0c\x1a\xbf\x07\x2d\x87\x4f\x9d\x16\x93\xb8\xec\x05\x36\xca\x61\xc7\xed\x01\xf6\x04\x26\x70\xb4\xce\x83\xde\xe7\x31\x0e\xf9\x99\x6a\x26\xc4\x75\x73\xcc\x21\x97\x5b\x45\x83\xbb\x95\x66\x63\x6f\x1c\x0c\xb3\xb3\x37\x3a\x72\x39\x35\x6b\xc0\x86\xef\xb5\x5d\x3b\xc6\x37\x00\x27\x4a\x4f\x91\xd8\x26\xf8\xbd\x46\x81\x48\x51\xb4\xe6\x09\x56\x60\x33\xf4\x9e\x93\x0a\x4b\xf3\x25\xee\x1d\x59\xba\xa7\xa3\x22\x1a\x09\x47\xbf\x0e\x71\xf7\xb0\x9d\xe8\x2b\x74\xe3\xd2\x39\xb2\x3b\x7e\x9c\x2d\xfe\xa4\x9d\x9c\xda\x55\x90\xee\x63\x51\xad\x30\x7c\x1f\xdc\xc7\x41\xa5\xf1\x45\xa5\xdc\x0c\xfa\xa1\x1a\x4a\x6f\xe0\x80\xc5\x1e\x33\x84\x80\xb4\xb7\xe4\x86\xb3\xc2\xa4\x06\x95\xac\x40\xbb\xe8\x98\xa0\x3e\xb6\xcb\x78\x59\xea\xc5\xe3\x8e\xe5\x62\x5c\x1b\x33\xaf\x5d\x9f\xdc\xf5\xf6\xd4\x4d\x0d\x8c\xe7\x71\x2d\x7c\x97\xb4\xa0\xf7\xcd\xd8\x76\x54\xea\x21\x95\x1c\x3f\x15\x5f\xb8\xad\x0d\xc2\x49\x71\x80\xa1\x4d\x21\xd0\x70\x3c\x05\xb6\xa0\x07\x6c\x1d\x8e\xa7\x6d\x0b\xec\xa2\x3c\x72\x39\x0f\x2c\x3a\x3f\x6a\x70\xc3\x23\xc3\x81\x9f\xfd\x6c\xfe\x34\xca\x73\x52\xae\xa9\xe8\x51\xac\xd5\x08\xbf\x45\x20\x4c\x8f\xa4\xfe\x58\xa5\x14\x11\x58\x50\x46\x88\x97\x90\xc5\x24\x76\xce\xa8\x8c\x68\xa7\x01\x86\xb5\xb4\x94\xc7\x7e\x1e\xeb\x31\xa6\xac\xe2\x4a\xcb\x7d\xf6\xb3\x5e\x71\x74\x27\x1c\x04\x72\x9f\xea\xe0\x76\x6d\xca\xff\x80\x40\xe2\xd9\x19\xba\xda\x50\x37\x75\x48\x52\x22\xb2\x0d\x62\x12\x89\xba\x84\x40\x17\x78\x0d\x15\x17\x8a\x14\x73\xf4\xb0\x61\xd9\xc6\x46\x5e\x25\xe2\x25\x45\x15\x59\x53\x10\x0f\xdb\x5c\x82\x6f\x4b\x14\x22\x3a\xf5\x33\x45\xae\xfc\x1d\x59\x53\x89\x36\xbc\xc8\x8d\xa7\xa2\x60\x33\x08\xed\xc0\x8a\x18\x24\x92\xd2\x12\x49\x8e\x6e\x89\x98\xc3\xff\x6a\x43\x94\xf3\x94\x41\x22\x74\x82\xab\x86\xd4\xe9\xb3\x44\xa2\x07\xc8\x9a\x22\x12\x12\xa7\x1e\x88\xc8\x4d\x0e\xab\xed\xf0\xd2\x0c\x63\x89\x7e\xdd\x2f\xc2\x72\x43\x88\xc9\x6d\xed\x14\xdb\xac\x4c\x3f\x25\x86\x13\xd6\xc0\x37\x6e\x51\xac\x03\xeb\xfe\xc0\x60\x02\xf7\x07\x5b\x21\xc6\x5f\xb2\xb2\x32\x04\xfd\x08\x25\xcd\xc4\x60\x59\xdf\xc8\x4c\xb0\x0a\x3a\x7c\x93\xb7\x61\x2f\xdb\x75\x41\x2b\x67\xb9\x75\x4a\x40\xbb\x91\x73\xce\x4c\x55\xd0\xc6\x9d\xd8\xb5\xa0\x7d\x4e\x4c\x03\xd7\xcf\x6c\x81\x16\x92\x16\x06\x7f\x3f\x51\xc5\x0a\x53\xe8\x01\xe8\x64\x71\x96\x87\xf2\x0c\xbc\xb6\xb9\x5b\xe8\x24\x61\xb9\xf5\x1c\x53\x25\xd8\x36\x8c\x77\x82\x2a\x8a\xa6\x84\xb5\xd8\xbe\xba\xa3\xbb\x6b\xb4\x34\x29\x5f\x81\xc8\x7b\xb9\xf6\x0e\xac\x22\x6b\x98\xee\x93\x74\x2d\x68\x95\x9c\xa4\x5b\x52\x81\x4d\x6c\x58\x70\x45\xd6\xd2\xf9\xb1\xce\xd5\x9a\xc3\x41\xea\x89\xa6\x6d\x36\x47\x7f\xe4\xbc\xa0\xa4\xb4\x44\x02\x81\x80\x34\x2d\x68\xb9\x56\x9b\x90\xcc\x36\x89\x58\x91\x35\x04\xc8\x10\x40\xdb\xf4\xaf\x49\x28\x41\x5e\x14\xb1\x0b\xc2\xca\x0d\x7f\xb0\xf2\x06\x95\xc9\xf3\x4e\xa8\xbf\x5b\x5f\x75\xf2\x1e\x61\x9d\xeb\xc1\xba\x9b\x07\xfb\x79\x9b\xa8\x60\x0c\x21\x11\x2b\x40\x74\x1d\x0e\x05\x30\xad\x30\x14\xeb\x21\xf4\x81\x5b\x03\x1a\x73\xfc\x6d\xd3\xbe\xe3\x0f\xff\x36\x65\x4a\x90\x9c\xc1\x20\x49\x71\x8e\x94\xa8\xe9\xa8\x9b\x2f\xa8\xac\x8b\x68\x46\xb6\xa4\x45\x7c\xa7\xb2\xe8\x81\xea\x28\x86\x05\x56\x37\x85\x65\xac\x44\x36\xba\xb1\x98\xb4\x5a\x48\x5a\xa4\x74\x5b\xa9\x5d\x37\x3a\xaf\xc1\x9b\xaa\x98\x97\x6c\xc8\xf5\x67\xf2\x9d\xb3\x78\xe6\x27\xa9\x3b\xa0\xee\xa1\x86\x05\x8b\xa6\x9f\xb5\x89\xea\xcc\xf2\x48\x9a\xd9\x11\x67\x07\x3a\x5f\xed\x30\x26\x70\x05\x66\x9d\xf3\xb6\x40\x48\xc2\x07\x98\x69\x6d\x2b\x38\x6a\x5c\xeb\x38\xef\xd0\xf8\xae\x9a\x1d\x8a\xf1\x8c\x0f\x6e\x26\xdc\xad\x98\xac\x16\x12\xcc\x6b\xc5\xf5\x29\xf1\x62\xfa\xed\x23\x3c\x22\x20\xce\x6c\x93\x2b\x08\xd9\x9b\x4b\x27\x78\x8e\xe2\x64\x45\xc6\x1b\xa3\x74\xd8\x85\xf6\x68\x9f\xb2\x03\xf0\x8d\x3b\x86\xe4\xa9\x68\xdc\xd0\x8c\xf9\x78\x2a\x96\x26\x13\xf3\x89\x08\x7c\x7a\x09\x24\x69\x60\xfc\xb9\x8e\x3a\xcc\x52\x3d\xde\xa9\xeb\x4b\x82\x8d\xd1\xf5\xb4\x43\xdb\x8d\x00\xbd\xb9\x18\xaa\x77\x96\xc3\xc2\xa1\x67\xe8\x45\x1b\x16\xd6\xad\xd3\x18\x25\xfd\xa8\xc0\x14\xc4\xd7\x69\x0b\xab\xbe\x81\x10\x69\xb7\x98\x8c\x2c\x46\x30\x85\x37\xaa\x7c\x27\xe8\x3d\xe3\xb5\xd4\x4d\x66\x36\xd3\x3f\x67\x12\x02\xb3\x90\x13\x0a\x03\xd2\xdb\xeb\xe7\xb3\x45\xac\xfd\x8f\xae\xbb\x48\xdb\x2f\x46\x69\xfa\xbd\xba\xc4\x52\x6e\xde\x37\x77\xab\x9a\x10\x5b\x10\x53\x0b\x2f\x5f\xc1\x0d\x2e\x37\x1d\x29\x42\x3f\x95\xc5\x0e\x91\x3a\x67\x90\x79\x05\xe8\xb6\x64\x07\xe1\x63\x46\x1f\x82\x56\x41\x8e\x7e\xab\xbb\xa4\x51\x30\x6e\xfc\x71\x0b\xdb\xc0\x81\xee\x3d\x93\x72\x73\xd6\x60\xc7\x07\x2c\xa8\x83\x3b\xee\x80\xbf\xa1\x6e\x30\x5c\xe6\x8d\x9d\x83\xec\x18\x39\x5f\xd1\xed\xd1\xf5\x5a\x0b\xb0\xdd\xa3\x83\xb2\x87\x5c\xf6\xab\xd9\x5f\x2f\x26\x1d\x5c\xf1\x18\xd3\x23\x34\x48\x70\xa6\xed\x7b\x0a\xd2\x6a\x3e\xdb\x99\xb6\x47\x1e\xcd\xc4\x89\xd6\x7f\x9e\xc8\x49\xb7\xff\x66\x77\xfd\x64\x14\xee\x9a\xa1\x09\x28\x3d\xd0\x1b\x7f\xf1\x10\x22\x4b\x01\xa0\x8d\xb6\x81\x62\x37\x19\xc3\xa7\x9d\x39\x35\xa5\x8f\x20\xc5\x16\xf4\x80\x8f\x8a\xa6\x8c\xc4\x81\xe0\x7a\xd7\x23\x23\x29\xc1\x25\xc9\xc7\x47\x7d\xc8\x21\x12\x35\x75\x26\x43\x61\x23\xe8\x2d\x9e\x83\x1a\x70\xf4\xba\x5b\xa1\xf8\xc8\x68\xc7\x67\x39\xd0\xeb\xab\x85\x4f\x3c\xcc\xeb\x5e\xdf\x8a\xe9\xf8\x21\x05\x34\x7c\xa5\x6b\x39\xd5\x47\x37\x4d\x92\xe5\x88\x91\x38\x78\xd7\x2b\x32\xb2\x43\xd6\x44\x9b\x82\xb7\x7c\x0d\x57\x17\x1f\x64\x10\x52\x31\x46\x02\x15\x7c\x0d\xd7\xc4\x9c\xc5\x48\x11\x7a\xcb\xee\xa8\x86\x6b\xc7\x65\xe6\x80\xcd\x18\x54\x73\xe5\xcb\x5f\x0d\xf3\xc1\x18\x1f\x7d\xd1\x67\x40\x1a\xe5\x50\xf4\x05\x90\x45\x03\x30\x26\x94\xa2\xdb\xf6\x02\x29\x01\xc6\x26\x8c\xe2\x0b\xbb\x41\x14\x37\x72\xbf\x6a\x62\x27\x31\x17\x16\xa8\x37\xb3\x4d\x44\xce\xa4\x15\x00\x9f\x4f\x0d\x27\x96\xd3\xc6\x50\xf8\x53\xc3\xc1\x13\x2a\x87\xf0\x9e\xf8\x08\xd7\x32\x10\xc4\x50\x0a\xfb\xa3\x9e\xf4\x03\x84\x3d\x72\x80\x02\x60\xe5\x88\x9e\x68\x87\x40\xd4\x86\xc9\xa1\x20\xc8\x68\x20\xc4\x55\x3a\x14\x36\x63\x09\x4e\x47\x66\xda\x47\xc3\x70\xd7\x07\xc2\x6f\xa7\xe0\x87\x17\x83\xa7\x21\x8e\x16\x6f\x70\x74\x01\x98\x96\x37\x97\x3f\x39\xbb\xb2\x98\x1c\x8e\xb1\x06\x1c\x5b\x39\xaa\x80\x35\x09\x36\xac\xc6\xb3\x48\xac\xa6\x8d\xca\x05\x75\xf7\xbd\x89\x8f\x1d\xbd\x19\xb4\x07\xcf\xdc\x0c\x58\x38\x86\xb6\xec\xfa\xb8\x8b\x8b\xbd\x5c\xb8\x6a\xc8\xe9\x9d\x7b\x67\x77\x3f\x3b\x8a\xaa\x2a\x70\xa2\x0f\xd3\xd6\xef\xae\x59\x43\xa7\xe8\xc5\xe3\xba\x76\xae\xf5\xa7\x75\xfb\xec\xb1\xdd\x7a\xa3\x2d\x0f\x77\xdc\x72\x70\x47\x16\x52\x74\x5d\xbb\x25\xd2\x25\xcf\xba\x9c\xbf\xc2\xe9\x12\x17\xea\xd5\xe5\xcf\x10\x0d\xcd\xe4\x3d\x64\x98\x99\x32\x38\xfa\xc7\x4e\x2f\xb7\xb2\x8b\xcd\x28\xe6\x10\x3e\xde\x92\x56\x30\x69\x7c\xd4\xe0\xae\x98\xdf\xc1\x41\x3d\x38\xf2\xb8\x24\xc2\xf3\xc0\xca\x9c\x3f\xa4\x6e\xb7\x0c\x2f\x12\xd0\x67\xe5\x7a\x46\xce\x0c\xf9\x2f\xa1\xb7\x13\xb8\x7a\x4f\xb6\x49\x13\xd8\xc3\x86\x68\x7c\x6e\xa9\xdf\xdb\x79\xb4\x61\xbe\xd9\xa2\xbf\xb2\x3c\xcf\xac\x6d\xeb\xe8\x5c\x73\x52\x31\xe9\x53\x3b\xb0\x66\x22\x92\x14\x0b\x57\x76\x40\x8e\x89\x58\x86\x03\x69\xe2\x95\x0d\x19\x07\xa3\x95\x5d\x50\xcb\x80\xc9\xc8\x4e\x2a\x60\xfc\x81\x40\xe5\xa3\xa2\x91\x2e\xc4\xd8\x08\x54\x3c\xb8\x38\x16\x43\x1c\xbe\x69\x00\x51\x6a\xb7\xb9\x36\x4b\xb1\x13\x59\x34\x85\x23\xc9\xf3\xfd\x0b\xb9\xf0\x74\x76\x20\x2b\xac\x86\x6e\x13\x44\x9b\xbb\x56\xcd\xa6\x62\x3e\xe9\x80\xb4\xe0\x7c\x06\xff\x8f\x47\x00\x9b\xf1\xbe\xa6\x3e\xd8\x65\xb6\x12\xed\x6a\x70\xc5\x0e\x21\x62\xd5\x45\x9e\xc3\xb5\xa4\x18\xa0\x15\x99\xe8\xa5\x84\x83\x4c\x8d\xd1\x62\x2e\x96\x5c\x1a\xd9\x39\x74\x27\xc1\xcb\x6f\x34\x94\xd4\x48\xf7\xe7\x0b\x24\x05\x38\x9f\x14\x46\x1a\x57\x98\x3d\xc3\x78\x7c\x7c\x69\x1c\xb1\x23\x6f\x7a\xfd\xaf\x13\x78\xca\x89\xdc\xdc\x70\x22\x72\x19\x6c\x16\x6e\x6a\x56\xa8\x53\x56\x86\xb5\x03\x09\x5d\x29\x82\xe3\x5c\xc0\x64\x77\x18\xa2\x2e\x8d\xd7\xef\xdb\xa2\x7f\xd4\x54\x30\x2a\x11\x59\x13\x56\x4a\x15\xee\x33\xa6\x12\xbd\x13\x1c\x92\x06\x69\x2d\x61\x07\x01\x98\xdc\x81\xaf\xdd\x2b\x94\xb4\x40\x82\x96\x39\x15\x34\x87\xd7\x13\x7d\x7f\xf5\xc3\xdb\x20\x96\xd5\xd0\x98\x8c\x87\xaf\x8c\xd2\x6d\xc0\xf1\xa8\x96\x6d\xe0\x8e\x49\xee\x7a\xdd\x60\x0d\x36\x15\xdd\x56\x92\x36\x80\x2d\x35\x6d\xa2\xbe\xce\x2f\x81\xdf\x97\x53\x4f\x00\x88\x5b\x14\xd7\x7b\xc8\xcc\x38\x88\x47\x00\x94\xc1\x11\x8b\x9f\x35\xe3\x0c\xb5\x0e\x24\xc7\xbb\x8a\xee\xf8\x6d\xa8\xda\x8f\x24\x7e\xb4\x02\x5b\x0d\x8f\xc2\x9f\x36\xd8\x5c\xf8\xa6\xdc\x5c\x7d\xe8\x05\x5f\x7a\x4a\x0a\x86\x6c\xa3\xe1\x83\x5b\x29\xf7\xf1\xdd\x26\x91\x5b\x90\x15\x29\x69\x21\xf5\xc5\xb8\xd6\x20\x80\x5e\x53\xa6\xd9\xea\xf7\x71\x0e\x69\xb8\x5d\x88\x72\x21\xdb\x40\xbb\xc4\x12\xd9\x69\xe9\xd1\x8e\x42\x8d\xab\x1f\xdb\x26\xf0\x00\x1b\x2c\xb1\x70\x42\x5f\x26\xdb\xe9\x21\xf0\x58\x0c\x49\x5c\x79\xfc\x7e\x34\x95\x27\xa1\x35\xb7\x73\x04\x62\x35\x47\x5a\xc4\x1d\x61\xc0\x44\x2d\x65\xf8\x2d\x27\x10\x6f\x4c\xd3\xd4\x0b\x7f\x5c\x33\x0c\xee\xe3\xc1\xe3\x0e\x34\x86\x0e\x07\xeb\x2e\x0f\x64\x95\x02\x3d\xf8\xdc\xd0\x35\x8f\x0d\x76\x4c\xe1\x74\x39\x0c\x03\x1a\x3d\xef\xf5\x2d\xc1\x29\x05\xe9\xee\x1d\xf8\xea\xe2\x2e\x5e\xb7\xac\x32\x5e\xc4\x75\xd9\x3b\x68\x15\xea\xb3\x5e\xf3\x8c\x17\x56\x60\x37\xff\xcb\xc5\xba\x74\x5f\xcd\xc2\xee\x5c\x71\xb2\xb5\x60\x12\x76\xf8\x3a\x86\x13\xdc\x08\x0b\x65\xef\x6c\xc6\x08\x6f\xf7\x9e\x6a\x29\xec\x50\x40\x1f\x71\x99\x39\xb8\xf3\x16\xef\xcb\x66\x06\xc1\xe5\x1d\xa5\x2d\x90\x4e\x59\xf2\x56\xa9\xcc\x11\x95\x19\xa9\x68\xde\xce\x61\x3a\x40\xb7\xd7\x4a\x1a\xef\xb5\xe3\xb5\x1d\x00\xfc\xde\x73\x85\x22\xee\x90\x13\x12\xab\x87\x33\xde\x8d\x06\xef\xe3\x2b\xee\x98\xe5\xdd\x12\xbe\xff\xfa\x75\x7f\x76\xe6\xe3\xfa\x90\x20\x26\x11\xcf\x50\xc6\xb7\x5b\x52\xe6\x6d\x7f\xa2\xeb\x97\x20\xb8\x6d\x88\x08\xfa\x3b\xbd\xb9\xe4\xd9\x1d\x35\xaf\x32\xbc\x6a\x22\xa1\x34\xdb\x70\x6a\x7d\x0c\x8b\x11\xfc\x0f\x24\x95\xa0\x64\x2b\x11\x53\xd2\xbd\x2f\x11\x12\xc0\x4c\x88\xd2\xd1\x62\x50\xa2\x25\x2a\xeb\xa2\x08\xe3\x8f\x0e\xc0\xdb\x25\x90\xe6\x76\xab\x90\xc3\xed\x9a\x34\x2b\x38\x5c\x05\x6b\x6d\x20\x7b\x6f\x22\xbc\xb2\x6d\x62\xef\x22\x6c\xbd\x3a\xac\x01\x1c\x5a\x01\xcd\xbe\x98\x66\x3c\xa7\xc2\x46\xe7\xae\xe8\x47\xf5\xda\x94\x38\x62\x80\x0a\xe9\x07\x4d\x1f\x1a\xbe\x26\xf8\x41\xca\xf3\x33\xad\x1a\x5d\x68\x21\xdd\xc0\x2b\xf2\x46\xde\x1b\xa5\xf5\x6a\x73\x7d\xe0\x4c\x75\xc6\x64\xba\x4a\x6f\x58\x49\xc4\x0e\xdc\x57\x08\x56\x10\x21\xc8\xee\xa6\xbe\xbd\xa5\x02\x2f\x26\x21\x1c\x2f\xed\x5b\x1c\x5a\xae\x81\x7e\xd3\x5d\xc8\x6d\xcf\x29\xff\x8b\xbd\x75\x07\x23\x4d\xcd\xff\xe6\x6d\x7d\x29\x2c\xca\x39\xfa\xd5\x08\x83\xc9\x10\xda\xcf\x82\x57\xe6\xb9\x17\xde\xcd\x16\x2d\xec\x47\xbf\xc1\x6e\xdf\x1d\x80\x9e\xfa\x41\xcf\xa6\x2f\x45\x68\xb9\x5c\xda\xd6\x21\x60\x5f\xa8\xbc\x90\xba\xea\x61\x4e\xe0\x0f\xe5\x2a\x67\xd2\xe7\x9d\x5f\xc3\x2b\x21\x9b\x86\xfb\x16\xe5\xbd\x5e\x0c\x31\x8d\x04\xb2\xb2\x0a\x24\xf0\x96\x67\xb5\x4c\x3a\xc6\xdc\xe3\x00\xf7\x11\xb2\xcb\xc1\xeb\x54\x64\x78\xed\xa0\xaf\xbe\xea\x74\x9c\xea\x57\x29\x5c\xfa\x3b\xca\xcd\x7a\xff\xe9\xdd\x77\x3f\x86\xac\xe9\xb4\x93\xd0\x67\xe7\xa2\x4e\x9b\x8f\xe6\x0a\xc0\x39\x82\xff\x1a\x75\x05\x0f\x84\xbc\x09\xee\x86\x6a\xfc\xbd\xb8\x3d\x68\xae\x93\x24\xe7\x59\xbd\x05\x11\x34\x34\x46\xe2\x75\xb1\xf5\xd1\x4a\x00\xb1\x08\xbb\xa9\xa1\x76\x0a\x6c\x36\xc4\xa5\x8d\x7a\x8f\x86\x05\xfb\x28\x9c\x96\x9d\x0c\x27\x66\x8c\x22\xec\x24\x07\x5a\xbd\x3b\x14\x50\xee\x67\x6e\x3c\x15\xf9\xb3\x41\xe4\x6f\xf9\x9a\xd7\xea\x00\xea\x93\xd4\xbd\x1a\x39\x31\x1b\xc2\xc2\xb6\x8a\xe3\xfc\xab\x57\x52\xc7\xe3\x3d\x5a\xed\x95\xf4\x61\xa8\xdf\x66\x57\x7c\xa0\xdf\x7e\x54\x77\xbc\xfb\xaa\x41\x1c\xef\xd9\xbb\x7f\x4d\xcf\xde\xc1\x94\x1d\x58\x7b\xb5\xf2\x42\x3b\xb3\x07\x08\xed\xb9\xde\x87\xae\x21\x1f\xe1\x9a\xdb\xab\xc8\xc0\xc7\xf9\xe4\xc8\x8b\xc5\x9f\xed\x12\x71\xe0\xc3\x3c\xea\xb2\x60\xb3\x6d\x18\x55\x3f\xf0\xd3\xb9\x84\x7f\xee\x14\xeb\x5f\x5a\xc5\x51\xea\xe0\xa7\x79\x09\x80\x6f\x79\xe5\x4a\xda\x27\x1d\xf3\xc9\x58\xd6\x40\x64\xb7\x12\xce\xec\x88\x4b\xa8\xfd\x41\x38\x1d\xf9\xce\x5e\x00\xb2\xef\x0f\x71\x37\xc7\x69\x9e\x22\xf4\x46\xa1\x6d\x2d\x15\xbc\x4e\xda\xde\xd8\xd7\x5e\xb4\xe4\x5b\x0a\x81\x6a\xf3\x2a\x8a\x1b\x7a\xcb\x05\x45\x4c\xb9\x37\x4f\xd7\x92\xe6\xa9\x5b\x3e\xe1\xd3\x7d\x43\xc9\x62\x6c\xd6\x8e\xf1\x81\x3f\xdd\xdd\x8d\x74\x1c\x77\x7b\xdd\x82\x8c\x2d\xcc\xde\x6d\x66\xb3\x38\xdb\xc3\xed\xb4\x09\x2e\x88\x18\x68\x9b\x4c\xd6\x85\x0b\x8e\xc4\x2d\x56\x5b\xd0\x81\x6b\x5e\x5c\x63\x35\x83\xf9\xde\x81\x0a\xec\xbe\x01\x73\xb6\x37\x84\x6b\xfb\x07\x77\x74\x97\xf3\x87\x32\x19\x71\xdf\xfc\x2b\x9f\xd3\x3b\xba\xd3\xc6\x1e\x7f\x07\xe9\xb6\xbd\x13\x67\x6f\xe9\xc1\xc4\x63\xeb\xdc\xe3\x79\xfb\x14\xbc\x33\x3b\x61\x5d\xeb\xaa\x9d\xdd\x15\x46\x3a\xcf\x30\xfa\xea\x2b\xb8\x89\x54\xaa\x34\x53\xa2\xf8\x2b\xdd\x8d\x53\xa2\x93\x83\x45\x5d\x79\x63\xe3\x1e\x83\x03\xe2\xd6\xb4\x54\xaf\xe9\x2d\xa9\x0b\x95\xf4\x3d\xaf\x01\x2e\xbf\x69\xd0\x8e\x2a\xe0\x23\x88\x19\xf1\xdb\xc6\x28\x78\x05\xce\xeb\x81\xde\x5b\x3b\x08\x4f\x4a\xe0\x33\x46\x9d\xca\xb6\xeb\x0a\x93\x10\xf7\x79\x65\x64\x03\xd5\x70\x2e\xbe\x29\x22\x79\xde\xdb\x11\xc5\x46\x79\x79\xf9\x7d\x73\x96\x7c\x60\x94\xed\xc4\xca\x41\xe3\x35\xdc\xd3\x7f\x83\x11\x85\x3c\xce\x7f\x5d\x0b\x6a\xd3\xfb\xce\x91\x7e\x9d\xfc\x9b\xd2\xbb\x67\xe6\xe5\xa0\x8e\xb0\x0e\x65\xf0\x63\x52\x07\xbd\xe5\xfc\x11\xbe\xb5\xad\x26\x1c\x05\x36\x57\x09\x1f\x6b\x42\x61\x37\x19\x7d\xad\x28\x88\xb9\xae\x3c\x18\x01\x83\x45\xe5\xde\x38\x6e\x3d\xbf\xbe\x65\x72\x01\xbc\xc5\xa4\xd3\x3a\x92\x01\xe7\x5f\x5f\x6e\x05\xb3\xfb\x3c\x22\xe7\xad\x33\x82\x78\xef\xa3\x99\x6f\x9f\xfa\x32\xff\x03\xdc\x19\x61\xcc\xf1\x4c\x19\x63\x88\xb5\x3c\xd3\x6b\xf4\x2d\x82\xd8\x42\x87\x33\xce\x30\xc5\x78\x73\x14\x5a\x9f\x9d\xe9\x2b\xf5\x3b\xa4\x3e\x09\x79\x45\xa4\x7c\xe0\xa2\x4f\x34\xfe\xda\x7e\xf0\x93\x31\x8e\xd1\xeb\xa0\xa2\x04\x3f\x4e\x4a\x4e\x92\x69\x0a\xc8\x4f\xcd\x21\xca\x74\x5c\xa3\xc6\xfe\xc6\x06\x11\x94\xb8\x6c\x6e\xf7\xd5\xe6\xca\xea\x1b\x3f\xe7\xc8\xfc\xa5\x99\xc5\x14\xb1\x7c\x39\x75\x30\xf0\x87\x67\xdc\xef\xd1\xf7\x0c\xb8\xbf\xc3\x00\x00\x46\x14\x9c\xdb\x01\x47\xcb\x49\x9f\x3d\xb3\x43\x48\xba\x7f\x10\x66\x31\x19\x85\x87\xc3\x42\x1b\x95\x48\xd7\x54\x7d\x57\x50\x08\x50\xfc\x71\xf7\x06\x72\x3c\x2c\x0c\x9e\x8d\xa2\xb0\x01\x8a\x21\x75\x12\x80\xa9\x4b\x0d\xc9\x78\xa9\x4f\xd4\x92\xe7\xf3\xa6\x56\x67\xe7\xb9\x84\x82\x66\x47\xdf\xfd\x78\x62\xe9\x47\x9a\xbd\x32\x22\x9d\x4c\x61\x72\xa7\x87\x5a\x00\x3f\x5c\xdc\x73\xc3\x8a\x3c\x71\x7d\xf7\x87\xb7\xff\x1c\x9e\xfa\x7e\xb6\x98\xfc\xc7\x00\x13\x57\x9f\xf5\x84\x69\x00\x00")
 
 func indexJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -124,7 +124,7 @@ func indexJs() (*asset, error) {
 	return a, nil
 }
 
-var _libBootstrap452MinCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x7b\x8f\xe3\x38\x92\x20\xfe\xff\x7d\x0a\x6f\x16\x0a\xd5\x39\x6d\xb9\x24\xd9\xf2\x2b\xd1\x8d\x9d\x1d\xec\xe2\x16\xe8\x9e\x3f\x76\xee\x80\x03\xfa\xea\x00\xd9\xa2\x6d\x4d\xe9\x75\x92\xb2\x52\xd9\x46\xde\x67\xff\x81\x2f\x89\x8f\x20\x25\xd9\xca\x9a\x99\xfd\xed\xf6\x4e\xa5\x4c\x06\x23\x82\x11\x41\x2a\x82\x22\x83\x9f\xff\xf0\x4f\xff\x6d\xf6\x87\xd9\xbf\xe4\x79\x5d\xd5\x65\x58\xcc\xbe\xad\x16\xc1\xc2\x9f\xfd\x70\xa9\xeb\xa2\xda\x7f\xfe\x7c\x46\xf5\x81\x57\x2e\x8e\x79\xfa\xf9\x11\xc3\xff\x29\x2f\x5e\xcb\xf8\x7c\xa9\x67\xbe\xeb\x79\x8e\xef\xfa\xee\xec\x7f\x5c\x90\x80\xe7\x8f\xcf\xf5\x25\x2f\x2b\x23\xf0\x4b\x5c\xd7\xa8\x9c\xcf\xfe\x3d\x3b\x2e\x30\xd0\x2f\xf1\x11\x65\x15\x8a\x66\xcf\x59\x84\xca\xd9\xaf\xff\xfe\x3f\x04\x1e\xe2\xfa\xf2\x7c\x20\xd4\xeb\x97\x43\xf5\xb9\x65\xe8\xf3\x21\xc9\x0f\x9f\xd3\x30\xce\x3e\xff\xf2\xef\x7f\xfa\xd7\x3f\xff\xe5\x5f\x31\x77\x9f\xf7\x65\x9e\xd7\x57\xc7\x39\x24\xcf\x68\xff\xc1\x75\x37\x87\xd3\xe9\xc9\x71\xe2\x2c\x8a\xcf\xf9\xfe\xc3\x7a\xed\xb9\x27\xff\xc9\x71\x8a\xe7\xb2\x48\xd0\xfe\xc3\xfa\xb4\xf2\x8f\x1e\x2e\x88\xb3\xaf\xfb\x0f\x68\xbb\x44\xdb\xe3\x93\xe3\x94\x28\xda\x7f\x88\x8e\xcb\x60\x15\x3c\x39\x4e\x5e\x86\xd9\x19\xed\x3f\x9c\xa2\x0d\xf2\x56\x4f\x8e\xf3\x8a\x92\x24\x7f\xd9\x7f\x38\x9d\x8e\x9e\xbb\x79\x72\x9c\x73\x89\x50\xb6\xff\xe0\x6f\xc3\x0d\x69\x51\xa3\x30\xd9\x7f\xf0\xdd\xe3\x6e\x87\xab\x8f\xaf\x61\xb6\xff\xe0\x6d\x42\xff\xb0\x7d\x72\x9c\x97\x4b\x5c\x63\x74\x84\xb7\x73\x19\xbe\xee\x3f\xac\x8f\x9b\x60\x13\xb1\x9f\x4e\x14\x96\x5f\xf7\x1f\x96\xab\x65\xb8\x72\x31\x73\x65\x9c\x86\xe5\xab\xd0\xa1\x0a\x1d\xf3\x2c\x22\x65\x6d\xcb\xea\xf9\x78\x44\x55\x25\x70\x11\x67\xa7\x5c\x24\x1b\x96\x59\x9c\x9d\x05\xb6\x23\xdc\xaf\x52\xe8\x69\x82\x95\xb5\xff\x70\xda\x9e\x76\xa7\x90\x00\x48\x8c\x1c\x4a\x14\x7e\x2d\xf2\x38\xab\x9d\xa6\xda\x2b\x25\x55\xba\x0f\x36\xeb\xa2\x91\x4b\xd3\x68\xbf\x59\x6f\xd5\xd2\xe4\xbc\xdf\xed\x7c\xb5\xb4\x49\xf6\x9e\xef\xba\xa4\xf8\x94\x67\xb5\x73\x0a\xd3\x38\x79\x75\xaa\x30\xab\x9c\x0a\x95\xf1\x69\xef\x84\x45\x91\x20\xa7\x7a\xad\x6a\x94\xce\xff\x25\x89\xb3\xaf\xbf\x86\xc7\xbf\x90\x9f\xff\x96\x67\xf5\xfc\xe1\x2f\xe8\x9c\xa3\xd9\xff\xfc\xf7\x87\xf9\x7f\xe4\x87\xbc\xce\xe7\x0f\xff\x1d\x25\xdf\x50\x1d\x1f\xc3\xd9\x9f\xd1\x33\x7a\x98\xff\xb1\x8c\xc3\x64\xfe\xf0\xe7\xbc\xce\x67\x7f\x09\xb3\xea\x61\xde\x11\x98\x3f\xfc\x11\x13\x98\xfd\x29\x4f\xf2\x72\xf6\xaf\x69\xfe\xd7\xf8\xa1\xc3\xa9\x17\xfc\xe5\x35\x3d\xe4\xc9\x03\xc3\x26\xb6\x52\xfa\x90\xe6\x59\x5e\x15\xe1\x11\xed\xff\xf2\x6f\xbf\xe6\x59\xee\xfc\x07\x3a\x3f\x27\x61\x39\xff\x15\x65\x49\x3e\xff\x35\xcf\xc2\x63\x3e\xff\x53\x9e\x55\x79\x12\x56\xf3\x87\x5f\xe2\x03\x2a\xc3\x3a\xce\xb3\x19\x06\x7f\x98\x3f\xfc\x29\x7f\x2e\x63\x54\xce\xfe\x8c\x5e\x1e\xe6\x2d\xba\xb7\x3f\xcc\xf7\xfb\xf0\x84\x47\xd4\x7e\x7f\x40\xa7\xbc\x44\xd7\x43\xde\x38\x55\xfc\x3b\xd6\xf5\x21\x2f\x23\x54\x3a\x87\xbc\x79\xbb\xd4\x69\x72\x15\x58\xda\x77\xbd\x7e\x4a\xe2\x0c\x39\x17\x44\xb4\xef\x2d\xbc\xe0\xc9\x79\x41\x87\xaf\x71\xed\xd4\xa8\xa9\x31\x2e\xe4\x84\xd1\x5f\x9f\xab\x7a\xef\xb9\xee\xc7\xae\x36\x2c\x9c\x4b\x7c\xbe\x10\xb3\x71\x8e\xb8\xf7\xfb\xba\x0c\xb3\xaa\x08\x4b\x94\xd5\x6f\x61\x59\xc7\xc7\x04\xcd\xc3\x2a\x8e\xd0\xfc\x14\x9f\x8f\x61\x81\xbb\x84\x1f\x9f\x4b\x34\x3f\xe5\x39\x66\xfc\x82\xc2\x08\xff\x39\x97\xf9\x73\x31\xc7\x83\x7a\x9e\x85\xdf\xe6\x15\x3a\x62\xe0\x6b\x14\x57\x45\x12\xbe\xee\x0f\x49\x7e\xfc\xfa\x76\xc8\xa3\xd7\x6b\x1a\x96\xe7\x38\xdb\xbb\x4f\x62\x7f\xfe\x81\x6c\x83\xb0\x8d\xc5\xba\xf7\x4a\x94\xd2\x9f\x2f\x54\xfc\x2b\xd7\x55\xd4\x11\x3c\x51\xc9\x7e\xf0\x3d\x3f\xf0\x77\x4f\x44\x27\x61\x12\x9f\xb3\x7d\x82\x4e\xf5\xd3\x21\x3c\x7e\xc5\xa2\xcb\x22\xa6\x02\x3c\xb3\xbc\xfd\x56\x87\x87\x38\x8b\x50\xf3\xd3\x83\xe3\x3d\x7c\xd9\x9f\xf2\xe3\x73\xb5\xcf\xf2\xfa\x07\xfa\xe8\x7c\x8b\xab\xf8\x90\xa0\xc7\x6b\xfe\x5c\x63\x82\x7b\xf7\x9f\xe2\xb4\xc8\xcb\x3a\xcc\xea\xb7\x4b\x29\x5a\xd1\x31\xcf\x6a\x94\xd5\xd8\x8c\x9e\x18\x5b\xee\x53\xfe\x0d\x95\x27\x3c\x0d\x32\x44\x6f\x17\x6f\x7e\xf1\xe7\x97\xe5\xfc\xb2\x9a\x5f\x82\xf9\x65\xcd\xd4\xe4\xd4\x79\xb1\x77\x9f\xd8\x8f\x43\x5e\xd7\x79\xba\x5f\x04\x25\x4a\xdf\x0a\x1b\x08\x16\xcd\x5b\x78\x38\x94\xbf\x45\x61\x1d\x3a\x79\x19\x9f\xe3\x2c\x4c\x9c\x3a\xae\x13\xf4\x65\x4e\x6a\xe8\xf3\x95\x88\x24\x42\xc7\x9c\x0e\x99\x3d\x79\x95\xe0\x4e\xc9\x86\x0c\x41\xcc\xa2\xbc\xae\x51\xf4\xd4\x0b\x70\x7c\x2e\xab\xbc\xdc\x5f\x50\x52\x3c\xb5\xc3\x8a\x30\xea\x9a\xa8\x38\xd5\xd7\xb8\x70\xf0\xab\x25\xcb\x33\xa4\x92\x90\x6b\xdf\xc2\x28\x2a\x51\x55\x5d\x75\x21\x30\x73\xa9\x5f\x13\xb4\xcf\xf2\x32\x0d\x13\xc9\x42\xe2\xec\x82\xca\xb8\x7e\x8b\x92\x79\x9e\xcc\x9f\x93\x5e\x99\xe6\xc9\x2c\xc7\xb0\xb3\x67\x0c\x3e\x23\x8d\x66\x5d\x3b\xde\xab\xb7\xa8\xbe\x8a\x96\xb9\x71\xdd\xb7\x28\xba\x02\x7a\xe4\x44\xb0\x39\xee\xdd\x37\x32\x50\xff\xef\x73\x5e\xa3\x76\xa0\xce\xdc\x19\x21\x7d\x98\x57\x75\x99\x67\x67\x09\xf1\x21\x4f\x22\x54\xbe\x55\x69\x98\xb0\x19\x8a\x0c\x8d\xad\xfb\xf1\xad\x7a\x3e\xcc\xab\xe7\xe2\x5a\xe4\x55\x4c\xd4\x52\xa2\x24\xac\xe3\x6f\x48\x18\x42\x9b\xe0\xa3\x24\x0f\xf7\xe9\x1b\xc2\x13\x4f\x98\xb0\x51\x72\x08\x2b\x84\x01\x30\xb6\x2b\xe3\xdb\x59\xf8\x01\x4a\xdf\x30\x6e\x2c\x27\x67\x81\x7f\x85\x57\x36\x80\xd8\x8b\x56\xb5\x09\xa2\x45\x6d\xb8\x49\x33\xde\xfe\x82\xc7\x45\x87\x27\x58\x1f\x96\x66\xdb\x7a\x0b\xc9\x88\xfc\xed\x52\xa2\xd3\x97\x47\xfa\x7c\x4c\xc2\xaa\xfa\xf2\xc8\x50\x30\xe5\x82\xac\x58\x5a\x4b\x6c\x58\x71\x1c\xf3\x08\xcd\xbf\x1e\xa2\x79\x51\xa2\x79\x15\xa6\x85\xf4\x8e\x98\xf6\x65\x25\xce\x7b\x78\xf4\x97\xa8\xcf\x56\xbb\x59\x26\x7c\xae\xf3\x27\x27\xad\x1c\x5e\xc2\x06\x44\x75\x2c\xf3\x24\x39\x84\xe5\x1b\x7d\xa9\x68\x26\x17\xa7\xe7\xab\x62\x10\x69\x1c\x45\x09\xe2\xa3\x98\x0f\x2c\x6c\x20\xdf\xce\xd7\x96\xe2\x25\x8e\x22\x94\xa9\xc6\x44\xdb\xbe\xd5\xe1\x21\xc1\x6f\x5a\x82\xe1\x98\x27\x49\x58\x54\x68\xcf\x1f\xde\xd8\x8b\xee\x5a\x84\x51\x14\x67\x67\xd2\xbf\xc5\x86\x8c\x15\x5e\xc4\x07\x10\x2d\x65\x06\xc3\xbc\x39\x75\x8a\x67\xe8\x1c\xfc\x1e\xdd\xd3\x86\x6f\xf5\xe5\x2a\x80\xf1\x49\x20\x09\x0f\x28\x69\x5f\x99\x71\x46\x06\x06\x19\x90\xe0\x04\x7c\x78\xae\xeb\x3c\xe3\xfd\x28\xc3\x28\x7e\xae\xf0\x08\x26\xc5\xf4\x45\xd1\xbe\x1f\xbc\xa2\xe1\x73\x21\x2f\x0a\x8a\x66\x86\x15\x33\xe3\x13\x20\x7d\xb5\x94\xb8\x7f\xa4\x47\x0c\xd3\x3c\xce\x8a\xe7\x7a\x9e\x17\x35\x7d\xc7\x57\x28\x41\xc7\x7a\x8e\xf9\x0f\x4b\x14\xc2\xef\x73\x6e\xb6\x9d\xd1\xf0\x12\x68\xf6\x13\x09\x5d\xb5\x77\x13\xab\xa5\x74\xa9\xdc\xc8\xb0\x3d\xe5\x65\x4a\x55\xff\x5b\x99\x27\xe8\x27\x0a\xf7\xe5\xca\x26\x7b\xe2\x97\xe2\xa9\x89\xb6\x7b\xc9\xcb\xc8\x79\x29\xc3\x82\x4d\xc3\x6f\xbf\xd5\xaf\x45\xdb\x68\x4e\x7f\x95\xa8\x42\x35\xff\x51\x3d\x1f\xd2\xb8\xfe\x32\x67\x72\xe6\x62\x0a\x8b\x02\x85\x65\x98\x1d\xd1\x9e\xd6\xc8\x98\xe8\x6b\x3a\x8a\x2b\x6c\x64\xd1\xa3\x84\x18\xae\x63\x74\xd4\x4a\xa6\x47\xb9\x54\xed\x9c\x4c\x7a\xef\xa4\xf9\xef\x4c\x8f\x71\x96\xa1\x52\xa6\x6e\xaa\xe6\x0c\xe8\xf5\x8c\x07\xad\x82\x8f\x8d\xbd\x0b\x0c\x44\xa2\x46\x8a\xf9\x78\x41\xc7\xaf\x87\xbc\xf9\x32\x17\x0a\xb1\xa5\xe6\x5f\x60\x4f\xf7\xa9\x45\xfc\xd6\x1a\x98\x3c\x8d\x94\x88\x98\x13\x1f\xd9\x6f\xa7\x18\x25\x51\x85\xea\x6b\x1a\x67\xce\x4b\x1c\xd5\x97\xbd\xdb\x61\x79\x6a\xad\x93\xd2\xd8\xbb\x6f\x09\x3a\xa3\x2c\x92\x3d\xd3\x27\xda\x90\x38\xc8\x69\xd8\x38\xc2\x4f\x15\x95\xfc\xf2\x14\x26\x45\x5a\x00\x98\xf7\x93\x3c\x93\x93\x08\xd2\xa1\xf1\x04\x33\xc5\xa2\xcc\xcf\xc4\x7f\x30\xbd\xfc\xa8\xe0\xb2\xe7\xf4\x80\x4a\xac\x27\x66\x8a\x44\x17\x4e\x55\x60\xae\xe8\x20\x31\x00\xe6\xcf\xb5\x0c\x78\x65\x2c\x62\x91\x32\xec\x15\x0a\xcb\xe3\xe5\x0b\x9f\x32\x9c\xfc\x74\xaa\x50\xbd\x77\x48\xa8\xa7\xdb\x3e\x1d\x78\x62\xcb\x8e\x1c\x2d\x10\x5e\x57\xd0\xe0\x21\x08\xba\x36\xa7\x38\x41\xce\x73\x91\xe4\x61\xc4\x79\xc4\xc2\x6d\xc5\x66\x1e\x7e\xf9\x73\x8d\xe7\x0d\x68\xde\x7c\xab\x9e\x53\x1c\x7e\xb7\x95\x49\x5c\xd5\x4e\x5c\xe3\x49\x5b\x1e\x48\x35\x4a\x8b\x24\xac\x51\x0b\x49\xfb\x47\xdf\x23\x5f\xa4\x52\xc1\xd5\x5e\x5c\xbc\xf9\xe2\xe2\xcf\x17\x97\xe5\x7c\x71\x59\xcd\x17\x97\x60\xbe\xb8\xac\xe7\x46\x77\x5a\x37\x1e\xe6\x47\x05\x5a\xe8\xe0\x13\xec\x17\x4f\xf0\xaa\x7c\x3a\xf1\x63\x8a\x17\x5f\x2c\xa7\xa5\xcb\xf9\x65\x79\x15\x2d\x72\xc3\xc0\x57\xf3\xcb\xea\xaa\x9a\xea\x1b\x66\xf6\x12\x48\xe5\x3e\xab\x58\x63\x96\xe5\x40\xe7\x6d\x91\xa0\x30\xd2\xa1\xa5\x5e\x2c\x5d\xf7\x6d\xc1\x64\xe5\x88\x9c\xaf\x01\x48\xbd\xbf\xbc\xa5\xd8\xb7\x40\x97\x95\xb5\xad\x28\x81\xd5\xc8\xb6\xa2\x90\x96\xc3\xda\x5e\x4a\xd1\x0d\xf2\x04\x87\x5a\xf4\x84\xf8\xe4\xc3\x67\x4b\x02\x5b\x34\xb3\x2a\x4f\xe2\x68\x56\x9e\x0f\xe1\x0f\xee\x1c\xff\xb7\xf0\x1e\xdf\x16\xc4\x99\x9e\x03\x2e\xb5\x1a\x6c\xbe\x2d\xd2\xb0\xfc\x3a\xc7\xff\xb4\x33\xf2\xc2\xc7\xf4\xf4\xb0\xf2\x78\xda\xa2\xe5\xdb\x82\x0c\x80\xe7\x8c\x4c\xd7\x51\xeb\xe2\x50\xe7\xff\x89\x54\x0a\x33\x39\x85\xa6\x03\x6a\x0c\x2c\x19\x61\xf0\x80\xd4\xc0\xe8\x0b\x2e\x09\xab\xda\x39\x5e\xe2\x24\x7a\xe4\xf2\x24\x8b\x8f\xcc\xd5\x59\xc4\x59\x5c\xc7\x61\x12\x57\xa9\x20\x91\x9d\xfb\xf1\x49\x71\x07\x9e\x8b\x02\x95\xc7\xb0\x42\x6f\x0b\x2d\x92\x01\xc2\x32\xc9\xea\xbb\x06\x0e\x5d\xdc\x50\xde\x11\xb2\x2a\x24\xa7\x0f\x68\xdc\xae\xe8\xb0\x00\x7c\xff\xf0\xbf\x7d\xd7\x5b\xfd\x6f\xd7\xfd\xa3\xfb\xf0\xb6\x88\xd3\xb3\x73\x4a\x9e\x63\x1c\x93\x49\xef\x1b\x71\x62\x26\x50\xf5\xe5\x39\x3d\x64\x61\x9c\x08\x2a\x26\xa6\x09\xae\x1d\x70\x53\xeb\xac\xeb\x43\x84\x90\x8f\xd6\x4f\xb2\x9f\xc8\x70\xd8\x88\x33\x9f\x1c\x56\x23\xad\x74\xb0\x83\x0e\x4d\x6d\xd2\x30\x69\xa1\xb9\x67\x2d\xab\x50\x96\x24\x8e\x69\x44\xab\xdf\x2c\x82\x16\x84\xad\xf1\x76\xae\x1c\x59\x80\x74\xf0\xef\xb7\xf0\x67\xd2\x52\x7a\xd7\xbe\x7d\x3d\x44\x82\xd4\x4a\x94\xce\x16\x2b\x59\xfb\x12\x7e\x22\x3f\x4d\xaa\x6c\xe9\x46\x95\x1f\xb6\x99\xaf\x87\x68\x26\xd2\x70\x45\xbb\x72\x95\x01\x8b\x63\xf0\x42\x10\xa8\x66\x55\x22\x2b\x94\x28\x86\x9f\x29\x12\x31\x78\x15\x58\x26\x44\x1c\xdc\xa9\x58\x14\x25\x72\x68\x84\x45\xe2\x1d\xac\x6a\xa6\x91\xe5\xca\x2d\x9a\x36\x3c\x73\x5e\x59\x20\xf6\xb6\xc0\xb6\x1a\xc6\xd8\xf3\xeb\x1e\xa9\x99\x8a\x05\xc9\x59\xfc\x95\x4a\x75\x55\x2a\xfe\x6a\x92\xab\xee\x4a\xb1\x91\xed\x05\x45\xeb\xee\xd1\x49\x85\x94\x48\xa3\x9f\x78\x7c\xe2\x02\x05\xb1\xcc\x7f\x4e\x51\x14\x87\xb3\x1f\x3a\x8f\x8f\x2c\x5e\x3f\x5e\x61\xfe\xab\x54\x18\x63\x01\xee\xfa\x1b\x80\x82\xac\x74\x9b\x50\xa8\x7d\x14\x10\x6e\x7c\x03\x42\xb2\x48\x6e\x42\x68\x17\xa1\x80\x7e\xb7\x36\xa0\xa7\xab\xed\xb7\xe1\x57\x54\x24\x4c\x02\x1e\x15\xcf\xa2\xcc\x5f\x5a\x3b\xc5\x81\xfb\x29\x41\x0d\x76\xcf\x79\x19\xfe\xfd\xc4\x2b\xe8\x58\xc4\xff\x3c\x29\x3f\x25\x65\x3a\xa2\x7e\x89\x36\x49\xc9\xdb\x22\xcb\x9d\xf3\x73\x5d\xa3\xb2\x92\xe7\x7e\x57\x59\x9b\x12\x00\x7f\x5e\x1c\xf3\x64\x2e\x16\xd0\xd5\x93\x3f\xfc\x74\xcc\x13\xe7\xcb\x55\x36\x35\x57\xb6\x33\xf7\x8d\xb6\xc6\xa0\x1e\xfb\xe3\xb2\xbf\xfc\xb7\x4f\xff\xb2\x3f\x4b\xfa\x67\x45\xff\x04\xf4\xcf\x9a\xfe\xd9\xd0\x3f\x5b\xfa\x67\x47\xff\x60\x3b\xa5\x4f\x54\x13\xf8\x2f\xa7\x85\x9f\xdc\xee\x51\x28\xf5\xdb\xc7\xee\x69\xd9\x3e\xad\xda\xa7\xa0\x7d\x5a\xb7\x4f\x9b\xf6\x69\xdb\x3e\xed\xda\xa7\x8e\x1f\x6a\x0b\xf8\x2f\xe7\x07\x3f\xb9\xdd\xa3\x50\xea\xb7\x8f\xdd\xd3\xb2\x7d\x5a\xb5\x4f\x41\xfb\xb4\x6e\x9f\x36\xed\xd3\xb6\x7d\xda\xb5\x4f\x1d\x3f\xd4\x1a\xf1\x5f\xce\x0f\x7e\x72\xbb\x47\xa1\xd4\x6f\x1f\xbb\xa7\x65\xfb\xb4\x6a\x9f\x82\xf6\x69\xdd\x3e\x6d\xda\xa7\x6d\xfb\xb4\x6b\x9f\x3a\x7e\x9a\x84\xff\xe5\xfc\x34\x9d\x79\x34\x9d\x85\x34\x9d\x91\x34\xad\x9d\x34\xad\xa9\x34\xad\xb5\x34\xad\xc1\x34\xad\xcd\x34\xad\xd9\x34\xad\xe5\x34\xad\xf1\x34\xd4\x7e\x80\x85\xd3\x91\x93\x29\xb1\xf3\x6b\x3b\x4c\x8b\x12\x9d\x50\x59\xa2\x88\xbe\x48\x5c\x3a\x5a\x0f\x61\x15\x93\x0f\x82\x2d\x18\x21\xfb\x0d\xed\x3d\x0a\x70\x2e\xf3\x97\xbd\xa7\xb8\x0a\x64\x8a\xc0\x6f\xc8\xca\xf1\x7e\xfe\x43\x4b\x83\x2e\xdd\x91\x37\x9f\xf4\xcb\xd8\xd8\x57\x1b\x07\x62\xdb\x40\x6a\x1a\x48\x2d\x97\x6a\xcb\xe5\x72\xb1\x24\xff\x27\x20\x10\xca\x3a\x3c\x5d\xa1\x80\x6e\xa5\xa2\xf3\x03\x01\x0f\xfe\xd1\x21\xf0\x03\xb1\x65\xa0\xb5\x14\xbb\xe0\x4b\x5d\xf0\xa5\x2e\xac\x35\xc9\xad\x17\x6b\xfc\x7f\x1b\x51\x7e\x5d\x99\x20\xc5\xb6\xf0\xad\x9d\x6f\x64\x54\xe4\xd5\x29\xff\xa2\x4d\xd9\x3b\x55\xd6\x07\x99\xf3\x64\x04\x5b\x5d\x9a\x5b\x40\x98\xdb\x4e\x96\x64\xc2\x9c\xa8\x43\xcb\x71\xca\x20\xb3\xf3\x04\xe6\x40\xa6\x77\x19\xcf\xca\xd3\xbb\x20\x94\x75\x78\xba\x42\x8a\x67\x3d\xce\xb0\xc9\xbb\x44\x69\x02\xe8\x20\x80\x94\x10\x28\x5a\xd8\xca\x78\xd6\x80\x16\xd6\x90\x16\xd6\x8a\x16\x76\x32\x9e\x8d\xa8\x85\x8d\xa4\x85\x0d\xd7\x82\xe7\x2a\x66\x04\xa8\x61\x0b\xa9\x61\xab\xa8\xc1\x53\xec\x71\x07\xe8\x61\x07\xe9\x61\xa7\xe8\xc1\x53\x6d\xb2\x7f\x7a\xa2\xae\xfe\x29\x2e\xab\xba\x9b\x3e\x69\x60\xe5\x78\x4f\xfc\x81\xc3\xe1\xe0\x55\x05\xf3\x96\x4f\xfc\x81\x83\xb9\x2a\x8c\xfb\xc4\xd7\x24\x19\x84\xa7\x61\xe1\x48\x38\x84\xaf\x42\xf8\x0c\xc2\xe7\x10\x4b\x15\x82\x33\xd2\xf2\xb1\x52\x21\x56\x0c\x62\xc5\x21\x02\x15\x22\x60\x10\x01\x87\x58\xab\x10\x6b\x06\xb1\xe6\x10\x1b\x15\x62\xc3\x20\x36\x1c\x62\xab\x42\x6c\x19\xc4\x96\x43\xec\x54\x88\x1d\x83\xd8\xb5\x12\xd3\x84\xea\x71\xa9\x7a\x9d\x58\x75\xb9\xb6\x82\x6d\x25\xeb\x69\xa2\xf5\xb8\x6c\x3d\x2c\x5c\xb2\x1a\xea\x78\x57\xd1\x25\x15\x46\x1d\xab\xf7\xa5\x7a\x71\x52\x63\x00\x4b\x09\x80\xcc\x5d\xac\x66\x25\xd5\x88\x93\x12\x03\x08\x24\x00\x71\xb6\x61\x00\x6b\x09\x80\x4c\x2a\xac\x66\x23\xd7\xe8\x7c\x6f\x25\x80\xb5\xce\xf7\x4e\x02\xd8\x08\x7c\x7b\xae\x2c\x13\x9d\x71\x4f\x96\x9a\x30\x3e\x6d\x91\x1c\xf6\xcb\xde\xdd\x77\xc1\x5e\xe5\x3d\xee\x0b\x76\x45\x6f\xf6\x60\xb0\xf7\x3a\xad\x13\x83\xbd\xe0\x9b\xfd\x18\xec\x38\xdf\xec\xca\x60\x5f\x7b\x32\x6f\x86\x39\xe4\xf7\x3a\x34\x58\xb9\x53\xf8\x34\x58\xc9\xd3\xf5\xec\x06\xcf\x06\xab\x75\x22\xe7\x06\x2b\x79\x22\xff\x06\xab\x7c\xbc\x8b\x83\x43\xb1\x89\xbc\x1c\x1c\xcb\x4d\xe4\xe8\xe0\x60\x70\xbc\xaf\x43\x22\xd5\x89\xdc\x1d\x12\xea\x4e\xe4\xf1\x90\x58\xf9\x46\xa7\xa7\x4a\x07\xfb\x3d\x55\x3a\xd4\xf5\xa9\xd2\x01\xde\x8f\x34\x5c\x4d\x0e\x90\x34\x16\x4d\x3e\x90\x34\xca\x4c\x6e\x90\x34\xa8\x4c\x9e\x90\x34\x5c\x4c\xce\x90\x34\x10\x4c\xfe\x90\x64\xf7\x26\x97\x48\xb2\x68\x93\x57\x24\xd9\xaa\xc9\x31\x92\x4d\xd3\xec\x1b\xc9\x66\x67\x76\x8f\x64\x93\xb2\x78\x48\x44\xd5\xca\xba\x5d\x57\xd5\xe7\x3f\x11\xf5\xf6\xb8\x50\x44\xbb\x26\x2f\x8a\x68\xb5\xc7\x91\x22\x4a\xed\xf1\xa5\x88\x4e\x4d\xee\x14\xd1\x65\x8f\x47\x45\x54\xd9\xe3\x54\x11\x4d\x9a\xfc\x2a\xaa\xc1\x1e\xd7\x8a\xaa\xcf\xe0\x5d\x59\x57\xb9\x13\x27\x8d\xde\xdd\xbd\x4a\xa3\xfb\xdc\xab\x34\xba\xc3\xbd\x4a\xa3\xa9\xdd\xab\x34\xba\xc3\xbd\x4a\xa3\x3b\xdc\xab\x34\x9a\xd0\xbd\x62\xeb\xaf\xf7\xba\x57\x58\xb9\x53\xb8\x57\x58\xc9\xd3\xf5\xec\x06\xf7\x0a\xab\x75\x22\xf7\x0a\x2b\x79\x22\xf7\x0a\xab\x7c\xbc\x7b\x95\x46\x93\xb9\x57\x69\x34\x99\x7b\x95\x46\xb7\xb8\x57\xe4\xc3\xc4\x44\xee\x15\xf9\xb2\x31\x91\x7b\x45\x3e\x8d\xdc\xe8\x5e\xa5\xd1\x60\xf7\x2a\x8d\x86\xba\x57\x69\x34\xc0\xbd\x92\x86\xab\xc9\xbd\x92\xc6\xa2\xc9\xbd\x92\x46\x99\xc9\xbd\x92\x06\x95\xc9\xbd\x92\x86\x8b\xc9\xbd\x92\x06\x82\xc9\xbd\x92\xec\xde\xe4\x5e\x49\x16\x6d\x72\xaf\x24\x5b\x35\xb9\x57\xb2\x69\x9a\xdd\x2b\xd9\xec\xcc\xee\x95\x6c\x52\x16\xf7\x8a\xa8\x1a\x76\xaf\x88\x82\xed\xee\x15\x51\x6f\x8f\x7b\x45\xb4\x6b\x72\xaf\x88\x56\x7b\xdc\x2b\xa2\xd4\x1e\xf7\x8a\xe8\xd4\xe4\x5e\x11\x5d\xf6\xb8\x57\x44\x95\x3d\xee\x15\xd1\xa4\xc9\xbd\xa2\x1a\xec\x71\xaf\xa8\xfa\x86\xbb\x57\xdd\x37\xff\xc4\x49\xce\xef\xee\x5e\x25\xe7\xfb\xdc\xab\xe4\x7c\x87\x7b\x95\x9c\xa7\x76\xaf\x92\xf3\x1d\xee\x55\x72\xbe\xc3\xbd\x4a\xce\x13\xba\x57\xec\x73\xfb\xbd\xee\x15\x56\xee\x14\xee\x15\x56\xf2\x74\x3d\xbb\xc1\xbd\xc2\x6a\x9d\xc8\xbd\xc2\x4a\x9e\xc8\xbd\xc2\x2a\x1f\xef\x5e\x25\xe7\xc9\xdc\xab\xe4\x3c\x99\x7b\x95\x9c\x6f\x71\xaf\xc8\x3e\x94\x89\xdc\x2b\xb2\x91\x65\x22\xf7\x8a\xec\x84\xb9\xd1\xbd\x4a\xce\xc3\xbf\xda\x9d\x87\xba\x57\xc9\x79\x80\x7b\x25\x0d\x57\x93\x7b\x25\x8d\x45\x93\x7b\x25\x8d\x32\x93\x7b\x25\x0d\x2a\x93\x7b\x25\x0d\x17\x93\x7b\x25\x0d\x04\x93\x7b\x25\xd9\xbd\xc9\xbd\x92\x2c\xda\xe4\x5e\x49\xb6\x6a\x72\xaf\x64\xd3\x34\xbb\x57\xb2\xd9\x99\xdd\x2b\xd9\xa4\x2c\xee\x15\x51\x35\xec\x5e\x11\x05\xdb\xdd\x2b\xa2\xde\x1e\xf7\x8a\x68\xd7\xe4\x5e\x11\xad\xf6\xb8\x57\x44\xa9\x3d\xee\x15\xd1\xa9\xc9\xbd\x22\xba\xec\x71\xaf\x88\x2a\x7b\xdc\x2b\xa2\x49\x93\x7b\x45\x35\xd8\xe3\x5e\x51\xf5\x0d\x77\xaf\x84\x3d\x8f\x89\xd3\xbc\xff\xce\xa6\x26\xb9\xcf\xbf\x6a\x92\x3b\xfc\xab\x26\x99\xda\xbf\x6a\x92\x3b\xfc\xab\x26\xb9\xc3\xbf\x6a\x92\x09\xfd\xab\x66\x9a\xed\x4e\xcd\x44\x3b\x9e\x9a\xe9\x36\x3d\x35\x37\xed\x7b\x6a\xa6\xdb\xfa\xd4\x4c\xb7\xfb\xa9\xb9\x69\x03\x54\x33\xdd\x1e\xa8\x66\xba\x6d\x50\xcd\x4d\x3b\xa1\x9a\x09\x37\x43\x35\x13\xee\x87\x6a\xee\xd8\x12\xd5\x24\x83\xfd\xab\x26\x19\xea\x5f\x35\xc9\x00\xff\x4a\x1a\xae\x26\xff\x4a\x1a\x8b\x26\xff\x4a\x1a\x65\x26\xff\x4a\x1a\x54\x26\xff\x4a\x1a\x2e\x26\xff\x4a\x1a\x08\x26\xff\x4a\xb2\x7b\x93\x7f\x25\x59\xb4\xc9\xbf\x92\x6c\xd5\xe4\x5f\xc9\xa6\x69\xf6\xaf\x64\xb3\x33\xfb\x57\xb2\x49\x59\xfc\x2b\xa2\x6a\xd8\xbf\x22\x0a\xb6\xfb\x57\x44\xbd\x3d\xfe\x15\xd1\xae\xc9\xbf\x22\x5a\xed\xf1\xaf\x88\x52\x7b\xfc\x2b\xa2\x53\x93\x7f\x45\x74\xd9\xe3\x5f\x11\x55\xf6\xf8\x57\x44\x93\x26\xff\x8a\x6a\xb0\xc7\xbf\xa2\xea\x33\xf9\x57\x0b\x9a\x07\x41\x3a\xfa\xac\x9d\x93\x93\x8f\x23\xd1\x26\xb3\x3a\x9a\xf3\xa7\x4b\x77\xbe\x8a\x26\x43\x50\x8e\x30\xd7\x79\x01\x1f\x7a\x64\xc7\xd2\x5a\x94\x17\x14\x46\x18\x9d\x7a\x04\x9a\xf0\xa2\x24\x6d\xf1\x8d\x58\x0e\x79\xf4\xfa\x23\xf9\xf7\x2a\x50\x35\xc1\x3b\x55\xda\xf5\x85\xfc\x10\xba\xb3\x24\x87\x02\x69\x15\xc5\x85\xa2\xab\xe9\x74\x9d\x0a\x28\xa0\xed\x8a\x2e\x23\x9a\x53\x71\x40\x48\x98\x9c\x24\x89\x70\x97\xa0\x68\x64\x4c\x09\xaa\x2a\x51\x28\x73\xa0\x36\x82\x0a\x2f\x60\xa1\x44\x1a\x0f\x5d\x26\xb8\xba\x8c\x0b\xcc\x1b\x26\x31\xab\xcb\x7d\x56\x5f\x9c\xfc\xe4\xd4\xaf\x05\xfa\x21\x8f\xa2\xc7\xab\x76\xa8\x4e\x3c\xf2\xea\x06\x8f\x1c\x13\xc9\x7c\xd2\xe1\x91\xf2\xb1\xf0\x63\x78\x56\x54\x9b\x0e\x17\xcb\xbc\x36\x97\x7f\xfe\xdc\xf5\xb7\x2d\xb9\xe8\xfc\x7d\x38\x6c\xa3\xf0\x74\x52\x70\x41\xa2\x6c\xab\x54\xbc\x82\x10\xbb\x12\x59\x79\x8c\xd6\x26\x3c\x08\xb4\xa8\x0c\xe4\x96\x4c\x12\x3a\x9b\xbb\xd3\x31\x1a\xd2\x54\xe8\xb6\x0d\x08\x92\x84\x4c\xa2\xcd\x5e\x37\x57\x0b\x04\x12\x42\x19\x84\x31\x5a\x47\xdb\xe8\xa0\x61\x84\xa4\x2b\x54\xea\xd8\x05\x09\x8b\x65\xa0\x8c\x0f\xcb\xc3\xe6\x70\x00\x05\xd5\x25\xe4\x33\x49\xf9\xb8\x3d\x1e\x8e\xb0\x94\x95\xc6\x26\x39\x6b\x60\x90\x5c\x64\x32\x2c\x27\xe0\x5c\xfe\x29\x4a\x99\x97\x80\xb8\x96\x68\x7d\x3c\x28\xb8\x40\x09\xf3\x2a\x15\xaf\x28\xdd\xb6\x04\x94\xed\xf6\x14\x79\x3b\x04\x8b\x87\x25\x36\x34\x49\xf6\xe0\x45\x27\x93\x5a\xc4\xa6\x46\xb9\xca\x40\xe0\x48\x96\x48\xc4\xd9\x29\x9f\x0b\xcf\x02\x62\xfa\x13\x44\x81\x50\x80\x24\x14\x90\x24\x69\x79\x24\xff\xbc\x28\x3f\x61\x01\xae\x8f\xa7\x28\x04\xa5\x40\xf2\x40\x9a\xa4\x17\x1e\xa2\x08\x05\x3d\xed\x4c\xa2\x13\x21\xa0\x4e\xcb\xc8\x59\x02\xca\xb9\xfc\x53\xc0\xdd\x96\x40\xb8\x4e\x27\x84\x0e\xa1\x82\x0b\x92\x61\x5b\xa5\xe2\x15\x24\xd9\x95\x80\xc2\x3c\x9d\xa2\xd3\x06\xb6\x46\x9e\x45\xd3\x24\xcf\xd3\x09\x6d\x43\xaf\xbf\xa9\x49\xa4\x0a\x90\x41\x12\x02\x09\x9a\xc4\x73\x2e\xfd\x12\x90\xf3\x02\x10\x51\x70\x14\x06\x38\x85\x84\x24\xca\x6b\x22\xb5\xe0\xa2\x15\x80\xe2\x44\xd1\x6e\x6d\x18\xdc\x2c\x05\xa9\x51\x9a\xde\xc1\x3d\x6c\x7a\x5b\x9a\x84\x29\xc3\x80\x22\x90\x08\x90\x44\x96\x73\xf1\x87\x80\x99\xfd\x06\xb1\x44\xa7\xe8\x84\x24\x2c\x90\x1c\x59\x45\xa4\xfc\xbe\xa8\xbf\x61\x9b\x3c\x9c\x8e\xa7\x23\x28\x0a\x9a\xb6\xd5\x24\x43\x74\x44\xc7\xd3\xba\xaf\xa1\x49\x84\x12\x08\xd4\x77\x19\x7d\x14\x96\x5f\xe7\xc2\xb3\x64\x89\xf8\x27\xf8\xa2\x59\x1f\xb7\xc7\x50\x44\x01\x5b\x21\x2e\x8f\xe4\x9f\x17\xe5\x27\x28\xba\x5d\xb0\xdb\xed\x60\xd1\x91\x0c\xb7\xc6\x37\xcb\xee\x70\x38\x98\xec\x96\xb7\x33\xdb\x5e\x07\x01\xbe\x10\x24\xe4\xe1\xb1\x8e\xbf\xa1\xb9\xf4\x4b\xc0\xcc\x0b\x00\x44\x26\xdf\x55\x62\x86\xb6\x37\x75\x74\x34\x0a\x53\x9f\x65\x98\xe1\xcc\xce\x16\x44\x73\x5c\x89\x57\x6b\x2e\x0d\x96\x8e\x58\x56\xf1\x2a\x58\x45\x41\xa0\xa0\xe3\xe3\x89\xe3\x5b\xed\x02\x37\xd8\x00\x28\xd1\x0e\x1d\xd1\x49\x41\x29\x87\x55\x98\xb5\x21\x7c\xbd\xdd\x6d\xad\x52\x57\x08\xa4\x12\xc0\x69\xc1\x93\x00\x73\x6b\x1c\xe5\x07\xc1\x9c\xff\x4f\x8c\xa6\x04\xd4\xb6\xc0\x0a\x94\x08\x80\x18\xab\xbc\xfd\x26\xd3\x2d\xbb\x6e\x82\xc5\x8e\x6e\x2a\xa6\xa4\x4a\x54\x15\x79\x56\xc5\xdf\x70\x44\x6d\x4e\xb3\xd6\x66\x1f\x69\x58\x7a\x48\x9e\xaa\xac\x4d\x11\x49\x72\x92\xe0\xd7\x69\x9d\x3f\x1f\x2f\x6f\x10\xfa\x9f\x8d\xd2\x05\x38\xdd\xac\x37\x46\x4e\x53\x4b\x42\xb8\x09\x38\x4d\xa3\x51\x9c\xee\x76\x9e\x91\xd3\xe4\xfc\xae\x9c\x26\xe7\x51\x9c\x7a\xde\x6e\x67\x64\xb5\x49\xde\x95\xd5\x26\xb1\xb0\xaa\x81\xbf\x27\x2b\x66\x3e\x16\xa7\xbc\x4c\x9d\x63\x9e\xd5\x65\x6e\x91\x06\xcb\xcb\x73\x0c\x93\xe3\x0f\xde\x22\x40\xe9\xec\xc7\x19\x5d\x4f\x9b\xfd\x38\xf3\x8b\xe6\xf1\xa9\x5b\x96\xa2\xc5\x6c\xb5\xed\xb6\x64\xd4\xc6\xb9\x54\x9d\x0a\x92\xb8\xd8\x77\x39\x4f\x1b\x20\xb9\xd4\x11\x45\xab\x28\x84\x93\x4b\x91\xb4\x5c\x34\x83\x85\x38\x4b\xce\x16\x5e\x50\xcd\x50\x58\xe1\x18\xc4\xc9\x9f\xeb\x39\x49\x05\x79\x09\xa3\xfc\x45\xab\x6b\x6d\x8e\x7e\xe2\xad\x9c\x12\x45\xcf\x47\x14\x39\x69\xce\x52\x63\xe0\x9f\x8f\x57\x59\xd0\x02\x65\x92\x9c\x4c\xd6\xc3\x9e\xa4\xb4\x41\x4d\x11\x66\x91\x3e\xa5\x0a\x29\x81\x9f\x60\x3d\x0a\xe9\x30\xcb\x38\x3b\x5f\xf5\x76\x34\xf5\x3a\xe9\x11\xf9\xa2\xe2\xce\x98\xcc\x15\x44\x34\x49\xeb\x20\xad\xc8\x01\xa3\x7b\x88\x4e\xa7\x36\x95\x2b\x7e\xa1\x36\x32\x3d\x9a\xed\x8a\xbd\xb2\x3d\x7f\x49\xe7\x70\x1f\xbf\x1c\x14\x51\xb4\xb9\x24\x8b\xe7\xda\x29\x92\xf0\x88\x2e\x24\xa7\xf3\x55\x4e\x6b\x9b\x17\xe1\x31\xae\x5f\x49\x36\x2f\x45\x96\xf9\xef\x37\xb4\xc3\x2a\xb8\x83\xe6\x1d\x8d\xc7\x37\xe1\xe9\x57\xe7\x52\xf1\x6f\x25\x0a\xa3\x3c\x4b\x5e\xbf\x40\x9e\x35\xf5\x4a\x3a\x8c\x42\x02\xd4\x28\xac\xd1\x17\x09\xd5\x5c\xa9\xad\xe3\x14\x39\x49\x7e\x0c\x13\x33\x5c\x9a\x67\xf5\xc5\x5c\x8d\x51\xc8\xb5\xa6\x24\x9c\x4f\x44\x83\x6a\xa1\x9a\xa9\x93\xa6\xd1\x05\xcc\x97\x6a\xe3\x5b\x98\x3c\xa3\x21\x96\x2c\x8b\x96\xa4\xfd\x94\xc5\xea\x90\x1b\x3b\x8c\x73\x25\xfd\x86\x49\x1a\xd0\x7c\xc9\x62\x9a\x66\x32\x81\xf2\x29\xf2\xc7\x99\x27\xcc\x9c\xfc\xdb\x01\x04\xa2\x24\x4f\xef\xc9\x5a\xec\x2d\x02\x95\x09\xfc\x56\xd6\xf9\xe8\xe5\x42\x04\xd0\x13\x6b\xf6\xd1\xac\x52\x80\xa6\xdf\x4b\xd4\x87\xa9\x2e\xb6\x1b\x98\xaa\xa4\x9b\x22\x09\xe3\x0c\xcf\x6e\xe6\x77\x99\xfa\xa6\x52\x13\xe7\xba\xea\x4b\xcb\x7e\x63\xc2\x90\xf9\x99\xbe\x8b\xf4\x0a\xee\xa4\x14\xcd\xcc\x35\x75\x44\x2e\x4e\xce\xf3\x41\x70\x82\xec\x4d\x29\xc6\x54\x78\xf0\x1d\x0f\xbe\xe2\xa9\x86\xd4\x04\xc3\x06\x05\x81\x79\x09\xd5\x4e\x81\xc4\x3d\x88\x36\x21\x0d\x67\xc8\xec\xa3\x4c\x3e\x98\x01\xd3\xc4\x6f\xe9\x73\x52\xc7\x45\x82\xbe\xcc\xa1\x5a\x4c\xe2\x8b\x94\x8f\x98\x67\x7e\x96\x67\x2f\x39\x37\x25\xae\x21\xf9\xc8\x81\xe4\x9e\xac\x1a\x30\x53\x21\x55\x2b\xcf\xfa\x49\x40\xdf\x25\xdd\x9d\x96\xed\x8e\x64\xe4\xe2\xf4\x58\x06\xbb\xf6\xa7\x2d\x7f\x9d\x96\xdc\xab\xc3\x44\x32\x6c\x03\x99\xc2\xe4\x7e\xcb\x99\xc1\xa4\xae\x13\x04\xf4\x65\xda\xa1\x09\x0f\x55\x9e\x3c\xd7\x48\x12\xd8\x52\xbd\x2d\xc2\x31\x62\x6a\xdf\x98\xff\x4f\xac\x22\xd3\xd6\x5c\x03\xfe\x8d\x03\x7f\xd1\xa1\xaf\x4a\x9a\x55\xad\x5e\xbd\xf7\x42\xc6\x4e\x12\xd7\x8a\x8a\x65\x49\x4c\x55\xfd\x0a\xc5\x9d\x9a\xe9\x07\xe8\x23\xca\x6a\x54\x3e\x91\x1f\x24\x61\x6d\xc5\x8b\x94\x84\xb8\x72\xda\xda\x0d\x20\x18\x72\x23\x89\x45\xea\x55\x1d\xd6\xf1\xf1\x09\xba\x56\x81\x61\x5d\x7a\x3e\x70\x67\xc7\xe2\x5b\x98\xc4\x91\x73\x42\x28\xc2\xb3\xa5\x94\xac\xfa\x49\xff\xbc\xdf\x59\x3f\x9c\xd2\x96\xde\x41\xc5\xb1\xd6\x79\x8e\x47\x2f\x60\x1b\xe4\x7b\x3e\xc6\xcb\x04\xf0\xbb\x43\xee\xac\xd9\x07\x4f\x12\x03\x86\x7c\xea\xd2\x2c\x27\x72\xe6\x0d\x9b\xf6\x7a\x17\x2f\x56\xee\xdc\x5b\x6f\xe6\xeb\xdd\x7c\xb1\x7b\x04\x03\x94\xb7\x45\x4c\x1c\x97\x38\xfa\x7f\x8a\x08\xe7\x5a\x0d\x13\xc3\x7c\xf1\x12\xb2\x9a\xb0\x46\xd1\x6c\x6f\x68\x6e\x83\xe2\x12\x95\xef\x46\x92\x26\xbb\x96\xbc\x8a\x49\xf6\xbf\x48\xb9\xb2\x02\xc5\x6e\x10\x93\x27\x10\x20\xb0\x7c\x14\xc5\x16\xa7\xe1\x19\xed\x9f\xcb\xe4\x87\x87\x28\xac\xc3\x3d\xf9\xfd\xb9\xfa\x76\xfe\xb1\x49\x93\xf9\xc7\xe5\xb1\xfa\x76\x9e\x35\x69\x92\x55\x3f\x7d\xba\xd4\x75\xb1\xff\xfc\xf9\xe5\xe5\x65\xf1\xb2\x5c\xe4\xe5\xf9\xb3\xef\xba\x2e\x06\xfe\x34\x23\x7a\xfe\xe9\xd3\xf6\xd3\x8c\x6a\x8a\x3c\x7e\x8b\xd1\xcb\xbf\xe4\xcd\x4f\x9f\xc8\x6e\xb7\xd9\xf6\xd3\xc7\x25\xfa\xb8\x3c\x16\x61\x7d\x99\x9d\xe2\x24\xf9\xe9\xd3\x47\x7f\x49\xd9\xfe\x34\x8b\x7e\xfa\xf4\xab\xbf\x58\xce\xd6\x8b\xcd\xf2\x97\xc5\x7a\xb6\x5a\x04\xcb\xa3\xb3\x58\x39\xde\xc2\x5d\x2d\x56\x6b\xc7\x5b\xac\x66\xde\xc2\x73\x16\xdb\xc4\x5b\x78\x33\xfc\x73\xb9\x58\x39\xcb\xc5\xf6\xb8\x58\x3b\x8b\xf5\x72\xe6\xe1\xbf\xfe\x66\xe6\x2d\xfc\xc5\x26\x71\x56\xb3\xd5\x62\x8d\x51\x2c\x17\x81\xb3\xd8\x12\x54\xde\xc2\xfb\xfd\xd3\x67\xca\x07\xe6\xfc\xe3\x12\x3d\x48\x02\x29\x51\x81\xc2\x7a\x9f\xe5\xec\x49\xac\xeb\x26\x5b\xb2\x44\xd9\x3a\x94\x54\xb8\x1e\xb5\xd7\xc7\x19\x9b\x25\x84\x86\xc4\x9e\x29\x38\x87\x5e\x72\x60\xa8\xd4\x60\x13\xd4\xed\x1e\x60\x19\x2c\xbc\x04\xed\xc3\x16\x30\x0a\x23\x87\x04\x8c\x32\x21\xf0\xed\x4c\x09\xce\xc1\xba\x96\xf1\xeb\x38\xa3\x6c\xe5\x5c\xe7\x85\x51\xca\x56\x1d\xbc\x2d\x8e\xcf\x55\x9d\xa7\x0e\xf3\x3b\x8c\xa3\x4a\x02\x1b\x39\xac\xb8\xd6\x7c\x3e\x3b\x8b\x9d\x98\x6e\x4c\xad\xba\x31\x15\x28\x63\x6a\x35\x0b\xc0\x31\x45\x17\xb5\xd9\x98\x9a\xb9\xbf\xb8\x33\xff\xb2\xfa\x3d\x75\x67\xc1\x2f\xee\x6c\x79\x59\xe9\x43\x60\xd6\x1a\x3c\x13\x2c\x5b\x84\xa2\xb6\xfc\x79\x5b\x34\x33\xcf\x2d\x9a\xf9\xff\xcf\x67\x8a\x19\x7e\xed\x08\xb2\xa2\xe2\x61\x22\x63\xd7\x2e\x7c\x1e\x35\xd0\x61\x3b\x35\x8c\x74\xc0\x5a\xa7\x1a\xea\xaa\x73\x22\xbc\x07\x75\x47\x0e\x9c\x80\x04\x27\xd0\xd0\xf0\xaa\xf8\x19\x16\x9a\xea\x6b\xb5\x17\xd4\xf0\x9a\x36\xf2\x66\x7f\x6f\xf7\x34\x33\xbc\xc8\x99\x7a\x78\xf4\xa5\x32\xaa\x54\xc3\xb2\x84\x70\xec\x2d\x08\x54\x99\xde\xc0\x03\xbf\xb0\xe0\x6e\x5e\x84\xbb\x2c\x75\x6b\xec\x61\x6d\x4f\x84\x8d\xa6\x63\x71\x18\x42\x85\xd5\xe5\xea\x88\xc0\xf5\x2b\x5a\xd1\xd7\x07\x32\x16\xa7\xeb\xc1\x00\x74\xd7\x31\x63\x7c\x00\xf3\xf4\x52\x0e\x26\xba\xc7\x89\xbb\x32\x06\xb9\xdd\x86\xc8\x9d\x41\x06\xdb\x26\x75\xd6\xc1\xd5\xb5\xde\x9b\x9a\x8e\x26\xaf\x28\x6b\x3c\x13\x46\x04\xf7\xcf\xed\x71\x36\x6d\x9c\x48\x6f\x1e\xee\xf0\xfe\xc3\x46\x8a\xbe\xef\xce\x83\x65\x6f\xa4\xc8\xfa\xf9\xff\x34\x41\xce\xc1\x5a\x53\xc4\x68\x41\xd3\x07\x39\x30\x72\x64\xe0\xf6\x08\x81\x01\x29\x66\xc5\x6e\x93\xfe\x9b\x46\x8f\x9e\xdf\x39\x85\xf8\x99\x7a\x7e\xd8\x42\x3e\xcd\xaa\xba\xcc\xbf\x22\xe2\x07\x52\x56\x15\xa7\xd1\xf3\x67\x9e\xcf\xdc\xc6\x63\x5c\x1e\x13\x34\x3b\x36\x3f\x7d\x5a\x7f\x9a\x1d\x5f\xc9\x9f\xf2\xa7\x4f\xab\x45\xc0\x3d\x3a\xe2\x59\x52\x9c\x0e\x36\x9b\xbf\xe6\x71\xf6\xd3\x27\xd2\x2b\xea\x60\x06\x8b\xed\x6c\xb9\x58\x5f\x16\xab\x5f\xd6\xb3\xf5\x22\x68\x7d\x41\x1d\xf9\x76\xe1\x13\xf4\x8b\xf5\xa7\xce\x59\xe5\x4c\x72\xbe\x49\x2f\xfe\x01\x03\x4f\x66\x2d\x43\x42\x4f\x09\x14\x36\x2f\xdb\xac\x25\x0c\xc6\xc1\xc1\x27\x37\x78\x63\xf8\xc9\x8d\xfd\xef\x20\x00\x35\x0d\x4e\xd9\xa9\x1f\x3b\x3a\xff\x2b\x08\xfd\xaf\x09\x67\xf8\x84\xf3\x1d\xe2\x57\xfb\x84\x01\x1a\xfb\x54\x33\x06\x14\x24\xb6\xaf\xd2\xf1\x51\xac\xb1\xe9\x55\xf1\x83\xac\x74\xf5\x97\xfd\x20\xf0\xc1\xf1\xec\x60\xaf\x62\x40\xd3\x91\x71\x6d\xdb\xfe\xf6\xc8\xd6\x8a\x42\x95\xf3\x4d\x7c\x8c\x8b\x56\x06\xa1\x02\x2d\xb5\x97\xbd\x29\x23\xdc\x51\x28\xd5\x7d\xfd\xab\xb5\xbb\x8e\xa0\xfd\xb4\xa4\xa2\xbf\x1f\x93\x45\xb9\x23\x10\x5a\xe3\x5c\x75\x1e\x18\xd4\x81\xe9\x23\xdd\x9b\xd1\xdb\xed\x49\x0e\x36\x55\x03\x1d\x15\x68\x9a\x1b\xdf\xc0\xc2\x3d\x11\x6f\x1f\x8a\x89\xde\x05\xc0\x77\x60\xfb\x07\x7e\x72\xdb\x74\x99\xbf\xcc\xba\x8f\xfc\x72\xd1\xd0\x6f\xc4\x12\x03\xe2\xd4\x2b\x9c\xba\xb6\x5c\x82\x21\x36\x96\x2f\x87\xef\xef\x43\xdf\xd7\xeb\x2e\x29\x4e\x78\xfc\xca\x0b\xff\xfa\x5c\xd5\xf1\xe9\xd5\xe1\x77\x96\xb2\x62\xf8\x23\xbb\xd4\x2b\xba\x17\x63\x30\x7b\xa6\x9c\x2d\xd3\xab\x60\x08\xf3\xea\xfe\x5c\xe9\xea\x7d\x21\x8d\x8c\x72\x36\x3d\x8d\xa3\x28\x41\x16\x84\xc0\x76\x29\xe5\x16\x55\xb1\xa5\xe4\x14\xcd\xe5\x3a\xba\xd9\x91\x0a\xb9\x63\xc8\x6c\x5e\x7f\x1b\x3b\x11\x44\x05\xee\x86\xea\xdf\x02\xd1\xee\x5f\x69\xc9\x66\xe8\x4c\x4a\x78\x52\xa7\xea\x52\xc6\xd9\xd7\x6e\x67\x04\xb4\x4d\x02\xdc\x24\x01\xc9\xba\xdd\x19\xf9\x0e\xd2\xb0\x52\x34\xec\x5e\x79\x5b\x1c\xea\x0c\x36\x43\x75\x6b\xb7\xbc\x4b\x8e\x6c\x38\x96\x98\x07\x6d\xb5\xdd\xdb\xfe\x5c\xa1\x92\xbb\xdf\xdd\x1e\x50\xa0\xb4\xd2\x0b\xb5\x82\x21\x5b\xf4\xba\x2d\xe3\x62\xe5\xb0\x5d\xed\x7d\x3b\xde\xd4\xcd\xe6\xa6\x5d\xe6\x0a\x9b\xd0\x3e\xf4\xf7\xde\xa5\x8e\xd5\xab\x6f\x4e\x3f\xd4\x19\x98\xeb\x80\x68\xb5\xbb\x45\x9f\x5d\xb4\x7d\xa8\xb3\x05\x0b\xab\x70\x43\x1a\x35\xdd\xb6\x15\x1c\xa3\xea\x36\x37\x63\x6c\xfc\xd7\x95\xef\x5c\x5e\xac\x03\xca\x20\xf1\x64\x78\xf5\x23\xf9\xd9\xb6\x7d\xbc\x2a\xd7\xe9\x87\x32\xea\x53\x8c\x92\xa8\x42\xdd\xbe\xb0\x19\x01\xb8\x32\x70\x07\x7d\x43\x59\x5d\x75\xfd\xe3\x79\x09\xec\x87\xb6\x5c\x77\x73\xd0\xb6\xc6\xd3\x42\x09\xcb\x80\xe3\x4e\x1f\x5c\x77\xbd\x8b\x76\x1a\xae\xb5\x7f\x3c\x4a\xb8\x04\xc9\xb7\xe8\xa5\x5d\xfc\xa3\xd1\x5b\x15\xb6\xdc\xce\xbd\x15\xd3\x18\x53\x58\xcb\x89\xa4\xb8\x96\x99\x56\x81\xd3\x88\xce\xaa\xf5\x05\x3f\xf9\x38\xb8\xc5\x9e\xb7\xa8\x2e\xf9\xcb\xcf\x72\x77\xca\xbc\x88\xf2\x17\x3c\xa3\x9f\xcf\x09\xea\x95\x27\x15\x9d\xcc\x7f\x70\x3c\xdc\xc0\xff\x1e\xd0\xe9\x90\x5e\xf0\x76\xbd\x7d\x69\x57\x36\xc6\x29\xba\x4d\x1a\x61\x97\x05\x3b\xbc\x20\xcb\xa2\xbd\x97\x5e\xc4\x33\x64\x20\x04\xe1\xda\x5f\x6f\x15\x6c\xc1\x2a\x38\xac\x7d\x05\x9b\x38\x14\x3a\x12\x03\x06\x83\x85\x84\x75\x30\x78\x4b\x77\xee\x11\x41\x41\x42\x52\xc6\x43\xc7\xd1\xb0\x11\x31\x42\x8a\xc3\xc7\xc4\xc0\x36\xc0\xa8\x10\xba\x35\x66\x5c\xb4\x52\x94\x0e\xa5\xa2\x20\x08\x0e\xb7\xf4\x62\x0f\xaa\xf8\xb6\xb1\x61\xec\xd1\x80\xd1\x01\x6b\x9e\xe6\xfe\xb0\x0b\xa4\xfd\x4e\x0a\x7d\xc7\x15\xb0\x0c\x19\x1a\xbe\xb7\xdd\x2e\x55\xbb\xf5\xd0\x06\x2d\x57\x12\x2e\x69\x60\x30\xf4\x03\x86\x85\x05\xbd\x75\x58\x6c\xfc\xb9\xb7\x75\xe7\xbb\x8d\x26\x1a\x75\x48\x30\x5e\x86\x0d\x88\xc1\x92\x1b\x31\x1c\x86\xb4\x80\x06\x03\xef\xce\x98\xa1\xd0\x4a\x4e\x12\xe7\x71\xb3\x5a\xba\xe3\xf9\xdf\x03\x2a\xbd\x71\x18\xc0\x7d\x19\x30\x08\x00\x3d\xc7\xd9\x29\xef\x91\xc2\x26\xf4\x0f\x9a\x51\x91\xc2\x0e\xc5\x10\xe3\xf7\x96\xdb\xd5\x6e\xad\x22\xf2\x36\xe1\xf6\xd0\x21\x12\x2d\x9f\x20\x1e\x60\xf6\x16\xc4\x56\xb3\x0f\xb6\x73\x6f\xb3\x9e\x7b\xbb\x40\x96\x87\x62\xf4\x84\x8d\x61\x16\x3f\x4c\x56\xc3\xcd\xbd\x1f\x1c\xb0\x75\xda\x85\x51\x86\xce\x65\x25\xb1\xed\x6e\xdc\xcd\x69\x24\xdb\x7b\x55\x7d\xb7\x99\x38\xd4\x85\x01\xf6\x0d\x29\x94\x65\xd4\xe9\xcb\x01\xf7\xe1\x74\x3a\x7a\xee\xe6\x49\xcd\x04\x84\x0b\x25\x44\xc3\x52\xca\x7d\x40\x6e\xb8\x75\xd5\x34\x15\xd1\x72\x87\x5c\x57\x42\x27\xda\x3b\xa7\x20\x99\xfc\x2d\x14\x7a\x16\x38\xfd\xb9\xb7\xc1\x71\x9c\x26\x24\xc5\xf0\x39\x3f\xaa\xed\xdf\x2f\xc3\xe1\x23\x60\x50\x0b\x60\x10\xb4\x3d\x82\xc7\x81\xb1\x0b\xad\x00\xc5\x2e\x1c\xd7\xbb\x40\xd1\xdb\xe8\xd1\x30\xa6\x23\xfa\x80\x30\x74\x67\xc0\x98\x80\xd4\x4d\x13\x23\xd9\xe7\x84\x76\xa9\x1c\x5a\xce\xef\x90\x0c\x99\xf8\x8f\x5b\x7f\xb9\x5c\x2a\xa8\x0e\x91\xef\xf1\xf7\x28\x45\x25\x0e\x05\x86\x7c\xc0\xe4\x6f\x41\xde\x33\x0c\x82\xf9\x76\x29\xbd\x0b\x19\x1b\xf2\x20\x60\x9c\x0c\x9b\xff\x87\x0a\x6d\xb8\xfd\x0f\x69\x00\x98\x3f\xef\xca\x98\xb7\x40\x2b\x34\x49\x92\xbe\x77\xf2\xa3\xd1\xcc\xef\x75\x5d\xde\x66\xf8\x70\x47\x06\xd9\xbd\xa6\x5f\x92\x10\xa8\x7f\x12\xdb\x9e\x76\xa7\x50\x9d\xc4\x48\xa1\x80\x66\xe8\x6b\xc0\x47\x6b\xa4\x22\x8b\x42\xe4\xa2\x40\x40\x26\x5a\x3e\xc5\x3e\xf0\x15\x60\xc6\x6e\xb7\x7d\x6f\x3d\xf7\xbd\xcd\xdc\xf7\x76\x8a\x74\x14\xe3\xa7\xcc\x0c\x9f\xff\x87\x89\x6e\xb8\xf5\x0f\x80\x07\x8c\x9f\xf5\x64\xec\xcc\xcf\xe5\x26\xbf\x4f\xa3\x5d\x74\x1a\xcb\xfc\x5e\xd3\xe7\x6d\xc6\x0f\x76\x64\x88\xed\x43\xfa\x1d\x9a\xc2\xea\x49\xdd\xc9\x4e\xf3\x5a\x71\x14\x83\xc2\xdc\xa5\xbf\xf1\x35\x7f\x32\xf2\x3d\x7f\xd5\x21\x92\xa7\xfb\xf2\xeb\xa0\x00\xd7\x8c\xd8\x6a\xf0\x5b\x7f\xbe\xdd\xce\x77\x4b\x59\x1a\xda\x4c\x5f\x7e\x1d\x38\xcf\x0f\x93\xd4\x98\x59\xbe\x0f\x1c\x9c\xe3\x71\x17\x46\xf9\xf9\x5c\x52\x72\x78\xe2\x85\x5e\x34\x92\xed\xbd\xaa\xbc\x5b\x67\x77\xbd\x0b\x03\xec\x5b\x57\x27\xfb\x78\xa1\xae\xfa\xf7\x2e\x52\x2b\xed\x86\xad\xf3\x8f\xc3\x29\xda\xb9\x4a\xae\xbf\xab\xc2\xf7\x16\x43\x5f\x15\x2b\x56\x49\xa8\x06\xcd\xd9\xb7\x7c\x71\x83\x45\x33\xdc\x9a\x47\xb5\x04\x0c\x5b\xeb\xe0\xb8\x75\xfd\x91\x2a\x1f\x6b\xee\xb7\xf4\x4e\xb7\xfc\x9e\x3e\xde\x67\x19\xda\xb2\x7f\xef\xd2\xb4\xd6\x72\xc8\x48\x18\x8f\x15\x1a\x0b\xea\xc2\xbf\x75\x15\xd7\xdd\xce\x3d\x6f\x33\xf7\x7c\x5b\xaf\x0d\x23\xc2\xbc\x9e\xcf\x3b\x32\x78\x4c\xdc\xb2\x92\x3f\xb2\xad\x65\x5c\xdc\xb8\xb2\x7f\x83\x11\xdc\x3a\x36\xee\x5d\xe9\xef\xed\xe9\xdd\xb6\x22\xaf\xfc\xf7\xae\x54\x2b\xed\x06\x39\x41\x23\x71\x82\x63\x43\x5a\xfb\x1f\x78\x54\xcb\xd0\x57\xd3\xa8\x30\x2c\xe9\x73\xf6\x87\x8f\x89\xd1\x8b\xf9\xa3\x5a\xda\xc6\xc3\x2d\x8b\xfb\xa3\x55\x7e\xf3\x58\xb8\x6b\xb1\xbf\xa7\x8f\xf7\x59\x86\xb8\xf8\xdf\xbb\x74\x2d\x36\x1a\xb4\xdc\x3f\x06\x21\x64\xfc\xc2\xf2\xbf\x35\xd8\x59\xce\xbd\xb5\x3f\xf7\xb6\x2b\xb8\x83\x06\xbb\x07\x57\xf5\x39\xcf\x83\x8d\x7e\xe4\x7a\xfe\xf0\x66\x16\x73\xbf\x61\x7d\x7f\x94\x6e\x6f\x35\xf4\x3b\xd6\xfb\x6d\x5d\xbb\xd3\x04\x94\xf5\xff\xde\x25\x6a\xa5\xdd\xc0\x75\x9e\xb1\x68\x21\x7b\x97\x97\xff\xad\xfd\x0d\x82\xb9\xb7\x5b\xce\x37\xc6\xee\x1a\x8c\xde\xb4\xa2\xcf\xd9\x1f\x6c\xf7\xe3\x17\xf2\x47\xb5\xb4\x58\xff\xad\x0b\xfb\xa3\x15\x7f\xeb\x30\xb8\x6f\xa1\xbf\xa7\x9b\xf7\x19\x87\xbc\xee\xdf\xbb\x58\x2d\x37\x1b\x32\xe7\x8f\x44\x09\x8d\x02\x69\xe5\x7f\xe0\x46\x7d\xb8\x9f\x86\x31\x60\x58\xd0\xe7\xac\x0f\x1e\x02\xa3\x97\xf2\xc7\x34\xb4\x0c\x80\x5b\x96\xf6\xc7\xaa\xfa\x56\xdb\xbf\x6b\xa9\xdf\xde\xc1\xfb\x2c\x42\x5a\xfa\xef\x5d\xa6\x96\x5a\x0d\x7d\x03\x8c\x42\x0a\x59\xbe\xb8\xf2\x6f\xed\xe6\x6a\x3b\xf7\x57\xbb\xb9\x1f\xb8\x86\x8e\x1a\x2c\x1f\x5e\xcd\xe7\x8c\x0f\x36\xfc\xb1\x8b\xf8\x23\xda\x59\xcc\xfe\xb6\x45\xfd\x91\xaa\xbe\xd5\xee\xef\x59\xe4\xb7\x76\xf0\x5e\x73\x10\x17\xfd\x7b\x17\xad\xc5\x46\x43\x66\xfb\x51\x08\xe1\xb9\xbe\x5d\xf6\xb7\x6e\xeb\xf0\xe7\xc1\x76\xbe\x06\x9c\x3b\x60\x15\x5f\xc2\xad\xda\x3a\xe7\x78\xc4\x24\x3f\x6a\x1d\x7f\x78\x33\xeb\x04\x3f\x76\x5d\x7f\x9c\x66\x6f\x9f\xdc\x6f\x5e\xe7\xb7\x75\xed\x26\x03\x48\xe2\xec\xeb\xd5\x70\xa0\x85\xad\x00\x1b\x8f\x3e\x90\xc6\xb2\x7d\xbb\x6e\xb0\x3e\x2c\xb5\x26\xcf\x59\x84\x4a\xcc\x78\xd7\x4e\xfe\x54\x9b\x71\xf3\x1d\xd2\x52\xfd\xb4\x9a\xe9\xf6\xc9\x96\xe8\x8c\x87\x1a\xc8\xf1\x2d\x72\xc3\xc6\xa1\xce\x18\x9e\xf3\x75\xb2\xd4\xcb\x02\x8d\x2a\x15\x68\x74\xb9\xaa\x27\x49\x2d\x8d\x51\x92\x23\x49\x96\x14\xed\x2d\xcc\x8f\x02\xb8\x98\xba\x87\x24\xd5\x11\xb2\xd5\x1f\x9e\xeb\x3a\xcf\xbe\x74\xd0\x62\x2a\xfb\x12\x55\xa8\x36\xd4\x55\xcf\x87\x34\x16\x2b\xc5\x93\x95\x8b\x53\x18\x21\xf1\xbc\x0d\x3b\xd4\x42\xcf\xef\xe0\xde\x86\xe5\xf0\x0b\x26\x14\x5c\xfc\x62\x89\x30\x42\x74\x20\xe1\x01\xf3\xd8\x9e\x9b\x71\x49\xbe\xf6\x24\x2c\x2a\xa9\x5a\x4c\x78\xd4\x42\xe0\x08\x57\x3f\x02\xc7\x14\xe1\xb6\x17\x94\xec\x2f\x71\x14\xa1\x4c\x3c\xea\x44\x61\x66\x8b\x25\x3b\x8f\x34\xb8\x37\x02\x65\xbd\x4f\x7c\x8c\xcf\xc9\x53\x82\x4e\x35\x7d\x2a\xe9\x15\x6b\xf8\xf1\xb9\xd0\x39\x7e\xd3\xe6\xbd\x97\x4b\x5c\x23\xa7\x2a\x42\x72\x8b\xc0\x4b\x19\x16\x1a\xcc\x7e\x1f\x9e\x6a\x54\xc2\x87\xde\xc4\x23\x7c\x0b\x3f\x08\xf4\xfb\x85\x59\x29\x3f\x7a\xf7\xf0\x20\xde\x36\xbc\x58\xa2\x94\x9e\x3a\x6b\x4d\x99\xe5\x51\xe6\xe5\x50\xd2\xf8\x36\x4f\x3d\xfb\x4d\xa9\x83\x4d\xf4\xde\xa0\xb4\xa8\x5f\x79\x9f\x94\x13\x88\x2d\x6c\x8a\xb2\xe7\x11\x69\xb2\x3c\xd7\x75\xe5\x4c\x59\xa7\x24\x0f\xeb\x3d\x06\x7b\xea\x4e\x0d\x7b\x2e\x1e\xc3\xf2\x74\xc2\x4f\x46\xee\x17\x34\x2d\x0c\x9e\x9b\xd5\xe3\x75\xb2\x17\x26\x9c\x24\x24\xf8\x93\xb8\xaa\x9d\xaa\x7e\x4d\x90\xe1\xb0\xdf\xf8\x4b\x63\xc4\x8b\xc4\xbc\xc0\x94\x71\x4b\x92\x16\x91\xe1\xb5\x6c\x33\xc0\x3f\x81\x32\xa5\xea\xbd\xf2\x8c\xfc\x04\x86\x9c\x93\x35\x1f\xb1\x96\xdb\x57\xe9\x40\x42\x55\x6a\xa4\x05\x10\xdb\xac\xb7\x00\xb1\x34\x1a\x48\x2c\x8d\xc6\x10\xdb\xed\x7c\x80\x58\x72\x1e\x48\x2c\x39\x8f\x21\xe6\xf9\xae\x0b\x50\x6b\x92\x81\xd4\x9a\xc4\x4c\x8d\xcd\x33\x33\x65\xdc\xe0\x51\x42\x30\xf2\x8c\xff\x4a\xca\x3a\xf5\xbe\x09\x66\xf9\x00\xba\xf7\x9f\x80\xdc\x1b\xe6\x1d\x7d\xd2\xea\x9d\x80\xa0\x5e\xf5\x4d\x44\x2c\xb5\x92\x2e\x5b\xf7\x49\xd5\x9a\x51\xc2\x94\x31\x51\xbe\x2a\xd6\xef\x39\xc7\x43\x32\xe5\x66\x65\x12\x31\xd4\x46\x11\xb7\xb5\x63\x63\xa5\x2c\xcb\x43\xe9\x27\x6b\x83\xdb\xdb\x14\xd3\xbd\x22\x88\x8a\x2c\xe7\xe0\x45\xcd\x28\x58\xdf\x47\x31\x23\x48\x51\x07\xc8\x02\xce\x92\xa2\xd8\x58\x6b\x8f\xfb\xbf\x87\xd1\xe8\xe3\xd0\x6a\x3d\xb6\xbe\xf4\x59\x89\x5d\x02\xb0\x95\xb4\xb6\xf1\x5b\x43\x2f\xc1\x4a\x51\x56\xff\x9f\x9f\x28\x8f\x5f\xe6\x36\x18\x4c\xce\x0e\x41\x24\x60\x07\xa9\xf3\xe2\x8b\x38\xbf\x33\xe1\xd0\xb4\x14\x6d\xbb\x28\xfe\x16\x47\xa8\xbc\xb6\xbe\x2c\x77\x47\x98\x77\xa2\xba\xb6\x82\x8e\x84\x4b\xe6\xe8\x65\x5a\x02\xda\xb8\x46\x96\xab\x25\x95\x68\xc7\xa3\xe1\xce\x31\x41\x61\xb9\x3f\xe4\xf5\x65\x78\x2e\x05\x7e\xfd\x93\xee\xc7\x0e\x4a\x7a\xe0\x2a\x2c\xf3\xd0\x5a\x2e\x94\x62\x59\x6f\xed\x6d\xbd\x03\x18\xfe\x1a\x17\xc8\x14\x2a\xed\x9a\x86\x4c\x86\x96\x8a\x4b\x11\x03\x89\xf0\xed\x57\x32\x91\x2e\x1e\x96\xc9\x0c\x0f\x8a\x7b\x16\x72\x24\xdb\x23\xd1\x93\x9a\x90\xac\x85\xb8\xa0\x30\x12\xa6\x16\xe9\xce\x1b\x1e\x57\x4b\xe9\x63\x81\xab\xa8\x78\x0c\x2c\xb3\x6d\x0d\x60\x70\x7f\xa1\x5b\x86\x0c\xf6\x27\x1a\x99\x10\xac\xcf\x85\xb8\x9d\x0f\x75\xcb\x85\x3e\x03\x6f\xb1\x31\xa4\xaa\xd1\x49\x09\x0b\x05\xa4\x82\xfc\xb6\x24\x64\xd9\x7b\x33\x4f\xc8\xdc\xc3\x7f\x19\x51\x53\xf3\x56\x09\x30\xa3\x6f\x23\x1c\x63\x73\x69\x81\x0e\x42\xdf\x57\x2f\xac\xf8\x74\xe4\x75\xac\x06\x64\x02\x0e\x95\xd9\x3a\xcf\x93\x43\x58\x4e\x71\x6f\x94\x9c\x55\xa6\xaa\xc3\xb2\xd6\x92\xca\xd0\xe4\x37\xb8\x4a\x22\x6f\x4e\x0d\x24\xf7\x81\x3e\xd2\x05\xc0\x53\x5c\x56\xb5\x73\xbc\xc4\x49\xf4\xa8\xf5\x55\x83\xb8\xca\x57\x3e\x15\x8d\x0d\x75\x12\xb6\xed\x40\xc3\xd2\xa0\xd8\x1a\xa4\xfc\xea\x7b\xbc\x76\xef\x01\xfa\x36\xe6\xc1\xa1\xe2\xc9\x29\x95\x43\x3b\x6d\x61\x4d\xea\xb9\xc0\x05\xee\xbd\x89\x09\xa9\x4e\x5b\x12\x70\xaa\x22\x89\x6b\x25\xf5\xec\x22\x58\xd3\x85\x3d\x29\x4f\x12\x2f\x35\xe0\x60\x1e\xc4\xdc\xe2\x59\x02\x80\x40\x70\x20\x41\x0d\x76\x48\x78\x2b\xe6\x96\x48\x3e\x98\x0b\xac\x3e\xfe\x08\x23\xe0\x8b\x92\x86\x6a\x55\x50\x34\x33\x90\x22\xa7\x25\xbb\x5e\x4b\x5b\x55\xb5\xd2\x4c\xce\x03\x69\x42\x24\x35\x8a\xed\x5c\xdd\x8e\xde\x28\x2e\xd1\x91\x67\x20\x7a\x4e\xb3\x27\xb8\x54\x49\x36\x45\x87\xbb\x98\x6b\xaa\x1b\xea\xe3\xf2\x4d\xf5\x4f\xee\x72\x85\x34\x69\x74\x0b\xb7\x46\x48\xeb\xec\xa1\x4c\xbb\xc6\x69\x04\xbb\x76\xca\x2c\x62\xa6\x65\x99\x4e\x20\x7a\x83\xe7\x15\x70\xf6\xb0\x8f\xea\x91\x92\x19\xc2\xee\xa8\xb9\x46\x9f\x0a\x45\x9e\x68\xff\x34\xa2\x42\xb1\xf8\x76\xd7\x52\xe2\x81\x2d\x66\xc2\x32\x3b\x49\xd6\x76\xc8\x9b\x2f\x06\xec\x22\x2c\xe6\x2f\x37\x01\x0a\x6c\x8c\xc1\x6f\x68\x46\x49\x01\x4b\xa9\x64\x11\x12\x8f\x3b\xb6\xce\xe8\x3e\xc2\x9f\x65\xc4\x97\xa7\xdd\xe7\xba\xf7\xd5\xce\x07\x7b\x89\xea\xe3\x45\x1a\xee\xbc\x4c\x1c\x87\x02\x5f\x3f\x8b\x79\x31\xe7\x60\x0d\x4f\x19\x28\xd5\x49\x17\x03\x9b\xab\x84\x1c\x85\xa3\x9d\x3e\xce\xf2\x47\x61\x15\x5a\xbb\xee\xd5\xdc\x99\x1f\xfb\x7b\x26\x81\x81\xdd\x94\x00\x2d\x7d\x96\x70\x0c\xa0\xac\x00\xda\x68\x73\xd0\x81\x12\xb7\x11\x07\xe1\x7a\xd5\x3b\x98\x74\xa7\xec\xa1\x4c\x00\x2d\x7a\xd9\x11\xdb\x48\xf9\x35\x75\x4f\xd2\xa4\x4a\x28\x5b\xac\x39\xd1\xac\x59\x29\xdc\xfd\x37\xf2\xaa\xf8\xf6\xcb\x1b\x58\x6a\x1b\xaf\xe0\xc6\x6d\xaa\x44\xf9\x0d\x65\x63\x4a\x85\xbd\xd9\x1f\xee\xe3\x47\x7e\x99\xf7\x30\x34\x81\x6f\x6c\x12\xee\x74\x09\x4b\xcd\x34\x34\xa9\xce\x86\xda\xd2\xd0\xd6\xdc\xa3\x9e\x52\x5b\x1d\x6d\x51\xfc\x00\xf1\xfb\x35\x42\xee\x80\xcf\x22\x49\x06\x4e\x51\x22\x5c\xd8\xab\x20\x08\xd3\x8c\xfa\x23\x00\xba\x99\x61\x95\x81\x0f\x25\xdf\x88\x0e\x18\xd1\x12\x52\xeb\x80\x16\x11\xfd\xa8\x33\x27\xd5\x8a\x15\x78\x2e\x83\x41\x55\x28\x0b\x56\x1d\xd4\x4e\x42\xec\x15\x80\x56\xae\x1e\x88\x6a\x00\xbb\x16\x58\xb5\xc4\x3e\xa1\xb7\xa6\x23\x5f\x8b\xad\x81\x51\xf9\xf4\xe0\x92\x96\xd9\xee\xcf\x6a\x6c\x48\x3c\xdb\x73\x53\xbd\xba\x6e\x6c\xb8\x69\x6a\xb5\x0b\xdc\x60\x03\xe4\xe4\x1d\xb2\x8c\xcc\x16\xbb\xf5\xaf\xe6\x1f\x8e\x28\x5a\x45\xa1\xe9\x7e\x2a\x45\x56\x06\xe7\xda\x06\xc6\x9c\x69\xf1\x7b\x92\x8c\x17\xc7\xd8\x66\x1f\x80\x54\x6b\x2f\x0d\x7e\x25\xcf\x63\xdf\x15\xf4\x77\x91\xd2\x6b\x75\x03\xfb\x59\x37\x75\x13\xa0\x7d\x30\xa9\xad\x98\x99\x0f\xc0\xdf\x42\x6a\x96\x3d\xdd\x26\x30\x11\x75\x95\x5a\xa5\x88\xab\x47\x2a\x8c\xf2\x07\x69\x6c\x24\x2d\xbd\x76\x90\xc6\x4c\x80\x76\x8d\xa9\xad\xcc\x1a\x33\x42\x9a\x35\x36\xc1\x96\xba\x11\x96\xaf\x89\x59\x59\xbb\xf2\xf8\x42\x95\xe4\x4b\xe8\x22\x13\x3c\x99\x31\x0b\x28\xf3\x51\x78\x55\xa1\xf5\xf9\xc0\x00\x3a\xc3\x32\xd0\xf8\x66\x56\x0b\x19\x68\x1e\x43\x6d\x63\x12\xff\x6f\xc8\x78\xb8\x61\x30\x80\x7d\x10\x7d\x4b\xc3\x02\xd5\x58\x24\xb0\xee\x47\x62\x34\x2d\xab\xdd\xd2\xd0\xa6\xa5\x11\xfe\xb2\x72\x73\x80\xd9\x85\xf5\x9e\xe4\xaf\x82\x69\x9c\x09\x13\x81\xb6\xc0\x4c\xcb\x80\x5b\x6b\xb4\xbb\x4b\x06\x7c\xfd\x93\x3c\x2e\x0f\xc6\x2b\xdd\xbb\xd0\x2e\x9c\x29\x5b\x0f\x1d\x8f\xaf\xf1\x60\x86\x5b\xf6\xe9\xfb\x48\xdc\xea\x0a\xdd\x84\x33\xec\x5a\x22\x71\x17\x21\x90\xf6\xc3\xf2\x41\x1a\x24\x7a\xef\x2d\x42\x6a\x8a\x7c\x33\x11\x6a\x64\x37\xdd\xed\xb3\x75\x0f\x91\xb1\x07\xca\x26\x7d\xfa\x4d\x72\x84\x10\xf5\xd4\x70\xcb\x68\xa3\x49\x97\x16\x1a\x58\xe0\xd4\x4d\x77\x7a\x41\x8d\x7e\xe3\x8d\xbe\xd8\xaf\xf1\xe2\x39\x2b\x6e\x20\xdc\x5d\xc4\x74\x0b\x03\x9d\x2e\x0c\x3e\xb7\xc6\x13\xe5\x5b\x1f\xe2\x6a\x98\xa0\x7c\x6c\xaf\xf3\x02\x46\xd5\x72\x00\xef\xfd\xe5\x6e\x1e\x8d\x80\xe8\x1c\x01\x6e\x72\x11\xc7\x22\x99\x48\x80\xcd\x15\xe2\xc6\x27\x78\xcb\x2e\x0d\x30\x3e\x84\xd1\x21\x38\x44\x2c\xc8\x20\x71\x17\xcc\x3a\x5d\xda\x98\x98\x73\x90\xcb\xee\xf6\xd3\x59\xe0\x7e\xfc\x1c\xb8\x1f\xf1\xdf\x8e\x2f\x16\xce\xc0\x77\xaa\xa8\x23\x4e\x89\x93\xfa\x70\x0c\x9a\xb9\xd8\x2a\xcf\xbb\xdd\x80\xbb\xed\xee\xa3\xdc\x2a\xf7\x4d\x6e\x67\x5b\xf0\x9a\xcd\xd3\xe9\x44\x2f\x90\x5c\x2f\x82\xf5\x6a\xb1\x09\x12\x67\xb9\x08\x76\xb3\xe5\x62\xed\xf9\x58\x25\xcb\x2d\xfe\x37\xf8\xc5\x9d\xad\x16\xfe\x3a\xf1\x17\xbb\xcd\x6a\xe6\x2f\x76\xbb\x5f\xb6\x33\x7f\xe1\xed\x96\xfa\x45\x9c\x43\xa5\x85\x5f\x15\x35\x2a\xd3\x38\x0b\xeb\xbe\x89\xea\xc6\x29\x7e\x02\x06\xde\x5b\x69\xc2\xdd\xa8\x2b\xed\x6e\xd4\xd5\x27\xed\xfe\x4f\x59\x6d\xe4\x5a\xd4\x9b\x35\xc0\x67\xbe\xa1\x37\x01\x82\xd7\x60\x2b\x29\xa9\x46\x52\x1e\x65\x04\x23\xe8\x93\x55\x89\x51\x43\x5d\x9c\x2b\xe0\xd6\x7f\x17\x83\x5c\xbd\x75\xb6\xb5\x18\x67\x35\x73\x56\xc2\x40\x67\x37\xbf\x96\x3f\x7d\x5a\x7e\x92\x07\xbc\xd1\x5c\x6c\xdd\x7e\x47\x5b\xa9\x5e\xe2\xfa\x78\xb9\x4a\x7e\xad\xaf\xcc\xbc\x14\xa6\x47\xa1\xf4\x45\xc2\x9a\xf2\x77\x07\xdf\x96\x21\xbf\xec\xc2\x24\x51\x83\xea\x11\xf4\xa8\x92\xf1\x2b\x8c\x5e\x6c\xeb\x0b\xeb\x1b\xf4\x75\x46\xca\x39\x2b\xbc\x86\x72\x44\x57\x46\x70\xb1\x33\x5b\xe1\x62\x69\xc5\x44\x28\xd7\xe7\x37\xfa\xd2\x85\x18\x17\x4f\x77\xbd\xe3\x8d\x55\x73\x7e\x13\x18\x21\x77\xca\xcb\x54\x03\x11\x39\x31\x43\xbd\xe7\xb5\x5a\x7f\x7b\x0e\x06\x88\x69\xf8\x91\xbb\x11\x06\xa9\x1d\xc9\xb3\x37\xbe\x6d\x42\x13\x9c\x41\xad\x9b\x54\xde\x49\x58\xa3\xff\xf5\x03\xbf\x1a\xdd\x56\x39\x8c\xc3\xf7\x9c\x7b\xe8\x6a\x98\xe5\x0a\x47\xb2\x37\x1c\x5c\xd4\xdc\x88\x63\x5b\xfd\x34\xc0\xe6\x9d\x99\xfd\x8e\xba\x71\x9f\x06\xe0\xcb\xf9\x04\x0f\x98\x5c\xd0\xfd\x9f\xf2\xda\xf6\x71\x1f\x36\x5a\xc3\x0c\x8b\x02\x85\x65\x98\x1d\x91\x70\x5f\xa1\x5a\xa8\xfc\x7e\x83\xf6\x02\x80\x91\xf8\xd3\x6d\xd7\xe7\x01\xe8\xf7\x64\x89\xe6\x5b\x98\x3c\xb7\x41\x39\xd3\x39\x38\xf0\x14\x1c\xbf\xa5\xcf\x49\x1d\x17\x09\xfa\x32\x57\x2a\xb0\xa5\x7d\x21\x4b\x03\xe4\xf1\xa7\x07\xef\xe1\x4b\xbb\x44\x2f\xdd\xb8\x29\xed\x61\xd4\xdc\x17\x48\x2e\x43\x13\x6a\xaa\x61\x32\x6b\x4e\x7a\x8c\x9a\x22\x14\xbe\x12\x43\x64\x88\xca\x88\x90\xca\x2e\xa5\x97\x78\x6a\x82\x7c\x30\x93\x64\xcf\x64\xa7\x60\x72\xaa\xb4\xf7\xe3\x44\x2b\x0e\x21\x3c\xed\x0e\xa5\xd2\x73\x3c\xe0\x0e\x5c\x70\x19\x5f\x65\x20\x39\xf7\x7d\xce\x92\xe9\x83\xe4\x81\x35\x40\xf0\xbb\x8f\x74\xff\xb2\x65\x97\xda\xed\x53\x9e\xb6\x63\x4b\xdb\xe9\x62\xfb\x54\x7f\x0b\xad\xbd\x0b\xac\x1e\x0e\xd8\xeb\x03\x8f\xde\x1b\xc6\xac\x40\x4b\x5b\x73\x12\xb7\x83\x68\xe0\xc0\x2a\x93\xc8\x5e\xdf\x98\x11\xe8\x26\x61\x76\xfe\x01\x65\x8f\x00\x1e\xfe\xb2\x6e\x97\x47\xfe\xa5\xcc\x5f\x2a\xf4\x00\xa0\x01\x5a\xff\x86\xdf\x19\xce\x81\x34\xf9\xa2\xa2\x0a\xeb\xba\xfc\x41\x00\x50\x44\xa2\x2c\x79\x49\xab\x3c\xfc\x00\xa2\xab\x1d\x50\xbf\xe1\xb5\x2a\xbe\x46\x07\xbf\x37\xad\x2b\x59\x43\x3f\x95\x1b\xa5\xdd\xd7\xeb\x76\xbd\xaf\xdd\x5c\xa2\x2c\x72\x99\xa5\x60\xec\xbf\xbd\xbf\xaa\xfe\xfb\xb6\x0b\xd0\x69\x84\x9f\x5a\x93\xbb\x8f\x47\x05\xa5\xcc\x32\x01\x08\xd1\x62\x76\x46\x57\x7d\x14\x7b\x8b\x95\x98\x51\xc0\x9e\x79\x67\x9a\x37\x35\x61\x45\xbd\xd2\x16\xaa\xdd\xef\x39\xbd\x2a\x89\xc9\x67\x9c\xcb\x73\x7a\xd0\x57\xf6\xb1\x51\x60\x23\x99\x0f\x9d\x18\x64\x1a\x98\x77\x52\xf2\x5e\xf8\xab\x89\x11\x0b\x6f\x59\x1c\x9c\xb4\x9b\xd1\x34\x29\xc2\xf2\x33\x2c\xd3\x8a\x7b\xf7\xd9\x6b\xb3\x27\x77\xbb\x70\xf4\x9d\x9a\x9f\x27\x7a\x73\xdf\x27\xc6\xfd\x4e\xa1\xb4\xd1\xf2\x55\x23\x1f\x19\x28\xda\xf4\x04\x08\x92\x90\x34\x06\x8e\x16\x64\xfc\x64\xa8\xe9\xc3\x51\x0f\x8e\xf2\x39\xcb\xf0\x0b\x11\xf3\x22\xa5\xdf\xe1\x16\x24\x1e\x7f\x14\x27\x0c\xf9\xca\x68\x28\xef\x21\x42\x3e\x52\x6e\x06\x84\x8e\x6b\x77\x06\x06\x0e\x06\x71\xfc\x1a\xec\xfb\x36\x63\xc6\xc8\xff\x93\x59\xf2\x90\xb9\xfa\x46\x33\x56\x55\xa1\x8a\x6f\x98\xfd\x2a\x58\x46\xdb\xae\xd0\xfe\xef\xd1\x5c\xab\xc1\xf3\xb0\x9a\xeb\xc1\x17\xea\x59\xca\x86\x3b\x2c\xbb\xfa\x4f\x66\xd8\x53\xd9\x70\xd5\x1a\x6f\x75\x83\xed\x56\xb7\x1a\x6d\x35\xb9\xb5\x02\x86\x69\xac\xa0\x54\xe5\x65\x75\x81\xb5\x53\x9c\x24\x4e\x92\xbf\x80\x8b\x8b\xf2\xa0\xe8\xb1\x7d\x82\xe9\xb9\x28\xba\x43\xa9\x6c\x07\x4b\x50\x34\xbd\x03\xce\x8c\xbb\x5d\x75\x34\xf8\x8c\x86\xd5\xf9\xa1\x58\x94\x17\x20\x93\x7c\x84\x4e\xe1\x73\x52\x9b\x91\x68\xae\xe5\x68\x36\xd4\xb9\x6c\x30\xe5\x6a\x28\x49\xfb\xee\x0b\x20\x4e\x66\xab\xb0\xdf\x67\x54\x8f\x1d\xc5\x13\xf4\x86\x0d\xec\x2c\xfc\x36\xc5\xb9\x7f\x69\xf1\x47\x3b\x38\xa7\xa6\x5e\x23\x64\x69\xee\x4c\x6b\xa6\x0b\x32\x00\x38\x28\x3f\x2e\xd1\xfe\xa6\x89\x17\xe0\xf4\x9a\x1c\x68\x31\x22\x89\x87\x6a\x74\x18\x47\x1d\x1e\x2a\xf9\xa8\xad\x18\x9c\xd3\x61\xdb\x41\xce\xc8\x13\x49\xe9\x22\x4b\x80\x9e\x02\x90\xc1\x48\xf7\xb5\x80\x1f\x98\xb4\xd4\x6d\x7d\x3c\x6c\x31\x6c\xcd\xe4\x8b\x03\x3a\x35\x51\x82\x4a\x0d\x95\xa5\xbc\x24\x45\xe3\xf1\x59\xfb\x17\xee\xad\x4d\xca\x37\xcd\xd5\x90\x38\x49\xd2\x94\x8e\x1e\xd4\x85\x85\x9c\x15\x66\xc0\x32\x8b\x3c\xf9\xce\xda\xbf\x64\x31\xbb\x23\x20\xa7\xaf\x52\xce\x7e\x9b\x74\x64\x3d\xec\x8c\x71\x17\x71\x92\xe8\x86\x00\xe9\x50\x81\x6c\x13\x7e\x08\x75\x34\xc1\x6d\x8b\xcb\xba\x85\x8e\xef\x47\xc1\xd0\xf8\x2d\xd5\x09\x79\xde\x96\x09\xb8\xfa\xce\xcd\x6a\x47\x43\x28\x66\x7a\xb0\x3f\x46\x91\x8a\xbe\xad\x00\x68\x38\x74\xf6\x2b\x51\x44\x57\x8d\x5d\x3a\xcb\x1c\xc2\x2a\xc6\x12\xed\xc0\xc8\xea\xd6\x37\xb4\xf7\x28\xc0\xb9\xcc\x5f\xf6\x1e\xc4\x4a\x1d\x1e\x78\x6e\x81\x9f\xc9\x8f\x22\x14\xb6\xa0\xd2\xa9\x42\x82\x61\x46\xa4\xa4\xe7\xc9\xc2\x6f\x87\xb0\xfc\x2e\xc7\xaa\xcd\x07\x7d\xe4\x04\x0a\x2c\x73\x82\x96\x41\x81\x1c\xc9\x71\x0e\xa8\x7e\x41\x28\x33\xcd\xa7\x24\xdb\x0a\x6e\x11\xc6\x19\x2a\xe7\x7a\x91\x73\x4a\x9e\xe3\x08\xaa\x48\xce\x50\x69\x0a\xc2\x56\x29\x54\xda\x24\x93\x26\x9a\x79\x07\xb1\x71\x19\x39\x87\x52\xfc\x12\x24\x7d\x95\x90\x3e\x8a\x2c\x3d\xf8\xb3\x0c\x2f\xd7\x36\x31\xf7\x1c\x89\x31\x27\xeb\x92\x59\x13\x26\xf4\xae\xac\xe7\xb5\x88\x01\xc7\xbd\xf4\x07\x26\x03\xb9\xc1\x05\x60\xbc\x08\xd3\xa0\xfc\xe9\xcf\x55\x90\xca\x8d\x0c\x49\x68\xab\x3a\xac\xe3\x23\x4b\x2c\x2b\x91\x92\x4e\xde\x99\xb5\x69\xfc\xc4\xd5\x22\xe2\xe9\x90\x8d\x33\x17\x89\x6b\x84\xc9\x8b\xfc\xee\x9b\xbf\x86\x9f\x0b\xe6\xfd\x21\x27\x59\x4a\xed\x00\x8f\xf6\x59\x1f\x3a\x75\x35\x28\xed\x9b\xd5\x2f\xd1\xdf\x55\x02\x53\x8a\x69\xf2\xd2\x01\xc6\xc9\x40\x9d\xf8\x98\x67\xd6\x4d\x10\x8b\x40\xdc\xc8\x0f\x24\x4e\x64\x9b\x11\xfa\x76\xe6\x52\xa9\xb2\x3f\xa2\x58\x5a\x55\xce\x48\x2a\x8b\x36\x67\x6c\xd8\xb4\xa9\x77\x83\xc5\x8e\x26\xc4\x65\xcc\xd3\x8f\xc7\xf4\x6c\x93\x3a\xbf\x82\x95\xf2\x4c\x0b\x83\x74\x73\x2e\x5c\x9f\xf6\xb4\xef\xe6\x61\xb8\xbe\x49\xfa\xc6\x9d\x25\xed\xb0\x8a\xb8\x1b\x13\x24\x1b\x62\x99\xbf\xcc\xd8\x01\x51\xb0\x70\x6c\xc2\x30\x95\xdc\x4c\x9c\xd3\x80\x29\xab\xcc\x5f\xd4\xf9\xaa\xcc\x5f\xec\x78\x8c\x53\x0b\xff\x9a\xd6\xd7\xdc\x30\x9d\x01\xdf\xc9\xe5\x79\xe5\x1f\xd5\x7a\xe4\xd7\xb5\xa8\x6e\xa1\xc0\x22\xb4\x76\x3e\x05\x5e\x4a\xff\x14\xa7\x45\x5e\xd6\x61\x56\x4b\xaf\x27\xa1\xd8\x34\x09\xb7\xde\x2a\x9b\x84\x69\x32\x39\x23\x13\x7c\x36\x95\xbc\x43\x60\xd0\x6f\xd6\x1b\x78\xd0\xa7\x91\x45\x6d\x52\x25\xac\x36\x19\x44\x57\x9b\x5c\xaf\xab\x4d\xae\xd7\xd5\x26\xd7\xdf\x34\xe8\x79\xfa\x6f\x15\xf1\x77\x1d\xf4\x69\x34\xcd\xa0\x97\xf1\x8c\x1e\xf4\x6a\xf3\x3b\x07\xfd\x3f\x9c\xf5\xdc\x34\xe8\x05\xa1\xfd\xed\x06\xbd\xc0\xc4\xd0\x41\xbf\xdb\x79\xf0\xa0\x27\x87\x9c\x4d\x6a\x93\x2a\x61\xb5\xc9\x20\xba\xda\xe4\x7a\x5d\x6d\x72\xbd\xae\x36\xb9\xfe\xa6\x41\xcf\xd3\xf0\xab\x88\xbf\xeb\xa0\x4f\xce\xd3\x0c\x7a\x19\xcf\xe8\x41\xaf\x36\xbf\x73\xd0\xff\xc3\x59\xcf\x4d\x83\x5e\x10\xda\xdf\x6e\xd0\x0b\x4c\x0c\x1d\xf4\x9e\xb7\xdb\xc1\xa3\xbe\x49\x2c\x7a\x93\x2a\x61\xbd\xc9\x20\xba\xde\xe4\x7a\x5d\x6f\x72\xbd\xae\x37\xb9\xfe\xa6\x51\xdf\xde\x87\xa1\x62\xfe\xae\xc3\xbe\x49\xa6\x19\xf6\x32\x9e\xd1\xc3\x5e\x6d\x7e\xe7\xb0\xff\x87\x33\x9f\x9b\x86\xbd\x20\xb4\xbf\xdd\xb0\x17\x98\x80\x87\xbd\x0c\xff\x3d\x8d\xdb\x6c\x03\x7d\x06\x60\xd5\xbe\x55\xf5\x56\xbd\x8f\x9b\x33\xe4\xb6\x93\x0c\xd3\x7b\xc6\xe8\x94\x03\xf4\xef\x5d\x33\xb7\x0c\xc7\xbf\xfd\x58\xb4\x0f\x44\x5e\x99\xd0\x13\x37\xd2\x0a\xbc\x74\x64\x8a\x5c\xb6\xb5\x7b\xb4\x35\x50\x16\x1f\x21\x08\xf1\xaa\x8a\x41\x88\x65\xb3\xd2\x1b\x06\x83\x1a\x5a\x39\x93\x21\x4d\x1c\x6e\x06\x11\x52\x3f\xc9\x8a\x18\x96\x56\x0c\xf4\x13\x58\xf7\x79\x6e\x08\xb3\xe2\x47\xc9\x3e\xd0\xea\x92\xbf\xd8\x00\xc1\x8f\x99\x83\x54\xc4\xed\x0a\x52\x8e\xfc\xcd\x57\xba\xb7\xad\x07\x1d\x5d\x88\x7e\xbf\xd3\xd3\x4b\xb7\x3b\xd3\x86\x9f\xa5\x43\x6d\x4b\xfc\xff\xd0\x89\x7b\xdc\x83\x8f\xfe\xd6\x9d\xcf\xd8\xff\x2f\x82\x8f\xfe\xee\x13\xab\xc7\xa2\x43\xc7\xb0\xf8\xe9\x13\x61\xb9\x2d\x4e\xe3\x1a\x95\x49\x9c\xc6\xf5\x4f\x9f\x3c\xb7\x2d\x66\x9c\xf8\xf4\x90\xdc\x6a\xb6\xb9\xf8\xfe\xaf\xab\x99\x17\xd0\xbf\xfe\xf2\xe2\xfb\xc0\x11\x6d\x58\x6a\xa8\xa9\xc7\x0c\x0f\x92\xbb\x2f\x1c\xa5\x66\xd2\xc2\x3a\x92\x18\xc8\x80\x41\x1e\x85\xe5\x57\x70\xb6\x69\xb7\x01\xc0\x50\x0a\x79\x00\x40\xbd\xb8\x18\x46\x66\x9c\x56\xfc\x20\x98\xf3\xff\x89\xd2\x33\xb7\xb6\xb1\xd4\x33\xb5\x48\xc4\x36\x83\xa8\x81\x13\x8c\x84\xc7\xb7\xe2\x31\x4e\x33\x16\x92\xca\x2c\x63\x81\x94\x26\x19\x1d\xce\xb8\x61\x02\x66\x18\x98\x58\x14\xf5\x00\xd3\x8b\x04\xe1\x19\x44\xf1\x8f\x30\xc7\xe0\x0e\xcc\xba\x7f\xfe\x86\x33\x8d\x2c\x3a\x65\xa2\x19\x34\x60\xa4\xd9\xc6\xa2\x70\x68\x8a\x81\x20\xf4\x41\x7e\x0c\xcb\xe8\xae\x9d\x22\x03\x3f\xb7\x8b\x79\xea\x5f\xf2\x32\xa2\xae\xdf\xa1\x44\xe1\x57\x07\xff\x1e\x78\xbd\x69\xbb\xb7\xad\xef\x76\x53\xdf\x78\xbd\x29\xee\xf1\xcf\x17\x65\xa3\xab\x7c\xb3\xa2\xcb\xa0\x16\x64\x17\x00\xc9\x5c\x27\x24\xad\x53\x8f\x79\xb1\x6f\xee\xac\x54\x6f\x2a\xa6\xc3\x13\x73\xdf\x71\x71\x18\xf6\x64\x89\x79\x31\x9c\x99\x47\xd2\x59\x18\x36\x69\xe9\xa0\x00\x17\x5d\x52\x46\xe5\x32\x0e\x85\x0f\x28\x45\xa2\x99\x17\x20\x57\x9f\x99\x1b\xfc\x2f\xbb\x33\xec\x47\x81\xb5\xb9\xc6\xec\x8f\x14\xf4\x94\xe7\x72\x56\x6f\xae\x19\xe7\x90\x47\xaf\xbd\x9b\xbd\xc4\xc4\x7f\x45\xd3\xee\x2e\xf2\x44\x4b\x70\xea\xb8\x4e\x90\xb2\xff\x91\xa7\xf2\x24\x00\xd5\xf3\x41\x82\xa1\x67\xb1\x96\x60\xde\x62\x8e\x13\x35\xb5\x28\x6f\x18\xaa\x77\x57\x68\x0b\xf5\x63\xf7\x28\x65\x6b\x96\x7b\xc2\x2e\x63\x6b\xb7\x57\xf0\x2c\x0a\x3e\x98\x61\xd9\x94\xe2\x81\x0c\x1f\x77\xa9\x28\xd8\x32\xcc\x24\xea\x90\xad\x1b\xcd\x62\x06\x14\xb9\x33\x2e\x1e\xa6\x7c\x43\x77\x86\xb2\x2f\x5f\x68\x68\xe0\x9d\x92\x02\x46\x48\x7b\x82\xd3\x85\x78\x35\x19\x3a\x93\x05\xdd\x8d\x2b\xe7\xe0\x5e\xac\x21\x6d\x38\x72\x16\x6c\x9a\x84\x87\x83\x2a\x97\x63\xcb\x24\xc8\x8e\x4a\x3b\x0d\x09\x1b\x6b\x1d\xa7\x67\x07\x1b\x5e\x12\xbe\x0e\x3f\x7c\xcb\x76\x4a\x29\xc3\xe8\xa9\x4f\xcb\x1d\xc9\x79\x47\x9c\x22\x15\x0a\xea\xbc\xe8\xd6\x09\x32\x74\x26\xaf\x21\xbe\xa5\xb2\xba\x94\x78\xac\xb8\xd2\x4d\x2d\x00\x56\x8c\xe4\xfd\x66\x53\xb0\x0b\xb6\x8b\x8d\x26\x99\x35\xf1\xb4\xf0\x75\x46\xdf\xd3\xb2\xd5\x78\x41\xd1\x58\x76\xba\xb4\x8d\x47\xec\x9d\x6b\x17\x0c\x81\x35\x44\x52\xa4\x64\x94\x0f\x8a\x46\x36\x33\xc2\x93\xc6\xb7\x30\x51\xbb\x33\xb6\xd5\x8c\x3f\xeb\xe7\x4d\xd4\x99\x4a\x9a\xf1\x30\x7e\x46\x80\x65\xa1\xbd\x51\x32\xf4\xad\x3e\x9d\x68\x00\xa6\x06\x74\x5b\x19\xd5\x42\xeb\x1f\xa5\x8e\xb1\xb1\x27\x1e\x36\x07\xda\x4c\x77\x7d\x49\x2f\xe6\x99\x38\x0d\xcd\x07\xc3\x03\xa3\xf4\x56\xd2\x74\xd2\x1e\x47\xba\x7f\xcc\x1a\x19\x98\xe0\x0e\x96\x7e\xc4\x83\xa4\x0a\x34\xe8\x99\xfc\xc6\xd0\xb6\x8b\xd5\x40\x1b\x94\xab\xcc\x01\x63\x81\x86\x03\x15\x38\xa1\x31\xb7\xab\x67\xe0\x32\x0c\xed\x49\x6c\xfa\xdb\x39\xe6\xcf\x59\xbd\x5f\xd2\x93\xb4\x4a\x99\x0a\x22\xb7\x3c\x87\x45\xfb\x32\x13\x5b\x8b\xe5\x40\x51\x5e\x16\x97\x30\xab\xf6\x24\x51\x73\xfe\x52\xed\x3d\xb0\x8b\x3d\x49\x62\xde\xde\x16\xe1\xf1\x98\x97\x51\x9c\x67\x57\x7e\x29\xb3\x13\x66\xc7\x4b\x5e\x32\x37\xb0\xad\x67\xd3\x8a\x72\x75\xb3\x06\x20\x0c\x80\xfc\xe4\xd4\xaf\x85\x7a\xfd\x5e\xcf\xe8\xb7\x1b\x31\x48\x8c\x9a\x85\x4a\x6d\xd4\x29\x13\x05\xaf\x14\x2c\xa8\xee\x98\xea\x3e\xd1\x5b\x0d\x4b\x14\x46\xc7\xf2\x39\x3d\x4c\x78\x42\xac\x75\x3b\x75\xa7\xcd\xa3\x7b\x94\xa5\x6d\xe2\xbd\x69\x42\x94\x58\xb4\xe3\x59\xbe\x59\xdb\x7c\xd7\x8f\xd2\xe2\x47\x0d\x05\xf4\xb5\xa8\xaf\x91\xfd\xce\x77\xe8\x9b\x94\x7c\x70\xaa\xdd\xb2\xfc\xf9\x61\x00\x31\x12\xf2\xb4\x24\xd5\xd0\xe7\x39\x8b\x50\x89\xa9\xdf\x8d\x89\x8e\x1e\xa5\x8d\x72\xee\x8a\xe7\xc4\x2e\xc2\x73\x9c\x91\x66\xfd\xc6\xa3\x9c\x1c\xd0\x4c\x00\xd4\x74\x11\x9e\x11\xfb\xc4\x67\x5c\x6c\x81\x0e\x15\x42\x91\x01\x0e\x66\xe5\xf4\x35\x7e\x9b\xbf\xc6\x98\xd8\x17\xce\xd7\xc3\x0f\xc9\xb5\xec\x29\xf7\x43\xfb\x1d\xde\x60\x7d\x58\x0e\xbd\xb9\x5c\x36\x7a\xe9\xe0\x9a\x48\x4b\xb9\x31\xea\xc6\x64\x70\x04\x1f\xbd\xe5\xbd\x7b\x3b\xcd\x04\x89\x83\x3e\x94\xfd\x98\x22\x30\xf7\x49\x9a\x24\xe4\x3a\x1f\x43\xa4\x66\x3f\xe8\x68\x9b\x79\x35\x0a\xcc\x58\x45\xec\x9d\xb0\x06\x1c\x9b\x03\xf3\xfa\x8b\xf8\xf9\xa2\xb8\x48\x61\xf0\xc9\x53\xb2\xc6\x32\xfc\xc4\xa2\x38\xc8\xc8\xf6\x26\x61\x48\xa8\x31\xfe\x90\x13\x21\x8b\x00\xc6\x68\xb3\x04\x93\xee\x97\x3d\xaa\x5f\x72\xbd\xc0\xd4\x46\x1a\x02\x40\x4d\x07\x90\xc8\x55\x29\x28\xae\x51\x37\xe0\xc0\x08\x6f\x91\x96\xdf\x37\x50\x8c\xec\xdf\x34\x6c\x7a\x47\x0d\x7d\xc7\x85\xd1\xd9\xfe\x02\xc3\xf2\xc2\xe2\x5a\x49\xd2\xda\x04\x1f\xa5\x5c\x68\x1b\x35\x17\xda\xb0\xeb\xc4\x94\xb3\x3c\x87\xb0\x42\x18\x0b\x9c\x5b\x53\x38\xd7\x6e\x3a\x7c\xff\x77\x70\x78\x9f\x4a\x54\x3b\x83\x1f\xd2\x0a\xf6\xb5\x81\xff\xb2\xad\x63\x52\x08\x94\x16\xf5\xab\xb2\x89\xe2\x50\x67\x33\x46\x47\x7f\x2d\x0a\x17\x56\x63\x08\xb2\xd6\xa5\x6e\x90\x59\x6b\xfb\x63\xd6\xda\x92\x94\xe7\x76\x06\xe2\x14\x65\x9c\x86\xe5\xeb\xa0\x43\xc7\xa1\xdc\x46\xee\x71\x5b\xaa\x7e\x53\x01\xf1\xad\xfd\xe3\x51\xc5\xb7\x80\xf1\x29\x39\xd8\x86\xbe\x0a\xf1\x9b\x90\x22\xaa\xd0\x31\xcf\xa2\xde\x4e\x32\xd7\x27\x54\x5b\x29\xdd\xec\xca\x07\x74\x34\x58\x05\x87\xb5\xaf\xe3\x5c\x98\x70\x8e\xe9\xac\xe7\x6e\xe7\x9e\xb7\x99\x7b\xbe\xdc\xdd\xe7\xe3\x11\x55\x95\x9d\x31\x7f\x1b\x6e\x56\x41\xc7\x18\x6d\xa3\x76\x95\x95\x0e\xe8\xa8\x87\x36\x68\xb9\x52\xf1\xa9\xdd\x14\xa9\x0c\xec\xe4\xca\x9d\x7b\xeb\xcd\x7c\xbd\x13\xbb\x18\x67\xa7\xbc\x87\x9f\x4d\xe8\x1f\xb6\x2d\x3f\xb8\x81\xd2\x39\x52\x34\xa4\x67\xde\x26\xdc\x1e\x24\x4c\x0b\x00\xd3\x98\x3e\xf9\xcb\xb9\xb7\xf6\xe7\xde\x76\x25\x76\xea\x25\x2c\xb3\x2e\x1d\xed\x07\xdf\xf3\x03\x7f\x07\xfa\x13\x47\xcf\xdd\xb4\x0c\xb1\x66\x4a\xef\x78\xa9\xd4\x41\x23\xca\x68\xb9\x43\xae\xab\xa2\x5c\xc0\x28\x47\xf5\x34\x08\xe6\xde\x6e\x39\xdf\x88\x1d\x8d\xc2\xec\xdc\x27\xf5\xe8\xb8\x0c\x04\xfb\xa4\x4d\x94\x3e\xb2\xc2\x01\x3a\x3c\x44\xbe\xb7\x74\x15\x6c\x0b\x10\xdb\xa8\xde\xf9\xee\x3c\x58\x2a\xb6\x49\x76\xb2\xf4\x2b\x71\x7b\xda\x9d\xc2\x96\x23\xd2\x48\xe9\x1e\x2d\x1b\xa8\xc0\x10\xb9\x28\x90\xd1\x2d\x20\x74\xa3\xba\xb7\xda\xce\xfd\xd5\x6e\xee\x07\xae\xac\xbe\xb2\x27\x47\x05\x4d\xd9\x2d\x88\xbb\xfc\xaa\xa9\xae\xfc\x3a\x68\xf0\x45\xbe\xe7\xaf\x24\x4c\x9a\xda\x38\xf2\x81\xbd\x0a\xfc\x79\xb0\x9d\xaf\xd9\xd0\xfb\xeb\x73\x7a\xc8\xeb\x32\xcf\x5a\x27\xd2\x37\x2c\x6f\x18\x92\x98\xc1\xcb\x19\x4b\xfb\xba\x9d\x4e\x75\x85\xa9\x12\xc7\x4d\xe0\x89\x6e\x51\xed\xd9\xc8\xab\x26\x57\x7d\x5b\x84\x09\x2a\xa1\x3c\xc9\x83\x3e\x84\x7a\x9d\x77\x39\xea\xf8\x38\x21\x4a\x96\xa7\xba\x39\xac\xdd\x01\x40\x2b\x89\x5b\xab\xb8\x96\xbc\x2e\x8a\xab\x34\xae\xaa\xf8\x90\x20\xa5\xbf\x2b\x01\xbd\x00\x35\x5b\x1c\x93\xbc\x32\x5d\x60\xd5\x7d\xad\x33\x74\x1a\x64\x50\xf1\x85\x5c\x77\xe5\x6e\x03\x40\xe7\xc7\x23\x0a\xf4\x6b\xcc\xb6\x51\x88\x83\x49\x09\xd5\xec\x22\xdd\xc1\xcd\x40\x77\xa7\x63\xa4\x83\x8a\x52\x6a\x39\xf0\x37\x81\xcf\x01\x35\x3f\x66\xb9\x5d\x46\x2b\x20\x13\xc0\x07\xe4\xa3\x25\x0a\xd4\xa8\x73\x1d\x6d\xa3\x83\x86\x0c\x66\xf1\xb8\x3d\x1e\x8e\x27\x1d\x18\x60\xd2\x77\xfd\xa5\xbf\x6e\x41\x65\xef\xc3\x0b\x82\x8d\xbf\x82\x66\xac\x15\x8a\xba\xc4\xc8\x9c\xea\x12\xad\x8f\x07\x05\x15\xcc\xe0\xc1\x8b\x4e\x07\x0d\x14\x92\xe1\xc1\x47\xde\x92\x03\x8a\x9e\x83\x7b\x0c\x56\x6b\xe0\xc3\xff\x87\xc8\x43\xc7\x93\xa7\xea\x17\xa1\x00\x1d\x44\x3c\x30\x63\xe1\x21\x8a\x50\x20\xc1\x41\x5c\xad\xfd\xe3\xb2\xe5\x4a\x79\xf5\x6f\x83\xf5\xca\x85\x84\x76\x3a\x9d\x96\xc7\x48\x61\xec\x74\x42\xe8\x10\x2a\xa8\x60\xde\x4e\x27\xb4\x0d\x3d\x15\x14\x60\x2f\x58\x2e\x4f\x6e\xcb\x9e\xfc\xc2\xde\xf8\xde\x11\x54\xe9\x69\x1b\x6d\x34\x95\x9e\x82\xa3\xa0\x52\x8a\xc9\xc0\x9c\x77\x70\x0f\x1b\x05\x12\xe0\x6d\xb5\xf3\x7c\x6f\xd3\x4d\x2a\xc2\xeb\x76\xeb\x6d\xbd\xad\x0f\xb1\x86\xf0\x7f\x2a\x6b\xd1\x29\x3a\x21\x09\x11\xcc\x19\x3a\xa2\xe3\x69\x2d\x03\x02\x8c\xad\xb7\xf8\xbf\xae\x03\xdd\x6b\xd2\x3b\x78\xc8\x87\x06\x2a\x19\x93\x3b\x75\x14\xac\x8f\xdb\x63\x28\xe2\x31\x0c\x81\xdd\xe1\x70\x40\x12\x1c\x64\x69\x2b\x37\x70\x83\xb7\x7f\xe6\x1f\x79\xbe\xa2\xd7\x53\x19\xa6\xa8\x9a\x15\x65\x7e\x2e\x51\x55\x39\x87\xb0\x74\xaa\xba\x8c\x0b\x54\x5d\x4f\x65\x9e\x8a\x3b\x19\xdb\xf9\xd5\xa3\xa9\xba\xeb\x1c\xac\x75\x67\xee\xdb\xdb\x3f\xbf\x23\xee\x05\xc7\xd8\xbf\x1e\x2d\xe6\x29\x55\xbe\x0d\x49\x2b\x19\xe2\xad\xea\xfa\x2d\x15\x43\x3e\x57\x88\xbd\x9c\x3e\x23\x8f\x7c\x3a\x89\x2d\xb5\xa8\xc7\x93\x58\xb1\xda\x4f\xc1\xab\xba\xf1\xf6\x77\xb6\x62\x2a\x2c\x77\x10\x1f\x66\xb6\x58\xd3\xd5\x93\xc1\xcb\x26\x92\x90\xf4\x0c\x86\x80\xa5\x44\xfa\x56\x5a\xac\xb7\xb0\x74\xce\x58\x01\x28\xab\x7f\x58\x05\x11\x3a\xcf\x81\x8d\xba\xc1\xe3\xcc\x0f\x3e\xce\x05\x87\x45\xfb\x1d\xb8\x1f\x0d\x2d\xcd\x35\x1b\x05\x87\xf2\xfb\x51\xcf\x3b\xd3\x65\xec\x92\x7a\x18\x66\x71\x1a\xd6\x28\x6a\x3f\xd7\xd2\x02\x2c\x10\x68\xcc\xcc\xbc\x6a\x46\xfb\x3e\x8b\xb3\x53\x9c\xc5\x35\x7a\x1a\xdd\xe2\x26\x55\xd9\x38\xa5\xd9\x98\xa5\x9f\x6f\x6f\x0b\x42\x64\xc4\x30\xa0\x36\x49\x4f\xdd\x89\x69\x92\xc4\x13\x77\x04\xa7\xba\xbb\x91\x6d\x1c\x79\x13\xb7\xa3\x7e\xef\x7c\x58\xf0\x84\xd0\x31\x44\xfa\xe2\x84\x04\xa5\x98\x2d\x57\xce\x6f\x28\x8c\xcd\xd6\x17\x85\x71\xf0\xcd\xcc\x86\x5a\xf9\x2b\x95\x07\x91\xe9\xff\x4a\xc5\x82\x52\x13\x0d\xf9\x4b\xa1\x31\x16\xe5\xf7\x8c\x28\x58\x86\x7f\xe7\xeb\xd9\xd8\x08\x7f\xb9\xd3\x77\x32\x2a\xf4\x4d\xbb\x8e\xc5\x95\x7a\x65\x23\xb3\xb6\xf6\x6e\xd2\x91\x79\x2f\x31\xd4\xdc\xf2\xa9\xc0\x44\xa0\xfd\x30\xa5\x19\x80\xe9\xce\x24\xe8\x3b\x95\xe1\xe2\x27\x95\x16\xd3\xb3\xf6\xc9\xf3\x86\x2f\x6b\x0a\xea\x1f\x35\xa3\xd0\xb7\x7f\xf7\x37\xe2\x0c\x5a\x72\x79\xb2\x01\x57\x34\x12\xb6\x4b\x5e\xc6\xbf\xe7\x59\x1d\x26\x63\x8e\xb8\x82\x08\x7e\x1e\x62\x5e\xc6\x8f\xa6\xb6\x7d\x1f\x03\xc9\xe9\x16\x37\xec\x03\xab\xb2\x7b\x65\x18\x35\x40\xe2\x83\xdb\x0e\xd0\xb9\xa0\x3c\xc2\x1e\x64\x09\x63\x28\x28\xec\x76\x9b\x04\x74\x22\x9e\x75\x63\x24\x48\x5f\x4a\x92\x76\xa3\xf9\x90\x1c\x5c\xdf\xd5\x82\x40\x8a\xef\x69\x44\x10\xc1\x11\x76\x04\x35\x9f\xdc\x94\x06\x11\x19\x69\x4d\x96\xb4\x5b\x30\x0f\x62\xfa\xad\x5b\xcd\x29\x8d\xbe\xb7\x39\x41\x14\xdf\xd5\x9c\x00\x82\x63\xcc\x09\x68\x3e\xbd\x39\x0d\x21\x72\xbf\x39\xf1\x84\x4e\x30\x0f\x62\x62\xa7\x5b\xcd\x29\x39\x7f\x6f\x73\x82\x28\xbe\xab\x39\x01\x04\xc7\x98\x13\xd0\x7c\x7a\x73\x1a\x42\xe4\x7e\x73\x6a\x33\x05\xc1\x4c\x34\xf7\x3b\x4b\x24\x21\xcd\xf7\xb5\x27\x88\xe2\xbb\xda\x13\x40\x70\x8c\x3d\x01\xcd\xa7\xb7\xa7\x21\x44\xc6\xda\x93\xd8\xfe\x94\x3c\x57\x17\x75\x6f\xb2\x0e\xa1\x31\xc1\x9b\x30\xf6\xe9\x9d\x73\x03\xda\xf5\x1e\xe1\xd4\x43\xd9\xa1\x9f\x78\xf8\xd7\x1c\x43\xfb\x91\x41\x7a\x4f\x2b\xe9\x43\xa8\x91\x25\xfe\xd5\x68\x1c\x72\x65\x7f\xaf\x29\x86\xa3\x34\x95\x18\x0e\x17\xea\xf4\x86\x7f\x86\xe2\x5f\x9c\x8c\x18\x46\x8a\xb1\xb7\x9d\x24\x48\x23\x5b\xfc\xdb\xd6\x58\xf4\x83\x44\xc9\xa9\x4a\xa2\xa4\x85\x00\xc5\x81\x1f\xcb\xf8\x77\x31\x43\xfb\xb1\x62\xb4\xb7\x92\x84\x68\x64\x89\x7f\x7f\x1b\x87\x7c\x90\x08\x39\x4d\x49\x84\xb4\x50\xa7\x37\xe8\x83\x1e\xff\x76\x07\x35\x1e\x29\x3c\x5b\x13\x79\x1c\x9b\x98\xe1\x1f\x08\x47\x60\x1e\x36\x88\x19\x41\x79\x10\x93\x42\x9d\xd8\xf0\x2f\x8e\xf4\xe3\xa2\xa1\xfd\x48\xe1\xf5\xb4\x92\xe4\x67\x63\x89\x7c\xc4\x1c\x87\x7c\x90\x08\x39\x4d\x49\x84\xb4\x50\xa7\x37\xf4\xab\x28\xfb\x00\x0a\x37\x1f\x29\x40\x7b\x23\x49\x7e\x66\x86\xd8\x77\xd6\x51\xb8\x07\x89\x8f\x93\x94\xc4\x47\x0b\x75\x72\x03\x3f\xdc\xb2\x6f\xb4\x60\xeb\x91\xc2\xb3\xb6\x91\x6d\xcf\xc4\x0e\xff\x12\x3c\x06\xf5\x30\xcb\x63\x14\x65\xcb\x23\x85\x90\xa6\x06\x7c\x5a\xe6\x5f\x91\xa1\xc6\xa3\xad\xce\xdc\x44\x7e\x5b\x98\x98\xe1\x9f\xaa\x47\x60\x1e\xf6\xaa\x60\x04\xe5\x57\x05\x29\x7c\x63\x1b\x84\xe8\x45\x22\xc4\xcb\x97\x4e\x50\x68\x97\x7e\xeb\x1b\xdd\x5b\x47\xc8\xa5\x5f\x50\xda\x2d\x65\x5e\xd1\xcc\x5c\x72\xd3\x54\x7b\x79\xfc\x22\x60\x04\x55\x6f\xce\x05\xbf\xbe\x70\x60\x72\x5a\x90\x2f\xe1\x3f\x92\x9f\x8b\xee\x27\xd3\xc7\x10\x50\x4a\xb5\xe5\x66\x13\xbc\x1d\x9e\xeb\x3a\xcf\xf8\x36\xa9\x61\x57\x67\xb3\xcf\x2a\xee\x5b\x48\xdb\x75\x59\xa2\x80\xef\x09\x6f\x8b\x3a\x0f\xab\xda\x78\x8b\xca\x32\x70\x8b\x46\x4c\x2a\x48\x0b\xba\x04\xbd\x0c\x40\x3b\xa8\x01\xe7\xb2\x90\xbe\xc9\x6e\x83\x47\x2d\x17\x4e\x77\xe7\x4b\x5f\x32\x9c\x47\x79\x87\xa0\x74\xf1\x8a\x0a\xc9\x45\x6a\xfa\xe4\x47\x64\xa0\x1f\x34\x87\x13\xa8\x10\x60\x92\xbe\x0a\xbf\x7c\x39\x6a\x4f\xac\x50\xae\xd4\xd3\x60\x2e\x71\xa4\x5d\x81\x85\x2b\xf8\xd1\xd0\xb1\xdf\x5f\xcd\x57\x2f\xc1\xf7\xd2\xc8\x9f\x99\x26\x53\x95\x3d\xaf\x8a\x1b\x3c\xbe\x53\x5a\x20\x2a\x3b\xf2\x81\x59\xfe\x00\xf9\xb6\x48\xf3\x28\x4c\x9c\xbc\x40\x99\x7e\xc4\xb8\xab\x9b\xd1\xe7\xee\xac\x72\xc3\xf7\x60\xb4\x25\xaf\x2c\x9d\x26\x05\x6c\xbf\x83\x9e\xe2\x06\x45\x6c\xbb\x22\xfb\xca\xdc\x7e\xc0\x75\x03\xf7\x49\x54\xf2\x13\x70\x29\x3e\x7e\x56\xf7\x7d\x08\x57\xd5\x53\x16\xa3\x38\x4c\xf2\x33\xf0\xf1\x95\x22\xa4\x79\x81\x88\xad\xf2\xec\xaa\xd0\x40\x27\xb8\x16\xa7\x30\x42\x33\x19\xaf\xb0\x9f\x43\xba\x09\xfc\x94\x97\xe9\x6c\xb1\x64\x07\x6d\x94\xfb\x75\x6f\x85\x98\xf7\x90\xd0\xaa\x29\x9e\x24\xac\xd1\x0f\xee\xdc\xc1\xb3\xcd\xe3\x93\xad\x72\xf0\x46\x89\x21\xf2\xe0\xbb\x22\x08\x28\xbd\x00\x51\x02\xd5\xb9\xed\x2e\xef\x6d\x7f\xf2\xf6\xb4\x25\xbd\x1b\xab\x17\x4f\x75\x0c\x13\xf4\x83\xb7\x70\x7d\xb1\xbb\x42\xa9\x6c\x1c\x4e\x75\x2c\xf3\x24\xc1\xf3\x7c\xff\xec\x81\x67\x6f\x66\x80\x64\x38\x91\xfb\x95\x1c\xb2\xd3\xc5\x8c\x96\x73\xcc\x36\x2d\x5d\x01\x24\xdf\x2e\x1c\x8b\x6a\xd3\xbd\x58\x79\x86\x86\x1e\x30\x36\x3f\xf6\x26\xd5\xe9\xa5\x47\x26\x0b\x78\x70\xf3\x46\x74\x06\x45\xd1\x84\xb3\xb1\x90\xbc\xab\x4f\xf0\x9c\xba\x76\x8c\x9d\xbe\x52\xcc\x92\x67\x35\xdc\xa2\x30\x49\xa6\xb2\xb6\x2a\xcd\x7f\x87\xca\xc5\xa2\xee\xc2\x2e\x03\x5f\x46\xeb\x7b\xcf\x1d\x72\xc2\xac\x39\x92\x2d\x8b\xf5\x0a\x43\x74\x28\xb6\x56\x29\x9c\x3f\x11\x07\x27\xf1\x1d\x12\x10\x0a\x2f\x14\x65\xca\xb7\x9d\x63\xbe\xd5\xe5\xf2\xb5\xec\x83\xe4\xd0\xaf\xf6\xba\xc2\x04\xa2\x32\x2f\x06\xbe\x25\x57\x42\x0a\xac\x6f\x2f\x82\x8e\xbf\x5d\xc0\x65\x4e\x8d\x0e\x99\xc3\x5b\x47\x4c\xaf\x26\x1e\x99\xe8\xe3\x4b\x73\xc9\x94\x7b\xdd\xee\xba\x20\xb4\xdd\x6d\x68\xf4\xa6\x94\x3b\xca\x61\x2f\x6a\x39\xd8\x89\x5a\x8a\x3e\x94\x28\x93\x99\x12\x70\x78\xca\x71\x9a\xbd\x43\x4a\xc4\x7f\xc5\xf9\x13\xca\x3e\xe8\xea\x27\xb7\x85\x89\x58\x1f\x2a\x7d\x69\x10\x45\xd6\xde\xa4\x77\xc8\xdf\xf0\x66\x53\x94\x45\xf0\xd5\x03\xb8\x42\xf6\x4c\xe1\x54\x7e\x8a\x82\xcd\xf9\xd7\x00\x25\x1b\xd3\xaf\x01\x6a\xa6\x92\xfa\xf9\x0f\x57\xee\x35\xfa\xa2\xb7\x4c\xe7\xb9\x43\x58\x3a\x29\x0a\xab\xe7\xd2\x74\x40\xc7\xd9\xed\x76\xbb\xa2\x61\xa3\x97\xc4\x81\x4c\xbf\xe4\xb9\x75\x01\x28\x3e\xcb\x16\x1d\xc9\x15\x12\xae\x76\x74\xdd\x36\x73\xda\xde\x63\x91\x1d\xf0\xa2\x16\x5e\x3e\x06\xcf\x66\x49\xdc\xe2\xfb\x7d\x1b\x18\x4f\xeb\x28\x18\x5e\xef\xf6\x56\xed\xbb\xc4\x42\x70\x9a\x97\x7a\xab\xdf\x54\x10\xf2\x12\x0b\xd9\xb6\x43\x81\xb6\x49\xce\xdc\x37\x6b\x12\xa1\xf5\xd6\xd4\xba\xfd\x20\x0d\x34\xf2\xbc\x15\x69\xb5\xa8\xf3\x3c\xa9\xe3\x02\x30\xaf\xee\xed\xb0\x71\x95\x2d\xa6\xcc\x1e\xd8\xc6\xff\x53\x98\xc6\xc9\xeb\xde\x09\x8b\x22\x41\x4e\xf5\x5a\xd5\x28\x9d\xff\x4b\x12\x67\x5f\x7f\x0d\x8f\x7f\x21\x3f\xff\x2d\xcf\xea\xf9\xc3\x5f\xd0\x39\x47\xb3\xff\xf9\xef\x0f\xf3\xff\xc8\x0f\x79\x9d\xcf\x1f\xfe\x3b\x4a\xbe\xa1\x3a\x3e\x86\xb3\x3f\xa3\x67\xf4\x30\xff\x63\x19\x87\xc9\xfc\xe1\xcf\x79\x9d\xcf\xfe\x12\x66\xd5\xc3\xbc\x0a\xb3\xca\xa9\x50\x19\x9f\xe6\x0f\x7f\xc4\x04\x66\x7f\xc2\x2f\xa1\xd9\xbf\xa6\xf9\x5f\xe3\x87\x0e\xa7\x5e\xf0\x97\xd7\xf4\x90\x27\x0f\x0c\x9b\xd8\x8a\x2d\x95\xb0\xd4\x3a\x65\x1a\x26\xd2\x02\xd6\x4a\x5d\xc0\x5a\x04\xe2\x06\x63\x3c\xba\xc5\xdf\xf4\xad\x03\x6e\x0d\x16\x57\xbb\xba\x02\x25\x50\x49\x50\x8d\x9d\x06\xfc\x42\xc2\x73\x13\x63\x88\xe4\x32\x26\x69\x8c\xa5\x12\x15\x4a\x3a\x81\x40\x8a\x68\x7e\x0a\xd2\x90\xce\xd9\xda\xb2\x10\x98\x26\x59\x78\x75\x33\x93\x50\xde\xd9\xbb\xb6\x62\xb6\x08\xcb\x32\x7f\x01\x4c\x46\xb6\x12\x6a\x69\x8b\x6d\xd9\xdd\x4f\xbb\x58\xb1\xe5\x1b\x11\x51\x3b\xfa\x74\x84\xe2\x9d\xb5\xe2\xda\x24\x70\x8e\x92\xaa\x93\xcc\xe1\x6f\x8b\x43\xe5\x30\x1a\x0e\x16\xc2\x6f\x8d\x53\x24\xe1\x11\xa5\x28\xab\xff\xcf\x4f\x75\x5e\x7c\x99\x8b\x20\x35\xf6\x93\xf8\xab\x61\x45\xcf\xd0\xf4\xa3\x60\xdc\xab\x98\xb8\x74\xba\x0c\x8c\x43\x31\x71\x39\x18\x30\x76\xf9\xa8\x88\x0f\x27\x7d\xeb\xa7\x5c\x33\xde\x9f\xf4\x23\x4e\xc4\x63\xb3\xf2\x41\x5e\x70\xb2\x58\x48\x51\xb7\xe2\x39\x63\xca\x1b\x80\x06\x12\x4d\xc9\x8e\x7b\x11\xe1\x30\x07\x54\x60\xbe\xb5\x90\xed\x48\x22\xa0\xd4\x44\x62\xad\xdc\xba\xc4\xb3\x06\xc9\x29\xf2\xa3\xef\xfc\xc1\x12\xa4\x0a\x97\x45\xc8\xf2\x17\x8e\x33\x2e\x86\x08\x12\x22\xad\xe2\x52\x64\x3b\x61\x46\x20\x03\x85\x25\x21\x6d\xa5\xa5\x9e\xd0\xe0\x9b\x4a\x04\x51\x29\x6e\xcf\x60\x49\x61\xed\xcb\x72\xc2\x25\x23\x2d\x8d\x20\x81\x64\x84\x2b\xb8\x84\xb8\xca\x6f\x34\x34\x91\x06\x28\x3a\x81\x56\x2b\x38\xf9\x38\xb9\x68\x65\xa0\xf0\x88\xb3\x28\x8a\x8e\xe3\x8e\xb3\x0c\x95\xc2\x2b\x9b\xbc\xcd\xb5\xc5\x64\x71\x2d\x19\x3e\xa5\x06\xc6\x6f\xa6\x83\x78\x79\x41\xbe\x7f\x98\x4e\x83\x6b\x81\xe3\x5a\x77\x0d\x5a\x7e\xb1\x67\xf9\x5f\x1e\xc2\x7f\x1a\x0f\xe1\xbb\xac\x64\xb4\x26\x38\xce\xc9\xf0\xc4\x81\x2d\x64\x63\xc0\x73\x09\x84\x76\xbf\x0f\x4f\x64\xad\x53\x2d\x36\x7a\x22\x32\xd5\x3b\xfc\x12\x46\xd1\xe6\x97\x70\x10\xec\x97\x28\xdf\xbf\x02\x3e\x69\xd9\xd1\xfc\x2c\xcc\x8b\x02\xb6\x9f\x65\xdf\x84\x84\x39\xce\x42\xfa\x8e\x33\x14\xb5\x34\x1d\xea\x24\x7a\x5e\x23\x8b\x6e\xf2\x82\xfc\x15\xc9\x4e\x82\x51\x5c\x31\xbd\x1a\x98\x22\xb5\xd7\x6e\x51\x67\x14\x57\xf4\x94\x97\x95\x15\xc1\x8b\xe2\x40\xd4\x8b\x12\xb7\x81\x0e\x51\x22\x45\x04\xa9\x91\xd4\xfc\x2c\xfa\x51\xba\x1a\x9f\x84\xfe\x48\xc7\xb8\xf9\x5a\xc3\xb2\xf3\x43\x06\xf2\x00\xea\x5b\xe4\xc5\xfe\xfe\x13\x24\xab\xc8\x57\xf4\xb2\xc6\xe9\x5d\xe1\x4f\xd7\xbc\xcc\x1e\xd5\x3d\xcd\xf6\x6e\xd3\xbc\x85\xbf\x01\x16\x20\x7a\x81\x1c\x8a\x79\x81\xc2\xfe\xe5\x21\x26\xc0\x30\x41\x36\x40\xab\x7e\x16\xdc\xc0\xd1\x43\x59\xc6\x0e\x6a\x57\xa2\x62\x8b\x3e\xdc\x99\x26\x3f\xd0\x35\x1c\xa7\x5e\x95\x43\x5d\xbf\x0a\x83\x54\xc1\x6c\x35\xef\x36\x0e\x87\x2b\x78\xc6\x5f\x1d\xfc\x82\x12\xb3\x04\x4d\xa0\x76\x2f\x2b\x70\x3f\x9a\x5f\x75\xf2\x15\x1c\xcc\x07\x54\x5f\x49\xfa\xda\xf5\x69\x83\xff\xeb\xe9\x60\xe7\x9d\x73\x18\xe2\x9d\x4b\xf7\x29\x0c\xb1\x5f\x82\x07\xb2\x5e\x5c\xf1\xb3\xe4\xa0\xbf\xd7\x0c\x26\xb2\x00\x2a\x48\x60\xa5\x27\x4c\x0c\x98\x03\xaf\x1b\x90\xe0\xc0\x8f\x33\x70\x99\x3b\xdd\xbc\x25\xe6\xa8\x71\x97\xed\xa5\x43\x37\x70\x47\x8d\x5b\xb6\xc5\xab\x2d\x2b\x74\xfb\xee\x16\xf6\x7b\x19\x8e\x92\x13\xc3\x32\x1b\x1e\x3a\xe0\xff\xde\xe5\xa3\x89\x32\xb6\xa0\x6c\xa0\xdd\x70\x14\x77\xa7\x00\x9b\x71\xe8\x59\x7c\x92\x6e\x3f\x7f\xae\x50\xa2\x7f\x14\xe9\xea\x16\xd2\xd7\x3e\xf2\xc9\xb5\xce\x9f\x8f\x17\xbe\xa1\xaf\x08\x33\xe7\xf5\x49\x2f\xea\x30\xb0\x08\xcf\xb4\xa5\x04\xda\x97\xa2\x36\xe6\x76\xa1\x78\xa7\x09\x0a\xcb\xfd\x21\xaf\x2f\xd2\x37\xe4\xae\xa9\x3d\x8d\x00\x89\x39\xe8\x76\x3f\x12\xc9\x08\xec\x28\xd7\xb4\xe0\x22\xbe\x1e\x8e\x8d\xe2\x14\x1e\x91\xf3\x2d\xae\xe2\x43\x9c\xc4\xf5\x2b\xdf\x4c\x63\xa9\xb2\xef\x7b\x59\x4b\x09\x66\x0d\x1b\x5b\x6e\x00\x82\x36\xc0\xac\x6f\x4b\x66\x2b\x0b\x55\xdf\xb6\x22\xd5\x3b\x19\x6a\xea\xb9\x52\x56\x94\xe8\x9b\x52\xc6\xf7\x6c\x4a\x6a\x7d\x63\xa5\x4a\x73\xa2\x0b\x15\x27\xa6\x43\x77\x36\xca\xe5\x58\x9f\x8f\xc0\x4e\x97\x76\x0b\xcf\xff\x22\x1f\x4c\xc0\xdd\x3d\xac\xca\xc0\x06\xc6\x0c\xf5\x0c\xe2\x82\xb0\xdc\xc3\x86\x63\xe1\xc3\x61\x8c\xb4\x58\xe9\x06\x22\x59\x15\xdd\x7e\xc3\x4e\x29\x4e\x51\xe6\x05\x2a\xeb\xd7\x3d\xab\x05\xb6\x3a\x81\x9b\x87\x6c\x94\x88\xb0\xed\xd2\x80\x5a\x61\xe1\xf4\xa8\x12\x68\xa6\x26\x95\xf0\xc4\x8d\x8d\x4a\xc3\x21\x7a\xb2\x40\xd2\xc0\x85\x13\x72\x9f\x20\x81\x72\x39\xce\xdc\x0a\x8f\xa0\xf1\xa3\x66\x42\x4e\x2d\x63\x0f\xcf\x82\x65\x9e\xa8\xc3\x8f\x17\x63\x55\x18\x9d\xb1\xf6\x25\xd8\x89\x7c\xba\xcd\x48\x63\x36\xde\xb0\x69\x38\xf8\x68\x5f\xee\xeb\x36\x59\x40\x7a\x6a\x93\x76\x8f\x57\xd5\x10\x21\x0e\xd4\x41\xbb\x21\x1b\xac\x24\x5b\xb0\x0d\x14\x8c\x2d\x49\xa5\x96\x09\x15\x5c\x73\xeb\xf2\x9b\x8a\x1f\xb7\xe0\x0e\x09\x37\x62\xe9\x9c\x72\x57\xd1\x50\x4d\x6e\xb3\x35\xb0\x4a\x6f\xba\xb5\xdc\xe1\xe3\x0b\x9f\xf2\xc9\x73\xe7\x74\xed\xb3\xdc\x29\x51\x81\x42\x92\x82\xeb\x33\xf9\xc2\xdd\x5e\x5d\x67\x22\xf5\x0e\x97\xea\x9e\xe2\x24\xf9\xe9\xd3\x47\x7f\x79\x3a\x9d\xda\x2b\x76\xb7\xdd\x0d\xbb\x5b\xe5\x82\xdd\xed\x6c\x2b\x5e\xaf\x1b\xfd\xf4\xe9\xd7\x60\xe1\x07\x33\x37\x71\x56\x33\xfa\x9f\xb7\x08\x1c\x6f\x11\xfc\xb2\xc2\xe5\xab\xc4\x5f\x04\x8e\xbf\x08\x7e\xa1\x60\xbf\x03\x37\xe2\x9a\x05\xff\x77\xda\x63\x7f\xb1\x21\x3d\xf6\x16\x01\xee\xed\x2f\x4b\xfc\x7b\x95\xe0\x6e\xce\x70\x57\x49\xfd\x36\x59\x39\xe4\x3f\x6b\x97\xe3\x2c\x8a\x8f\x61\x9d\x97\x15\x30\x79\x99\x6e\x59\x6c\x67\xb1\x60\xc4\x34\x36\x60\x76\x82\xd3\x7c\xf1\xdb\xf7\x3e\x2a\x97\xed\x7d\x54\x6f\xb7\x01\xbb\x35\x4b\xe2\x2b\x39\x60\x10\xff\x8e\x3d\x76\x46\x91\x2c\x2d\xb7\x7b\x93\x5c\x69\x6f\x52\xfb\x8b\x6f\xac\xe8\x06\xd1\xb2\xbb\x01\xb0\x54\x0b\x08\x57\xf8\x37\x99\x2f\xb0\x80\xb2\x9a\x6c\xad\x29\x1a\x7e\x29\x09\xf3\xf6\x6f\x5e\x05\xa7\xbb\x8c\x5c\x5b\x26\x60\x1e\x36\xc1\x40\x3d\xf3\xfa\xd8\x6c\x82\x06\x71\xdb\x1c\xd8\x0e\x90\x7b\x21\x90\xef\x71\x0c\x0b\x92\xab\xcd\x64\x92\x58\xf9\x3c\x15\x34\x56\x4f\x6b\x11\xdd\x47\xa5\xd6\x98\xb0\xcc\x7c\xe1\xab\x97\x23\x36\xb4\xbd\x05\xa1\x34\x9d\x55\x41\x42\x26\x87\x4a\xfb\x5a\xe7\x80\x03\x5a\xe6\x75\x58\xa3\x1f\x96\x6b\x37\x42\x67\xd1\xfb\x94\x2b\xa4\x14\x9d\x93\xe2\x5d\x28\xd8\x6c\x6f\x08\x61\x69\x84\x3c\x2b\x57\x85\x10\xa1\x50\x81\xf1\x2f\x31\xf4\xa2\x12\x6a\x5b\xc7\xe7\x12\xdb\x15\xf9\xea\x05\xad\x75\x9a\x53\x55\x07\x42\xd4\xd7\x25\x2f\x94\x19\xc7\x81\xb5\x2d\xe3\xe2\x00\x68\x55\x16\x4e\x95\x5e\xf5\xaf\x3d\xe2\x66\x4d\xb6\x18\xe2\xa3\xd4\xa6\xff\x73\x99\xbf\x5c\xdd\x8f\xc6\xfd\xfe\xae\xbe\xd9\xdf\x7d\x7c\x0b\xdc\x8f\x9d\xb9\x0f\x0c\x1b\x20\x2b\xf9\x8e\xd4\x17\x12\xcd\xa9\x2c\x49\x9d\xff\x40\x3b\xea\x0c\xa5\x8b\x1a\xcc\x26\x83\xf9\x1b\x6a\x30\x46\x58\xb9\xbb\x66\x63\x79\x5b\x50\x3f\x9c\xdf\xa4\x73\x35\xdc\xb0\xf3\x4f\x71\x5a\xe4\x65\x1d\x66\x35\x6f\x51\xe7\x85\x0a\x5c\xe7\x85\x0e\x97\xc6\x51\x94\x68\x78\x69\xa9\x0e\xcd\x3e\x0e\xa8\x5c\x90\x52\x80\x87\x4e\x15\x1a\x2f\x5d\x95\xa1\x1d\xd4\x01\x56\x2e\xb6\x38\x9c\xdb\xf4\x1e\xa6\x34\x85\x02\x78\x28\xc0\x77\xb7\x13\x9c\xe5\x6b\x6c\xe6\xec\x10\xa5\x06\xaa\x97\x53\x1f\xde\x74\xd7\x8d\xc2\x67\x97\x43\xc3\x74\x0f\x8d\xca\x29\x70\x1d\xcd\x59\xbd\x8b\x46\xe0\x4a\x05\x87\x6a\x4c\x1c\xd3\x4b\x6b\x54\x8e\x59\xaa\x0a\xd3\x55\x32\x1a\xbf\xea\x8d\x32\x67\xf9\x3a\x19\x91\x23\x09\x54\x2f\x37\xf1\x49\xef\x9c\x51\xf8\x24\xf9\x20\x4c\xf7\xc1\xa8\x4c\xca\xd7\xc2\x9c\x85\x3b\x61\x04\x36\x04\x20\xa5\xd0\xc8\x18\xb9\x32\x46\x61\x8c\x67\x5c\x30\x5d\xe8\xa2\xf2\xa6\xdd\xeb\x72\x96\x2f\x75\x11\x98\x91\x41\xf5\x72\x13\x9f\xf4\xda\x17\x85\x4f\x96\xd6\xc0\x74\x25\x8b\xca\xa6\x7a\x33\xcb\x59\xba\x96\x45\x60\x46\x02\xd4\x8a\x4d\x2c\xd2\x9b\x5b\x14\x16\x69\xea\x00\x53\xfe\x5a\x95\x43\xe5\x6e\x95\xb3\x78\xb1\x8a\xc0\x88\x08\xa6\x96\x1a\x05\x48\xae\x5d\xd1\x04\x58\x7e\x05\x60\xe9\xa5\x28\xba\xf8\xc4\xbb\x51\xce\xc2\xc5\x28\x92\x8c\x5a\x20\xa5\xd0\x68\x81\xe4\xde\x14\xd5\x02\x2f\x71\x8d\x40\xfb\x3b\x29\x90\x82\x0f\xa5\xc3\x0b\x95\x52\x33\xea\xf8\x69\x7b\x67\xd8\xb9\x04\x1d\x54\xb9\xa0\x79\x50\x13\xba\x62\x26\xfe\x18\xd4\x0c\xba\x8e\x79\x50\x43\xf2\x05\x51\x78\x1e\xd4\xc8\xe5\x42\x70\xe1\x4e\xb7\x00\x34\x25\x98\xa9\x9b\x1d\x18\x8b\x89\x8d\x1d\xeb\x20\x79\xd4\x6c\xe8\x4a\x07\x48\xc3\x5d\x00\xac\x7d\x83\x2a\xb9\xa0\x94\xb7\x27\x07\x17\x5e\x64\x52\x03\xed\x25\xd6\x36\xe0\xef\x11\x09\x5c\x7b\x87\x70\x70\x3a\x9d\xcb\xe9\x1d\xd4\xa9\x9c\xc3\xb6\x33\xac\x04\xae\xcd\xae\x1c\x9c\x4f\x74\x12\xb4\x36\xc9\xb5\xe2\x13\x2d\xcf\x34\xdf\x74\xa8\xcb\xaf\x0a\xac\x36\xfc\x5b\xae\xe9\xa8\x54\x78\x96\x64\x4d\x46\x1f\x8a\xb0\x6f\xa8\x6e\x7f\x2c\x51\x0a\x40\x6a\x60\x01\x0c\x67\xbb\x23\x5d\x6d\x64\xfc\xb6\x69\xc6\x2e\x8d\xd6\xde\x76\xfd\x37\xc1\x42\x34\xfa\x6f\xb0\xef\xa1\x63\xeb\x73\x47\x46\x9c\x08\x86\x4a\x6a\x1c\x81\xb3\xaa\xb4\xa5\x01\xf2\x18\x97\xc7\x04\x5d\xb5\x90\x05\x82\x25\x57\x44\xaa\x90\x06\xbc\xae\x9a\xb9\x4f\x04\x22\x9f\x66\x4f\x71\x33\xea\xc3\x6d\xe4\xe0\x80\x4e\xfa\xa8\x2d\xe2\x8c\x1c\x1a\xd3\x29\x21\x1e\x04\x42\xc3\x3e\x30\x16\x94\xc1\x65\x38\x00\xa0\x96\x8e\xb9\x93\x5f\x00\x80\x23\x46\x9e\x6d\x09\x04\x78\x44\x49\xa2\x40\xe2\x22\x19\xf4\x94\xa0\x06\x3a\x5b\x28\xd8\x8c\xb8\x80\x09\x4a\x40\xc3\x21\x94\xc3\xa8\x04\x00\x01\xa3\xf9\x28\x1d\x9e\x60\xfa\x34\x56\xa5\x43\x94\xd6\x42\x0d\xd6\x5b\x95\xf6\xab\xae\x4a\xfb\xb5\xc7\x61\x86\x28\xb0\x85\x1d\xa4\xc3\x2a\xbd\x4f\x8d\x9d\x4c\xa6\xd2\xa4\x25\xd3\x74\xe4\xa4\xbd\x83\x2f\x1d\x34\xfe\xd2\xd1\x43\x30\x1d\x30\x0a\xd3\x01\x03\x31\x1d\x31\x16\xd3\x51\xc3\x31\xbd\x73\x44\xa6\x93\x0f\x4a\xcb\x19\x4a\xfc\x6a\xe8\x53\x65\x72\x1e\xa2\xca\x16\x6a\xb0\x2a\x93\x73\xbf\x2a\x93\x73\xbf\x2a\x39\xcc\x10\x55\xb6\xb0\x83\x54\x99\x9c\xef\x53\x65\x27\x93\x77\x54\x65\x7b\xa0\x35\x72\x9a\xa4\x4f\x97\x4d\x32\x44\x97\x2d\xd4\x60\x5d\x36\x49\xbf\x2e\x9b\xa4\x5f\x97\x1c\x66\x88\x2e\x5b\xd8\x41\xba\x6c\x92\xfb\x74\xd9\xc9\x64\x6a\x5d\x16\x65\x9c\xd5\x58\x7d\xe4\xa1\x4f\x83\x14\x68\x80\x12\x45\xc0\xc1\x7a\xa4\x8d\x7a\x55\x49\xc1\x7a\xb5\x29\x80\x0d\x51\xa8\x08\x3e\x48\xa7\xb4\xc1\x5d\x6a\x95\xa4\x34\x99\x66\x17\x28\x3d\xe0\x10\x05\x55\x45\x9e\x55\xf1\x37\xe8\xc2\x75\x70\x1b\x33\x49\x98\xd2\x66\xd3\xd3\xf6\x39\xaa\x68\x0d\x39\x78\x44\x37\x59\x6d\x32\xd3\x4a\xc8\xe6\x9b\xb9\x0e\x48\x0a\x80\xf2\x98\x7c\xbc\x01\x2a\xf2\xc3\x5f\xd1\xb1\x06\x2a\xbe\xc5\x11\xca\xfb\xf7\x12\x49\xe7\x5f\xb5\x64\x64\x6d\xf6\x40\xbd\x03\xbe\x77\x78\xdd\x75\x1b\xc8\x85\xef\xa4\x2b\x7f\xb1\x0d\x36\xde\x6a\xf9\x11\x68\xe6\xad\x4d\xcd\x82\xf5\xc2\x0f\xa0\x26\xab\xc3\xeb\x12\x6c\xb1\x01\xc1\xbd\xc3\xab\x07\x82\xd3\x9d\x29\x64\x1b\x01\x1e\x18\x70\x0e\x7c\xc1\xde\x6c\x95\x0c\x0f\xcd\xbc\x63\xce\x72\x64\xc6\xa6\xd6\x77\x8c\x39\x25\xfa\x86\xca\x0a\xcc\x9d\x24\x54\x5b\x19\xd5\x81\x24\x86\x6d\x24\x64\x88\xbe\x0e\x18\x09\xbd\x94\x61\x71\xd5\x73\xab\xa8\xf8\x80\x0a\x86\x80\x5e\x63\xa8\xa0\xa0\x85\x20\x12\xb5\x4a\xe0\x43\xef\x6e\x4b\xd6\xd4\x4f\x0b\x00\x43\x7c\xc2\x81\xb8\x96\x98\x46\xc1\x03\x94\xb3\xe6\xe4\xd3\xa0\x2b\x64\xda\x24\x03\xf5\x1b\x12\x83\xf4\xa7\x16\x52\x0e\xdd\x3b\x04\x1e\x80\xc0\x03\x11\x78\x1a\x02\x9a\x1d\x4d\xe4\xa1\xcb\x9f\xa6\xa2\xe0\x99\xd4\x4c\x48\x3c\x00\x89\xc6\x07\x43\x22\x71\xa2\xec\xe1\xa1\x89\x93\xae\xf2\x76\x1f\x52\x26\x20\x03\x93\xea\x28\x40\x3a\x66\x94\x45\x57\x2d\x41\x4f\x1f\x56\x09\x44\xc7\x49\xb7\x78\x5c\xa1\xdd\x49\x16\xcc\x2a\x80\x8e\x97\x25\x83\xba\x82\x09\xa4\x2c\x98\xa5\x54\x52\x36\x02\x21\x59\x22\x52\xf0\x47\x71\x55\x97\xf1\xe1\xb9\x46\xbd\x24\x68\x7b\x91\x82\xb0\xa5\x54\x55\xa2\x70\xf4\x58\x40\x0c\xe7\xcc\x32\xa1\x94\xb4\x47\x11\xca\xea\xd3\xd0\x21\x33\x7f\xaa\xda\xc4\xfd\x3a\x06\x94\xba\xce\x44\x84\xed\x17\x7c\x05\xa5\xfe\x05\x5f\x42\x6a\xfe\xc0\xcf\xe5\x58\xa2\xfa\x78\xd1\x25\x49\x8a\x0d\x48\xb5\x5a\x8e\xd3\x30\xc4\x88\x2f\x05\x8e\x33\xa9\x99\x55\x47\xe0\x18\xeb\x10\x43\x9a\xb2\x0d\x33\x19\xa9\xaa\xad\x0e\xaf\x41\x63\xe6\x71\x26\x23\xd6\x46\x59\x87\x59\x1f\x6a\x32\x6a\xe3\x40\x93\x29\xa8\xc3\xac\x23\x00\x8e\x35\x88\x86\x69\xa4\x75\xda\x54\x6c\x44\xd4\x27\x6c\x27\x2d\x01\x93\xa5\x54\x28\x39\x91\x53\x62\x1d\x56\x9a\xc6\x9b\x98\x9f\xf2\x9a\xeb\x9a\xec\xd5\x17\x9d\x80\x4d\x31\x3a\x01\x1d\x6c\x75\x04\x9f\xcd\xe4\x08\x56\xc9\xde\x04\x9c\x90\xc1\x75\x18\x41\x6b\x23\xf8\x54\x53\x13\x50\x1a\x6c\x8d\x60\x35\x19\x1a\xc1\xa9\xcf\x0d\x02\x56\xe3\x04\x41\xf0\x9a\xe7\x07\x26\x53\x45\xf5\x92\x54\x61\xdd\x13\xbc\xba\xe2\xcd\x4b\xc8\x54\x07\xe9\x64\x1e\x73\x95\x4e\xef\x34\x53\xf6\xde\xd5\x6f\x6e\xd9\xfe\x0e\xae\x73\x95\xde\xef\x3d\x93\x75\xff\x49\x1c\x68\xc6\xcd\xbb\xf8\xd0\x55\x7a\xaf\x1b\x5d\xa5\xf7\x7b\xd2\x1c\xc7\x5d\xce\x74\x3a\x91\x3f\x9d\x4e\xef\x52\xa7\xef\xe8\x55\x57\xe9\xbb\x38\xd6\x78\xb8\xbd\x93\x6f\x5d\xa5\xef\xef\x5e\x57\xe9\x7b\x7b\xd8\x9a\x4e\xa7\x70\xb2\x55\x65\xde\xeb\x67\x03\x5a\xbc\xdb\xd5\xc6\xea\x7b\x27\x6f\x3b\x7d\x2f\x87\x5b\x53\xd6\x94\x3e\xb7\xaa\xb4\xa9\xdc\x6e\x40\x79\x93\x79\xde\xd0\x18\x9c\xdc\xf9\x06\x06\xe1\x7b\xf8\xdf\x80\xd5\x4c\xe5\x82\xe3\x1e\x4c\xeb\x85\x6b\x96\x38\x91\x23\xae\x1a\xe1\x04\xbe\x38\x60\x7f\x53\xb8\xe3\xe0\xfc\x31\x99\x47\x0e\x18\xc3\xed\x4e\xb9\x65\x37\x00\xc1\x9c\x46\x93\x79\xe5\x69\x34\xbd\x57\x4e\xd9\x7b\x57\xaf\xbc\x65\xfb\x3b\x78\xe5\x69\x74\xbf\x57\x4e\xb6\x70\x4c\xe2\x95\x33\x6e\xde\xc5\x2b\x4f\xa3\x7b\xbd\xf2\x34\xba\xdf\x2b\xe7\x38\xee\xf1\xca\xd3\x68\x1a\xaf\xbc\xc3\x33\x99\x57\x8e\x51\xbe\x9b\x57\x9e\x46\xef\xe2\x95\xe3\xe1\xf6\x4e\x5e\x79\x1a\xbd\xbf\x57\x9e\x46\xef\xec\x95\xeb\x3a\x9d\xc2\x2b\x57\x95\x79\xaf\x57\x0e\x68\xf1\x6e\xaf\x1c\xab\xef\x7d\xbc\x72\x22\xd3\xf7\xf0\xca\x75\x65\x4d\xe9\x95\xab\x4a\x9b\xca\x2b\x07\x94\x37\x99\x57\x0e\x8d\xc1\xc9\xbd\x72\x60\x10\xbe\x83\x57\x0e\x59\xcd\x54\x5e\x39\xee\xc1\xa4\x5e\xb9\x6e\x89\x13\x79\xe5\xaa\x11\x4e\xe0\x95\x03\xf6\x37\x85\x57\x0e\xce\x1f\x53\x79\xe5\x90\x31\x4c\xea\x95\xf3\x8d\x9d\xd4\xcc\xce\x93\x79\xe5\xc9\x79\x7a\xaf\x9c\xb2\xf7\xae\x5e\x79\xcb\xf6\x77\xf0\xca\x93\xf3\xfd\x5e\x39\xd9\x8d\x3b\x89\x57\xce\xb8\x79\x17\xaf\x3c\x39\xdf\xeb\x95\x27\xe7\xfb\xbd\x72\x8e\xe3\x1e\xaf\x3c\x39\x4f\xe3\x95\x77\x78\x26\xf3\xca\x31\xca\x77\xf3\xca\x93\xf3\xbb\x78\xe5\xc9\xf9\xdd\xbc\xf2\xe4\xfc\xfe\x5e\x79\x72\x7e\x67\xaf\x5c\xd7\xe9\x14\x5e\xb9\xaa\xcc\x7b\xbd\x72\x40\x8b\x77\x7b\xe5\x58\x7d\xef\xe3\x95\x13\x99\xbe\x87\x57\xae\x2b\x6b\x4a\xaf\x5c\x55\xda\x54\x5e\x39\xa0\xbc\xc9\xbc\x72\x68\x0c\x4e\xee\x95\x03\x83\xf0\x1d\xbc\x72\xc8\x6a\xa6\xf2\xca\x71\x0f\x26\xf5\xca\x75\x4b\x9c\xc8\x2b\x57\x8d\x70\x02\xaf\x1c\xb0\xbf\x29\xbc\x72\x70\xfe\x98\xca\x2b\x87\x8c\x61\x52\xaf\xbc\x3d\xa3\x43\x50\x37\xc9\x64\x6e\x79\x93\x4c\xef\x96\x53\xf6\xde\xd5\x2d\x6f\xd9\xfe\x0e\x6e\x79\x93\xdc\xef\x96\x93\x83\x55\x93\xb8\xe5\x8c\x9b\x77\x71\xcb\x9b\xe4\x5e\xb7\xbc\x49\xee\x77\xcb\x39\x8e\x7b\xdc\xf2\x26\x99\xc6\x2d\xef\xf0\x4c\xe6\x96\x37\xc9\x3b\xba\xe5\x4d\xf2\x2e\x6e\x39\x1e\x6e\xef\xe4\x96\x37\xc9\xfb\xbb\xe5\x4d\xf2\xce\x6e\xb9\xae\xd3\x29\xdc\x72\x55\x99\xf7\xba\xe5\x80\x16\xef\x76\xcb\xb1\xfa\xde\xc7\x2d\x27\x32\x7d\x0f\xb7\x5c\x57\xd6\x94\x6e\xb9\xaa\xb4\xa9\xdc\x72\x40\x79\x93\xb9\xe5\xd0\x18\x9c\xdc\x2d\x07\x06\xe1\x3b\xb8\xe5\x90\xd5\x4c\xe5\x96\xe3\x1e\x4c\xea\x96\xeb\x96\x38\x91\x5b\xae\x1a\xe1\x04\x6e\x39\x60\x7f\x53\xb8\xe5\xe0\xfc\x31\x95\x5b\x0e\x19\xc3\x1d\x6e\xf9\x82\xdc\xde\x41\x93\xed\x74\x17\x79\xc8\x3e\x03\x06\xa0\x89\x85\x28\x04\x79\xd6\x41\xc8\x19\x6d\x0a\xa1\x9c\xd0\xb6\xed\x5e\xc7\x2d\xab\xb4\x9f\x81\x2a\x1d\xc2\x03\x4f\xa7\x02\xb2\x61\xdd\xaf\x83\x5b\xa7\x51\x3f\x1f\x69\x34\x84\x0f\x9e\x0b\x64\x28\x1f\xdd\x17\x0a\xa2\x8d\x73\x3f\x1f\xc9\x79\x08\x1f\x3c\x91\xc5\x50\x3e\x84\x98\x0c\x37\x6f\x92\x7e\x46\x70\x64\xd4\xcf\x08\xcf\xc2\x00\x33\xb2\x78\xae\x48\x66\xb3\x04\x1d\x6b\x27\xc4\xde\x3a\xcb\x00\x2b\x94\xef\x43\xf1\xa4\xfb\x13\xb9\x41\xdd\x56\x5d\x59\x6a\x8d\x35\x0a\x27\xcf\x42\x8a\x66\xa9\x8d\x3c\x33\xea\xbc\xa8\xf5\x95\xad\xda\x5c\x25\xb3\x43\x04\x08\xb1\x23\x8b\x53\x67\x47\xab\xaf\x6c\xd5\xe6\xaa\xb7\x05\x3f\x7c\x4f\x45\xd3\x1e\xc5\x57\xb9\x6e\xc1\xe8\x19\xfd\xab\x72\x66\x5f\x04\xe5\x07\xe0\xf1\xdc\x5f\xc7\xc7\xee\x40\x3c\xfd\x0d\x82\xf2\x74\x01\x7a\x02\x01\x10\x9c\x1f\xad\xd7\x0f\xdb\x83\xe0\xa7\xb8\x41\x51\x07\x4b\x7e\x1a\x38\x8e\x8f\x5f\x5f\x3b\x48\xae\x1a\x5a\x2e\x48\x54\xe8\x93\x5c\xf3\xb6\x20\xd8\xe9\xb5\xe2\x12\x41\x96\x06\x80\xe7\xe6\xd7\x6e\xd3\x5d\xba\xbc\x2d\xbf\x38\x5a\x6e\xde\x9b\xd4\x1f\x63\xf8\xe7\xea\xb9\xc0\x9c\x54\xb3\x1f\x7e\x30\xf4\xe2\x71\x96\x97\xb3\x1f\x94\x0e\x3c\x3e\x5e\x17\xf4\x49\xe6\x5c\x6e\xaa\x76\x9b\x75\xa9\xe3\xc0\x77\xdf\xde\x16\x55\xe9\xe4\x59\xf2\x0a\x24\x42\x60\x33\x52\x97\x97\xdf\x13\x2e\x35\xe6\xf7\x06\xec\x1d\x5c\xaa\xd8\xd7\x13\xc9\xac\x5f\xa2\x63\xcd\xae\x58\x73\x1f\x95\xdb\x6d\x5f\xca\xb0\x10\x92\x27\x30\x26\x1c\x92\xf1\x33\x3c\x24\x68\x4f\xd3\xd6\xcf\x81\x1a\xf2\xa4\x9a\x29\x63\x96\x5c\x28\xc0\xb8\x25\xcf\x2d\x63\xe4\x3e\xab\x04\x51\xce\xe8\xbd\x03\xda\x75\xbb\x6f\x0b\x7a\xbd\x2f\xcd\x3a\xd8\xf0\xcb\x7e\xdd\xd9\xc2\x63\x57\x38\xd3\x3f\xe2\xdd\x71\xee\x26\x78\x14\xed\x89\xb6\x51\x9a\x93\x56\x9e\xda\xd4\x83\x5a\xd2\xac\x78\x42\x63\xd2\x6c\xa9\xb5\x05\xc9\xd2\x19\x4a\x68\xae\x4e\x1f\x2f\x8e\x1f\xb0\x64\xdb\x7e\xf0\x51\xae\x09\x5c\x56\xa3\x24\xd6\x7b\x71\x36\xbc\xcd\x46\x6d\xe3\xb9\xbc\x91\xe7\xaa\xad\xc8\x0c\xd5\xa9\x45\xac\xbc\x60\x36\x78\x16\x73\x19\xe7\x05\xf3\xc1\xaa\x14\x46\x2e\x98\x11\x56\xb5\x51\x5b\x61\x4e\x84\xc4\x1c\x72\x25\x61\x45\x30\x0b\xb1\x36\xa5\xbd\xe8\x2e\xc3\x56\x9b\xa7\x97\x16\xc0\x40\x00\xbf\xbe\xbf\x31\x34\xdd\x9b\xdc\x75\xbf\xbd\x68\x50\x97\x16\xaa\xc3\xf5\x4d\x8a\x0d\xbe\x29\x52\x95\x91\x7c\x53\x7b\x2a\x37\x4e\x1d\xf7\xca\xaf\x53\x96\xca\x6b\xc7\x9d\x2f\xd2\xd7\xb6\x5a\xcf\xc3\x9a\x96\x04\xa4\xe9\x40\x80\x1c\xac\xe9\x41\xc5\x03\xa5\x5f\x4d\x13\x15\x95\x9e\x7b\x35\x75\x3c\xce\xa9\x9e\x21\x32\xad\x1d\x8f\x90\xf1\xa4\x9b\x58\x75\xb8\x92\xc0\x35\x1d\x1c\xbb\xf3\x52\x87\x3c\xa8\x18\xf9\x35\xcd\x3a\x68\xa2\x22\xa5\x57\x01\xeb\x80\x8e\xdf\x76\x01\xe8\x81\x4f\xe8\xf9\xda\x5d\xb2\x4a\x07\x7c\x42\xcb\x07\x2e\xed\x54\xf8\x57\xf0\x89\xd7\x4c\x2b\xec\x2b\x28\xbb\x9b\x8c\x65\xee\x97\x9c\x7b\x4f\x67\x7e\x49\x88\x2d\x45\xe6\x35\xa8\x92\x40\x35\x1d\x54\xd9\x66\xee\x57\x58\x57\xb0\xf1\x6c\xc4\x3a\xe7\x0a\x42\x9a\x7f\x58\x63\x7c\xd5\x32\x0e\xc9\x7d\x45\x88\xad\x24\xd6\x21\xc1\xaf\x08\xad\x95\xc2\x3c\x24\x79\x05\x23\x67\x1f\x12\xbd\x82\x94\x76\x00\x90\x7d\xc0\xbb\xa0\xa6\x3c\x4d\x6b\x27\x20\xe4\x02\xb1\x03\x1a\x54\x49\xa0\x9a\x0e\x8a\x5d\x94\xa3\x33\xaf\x60\x63\xcc\x6b\x80\x89\x8a\x90\x5e\xb4\xa3\x80\x15\x8e\xdb\xde\xca\x29\x0d\xe7\x82\x4c\x30\xc5\x6b\x57\xaf\xcf\x30\x05\x99\x61\x8a\x46\x80\x01\xa6\x98\xe2\xa0\x61\x82\xe6\x98\x22\xd1\x90\xe9\x93\x4c\xe1\x78\xdd\x25\xa2\xda\xf8\x2d\xc8\x2c\x53\xbc\x76\x40\x86\x69\xa6\x20\xd3\x4c\xd1\x08\x80\xa6\x79\xa6\x38\x68\x38\x8d\x13\x4d\x91\x68\x68\x0d\x33\x4d\xe1\xf8\xf2\x65\xa8\x4a\x37\x7c\x42\xd2\x97\xbb\x01\xf4\xc2\x27\xe4\x7c\xb5\x17\x40\x27\x54\x8c\xa6\xd9\xa6\x48\x34\xa4\xf0\x74\x53\x38\xcb\xb6\x0b\xea\x88\x2e\xc8\x7c\x53\xbc\x76\x20\xe0\x84\x53\x90\x09\xa7\x68\x04\x30\x78\xc6\x29\x0e\x1a\x3e\xc3\x94\x53\x24\x1a\x4a\x70\xce\x29\x9c\x55\xc7\x3d\xa4\x81\x15\xa1\xb7\x92\xf9\x87\x54\xb0\x22\xe4\x56\x6a\x0f\x20\x1d\xa8\x38\x8d\xf3\x4e\x91\x68\x68\x0d\x13\x4f\xe1\x04\x6d\x3f\xb4\xb1\x4d\x66\x9e\xe2\xb5\x03\x01\xa7\x9e\x82\x4c\x3d\x45\x23\x80\xc1\x73\x4f\x71\xd0\xf0\x19\x26\x9f\x22\xd1\x50\x82\xb3\x4f\xea\x64\xad\xd3\xe0\x80\x5e\x43\x46\x5f\xf2\x99\xe4\x37\x40\xa0\x25\x05\x6d\x04\x50\x76\x53\x2e\xe8\x3b\x68\x78\x59\x4f\x20\xe8\x44\x47\xcd\x2e\xff\x06\x3c\x88\xcc\xef\x3a\x04\xf5\x87\xbe\xf4\x33\x5f\xee\x0f\xd4\x1d\xfa\xd2\xcf\x7c\xb5\x3b\x50\x6f\x54\xac\x6d\x6f\xa0\xce\xa8\x88\x85\x9b\xcc\x95\xbe\xb4\x0e\x85\x03\x78\x14\x19\x75\x02\x32\xc9\xa7\xd0\x01\x4b\x0a\xd8\x08\x80\xfc\x0a\x63\xa0\x23\x2a\x4e\xde\x11\xc0\xb5\xd0\xd0\xd2\x7e\xe8\xde\x45\xb6\xea\xba\x01\xea\x84\xba\x03\xd9\x4a\xee\x08\xa8\x14\xea\x0e\x64\x2b\xb5\x2b\xa0\x56\x54\xbc\x6d\x67\x40\xb5\xa8\xa8\x59\x77\x20\xbd\xb4\xce\x86\x03\x78\x1b\x19\x75\x10\x32\xc9\xdf\xd0\x01\x4b\x0a\xd8\x08\x80\xac\x33\x80\xcf\xa1\xe1\xe4\x5d\x01\xdc\x0e\x0d\x2d\xed\x88\x3e\xf6\x49\x10\xc7\x3a\xa2\x05\x71\x74\xad\x90\x50\x15\xe0\x48\x5f\x34\xd8\x92\xc3\x36\x12\x6c\x09\x87\x87\x07\x18\x33\xeb\x91\x06\x9e\xc0\xc8\x49\xa7\x14\x60\xf3\xaa\x7c\xea\x54\x96\x48\x0e\xd7\x11\x7e\x04\x20\x30\x9e\x63\x80\x8d\x04\x08\x47\x75\x20\x4e\x43\x6c\x07\xa2\x85\x22\xbc\xaa\x2f\xc8\xc3\x00\x9c\x6a\x7f\xa8\xc7\xa0\x1b\x09\xda\x12\xf0\x81\xd8\x6d\x61\x1f\x48\xc0\x18\xfc\x55\x3d\xf1\x1f\xae\xe7\xe4\x7b\xa3\x40\x06\xdc\x48\xc0\xe6\x58\x10\xc4\x6d\x89\x08\x41\xf4\xa6\xb8\xb0\xb2\x87\x86\xb8\x9a\xd3\xee\x0b\x10\x19\x6c\x23\xc1\x1a\xc3\x44\x10\xb3\x39\x58\x04\x91\x1b\x42\xc6\xaa\x2f\x6a\xc4\x00\x9c\x76\x7f\xec\xc8\xa0\x1b\x09\xda\x12\x41\x82\xd8\x6d\x71\x24\x48\xc0\x18\x4d\x56\xf6\x80\x12\x57\x73\xea\x7d\x61\x25\x83\x6d\x24\x58\x63\x70\x09\x62\x36\x87\x98\x20\x72\x43\xa0\x49\x26\x17\x53\xac\x49\xa7\xa0\xe2\x55\x82\x02\x23\x4e\x06\xd9\xc8\x90\x70\xdc\x09\x63\x35\x44\x9f\x30\x62\x28\x06\x25\xb3\x89\x35\x0c\xa5\x13\x4f\xf1\x2a\x81\x9a\x83\x51\x06\xde\xc8\xe0\x96\x90\x14\xc6\x6f\x0b\x4c\x61\x12\xc6\xf0\x94\x4c\x2b\xb6\x08\x95\x4e\x40\xc5\xab\x04\x69\x8c\x53\x19\x74\x23\x43\x9b\xa3\x55\x18\xbb\x25\x66\x85\x09\x98\x22\x57\x32\xbf\x58\x82\x57\x3a\x11\x15\xaf\x12\xa0\x29\x84\x65\xc0\x8d\x0c\x6c\x0c\x64\x61\xdc\xe6\x70\x16\x46\x6f\x08\x6a\xc9\xe4\x62\x8d\x6b\xe9\x3c\x54\xbc\x4a\xa0\xe6\xe8\x96\x81\x37\x32\xb8\x25\xc6\x85\xf1\xdb\x22\x5d\x98\x84\x31\xde\x25\x33\x8d\x25\xe4\xa5\x53\x52\xf1\x2a\x01\x9a\x02\x5f\x06\xdc\xc8\xc0\xc6\xf0\x17\xc6\x6d\x0e\x82\x61\xf4\x86\x50\xb8\xea\x8f\x86\x09\x08\x9f\x9e\x87\xc4\xc4\xbc\x41\x23\x37\xb0\x45\xc6\x06\x1a\xd6\xf8\xd8\x40\xc6\x1c\x25\x57\xbd\x81\x32\x81\x68\xd9\xe8\x0f\x97\x39\x7c\x23\xc3\x5b\x82\x66\x03\x05\x5b\xe8\x6c\x20\x62\x0c\xa0\xab\xbe\x18\x9a\x00\xb4\x3c\xf4\x46\xd2\x1c\xbc\x91\xc1\xcd\xf1\xb4\x01\xbf\x25\xaa\x36\x90\x30\xc5\xd6\x55\x7f\x78\x4d\x40\x5a\x1e\x06\x04\xd9\xbc\x41\x23\x37\xb0\x85\xda\x06\x1a\xd6\x80\xdb\x40\xc6\x1c\x76\x57\x7d\x91\x37\x01\x68\xb9\xe8\x8d\xbf\x39\x78\x23\x83\x9b\xa3\x70\x03\x7e\x4b\x2c\x6e\x20\x61\x8a\xc8\xab\xde\xa0\x9c\x41\x70\x26\x06\x84\xe6\x5d\x8b\x46\x6d\x61\x0c\xd0\x2d\x54\xcc\x61\xba\x85\x10\x14\xac\x5b\xf6\xae\xa5\x4e\x1a\x99\xa3\x75\x5c\x47\x38\x13\x80\xc0\x68\x9d\x01\x36\x12\x20\x1c\xad\x83\x38\x0d\xd1\x3a\x88\x16\x8a\xd6\xd3\xa8\x27\x5a\xc7\x00\x9c\x6a\x7f\xb4\xce\xa0\x1b\x09\xda\x12\xad\x83\xd8\x6d\xd1\x3a\x48\xc0\x18\xad\xa7\x91\x3d\x5a\xc7\xf5\x9c\x7c\x6f\xb4\xce\x80\x1b\x09\xd8\x1c\xad\x83\xb8\x2d\xd1\x3a\x88\xde\x14\xad\xa7\x91\x35\x5a\xc7\xd5\x9c\x76\x5f\xb4\xce\x60\x1b\x09\xd6\x18\xad\x83\x98\xcd\xd1\x3a\x88\xdc\x10\xad\xa7\x51\x4f\xb4\x8e\x01\x38\xed\xfe\x68\x9d\x41\x37\x12\xb4\x25\x5a\x07\xb1\xdb\xa2\x75\x90\x80\x31\x5a\x4f\x23\x6b\xb4\x8e\xab\x39\xf5\xbe\x68\x9d\xc1\x36\x12\xac\x31\x5a\x07\x31\x9b\xa3\x75\x10\xb9\x21\x5a\x27\x93\x8b\x29\x5a\xa7\x53\x50\xf1\x2a\x41\x81\xd1\x3a\x83\x6c\x64\x48\x38\x5a\x87\xb1\x1a\xa2\x75\x18\x31\x14\xad\x93\xd9\xc4\x1a\xad\xd3\x89\xa7\x78\x95\x40\xcd\xd1\x3a\x03\x6f\x64\x70\x4b\xb4\x0e\xe3\xb7\x45\xeb\x30\x09\x63\xb4\x4e\xa6\x15\x5b\xb4\x4e\x27\xa0\xe2\x55\x82\x34\x46\xeb\x0c\xba\x91\xa1\xcd\xd1\x3a\x8c\xdd\x12\xad\xc3\x04\x4c\xd1\x3a\x99\x5f\x2c\xd1\x3a\x9d\x88\x8a\x57\x09\xd0\x14\xad\x33\xe0\x46\x06\x36\x46\xeb\x30\x6e\x73\xb4\x0e\xa3\x37\x44\xeb\x64\x72\xb1\x46\xeb\x74\x1e\x2a\x5e\x25\x50\x73\xb4\xce\xc0\x1b\x19\xdc\x12\xad\xc3\xf8\x6d\xd1\x3a\x4c\xc2\x18\xad\x93\x99\xc6\x12\xad\xd3\x29\xa9\x78\x95\x00\x4d\xd1\x3a\x03\x6e\x64\x60\x63\xb4\x0e\xe3\x36\x47\xeb\x30\x7a\x43\xb4\x9e\x46\xbd\xd1\x3a\x01\xe1\xd3\xf3\x90\x68\x9d\x37\x68\xe4\x06\xb6\x68\xdd\x40\xc3\x1a\xad\x1b\xc8\x98\xa3\x75\x0c\x66\x8f\xd6\x09\x44\xcb\x46\x7f\xb4\xce\xe1\x1b\x19\xde\x12\xad\x1b\x28\xd8\xa2\x75\x03\x11\x63\xb4\x8e\xa1\xac\xd1\x3a\x01\x68\x79\xe8\x8d\xd6\x39\x78\x23\x83\x9b\xa3\x75\x03\x7e\x4b\xb4\x6e\x20\x61\x8a\xd6\x31\x50\x4f\xb4\x4e\x40\x5a\x1e\x06\x44\xeb\xbc\x41\x23\x37\xb0\x45\xeb\x06\x1a\xd6\x68\xdd\x40\xc6\x1c\xad\x63\x30\x6b\xb4\x4e\x00\x5a\x2e\x7a\xa3\x75\x0e\xde\xc8\xe0\xe6\x68\xdd\x80\xdf\x12\xad\x1b\x48\x98\xa2\x75\x9e\x96\xcf\x1c\xad\x33\x08\xce\xc4\x80\x68\xbd\x6b\xd1\xa8\x2d\x8c\xd1\xba\x85\x8a\x39\x5a\xb7\x10\x1a\x18\xad\xf3\x13\x5e\xa9\x93\x9c\xcd\xd1\x3a\xae\x23\x9c\x09\x40\x60\xb4\xce\x00\x1b\x09\x10\x8e\xd6\x41\x9c\x86\x68\x1d\x44\x0b\x45\xeb\xc9\xb9\x27\x5a\xc7\x00\x9c\x6a\x7f\xb4\xce\xa0\x1b\x09\xda\x12\xad\x83\xd8\x6d\xd1\x3a\x48\xc0\x18\xad\x27\x67\x7b\xb4\x8e\xeb\x39\xf9\xde\x68\x9d\x01\x37\x12\xb0\x39\x5a\x07\x71\x5b\xa2\x75\x10\xbd\x29\x5a\x4f\xce\xd6\x68\x1d\x57\x73\xda\x7d\xd1\x3a\x83\x6d\x24\x58\x63\xb4\x0e\x62\x36\x47\xeb\x20\x72\x43\xb4\x9e\x9c\x7b\xa2\x75\x0c\xc0\x69\xf7\x47\xeb\x0c\xba\x91\xa0\x2d\xd1\x3a\x88\xdd\x16\xad\x83\x04\x8c\xd1\x7a\x72\xb6\x46\xeb\xb8\x9a\x53\xef\x8b\xd6\x19\x6c\x23\xc1\x1a\xa3\x75\x10\xb3\x39\x5a\x07\x91\x1b\xa2\x75\x32\xb9\x98\xa2\x75\x3a\x05\x15\xaf\x12\x14\x18\xad\x33\xc8\x46\x86\x84\xa3\x75\x18\xab\x21\x5a\x87\x11\x43\xd1\x3a\x99\x4d\xac\xd1\x3a\x9d\x78\x8a\x57\x09\xd4\x1c\xad\x33\xf0\x46\x06\xb7\x44\xeb\x30\x7e\x5b\xb4\x0e\x93\x30\x46\xeb\x64\x5a\xb1\x45\xeb\x74\x02\x2a\x5e\x25\x48\x63\xb4\xce\xa0\x1b\x19\xda\x1c\xad\xc3\xd8\x2d\xd1\x3a\x4c\xc0\x14\xad\x93\xf9\xc5\x12\xad\xd3\x89\xa8\x78\x95\x00\x4d\xd1\x3a\x03\x6e\x64\x60\x63\xb4\x0e\xe3\x36\x47\xeb\x30\x7a\x43\xb4\x4e\x26\x17\x6b\xb4\x4e\xe7\xa1\xe2\x55\x02\x35\x47\xeb\x0c\xbc\x91\xc1\x2d\xd1\x3a\x8c\xdf\x16\xad\xc3\x24\x8c\xd1\x3a\x99\x69\x2c\xd1\x3a\x9d\x92\x8a\x57\x09\xd0\x14\xad\x33\xe0\x46\x06\x36\x46\xeb\x30\x6e\x73\xb4\x0e\xa3\x37\x44\xeb\xc9\xb9\x37\x5a\x27\x20\x7c\x7a\x1e\x12\xad\xf3\x06\x8d\xdc\xc0\x16\xad\x1b\x68\x58\xa3\x75\x03\x19\x73\xb4\x8e\xc1\xec\xd1\x3a\x81\x68\xd9\xe8\x8f\xd6\x39\x7c\x23\xc3\x5b\xa2\x75\x03\x05\x5b\xb4\x6e\x20\x62\x8c\xd6\x31\x94\x35\x5a\x27\x00\x2d\x0f\xbd\xd1\x3a\x07\x6f\x64\x70\x73\xb4\x6e\xc0\x6f\x89\xd6\x0d\x24\x4c\xd1\x3a\x06\xea\x89\xd6\x09\x48\xcb\xc3\x80\x68\x9d\x37\x68\xe4\x06\xb6\x68\xdd\x40\xc3\x1a\xad\x1b\xc8\x98\xa3\x75\x0c\x66\x8d\xd6\x09\x40\xcb\x45\x6f\xb4\xce\xc1\x1b\x19\xdc\x1c\xad\x1b\xf0\x5b\xa2\x75\x03\x09\x53\xb4\xce\xd3\x75\x9a\xa3\x75\x06\xc1\x99\x18\x10\xad\x77\x2d\x1a\xb5\x85\x31\x5a\xb7\x50\x31\x47\xeb\x16\x42\x03\xa3\xf5\x36\x0f\x4a\xea\x34\x89\x39\x5c\x6f\x12\x16\x5a\x0b\x40\x60\xb8\xde\xf0\x23\xc9\x22\x20\x1c\xae\x83\x38\x0d\xe1\x3a\x88\x16\x0a\xd7\x9b\xa4\x27\x5c\x6f\x12\x16\x50\x0b\x90\xe6\x70\xbd\xe1\x67\x94\x45\x68\x4b\xb8\x0e\x62\xb7\x85\xeb\x20\x01\x63\xb8\xde\x24\xf6\x70\xbd\x49\x58\x48\x2d\x00\x1a\xc3\xf5\x86\x1f\x60\x16\x81\xcd\xe1\x3a\x88\xdb\x12\xae\x83\xe8\x4d\xe1\x7a\x93\x58\xc3\xf5\x26\x61\x41\xb5\x00\x67\x0a\xd7\x1b\x7e\xba\x59\x84\x35\x86\xeb\x20\x66\x73\xb8\x0e\x22\x37\x84\xeb\x4d\xd2\x13\xae\x37\x09\x0b\xa8\x05\x48\x73\xb8\xde\xf0\x43\xcf\x22\xb4\x25\x5c\x07\xb1\xdb\xc2\x75\x90\x80\x31\x5c\x6f\x12\x6b\xb8\xde\x24\x2c\xa8\x16\xe0\x4c\xe1\x7a\xc3\xcf\x44\x8b\xb0\xc6\x70\x1d\xc4\x6c\x0e\xd7\x41\xe4\x86\x70\x9d\x4c\x2e\xa6\x70\x9d\x4e\x41\xc5\xab\x04\x05\x86\xeb\x0d\x3f\x32\x2d\x41\xc2\xe1\x3a\x8c\xd5\x10\xae\xc3\x88\xa1\x70\x9d\xcc\x26\xd6\x70\x9d\x4e\x3c\xc5\xab\x04\x6a\x0e\xd7\x1b\x7e\x86\x5a\x02\xb7\x84\xeb\x30\x7e\x5b\xb8\x0e\x93\x30\x86\xeb\x64\x5a\xb1\x85\xeb\x74\x02\x2a\x5e\x25\x48\x63\xb8\xde\xf0\x03\xd6\x12\xb4\x39\x5c\x87\xb1\x5b\xc2\x75\x98\x80\x29\x5c\x27\xf3\x8b\x25\x5c\xa7\x13\x51\xf1\x2a\x01\x9a\xc2\xf5\x86\x9f\xbe\x96\x80\x8d\xe1\x3a\x8c\xdb\x1c\xae\xc3\xe8\x0d\xe1\x3a\x99\x5c\xac\xe1\x3a\x9d\x87\x8a\x57\x09\xd4\x1c\xae\x37\xfc\x50\xb6\x04\x6e\x09\xd7\x61\xfc\xb6\x70\x1d\x26\x61\x0c\xd7\xc9\x4c\x63\x09\xd7\xe9\x94\x54\xbc\x4a\x80\xa6\x70\xbd\xe1\x67\xb6\x25\x60\x63\xb8\x0e\xe3\x36\x87\xeb\x30\x7a\x43\xb8\xde\x24\xbd\xe1\x7a\x93\xf0\x50\x5a\x04\xb6\x84\xeb\x4d\x7b\x8c\x5b\x6a\x60\x0b\xd7\x0d\x34\xac\xe1\xba\x81\x8c\x39\x5c\xc7\x60\xf6\x70\xbd\x49\x78\x30\x2d\xc2\x9a\xc3\xf5\xa6\x3d\xe3\x2d\xc1\x5b\xc2\x75\x03\x05\x5b\xb8\x6e\x20\x62\x0c\xd7\x31\x94\x35\x5c\x6f\x12\x1e\x4e\x8b\xa0\xc6\x70\xbd\x69\x0f\x80\x4b\xe0\xe6\x70\xdd\x80\xdf\x12\xae\x1b\x48\x98\xc2\x75\x0c\xd4\x13\xae\x37\x09\x0f\xa5\x45\x60\x4b\xb8\xde\xb4\xe7\xc2\xa5\x06\xb6\x70\xdd\x40\xc3\x1a\xae\x1b\xc8\x98\xc3\x75\x0c\x66\x0d\xd7\x9b\x84\x87\xd3\x22\xa8\x31\x5c\x6f\xda\x63\xe3\x12\xb8\x39\x5c\x37\xe0\xb7\x84\xeb\x06\x12\xa6\x70\x9d\xa7\xf1\x35\x87\xeb\x4d\xd2\x05\xd2\x32\xb4\x29\x5c\x6f\x84\xb3\xe4\x4a\x0b\x63\xb8\x6e\xa1\x62\x0e\xd7\x2d\x84\xc0\x70\x7d\xc1\xf2\xd8\xa2\xc8\x49\xe2\xec\xeb\x7e\x1f\x9e\x6a\x54\x02\x19\x04\xe5\x1c\x8a\xc6\x54\x88\x4f\x45\x1e\x67\x35\x2a\x1d\xf4\x0d\x65\x75\x45\xd3\xf3\xf1\xf4\xc9\x0f\x0f\x4f\x87\xf0\xf8\xf5\x4c\x72\x34\x3b\xc7\x3c\xc9\xcb\xbd\x90\x09\xcf\x7d\x7c\x5b\xd4\xa8\xa9\x9d\x34\xcf\x72\x92\xcc\xef\x7a\xca\xb3\xda\x39\x85\x69\x9c\xbc\xee\xff\xf2\x6f\xbf\xe6\x59\xee\xfc\x07\x3a\x3f\x27\x61\x39\xff\x15\x65\x49\x3e\xff\x35\xcf\xc2\x63\x3e\xff\x53\x9e\x55\x79\x12\x56\xf3\x87\x5f\xe2\x03\x2a\x43\xcc\xfb\x0c\x83\x3f\xcc\x1f\xfe\x94\x3f\x97\x31\x2a\x67\x7f\x46\x2f\x0f\xf3\x16\xb5\x28\x37\x42\x94\xa5\xb1\xbe\x92\x1f\x34\x01\xb0\x96\xd9\x9a\x81\x92\x8b\x1a\xf4\x9c\x83\x1a\x1c\xbb\xd2\x41\x4f\x97\xa8\x41\xd6\xe5\x73\x76\x0c\x6b\xa4\x66\xf7\x7c\x22\xb5\x6d\x21\x4a\x92\xb8\xa8\xe2\x0a\xc8\xc0\xc8\x10\x91\x14\xb3\x42\x17\xd4\x3c\xb3\xa4\x8a\xe6\x98\x15\xa0\xb4\x44\xb3\xa4\x8e\xe5\x73\x16\xe0\xb4\x54\xcd\xe6\xbc\x07\xa4\x15\x4f\x46\xdc\xc7\x50\x9b\x90\xb8\x97\xa7\xee\xa6\x74\x1b\x5b\x96\x13\x1e\xd4\xc0\xa2\x61\x7c\xb5\x09\x8a\x7b\xf9\xea\xee\x8a\x1c\xc9\x17\xdf\xcb\x42\xb5\x77\x1e\xc6\x57\x9b\xb0\xb8\x97\xaf\xee\xb6\x9c\x91\x7c\xb5\xab\x76\xa4\x1d\x4f\x5e\xdc\xc7\x58\x9b\xc0\xb8\x97\xb1\x2e\x5f\xb8\x95\x31\xd6\x8b\xfc\x05\x95\xc7\xb0\x42\x57\x36\x5a\xc2\xac\x3a\xe5\x65\xba\x6f\x2b\x34\xfc\xcf\x45\x01\x37\x69\x2b\x74\x7b\x0f\x8b\xb8\x0e\x93\xf8\x77\xad\x4d\x57\x23\x36\x22\x93\xd3\x0b\x49\xdf\xe8\x24\x34\x6d\x73\x57\xb2\x5f\xba\xae\x15\x18\x95\x12\x38\x2b\x33\x35\xa1\x33\x8c\xd4\x62\x65\x26\x70\xc8\x93\x48\x82\xdd\xd8\x61\x15\x5e\x68\x91\xd6\x80\x88\xe0\x48\x21\xab\xfa\x35\x41\x7b\x5a\xa2\xcf\x8f\x78\x76\xba\xd2\xf9\xfd\xc3\xe9\x74\xd2\x00\x8a\x32\x4e\xc3\xf2\x95\x83\xb8\xee\xe6\x20\x41\x85\x12\x18\x4d\x13\x3b\x57\x0a\x2f\x78\x56\xec\x30\x04\xeb\xc3\x52\x9f\x2d\xd0\x31\xcf\x22\x81\xd2\xfa\xb8\x09\x36\x91\x4e\xa9\x05\x94\x69\x75\xc5\x12\xb5\xd5\x6e\x75\x0a\x56\x3a\xb5\xe7\xe3\x11\x55\x15\x87\xf2\xb7\xe1\x66\x15\x00\xb4\x28\x98\x42\x89\x15\x4a\x74\xbc\xdd\x7a\xe7\xeb\xe2\x8d\xb3\x53\xde\x82\x6c\x42\xff\xb0\xd5\x89\x60\x18\x99\x02\x29\x91\x85\x76\x5a\xaf\x37\x7a\x37\x5e\xc2\x32\x8b\xb3\x73\xa7\xbf\xa3\xe7\x6e\x74\x0a\x0c\x4c\x26\xc2\x0b\x25\x3a\x87\x70\x7b\x90\xed\x8f\xc0\x46\x61\x76\xee\x80\xa2\xe3\x32\x80\xa4\x45\xa1\x64\x2a\xac\x4c\x22\x12\x6e\xbc\xc8\x0f\xf5\xf9\x8f\x8c\x4b\xde\x95\xed\x69\x77\x0a\x75\x1a\x04\x48\x26\x41\x8b\x24\x0a\xc7\x43\xb4\x8c\x74\x0a\x51\x58\x7e\xe5\x20\xcb\xd5\x32\x5c\xb9\x50\x27\xca\xaf\x6a\x17\xca\xaf\x8a\xb2\x7d\x6f\xe5\xad\x35\xf4\x87\x3c\x6a\xad\xd7\xf7\xfc\xc0\xdf\xe9\xef\x9e\xe7\x1a\x45\x46\x0b\x67\x68\x92\xf0\xf8\xd5\x09\xdc\xab\xe6\x75\x2d\xe4\xec\xc3\xdd\x00\x56\xa0\xfd\x20\x98\xf3\xff\x41\x6d\x2e\x71\x44\xfd\xb5\xbd\xfb\xd9\x9d\x85\x4f\xb4\x29\x99\x43\x8b\xb0\x44\x59\x4d\x7d\x19\x21\x9b\xb1\xee\x0c\x8a\xd0\x5d\x46\x69\x2a\x30\x74\xcc\xa9\x5f\x47\x33\x23\x2b\x85\x5a\x7a\x64\xda\xeb\x12\x85\x5f\xaf\x2f\x79\x19\xd1\xc7\x3d\xf9\xd7\xc1\x05\x1d\x6c\x9b\x58\x9a\xde\x80\x05\x81\x70\xbf\x09\x55\x88\x1b\x53\x9c\x5d\x50\x19\x4b\xef\x35\x96\x97\xfa\x4a\xfe\xc6\x49\x5c\xbf\xf2\x54\xd5\x22\x54\x9c\x01\x70\x5a\x2e\x77\xf6\x42\x2e\xca\x38\xab\xaf\x7f\x98\x33\xbf\x7c\xbe\xdf\x1f\xd0\x29\x2f\x59\xf7\xc1\xcc\xd0\x4f\xe6\x9c\xd1\xe1\x3e\xcb\xeb\x1f\x16\x87\x3a\x7b\xd4\xe4\xf7\x9c\x45\xa8\x4c\xe2\x0c\xbd\x85\x87\x43\xf9\x5b\x1d\xd7\x09\xfa\xc2\xc3\x81\xd6\x81\x9f\xfd\xf0\x30\x0b\xeb\xba\xfc\x81\xd4\x3f\xce\x1e\x1e\x1f\xde\x8a\x12\x49\x2e\x6e\x51\x22\x47\x71\x72\x0f\x49\x7e\xfc\xfa\x7f\x9f\xf3\x1a\xcd\x31\x34\x53\xad\x57\x34\xb3\x2a\x4f\xe2\x68\xf6\x21\x8c\x0e\xc1\x21\x7a\x2a\xc2\x33\xa2\x9a\x72\xe2\xac\x8a\x23\xb4\x0f\xbf\xe5\x71\xf4\x56\x5f\x50\x18\x5d\xa3\xb8\x2a\x92\xf0\x75\x5f\x87\x87\x04\x39\xb8\x08\x95\x0e\xb6\x9f\xe2\x2d\x4e\xcf\xf3\xba\xbc\x9a\xda\x5f\xfc\xf9\x65\x39\x2f\xae\x79\x59\x5c\xc2\xac\xda\x2f\x9f\x5e\xe2\x28\x7f\xa9\xf6\x4b\x5a\x25\x36\x24\x3d\x66\xed\xfe\x19\x97\x5f\xab\xf8\x77\xb4\x0f\x97\x6f\x64\x28\x2a\x9e\x9b\xa8\x59\x2c\xa5\x30\xce\x50\x69\x03\xca\xc2\x6f\x87\xb0\x6c\xfb\x82\x35\xf4\xb6\x38\x84\xd1\x19\x12\x8b\xeb\x62\xf3\xc7\xdd\x65\x95\x78\x9c\x24\x61\x51\xa1\x3d\x7f\x90\x8c\x14\x43\xce\xea\x68\xce\x9f\x2e\x57\x6d\x88\x69\xef\x63\x22\x4c\x8a\x1d\x45\x5d\x63\xa1\xe8\x02\x70\x16\x21\xe4\xa3\xb5\x8e\x48\x98\x0d\xd9\x08\x11\x6b\x66\x35\x96\xe1\x8f\xe4\xdf\xb9\x54\x1e\xc9\x3f\x2f\xca\x4f\x14\x0a\x7c\xf0\x9e\x50\x1e\x78\xb7\x17\x04\x8a\x37\x90\x79\x78\x02\x1b\xbe\xfd\xb7\xcf\x7f\xf8\x30\xab\xf2\xe7\xf2\x88\x7e\x0d\x8b\x22\xce\xce\xff\xf3\x3f\x7e\xf9\xe9\x90\xe7\x75\x55\x97\x61\xb1\x48\xe3\x6c\x71\xac\xaa\x45\x1a\x16\xb3\x3f\x7c\xfe\xff\x02\x00\x00\xff\xff\xa2\x01\xc0\x2b\x2e\x72\x02\x00")
+var _libBootstrap452MinCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x7b\xaf\xa3\x3a\x9e\xe0\xff\xfb\x29\x98\x3a\x2a\xd5\x3d\xf7\x02\x05\x24\xe4\x41\x54\xa5\xed\x69\xf5\x68\x5b\xba\xb7\xff\x98\x3b\x2b\xad\x54\x73\x56\xe2\x61\x12\xba\x78\x2d\x90\x3a\xa9\x8e\xd2\x9f\x7d\x65\x63\x1b\xdb\xd8\x60\x72\x52\xbb\x3d\x3b\x7b\x5b\x5d\x27\xd8\xbf\xf7\xc3\xfe\xd9\x80\xf9\xf8\xf3\x3f\xfd\x17\xe3\x67\xe3\x9f\xab\xaa\x6b\xbb\x26\xac\x8d\x6f\x6b\xdb\xb7\x3d\xe3\xa7\x53\xd7\xd5\x6d\xf0\xf1\xe3\x11\x74\x11\xe9\xb4\xe3\xaa\xf8\xf8\x0c\xe1\xff\x58\xd5\xdf\x9b\xec\x78\xea\x0c\xcf\x71\x5d\xcb\x73\x3c\xc7\xf8\xb7\x13\x60\xe8\xfc\xe1\xdc\x9d\xaa\xa6\x55\x02\xbf\x66\x5d\x07\x1a\xd3\xf8\x73\x19\xdb\x10\xe8\xd7\x2c\x06\x65\x0b\x12\xe3\x5c\x26\xa0\x31\x7e\xfb\xf3\xbf\x31\x32\x64\xdd\xe9\x1c\x21\xee\xdd\x6b\xd4\x7e\xa4\x02\x7d\x8c\xf2\x2a\xfa\x58\x84\x59\xf9\xf1\xd7\x3f\xff\xf1\x4f\x7f\xf9\xfd\x4f\x50\xba\x8f\x41\x53\x55\xdd\xd5\xb2\xa2\xfc\x0c\x82\x27\xc7\xd9\x46\x69\x7a\xb0\xac\xac\x4c\xb2\x63\x15\x3c\x6d\x36\xae\x93\x7a\x07\xcb\xaa\xcf\x4d\x9d\x83\xe0\x69\x93\xae\xbd\xd8\x85\x0d\x59\xf9\x35\x78\x02\xbb\x15\xd8\xc5\x07\xcb\x6a\x40\x12\x3c\x25\xf1\xca\x5f\xfb\x07\xcb\xaa\x9a\xb0\x3c\x82\xe0\x29\x4d\xb6\xc0\x5d\x1f\x2c\xeb\x3b\xc8\xf3\xea\x35\x78\x4a\xd3\xd8\x75\xb6\x07\xcb\x3a\x36\x00\x94\xc1\x93\xb7\x0b\xb7\x08\xa3\x03\x61\x1e\x3c\x79\x4e\xbc\xdf\xc3\xee\xf8\x7b\x58\x06\x4f\xee\x36\xf4\xa2\xdd\xc1\xb2\x5e\x4f\x59\x07\xc9\x21\xd9\x8e\x4d\xf8\x3d\x78\xda\xc4\x5b\x7f\x9b\xe0\x4b\x2b\x09\x9b\xaf\xc1\xd3\x6a\xbd\x0a\xd7\x0e\x14\xae\xc9\x8a\xb0\xf9\xce\x28\xd4\x82\xb8\x2a\x93\xb0\x61\x31\xdb\x73\x1c\x83\xb6\x65\xa4\xc8\xca\xb4\x62\xd9\x86\x4d\x99\x95\x47\x46\xec\x04\xea\xd5\x30\x9a\xe6\xd0\xb3\xc1\x53\xba\x4b\xf7\x69\x78\xb0\x44\x41\xa2\x06\x84\x5f\xeb\x2a\x2b\x3b\xeb\xd2\x06\x42\x4b\x5b\x04\xfe\x76\x53\x5f\xf8\xd6\x22\x09\xb6\x9b\x9d\xd8\x9a\x1f\x83\xfd\xde\x13\x5b\x2f\x79\xe0\x7a\x8e\x83\x9a\xd3\xaa\xec\xac\x34\x2c\xb2\xfc\xbb\xd5\x86\x65\x6b\xb5\xa0\xc9\xd2\xc0\x0a\xeb\x3a\x07\x56\xfb\xbd\xed\x40\x61\xfe\x73\x9e\x95\x5f\x7f\x0b\xe3\xdf\xd1\xe5\xbf\x54\x65\x67\xbe\xfb\x1d\x1c\x2b\x60\xfc\xf7\x3f\xbf\x33\xff\xb5\x8a\xaa\xae\x32\xdf\xfd\x37\x90\x7f\x03\x5d\x16\x87\xc6\x5f\xc0\x19\xbc\x33\xff\xd0\x64\x61\x6e\xbe\xfb\x4b\xd5\x55\xc6\xef\x61\xd9\xbe\x33\x07\x06\xe6\xbb\x3f\x40\x06\xc6\x1f\xab\xbc\x6a\x8c\x3f\x15\xd5\x5f\xb3\x77\x03\xcd\x71\xc3\xef\xdf\x8b\xa8\xca\xdf\x61\x6a\x2c\x96\xa0\x43\x51\x95\x55\x5b\x87\x31\x08\x7e\xff\x97\xdf\xaa\xb2\xb2\xfe\x15\x1c\xcf\x79\xd8\x98\xbf\x81\x32\xaf\xcc\xdf\xaa\x32\x8c\x2b\xf3\x8f\x55\xd9\x56\x79\xd8\x9a\xef\x7e\xcd\x22\xd0\x84\x5d\x56\x95\x06\x04\x7f\x67\xbe\xfb\x63\x75\x6e\x32\xd0\x18\x7f\x01\xaf\xef\x4c\x4a\xee\xf6\xb3\x19\x04\x61\xda\x81\xc6\x0c\x82\x08\xa4\x55\x03\xae\x51\x75\xb1\xda\xec\x6f\xd0\xd7\x51\xd5\x24\xa0\xb1\xa2\xea\x72\x3b\x75\x45\x7e\x65\x44\x0a\x06\xad\x0f\x79\x56\x02\xeb\x04\x90\xf7\x5d\xdb\xf5\x0f\xd6\x2b\x88\xbe\x66\x9d\xd5\x81\x4b\x07\x69\x01\x2b\x4c\xfe\x7a\x6e\xbb\xc0\x75\x9c\xf7\x43\x6f\x58\x5b\xa7\xec\x78\x42\x61\x63\xc5\x50\xfb\xa0\x6b\xc2\xb2\xad\xc3\x06\x94\xdd\x2d\x6c\xba\x2c\xce\x81\x19\xb6\x59\x02\xcc\x34\x3b\xc6\x61\x0d\x55\x82\x3f\xcf\x0d\x30\xd3\xaa\x82\x82\x9f\x40\x98\xc0\x3f\xc7\xa6\x3a\xd7\x26\x4c\x6a\xb3\x0c\xbf\x99\x2d\x88\x21\xf0\x35\xc9\xda\x3a\x0f\xbf\x07\x51\x5e\xc5\x5f\x6f\x51\x95\x7c\xbf\x16\x61\x73\xcc\xca\xc0\x39\xb0\xfa\xfc\x07\x8a\x0d\x24\x36\x34\x6b\xe0\x36\xa0\xe8\x2f\x5f\x7b\xf3\xaf\x1d\x47\x70\x87\x7f\xe8\x2d\xfb\xe4\xb9\x9e\xef\xed\x0f\xc8\x27\x61\x9e\x1d\xcb\x20\x07\x69\x77\x88\xc2\xf8\x2b\x34\x5d\x99\x60\x17\xc0\x91\xe5\xf6\xa5\x0b\xa3\xac\x4c\xc0\xe5\xd3\x3b\xcb\x7d\xf7\x12\xa4\x55\x7c\x6e\x83\xb2\xea\x7e\xea\x7f\x5a\xdf\xb2\x36\x8b\x72\xf0\x7c\xad\xce\x1d\x64\x18\x38\xff\x94\x15\x75\xd5\x74\x61\xd9\xdd\x4e\x0d\x1b\x45\x71\x55\x76\xa0\xec\x60\x18\x1d\xb0\x58\xce\xa1\xfa\x06\x9a\x14\x0e\x83\x98\xd0\xed\xe4\x9a\x27\xcf\x3c\xad\xcc\xd3\xda\x3c\xf9\xe6\x69\x83\xdd\x64\x75\x55\x1d\x38\x07\x7c\x11\x55\x5d\x57\x15\x81\xed\x37\xa0\xb8\xd5\x53\x20\xd0\x34\xb7\x30\x8a\x9a\x2f\x49\xd8\x85\x56\xd5\x64\xc7\xac\x0c\x73\xab\xcb\xba\x1c\xbc\x98\xa8\xa7\xff\x7d\x45\x26\x49\x40\x5c\xf5\x29\x13\xa0\xa9\x04\x2a\xc5\x07\xb2\x0c\xc2\x48\xaa\xae\x03\xc9\x61\x16\x20\x3e\x37\x6d\xd5\x04\x27\x90\xd7\x07\x9a\x56\x48\x17\x47\xc5\xc5\x6a\xbf\x66\xb5\x05\xa7\x96\xb2\x2a\xc1\x61\xb2\xf7\x16\x26\x49\x03\xda\xf6\x3a\x36\x42\x1f\x1f\x6d\xf7\x3d\x07\x41\x59\x35\x45\x98\x73\x11\x92\x95\x27\xd0\x64\xdd\x2d\xc9\xcd\x2a\x37\xcf\xf9\xac\x4d\xab\xdc\xa8\x20\xac\x71\x86\xe0\xf0\xf7\x39\x37\xce\xb9\xc0\xd9\xb9\x25\xdd\x95\x8d\xcc\xad\xe3\xdc\x92\x44\x80\x42\x7e\x24\x4c\x60\x38\x06\xce\x0d\x25\xea\xff\x3a\x57\x1d\xc0\xc0\x81\x63\x38\x06\x62\x1d\x99\x6d\xd7\x54\xe5\x91\x23\x1c\x55\x79\x02\x9a\x5b\x5b\x84\x39\x1e\xa1\x50\x6a\xec\x9c\xf7\xb7\xf6\x1c\x99\xed\xb9\xbe\xd6\x55\x9b\x41\x9b\x05\x0d\xc8\xc3\x2e\xfb\x06\xb0\x4d\x20\xdc\xd6\x7f\xcf\xd9\xc3\x39\x7c\x03\x70\xe0\x09\x73\x9c\x25\x51\xd8\x02\x08\x00\xa9\x5d\xb1\xdc\x96\xed\xf9\xa0\xb8\x41\xda\xd0\x4e\x96\x0d\xaf\xc2\x2b\x4e\x20\x3c\xd1\x0a\x0e\x43\x7e\x1a\xa7\x1b\x37\xe2\x05\x27\x98\x17\x03\x1d\x7f\x13\xad\xd4\xb1\x75\x0b\x51\x46\x7e\x39\x35\x20\x7d\x79\xee\x7f\xc7\x79\xd8\xb6\x2f\xcf\x98\x04\x76\xee\x88\x44\x59\x4d\x62\x73\x62\x4c\xd2\x88\xab\x04\x98\x5f\xa3\xc4\xac\x1b\x60\xb6\x61\x51\x73\x73\xc4\x63\x27\x2b\xc6\x69\x2e\xcc\xfe\x06\xcc\xc5\xea\x30\xca\x84\xe7\xae\x3a\x58\x45\x6b\x91\x16\x9c\x10\x6d\xdc\x54\x79\x1e\x85\xcd\xad\x9f\x54\x46\x21\x97\x15\xc7\xab\x10\x10\x45\x96\x24\x39\x20\x59\x4c\x12\x0b\x06\xc8\xb7\xe3\x95\x72\x3c\x65\x49\x02\x4a\x31\x98\x7a\xdc\x5b\x17\x46\x39\x9c\x69\xd1\x38\x10\x57\x79\x1e\xd6\x2d\x08\xc8\x8f\x1b\x9e\xe8\xae\x75\x98\x24\x59\x79\x44\xfa\xd9\x5b\x94\x2b\xa4\x09\x6b\x89\x5b\x71\xc0\xe0\x6a\x4e\x1c\xe2\x31\x39\x0b\xce\xa3\x41\x8f\x78\xeb\x4e\x57\x06\x0c\xfb\xf8\x96\x87\x11\xc8\xe9\x94\x99\x95\x30\xca\x2c\x94\x90\x82\x75\x51\xe2\xde\xa2\x73\xd7\x55\x25\xd1\xa3\x09\x93\xec\xdc\xc2\x0c\x46\xcd\xfd\x44\x41\xe7\x07\xb7\xbe\x90\xb1\x90\x34\xf9\xf5\xc5\x80\x8e\x31\xc8\x00\x88\x30\xac\x06\xea\x87\x34\xc2\x94\xcc\xac\xac\xcf\x9d\x59\xd5\x1d\x9c\xa8\x6a\xb3\x05\x39\x88\x3b\x13\xca\x1f\x36\x20\x94\xcf\xe7\x24\x6c\x87\xa0\x21\x2d\xb2\xd1\x8f\x65\x74\x1d\xcd\x4d\xb8\xb7\xe7\xdb\xdb\x0d\xa5\x6d\x5a\x35\x05\x4a\xeb\xdb\x97\xa6\xca\xc1\xa7\x1e\xee\xe5\x8a\x07\x7b\x54\x97\xc2\xa1\xa9\xc7\x7b\xad\x9a\xc4\x7a\x6d\xc2\x1a\x0f\xc3\xb7\x2f\xdd\xf7\x9a\x22\x99\xfd\x55\x03\x5a\xd0\x91\x8b\xf6\x1c\x15\x59\xf7\x62\x62\x3b\x13\x33\x85\x75\x0d\xc2\x26\x2c\x63\x10\xf4\x3d\x3c\x25\x94\xca\x41\x92\xb5\x30\xc8\x92\x67\x8e\xb0\xbc\x0f\xf3\x11\x3b\xb1\x1f\xf9\x56\x51\x39\x9e\x75\x60\x15\xd5\xdf\xb0\x1f\xb3\xb2\x04\x0d\xcf\x5d\xd5\x4d\x04\x18\xf7\x63\x19\x46\x1d\x24\x37\x02\x47\x92\x88\x28\x5e\x7a\xca\xf1\x09\xc4\x5f\xa3\xea\xf2\x62\x32\x8d\x30\x52\xab\x17\x79\xa5\x7b\xa0\x84\x6f\x34\xc0\x68\x40\xc0\x68\x3d\x34\x00\x8d\x41\x24\xb3\x6f\x69\x06\xf2\xa4\x05\xdd\xb5\xc8\x4a\xeb\x35\x4b\xba\x53\xe0\x0c\x54\x70\xe2\x50\x39\x03\xe7\x96\x83\x23\x28\x13\xbe\x32\x3d\xf4\x88\xa8\x40\x2e\xc2\x0b\xa6\x83\x2e\x45\x52\x5c\x0e\xb2\x83\x62\xdf\x20\x09\x6f\x5c\x02\x92\x2b\xb4\x82\xb4\xd0\x02\x80\x84\x62\xdd\x54\x47\x54\x3f\xa8\x26\xbf\xde\x70\xe5\xb9\x88\x40\x03\xfd\x84\x43\x11\xf9\xc2\x6a\x6b\x28\x15\xf2\x94\xa9\x00\xac\xce\x1d\x0f\x78\xc5\x22\x42\x93\x62\xea\x2d\x08\x9b\xf8\xf4\x42\x86\x0c\xab\x4a\xd3\x16\x74\x81\x85\x96\x7a\xe3\xd8\x47\xae\xe6\x30\x07\x76\x7d\x03\x33\x5d\x5d\x55\x04\x06\x9c\x34\xcb\x81\x75\xae\xf3\x2a\x4c\x88\x8c\xd0\xb8\xd4\x88\xea\xf4\xab\xce\x5d\x7d\xee\xa4\xe3\xe6\xad\x3d\x17\x70\xf9\x4d\x3b\xf3\xac\xed\xac\xac\x83\x83\x36\x9f\x48\x1d\x28\xea\x3c\xec\x00\x85\xec\xf5\xeb\xe7\x91\x17\xae\x95\x29\xb5\xed\x93\x6b\xda\x27\xcf\xb4\x4f\x2b\xd3\x3e\xad\x4d\xfb\xe4\x9b\xf6\x69\x63\x2a\xcb\xe9\x71\xf0\xe0\x3a\xca\x1f\x2d\x1d\x3c\x44\xfd\xe4\x32\x55\x95\x87\x62\xec\x06\x39\x9e\x3c\xb6\xbd\x6f\x5d\x99\xa7\x15\xd3\xea\xf6\xd3\xd3\x0d\x0a\x76\x5a\x73\x1d\xb8\xdd\x37\x4f\x3e\xd7\xee\xe1\x8e\x0d\x14\x99\xe9\x40\xad\x39\x08\x93\x31\x34\xa7\xc5\xca\x71\x6e\x36\xb6\x95\xc5\x4a\xbe\x91\x40\x8e\xf5\x25\x98\xac\x6e\xbe\xed\x2f\xc2\x65\x2d\xb0\x5e\x88\xcb\x1a\x69\xa5\x87\x7b\x6a\x88\x6b\xbb\xaa\xee\xcb\x1e\xde\xd5\xa8\x89\x0c\x3e\x64\xb4\x44\xb0\xf5\xc5\x68\xab\x3c\x4b\x8c\xe6\x18\x85\x3f\x39\x26\xfc\x9f\xed\x3e\xdf\x6c\x54\x4c\x9b\x92\x92\x9a\x93\x66\x0d\x6d\x5d\x84\xcd\x57\x13\xfe\x43\x47\x64\xdb\x03\xc5\xb8\xce\x7d\x4a\xe3\x74\x07\x56\x37\x1b\x25\xc0\xb9\x44\xc3\x75\x42\x90\x70\xf1\x7f\x40\x9d\xcc\x48\xde\x43\xf7\x85\xc8\x12\x58\x94\x61\xf2\x84\x1c\x81\xf5\xd3\x5e\x1e\xb6\x9d\x15\x9f\xb2\x3c\x79\x26\xf6\x44\x9b\x8f\x78\xad\x69\x67\x65\xd6\x65\x61\x9e\xb5\x05\x63\x91\xbd\xf3\xfe\x20\x94\x03\xe7\xba\x06\x4d\x1c\xb6\xe0\x66\x8f\x56\x32\x9c\x47\x46\x71\xcc\x22\x58\xfd\xe6\x06\xd5\x00\xf5\x30\x63\x3d\x74\x05\x57\xf4\x49\x90\xe9\x8e\x0e\x5e\x80\x07\xef\xfe\xdd\x73\xdc\xf5\xbf\x3b\xce\x1f\x9c\x77\x37\x3b\x2b\x8e\x56\x9a\x9f\xb3\xe4\x2a\xcc\x37\x38\xb6\xd0\xc0\x8c\xa0\xba\xd3\xb9\x88\xca\x30\xcb\x89\x07\x02\x9c\x78\x12\x27\xa7\x29\x99\xe7\x86\xe8\x7a\x4a\x00\xf0\xc0\xe6\xc0\xd7\x89\x98\xc6\x14\x73\x5c\x93\xcb\xdd\xd8\x77\x5a\xb0\x40\xe7\xad\x3b\x9e\x06\x5d\x0a\x4d\x2a\xeb\xc1\x92\xfb\x91\x25\xe1\x9a\x86\x01\xd8\x6d\x6d\x9f\x82\xe0\x3d\xde\xa1\x94\x43\x5b\x98\x16\xbc\xbe\x85\x9f\x11\x26\x37\xd7\xde\xbe\x46\x34\xc6\x03\xdb\x6b\x40\x61\xd8\x6b\xde\xfb\x1c\xfd\x34\x4d\x25\x56\xc5\x5b\x37\xa2\xfd\x60\xcc\x7c\x8d\x12\x83\xe5\x81\x8b\x60\x24\xb9\xeb\x08\x09\x0b\xd7\xe0\x75\x03\xd4\x51\xc5\x8a\xd2\x33\x85\xcb\x2c\x43\xb0\x08\x99\x10\x39\x4d\x0f\xd0\x06\xfd\x7e\x2c\x29\x2a\xec\xba\x01\x56\xbf\xc2\x82\xb5\x23\x8a\x33\xec\x91\xd5\x1a\x6e\xd4\x92\xba\xca\xfa\x1e\xf4\x60\x37\x1b\xc6\x6a\x98\xc1\xca\x6f\xf8\xd9\x87\x29\xdb\x90\x1f\xd9\xab\x82\xeb\x6b\x0b\xf6\xea\x92\x5f\x99\xe8\xc2\x56\xc2\x99\xed\xfa\x35\x2d\xf7\xfa\x41\x05\xb5\xe0\x68\x6a\x68\x20\x92\xf1\x14\x81\xc0\x86\xdb\x7f\x2d\x40\x92\x85\xc6\x4f\x43\xc5\x87\x36\xaf\x9f\xaf\x03\x67\x5e\x24\x26\xc7\x7c\xa8\xfa\x4d\x42\x02\xed\x74\xab\x48\x14\x89\x92\xe0\xd6\x53\x10\x44\x9b\xe4\x2a\x82\xf9\x51\x93\xfc\x7e\xa3\x20\xdf\xef\xb6\xdf\x47\x9f\xbd\xba\xe4\x0c\x37\xd7\xed\xcd\x63\x37\xd5\x2b\x8d\x53\xb8\x70\x4f\x73\x70\x81\x3b\x88\xa4\x0d\x5e\x1f\x48\x47\x9f\x8b\xf0\x9f\x83\x70\xc9\x39\xd3\x62\xfd\x8b\xbc\x89\x5a\x6e\x76\x59\x59\xc7\x33\xbc\xb1\x44\x37\xd0\x1a\xbc\x19\xc4\x02\x3b\x2c\xe0\x67\x3b\xae\x72\x93\x6d\xe8\x77\x4f\x7e\xfe\x14\x57\xb9\xf5\x72\xe5\x43\xcd\x39\xf0\x93\x17\x0c\xf4\x1c\x1a\x21\xb7\x5c\xfc\xc7\xc1\x7f\xc9\xb5\xd7\xff\xc5\x7f\x56\xfd\x9f\x75\xff\xc7\xef\xff\x6c\xfa\x3f\xdb\xfe\xcf\xae\xff\xb3\xef\xff\xc0\x38\xed\x7f\xf5\x9e\x80\x7f\x09\xaf\xfc\x48\xd9\xe5\x47\xca\x31\x3f\x52\xa6\xf9\x91\xf0\xcd\x8f\x84\x75\x7e\x24\xdc\xf3\x23\x11\x20\x3f\x12\x19\xf2\x23\x11\x23\x3f\x12\x49\xf2\x23\x11\x26\x3f\x32\xf2\xf4\xb1\x90\x5b\x45\x42\xe4\x29\x12\x2a\x4f\x91\x50\x79\x8a\x84\xca\x53\x24\x44\x9e\x22\x21\xf2\x14\x09\x91\xa7\x48\x88\x3c\x45\x42\xe4\x29\x12\x22\x4f\x91\x10\x79\x8a\x84\xc8\x53\x24\x8c\x3c\x7d\x34\xe6\x56\x5b\x10\x79\xda\x82\xca\xd3\x16\x54\x9e\xb6\xa0\xf2\xb4\x05\x91\xa7\x2d\x88\x3c\x6d\x41\xe4\x69\x0b\x22\x4f\x5b\x10\x79\xda\x82\xc8\xd3\x16\x44\x9e\xb6\x20\xf2\xb4\x05\x23\xcf\x05\x07\xc6\x85\xc6\xc6\x65\x08\x8f\xcb\x10\x21\x97\x21\x48\x2e\x34\x4e\x2e\x34\x54\x2e\x34\x5a\x2e\x34\x60\x2e\x34\x66\x2e\x34\x6c\x2e\x34\x72\x2e\x34\x78\x2e\x7d\xfc\x48\x36\x4e\x17\x0e\xa6\x28\xce\xaf\x34\x4d\xeb\x06\xa4\xa0\x69\x40\xd2\x4f\x7d\x4e\x9f\xad\x51\xd8\x66\xe8\x86\x20\x05\x43\x6c\xbf\x81\xc0\xed\x01\x8e\x4d\xf5\x1a\xb8\x07\x66\x94\x70\x9c\xf7\x68\x88\x80\x75\x47\x6b\xb9\x9f\x7f\xa6\x3c\xfa\xdd\x62\x34\xf3\x71\x57\x4a\x64\x4f\x44\xf6\x59\x5c\x9f\x43\xf5\x39\xcc\x95\x88\xb9\x5a\xd9\x2b\xf4\xdf\xfb\x83\xac\x6d\xa0\x33\x34\x32\xe4\xd6\x22\x39\xcf\x67\xe8\x78\x3e\x4b\xc0\xf3\x59\x4c\x7f\x84\xc9\xaa\xe0\x71\x2a\x78\x9c\x0a\x1b\x11\xd3\xdd\xd8\x1b\xf8\xdf\xf6\xfd\x41\xd6\x36\xd0\x19\x1a\x6f\x74\xbc\xe1\x49\xc1\x96\x81\x08\xba\xea\x51\xf1\x9c\x4a\x29\x21\x67\x42\x1a\x2e\x4f\x60\x47\x2d\x27\x69\x1a\xf0\x69\x5b\x2f\x88\xc7\x13\x61\x84\x97\xb5\x31\x62\xd0\xc6\x9e\xce\x6a\x99\x33\x20\xca\x9a\x47\x61\x5c\x2f\x6b\x1b\x08\x0c\x8d\x3d\x1d\x9f\xa7\xb3\x76\xa9\xb8\xb2\xb6\x81\xce\xd0\xd8\xd3\xd9\x2c\x0b\x6c\x88\xb2\x15\x50\x06\x83\xcb\xda\x18\x02\xb4\xb1\xa7\xb3\xe3\xe9\x6c\x06\x8b\xcb\xda\x06\x3a\x43\x63\x4f\x67\xcf\xd3\xd9\xb2\x5e\xd8\x72\x5e\xd8\x12\x2f\xb8\x0e\x8f\xb3\x1b\x4c\x2e\x6b\x1b\x28\x0c\x8d\x98\x90\x10\x8f\xfb\xc1\xe6\xb2\xb6\x81\xd0\xd0\x88\x09\x79\x8b\x87\xa7\xbe\xd4\x4f\xb3\xa6\xed\x28\xae\x85\x1a\x03\xcb\x3d\x90\x1f\x04\x2e\x0f\xc7\x60\xee\x0a\x83\xb9\x2b\x02\xe6\x88\x30\x0e\x06\x71\x08\x84\x2b\x42\x10\x5e\x94\x95\x27\x42\x78\x18\xc2\x23\x10\x2b\x11\x82\x08\x42\xe5\x58\x8b\x10\x6b\x0c\xb1\x26\x10\xbe\x08\xe1\x63\x08\x9f\x40\x6c\x44\x88\x0d\x86\xd8\x10\x88\xad\x08\xb1\xc5\x10\x5b\x02\xb1\x13\x21\x76\x18\x62\x47\x20\xf6\x22\xc4\x1e\x43\xec\x09\x84\xeb\x88\x20\x2e\xb1\xaa\x3b\x98\x75\x6c\x57\x6a\x58\x6a\x59\x77\x64\x5a\x97\xd8\xd6\x85\xc6\x45\xbb\xa1\x96\x4b\x2a\x54\x34\xc7\x32\x59\x87\xfb\x3d\xae\x7f\x18\xe9\x28\xc0\x8a\x03\xf0\xfc\xa1\x67\xcd\xf5\xb0\x83\x12\x06\xf0\x39\x00\x76\xb4\xc1\x00\x74\x97\x11\x01\xf8\xce\xd0\xb3\xe5\x7b\xc6\x72\xef\x38\x00\x76\x18\xc0\x00\x7b\x0e\x60\xcb\xc8\xed\x3a\x5c\x17\x9b\xc6\x04\x82\xb7\x1a\x93\x9f\x53\x2b\x39\x58\x97\xfd\xf0\xda\x05\x56\x95\x9f\x7f\x5e\x3c\x3e\xb0\xf8\xf7\x57\x30\xb0\x7a\x15\x91\x07\xbf\x1f\x64\x6d\x03\xa9\xa1\x91\xa7\x78\x7f\x1d\x03\x0b\xe7\x11\xb2\x6e\x29\x03\x6b\x6d\x11\x99\x99\xe8\x65\x6d\x03\xa9\xa1\xf1\xc6\x16\xe4\x6f\x2d\x68\xa0\x73\x79\x1a\x34\xf0\x0f\x92\xa6\x81\x04\x6d\xa3\x74\xbc\xc7\x69\x76\x47\x65\x03\x17\x37\x3c\x16\x13\x12\xb2\xb6\x81\xc6\xd0\x48\x49\x3d\xaa\xbe\x81\x2e\x5f\x16\xf9\x18\xeb\x51\x55\x0e\x5c\xcb\xf1\xa4\x86\x81\xeb\x20\x6b\x1b\x48\x0d\x8d\x94\xd4\x1d\xb5\x4e\x5b\xb0\x13\xd0\xdb\xca\x9d\xb6\x60\xe7\xa9\xb7\x55\x3c\x6d\xc1\x4e\x67\xba\x83\x1a\xbe\x91\x5b\x68\xd7\x3d\x6d\xa1\x5b\xfa\xb4\x85\x46\xf5\xc3\xa5\x2b\xa1\x45\x48\x31\x40\x9e\x08\x34\xaa\x81\xb8\x2c\x53\x95\x41\x5c\x52\xa9\x2a\x21\x2e\x5d\x54\xc5\x10\x97\x08\xaa\x7a\x88\x8b\x7b\x55\x49\xc4\x45\xb4\xaa\x2a\xe2\x62\x55\x55\x18\xf1\xa1\xa9\xae\x8d\xf8\xb0\x23\x70\xd4\xec\xac\xdd\xf5\x2a\x24\xe4\x6a\x76\xba\x77\xd8\xae\xb9\xfa\x09\xb9\x97\x05\x61\x07\xd0\x01\x46\x5d\x45\x21\xaf\xb2\x9d\xec\x00\x38\xc0\xcc\xd6\x52\xc8\xa7\xaa\x72\x0a\xf9\x92\xeb\x94\x6a\x32\x5b\x54\x21\x4f\xaa\xea\xaa\xb6\xd0\x28\xad\xda\x62\xa2\xba\x9a\xdc\xe5\x86\xdb\x70\x3f\xbc\xbc\x82\x9b\x88\x9f\x7f\x5e\x3c\x12\xb1\xf8\xf7\x97\x57\x70\xb3\x52\x44\x1e\xa2\xe1\x20\x6b\x1b\x48\x0d\x8d\x3c\xc5\xfb\xcb\x2b\xb8\x4f\x3a\x42\xd6\x2d\xaf\xe0\xd6\xaa\x88\x3c\xa4\xc6\x41\xd6\x36\x90\x1a\x1a\x6f\xec\xfe\xeb\x5b\xcb\x2b\xe8\x5c\x9e\x06\xcd\x82\x83\xa4\x69\x20\x41\xdb\x28\x1d\xef\x71\x9a\xdd\x51\x5e\xc1\xbd\x6c\x1e\x8b\x09\x09\x59\xdb\x40\x63\x68\xa4\xa4\x1e\x55\x5e\x41\x97\x2f\x8b\x7c\x8c\xf5\xa8\xf2\x0a\x6e\xdd\xf3\xa4\x86\x21\xec\x20\x6b\x1b\x48\x0d\x8d\x94\xd4\x1d\xe5\x55\x91\xb0\x73\xd8\xdb\xca\xab\x22\x61\xe7\xb9\xb7\x95\x57\x45\xc2\xce\x85\xba\x83\x1a\x9a\x32\xad\x22\xd1\x2e\xaf\x8a\x44\xb7\xbc\x2a\x12\x8d\xf2\x8a\x4b\x57\x42\x8b\x90\x62\x80\x3c\x11\x68\x54\x5e\x71\x59\xa6\x2a\xaf\xb8\xa4\x52\x95\x57\x5c\xba\xa8\xca\x2b\x2e\x11\x54\xe5\x15\x17\xf7\xaa\xf2\x8a\x8b\x68\x55\x79\xc5\xc5\xaa\xaa\xbc\xe2\x43\x53\x5d\x5e\xf1\x61\x47\xe0\xa8\xd9\x59\xbb\xeb\x95\x57\xc8\xd5\xf2\xf2\x0a\xf2\xe2\xeb\x85\x21\x05\x06\x90\xd9\xf2\x0a\x79\x57\x55\x5e\x21\xaf\xb2\x9d\xec\x00\x38\xc0\xcc\x96\x57\xc8\xa7\xaa\xf2\x0a\xf9\x92\xeb\x94\x6a\x32\x5b\x5e\x21\x4f\xaa\xca\xab\x22\xd1\x28\xaf\x8a\x64\x59\x79\x35\xdc\xf3\x87\x77\x5d\x7f\x78\x79\x05\xef\x19\x7f\xfe\x79\xf1\x48\xc4\xe2\xdf\x5f\x5e\xc1\x7b\xd3\x22\xf2\x10\x0d\x07\x59\xdb\x40\x6a\x68\xe4\x29\xde\x5f\x5e\xc1\xdb\xe2\x23\x64\xdd\xf2\x0a\xde\x49\x17\x91\x87\xd4\x38\xc8\xda\x06\x52\x43\xe3\x8d\xbd\xdd\xfe\xd6\xf2\x0a\x3a\x97\xa7\x41\xb3\xe0\x20\x69\x1a\x48\xd0\x36\x4a\xc7\x7b\x9c\x66\x77\x94\x57\xf0\xd1\x05\x1e\x8b\x09\x09\x59\xdb\x40\x63\x68\xa4\xa4\x1e\x55\x5e\x41\x97\x2f\x8b\x7c\x8c\xf5\xa8\xf2\x0a\x3e\xa9\xc1\x93\x1a\x86\xb0\x83\xac\x6d\x20\x35\x34\x52\x52\x77\x94\x57\xf9\x91\x9d\xc3\xde\x56\x5e\xe5\x47\x76\x9e\x7b\x5b\x79\x95\x1f\xd9\xb9\x50\x77\x50\xc3\xb7\xe2\x8e\xda\xe5\x55\x7e\xd4\x2d\xaf\xf2\xa3\x46\x79\xc5\xa5\x2b\xa1\x45\x48\x31\x40\x9e\x08\x34\x2a\xaf\xb8\x2c\x53\x95\x57\x5c\x52\xa9\xca\x2b\x2e\x5d\x54\xe5\x15\x97\x08\xaa\xf2\x8a\x8b\x7b\x55\x79\xc5\x45\xb4\xaa\xbc\xe2\x62\x55\x55\x5e\xf1\xa1\xa9\x2e\xaf\xf8\xb0\x23\x70\xd4\xec\xac\xdd\xf5\xca\x2b\xe4\x6a\x79\x79\x05\x79\xf1\xf5\xc2\x90\x02\x03\xc8\x6c\x79\x85\xbc\xab\x2a\xaf\x90\x57\xd9\x4e\x76\x00\x1c\x60\x66\xcb\x2b\xe4\x53\x55\x79\x85\x7c\xc9\x75\x4a\x35\x99\x2d\xaf\x90\x27\x55\xe5\x55\x7e\xd4\x28\xaf\xf2\xe3\xb2\xf2\x8a\x79\xe6\x31\xb7\x2e\xf9\x0f\xaf\xaf\xe0\x33\x5e\x9f\x7f\x5e\x3c\x14\xb1\xf8\xf7\xd7\x57\xf0\x59\x32\x11\x79\x08\x87\x83\xac\x6d\x20\x35\x34\xf2\x14\xef\xaf\xaf\xe0\x63\x6c\x23\x64\xdd\xfa\x0a\x3e\xf9\x26\x22\x0f\xb9\x71\x90\xb5\x0d\xa4\x86\xc6\x1b\xf7\x78\xdc\x1b\xeb\xab\xcb\x83\x9e\x78\xba\x3c\xee\xa1\xa7\xcb\x5d\xcf\x3d\x5d\x1e\xf7\xe8\xd3\xe5\x71\x4f\x3f\x5d\xee\x7a\x00\xea\xf2\xb8\x67\xa0\x2e\x8f\x7b\x0c\xea\x72\xd7\x93\x50\x97\x07\x3e\x0c\x75\x79\xe0\xf3\x50\x97\x37\x3c\x12\x75\xc9\xb5\xeb\xab\x4b\xae\x5b\x5f\x5d\x72\x8d\xfa\x8a\x4b\x57\x42\x8b\x90\x62\x80\x3c\x11\x68\x54\x5f\x71\x59\xa6\xaa\xaf\xb8\xa4\x52\xd5\x57\x5c\xba\xa8\xea\x2b\x2e\x11\x54\xf5\x15\x17\xf7\xaa\xfa\x8a\x8b\x68\x55\x7d\xc5\xc5\xaa\xaa\xbe\xe2\x43\x53\x5d\x5f\xf1\x61\x47\xe0\xa8\xd9\x59\xbb\xeb\xd5\x57\xc8\xd5\xf2\xfa\x0a\xf2\xe2\x0b\x86\x21\x05\x06\x90\xd9\xfa\x0a\x79\x57\x55\x5f\x21\xaf\xb2\x9d\xec\x00\x38\xc0\xcc\xd6\x57\xc8\xa7\xaa\xfa\x0a\xf9\x92\xeb\x94\x6a\x32\x5b\x5f\x21\x4f\xaa\xea\xab\x4b\xae\x51\x5f\x5d\xf2\xa9\xfa\xca\xee\xcf\x41\x18\x92\xfc\xc0\xbf\xc9\x85\xde\x93\xe3\x5f\x47\xea\x51\x8c\x2e\x31\xc9\xaf\x13\x7d\xf7\x09\x1f\x86\x20\xbc\xc2\xdc\x55\xb5\xfc\xa5\x47\xfc\x5a\x1a\x25\x09\x0f\x12\x82\xe4\xc4\x57\xa0\xd1\x5b\x65\x84\x04\x96\xcc\x53\x52\x81\x27\x0c\xfd\x82\xfe\x25\xe7\x22\x74\x55\xad\x84\xb7\xda\x62\xd0\x05\x5d\x30\xea\xac\xe0\x0b\x5e\xb8\xab\xa7\x05\x92\xab\xea\xed\x3a\x11\x90\x21\x3b\x34\x9d\x16\xa0\xf7\xe6\x90\x11\xc1\x76\xe2\x2c\x82\x47\x6a\xaf\xbe\xf0\x94\x72\xd0\xb6\xac\x51\x78\x72\x7d\xaf\xc0\xa3\x6f\x3c\x49\x1b\x39\xd6\x81\x43\xad\xd8\x35\x59\x0d\x15\x84\x2c\x8c\xae\x09\xca\xee\x64\x55\xa9\xd5\x7d\xaf\xc1\x4f\x55\x92\x3c\x5f\x47\x2f\xd5\xb1\xaf\xbc\x3a\xfe\x33\xa1\x84\x4e\x3e\x19\xe8\x70\xe7\xb1\x90\xd7\xf0\x26\x49\x6d\x07\x5a\xf8\xe4\x35\x93\xbf\xfc\xdc\x25\xa3\x96\xd3\x58\xbe\xa7\x68\x97\x84\x69\x2a\xd0\x92\x99\x92\x76\x89\x74\x19\x23\x0e\x2d\xbc\xf3\x30\xaf\x6d\x18\x31\xbc\x90\xd2\x06\x8f\x89\x2d\x31\x16\x73\x9f\xc6\x89\x0e\x2a\xa3\xf6\x14\xd0\x69\x96\x05\x3d\xbd\xce\x14\x1b\x18\x16\x4c\x9b\x8c\x62\xb2\x49\x76\x49\x34\xa2\x28\xb3\x2e\xd3\x39\xa6\xce\x58\x98\x6d\x93\xda\x38\x5a\x45\xdb\x28\xba\xc9\x6c\x40\x71\x95\x56\x8e\x77\x71\x14\xa7\x3a\xc8\x2a\x3b\x8f\xc0\x4e\xb3\x6c\xf0\x99\x80\x54\xc3\xfe\x92\xb5\x32\x69\x91\xd2\x5a\x81\x4d\x3c\xd8\xb8\x07\x95\x5a\x98\x74\x89\x74\x59\xeb\xd2\x16\xa9\x6d\x77\x69\xe2\xee\xc1\x4d\xaa\x37\x3e\xd8\x50\x65\xd9\xc8\x4d\xd2\x28\x9a\x47\x55\xda\x95\x07\x3a\xcd\xb2\xc8\xca\xb4\x22\x94\xe0\x6f\x86\x70\x7f\x29\x25\x01\x80\x0f\x38\x12\x32\x4b\x42\x7c\xc6\x8c\xfd\xe5\x49\xb8\x94\x1b\x70\x13\xa7\x49\x78\x93\x29\x08\xa9\x28\xe3\x32\x8c\x92\x04\xf8\x33\x78\x2a\xd3\xb1\x10\xa7\x59\xe2\xf8\x00\x4a\x93\xbf\x64\x68\xd3\x16\x19\xad\x34\x05\x20\x0a\x6f\x3c\xa8\xcc\x86\xb4\x4b\xa4\xcb\x58\x72\x68\x91\x1a\x33\x4d\x93\x74\x2b\x8f\x46\x8c\xa9\xb4\x67\x9a\x82\x5d\xe8\xce\xa3\xaa\x4c\x2a\x00\x9d\x66\x59\xf4\x87\x78\x12\x5a\xfd\x15\x43\x9c\x34\x48\x09\xf9\x31\x93\xe0\x3d\xa4\xcc\xa2\xa4\x47\x20\xca\xd8\x93\x36\x48\xcd\x09\x92\xfd\x46\x91\xdc\xf8\x08\x52\xa5\x35\xdd\xc8\x89\xb6\xb3\x98\x2a\x63\xf2\x30\xa7\x59\x06\xe8\x20\x4b\x42\x09\x5d\x30\x94\xf1\xb5\x94\x4a\x92\x26\x29\x55\x10\x01\xca\xec\x88\x3b\x78\x8a\x8c\x15\xc9\xb5\x3c\x26\xa3\x34\x4e\xe3\x9b\x4c\x4d\x84\xa7\x8c\x48\x10\x83\x38\xdd\xcc\x21\xaa\x4c\xc8\x81\x9c\x66\xc9\xc3\xa3\x6b\x09\x1d\xf8\x9b\x21\xdb\x5f\xca\x48\xc4\x9b\x78\x17\xd3\xd4\x86\x70\xf2\x28\x84\xed\x1c\x39\x2e\x02\x9b\xaf\x2a\xd3\xed\xfd\xfd\x7e\x2f\x37\x1d\x24\xaa\xb4\x5c\xb4\x8f\xa2\x08\xcc\xe0\xa9\x0c\xc7\x42\x9c\x66\x89\x87\x31\x7c\x39\x97\x10\xea\xaf\x18\xca\xa4\xe1\x74\xd5\xae\x5d\x39\x61\x7a\x7c\x95\xa2\x8b\x49\xa8\x74\xe6\x61\xf4\x85\x35\x6c\x14\xf4\xc4\x89\xd7\xc9\xb3\x34\xf0\x71\xc4\xbc\x8b\xd7\xfe\x3a\xf1\x7d\x81\x1c\xc9\x27\x42\x6f\xbd\xf7\x1d\x7f\x2b\x21\x09\xf6\x20\x06\xa9\x40\x92\x5f\x56\x41\xd1\x74\xe4\xba\xbd\x39\x5a\x39\x55\x10\xa2\xb0\x80\x1b\x2d\x9e\x18\x98\x7b\xd7\x51\x9e\xef\x9b\xe4\xff\xec\x6a\x8a\x21\x3d\xb5\xb0\x92\x5a\x44\x42\x18\xba\x9c\xde\x93\xa1\xbb\x83\xfe\xd6\xb7\xf7\xfd\xd1\x19\x3d\xd7\x06\xb4\x75\x55\xb6\xd9\x37\x00\x5f\xe0\x52\x1e\xb3\x46\x4f\x1f\xb9\xe0\xe3\x21\xf1\xf9\x5e\xb4\xbd\x3f\x93\x04\x4e\xa7\x5d\x75\x8e\x4f\x37\x19\xf9\xcf\x4a\xeb\x4a\x24\xdd\x6e\xb6\x4a\x49\x8b\xe4\x87\x4a\x5a\x24\x8b\x24\xdd\xef\x5d\xa5\xa4\xf9\xf1\x87\x4a\x9a\x1f\x17\x49\xea\xba\xfb\xbd\x52\xd4\x4b\xfe\x43\x45\xbd\xe4\x13\xa2\x8e\xc0\x7f\xa4\x28\x6a\x39\x6c\x78\x48\xa5\x05\x4f\x5b\x69\xaa\x09\x6b\xe0\x73\x79\xe2\x30\x8f\x7f\x72\xe1\x71\xb6\xc6\x2f\x46\xbf\x9f\x66\xfc\x62\x78\xf5\xe5\x99\x9c\xed\x10\xd8\xab\xbe\x19\xef\xb6\x0d\xc7\x02\x0d\xa7\x5a\x0d\xe7\x83\xa9\x0e\xa3\x56\x8e\xa5\xe2\x50\x90\x67\x75\x80\x39\xa3\xd3\x19\xc7\xfb\x57\x31\x48\xd6\x49\x38\x3a\x1c\x09\x09\x87\x4e\xe9\xec\x4f\xb0\x60\x47\x49\xc3\x76\xfd\xd6\x00\x61\x0b\xd7\x20\x56\x75\xee\x4c\x74\x14\xe4\x29\x4c\xaa\xd7\x51\x1f\x8d\xb9\xfe\x16\x6f\x0b\x8f\xf7\x3f\xc7\x20\xb1\x8a\x0a\x1f\x8d\x91\x9c\x63\xf0\x7c\xe5\x0d\xcd\x70\x46\x07\x99\xf1\x7e\x08\x02\xb8\xa3\x0d\x2e\x75\x58\x26\xd7\xa9\x23\x81\x0f\x72\x3f\x32\xc7\x61\xc2\xd3\x52\xaf\x63\x3c\x74\x2a\x59\xaf\x11\xba\xa3\xe2\x18\xd8\xe6\x02\x21\x44\xe3\xaa\xe5\x15\x6e\x9a\xd9\x39\x51\x92\xa6\xf4\x28\x57\xe7\x30\x58\x10\xf3\x43\xe7\x53\x91\x93\xe5\x5c\x6f\xd5\x8f\xe1\x9e\xff\x2c\x48\x40\xcf\x5f\x44\x07\x74\x5a\x75\x1e\xc6\xe0\x84\xce\x74\xbe\xf2\xc7\xda\x56\x75\x18\x67\xdd\xf7\xc0\x1d\x11\x80\xc6\x58\x8e\x07\x5d\xf0\x06\x9e\x6f\x40\x5e\x8e\x42\x8e\x5f\x35\xb9\xe6\x2f\x0d\x08\x93\xaa\xcc\xbf\xbf\x8c\x83\x88\x54\x25\x03\x45\xe6\x00\xd4\x24\xec\xc0\x0b\x47\x8a\x3d\x1e\x15\xf6\x76\x59\x01\xac\xbc\x8a\xc3\x5c\x0d\x57\x54\x65\x77\x52\x77\x43\x12\x7c\xaf\xea\x10\xce\x03\x0a\x67\xb1\x51\xb8\xc6\xc7\xe8\x72\x04\xfb\x33\x86\x61\x04\xb4\xd6\xb7\x30\x3f\x03\x9d\x48\xe6\x4d\x8b\x8e\xfd\xe4\xcd\x6a\xa1\x2f\x76\x28\xc7\xca\xfe\x1e\x26\x42\xe8\xcf\x4b\x26\xe3\x13\xdc\xe8\x47\x03\x28\x19\x22\x7f\x31\x5c\x66\xe4\x24\xf7\x0e\x64\x20\xfc\x7d\x0f\xf6\x10\x37\x72\xbc\x9a\x30\x90\x8a\x42\xc0\x87\x7d\xc7\x72\xcc\x4a\xc1\x02\x30\x23\x39\x1e\x3e\xe7\x78\xb6\x85\x84\xa7\x37\xcb\xd4\x93\x73\xb5\x77\x5b\x39\x57\xce\x37\x75\x1e\xc2\xf3\x52\x2f\x9d\xd2\x3f\xa3\x99\xca\x99\x30\xaf\x2b\xe1\x78\x98\xd9\xef\x97\x8c\xcf\xfd\xbd\x94\x71\x07\x29\x52\xea\x8b\x21\x0c\xe1\x83\x22\x7c\x33\x3c\xad\x4b\x07\xae\x2d\x66\x8f\x18\x13\xe1\xa5\x73\xbc\x74\x8a\xef\x3d\xc4\x9c\x3f\x3a\xe5\xa0\xd1\xd4\x8b\x6e\x5b\x71\xcc\xf3\xa3\x94\xb9\x2b\xe3\x0d\x59\x18\xf2\x13\x32\xe7\x38\xa3\x1b\x66\x92\x61\xe2\x4b\x71\xce\xbb\xac\x86\xdf\x7d\x90\xf5\x42\xe5\x5e\x88\x80\xb0\x16\xa4\x27\x3f\x73\x70\x1c\x44\xdf\x03\xc7\x96\xfa\xca\x47\x97\x3b\xa8\x2f\x09\x53\x0c\x8b\x4e\x74\xc7\xa7\x7e\x22\xd0\x1f\x72\xdc\xdd\xe8\xb4\x3b\x74\x22\x17\xe1\x87\x4f\xb0\xa3\x97\x53\xe7\xd7\x8d\x0e\xf7\x1a\x28\xa1\x13\xb6\x25\x27\x85\x11\xc9\xfb\xf4\xe4\x90\xe9\x81\xa7\x03\x81\x7e\x32\x1d\xc8\x84\x51\x5b\xe5\xe7\x0e\x10\xf9\x91\xc1\x56\xe2\xd7\x22\x2c\x25\x25\x3a\x63\xfe\x9d\xed\x42\xc3\x96\x39\x02\xfe\x42\x80\x5f\xc6\xd0\xfc\x04\xcd\x31\x42\xd4\x04\xef\x3b\x37\x9e\x3a\x8c\x58\xce\xb1\xf8\x10\x53\xd1\xbf\x4c\xf3\xe0\xe6\xfe\x70\xfe\x18\x94\x1d\x68\x0e\xe8\x02\x1d\x58\xdb\x92\x26\xce\xa8\xce\x81\x3f\xb6\x16\x1f\xbd\x3c\x92\xc6\x98\xb0\x7a\xdb\x85\x5d\x16\x1f\x64\x9f\x55\xc0\x54\x57\xae\xe7\x8b\x5e\x70\x6e\xf6\xb7\x30\xcf\x12\x2b\x05\x20\x81\x53\x2e\x55\x18\x4d\xee\xcc\xc8\x3c\x8a\x7e\xf9\x91\xb6\xfd\x37\xa8\x08\xd5\xae\xaa\x60\xf6\x4a\x62\x03\x0a\x88\xe8\x62\x03\xfc\x0d\x7e\xab\x0b\x5c\x02\x9f\x9a\x15\x09\xc0\x2c\x16\xb9\xe9\x81\x1d\xe5\x58\xc9\x5c\xbd\x61\x4f\xb1\x62\x19\x36\x2f\xd6\x8e\xe9\x6e\xb6\xe6\x66\x6f\xda\xfb\xe7\xd1\x28\x89\x8f\x15\x46\x85\x4b\x96\xfc\x5d\x30\xa1\x39\xea\xc1\x66\x30\xed\xd7\x10\xf7\x84\x1d\x48\x8c\x40\x81\x3e\x05\x45\x2c\x4a\xac\x44\x4e\xd4\x65\x06\x3b\xca\x5e\xa4\xc4\x41\xf5\x64\xf1\x62\xd3\xe2\xbc\x47\x83\xb3\x91\x4c\x3a\x28\x36\x9f\x59\xb3\x65\x45\x78\x04\xc1\xb9\xc9\x7f\x7a\x07\xbf\xd7\x13\xa0\xeb\x8f\xed\xb7\xe3\x2f\x97\x22\x37\xdf\xaf\xe2\xf6\xdb\xd1\xb8\x14\x79\xd9\x7e\xfa\x00\xbf\xef\x16\x7c\xfc\xf8\xfa\xfa\x6a\xbf\xae\xec\xaa\x39\x7e\xf4\x1c\xc7\x81\xc0\x1f\x0c\xe4\xe7\x4f\x1f\x76\x1f\x8c\xde\x53\xe8\xe7\xb7\x0c\xbc\xfe\x73\x75\xf9\xf4\x01\xae\x4c\x76\xc6\xee\xc3\xfb\x15\x78\xbf\x8a\xeb\xb0\x3b\x19\x69\x96\xe7\x9f\x3e\xbc\xf7\x56\xbd\xd8\x1f\x8c\xe4\xd3\x87\xdf\x3c\x7b\x65\x6c\xec\xed\xea\x57\x7b\x63\xac\x6d\x7f\x15\x5b\xf6\xda\x72\x6d\x67\x6d\xaf\x37\x96\x6b\xaf\x0d\xd7\x76\x2d\x7b\x97\xbb\xb6\x6b\xc0\xcb\x95\xbd\xb6\x56\xf6\x2e\xb6\x37\x96\xbd\x59\x19\x2e\xfc\xeb\x6d\x0d\xd7\xf6\xec\x6d\x6e\xad\x8d\xb5\xbd\x81\x24\x56\xb6\x6f\xd9\x3b\x44\xca\xb5\xdd\xbf\x7d\xf8\xd8\xcb\x01\x25\x7f\xbf\x02\xef\x38\x83\x34\xa0\x06\x61\x17\x94\x15\xfe\xc5\xf6\xd1\x4c\x40\xb6\x35\x68\x41\xd9\x1b\xd7\xed\xe3\xf5\xd9\xc0\xa3\x04\x83\x88\xe2\xb9\x07\x27\xd0\x2b\x02\x2c\x6b\x55\xc4\x44\x5f\x76\x6b\x44\x06\xfe\x06\x88\x34\x3e\xa6\x16\x8c\x4c\xe6\xa0\x05\x23\xcf\x48\x3a\x3b\xf7\xa1\x68\x4a\xfb\xa8\xe0\xd7\x65\x41\x49\xed\xdc\x55\xb5\xd2\xca\x93\x3e\xb8\xd9\xf1\xb9\x85\x8f\xc7\xe0\xba\x43\x99\x55\x1c\xd8\xc2\xb4\x22\x5e\xf3\xc8\xe8\xcc\x2a\xf1\xb8\x9c\x5a\x0f\x39\xe5\x0b\x39\xb5\x36\x7c\x69\x4e\xf5\x9b\xda\x38\xa7\x0c\xe7\x57\xc7\xf0\x4e\xeb\xbf\x15\x8e\xe1\xff\xea\x18\xab\xd3\x7a\x9c\x02\x06\x0d\x78\x03\x69\x88\xdd\x82\x63\xf9\xe3\xae\xbe\x18\xae\x53\x5f\xcc\xff\xe4\x23\x85\xf1\x94\xa6\x29\x63\xab\xde\x3c\xd8\x64\xf8\xb3\x0b\x1f\x17\x25\xba\x3c\x4e\x15\x99\x2e\x89\xd6\x47\xa5\xba\x58\x9c\x50\x51\x64\x85\x9c\x20\x96\x88\x1b\x28\x10\xaf\x42\x9d\x31\xc1\x53\x9c\x56\x67\x41\xf1\xdc\xaa\x2d\xdb\xf4\xbc\x3d\x83\xa6\x98\xc8\xb1\x7b\xf0\xe8\x37\x12\x54\xe8\x96\xdb\x52\x46\x23\x98\x20\x20\xda\xf4\x0e\x19\xc8\x07\x0b\xde\x2c\x0b\xfd\xf2\x81\x2c\x1a\x67\x44\x0b\x90\xb1\xc1\xe3\x44\xd4\x23\x28\x24\xce\x6a\x1d\x03\xe9\xfe\x55\xdf\x31\xa7\x03\xca\xc5\xc7\x69\xa0\x41\xee\xba\x24\xc7\x35\x84\xef\x3f\xca\x81\x4d\xf7\xfc\x60\x55\x96\x10\x97\x8e\x68\x54\x05\xb8\x79\x28\xc8\x4f\xc9\xa1\xbe\xc9\xe4\x1a\xb0\x03\x15\xea\x62\xf6\x82\xb3\x96\x0b\xa1\x24\xf0\xf6\xb1\x3d\x2b\xf9\xd1\xee\xad\xeb\xc4\xfe\xcb\xc3\x03\x5d\x32\x1c\xfe\x87\x5b\x29\x7a\x9e\x63\xfa\xab\xd9\x95\x22\xd6\xf3\xef\x23\x43\x9a\xd2\x5e\x6c\x0e\xd1\xf1\xc1\x04\x99\x39\x48\x62\x61\x61\xc2\x11\x8b\x6d\x0c\xae\x98\xcd\x70\xc1\x8e\x81\x84\xb0\xc2\x5f\x93\x5e\x56\xa8\x3f\x78\xf5\xe8\x7a\x43\x51\x08\x7f\xf7\xf5\x2c\x8c\x90\x0f\x06\xfc\x02\xe9\x57\x80\xaa\xdb\x5e\x54\xa1\x68\x74\x3d\xc3\xf5\x70\xd9\x18\x67\x4d\x9c\x03\x23\xbe\x7c\xfa\xb0\xf9\x60\xc4\xdf\xd1\x9f\xe6\xd3\x87\xb5\xed\x93\x8a\x0e\x55\x96\x3d\x4d\x0b\x86\xcd\x5f\xab\xac\xfc\xf4\x01\x69\xd5\x17\x98\xbe\xbd\x33\x56\xf6\xe6\x64\xaf\x7f\xdd\x18\x1b\xdb\xa7\xb5\xe0\x98\xf8\xce\xf6\x3e\x18\xcd\xa7\x0f\xf6\x86\x88\xcc\x08\x49\xe4\x46\x5a\x88\xe5\x24\x6b\xcb\x7f\xd0\x85\x67\x56\x4e\x15\xa4\xb2\xc0\x92\x56\xa4\x38\xbc\xa6\x46\x2d\x26\x19\xb5\x17\x9f\x59\x39\xb3\xfc\xcc\xca\x7f\x98\x05\x28\x91\x55\xb4\x21\x07\xb8\x38\x3b\xff\xff\x22\xf4\x0d\x8b\xd0\xff\x74\x03\xce\xff\x81\xf5\xeb\xf4\x80\x21\x0d\xf6\x47\x8d\x18\xe2\xa2\x8d\x11\xe7\x9e\x55\x6c\x56\xce\xac\x63\x49\x1d\x34\xc9\x77\x3c\xd9\x6b\x81\xe3\x19\x7f\x81\x94\x63\x46\x8b\x51\x15\x65\x06\x76\x1a\x1e\x56\x25\x42\x0b\x00\x93\x75\x2f\x47\x25\x98\x24\x21\xda\xf9\x2e\x39\x96\xad\x56\xb4\x48\x49\x23\x75\x56\x3c\xb2\xf0\x79\xa0\x98\x7a\x24\x05\x71\xc1\x7a\xe3\x6c\x12\x76\xba\xe3\x3a\xe6\xf5\xe0\x17\x2a\x0f\xd0\xe2\xad\xeb\x5c\x71\x1c\xd0\x52\xe0\xf1\x2b\xdd\xbb\xc9\x4f\xc7\x13\xbf\xd8\xc4\x4c\x24\xeb\x44\x8d\x85\xa6\x1a\xf9\x0e\x11\x94\x0b\x56\x7d\x41\x94\x24\x1e\x34\x17\x48\xee\x03\x4f\xdf\xe0\x87\x4f\x7d\x06\x4d\xf5\x6a\x0c\x37\xf9\xf9\x26\xdd\x7b\xc4\x9c\x00\xec\xd0\xcb\xbc\x75\x2d\x39\xe7\x86\x7c\x04\x83\x45\xe6\x3f\x0e\x3f\xaf\xc3\xdc\xdd\x6b\x0a\x58\x87\xf1\x57\xd2\xf8\xd7\x73\xdb\x65\xe9\x77\x8b\x7c\xb3\x14\x37\xcb\x6f\xb2\x73\x5a\xc1\x95\xc6\x30\x63\xcc\x8a\xa7\x3a\xb3\xe5\xf1\x2e\xd0\x11\x1e\xa7\xe3\x55\x78\x1c\x80\x7d\xb4\x09\x3e\x76\x72\x10\xde\x4d\x2f\xb2\x24\xc9\xc1\x04\xc1\xe1\xe9\x21\x29\x69\x01\x13\x87\x3f\xfe\xbe\x3e\xdf\x87\x86\x16\xfc\xc0\xcb\x20\x90\x94\x35\x0a\x2f\xc2\x6e\xd6\x11\xb3\xe6\x5b\x12\x27\x8c\xa9\xa4\x4f\x43\xcd\x3f\x02\x41\x9f\x5f\xa1\x6c\x4b\x70\x44\x2d\xe4\x50\xa7\xf6\xd4\x64\xe5\xd7\xc0\x99\x7a\x4c\x42\xfa\x90\x84\xcc\xd6\xc4\xf1\x3f\xc2\x1a\x93\x1c\xf1\xf8\x26\xc6\xe6\xcd\x8e\xba\x52\x1e\x86\xe2\xa3\xdd\xfc\x53\x72\x30\xc6\x78\xe1\xa5\xb1\x4a\x9f\x6d\x3f\xb7\xf0\x4c\x7f\x14\x69\xcc\x33\xa0\x92\xd6\x76\xdc\x38\x6a\xd0\x79\x44\x6f\x78\x64\x9c\xed\x14\x9f\x15\x94\x3f\xd5\x2e\x6e\xe8\xc9\x76\xe8\x0e\xcc\x23\xdf\xaa\xa7\xcc\x05\x31\x25\x10\xcc\x74\x23\xeb\x25\xf3\xce\xfd\x4f\xa9\x43\xf7\x8e\x1f\x4e\x8f\xba\x92\x7f\x25\x87\xf5\xea\xf0\x15\x7d\xfc\xa1\xed\xa8\x2b\x6d\xbc\xac\x82\x88\xe8\x27\xf9\x6a\xff\xc2\x47\xc1\x21\x29\xf2\xf4\x95\x09\x63\x8f\x3e\xb8\x75\x25\x4f\x2e\xdb\x1b\x1f\xc1\xf5\x85\x12\xe9\x7e\x46\x97\x14\xf7\xf9\x2a\x7c\x4e\x3f\xe4\x49\xa7\x19\xc8\x93\x16\x74\x14\xdf\x40\x00\x57\x0c\x6e\x81\x6f\xa0\xec\xda\x41\x3f\x72\x2e\x01\xb1\x87\x74\xef\xf6\xc9\x71\xb6\xd1\xe8\xd1\xf8\xbe\x91\xa3\xa2\xf1\xba\xd3\x93\xe3\x6c\xf6\x09\xfd\xc8\xf3\xd0\xe8\xc5\x31\x47\x8b\xb1\x3c\x25\xcf\x3d\xc5\xbf\x98\xfc\xa4\xc3\x56\x3b\xd3\x5d\x63\x8f\xf9\xcf\xbc\x24\xd4\xba\x9c\x30\xa4\xf5\x41\xa6\x9b\xf4\xba\x4d\xde\x7c\xd4\xc6\x08\x08\x46\x7b\x82\x8f\x40\x32\x78\x76\xd2\x54\x75\x52\xbd\xc2\x11\xfd\x78\xcc\xc1\x9c\xfc\xd8\x74\xbc\xfc\x7e\x1c\xdd\x21\x3f\xd9\xa4\x58\xaa\x05\xc1\x9b\xd5\x85\xee\x6c\x2c\x73\x34\x3d\x34\x62\xda\x16\xf8\xe5\x05\xde\x16\xe4\x81\x49\x8e\x8e\x4e\x22\xf8\xe1\xc6\xdb\xec\x04\xcb\xfa\x6b\x3f\xda\x78\x02\x35\x36\x15\x06\x16\x1a\xc9\x30\xc1\x62\x32\x19\xdc\x95\x63\xba\xc8\x50\x32\x23\x51\xf7\x88\x12\x91\xf6\x87\x59\x51\x27\xa6\xcc\x25\x38\x92\xac\xa0\x98\xcb\xf2\x82\x5a\x91\xd5\x62\x0d\x7c\xdf\x8f\xee\xd1\x82\xcd\x8c\x65\xba\x8c\x73\x43\xa9\x91\x46\x76\xc8\x3d\xdf\x9f\xfd\x31\x6d\x10\x7a\x9f\x54\x76\x1f\x97\xa1\xa2\x93\x1a\x9e\xbb\xdb\xad\xc4\xb8\x75\xc1\x16\xac\xd6\x1c\x2d\x2e\x31\x30\x79\x8d\xb4\x98\x20\x3f\x99\x16\x5b\xcf\x74\x77\x8e\xb9\xdf\x8e\x4c\x43\xbd\xc2\xcb\x42\x5a\x1f\x64\x39\x9d\x40\x32\xf5\x31\x64\xc9\x40\xd4\x59\x92\x0a\xd4\x72\x9c\x39\xe3\xed\x7a\xe5\x2c\x97\x9f\x4b\x84\x05\x5a\x48\xd2\x40\xae\x8b\x46\x12\x48\xfc\x0c\xcf\x70\x99\xb1\xc2\x36\xf4\xa2\x51\x50\xa1\xc6\x81\x84\x4e\xf0\xbb\xab\xdd\x7a\xbf\x11\x09\xb9\xdb\x70\x87\x47\x16\x48\x88\x8d\x7c\x78\x8d\xb5\xba\x97\xf0\x64\xd8\xfb\x3b\xd3\xdd\x6e\x4c\x77\xcf\x0c\x09\x90\x27\xf5\x01\x23\x06\x69\x7a\x84\xad\x74\xc2\xc5\xd4\x04\x97\xc4\x3a\x44\x5a\x18\xe8\xc4\x56\x9c\xd8\xce\xd6\xd9\xa6\x37\x4d\x39\x24\x51\xae\x2d\xfc\x38\xc4\x65\x2a\x68\xc4\xb7\xcc\xa1\xf8\x44\x1d\x61\x5d\x34\xb6\x41\x9a\xc6\xae\xb3\x15\x6c\xd0\x37\x72\x84\xa4\xcb\xac\x31\x39\xe0\x84\x3b\x47\x3c\xa6\x22\x59\xed\x81\xe3\x70\xe4\xd8\x78\x27\x1c\xb8\x90\xbf\x87\xc3\x64\xd4\x7b\x9e\x67\xba\x5b\xb8\x8e\x1b\x19\x89\x7a\x86\x97\x87\xb4\x3e\xce\x86\x3a\xa1\x64\xea\x63\x48\x92\x00\xe3\xa9\xf2\x40\xa9\x02\x35\x20\xab\x42\xbc\xd9\xfb\x8e\xb3\x5c\x05\x36\x1b\x96\x28\x32\x4e\x08\x85\x3a\x1a\x39\x21\x73\x77\x7f\x30\xd2\xf4\x98\x40\xb7\xca\x65\xdb\xf9\x03\x11\x9d\x81\x3f\xde\x79\xab\xd5\x4a\x20\x15\x25\x9e\x4b\xe6\xd1\x9e\x14\x9b\x0a\x98\xb8\xc6\xe0\x3f\x41\x7c\x26\x0d\x7c\x73\xb7\xe2\xe6\x42\x2c\x06\xf1\x06\x27\x09\x69\x7c\x8c\xd1\x74\x82\xc7\xd4\x46\x90\x84\x3f\x51\x45\x1e\xfd\x72\xe1\xa9\xd1\x38\x4b\x7a\x6e\xea\x25\x37\x6d\x59\x24\x91\xbf\x40\x85\x71\xe0\xcb\x15\xd1\x8a\xfb\x91\x7f\xd1\x81\x40\xb3\x23\x40\xba\x4b\xf7\x29\x3d\x8f\x82\x6b\x64\xc8\xe8\x4e\x03\x1e\xd8\x00\x91\x58\x12\x02\x07\xe0\x80\x40\xc4\xd8\xc8\x47\x0d\x58\xbf\x37\x50\x9f\x8e\x7d\x77\x63\x7a\xee\xd6\xf4\xdc\xbd\x60\x1d\xea\x11\x56\x18\xd2\xf6\x28\xd3\xe9\x04\x90\xa9\x0b\x2f\x09\x7e\xac\x89\x3c\xf6\x95\xc2\x53\xbb\x71\xc6\x5c\x25\xfb\x24\xbd\xe9\x0a\x23\x89\x7e\x7d\x15\xc6\xc1\x2f\x55\x44\x27\xf6\x65\xfe\xd5\x3d\xc2\x4a\xb0\x00\x39\xd7\x8a\x90\xd0\x19\xf0\xbd\x95\xb7\xf5\x46\xf5\x64\xe2\xb9\x1e\x5e\xe6\x42\x42\xfc\x70\xdf\x7c\xe5\x63\x7e\x31\xe1\xc9\x80\xdf\x79\xe6\x6e\x67\xee\x57\xbc\x35\xa8\x07\x18\x21\x48\xd3\x23\x2c\xa5\x13\x2a\xa6\x26\xb8\x24\xcc\x21\x92\x2a\xca\xe5\x62\x53\x4b\x71\xe6\xdb\xba\xa1\x9b\xdc\x34\xe5\x90\x44\xb8\xb6\xf0\xe3\x00\x97\xa9\xa0\x11\xdf\x63\x77\xe2\x9b\x17\x64\xfb\xf4\xaa\xbb\x49\x2d\xe0\xe9\x04\xf7\x52\x9a\x6c\x9c\x8b\xec\xe6\x55\x65\xee\xb7\x28\x74\xa5\x66\x96\xb3\x20\xbd\xa2\x49\x26\xee\xb8\x49\xd9\x68\x85\x85\xb9\x1c\x53\x12\xd8\x02\xfe\xb2\x18\x5f\xea\x9e\xc5\xe1\x7e\x8f\x76\xe3\xc8\x9f\xd1\xf1\x6d\x91\x41\x37\x4a\xaf\xba\x5b\xd3\x23\x4c\x9d\x4c\x58\x4e\x55\x96\x0b\x03\xcb\x79\x9d\x5d\x67\x67\xba\xee\xd6\x74\xbd\x29\xad\xa9\xf9\x55\x8c\x48\xbf\x68\x1e\xed\x9c\x18\x48\xe9\x44\x8f\x79\x0f\xee\x44\x5e\x50\x0a\xcb\x32\x63\xb9\xbb\xee\xce\x8d\x65\x3a\xaa\xb3\x43\xa9\xe9\x9b\x63\x85\xdf\xf9\x9f\xdd\xa9\x16\xf0\x74\xb2\x63\x29\x4d\x69\x6e\x60\x76\xf3\xda\x32\xaf\x6a\x29\x74\xa5\x66\x97\xb3\x20\xbd\xa2\x49\xf4\x73\x02\x13\xd2\x89\x19\x73\x39\xe6\x54\x3e\x10\x05\x97\x64\xc3\x52\xf7\x68\xe9\x25\xcd\x85\x05\xda\x4d\x64\x82\x5c\xc7\xb7\x45\x06\xbb\xf9\x3f\xbb\x75\xcd\x22\xe9\xc4\xff\x22\x82\xb2\xe0\x67\xb6\xff\xa7\xf4\xf3\x56\xa6\xbb\x81\x37\x36\xd6\x72\x05\xa9\x91\x25\xd4\x49\x97\x68\x04\xed\xa0\xd7\xde\x18\x37\x17\xa2\x4d\x84\xfb\x1d\xfb\xfb\x4b\x5c\x71\x77\xa0\x6b\x2b\xa5\x8e\xf2\x3b\xf7\xfd\xa7\x42\x00\xef\x9c\x5e\x75\xb7\xa8\x05\x3c\x3e\xd2\xef\xdf\xf9\x16\xc8\xca\xe2\x9d\x70\xd4\xd0\xd7\xf7\x4d\x77\xbf\x32\x99\xbd\x2d\x91\x3e\xb1\xb9\x9c\x05\xe9\x15\xad\xa2\x1d\xf7\x84\x90\x4e\xac\x98\xcb\x31\x27\xa2\x1f\xe3\x8b\x51\xf2\x70\x0f\xdd\x9d\x06\x4b\x14\x54\x67\x82\x42\xcd\xb7\x05\x07\xbf\xef\x3f\xbb\x59\xcd\xa3\xe9\x8c\xf9\x0b\x49\xca\xb2\x00\x33\xd3\xd0\x73\x78\x50\x5f\xae\x27\xb5\xb6\x94\x01\xe9\x14\xad\xa1\x9d\x02\x98\x8e\x4e\x98\x98\x8b\x11\x27\x12\x80\x28\xb7\x64\x02\x58\xe8\x17\x2d\xa5\x64\xb1\xbf\x40\x35\x75\xe8\xcb\x15\x7c\x5b\x44\x70\x5b\xff\xb3\xdb\xd4\x1c\x96\xee\x0c\xb0\x88\xa8\x2c\xf2\xd9\x9d\xff\x49\x35\xd7\x3b\xd3\x5b\xef\x4d\xcf\x77\x14\x8a\x52\x63\xcb\x18\x90\x3e\xd1\x1a\xda\x81\xaf\xbf\x0f\x6e\x2e\xc5\x9b\x08\x7b\xe9\x5e\xf8\x83\xbd\x72\x77\xdc\xeb\xab\xa6\x0e\x7b\xa9\x82\x6f\x0d\x07\x76\xd3\x7f\x76\xd3\x9a\x45\xd2\x19\xed\x17\x11\x94\x8f\xf5\xcd\x57\x0d\x0d\x7d\xcf\xf4\x77\xe6\x46\x52\xdc\x49\x76\xf1\x39\xda\xa4\x4b\x34\x81\x76\xac\x6b\x6f\x88\x9b\x0b\xd1\x26\x22\x7d\xf9\xbe\xfe\x22\x47\xdc\x1d\xe4\xda\x4a\xa9\x63\xfc\xce\xfd\xfe\x71\x00\xe4\x59\xf9\xf5\xaa\x78\xa1\x05\xef\x00\x2b\x5f\x7d\x80\xaf\xcd\x0b\xf1\xed\x38\xfe\x26\x5a\x8d\xde\x96\x38\x97\xf0\x73\x96\x19\x8b\xc7\x86\x31\xbc\xc6\xd2\xeb\x60\x12\x33\x31\xc8\xa4\xe9\xca\x6f\xd1\x29\x5f\x6a\x80\x6e\xaf\xd1\x17\x36\xa2\xae\xc4\x74\x8e\xd7\x87\x1d\xbd\xcc\xf0\x68\x0b\x86\xc7\x70\x56\x35\x7f\x94\xce\xfc\xc9\x39\x02\x17\x8f\x72\x41\xaf\x24\x29\x8f\x00\x67\x60\x7e\x61\xc0\xf1\xcb\x4e\xe8\x90\x57\xa8\xd4\x8d\x39\xad\x3e\x3a\x77\x5d\x55\xbe\x0c\xd0\xec\x51\xf6\x0d\x68\x41\xa7\xe8\x6b\xcf\x51\x91\xb1\x9d\xec\x9b\x95\x76\x1a\x26\x80\x7d\xdf\x06\xbf\xd4\xd2\xbf\xbf\x03\xb5\x0d\x1b\xed\x57\x77\x44\x5a\xe4\xc3\x12\x61\x02\x8f\xd1\xef\x7e\x42\x63\xc1\x33\x7d\x6f\xc6\x41\x67\xc4\xe7\x61\xdd\x72\xdd\xc4\x66\x08\x9b\x40\xc0\x15\xee\xf8\x15\x38\xec\x08\x87\x7e\xa0\x24\x38\x65\x49\x02\x4a\xf6\x55\xa7\x1e\xc6\xb0\x57\xf8\x7d\x24\x6d\x6d\x18\xce\x63\x9d\x48\x8e\x9b\x28\xdb\xe1\x2b\x7c\xfd\x2f\x74\xe0\x48\xff\xf3\x5c\x8f\x25\xbe\x89\x83\xc3\xf5\xf5\x94\x75\xc0\x6a\xeb\x10\x7d\x45\x00\xbe\xca\x39\x82\x09\x82\x30\xed\x40\x23\x7f\xe9\x0d\x87\x0c\x94\x00\xc6\xae\x3f\xfe\xbe\x30\x6e\x25\xaf\xde\xbd\x7b\x47\x82\x16\x85\xd9\x0a\x14\xfd\x87\x4a\x68\x28\xe3\x73\x94\x41\x21\x79\x1b\x0d\xc3\x90\xb7\xf1\x08\x4e\xcf\x5d\x8a\x32\xd6\x06\x14\x75\xf7\x9d\xe8\xc4\x8a\xef\x30\xb0\x05\x28\xcf\x0b\x8e\xc9\x72\x1d\xc7\xa1\x6f\x6f\xc2\xc0\x39\xa4\x79\x15\x76\x01\x04\x3b\x0c\x6f\x0d\xbb\x0e\xcc\x61\x7e\x38\x21\x6f\x46\x06\x76\x7f\x36\x29\xac\x46\xd9\xe1\x65\xf4\x89\x67\xf6\x4d\x42\x44\x3f\xcf\xda\xce\x6a\xbb\xef\x39\xfe\x2e\xc4\x78\xfe\x5a\xfc\xd1\x18\x7c\x03\x0c\x7d\xb2\xcc\xf5\x55\x27\x6e\x71\xd6\x42\x36\xbc\x36\xf4\x04\xf8\x83\xd4\xa6\xfd\xfb\x9f\x18\xcc\xe9\x61\x20\xf4\xc4\x2b\xd6\x3c\x7e\x5b\x68\x32\x6a\x0b\x25\x2f\x09\xb3\xed\x66\x27\x61\x56\x24\x9a\xcc\x8a\x64\x09\xb3\xfd\xde\x93\x30\xcb\x8f\x9a\xcc\xf2\xe3\x12\x66\xae\xe7\x38\x12\x6e\x97\x5c\x93\xdb\x25\x57\x73\xc3\xe3\x8c\xc1\xa3\x5c\x61\x62\x43\x80\x03\xce\x53\xf1\xc8\x3a\xe7\xc0\xbf\x55\x8b\x23\x5f\x42\xee\xc7\x0f\x40\x74\x0c\x59\x30\xee\x0c\x40\xda\x03\x90\x4c\xab\xb9\x81\x08\x1f\xad\x34\xb6\xad\x73\x10\xbd\xa6\xb4\x30\xa2\xc7\xd9\x57\xa4\xfa\xe3\x4d\x2c\xb7\x0b\x81\x20\x61\xa5\x32\xb1\x0c\x47\x30\xf7\xa4\x62\x4b\xad\xcc\xdb\x43\xd0\x13\xe3\x40\xfc\x29\xc7\x0c\x53\x04\x72\x91\xcc\x31\x38\xdc\x58\xcf\x08\x54\x7f\x8c\x63\x16\xb0\x82\x93\xc9\x24\x38\x3e\x14\x65\x4a\x34\xac\xe6\x8f\x09\x9a\x01\x46\x2b\x7a\xa6\x74\x99\x8b\x92\x69\x0b\xc8\xa3\x84\xc6\xc6\x97\x0b\x3c\x66\x22\x06\x05\x28\xbb\xff\xf9\xa9\x97\xf1\xc5\x9c\x82\x81\xec\xa6\x21\x90\x05\xa6\x41\xba\xaa\x7e\x61\xc7\x77\x6c\x1c\x18\x92\x8c\x80\x49\xf6\x2d\x83\xdf\xd9\xa2\xb5\x2c\x29\x47\x70\x75\x22\x96\xb6\xd8\xd2\xd0\x47\x43\xbd\x80\x3f\xa6\xc5\x90\x85\x67\x32\x28\x97\x1f\x43\x09\x84\xc2\xdf\x70\x51\x29\x74\x88\x73\x10\x36\x41\x54\x75\x27\xfd\xb3\x14\xc8\xe7\x9f\xc6\x75\xec\x78\x1d\x3f\x0e\x24\xce\x55\x50\x64\xb2\xb4\xe6\x1b\xb9\xb5\xac\xbb\x71\x77\x6e\x24\x7d\xf3\x5f\xb9\x41\x26\x70\xa1\x7b\x1a\x3c\x9b\xbe\x95\xf0\x49\xd3\x54\x97\x09\x79\xfc\x8a\x67\x32\xac\x87\x79\x36\xfa\x8b\xe2\x49\x13\x32\xec\x60\xec\xa1\xd5\x13\xef\x71\x06\xe2\x04\xc2\x84\x19\x5a\xb8\x6f\xde\x04\x36\x1b\x04\x38\xf9\x24\x9f\xa2\x22\x6b\x60\x5e\xec\xc9\x05\x0c\x74\xa9\xec\x2b\x43\x8a\xf8\x63\x83\x8c\xd9\x10\x30\x99\x75\x3b\x49\xf5\xf1\xda\xea\xb0\xf0\x2b\x36\x8a\xa3\x6a\xc6\xac\x98\x8d\x02\xd4\xf1\x19\x1f\xcc\x20\xf2\x27\x27\x9f\x04\xae\xe1\x32\x27\xf7\x90\x2b\x25\xe9\x7e\xab\x46\x64\x80\x83\x9e\xae\x70\x94\xe8\x34\x98\x15\xfd\xc1\x5c\x3f\x4e\x39\xda\xad\xa0\xaa\x20\xc6\xd0\x10\x85\x85\xa7\xe4\x45\xe1\x10\x75\xf3\x47\xed\x28\xbf\x1b\x45\x21\xd0\xa9\x32\x6d\x17\x36\xdd\xe8\x88\x1d\xd4\x8f\xba\x38\xf6\xea\xa3\x81\x78\x1d\x7a\xdb\xa0\x9d\x88\x20\xcd\x9a\xb6\xb3\xe2\x53\x96\x27\xcf\x23\x5d\x47\x10\xdc\x84\x65\xb9\xf0\xcb\x53\x6a\xd2\x79\x48\xf1\xa4\x81\x35\x82\xc2\x7b\x90\x24\xa5\xfa\xb2\xe4\x99\x1c\xfc\xd5\x55\x75\xbf\x3e\x20\x8b\x43\xa1\x92\x13\x3a\x75\x95\x9e\x10\x8d\xd3\x9c\x91\x02\x6a\xaf\x12\x82\xeb\x63\xc6\x87\x5e\x19\xab\xad\xf3\xac\x23\x5b\x70\xa4\xb8\xf0\x37\x68\x72\x22\xa3\x14\xae\xb2\x70\xab\x82\x06\xae\x20\x98\xbd\x18\x43\x03\xf0\x5c\x4f\x43\x69\x17\x24\x04\x0b\x97\x25\x18\x0d\x57\xd8\x8c\xe9\xc9\xee\xe3\x2f\x72\x02\x64\x53\xf2\x17\x3d\x43\xad\xb6\x12\x3b\xad\xf0\xe7\xb5\x46\xbb\xaa\x93\x3c\xf3\xa3\x26\x4f\x19\xcb\x11\x47\x32\xcc\x0c\x87\x47\x25\x59\x03\x62\x72\x02\xd1\xb9\x28\x0f\xf2\x56\x0a\xdf\xd7\x18\x28\xa7\xb9\xb3\xa6\x86\x54\x1f\xc6\x0e\x9d\xf3\xa6\xc6\xb2\x89\x81\xce\x77\x70\x83\xc6\xb0\x71\xab\x84\x1c\x67\x88\x92\xb4\x24\x99\x70\xbc\xc0\xd2\x4e\x18\x45\xd4\xbc\x26\x86\x13\x19\x3f\xed\x71\x45\x3a\x7a\x4c\x67\xb5\x86\xb4\xac\xba\x3a\xe2\x72\xe6\x99\x1b\x6b\xc6\x43\x21\x2b\x53\xaf\xdf\x88\x29\xd3\xcc\x8c\x74\x57\xa1\x0a\x52\x10\x32\x98\x6d\x76\x74\x40\x66\x54\x5d\x5e\xcc\x79\x58\x28\x5f\xf5\x32\x2f\xc6\x12\xfa\x0a\xb4\x9e\x95\x64\x2b\x15\x6d\x42\xc2\xbc\xc3\xfb\x8c\xce\xb3\xac\x02\xbd\x71\x93\xe7\xb8\xe6\x79\xe4\xd4\x4e\x92\xbd\x01\x5d\x7c\xe2\xd2\x9d\xb4\xb1\x79\xc8\xc8\xf5\x99\x1c\x1d\x07\x0f\xce\x34\xa5\x3d\xe4\xc8\x40\xae\x8f\x3d\x88\x70\xa2\x8b\x39\xa3\x70\x6c\x80\x99\xa2\x8f\x88\xfc\x9e\xd9\x85\x76\x0e\xa3\xe8\x92\x89\x0c\x95\xf9\x85\xbd\x30\xe7\xc1\xa4\x6a\x72\x80\x13\x3a\x73\x34\x34\x38\x0b\x80\x53\xbc\x09\xe8\x04\x77\xb6\x6b\x8a\xb9\x14\x4e\xca\x9b\x87\x64\xaf\xb4\x9c\xad\x2b\x84\x04\x63\x56\x1c\x16\x87\x6d\xe7\x2a\x8d\x7e\x0e\xe0\x68\x30\x12\xc9\x4e\x8b\x55\x1f\x34\x2b\xa3\x82\x0f\x28\xc4\xe5\xbf\x52\x56\xa1\xb6\x5f\xdd\x21\x12\x45\x5e\xdf\xa6\x24\x11\x67\xa8\x29\xa1\x44\xd8\xbb\xeb\xe1\x39\x79\xd8\x29\x68\x56\xa0\x45\xf3\x95\x7c\x16\x95\xc9\x03\x8d\xbb\x60\x15\x35\x73\x44\xa7\x9a\xc7\xc8\xaa\x86\x6e\x2c\xe9\x62\x93\x8a\xfa\x91\xde\x1a\x78\xb3\xe6\x97\x30\x7f\xbb\x47\xd0\x87\xe1\xcb\x84\xb3\x81\x55\xc3\x4f\x31\x94\xc9\xac\x83\x64\x94\x0c\x38\x75\x4b\xc9\x19\x8a\x5d\x06\x92\x4a\x9e\x92\x1c\xd9\x43\x53\x11\x9d\x4c\x68\x96\xd0\x2f\x63\xe1\xb8\x5e\xb6\x03\x8e\x65\x72\x50\x11\x6a\x82\xea\x18\x74\x9a\x05\xab\x95\x84\x2c\xdf\xad\x49\x4a\x43\xdc\x09\x58\xb1\x65\x7a\x40\x27\x5c\xaf\xfc\x67\xb1\x47\x60\x61\xcd\x42\x29\x68\x71\xdb\x6c\x6f\x1e\x24\xc8\xda\x4e\x3c\x78\x56\x28\x5d\xc4\x3b\xe5\xe2\xbe\xb1\xf8\xbc\x0c\xde\x3b\x5c\xef\x7d\xc7\xdf\xb2\xfb\xc8\x98\xab\xce\x36\x32\xde\xec\x1e\xdf\x35\x7f\x8a\x41\xb2\x4e\xe8\xd3\x90\xc2\xdd\x72\xd1\x56\x8a\xe2\x7a\x0a\x0c\x17\xd3\xd8\x06\x70\x9d\x26\x0c\x0f\xf9\x91\x8e\x4a\x92\x1a\x00\x3d\xd8\x34\x9a\x34\xc8\x27\x79\x9e\xc9\x2d\x00\xd5\x27\xe8\xdf\xc4\x6a\xdc\xcb\x5e\xf7\x01\x86\x17\x47\x3a\x80\xd3\xc9\x24\x62\xe1\x30\xd7\xa0\x4f\x21\xd9\x46\xc8\xe0\x2a\x6c\x56\xbf\xe1\x21\x30\x96\x74\x5b\x4c\x5a\xb1\x2d\x78\x2b\x6a\x38\xcc\xf6\x55\x1e\x5b\xc8\x6b\xdc\x2b\x73\xc4\xc8\xa2\x2a\xc0\x69\x8f\xb5\x85\xc2\x0f\xb3\xf4\x35\x3c\xf6\x80\x47\xea\x16\x44\xfe\xc8\xcc\xc2\xde\x15\xfe\x7a\x0f\x47\x93\x97\xbe\x37\x19\x53\xc9\x2c\xd9\x40\x31\x17\xd1\x65\xbb\xa1\x57\x46\x4c\xe6\xc9\x89\x18\x63\x9f\x69\xa2\x4d\x46\x88\xc2\xe9\xd3\xac\xd4\xb1\xf1\x90\xfa\x4f\x16\xe6\x3a\xba\xdf\xa3\x2a\x5b\x5b\x32\xe1\xc0\x56\x9c\x4b\x89\xc8\x7d\xbf\x90\xe2\x08\x47\xd3\x27\x63\x66\xd3\x5e\x5a\x50\x2f\xe3\xe4\xc3\xc3\xd8\x44\x09\xeb\xd2\xd2\xa4\xbf\x1f\x09\xb7\x48\x86\x81\x60\xb4\xc1\xec\xda\xfd\x06\x33\x4f\x1f\xdf\xea\xbb\x2e\xbc\xfb\x87\xe7\x70\x3c\x24\xc8\xe9\x72\xdf\x5d\xa0\x1b\x67\xc2\xa3\x87\x96\x4b\xf6\x78\xa0\xc0\x54\x7c\x34\xe6\x1d\xd8\x47\x5d\x25\xd4\xc9\x67\x6f\xfe\x2e\xf6\xf2\x5f\xbd\xc1\x65\x4f\xaa\x38\xf6\x63\xe2\x86\xb4\x94\x29\xbf\x4b\x20\x67\xa9\x79\x30\x87\xf2\x2b\x42\x6f\xfe\xb6\xcf\xce\x89\x12\xa5\x06\xc2\x43\xfa\xfd\x3d\xc9\x19\xf2\x8a\x47\x31\xf1\x29\x70\xab\x64\x3b\xb2\x6e\xdf\xa8\x10\x81\x70\x97\x73\x35\xa5\x48\x5f\x08\xd2\x8b\x1c\x8b\x7f\x20\xe0\x2e\xc6\x44\xdd\xfb\x04\xa0\xc6\x1a\x5b\x88\x3c\x60\x22\x43\x93\xa4\xb8\xb8\x4c\x20\x7b\xfb\xb8\xda\xef\xaa\xfa\x36\x2d\xc1\x38\xef\x98\x8f\xed\xe2\x15\x10\x1a\x0f\x84\x31\x44\x92\x8b\x10\x48\xb2\xb5\xcd\x3d\xf8\x34\xd2\x77\x48\xb6\xe0\x29\x4c\x22\x3f\x4a\xf0\x22\x03\xad\xbb\xe4\xa2\xf7\x5b\x1b\x0f\x96\x5c\x2a\xe5\xf0\xf5\x53\xc3\x77\xde\x7f\xf4\x9d\xf7\xf0\xef\x20\x17\x5e\xce\x18\x0a\x41\x89\x97\x85\x4a\x8b\x1f\x5e\x55\x34\xb4\x46\x2e\xbc\xcb\xf3\xc3\xbe\x80\xbb\x1b\xbe\x47\xb9\x13\xbe\x37\xb9\x33\x76\xd2\xcf\x6c\xa6\x69\xda\x7f\xb1\x76\x63\xfb\x9b\xb5\xbd\xf5\x73\x6b\x65\xfb\x7b\xf8\xf1\x5a\xd7\x83\x2e\x59\xed\xe0\xbf\xfe\xaf\x8e\xb1\xb6\xbd\x4d\xee\xd9\xfb\xed\xda\xf0\xec\xfd\xfe\xd7\x9d\xe1\xd9\xee\x7e\x35\xfe\x10\xa7\xae\xb5\xe0\x96\x4d\x07\x9a\x22\x2b\xc3\x0e\xfc\x5d\xcb\x2b\x4b\x87\xf8\x07\x08\xf0\xa3\x9d\xc6\x7c\x1b\x75\x2d\x38\x6d\x6d\xac\x59\xa7\x31\xdf\x14\xa5\x6e\x43\x9f\x45\xbd\xdb\x03\x64\xe4\x9b\x0b\x5c\xe2\x04\xd1\xde\xe2\xcc\xe7\x2f\xe7\xbc\x28\x08\x16\xf0\x87\xf9\x5b\x19\x33\xf4\xb8\x54\x67\xc7\x0a\x39\xf6\x3f\x44\x92\x8b\x5f\x9d\xa5\x11\x63\xad\x0d\x6b\xcd\x24\x3a\xfe\xf2\x6b\xf3\xe9\xc3\x8a\xf9\xd0\x2b\x8c\x1c\x65\xb8\x4c\xa9\xfd\x03\x63\xa5\x7d\xcd\xba\xf8\x44\x57\xa4\x68\x22\xf3\x84\x91\xb7\x87\x31\xa6\x99\x22\x4c\x0b\xa3\x92\xb9\x03\xef\xd1\x09\x93\x5d\x98\xe7\x07\xde\xff\xf6\x02\x7e\xfd\xa0\x00\xa7\x30\xb4\xcd\x81\x9f\xd8\xeb\xf7\x37\xfa\xe9\x0c\xb5\x13\x51\xf0\xce\x07\x96\x08\x75\xc1\x69\xcc\xb0\x8c\x35\x6c\xc6\x33\xdb\xa8\x7d\x64\x43\x3c\xe9\xca\x04\x67\xdf\xee\x12\xf1\x64\xdf\xa4\x1a\x86\x53\x59\x2f\xa9\x73\xc7\x7d\xe4\x4b\x60\x88\x1d\xdc\x05\x1a\x81\xb0\x92\xa8\xa1\x7e\xe4\x67\xb5\xfe\xef\x4b\xa0\x61\x26\xfd\x57\xee\x16\x04\xe4\xe8\x95\xbc\x69\xe4\xfb\x06\x34\x1c\x8b\xb0\x18\x1c\xa9\x19\xa0\x5f\x79\xd8\x81\xff\xf1\x13\xf9\x34\xfa\x54\xa7\x9e\x84\x3f\x72\xec\xe9\x77\xc3\x84\xd5\x30\x57\x7c\xc2\x67\xc3\xa5\x9b\x9a\x5b\x36\xb7\xc5\x5b\x03\x78\xdc\x31\x84\x5b\x05\x6f\xba\x35\x20\xff\x38\xdf\xa0\x2e\x7a\x3e\xdb\xf8\x7f\xf2\xb3\xed\xcb\x6e\x6c\xd0\xc0\x84\x7b\x5b\x61\x13\x96\x31\x7e\x0d\x11\x7d\xaf\x50\x6c\x14\xae\x85\xd8\xc0\x77\x06\x65\x2b\xf1\xc3\x7d\x9f\xcf\x93\x90\x0f\xd0\x16\xcd\xb7\x30\x3f\x83\x2b\xef\xf3\x51\x30\x43\x1f\x0b\x34\xbe\x14\xe7\xbc\xcb\xea\x1c\xbc\x98\x42\x07\xbc\x09\xf5\x12\x94\x55\xf7\xd3\x97\xf6\x7f\xb3\xf7\xfd\x3d\x8e\xe3\xc8\x62\x5f\xc5\xaf\x0f\x8d\xdd\xc9\xda\x7e\x92\x6c\x75\xbb\xdb\xd8\xc1\xbd\x17\x24\x48\x80\x7b\xef\x8f\x2c\x02\x04\x38\x5c\x00\xd9\x92\xdd\xbe\x91\x2c\x43\x72\xcf\x78\xd6\xe8\xef\x1e\x90\x2c\x52\x64\xb1\x48\x51\xb6\xbb\x6f\xf6\x10\xcc\xdd\xec\x98\x2c\x56\x15\xeb\x07\x59\xe2\x8f\xe2\xee\xf7\xe2\xd7\xbb\xf8\xee\x6f\x6a\x89\xde\x78\x71\xd3\x38\xc3\xa8\x11\xe5\x36\x44\xca\x45\x7a\xa5\xf9\x85\xbe\xec\xfd\x4c\x86\xe6\xbc\xc7\xc5\xe9\x90\x69\xbb\xc4\x14\x19\xae\x32\x2e\xa4\xa6\x4b\xe9\xa5\xdf\x9a\xe0\x1b\x66\x86\xec\x41\x76\x08\xd3\xa4\xad\x7a\x37\x27\x94\x38\xb4\xcf\x53\x59\x04\xdf\xed\x53\xf2\x04\x2e\xb9\x8c\x8f\x19\x28\xb7\x7d\xdb\x59\x26\x7d\x92\xbc\x4d\x9d\xde\xf7\x51\xc4\xd9\x51\x00\x62\x35\xe2\xfa\x21\x0f\xad\x67\x18\x04\x9d\x2f\xb4\xca\x25\xc2\xe4\x12\x5a\xcf\x11\xb1\x7a\x18\x70\xd6\x87\xf6\xde\x0b\x7c\x56\xa3\x25\xcd\x9f\x20\x37\xb6\xc1\x89\x55\x26\x9d\xbd\x3e\x9f\xd1\xe8\x96\xd9\x7e\xfb\x73\xb1\xff\x44\xe0\x91\x93\xb5\x5a\x1e\xf9\xf7\xa6\xfe\xd6\x16\x77\x04\x1a\xa2\xf5\x5f\xd9\xe7\xec\x64\xc5\x9b\xfc\x0d\xa3\xca\x8e\xc7\xe6\x67\x0d\x00\x89\x04\x2d\x79\x19\xab\x3c\xd1\xd2\xb8\xa5\xab\x5d\x50\x0f\xd0\x3b\x9e\x56\xf5\x69\x34\x78\xde\xf4\xae\x64\x85\x6e\x95\x3b\xa5\xdd\xd7\x6b\xe9\x1f\xaa\xdf\x33\xb4\xc8\xe5\x96\x82\xb3\xff\xfe\xfe\x62\xfd\x3b\xc7\x64\xd9\x55\xae\x18\x79\x6b\xcd\xec\x3e\xcb\xc9\xc2\x48\xca\xff\x74\x7e\xd7\xb0\x94\x5d\x67\xdb\x8b\xe3\xe9\x5c\x1b\xa2\x9e\xfd\x99\x77\x6e\x33\x53\x73\x56\x60\xa2\x56\x73\x32\x55\xfb\xfc\x2c\xe9\xb5\x25\xbb\x61\x38\x39\xbe\xbc\x56\x2b\x7b\x65\x9f\x19\x05\x9b\x68\xc7\xa1\x03\x83\x49\x83\xf1\xce\x4b\xde\x0b\x7f\x7b\x63\xc4\xda\x2c\xcb\xbe\x5f\xd4\x61\x34\x4b\x8a\xb4\xfc\x1c\xcb\xb4\x30\x41\x30\x77\x98\xc0\xb4\x69\x5b\xa2\x5c\xcc\x03\x7a\xc8\xfc\x62\x3d\x9a\xd3\xbe\xeb\x30\x1e\xfb\x13\xec\xe2\x8f\xb7\xe5\x07\x91\x91\xbd\xea\x33\xf2\x81\x1f\x8a\x3e\x3d\x11\x82\xe4\xce\xe6\xfc\x70\xf4\x20\x83\x5b\x6f\xc4\xdc\x85\xf6\x88\x68\x1c\xcd\xeb\x7e\xcf\x26\x44\xf6\x89\xbc\xfe\x42\x0c\x23\xfa\xf5\x47\x7d\xc0\x30\x9f\x8c\x26\x86\xb6\xbc\x60\x4f\xe4\x48\x3b\xb2\x11\xd8\x06\x66\xb1\x8a\xfd\xd7\x61\xdf\x97\x19\x33\x43\xae\x09\x1c\x23\xb9\xa5\x89\x7d\x10\x99\xa0\xb1\xfa\x42\x33\xc6\xaa\xc0\xe2\x0b\xb3\x5f\x84\x65\xb0\xed\x6a\xed\x7f\x44\x73\x6d\xfd\x76\xaa\x8d\xc3\xea\x9e\x05\x7c\xef\x25\x5a\x3d\xa4\x6c\xb8\xc2\xb2\xdb\x7f\x32\xc3\xbe\x95\x0d\x4b\xfd\x20\x09\x05\xda\x6e\x7b\xa9\xd1\xb6\x37\xb7\x56\xc2\x30\x9d\x15\x82\xaa\xb9\xac\xae\xb1\xc6\xb6\x24\x26\x65\xfd\x8d\x5c\x5c\x34\x9d\xa2\xc7\xf6\x39\xa6\xd7\xc3\xa1\xbb\x94\x0a\x27\x58\xd2\xc3\x69\x79\x39\x6e\xf9\xc5\x46\x4f\x7f\x04\xd3\x62\x75\x3e\x14\x0b\x9a\x00\x41\xf2\x79\xb1\xc9\x5e\xcb\xa3\x1b\x09\x1a\xc9\x2e\x60\x03\x8f\x65\xc1\x94\xdb\x50\x92\xe4\xb2\xec\xd8\xf3\x9d\x0c\xab\xb0\x9a\x2b\x60\x1a\x37\xf4\xea\xa1\x5e\x7c\x83\xde\x80\x63\xef\xb3\xaf\x03\x0e\xa3\x3b\x2f\x07\xc2\x97\x15\x5c\xbd\xc6\xcb\x30\x38\xf5\xda\xdb\x74\x9f\x7d\xe5\xc9\x28\x5d\xf9\x26\xd4\xe1\xe1\x0e\x54\x5e\x97\x50\xbf\x45\xe2\x05\x2a\xf5\x47\xd7\x68\xea\x58\x11\x44\x5b\x6f\xac\xd1\x12\x1b\x1d\xc3\x71\xcc\x56\x6a\x9d\x15\x7a\xa3\x7d\x9c\x8b\x21\xa1\x83\x1c\xf1\x7f\xf1\x94\x2e\xa6\x04\xc4\x15\x31\x13\x8c\xb1\x77\xb6\x3e\xf8\x89\x41\x0b\x1f\xeb\x93\x9f\x2d\x8e\xa3\x99\x72\x71\xc0\xa6\xa6\x4b\x10\xd5\x08\x59\x02\x4a\x10\x95\x38\x4a\x24\x73\xd6\xb8\x7a\xeb\x93\xb2\xe5\x95\x21\x63\x35\x26\xc0\xc4\xc9\x93\xa6\x74\xf4\xa8\x2e\x40\x22\x8c\xb3\xb9\xec\x60\x71\xa0\x2d\xb3\xc8\x12\xd1\x2f\xd9\xbf\x11\x83\xd0\x79\x50\x07\x87\x59\xf6\x16\x7c\xf7\xdb\xa5\xa3\xc8\xa9\x9e\x48\xe0\x3e\xec\xca\xd2\x36\x04\x4a\x87\x08\x52\x25\xfc\xd0\xea\x98\x74\x3e\x77\xb8\xf4\x9e\x3a\xcf\xa3\x30\x68\x36\x4b\x75\x42\x16\x52\x65\x65\x1a\xae\xbe\x7b\xb3\xd6\xd5\x10\xc1\xb4\xc8\xf9\xb1\x2b\x72\x8c\x5e\x55\x10\x34\xd8\x61\xd9\x4d\xd1\x34\x45\x2e\x96\xac\x23\x91\x76\x60\x95\xb5\x3b\x26\xd1\x0e\x8c\xaf\x6e\x7d\x2d\x9e\x63\x01\xb0\x6d\xea\x6f\xcf\x31\xc5\xca\x31\x5b\xc9\xdc\x02\x9f\xf9\x8f\x43\xa6\x1d\x41\x65\x5e\x8f\x60\xc0\x88\x8c\x51\x89\xf7\x68\x95\x69\xab\x6a\xd6\x82\xf5\x15\x23\xa7\x82\xe8\xbb\xe8\xa3\x00\x79\x02\x05\xc8\x9c\x60\x65\x50\xe0\x57\x72\x26\xab\xe2\xf8\xad\x28\xf6\xae\xf1\x94\x67\x5b\x61\x7d\xce\x76\xfb\xa2\x19\xdb\x45\x93\x4d\xf9\xba\xcb\xa9\x8a\x72\x4b\x95\x56\x24\x6c\x5b\x51\xa5\xa7\x52\x29\xe0\x07\x15\x9b\x94\xd1\x64\xd5\xe8\x3b\x41\xc6\xae\x04\x48\x16\x72\xb0\xc5\xf4\xb6\x8c\x2c\x37\x43\x40\x72\x6b\xc4\x58\x2e\x96\xcb\x9e\x54\xce\x26\x9d\x35\x6d\x40\xef\xca\x7a\xa6\x45\x06\x38\x6c\xd2\x0f\x4c\x06\x72\x41\x08\x00\xbc\x68\xc3\xa0\x44\x22\x17\xaa\x4d\xa4\x66\x23\x73\x60\x56\xce\xd9\x1e\xb3\xe3\x6e\x0d\x89\x65\x0d\x52\xc6\xcd\x3b\xb7\x36\x49\x55\xaa\xf1\x98\xf1\x0c\x29\x87\x0b\xe7\xc8\xc5\xbf\x6b\xb4\xc1\x8b\xff\xee\x1b\xbf\x42\x4d\xba\xeb\x0f\xbf\xc9\xd2\xc8\x53\x4a\x30\x6b\xc8\xf5\x78\xbf\x89\xc5\x21\x93\xb3\x3f\x2e\xb1\xe7\x2a\x8d\x29\x64\x9a\xb2\x34\xc0\x38\x01\x74\xb2\x5b\xd7\x7b\x5a\x5b\xf0\xed\x38\x4d\xb5\x35\x85\x29\x91\x38\x11\x0e\x23\xf4\x9d\xcc\x15\x52\x85\xff\xe8\x62\x51\xaa\x1c\x31\xfd\xa9\x18\xbd\xca\x4e\x90\x21\x22\x7d\x4c\xa7\x4f\x22\x21\x2e\x30\x2f\x36\x8f\xf9\xe5\x2b\x35\xe2\x8d\x7d\x95\xe6\x48\x4b\x83\x94\x5b\x7f\x7d\x95\xfb\xeb\xdb\xca\x5f\x7f\x2a\xfb\xfc\xae\xeb\xbb\x95\x76\x18\x23\xee\x7c\x82\x67\x43\x6c\xea\x6f\x23\xb8\x20\x4a\x16\x0e\x4d\x18\x86\xc9\x8d\x64\x09\x1b\xd3\x88\x21\xab\xa9\xbf\xe1\xf1\xaa\xa9\xbf\xf9\xf1\x38\x87\x16\xb9\x9b\xd6\xd7\xdc\x31\x9c\x4d\x1d\xbb\xf4\x6f\x3e\xed\xfc\x11\xac\x47\xc9\x9d\xa9\x54\xde\x07\xc6\x05\x1e\xa1\xa9\xf1\x54\xba\xbb\x44\xb8\xaa\x4f\xff\xb2\xab\x0e\x75\x73\xcc\xf6\x47\x63\x7a\xd2\x8a\x5d\x83\xb0\x8a\x56\x61\x10\x66\xbf\x3d\x4c\xc0\xb8\xa3\x86\x1c\x3e\x28\x11\x4e\xff\xf8\xf0\x48\x3b\x7d\x95\x6b\x62\x19\xfb\x2a\x69\xb5\x55\xb9\x5f\x6d\x55\xee\x57\x5b\x95\xfb\xd5\x56\xe5\x57\x3b\xbd\x4c\xff\x8d\x11\x7f\xa8\xd3\x57\xf9\x6d\x9c\xbe\xca\xaf\x72\xfa\x2a\xbf\xa9\xd3\x1b\xda\xf9\x23\x58\xcf\x45\x4e\x5f\xe5\x3f\x80\xd3\x57\xf9\x60\xa7\x7f\x7a\x8a\x69\xa7\x2f\xb7\x9a\x58\xc6\xbe\x4a\x5a\x6d\xe5\xd6\xaf\xb6\x72\xeb\x57\x5b\xb9\xf5\xab\xad\xdc\x5e\xed\xf4\x32\x0d\x3f\x46\xfc\xa1\x4e\x5f\x6e\x6f\xe3\xf4\xe5\xf6\x2a\xa7\x2f\xb7\x37\x75\x7a\x43\x3b\x7f\x04\xeb\xb9\xc8\xe9\xcb\xed\x0f\xe0\xf4\xe5\x76\xb0\xd3\xc7\xf1\xd3\x13\xed\xf5\xa7\x52\x93\xcb\xd8\x57\x49\xeb\xed\x54\xfa\xf5\x76\x2a\xfd\x7a\x3b\x95\x7e\xbd\x9d\xca\xab\xbd\x5e\xbd\x87\x81\x31\x7f\xa8\xdb\x9f\xca\xdb\xb8\xfd\xa9\xbc\xca\xed\x4f\xe5\x4d\xdd\xde\x50\xcf\x1f\xc1\x7c\x2e\x72\xfb\x53\xf9\x03\xb8\xfd\xa9\xec\x71\x7b\x13\xfe\x23\x8d\x5b\x93\xf1\xd8\x59\x43\x1a\x80\x57\xfb\x5e\xd5\x7b\xf5\x3e\x6c\xcc\x30\xdb\xde\xc4\x4d\xaf\xf1\xd1\x5b\x3a\xa8\x26\x88\x1f\x52\x33\x97\xb8\xe3\x3f\xde\x17\xfd\x8e\x28\x2b\xf9\xf3\xa3\x0a\x94\x2f\x69\xc3\x86\x16\x1c\x19\xe5\x8f\x6d\x3d\x7d\xf2\x35\x40\x8b\x8f\x14\x84\xfe\x54\x45\x10\x62\xd3\xac\xec\x86\x69\x50\x43\x2f\x67\x26\xa4\x8b\xc3\xc7\x20\x42\x78\x4b\x56\xc7\x30\xf3\x62\x10\x5b\x60\xdd\xf6\x5c\x08\xb3\xfa\xa6\x64\x1f\x28\xdb\xac\xf4\x01\x92\x9b\x99\x41\x2a\x92\x76\x65\x37\xe9\x9e\x66\xb3\xeb\xe2\x1e\x74\x62\x21\xfa\xfd\x6e\x4f\xcf\xa2\xee\x4e\xdb\x2c\x42\x97\xda\x66\xd1\x68\x16\x51\x37\xee\x59\x0f\xee\x93\x45\x34\x1e\xc1\xff\xa6\xe9\x7d\xf2\xf4\x13\xd4\x33\x49\x17\xeb\xec\xf0\xeb\x4f\x7c\x25\x5b\x15\x57\xbb\x23\x7b\x48\xb4\xda\x1d\x7f\xfd\x29\x8e\x54\x31\x70\x92\x88\x4b\x72\xf3\xd1\xe3\x4b\x92\xfc\xc7\x7c\x14\xa7\xe2\xbf\xc9\xec\x25\x49\x88\x2b\xda\xb4\xd4\xd8\x26\x0b\xa5\x01\x0f\xfc\x28\x1b\xa4\x66\xde\xc2\xeb\x49\x6c\x83\x61\x94\x85\x38\x39\x7b\x09\x96\x1c\x6d\xd4\x31\x00\x1a\x0a\x91\x27\x00\x74\xe2\x6e\x64\x86\x6f\x00\xb4\x7a\x3e\x5f\xfe\x5f\x97\x9e\xbb\xb5\x8f\x25\x13\xd0\x92\x8b\x41\xec\x31\x88\x1a\x39\xc0\x18\x78\x12\x2f\x1e\xe7\x30\xe3\x21\x89\x46\x19\x0f\xa4\x31\xc8\xd8\x70\xce\x03\x13\x34\xc3\xc4\xc0\x62\xf4\x94\x1c\x5e\x0c\x88\xf8\x93\x17\xf3\x0f\x3d\xc6\xb0\x0e\x8c\xba\xbf\xfe\x81\x23\x8d\x29\x3a\x34\xd0\x18\xf2\x76\x99\x9e\x31\xda\x78\x14\x4e\x0d\x31\x14\x84\xed\xe4\xeb\xac\xc9\xbb\x60\xf5\x82\x93\x22\xd6\xc6\x3a\x5d\xda\x7d\xa9\x47\xcb\x6f\x75\x93\x8b\x58\x70\xd5\x14\xd9\x97\x09\xfb\xad\x6f\x64\x76\xfc\x19\xa5\xec\x65\x01\xb0\xdb\xfe\xd7\x4d\x93\xf4\x93\x63\xe3\x97\xf5\xf8\xf3\x0b\x3a\xe8\xaa\x0e\x00\xc8\xaf\x05\x0e\x35\xe5\x07\x01\x99\x8d\x1f\xe4\x89\xa7\x63\x7d\x50\x8f\x93\x41\x11\xec\xb9\x43\xa9\xdd\x54\x4f\x87\xa7\xa1\x51\xe2\x70\x9c\xc9\xd2\xf3\x62\x4c\x46\x31\xbb\xc2\xe9\x3a\xa4\x65\x83\x12\x5c\x94\x19\x66\x02\xf2\xec\x21\x3e\xa0\xb4\x87\x00\x82\xf6\x33\x2e\xb9\x61\x7f\xc3\x9b\x61\xbf\x68\xac\x8d\x2d\x66\x7f\xe1\x25\x93\x4d\x5d\x9b\x59\xbd\xa5\x66\x26\xab\x3a\xff\xde\x7b\xd8\x4b\x4f\xfc\x77\x38\xc9\xef\x28\x79\xbe\x00\x30\x1d\x77\xc7\x52\xbd\xa9\x23\x8f\x4f\xc8\xbb\xc7\x8c\x89\xf6\x75\x65\xc0\x30\x0b\x98\xc0\x65\x42\x7c\x6c\x44\xe2\x64\x29\x37\x35\x79\xd3\x50\xda\xdc\x46\x9f\x30\x50\x50\x20\x0e\xd6\x40\x72\x01\xa9\x0b\xf5\x9e\xc0\x63\x6c\xb2\x9b\x70\x0b\x14\x60\x30\xa7\xb6\xbf\xe9\xee\x13\xcd\x94\xb1\x41\x03\xb7\x9b\x19\xd4\x29\x5b\x77\x9a\xc5\x88\x28\x8a\x46\xd1\x9b\xa1\x7c\x47\x77\x42\xd9\x37\x1f\x34\x74\xf0\x2e\x48\xe9\x1a\x33\x59\x67\xd7\x02\x83\xd8\x07\x7c\x42\x13\xfc\x40\x26\xca\xc1\x3d\x7d\xa0\xb4\x31\x99\x3e\xea\xa5\x5c\xb7\x0a\xd4\xd4\x43\x64\x92\xe0\x27\x2a\xfd\x34\x0c\x6c\xd0\x7a\x57\x6d\x27\xcc\xf0\xca\xec\x7b\xf8\xe5\x5b\x38\x29\x25\x15\xa2\x34\xd1\xa3\xe5\x8e\xe4\xb8\x23\x2e\x3a\xae\x15\x1c\xeb\x43\xb7\x0e\xb3\x2f\xb6\x3c\x2b\xbd\x3c\x52\xd9\xbe\x34\xcc\x57\x22\xed\xc2\x3b\x89\x95\x21\x79\xbf\xd1\x94\xec\xc2\xd9\x1c\x07\x6f\x3f\x6a\xb2\x61\xe1\xcb\x88\xf7\x56\xea\x19\x34\x12\xa7\x87\x93\xe7\xa4\x8b\x6a\x7c\x0e\x9f\xcc\xd5\x82\x21\xb1\x86\xc8\x8b\x4c\x4b\x63\x1c\x98\x66\xc6\x4a\x6c\xbe\x25\xfe\xe7\x98\x5d\xa9\xbd\x97\xc3\x34\xff\xb7\x81\x51\x47\xa8\xec\x0e\x7e\x73\x02\x0c\xe0\x0d\x08\xc8\xe7\x1a\x2e\x93\x0c\x6f\x7d\x43\xd1\x10\x4c\x05\x74\x5b\x76\xd2\x6e\xfd\x8b\xd1\x31\xf0\x3d\x30\x1f\x3d\x48\xd1\xdb\x58\xd9\x97\xcf\x2e\x1b\xf7\xce\xf6\x21\x98\x47\xfa\x30\x34\x0e\x86\x27\xbc\xf4\x52\xd2\x62\xd0\x1e\x46\xba\xdf\x67\x9d\x0c\xdc\xe0\x0d\x96\x7e\xc4\x41\x52\x25\x1a\xf4\x0c\x7e\x43\x68\xfb\xc5\xea\xa0\x4d\xca\xd5\xe4\x00\x58\x10\x1f\x09\x2d\x39\xa0\x41\xd8\xd5\xe3\xb8\x80\x41\xdd\xc4\x16\xbf\x27\xeb\xfa\x75\x7f\x7c\x9e\x89\xcb\xc1\xa8\x0c\x83\x98\x2d\xb7\xd9\x41\x4d\x66\x7a\x6b\xbd\x9c\x28\xaa\x9b\xc3\x4b\xb6\x6f\x9f\x79\xa2\xe6\xfa\x5b\xfb\x1c\x93\x5d\xf4\x1d\x09\x65\xf3\xd8\xdb\x34\x5b\xaf\xeb\x26\xdf\xd5\xfb\xb3\x7c\x94\x79\x92\xed\xd7\x2f\x75\x03\x61\xa0\xaa\x87\x61\x05\x3d\xdd\x6c\x01\x68\x0e\x50\x6f\x26\xc7\xef\x07\xfc\xfc\x9e\x65\xa7\xa6\x07\xf8\x8d\x98\x24\x26\xcc\x02\x53\x73\xba\x07\xe5\xf6\x08\xaf\xf1\xb1\x20\x11\x2a\x3c\xa6\xdd\xb0\x9b\x2d\x6f\x53\xf6\x2d\x99\xaf\x1b\x76\xbd\x4e\xca\xbc\x7f\x54\x77\x1e\xd8\xc7\x61\xa7\x16\x50\x01\x55\x76\xdf\x11\x1f\x13\xef\x4d\x13\x82\xbe\x45\x3b\x9e\xcd\x97\xb5\x5d\x8c\x5b\x2d\x7e\xc1\x05\x67\x6a\xb7\xa8\xaf\x91\xbc\x92\x47\x1b\x2b\xb5\x27\x65\x5e\x9c\x92\x7b\x87\x77\xff\x7a\x17\x40\x8c\x2f\x42\x28\x92\xf8\xd3\xe7\x75\x9f\xb3\x95\x98\x7d\x71\x35\xa6\x7d\x4d\x20\x81\x85\x3c\x9c\x13\xfb\x90\x6d\x59\xfe\xd4\x5d\xbd\xef\xd5\x81\x92\x07\xcc\xc9\x96\x09\x90\x9a\x3e\x64\xdb\x02\xb6\xf8\x9c\x8b\x2d\xd4\xa5\x42\xea\xcb\x20\x3e\x9c\xcc\xc3\xf1\xd3\x24\x5d\xa2\x4b\xe6\x9e\x8b\x64\xda\xf7\x10\x5c\x22\xd3\xd8\x83\x0f\x52\x99\x68\x27\xe9\xf0\xa6\x0f\xab\x59\xe8\xcb\xe5\xa6\xd1\x43\xa1\x4d\x0b\xbd\x18\x75\x61\x32\x38\x8e\x8f\xdb\x83\x36\x3b\x8d\x3a\x32\x74\x0c\x85\x07\x27\x18\xd5\xdd\x63\x9f\xa1\x49\x4e\xae\xcc\x28\x6a\xae\x31\x8e\x24\x40\x40\x68\x14\xc0\x58\x75\xec\x9d\xb0\x1c\xab\x64\xa6\x19\x18\x0a\x90\x77\xe9\x3a\xfc\x72\x51\x5c\xa7\x60\xba\xb6\xe7\xe6\x29\x5f\x63\xf1\x19\x1a\xa1\x78\x70\xb2\x49\xb9\xd5\x49\xe2\xc1\x76\x1a\x72\x23\x64\x9a\xd2\x18\x7d\x96\xe0\xd2\xfd\xac\x47\xf5\x33\xa9\x17\x9a\xda\x40\x43\x98\xf5\xd9\x81\x45\xae\xad\x74\xc4\xf8\x02\x0d\x9d\x3a\xcf\x2b\xad\xb6\xba\x42\x5a\x49\x8f\xb4\x12\x27\xfb\x17\xb9\x4d\x9f\xb4\x04\xb9\x55\x96\x6f\xfd\x13\x18\xf3\x2f\x26\xae\xb9\x21\xad\xc7\xf4\xde\xc8\x85\xf6\x88\x73\xa1\xd9\x17\x35\x89\x8b\x6e\xf8\x2e\xcf\x2a\x6b\x0b\xc6\x81\x64\x5c\xf1\x8a\x33\x0d\xbb\x2e\xdf\x23\xb7\x22\x20\x34\x17\x7b\x9f\xcb\xfb\x42\xa2\xd6\x1d\xfc\x4c\x54\xc0\x6e\x83\xfc\xe5\x5b\xc7\x14\x10\x45\x75\x38\x7e\x57\x0a\x82\x9a\xe3\x7e\x04\x74\xec\x69\x51\x7b\xb0\x9a\x41\xf0\xb5\xae\x33\x0a\x46\x1e\xac\xf3\x31\x0f\x45\x85\x84\x1e\x47\x9d\x81\x4c\x0e\xcd\xae\xca\x9a\xef\xe7\x80\xd1\xf3\x2d\x33\xdb\x98\x3d\x56\xa5\x78\x4f\x85\xc4\xf7\x90\xac\xd7\x18\xdf\x94\xc6\x87\x72\xb0\x85\x4e\x85\x6c\x31\x53\x20\x6a\x8b\x75\xbd\xcf\x7b\x3b\x09\xa1\x4f\x86\x5b\xa1\x6e\x76\xe5\x01\x1d\x4d\xe7\xe9\xea\x21\x79\xb3\xda\x4e\x5d\x38\x87\x74\x36\x8e\x16\xe3\x38\x7e\x1c\xc7\x89\xd9\xdd\xd7\xf5\xba\x68\x5b\x3f\x63\xc9\x22\x7b\x9c\xa7\x1d\x63\xa2\x0d\xee\x2a\x94\x06\x74\x34\x2e\x1e\x8b\xd9\x1c\xe3\x9b\xd2\xf8\x86\x74\x72\x1e\x8d\xe3\x87\xc7\xf1\xc3\x93\xde\xc5\xdd\x7e\x53\xf7\xf0\xf3\x98\x25\xab\x85\xe2\x87\x35\x40\x9d\xe3\x45\x21\x3d\x8b\x1f\xb3\xc5\xca\xc0\x84\xba\xd5\x21\x0f\xec\x53\x32\x1b\xc7\x0f\xc9\x38\x5e\xcc\xf5\x4e\x7d\xcb\x9a\x7d\x97\x8e\xf6\x4f\x49\x9c\xa4\xc9\x13\xc1\xd0\x66\xb3\x8e\xa3\xc7\xb7\xcc\x6c\x86\x7a\x27\x4b\x8d\x0e\x3a\x51\xe6\xb3\xa7\x22\x8a\x30\xca\x29\x8d\x72\x50\x4f\xd3\x74\x1c\x3f\xcd\xc6\x8f\x7a\x47\x73\x96\x06\xa6\x47\xea\xf9\x7a\x96\x6a\xf6\x29\x9a\xa0\x3e\x42\x61\x80\x0e\x57\x79\x12\xcf\xba\xfe\x89\x86\x53\x12\xdb\xa0\xde\x25\xd1\x38\x9d\x21\xdb\xe4\x87\x5d\x7a\x25\xbe\x59\x6c\x9e\x36\x99\xe2\x88\x37\x42\xdd\x13\x65\x81\x0a\xcc\x8a\xa8\xe8\xc4\xc5\x9b\xa2\xfe\x69\x24\x42\xbb\x37\x5f\x8c\x93\xf9\xd3\x38\x49\xe1\x00\x90\x14\x54\xa3\x1f\xb9\x20\xb8\x11\x29\xbb\x15\x37\xac\x81\xa5\xba\xe6\x4b\x88\xe2\xe2\x3c\x89\x93\x6e\x58\x61\x98\x2c\xb5\x35\x5f\x06\xf5\x2a\x4d\xc6\xe9\x62\xfc\x00\xae\xf7\xf7\xd7\x6a\x55\x1f\x9b\x7a\xaf\x82\xc8\xc4\xb1\xbc\xe1\x48\x62\x46\x2f\x67\xcc\xfc\xeb\x76\x36\xd5\x39\xa3\xca\x48\xbc\x69\x3c\x89\x23\xaa\x68\x8a\xc7\x07\x79\x11\xe9\xe8\x6d\x9a\x95\x45\x43\xe5\x49\xb6\xbe\x2a\xa8\xad\xb7\xb8\x8b\x2e\x07\x5d\x1f\xe7\x44\xf9\xf2\x54\x37\x86\xa9\x13\x00\xa2\x92\x87\xb5\x28\xb4\x94\x75\xf9\xae\xad\x76\x6d\xbb\x5b\x95\x05\xea\xef\x5c\x43\xaf\x41\x8d\xa6\xeb\xb2\x6e\x8b\xde\xdd\x3a\x47\xa7\x49\x06\x21\xe2\x90\x26\x19\x45\xf3\x68\x91\x12\x3a\x5f\xaf\x8b\xd4\xfa\xa8\x5b\x2d\xf2\x6c\xb3\x41\xa8\x46\x2f\xfa\x6e\xbd\x04\x7d\xda\xac\x73\x1b\x14\x7e\xea\xdf\x9c\x51\x94\x3c\xa6\x89\x04\x54\x51\x82\xac\x9e\x2d\x66\xf9\x9c\xc8\x04\xf0\xa7\x22\x29\x66\x85\x7a\xe2\x13\x0a\xf3\x87\x7c\x91\xaf\x2c\x64\x34\x8b\xeb\xc5\x7a\xb5\xde\xd8\xc0\x04\x93\x49\x94\xcc\x92\x07\x05\x6a\x46\x1f\x71\x9a\x3e\x26\x73\x82\xc5\x7c\x5e\xe4\x5d\x62\x64\x28\x5c\xcf\x8a\x87\x75\xc7\xa2\x40\x45\x33\xb8\x8a\xf3\xcd\xca\x02\x25\xd8\x8b\x56\x49\x11\xcf\x24\xa0\x1e\x39\x44\xeb\x74\xfe\x40\x6c\xfc\xff\x29\x8f\x8b\xf5\x26\x46\xbc\xad\x8a\x22\x2d\x14\x41\x86\x87\x66\x2c\x5b\xe5\x79\x91\x1a\x70\x14\x57\x0f\xc9\x7a\xa6\xb8\x82\xa9\x55\x56\x2e\xd2\x87\x79\x44\x09\x6d\xb3\xd9\xcc\xd6\x39\x62\x6c\xb3\x29\x8a\x55\x86\x50\xd1\xbc\x6d\x36\xc5\x22\x8b\x31\x28\xc1\x5e\x3a\x9b\x6d\x22\xc5\x9e\x39\x61\x3f\x26\xf1\x9a\x54\xe9\x66\x91\x3f\x5a\x2a\xdd\xa4\x6b\x4d\xa5\x02\x93\x83\xb9\x78\x15\xad\x1e\x11\x24\xc1\xdb\xfc\x29\x4e\x62\x05\x67\x4c\xb7\x8b\x78\x11\x2f\x12\x8a\xb5\x62\x53\x6c\xd4\xc7\xa7\x2c\xcc\x37\xf9\xa6\x30\x10\xd1\x9c\x15\xeb\x62\xbd\x51\x16\x0e\x47\x55\x6d\xc6\x1e\x16\xec\x8f\x04\xd3\xa7\xc9\x78\x15\x17\x09\xe5\xa8\xdc\x27\x9f\x10\x5f\xeb\x87\xf5\x62\xad\x14\xca\xf0\xd0\x6c\xad\x9e\x56\xab\x95\xe2\x9f\xc3\x11\x5c\x45\xf3\x28\x8d\xd2\xb7\x3f\xcb\x4d\x9e\x2f\xc5\xf7\x4d\x93\x55\x45\x3b\x3a\x34\xf5\xb6\x29\xda\x76\xc2\x4e\xc7\xb5\xc7\x66\x77\x28\xda\xf3\xa6\x61\xfb\x56\x1d\xa3\x6a\x7c\x65\x13\xc3\x28\x7a\x3b\xd6\x64\x2d\x3b\xa6\xf2\xf6\xe7\x77\xc4\x3d\x95\x18\xfb\xd7\xa3\xe5\x42\x05\x9b\xc9\xd0\xde\x90\xb1\x92\x11\xe9\x6b\x44\xd6\x2b\x15\xde\xf9\x5d\x2e\x4c\x6a\xbd\xec\xe7\x4b\x56\x84\x1e\x11\x54\xf0\xfc\x76\x12\x2c\xb5\xe0\xeb\x49\x50\x8c\xfb\x09\xdc\xb3\x8f\xa3\xa0\xe5\x1a\xe7\x8a\xa9\xb6\xdc\xc1\xf7\x1e\x47\xd3\x07\xb1\x7a\x12\xbc\x6c\x62\x08\xc9\x5a\x3d\x99\x12\x96\x92\xeb\x66\x20\x5e\x0b\x61\x7a\xcb\x9a\xc9\x96\x45\x1d\xc5\xfe\xf8\xf3\x3c\xcd\x8b\xed\xd8\x3e\x38\x1a\xa7\x9f\x46\x49\x7a\x3f\xd6\x02\x16\xeb\x77\x1a\xdd\x3b\x5a\xba\x6b\x1e\x11\x0e\xf4\xdb\x78\x59\x4b\xbd\xc5\x03\x19\xbb\x8c\x1e\x66\xfb\x5d\x95\x1d\x8b\x5c\x6d\xd7\x8a\x02\x26\x31\x42\x12\xed\x28\x6e\x47\xa2\xef\xa3\xdd\x7e\xb3\xdb\xef\x8e\xc5\x72\x70\x8b\x8b\x54\xe5\xe3\x94\x2d\x56\x69\x6c\x80\x26\x39\x91\x01\x6e\x20\x56\x06\xf9\xd5\x3a\x23\x4d\x92\x7e\xe3\x8e\xe3\xc4\xa7\x1b\xe1\xe0\xc8\x9b\x76\x40\xf2\xf6\xde\x87\xa2\x6c\x33\x4a\x8e\x1c\x51\x70\xc7\x10\xef\xcb\x84\xed\x57\xd4\x7b\x3d\x5b\xae\x99\xdf\x50\xf3\x4d\x15\x8b\xd2\x38\xe0\x03\xca\x41\x01\xbe\xa5\xe4\x66\x48\x4c\x91\xe9\xdf\xa5\x82\x8f\x52\x17\x0d\x73\xa7\xd0\xf9\x2d\x2a\xdf\x19\x41\x58\x88\x0f\x12\xc7\x3e\x9f\x19\xa9\xfb\x36\x54\xb4\x2f\x14\xfb\x24\x23\xa2\xaf\x2f\xea\xeb\x93\xa9\xbe\x52\x0f\x3a\x58\xba\xd6\xde\x5d\x3a\xd2\x96\xf0\xcf\x9e\x95\x79\x84\x1e\x40\x08\x06\x2c\x02\x6a\x63\x6a\x6c\x91\x96\x35\x01\xfb\x54\xa4\x28\x6d\x5a\xa0\x67\x6b\xcb\xf3\x82\x9d\x35\x84\x5a\x3f\xe4\xcc\x0b\x88\xe3\xdf\xfd\x8d\x24\x83\x9e\x5c\x9e\xe0\x70\x87\x93\x81\xed\xa5\x6e\x76\xbf\xb3\x5b\x9a\xe5\x99\x18\x0d\x5c\x57\x5c\x49\x04\x9f\x43\xcc\x8b\x50\xb0\x34\x6a\x87\x81\x45\xc1\xe4\x6c\x8b\xb3\x90\x99\xb4\x08\x66\x82\xa9\x11\x12\x0f\x6e\x1b\xa0\x73\x4d\x79\xdc\x19\x28\x4b\x18\x42\x01\xb1\xdb\x1d\x12\xb0\x89\xc4\xde\x83\x91\x24\x7d\x23\x49\xda\x85\xe6\xc3\x73\x70\x7d\xa8\x05\x91\x14\xdf\xd3\x88\x28\x82\x03\xec\x88\x6a\x7e\x73\x53\x0a\x22\x32\xd0\x9a\x3c\x69\xb7\x68\x1e\xaa\xfc\x7a\x73\xaa\xf2\x8f\x36\xa7\x2a\xff\x60\x73\xaa\xf2\xab\xcc\xa9\xca\xfb\x35\x7d\xb5\x39\x55\xf9\x47\x98\x93\x4c\xe8\x44\xf3\x50\x6e\xaf\x37\x27\x96\xba\xe8\x63\xcd\xa9\xdc\x7e\xb0\x39\x95\xdb\xab\xcc\xa9\xdc\xf6\x6b\xfa\x6a\x73\x2a\xb7\x1f\x61\x4e\x2a\x53\x10\xcd\xc4\xa9\xbc\xde\x9e\x4e\xe5\x47\xdb\xd3\xa9\xfc\x60\x7b\x3a\x95\x57\xd9\xd3\xa9\xec\x57\xb5\xce\xb5\xd2\x28\xa1\xe7\xab\x88\x0c\xb5\x27\xbd\xfd\xa6\x7c\x6d\x5f\x24\x97\xa4\xc0\x38\xc4\x67\x57\xc7\x80\x7d\xf1\xe6\x5c\x40\x3b\x42\xa3\xa0\x32\x4a\x10\xac\xbb\x72\xb3\xa5\x77\x8b\x47\xee\xe6\x38\xda\xbb\x3e\x91\x1d\x1f\xe9\x3d\xad\x8c\x8d\x50\x27\x4b\x72\xd7\x68\x18\x72\xa9\xcf\x9e\x6f\x38\x41\x13\x7d\xc3\xb1\x42\x9b\x9e\xda\x0c\xea\xdd\x86\x92\x3b\x4e\x4e\x0c\x2e\x81\x38\xc4\xd8\xdb\xce\x10\xa4\x93\x2d\xb9\xb7\x35\x14\x7d\x90\x28\x25\x55\x43\x94\xa2\x90\xa0\x18\xb8\x59\x26\xf7\xc5\x1c\xed\x5d\xe2\x70\x89\xd1\xdf\xca\x10\xa2\x93\x25\xb9\xff\x36\x0c\x79\x90\x08\x25\x4d\x43\x84\xa2\xd0\xa6\x17\xb4\xa1\x27\xf7\xee\xa8\xc6\x2e\x31\x38\x84\xe7\x6b\x62\x48\xce\xc9\x8c\xdc\x20\x1c\x80\x39\x48\x6c\x92\xa0\x21\x36\x51\x68\x13\x83\xbd\xbf\x80\x1d\x47\xb1\xb9\xe8\x68\xef\x92\x84\x43\x78\x3d\xad\x0c\xf9\xf9\x58\xe2\x9b\x98\xc3\x90\x07\x89\x50\xd2\x34\x44\x28\x0a\x6d\x7a\xa1\xbb\xa2\xb0\x01\x4a\x37\x1f\x28\x40\x7f\x23\x43\x7e\x6e\x86\x60\x9f\x75\x10\xee\x20\xf1\x49\x92\x86\xf8\x44\xa1\x4d\x2e\x70\xe3\x16\xf6\x68\xc9\xd6\x03\x85\xe7\x6d\x63\xda\x9e\x8b\x1d\xb9\x13\x3c\x04\x75\x98\xe5\x01\x45\xd3\xf2\x78\xa1\x4d\x2d\x68\x6b\x59\xee\x22\x53\x8d\x5d\x42\x70\x5a\x5d\xf3\x25\x48\x6e\x4e\x66\xe4\x56\xf5\x00\xcc\x41\x62\x93\x04\x0d\xb1\x89\xc2\x37\x38\x20\x24\x1e\x12\xe1\x51\xbe\xb6\xdf\xab\x5f\xa9\x70\x1e\x74\x07\x7c\x51\x14\x89\x1d\x14\x75\xa4\x8c\x6d\x3b\x44\xfc\xa5\x29\xf5\x78\x3c\xbb\x4f\xc0\x09\x9a\x12\x51\x6d\xad\x43\xe0\x02\x98\xdf\x16\x94\x4b\xf8\x9f\xf8\x4d\x45\xb5\xd6\xff\x49\xea\x23\x04\x54\x50\x55\xdc\x3c\xa6\x6f\xab\xd7\xe3\xb1\xde\xcb\x63\x52\xb0\xb9\x42\x4c\x48\xda\x36\x26\x08\xf2\x99\x1d\x95\xe4\xed\x14\x81\x33\xb1\x9f\xf0\x36\x3d\xd6\x59\x7b\x3c\xbb\x72\x0a\xce\xd2\xe8\x70\xd2\x93\x0a\x8a\x82\x2e\x41\x2f\x00\x58\x17\x35\x2c\x16\xed\x3d\xd9\x45\x6a\x6c\xb9\xf2\x5c\x38\xd0\xc7\x80\x64\x38\x9f\xcc\x13\x82\xf2\xde\x88\xd8\x78\x42\x90\x52\xa4\xae\x2d\x3f\x2e\x03\xeb\x8e\xf5\xd9\xdc\x2f\x94\x09\x54\x38\x30\x7f\x0d\x8d\x4d\xbe\x12\x75\xac\x57\xa8\x9d\x4c\xb1\x2b\x66\xc1\xbc\xec\x72\xeb\x09\x2c\x56\x21\xaf\x86\xca\xaa\xfe\x8d\x50\xe3\x4c\x80\xbe\x01\x0b\x45\xf8\x5a\xcd\x23\x71\xd3\xf1\x66\xaa\x92\x92\xa2\x35\x16\x75\xd9\x8b\xf0\x9e\x9d\x3b\xa9\x84\xf5\x75\x4f\xa5\x94\xe0\x82\x17\x1b\xcc\xaa\xbf\xa0\xac\xaa\xce\xb3\x72\x52\x1f\x8a\xfd\x19\x1d\xaf\xd0\xeb\x46\xe2\xdf\xdd\x5d\xe5\x93\x3c\x83\xa1\x4a\xbe\x43\x3a\x4d\x01\xa8\xf6\x41\x37\xbb\x53\x91\xc3\x71\x45\xd8\x65\x56\x1b\xb8\x51\x1a\x29\xad\x31\x25\x6b\x37\xa4\xd5\xf9\x16\xf6\x6f\xc4\x58\x77\x63\x50\xb2\x98\xef\xb2\xb2\xde\x12\x9b\xaf\x02\x21\x63\x0c\x4e\x80\xca\xec\xaa\x94\xa3\x73\x5c\xd3\x4d\x96\x17\x23\x13\xaf\x76\x9e\x43\x1e\x13\xe0\x45\x9b\xba\xa9\x46\xd3\x19\x5c\xb4\x41\xef\xeb\x5e\x0a\x31\xee\x21\x61\x55\x8b\x77\x09\xcb\xec\x58\xfc\x1c\x8d\x27\x6c\xb4\xf9\xb4\xf4\x55\x06\x1f\x94\x08\x91\x87\x3c\x15\xc1\x41\x99\x67\x23\x50\x9b\xdb\xee\xf1\x5e\xf5\x53\xb6\xe7\x7f\xb3\x43\x11\xc7\xdd\xba\x17\x4f\xbb\xce\xca\xe2\xe7\x78\x1a\x25\x9f\x96\x64\xe9\x9b\x81\x61\xd2\xae\x1b\x96\x54\x96\x9d\xbd\xed\x1d\x3d\xd8\xe8\x0d\x06\xc8\xdd\x89\x3d\xad\xc4\x92\xb9\x34\x45\xe5\x46\x2b\x39\x86\x43\x4b\x67\x02\xc9\xd7\x17\x89\x05\xdb\x74\x2f\x56\x99\xa1\xa1\x07\x0c\xc6\xc7\xde\xa4\x3a\xbd\xf4\xf8\x60\x21\x99\x34\x9d\x5b\x36\x12\x23\x68\x91\xf7\xcb\x33\x78\x34\xd6\x92\x77\xf5\x09\x5e\x52\x57\x37\xc1\x25\x45\x31\xa5\xb8\x25\x0f\x35\xd2\xa2\x18\x49\x50\x99\xaa\x62\xf9\x20\x88\x72\xbd\x08\xfe\xfb\x7c\x77\xe7\xe0\xcb\x25\xe0\xf3\xd0\x33\x3a\x0a\x3e\xe0\x84\x1c\x30\xca\xec\x75\x20\x5b\x52\xef\x84\xf5\x6a\x2e\x1a\x8a\x4d\x29\x45\xf2\xa7\xe3\x90\x24\xec\xe1\x5a\xaa\xb0\xdf\x90\x02\x65\xa7\x4d\x28\x68\xc8\xf7\xdd\x63\xbe\x34\xe4\x4a\xd4\xac\x0c\x73\x31\xbf\x22\x61\x4f\x57\x2c\x9e\x60\x89\xc3\x03\x67\xc9\xb9\x96\x02\xeb\xeb\x37\x69\x8c\xec\xc7\x0b\xd1\x83\x28\xb2\xe8\xf0\x31\x5c\x05\x62\x76\x35\x1b\xb7\x55\x35\x8b\xf1\x8d\xb1\xe4\x96\x67\xdd\x2e\x79\xe9\x52\x9d\x99\x52\xa7\x0d\x9d\xd1\x14\x5c\x3a\xf7\x47\x51\xb3\xe0\x20\x4a\x83\x34\x65\xa2\xee\x65\x58\x9c\x41\x94\x31\x61\x3f\x46\xfa\xdf\xfa\xf8\x69\x64\x16\x84\x4e\xa0\xcf\xb2\x4e\x0b\x7c\x20\xb6\x5d\xa5\x2f\x0d\xa2\x2e\x34\x89\x0a\x92\xeb\xdd\x20\x51\x4a\xf0\x80\xae\x00\xb9\xbe\x8b\x7d\x6e\xe9\x9a\xd7\xb2\x0a\xc9\xb1\x3c\xa5\xac\x54\xe3\x56\x30\x6c\x84\xf8\x55\x87\x80\xbd\x06\x61\x4a\xea\xf3\x7f\x01\x25\xc1\xd7\x81\xac\x15\xe3\x1c\x3b\x3e\x5a\x15\x59\xfb\xda\xb8\x2e\xe8\x4c\x9e\x9e\x9e\x9e\x0e\x27\xf0\x5e\x16\x7c\x49\xe7\xe5\xff\x56\x21\x80\xc0\xe7\x39\xa2\xa3\x8f\xb2\xe7\xee\xd3\x32\x65\x7b\x9a\x32\xb0\x8d\xe1\xcb\x8e\x98\xa8\xbb\x71\x99\x0a\x4a\xd8\x04\x3b\x9b\xa6\xc4\x14\x1b\x34\x3b\x18\x33\x2c\x8d\x47\xce\x16\x67\xc7\xf4\xee\x6f\xa5\xe6\x12\x0f\xc1\xdb\x4c\xea\x92\x81\xb6\xd2\x84\x3c\x8b\x22\x7a\x4b\x59\x9e\x50\x10\x6d\xd8\x13\x17\xe2\x5f\xa7\x52\x6b\xbd\x88\xa2\x9e\x0d\x69\xa2\x51\x1c\xcf\x79\xab\xe9\xb1\xae\xcb\xe3\xee\x40\x98\x57\x37\x3b\x3c\x76\xdf\x50\x22\xf2\x01\x7b\x80\x83\xff\x9b\xac\xda\x95\xdf\x9f\x27\xd9\xe1\x50\x16\x93\xf6\x7b\x7b\x2c\xaa\xf1\xbf\xb3\x1b\x1d\xff\x91\xad\x7f\xe3\x3f\xff\x7b\xbd\x3f\x8e\xef\x7e\x2b\xb6\x75\x31\xfa\xdf\xff\xf3\x6e\xfc\xbf\xea\x55\x7d\xac\xc7\x77\xff\xa3\x28\xbf\x16\x2c\xbf\xc1\xe8\x3f\x8b\xd7\xe2\x6e\xfc\x6f\xcd\x2e\x2b\xc7\x77\xff\x59\x1f\xeb\xd1\x6f\xd9\xbe\xbd\x1b\xb7\xd9\xbe\x9d\xb4\x45\xb3\xdb\x8c\xef\xfe\x8d\x11\x18\xfd\x57\xf6\x7d\x3c\xfa\x6f\x55\xfd\xf7\xdd\x5d\x87\xd3\x2e\xf8\xed\x7b\xb5\xaa\xcb\x3b\xc0\xa6\xb7\x82\xa5\x12\x48\xad\xd3\x54\x59\x69\x2c\x60\xcd\xf1\x02\xd6\x34\xd5\x0f\x18\x33\xef\xd6\x7f\x8b\xc9\x88\x3c\x1a\xac\xaf\x76\x75\x05\xdd\xa7\x04\x2f\x2b\x8b\x23\x0b\x1a\xd8\x35\x02\x36\x36\x01\x43\x2c\x77\xf1\x84\xe5\x18\xfa\x62\x94\x60\x28\xe3\x06\x02\x2f\xe2\x63\xbc\x68\xc8\xfc\x94\x58\x16\x22\xd3\x24\x6b\x53\x37\x98\x04\x9a\xb3\x9f\x54\xc5\x68\x9a\x35\x4d\xfd\x8d\x30\x19\xd3\x4a\x84\x15\x4e\x17\x4d\xf7\x3e\xed\x74\x0e\xcb\x37\x3a\x22\xe5\x7d\x36\x42\xf0\x9a\xe7\xbb\x3b\x39\xc4\xba\xd6\xda\x40\x9d\x3c\x62\x7a\x9b\xae\xda\x09\xd0\x98\x30\x21\xfc\xf5\x34\x39\x94\xd9\xba\xa8\x8a\xfd\xf1\xff\xfe\x7a\xac\x0f\x7f\x1b\xeb\x20\x2c\xc3\x9d\x9a\x1a\x18\x87\xa3\x28\x00\x05\x70\x8f\x31\x41\xf1\x59\x4e\xba\xe1\x98\xa4\x1c\x1c\x18\x95\x98\xd8\xb8\xaf\x56\xd1\x40\xc8\x9c\x6b\x2e\xdd\x51\xb4\xb4\xaf\x38\x45\x51\x1f\x1f\x7c\x82\x33\xc5\xc2\x8b\x94\x60\xa2\x11\x28\x2f\x00\x0d\x70\x6c\x63\x83\x8a\x33\x04\xa0\x1a\xf3\xca\x42\x16\x03\x89\x90\x52\xd3\x89\x29\xb9\xc9\xab\xac\x4e\xc9\x21\xf9\x71\xf8\x70\x09\x0a\x85\x9b\x22\x84\xfc\x85\xc3\x8c\x0b\x10\x01\xfb\x04\x3e\xa8\x39\xc3\x49\x98\x01\xc8\x48\x61\x19\x48\x95\xb4\x54\xd0\x68\x88\x0b\xac\x40\xfc\x8d\xc2\x9e\x60\x49\x31\xed\x9b\x72\x62\x25\x03\x2d\x8d\x35\x91\xdd\xb2\x70\x41\xb9\x52\xf9\x85\x86\xa6\xd3\x20\x45\xa7\xd1\x52\x82\x03\xdb\x36\xc4\x06\x8a\xa7\x84\xc7\xc0\x0d\x23\x93\xb8\x77\xfb\x7d\xd1\x68\x53\x36\x3f\x5e\x66\x2d\x26\xeb\x6b\xc9\xf4\x2d\x35\xf2\xfb\x4d\x52\x47\x8b\xf0\x87\xfa\xc0\x42\x46\x62\x68\x77\x7c\x38\x3e\xd8\xa1\x81\xe2\x97\x45\x96\xff\x3f\x42\xf8\xa7\x89\x10\x3e\x64\x25\x43\x99\xe0\xb0\x20\x23\xd6\x1d\x5b\xcb\xc6\xc0\xc6\x12\x0a\xed\xf3\x73\xb6\x39\x16\xcd\xd8\x2a\x06\x1f\xee\xa3\x7a\x45\x5c\x02\x14\x7d\x71\x89\x04\x61\x71\x09\xda\xff\x82\xbc\x9b\x7d\x68\x3e\x6b\xe3\xa2\x86\xed\xb3\x19\x9b\xf0\xef\xaa\xc9\xd4\xd8\xc7\x09\x45\x2d\x45\xe5\x20\xa1\x24\x49\x4f\x23\x90\x83\x32\x75\xc4\x2b\x86\x9d\xa4\x83\xb8\x02\xbd\x3a\x98\xe2\xb5\xb2\xfb\xda\x69\xcc\x20\xae\xc4\x2d\x2f\x2f\x2b\x5a\x14\x25\x81\x78\x91\x54\xa3\x91\x3c\xb5\x1f\x11\xf0\x6d\xe3\x83\x0a\x11\x47\xd9\x6a\x94\xf3\x5d\xaa\xcd\x77\xb1\xe6\x15\xd3\x59\x17\x87\x04\xf2\x40\xea\x5b\xe7\xc5\x3f\xff\x69\x92\x9d\xa6\xce\x28\x6b\x98\xde\x11\x7f\xb6\xe6\x4d\xf6\x78\xbd\xe0\xce\xab\x79\x0f\x7f\x01\x16\x00\xf1\x96\xc1\x07\x44\x81\x60\x03\x6c\x26\x0d\x31\x01\xc0\x04\xfc\x13\x08\xa1\x86\x87\x81\x83\x5d\xd9\xc4\x4e\x6a\xd7\xa0\xe2\xfb\xfa\x88\x0c\xc9\x4d\x89\xa3\xdf\x97\xa8\x17\x73\x68\xeb\x17\x31\xc8\x01\xce\xb0\x9a\x77\x19\x87\xe1\x0a\x1e\xc9\xa9\x03\xd6\x6b\x3d\x12\x74\x81\xfa\xa3\xac\x34\xba\x77\x4f\x75\xfa\x70\x32\x91\x99\x93\xf1\x94\xd4\x0d\x73\x72\x69\x73\xf3\xc8\xfe\xf4\x74\xb0\x8b\xce\x25\x0c\x2b\x91\x23\x98\x96\xac\x39\x04\x0f\x28\xc7\x42\xf7\xd9\x08\xd0\xdf\x6b\x04\xd3\x59\x20\x15\xa4\xb1\xd2\xf3\x99\x98\x42\x00\x6f\x1b\x90\x16\xc0\x0f\x33\x70\x93\x3b\xdb\xbc\x0d\xe6\x78\x35\xf0\xe6\x18\xbe\xfa\xb8\x13\xc6\x2d\xb1\xc3\xd6\x8b\xfa\x9e\xd0\xcf\xa6\x80\xae\xc1\x80\xf4\xfc\x1e\xb1\xe3\x2a\x39\x37\x2c\xb7\xe1\x15\x2b\xf6\xe7\x5d\x36\x4d\xcc\xfe\x90\xd9\x40\x25\x88\x79\x3a\x85\x38\x8c\x23\xee\xe2\xf3\x74\xfb\xf5\x6b\x5b\x94\xf6\xa6\x48\x57\x37\x35\x76\xfb\xf8\x96\xeb\xb1\x7e\x5d\xbf\xc8\x03\x7d\x87\x6c\x3f\xf9\xbe\xb4\x8b\x3a\x0c\xf0\x85\x67\xd1\x90\x8e\x4e\x9c\x4b\xc1\x8d\xa5\x5d\x98\x03\xc5\xba\x2c\xb2\xe6\x79\x55\x1f\x5f\xb4\x51\x41\x6f\xea\x4f\x23\xc0\xbf\x39\xc4\x71\x3f\xa6\x25\x9d\x1d\x63\x10\x98\xb0\xb5\x75\xf5\xe8\x01\xfb\x10\xd8\x64\xeb\x62\xf2\x75\xd7\xee\x56\xbb\x92\x2d\x1a\xc2\x61\x1a\x4f\x95\x76\xce\x43\x22\x52\x1f\x3f\x2a\x45\x0a\x24\x98\x75\x1c\x6c\xb9\x00\x88\x3a\x00\x63\x42\x04\x9f\x60\x31\x85\x6a\x1f\x5b\x31\xea\x27\xfb\xe2\x74\x1c\xa3\xb2\x43\x53\x7c\x45\x65\xf2\xcc\xa6\xa1\xd6\x37\x28\x45\xcd\xf9\x90\x80\x71\x32\x3a\xfc\x2c\x1d\x2a\x67\xfa\xfc\x74\xf6\x9c\xef\xf9\x3f\x6c\x9f\xe5\xfe\xd3\xd2\x5d\xe5\x60\x83\x61\xc6\x5c\xb0\x9e\x51\x5c\x70\x96\x7b\xd8\x98\x78\xf8\x98\x00\x23\x0a\x2b\xdb\x7c\x1e\x99\x44\xd4\xc2\x75\xa4\x59\xc4\xe4\xd0\xd4\x87\xa2\x39\x7e\x7f\x86\x5a\x65\xbd\x3d\x87\x87\x7c\x94\xb8\x52\xfd\xd2\xa0\x5a\x31\xe1\xf4\xa8\x92\x68\x06\xc2\x57\xe9\xda\x63\xfd\x60\x23\x6a\x18\xa2\x27\x0f\x24\xd7\x92\x22\x14\x2d\x29\x81\x4a\x39\x8e\xa2\x96\x79\xd0\x70\xaf\xb9\x21\xa7\x1e\xdf\x63\xa3\x60\x53\x97\xd8\xfd\x64\x31\x53\x85\x33\x18\x53\x93\x60\x27\x72\xe9\x96\xfd\xbb\xdb\xc3\xf6\xae\xa1\x10\x6f\x5f\x43\x31\x0c\xc3\xe9\xbd\x7f\xb9\x4f\xaa\x69\x9a\x52\x7a\x52\x49\xbb\x87\xab\x2a\x44\x88\x81\x3a\x50\x07\xb2\xc9\x4a\x7e\x04\xdb\x41\xc1\xd9\x92\xe9\xd0\x3c\x32\xae\xc4\x83\xd7\xdc\xd4\x61\x19\x65\xd2\xd3\xa7\x37\x1a\x23\x7c\xcc\x3a\xba\x21\x43\x45\x47\x35\x7f\xcd\xd6\xc1\x2a\xaf\xa3\xb3\xca\xcb\xd5\xde\x6e\x2b\x9f\xff\xbb\x0b\xba\x9e\xf7\xf5\xa4\x29\x0e\x45\x76\x1c\xa5\xd1\xfd\xbf\xb2\xc1\x70\xa4\x9e\xae\x73\x91\xb2\x32\x81\x5d\xff\xa8\xee\x66\x57\x96\xbf\xfe\x74\x9f\xcc\x36\x9b\x8d\x7a\x62\x77\xd1\xbd\xb0\xbb\x40\x0f\xec\x2e\x46\x0b\xfd\x79\x5d\xf6\xf2\x6d\x3a\x4d\xd2\x51\x54\x4e\xe6\x23\xf1\x27\x9e\xa6\x93\x78\x9a\xfe\x65\xce\xca\xe7\x65\x32\x4d\x27\xc9\x34\xfd\x8b\x00\xfb\x9d\x78\x11\xd7\x2d\xf8\x1f\xb4\xc7\xc9\xf4\x91\xf7\x38\x9e\xa6\xec\x3d\x8a\xbf\xcc\xd8\xef\x79\xc9\xba\x39\x62\x5d\xe5\xf5\x8b\x72\x3e\xe1\x7f\xbc\x5d\xde\xed\xf3\xdd\x3a\x3b\xd6\x4d\x4b\x0c\x5e\xae\x57\x16\xd5\x28\x96\x0e\x18\xc6\x02\x46\x27\x3a\xcd\x97\x7c\x7d\xef\x1e\x3d\xb6\x77\x8f\x1f\x38\x22\xbb\x35\x2a\x77\x67\x7e\xc1\x60\xf7\x3b\xfb\x44\x01\x8a\xfc\x90\x9c\x64\xed\x39\x32\xce\x26\xa9\x5f\xf2\x60\x45\xe7\x44\xb3\xee\x05\xc0\x06\x17\x70\xa6\xd9\x6f\x3e\x5e\x30\x01\xed\x8f\xfc\x68\xcd\xe1\x24\x1f\x25\x81\x68\xff\xe2\x55\x70\xf6\x2d\xf3\x1c\x47\xea\x93\x88\x58\x29\x06\x55\x39\x80\x7a\xc6\xf5\x87\x4b\x87\x75\x53\xdc\x9e\x01\x5c\x03\x84\x69\xf8\x4c\xc5\x1e\xeb\xec\xc0\x9a\x3b\x4d\x92\x29\x1f\x7a\xca\xc7\x35\x65\x11\xca\x32\x55\xb2\x61\x2e\xb3\x44\xdb\xf5\x92\x5f\x96\xbc\xcc\x37\x0b\x52\x69\x3a\xdb\x03\xff\xde\x9a\x08\x69\x9f\x8f\x35\x11\x80\x36\xf5\x91\x1d\x72\x9f\x3d\x44\x79\xb1\xfd\xb4\x74\x55\x18\x29\x3a\x6f\x8a\x77\x8a\xb0\xf9\x66\x08\x6d\x69\x84\x65\xbc\xc6\x4f\x85\x70\x5b\x16\x02\x03\x0b\x83\x87\x4a\xc4\x62\xd0\xfa\xb5\x61\x76\xc5\xcf\xc5\x50\x6b\x9d\x84\x81\xc2\x47\x78\xaa\x7d\xf5\x75\xc9\x0b\x4d\xc6\xd9\x87\xb5\x2f\xe3\x62\x00\x34\x96\x05\xcb\x17\x05\x31\x10\x5e\x14\x32\x17\x43\x12\x96\x33\xdc\xad\xff\x2d\x5b\x77\x8a\xee\x09\x2d\x89\x93\xfd\xd1\xa7\xa5\x5d\xf4\x96\x46\xf7\x9d\xb9\x07\x7e\x36\xbc\xfd\xf9\x1f\x4a\x5d\xc9\x8f\xd3\xbc\x95\x25\xe1\xf1\x8f\xb4\xa3\xce\x50\x24\xd7\x91\xc7\x64\x18\x7f\xa1\x06\xe3\x84\xed\xcc\x85\x81\xb8\x8d\x85\xe5\xf9\x65\x67\x48\xe5\x4b\x3a\x67\xc7\x0b\x3b\xff\xb2\xab\x0e\x75\x73\xcc\xd8\x99\x3d\xd1\x82\x6d\xc7\x61\xc9\xd4\x07\x1b\xae\xda\xe5\x79\x69\xe1\x15\xa5\x36\x34\x6c\x0e\x20\x68\x51\x6a\x43\x6b\xaa\x38\xbb\xb5\xe4\x68\x47\x75\x00\xca\xf5\x16\xab\xad\x4a\xef\x61\x69\x1b\x32\x12\x6a\xe0\x99\x06\x0f\xd1\xb9\x51\xc4\x63\xf2\x31\x5c\xa2\xb4\x40\xed\x72\x0e\x4f\x12\x7e\x48\xd6\x6b\xc4\xa7\x4a\x35\x41\x34\x10\x09\x1c\x31\xa7\xaa\x85\xce\x6b\x57\x88\xb9\xc5\xe0\x54\x8d\x8b\x63\xf1\x68\x0d\xe6\x18\x52\x55\xd8\xe0\xe2\x29\x19\x8b\x5f\xfd\xad\x97\xb1\x51\x64\xf1\x6a\x80\xda\xe5\x2e\x3e\xc5\x9b\x33\x88\x4f\x96\x45\x81\x82\xe5\xef\xc1\x60\x26\xbb\x97\x5b\xc6\xdd\x6f\xcc\x9e\x06\x84\x0a\x9d\x8c\xf1\x27\x63\x10\x63\x90\x9e\x80\x00\x17\x0f\xba\x60\xde\x00\x5e\x67\x4f\x16\x61\x0e\x4d\x50\xbb\xdc\xc5\xa7\x78\xf6\x05\xf1\x09\x69\x0d\x08\x68\xfe\x24\x0b\x66\x53\x7f\x36\x65\xac\x97\x60\x26\x0d\x40\xab\xd8\xc5\xa2\x78\xb9\x05\xb1\xc8\x6f\xdb\x13\xc0\x22\x7f\x2d\xe6\x10\xbd\xad\x22\x0b\x30\x7f\x3a\x18\x2e\x75\x0a\x90\x3f\xbb\x82\xb8\x63\x77\xda\x09\x58\xf1\x28\x0a\x66\xae\x7b\xbe\x44\x0a\xaf\xf9\x62\xb1\xa6\x01\xa1\x42\x17\x63\xe2\xdd\x14\xc4\x18\x4f\xfb\x4d\x00\x6f\x8c\x71\x91\xe1\xd6\x62\x28\x1b\x5e\xab\x34\x9a\xf1\x29\x14\xf2\x30\xe9\x7b\x28\xe2\x5e\x82\x0d\x8a\x1e\x68\x0e\x6a\xc2\x03\x3d\xd9\x08\xe2\xf2\x80\x66\x30\xf1\x18\xbf\x82\x1a\xb2\x20\x5f\x92\x93\x3b\xf0\xbd\x8d\x22\x68\xf1\x1c\xd1\x9d\x56\x00\x4c\x02\x24\x10\xef\x59\x07\x06\xdf\xc4\x04\x20\x6c\x02\x47\xa8\x6b\x14\x4e\xc6\x7e\x87\x92\xfd\x22\xc1\x60\x3a\x93\x70\xae\xd9\x53\x82\xab\xd9\x04\x35\xb0\x26\x31\xd5\x40\xce\x23\x06\xb8\x35\x87\x48\x70\x36\xce\x22\x58\x6b\x28\x97\xb0\x30\xdc\x21\x70\x6b\x74\x95\xe0\x72\xa0\x33\xa0\xad\x41\x4e\x42\xc3\x98\x63\xa2\xc6\xe3\x4d\x87\xba\xf9\x82\x60\x2d\xf7\x97\xb0\xe0\x95\x26\x62\x53\xd6\xdc\xfb\x8a\x9c\xc5\x86\xe6\x17\x0d\x7f\xd6\x88\x80\xb4\xc0\x52\x1a\xce\xf7\x46\x3a\x6e\xe4\xdc\xdb\x74\x63\x37\xbc\xb5\xb7\xdd\xd2\xb4\xe9\x40\x1a\x94\x6b\x0f\xa2\xe3\xeb\x73\x47\x46\x1f\x08\x42\x25\x35\x8c\xc0\x56\xa2\x97\xa0\x33\x07\xe4\x7a\xd7\xac\xcb\x02\x41\xa7\xd1\x3d\x05\xcb\x9f\x88\xc4\x90\x0e\xbc\x11\x02\x34\x8c\x95\x6f\xcd\x6e\x76\xa7\x41\x1b\xb7\xf9\x84\x7d\xdf\x29\x58\xf6\x43\xc7\x99\x4f\xc4\x37\x9d\x02\x10\x3f\x29\x10\xf1\xd9\x87\x00\x45\xa1\x09\x6e\xc2\x11\x00\x47\xe3\x9a\x3b\xff\x45\x00\x4c\xf4\x2f\x4f\x55\x42\x01\xae\x8b\xb2\x44\x90\xac\xc8\x04\x65\x6b\x7c\x0a\x48\x2e\x01\xae\xea\x53\x07\x65\x2c\x60\x92\x12\xb0\x70\x68\xe5\x34\x2a\x0d\x40\xc3\x68\xdf\xb7\x92\x57\xe9\xd8\x00\xd3\xa7\xb1\xb6\x02\x7e\xbc\x4a\x6b\xab\xa1\x7a\x6b\xab\x7e\xd5\xb5\x55\xbf\xf6\xda\x2a\x5c\x81\x6d\x35\x44\x87\x6d\x75\x9d\x1a\xdb\xea\xd6\x9a\x24\x54\x29\x33\x4d\xe7\x93\xaa\xd7\xf9\x2a\x69\x5a\x5e\x55\x56\xf9\x50\x55\x56\x01\x5e\x58\x81\xfb\xf8\x54\x59\xe5\xe1\xaa\xac\xf2\x21\xaa\xac\xae\xf4\xc8\x2a\xff\x00\x55\xca\x3b\x94\xf9\xa4\xdc\xf6\xa9\xb2\xdc\x86\xa8\xb2\xdc\x0e\x55\x65\xb9\xed\x57\x65\xb9\xed\x57\x65\xb9\x0d\x57\x65\xb9\x1d\xa2\xca\x72\x7b\x9d\x2a\xcb\xed\x07\xa8\x52\x5d\x68\xcd\x27\xa7\xb2\x4f\x97\xa7\x12\x28\x7b\x75\x79\x2a\x87\xea\xf2\x54\xf6\xeb\xf2\x54\xf6\xeb\xf2\x54\x86\xeb\xf2\x54\x0e\xd1\xe5\xa9\xbc\x4e\x97\xa7\xf2\xbd\x74\x79\x68\x76\xfb\x23\x73\x45\xfe\x8f\x3e\x0d\x0a\xa0\x00\x25\xea\x80\xc1\x7a\x14\x8d\x7a\x55\x29\xc0\x7a\xb5\xa9\x81\x85\x28\x54\x07\x0f\xd2\xa9\x68\x70\x95\x5a\x0d\x29\xdd\x4c\xb3\xd3\xa2\x5a\x15\xf9\xa4\x29\xda\x43\xbd\x6f\x77\x5f\x0b\xcf\xc1\x42\x7d\xcf\x81\x1d\x58\x90\xdb\x7a\xcf\x91\x7d\xce\x11\xa3\x55\xc7\x73\x4d\x64\x7a\x98\x8c\x9b\x8c\xac\x12\x7e\xf8\x66\x6c\x03\xf2\x02\xa2\x7c\xc7\xb7\xf8\x88\x8a\x7a\xf5\xf7\x62\x7d\x24\x2a\xbe\xee\xf2\xa2\xee\x3f\x4b\x04\xbb\xe4\x9a\x28\xe4\x0e\x05\x13\x8b\x5c\xf6\xb0\xbb\x34\x49\xe2\xd5\xf7\x27\x25\x0b\xb9\x2d\xca\xd6\x3f\xe6\xc9\x74\x91\x3e\xc6\xf3\xd9\x3d\xd1\x2c\x7e\x70\x35\x4b\x1f\xa6\x49\x4a\x35\x99\xaf\xbe\xcf\xc8\x16\x8f\x24\x78\xbc\xfa\x1e\x93\xe0\xac\x77\x6f\x53\x66\x57\xcc\x0d\xa8\xd4\x44\x86\x77\x2c\x7d\x95\x80\x47\x64\xde\x71\x67\x39\x72\x63\xc3\xf5\x1d\x63\x93\xa6\xf8\x5a\x34\x2d\x99\x3b\x49\xab\x76\xa3\x26\x81\x0c\x86\x7d\x24\x4c\x08\x37\x15\x17\x1c\x10\x62\x99\x53\xce\x76\x6e\x15\x8c\x8f\xa8\x00\x04\xfb\x9a\x40\xb1\xaf\x4d\xd8\xa5\xbb\x4a\xe3\x43\x32\x49\xf0\x63\xf3\xbf\xec\x03\x00\xc4\xec\x04\xce\xd9\x4a\x4c\x83\xf0\x10\xe5\xd0\x9c\x6f\x0d\x46\x1d\x47\xc2\x51\xbf\x16\xfa\x47\xfa\x52\x41\x3e\x47\x34\x82\x98\x40\x10\x93\x08\x62\x0b\x81\xc8\x8e\x36\x89\xa8\xfc\x69\x18\x85\xcc\xa4\xe6\x42\x12\x13\x48\x62\x07\x12\x83\x13\x74\x86\x47\x24\x4e\xea\x90\xf1\xe3\x3e\xbc\x4c\x43\x86\xcf\xfd\x74\x19\x97\x7c\x98\x8b\x7d\x7e\xb6\x12\xf4\xf4\x61\x35\x40\x6c\x9c\xe2\x88\x07\x42\x2b\x0a\x3d\x98\x31\x80\x8d\x17\x92\x41\x21\xc4\x00\xe5\xc1\x6c\xa4\x92\xf2\x11\x60\x67\xd5\x2c\x79\xe4\x3b\xf6\x58\xe7\xea\xf5\x58\xf4\x92\x10\xed\x75\x0a\xda\x91\x52\xac\x44\xed\xea\xb1\x86\x98\xce\x99\xe5\x42\x69\x68\x8f\x57\x20\xf5\x59\xe8\x90\xea\x74\x64\x58\x6d\xfa\x79\x1d\x07\x4a\x5b\x67\x3a\x42\xb5\x83\x8f\x50\xda\x3b\xf8\x06\x52\xbb\xda\x44\xdb\x1e\x9b\xe2\xb8\x7e\xc1\x8c\x42\xb1\x03\xa9\x55\x2b\x71\x3a\x5c\x8c\x31\x40\xfb\x99\xd1\xcc\xab\x23\xd2\xc7\x3a\xc4\x94\xa6\x0c\xb4\xa4\xae\x5c\x4e\xd6\xe1\x75\x68\x4c\xa2\x76\xe9\xcc\xe9\x65\x1d\x66\xb0\x7a\x27\x6a\xa7\xa3\x99\x14\xb0\x9b\x75\x04\x48\x5f\xa3\x68\xb8\x3c\x4d\x92\x00\x75\x53\x34\x2c\x4b\xc0\x9d\x70\x59\x4a\x5b\x94\x1b\x7e\x8d\xae\xc3\xca\x0c\x12\xcc\x0f\x4d\x73\x5d\x93\x67\x3c\xd1\x69\xd8\x90\xd1\x69\xe8\x68\xab\x63\x8d\xbc\x26\xc7\x00\x4c\x7b\xd3\x70\x52\x06\xd7\x61\x24\xad\x8d\x55\x5b\xa6\xa6\xa1\x74\xd8\x1a\x6b\xe6\x34\x34\x56\x49\x8c\x0d\x1a\x56\x7b\x04\xd0\xf1\xda\xb5\x06\x66\xd0\x1f\x89\xd8\xd2\xad\x8e\xd7\xaa\xf4\x2c\x21\x73\x8e\xdb\xea\x66\x11\x73\x5b\x41\x0c\x7a\xc3\xa0\x59\xb0\x27\x23\x35\x07\x9b\x76\x20\xb7\x0c\x01\xc2\x6c\xfb\xa8\xb8\x42\xe2\x65\x20\x5c\x47\xeb\xea\xe8\xb9\xad\x6e\x15\x40\x03\x37\x92\x5b\x82\x2b\xbb\x23\xcb\x3e\x80\x0e\xf7\x95\x61\x74\x5b\x5d\x1f\x49\xb7\xd5\x0d\x82\xe9\x4a\x86\xc2\x11\x11\x0a\x47\x8e\x50\x38\xf2\xe0\xb9\x5d\x48\x5d\xe1\x29\x9f\x9c\xed\x51\x33\xc7\xe0\x4b\x20\x7f\x8f\xc0\xba\xad\xac\xb1\xf8\x56\xb1\x75\x5b\xd9\x13\xff\xad\xc3\xeb\xb6\x7a\xef\x08\xdb\xd2\xe9\x2d\x82\xec\xb6\xba\x6d\x9c\xdd\x56\xb7\x0f\xb5\x99\xfa\x60\x5a\xc4\x58\xed\xe9\xd2\xc0\x6b\x57\x5b\x98\x61\x62\xc4\x88\xad\xf9\xd2\xc0\x6b\xd5\x4a\xb4\x6e\x07\xd4\x63\x34\x4a\x61\x7e\x1f\xb4\xb0\x1b\x4a\xeb\x70\x53\x8a\x33\x30\x93\xaa\xf3\xb8\x60\x87\xda\xa1\x40\x89\xdd\xa5\x42\x9f\x0f\x76\xc8\xc1\x21\xae\x0d\xbe\x09\x27\x7c\x8f\xf8\x9b\xb0\x9a\x8e\x8c\x65\x1b\x98\x86\xcb\x76\x44\x80\x57\xdd\x3a\x0a\xb7\x2c\xd1\x08\x19\x2f\x0f\xc4\xb1\x11\x6a\x68\x2f\x8d\xc5\x09\xfb\xd3\xb0\x5e\x1c\x8e\x93\xe3\x87\x86\xd8\x1e\x25\x74\xd4\x76\x2d\x46\x0e\x1a\x25\x71\x5b\xda\xd6\x51\x5b\x95\xbe\xd3\x00\x9c\xeb\x2a\xbf\x59\x54\x5e\xe5\xb7\x8f\xca\x05\x7b\x32\xf6\x73\xb0\x69\x87\x86\xcb\x10\x20\xcc\xb6\x8f\x8a\x2b\xda\x5e\x06\xc2\x75\xb4\xae\x8e\xca\xab\xfc\x56\x51\x39\x70\x23\xb9\x25\xb8\xb2\x3b\xb2\xec\x03\xe8\x70\x5f\x19\x95\x57\xf9\xf5\x51\x79\x95\x5f\x1f\x95\x57\xb9\x8c\xa6\x23\x22\x9a\x8e\x1c\xd1\x74\xe4\xc1\x73\xb3\xa8\xbc\xca\xf1\x50\x4c\xc6\x03\xa8\x99\x63\x24\x26\x90\xbf\x47\x54\x5e\xe5\xd6\x90\x7c\xab\xa8\xbc\xca\xed\x88\xe0\xd6\x51\x79\x95\xbf\x73\x54\x6e\xeb\xf4\x16\x51\x79\x95\xdf\x36\x2a\xaf\xf2\xdb\x47\xe5\x4c\x7d\x30\x35\x62\xac\xf6\x94\x69\xe0\xb5\xab\x2d\xcc\x30\x33\x62\xc4\xd6\x84\x69\xe0\xb5\x6a\x25\x5a\xb7\x03\xea\x61\x1b\xa5\x30\xbf\x0f\x5a\xd8\x0d\xa5\x75\xb8\x29\xc5\x19\x98\x49\xd5\x79\x5c\xb0\x43\xed\x50\xa0\xc4\xee\x52\xa1\xcf\x07\x3b\xe4\xe0\x10\xd7\x46\xe5\x84\x13\xbe\x43\x54\x4e\x59\x4d\x47\xc6\xb2\x0d\x4c\xc3\x65\x3b\x3c\xc8\xab\xf2\x1b\x47\xe5\xb6\x25\x1a\x31\xe3\xe5\x51\x39\x36\x42\x0d\xed\xa5\x51\x39\x61\x7f\x1a\xd6\x8b\xa3\x72\x72\xfc\xd0\x10\xdb\xa3\x84\x8e\xda\xae\xc5\xc8\x41\xa3\x24\x6e\x4b\xdb\x3a\xea\xa0\xa8\x5c\x1e\xec\xe4\x5c\x97\xdb\x9b\x45\xe5\xe5\xf6\xf6\x51\xb9\x60\x4f\xc6\x7e\x0e\x36\xed\xd0\x70\x19\x02\x84\xd9\xf6\x51\x71\x45\xdb\xcb\x40\xb8\x8e\xd6\xd5\x51\x79\xb9\xbd\x55\x54\x0e\xdc\x48\x6e\x09\xae\xec\x8e\x2c\xfb\x00\x3a\xdc\x57\x46\xe5\xe5\xf6\xfa\xa8\xbc\xdc\x5e\x1f\x95\x97\x5b\x19\x4d\x47\x44\x34\x1d\x39\xa2\xe9\xc8\x83\xe7\x66\x51\x79\xb9\xc5\x43\x31\x19\x0f\xa0\x66\x8e\x91\x98\x40\xfe\x1e\x51\x79\x29\x5f\x0f\x42\x98\xad\xc1\x18\x23\xb7\x47\x64\x02\xf5\xbb\x47\xe5\xe5\xf6\x9d\xa3\x72\x5b\xa7\xb7\x88\xca\xcb\xed\x6d\xa3\xf2\x72\x7b\xfb\xa8\x9c\xa9\x0f\xa6\x46\x8c\xd5\x9e\x32\x0d\xbc\x76\xb5\x85\x19\x66\x46\x8c\xd8\x9a\x30\x0d\xbc\x56\xad\x44\xeb\x76\x40\x3d\x6c\xa3\x14\xe6\xf7\x41\x0b\xbb\xa1\xb4\x0e\x37\xa5\x38\x03\x33\xa9\x3a\x8f\x0b\x76\xa8\x1d\x0a\x94\xd8\x5d\x2a\xf4\xf9\x60\x87\x1c\x1c\xe2\xda\xa8\x9c\x70\xc2\x77\x88\xca\x29\xab\xe9\xc8\x58\xb6\x81\x69\xb8\x6c\x87\x07\x79\xe5\xf6\xc6\x51\xb9\x6d\x89\x46\xcc\x78\x79\x54\x8e\x8d\x50\x43\x7b\x69\x54\x4e\xd8\x9f\x86\x75\x40\x54\xfe\xff\x68\x3b\x9f\xdf\xc6\x91\xe5\x8e\xdf\xf3\x57\x18\xb3\x08\x30\xb3\x31\xb5\xfa\x61\x8d\x77\x64\x04\x78\xc0\x03\x72\x7a\x9b\xc3\x0b\x72\x0a\x72\x60\x4b\xb4\x2d\x4c\x53\xea\x90\xf4\x4c\x73\x0d\xff\xef\x41\x77\x57\x91\xac\xae\x2a\x51\xbb\xe3\x3d\xbc\xd9\xb1\xfa\x53\x55\xfd\x15\xa5\x52\x7d\xfd\x46\x4d\x96\x16\x1b\x81\x98\x98\x77\x89\x69\x6a\xbe\x9a\x27\x87\x2b\x2a\xe6\x66\x57\x7b\x9a\xfa\xaa\xa9\x7c\xf8\x8e\x4e\x7c\x3e\xbc\x7d\xb7\xb1\xdc\xdb\xf7\x1f\xcb\xd3\xf6\x70\xf8\x53\xb6\xc9\x67\xc3\x87\x6b\xa0\x7c\xdb\x97\xaa\x68\xe3\xf6\xc3\x95\xdc\x58\xeb\x87\xc7\x72\x6f\xdf\x6b\x2c\x87\xdd\xe0\x6e\x85\x5d\x71\x21\x0f\x73\xc0\x98\xfb\x07\xc7\x72\x6f\x7f\x7c\x2c\xf7\xf6\xc7\xc7\x72\x6f\x71\x9c\x5e\x0a\xe3\xf4\x52\x19\xa7\x97\x17\xf2\xbc\xdb\x58\xee\x6d\xde\x8b\xc5\x81\x20\x0b\x53\x5a\xb1\x90\xfc\xaf\x18\xcb\xbd\x65\x3d\xf9\xbd\xc6\x72\x6f\xf9\x48\xf0\xde\x63\xb9\xb7\x7f\xf1\x58\xce\xaf\xe9\x7b\x8c\xe5\xde\xbe\xef\x58\xee\xed\xfb\x8f\xe5\xe1\xf2\xc1\x67\x63\x9e\x95\x7f\x66\x92\xbc\x7c\x99\x65\x86\x8f\xc6\x3c\x31\xfb\xc4\x24\x79\xd9\x2a\xa6\xd5\xdf\x80\xd3\xb9\x4d\xba\x60\x97\xdf\x83\x2c\x3b\xb9\x68\x63\x6e\xe9\xc2\x91\xcc\xe2\xa5\xbb\xf0\x16\x1c\x53\x2b\x17\x10\xb3\x6b\x97\xf0\xd2\x7b\x70\x4c\x0e\x6f\x88\x1f\x1d\xcb\x85\x37\xe1\x5f\x30\x96\x4b\xaf\x9a\xb1\x0c\x7b\x6d\xe4\x35\xb4\xd7\x4e\x9c\xf2\xbc\x7d\xe7\xb1\x9c\xbf\x12\xc9\xd0\xf8\xe7\xc7\xf2\xfc\x45\x38\x49\xfb\x67\xc7\x72\xe1\xf5\x37\xc9\xfa\xa7\x7f\x59\x2e\xf6\x8f\x49\x62\xde\x25\xa6\xa9\xf9\x6a\x9e\x1c\xae\xa8\x98\x9b\x5d\xed\x69\x6a\xb6\xf8\x16\x46\x9c\x73\xd9\xc5\x13\x73\x5e\xc7\x1b\x79\x4c\x08\x00\xe2\xf9\x3e\x40\xc4\xbf\x73\x24\x7c\x2d\x1b\x88\xec\x1b\xda\x97\xfe\xf5\x7a\x88\x6c\xeb\xf9\x0d\xb4\x70\xc6\xd0\xe5\x3d\xb4\xf5\x85\x6d\x5c\xfc\xf7\x3a\x21\xba\x3e\xcc\xef\xa3\x3e\x5c\xb3\x8f\xfa\xf0\xc7\xf6\x31\xfe\x3f\x14\x21\xda\x3e\xcd\xef\xc3\x3e\x5d\xb3\x0f\xfb\xf4\xc7\xf6\x31\xf1\x64\x21\xdc\xdb\xf9\x8d\x78\x7b\xcd\x46\xbc\xbd\xb4\x91\xc5\x4b\x1b\xee\x7f\x58\xd9\x6a\x1f\x4e\x67\xb6\xc3\x99\xb7\x93\xc7\x77\xe5\xf4\x9b\xee\x0f\xf1\x0e\xea\x97\x96\xdb\x0b\xab\xea\x4a\xb6\x93\x97\xc9\x11\xcd\x24\x86\x76\x46\xbe\x97\x7c\xbd\xbd\xb4\xac\x2f\xd1\xed\x84\x67\x4d\xdc\x0e\x7d\x3a\xf9\x76\xd8\x7a\x7b\x69\x59\x5f\x7a\x5b\xe0\x97\xef\xd3\x87\xc6\xf0\x55\xfc\x7c\xd7\x03\x96\xbe\xa3\x3f\x82\xe9\xe7\x29\x8a\x5f\x80\x0f\x9f\x1a\xdd\x71\x3f\x7e\x21\x3e\xfd\x2c\xa2\x78\x5c\xc0\x08\xe3\x23\x22\x8e\x5f\xad\xe7\x5f\xb6\x17\xf1\xc7\xa3\xaf\x0e\x23\x1b\x7f\x14\xc1\xb6\x3b\xee\xbf\xf6\x23\x89\x97\x26\x3d\x3e\x86\x3c\x0c\x44\xbe\xf2\xb6\x88\xd9\xc3\xb7\xdf\xb3\x82\x70\x0c\x00\x9c\x43\xc8\x8e\xe4\x5f\x6e\x96\x18\x0b\xc7\xb1\x65\xe1\x18\x98\x9f\x23\x40\x32\xfc\xad\x7d\x71\xe1\x35\xda\xde\x7c\xfc\xa8\xa8\xf8\x74\x73\x6e\x6e\xc6\x45\x78\xf0\xd3\xeb\x22\xfd\x8d\xee\x9c\x86\xe6\xb2\x41\xd2\xb8\x83\xf5\xf2\xed\x6d\xd1\x36\xc5\xf9\x64\x7b\x7e\x6d\xf0\xf4\x87\xf1\x5c\xfe\xd5\xe4\xa6\xc6\x78\xdf\x80\x5d\x11\x1e\xcd\x5e\x5f\x0f\xf1\x64\xfd\xf0\xab\x10\xb8\xc5\xda\xf2\x53\x76\x77\xdb\xf0\x7b\x83\xc9\xe1\x09\xb0\x89\x22\x9e\x45\x1a\xce\xd5\xd8\xa5\x63\xeb\x6f\x85\x95\xc8\x8c\xdb\x4d\x2f\x53\xd8\x6c\x78\x1f\xe0\x6e\xe3\xdf\x87\x8d\xc5\x5b\x5d\xd9\x2a\xed\x2c\x2e\xf1\xdb\xed\xbe\x2d\xda\xe7\xf2\x90\x4e\xa4\x8e\x37\x33\x88\x3f\x85\x1b\xd3\xae\xe0\x16\xce\xe9\x3f\xd3\x7b\xc7\x2d\xef\xb7\x9f\xa6\xaf\xa7\x94\x21\x0b\x8f\x51\xab\x3c\x74\x25\x45\xa6\x53\xf1\x26\xc1\x31\x6c\xc3\x62\xc5\xb2\xa9\xc5\x4f\xc2\xf3\xf6\xf1\xbd\x58\x6f\xe1\xb0\xed\xf5\xf6\x5f\xe9\xca\x76\x09\x2b\xd9\xc1\x7a\xdf\x8b\x7b\x8c\xb9\xcf\x63\x56\x4b\x0c\x5a\x2d\xf3\xa8\xf0\x24\xc3\x62\xde\x9e\x9e\xc3\x36\xe0\x32\x65\xfb\x78\x2e\xb6\x4b\x5c\xca\x36\xf2\x5c\xdc\x0f\x51\xd9\x4e\x9e\xc3\x7d\xa6\x70\x2d\xdf\xca\x73\x6a\x96\xb0\x9a\xef\xa5\x4e\x2a\xc6\x9b\x61\xe7\xe1\xf5\xf3\x00\x28\x05\xc2\xc7\xf7\x37\x48\x33\x7e\x92\x2f\x97\xdf\xc8\xaf\x46\x23\x05\xb9\x8e\xa7\x49\xae\x6f\xd3\x81\x70\x01\x89\x94\x24\xdf\x72\xa5\x34\xb8\x2e\x96\x70\xf7\x47\xfa\x7b\xa7\xba\x2b\x96\xb7\x8b\xba\x1f\x96\xf9\x39\xac\x75\x13\x11\x3f\x22\xd0\xc2\x08\x64\xf2\x3c\xd8\xdf\x08\x65\xf3\x54\xfc\xec\xd5\xba\x58\xc1\xa2\x70\x42\x64\xdd\x15\xab\x58\x06\x99\xb8\x5d\x81\x6b\x22\xe7\x8b\x15\xdd\xb3\x40\x9a\x3c\x23\x6c\x5c\x40\x6d\x9e\x34\xee\x5e\x00\x8b\x35\x10\xbb\x05\x5b\xeb\x8a\x75\xac\x87\x48\x52\xc0\xb0\x26\x62\xbe\x58\x67\x02\x18\x68\xf2\x7c\xb8\x7f\x46\xda\x3c\xe5\x78\x27\x63\xc2\x15\x1b\x00\x76\xab\x7c\xa9\x2b\x36\xb1\x18\x12\x71\xf3\x8c\x6a\x22\xe5\x8b\x0d\xdd\x3b\xe3\x4c\x9e\x0d\xb6\xce\x40\x9b\x27\x8c\x3b\x67\x58\x71\x07\xeb\xbb\x15\x57\xd5\x15\x77\xb1\x18\x32\x69\xeb\x9c\x6b\x22\xe7\x8b\xbb\x6c\xf3\x9c\x34\x79\x46\xdc\x3e\x47\x6d\x9e\x34\x09\xe0\x60\xb1\x05\x62\x97\x1f\x79\x5a\x77\xc5\x36\x96\x43\x22\x0a\x60\x54\x13\x29\x5f\x6c\xe9\xf6\x19\x67\xf2\x6c\xb0\x79\x06\xda\x3c\x61\xdc\x7a\x8e\xb9\x62\xf9\x3a\x4c\x00\x64\x21\x36\x18\xd7\x8f\xeb\xbc\xc3\xb8\xd8\x61\x9c\x9f\x30\x42\x8b\x71\x86\x65\x82\x3d\x53\xcc\xb2\x64\xbc\xc9\xb8\x62\x85\xab\x42\x4f\x70\xb1\xcb\xb8\x7e\x84\x94\x36\xe3\x62\x9b\x71\x7e\x02\x6a\x7d\xc6\x19\x96\x13\x76\x2f\xb0\x96\xa5\x55\x3a\x8d\x2b\xd6\x88\xf0\x37\xb2\x8b\xad\xc6\xf5\x23\x23\xf7\x1a\x17\x7b\x8d\xf3\xc5\x3a\x57\xc1\x48\xc3\x32\xa2\x08\x86\x5a\x96\x54\x6e\x37\xae\xd8\x20\xc1\x3a\x84\x8b\xfd\xc6\xf5\x23\x22\x36\x1c\x17\x1b\x8e\xf3\x13\x4c\xee\x38\xce\xb0\x7c\xb0\x7f\x46\x5a\x96\x52\xec\x39\xae\xb8\x43\x40\x78\x3b\xbb\xd8\x74\x5c\x3f\x42\x4a\xd7\x71\xb1\xeb\x38\x5f\xdc\xe5\x0a\x38\x6a\x58\x4e\xd4\xc0\x59\xcb\xd2\x2a\x8d\xc7\x15\x5b\x44\x58\xaf\x70\xb1\xf3\xb8\x7e\x44\xc4\xd6\xe3\x62\xeb\x71\x7e\x82\xc9\xbd\xc7\x19\x96\x0f\x14\x30\xd2\xb2\x94\x62\xf7\xa9\x8b\x13\x7e\x26\xef\x0a\xfe\x2e\xa9\xbb\xe2\x14\x3e\xb9\xfb\x11\x8b\x0a\x24\xb4\x49\xa8\x9f\xa0\x70\xa7\x5c\x01\x36\x3c\x2f\x28\x91\x52\x5b\x9e\x1a\x6e\xfe\xcd\xd9\xe2\x84\x9f\xd2\x70\x6f\x70\xb2\xda\x15\xa7\xf0\x51\xde\x8f\x14\xe8\x61\x64\x93\x48\x3f\x21\x51\x0e\x63\x0d\xcf\x3a\xa8\x61\xb0\xe5\x89\x27\x77\x32\x27\x68\x71\xc2\xcf\xed\x5d\x91\xbf\x7f\xc2\xa5\xd9\xa4\x4b\x33\x7c\xb8\x47\x29\x0c\x6c\x12\xe8\x27\x20\x28\x61\xa8\xe1\x39\x51\x08\x63\x2d\x4f\x9b\x74\x30\xb2\x38\xe1\xa7\xf7\xae\xe0\x6f\xa0\x20\xe4\x2e\x09\x19\x3e\xe4\x93\x10\x8e\x36\x09\xf5\x13\x14\xa5\x70\xd8\xf0\xbc\x83\x18\x4e\x5b\x9e\x1a\xe4\x70\xb6\x38\xe1\x67\xfa\xae\x60\xef\xa8\xae\x38\x85\xcf\xfd\x7e\x84\xd2\x4b\x8c\x81\x4d\x02\xfd\x04\x04\x31\x0c\x35\x3c\x27\x4a\x61\xac\xe5\x69\x93\x10\x46\x26\x13\x07\x42\x98\x89\x4b\xbf\x2b\x8c\x4a\x26\x5c\xd4\xc2\xd8\x06\x59\x3f\xcd\x09\x5d\x8c\xd1\x46\xce\x0c\x8a\x18\x6e\xe5\xe4\x51\x54\x06\xeb\xbf\x95\xaf\xc3\x17\x65\xd1\x3c\xd1\x91\xa6\x8e\xbf\x63\x0f\xf6\xaa\x9f\x42\xa2\x9f\x03\xd0\x13\x50\x76\x75\x62\x4e\xd0\x48\x59\x2b\xa7\x95\x1c\x5e\x3b\x67\xf2\x02\x80\x55\xe7\xad\x1e\xd0\x9e\xd0\xda\x20\x56\x1b\x39\x3b\x68\x12\x02\xac\x5c\x40\x35\x7f\x6d\x3d\x58\x2c\x3e\xee\xa4\xcb\xb4\xc6\xcb\x34\xeb\x02\x01\xf6\x04\x56\xc6\xb3\xda\xc8\xb9\x51\x1a\xe3\xad\x9c\x5e\xf3\x85\x6d\x3d\x38\x30\x36\x08\x25\x5d\x1b\xd4\x35\x67\x10\x81\xf5\x84\x95\x87\xb6\xda\xc8\x99\x41\x15\xc3\xad\x9c\x5c\xb1\x8c\x6d\x3d\x98\x32\x61\x2c\x4a\xaa\xee\x50\xd5\xbc\x77\x04\xda\x13\x5a\x1b\xe5\x6a\x23\x67\x47\x65\x3c\xc0\xca\x05\x54\x37\xd9\x5e\x36\x94\x61\x19\xab\xcf\xd9\x4a\x60\x3d\x61\x55\x73\x29\x66\x06\x5d\x0c\xb7\x72\x72\xc5\x68\xc6\xe6\x02\xe3\x20\x6d\x2c\x0e\x5b\xa0\xeb\x09\x25\x3a\x4e\x20\x3d\x25\x65\xdf\x29\x67\x05\x39\x14\xc6\x2e\x98\x27\x96\x3c\x68\x68\x2b\x08\x08\x9d\xc4\x61\x1f\x74\x3d\x41\x75\x33\x0a\xb8\xa7\xb8\xd6\x09\x9d\x51\xf2\x83\x30\x21\x02\x5b\xa1\xf3\x34\x42\xb5\xa7\xa1\xbf\x20\xc5\x5b\x8a\xc3\x66\xe8\x7a\x42\xaa\x3e\x15\x68\x4f\x69\xa5\x1d\x3a\xa3\x64\x47\x7d\x2c\x00\xfb\xa1\xf3\x34\x40\x73\xae\xa1\xd1\x20\xc4\x7a\x8b\xc3\x8e\xe8\x7a\x02\x6a\x16\x16\x60\x4f\x61\xb9\x27\x3a\xa3\xe4\x06\x69\x8c\xc7\xa6\xe8\x3c\xe5\x15\x53\x1b\xba\x0c\x32\x42\x63\x71\xd8\x16\x5d\x4f\x50\xdd\xdd\x02\xee\x29\xae\x35\x46\x67\x94\xfc\x28\x8f\x47\x60\x67\x74\x9e\x46\xa8\x7e\x37\xb4\x1c\xa4\x78\x97\xc1\xde\xe8\x7a\x02\x6a\xc6\x17\x60\x4f\x61\xd5\xfe\xca\xb9\x41\x1c\xe3\xb1\x3d\x3a\x4f\x79\xc5\x0a\xb7\xf3\x6e\x38\x22\xd8\x9e\xaf\xf1\xc4\x18\xe0\x69\x00\x0c\xfa\x42\x88\xd1\x6a\x80\x48\xa9\x8c\xd5\xca\xe8\x2e\xb9\x9d\x35\xca\x91\x18\xb6\x31\x6f\x97\x91\xf7\x94\x47\xa5\x2c\xc2\x68\x15\x06\xa1\x2c\xc4\x6a\x45\x54\x03\x1d\x28\x1c\x64\x04\x67\x9a\x54\x0e\x13\xd2\xbc\x93\x46\xdc\x53\x1c\x44\xb2\x00\xa3\xe5\x47\x8d\x2c\xc2\x6a\x25\x34\x6f\x1d\x20\x1c\x67\x44\xb3\x9a\x34\x0e\xb3\xd2\x35\x26\x1b\x03\x3c\x0d\x40\x95\x3c\xc4\x68\x35\x06\x9d\x3c\xc6\x6a\x65\x74\xdb\xdd\xce\x39\xef\x08\x0c\xbb\x98\xf5\xdf\x88\x7b\x8a\x83\x4e\x16\x60\xb4\xfc\xa8\x92\x45\x58\xad\x84\xe6\xc8\xf1\xf8\x2d\xd0\xc8\xbc\x70\x87\x04\x6e\xe2\x0a\x6b\x3e\x46\xf8\x3c\x42\x35\xe8\x17\xaa\x80\x58\x16\x64\x2f\x15\x92\xcc\xfa\x85\x7f\xbb\x56\x87\x2f\xd0\xa3\x11\xa6\xc3\x5f\x1d\xff\x25\x5a\x70\xcb\xfd\x14\x12\xdd\x3a\x80\x9e\x80\xb2\x5b\x17\x73\x82\x54\xca\x5a\x39\xad\xe4\xd6\xeb\xc3\x60\x7a\x85\x19\x2f\x29\x59\xa1\x92\x79\xb7\x0e\xb4\x27\xb4\x36\xa3\xd6\x46\xce\x0e\x9a\x84\x00\x2b\x17\x50\xdd\x7a\x7d\x18\x8c\x2f\x1f\xf1\x92\xb8\x35\x8a\x9b\x75\xeb\x00\x7b\x02\x2b\xe3\x69\x6d\xe4\xdc\x28\x8d\xf1\x56\x4e\xaf\xb9\xf5\xfa\x30\x98\x5f\x36\xe1\x25\x5d\x1b\xd4\x35\xe7\xd6\x81\xf5\x84\x95\x27\xd3\xda\xc8\x99\x41\x15\xc3\xad\x9c\x5c\x71\xeb\xf5\x61\x30\xbd\xc2\x50\x97\x54\xdd\xa1\xaa\x79\xb7\x0e\xb4\x27\xb4\x36\x94\xd6\x46\xce\x8e\xca\x78\x80\x95\x0b\xa8\x6e\xbd\x3e\x0c\xe6\x97\xcf\x75\x51\xdb\x16\xb5\xcd\xb9\x75\x60\x3d\x61\x55\xb7\x2e\x66\x06\x5d\x0c\xb7\x72\x72\xc5\xad\xc7\xe6\x02\x13\x2b\x6d\x2c\x0e\x5b\xa0\xeb\x09\x25\xba\x75\x20\x3d\x25\x65\xb7\x2e\x67\x05\x39\x14\xc6\x2e\x98\x27\x96\xdc\x7a\x68\x2b\x08\x08\x9d\xc4\x61\x1f\x74\x3d\x41\x75\xb7\x0e\xb8\xa7\xb8\xd6\x09\x9d\x51\xf2\x83\x30\x21\x02\x5b\xa1\xf3\x34\x42\x75\xeb\xa1\xbf\x20\xc5\x5b\x8a\xc3\x66\xe8\x7a\x42\xaa\x6e\x1d\x68\x4f\x69\xa5\x1d\x3a\xa3\x64\x47\x7d\x2c\x00\xfb\xa1\xf3\x34\x40\x73\xeb\xa1\xd1\x20\xc4\x7a\x8b\xc3\x8e\xe8\x7a\x02\x8a\x2d\xd1\x61\x4b\x74\x9e\xc2\x72\x4f\x74\x46\xc9\x0d\xd2\x18\x6f\x95\xf4\x8a\x5b\x0f\x5d\x06\x19\xa1\xb1\x38\x6c\x8b\xae\x27\xa8\xee\xd6\x01\xf7\x14\xd7\x1a\xa3\x33\x4a\x7e\x94\xc7\x23\xb0\x33\x3a\x4f\x23\x54\xb7\x1e\x5a\x0e\x52\xbc\xcb\x60\x6f\x74\x3d\x01\x35\xb7\x0e\xb0\xa7\xb0\xea\xd6\xe5\xdc\x20\x8e\xf1\xd8\x1e\x9d\xa7\xbc\xe2\xd6\xc3\xf7\x11\x70\x48\x11\x3d\x6e\xd4\x06\x4e\xba\x3e\x5c\xe5\xd6\x31\xc0\xd3\x00\x30\x04\x42\x88\xd1\x6a\x80\x48\xa9\x8c\xd5\xca\xe8\x6e\x3d\x60\x38\xb6\x48\x3e\x37\x49\x1d\x26\xa2\x2b\xdc\x3a\xf2\x9e\xf2\xa8\x94\x45\x18\xad\xc2\x20\x94\x85\x58\xad\x88\xea\xd6\x03\x85\x83\x8c\xe0\x75\x93\xca\x61\x42\x9a\x77\xeb\x88\x7b\x8a\x83\x48\x16\x60\xb4\xfc\xa8\x91\x45\x58\xad\x84\xe6\xd6\x03\x84\xe3\x8c\xe8\x71\x93\xc6\x61\x56\xba\xc6\xad\x63\x80\xa7\x01\xa8\x92\x87\x18\xad\xc6\xa0\x93\xc7\x58\xad\x8c\xee\xd6\x03\x86\x43\x8e\xe0\x75\x93\xd2\x61\x7a\x9a\x77\xeb\x88\x7b\x8a\x83\x4e\x16\x60\xb4\xfc\xa8\x92\x45\x58\xad\x84\xe6\xd6\xf1\x58\x3e\xd0\xc8\x2c\x71\x87\x04\x6e\xe2\x0a\xb7\x3e\x46\xf8\x3c\x42\x75\xeb\x17\xaa\x80\x58\x16\x64\x2f\x15\xba\xd2\xad\xe3\x37\xbc\xea\x70\xb0\x06\x1a\x61\x3a\xfc\xd5\xf1\xfb\x5a\xc1\x2d\xf7\x53\x48\x74\xeb\x00\x7a\x02\xca\x6e\x5d\xcc\x09\x52\x29\x6b\xe5\xb4\x92\x5b\xb7\x4f\x83\xe9\x15\x66\xbc\xa4\x64\x85\x4a\xe6\xdd\x3a\xd0\x9e\xd0\xda\x8c\x5a\x1b\x39\x3b\x68\x12\x02\xac\x5c\x40\x75\xeb\xf6\x69\x30\xbe\x7c\xc4\x4b\xe2\xd6\x28\x6e\xd6\xad\x03\xec\x09\xac\x8c\xa7\xb5\x91\x73\xa3\x34\xc6\x5b\x39\xbd\xe6\xd6\xed\xd3\x60\x7e\xd9\x84\x97\x74\x6d\x50\xd7\x9c\x5b\x07\xd6\x13\x56\x9e\x4c\x6b\x23\x67\x06\x55\x0c\xb7\x72\x72\xc5\xad\xdb\xa7\xc1\xf4\x0a\x43\x5d\x52\x75\x87\xaa\xe6\xdd\x3a\xd0\x9e\xd0\xda\x50\x5a\x1b\x39\x3b\x2a\xe3\x01\x56\x2e\xa0\xba\x75\xfb\x34\x98\x5f\x3e\xd7\x45\x6d\x5b\xd4\x36\xe7\xd6\x81\xf5\x84\x55\xdd\xba\x98\x19\x74\x31\xdc\xca\xc9\x15\xb7\x1e\x9b\x0b\x4c\xac\xb4\xb1\x38\x6c\x81\xae\x27\x94\xe8\xd6\x81\xf4\x94\x94\xdd\xba\x9c\x15\xe4\x50\x18\xbb\x60\x9e\x58\x72\xeb\xa1\xad\x20\x20\x74\x12\x87\x7d\xd0\xf5\x04\xd5\xdd\x3a\xe0\x9e\xe2\x5a\x27\x74\x46\xc9\x0f\xc2\x84\x08\x6c\x85\xce\xd3\x08\xd5\xad\x87\xfe\x82\x14\x6f\x29\x0e\x9b\xa1\xeb\x09\xa9\xba\x75\xa0\x3d\xa5\x95\x76\xe8\x8c\x92\x1d\xf5\xb1\x00\xec\x87\xce\xd3\x00\xcd\xad\x87\x46\x83\x10\xeb\x2d\x0e\x3b\xa2\xeb\x09\xa8\xb9\x75\x80\x3d\x85\xe5\x9e\xe8\x8c\x92\x1b\xa4\x31\x1e\x9b\xa2\xf3\x94\x57\xdc\x7a\xe8\x32\xc8\x08\x8d\xc5\x61\x5b\x74\x3d\x41\x75\xb7\x0e\xb8\xa7\xb8\xd6\x18\x9d\x51\xf2\xa3\x3c\x1e\x81\x9d\xd1\x79\x1a\xa1\xba\xf5\xd0\x72\x90\xe2\x5d\x06\x7b\xa3\xeb\x09\xa8\xb9\x75\x80\x3d\x85\x55\xb7\x2e\xe7\x06\x71\x8c\xc7\xf6\xe8\x3c\xe5\x15\xb7\x1e\xbe\xb5\x8f\x43\x8a\xe8\x71\xa3\x36\x70\xd2\xf6\xe9\x2a\xb7\x8e\x01\x9e\x06\x80\x21\x10\x42\x8c\x56\x03\x44\x4a\x65\xac\x56\x46\x77\xeb\x01\xc3\xb1\x45\xf2\xb9\x49\xea\x30\x11\x5d\xe1\xd6\x91\xf7\x94\x47\xa5\x2c\xc2\x68\x15\x06\xa1\x2c\xc4\x6a\x45\x54\xb7\x1e\x28\x1c\x64\x04\xaf\x9b\x54\x0e\x13\xd2\xbc\x5b\x47\xdc\x53\x1c\x44\xb2\x00\xa3\xe5\x47\x8d\x2c\xc2\x6a\x25\x34\xb7\x1e\x20\x1c\x67\x44\x8f\x9b\x34\x0e\xb3\xd2\x35\x6e\x1d\x03\x3c\x0d\x40\x95\x3c\xc4\x68\x35\x06\x9d\x3c\xc6\x6a\x65\x74\xb7\x1e\x30\x1c\x72\x04\xaf\x9b\x94\x0e\xd3\xd3\xbc\x5b\x47\xdc\x53\x1c\x74\xb2\x00\xa3\xe5\x47\x95\x2c\xc2\x6a\x25\x34\xb7\x8e\xc7\x75\x82\x46\x66\x89\x3b\x24\x70\x13\x57\xb8\xf5\x31\xc2\xe7\x11\xaa\x5b\xbf\x50\x05\xc4\xb2\x20\x7b\xa9\xd0\x95\x6e\x7d\x38\x07\xa5\x2e\xbc\x1d\x9c\x30\x9d\xfe\xea\x78\xaa\x49\xb0\xcb\xfd\x14\x12\xed\x3a\x80\x9e\x80\xb2\x5d\x17\x73\x82\x56\xca\x5a\x39\xad\x64\xd7\xbd\x1d\x5c\xaf\x30\xe4\x25\x25\x2b\x54\x32\x6f\xd7\x81\xf6\x84\xd6\x86\xd4\xda\xc8\xd9\x41\x93\x10\x60\xe5\x02\xaa\x5d\xf7\x76\x70\xbe\x7c\xc6\x4b\xe2\xd6\x28\x6e\xd6\xae\x03\xec\x09\xac\xcc\xa7\xb5\x91\x73\xa3\x34\xc6\x5b\x39\xbd\x66\xd7\xbd\x1d\xdc\x2f\x1b\xf1\x92\xae\x0d\xea\x9a\xb3\xeb\xc0\x7a\xc2\xca\xa3\x69\x6d\xe4\xcc\xa0\x8a\xe1\x56\x4e\xae\xd8\x75\x6f\x07\xd7\x2b\x4c\x75\x49\xd5\x1d\xaa\x9a\xb7\xeb\x40\x7b\x42\x6b\x53\x69\x6d\xe4\xec\xa8\x8c\x07\x58\xb9\x80\x6a\xd7\xbd\x1d\xdc\x2f\x1f\xec\xa2\xb6\x2d\x6a\x9b\xb3\xeb\xc0\x7a\xc2\xaa\x76\x5d\xcc\x0c\xba\x18\x6e\xe5\xe4\x8a\x5d\x8f\xcd\x05\x46\x56\xda\x58\x1c\xb6\x40\xd7\x13\x4a\xb4\xeb\x40\x7a\x4a\xca\x76\x5d\xce\x0a\x72\x28\x8c\x5d\x30\x4f\x2c\xd9\xf5\xd0\x56\x10\x10\x3a\x89\xc3\x3e\xe8\x7a\x82\xea\x76\x1d\x70\x4f\x71\xad\x13\x3a\xa3\xe4\x07\x61\x42\x04\xb6\x42\xe7\x69\x84\x6a\xd7\x43\x7f\x41\x8a\xb7\x14\x87\xcd\xd0\xf5\x84\x54\xed\x3a\xd0\x9e\xd2\x4a\x3b\x74\x46\xc9\x8e\xfa\x58\x00\xf6\x43\xe7\x69\x80\x66\xd7\x43\xa3\x41\x88\xf5\x16\x87\x1d\xd1\xf5\x04\xd4\xec\x3a\xc0\x9e\xc2\x72\x4f\x74\x46\xc9\x0d\xd2\x18\x8f\x4d\xd1\x79\xca\x2b\x76\x3d\x74\x19\x64\x84\xc6\xe2\xb0\x2d\xba\x9e\xa0\xba\x5d\x07\xdc\x53\x5c\x6b\x8c\xce\x28\xf9\x51\x1e\x8f\xc0\xce\xe8\x3c\x8d\x50\xed\x7a\x68\x39\x48\xf1\x2e\x83\xbd\xd1\xf5\x04\xd4\xec\x3a\xc0\x9e\xc2\xaa\x5d\x97\x73\x83\x38\xc6\x63\x7b\x74\x9e\xf2\x8a\x5d\x0f\x67\xdb\xe1\x90\x22\x9a\xdc\xa8\x0d\xac\xb4\xb7\x57\xd9\x75\x0c\xf0\x34\xe0\x92\x5d\x57\x6a\x80\x48\x29\xc6\x6a\x65\x74\xbb\x1e\x30\x1c\x5b\x24\xa3\x9b\xa4\x0e\x13\xd1\x15\x76\x1d\x79\x4f\x79\x54\xca\x22\x8c\x56\x61\x10\xca\x42\xac\x56\x44\xb5\xeb\x81\xc2\x41\x46\x30\xbb\x49\xe5\x30\x21\xcd\xdb\x75\xc4\x3d\xc5\x41\x24\x0b\x30\x5a\x7e\xd4\xc8\x22\xac\x56\x42\xb3\xeb\x01\xc2\x71\x46\x34\xb9\x49\xe3\x30\x2b\x5d\x63\xd7\x31\xc0\xd3\x00\x54\xc9\x43\x8c\x56\x63\xd0\xc9\x63\xac\x56\x46\xb7\xeb\x01\xc3\x21\x47\x30\xbb\x49\xe9\x30\x3d\xcd\xdb\x75\xc4\x3d\xc5\x41\x27\x0b\x30\x5a\x7e\x54\xc9\x22\xac\x56\x42\xb3\xeb\x78\x8c\x2f\x68\x64\x9e\xb8\x43\x02\x37\x71\x85\x5d\x1f\x23\x7c\x1e\xa1\xda\xf5\x0b\x55\x40\x2c\x0b\xb2\x97\x0a\x89\x76\x7d\x01\xe7\xd8\x56\x87\xc2\x86\xa3\xe9\x77\xe5\x63\x38\xc3\x97\x9f\x20\x48\xcf\x50\x54\x8f\x42\x7c\x70\xe7\x63\x38\xce\xb7\xa8\xbe\x55\xa7\xae\x8d\xf5\x1e\xf0\xf8\xe4\x0f\x1f\x1e\x4c\xb9\xff\xfa\x14\xcf\x68\x0e\xf7\x95\x38\x37\xbb\xc9\x49\x78\xcb\x4f\x6f\x8b\xae\xf2\x5d\x51\x9f\x4f\xe7\x78\xba\xe0\xeb\xe3\xf9\xd4\x15\x8f\x65\x7d\xb4\xfd\xee\xbf\xfe\xe3\xb7\xf3\xe9\x5c\xfc\xb3\x7a\x7a\xb1\x65\x73\xfb\x5b\x75\xb2\xe7\xdb\xdf\xce\xa7\x72\x7f\xbe\xfd\xfb\xf9\xd4\x9e\x6d\xd9\xde\x7e\xf8\xc7\xd1\x54\x4d\x19\xf6\x7e\x13\xf0\x0f\xb7\x1f\xfe\x7e\x7e\x69\x8e\x55\x73\xf3\x9f\xd5\xf7\x0f\xb7\x43\xea\xe9\xf3\x16\x8b\xc2\x31\xd6\xaf\xf1\x87\x78\xd0\x2f\x3f\xd9\x1a\xd0\x70\xe2\xe1\x2b\x3f\x73\x90\x71\xa7\xb3\x40\xe6\x77\x6d\x88\x64\xd7\xbc\x9c\xf6\x65\x57\xe5\xa7\x7b\x3e\xc4\xd5\xe1\xc1\xca\xda\xa3\x6b\x8f\xad\x70\x02\x23\x24\x0a\x17\x79\x2a\x21\x3f\x67\x36\x2e\xc5\xab\x38\xa5\xd8\x41\xb3\x71\x0d\xce\x73\x9e\x70\xec\xa8\x66\xfd\xdc\x83\x18\x85\x87\x11\xcf\x6d\x68\x38\x90\x78\x76\x4f\xe3\x9d\xd2\x2f\x6d\xeb\xc2\x37\x3c\x62\x18\x1e\x4e\x3c\xb7\xaf\xe1\x80\xe2\xd9\x7d\x8d\xf7\x8a\xfc\x83\xfb\xc2\x7f\xcb\x12\xc3\xf0\xb0\xe2\xb9\x7d\x0d\x07\x16\xcf\xee\x6b\xbc\x5b\xce\x1f\xdc\xd7\xf0\x5b\xbb\x18\x87\x87\x17\xcf\x6d\x6c\x38\xc0\x78\x76\x63\xe3\x79\xe1\x17\x37\x06\x2a\xce\xdf\xab\x66\x5f\xb6\xd5\x2b\xbc\x5b\xca\x53\xfb\x78\x6e\xea\xdd\xb0\xc0\xf2\xbf\x38\x27\x87\x0c\x0b\x2c\x64\x5f\xba\x63\x57\xda\xe3\xef\x2c\x66\x5c\x99\x06\xc5\xe6\xf4\x3d\x9e\xfd\x58\xd8\xf0\xe7\xeb\xe4\x91\xdd\x66\xb9\xbc\x08\x57\x0d\xc1\xe1\x31\x2d\x24\x75\x18\x12\x71\xa7\x17\x30\x67\x7b\x20\xec\xfd\x65\x36\xdb\x4b\x7a\x88\x05\xc4\x27\x67\x9f\xc8\xb6\xeb\x6d\xb5\x4b\x8f\x4c\xc1\xf8\xc4\xc5\xee\xf4\x9a\xfa\xfb\x4f\x8f\x8f\x8f\x0c\x70\xcd\xb1\x2e\x9b\x1e\x91\xe5\xf2\xde\x10\xaa\x24\x58\x3a\x26\xf6\x36\x7b\xf0\xf9\xfc\xad\x6a\xc6\x0c\xdb\xcf\x66\xc3\xea\xb4\xd5\xfe\x7c\x3a\x4c\x2a\x7d\xde\xdf\x6f\xef\x0f\xbc\xd2\x00\xd2\x5a\xe3\xc3\xa4\xda\xdd\x97\xbb\xc7\xed\x1d\xaf\xf6\xb2\xdf\x57\x6d\x8b\xd4\xfa\xd7\xf2\xfe\x6e\x2b\xd4\x4a\x58\x56\x09\x1e\x24\x75\x56\x5f\x3e\x7f\x59\xf3\xa7\xf7\x78\x7a\x3c\x0f\xc8\x7d\xb9\x36\xbf\xf2\x22\x81\xa1\x15\xe2\x23\x24\xfd\xf2\xf1\xf3\xe7\x7b\x2e\xe3\x7b\xd9\x9c\x8e\xa7\x27\xa4\x1e\x1f\xf7\xab\xe5\x3d\xaf\x00\x18\x2d\x82\x0f\x92\x3a\xa6\xfc\xd5\xd0\xd7\x5f\x7c\xba\x0e\xe5\xe9\x69\x84\x0e\xfb\xcd\x56\x7a\xb6\x12\x45\xab\xc0\x63\xa4\x48\x79\xbf\x3a\xac\x4b\x56\x24\xbe\xad\x90\x79\xfc\xf5\xf1\xcb\x63\xc9\x6b\x44\x88\x96\x48\x0f\x91\x0a\x7b\x73\xd8\x1c\x78\x85\x43\xd9\x7c\x45\x64\x73\xb7\x29\xef\x96\xbc\x40\x60\x72\x09\xcd\x57\x9a\x7e\xb5\x5e\xdd\xad\x3e\xb3\xf4\xe6\x7c\x18\x5e\xbd\xeb\xd5\x7a\xbb\xfe\xc2\x90\xfa\xa5\xab\x0e\xea\x2b\x1c\xd2\xd8\x72\xff\x35\x9c\xc7\xcb\xa6\xae\xc5\xf6\x93\xfc\x06\xce\xe8\xf5\x76\x7b\x8b\xff\x93\x62\x9e\x8f\x87\x2a\xf6\x86\xdd\xf2\x97\xe5\x4d\xf9\x90\x42\x63\x0f\x75\x65\x53\x9d\xba\x34\xcb\x4c\x4e\x33\xe6\xc3\xe0\x94\x1e\x4f\x94\x8e\x71\x87\x6a\x7f\x4e\x73\x5d\x3a\x19\x39\x7b\x90\x9d\xae\x1e\xd7\x4d\x53\x95\x5f\x5f\xbf\x9f\x9b\x43\xfa\xeb\x2e\xfe\x59\x84\x07\x46\x76\x38\xf1\x3a\xde\x38\x4b\x44\x20\x5d\x53\xb5\x55\x07\xcf\xc9\xf1\xf4\x5c\x35\x47\xf2\x09\x08\xe7\x52\xbf\xc6\xff\x1e\xed\xb1\xeb\x77\xf0\xd0\x94\x3a\x9e\x04\x8e\x9d\xe5\x0e\x1f\xc8\xae\x39\x9e\xba\xd7\x9f\x6f\x61\x2e\xbf\xdd\xed\x4c\xf5\x78\x6e\xaa\xd7\xfc\xb9\x1c\x43\x1f\xf4\x33\xa3\xcb\xdd\xe9\xdc\x7d\x5c\x98\xee\xf4\x89\x3d\x7f\x2f\xa7\x43\xd5\x84\x7b\x65\xbc\x95\xc6\x34\xff\xd3\x1d\x3b\x5b\xfd\x2f\xda\x81\x61\x80\xbf\xf9\xf8\xe1\xa6\xec\xba\xe6\x63\x5c\xff\x74\xf3\xe1\xd3\x87\x37\xd7\x54\x64\xc4\x75\x4d\x55\x64\x43\xae\xb1\xe7\xfd\xd7\xff\x7b\x39\x77\xd5\x6d\xa0\xe1\xd2\xae\x9c\xbf\x69\xcf\xf6\x78\xb8\xf9\xa9\x3c\x98\xad\x39\x3c\xb8\xf2\xa9\x4a\x57\xaa\x38\x9e\xda\xe3\xa1\xda\x95\xdf\xce\xc7\xc3\x5b\xf7\x5c\x95\x87\xd7\xc3\xb1\x75\xb6\xec\x77\x5d\x69\x6c\x55\x84\x87\xaa\x26\xdc\xe0\xeb\xc5\xbd\x1d\xeb\xa7\xdb\xae\x79\xd5\xe2\x9f\xd7\xb7\xcf\x9b\x5b\xf7\x7a\x6e\xdc\x73\x79\x6a\x77\x9b\x70\xc6\xf8\xf9\x7b\xbb\xdb\xa4\xa5\x69\x60\x54\x0c\x71\x7f\x0b\x8f\xbf\xb6\xc7\xdf\xab\x5d\xb9\x79\x8b\x6f\xc5\x6c\x72\x9b\x88\x5c\x84\x67\xa9\x3c\x9e\xaa\xe6\x12\x74\x2a\xbf\x99\xb2\x19\xb4\x84\x6b\xf7\xb6\x30\xe5\xe1\x49\x7a\x5a\x96\xcb\xe5\xdb\x22\xca\x85\xc5\x60\x9a\x6c\xe9\xda\x6a\x87\x7f\x99\xe6\x8e\xe4\x4d\x77\xb8\xc5\xbf\x3d\xbf\xb2\xb7\x18\xfb\x3c\x0e\x64\x91\xb2\x57\x87\x31\x78\xf2\xd0\xb3\xb0\xb3\x43\x55\xad\x2b\xda\xad\x62\xd4\xa4\x1b\xc2\x3b\x64\xba\x72\xd3\x85\xe7\xf0\xdf\xe2\x9f\x58\x27\x3d\x3e\x94\x4d\x3f\x3e\x67\x3f\x56\xe5\x64\x1f\xa8\x24\xed\x01\xf2\xdf\x2c\xe2\x8b\x04\x03\xe8\x1e\x1e\xc4\xc0\xb7\x7f\xf9\xe5\xe7\x9f\x6e\xda\xf3\x4b\xb3\xaf\x7e\x2b\x9d\x3b\x9e\x9e\xfe\xfb\x9f\xff\xf8\x77\x73\x3e\x77\x6d\xd7\x94\x6e\x51\x1f\x4f\x8b\x7d\xdb\x2e\xea\xd2\xdd\xfc\xfc\xcb\xff\x0f\x00\xa2\x01\xc0\x2b\x2e\x72\x02\x00")
 
 func libBootstrap452MinCssBytes() ([]byte, error) {
 	return bindataRead(
@@ -144,7 +144,7 @@ func libBootstrap452MinCss() (*asset, error) {
 	return a, nil
 }
 
-var _libBootstrap452MinJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\xbd\x6b\x7b\xdb\x36\x96\x38\xfe\x7e\x3f\x85\x84\xce\x9f\x21\x6a\x98\x96\xdb\xce\xce\x8e\x14\x46\x4f\x2e\xee\x36\x3b\xb9\x4d\xec\x74\xda\x55\xd5\x3c\xb4\x08\x49\x68\x28\x40\x43\x42\x76\x3c\x96\xf6\xb3\xff\x1f\x5c\x09\x90\xa0\xa4\xa4\xcd\xbc\xf8\xbd\x49\x2c\x00\x04\x0e\x80\x83\x83\x73\xc7\xd9\xd7\xfd\xff\xe8\xf5\xbe\xee\x3d\x61\x8c\x57\xbc\xcc\xd6\xbd\x9b\xef\x92\x3f\x27\xdf\xf4\xe2\x25\xe7\xeb\x6a\x78\x76\xb6\xc0\xfc\xda\x54\x26\x33\xb6\x3a\x83\xf2\x83\xa7\x6c\x7d\x57\x92\xc5\x92\xf7\xbe\x19\x9c\x9f\x9f\x7e\x33\xf8\x66\xd0\xbb\x5a\x62\xa7\xa3\xc7\x1b\xbe\x64\x65\xe5\xf4\x44\xf8\x72\x73\x2d\xfb\xe0\xb7\xd7\xd5\x99\xed\xf6\x6c\x51\x66\xeb\x65\x75\x36\x63\x94\x97\xe4\x7a\xc3\x59\x59\xa9\x51\x5e\x90\x19\xa6\x15\xce\x7b\x1b\x9a\xe3\xb2\xf7\xf2\xf9\xd5\x31\xdd\x5d\x17\xec\xfa\x6c\x95\x11\x7a\xf6\xe2\xf9\xd3\x8b\x57\x97\x17\xb2\xb3\xb3\xff\xe8\xcf\x37\x74\xc6\x09\xa3\x31\x47\x18\xde\x03\x76\xfd\x1b\x9e\x71\x90\xa6\xfc\x6e\x8d\xd9\xbc\x87\x3f\xae\x59\xc9\xab\x28\x02\x62\xb8\x39\xa1\x38\x07\x7d\x53\xb9\x62\xf9\xa6\xc0\x63\x1c\xeb\x56\xa8\xc4\xff\xdc\x90\x12\xc7\xe0\xb7\x7f\x6e\x70\x79\x07\x60\x5d\xb2\x66\xeb\x35\x2e\x93\xdf\x2a\x00\xe1\x10\x98\x51\xeb\x81\x54\xe7\x51\xa4\xfe\x4f\xb2\x55\x3e\x56\x7f\xc6\x13\xa0\xbb\x07\xc8\xf4\x8b\x9c\xee\xa6\x08\xc3\x21\x8e\x63\x9e\x86\x40\x5c\x14\xec\x3a\x2b\xae\x96\xa4\x1a\xd7\x7f\x0e\xf9\x76\x5b\xe1\x62\x0e\x13\xbb\x40\xe9\xfd\x0e\xf1\xe4\xb7\xbf\x8b\xee\x11\x4f\xde\xc8\xee\xe1\x2e\xe6\x4b\x52\xa1\xd8\x5d\x24\x44\xe1\x3d\xd8\x54\xb8\x57\xf1\x92\xcc\x38\x18\x99\xca\x1e\x51\x6b\x38\x67\x65\x7c\x93\x95\x3d\x9a\x0e\x46\xf4\x21\x4e\x0a\x4c\x17\x7c\x39\xa2\x27\x27\xf0\x5e\x94\x93\x14\x4f\xe8\x74\x44\x12\x4c\x37\x2b\x5c\x66\xd7\x05\x4e\xdd\x1f\xdb\x6d\xff\x1c\x91\x64\xc6\xe8\x9c\x2c\x36\xaa\xbe\x3f\x40\xe0\x26\x2b\x36\x18\x10\xda\x23\x51\x14\x93\xe4\xb6\x24\x5c\xd7\x41\xf4\x5a\x6e\x5b\xa2\xa6\xff\xa6\x64\x6b\x5c\xf2\xbb\x98\x23\x92\x7c\xc0\x77\x88\xc0\xdd\xce\x42\xc9\xcc\x24\x4a\xcc\x37\x25\xed\xe1\x28\x22\x31\x4f\xd6\x25\xe3\x4c\x2c\x1a\xc2\x10\x51\x59\x86\x28\x44\xbc\xfe\xb0\x8a\xcd\x37\x71\x95\xea\x01\xb3\xaa\x22\x0b\xba\xdd\xd6\x0b\x54\xcf\x1f\xa7\xe7\x23\xfc\x30\x2b\x17\x9b\x15\xa6\xbc\x32\xeb\x80\xcd\x3a\xd0\xd4\xd6\x4d\xf0\x74\x64\x3e\x23\x3d\x42\x7b\x14\xea\x01\x2c\x58\xc9\x32\xab\x5e\xdf\x52\x33\xb7\x64\x96\x15\x45\x4c\x11\x81\x51\x14\xf3\x09\x99\xa6\x74\x42\xa6\x70\xa7\x27\xc5\x77\x30\xc9\xd6\xeb\xe2\x4e\x6d\xa0\x1d\x08\xee\x70\x8a\xa3\xe8\xa8\xce\x31\x02\x39\x9e\x67\x9b\x82\x03\x38\xc6\x89\xfe\x7b\x88\x11\x4d\xe9\x91\x5d\x50\xb7\x0b\x6a\xbb\xa0\x35\xce\x94\x62\xc5\xd4\x6a\x48\x48\x49\xda\x3f\x1f\x99\x9d\x91\xc0\xc3\x84\x51\x1c\x67\xc9\xd5\xdb\xc7\xaf\x2e\x9f\x5f\x3d\x7f\xfd\xea\xfd\xc5\xab\x67\x0e\x4e\xc2\x7b\x92\xf6\x07\x3b\x08\x51\x85\xf9\x15\x59\x61\xb6\xe1\xb1\x57\xbd\xdd\x66\x09\x2f\xc9\x62\x81\xcb\xab\x32\xa3\x15\x11\x15\x17\x34\x8f\x29\xdc\x41\xc4\x21\x12\xe3\xec\x04\x14\x59\x7a\xef\x8f\x33\x04\xd7\x95\xf7\x0d\x40\x0b\xcc\xdf\x3d\x7f\x36\x74\xb7\x3c\x67\xf7\xfc\x24\xfd\xbf\xff\x8b\xcf\xf1\x7f\x7e\xfd\x32\xe3\xcb\xa4\xcc\x68\xce\x56\x31\x84\xbb\xdb\x25\x29\x70\x9c\xb3\x99\xdc\x81\x64\x81\xf9\x45\x81\xc5\x9f\x4f\xee\x9e\xe7\x31\x87\x70\x64\xf7\x4c\x74\x7d\x89\x0b\x3c\xe3\xac\xfc\xbe\x64\x2b\xdd\xd0\x1b\x4a\x61\x96\xec\xe7\x31\x57\xa4\x11\xc7\x20\xcf\x78\x76\xca\xb3\x72\x81\x39\x80\x23\x32\x8f\xfb\x78\xbb\x05\x5f\x81\x34\x4d\xb1\x5d\xdf\xc6\x37\xcb\x12\xcf\x01\x1c\x61\xb1\x9b\xe0\x2b\xd0\x4f\x53\x3a\xa6\x62\x9d\x56\x31\x1c\x02\xb0\xe3\xe5\x9d\x39\x23\x16\x7a\x49\x7e\x0c\x88\x31\x86\x63\x3c\xa4\x9b\xa2\xd8\xcd\x32\x3e\x5b\x0a\xf0\xf4\x07\xb2\x50\xce\xa7\x5e\xbc\x67\x9b\x32\x13\xff\x77\xcd\x4c\x40\xcd\xa1\xee\x60\x30\x52\x40\xe3\x98\xc3\x64\x56\x55\x31\xe0\xb6\xa3\xd3\x5c\xf7\x04\x20\x22\x1d\x2d\x70\x91\x09\xf2\xcb\xd2\x75\x56\x56\xf8\xfb\x82\x65\x3c\xa6\x10\x55\xee\x6f\x62\x97\x9e\x6d\xb7\xd5\x38\xa6\x29\x4d\xaa\x75\x41\x78\x0c\x10\x80\x93\xc1\x14\x91\x94\x34\x4a\xce\xf1\xb7\x5f\xc7\x5e\x9f\x27\x5e\x8f\x10\x0e\x07\x3b\x54\xe2\x79\xc1\x6e\xbd\xd9\x99\x4d\x4e\xd8\x7c\x5e\x61\xfe\x03\x16\x17\xe5\x0e\x85\xd0\xd2\xfb\x4e\x4e\x4f\xb7\x72\xa7\x88\x69\x0e\xe0\x0e\x55\x9b\xb5\xbc\x1b\x3a\x3a\xb0\xe3\x3e\x61\xac\xc0\x19\x0d\xf4\x40\xaa\xd0\x66\x68\x42\xc7\x27\x83\xe9\x76\xcb\x61\x42\x59\x8e\xaf\xee\xd6\x78\x87\xc4\x61\x7f\xba\xc4\xb3\x0f\x4f\x25\x81\x1e\x36\xef\x86\x06\x1d\x23\xf3\xf8\x68\x52\xa6\x30\x95\x49\x52\x86\xaa\x14\x8b\xff\xca\xb4\x8a\xa2\x2c\xb1\x50\xc6\x15\x1c\x03\xac\xfe\x06\x12\xf9\xd2\x34\x8d\x8b\xb4\x82\xdb\xad\x73\x01\xda\x7b\xb5\x18\x03\x70\x52\x0c\xef\x77\x09\x67\x97\xbc\x24\x74\xa1\xc6\x2b\x60\xb2\x92\x48\x7b\xf6\x4b\x15\x4f\xb2\xd3\x7f\x4d\x4f\xe0\x19\x81\x93\xf3\x69\xc2\xd9\x0b\x76\x8b\xcb\xa7\x59\x85\x63\x75\x98\x28\xbe\xed\xbd\xc5\x8b\x8b\x8f\xeb\x98\xc1\x84\xe3\x8a\xc7\x25\x84\x7c\x59\xb2\xdb\x9e\xa8\xbb\x28\x4b\x56\xc6\x3c\xe1\xec\x9d\xb8\x37\xd5\x97\x27\x0f\x86\xbd\xd7\x6b\x49\xe5\xc0\x83\x13\x72\xf2\x00\xf4\xd6\x25\xbb\x21\x39\xce\x7b\x02\x38\x51\x5a\x8a\xd2\xeb\x0d\x17\x7c\x06\x9e\x71\xa7\x86\x9d\x3c\x00\xc9\x03\x28\xa9\x52\xb1\x43\x73\x42\xf3\xcb\x65\x96\xb3\xdb\xb7\x8c\xb5\xcf\x8d\x3d\xa0\xe6\x0f\xbd\x5a\x49\xc6\x79\x36\x5b\xaa\x2f\xa1\x73\x38\xc5\xb4\x02\x5c\x88\xa4\x11\x62\x84\x57\x2c\xc7\x1e\xb1\x31\x85\xb1\x3d\x34\xb8\x47\x68\xc5\x33\x3a\x13\x1f\xd6\xb0\x19\x92\x60\x10\xbe\xa3\x15\x1f\xf2\x64\x9d\x95\x98\xca\x5e\xc7\x59\xe2\xcf\x30\x76\x6b\xa1\xea\x11\x29\xf6\xe4\x19\xe6\x58\x82\xed\xa2\xb9\x98\x4e\x60\xf7\xb1\xb3\x49\x02\x7d\xd5\x46\x01\xcb\x91\x3e\xa8\x7a\xff\x93\xdd\x64\x97\xb3\x92\xac\x79\x4f\x33\x6b\x55\x4f\x0d\x94\xe8\xff\x7b\xab\x4d\xc5\x7b\xd7\x62\xbe\xb3\x62\x23\xb6\xef\x1a\xcf\x59\xe9\x30\xb6\x5e\x37\x09\x80\x92\x78\xf1\x14\x27\x73\x9a\x28\x96\xcd\x90\x91\x9e\x24\x23\xe6\x57\xa2\x88\xb5\x38\x65\x0f\xbf\x89\x22\x3e\x39\x9f\x3e\xfc\xeb\x76\x7b\x9e\xa6\xa9\x28\x8b\xa2\xbf\xca\xbf\xce\xa7\xa2\xee\x9b\xe9\xc3\xf3\xed\x56\x94\x3f\x4a\xbf\x6b\x21\xdf\xc1\x39\x65\xbc\x57\xe0\xac\xe2\x66\x52\x37\xe7\xc9\x5f\x93\x73\x89\x7c\x05\xae\xaa\x1e\x5f\x66\x54\x70\xfa\x83\x64\x00\xe0\x6e\x37\xca\x92\xc6\x72\xc7\x10\xc9\x09\xe1\xd5\xa6\xc8\x38\xf6\x28\x4e\x5a\x22\x9c\xe0\x1b\x81\x71\xd5\x1a\xcf\x48\x56\x4c\x9a\x37\xf6\x34\xbd\xbf\x26\x34\x17\xbb\x30\x6c\x50\x21\x94\xe3\x02\x2f\x44\x9f\xa1\xca\x65\x46\xf3\x02\x37\x51\x1e\x8b\xe3\x26\x2f\x3c\x98\x90\x4a\x71\x0a\xd0\x12\x59\xf5\xcd\xeb\xeb\xdf\xf4\x5f\x65\x07\x37\xb4\x93\x3b\x55\xa4\x20\x2b\x70\xc9\x01\x9a\xc9\x3d\x9b\x14\x53\xb4\x4c\x1d\xec\xb2\xec\x0a\x17\xa3\x8b\x4e\x92\xf7\x9a\x06\xa5\x7c\x67\xae\x57\x4b\xe0\xcc\x09\xa1\xc9\xac\x60\x15\x4e\x03\xf7\xb7\xdb\xc5\x88\x47\x51\x6c\xca\xf4\x51\x33\xd4\x8e\x43\xc5\x9d\x24\xef\xf5\x1d\xf0\x54\xf4\x78\x21\x56\x3a\xc6\x62\xe6\xcf\x14\x4f\xf5\xa6\x94\xab\x8f\xf3\x18\x6e\xb7\xea\x83\x12\xaf\xd8\x0d\x36\x1d\x61\xb8\x43\x34\xc9\x49\xb5\xf6\x20\x82\xf7\x38\x51\x0d\x9f\x65\x3c\x8b\x3d\xb8\x10\xb8\xae\x12\xb5\x30\x06\x08\x33\x69\x75\x1e\x69\x13\xdc\xd6\x4c\x69\x9a\x25\x61\xa6\x26\xe6\xd0\x63\xf6\xa8\x60\xea\xd3\x0e\x46\x83\x42\x88\xc8\x76\x1b\x9b\xdb\x5e\xac\x41\x25\xce\x8f\x86\x6e\x32\x98\x42\x44\x24\x40\xad\x65\x0a\xc0\x84\x13\xb5\x80\x40\x76\x94\xd4\xb3\x74\x38\xcf\xfa\xd2\xa5\x10\x51\xd9\xb5\xb7\xa0\x81\x6e\xc5\x12\x8d\x14\x6a\x42\xbd\xa8\x4f\x8b\x4c\x70\x26\xd5\x92\xdd\x02\x88\x64\xc5\x32\xab\x74\xe9\x3c\xcb\x31\x80\x46\x28\x92\x0b\xb5\x97\x5b\x8a\x39\x1c\xc9\x2e\x0e\x30\xc4\xb8\x66\xc3\x92\xf7\x39\xae\x78\xc9\xee\x6c\x17\x08\xc3\x1d\x84\xc1\x43\x1c\x13\xb8\xc3\x45\x85\x7b\x6a\xaf\x9b\x5f\x4a\x04\x6a\x94\xa6\x2d\x56\x25\xc7\xe2\xc2\x89\x1d\x9e\x45\x2e\x72\xee\xac\xb2\x5e\x9b\x18\x0a\x91\xf3\xbd\xa2\x32\xcf\x29\xc7\xe5\x3c\x9b\x39\xa8\x59\x8b\x68\x12\x1e\x2c\xba\x75\xd9\x7a\x2d\x4b\xaa\xa3\x8f\x58\x4a\x12\xc1\x05\xc7\x0e\xd2\x8e\xd8\x76\x1b\xb3\x54\x10\x49\xae\x9b\xb5\x1a\x21\x06\x21\x52\x30\x0a\x6e\x99\x46\x11\x9b\xd0\xa9\x6a\xbd\x83\x0a\x44\x45\x43\x9e\x91\x6a\x45\xaa\x2a\x0d\x70\x75\xee\xd2\xe3\x28\xc2\xc9\x5a\x1d\x47\x7d\x38\x63\x88\xb8\x42\x5a\xdd\xef\x0e\x09\x29\x54\x1c\x22\x34\xb9\xff\x80\xef\x86\xe0\xc7\x8b\xb7\x97\xcf\x5f\xbf\x92\xd2\x45\x9b\x7d\x03\x52\x01\x03\x76\xbb\xa9\x90\x48\x63\x81\x06\xe6\xa4\x08\x6c\x10\x6b\x4c\x66\x1f\xec\x12\xcb\x39\x9e\x66\x6b\x02\xd0\x83\x89\xfc\x3b\xd7\xb0\x6b\x3a\x37\x7d\x80\x96\x8d\x69\xc5\x62\x95\x96\x50\xd1\xf8\x49\x31\x4d\x97\xad\xad\x31\x55\xc9\x53\x46\x2b\x5e\x6e\xc4\xd1\x4c\x97\xb6\x94\x32\xc1\x11\x16\x64\xc6\xd3\x36\xff\x69\x7a\x9d\xa1\x76\xbf\x8a\x08\x6f\xd4\x75\x79\xbd\xe1\x9c\x51\x94\xff\x21\xe4\x97\xb3\xc5\xa2\xf0\xa8\x9d\xba\x98\xfb\x03\x44\xc5\x3f\x06\x7d\x6c\x77\x35\x6d\xd1\x0b\xa7\x7b\x00\x0a\xac\x0a\x4c\x1f\x08\x62\x23\x4e\x39\x31\xdc\xaa\xd7\x41\x83\x70\x3d\x20\x74\xbd\xe1\x43\xca\x78\x3c\x11\xa0\xa5\x60\x49\xf2\x1c\x53\x30\x85\x0f\xe4\xd5\xcf\x14\xeb\x52\x66\x39\x61\x02\xff\x58\x22\x9a\x09\xae\x99\x25\x33\xc1\x65\xe3\x3c\x8a\xfc\x11\x66\x82\x7a\xbc\x20\x15\x4f\x66\x8c\xf2\x8c\xd0\x2a\x06\xd9\x8c\x93\x1b\x41\x4c\xb8\x20\xa9\xe2\x10\x4b\xe0\xaa\x94\x34\x00\x02\x89\x69\x3a\xaa\xa2\x08\xc7\x55\x83\x52\x99\xda\x9d\xb8\x93\x80\x84\xe0\x9a\x7d\x14\xc2\xa1\x82\x2c\x8a\x34\xac\xb6\x44\x1c\x31\x03\x6a\xda\xff\x04\x50\x11\x96\xcc\xb4\x25\x13\xcb\x8c\x2e\x64\x39\x4b\xe6\x6c\xb6\xa9\x62\x28\x36\xe9\x7c\xb7\xf3\xfb\x5c\x66\x95\x2b\xf8\x92\x2a\xbb\x2e\x70\x0e\xec\xb5\xb7\x6f\x6c\xb7\x75\x4c\x9b\x0b\x5b\x79\xe2\x71\x56\x92\xec\x74\x5d\xe2\xaa\xc2\x39\x40\x9f\x34\x31\x2e\x56\xb6\x81\x57\x0a\x91\x1a\xab\xfc\x99\x77\xb2\x42\xc6\x8e\x4b\xf9\x0f\x23\xa9\x35\xa5\x34\xe3\x8d\xd4\x1d\xec\xd2\xd3\xae\xb6\x42\x9a\x43\x40\x4d\x5a\xd3\x55\x22\xe8\xaa\xa2\xa9\x5f\x8a\xfa\xa9\xc1\xdb\xe4\x4f\xc1\xf1\xab\x39\xc6\x82\xfe\xc5\x81\xfb\x5b\x33\x94\x88\xa4\x54\xdd\xe3\xd4\xbd\xae\xaf\x39\x55\x78\x93\xca\x8a\x9a\x09\x91\x15\x92\x05\xe9\xd3\xed\x96\xee\xc1\x50\x7a\x00\x2b\x21\x6f\xdd\x1c\xf5\x61\x66\x29\xfd\x64\xea\x12\x45\x31\xdb\x03\x0f\x3b\x04\x8f\xe1\xa8\x6f\x18\xc9\x7b\x01\xe0\x62\xf0\xe2\xf1\x93\x8b\x17\x82\x1a\x90\x84\x67\x8b\x57\xd9\x0a\x6f\xb7\x2c\x8a\x02\xa4\x03\x46\x51\xde\xc2\x4d\xad\x6a\x14\x4c\x96\xc1\x16\xb8\x13\x0c\x8a\xd8\x57\x49\x06\x02\xfb\xda\xbb\x2e\x36\xe5\xef\xdf\x6f\x57\x84\x68\xef\xe6\x48\xee\xb2\x77\x6c\x25\x3c\x00\x9d\xfd\xaa\xe8\x13\xa1\x70\xfc\xa7\x33\xa5\x0e\xe0\x6a\x8a\x02\xbd\x11\x8e\x6f\x09\x15\xf2\xb6\x9c\x44\xc1\xb2\x3c\x08\xab\x7b\xf0\x8c\xd2\x84\xa7\x93\x69\x52\x15\xc4\x2c\x4c\x98\x1f\x7e\x5c\x14\x9d\xf7\x53\x4f\x4c\xe0\x81\x80\x22\x15\x97\x1c\xb7\x6a\xe7\x87\xb4\x56\x3d\x93\x94\x4f\xf0\x54\xf2\x4c\x9f\x82\x51\x96\xce\x0b\xcc\xf1\xb1\x4a\x57\x00\x38\x26\x0e\x4e\x65\x79\x5e\x13\xbb\xa1\x5b\xa3\x59\xc0\xfa\xbe\x31\x2b\x50\xa5\x03\x54\xa6\xf1\xef\x5c\x08\x71\x4f\x43\x68\xe6\x5e\x3d\x2c\x47\x95\x99\x7b\x96\xf2\x49\x35\x1d\x01\x5e\x6e\x24\x65\xca\x1a\x6a\x51\x8f\xee\xc3\x71\xd6\x39\x9d\x6c\xdf\x74\x76\x9a\x93\xd2\xdb\x9e\xb6\x31\xdf\xad\xf6\x38\xaa\xdc\xab\x39\xc8\x55\x99\x11\x36\xa8\x3d\x86\xe2\xac\xe6\x29\x98\x65\x25\xdb\x54\xb8\x00\x68\x91\x02\x81\x8d\x75\xc1\x4a\x89\xbc\xf3\x29\x5a\xa7\xf7\x44\x7c\x79\x93\x15\xc3\x3f\xe3\x6f\xd1\x07\x7c\x77\xcd\xb2\x32\x1f\xf6\x07\xa8\x2a\x48\x8e\x87\xfd\x73\xb4\xce\x36\x15\x1e\x82\x25\xbb\xc1\x25\x40\xb7\x65\xb6\x16\xd5\x9c\x6d\x66\xcb\x61\x7f\xb0\x43\xef\x9d\x4e\x40\x4c\x37\xab\x6b\x5c\x6e\xaf\x95\xf6\x11\x82\xba\x4f\xa0\xcb\x80\xee\x1a\xc4\xba\x60\x5b\x49\x2d\x1d\x04\x66\xa8\x58\x15\x38\x9d\xc8\x51\xeb\x0e\xd4\xe0\xf6\xf7\x0e\xdd\xa4\xf7\x57\xaf\xdf\x3d\xfd\x61\x08\x64\x15\x40\x6f\x2e\x5e\x0d\xc1\x1a\x8b\xba\xeb\x2e\xc6\x12\x61\xc3\x5a\x12\x8e\x57\x95\xbc\x4d\xf5\x05\x6b\x26\xe4\x96\xa9\x9d\xbe\x70\xae\x5e\xd3\xb8\x7a\x23\xe0\xce\xd3\xfe\xb9\x2d\xb9\x2c\x48\x4e\xe8\xc2\x16\x49\xb0\xb4\xa9\xc2\xf9\x56\x16\x5f\xf2\xac\xe4\x3f\xa5\x03\xa7\xe8\x19\x2e\x78\x66\x8b\xde\x2b\xcb\x58\xad\x2f\x50\x8a\xd8\x18\x37\xf9\x01\x6e\xc1\xcf\xc9\x2c\xe3\xac\x34\x3a\xd4\xbd\x2c\x2b\xb0\xb8\x71\x5a\x7f\x68\x79\x0d\x05\xa2\x52\x3a\xe3\x3c\x05\x8c\xca\x92\x4a\x00\x0d\x88\x63\x29\x68\x28\x22\xb7\x5b\x9a\xdd\x90\x85\xe8\x2c\x59\x65\x1f\xaf\xc4\x47\x6f\x18\xa1\xbc\x7a\x64\xa6\xb5\x66\x72\x9d\x95\xd0\x6e\xf4\xd5\x8a\x92\x26\x6f\x9c\xba\xed\x56\x17\xbe\xbc\x74\x8b\x0d\x84\x59\x9e\xcb\xdf\xe2\x5c\x62\x8a\xcb\x2a\x86\xfb\x44\x04\x8a\x3f\x7a\x27\xab\xb1\x65\x86\xab\x94\x48\x1a\x03\xd1\x1a\x48\xae\x4d\xfc\xf5\x8f\x25\xa6\x3f\x92\x8a\x5c\xfb\x32\x46\xad\x8d\x55\xc4\x33\xc0\x0d\x92\x2a\x06\xc3\x1b\xf5\x29\x80\x51\x64\xc8\x6c\x3f\x0d\x48\x24\xe2\xea\x91\x6d\x49\x41\xf8\x9d\x68\x2e\x5b\x08\x08\x62\x09\x8b\xb8\x96\x8f\x9f\x83\x68\xad\xe6\x20\x0f\x98\x27\xcf\xf2\xed\x36\x6e\xa2\xf1\xa0\x81\x57\x7b\xf0\x85\xe3\xd5\xa9\x00\x0b\xf5\x1a\x85\x6a\xcc\x28\x8a\x3b\x0c\x71\xfe\x94\xd5\x78\xb3\xbb\x59\x81\xe3\xfe\x00\x42\x34\x2b\x70\x56\x3e\xd7\xa7\x30\xf6\x0f\x25\x0c\x1d\x52\x31\x3b\xf9\xfd\xc1\xd9\x9d\x37\xbf\x8f\xa2\xf8\x93\x87\x83\xde\xc9\x4c\xea\xae\xfa\xfe\x70\x51\xd4\xe8\x2d\xad\x30\xb7\x03\xd5\x97\x5c\xbd\xdb\x97\x3c\xe3\x78\x6c\xf7\xdb\xc1\xb8\xa1\x2d\x84\xc9\x35\xd1\x6b\xd8\x01\x88\x12\x34\x38\xeb\x52\x5a\x85\x48\xda\xfe\x3d\x57\x6d\xfd\x5d\xd6\x7a\x6f\x52\x53\xa6\xe7\x1c\xaf\x9e\xd3\x1c\x7f\x8c\x03\x23\x28\xd3\x4a\xcc\x1f\x39\x54\x57\xdf\xd8\xa7\xe7\xdb\x2d\x7f\x38\x80\x42\x0e\x6e\x20\x33\x6c\x9d\x0f\x46\x71\x0c\x04\x6e\xfb\xf7\x5a\xdc\xbe\x2e\xc5\x12\xc4\x5c\xf0\x68\x8a\xab\x16\x52\x7c\x9a\xa6\xd6\xe8\x28\x3b\x96\x87\x22\x86\x48\xb1\xbc\x35\x22\xaa\xc9\xb1\x94\x3f\x22\x63\x45\x07\x86\xea\x28\x8d\xdc\xd3\xc5\x90\x33\x9b\x09\x9f\xc2\x5d\x97\x88\xd7\x9a\xc6\x7c\x1e\x2f\x04\xd3\xb0\x57\xf4\xb3\xf3\x83\xa8\xe3\xb2\xd2\xb7\x83\x53\x82\x03\x97\x54\xe0\x46\xb3\x67\xc2\x2b\x33\x57\xd7\x51\x57\x5f\xeb\xa2\x71\xf5\xc4\xea\x9a\x0a\xa9\xcf\x78\x5a\xc5\xf7\x3b\xb4\x46\x1c\xa2\x2c\x69\x18\x18\xe3\x39\xe2\xe8\xbd\x90\xfc\x44\x3f\x4a\x67\x75\x79\x4b\xd6\x01\xb5\x8e\xb4\xbc\x67\xd7\xca\x1a\xe0\x5e\x9d\x06\xd5\x1e\xa6\xdf\x0d\xa0\xd5\xc1\x9f\x35\x9b\x8d\x02\x57\x2e\x7e\x34\xd0\xf4\x56\x6c\x76\x0c\x11\x7e\x38\xf0\x09\xb0\x84\xab\x75\xeb\xb4\xa1\x73\x4e\x9a\x3e\x9e\x86\x19\x0a\x5c\x10\x42\x72\xf8\x80\xef\x72\x76\x4b\xbb\xd0\x1a\x3b\x46\xe5\xf7\xba\x6d\x2c\xb5\xbb\x48\xb3\x67\x02\xb7\xdd\xf1\x24\x6a\x77\x0c\xb6\x12\xfd\x63\x81\x16\xc7\x8c\xa7\x0e\x89\x52\x25\xdb\xaf\x0b\x9c\xdd\xe0\x63\xbe\x56\x07\x4a\x81\xea\x01\x28\x97\xde\xa8\x65\xb2\x3c\x97\x6c\x42\xeb\x36\xd7\xeb\x1d\xa8\xec\x58\x74\x4b\x45\x7c\xee\xc5\x90\x40\x17\x46\xee\xf3\x21\x51\x74\x33\xc1\x09\x2b\xc9\x82\xd0\xac\x90\x45\x89\xae\xbf\xba\x5b\x63\xdf\xe0\x3b\x1d\x73\x8f\x85\x6b\x7e\x38\x2b\x08\xa6\xfc\xa7\x61\x63\x0c\x71\x29\xed\xfd\x4e\xd6\xe1\x6a\x32\x98\x9a\x2e\xe0\x0e\x91\x3f\x0e\x6c\x71\x2b\x79\x58\xdf\x01\xf8\xa9\x07\x26\xac\x35\xe3\xf2\x38\xc6\x1e\xda\x35\x71\x2e\xe6\x96\xb0\x72\x8f\xfd\x8d\x22\x79\xdb\x1a\xbf\x1d\xbf\xb2\xd9\x38\x0d\x7a\xf8\x84\x51\x0b\xfd\x79\x30\x38\xe1\xa1\xab\x70\xd4\x38\x00\x6d\x99\xb2\xc1\xd3\xf4\xc8\x6a\x01\x34\xa6\xe7\x65\xb6\x90\xdc\x6e\x17\xa2\xbb\xbe\x1e\x4d\x7d\x49\x8d\xf0\xee\x6e\x8d\xe3\xe0\x89\xd4\x4d\xf6\x91\x00\xc7\xe1\x46\xdd\x6b\x68\x5f\x4f\x9b\xf5\xe1\x7e\x88\xee\xa7\x4b\xdd\x29\x45\x60\xdd\xdf\xa9\x9c\x1c\x80\x70\x18\x9e\x40\x2d\x19\xfc\x4e\xf8\x65\x47\xe2\x52\x3c\x4c\x5c\x3c\x4b\x4c\xf8\x1c\x45\x51\x47\x85\x66\x3e\x1e\x9d\x8f\xfd\xe3\x30\x18\xee\x3f\x1e\xed\xf3\xe9\x9f\x94\x9d\x26\x75\xdd\x73\xc3\xb4\x93\x79\x69\x6d\x8d\xbe\x70\x34\xc1\x4f\x9b\x9e\x20\x67\x52\x83\xb3\xe5\xf8\x23\xcf\x4a\x9c\x9d\x11\xab\x9f\x92\x9a\x2e\xa3\xa4\x83\xb0\xba\x25\xd2\x67\x2b\xb9\x5d\x92\xd9\x12\xde\xcf\xb2\x0a\xf7\xbe\xfd\xcb\xb0\x8d\xb5\xc8\xb9\xfe\x46\xd7\x25\xce\x3e\x8c\x54\xe3\xbf\x76\x36\x76\xaf\x46\x97\x0f\x0c\xde\xfe\x0e\x1f\xc3\xa3\xc8\x73\x0b\xf1\x95\x40\x6e\xd5\xc1\x43\x2b\xf0\x72\x32\x75\xb9\xa4\x84\x08\x10\x5e\xcf\x8d\x29\x53\x03\xf6\xe4\xee\x19\x29\x95\x8b\x43\xea\x7b\x03\xab\xeb\x41\x31\x7b\x82\xa8\x21\x92\x2a\x96\x4f\xfe\x60\x21\x3e\x17\x43\x54\xa5\x21\x86\x56\x5c\x42\x31\x89\xa2\x41\x9a\xa6\x6c\xbb\xa5\x51\xc4\xd2\x34\xad\xa0\x95\x11\x34\x9d\xba\x2d\xb3\xb5\xe1\x47\xb1\x64\x39\xcb\x34\x66\x27\x71\x3d\xf0\xf8\xf4\x7c\x78\x0e\xe1\xff\xd7\x1e\x45\x4b\xb5\xa7\xe7\x69\x9a\x96\x63\x8f\x11\x0d\x40\x34\x1d\xba\x2d\xca\xa9\x6b\x3e\x17\x5c\x5f\xcb\x7c\x8e\xa8\xd5\x1e\x06\x18\x7c\x18\x5e\x90\xcf\x11\x23\xc4\x1a\x5a\x1b\xbd\xe4\xab\xfd\xd3\x71\x5f\xe2\x22\xe3\x38\xbf\x92\x28\x3d\xe4\x28\x37\x1b\x38\xa4\x68\x5e\xb2\xd5\x90\x21\xce\x86\x64\x07\x7d\x57\x52\xd7\x1c\xa3\xcd\x4e\x15\x44\x95\x9c\x78\x85\xf9\x63\x09\xcc\x73\xc3\xc4\x86\x4c\xdc\xb5\x3c\xd2\x64\x75\x0d\xb6\x34\x30\x36\xdc\x38\x84\xbc\xd6\x26\xa4\x14\xcf\x41\xab\x9c\x27\x5f\xb5\x7b\x9d\x2d\x49\x91\x97\x98\x4e\x82\xfb\x33\x1d\x11\xc1\xfb\x11\x28\x88\x78\xd3\xdc\xa7\xd6\x40\x2c\x75\x68\xc3\x11\x43\x15\x2a\x95\x87\x6e\xf1\x59\x92\x21\x9a\x85\x90\xa3\x80\x68\x99\xd2\xed\xb6\x30\x3c\x5f\xfb\x48\xc6\x1c\x15\x10\x6d\x42\x5f\x2f\x21\xca\xad\xa2\xa8\x21\xa5\x4b\xff\x36\x7b\x70\xc7\x31\xa9\x75\xa0\x4a\x23\x51\xe0\x39\x07\x88\x35\x8b\xe5\x27\xa8\x4a\x81\xac\x87\xc3\xf6\x87\x32\xc2\x21\xf0\xa5\x3c\x9f\xe2\x4b\xd5\x00\xa2\xa5\x58\xed\xa5\x6b\x41\xaa\xad\xb4\x2d\xbd\xa0\x14\x48\x7b\xd2\x05\xd6\xf5\xf6\xa9\xcf\x61\xbc\x44\x55\xd8\xdf\x27\x8a\x8a\x28\x5a\xb6\xb4\x3e\x69\x7f\x80\x8c\xed\xd8\xb2\x5e\x4a\x52\xed\x42\xf5\x78\x09\xb5\xc2\xd8\x3b\x7d\x7b\x0f\xdf\xd2\x39\x7c\x95\x3a\x7c\x33\x71\xf8\x36\x3b\xa8\x7d\x61\xfc\x83\x57\xaf\x86\xc4\x36\x00\x85\x28\xbc\x74\x70\x92\x09\x19\x50\x39\xd0\x8a\x1d\xc6\x71\xe1\x54\x12\x51\xb0\xf4\x0a\x24\xc4\x0b\xe5\xd7\xfb\x5c\xcc\x21\xe4\x1a\x6d\xf0\x02\x40\x74\x3e\x80\xa3\xc5\x38\xf6\x88\xae\x76\x4c\x37\x9a\x98\x74\x5f\xa5\xd1\xa6\x35\xf8\xca\xb0\xe2\x25\x5d\xc0\x61\xb8\xe2\x33\x86\x90\x33\x5d\x1d\xe3\x28\x54\x08\x22\x52\x1c\xe1\x39\x2f\x17\xd3\x25\x35\xe4\x04\xf4\xc0\x09\x0b\xd1\x08\xb5\x15\x01\xba\x24\x3e\x90\x9f\x11\x88\xca\x86\xba\xbb\xc3\x25\xdf\x92\xe5\x32\x40\x93\xe7\x82\x7b\x1f\x74\xbb\x29\xad\xb4\x9b\x52\x17\x3c\xa0\x89\x24\x75\x79\x40\x21\xdf\x79\x35\xcc\xe1\xc8\x9c\x1f\xad\x01\xda\x7d\x11\xeb\xba\xa3\xd2\x61\x46\x11\xe2\x35\x82\x70\xd4\x8a\x4b\xa2\x51\x14\xeb\xd6\x0c\x51\x08\x35\xa7\x00\x94\xad\xc4\x69\x37\xa6\x43\x96\xc8\xa3\x26\x9d\x56\x1a\x06\x7c\xc4\x02\x26\xfc\xfa\xac\x4b\x23\xbe\xb2\xe1\x38\x5d\x42\x92\x70\x16\x53\x68\x69\x56\x6b\xd4\xb2\xd3\x21\x97\x4c\xca\x69\xd0\x27\xf7\xc1\x2b\xd6\x5b\x61\xbe\x64\x79\x8f\x66\x2b\x9c\x1b\xef\xe8\x07\x70\x24\xbe\x89\xf5\x9e\x33\x47\xb9\xca\x92\x92\xe4\x58\x06\x03\x19\x02\x47\xcc\x56\x59\xdf\x2d\x31\xab\xc7\x6b\xf2\xb4\x20\xb3\x0f\x3f\x28\x5f\x50\x6f\xc7\x1c\xe7\xbb\xa0\x97\xa2\x58\x1a\xcf\xdd\x47\xdc\xa0\xda\x05\x88\x09\x0a\xcf\x5c\x9a\x56\xef\xa5\x6a\x5e\xaa\x2d\x92\xad\xd4\x5e\x36\xb7\xd6\xdc\xa7\x01\xa2\x25\x77\xed\x94\x33\x00\x47\x45\x14\xc5\x65\x4d\x3c\xa4\xd6\xba\xd3\x96\xce\x20\x2a\x21\x2a\x34\x70\x01\x3c\x13\xdb\x57\x40\x6d\x38\xf0\xe4\xd3\xcf\xf7\x4c\x43\xaa\xb5\xee\xaa\xa3\x75\x6f\x7d\x84\x13\x87\x81\xd3\x31\x95\x83\x49\xbd\x20\x53\xd4\x9b\x78\xcb\x33\x05\xe8\x3a\xb8\xcf\x5d\x96\xf8\xc0\x00\x7f\x88\x2d\x5e\x60\xa3\x63\x6a\x9d\x3e\x80\x10\xd1\x74\x80\x48\x6d\x85\xa7\x0f\x49\x1d\x08\x27\x70\x89\x4f\xe8\x14\x8e\xae\x3b\xf6\x92\x21\x66\xf0\xc4\x5a\x94\x27\xf3\x69\xda\x6e\x6f\xaa\x3c\x4b\xf2\xb5\x2d\x3d\xec\x9b\x37\x9f\xa6\x2b\xd4\xee\x57\x59\x90\xef\x52\x30\x63\x45\x91\xad\x2b\x0c\xd0\x85\x32\x18\xdf\x4d\xd1\x6d\x7a\xaf\xcc\xee\xc3\xfe\x00\x29\xd1\x6c\x08\xc0\x0e\x5d\xd9\xf2\xda\x48\x6b\xaa\x8d\x35\xd7\x90\x5b\xb0\x43\x4f\x8f\x30\xc9\x3a\x01\x2e\xae\x21\xb5\x65\xeb\x3c\x64\x1b\xd5\xc4\xfd\x71\x59\x66\x77\x9f\xeb\x62\x60\x97\x62\x3a\x59\x96\x78\x9e\x82\xaf\x1e\x9c\xf0\x84\xe4\x27\x0f\xc0\x14\x75\x36\x75\x42\xb4\xbc\x2f\x1e\x40\x38\xaa\x43\x26\x7f\x37\x48\x02\xe9\x48\x3a\x40\x2c\xa5\x06\xe9\xc8\x43\x36\x22\x06\xe9\x2a\x15\x5d\x53\x76\x93\xbc\x4a\xd0\xa4\x63\xe1\x28\x21\x4c\xe6\xa4\xe0\xb8\x0c\x2a\xe4\xb0\xe0\xbe\x77\x10\x8e\x04\x45\xe9\xa7\x69\x19\x45\x85\x51\xb3\x0c\xac\x35\xac\xd2\x1d\xa6\x65\x60\x97\x92\xf5\xa6\x5a\xc6\x15\x84\xda\x0f\x50\x21\x52\x53\xb1\x2d\xca\xc6\x76\xd7\xdf\xc8\xdf\xb1\x8a\x1b\x41\x81\xa6\x86\xc3\xca\xf2\xfc\x71\x49\xb2\xc7\x34\x7f\xaa\x57\x50\xb3\x0c\xbe\xe5\xa5\x0d\x55\x4b\x71\x2d\xf6\x41\x73\x0b\xea\xc7\x7e\xb3\x73\xdb\x33\x75\x1f\xa7\x2c\xdd\xc7\xd5\xf4\x96\x24\xc7\xb1\x66\x2a\x45\xb9\xd2\x87\x8b\xbf\x9a\xca\x6f\x8a\x88\x16\xc9\x47\xb5\x5c\xd1\x38\x48\x51\xd4\x3f\x38\xae\xdd\x26\xb5\x74\x4a\x85\x11\x87\x25\x5d\xd5\x24\x24\xde\x8a\xbe\x50\x2f\xd1\x88\x2a\xb8\x85\x10\xe6\x58\xad\x50\x8b\xa5\x60\xad\xcd\x0e\x5d\x99\xaa\x0e\x40\xe9\x49\xeb\x7f\x30\x0c\x7b\x6d\xda\x93\x23\x75\x6b\x1a\x37\xa3\x28\xa6\xda\xb0\xdb\x8f\x55\x64\x82\x14\xca\x29\xe3\x0d\x94\x75\xef\x57\xdb\x13\x8c\x22\xd2\x5a\x6a\x08\xcd\x01\xac\x85\xab\x25\xbb\x4d\xbc\x2f\x83\x22\x93\xa3\xab\xe8\x57\x41\x11\x10\xde\x53\xa9\x62\xef\xf6\xae\x0b\x81\x8e\xc0\x52\x4a\x60\x88\x6c\xb7\xb2\x4d\x7b\x2a\x92\x13\xb0\xdc\xa5\x3d\x5f\xcf\xc8\x0a\xd3\x4a\xa2\x5a\x53\x99\xde\xe0\xc8\xeb\xa9\x39\xec\xb8\x8b\x04\x0d\x4d\x73\xc5\xef\x0a\x3c\x29\xa7\xd6\xd9\xc5\x23\x05\x66\x77\xcc\x98\xde\x89\x0c\x0f\x9c\x8b\x91\x39\x2f\xb5\x63\x17\xfe\xb8\xce\x68\x2e\x3d\x7a\x8d\x5f\x43\xe5\x4a\x53\x84\x2e\xe2\xfe\x00\x9a\xb0\xa0\x6a\x56\xb2\xa2\x00\x27\x71\x39\x19\x4c\x1b\xe1\x7d\xa5\x42\xff\xf8\x1c\x42\x34\x3b\x2a\x7c\xc3\x5b\xa7\xf6\xc2\x1d\x21\xad\xb1\x03\xeb\x2c\x97\xb4\xbd\xd2\xb8\xa7\x23\x50\x58\x60\xa5\x01\x40\x2c\xb0\x08\xe7\xd2\x41\x3b\x80\x86\x12\x6d\xa9\x8f\xb7\x9d\xb2\xda\xac\x73\x83\xbd\xe2\x49\x31\x3d\x01\xeb\x8f\x60\x27\x15\x51\x4b\x4f\x0d\xd5\x34\xe5\x75\x51\xb2\x83\x84\xac\x15\xfe\xb3\x34\x9a\xc5\xe3\xce\x1f\x85\xa8\x4f\x3b\xce\x5f\x43\x2f\xea\x1e\x90\xd0\xfc\x49\x63\xfe\x02\x79\x9e\xb0\x0d\x15\x42\xe9\x53\x69\x43\x78\x8b\x67\x3c\x86\x13\xa2\xd6\xa5\x56\x89\xf8\xb0\xb5\xa5\xd7\xf0\x29\x0b\x9f\x0d\x8d\x15\x23\x37\x8e\x21\x70\xde\xa4\xa8\xf3\x68\x00\x1d\x17\xcd\x51\xf5\x90\xd5\x2e\x95\x65\xe0\xdb\x49\x35\x45\x45\x37\x93\x51\xca\xa5\xd6\x5c\x41\x01\x71\x7c\x2c\xc3\x51\x08\x2a\xdd\xdc\x59\x41\xbf\xca\x10\xde\x77\x9f\xfe\x73\xcd\x4f\x04\x4f\x7f\xc7\x96\x01\x20\x97\xea\xdf\x73\xd8\x79\xd7\x99\xe4\x9f\x49\x03\x80\x73\x84\x95\xbb\xd9\xd1\x67\x58\x1d\xcb\x26\x3c\xbe\x6f\x55\x90\x4f\xe7\x9f\x17\xe0\x50\x03\xd5\xe9\xd4\xa2\xd9\xc0\xfd\x6e\x2e\x1e\xb7\xef\xb9\xb5\xf8\x60\x1e\xe5\xa6\x12\x6b\x37\x95\x5b\xc4\xa1\x71\x0c\xaf\xd5\xcf\xba\x20\xe4\xc0\x72\x87\x38\xba\x32\x0e\x2c\x2e\x71\x08\xc9\x62\xdd\x44\xec\x96\xe4\x7c\x09\xe0\x58\xff\x31\x04\x4b\x99\x43\x00\x98\x6e\x15\xd3\x1b\xe0\x02\x15\xe5\x74\x63\xe8\x03\x1c\x31\x1c\xc7\x34\xc4\x54\xa3\x50\x56\x99\x40\x3b\x1d\xe7\x2c\x39\xa7\x40\xf5\x64\x30\x85\x70\x48\xbb\x02\x3c\x43\x00\x69\xb2\xd4\x2d\x7c\x39\x1c\x5f\x0a\x1e\x9c\x04\xfa\x90\xf2\x15\xaa\x1a\xac\x2a\x6d\x53\x15\x06\x1d\x53\x51\x05\x9b\xba\x3b\x99\xd8\x80\xec\x13\x17\xe4\x0e\x28\x95\xb8\x4b\x05\x28\x44\x42\xc0\x17\xb2\x3b\x35\x7e\x2b\xc1\x1e\x82\x66\xb6\x46\xbc\xa8\xa6\xd6\xd4\x61\x83\x9a\x11\x0a\x35\xdd\x43\x7d\xd2\x41\xfa\x88\x52\x8c\x85\xe0\x0d\x44\x2a\xef\x89\xdf\xb5\x2b\x36\xee\xcc\x0f\x32\xfc\x83\x63\x92\xfc\x30\x4f\x87\x4c\x94\xe6\x6c\x12\xa3\x67\x0b\xa9\x4c\xe9\x98\x0e\xef\x95\x61\xa2\xcf\xa2\xa8\xb4\x72\x5b\x5b\x6f\x1a\x45\x67\x62\xbd\xb7\x82\x47\xd0\x31\x1e\x14\x4a\x2d\x9c\xc6\x44\x41\x8c\x9b\xf1\xa5\xa8\xf4\x22\x4c\x6b\x36\x5a\x06\x99\xb6\xc6\xe8\xd4\x92\x32\x81\x34\xc7\x6a\x49\xa9\xd2\x92\x32\x15\x5e\xf5\x7b\xe2\xab\x8e\xd3\xe1\xdd\x1e\xa3\xc3\xd3\x33\xef\x0a\xcd\xf1\x34\x16\xbe\xb7\x03\x78\xac\x1c\x88\x66\x9b\x52\x1c\xe1\x2b\xcf\x6d\x41\xba\x07\x34\x83\x90\x6c\x34\x8f\x8a\xf0\x3d\xa0\xcc\x45\xec\x68\xe5\x06\x91\xc6\x58\xd6\x22\x07\x86\x23\xad\x87\xe4\x21\xa4\x1c\x9b\x88\xa6\x21\xd5\x48\x39\x7a\xda\x21\xa2\x71\x71\x2a\x75\xf4\x90\x52\xae\xa5\xed\xa6\xa6\xca\xd3\xf1\x3d\xb5\xa5\x87\x75\x7c\x77\xd3\xf4\x02\xb5\xfb\x55\x3a\xbe\xcb\x14\xe4\x25\x5b\xe7\xec\x96\x02\xf4\x41\xe9\xf8\x2e\xa7\xe8\x59\xea\x24\x3f\x01\xdf\xfe\xd7\xf6\xbb\xc1\xf6\x9b\xbf\x00\x88\x5e\xa5\xf7\x2a\x95\xcd\x70\x80\xe6\x05\x91\x61\x20\xd7\x82\x85\xcd\xca\xbb\xa1\x16\x9c\xd4\x95\x04\x50\x89\xe7\xb8\xc4\x74\x86\x87\x66\x49\x90\x60\x0a\x8a\xec\x6e\x08\xf2\x3b\x9a\xad\xc8\x0c\x20\x95\xd2\x4c\x67\x95\x51\x74\xe3\xb1\x1d\xc3\x86\x92\x68\xf5\xa1\x99\x21\x04\x6a\xf0\x5a\xd1\x58\xc3\xd0\x52\x35\xba\x70\xb4\x2b\x2d\x44\xfa\x9c\xfa\x00\x01\xc9\xac\x6e\x15\x51\x81\x60\x87\x9e\x1f\xd6\x5a\x36\x95\x93\xaa\xc3\x80\xb3\x6e\xa7\xba\x72\x85\xa9\x63\xfa\x7e\x89\xe9\xc6\xe0\x72\xed\x87\xfe\x2a\xbb\xb9\xce\x0c\x0b\x9e\xcb\x6c\x1d\xaa\x28\x3e\x14\x12\x41\x42\xba\x29\x12\xd0\x4d\xd5\x52\x97\xe1\x89\x4d\x8c\xe0\x7e\x05\x92\x13\x49\x78\xef\x9e\x52\x35\xb1\xc0\xdd\xc6\x93\xf7\xd2\x1d\x50\xcc\x54\x06\x00\x6b\x45\x9d\xd4\x74\xf5\x07\x70\xb7\x43\xa4\xa1\xec\x22\x12\x3c\xe9\xad\x3d\x48\xd3\x54\x26\x87\x93\xc4\xb9\x1f\x87\x41\x16\xc2\xc2\x11\x10\xd7\xcd\xc2\xb0\xda\x6c\x45\x4d\x6f\x14\xb7\xeb\x1d\x0a\xa8\x7b\xea\x63\xc6\xc4\xc5\xc5\x1d\xee\x6d\x8f\x04\x21\x25\xd3\xf2\x38\x6d\x90\x23\x25\x6b\xf4\x88\x22\xd2\x79\xdb\xd0\xc3\x49\x72\x0c\xc8\x95\xc9\x27\xd3\x7b\x63\x12\x10\xd6\xd9\x17\x6d\x4e\xc2\x84\x95\x8b\x33\x08\x8c\x16\xc5\x9b\xc8\x08\x68\x1d\x5d\xd3\x39\xd9\x9e\xcd\x71\x91\x96\xc3\x4e\x6e\xd5\x36\x13\x97\x71\xd1\xd1\xc5\x61\xa6\xd5\x36\xad\xf9\xd6\xae\xce\x24\xeb\x8a\x7c\x8a\xd6\x6f\x00\x6f\x88\x8e\xe0\xc9\x3c\x41\x74\xcd\x74\x46\xb2\x8a\x67\x9c\xcc\x00\x6c\x90\x02\x7c\xdb\xa3\x71\xe1\x9c\x76\x54\x2b\xb1\x1d\xf2\x13\x43\xb8\x3b\x3a\xd6\x4a\x69\x68\x25\x20\x75\x58\x2b\x95\x68\x70\x4a\xb3\x1b\x00\x1d\x06\xd2\xf6\x71\xcd\xf2\x3b\x68\x3d\x88\x62\xc7\xad\x5b\x45\xfa\x49\xa2\x85\x13\xca\xd8\xba\xa9\xd2\x31\xd1\xfa\x0d\xa9\xb9\x1d\x55\xe9\x2b\xdf\xfc\xe3\xe5\xb1\xb1\x36\x5f\x4a\x19\xac\xb0\x67\xc0\x3b\x5a\xb4\x79\xb6\xa4\xd8\x4a\x5a\xda\xa4\xdf\x4f\xcc\x6a\x5d\x53\x07\x69\xd0\xc4\x6e\x3f\x65\x20\x6d\x45\x54\x0d\x3d\x95\xee\x74\xc7\x51\x06\x2f\x8f\x02\x11\x1c\x68\x38\x2d\x50\xec\xa2\x9e\xf1\xb8\xd2\x87\x56\xa7\x75\x89\x8f\xdb\x16\x76\xe4\xb6\xd4\x6a\x06\x77\x66\x50\x91\xf1\x4f\x57\x0b\xd8\x4e\x42\xbe\xb4\xf3\x79\x0c\x12\xbf\x51\xa7\x5e\x40\x5e\xab\xf2\xb7\xd6\x40\xf9\x2b\x13\x77\x2c\x4d\xeb\x16\x87\x62\x1e\x9b\x75\x9e\x71\x1c\x88\xbf\xdb\x7b\x33\x07\x07\xf6\xc6\xad\x66\x4b\x9c\x6f\x0a\xfc\x4e\xf6\x1f\xcb\xb1\x8e\x8e\x33\x09\xfa\x1a\x5b\xf6\xbc\xde\x8d\xd8\x77\x98\x6e\x79\xf3\xe2\xa4\xe2\x6c\xfd\xa6\x64\xeb\x6c\x91\xa9\x61\x10\xaf\x2d\x5c\x50\x41\x75\x4c\x74\x8f\x84\x67\x56\x73\xae\x89\x1e\xa4\xbd\x95\x5a\x80\x0b\x06\x03\x5d\x22\xde\xd9\x93\xb8\xbb\xa4\x9a\x85\x34\xb9\xa5\xf0\xf1\x97\x18\x6e\x73\xdc\x1d\x77\xd4\x2c\x72\x48\x14\xc2\x2d\xef\x47\xb3\xb2\xa7\xa2\x1e\xc0\x3a\x21\xab\xfd\xc8\x80\xf7\xa6\xc8\x66\x2d\xe0\x5c\xb9\xa2\xa6\x4f\x4e\x26\x3c\x44\x53\x70\xcd\x38\x67\xab\x53\x75\x0f\x8c\x9c\x9c\x67\x96\x52\x95\x6c\xbd\x59\x03\x38\xee\xe6\xb7\x3c\x38\xb5\x4f\xa3\x14\x58\xd6\xa7\x98\xe6\x60\x28\xff\x52\x23\x0c\x9b\x5d\x9b\xd6\x54\xfb\x3a\x76\xb5\x53\x2e\x94\xa2\x99\xf8\xcb\xb4\xfa\x24\x88\xa4\x3a\xc9\x4c\x58\x26\xac\x44\x54\x2e\xa0\x7b\x9a\x8e\x51\xa3\x35\x2f\x42\x7b\x09\x3e\x1a\x98\x0d\x79\x2d\x25\x8d\xf0\x79\x42\x38\xbd\xdf\xe9\xa5\x0e\xa5\x4d\x74\x79\x01\x25\xb1\x8c\x85\x00\x95\x86\x4c\xe2\xba\x41\xa5\x5d\x83\xcc\x4f\xc4\x1b\x3d\xc4\x5e\x95\x99\xc9\x76\x7b\xbf\x83\x08\xef\x86\xa6\x36\x0d\x0c\x8e\xb0\xc5\x32\x87\x89\x68\x4f\xed\x7e\x6d\x90\x70\x58\xb3\x1d\xa6\x28\x86\x68\xc5\x72\x32\x27\xb8\xac\x86\x46\x0e\xb3\xcd\xd4\x6a\xc5\x50\x89\x5f\xf7\x98\xca\xbb\xd1\x77\x76\x14\x55\x3b\xa4\x69\xca\xeb\x1b\x5c\xca\xac\xa8\xf7\x9a\x59\x22\xd8\x66\x1e\x0d\xf2\x52\xbb\x9d\x5d\x71\xcd\x3c\x35\x59\x46\x2d\xb1\x49\xd3\xa7\x05\x55\x65\xfa\xbb\xe4\x77\x05\x4e\x2d\x58\xfd\xf3\x1d\x44\x8a\x0c\x35\xdc\x01\x9c\xf5\xf9\x23\xf3\x91\xd5\xfa\x80\xfa\x3e\x0a\x3a\xe0\xb5\xd5\x54\x63\x3a\x54\xa6\xe7\xee\xae\x94\x63\xde\xf1\xfa\x24\xf2\x19\xfa\x24\xe2\xe8\x93\x3c\xb1\xcc\x5b\x11\x99\x1d\x75\xbb\xfd\xb6\x9f\xa6\x54\x45\x92\x44\x91\x8c\x5c\xdc\xac\x65\x46\x61\x9d\x22\xea\xaf\xa9\xad\x87\xd6\x8e\x44\x3e\xd7\xdb\xc5\xae\x83\xf4\x76\x61\xe9\x00\x55\x29\x31\xc6\x2a\xf6\xb0\x1a\x31\xc7\x32\xd5\x41\xd8\xc9\x84\x4d\x21\xca\x52\xac\xfe\x0a\xed\x17\x2a\x9a\x1c\x9c\x68\xba\x93\x86\xab\x28\x52\xb7\xa9\xcc\x6d\xa4\x33\x63\xc5\x45\x22\xcb\x54\xdc\x04\x85\x28\x53\x40\xcc\xd2\x4c\x91\x3b\x2b\xc3\xb5\x5d\x2c\x94\x9b\x41\xbb\xcf\x8e\x70\x1e\xda\x0c\xe7\xd9\x6e\xf5\xa2\x3b\xdf\xba\x8b\x1e\x45\xb8\x76\x7a\x28\x91\xf9\xde\x48\xb1\xcb\x7d\xbc\xa8\xf4\x26\x76\x24\xcf\x25\x44\xcb\x2e\x16\xf3\x13\x84\x94\xbd\xc2\x87\xe0\xe6\xba\xa4\x0f\xb1\x0b\xfb\xc5\x0b\x30\xcf\x0a\xa9\x16\xce\x6a\xde\x2a\x0b\xf2\xba\xb3\xae\x4c\x8d\x65\xb0\xe2\x38\x16\xb7\x90\x2c\xee\xce\xa8\xd9\x5b\xc8\xd7\x56\xb3\xa3\xbd\x89\x32\xdd\x1c\x99\x78\x5f\x8e\x4c\xbc\xdd\xba\xdc\x82\xeb\x00\xfb\x37\x15\x2f\x16\x72\x81\x95\xb1\xd1\xc7\x22\xda\xf8\xdb\x6f\x6a\xb4\xda\x6e\xbf\xf9\x8b\x77\xf2\xbf\x1b\xb8\x3f\xbf\xfd\xaf\xbe\xd3\x14\xdb\xce\xdc\xeb\xb8\xc1\x30\xe9\x53\x0c\x87\xfd\x67\x06\x00\x4d\x35\x4c\xa0\x54\x4b\x58\xdb\xa7\x70\x22\x7b\x19\x3b\x41\x3b\x64\x40\x4c\x4b\x0f\x45\xe6\x31\xf3\x27\x27\x97\xa9\xed\x31\x8b\x68\x80\x41\xee\x33\x99\x03\x2b\xfe\xe6\x2f\xee\x52\xb9\x0b\x67\x13\x6b\xb9\x4d\x64\x74\x4e\x33\x21\xd3\x1e\xca\x57\x9b\x73\x55\x62\x2a\x9d\xb3\xc0\xac\x8a\x93\x80\x53\x10\x15\xa5\x8d\x69\xda\xc2\x48\xc8\x57\xcb\xdb\x93\x5e\xfd\x93\x70\xbc\x92\xb9\xa1\xec\x26\x40\xf9\x73\x68\x7f\xee\x77\xea\x52\x79\x55\xfd\xdc\x27\x3b\x28\x57\x5b\xe0\x8d\x09\x4d\xb3\xae\xd3\x36\x60\xcf\x22\xce\xe8\xdb\xff\x72\xd7\xab\x7c\x34\x88\xa2\xf2\xf4\x14\x7d\x37\xf0\x8a\x1f\xd6\x51\x6e\x51\x54\x9e\x9c\xa0\xf2\xe1\x20\x8a\xe2\x32\x1d\x40\x54\x4d\xca\xa9\x51\x59\xec\xbe\xb8\xc3\xf3\xab\x66\x43\x71\xf7\x76\x35\x7e\xdc\x6d\x59\x71\x72\x01\x98\xed\xe8\xb6\xad\x38\x58\x82\x9e\x77\x50\x80\x63\xba\x6d\x9e\xce\x03\x9d\xb5\xc4\xcb\x3a\x61\x9b\xbc\x9b\x3a\x46\x79\xee\x32\x17\x87\x7a\xda\x3b\x57\x0f\xe5\x42\xa1\xa9\x81\xb3\xfa\xbc\xd3\x69\x4e\x51\x88\x3a\x2b\x1d\x3c\x0c\x5b\xbd\x60\xbd\x39\x2b\x57\xa0\x09\x51\x6b\x78\x6b\xf0\xb9\x9c\xa6\x6d\x48\x4c\x95\x67\xf0\x79\x6e\x4b\x0f\x1b\x7c\x2e\xa7\xe9\x87\xc0\x0c\x95\xc1\xe7\xb5\x4a\xb8\xba\x62\x79\x56\xa0\xdf\xd2\xfb\xeb\x6c\xf6\x41\x80\x3f\xec\x0f\xbc\xd4\x5f\xf2\xac\xc8\x1c\x60\x4b\x76\x2b\x73\x7c\x7d\x74\x1a\x07\xf2\x76\x05\x72\x7c\xa9\x3e\x9c\x9c\x5f\xa2\x2b\x27\x63\xd7\x9b\xc3\xc6\x94\x4f\xcd\x7a\x95\x93\xac\x60\x8b\xb4\x1d\xa0\x28\x27\x7c\xaa\xaa\xad\x92\xc8\xcc\xa7\x91\xfc\x64\xc9\x6e\xa9\x9b\xc8\xeb\x09\xcb\xef\x8c\x20\xe3\xf9\xa1\x93\x05\x65\x25\x7e\xa2\x7b\x91\x51\x08\xee\x77\x1d\xde\xeb\x4a\xa7\x7c\x9d\x95\xff\x20\x39\x5f\xa6\x83\x4f\xf1\x18\x6e\x05\x50\x2b\x68\x3b\xbc\x84\x79\xc0\x4d\xb8\x95\xb8\xba\xef\xf5\xe4\x64\x2f\xf2\x1d\x59\xf7\xb9\x2a\xab\x9c\xd6\x75\x9e\xa3\xe6\xcc\x07\x26\x86\xb5\x65\x15\x91\xdb\xd2\x8e\xed\xdd\x05\x7c\xa7\x1c\x5d\xa7\x07\xf1\x76\x7b\x40\xf5\x69\x77\xd4\xa6\x52\x5b\xe2\xd9\x87\x4b\xb3\x0b\x6e\x84\x64\xbb\x30\xcb\x7f\xdb\x54\xfc\x99\xc4\x1b\xb7\xe5\x45\x35\xcb\xd6\x3a\x48\xd3\x29\x7e\x8b\x2b\xf2\x2f\x5b\xbc\x47\x1f\xa7\x73\x33\x3b\x6b\xd0\x4c\xda\xac\x8a\x9b\x04\xce\x62\x86\xdc\x6c\x3f\x7d\x82\xc2\x6e\x47\x89\xaf\x08\x55\x6b\x24\xdf\xbb\x94\x36\xb3\x2a\xc9\x4f\x37\xeb\xbd\x1f\xaa\xc4\xdf\x6e\x2e\x7e\xa7\x9b\x28\x12\xbf\x82\x87\x63\x60\x0c\xde\x7a\xc5\x96\xec\xd6\xb4\x08\x85\x0a\x52\xd5\xc4\xc9\x46\x6e\x52\x21\xf8\xfa\xfd\x16\x52\xf3\x90\xcf\x00\x3a\x12\xd3\x1b\xb8\x6a\x64\x23\xb5\x08\xfb\xbd\x45\x9b\xc8\x19\x45\xfd\x30\x76\x3a\x91\xbb\x9a\xdc\x8c\x6c\x74\xd0\xfe\x63\x66\x52\xbc\x76\x9e\xb5\x4f\x46\x52\x87\xe9\x10\x12\x98\x24\xdb\x84\x3a\x53\x6e\x23\x72\x47\xa2\xfb\x80\x76\xbe\x03\xdd\x03\x58\x6b\xa5\xbf\x30\xda\x42\xc4\x8c\x2f\xfd\x97\xf7\xc4\x74\x4f\xda\x7b\x81\x00\x2f\x05\x0c\x0a\x01\xc3\xfe\x92\x95\x97\x46\xbf\xfe\x44\xbb\x51\x06\xec\x1e\x13\x15\xa6\xe6\xdf\x64\xde\x3d\x26\x58\xd6\xf2\xc2\x57\x39\x35\xf8\x6a\x6b\x01\xd1\x8b\xa4\xe8\xc1\x11\x3b\xba\xdf\xe8\x62\x9a\x79\xb7\xf0\x7e\xb7\x4b\x7d\xf5\x3a\x25\xfb\xae\x57\xaf\xa8\x79\xc1\xba\x95\x01\x2a\x72\xc0\xa7\x33\x7c\xcd\x1a\x6f\x4f\x95\xbc\xe8\x5d\x87\xf9\xc6\x27\xf7\x47\x27\x31\xfb\x2d\x6c\xb7\xd0\x34\x1c\x71\xf4\xd1\x78\x82\x6a\x42\x61\x26\x54\x43\xdf\xed\xfa\xde\xa5\x08\x35\xcb\x1b\xf4\x70\xb7\xa4\xe6\x58\xca\x45\x85\x54\x9b\x37\xe8\x94\x16\x58\xbd\x0c\x14\xd6\xd4\x2b\xd7\x57\x3d\x69\xf5\xa8\xf3\x29\x22\x95\x1b\x47\xb5\x92\x99\xc4\x1b\x9d\xf0\xbb\x02\x27\x4c\xef\xfd\xcf\x36\xf3\xf0\x81\x5c\x44\x8a\x9b\x33\xd6\x75\x4d\x39\x8f\xa6\x0a\x9a\xe2\x04\x88\xc2\x7c\xde\x22\x0a\xc1\x5b\xfc\xa0\x17\x77\x00\x70\x93\x2d\xd8\x87\x5d\x85\xe1\xf0\xcf\xec\xbd\xb5\x7c\xa0\x93\x3e\xd5\x5f\x21\xd6\x4d\xc5\x58\x87\x99\xdf\xa1\x6d\x8a\xcb\x54\xa9\x43\xea\xbb\xb9\xe3\x2e\x0e\x3d\xb6\xd0\xbc\xd0\x6c\x0a\x19\xb5\x2f\x63\xf7\x47\x07\x1f\x7f\xcd\xf2\x3b\xa0\xfc\x4c\x47\x5d\x56\xb3\x66\xa2\x7f\x27\x8b\x90\x79\xd7\x2c\x4d\x53\xf9\xfb\xe2\xc5\xc5\xcb\x8b\x57\x57\xef\x5f\xbd\x7e\x76\xb1\xdd\x7a\x0a\xca\x24\x5b\xaf\x31\xcd\x9f\x2e\x49\x11\x4e\x58\xda\xd8\x0b\x6d\x9d\x48\xc1\x75\xc1\x66\x1f\x40\xa3\x8d\xc2\x81\xa6\x0a\xb3\x03\xe7\x03\xca\x4e\x4d\x4f\xda\xd9\x59\xfd\xb6\x25\x2b\x30\x40\xc0\x4a\x3b\xcd\xab\xb6\xde\x01\x57\x2c\x3a\x55\x27\x3a\xd3\xd9\x69\xd9\x98\xe9\x33\x7e\xc5\xd6\xe9\x60\x18\x3a\xfd\xb2\x06\x91\x28\xfa\x8c\x48\x15\xcd\x35\xf8\xb6\x23\x81\x6d\x76\xe3\xe8\x9c\x95\x33\xfc\xbd\xc2\xc0\x51\x20\x8c\x8e\xee\x15\x21\x90\x67\x23\xa4\xcd\x41\x68\xdb\x97\x85\x06\xa5\x36\x9f\x45\xae\x3d\xb1\x76\x4e\x86\x80\xe2\xf3\xa9\x4f\xcd\xb5\x07\xce\x7d\xd9\x71\x4e\x0b\x7d\x22\x4b\x7d\x12\xdd\xb5\xea\x74\x0e\x38\xc8\x15\xd4\xf9\xf7\xdd\x62\x87\xf8\x50\x78\x6f\x3a\x51\x76\x1e\xb9\xd8\x82\xd7\x36\x0b\xe4\x15\x2b\x8f\x24\xee\x9f\x7d\x47\x21\x6c\x3c\x92\x78\x9b\xde\x40\x68\x12\x34\x39\x4c\xec\xde\xf4\x9a\x46\x14\xde\x9b\x55\x73\x9f\x4c\xa3\x32\x2a\xb6\xb2\x74\x4a\x65\x2d\x56\x5a\xc6\x71\x1c\x7c\xe5\x47\x91\x44\x38\x6c\x7f\xae\xd5\xc9\xd8\xa8\x83\xf9\x1e\x0e\x40\xce\x7a\xd8\x90\x6d\xc3\x4c\x75\xe7\x74\xec\xaa\x39\x3c\xfe\x91\xab\xe6\x26\x4d\x28\xe5\xd7\x5d\xab\xd4\x78\x70\x4d\xbb\xaa\xc8\x74\x77\x43\xa7\x1f\x01\x68\xb3\x23\x05\x9f\xe5\x8e\xf7\x82\xd6\x41\x58\x29\xa3\xb8\x49\x57\x03\xa4\x52\x13\xd5\x00\xad\x0c\x13\x61\x9f\xe3\xed\x6c\x2b\x49\x2b\x3c\xa8\xe2\xe9\x92\x6b\x5b\xe6\x2f\x4f\x90\x52\xd4\x98\xad\xd5\x65\x90\xbc\x2f\xb1\x98\x98\x64\x49\xc5\x27\xd2\xdd\x4e\x97\xba\x9a\x0a\xff\x88\x05\xc2\xbd\x6a\xd9\xc0\x79\xd2\xcc\xc0\xd7\x66\x82\x0d\x63\x19\x45\x96\x5d\xb4\xbc\xa6\x7d\xc6\x2b\xc4\xe3\xd7\x5c\x41\xbb\xf3\x4f\x66\x0b\xc6\xea\xff\x21\x00\x23\x37\x21\xb4\x92\xaa\x3b\x18\x61\xdb\xd0\x82\x65\x97\x7b\x56\xe2\x8c\xdb\x87\xf1\x40\x4e\x6e\x5a\x6a\x40\xc5\xac\xbd\xca\x56\x58\x6b\x5f\x4e\x4d\x0d\x10\x77\x5c\xa8\xb1\x65\x49\x49\x7d\xd7\xd5\x6b\xa5\x78\x87\x2b\xd6\xd8\xf4\x4f\xd3\x0b\x79\xa2\x5f\x58\xaf\x32\xee\x52\xb7\x9c\x0f\x8d\x8e\xa6\x1d\x5d\x21\x6f\xc0\xfd\xc4\x28\x70\xb3\xdb\xc9\x85\xa6\xdb\xbc\xdb\xed\xcb\xbe\x52\xcf\x48\xfc\x77\x65\xe2\x4f\xe6\xdb\xed\x48\xa3\xf6\xd8\xc1\xdb\x93\x77\x72\xb9\xbb\x46\xe6\xb5\x06\x62\xd5\x18\xd5\x75\x00\x7c\xcb\xa1\xe2\x4d\x7c\x6e\xc3\x3f\x65\xb1\x7c\x20\x8a\xc7\x8a\x6b\x38\x88\xfc\xc6\x1a\xf6\xe5\xd6\xa6\xea\x58\x9b\x52\xaf\x4d\xcd\xf4\x2b\xb0\x55\x1c\x5b\x2d\x1d\x87\x49\xf7\xef\x12\x0f\xfb\x1d\xfa\x80\x28\xe2\x56\xdf\xe5\x5f\x0a\xeb\x2c\xcf\x09\x5d\xbc\xc0\x73\x33\xbc\x2f\xf5\xcb\x30\x6a\xd8\xa5\x67\x88\xa2\xfe\x81\x8e\xdf\x0a\xb8\xf6\xf4\xac\xc9\xa9\x4f\xa5\xdb\x04\xb5\x1b\x68\xd0\xba\xc9\xda\xc3\x03\x15\xec\xe9\x6b\xad\xdb\xcb\xef\x8b\x2d\x1d\xa1\xe5\xa3\x2e\x95\x8b\x7a\xbf\x5c\x7c\x11\xf3\xa4\xc0\x73\x7e\xc2\x13\xe9\x8e\x07\x1f\xea\x87\x37\x08\xa5\x58\xcd\x3d\xac\x60\xe1\xc6\x46\x73\xe9\x95\xc7\x96\x25\xd9\x03\x3b\xf7\x12\x76\xb7\xa0\x0b\xa7\xd9\xec\xf6\x1c\x02\xc9\x9c\x7c\xc4\xf9\x29\x67\x6b\xd4\xd3\x7f\x2b\x77\x42\xd4\x4b\x48\x75\x2a\x4b\x50\x2f\xa9\x38\x99\x7d\xb8\x13\xcd\x80\x4c\xa0\x73\x7c\xff\xde\x97\x2a\x77\x67\xc3\x35\x8c\x22\x9b\x3c\x8c\x04\x76\x15\x55\xca\x09\x41\xbe\xf3\xa1\x2b\x8c\xff\xe3\x48\xd6\x28\xdf\x24\xbf\x0a\xb1\xe0\x17\xc8\x79\x8d\xbc\x82\x27\xbc\x03\x59\xa5\x6a\x90\x1c\x03\xe9\x2a\x2b\x17\x84\xb6\x01\x55\xe5\x21\x38\xbd\x1a\x0b\xa6\x5f\xea\x41\x79\xda\x0d\xa5\xbe\x17\x7c\x84\xee\x58\xc2\xa6\x37\x51\x78\x39\x1b\xad\x7e\xc7\xd2\x76\x93\x82\xd6\x28\xf5\x75\xe8\xf2\x75\x35\xc9\xd8\x73\x1e\xbe\x28\xa2\xab\xe7\x64\x1b\x48\xe0\x05\x20\xd3\xf0\x12\x79\x49\x6a\x9f\x85\x1a\x48\xc7\x98\x16\x01\x23\xdb\xad\x54\x4c\x8d\x3e\xf5\x14\x1f\x3e\x65\x61\xb0\x1b\x68\x1a\x8a\x0b\x22\x3a\x90\x3a\x80\xa6\xc4\x9f\xa2\xdf\x99\x99\x06\x69\xe3\x5e\xd7\x92\x05\x39\xfe\xf0\xf2\x1d\x42\x79\xbd\x94\x5a\x2d\xed\x13\xda\x3d\x37\x42\x88\xf7\x1d\xf1\x36\xbb\x6b\xf1\xfa\x74\x85\xb3\x6a\x53\x62\x80\xf6\x28\xc3\xd4\x42\xe8\x97\xe2\x43\x97\x4d\x22\x73\x16\x9c\x9a\x5b\x5e\x42\x69\x4c\xdb\x7e\xbf\x9a\xa7\xd2\xfd\x22\x7c\xc0\x27\x57\x10\xac\x83\x5e\xb9\xac\xed\x95\x6b\x64\xbc\xd2\x68\xed\xfd\xb4\x8c\x87\xa2\xc7\x43\x81\xdf\x1d\x63\xfc\xdb\x62\xbf\xcd\xeb\xcd\xa5\x34\xf3\x47\x11\x53\x96\x7f\xf2\xbb\x9e\xdc\x3c\xce\xcb\xe9\xb7\x23\x42\xc2\xe5\x6a\x74\xfb\xf1\x84\x6d\xdc\x6e\x06\x0b\xc4\x0e\xa5\xe9\x64\x32\x5c\xa0\xc3\x3f\x92\xd9\x34\x56\x7e\xae\x2b\x8d\x09\x75\xb0\xb6\xce\xd8\x49\xbb\x32\x76\x8e\xc0\x63\x1b\xff\x67\xc3\xd2\xc1\xe3\xb7\x17\xad\x62\xe9\x8d\x19\x8a\x56\x2f\x14\x0c\xea\xe5\x22\xdf\xff\xa1\xe1\x31\x14\x76\x67\x28\xd4\xa7\x4d\xb1\xbe\x61\xce\x27\xed\x77\xc6\x88\xab\x55\x83\x70\xf4\x66\xdf\x83\x9c\x2a\x1b\x9f\xf5\x51\x52\x83\xa4\xed\x4f\x9c\x5a\xcf\x53\xe9\x8d\x5b\x71\xf8\x1d\x43\xd5\xfd\x6b\xd4\x1e\x40\xf9\x2b\xbd\x4d\x27\x40\x48\x32\x0b\xc9\x9b\x02\x04\x66\x84\x63\x80\xc0\xb2\xc4\x73\x80\x00\xe1\x78\xc5\xa5\x73\x1d\x28\x18\x5d\xe4\xb8\x9a\x01\x04\xd6\xac\xe2\xb8\x04\x08\x54\xa5\xf8\xf9\xb1\x20\xf4\xc3\x50\x7e\x31\x45\x2f\xd2\x7b\xf0\x35\x18\x4e\x80\xa4\x7e\x52\x4b\x2e\x5a\x12\xd1\x77\x91\xd1\x05\x40\x5a\x7d\x7e\xf6\xab\xd4\x0b\x4d\x7e\xb9\x3d\x9d\x7e\xfd\xa7\x33\x32\x45\xd9\x70\x02\x94\x34\x5d\x03\xc0\x09\x97\xba\xf6\x12\x17\x60\x8a\xb2\x12\x67\xc3\xc9\x14\x5d\xcb\x7f\x4a\xf1\xef\x8c\x15\xea\xbf\x1c\x8b\xff\x73\x72\x23\xfe\xc3\x2b\xf1\xef\x52\xb6\x58\x9e\xcb\x7f\xbf\x91\xff\x7e\x2b\xff\xfd\x4e\xfe\xfb\x67\xf9\xef\x7f\x8a\x7f\x89\xfc\x67\xb5\x18\x4e\xf4\x9c\xaa\x72\x56\x49\x38\x32\x71\x40\x2d\x18\x2a\x4b\x0c\x32\x59\x62\xa6\xa8\x90\x5f\x2a\x18\xd6\xf2\x9f\x52\xc2\x51\xc9\x7f\x56\x59\x21\x6b\xaa\x75\x46\xe5\xff\x9b\x6b\xf5\x9f\x6c\x5a\xf1\x92\xd1\x85\xf8\x6b\x23\xff\x11\x4d\x77\xe8\x9f\xe9\xd9\xaf\xf1\x78\x18\x8f\x87\x32\x04\x78\xbc\x5d\x65\xa4\xe0\x6c\x3b\xe7\xeb\x2d\xc7\xc5\x76\x4e\x0a\x0c\x87\xdb\xc9\xaf\x5f\x45\x67\xc3\xf1\xf4\xeb\x78\x3c\x9c\x7c\x75\x36\x9e\x6e\xff\x04\xe1\xd9\x82\xa0\xef\xd3\xb3\x5f\xc5\xa1\x12\x3d\x90\x55\xb6\xc0\xbf\x9c\xc5\xe3\xe1\xf5\x6a\xbd\x5d\x90\xf9\xf6\xb7\x35\x5e\x6c\x7f\x5b\x2f\xb6\x6b\xba\xd8\x72\x32\x9f\x6f\x6f\xf1\xf5\x1a\x6e\x6f\x48\x8e\x99\x6c\xb9\x12\x2d\x56\xeb\xef\xb6\x6c\xb1\x10\x95\x2b\xb8\xcd\x36\x39\x31\x95\xdf\x6e\xd9\x22\x93\x75\x6c\xbd\xa9\x20\x1c\x5d\x67\x15\xfe\xcf\xef\xd0\xe4\x97\xfc\xe4\x2c\x3b\xfd\xd7\xf4\x24\x15\xbb\x39\xb2\xfe\x66\x7f\x8f\x39\x52\xa9\x5e\xe6\xf1\x40\xaa\x49\xb4\x43\xaa\xb9\x61\x8c\xeb\x7f\x3b\x02\x88\x42\xe7\x21\x93\x51\x1d\xe1\x20\x9f\x55\xd7\x62\xd3\xb3\xd7\x2f\xdf\x08\x16\xb2\x84\x89\x62\x25\x4b\xb6\xba\x94\xf7\x42\xcc\x11\xe0\xf8\x23\x3f\x5b\xf2\x95\xca\x03\xfd\x5a\x5e\x3f\xc9\x07\x7c\x57\xa9\x37\x25\x9a\x6e\xbb\xf2\xb2\x6c\x33\x4a\x5f\x0b\xf1\xa5\x0c\x66\x93\xa9\x26\x7c\x8a\xca\x94\x48\x9b\x97\x20\x4f\x09\x67\x2f\xd8\xad\xc9\x67\x27\xe6\x26\x5f\x8e\x60\xd6\xed\xb6\xab\xa9\xf5\x5e\x26\xae\x29\xcd\xbd\xb9\x09\x44\x60\xc6\x28\x27\x74\x83\x55\xd2\xac\xac\x35\x83\xcc\xa8\x52\x4d\xc2\xd1\x19\xa3\xb3\x8c\xc7\x78\x02\xbe\x06\xd3\xed\x56\x9c\x8d\x49\x29\xff\x80\xa3\x2c\xec\xff\x10\x87\x1e\xec\xe0\x7b\x67\x28\x75\xf0\xd6\xb1\xd8\x4c\x45\xce\xfc\xad\x53\xbe\xdd\xd6\xa9\x9d\x44\x77\x3f\x66\xc5\x46\x48\x43\x7c\xb6\x8c\xff\x09\x05\x65\x6f\x96\x7e\x0f\xdd\x8d\xc7\xfb\xfc\xa0\x79\x8f\xd0\x8a\x67\x74\x26\x50\x47\xe5\xd6\xd8\x75\x47\xb2\x48\xc7\x73\x35\x86\x8c\x55\xd1\x20\xf7\x07\x9a\x83\xea\x9f\xef\xa0\x7c\x26\x61\xbb\x25\x2d\x35\x75\xbd\x18\x8a\x0f\xc8\xd2\x01\x2a\xac\xab\xf5\x28\x7b\x58\x8c\xb2\x93\x13\x58\xc6\x19\xac\x33\x40\x48\xe4\x92\x52\xfe\x0f\x57\x2f\x5f\x48\xaf\xc4\x27\x29\xe0\x8c\x15\x9c\xac\x01\xfa\x41\x65\x07\x79\x32\x45\xef\xbc\xec\x20\xf1\xaf\xdb\x5f\x7e\xa9\xe0\x75\x75\xaa\x9b\xfe\xf2\xcb\xe5\x09\x40\x60\x01\x20\x7a\x99\x4e\x40\x95\x51\xc2\xc9\xbf\x24\x75\x5a\x12\x8e\x5f\x90\x4a\x50\x2c\x53\xfc\x3d\x05\x53\xf4\x8f\xf4\x3e\xa3\x64\x25\x75\x5b\xee\x1b\xaf\x78\xb5\x2e\x32\x8e\xeb\xec\x1c\x92\xce\xb5\xf2\x78\xb8\x89\x41\xb4\x46\xb3\xfe\x24\xc7\x32\xbf\x87\x49\x25\x62\x72\x79\x20\x71\xea\x5c\xe7\x54\x7d\xa0\x42\x4f\xcf\xd6\x01\x73\xb6\xd2\x19\xf1\x70\xb2\x12\x1d\x83\x83\xcb\x36\xe4\xf5\x20\xf3\x4c\xb0\xde\xb3\x0f\x6f\xda\x83\x65\x32\xd5\xe5\xfe\x2c\x27\x66\x3d\xdd\x29\xd9\x25\x36\x89\x4c\x1c\x98\xec\x5e\x0c\x0d\xd7\x7b\x20\xf5\xc9\x8f\xe9\xfd\xe3\x77\x57\xaf\x87\x20\xdb\x70\x06\xd0\xd5\xeb\x37\x32\x5e\x14\xa0\xb7\xcf\xff\xfb\x87\xab\xa1\x7e\x01\x03\x3d\x79\x7d\x75\xf5\xfa\xe5\x50\x87\x6f\x02\xf4\xe2\xe2\xfb\xab\xa1\x7a\x58\x63\x87\xfe\xe5\xee\x73\x7f\x50\x6f\xf0\x83\x87\x39\xb9\xe9\xc9\x2b\xb9\x46\xb9\x9e\xb8\x88\xeb\x9f\x8f\xdc\x36\x59\x59\xb2\x5b\xf0\xe8\xe1\x59\x4e\x6e\x1e\x05\x3e\x3e\x95\x88\x6c\x1b\xc8\x7f\x1f\xd4\xc8\x21\xdf\x2c\xeb\xe9\xa7\xbd\x35\x52\x19\x54\x19\x28\xd4\x90\xef\x18\x68\x9c\xe8\x9f\xbb\x38\x20\x67\x6d\xd3\xe0\xd4\x9b\xdb\x3f\x0f\x6d\xe2\xbc\x10\xa7\xa7\x2b\x49\x8e\xdd\xb6\xfe\xc0\xdd\x2f\xc9\xb4\xd7\x5b\xf4\x22\x94\x27\xe7\x6f\xe9\xfd\x0f\xcf\x9f\x5d\x0c\xad\x83\x62\x7d\x54\x9f\x3f\x7b\x76\xf1\x6a\xe8\xb0\x8a\xb6\xea\xf2\x87\xd7\xff\x18\x5a\xf6\xd3\x2b\x7e\x35\xac\x8d\xea\xb6\xe2\xf9\xab\xcb\x8b\xb7\x57\x17\xcf\x86\x80\xd0\x0a\x97\xda\xa1\xc8\x56\x3f\x7d\xf1\xfc\xe9\xdf\x86\x35\xbf\x6f\x2b\xbe\x7f\xfd\xf4\xdd\xe5\xf3\x57\x43\xd7\x9c\xec\x57\xbe\x7e\x77\xa5\x6b\xd9\x86\x7b\xd5\x2f\x5f\xbf\xbb\xbc\xb8\x78\x75\x75\xf1\x76\xd8\x78\xa1\xd0\x6f\xf2\xe2\xe2\xf1\x8f\x17\xc3\xc6\x33\x84\xa6\xc9\x0e\xfd\xb4\xcf\xbd\xfc\xf3\xb3\xa2\xe8\x01\x3e\x31\x29\x8a\xd1\x6c\x5e\xa8\xd8\xd4\xda\x19\x99\xeb\x47\xee\xcc\x6f\x89\x99\xf2\x01\xd8\x5a\x39\xac\x5e\xb2\xb8\x52\xc8\x9b\x9a\xb0\xfa\x76\x66\xa1\x86\x37\xfc\x01\xe7\x79\x4e\x3c\xcf\xbc\x0a\x1f\x9b\x2a\x48\xc5\xd7\x86\xde\x1e\xae\x67\xa7\x73\x3d\x1c\x6c\x78\x2e\x1a\x2a\x21\xcc\x94\xed\x6b\xde\x28\xa8\x3f\xee\x78\xb0\xc9\x34\x94\x6f\xca\xba\x16\x48\x3f\x95\xc0\xe3\xab\xc7\xef\xff\x76\xf1\xb3\x32\x4b\xfa\x66\x32\x2d\x01\x52\x38\x72\xa2\x78\x1b\x1d\x34\xbf\xa9\xd3\xb9\x3c\xc3\x05\x5e\x64\x1c\xdb\x84\x2e\xa8\x73\x00\x19\xde\x4b\x1a\x9b\xad\x62\x4b\xd3\x7e\xb8\x1c\xc9\x84\xd3\xff\x20\x7c\xf9\xd8\xad\x8c\xe1\x98\x24\xef\xe5\xa1\x91\x44\x1c\x11\x38\x24\xc9\x7b\x79\x44\x4c\xc1\xce\xbc\x85\xab\xcd\x45\x0b\xcc\xaf\xc8\xda\xe6\x99\x0a\xa4\x37\xf1\x8c\x77\x72\x7e\x4e\x87\x4a\x14\x37\x7e\x3b\x76\x60\x25\x4a\x76\xe4\xfd\xf0\x9f\x80\x74\x0f\x43\xc8\x3d\xd5\xf3\x8f\x0d\x6d\x9e\xb5\x48\x7a\xae\x12\xad\xe6\x17\x3f\x5e\xbc\xba\x0a\xb7\xaf\xe3\x13\xad\x3f\xcc\x7c\xde\xf4\xff\x36\xc7\xd4\xb8\x30\xd8\x67\x27\xcc\x99\xb2\x79\x62\x38\x69\x9b\xcb\x6b\x54\x76\x13\x96\xb6\xde\x84\x77\xa9\x40\xeb\x0d\x5e\x43\x07\xdc\xbc\xa8\xfb\xb3\xbb\x1c\x20\x17\x75\x49\xcb\xd1\xd7\xd0\x88\x76\x06\xae\x96\x97\xaa\xf4\xcb\x48\xed\x53\xe6\xf5\xaa\xea\x10\xcd\x75\x91\xdd\xc9\x57\xb0\x0c\x7d\xd5\xb4\xf5\x4d\x81\xb3\x0a\xf7\x36\x3a\x33\x71\x8f\xd1\x9e\xd6\x5d\xf4\x74\x1f\x15\x68\xc6\x8f\xb4\x77\x55\xbe\xc9\x28\x6e\x31\x68\xed\x48\xea\x64\x3c\x65\x94\x4b\x94\x36\xab\x53\xd3\x84\xfb\x26\xa8\xb5\x17\xbf\x35\x4d\xcf\x09\xcd\x2f\x97\x59\xce\x6e\xdf\x32\x93\x4c\xdd\x7a\xb7\x55\xa9\x13\x5f\xad\x73\xbb\xb0\x31\x1b\xba\xad\x12\x76\x4b\x71\xf9\xac\xc3\x00\xea\x6d\x84\xca\x15\xd0\xa1\xfd\xe9\x57\xae\x33\x6e\x99\x86\x4e\xad\xc9\xbd\xfc\xee\xf9\xb3\xf6\x12\xbd\x7a\xfc\xf2\x02\x8e\xca\x86\xaf\x0c\xc9\x01\x32\x2f\x99\xef\xf1\xa6\xc9\x71\x35\x2b\xc9\x35\xce\xaf\xef\xea\xf6\x95\xbc\x52\xb8\x13\x5d\xa0\x1d\x32\x2c\x8f\xd7\xca\x8c\xa5\x43\x18\x54\x80\x7c\x57\x4e\x0d\x93\xa5\xc1\xf0\x50\xe3\x60\x69\xfd\x02\x81\xd6\x59\xd9\x65\x1c\x06\xdb\x23\xc7\x44\xf9\x98\xf3\x6c\xb6\x94\x8b\x36\xd3\x64\x2b\xcb\xf3\xba\x54\x41\xab\x1f\x46\xdb\x78\x37\xa8\xe2\xf5\x62\x1d\x1d\x9f\x5b\xfa\x14\xbc\x4f\x24\xfd\x43\x0e\x92\x7c\x2a\x66\x08\x1a\x62\xf2\x5c\x1b\x67\x92\x4d\x9b\x72\x19\xc4\xed\x38\x17\x86\x89\x0b\xe6\x21\xf3\xa8\x6b\xd9\x91\x89\x6c\x09\x75\x78\x7c\xcb\xc7\xe3\x8f\x8a\xfd\xef\x4e\x3c\x26\x37\xc1\x67\x0b\x02\x88\xc6\x93\xf7\x73\xf2\xd1\x75\x60\x19\x19\xc5\x80\x43\x4d\x47\xbc\x4d\x5b\xc5\x8d\xdc\x5e\xc9\x0e\xf2\xf2\x4a\xcc\x78\x23\x13\xd9\xa9\x41\xdd\x4b\xd0\x73\xee\x90\xe4\x3f\xec\xd7\x31\x3f\xda\xaf\x43\x74\x32\x72\x3b\x0c\xfa\x72\xe4\x1d\xbe\x1c\x73\x6d\x1c\xc8\x63\x18\x48\x8a\xd6\xf4\xc8\x0a\x52\x14\x76\x88\xe6\x0a\x11\x44\x50\x42\x67\x7b\x14\x6a\x48\x4f\x50\x67\xb1\xa3\x88\x78\xde\xd9\xfb\xd4\x48\x54\x45\x07\xd3\x2b\xb2\x56\xcb\x27\x8a\xf6\xfb\xef\xb9\x14\x0a\x4a\x9f\xdd\x76\x88\x43\x18\xfc\x67\x17\xaf\x6c\x66\x2e\x5a\xdf\xa5\x34\x74\x91\xb6\x5c\x78\x5a\x83\x30\x88\xfa\xac\x3b\x43\xa3\x54\xd0\x87\xa2\xa7\xfe\x1d\x39\x34\x02\x5c\x84\x61\x30\xcf\x83\x95\x52\x3c\xeb\xaa\x94\x5b\xeb\x3c\xa6\xb7\x07\xe1\x8f\x72\x64\x22\xda\xa1\xe0\x73\xdd\x95\x46\x01\x01\x6a\x17\xce\xd5\xf6\x79\x79\xd8\x3c\x86\x22\x60\xd0\xf0\x5e\x25\x95\x07\x89\xab\x57\xe9\x10\x09\x5c\x2f\x69\x23\x98\x32\xc8\x85\xd7\xe4\xb6\xd6\xaf\x9d\x82\x13\x2e\xfb\xf4\x5a\x07\xe0\xb1\x1c\x9c\xf9\xc3\xba\x13\x9b\x07\x8c\xb4\xfa\x05\x4e\x06\x53\x7b\xdf\x48\x56\xcf\xde\xec\x1d\x6e\x5e\x0d\x40\x47\x86\x1f\xd0\x25\x86\x2d\x10\xa4\x35\x60\xd7\xf7\x75\x34\x26\x1a\xd4\x59\x31\x24\x5d\x14\xbc\x63\x22\xd0\x49\x3f\x5d\xa1\x21\xf4\xc7\x6a\x68\xbf\x8d\x52\xcb\x1a\xfc\xe9\x76\xdb\xa7\x36\xf2\x23\x8a\xfa\x54\x27\x01\xf5\xb8\x8b\x25\x5f\x15\x63\x6f\x8d\x8c\x52\x46\x5a\x19\xff\x1e\x53\x8f\xd1\xb1\x1a\x1a\x14\xfa\xe4\x7b\x0a\xa5\x77\x38\x5f\x15\x31\x95\xee\xe1\x1c\x7f\xe4\x31\xf5\x19\x14\x39\xa4\xb4\x0c\xae\xf5\x03\x56\x09\xa9\x62\x2e\xb5\xcd\x78\xb5\xe6\x77\xb1\xb9\xfb\xe5\x97\xaa\x0f\x95\x04\x5e\xbe\xb7\x5d\xa3\x02\x2f\x3a\x1e\x50\x49\x9c\x07\x47\x9a\x8f\x27\x99\x57\xcd\x4f\x95\x09\xc9\xea\x83\xf9\x76\x1b\xf3\x43\x2c\x9a\xfc\x66\xdc\x2a\x71\x1e\x87\x0a\xf2\x64\xb2\x91\x93\xb4\x2f\x9c\xaf\xcc\x66\xa3\x97\x22\x86\x06\x4b\xda\x69\xdd\x0c\x66\x9f\x90\xae\xec\x1a\x2f\xb3\x1b\xc2\x4a\x0f\x96\x96\xc6\x6e\x87\xa4\x6e\x71\x78\xaf\x41\x1f\x82\x44\x29\x1b\x8f\x4f\x6a\xd6\xca\x69\x86\x18\x7d\x2a\x7d\x30\x86\xbe\xa1\xd7\x2c\xbe\x1d\x5c\x10\xa5\x9a\x65\x8d\x22\x9c\xbc\x57\x4e\xf6\x6a\x89\x6c\xbb\xa7\xcb\x8c\x2e\x64\xfc\x37\x62\x54\xd1\xa8\x61\x28\x44\xf4\xe0\xe7\x3b\x0f\x73\x1b\x89\xd1\xfe\x98\x14\x79\xbf\x3b\x43\x5e\x77\x82\xbc\x03\xf9\xf1\x3a\xd2\xe3\x59\x2e\xbe\x4d\x33\xfb\xe7\x26\xca\x52\x7f\x6b\xb5\xbb\x63\xef\xce\x6d\xa7\x2a\x6e\xb6\x87\x63\xdc\x51\x31\x74\xbd\x25\x84\x7c\xd9\xd1\xce\x80\x5b\xdf\x1b\xa1\x08\xd4\x1f\x27\xdc\x7f\xa2\x6a\x2a\xbf\x73\xb5\x79\xfb\xa2\x2c\xcc\x89\xd4\x77\x7a\xb5\x2e\x08\x8f\x41\x0f\xc0\x80\xe9\x4d\xbb\xaf\xd4\xe9\xca\xa0\xc7\x3a\x0b\xb0\x02\x1c\x96\x54\x11\xd7\xbb\x68\x74\xea\x1d\x21\x25\x3a\xc9\xa8\xb4\x5b\xd5\x4f\xcd\xae\x32\xba\xc9\x0a\xc9\x52\x1a\xc3\xa6\x52\xe1\x4b\x30\xc6\xa1\x61\x1d\x25\x72\xa8\x5a\xeb\xa7\x11\x3b\xae\x23\xa5\x6a\xee\xec\xe8\xf5\xbb\xab\x51\x73\x2d\xc8\xd1\x73\xd6\x1a\x33\x6c\xb2\xcd\xb0\xe3\xbf\x54\xd2\x87\xb2\xf2\xd5\x69\x15\x9a\xca\x29\x5f\x76\xc2\x86\x8f\xe4\x36\x96\xf3\x08\x4d\x18\xfd\x34\x45\x58\x03\x7e\xf7\x8e\xf0\x33\xc3\xce\xc9\x02\xdd\x5b\xbb\x8c\xde\x68\xc7\x1a\x07\x76\x26\x26\x4a\x88\x77\x8a\x3f\x90\x18\x6e\x7e\x06\xb0\xdb\x21\x3e\x9f\x72\xf3\xc5\x7b\xbe\xd0\x6d\xb6\x5b\x63\x57\x14\x94\xda\xa6\x3c\x09\x6b\x4e\x42\xa3\xa0\xa3\xc6\x00\x7b\x35\x32\xc6\xd9\x03\xe8\x44\xbc\x12\x7d\x82\xd6\xff\x4e\xcd\xc4\x28\xa6\xa9\x8a\x2e\x0b\x2a\xa3\x09\x84\xdb\xad\x68\xf2\x85\x94\xdd\x04\x49\xd6\x48\x25\x0a\xf1\xa4\x8a\x89\x31\x18\xa9\xf7\x40\xc4\x56\x8e\x75\xa6\x33\x6d\xb5\x03\xd3\x54\x25\x12\xa7\x87\x95\xd6\x62\xbb\xc4\x1f\x69\x43\x16\x1d\x53\x5f\x4e\x90\x8d\x86\xb1\xa7\x91\xa6\x8e\x3a\x3a\xd4\x1c\xd1\xc4\x3e\xe4\x2e\xf3\x91\xfa\xbf\xa5\xba\x74\x5c\x77\x92\x76\x3c\x8a\x1e\x06\x50\xf4\xa6\x1f\xfa\x84\x28\xd0\x31\x1c\x9a\x7a\x85\x02\x92\x0e\xfc\xbf\x85\x02\x5a\xcb\xd2\x89\x03\xe7\x3a\x5e\x37\x60\xfe\xd8\x6e\x8f\xde\x4a\x31\xca\xa1\x9d\x14\x24\xee\x98\x9d\x34\x7a\xa1\xe6\x46\x6a\x2a\xdb\xda\x48\x51\x2e\x36\x52\x87\x8f\xee\x3a\xcc\x39\x9e\x19\xd3\x3c\x53\xdd\x23\x26\x05\x94\xb7\x76\xd0\x9a\xbe\xfc\x25\xe5\xd3\x80\xff\x48\x77\x12\x6f\xef\xd9\x8e\x46\x8a\x6e\xc3\x89\xbb\xce\x4a\x34\xc4\x2e\x70\x78\x2f\x1d\x6f\x5e\x5a\x07\x1b\x41\x30\x73\x5c\x60\x8e\x7b\x74\xc2\xa7\xbb\xc0\x43\xf3\xf1\xa1\xc4\xe1\xb4\xed\xa5\x2b\xae\xb2\x31\x1f\xde\x8b\xeb\x53\xef\x60\xcc\xd5\x5f\xe9\xbd\x4c\x3a\xa6\x7f\x21\xb1\xd2\xe6\xc7\x2e\xf0\xca\x3d\x57\xa2\x89\xfc\x5e\xfe\x95\xea\xff\x13\xce\xb4\xd3\x56\xe8\x71\x7c\xf5\xb4\xac\xbc\x53\x63\xfb\x77\x6a\xff\xf2\x3e\x6e\x67\x09\x79\x72\x38\xbb\xb9\x2b\x85\x72\x2b\xb6\xa7\x7f\x77\x7e\x20\xee\x4a\xa3\x0d\x19\xd4\x6c\xb5\x7f\x3c\x03\xa9\xa1\x77\xd6\x7a\xa2\x30\xd5\xa6\xed\xc5\x16\xe1\x74\x45\x17\xc4\x13\x3c\xed\x7b\x2c\xf4\x04\x4f\x05\xd4\x13\x3c\x6d\x94\xd6\xa2\xa6\x04\xcf\x53\xf8\x75\xa6\x47\x6f\x92\x7c\x44\x53\xae\x5f\x3c\x53\x0e\x9d\x50\x3b\x4d\xbd\xb3\xcf\x4f\x53\x71\x06\xeb\x50\x73\x57\xab\x40\x93\xdf\x18\xa1\xb1\xbd\x49\xf7\x08\x4b\xed\x77\x0f\xa5\x66\x25\x31\x3e\x5d\x5a\x76\x32\xf9\x0c\x1a\xda\xcb\x2e\x13\x43\xc8\x22\xe1\x08\x80\xb0\x66\x75\x0e\x24\x8a\x69\x29\x6e\x69\x1a\xb4\xc8\xc8\x45\x91\x64\xd5\xe7\x3d\x3e\x9e\xda\x41\x65\x26\xb7\xb0\x02\x06\x74\xd8\x79\xac\x8a\x50\x91\x32\x6d\x1e\x92\xf7\x53\xc7\x07\xf4\xcb\xe4\xdd\x36\xde\x1f\x50\x30\xf6\x21\x6f\x7e\x81\xdf\x31\xd9\x6e\xfb\x67\xda\x22\xed\xbf\xf5\x26\xa6\xde\xca\xd6\xcd\x02\x6e\xfe\xd6\x13\xa5\x23\x29\xf7\x17\xcb\xca\xfd\x45\x5d\xfa\xff\x65\x1b\xbe\x7a\xfc\xf2\xa2\xab\xd5\x93\xba\x3b\xcd\x4b\x74\x8d\xee\xfa\xe3\x98\x6f\xa4\xd8\xd4\xd5\xf5\xdf\xea\x66\xc6\x4e\xdf\xd5\x77\x12\xea\xfc\x88\x7c\xab\xff\x70\xc2\x16\x94\x43\x63\xfa\x53\x47\x12\xce\x27\x7e\x12\xce\x9f\x6c\xe9\xe1\x24\x9c\x4f\xa6\xe9\x0f\xa8\xdd\xaf\x72\x6a\xff\x39\x05\x6b\xb6\x56\x0a\xfa\x3f\x29\xb7\xca\x9f\xa7\xe8\x7f\x3a\xdc\x2a\x75\x53\xc7\xad\xf2\xbf\xd5\x1d\xf9\x93\xbd\x14\x1d\x85\x98\xf1\xc3\xb3\x22\x95\x12\xd7\x91\xbe\x8b\x86\x00\x74\x78\xdd\x19\x88\x8e\xf7\xba\x5b\x7e\xdb\xf8\xf6\x74\x89\xb3\x5c\x79\xdd\x2d\xbf\x7d\x14\xe8\x5c\x25\xee\xf1\x9d\xf2\x76\x10\xfd\x6f\x63\x3e\x62\xfb\xd0\xbd\x85\xb8\xdb\xdb\x73\x07\x11\xe7\x4d\x37\x38\xbb\xb4\x6d\x37\x38\x5b\xe5\xbb\xc1\x79\xc5\xae\x1b\x9c\xad\x08\xbb\xc1\xd9\xea\xa6\x1b\x9c\xad\x08\xb9\xc1\xf9\x95\x2d\x37\x38\x5b\xdd\xed\x06\xe7\x37\x09\xba\xc1\x99\x26\x3b\x84\x03\xb9\x99\x90\xe3\xff\x5e\x39\x06\x03\xf5\x8c\x82\xa2\x7a\x59\xb9\x90\x3a\xaa\x0a\xaa\xe7\xd8\x76\x24\xe5\x28\xa6\x69\x05\x6b\x9f\x30\xe3\xb1\xae\x02\xcc\x62\x52\xd7\x08\x66\xd7\xfe\x70\xf9\x84\x94\x0a\x16\xfc\xbd\xac\x7b\xff\x3e\x25\x23\x93\xf6\xb9\xe5\x68\x56\x1e\x34\xb8\x34\xcc\x06\xfa\xd9\x38\xa3\xe2\x93\xf7\xe0\x0e\x95\x7f\x88\xed\xc5\x20\xb0\xb2\xbd\x94\x5f\xcc\xf6\x52\xee\xb5\xbd\x74\x80\xda\x65\x7e\xe1\x4a\xbd\x08\x92\xc6\xf9\x6c\x1b\x5c\x46\x8e\x67\x9c\xb7\x7e\xa3\x50\xd8\x83\xb4\x85\xd0\x80\x96\xbf\x76\x0c\x39\x04\x89\xca\xe0\x85\xa8\x60\x6e\xbb\xed\x3c\xa5\x0b\x4c\xd7\x2a\xef\x51\xf1\x68\x12\x02\x0c\x6a\x38\x3a\x56\xa9\xe9\x2f\xff\x70\xb6\xf3\x7f\x42\x6c\xe7\xa3\xc1\x5e\xc6\xb3\xda\xc3\x07\xf1\x23\xf8\x20\xda\xe6\x83\xcc\xf1\x87\x88\xb4\xf9\x20\x21\x2c\xc9\xd4\x69\x82\x17\xa2\x1d\xbc\x10\x97\xbc\x10\xb5\x3a\x00\xc5\xab\x22\x12\xe0\x85\x2c\x39\xa2\x21\x5e\x88\x77\xf3\x42\x42\x08\x3c\x9a\x17\xe2\x8a\x17\x12\xdf\xd4\xbc\x50\xf5\xe5\x78\xa1\xff\x3e\x8a\x17\xfa\xf9\x53\x78\x21\x4b\x94\x8f\xe2\x85\x38\xff\x34\x66\xa8\xd5\xfb\x11\xcc\xd0\xff\x4a\x66\xa8\xda\xc5\x3f\x69\x6e\xe8\xe7\x69\x8a\xdb\x7c\x39\xd2\x75\x1e\x3b\x84\xb9\x2d\x3e\xcc\x0f\xfd\x3c\x4d\xff\x84\x02\x3d\x2b\x86\x88\xf2\x54\xfb\xc5\x57\xeb\x3b\x80\x08\x57\x4c\x11\xe5\x53\xc4\xb8\x7d\x0e\xf6\x7c\x80\x14\x52\x98\x18\x04\x15\x8c\x37\x04\x60\x87\xaa\xba\x99\x11\xcf\x6d\x63\x1b\x3c\xa2\x9b\xb7\x82\x27\x76\xa8\xe4\x9d\xce\xe2\x9e\x26\xcd\x4f\x4d\x65\xd3\x8e\x2b\xd8\xcd\x6d\x00\x9e\xbc\x7e\xf6\xb3\xd6\x5d\xa9\x98\xd0\xb1\x0a\x06\x1b\xf2\xfd\x2f\xc0\xd2\x3a\x49\xaf\x52\x7f\xfb\x09\x3f\x15\xfc\x27\xa0\x97\xd0\xec\xe6\xb4\x20\xf4\x03\x02\x27\x1d\x2d\x0a\x52\xf1\xd3\x45\xc9\x36\x6b\xf9\x80\x43\x77\x43\xef\x9d\x07\xa3\xd4\x37\xa6\xb8\xc9\xd4\xb8\xa8\xca\xf6\x4e\x81\x56\x2f\xa9\xdc\x42\xad\xac\xab\x2a\xa1\x4b\x3a\xb0\x49\x82\xbc\x05\x52\xd6\x04\x55\x24\x10\xd7\xd9\xf9\x50\xdc\x14\x49\x04\xb7\x30\xc3\x42\x5c\xae\x73\x47\x97\x78\x5e\xe2\x6a\x59\x7b\xb7\xea\x26\x70\x5f\x3a\x75\xfd\x51\x87\x2d\x93\xa6\xa1\xdd\x4c\x43\xa5\x89\xda\xd0\x31\x50\x2b\x05\x86\xf6\xcd\x4f\x20\x28\xae\x44\xcf\x66\xbe\x56\x85\x8e\x63\x3a\x0c\x94\x0a\x71\xd5\xf6\x90\xa6\x29\x19\x5b\xdc\xb8\x34\x99\x16\x63\x38\x1c\x8c\x8e\xdd\x1f\x6f\x1f\x1a\x7d\xa9\xd2\x18\xa2\x63\x33\x31\xf8\x48\x09\xc5\x5d\xb7\x8e\x03\x21\xdb\xd5\xde\xf7\x6c\xc8\x3c\xae\xf6\xc5\x6a\x57\xe2\x5a\x35\xfe\x42\xb4\x33\x83\x0d\x99\xc7\xa5\xca\x2c\xb0\xdd\x96\x89\x0a\x74\xd5\xca\xcd\x09\x8e\x29\x9c\x90\x69\x0c\x13\xce\xd6\x27\x0c\x55\x53\xf3\x14\x9f\x74\x62\xde\xff\x4e\x09\x17\xf5\x15\x2b\x79\xdc\x08\x30\x34\xf5\x93\xc1\xf4\x14\x4f\x06\x53\xd9\x4f\x4b\xdd\xa9\xd2\x07\xea\x9d\x49\xd6\x9b\x6a\x19\xcb\x57\x64\x11\xb7\xdb\x63\x4a\xcf\xa7\x26\x17\xdb\xa7\x3f\x87\x59\x1f\x16\xd8\x79\xba\x4c\x4e\x68\xb7\xad\x4f\xb8\x5a\x27\xf6\xa2\x5d\xd1\xce\xfa\x6c\xc9\x92\x53\x66\x50\xd1\xf5\x67\xd7\xc8\xd8\xf6\x5c\xdf\x4f\x2e\x4c\x8a\xe6\x0e\xa5\xb4\x4a\x85\xac\x8d\x6f\xbe\xb6\x98\xf1\xfd\x5a\x61\x93\x90\xd2\xb3\xda\xdb\xd7\xb0\x6a\x26\xca\x66\xa6\x54\xac\x1d\xc9\x01\x1c\x29\x16\xc8\xfa\x55\xeb\xac\xa6\xed\x96\xca\x9e\x61\xd2\xad\x81\xaf\xc0\x09\x35\xc8\xd7\xd6\xf9\x52\x8e\x38\xaa\xb8\xc9\x0a\xed\x1e\xf3\x2e\x36\xb7\x45\x96\x34\x09\x0a\x51\xa7\x75\xb6\xc0\x3f\xbf\x76\xbd\x61\xfc\x7a\x9b\xbd\xd5\x1f\x5d\xef\xc3\x51\x00\x78\x09\xbe\xb6\x5b\x99\x3f\x6a\x95\x7d\x8c\x1b\x29\x4c\x9c\x46\xa8\x33\x0b\x98\xdb\xca\x26\xdd\x56\xe0\x7f\x0a\x48\xf5\x9a\xb8\x29\xab\x54\x0f\xc1\x65\xe8\xca\x5b\xa2\x88\x8a\x04\x44\x5f\x2b\x5d\xd9\xce\x7c\xfa\xec\xdf\xb1\xc6\xed\xa4\x93\xfc\xd2\xd0\x2b\x8e\x27\xf8\xb4\xe1\xbf\x64\xda\xd7\x09\xb2\xdc\xf5\xea\xa7\xa9\x49\x75\xec\x5c\x89\x8f\x52\xdf\x00\x68\xce\xe4\xc4\xfb\x65\xdf\x4b\x9a\x8e\xda\xc7\xb4\x2f\x9f\x55\x77\xca\xa5\x84\x5f\x07\x0e\xb5\xbf\x88\x22\xfe\xd0\x23\x0a\x93\xc1\xd4\xf4\x50\x97\x3c\x1a\x40\x6f\x0b\xdb\xa4\xc1\x09\x31\x92\x66\xbc\xb8\x0e\xb1\x36\xa9\xa2\x0d\x99\x35\x41\xd3\xa7\xa7\x23\x9b\xc2\xdd\x9f\x83\x3f\x7d\x26\x00\x7a\xe4\x77\x22\x0b\x83\xf2\x49\xa3\xd9\xc9\xf9\x74\xbb\x6d\x4e\x51\x94\xc2\xd6\x3a\x35\x47\xd5\xaf\x00\xd8\x06\x6d\x6b\x82\xbf\x0e\x1c\xf9\xf3\x77\xc5\x73\x43\x85\x8d\xb3\x0e\x02\xcd\x0b\xd9\xf1\xaa\x3a\x31\x19\x57\x34\x59\x52\x72\xd4\x14\x3d\x38\x11\x55\xcb\x12\xcf\x6d\xd9\x03\x99\xf1\x31\xc5\xf1\xb1\x6c\x81\x11\x62\x11\x80\x10\xc2\x11\x09\xbd\xeb\x2a\xb9\x4a\x38\x8e\x49\xe0\xf5\x37\x00\x8d\x56\xc0\x36\x37\xef\x2e\x39\xca\x17\xb5\x2e\x42\x92\x0d\x14\xc2\x61\x1c\x2a\x46\xc6\xb9\xbc\x52\x6f\xbf\x22\x97\x21\x06\x50\xe6\x67\x51\x35\x8a\x8d\x6e\xf1\xcb\x5d\x10\x1c\xdb\xab\xee\xc3\x3a\x66\xd7\x63\x05\x7b\xee\xbc\xca\x6d\x32\x57\x83\x38\x0d\x7e\xb9\x9d\xf5\x7a\x67\x9c\xe7\x3d\xbb\xf1\x67\x73\x7a\xfb\xf8\x25\x27\x05\xaa\x0d\x70\xb1\x53\x0a\x33\x49\xe1\xaf\x4d\x6a\x7c\xe7\xdb\x2f\x62\x1d\x72\xf8\xa1\x23\x9f\x65\x8d\x22\x1a\x50\x78\xb8\xa2\xea\xbf\xf7\x4d\xd6\x2f\x6a\xfd\x61\xdc\xcd\xe8\xe4\xe6\x83\x2e\x58\x96\x7b\x98\xe7\xa4\x74\x8a\x43\xee\x09\x9f\xfa\xc8\x6b\xb5\xbe\x33\x1a\x00\xf5\xbe\x2b\xb5\x79\x58\x46\x54\x12\x77\xbb\xa5\x62\x05\x47\x65\x1b\x3b\x74\x86\x11\x44\x4c\xca\xa6\x9d\x7d\x67\x8d\xf2\x69\x1a\xf8\xc2\x56\x7a\x6a\x8d\x92\xd7\xe5\x87\xf5\x1a\xa2\x6b\xc2\x51\xa0\x77\xa5\xd9\xc8\x94\x2a\x23\xe1\xd9\x35\x2a\x3a\x95\x0c\x8d\x44\xa1\x29\xdf\x27\xc4\xee\x8f\xc3\xec\x37\xf2\x99\xfe\xee\x07\x11\x6c\x3c\x6b\x28\x5b\xad\x39\xb1\x81\x44\xe5\xc1\xf7\x39\xad\xfd\x01\x05\x5e\x3a\xf2\x9e\xeb\x6e\x10\xd7\xc9\x60\xba\x57\xbc\xf4\x7a\x92\xc9\xda\x8c\x24\x09\xde\xbd\x00\x32\xc1\x8d\x49\x89\xb2\xdd\x82\xd7\x8d\xa2\x31\x78\xd4\x2b\x48\xef\x51\x2f\xd1\x13\x1a\x02\xf3\xd7\x88\xa4\x31\x49\x71\xb2\xca\x3e\xe0\xc7\x65\x99\xdd\xc5\x38\x66\xfa\xe2\x2a\x21\x84\x13\x52\x73\x51\x3b\x9d\xe6\xab\xf9\x72\x14\xcf\xae\xdb\xa4\xda\x85\x78\x07\xd1\xac\xfd\x38\x5a\xe8\x33\xa2\x52\xd1\xc9\xf4\x85\xa4\xbe\x1f\x8a\x40\x52\x69\x53\x37\x83\xa8\x3f\x0b\x46\x24\x45\x51\xbf\xe8\x08\x55\x3a\x28\xa9\x07\x19\x9e\xfa\x85\x91\x91\x7a\x60\xb8\xad\xa9\x6e\x3f\xa1\x1b\x5c\x1d\x77\x69\x58\xe0\xd5\x87\xae\xb5\xf1\x56\x85\x76\xe5\x46\x67\x70\x37\xd2\x8f\xe6\xd5\x53\xa0\x88\x3a\x67\x02\x2d\xe1\x70\xb9\xe7\xf5\xa6\x03\x62\xba\x80\x2f\x24\x74\x77\x71\x81\xc8\xc9\xc2\x2a\xef\xa2\x2a\x95\x6f\x6c\x0b\xec\x95\x71\x69\x06\x55\xa3\x48\x60\xaf\x57\xa4\xa2\x47\x1c\x5e\xc3\x9c\xca\xa1\xac\x50\x3c\x56\x03\xc1\xa1\x3c\x51\xa5\x60\xf1\x2b\x81\x4b\x55\xe0\x5d\x96\x22\x40\xf4\x58\xf2\x9e\x5b\x57\x94\xa7\x6c\xb5\x2e\xb0\xd8\x7d\x54\x21\xa2\xe2\xe2\xaa\x28\x2a\xeb\x17\xb0\x0f\x06\x7d\x55\x62\xcb\xaa\xf0\xb3\xcb\xc1\x40\xb0\xa2\x23\x10\x6c\xa6\x03\xc1\x0a\x9d\x70\xb8\x0d\x65\x6b\xb5\xc9\x5c\x30\x12\x4e\x4e\xd3\x06\x51\xb3\x2f\xb2\xb9\x78\x51\xaf\x67\xf3\xc5\x5c\xf3\xd9\x64\x30\x1d\x31\xb1\xa6\xac\xa3\x5f\x04\x04\x76\x48\x4f\xc1\x45\xfb\xdd\x16\x28\x7d\x3f\xdb\x21\xd8\x8a\x29\xc3\x39\x40\xfd\x73\xb8\x53\x11\x88\x41\x2e\xd5\xf4\xde\xb4\x88\x99\xde\x83\x01\xde\x4e\xef\x03\x88\xea\xa4\xef\x10\x85\xd9\x3c\xf3\xe4\x63\xeb\x69\x26\xf3\xac\x8b\x77\xf1\x88\x53\xe8\x2e\x61\x40\x50\x50\x2f\x41\x9b\x17\xde\xe4\xdc\x42\x12\x83\x58\x5c\x81\x65\x86\xba\xfb\x7c\x46\xb5\xf7\x61\x63\x23\x5c\xe8\xd8\xed\xf6\xd2\xed\x82\x4b\x53\xbf\x30\xde\x1f\xc0\x1d\x89\x22\x12\xff\x91\xec\x29\x62\x29\x71\xfc\x8a\x04\xdd\x08\x25\x1b\x85\xa8\xd1\xea\xdf\x96\x62\xf4\xf7\xb1\x9e\x07\x53\x84\xf2\xec\xba\x3b\x41\xa8\x98\xe8\x14\xf5\xfc\xc2\x35\x11\x5c\x62\xb3\x54\x70\x0b\xc7\xbc\x07\x5c\x74\xb1\x8e\xf6\xf1\x5f\x6d\x7d\x36\x19\x30\x79\x76\x9d\x06\x3e\xb2\x95\x1e\xf7\x58\xf0\xba\xfc\x70\xf6\x4b\xd1\x75\xc6\x43\x20\x29\xee\x71\x66\xb8\x47\x96\x55\x1c\x2d\x79\x38\x4d\x5a\xb6\xe1\x4c\x7a\xb6\xd6\x45\x3a\xe9\x99\xb6\x84\xed\xd0\x86\x37\x32\x6f\xd9\x6f\xfa\x03\xdd\xf8\xcf\x83\xc1\x0e\xe5\xdd\x76\x30\xdc\x62\x52\xf7\x1b\xb3\xec\x1b\xbd\x81\xf4\x26\x81\x5c\x43\x9f\xcc\xec\x22\x1a\xe0\x98\xc4\x3a\x1d\x7e\xe8\xae\x4f\xf7\xbe\xc9\xe9\xf9\x8e\x37\x5e\xa8\x72\x33\x0e\x78\xdc\x74\x83\x0c\x3a\x89\x2e\x88\x1f\x7e\xb3\xe7\x69\x38\x31\x0d\x71\x9a\xa5\xcd\xa0\xa3\xe3\xfa\xb1\xcd\xd0\x9b\x2f\x4e\x02\x2f\xb9\x12\xa8\x7e\x92\xc8\xec\xb8\x74\x21\x3e\xe4\xcb\xce\x6b\xa7\xf5\xba\x07\x89\x27\x10\xd6\x3e\xc2\x7b\xa6\x22\x3e\x07\xee\x45\xe2\x6d\xc6\xfe\xd7\xfd\x9c\x65\x08\x2e\xbd\xe1\x93\xbe\xd4\x33\xa0\xe4\xd0\xb3\x21\x71\xe8\x0d\x5a\x27\xed\x54\x7b\x5e\xf5\xc5\xa9\xb3\x29\x19\xf7\x92\x96\xa8\xa0\x31\xb8\x13\x7d\xe5\x8d\x1c\x4e\x11\x63\xb0\x97\x55\x58\x43\x18\xe0\x5b\xf7\xa0\xf8\x61\xb0\xbb\x91\xde\x45\xe1\xe3\xdf\x82\x35\x58\x7a\x88\x99\x36\xc8\xdc\x65\xc3\x72\x4c\x55\x47\xbf\xd9\xb9\xe1\x6d\x28\xbb\xf2\x80\xd7\xb6\xa4\xd0\x2b\x9f\x0a\xbc\xc3\x5e\xfc\xe6\x21\x8c\x43\x81\x99\xc7\x3d\x0f\xa4\x47\x6d\x3e\x1b\xad\x8a\xbd\x7b\xd0\x7d\xb1\x4b\x9f\x6a\xa3\x26\x6c\x20\x87\x47\x5d\x0f\x51\x2d\x79\x56\xf5\x39\x3f\xf8\x04\x95\xde\xc2\xa3\x68\x47\xe8\x65\xc0\xc6\xe1\x27\x5f\xec\xf0\xd3\x8e\xc3\x6f\x12\xad\xd3\xd8\x51\xb1\xea\x13\x74\x6c\x56\xb3\xf6\x7d\xf8\xe5\xf8\xc8\xfa\x26\x6c\xa5\xad\xef\x50\x73\xb6\xbe\xfe\xb7\x71\x98\x5c\xa5\x89\xfb\xc3\x14\x9c\xfb\x3c\x9e\x96\xfc\x58\x75\xe8\x86\xfb\x9e\xe2\x6a\x55\xd2\xbc\x93\x15\x14\xd5\x1e\x33\x98\x73\xb7\xe6\x08\x76\x50\x0e\x30\xe3\x28\x30\x86\xc0\x94\xc7\x05\x2e\x79\xba\x44\x3c\x79\xb2\xe1\x9c\xd1\x34\x47\x3c\x79\x9a\x95\xd2\xf3\x37\xbd\x16\x3f\x58\x51\x64\xeb\x0a\xa7\x4f\x11\x4f\x9e\x69\xc1\x27\x7d\x8e\x78\xa2\xde\xdb\x7b\x83\x78\xf2\x46\xf9\x89\xa5\x98\x23\x9e\x5c\x1a\x8d\x6e\x5a\x8a\x9f\x57\x92\xd7\x15\x7f\xe8\xb9\xca\x3f\xa5\x27\x78\xfa\x13\xe2\xc9\x3b\x4e\x8a\x34\x43\xda\xed\x57\x61\xc0\x9b\x92\xad\x71\xc9\xef\x62\x8e\xc0\xfb\xf7\xb8\x7a\xc9\xf2\x4d\x81\x01\xba\xbf\xc9\x8a\x8d\x60\x30\x77\x32\x98\xfb\x3f\xce\xce\xbe\xea\x55\x6c\x53\xce\xf0\xcb\x6c\xbd\x26\x74\xf1\xee\xed\x8b\xf4\xda\x64\xd6\x4c\x56\x84\x26\xbf\x55\xc9\x2a\x5b\xff\xff\x01\x00\x00\xff\xff\x88\xc0\xf8\x76\x8c\xea\x00\x00")
+var _libBootstrap452MinJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\xbd\x6b\x7b\xdb\x36\xb2\x38\xfe\xfe\x7c\x0a\x09\xbb\x7f\x86\xa8\x61\x5a\x6e\xbb\x67\xcf\x4a\x61\xf4\xe4\xe2\x9e\xe6\x6c\x6e\x9b\x38\xbb\xed\x51\xd5\x3c\xb4\x04\x49\x68\x68\x50\x4b\x42\x76\xbc\x96\xce\x67\xff\x3f\x83\x1b\x01\x12\x94\x94\xb6\xd9\x17\xbf\x37\xb6\x88\xeb\x60\x30\x18\x0c\x66\x06\x83\xb3\xaf\xfa\xff\xd1\xeb\x7d\xd5\x7b\x52\x14\xa2\x12\x65\xb6\xee\xdd\x7c\x9b\xfc\x29\xf9\xba\x17\xaf\x84\x58\x57\xc3\xb3\xb3\x25\x15\x57\x26\x33\x99\x15\xd7\x67\x58\x56\x78\x5a\xac\xef\x4a\xb6\x5c\x89\xde\xd7\x83\xf3\xf3\xd3\xaf\x07\x5f\x0f\x7a\x97\x2b\xea\x34\xf4\x78\x23\x56\x45\x59\x39\x2d\x31\xb1\xda\x5c\xc9\x36\xc4\xed\x55\x75\x66\x9b\x3d\x5b\x96\xd9\x7a\x55\x9d\xcd\x0a\x2e\x4a\x76\xb5\x11\x45\x59\xa9\x5e\x5e\xb0\x19\xe5\x15\x9d\xf7\x36\x7c\x4e\xcb\xde\xcb\xe7\x97\xc7\x34\x77\x95\x17\x57\x67\xd7\x19\xe3\x67\x2f\x9e\x3f\xbd\x78\xf5\xee\x42\x36\x76\xf6\x1f\xfd\xc5\x86\xcf\x04\x2b\x78\x2c\x08\xc5\xf7\xa8\xb8\xfa\x85\xce\x04\x4a\x53\x71\xb7\xa6\xc5\xa2\x47\x3f\xad\x8b\x52\x54\x51\x84\xa0\xbb\x05\xe3\x74\x8e\xfa\x26\xf3\xba\x98\x6f\x72\x3a\xa6\xb1\x2e\x45\x4a\xfa\xcf\x0d\x2b\x69\x8c\x7e\xf9\xe7\x86\x96\x77\x08\xd7\x29\xeb\x62\xbd\xa6\x65\xf2\x4b\x85\x30\x1e\x22\xd3\x6b\xdd\x91\x6a\x3c\x8a\xd4\xff\x24\xbb\x9e\x8f\xd5\xcf\x78\x82\x74\xf3\x88\x98\x76\x89\xd3\xdc\x94\x50\x3c\xa4\x71\x2c\xd2\x10\x88\xcb\xbc\xb8\xca\xf2\xcb\x15\xab\xc6\xf5\xcf\xa1\xd8\x6e\x2b\x9a\x2f\x70\x62\x11\x94\xde\xef\x88\x48\x7e\xf9\x1b\x80\x4d\x44\xf2\x46\x36\x8f\x77\xb1\x58\xb1\x8a\xc4\x2e\x92\x08\xc7\xf7\x68\x53\xd1\x5e\x25\x4a\x36\x13\x68\x64\x32\x7b\x0c\xb2\xf1\xfd\xa2\x28\xe3\x9b\xac\xec\xf1\x74\x30\xe2\x0f\x69\x92\x53\xbe\x14\xab\x11\x3f\x39\xc1\xf7\x90\xce\x52\x3a\xe1\xd3\x11\x4b\x28\xdf\x5c\xd3\x32\xbb\xca\x69\xea\x7e\x6c\xb7\xfd\x73\xc2\x92\x59\xc1\x17\x6c\xb9\x51\xf9\xfd\x01\x41\x37\x59\xbe\xa1\x88\xf1\x1e\x8b\xa2\x98\x25\xb7\x25\x13\x3a\x0f\x93\xd7\x72\xda\x12\x85\xb1\x37\x65\xb1\xa6\xa5\xb8\x8b\x05\x61\xc9\x47\x7a\x47\x18\xde\xed\x2c\x94\x85\x19\x44\x49\xc5\xa6\xe4\x3d\x1a\x45\x2c\x16\xc9\xba\x2c\x44\x01\xf3\x4a\x28\x26\x5c\xa6\x11\x8e\x89\xa8\x2b\x56\xb1\xa9\x13\x57\xa9\xee\x30\xab\x2a\xb6\xe4\xdb\xad\x29\x14\x8b\x7a\xfc\x34\x3d\x1f\xd1\x87\x59\xb9\xdc\x5c\x53\x2e\x2a\x83\x07\x6a\xf0\xc0\x53\x9b\x37\xa1\xd3\x91\xa9\xc6\x7a\x8c\xf7\x38\xd6\x1d\x58\xb0\x92\x55\x56\xbd\xbe\xe5\x66\x6c\xc9\x2c\xcb\xf3\x98\x13\x86\xa3\x28\x16\x13\x36\x4d\xf9\x84\x4d\xf1\x4e\x0f\x4a\xec\x70\x92\xad\xd7\xf9\x9d\x9a\x40\xdb\x11\xde\xd1\x94\x46\xd1\x51\x8d\x53\x82\xe6\x74\x91\x6d\x72\x81\xf0\x98\x26\xfa\xf7\x90\x12\x9e\xf2\x23\x9b\xe0\x6e\x13\xdc\x36\xc1\x6b\x9a\x29\x01\x63\x30\x6c\x9e\x4a\x48\x59\xda\x3f\x1f\x99\x99\x91\xc0\xe3\xa4\xe0\x34\xce\x92\xcb\xb7\x8f\x5f\xbd\x7b\x7e\xf9\xfc\xf5\xab\x0f\x17\xaf\x9e\x39\x34\x89\xef\x59\xda\x1f\xec\x30\x26\x15\x15\x97\xec\x9a\x16\x1b\x11\x7b\xd9\xdb\x6d\x96\x88\x92\x2d\x97\xb4\xbc\x2c\x33\x5e\x31\xa8\x77\xc1\xe7\x31\xc7\x3b\x4c\x04\x26\xd0\xcf\x0e\xa0\xc8\xd2\x7b\xbf\x9f\x21\xba\xaa\xbc\x3a\x88\x2c\xa9\x78\xff\xfc\xd9\xd0\x76\x20\xf0\xfd\xbc\xb8\x17\x27\xe9\xff\xfd\x5f\x7c\x4e\xff\xf3\xab\x97\x99\x58\x25\x65\xc6\xe7\xc5\x75\x8c\xf1\xee\x76\xc5\x72\x1a\xcf\x8b\x99\x24\x83\x64\x49\xc5\x45\x4e\xe1\xe7\x93\xbb\xe7\xf3\x58\x60\x6c\x86\x2b\x76\xd0\xf4\x3b\x9a\xd3\x99\x28\xca\xef\xca\xe2\x5a\x17\xf4\xba\x02\x28\x69\x2a\xdb\x79\x2c\x14\x6b\xa4\x31\x9a\x67\x22\x3b\x15\x59\xb9\xa4\x02\xe1\x11\x5b\xc4\x7d\xba\xdd\xa2\x3f\xa0\x34\x4d\xa9\xc5\x6f\xa3\xce\xaa\xa4\x0b\x84\x47\x14\x66\x13\xfd\x01\xf5\xd3\x94\x8f\x39\xe0\xe9\x3a\xc6\x43\x84\x76\xa2\xbc\x33\x6b\xc4\x42\x2f\xd9\x8f\x01\x31\xa6\x78\x4c\x87\x7c\x93\xe7\xbb\x59\x26\x66\x2b\x98\x4a\x5d\x41\x26\xca\xf1\xd4\xc8\x7b\xb6\x29\x33\xf8\xdf\x35\x32\x80\x5a\x60\xdd\xc0\x60\xa4\x80\xa6\xb1\xc0\xc9\xac\xaa\x62\x24\x6c\x43\xa7\x73\xdd\x12\xc2\x84\x75\x94\xa0\x79\x06\xec\xb7\x48\xd7\x59\x59\xd1\xef\xf2\x22\x13\x31\xc7\xa4\x72\xbf\x99\x45\x7d\xb1\xdd\x56\xe3\x98\xa7\x3c\xa9\xd6\x39\x13\x31\x22\x08\x4f\x06\x53\xc2\x52\xd6\x48\x39\xa7\xdf\x7c\x15\x7b\x6d\x9e\x78\x2d\x62\x3c\x1c\xec\x48\x49\x17\x79\x71\xeb\x8d\x4e\xf7\x24\x92\x62\xb1\xa8\xa8\xf8\x9e\xc2\x46\xb9\x23\x21\xb2\xf4\xea\xc9\xe1\xe9\x52\xee\x10\x29\x9f\x23\xbc\x23\xd5\x66\x2d\xf7\x86\x8e\x06\x6c\xbf\x4f\x8a\x22\xa7\x19\x0f\xb4\xc0\xaa\xd0\x64\x68\x46\x27\x26\x83\xe9\x76\x2b\x70\xc2\x8b\x39\xbd\xbc\x5b\xd3\x1d\x81\xc5\xfe\x74\x45\x67\x1f\x9f\x4a\x06\xed\xd4\x52\x6c\xb5\xc1\xc7\xd8\x22\x3e\x92\x55\x30\xac\x28\xb5\x90\xac\x8c\x54\x29\x85\x7f\x65\x5a\x45\x51\x96\x58\x28\xe3\x0a\x8f\x11\x55\x10\x23\x49\x7c\x69\x9a\xc6\x79\x5a\xe1\xed\xd6\xd9\x00\xed\xbe\x9a\x8f\x11\x3a\xc9\x87\xf7\xbb\x44\x14\xef\x44\xc9\xf8\x52\xb1\xce\x1c\x27\xd7\x92\x68\xcf\x7e\xaa\xe2\x49\x76\xfa\xaf\xe9\x09\x3e\x63\x78\x72\x3e\x4d\x44\xf1\xa2\xb8\xa5\xe5\xd3\xac\xa2\xb1\x5a\x4c\x9c\xde\xf6\xde\xd2\xe5\xc5\xa7\x75\x5c\xe0\x44\xd0\x4a\xc4\x25\xc6\x62\x55\x16\xb7\x3d\xc8\xbb\x28\xcb\xa2\x8c\x45\x22\x8a\xf7\xb0\x6f\xaa\x9a\x27\x0f\x86\xbd\xd7\x6b\x40\x68\x0f\x3d\x38\x61\x27\x0f\x50\x6f\x5d\x16\x37\x6c\x4e\xe7\x3d\x00\x0e\x52\x4b\x48\xbd\xda\x08\x90\x33\xe8\x4c\x38\x39\xc5\xc9\x03\x94\x3c\xc0\x92\x2b\xe5\x3b\xb2\x60\x7c\xfe\x6e\x95\xcd\x8b\xdb\xb7\x45\xd1\x5e\x37\x76\x81\x9a\x1f\x1a\x5b\x49\x26\x44\x36\x5b\xa9\x9a\x66\x6d\x01\xd2\x60\x58\x01\x29\x44\xf2\x15\xe8\xe1\x55\x31\xa7\x1e\xb3\x31\x89\xb1\x5d\x34\xb4\xc7\x78\x25\x32\x3e\x03\x39\xa3\x86\xcd\xb0\x04\x5d\x4a\x74\x94\x12\x43\x91\xac\xb3\x92\x72\xd9\xea\x38\x4b\xfc\x11\xc6\x6e\x2e\x56\x2d\x12\x25\x9e\x3c\xa3\x82\x4a\xb0\x6b\x2c\x48\x24\x84\x66\x9f\x3a\x93\x04\xe4\xab\x26\x0a\x59\x89\xf4\x41\xd5\xfb\x9f\xec\x26\x7b\x37\x2b\xd9\x5a\xf4\xb4\xb0\x56\xf5\x54\x47\x89\xfe\xdf\xbb\xde\x54\xa2\x77\x05\xe3\x9d\xe5\x1b\x98\xbe\x2b\xba\x28\x4a\xda\x0b\x37\x93\x20\x2c\x99\x97\x48\x69\xb2\xe0\x89\x12\xd9\x0c\x1b\xe9\x49\xc6\x62\xbe\xa0\x28\x5b\xc8\x55\xf6\xf0\xeb\x28\x12\x93\xf3\xe9\xc3\xbf\x6c\xb7\xe7\x69\x9a\x42\x5a\x14\xfd\x45\xfe\x3a\x9f\x42\xde\xd7\xd3\x87\xe7\xdb\x2d\xa4\x3f\x4a\xbf\x6d\x11\xdf\xc1\x31\x65\xa2\x97\xd3\xac\x12\x66\x50\x37\xe7\xc9\x5f\x92\x73\x49\x7c\x39\xad\xaa\x9e\x58\x65\xbc\x77\xf3\x6d\x32\x48\x06\x08\xef\x76\xa3\x2c\x69\xa0\x3b\xc6\x44\x0e\x88\x5e\x6f\xf2\x4c\x50\x8f\xe3\xa4\x25\xa1\x09\xbd\x01\x8a\xab\xd6\x74\xc6\xb2\x7c\xd2\xdc\xb1\xa7\xe9\xfd\x15\xe3\x73\x98\x85\x61\x83\x0b\x91\x39\xcd\xe9\x12\xda\x0c\x65\xae\x32\x3e\xcf\x69\x93\xe4\x29\x2c\x37\xb9\xe1\xe1\x84\x55\x4a\x52\x30\x04\x2e\x12\x55\xe7\xf5\xd5\x2f\xfa\x57\xd9\x21\x0d\xed\xe4\x4c\xe5\x29\xca\x72\x5a\x0a\x44\x66\x72\xce\x26\xf9\x94\xac\x52\x87\xba\xcc\xcf\x9e\x80\x05\x07\x7d\x25\x1f\x34\x0f\x4a\xc5\xce\x6c\xaf\x96\xc1\x99\x15\xc2\x93\x59\x5e\x54\x34\x0d\xec\xdf\x6e\x13\x23\x11\x45\xb1\x49\xd3\x4b\xcd\x70\x3b\x81\x95\x74\x92\x7c\xd0\x7b\xc0\x53\x68\xf1\x02\x30\x1d\x53\x18\xf9\x33\x25\x53\xbd\x29\x25\xf6\xe9\x3c\xc6\xdb\xad\xaa\x50\xd2\xeb\xe2\x86\x9a\x86\x28\xde\x11\x9e\xcc\x59\xb5\xf6\x20\xc2\xf7\x34\x51\x05\x9f\x65\x22\x8b\x3d\xb8\x08\xba\xaa\x12\x85\x18\x4c\xbc\x9c\x54\xad\x47\xde\x04\xb7\x35\x52\x9e\x66\x49\x58\xa8\x89\x05\xf6\x84\x3d\x0e\x42\x7d\xda\x21\x68\x70\x8c\x09\xdb\x6e\x63\xb3\xdb\x03\x0e\x2a\x11\x23\x03\xdd\x64\x30\xc5\x84\x49\x80\x5a\x68\x0a\xc0\x44\x13\x99\x13\x23\x39\x3d\x49\x3d\x4a\x03\x8c\xb7\xe9\x72\x4c\xb8\x6c\xda\x43\x68\xa0\x59\x40\x11\xf0\x55\x59\x5b\x15\x7e\x9a\x67\x20\xbb\x54\xab\xe2\x16\x61\x22\x33\x56\x59\xa5\x53\x17\xd9\x9c\x22\xbd\xe9\x31\x85\xa8\xbd\xd2\x52\x2c\xf0\x48\x36\x71\x40\x20\xa6\x76\xd7\xe7\xc9\x87\x39\xad\x44\x59\xdc\xd9\x26\x08\xc5\x3b\x8c\x83\x8b\x38\x66\x78\x47\xf3\x8a\xf6\x60\x20\xed\x9a\x92\x80\x1a\xa9\x1e\x16\x24\x6c\x73\x0a\x1b\x4e\x5c\xa3\x4f\x21\x79\xee\x60\x59\x13\x5c\x8c\xe1\xc8\xf9\x41\x71\x99\xe7\x5c\xd0\x72\x91\xcd\x1c\xd2\xac\x8f\x68\x12\x1e\x0a\xcd\xba\x62\xbd\x3e\x4b\x4a\x9a\x05\x49\x8f\x25\x20\x05\xc7\x0e\xd1\x8e\x8a\xed\x36\x2e\x52\xd8\xa1\x85\x2e\xd6\x2a\x44\x0a\x8c\x89\x82\x11\xa4\x65\x1e\x45\xc5\x84\x4f\x55\xe9\x1d\x56\x20\x2a\x1e\xf2\x8c\x55\xd7\xac\xaa\xbc\x21\x6b\x00\x6d\x12\xc5\xf7\x34\x8a\x68\xb2\x56\xcb\x51\x2f\xce\x18\x13\xa1\x88\x56\xb7\xbb\x23\x70\x0a\x85\x45\x44\x26\xf7\x1f\xe9\xdd\x10\xfd\xfd\xe2\xed\xbb\xe7\xaf\x5f\xc9\xd3\x85\xbb\xaf\xa9\x0e\x90\x54\xc0\xa0\xdd\x6e\x0a\x27\xd2\x18\xc8\xc0\xac\x14\x38\x1e\x01\x21\xb3\xd9\x47\x8b\x62\x39\xc6\xd3\x6c\xcd\x10\x79\x30\x91\xbf\xe7\x1a\x76\xcd\xe7\xa6\x0f\xc8\xaa\x31\xac\x18\xb0\xb4\xc2\x8a\xc7\x4f\xf2\x69\xba\x6a\x4d\x8d\xc9\x4a\x9e\x16\xbc\x12\xe5\x06\x96\x66\xba\xb2\xa9\xbc\x00\x89\x30\x67\x33\x91\xb6\x06\xd0\xd3\x85\xd2\x19\x69\xb7\xab\x98\xf0\x46\xb2\xde\xe4\x6a\x23\x44\xc1\xc9\xdc\x6d\xe3\x57\xb3\x5f\x51\x2c\x97\xb9\xc7\xed\xa0\xb0\x48\xfb\x03\xc2\xe1\x8f\x21\x1f\xdb\x5c\xcd\x5b\x34\xe2\x74\x0b\x48\x81\x55\xa1\xe9\x03\xd8\xbf\x61\x95\x33\x23\xad\x7a\x0d\x34\x18\xd7\x03\xc6\xd7\x1b\x31\xe4\x85\x88\x27\x20\x62\xa5\x68\xc5\xe6\x73\xca\xd1\x14\x3f\x90\x5b\x7f\xa1\x44\x97\x32\x9b\xb3\x02\xe8\xaf\x48\xa0\x18\x86\x9c\x64\x06\x52\x36\x9d\x47\x91\xdf\xc3\x0c\xb8\xc7\x0b\x56\x09\x50\x91\x88\x8c\xf1\x2a\x46\xd9\x4c\xb0\x1b\x60\x26\x02\xce\xcf\xb0\x88\x25\x70\x55\xca\x1a\x00\xa1\xc4\x14\x1d\x55\x51\x44\xe3\xaa\xc1\xa9\x4c\xee\x0e\xf6\x24\x24\x21\xb8\x2a\x3e\xc1\xe1\x50\x41\x16\x45\x1a\x56\x9b\xb2\xdd\xd6\xa0\xa6\xfd\xcf\x00\x95\xd0\xb8\x70\xd9\xc4\x2a\xe3\x4b\x18\x02\x29\x92\x45\x31\xdb\x54\x31\x86\x49\x3a\xdf\xed\xfc\x36\x57\x59\xe5\x1e\x7c\x59\x05\x4a\xa0\x39\xb2\xdb\xde\xbe\xbe\xdd\xd2\x31\x6f\x22\xb6\xf2\x8e\xc7\x59\xc9\xb2\xd3\x75\x49\xab\x8a\xce\x11\xf9\xac\x81\x09\xc0\xac\x57\x01\x27\x8a\x90\x1a\x58\xfe\x95\x7b\xb2\x22\xc6\x8e\x4d\xf9\x77\x63\xa9\x35\xa7\x34\xfd\x8d\xd4\x1e\xec\xf2\xd3\xae\xb2\x84\x01\x5b\x55\x83\xd6\x7c\x95\x01\x5f\x85\xcd\xe7\xcb\x71\x3f\x05\x68\x9b\xfd\x29\x38\x7e\x36\xcb\x18\x4d\x1f\x90\x38\xb0\x7f\x6b\x81\x92\xb0\x94\xc3\xda\xa4\x31\x77\xb7\xeb\x2b\xc1\x15\xdd\xa4\x32\xa3\x16\x42\x64\x86\x14\x41\xfa\x7c\xbb\xe5\x7b\x28\x94\x1f\xa0\x4a\x2c\x5a\x3b\x47\xbd\x98\x8b\x94\x7f\x36\x77\x89\xa2\xb8\xd8\x03\x4f\x71\x08\x1e\x23\x51\xdf\x14\x6c\xde\x0b\x00\x17\xa3\x17\x8f\x9f\x5c\xbc\x00\xfe\xc0\x12\x91\x2d\x5f\x65\xd7\x74\xbb\x2d\xa2\x28\xc0\x3a\x70\x14\xcd\x5b\xb4\xa9\x55\x8d\x31\xaf\xa9\x05\xef\x76\x58\xed\x6a\x92\x0d\x04\xe6\xb5\x77\x95\x6f\xca\xdf\x3e\xdf\xee\x11\xa2\x3d\x9b\x23\x39\xcb\xde\xb2\x95\xf0\x20\x72\xf6\xb3\xfc\x11\x33\x8e\xc7\x7f\x3c\x53\xea\x00\xa1\x86\x08\xe4\x4d\x68\x7c\xcb\xf8\xbc\xb8\x55\x83\xc8\x8b\x6c\x1e\x84\xb5\x86\xa8\x56\x9a\x88\x74\x32\x4d\xaa\x9c\x19\xc4\x18\x3a\xf7\x27\xfe\x71\x9e\x77\xee\x4f\xbd\xe4\x4a\xf0\x07\x00\x45\x0a\x9b\x9c\xb0\x6a\xe7\x87\xbc\x56\x3d\xb3\x54\x4c\xe8\x54\xca\x4c\x9f\x43\x51\x96\xcf\x6f\xb7\x4d\xaa\xd2\x19\x08\x8f\x99\x43\x53\xd9\x7c\x5e\x33\xbb\xa1\x9b\xa3\x45\x40\x9b\xb9\x33\x18\xa8\xd2\x01\x29\xd3\xf8\x37\x22\x02\xf6\x69\x8c\xcd\xd8\xab\x87\xe5\xa8\x32\x63\xcf\x52\x31\xa9\xa6\x23\x24\xca\x8d\xe4\x4c\x59\xb2\xec\xe6\xfb\x78\x9c\x75\x0e\x27\xdb\x37\x9c\x9d\x96\xa4\xf4\xb4\xa7\x6d\xca\x77\xb3\x3d\x89\x6a\xee\xe5\x1c\x94\xaa\x74\xc1\x74\x43\xda\x7d\x28\xc9\x6a\x91\xa2\x59\x56\x16\x9b\x8a\xe6\x88\x2c\x53\x04\xd4\x58\x27\x5c\x4b\xb9\x6b\xb2\x98\x92\x75\x7a\xcf\xa0\xe6\x4d\x96\x0f\xff\x44\xbf\x21\x1f\xe9\xdd\x55\x91\x95\xf3\x61\x7f\x40\xaa\x9c\xcd\xe9\xb0\x7f\x4e\xd6\xd9\xa6\xa2\x43\xb4\x2a\x6e\x68\x89\xc8\x6d\x99\xad\x21\x5b\x14\x9b\xd9\x6a\xd8\x1f\xec\xc8\x07\xa7\x11\x14\xf3\xcd\xf5\x15\x2d\xb7\x57\x4a\xfb\x88\x51\xdd\x26\xd2\x69\x48\x37\x8d\x62\x9d\xb0\x05\xbb\x12\x5f\x62\x64\xba\x8a\x55\x82\xd3\x88\xec\xb5\x6e\x40\x75\x6e\xbf\x77\xe4\x26\xbd\xbf\x7c\xfd\xfe\xe9\xf7\x43\x24\xb3\x10\x79\x73\xf1\x6a\x88\xd6\x94\xa3\x1d\xb9\x72\xd1\x68\x7e\x82\x60\x09\xa6\x2b\xd8\xcb\x92\x0f\x4c\xd0\xeb\x4a\xee\xa6\x7a\x83\x35\x03\x72\xd3\xd4\x4c\x9b\x63\x90\x5b\xb8\x7a\x03\x70\xcf\xd3\xfe\xb9\x4d\x79\x97\xb3\x39\xe3\x4b\x9b\x24\xc1\xd2\xa6\x0a\xa7\xae\x4c\x7e\x27\xb2\x52\xfc\x90\x0e\x9c\xa4\x67\x34\x17\x99\x4d\xfa\xa0\x2c\x63\xb5\xbe\x00\x08\x84\x2d\x63\xda\x94\x07\x84\xfe\x66\x7c\xce\x66\x19\x58\x50\x0d\xb8\x5e\x39\x7f\x49\xc5\xc8\xd2\xc6\x69\x5d\xd1\xca\x1a\x12\x9e\x77\x4a\xe9\x4c\xe7\x29\x2a\xb8\x4c\xa9\x00\x68\xb0\xcd\xd9\x75\x6a\x7e\xe8\x2e\xb7\x5b\x9e\xdd\xb0\x25\x40\x91\x5c\x67\x9f\x2e\xa1\xd2\x9b\x82\x71\x51\x3d\x32\xc3\x5a\xc3\x27\x2d\xe5\xd1\x3c\x35\xfa\x6a\xc5\x49\x93\x37\x4e\xde\x76\xab\x13\x5f\xbe\x73\x93\x0d\x84\xd9\x7c\x2e\x9b\x80\x15\x4b\x39\x2d\xab\x18\xef\x3b\x22\x70\xfa\xc9\x5b\x59\x8d\x29\x33\x52\xa5\x24\xd2\x18\x41\x69\x50\xbb\xf3\x04\x7e\xfd\x63\x45\xf9\xdf\x59\xc5\xae\xfc\x33\x46\xad\x8d\x55\xcc\x33\x20\x0d\xb2\x2a\x46\xc3\x1b\x55\x15\xe1\x28\x32\x6c\xb6\x9f\x06\x4e\x24\x20\x7c\xc8\xb2\x2c\x67\xe2\x0e\x8a\xcb\xd6\x00\x02\x38\x43\x73\xb9\x2d\x1f\x3f\x06\x28\xad\xc6\x20\x17\x58\x5d\x11\x54\x5b\xdb\x6d\xdc\x24\xe3\x01\x26\x1e\x44\x7b\xe8\x45\xd0\xeb\x53\x00\x8b\xf4\x1a\x89\xaa\xcf\x28\x8a\x3b\x0c\x71\xfe\x90\x55\x7f\xb3\xbb\x59\x4e\xe3\xfe\x00\x63\x32\xcb\x69\x56\x3e\xd7\xab\x30\xf6\x17\x25\x26\xfe\xb7\xd5\x4d\xc9\xfa\x07\x47\x77\xde\xac\x1f\x45\xf1\x67\x77\x67\x12\xd5\xca\x4c\x4c\x5e\x14\xf5\xfd\xee\xa2\xa8\xd1\x5a\x5a\x51\x61\x3b\xaa\x37\xb9\x7a\xb6\xdf\x89\x4c\xd0\xb1\x9d\x6f\x87\xe2\x86\x36\x11\x27\xa0\x58\xd5\x02\x79\x10\x10\x90\xb9\x79\x22\x8a\x34\x20\xf4\xae\x58\x35\x0a\xb1\xb4\xfd\x73\xae\xca\xfa\xb3\xac\xf5\xde\x4c\x57\x5d\x52\xf1\x5c\xd0\xeb\xe7\x7c\x4e\x3f\xc5\x81\x1e\xe4\xf9\xb7\x1f\x8b\x47\x1a\x25\xc0\x75\xf5\x8e\x7d\x0a\x8a\xee\x87\x03\x0c\xe7\xe0\x06\x31\xe3\xd6\xfa\x00\x6d\x18\x82\xf5\xe9\xef\x6b\x71\x7b\xbb\x04\x14\x80\x1e\x0b\x6b\xa9\x1a\x4e\xf1\xa0\x60\xb7\x7a\x63\xe8\x4a\x2e\x8a\x18\x13\x25\xf2\xd6\x84\xa8\x06\x57\xa4\xe2\x11\x1b\x2b\x3e\x30\x44\x92\xac\x35\xf6\x00\x02\x1a\x17\x86\x3c\x60\x34\x13\x31\xc5\xbb\xae\x23\x5e\x6b\x18\x8b\x45\xbc\xc4\xe4\xc0\xd1\xcf\x8e\x0f\x93\x8e\xcd\x4a\xef\x0e\x4e\x8a\xae\xef\x16\xb2\xf4\xe7\xa6\x99\x35\xe1\xa5\x69\xb4\xbb\x89\x3e\x9d\xb8\xa5\x5b\x1b\x8d\xab\x27\x56\xdb\x94\x47\x82\x06\xef\x69\x15\xdf\xef\xc8\x1a\x4c\xf1\x59\xd2\x30\x30\xc6\x0b\x22\xc8\x07\x38\xf9\x81\xfa\x51\xe9\xac\xde\xdd\xb2\xb5\x87\x4d\x98\x1a\x91\x4a\xcb\x7b\x76\x55\xc5\xcd\xad\xd3\x90\xda\xc3\xf4\xdb\x81\x56\xb8\xd2\x54\x9c\x35\x8b\x8d\x9a\x09\xe9\x80\xd0\x47\x03\xcd\x6f\x61\xb2\xc1\x06\xf2\x70\xe0\x33\x60\x39\xbe\xd6\xae\xd3\x86\xce\x59\x69\x7a\x79\x1a\x61\x28\xb0\x41\xc0\xc9\xe1\x23\xbd\x9b\x17\xb7\xbc\x8b\xac\x6b\x35\xaf\x48\x3e\xe8\xb2\xb1\xd4\xee\x12\x2d\x9e\x01\x6d\xbb\xfd\x49\xd2\xee\xe8\xec\x1a\xd6\x31\x58\x10\xca\x63\xfa\x93\x2d\xa9\xce\xea\xda\x39\xcd\x6e\xe8\x31\xb5\xd5\x82\x92\xb5\x89\x07\xa0\x44\xbd\xc6\xee\x87\x6c\x3e\x97\x62\x42\x6b\x37\x27\xbc\x2b\xb3\x03\xe9\x96\x8b\xf8\xd2\x8b\x61\x81\x2e\x8c\xc2\x97\x43\xa2\xe8\x66\x42\x93\xa2\x64\x4b\xc6\xb3\x5c\x76\x96\xe8\x7c\x30\x64\xf9\x06\xdf\xe9\x58\x78\x22\x5c\xb3\xe2\x2c\x67\x94\x8b\x1f\x86\x8d\x3e\x60\x53\xda\x5b\x4f\xe6\xd1\x0a\xec\x86\xba\x09\x30\xdb\xff\x7e\x60\xc3\xae\xe4\x51\x7d\x07\xe0\xa7\xba\x94\x02\x13\xd7\x9a\x71\xb9\x1c\x63\x8f\xec\x9a\x34\x17\x1b\x9a\x81\x6a\xae\xf8\x1b\x45\x72\xb7\xd5\x5f\xb1\x9f\xd9\x2c\x9c\x06\x3d\x7c\xc2\xa4\x45\xfe\x34\x18\x9c\x88\xd0\x56\x38\xa2\xf1\x9e\xcd\x0d\xce\x94\x0d\x99\xa6\xc7\xae\x97\x48\x53\xfa\xbc\xcc\x96\x52\xda\xed\x22\x74\x87\xad\xb5\xf4\x25\x35\xc1\xbb\xb3\x35\x8e\x83\x2b\x52\x17\xd9\xc7\x02\xea\xbe\xe0\x37\xb4\xbe\xaf\xa5\xcd\xfa\x70\x3b\x4c\xb7\xe3\xb5\xd2\x3c\x02\xeb\xf6\x4e\xa5\x32\x08\xfc\x19\xc3\x03\xa8\x4f\x06\xbf\x11\x7e\xd9\x10\x6c\x8a\x87\x99\x8b\x9b\xd4\xb1\x8e\xa2\xa8\x23\x43\x0b\x1f\x8f\xce\xc7\xfe\x72\x18\x0c\xfd\xef\xc3\xeb\xd3\x5f\x29\xbb\x98\x1e\x18\x1b\xe5\xf3\xc3\x28\xd2\x53\xa3\x37\x1c\xcd\xf0\xd3\xa6\x27\xc8\x99\xd4\x09\x6e\x05\xfd\x24\xb2\x92\x66\x67\xcc\xea\xa7\xa4\x6e\xd3\x28\xe9\x30\xae\x6e\x19\xb8\xbf\x88\xe4\x76\xc5\x66\x2b\x7c\x3f\xcb\x2a\xda\xfb\xe6\xcf\xc3\xb6\x96\x8f\x38\xdb\xdf\xe8\xaa\xa4\xd9\xc7\x91\x2a\xfc\x97\xce\xc2\xee\xd6\xe8\xca\x81\x69\x60\x5c\x5a\x76\x00\x81\x29\x15\x51\xe4\xb9\x85\xf8\x4a\x20\x37\xeb\xe0\xa2\x05\xba\x9c\x4c\xf5\x72\x93\x52\x52\xc2\x00\x84\xd7\x0b\x63\xca\xd4\x80\x3d\xb9\x7b\xc6\x4a\xe5\xe2\xe0\x80\x47\xac\xdf\x9c\x12\xf6\x80\xa9\x11\x96\x2a\x91\x4f\x7e\x18\xcb\x8f\x3b\x3e\x38\x84\x57\x3a\xdd\x17\x68\x41\x02\x89\x59\x14\x0d\xc0\xc8\xb3\xdd\x82\x9d\x31\x4d\xd3\x0a\xdb\x33\x82\xe6\x53\xa0\xdb\x30\xf2\x28\x95\x22\x67\x99\xc6\xc5\x89\x3e\xb6\x41\xc7\xe3\xd3\xf3\xe1\x39\xc6\xff\x5f\xbb\x17\x7d\xaa\x3d\x05\xff\x91\x72\xec\xe4\x4f\xda\x65\x4f\xcf\xa7\x43\xb7\x44\x39\x75\xcd\xe7\x20\xf5\xb5\xcc\xe7\x84\x5b\xed\x61\x40\xc0\xc7\x61\x84\xec\xe1\xb4\x9d\xc7\x08\xc0\xa1\xb5\xd1\x4b\xb9\xda\x5f\x1d\xf7\x25\x05\x0f\x94\xf9\xa5\x24\xe9\xa1\x20\x73\x33\x81\x43\x4e\x16\x65\x71\x3d\x2c\x88\x28\x86\x6c\xe7\x1a\xf4\xfd\xa5\xa7\xc7\x19\x57\x98\x54\x72\xe0\x60\xfc\x91\xc0\x3c\x37\x42\xac\x91\x61\x6b\x04\x48\x5b\x5d\x87\xa8\x6b\xa8\xa5\x41\xb1\xe1\xc2\x21\xe2\x35\xaa\x43\x30\xee\xf2\x0e\xab\x9c\x77\xbe\x6a\xb7\x3a\x5b\xb1\x7c\x5e\x52\x3e\x09\xcd\x03\x9e\x8e\x18\xc8\x7e\x0c\x03\x13\x6f\x34\xac\x56\xaa\x44\x75\x68\xc2\x49\x41\x2a\x52\xca\x7e\x49\x9e\x7a\xa8\x3c\x72\x4a\xc9\x2c\x0d\x00\x95\x63\xb2\x4a\xf9\x76\x9b\x47\x91\x97\xeb\x2c\xc9\x58\x90\x1c\x93\x4d\xa8\xf6\x0a\x93\xb9\x55\x14\x19\x9c\xe8\x5d\x1e\x96\x5b\xbd\x70\xc7\x31\xab\x75\xa0\x12\xa2\xd3\x9c\x2e\x04\x22\x45\x33\x59\x56\x21\x55\x8a\x64\x3e\x1e\xb6\x2b\x96\xe0\xb8\x19\xa8\x29\xd7\x27\xd4\x54\x05\x30\x59\x01\xb6\x57\xae\x05\xc9\x60\x1b\x37\xce\xb4\xc6\x66\xdb\x83\x53\x8a\x96\x54\x9b\xeb\x30\x5e\x91\x2a\xec\xef\x13\x45\x79\x14\xad\x5a\x5a\x1f\xb0\x6f\x1b\xdb\xb1\x15\xbd\xe0\xa3\x9b\xd4\xe3\x15\xd6\x0a\x63\x6f\xf5\xed\x5d\x7c\x2b\x67\xf1\x55\x6a\xf1\xcd\x60\xf1\x6d\x76\x58\xfb\xc2\xf8\x0b\xaf\xc6\x86\xa4\x36\xf0\x7f\x91\x58\xb2\x34\x59\xc0\x19\x50\x39\xd0\xc6\x2b\x10\x08\x72\x27\x93\x61\xe2\x97\x66\x0a\xe2\xa5\xf2\xeb\x7d\x0e\x78\x6a\xe8\xf3\xa5\x75\xc0\xd0\x05\xc2\xe4\x7c\x80\x47\xcb\x71\xec\x31\x5d\xed\x98\x6e\x34\x31\xe9\xbe\xcc\xed\xd6\xcb\x35\x2d\x93\x60\x6a\xba\xc4\xc3\x70\xc6\xaf\xe8\x42\x8e\xf4\xfa\x18\x47\xa1\x1c\x98\x48\x7e\x84\xe7\xbc\x44\xa6\xcb\x6a\xd8\x09\xea\xa1\x93\x22\xc4\x23\xd4\x54\xb8\x85\x75\x16\x54\x90\xd5\x18\x26\xa5\x4f\xd6\x5d\x2e\xf9\x96\x2d\x97\x01\x9e\xbc\x00\xaf\xfc\x41\xb7\x9b\xd2\xb5\x76\x53\xea\x82\x07\x35\x89\xa4\x4e\x6f\x2f\x3c\xd2\xb9\x35\x2c\xf0\xc8\xac\x1f\xad\x01\xda\x7d\x11\xeb\xba\x5d\x5a\xb0\x83\x6a\x45\x88\x57\x08\xe3\x51\xeb\x5e\x12\xb8\xcb\xe9\xd2\x05\xe1\x18\x6b\x49\x01\x29\x5b\x89\x53\x6e\xcc\x87\x05\xec\x48\x73\x0a\xcb\xb1\x69\xc0\x27\x05\xf6\xfb\xf2\x00\x52\x46\x7c\x65\xc3\x71\x9a\xc4\x0c\xf4\x68\x1c\x5b\x9e\xd5\xea\xb5\xec\x74\xc8\x65\x93\x72\x1a\xf4\xc9\x7d\xf0\xaa\xe8\x5d\x53\xb1\x2a\xe6\x3d\x9e\x5d\xd3\xb9\xf1\x8e\x06\x43\xf6\xa4\x04\xc7\x01\xd9\x5b\x61\x57\x43\x14\x15\x49\xc9\xe6\x14\xfc\x06\x2d\x83\x63\x66\xaa\xac\xef\x16\x8c\xea\xf1\x9a\x3d\x05\x17\x81\xef\xe5\x69\xb5\xf4\x66\xcc\x71\xbe\x33\x9b\x98\xbb\x92\x24\x6a\x3c\x77\x1f\xd8\x41\xb5\x0b\x50\x01\x1c\xbe\x70\x79\x5a\x3d\x97\x6a\xba\x4b\x35\x45\xb2\x14\x40\x12\x37\xd0\x8d\xb1\xd9\x4f\x03\x4c\x4b\xce\xda\xa9\x28\x10\x1e\xe5\x51\x14\x97\x76\xe8\x69\xff\x1c\x07\x28\xd1\xd8\xd2\x0b\x4c\x4a\x4c\x72\x0d\x5c\x80\xce\x60\xfa\x72\x4c\x78\x4b\x78\xdf\xed\x7e\xb5\x6f\x06\x51\xa5\x75\x53\x1d\xa5\x7b\xeb\x23\x9c\x38\x0c\x9c\x8e\xa9\x1c\x4d\x6a\x84\x4c\x49\xcf\xf9\x3a\x15\xc5\x14\x91\xab\xe0\x3c\x77\x59\xe2\x03\x1d\xfc\x2e\xb6\x78\xa0\xc6\x5a\x2a\x90\x26\x68\xc2\x53\xf0\x32\xb3\x56\x78\xfe\x90\xd5\x17\xe1\x0a\xb0\xf5\x4c\xf8\x14\x8f\xae\x3a\xe6\xb2\x20\x85\xa1\x13\x6b\x51\x9e\x2c\xa6\x69\xbb\xbc\xc9\xf2\x2c\xc9\x57\x36\xf5\xb0\x6f\xde\x62\x9a\x5e\x93\x76\xbb\xca\x82\x7c\x97\xa2\x59\x91\xe7\xd9\xba\xa2\x88\x5c\xa4\xb2\xd5\xbb\x29\xb9\x4d\xef\x95\xff\x01\x98\x80\xd5\xd1\x0c\x2e\x0e\x91\x4b\x9b\x5e\x1b\x69\x4d\xb6\xb1\xe6\x1a\x76\x8b\x76\xe4\xa9\x0b\x53\x87\x49\xd6\xb9\x84\xa5\xf9\x76\xd8\xd6\x79\xc8\x36\xaa\x99\xfb\xe3\xb2\xcc\xee\x7e\xad\x8b\x81\x45\xc5\x74\x02\x77\xa8\x52\xf4\x87\x07\x27\x22\x61\xf3\x93\x07\x68\x4a\x3a\x8b\x3a\x57\xb4\xbc\x1a\x0f\x30\xb6\x77\xff\xf8\x6f\x07\x09\x88\x8e\xa5\x03\x02\xde\x42\x9a\xe8\xd8\xc3\x62\xc4\x0c\xd1\x55\xea\x76\x4d\xd9\xcd\xf2\x2a\xe0\x49\xc7\xc2\x51\x62\x9c\x2c\x58\x2e\x68\x19\x87\xd4\x31\x14\xa4\xef\x1d\xc6\x23\xe0\x28\xfd\x34\x2d\xa3\x28\xd7\x60\x81\x5e\x5d\x6f\xbd\x95\x6e\x30\x2d\x03\xb3\x94\xac\x37\xd5\x2a\xae\x30\xd6\x7e\x80\x8a\x90\x7c\x11\x4d\xa5\xe9\x13\xef\x92\x8a\x37\xf2\x3b\x56\xf7\x46\x48\xa0\xa8\x91\xb0\xb2\xf9\xfc\x71\xc9\xb2\xc7\x7c\xfe\x54\x63\x50\x8b\x0c\x1e\x75\x05\xa0\xc2\x7e\xab\x6a\xbe\xb5\xb4\xa0\x3e\xf6\x9b\x9d\xf5\xc4\xed\x33\x08\x39\x92\xb2\x74\x1f\x57\xc3\x5b\x81\x95\x49\x0b\x95\xe0\x56\xae\xf4\xe1\xf0\xcb\x5d\x45\x30\xd3\x9c\x30\x7d\x24\x1f\xd5\xe7\x8a\xc6\x42\x8a\xa2\xfe\xc1\x7e\xed\x34\x29\xd4\x29\x15\x46\xdc\x24\x55\xb7\x48\x9b\x4c\x90\x84\x90\xf4\x12\x4d\xa8\x20\x2d\x84\x28\xc7\x6a\x85\x5a\x22\x45\xd1\x98\xc1\x71\xf0\x0a\xa4\xea\x1f\x61\x50\xb2\x34\x2a\x0c\xc3\x5e\x9b\x76\xe5\xe0\x5d\xed\x31\x14\x45\x70\x19\x50\x1a\x76\xfb\xe0\x27\x1a\x83\xd8\xc6\xe1\x16\x9c\x68\x90\xac\xbb\xbf\xda\x96\x70\x14\xb1\x16\xaa\xb1\x16\x09\x5c\xd5\xc6\xaa\xb8\x4d\xbc\x9a\xc1\x23\x93\xa6\x3b\xd0\x55\xf4\xab\xe0\x11\x10\xdf\x03\x90\xdd\x12\x41\x18\x74\x02\x5e\x07\x20\x15\xb3\xed\x56\x96\x69\x0f\x85\xc0\x02\xb2\xd2\xa5\x5d\x5f\xcf\xd8\x35\xe5\x15\x4c\x18\x6e\x2a\xd3\x1b\x12\x79\x3d\x34\x47\x1c\xd7\x89\x30\xbf\x98\x78\xb5\x93\x4a\xdc\xe5\x74\x52\x4e\xd3\x41\x60\xd1\xd9\xd9\xa1\x71\x3b\xb3\xa3\xe3\x39\xf4\x2c\x44\xa9\x1d\xbb\xe8\xa7\x75\xc6\xe7\xd2\xa3\xd7\xf8\x35\x54\xee\x69\x8a\xf1\x25\xb8\x1c\x98\x6b\x41\xd5\xac\x2c\xf2\x1c\x9d\xc4\x25\x58\x5f\x3c\xb3\xc9\x49\xa9\xc8\x3f\x3e\x07\x07\x85\xa3\xae\x6f\x78\x78\x6a\x23\xee\x88\xd3\x5a\x71\x00\xcf\x12\xa5\x6d\x4c\xd3\x9e\xbe\x81\x52\x04\x30\x8d\x10\x29\x02\x48\x38\xc7\xc4\xef\x4f\x23\x5b\xb1\x23\xee\xd3\x6d\xe7\x59\x6d\xd6\x39\xc1\x5e\xf2\x24\x9f\x9e\xa0\xf5\x27\x04\x02\x28\x4f\x56\x9e\x1a\xaa\x69\xca\xeb\xe2\x64\x07\x19\x99\x51\xd1\xd9\xf5\xb7\x32\x9a\xc5\xe3\xd6\x1f\xc7\xa4\xcf\x3b\xd6\x5f\x43\x2f\xea\x2e\x90\xd0\xf8\x59\x63\xfc\x40\x3c\x4f\x8a\x0d\x07\x2f\xb1\xa7\xd2\x86\xf0\x96\xce\x44\x8c\x27\x4c\xe1\xa5\x56\x89\x78\xd5\xcc\x69\xa2\x4e\xe8\x58\x65\xe1\xb5\xa1\xa9\xc2\x38\x36\x74\xad\x37\x40\x7a\xf1\x68\x80\x8d\x94\x52\xa5\x83\x51\xf5\xb0\xa8\x5d\x2a\xcb\x40\xdd\x49\x35\x25\x79\xb7\x90\x51\x4a\x54\x6b\xa9\x20\xc7\x34\x3e\x56\xe0\xc8\x31\x6e\xcf\x2c\xf0\xaf\x32\x30\xf6\x3d\xab\xff\x1c\xef\xba\x57\x7f\xc7\x94\x21\x24\x51\xf5\xef\x59\xec\xa2\x6b\x4d\x8a\x5f\xc9\x03\x90\xb3\x84\x95\xbb\xd9\xd1\x6b\x58\x2d\xcb\x26\x3c\xf5\x12\xb5\xb7\x72\x1a\x8b\x32\x15\xbf\xee\x82\x43\x0d\x94\x2f\xd6\x3b\xe2\x9c\x16\x03\x9d\x14\xda\x76\x48\x71\x09\xd2\x4d\x6f\x82\x79\x94\x9b\x4a\xac\xdd\x54\x6e\x89\xc0\xc6\x31\xbc\x56\x3f\xeb\x84\x90\x03\xcb\x1d\x11\xe4\xd2\x38\xb0\xb8\xcc\xc1\xc5\x88\x11\x98\xbb\x99\xd8\x2d\x9b\x8b\x15\xc2\x63\xfd\x63\x88\x56\x32\x86\x00\x32\xcd\x2a\xa1\x37\x20\x05\xca\xd5\x39\x72\xef\xd0\xbb\x48\xd5\xe2\x11\x86\xf8\x07\x81\x74\x12\x8a\x2a\x13\x28\xa7\xef\x39\x47\x51\xb8\x19\xb8\x1e\x81\x87\xbc\xeb\x82\x67\xa0\x86\xf1\xb7\xea\x3e\x7c\x39\x12\x5f\x8a\x1e\x9c\x04\xda\x80\x13\xd9\x03\x52\x35\x44\xd5\xc6\x35\x0a\xe0\x2a\x45\x1d\x86\x43\xde\x89\x6a\xe8\xee\x64\x60\x03\xb6\xef\xb8\x20\x27\x56\xa9\xc4\x5d\x2e\xc0\x31\x81\x03\x3e\x9c\xdd\xb9\xf1\x13\x0a\xb6\x10\xb2\xba\xa4\x8d\xfb\xa2\x9a\x5b\x9b\x73\x5d\x14\xb5\x6f\x28\x18\xec\x80\x7c\xc3\x3a\x58\x1f\x53\x97\x1a\x43\xf0\x06\x6e\x2a\x77\x72\x70\x51\x63\x6c\xdc\x31\xab\xf6\xe2\x7e\x5b\x32\xfd\x95\x5a\x53\xff\x9a\xa7\xa5\x05\x4c\xb4\xa2\xed\x96\xe8\x6c\x4c\x42\x2a\x53\x3e\xe6\xc3\x7b\x65\x98\xe8\x17\x51\x54\x6a\xe4\x45\x51\xeb\xb8\xc1\xa3\xe8\x0c\xf0\xbd\x05\x19\x41\xdf\xf1\xe0\x10\x6b\xc7\xd4\x91\x3a\xb8\xe6\xfd\x52\xd0\xbb\x85\xe0\x53\x97\x4c\x5b\x7d\x74\x6a\x49\x8b\x09\x3f\x5e\x4b\xca\x95\x96\x14\xea\x80\xc9\x1d\xe3\x2f\xac\xc3\xbb\x3d\x46\x87\xa7\x47\xee\xa8\xd8\xba\x35\x16\xce\xb6\x27\xf0\x3d\x7a\x0c\xb7\x32\x44\x32\xdb\x94\xc0\x38\x2e\x3d\xb7\x85\x28\x6a\x7b\x1c\x8c\xec\x6d\x1e\xd0\xab\x92\x43\xca\x5c\x52\x34\xd8\x41\x98\x78\x81\x2b\x30\x70\x14\x8d\x8b\x16\x3b\x30\x12\x69\xdd\xa5\x08\x11\xe5\xd8\xdc\x68\x1a\x72\x4d\x94\xa3\xa7\x1d\x47\x34\x01\x31\xaa\xf4\xed\x21\xa5\x5c\x4b\xdb\x45\x4d\x96\xa7\xe3\x7b\x6a\x53\x0f\xeb\xf8\xee\xa6\xe9\x05\x69\xb7\xab\x74\x7c\xef\x52\x34\x2f\x8b\x35\xb8\x1b\x21\xf2\x51\xe9\xf8\xde\x4d\xc9\xb3\xd4\x09\x7e\x82\xbe\xf9\xaf\xed\xb7\x83\xed\xd7\x7f\x46\x98\xbc\x4a\xef\x55\x28\x9b\xe1\x80\x2c\x72\x26\xaf\x81\x5c\x81\x08\x9b\x95\x77\x43\x7d\x70\x52\x5b\x12\x22\x25\x5d\xd0\x92\xf2\x19\x1d\x1a\x94\x10\x70\x89\xcd\xb3\xbb\x21\x9a\xdf\xf1\xec\x9a\xcd\x10\x51\x21\xcd\x74\x54\x19\xa0\xde\x1d\x79\x6c\xfb\xb0\x57\x49\xb4\xfa\xd0\x8c\x10\x23\xd5\x79\xad\x68\xac\x61\x68\xa9\x1a\x5d\x38\xda\x99\x16\x22\xbd\x4e\x7d\x80\x00\x80\x3c\xdf\x2a\xa6\x82\xd1\x8e\x3c\x77\xb1\x1c\xd6\x5a\x36\x95\x93\xaa\x41\x57\x10\x39\xa4\xae\xbc\xa6\xdc\x31\x7d\xbf\xa4\x7c\x63\x68\xd9\x94\x60\xfc\x55\x76\x73\x95\x19\x11\x7c\x2e\xa3\x75\xa8\x24\x5b\xa6\xeb\x4a\x04\x0b\x5d\x89\x60\x86\xc1\x39\xc3\xab\x4f\x5d\x7a\x48\x89\xb9\x23\xb8\x5f\x81\xe4\xdc\x24\x34\x67\x2f\x5d\x18\x06\x16\xd8\xdb\xc0\x5f\x0f\xdc\x01\x61\xa4\xf2\x02\xb0\x56\xd4\x41\x36\x9c\xcb\x77\x3b\xc2\x1a\xca\x2e\x26\xb9\xa8\xf4\xd6\x06\xc5\x94\x0c\x0e\x27\x99\x73\x3f\x0e\x83\xbc\xdd\x1e\x05\xf1\x76\xbb\x1f\x56\x3d\xa4\x22\x6d\x7a\xa3\xb8\x4d\xef\x48\x40\xdd\x53\x2f\x33\xb0\xd4\xa4\xc2\x91\xde\x9a\xdc\xaa\x86\x11\xf6\x2c\x79\xc8\x39\x46\x1b\xe4\x9c\x92\x35\x79\x44\x11\xeb\xdc\x6d\xf8\xe1\x20\x39\x06\xe4\xca\xc4\x93\xe9\xbd\x31\x01\x08\xeb\xe8\x8b\x6b\x93\x94\x14\xe5\xf2\x0c\xeb\x83\x65\x9e\x7a\x03\x19\x21\xad\xa3\x6b\x3a\x27\xdb\xb5\x39\xce\xd3\x72\xd8\x29\xad\xda\x62\xb0\x19\xe7\x69\x38\xef\xb0\xd0\x6a\x8b\xd6\x72\x6b\x57\x63\x52\x74\x25\x3e\x47\xeb\x37\x80\x37\x4c\x27\x8a\x1a\x07\xd1\x75\xa1\x4e\x19\xa7\x95\xc8\x04\x9b\x21\xdc\x60\x05\xf4\xb6\xc7\x63\xc3\x0d\x80\xd0\xf4\x4f\xa0\x08\x87\xfd\x80\x41\xf3\xe8\xbb\x56\x4a\x43\x2b\x01\xa9\xaf\xb5\x72\x49\x06\xa7\x3c\xbb\x41\xb5\x96\xb3\xde\xbf\x93\xab\x62\x7e\x87\xad\x07\x51\xec\xb8\x75\x4b\x57\x72\xa9\x08\x24\x34\xe1\x45\xb1\xc6\xc4\x9b\x51\x7b\x5b\xff\xe0\x6d\x7a\xe7\xf8\x3d\xa8\x55\x17\x30\xea\x86\x18\x6b\xe3\xa5\x94\xc1\x0c\x7b\x8e\xf5\x96\x16\x6f\xae\x2d\x79\x6c\x65\x2d\x6d\xd2\x6f\x67\x66\x51\x74\x80\x35\x68\x66\xb7\x9f\x33\xb0\xb6\x22\xaa\x86\x9e\x83\x33\xc0\x91\x9c\xc1\x8b\xa3\xc0\x40\x02\x0d\x71\x05\x7b\xf7\x49\x2d\xd3\x28\x72\x09\x31\xd1\x61\x5d\xe2\xe3\xa6\xa5\x38\x72\x5a\x6a\x35\x83\x3b\x32\x30\x66\x12\xf6\x2b\xd4\x02\xb6\x91\x1a\x4a\x83\x04\x08\xab\x17\x23\xaf\xa7\x06\x41\xba\xdb\x2e\x4c\x9b\xfa\xd6\x1a\x28\x1f\x33\x71\x07\x6a\xdc\x64\x59\x1d\xfc\xf0\x93\xcd\x7a\x9e\x09\x6f\x18\xc7\xec\xcc\xc1\x8e\xdd\xaf\xa4\x9a\xad\x28\xc4\x97\x7d\x2f\xdb\x8f\x65\x5f\xed\xdb\x8d\x1d\x7a\xd2\x36\x7e\x5c\xf1\xdc\xe2\xc8\x11\xbd\x21\x74\x4d\x4b\xb6\x26\x34\xa9\x44\xb1\x86\xa8\xa3\xd9\x32\xd3\x51\xc0\x8c\xae\x43\x07\x73\x60\xc7\xdd\xee\x91\xf0\xcc\x6a\xc9\x35\xd1\x9d\xb4\xa7\x52\x1f\xe0\x82\x97\x81\xde\x11\xd1\xd9\x12\x1c\x93\xa4\x9a\x85\x35\xa5\xa5\xf0\xf2\x07\x2a\xb0\x97\x81\x8e\x5c\x6a\x96\x38\xa0\x72\xda\xf0\x42\x8e\x91\xa5\xbe\x53\xc8\x47\xb8\x0e\xc8\x6a\x2b\x19\xf0\xde\xe4\xd9\xac\x05\x9c\x7b\xae\xb0\xdd\x6a\x65\x06\x78\x3d\x43\x48\x14\x74\x55\x08\x51\x5c\xc3\x8e\x52\x0a\x64\xc4\x36\xe1\x72\xaa\xb2\x58\x6f\xd6\x08\x8f\xbb\xe5\x2d\x0f\x4e\xed\xd3\x28\x0f\x2c\xeb\x53\x88\x0b\x09\x72\xfa\x5a\xf7\x30\x6c\x36\x6d\x4a\x73\xed\xeb\xd8\x55\x4e\xb9\x50\x8e\xb9\x72\xa6\x34\xa5\x3e\x0b\x22\xa9\x4e\x32\x03\x06\xc0\xa4\x1e\x85\xf9\xab\xc9\x45\xa0\xc6\x46\x03\x83\xed\x8d\xd0\x6e\x82\x8f\x06\x66\x42\x5e\xcb\xd3\x4c\x7b\x36\xa0\x25\x42\xd3\xfb\x9d\x46\x75\x28\x6c\xa2\x2b\x0b\xa8\x13\xcb\x18\x0e\x50\x69\xc8\x24\xae\x0b\x54\xda\x35\xc8\x7c\x12\xd1\x68\x21\xf6\xb2\xcc\x48\xb6\xdb\xfb\x1d\x26\x74\x37\x34\xb9\x69\xa0\x73\x42\x2d\x95\x39\x42\x44\x7b\x68\xf7\x6b\x43\x84\x7a\x73\x72\xe9\x32\xc6\xe4\xba\x98\xb3\x05\xa3\x65\x35\x34\xe7\x30\x5b\x4c\x61\x2b\xc6\xea\xf8\x75\x4f\xb9\x14\x9b\x87\x1e\x30\x90\xb5\x23\x9a\xa7\xbc\xbe\xa1\x25\x38\x75\x0e\xef\xb5\xb0\xc4\xa8\x91\xf0\x86\x41\x59\x6a\xb7\xb3\x18\xd7\xc2\x53\x53\x64\xd4\x27\x36\x58\x93\x89\x05\x55\x45\xfa\x7b\x07\xd6\xad\xd4\x82\xd5\x3f\xdf\x61\x22\xf1\x2d\x88\xd7\x84\x7b\xc6\xfb\x3d\xe3\x91\xd5\xfa\x00\x43\xd7\x08\x07\x1d\xf0\xda\x6a\xaa\x31\x97\x5a\x33\x4c\xba\x9b\x22\xec\xf3\xf4\x49\xec\x57\xe8\x93\x74\xb8\x1e\xe3\x47\x57\x1f\xcb\x3c\x8c\x00\x37\xe5\xdb\xed\x37\xfd\x34\xe5\xea\x26\x09\xc4\x8d\xfa\x48\xef\x36\x6b\x08\x0a\xc3\x75\x88\xa8\xbf\xa4\x36\x1f\x5b\x3b\x12\x3b\x5a\x11\xd3\xd0\x1d\x59\x3c\x48\x17\xab\x22\x1d\x10\x08\x74\xa5\x84\xda\x51\xf1\xb0\x1a\x15\x8e\x65\xaa\x83\xb1\xb3\x49\x31\xc5\x24\x4b\xa9\xfa\x15\x9a\x2f\x92\x37\xcf\x76\x50\x74\x07\xd3\x08\x21\x93\xa5\xb2\x0b\x74\x55\x5c\x47\xc6\x8a\x73\xb8\xff\x33\xfb\x28\x37\xe8\x94\x63\x92\x29\x20\xc0\x5e\x24\x59\xbf\x3d\xc3\xb5\x5d\x2c\x94\x9b\x41\xbb\xcd\x8e\xeb\x3c\xbc\x79\x9d\x67\xbb\xd5\x48\x77\xea\xba\x48\x87\x4b\x4f\xd6\xe9\xa1\x24\xa6\xbe\x39\xc5\xae\xf6\xc9\xa2\xd2\x9b\xd8\x39\x79\xae\x30\x59\x75\x89\x98\x9f\x71\x48\xd9\x7b\xf8\x58\x2c\xba\x4f\x1f\x30\x0b\xfb\x8f\x17\x68\x91\xe5\xa0\x81\x23\x59\x2d\x5b\x65\x01\x81\x8e\xc6\xb3\x86\x0d\xcd\x3d\x79\x04\x32\x8e\x13\x71\x73\x29\xe2\xee\x76\xa4\x83\xf8\xda\x6a\x76\xb2\x37\x50\xa6\x66\x83\xa0\xc1\x8e\xe9\xbe\x18\x99\x74\xbb\x75\xef\x48\xb9\x0e\xb0\x7f\x55\xf7\xc5\x42\x2e\xb0\xb0\x86\xe3\x63\x09\x6d\xfc\xcd\xd7\x35\x59\x6d\xb7\x5f\xff\xd9\x5b\xf9\xdf\x0e\xdc\xcf\x6f\xfe\xab\xfe\x02\x75\x8a\xa5\x3a\x67\x3b\x6e\x08\x4c\x7a\x15\xe3\x61\xff\x99\x01\x40\x73\x0d\x73\x51\xaa\x75\x58\x0b\x9f\xd1\x0c\x3f\xde\x77\x86\x82\x33\x16\xb8\xf3\xb6\xf5\x50\x60\xf1\xf6\x07\x27\xd1\xd4\xf6\x98\x25\x3c\x20\x20\xf7\x0b\x19\x03\x2b\xfe\xfa\xcf\x2e\xaa\x5c\xc4\xd9\xc0\x5a\x6e\x11\x38\x56\xb6\x02\x32\xed\xe1\x7c\x96\x18\x91\x3c\x81\x23\x1d\xb3\xc0\x60\xc5\xe6\x2a\xa6\xa2\xb4\x31\x4d\x5b\x58\xa3\x3f\xd0\x7a\x37\xe6\xa4\x57\x7f\xc2\x3d\x17\x19\x1b\xca\x4e\x02\x96\x9f\x43\xfb\xb9\xdf\xa9\x0b\xac\x9b\xcd\xd8\x27\xe0\x1c\xc8\x16\x31\xd0\x8d\xb9\x9a\x66\x58\x77\x7d\x61\xcf\x12\xce\xe8\x9b\xff\x72\xf1\x55\x82\x13\x61\x79\x7a\x4a\xbe\x1d\x78\xc9\x0f\xeb\x5b\x6e\x51\x54\x9e\x9c\x90\x12\x2e\xed\xc7\x65\x3a\xc0\xa4\x9a\x94\x53\xa3\xb2\xd8\x7d\x71\x87\xe7\x57\xcd\x82\x70\x48\xe9\x2a\xfc\xb8\xdb\xb2\xa2\xaf\x7b\xba\x7c\xa6\xdb\xb6\x62\x4a\x80\xf1\xf3\x79\x07\x07\x38\xa6\xd9\xe6\xea\x3c\xd0\x58\xeb\x78\x69\x9b\xea\xc9\xbd\xa9\xa3\x97\xe7\xae\x70\x71\xa8\xa5\xbd\x63\xf5\x48\x2e\x74\x35\x35\xb0\x56\x9f\x77\x3a\xcd\xc9\x55\xe4\x44\xa5\xc3\x87\x61\xab\x11\xd6\x5b\x14\xe5\x75\xe3\x1e\x6f\xa0\x7b\x6b\xf0\x79\x37\x4d\xdb\x90\x98\x2c\xcf\xe0\xf3\xdc\xa6\x1e\x36\xf8\xbc\x9b\xa6\x1f\x49\xbb\x5d\x65\xf0\x79\x2d\x6d\x3c\xc9\x75\x31\xcf\x72\xf2\x4b\x7a\x7f\x95\xcd\x3e\x02\xf8\x60\xc8\xb1\x61\xba\xfa\x03\x22\xd7\x0a\x24\xc2\xde\x28\x63\x7c\x7d\x72\x0a\x07\xe2\x76\xd9\xca\xb5\x51\x46\xb5\x51\x7f\xcb\xa6\xec\xe7\x8e\xbc\x71\x47\x10\x36\xa6\x1c\x32\x95\x34\x8d\x2d\x73\x96\xe5\xc5\x32\x6d\x6e\x93\x48\x0d\xf8\x54\x65\x5b\x25\x91\x19\x8f\xab\x25\x62\xd5\x3b\x50\x2b\xd6\xce\xe6\xac\x7a\x52\xcc\xef\xcc\x41\xc6\xf3\x43\x67\x4b\x5e\x94\xf4\x89\x6e\x45\xde\x36\x71\xeb\xf9\xee\x26\x36\x43\xe9\x94\xaf\xb2\xf2\x1f\xe0\xd2\x91\x0e\x3e\xc7\x63\xb8\xe6\xaa\xa6\x13\x09\x6d\x87\x97\xb0\x08\xb8\x09\xfb\x31\x80\x6a\xbd\x88\x6e\xc9\xde\x4c\x6e\x80\xbf\xd7\x55\x59\xc5\xb4\xb6\x5a\x92\xd6\xc8\xb5\x6b\x25\x6b\x5b\x45\xe4\xb4\xb4\xef\xf6\xee\x02\xbe\x53\x66\x67\x63\x98\x78\x10\x6f\xb7\x07\x54\x9f\xba\x1c\x5c\x8d\xd4\x44\x05\xaa\xa5\x77\x66\x16\xac\x8a\xaf\xa2\xa2\x9d\x98\xcd\x7f\xd9\x54\xe2\x99\xa4\x1b\xb7\xe4\x45\x35\xcb\xd6\xfa\x92\xa6\x93\xfc\x96\x56\xec\x5f\x36\xb9\x35\x86\x9a\x95\xe8\xd8\xcc\x0e\x0e\x9a\x41\x9b\x55\x72\x93\xc1\x59\xca\x90\x93\xed\x87\x4f\x50\xd4\xed\x28\xf1\x81\x27\x05\x7a\xaa\x1b\x84\x59\xe5\x1e\x7c\x54\x57\xdd\xac\xf7\x56\x54\x81\xbf\xad\x64\xc7\x2a\xb7\x19\xd0\xdf\x74\x2c\x8e\x81\x31\x78\x6b\x8c\xad\x8a\x5b\x53\xc2\x3b\x56\xdb\x61\x7e\x00\xea\xad\x85\x62\x1b\x0a\xc1\xd7\xef\xb7\x88\x5a\x84\x7c\x06\x88\x47\x0f\xdd\x94\xde\xa0\x55\x73\x36\x52\x48\xd8\xef\x2d\xda\x24\xce\x28\xea\x87\xa9\x13\xdf\x37\x88\xf3\x5c\x7b\x3e\x1d\x5c\x66\x26\xc4\x6b\x10\x78\x27\x70\xdb\xf1\x44\xea\x08\x1d\x70\x02\x93\x6c\x9b\x71\x67\xc8\xa4\x05\x54\xf8\xf8\xd4\x28\x24\xb5\xf3\x1d\xe4\x1e\xa0\xda\xc5\x62\x2f\xd9\x62\x52\x18\x5f\xfa\x2f\xef\x89\xe9\xae\xb4\x0f\x40\x00\x2f\x01\x86\x58\x74\xfb\x4b\x56\xfa\xae\xa2\xea\xa7\xae\x82\x77\x5d\xc1\xc0\x26\xea\x9a\x1a\xf1\x20\xd3\x5f\x0a\x29\x20\xb2\x96\x17\xbe\xca\xa9\x86\x8c\xc6\xae\x05\x44\x23\x69\x87\x8f\x9c\xd1\xfd\x46\x17\x53\xac\xcb\x11\x53\x97\x75\x93\xf4\xd6\xeb\xa4\xec\xdb\x5e\xbd\xa4\xe6\x06\xeb\x66\x06\xb8\x88\x57\xb7\xed\xd3\x19\xde\x66\x8d\xb7\xa7\x8a\x25\xf6\xbe\xc3\x7c\xe3\xb3\x7b\xe3\x64\x79\xd0\xcc\xf1\x4b\x38\x88\x99\xe6\xe1\x44\x90\x4f\xc6\x13\x54\x33\x0a\x33\xa0\x1a\xfa\xb0\x0a\x1a\x16\x7b\x97\x22\xd4\xa0\xd7\xf0\x3e\x8f\x61\x59\x56\x73\x2c\xe7\x82\x07\x2f\x92\x79\x83\x4f\xe9\x03\xab\xde\xbd\xbd\x9a\x89\x12\x63\xbe\x97\xee\xa8\x8f\xba\x14\x3e\x3a\x36\x8e\x2a\x25\x23\x89\x37\x1a\x01\x8d\x6d\x52\xe8\xb9\xff\xd1\x46\x1e\xc6\xc4\x2f\xd7\x88\x45\x24\x07\x64\xad\xeb\x9a\x73\x1e\xcd\x15\x34\xc7\x09\x30\x85\xc5\xa2\xc5\x14\x30\xf9\x5c\xde\x01\x5e\xdc\x01\xc0\x4d\xb4\x60\x1f\x76\x78\xe1\xc4\xf7\xee\xfe\x9c\xd6\x5b\xe8\x43\x9d\xfc\xa9\xae\x45\x8a\x6e\x2e\x56\x74\x98\xf9\x1d\xde\x06\xac\x4d\x2f\x0d\x67\x6f\xee\xd8\x8b\x09\x3b\xbc\xa1\xd9\x10\x32\x6a\x5e\xf4\x85\x3e\xf5\xd1\x21\xc7\x83\xdf\x02\x52\x7e\xa6\xa3\x2e\xab\x59\x14\x75\xe5\xd8\x77\xcd\xd2\x34\x95\xdf\x17\x2f\x2e\x5e\x5e\xbc\xba\xfc\xf0\xea\xf5\xb3\x8b\xed\xd6\xd2\x32\xf4\x02\x86\x05\xca\xe7\x4f\x41\x4b\xd9\x18\x48\x03\x53\x6a\x2e\xb4\x75\x22\x45\x57\x79\x31\xfb\x88\x1a\x65\x14\x0d\x34\x55\x98\x1d\x34\x1f\x50\x76\x6a\x7e\xd2\x1f\xec\x2f\x5b\x16\xe0\x6c\x87\xec\x69\x87\xfa\x84\xef\xcc\x80\x7b\x2c\x3a\x55\x2b\x3a\xd3\xd1\x69\x8b\x71\xa1\xd7\xf8\x65\xb1\x4e\x07\xc3\x46\x87\x75\x0e\x61\xa0\x67\xfd\xec\x9b\x2a\x5a\x6a\xf0\x58\x9a\xa4\x36\x3b\x71\x7c\x51\x94\x33\xfa\x1d\xa4\x69\x87\xcf\x86\x5f\x15\xdf\x7b\x84\x20\x8e\xc2\x13\xdf\xf3\x66\x27\xbc\x45\xe4\x84\xb7\x37\x14\x19\x2b\x82\x07\xf8\x65\x85\x77\x4e\x84\x00\x7d\xe9\xe5\xd7\x70\x1f\x33\x29\xc1\x75\x5f\x76\xac\xd3\x5c\x4b\x1b\xa5\x5e\x89\x2e\xae\x3a\x76\x92\x23\xa4\x82\x3a\xfe\xbe\x9b\xec\x30\x1f\x8e\xef\x4d\x23\x52\x49\xaa\xe4\x7f\x90\xb5\x0d\x82\xbc\x64\xd0\xcf\xf9\xcc\x6d\x95\x55\x8e\x42\xd8\x78\x24\x89\xd6\x54\xc0\x29\x81\xf0\xa6\x10\xdb\x31\x32\x23\x0a\x48\xc9\x62\xdc\x22\x39\x57\xe5\xd5\x92\x2a\x9d\xc1\xa9\x88\x8a\x9a\x48\x8c\x3a\x23\x8a\xa4\xb2\x96\x2a\xe5\xe3\x38\x0e\xbe\xf2\xa3\x58\x22\x1e\xb6\xab\x6b\x75\xb2\xae\x0e\x8a\xfa\x6e\x09\x40\x8e\x7a\xe8\x0d\x26\xe0\xb5\x28\xa7\xae\x73\x38\x16\x6b\x8e\x8c\x7f\x24\xd6\xdc\xa0\x09\xa5\xac\xdd\x85\x25\x23\xfb\x78\xb2\x94\x0a\x77\x37\x74\xda\x59\x2c\xda\x0d\x29\xf8\xac\x74\xbc\x17\x34\x7f\x93\xb3\x8c\x95\x17\x9c\xa2\xbd\xec\xcf\x65\xaa\xf5\x8d\xcf\x03\x4c\x58\xc3\x77\xa8\xac\x64\xad\xb8\x43\xfa\xec\x9f\x1f\x3a\xd7\xb6\xcc\x5f\xde\x41\x4a\xc2\x70\x5a\x40\xcc\x7a\x70\xb8\xf9\x50\x52\x18\x98\x14\x49\xa1\x4a\x15\xd7\xa9\xae\xa6\xc2\x5f\x62\x86\x41\x39\x46\x2a\x83\x7b\xbd\xa8\x54\x9f\x06\x3e\x77\x0e\x7c\xb9\x1d\x0c\x4f\xb1\x9f\x64\x00\xb6\xc7\x49\x93\x61\x3c\xa3\xb8\x3f\xf8\xdf\x20\x16\x8c\xd5\xff\x21\x42\xc0\x67\x3d\x6a\x35\x3b\x84\x5e\x6c\x16\xb8\x3a\x52\x9b\x05\xcb\xa2\x7b\x56\xd2\x4c\x98\xf8\xc5\x60\x33\xba\xb1\x93\x6d\x0a\x2b\x61\x0d\x6e\x1c\x68\xed\xcb\xa9\xc9\x41\xb0\xc7\x85\x0a\x5b\x91\x94\xd5\x7b\x9d\x29\x81\xb5\xec\x70\x59\x34\x26\xfd\xf3\xf4\x42\x35\xf9\x08\x7c\x1f\xd6\xab\x8c\xbb\xd4\x2d\xe7\x43\xa3\xa3\x69\xdf\xae\x90\x3b\xe0\x7e\x66\x14\xd8\xd9\xed\xe0\x42\xc3\x6d\xee\xed\xf6\x65\x5f\x98\xc1\x3e\xf3\xdf\x95\x89\x3f\x5b\x6e\xb7\x3d\x8d\x68\x33\x25\xbc\x7b\x8a\x4e\x29\x77\xd7\x88\xbc\xd6\x20\x2c\xdb\xee\x7d\xbb\xa7\x80\xea\x43\xcb\x26\x76\xa2\xf0\x7d\x6b\x95\xc1\x82\x89\x22\x11\x2b\xa9\xa1\x45\x00\x4d\xe2\x37\xd6\xb0\x2f\x87\x9b\xaa\x03\x37\xa5\x96\x2c\x6a\xa1\x5f\x81\x4d\xb8\x7f\x3a\x0e\xb3\xee\xa6\x80\xf8\x59\xc7\xc3\x7e\x87\x3e\x20\x8a\x44\x14\xf9\x18\xd3\x9b\xc2\x3a\x9b\xc3\xe5\xe9\x17\x74\x21\xd2\xd0\xa9\x5f\x5e\xa3\xc6\xa4\xb3\xdd\xfe\x81\x86\xdf\x02\x5c\x7b\x5a\xd6\xec\xd4\xe7\xd2\x2e\x66\x0e\x01\x8d\x10\xd9\x53\x44\x75\x0f\xc1\x75\x78\x53\x6b\xdd\x46\xbf\xc5\x31\xf0\x98\xae\xab\xe5\xa3\x0e\x54\xa8\x28\xea\x25\xf8\x68\xc5\x10\xb7\x68\x21\x4e\x44\x22\xdd\xf1\xf0\x43\xfd\xf0\x06\xe3\x9c\x2a\xac\x92\x10\x42\x34\x96\x96\xce\xd6\x24\xd3\x35\xe9\xb8\x5b\x56\xb7\xaa\xa3\x03\x3a\xb3\x75\x1c\xeb\x39\x84\x92\x05\xfb\x44\xe7\xa7\xa2\x58\x93\x9e\xfe\xad\xdc\x09\x49\x2f\x61\xd5\xa9\x4c\x21\xbd\xa4\x12\x6c\xf6\xf1\x0e\x8a\xc1\x0b\x74\xc7\x7b\x26\x21\xbf\xa6\x8a\xdd\xd9\x70\x0d\xe3\xc4\x06\x0f\x63\x81\x59\x85\x2b\x22\xe0\x84\x20\xdf\x30\xd7\x19\xc6\xff\x71\x24\xdd\x13\xc0\x1a\xd0\xcc\x22\x45\xb0\x06\x71\x5e\x23\xaf\xf0\x89\x68\xce\x8d\x26\x56\xa9\x1a\x64\xc7\x40\x7a\x9d\x95\x4b\xc6\xdb\x80\xaa\xf4\x10\x9c\x5e\x0e\x29\x42\xe5\x7d\x28\x4f\xbb\xa1\xd4\xfb\x82\xc5\xbf\x24\xe8\x0e\x14\x7a\x85\x82\xc8\xc1\xa3\x56\xa9\xdf\x80\xda\x6e\x56\xd0\xea\xa5\xde\x0e\x5d\xb9\xae\x66\x19\x7b\xd6\xc3\x17\x25\x74\xd8\x21\x5a\x44\xe0\x5d\x40\xe6\x61\x14\x69\x42\x77\x14\xc7\xcd\x02\x84\x07\xe6\x29\x65\xdb\x2d\x42\x66\x5e\xf9\xef\xba\xca\xc2\x60\x7b\x64\x87\x47\xa1\x7b\x41\x4c\x5f\xa4\x0e\x90\x29\xf3\x87\xe8\x37\x66\x86\xc1\xd2\xa3\xa8\x2a\x40\x7c\xfb\xd0\xe7\x15\xed\x46\xa5\x56\x4b\x5b\x02\x92\x64\xd8\xa6\xa2\xbd\xb2\xef\x48\xb4\xc5\x5d\x4b\xd7\xa7\xd7\x34\xab\x36\x25\x45\x64\x8f\x32\x0c\x8f\x9c\x97\xe2\x43\x9b\x4d\x22\x63\x16\x9c\x9a\x5d\x5e\x42\x69\x4c\xdb\x7e\xbb\x5a\xa6\xd2\xed\x12\x7a\xc0\x27\x17\x18\xd6\x41\xaf\x5c\x63\x4a\x33\x13\x53\x9f\x83\xcc\xbd\xf1\x5f\x88\x57\xe2\xe0\xed\xf1\xd0\xc5\xef\x8e\x3e\xfe\x6d\x77\xbf\xcd\xeb\xcd\xa5\xbc\x20\x09\xe1\x31\xe1\x7f\xcc\x7e\xd3\x93\x9b\xbe\xf3\x52\x97\xe3\xd2\x2f\x47\x5c\x09\x97\x9c\xaf\xdb\x8f\x27\x6c\xe3\x76\x23\x58\x10\x7d\x3e\x30\x8c\xa1\x29\xf9\xe2\x11\xd8\x42\x3b\x43\x4c\x14\x7a\xb9\x96\x2e\x7b\xb0\xb3\xe4\x5c\xd6\xd6\x11\x3b\x4d\x99\xc6\xc4\x42\x30\xd6\xc7\xf6\xfe\x9f\xf6\x8a\x8c\x22\xf4\xf8\xed\x45\x2b\x79\xbb\x6d\x5b\x9e\xf5\x9d\x48\x09\x03\x9c\x54\x9a\xfe\x0f\xde\xf0\x45\x87\x3b\x43\x2e\x05\xf9\xd6\xb1\xde\xa9\x0c\xfa\x05\xd6\xf4\xb5\x83\xb0\x64\x8e\x56\x0d\xe3\xd1\x9b\x4e\xef\x27\x8e\x89\x8a\xc6\x67\x2f\xa5\xab\x4e\xd2\x76\x15\x27\xd7\xf3\x54\x7a\xe3\x66\x1c\x74\x56\xd2\xcd\xbf\x26\xed\x0e\x94\xbf\xd2\xdb\x74\x82\xe0\x34\xb6\x94\xb2\x29\x22\x68\xc6\x04\xe8\xb6\x21\x06\x23\x22\x08\x9c\x13\x61\xad\x22\x82\xf2\x82\x2f\xe7\xb4\x9a\x21\x82\xd6\x45\x25\xe0\xfa\x22\xaa\x4a\xf8\xfc\x94\x33\xfe\x71\x28\x6b\x4c\xc9\x8b\xf4\x1e\x7d\x85\x86\x13\x24\xb9\x9f\xd4\x92\x43\x49\x36\x87\x26\x32\xb8\x01\xae\xd5\xe7\x67\x3f\x4b\xbd\xd0\xe4\xa7\xdb\xd3\xe9\x57\x7f\x3c\x63\x53\x92\x0d\x27\x48\xa9\x2e\x6b\x00\x04\x13\x52\xd7\x5e\x42\xec\x4f\x02\x8f\x2f\x0c\x27\x53\x72\x25\xff\x94\xf0\x77\x56\xe4\xea\xdf\x9c\xc2\xff\x39\xbb\x81\x7f\xf4\x1a\xfe\xae\x64\x89\xd5\xb9\xfc\xfb\xb5\xfc\xfb\x8d\xfc\xfb\xad\xfc\xfb\x27\xf9\xf7\x3f\xe1\x2f\x93\x7f\xae\x97\xc3\x89\x1e\x53\x55\xce\x2a\x09\x47\x96\x0b\x07\x0c\xc9\x71\x01\x3a\x79\xee\x42\x53\x92\xcb\x9a\x0a\x86\xb5\xfc\x53\x4a\x38\x2a\xf9\xe7\x3a\xcb\x65\x4e\xb5\xce\xb8\xfc\xbf\x91\x90\x57\x1b\x59\x14\x1c\xa6\xf9\x12\x7e\x6d\xe4\x1f\x28\xba\x23\xff\x4c\xcf\x7e\x8e\xc7\xc3\x78\x3c\x94\x57\x80\xc7\xdb\xeb\x8c\xe5\xa2\xd8\x2e\xc4\x7a\x2b\x68\xbe\x5d\xb0\x9c\xe2\xe1\x76\xf2\xf3\x1f\xa2\xb3\xe1\x78\xfa\x55\x3c\x1e\x4e\xfe\x70\x36\x9e\x6e\xff\x88\xf1\xd9\x92\x91\xef\xd2\xb3\x9f\x61\x51\x41\x0b\xec\x3a\x5b\xd2\x9f\xce\xe2\xf1\xf0\xea\x7a\xbd\x5d\xb2\xc5\xf6\x97\x35\x5d\x6e\x7f\x59\x2f\xb7\x6b\xbe\xdc\x0a\xb6\x58\x6c\x6f\xe9\xd5\x1a\x6f\x6f\xd8\x9c\x16\xb2\xe4\x35\x94\xb8\x5e\x7f\xbb\x2d\x96\x4b\xc8\xbc\xc6\xdb\x6c\x33\x67\x26\xf3\x9b\x6d\xb1\xcc\x64\x5e\xb1\xde\x54\x18\x8f\xae\xb2\x8a\xfe\xe7\xb7\x64\xf2\xd3\xfc\xe4\x2c\x3b\xfd\xd7\xf4\x24\x85\xd9\x1c\x19\x7a\xec\xfd\x2d\x16\x44\x85\x7a\x59\xc4\xa0\xb0\x36\x61\x5b\x8d\xb6\x42\x18\xd7\x7f\x53\xc3\xe5\xe7\xba\x0c\xac\x5b\x1b\xcf\x93\xa5\xf2\x59\x75\x7d\x6c\x7a\xf6\xfa\xe5\x1b\x90\x7b\x4b\x0c\xb6\xa9\x8a\x02\x03\x7b\x27\x63\x0d\xc4\x82\x20\x78\xb1\xe3\x6c\x25\xae\x41\xf3\x58\xa4\xaf\xe5\xf6\x03\xca\xe3\x0a\x5c\xdc\xda\x6e\xbb\x72\x13\x6e\x0b\x4a\x5f\xc1\xf1\xa5\x4c\x43\x52\x51\x35\x11\x53\x52\xa6\x4c\xda\xbc\x40\xb5\x95\x88\xe2\x45\x71\x6b\xe2\xd9\xc1\xd8\xe4\xcb\x11\x85\x75\xbb\xed\x2a\x6a\xbd\x97\x99\x6b\x4a\x73\x77\x6e\x06\x6f\xea\x17\x5c\x30\xbe\xa1\x48\xae\xde\xac\x35\x82\xcc\xa8\x52\x4d\xc0\xd1\x59\xc1\x67\x99\x88\xe9\x04\x7d\x85\xa6\xdb\x2d\xac\x8d\x49\x29\x7f\xe0\x51\x16\xf6\x7f\x88\x43\x0f\x76\x88\xbd\x23\x94\x3a\x78\xeb\x58\x6c\x86\x22\x47\xfe\xb6\x76\x38\xc6\xdb\x6d\x1d\xda\x09\x9a\xfb\x7b\x96\x6f\xe0\x34\x04\x6f\xa6\xfc\x13\x1e\x1b\x6f\xa5\x7e\xe7\x04\x72\x05\x9f\xa1\x3d\x7e\xd0\xa2\xc7\x78\x25\x32\x3e\x03\xd2\x51\xb1\x35\x76\xdd\x37\x59\x80\xe8\x74\x1f\x70\xf5\xc1\x80\xdc\x1f\x68\x09\xaa\x7f\xbe\xc3\xf2\x99\x04\xf0\x7f\x6b\xaa\xa9\x6b\x64\x00\x23\xdf\x91\x2c\x1d\x90\x3c\x35\xfe\xd1\xa3\xec\x61\x3e\xca\x4e\x4e\x70\x19\x67\x36\x9e\x8f\x26\x2e\x79\xca\xff\xfe\xf2\xe5\x0b\xe9\x95\xf8\x24\x45\xa2\x28\x72\xc1\xd6\x88\x7c\x0f\xa3\xe3\x93\x27\x53\xf2\xde\x8b\x0e\x12\xff\xbc\xfd\xe9\xa7\x0a\x5f\x55\xa7\xba\xe8\x4f\x3f\xbd\x3b\x41\x04\x41\x98\xc7\x97\xe9\x04\x55\x19\x67\x82\xfd\x0b\x98\xe4\xed\x8a\x09\x0a\x2a\x53\x44\x6c\xf2\x77\x1c\x4d\xc9\x3f\xd2\xfb\x8c\xb3\x6b\xa9\xdb\x72\x1c\x46\x05\xbd\x5e\x83\x86\xaa\x8e\xce\x21\xd9\x6d\x2b\x8e\xc7\xd6\x60\x1b\x23\xa2\x35\x9a\x75\x95\x39\x95\xf1\x3d\x4c\x28\x11\x13\xcb\x83\xc0\xaa\x73\x9d\x53\xf5\x82\xaa\x1b\xd7\x59\xf0\x26\xad\xbd\x30\x67\x33\x9d\x1e\x0f\x07\x2b\xd1\x77\x70\x68\xd9\x86\xbc\xee\x64\x91\x81\xe8\x3d\xfb\x68\xaf\xe2\xd5\x85\x33\x08\x65\x86\xf7\x47\x39\x31\xf8\x74\x87\x64\x51\x2c\x81\xcb\x73\x17\x26\x3b\x17\x43\x23\xf5\x1e\x08\x7d\xf2\xf7\xf4\xfe\xf1\xfb\xcb\xd7\x43\x94\x6d\x44\x81\xc8\xe5\xeb\x37\xf2\xbe\x28\x22\x6f\x9f\xff\xf7\xf7\x97\x43\xfd\x02\x06\x79\xf2\xfa\xf2\xf2\xf5\xcb\xa1\xbe\xbe\x89\xc8\x8b\x8b\xef\x2e\x87\xf2\x2e\x28\xda\x91\x7f\xb9\xf3\xdc\x1f\xd4\x13\xfc\xe0\xe1\x9c\xdd\xf4\xe4\x96\x5c\x93\x5c\x0f\x36\xe2\xfa\xf3\x91\x5b\x26\x2b\xcb\xe2\x16\x3d\x7a\x78\x36\x67\x37\x8f\x02\x95\x4f\x25\x21\xdb\x02\xb2\xd8\x83\x9a\x38\xe4\x9b\x65\x3d\x29\x16\x59\xa2\x32\xa4\x32\x50\xa4\xd1\x3f\xaf\x69\xa2\x7f\xee\xd2\x80\x1c\xb5\x9e\xf5\x81\x33\xb9\xfd\xf3\xd0\x24\xc2\x7d\x48\xd4\x19\x24\xc7\x4e\x5b\x7f\x60\x7f\x7f\xa7\xee\x02\x3a\x53\xf4\xc2\x9f\x1b\xc8\xdd\x91\xbf\xa6\xf7\xdf\x3f\x7f\x76\x31\xb4\x97\xb7\x0c\xa2\xc8\xf7\xcf\x9f\x3d\x83\xc7\x8f\x6b\x51\xd1\x66\xbd\xfb\xfe\xf5\x3f\x86\x56\xfc\xf4\x92\x5f\x0d\x6b\xa3\xba\xcd\x78\xfe\xea\xdd\xc5\xdb\xcb\x8b\x67\x43\xc4\x78\x45\x4b\xed\x50\x64\xb3\x9f\xbe\x78\xfe\xf4\xaf\xc3\x5a\xde\xb7\x19\xdf\xbd\x7e\xfa\xfe\xdd\xf3\x57\x43\xd7\x9c\xec\x67\xbe\x7e\x7f\xa9\x73\x8b\x8d\xf0\xb2\x5f\xbe\x7e\xff\xee\xe2\xe2\xd5\xe5\xc5\xdb\x61\xe3\x85\x42\xbf\xc8\x8b\x8b\xc7\x7f\xbf\x18\x36\x9e\x21\x34\x45\x76\xe4\x07\x57\xe6\x6c\xba\x97\xff\xfa\xa8\x28\xba\x83\xcf\x0c\x8a\x62\x34\x9b\x17\xea\x6e\x6a\xed\x8c\x2c\xf4\x23\x77\xe6\x5b\x52\xa6\x7c\x00\xb6\x56\x0e\xab\x97\x2c\x2e\x15\xf1\xa6\xe6\x5a\xbd\xbe\xd9\xe6\xf8\xbc\x35\xbc\xe1\x0f\x38\xcf\x0b\xe6\x79\xe6\x55\xf4\xd8\x50\x41\xea\x7e\xad\x8b\xdd\xf6\xe8\x74\xac\x87\x83\x05\xcf\xa1\xa0\x3a\x0e\x9a\xb4\x7d\xc5\x1b\x09\x75\xe5\x8e\x07\x9b\x4c\x41\xf9\xa6\xac\x15\x15\x5a\xa1\x04\x1e\x5f\x3e\xfe\xf0\xd7\x8b\x1f\x95\xb7\x92\x6f\x26\xd3\x27\x40\x8e\x47\xce\x2d\xde\x46\x03\xcd\x3a\x1a\xa3\x10\xf5\x91\xe6\x74\x99\x09\xaa\x51\x0f\x87\xaa\xae\x0e\xe4\xf5\x5e\xd6\x98\x6c\x50\x9d\x80\x21\x2f\x9c\x4e\x18\x0c\xf1\x1f\x4c\xac\x1e\xbb\x99\x31\xbc\xc7\xff\x41\x2e\x1a\xc9\xf6\x09\xc3\x43\x96\x7c\xc8\x69\x76\x43\x4d\xc2\xce\xbc\x85\xab\xcd\x45\x60\x66\x62\x6b\x73\xb2\x0e\x44\x5b\xf5\x8d\x77\x72\x7c\x4e\x83\xd0\x86\x21\x72\xa7\x63\x48\xe8\x8c\xfb\xe1\x3f\x01\xe9\x2e\x86\x90\x7b\xaa\xa6\x6d\xd2\x39\x79\xe6\xc8\x6e\x4a\x2a\x0f\x84\x56\xf1\x8b\xbf\x83\xa7\x57\xb0\x7c\x7d\x3f\x51\xab\x07\x64\x0b\x96\xbd\xea\xe3\x76\xc3\xc1\xd7\x5c\x86\xb2\x6b\xca\xc6\x89\x11\xac\x6d\x2e\xaf\x49\xd9\xe2\xa7\x66\x01\x4e\x92\xc3\x05\x9c\x54\x8f\x04\xdc\xe2\xc1\x50\x22\x7b\x42\x98\xf8\xec\xa2\x4e\x69\x39\xfa\x1a\x1e\xd1\x8e\xc0\xd5\x34\xdd\x28\xbf\x8c\x34\x4d\x5b\x58\xd5\x57\x34\xd7\x79\x76\x87\xb0\xc3\x5f\x35\x6f\x7d\x93\x53\x78\x57\x70\xa3\x23\x13\xf7\x0a\xde\xd3\xba\x8b\x9e\x6e\xa3\x42\xcd\xfb\x23\xed\x59\x85\xe4\x04\x36\x37\x6c\xed\x48\x6a\x65\x3c\x2d\xb8\x90\x24\x1d\x45\x8d\x29\xc0\xf7\x4d\x50\xb5\x78\x60\xde\x97\x02\xd5\xd3\x82\xf1\xf9\xbb\x55\x36\x2f\x6e\xdf\x16\x85\xf0\xcb\x83\x01\xa2\xbe\x5f\xad\x63\xbb\x14\xe3\x62\xe8\x96\x4a\x8a\x5b\x4e\xcb\x67\x46\x3d\x65\xf4\x54\x7a\xa5\x79\x13\x21\x41\xef\xba\xcc\xd2\xaf\xf4\x0a\x74\xc3\xb2\x37\x56\xad\x89\xbd\xfc\xfe\xf9\xb3\x36\xe1\xbf\x7a\xfc\xf2\x02\x8f\xca\x86\xaf\x0c\xe8\x3d\x72\xec\x81\x11\xf2\xa6\x01\xc5\x4a\xc9\xae\xe8\xfc\xea\xae\x2e\x5f\xc9\x2d\x45\x38\xb7\x0b\xb4\x43\x86\x95\xf1\x5a\x91\xb1\xf4\x15\x06\x18\xc2\x2c\xed\x8a\xa9\xa1\x5b\xb1\x12\xd7\x38\x98\x5a\xbf\x40\x40\x4a\x0f\x7e\x3c\x0c\x96\x27\xab\x7a\x27\x7c\x2c\x44\x36\x5b\x41\x2b\xf1\x4c\xb3\x2d\x88\xd1\x6a\x53\x15\xb4\xfa\x61\xb4\x8d\xb7\x83\xc2\x64\x03\x8b\x55\xb7\xe3\xe7\x96\x3f\x85\x58\x92\x04\x0b\x13\x87\x48\x3e\x97\x32\x80\x87\x98\x38\xd7\xc6\x99\x64\x83\x49\x17\xe1\xb6\x20\xb9\x00\x02\x4a\x8c\x10\xd7\xe4\x04\x32\x0e\x99\xdb\x92\xc1\x64\x33\x88\x48\xbc\x92\x3b\x57\x19\xf0\xf1\xf8\xbd\xee\xfe\x77\x07\x1e\x93\x93\xe0\x8b\x05\x01\x42\x13\xc9\x87\x05\xfb\x54\xfb\x32\x68\xa5\x2b\x4f\x85\xcb\x4d\x47\xde\x97\x62\x74\x70\x5c\x6e\x63\x32\x80\x46\x60\x2f\xaf\x60\xc4\x1b\x30\x11\xa4\xaa\x53\x77\x13\xf4\x9c\x3b\x60\xea\x5a\x4e\x4d\x8a\x6b\x2e\x8e\xf6\xeb\x10\xac\xf6\xe8\x10\xac\xcb\x97\x63\xde\xe1\xcb\xb1\xd0\xc6\x81\x79\x8c\x03\x41\xd1\x9a\x1e\x59\x41\x8e\x52\x1c\xe2\xb9\x70\x04\x01\x55\x95\x33\x3d\x8a\x34\xa4\x27\xa8\x83\xec\x28\x72\x15\x47\xfe\x57\x53\x8d\x04\xee\x0e\x70\x49\xf4\x92\xad\x15\xfa\x20\x49\xcf\x91\x2e\xbb\x8f\x43\x01\xad\xf2\xf6\x9c\xf2\x30\xf8\xcf\x2e\x5e\xd9\xc8\x5c\xdc\x2c\x0e\xe9\x1d\xd5\xde\x48\x5b\x2e\x3c\xad\x4e\x0a\x4c\xfa\x45\x90\x87\xcb\x43\x87\x54\xd0\xeb\xd1\x7e\x91\x75\xb4\x2f\x86\x46\x40\x8a\x30\x02\xe6\x79\x30\x53\x1e\xcf\xba\x32\xe5\xd4\x3a\x8f\xe9\xed\x21\xf8\xa3\x1c\x99\x98\x76\x28\xf8\xb5\xee\x4a\x5a\xfc\x74\x96\x37\x42\xbb\x70\xac\x36\x3d\xdb\x7b\x84\xa7\x50\x1c\x36\x4f\xa0\x70\x9b\xd3\xa2\xb1\xd5\x1d\xda\x85\x24\x20\x5e\x9a\x8c\x96\xd6\xde\x5e\xea\x06\x84\x95\x44\x1a\xab\xcf\x65\xb7\xb5\x7e\xed\x14\x9d\xc0\xc5\x5e\xe6\x97\x0e\xc0\x63\x66\x45\x9b\xa7\xd8\xda\xba\x13\x6b\xf6\x69\xd4\x2f\x78\x32\xd0\x6f\x26\x0b\x88\xdd\xc4\x9c\x9d\x3d\x2c\xf0\x35\x01\x1d\x19\x79\x40\xa7\xe8\xca\xe0\x3e\x1a\xb2\xeb\x9b\x81\x28\x1d\x8d\xb9\x0d\xea\x60\x0c\x36\xb8\xc6\x32\x01\xc9\x41\x3f\x5d\xa1\x21\xf4\xfb\xaa\x01\x95\x3e\x01\x46\xa9\x65\x0d\xfe\x7c\xbb\xed\x73\x7b\xf3\x23\x8a\xfa\x5c\x07\x01\xf5\xa4\x0b\xd0\xfc\x8c\x63\x37\xc5\x28\x65\xa4\x95\xf1\x6f\x31\xf7\x04\x1d\xab\xa1\x21\xa1\x2a\xdf\x71\x18\x9a\x6c\x33\xe6\xd2\x3d\x1c\xd4\xfd\x31\xf7\x05\x14\xc8\x1e\x4b\xcb\xe0\x5a\x3f\x60\x05\x06\x3c\x88\xf9\x25\x12\x7a\xbd\x16\x77\xb1\xd9\xfb\x65\x4d\xd5\x86\x2c\x2f\x7f\xe1\x9a\x14\x44\x4e\x3b\xa6\x4b\x73\xa9\xd0\xe3\x49\xe6\x55\xf3\x53\xa9\x05\x43\x56\x1f\x2c\xe0\xae\xd6\x21\x11\x4d\xd6\xf1\x10\x28\x53\x6a\xd1\x2c\x2c\x93\xc9\x42\x4e\xd0\x3e\x57\xd4\x68\x87\xc9\x91\xe4\x6b\xc0\x92\x76\x5a\x37\x82\xd9\x67\x84\x2b\xbb\xa2\xab\xec\x86\x15\xa5\x07\x4b\x4b\x63\xb7\x23\x52\xb7\x38\xbc\xd7\xa0\x0f\x51\x22\x13\xd0\xf1\x41\xcd\x74\xd3\x46\xe7\x07\xe1\x46\xf8\x53\xe9\x83\x51\x9b\xd1\xa5\xa1\xd7\x20\xdf\x76\x0e\x4c\xa9\x16\x59\x21\x7e\xd3\x07\xe5\x64\xaf\x50\x64\xcb\x3d\x5d\x65\x7c\x09\x8b\x04\x9a\x56\x3c\xca\x6b\x5a\x63\xeb\x70\xf5\x1d\x71\x21\x76\xb5\x8c\xf8\xf7\x0a\x91\xf7\x9b\x23\xe4\x75\x07\xc8\x3b\x10\x1f\xaf\x23\x3c\x9e\x95\xe2\xdd\x31\x19\xab\x4a\x9a\x7a\x75\xb5\xe4\x4e\xcb\xfa\xb9\x03\x90\x5d\xdb\xa1\x8a\x9b\xe5\xf1\x98\x76\x64\x0c\xeb\xfd\x1b\xe2\xe6\xf0\x79\x47\x39\x03\x6e\xbd\x6f\xa4\x81\x29\xfe\xfb\x44\xf8\x4f\x54\x4d\x65\x3d\x57\x9b\x17\x9e\xba\x91\xdb\xab\x96\x5e\x92\x6a\x9d\x33\x11\xa3\x1e\xc2\x01\xd3\x9b\x76\x5f\xa9\xc3\x95\x61\x4f\x74\x06\x91\x32\x20\x61\x49\x15\x71\x3d\x8b\x46\xa7\x4e\xe2\x00\x11\xd8\x20\xa3\xf2\x32\x49\xfd\xd4\xec\x75\xc6\x37\x59\x0e\x9e\x16\xd6\xdd\x0b\xc9\x7d\x5e\xca\xe1\xe3\x50\xb7\x8e\x12\x39\x94\xad\xf5\xd3\xa4\x38\xae\x21\xa5\x6a\xee\x6c\xe8\xf5\xfb\xcb\x51\x13\x17\xec\xe8\x31\x6b\x8d\x19\x35\xd1\x66\x8a\xe3\x6b\xaa\xd3\x87\xac\xb9\xab\xc3\x2a\x34\x95\x53\x2e\x01\x58\x20\xe1\xf4\x62\xee\x72\x6a\x52\xdd\xa7\x09\xe3\xcd\x40\x08\x1d\x7d\x69\x18\x1a\xf0\xbb\x7b\x84\x1f\x19\x76\xc1\x96\xe4\x5e\x93\xdf\xd0\x4c\xb4\x63\x8d\x43\x3b\xbd\x77\xc8\xe3\x1d\x6c\x1c\x3a\x28\xae\xf9\x0c\x50\xb7\xc3\x7c\x3e\x67\xe7\x8b\xf7\xd4\xd0\x65\xb6\x5b\x63\x57\x04\x4e\x6d\x43\x9e\x84\x35\x27\xa1\x5e\xc8\x51\x7d\x20\xec\x97\xf3\xdb\x35\xce\x1e\x48\x07\xe2\x95\xe4\x13\xb4\xfe\x1b\x24\xb7\x34\x13\xa3\x98\xc3\x13\xfc\x5d\xca\x68\x86\x21\xac\x1f\xff\x62\xca\x6e\x46\xa4\x68\x04\x32\x55\xe3\x54\x31\x31\x06\x23\x58\xd9\x22\x01\xe1\x6d\xac\x23\x9d\x69\xab\x1d\x9a\xca\x20\x17\x70\xc2\x6b\x48\xa1\xa1\x27\xc2\xd4\x59\x34\x6d\x9c\x45\xc7\xde\x57\xaa\x0a\x0d\x63\x4f\x23\xcd\x1d\x75\x74\xa8\x38\xe1\x9a\x7c\x13\x69\x36\x8c\x22\xff\x5b\xfa\xe3\x8d\xeb\x46\xd2\x8e\x47\xd1\xc3\x00\x42\x6b\xfa\xa1\x4f\xdc\xe8\x48\x36\x8c\x87\x26\x5f\x91\x80\xe4\x03\xff\x6f\x91\x80\xd6\xb2\x74\xd2\xc0\xb9\xbe\xaf\x1b\x30\x7f\x6c\xb7\x47\x4f\x25\xf4\x72\x68\x26\x81\xef\x1d\x33\x93\x46\x2f\xd4\x9c\x48\xcd\x65\x5b\x13\x09\xe9\x30\x91\xfa\xfa\xe8\xae\xc3\x9c\x53\x4f\xab\xf3\x4c\x75\x8f\xe9\xf3\x9d\x8f\x3b\x6c\x4d\x5f\x3e\x4a\xc5\x54\x6b\x8f\x5d\xff\x11\xc2\xba\xa2\x5b\x78\xcf\x76\x68\x26\xa4\xe7\xcd\x1e\x10\x5c\x67\x25\x1e\x12\x17\x04\xbe\x3f\x3d\xef\xa7\xe9\x4b\xeb\x60\x03\xa1\x81\xe6\x34\xa7\x82\xf6\xf8\x44\x4c\x77\x81\x87\xe6\xcd\x7b\x6b\x9d\x81\xc3\x79\xdb\x4b\x17\x82\xfd\x8c\xc5\xf0\x1e\xb6\x4f\x3d\x83\xb1\x50\x38\x4e\xef\x61\x95\x0c\xf5\x17\x01\x4c\x9b\x8f\x5d\xbb\xf3\x9e\x48\x24\x6f\x05\xb6\xae\x7e\xa5\xfa\x7f\x22\x0a\xed\xb4\x15\x7a\x1c\x5f\x6e\xd7\x70\x08\x95\x15\xf5\xef\xd4\xfe\xf2\x2a\xb7\xa3\x84\x3c\x39\x1c\xdd\xdc\x3d\x85\x0a\x7b\x6c\x4f\xff\xe6\x7c\x10\xe1\x9e\x46\x1b\x67\x50\x33\xd5\xfe\xf2\x0c\x84\x86\xde\x59\xeb\x89\xa2\x54\x1b\xb6\x97\x5a\x82\xd3\x19\x5d\x10\x4f\xe8\xd4\x68\x56\x54\xc9\x09\x9d\x02\x5a\x26\x74\xda\x48\xad\x8f\x9a\x12\x3c\x4f\xe1\xd7\x06\x8d\xc6\x21\xc5\x03\x84\x46\x17\xfa\xc5\x33\xe9\x00\x82\xb0\x76\x9a\x7a\x6f\x9f\x9f\xe6\xb0\x06\xeb\xab\xe6\xae\x56\x81\x27\xbf\x14\x8c\xc7\x76\x27\xdd\x73\x58\x6a\xbf\x7b\x08\xb6\x31\x91\x18\x9f\x2e\x7d\x76\xd2\xac\xb0\xa9\xbd\xec\x32\x31\x58\xc6\x59\x67\xc4\xce\x01\x50\x83\xe5\x29\xb6\xdb\xa8\x09\x21\x86\xf0\x34\x68\x91\x91\x48\x91\x6c\xd5\x97\x3d\x3e\x9d\xda\x4e\xc1\x65\x38\x0e\x2b\x60\x8c\x5c\xd2\x6c\xd5\xaa\x08\x61\x81\x99\xe1\xc2\xca\x8b\xbb\x2a\xf0\x2f\x13\x77\x5b\x6b\x96\xa4\x37\x65\x8b\x4f\x00\x21\x00\x7d\xc7\x6c\xbb\xed\x9f\x69\x8b\xb4\xff\xd6\x1b\x0c\xbd\x15\xad\xbb\xc0\xa4\xb3\xa3\xae\xa0\xdc\x5f\x2c\x2a\xf7\x17\x75\xe9\xff\x97\x2d\x08\xd6\xc2\xae\x52\x4f\xea\xe6\xb4\x2c\xd1\x51\xd2\x45\x94\xad\x23\x8f\x4d\x5d\x4d\xff\xb5\x2e\x66\xec\xf4\x5d\x6d\x27\xa1\xc6\x8f\x88\xb7\xfa\x0f\xe7\xda\x82\x72\x68\x4c\x7f\x68\x11\x22\xd1\xbe\x8e\x9e\x6b\xfb\x0f\x36\xf5\x70\x10\xce\x27\xd3\xf4\x7b\xd2\x6e\x57\x39\xb5\xff\x98\xa2\x75\xb1\x06\x29\x04\x91\x3f\x2a\xb7\xca\x1f\xa7\xe4\x7f\x3a\xdc\x2a\x75\x51\xc7\xad\xf2\xbf\xd5\x1e\xf9\x83\xe1\xb9\xae\x42\xcc\xf8\xe1\xd9\x23\x95\x3a\xae\x13\xbd\x17\x0d\x11\xea\xf0\xba\x33\x10\x1d\xef\x75\xb7\xfa\xa6\x51\xf7\x74\x45\xb3\xb9\xf2\xba\x5b\x7d\xf3\x28\xd0\xf8\x29\xe8\x4e\x1a\x4e\x79\x3b\x4c\xfe\xb7\x31\x1e\x58\x14\xe4\xde\x42\xdc\x70\x76\x74\xbc\x18\x77\x98\x08\xd1\x74\x83\xd3\x7d\x85\xdc\xe0\x6c\x96\xef\x06\xe7\x25\xbb\x6e\x70\x36\x23\xec\x06\x67\xb3\x9b\x6e\x70\x36\x23\xe4\x06\xe7\x67\xb6\xdc\xe0\x6c\x76\xb7\x1b\x9c\x5f\x24\xe8\x06\x67\x8a\xec\x08\x15\x6d\xd1\x8e\x38\xfe\xef\x55\x4d\xb9\x02\x14\xcd\xf9\x9d\x64\xaa\x24\x2b\x97\x52\xdf\x55\x81\x26\x7a\xc5\xaa\x1d\x4b\x05\x89\x79\x5a\xe1\xda\x27\xcc\x78\xac\xab\xe0\x0a\x31\xab\x73\x40\xd8\xb5\x1f\xae\x9c\x90\x72\x10\xdb\x3f\xc8\xbc\x0f\x1f\x52\xa6\xdd\x19\xaa\xba\xb4\x11\x0b\xca\x83\x06\x97\x7a\xe3\x93\x6a\x01\xfd\x6c\x9c\x51\xf1\xc9\x33\xe1\x8e\x94\x81\x7d\xd7\xc3\xc8\x51\xb6\x17\x8d\x4f\x6d\x7b\x29\xfd\xd2\xbf\xa3\xed\xa5\xdc\x6b\x7b\xe9\x00\xb5\xcb\xfc\x22\x94\x7a\x11\x25\x8d\xf5\x89\x49\x03\x73\xd6\x56\xde\xc2\xdf\x28\xa0\xd5\x85\x88\xf4\x3c\xe5\x01\x2d\x3f\x26\xc7\x42\x22\x19\x01\x26\x1c\x13\x5f\x22\xf3\xed\x3c\xa5\x0b\x4c\x17\x96\xc3\xca\x14\xd8\xa9\x4f\x35\x0b\x41\x9a\x84\x0d\xee\x75\xb2\x6c\xff\x77\x16\x3b\xff\x27\x24\x76\x3e\x1a\xec\x15\x3c\xab\xd6\x36\x11\x52\xf4\x76\xcb\x41\x3c\x6d\x89\x27\x1a\xcd\x10\x5f\x2e\x6d\x9f\x97\xc6\x42\x3a\x18\x4b\x59\x88\x77\xc8\x42\x42\xca\x42\xdc\xea\x00\x94\xac\x4a\x58\x40\x16\x32\x9d\x11\x1e\x92\x85\x44\xb7\x2c\x04\x87\xc0\xa3\x65\x21\xa1\x6e\x3d\x42\x9d\x5a\x16\xaa\xbe\x9c\x2c\xf4\xdf\x47\xc9\x42\x3f\x7e\x8e\x2c\x64\x10\x75\x9c\x2c\x24\xc4\xe7\x09\x43\xad\xd6\x9d\xdd\xb4\xab\x8f\xff\x95\xc2\x50\xb5\x8b\x7f\xd0\xd2\xd0\x8f\xd3\x94\xb6\xe5\x72\xa2\xf3\x3c\x71\x88\x0a\x9b\x7c\x58\x1e\xfa\x71\x9a\xfe\x91\x04\x5a\x56\x02\x11\x17\xa9\xf6\x8b\xaf\xd6\x77\x88\x30\xa1\x84\x22\x2e\xa6\xa4\x10\xf6\x39\xd8\xf3\x01\x51\x02\xb2\xb9\x83\xa0\x2e\xe3\x0d\x21\x40\x46\x55\x17\x33\xc7\x73\x5b\x58\x53\xa4\x2d\xde\xba\x3c\xb1\x23\xa5\x07\xb8\xf9\xd9\x13\x4d\x4d\x9a\x71\x71\xd5\x7c\xdf\x68\xbe\x14\xec\x9a\x3d\xa4\xe8\xc9\xeb\x67\x3f\x6a\xdd\x95\xba\x2a\x3a\x56\x97\xc1\x86\xa6\xbc\x56\x84\xbb\xdb\x15\x68\x02\xb8\xe6\x9f\x1f\x8c\xfa\x5b\x33\x64\xb3\x65\x48\xf8\x4f\x50\x2f\xe1\xd9\xcd\x29\x5c\x6a\x24\xe8\xa4\xa3\x44\xce\x2a\x71\x0a\x97\x26\xd7\xa7\x70\x47\xb2\xbb\xa0\x89\x5d\x2f\x8b\x19\xa5\xbe\xb6\xb0\xa5\x13\xbd\x35\x7d\x50\xe5\x9d\x04\xad\x5e\x92\xc9\x8e\xbf\xa8\xc6\x84\x0a\xe8\x92\x0e\x34\xaf\x68\x20\x48\x1a\x39\xf4\x7c\x03\xe1\x3a\x33\x1f\x07\x18\x1f\x4b\x40\x5a\x98\x51\x78\x71\xbd\x8e\x1d\x5d\xd2\x45\x49\xab\x95\x39\x60\xda\x22\x78\x5f\x38\x75\x5d\x29\x7c\x84\x36\x47\x66\x1f\xd8\x34\x0d\xa5\x26\x6a\x42\xc7\x48\x61\x0a\x0d\xe1\xce\xa9\x3c\x9e\x23\xe0\xb8\x92\x3c\x6d\x4d\x8d\x73\x45\x8e\x63\x3e\x0c\xa4\xc2\x71\xd5\xb6\x00\x0f\xda\x8e\x1b\xe1\x54\x2e\x8b\x75\x8c\x87\x83\xd1\xb1\xf3\xe3\xcd\x43\xa3\x2d\x95\x1a\x63\xe2\x5f\xce\xb3\x06\xce\x96\xc7\x86\x4f\x94\x18\x54\x2c\x6e\x58\x33\x2b\x55\xea\xc8\xcc\xa6\xaa\xe7\xcd\x26\x9d\x5b\xab\x7d\x77\xb5\x2b\xd8\x56\x8d\xbf\x10\xef\x8c\x60\xc3\x16\x71\xa9\x22\x0b\x6c\xb7\x65\xb2\x92\x63\xd1\xca\xcd\x09\xb8\x45\x4c\xd8\x34\x86\x97\x2a\xd7\x27\x05\xa9\xa6\xe6\x29\x3e\x20\xd1\xdd\xfe\x77\x4a\x04\xe4\x57\x45\xe9\x6a\x75\x05\x71\xcc\x6d\x93\xc1\xf4\x94\x4e\x06\xd3\x1d\x0e\xa9\x3b\x29\xd8\xd6\xcc\xcc\x24\xeb\x4d\xb5\x8a\xa1\x30\x08\x38\x66\x7a\x4c\xea\xf9\xd4\xc4\x62\xd3\x1b\xaf\x4b\x90\x6d\xd7\x77\xc3\x6e\x08\xf2\x16\x8b\xd9\x89\x1b\xa4\xa9\x7d\xc0\xfc\x85\x85\x89\xd7\x52\x7b\xc5\x5e\xb4\x33\x34\x93\x72\x8b\xea\xa9\x72\x4b\x19\x52\x74\x92\x0c\x31\x3a\x49\x47\xb1\x0b\x13\xa2\xb9\xe6\x88\x35\x5e\xd4\xad\x0e\x23\x5c\xf4\x1b\xda\xe2\x42\xec\xd7\x0a\x9b\x80\x94\x9e\xd5\x5e\xa7\x6a\x17\x35\x29\x44\x99\x24\x2d\xda\xb1\x39\xc2\x23\x25\x02\x59\xbf\x6a\x1d\xd5\xb4\x5d\x52\x4a\x3f\x36\xdc\x1a\xfa\x03\x3a\xe1\x86\xf8\xda\x3a\x5f\xf0\xef\x25\x95\x30\x51\xa1\xdd\x65\x5e\x8f\xb9\xa6\xbc\xc0\x44\xa5\xa9\x66\x41\x81\xcc\x64\x9d\x2d\xe9\x8f\xaf\x5d\x6f\x18\x3f\xdf\x46\x6f\xf5\x7b\xd7\xf3\x70\x14\x00\xba\x0d\x55\x65\xbb\x95\xf1\xa3\xae\xb3\x4f\x35\x17\x01\x09\xdf\x2b\x54\xc7\x12\x31\x3f\x42\x4d\x61\x03\x92\x02\xff\x73\x40\xaa\x71\xe2\x86\xac\x52\x2d\x04\xd1\xd0\x15\xb7\x44\x31\x15\x09\x88\xde\x56\xc2\x3b\x86\x3f\x71\x31\x3e\xf1\x38\xbb\x71\x3b\xe9\x64\xbf\x3c\x0d\x94\x3f\xa1\xa7\xb6\xbc\x8b\x82\xb8\xbe\xd8\xa0\x47\xa1\xd2\xe1\x66\x73\x14\x35\xb7\xc4\x47\xb5\xa3\x84\xb7\x26\x27\xde\x97\x3e\x9e\x9c\x9e\x4f\x47\xed\x65\xda\x97\xcf\xaa\x3b\xe9\xf2\x84\x5f\x5f\x1c\x6a\xd7\x88\x22\xf1\xd0\x63\x0a\x93\xc1\x34\x8a\x9a\x29\x8f\x06\xd8\x9b\x42\xb7\x05\xc5\x1a\x9c\x2b\x46\x70\x3a\x03\x67\x7b\x63\x86\x28\x52\xaf\x39\x3d\x80\x51\x71\x7a\x3a\xc2\xf7\xed\xf6\xfa\x69\x63\xf8\x05\x00\xf4\xc8\x6f\x44\x26\x06\xcf\x27\x8d\x62\x27\xe7\xd3\xed\xb6\x39\xc4\xe2\xe4\x7c\x8a\x5b\x78\x6a\xf6\xaa\x5f\x01\xb0\x05\xda\xd6\x04\x1f\x0f\x82\xf8\xe3\xd7\x32\x8d\xc7\x85\x8d\xb3\x0e\x41\xcd\x0d\xb9\xde\xb3\xe8\x89\x89\xb8\xa2\xd9\x92\x3a\x47\x4d\xc9\x83\x13\xc8\x82\x98\x18\x36\xed\x01\xc8\x56\x70\x03\xee\x58\xb1\xc0\x1c\x62\x09\xc2\x18\xe3\x11\x73\xcc\xef\xbe\x54\x89\xc7\x31\x0b\xbc\xfe\x86\xb4\xfb\x53\x9d\xa2\x03\xc3\x20\x57\xf9\xa2\xf0\x02\x27\xd9\x40\x22\x1e\xc6\xa1\x64\x62\x9c\xcb\x2b\xf5\xf6\x2b\x71\x05\x62\x04\x0a\x2c\x7a\xa3\x72\x94\x18\xdd\x92\x97\x11\xfe\x6d\xad\xea\x36\xac\x63\x76\xdd\x57\xb0\xe5\xce\xad\x5c\x6b\x71\x75\xc9\x4c\xd0\x86\xbc\xdc\x8e\x7a\xbd\x33\xce\xf3\x9e\xdd\xf8\x57\x4b\x7a\xfb\xe4\x25\x27\x04\xaa\xf6\x5a\x73\x86\x14\x16\x92\xc2\xb5\xf5\x25\x39\xb7\xee\x17\xb1\x0e\x39\xf2\xd0\x91\xcf\xb2\x46\x11\xc7\x64\x4f\x3b\xff\xee\x37\x59\xbf\xa8\xf5\xa7\x10\x6e\x44\x27\x37\x1e\x74\x5e\x64\x73\x8f\xf2\x9c\x90\x4e\x2e\xde\x0d\x9b\x3e\x3e\x82\x9d\x66\x4f\xd5\xfa\xce\x68\x00\xe4\x2b\x87\x70\x16\x33\xcc\x9d\x4b\xe6\x6e\xa7\x14\xec\x67\xa3\xb2\x4d\x1d\x3a\xc2\x08\xdc\x3b\x06\xc5\x94\x76\x88\x33\x7a\x84\x34\x50\xc3\x66\x7a\x6a\x8d\x52\xab\x35\xb8\x38\x46\xaf\xc1\xc5\x34\x65\x82\x04\x5a\x57\x9a\x8d\x4c\xa9\x32\x12\x91\x5d\x91\xdc\x6b\xc5\xfc\xec\x89\x7a\x0f\x30\xe2\xb9\xd8\x77\x88\xdd\x7f\x0f\xb3\x1f\x7b\x4d\xfd\xf6\x07\x11\xec\x7d\x56\x53\xd1\x75\xb3\x32\x2b\x76\xbb\xdd\x53\xc8\x3c\x14\x89\xcc\xc3\xb3\x9c\x30\x12\x78\xe9\xa8\xde\x21\xda\xcc\x15\x6e\x28\xec\x3b\x5e\x7a\x2d\x01\x1e\xf4\x63\x41\x65\x8a\xde\xbf\x00\x5d\x4c\x61\x43\xa2\x6c\xb7\xe8\x75\x23\x69\x8c\x1e\xf5\x72\xd6\x7b\xd4\x4b\xf4\x80\xc0\xe9\x5b\xfd\x1a\xb1\x34\x86\xc0\x2e\xd7\xd9\x47\xfa\x18\xe2\x70\xc4\x34\x2e\xf4\xc6\x55\x62\x8c\x27\x26\x72\xcb\xe9\xf9\x74\xa7\xc3\x7c\xe9\x1b\x53\xd6\x22\x25\xb2\xab\x36\xab\x76\x21\xde\x61\x32\xab\xab\xd9\xe8\x0c\x81\x6a\x6c\x27\xe5\x40\x19\xbe\x90\xd5\xfb\x43\x5e\xef\x20\xad\x1b\x49\x33\x4c\xfa\xb3\xe0\x8d\xa4\x28\xea\xe7\xc1\x0c\x7c\x7f\xf0\xa4\x1e\x14\x78\x74\xdf\xa4\x50\x12\xcb\xaa\x49\xa6\xfe\x23\x35\x26\x36\x45\x08\x3b\xb6\xad\x9d\x7b\x07\xad\xb6\xcc\x85\x2a\x01\x6e\x3c\xac\xf0\xae\xd8\xe8\x05\xde\x8d\xf8\xb8\x31\x04\x4e\xb8\xb3\x26\xc8\x0a\x0f\x57\x7b\x5e\x6f\x3a\x70\x4c\x07\xf8\x42\x87\xee\x2e\x29\x90\x38\x51\x58\xa1\x16\xa9\x52\xf9\xc6\x36\x50\x2f\x18\x13\x2c\xa9\x46\x11\x7a\xdd\x48\x52\xb7\x47\x1c\x59\x43\x93\x2e\x1e\xca\x0c\x49\xaa\x4d\x02\xc7\xd2\xee\x54\x82\x88\x5f\x01\x2d\x55\xee\x82\xd5\xae\x1e\x79\x80\xe9\x15\xf0\x84\x91\xb9\xb6\xf8\xb4\xb8\x5e\x83\x37\x57\x2c\x48\x45\x98\xba\x17\x57\x45\x51\x59\xbf\x80\x7d\xf0\xd2\x57\x05\x53\x56\x85\x9f\x5d\x0e\x5e\x04\xcb\x3b\x2e\x82\xcd\xf4\x45\x30\x78\x71\x8b\xf0\x10\x94\x2d\x6c\x43\x88\x25\x7c\xef\xc4\x34\xd5\xdd\x1b\x14\xe9\x4b\xe0\x34\x76\xe9\xa2\xc6\x67\x2d\xb5\xc2\x33\xdf\x35\x66\x27\x83\x29\x04\x25\x94\x6c\x22\xd8\x2e\x41\x40\x1d\xd2\x53\xd0\xb7\x59\x81\xe5\x1d\xbc\x6f\x78\xc3\xe1\x57\x06\xa2\x53\x42\x19\x3c\x74\xdd\x3f\xc7\x3b\x79\x95\xd0\x7b\x4f\xa5\xd5\xba\xe8\x68\x5d\x1c\x68\x7d\x80\x49\x1d\xf4\x1d\x13\x57\x50\xab\xc5\x3c\xf3\xe4\xa3\x9b\x9b\xcd\xe7\xf6\x3a\xa3\xbf\xf1\xd0\xd8\xfd\xf6\xf6\x06\x17\x87\xe6\xba\x20\x84\x1c\xf6\xf6\x03\x83\x69\x85\x5c\x50\x24\x1a\xee\xee\xcb\x19\x55\x5b\xc0\x08\x1c\x2e\xf4\xdd\xed\x36\xea\x76\x41\xd4\xd4\x2f\x8c\xf7\x07\x78\xc7\xa2\x88\xc5\xbf\xa7\x78\x4a\x20\xec\xb2\x15\x2d\x61\xee\x82\xc1\x46\x31\x69\x94\xfa\xb7\x85\x18\xfd\x6d\xa2\x67\x2d\x50\x76\x84\x08\x15\xd9\x55\x77\x80\x50\x40\xc7\x94\xf4\xfc\xc4\x35\xcb\xf3\x76\x2a\x48\x0b\xad\x50\xa2\x81\x47\x20\xf3\xf6\xcc\x35\x1e\xff\xd5\xd6\x67\x2d\x3d\x02\xb2\xd3\x40\x25\x9b\xe9\x49\x8f\xb9\xa8\xd3\x0f\x4a\x8f\x50\x2a\xcd\x04\x09\xb4\xae\xa4\xc7\x99\x91\x1e\x8b\xac\x12\x64\x25\xc2\x61\xd2\xc0\xda\x00\xa2\x86\x93\xa4\x83\x9e\x69\x4b\xd8\x8e\x6c\xbc\xaa\xfd\x41\x5d\xa7\x3f\xd0\x61\xaf\xfe\x34\x18\xec\xc8\x5c\x1c\x7e\x93\xd7\x6c\x67\x07\x8c\x59\x26\xcc\x90\x75\x9b\xde\x1f\x6b\xe8\xb3\x85\x5d\xc2\x03\x12\x13\xe0\xe9\xf0\x43\x77\x7d\xde\x21\xf5\x38\x4a\x17\xe3\x3b\x8e\x89\xa7\xa4\xb3\x48\x6c\x4a\xd3\x0d\x36\xe8\x04\xba\x60\x2e\xfc\x22\x54\xc3\x9c\x7f\x81\xf0\x40\xbd\x8d\x49\xb0\x98\xcb\x5f\x3b\xde\x7c\xa9\xe5\x23\x85\x09\x22\x6a\xc8\xf5\x8c\x83\x33\xee\x41\x5f\x76\x7b\x35\xc8\x6d\x41\xd2\x09\xc6\xb5\x8f\xf0\x9e\xa1\x40\x75\xe4\x6e\x24\x6e\x79\x4c\xbc\xcf\xd0\x10\x35\x1a\x82\xa8\x37\x72\xd2\x11\xe2\x85\xdf\xed\x88\x36\x12\xc2\x22\x06\x3b\xf4\x6c\x48\x1c\x7a\x83\xd6\x09\x3b\xd5\x1e\x57\xbd\x71\xea\x68\x4a\x9a\x90\xdb\x47\x05\x4d\xc1\x9d\xe4\x0b\x36\x83\x20\xf5\x1a\xef\x27\x79\x8a\x8a\x71\x97\xdc\xba\x87\xc4\x0f\x83\x1d\x45\x87\xe6\xbd\x26\x4f\xb7\x60\xd7\x5b\xb0\x86\x4a\x0f\x09\xd3\x86\x98\xdd\x9c\x0e\x53\xd5\x1e\x03\x92\x66\x2a\xda\x66\xb4\x11\x6d\x28\xbb\xe2\x80\xd7\xb6\xa4\xd0\x2b\x9f\x6a\x14\x87\xbd\xf8\x77\x84\xfb\xac\x2f\xcc\xd8\x42\x54\xda\x89\x3b\xb3\x61\xea\x8c\x54\x03\xe3\xed\x83\xf5\xc8\xed\xaa\x36\x6a\xc2\x06\x71\x78\xdc\xd5\x49\xef\x66\x47\x7a\x9d\x77\xb0\x23\xe7\x90\x27\xa1\x3d\x8e\x77\x68\x1a\xda\xb7\xf8\xd9\x17\x5b\xfc\xbc\x63\xf1\x6b\xcb\x47\x8f\xc7\x8e\x8a\x55\xaf\x20\x17\x85\xde\xca\xf2\x36\x40\x4c\xbc\x4f\x4d\xad\xed\xad\xff\xf7\x91\x23\xeb\x9d\xb0\x15\xb6\xbe\x43\xcd\xd9\xaa\xfd\x6f\x93\x30\x75\x98\xb8\xdf\x4d\xc1\xb9\xcf\xe3\x69\x25\x9a\xa5\xbb\x4a\x6e\x5c\x75\xa8\x95\xc2\xd2\x79\xa7\x28\x08\x48\xf3\x84\xc1\xb9\x11\x06\x65\xce\x11\xe2\x20\x94\x4b\x67\x82\x04\xfa\x80\x13\xc7\xe3\x9c\x96\x22\x5d\x11\x91\x3c\xd9\x08\x51\xf0\x74\x4e\x44\xf2\x34\x2b\x65\x00\xcc\xf4\x0a\x3e\x8a\x3c\xcf\xd6\x15\x4d\x9f\x12\x91\x3c\xd3\x07\x9f\xf4\x39\x11\x89\xbc\x0f\x9c\xbe\x21\x22\x79\xa3\xfc\xc4\x52\x88\x53\x98\xbc\x33\x1a\xdd\xb4\x84\xcf\x4b\x29\xeb\xc2\x0f\x3d\x56\xf9\x53\x3a\xd9\xa7\x3f\x10\x91\xbc\x17\x2c\x4f\x33\xa2\xdd\x7e\x95\xc1\xec\x4d\x59\xac\x69\x29\xee\x20\x9e\xf5\x87\x0f\xb4\x7a\x59\x40\xe0\x14\x44\xee\x6f\x20\x4e\xf2\xb0\x3f\xd8\x01\xc3\x19\xfd\xc7\xd9\xd9\x1f\x7a\x55\xb1\x29\x67\xf4\x65\xb6\x5e\x33\xbe\x7c\xff\xf6\x45\x7a\x65\x22\x6b\x26\xd7\x8c\x27\xbf\x54\xc9\x75\xb6\xfe\xff\x07\x00\x88\xc0\xf8\x76\x8c\xea\x00\x00")
 
 func libBootstrap452MinJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -164,7 +164,7 @@ func libBootstrap452MinJs() (*asset, error) {
 	return a, nil
 }
 
-var _libBootstrapSelect11314MinCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x5a\x5b\x8f\xdb\xb8\xf5\x7f\xff\x03\xff\xef\xa0\x45\x10\x24\x93\x35\x35\xb2\x67\x9c\x64\x64\x34\xd8\x36\xd8\x87\x05\xd2\xf6\x61\xfb\xd6\xed\x03\x25\x1d\xd9\xac\x29\x92\x20\xe9\xb1\x27\xc6\x7c\xf7\x82\x14\x25\x51\x17\x5f\x64\x35\xc5\xc0\x71\x44\xf1\xdc\x0f\xcf\xf9\x91\xf4\xfd\x87\x9f\xfe\xff\xff\x82\x0f\xc1\x5f\x38\xd7\x4a\x4b\x2c\x90\x02\x0a\xa9\x0e\x9e\xe7\xe1\xfc\x21\x9c\x3f\x06\xef\x37\x5a\x0b\x15\xdf\xdf\x67\xf0\x0c\x94\x0b\x90\xa1\x62\x58\x60\x21\x38\x61\xba\x00\xa6\x55\x98\xf2\xe2\x3e\xe9\x70\xb8\x33\x7c\x2d\xef\xaf\x5c\xbc\x48\xb2\xde\xe8\x60\x11\xcd\x17\x68\x11\x2d\xa2\xe0\x77\x86\xc5\x9f\x3d\x16\xb3\xe0\xdb\xb7\xaf\x76\xf6\x37\x92\x02\x53\x90\x05\x3b\x96\x81\x0c\xfe\xfa\xdb\x3f\x1a\x15\xd6\x44\x6f\x76\x89\x15\xd7\xd5\xa1\x27\xff\x3e\xa1\x3c\xb9\x2f\xb0\xd2\x20\xef\xbf\xfd\xf6\xf5\xd7\xbf\xfd\xfe\xab\xd5\xe9\xfe\x17\xb4\x87\x64\x4b\x34\xda\xc2\x4b\x2e\x71\x01\x2a\x48\x14\x62\x5c\x93\xfc\x05\xe5\x38\x83\xbf\xef\xf4\x31\x7a\x7b\xe4\x02\xa7\x44\xbf\xc4\xe1\xd3\xeb\x3c\xf2\x9e\xa3\xd7\xd7\x5f\x10\x9f\x42\x7d\x3b\x69\xd8\x35\xf3\x4b\xf9\x15\x26\xca\x0d\xa0\x0d\xc9\x32\x60\xb3\x0b\xe3\xe5\x97\x20\xe9\x16\xe4\x31\x23\x4a\x50\xfc\x12\x33\xce\xe0\x27\x52\x08\x2e\x35\x66\xba\x2f\xec\xb8\x27\x99\xde\xc4\x8b\x45\x24\x0e\x7f\x44\xab\x67\x90\x9a\xa4\x98\x22\x4c\xc9\x9a\xc5\x05\xc9\x32\x0a\x03\x2a\x86\x99\xe4\x22\xe3\x7b\x86\x34\x5f\xaf\x29\x1c\x05\x57\x44\x13\xce\x62\x09\x14\x6b\xf2\x0c\xab\x92\xb1\xb1\x76\xa5\xe1\xa0\x1d\x47\x9b\x35\xab\xfd\x86\x68\x40\x4a\xe0\x14\x62\xc6\xf7\x12\x8b\x55\xa5\x70\x15\x49\xc2\x28\x61\x80\x12\x7e\x38\xf5\x2a\xa7\xe0\xbd\x2b\x94\x3f\xee\x93\xf9\xd3\x2b\x16\x09\x3f\x38\x85\x52\x60\x1a\x64\xfd\xc2\x0e\x22\xa2\xa1\x50\xf5\xab\x42\x59\xe2\x36\xc1\xd0\x44\x8f\xb9\xc0\xe9\x36\xfe\xf7\x4e\x99\x44\xa8\x5f\xb8\x67\x94\x72\xa6\x81\xe9\xd8\x3a\x00\x25\xa0\xf7\x00\xac\x91\xd3\xa2\x3d\x4b\x73\x45\x64\x62\x9c\x6b\x90\xc7\x02\xcb\x35\x31\x63\x22\x46\x73\x71\xb8\x82\xd0\xe4\x99\xa0\x38\x85\x0d\xa7\x19\xc8\xd9\x68\x8a\x18\xa7\x26\x11\x6e\x20\xcc\x79\xba\x53\x37\xd0\x6d\xf8\x33\xc8\x63\xca\x29\x97\xf1\x9b\xa7\xa7\xa7\xf1\x56\x86\x89\x66\x28\xc3\x6c\x7d\x8b\xc1\x1e\xf1\xcd\xb6\xfb\x3c\x6e\x74\x83\xcf\xc2\x7a\xe4\x66\x16\x72\x3b\x81\x74\xaa\x0b\xe4\x76\xa2\x03\xe4\x76\x92\xf9\x84\xe5\x7c\x02\xe9\x34\xf3\x2d\x87\x29\xe6\x5b\x06\x53\xcc\x17\x92\x14\x58\xbe\x4c\xa3\x9e\xe6\x84\x8a\xc9\x14\x3f\x54\x3c\xa6\xb8\x42\x41\xca\x59\x76\xbb\x33\x6a\xfa\x69\xee\x68\xd8\x4c\x71\x48\xc3\x65\x92\x4b\x76\x69\x0a\xea\x66\x1d\x4a\xea\x89\xee\x70\x4c\x26\x39\xc3\xf1\xf0\x1b\x87\x5c\x27\xf8\xfd\x62\xb9\x9c\x55\x9f\x70\x79\x77\x12\xa4\x35\xb8\x07\x27\x8a\xd3\x9d\xf6\xc0\xd6\x2a\xe1\x5a\xf3\x22\x8e\x56\x14\x72\x1d\x2f\xa3\xb7\x35\x24\x49\x28\x4f\xb7\xde\xcc\x12\x2b\x85\x4b\x71\xf0\x06\x37\x60\xb0\x92\x45\x50\xde\xa8\xc0\x59\x46\xd8\x3a\x8e\xbc\xb1\x1a\x4d\xb6\x84\x4b\xd3\x12\x0d\xfe\x5b\x7d\x47\x84\x65\x70\xf0\xdf\x9f\x86\x9d\x05\x4f\x08\x05\x94\xc1\x33\x49\xe1\x68\x00\x83\x33\x20\xba\xa0\x7e\x47\xd1\x4a\xe8\xe2\x9c\xd0\x90\x18\xd8\xf6\x8c\x29\xc9\x82\x6e\xc0\x66\x21\x48\xc9\x65\xd0\x23\x1a\x98\xb9\xc1\x0a\x5d\x3f\x7b\x8f\x15\xb2\x32\xb1\x86\x6c\x80\xa2\xfc\x8a\x9d\x62\x3f\xf7\xc0\x6e\xe9\x5b\xe4\x80\x46\xf2\xf4\x88\x1f\x3f\x0f\xdb\x76\xca\xb2\x2b\x35\xb8\x4a\xfe\xe2\x33\xfe\xf4\xb8\x1c\x90\x9f\x13\x8d\x6c\x68\x1c\xc8\xc7\x3b\xcd\xcf\x05\x23\x66\x5c\xbf\xff\x67\x4a\xb1\x52\x1f\xfe\x94\x72\x8a\xfe\x75\xd7\x1a\xca\xb9\x2c\x2c\x0e\x95\x9c\xba\x57\x21\x61\x62\xa7\xd1\x5a\xf2\x9d\x40\x89\x66\x77\xfe\x7e\xa2\x2f\xa1\xe7\x89\x93\xab\x77\x28\x19\xcb\xc9\x7d\x77\xf0\x9d\x36\x08\x3f\xd6\x1b\xc2\x82\x8c\x6b\xe3\xd2\x37\x0f\x0f\x0f\xfe\x0a\x71\x53\x96\xe2\x10\x18\x37\x04\x15\x24\xb7\x2c\x91\x24\x6c\x5d\xba\xb3\x4f\x83\x78\x9e\x2b\xd0\x31\x5a\x0c\x59\x14\xfa\x4e\xa9\x50\x76\xbd\xf0\xeb\xc5\xda\x5a\x8e\x6e\x61\x1b\x3d\x5e\x7b\x5e\xbc\xfb\x72\x5e\xc6\x40\x90\x8e\xcd\xfa\xbb\xa0\x60\x37\x5c\xc7\x9c\x72\xac\xdb\x45\xc2\xaa\x55\x52\x95\x3b\xa7\x7e\x7a\xce\xce\xbf\xbe\x52\xdf\x52\xd0\x60\x12\xf6\xd2\xaa\x9f\x21\x2d\x96\xbe\x1d\x9d\x6d\x9f\xad\x55\x2b\x17\x98\xb2\x8e\x0d\x38\xa9\x4e\xa9\x02\xd8\x0e\xd9\x4d\xea\x05\x91\xc3\x24\x92\xef\xfb\xfe\xb8\x48\xe7\xd4\xb7\xff\x77\xae\xb7\xa6\x9f\xf4\xfc\x86\x4b\xf2\x9d\x33\x8d\xe9\xe8\xe0\x74\x73\xd4\x97\x87\xe8\xfa\x42\x34\x67\xfe\x6c\x55\x5c\x98\x7d\xac\xf3\x7f\x9c\x94\x81\x82\x39\x46\x6c\x8f\xfc\xe8\xb5\xd2\x55\xce\x99\x46\x8a\x7c\x87\x98\xb0\x0d\x48\xa2\x57\x36\x4f\xdc\x94\x6a\xcc\x95\x58\x89\x33\xb2\x53\xd5\xe8\x85\xd5\x65\x0d\xeb\x69\x7e\x9e\xc4\x18\xd3\xd5\xf6\x07\x6a\x38\x24\xae\x0a\x52\xb8\x58\x4a\x28\x82\xd0\xfc\x7b\x83\xa5\x0d\x1f\xcb\x66\x6e\xb9\x9c\x4d\xc5\xa0\x9d\x2c\x67\xab\x58\x46\x14\x4e\x28\x64\x83\x40\xcf\xbd\x3b\xa6\x3b\xa9\xb8\x29\xb2\x1a\x61\x4a\xf9\x1e\xb2\x33\x9c\xce\x00\xc7\xd6\x8c\xba\xbd\x9c\x45\x4f\x06\x5d\x1a\x43\x30\x61\x20\xfb\xa8\x70\xd5\xc2\x51\x2e\x92\xd1\x79\x58\x77\x41\x48\xd0\xae\x24\xc7\xaa\x7e\xcf\xa3\x8f\x03\x15\xae\x17\xae\x20\xcc\x09\xd5\x20\x11\x17\x46\xd3\x46\x65\xa5\xb1\x26\x69\x5b\xe1\xb2\x3e\x99\x07\x1f\x95\x9e\x38\xe2\xb3\xd3\x0c\xa0\xce\x29\xdf\xc7\xe5\x19\x65\xeb\x6c\x2c\xa7\x06\x8b\xd6\x43\xe5\x63\x30\xb7\x8d\xb9\x3e\x05\x6b\x46\x5a\x4f\xaf\x61\xa2\x1e\x6e\xb0\xae\x74\x6f\x59\x6e\x9b\xc5\xe2\xb5\x99\xc0\x30\x46\x06\x47\xe2\x2c\xe3\x6c\x82\x03\xeb\x98\x57\x42\x8d\x2b\xab\x55\x5b\x8d\xb9\xf2\xdb\x1d\xb6\xfe\xae\x06\x9b\xa6\xf6\x5f\xd5\xb4\xf3\x88\x08\xb3\x29\x3b\xec\xa1\xb1\xcc\x4b\x6e\x8e\x67\x27\x09\xc6\x70\x83\x83\xc0\x2c\x73\x35\xc1\x5f\x28\x5e\x2a\x0e\x6d\x7b\x6e\x11\x99\x62\x09\x03\x3b\x39\xbb\x04\xcc\xae\xad\x74\xc9\x7c\x21\x0e\x2b\xff\x18\xd5\x3c\x9f\x38\x2f\x6f\x47\x6b\x5c\xaf\xba\xb2\xae\xb7\x40\x45\x9f\xcb\xb9\x62\xda\xad\x1c\x05\x61\xc8\x5b\xca\xfe\x52\x55\xe4\xbb\xa9\x4a\x4e\xa7\x84\x1f\x56\xa8\xe0\xdf\x4f\xbc\x1a\x1c\xbd\x28\xfe\x4b\x68\x93\x65\x4c\xb1\xed\x70\x08\x5d\x06\x77\x2a\x98\x87\x09\x1d\xfa\xf6\x01\x79\x19\xc9\x1a\x99\x57\xfe\x8e\xda\xe6\x6f\x70\xc6\xf7\x15\x8f\xd6\xf3\x45\xad\x02\x4a\xfa\xb7\x22\xd7\x50\x85\xe5\xb1\x48\xa0\x0a\x4c\xe9\x99\x43\x89\x11\x1e\x32\x5c\xab\xae\x16\xe0\xab\xfa\x64\x9f\x43\x43\x68\xef\xe8\xbc\x0b\x8f\x9d\x02\xd9\xc0\x77\x06\x65\x92\x0c\x8c\xaa\xfe\x60\x77\xe0\x3a\x4d\x42\x2e\xf4\xc0\x9d\x53\xab\x8c\x2e\xc2\xc5\x72\x08\xc9\x0c\xf1\x0b\x94\xc0\x2c\x4c\x37\x90\x6e\x51\x81\xe5\xb6\x75\x7f\x36\x86\x85\xe9\x83\xc7\xa1\x2d\xc8\x55\x4c\xca\x90\xb7\xac\x08\xaf\xb2\x21\x2c\xaf\x1c\x07\x8a\x98\x6b\x34\x4b\x71\x70\xed\xfa\xe9\xe3\xdb\x3a\xf9\x83\xc5\xdb\x95\x59\xfd\xae\xa9\x2f\x3e\x8a\x43\xbd\x44\x1e\xc4\x21\x30\x54\x09\x4e\xb7\xa6\x92\xb1\x2c\x7e\x93\x2f\xcd\x5f\xb5\x9c\xe6\xe2\x10\x28\x4e\x49\x16\xbc\x81\x07\xf3\x37\xb4\x76\x08\x53\xa0\x83\x28\x30\x93\xcd\xc7\x66\x72\x34\x33\x7f\x61\xb4\xbc\x5b\x8d\x98\xea\xd2\x0e\xc1\x33\x30\xad\xca\xf4\x69\x2e\x56\xff\xd7\x75\xab\xf2\x79\x58\x5d\xf3\xd6\x57\x88\x8c\x14\xd8\x06\x21\x7c\x50\x81\xc9\x00\x2c\x83\x4f\xcb\xa8\x50\x41\xce\xe5\x1e\xcb\x6c\xe0\x92\x78\x85\xf8\x8d\x84\x37\x51\x0d\x59\xc7\x38\x92\xa0\x76\x54\xab\xa3\x97\x03\x43\xf1\xaf\xd3\xc7\x66\x55\xef\x2e\xf7\xdc\x51\xd4\x68\x1c\xda\x5e\x4b\x2b\x6f\x47\x79\xbb\x90\x12\x9e\x0c\x6d\xcd\xc6\x71\x70\x30\xa7\xad\xe2\x48\xc5\x0c\xa4\x37\xf8\x23\x4e\x20\xe7\x12\x8e\xd5\x3d\xef\xbb\x3f\xa2\x08\x47\xef\x46\x72\xeb\x40\x19\x0f\xcb\x5b\x28\x7d\xf1\x16\x38\x54\x1b\xbe\x47\x9a\xa4\xdb\x5e\xb6\x97\x13\x20\xeb\x95\xca\x7e\xcd\x19\x3c\x82\x71\x28\xca\xa4\x8c\x05\x56\xe3\xc4\x77\x2a\xac\x33\xa4\xe4\xf9\xf0\x38\x7c\xd6\x98\x28\xc4\xb7\x28\x83\x1c\xef\xa8\x76\xf7\xe0\xb5\x7b\xdf\xb5\x4f\xb3\xeb\x23\x78\x28\xea\x2d\x0e\x14\x15\x38\x50\xfa\x85\x42\x6c\x6b\x5d\x35\xe4\x90\x69\x10\x2e\x3e\x9a\x4d\xb3\xfd\xb7\x01\x0f\x5a\x62\xa6\x0c\xce\x8b\x25\xd7\x58\xc3\xfb\xc7\x65\x06\xeb\x3b\xdb\x02\x4f\xbe\xe3\x27\x5f\x9d\x18\x3f\xe5\x40\x7b\xb2\x84\xa5\xe4\xfb\x90\x0b\x60\xbd\xab\x90\x81\xcc\xef\xd2\x99\xe7\xfe\xaf\x3c\xbc\x3d\xe6\xfc\xb2\xf0\x4b\x8b\xa8\x97\xf2\xef\x2a\xe7\xda\xce\xf7\xa9\x6e\x2f\xd6\x7c\x81\x25\xb0\xe6\xd8\xc3\x86\xe8\xec\x14\xd7\xf9\x9a\x39\x25\x88\x8a\x1e\x67\xd5\x27\x5c\x98\xa6\x72\xa2\x63\xa2\x47\x71\x28\xf7\xbf\x4f\xa2\xf9\x31\xc9\x30\x1e\x18\x6d\x79\x2f\x19\x7d\xc3\x3f\x5e\x36\xfc\xec\x14\x67\x40\x33\xe7\x4d\x9e\xe7\xd7\x18\x3a\x8f\x46\x5b\x6a\x0d\x35\xbb\x9c\xeb\x42\xed\x44\xda\x62\x64\xab\x90\x91\xed\xd4\x36\xcf\x17\x82\xd5\x36\x70\xa8\x07\xdc\xa8\x5e\x19\x8f\x4b\xda\x75\x3c\x3a\x5e\x1b\xb1\xa3\xb4\x8c\xe0\xb5\x0e\xf3\xf6\x9e\x36\x44\xc3\x87\xe6\x53\x04\x95\xa6\x3b\x39\x0f\x23\xe5\x0c\x96\x97\x41\x09\x57\xd4\x9c\xab\x98\x95\x7e\xb9\xb5\x82\xdd\xaa\xda\x55\xcc\x5c\xc8\x5a\x7d\xe5\xd5\x74\x21\xb3\xa1\xe3\x4c\x25\xfc\x30\x33\x8f\x19\x67\x90\xec\xb4\xe6\x6c\x56\xfe\x98\x0f\xcb\x74\x93\xf0\x43\x0d\xbb\x1e\xc5\x21\xf8\x6c\x7b\x9a\x4f\x7b\xfc\x91\x7b\x74\x5f\x50\x60\xef\xc6\xcb\xd3\x8b\x52\x4f\x27\x7a\x69\x4f\x13\x7c\x03\x8e\xde\x71\xcc\x8f\x55\xaf\x91\x79\x52\x3d\x77\xda\xe1\x79\xf4\xe7\x8e\x07\x6b\xf4\x68\xdc\x1b\x3c\x3a\x17\xd7\xd3\x83\xf3\xb7\x79\x9e\x81\xde\xd1\xdc\x7f\x02\x00\x00\xff\xff\x63\xbb\xf3\x77\x70\x2b\x00\x00")
+var _libBootstrapSelect11314MinCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x5a\x5b\x8f\xdb\xb8\x15\x7e\x2f\xd0\xff\xc0\x45\x10\x24\x93\x35\x35\xb2\x3d\x9e\x64\x64\x34\xd8\x36\xd8\x87\x05\xd2\xf6\x61\xfb\xd6\xed\x03\x25\x1d\xd9\xac\x25\x92\x20\xe9\xb1\x26\xc6\xfc\xf7\x05\x29\x4a\xa6\x2e\xbe\x2a\x81\xe1\x71\x44\xf1\xdc\x0f\xcf\xf9\x48\xe6\xfe\xc3\x4f\x7f\xfd\x0b\xfa\x80\xfe\xc1\xb9\x56\x5a\x12\x81\x15\xe4\x90\x68\xf4\x3c\x0d\xa6\xf3\x60\xfa\x80\xde\xaf\xb5\x16\x2a\xba\xbf\x4f\xe1\x19\x72\x2e\x40\x06\x8a\x11\x41\x84\xe0\x94\xe9\x02\x98\x56\x41\xc2\x8b\xfb\xb8\xc3\xe1\xce\xf0\x35\x5f\xf4\x85\x8b\x17\x49\x57\x6b\x8d\x66\xe1\x74\x86\x67\xe1\x2c\x44\xbf\x33\x22\xfe\xee\xb1\x98\xa0\xaf\x5f\xbf\xd8\xd9\x5f\x69\x02\x4c\x41\x8a\xb6\x2c\x05\x89\xfe\xf9\xdb\x7f\x0e\x2a\xac\xa8\x5e\x6f\x63\x2b\xae\xab\x43\x4f\xfe\x7d\x9c\xf3\xf8\xbe\x20\x4a\x83\xbc\xff\xfa\xdb\x97\x5f\xff\xf5\xfb\xaf\x56\xa7\xfb\x5f\xf0\x0e\xe2\x0d\xd5\x78\x03\x2f\x99\x24\x05\x28\x14\x2b\xcc\xb8\xa6\xd9\x0b\xce\x48\x0a\xff\xde\xea\x7d\xf8\x76\xcf\x05\x49\xa8\x7e\x89\x82\xa7\xd7\x69\xe8\x3d\x87\xaf\xaf\xbf\x60\x3e\x86\xfa\x76\xd2\xa0\x6b\xe6\xe7\xea\x27\x88\x95\x1b\xc0\x6b\x9a\xa6\xc0\x26\x67\xc6\xab\x1f\x41\x93\x0d\xc8\x7d\x4a\x95\xc8\xc9\x4b\xc4\x38\x83\x9f\x68\x21\xb8\xd4\x84\xe9\xbe\xb0\xfd\x8e\xa6\x7a\x1d\xcd\x66\xa1\x28\xff\x08\x97\xcf\x20\x35\x4d\x48\x8e\x49\x4e\x57\x2c\x2a\x68\x9a\xe6\x30\xa0\x62\x90\x4a\x2e\x52\xbe\x63\x58\xf3\xd5\x2a\x87\xbd\xe0\x8a\x6a\xca\x59\x24\x21\x27\x9a\x3e\xc3\xb2\x62\x6c\xac\x5d\x6a\x28\xb5\xe3\x68\xb3\x66\xb9\x5b\x53\x0d\x58\x09\x92\x40\xc4\xf8\x4e\x12\xb1\xac\x15\xae\x23\x49\x59\x4e\x19\xe0\x98\x97\xc7\x5e\x65\x39\x78\xef\x0a\xe5\x8f\xfb\x64\xfe\xf4\x9a\x45\xcc\x4b\xa7\x50\x02\x4c\x83\x6c\x5e\xd8\x41\x4c\x35\x14\xaa\x79\x55\x28\x4b\xdc\x26\x18\x9a\xe8\x31\x17\x24\xd9\x44\xff\xdf\x2a\x93\x43\xcd\x0b\xf7\x8c\x13\xce\x34\x30\x1d\x59\x07\xe0\x18\xf4\x0e\x80\x1d\xe4\xb4\x68\x4f\xd2\x5c\x10\x99\x88\x64\x1a\xe4\xbe\x20\x72\x45\x4d\xb4\x44\x84\xa7\xa2\xbc\x80\xd0\xe4\x99\xc8\x49\x02\x6b\x9e\xa7\x20\x27\x57\x53\x44\x24\x31\x89\x70\x03\x61\xc6\x93\xad\xba\x81\x6e\xcd\x9f\x41\xee\x13\x9e\x73\x19\xbd\x79\x7a\x7a\xba\xde\xca\x20\xd6\x0c\xa7\x84\xad\x6e\x31\xd8\x23\xbe\xd9\x76\x9f\xc7\x8d\x6e\xf0\x59\x58\x8f\xdc\xcc\x42\x6e\x46\x90\x8e\x75\x81\xdc\x8c\x74\x80\xdc\x8c\x32\x9f\xb2\x8c\x8f\x20\x1d\x67\xbe\x11\x3e\xca\x7c\xcb\x60\x8c\xf9\x42\xd2\x82\xc8\x97\x71\xd4\xe3\x9c\x50\x33\x19\xe3\x87\x9a\xc7\x18\x57\x28\x48\x38\x4b\x6f\x77\x46\x43\x3f\xce\x1d\x07\x36\x63\x1c\x72\xe0\x32\xca\x25\xdb\x24\x01\x75\xb3\x0e\x15\xf5\x48\x77\x38\x26\xa3\x9c\xe1\x78\xf8\x8d\x43\xae\x62\xf2\x7e\xb6\x58\x4c\xea\x6f\xb0\xb8\x1b\x68\x24\x0e\x3e\x35\xb8\x87\xc4\x8a\xe7\x5b\xed\x81\xad\x65\xcc\xb5\xe6\x45\x14\x2e\x73\xc8\x74\xb4\x08\xdf\x36\x90\x24\xce\x79\xb2\xf1\x66\x56\x58\x29\x58\x88\xd2\x1b\x5c\x83\xc1\x4a\x16\x41\x79\xa3\x82\xa4\x29\x65\xab\x28\xf4\xc6\x1a\x34\xe9\x8d\xc5\x5c\x9a\x1e\x6c\xf0\xdf\xf2\x1b\xa6\x2c\x85\xd2\x7f\x7f\xd4\xa2\xa0\xe0\x31\xcd\x01\xa7\xf0\x4c\x13\xd8\x1b\xc0\xe0\x0c\x08\xcf\xa8\xdf\x51\xb4\x16\x3a\x3b\x25\x34\xa0\x06\xb6\x3d\x93\x9c\xa6\xa8\x1b\xb0\x49\x00\x52\x72\x89\x7a\x44\x03\x33\xd7\x44\xe1\xcb\x67\xef\x88\xc2\x56\x26\xd1\x90\x0e\x50\x54\x3f\x91\x53\xec\xe7\x2e\x83\x7d\xe5\x5b\xec\x80\x46\xfc\xf4\x40\x1e\x3e\x0d\xdb\x76\xcc\xb2\x0b\x35\xb8\x48\xfe\xec\x13\xf9\xf8\xb0\x18\x90\x9f\x51\x8d\x6d\x66\x39\x90\x4f\xb6\x9a\x9f\x0a\x46\xc4\xb8\x7e\xff\xdf\x24\x27\x4a\x7d\xf8\x5b\xc2\x73\xfc\xbf\xbb\xd6\x50\xc6\x65\x61\xb1\xab\xe4\xb9\x7b\x15\x50\x26\xb6\x1a\xaf\x24\xdf\x0a\x1c\x6b\x76\xe7\xef\x27\x5e\xcf\xc7\xe2\x68\x29\x1b\x4a\xc6\x6a\x72\xdf\x1d\x7c\xab\x0d\xc2\x8f\xf4\x9a\x32\x94\x72\x6d\x5c\xfa\x66\x3e\x9f\x1f\x6c\x5d\xd6\x53\x16\xa2\x44\xc6\x0d\xa8\x86\xe4\x96\x25\x96\x94\xad\xaa\x70\xf6\x69\x30\xcf\x32\x05\x3a\xc2\xb3\x21\x8b\x02\xdf\x29\x35\xca\x6e\x16\x7e\xb3\x58\x5b\xcb\xd1\x2d\x6c\xa3\xc7\x6b\xcf\x8b\x77\x9f\x4f\xcb\x18\x08\x92\xf3\xb9\x59\x7f\x67\x14\xec\x86\x6b\x9f\xe5\x9c\xe8\x76\x91\xb0\x6a\x55\x54\xd5\xce\xa9\x9f\x9e\x93\xd3\xaf\x2f\xd4\xb7\x12\xd4\x25\x1e\x4e\xab\x7e\x86\xb4\x58\xfa\x76\x74\xb6\x7d\xb6\xd4\x2e\x5d\x60\xaa\x3a\x36\xe0\xa4\x26\xa5\x0a\x60\x5b\x6c\x37\xa9\x67\x44\x0e\x93\x48\xbe\x43\xd7\xd3\x39\xf5\xed\xbf\x5f\x2b\xef\x59\xd3\x8f\x7a\x7e\xcd\x25\xfd\xc6\x99\x26\xf9\xd5\xc1\xe9\xe6\xa8\x2f\x0f\xe7\xab\x33\xd1\x74\xcc\xad\x76\x58\x15\x67\x66\xef\x9b\xfc\xbf\x4e\xca\x40\xc1\xbc\x46\x6c\x8f\x7c\xef\xb5\xd2\x65\xc6\x99\xc6\x8a\x7e\x83\x88\xb2\x35\x48\xaa\x97\x36\x4f\xdc\x94\x7a\xcc\x95\x58\x49\x52\xba\x55\xf5\xcc\xd7\xd3\x72\xad\x61\x3d\xcd\x4f\x93\xa8\xa2\x47\xb2\xff\x81\x1a\x0e\x89\xab\x83\x14\xcc\x16\x12\x0a\x14\x98\xbf\x37\x58\x7a\xe0\x63\x18\xa0\xa9\xe5\x72\x32\x15\x51\x8b\xe7\xe9\x2a\x96\x52\x45\xe2\x1c\xd2\xbe\x3f\x3f\x37\xef\xf6\xc9\x56\x2a\x6e\x30\x8f\x39\x6d\xca\xf9\x0e\xd2\x13\x9c\x4e\xa0\xe8\xd6\x8c\xa6\xbd\x9c\x44\x4f\x06\x5d\x1a\x43\x08\x65\x20\xfb\xa8\x70\xd9\xc2\x51\x2e\x92\x1e\xc3\x65\xed\xbc\xcb\x85\x78\x01\x30\x15\x68\x5f\xd7\xef\x69\xf8\x38\x50\xe1\x7a\xe1\x42\x41\x46\x73\x0d\x12\x73\x61\xf0\xeb\x41\x65\xa5\x89\xa6\x49\x5b\xe1\xaa\x3e\x19\xed\xeb\xe6\x65\x9a\xcd\x91\x23\x3e\x3b\xcd\x00\xea\x2c\xe7\xbb\xa8\x3a\xbb\x6c\x9d\x8d\x99\xc3\xb4\x28\x6c\x86\xaa\x47\x34\xb5\x8d\xb9\x39\x05\x3b\x8c\xb4\x9e\x5e\x83\x58\xcd\x6f\xb0\xae\x72\x2f\x96\xfe\x12\x7a\xf5\xdb\x0c\x32\x8c\xb1\xc1\x91\x24\x4d\x39\x1b\xe1\xc0\x26\xe6\x2e\xa6\xf6\xd0\xcd\x89\xac\xe3\x5c\x97\xdf\xee\xb0\xf1\x5d\x33\x78\x68\x6a\xdf\x55\xd3\xce\x23\xa6\xcc\xa6\xec\xb0\x87\xae\x65\x5e\x71\x73\x3c\x3b\x49\x70\x0d\x37\x28\x05\x61\xa9\xab\x09\xfe\x42\xf1\x52\x71\x68\xdb\x73\x8b\xc8\x84\x48\x18\xd8\xc9\xd9\x25\x60\x76\x6d\x55\xd2\x4c\x67\xa2\xac\x71\x84\x79\x63\xf0\xe0\xb1\xf3\xf2\x76\xb4\x4e\x55\x52\x74\x0c\xdc\x9f\xab\xeb\x2d\x50\xd1\xe7\x72\xaa\x98\x7a\xb3\x6d\xe5\x28\x28\xc3\xde\x52\xf6\x97\xaa\xa2\xdf\x4c\x55\x72\x3a\x99\x53\x75\x5c\xf0\x6f\x47\x5e\x0d\x8e\x9e\x15\xff\x39\xb0\xc9\x72\x4d\xb1\xed\x70\x08\x5c\x06\x77\x2a\x98\x87\x09\x1d\xfa\xf6\x01\x79\x15\xc9\x28\xec\xf4\xd1\x43\x59\xb2\xd6\xac\x49\xca\x77\x35\x8f\xd6\xf3\x59\xad\x50\x4e\x0f\x2a\xd5\xb7\x22\x97\x50\x05\xd5\xb1\x08\x52\x05\xc9\xf3\x13\x87\x12\x57\x78\x08\xe5\xb4\xe9\x6a\x88\x5c\xd4\x27\xfb\x1c\x0e\x84\xf6\x8e\xce\xbb\xf0\xd8\x2a\x90\x8e\xcc\xfa\xa6\x4a\x92\x81\x51\xd5\x1f\xec\x0e\x5c\xa6\x49\xc0\x85\xee\x7b\xb7\x5d\x46\x67\xc1\x6c\x01\xc5\x65\xfc\x90\x12\x84\x05\xc9\x1a\x92\x0d\x2e\x88\xdc\xb4\xee\xcf\xae\x61\x61\xfa\xe0\x7e\x68\x0b\x72\x11\x93\x2a\xe4\x2d\x2b\x82\x8b\x6c\x08\xaa\xdb\xca\x81\x22\xe6\x1a\xcd\x42\x94\xae\x5d\x3f\x3d\xbe\x6d\x92\x1f\xcd\xde\x2e\xcd\xea\x77\x4d\x7d\xf6\x28\xca\x66\x89\xcc\x45\x89\x0c\x55\x4c\x92\x8d\xa9\x64\x2c\x8d\xde\x64\x0b\xf3\x71\x4b\x26\x9a\x8a\x12\x29\x6e\x0e\x38\xde\xc0\xdc\x7c\x86\xd6\x0e\x65\x0a\x34\x0a\x91\x99\x6c\xbe\xf6\x78\x2d\x9c\x98\x4f\x10\x2e\xee\x96\x57\x4c\x75\x69\x87\xe1\xd9\x5c\x0f\xdb\xd0\x34\x3d\x20\x78\x6a\x0b\xff\xf1\x75\xab\xf6\x79\x50\x5f\xf3\xd6\xf2\x09\xa3\x05\xb1\x79\x19\xcc\x15\x32\xd5\x8c\x48\xf4\x71\x11\x16\x0a\x65\x5c\xee\x88\x4c\x07\x2e\x89\x97\x98\xdf\x48\x78\x13\xd5\x90\x75\x8c\x63\x09\x6a\x9b\x6b\x55\x67\x60\x34\x1f\x8e\x7f\x93\x3e\x26\x3f\xfa\x77\xb9\x7d\xe6\x87\xa3\xa8\x81\xf6\xdb\xc2\x0f\xbd\x2a\xde\x5e\x4b\x4d\x76\x86\x63\x84\x54\xf0\x64\x32\x9a\x83\x83\x39\x6d\x15\xaf\x54\xcc\x40\x7a\x83\x3f\xa2\x18\x32\x2e\x61\x5f\xdf\xf3\xbe\xfb\x23\x0c\x49\xf8\xee\x4a\x6e\x1d\x28\xe3\x7c\x68\xe0\x8a\x05\xd7\x67\x6f\x81\x03\xb5\xe6\x3b\xac\x69\xb2\xf1\x98\x57\xd9\x5e\x49\x87\xb4\x57\x2a\xfb\x35\xa7\xed\x90\xaa\xfe\xd5\x28\xca\xa4\x8c\xd1\x67\x71\x9d\xf8\x4e\x85\x75\x86\x54\x3c\xe7\x0f\x43\xbc\x0c\xa0\xc7\x7c\x83\x53\xc8\xc8\x36\xd7\xee\x1e\xbc\x71\xef\xbb\x46\xcb\x4a\xbd\xfa\x08\x1e\x8a\x66\x8b\x03\x45\x0d\x0e\x94\x7e\xc9\x21\xb2\xb5\xae\x1e\x72\xc8\x14\x05\xb3\x47\xb3\x69\xb6\x7f\x0f\xe0\x41\x4b\xc2\x94\xc1\x79\x91\xe4\x9a\x68\x78\xff\xb0\x48\x61\x75\x67\x5b\xe0\xd1\x77\xfc\x28\xd9\x91\xf1\x63\x0e\x34\x01\xc3\x44\x4a\xbe\x0b\xb8\x00\xd6\xbb\x0a\x99\x9c\xa7\x33\x79\xd0\xa3\xf3\xf7\x98\xd3\xf3\xc2\x07\xf2\xb3\xb5\x88\x7a\x29\xff\xae\x76\xae\xed\x7c\x1f\x9b\xf6\x62\xcd\x17\x44\x42\x73\xaf\xe1\xb6\x29\x27\xa7\xb8\xce\x77\x98\x63\xfb\xc9\x2c\x7c\x98\xd4\xdf\x60\x76\xb7\xec\x67\xaf\xa3\xc3\x0f\xa2\xac\xf6\xbf\x4f\xa2\x5c\x9e\xc6\x03\x57\x5b\xde\x4b\x46\xa7\xb3\x95\xf7\x78\xca\xaa\xca\xf0\xc7\x0b\x0c\x3f\xcc\x79\x93\x65\xd9\x25\x86\x4e\xc3\xab\x2d\xb5\x21\xde\x8a\xb3\x06\xbb\x50\x3b\x91\xb6\x18\x99\x2a\x60\x65\x3b\xb5\xcd\xf3\x99\x60\xb5\x0d\x0c\xbf\x9f\x7a\x55\x3c\xce\x69\xd7\xf1\xe8\xf5\xda\x88\x6d\x9e\x57\xa9\x7b\xa9\xc3\xbc\xbd\xa7\xcd\x8d\xe1\x43\xf3\x31\x82\x2a\xd3\x9d\x9c\xf9\x95\x72\x06\xcb\xcb\xa0\x84\xc9\x77\x62\x56\x25\xd2\xad\x15\xec\x56\xd5\x2e\x62\xe6\x42\x56\x2f\xa0\x1a\xf6\x2b\x6c\x36\x74\x9c\xa9\x98\x97\x13\xf3\x98\x72\x06\xf1\x56\x6b\xce\xec\xa3\x02\x22\x93\x75\xcc\xcb\x06\x76\x3d\x88\x12\x7d\x12\x65\x87\xd6\xdb\xd3\x7f\x7f\xac\xeb\x0b\x42\xf6\xbf\x70\x18\xcc\x2f\x50\xa5\xa7\x13\xbd\xb0\xa7\x09\xbe\x01\xee\xe6\xc2\xa4\xcc\xf2\xc7\xaa\x77\x90\x79\x54\x3d\x77\xda\xe1\x79\xf4\xe7\x8e\x07\x1b\xf4\x68\xdc\x8b\x1e\x44\xd9\x9e\xde\x39\x90\x76\x20\xa3\x5e\xdf\xbe\x81\x59\xce\x89\x8e\x18\x67\xf0\xfa\xe7\x00\x63\xbb\xf3\x77\x70\x2b\x00\x00")
 
 func libBootstrapSelect11314MinCssBytes() ([]byte, error) {
 	return bindataRead(
@@ -184,7 +184,7 @@ func libBootstrapSelect11314MinCss() (*asset, error) {
 	return a, nil
 }
 
-var _libBootstrapSelect11314MinJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\xbd\x7b\x73\xdb\x38\xb2\x38\xfa\xff\x7e\x0a\x09\x27\x45\x13\x23\x88\xa1\xec\x38\x71\x28\x23\x2a\x8f\x93\xd9\xf8\x4c\x26\x99\x13\x7b\x32\x77\x56\xa3\xcd\xa5\x48\x48\xc2\x98\x22\xb5\x24\xe4\x27\xf9\xdd\x6f\xe1\x45\x82\x14\x29\x27\x7b\xf6\xfe\x7e\xe5\x2a\x8b\x78\x37\x80\x46\xa3\xd1\xe8\x6e\x3c\xff\xa1\xff\xb7\xde\x0f\xbd\x1f\x93\x84\x65\x2c\xf5\x37\xc3\x8c\x44\x24\x60\xbd\x9b\x91\x33\x3a\x72\x46\x2f\x7a\xf6\x8a\xb1\x4d\xe6\x3d\x7f\x1e\x92\x1b\x12\x25\x1b\x92\x3a\x59\xec\x6f\xfc\xcd\x26\xa1\x31\x5b\x93\x98\x65\x4e\x90\xac\x9f\xcf\x1b\x35\xc0\xbf\xf5\x7e\xe0\x35\x9f\x27\x9b\xfb\x94\x2e\x57\xac\x77\xe8\x8e\x0e\x87\x87\xee\xa1\xdb\xbb\x8c\xfd\xcd\x99\x51\x01\xea\x7d\xf8\x70\xce\x33\x7f\xa0\x01\x89\x33\x12\xf6\xb6\x71\x48\xd2\xde\x2f\x17\x57\x55\xfb\x4b\xca\x56\xdb\xb9\x68\xab\x09\xc0\x4e\xe3\xcf\xe7\x51\x32\x7f\xbe\xf6\x33\x46\xd2\xe7\x1f\x2e\xce\xdf\x7d\xbc\x7c\xc7\x01\x7a\xfe\xb7\xbf\xf5\x17\xdb\x38\x60\x34\x89\x6d\x82\x18\x7c\xbc\x49\x68\xd8\x73\x31\xc6\xc4\xb2\xe4\x77\x1f\xe3\x5b\x1a\x87\xc9\xad\x65\xd9\x44\x7d\x42\x04\x74\x31\x80\x31\xbb\xdf\x90\x64\xd1\x0b\xc9\x82\xc6\xc4\xb2\xe4\xaf\xe3\xaf\xc3\x89\xfc\xb4\xa7\xe0\xaf\x7f\x6d\x49\x7a\x0f\x66\xa8\x6a\x0d\x3e\xa6\x84\x6d\xd3\xb8\xc7\x6c\x02\x0b\xe8\x81\x64\xfe\x17\x09\x58\x55\xdf\x3a\x09\xb7\x11\xb1\x2c\xf9\xeb\x90\xbb\x4d\x92\xb2\x6c\x52\x0f\x62\x66\xa7\xe4\x5f\x5b\x9a\x12\x5b\x37\x02\xa1\xc7\x6c\xe2\xfc\xf5\x3f\x3c\x04\x0b\x9b\xad\x68\x56\x6b\xb7\xea\xf2\x03\x7c\x04\xdb\x8c\xf4\x32\x96\xd2\x80\x81\xf1\x8d\x9f\xf6\x42\x3c\x05\x99\x1f\x53\x46\x1f\x08\x40\xe0\x76\x45\x19\xf9\x40\x33\x06\x50\x19\xfd\x53\x0c\x66\x28\xc5\x53\x30\xf7\x83\xeb\x65\x9a\x6c\xe3\x10\x20\x10\x50\xc6\x0b\xac\x52\xb2\x00\x08\x50\x46\xd6\xbc\x23\x00\x81\x28\x89\x97\x21\xc9\x02\x80\xc0\x26\xe1\x53\xc0\xab\x4a\x79\xf0\x2e\xa2\xf1\xb5\x27\x4a\xcc\x10\xc1\x8f\xe0\x07\xe0\x4d\x41\x10\xf9\x59\x06\x10\x08\x29\xcf\x49\x79\xdd\x91\x1f\x2f\x01\x02\x69\x12\xf1\x0a\x99\x3f\xa7\x71\x48\xee\x78\x3d\xec\x9e\x47\x3d\xff\xa7\x9f\x52\x7f\x38\xfd\xf3\x76\x38\xfb\xe1\xd9\x73\x3a\x43\xbe\x37\x05\xcc\x4f\x97\x84\x55\x30\x31\xca\x44\xf9\x94\x44\x60\x86\xfc\x94\xf8\xde\x74\x86\xe6\xe2\x5f\xca\xff\x07\x49\x24\x7f\x42\xc2\x7f\x43\x7a\xc3\x7f\xc8\x9a\xff\x5f\x89\x1c\xab\x91\xf8\x7f\x28\xfe\x1f\x89\xff\x2f\xc4\xff\x63\xf1\xff\x25\xff\x4f\xc5\xbf\xf5\xd2\x9b\xaa\x6e\xfa\x11\x33\x5a\xbf\xa5\x21\x5b\x71\xa0\x08\x5f\x06\x60\x86\x22\x51\x40\x36\xbd\x11\xff\x52\xd1\x7c\x26\xfe\xad\xfd\x48\xa4\x64\x1b\x3f\x16\xbf\xdb\xb9\xfc\x11\x59\x33\x96\x26\xf1\x92\x7f\x6d\xc5\x3f\x9e\xb5\x40\x11\x7e\xfe\x4f\x7b\xe2\xd9\x13\x4f\xac\x96\x49\xbe\xf6\x69\xc4\x92\x7c\xc1\x36\x39\x23\x51\xbe\xa0\x11\x81\x5e\x3e\xfd\xa7\xe5\x3d\x9f\xfc\xd7\xec\x07\x7b\xe2\x4d\xf9\x47\xfe\x0c\xc2\xe7\x4b\x8a\x7c\xfc\xfc\x9f\xa1\xcf\x7c\x5e\x03\x5d\xfb\x4b\xf2\xe7\x73\x7b\xe2\xcd\xd7\x9b\x7c\x49\x17\xf9\x5f\x1b\xb2\xcc\xff\xda\x2c\xf3\x4d\xbc\xcc\x19\x5d\x2c\xf2\x5b\x32\xdf\xc0\xfc\x86\x86\x24\x11\x39\xd7\x3c\xc7\x7a\xf3\x22\x4f\x96\x4b\x9e\xb8\x86\xb9\xbf\x0d\xa9\x4e\x3c\xca\x93\xa5\x2f\xd2\x92\xcd\x36\x83\x70\x3c\xf7\x33\xf2\xf2\x05\x9a\xfa\xc3\x07\x77\xf8\x7a\xf0\x7c\x36\xc0\x7c\x16\xc7\x1a\x51\x7b\x37\x6a\x75\xfa\x69\x8f\x62\xe2\xc4\x49\x48\x3e\xfa\x6b\xe2\xb0\xe4\x43\x72\x4b\xd2\x73\x3f\x23\x36\x1c\xd3\x85\x3d\x1c\xf5\x31\x7e\x70\x68\x7c\x96\xa6\xfe\xbd\x4d\x11\x83\x50\xae\xb3\xe1\x08\xd7\x53\x52\x98\xe7\x3f\x26\x49\x44\xfc\xd8\x96\x55\x7e\xf1\xa3\x2d\x71\xd6\x3e\x0b\x56\x76\x04\xf3\x7c\x37\xd6\x87\x70\xbc\x48\x52\x9b\x03\x92\xe1\x07\x9b\x41\x67\x41\x23\x46\x52\xbb\x4e\x46\xf4\xd2\xee\xd1\x38\x63\x7e\x1c\xf0\xf5\xfc\x99\x2c\xdf\xdd\x6d\x0a\x88\x62\xec\xa2\x04\x67\x4e\x44\xe2\x25\x5b\x8d\xe3\xd3\x64\x1c\x0f\x06\x90\x2e\x6c\xaa\x9a\xc9\xa6\xf1\x4c\xc3\xdd\x77\xc7\xea\x63\x54\x94\xe3\xf1\x2b\x6f\x06\x51\xf8\xc8\x0b\x59\x56\x0b\x35\xa2\xaa\x78\x8f\xda\xc4\x84\xf9\x93\xa0\x32\xce\x35\xb9\xcf\x6c\xa6\x61\x21\x4d\x58\x74\xfe\x14\x93\x69\x3c\x73\x04\x61\xb9\x14\xa4\x34\x49\xcf\xa2\xc8\x06\x3f\x00\x88\x22\xec\x22\x1f\xa7\xba\x6c\x74\xea\x8f\xa3\xc1\x40\xce\x52\x80\xd3\x69\x34\x43\x21\x0e\x9e\x9a\xac\xcc\x11\x6b\xf9\xd3\xc2\x0e\x61\xd9\xee\x0a\x4f\x67\x4e\x16\xd1\x80\x38\x81\x1f\x45\x76\xe0\xf8\x8c\xa5\x74\xbe\x65\x24\x83\x68\xc3\x53\x83\x24\x0e\x7c\x66\xb3\x29\xf8\x01\xcc\xf2\x7c\x3a\x43\x6c\x1a\x8a\x0f\x88\xb6\xd8\x45\x0b\xbc\xd2\x90\x6d\x4f\x17\xe3\xad\x86\x6c\x8d\x57\xd3\xed\x6c\x7c\x63\xaf\xd1\x06\xe6\x79\xe0\xa4\x64\x9d\xdc\x90\x33\x5d\xbf\xbd\x2e\x21\x86\x05\x89\x32\xd2\x0b\x9c\x8d\x9f\x92\x98\x7d\x4c\x42\xa2\x72\x9f\xaf\x68\x14\xda\x01\x2c\x0a\x49\xad\x04\x81\xa4\x71\x2f\x4c\x82\x2d\xdf\x82\x9c\x20\x25\x3e\x23\xef\x22\xc2\x43\x36\xf8\x0a\x60\x9e\x9b\x34\x98\x2e\x6c\xa0\x52\x79\x39\x22\x61\x63\xd8\xa8\x0e\x51\x0c\x36\x69\xc2\x12\x49\x46\x33\x4c\x1c\x55\x62\x4a\x67\x28\x56\x33\x89\x12\x5c\xd6\xab\x17\xc8\x83\xa0\xf9\x50\xe1\xcd\xa3\x1f\x86\x5e\xcb\xbe\x43\xb0\x58\x09\x4e\xd9\x86\x39\xe0\x7e\xba\x14\x1d\xc9\xa0\xf3\x57\x42\x63\x1b\xf4\x00\x44\xd4\xf1\xc3\xf0\x9c\x03\xc8\xb7\x2c\x24\x87\xe2\x3f\x55\xb5\x1a\xd8\xb2\x76\x96\x2c\x97\x91\x59\xbb\xb1\xae\xa8\x23\x53\x55\x6e\xc4\x60\x81\x82\x24\x66\x3e\x8d\xb3\x36\x78\xa8\xb3\xf2\xb3\xb2\xea\xa2\xe0\xc8\x17\x3b\x72\x77\xfe\x35\xe5\x4c\x0c\xbb\x97\x83\x97\xe2\xc7\x25\x61\x5e\x82\x48\xbc\x5d\x93\xd4\x9f\x47\xc4\xeb\xbb\xbc\xf2\x05\x5d\x6e\x75\xb8\x18\xb3\xf4\xfe\xb1\x59\x83\x9d\x21\x86\x52\x58\x04\x62\x0d\x13\xcd\x4c\xf4\x31\xa7\x58\xdb\xf5\x9c\xa4\x96\x35\x3c\x1c\xbd\x78\x79\x72\x78\x74\x78\x7c\x68\xc4\xe7\xb9\x9d\x3a\x55\x8b\xb8\x3f\x42\x1d\x95\xc3\x42\xe2\x64\x3c\xa5\x33\xe7\xeb\x57\x99\xe7\xef\x84\x31\x92\x7e\xfd\x6a\x59\xd9\x4e\x9c\xcd\x50\x02\x8b\xc2\x56\x8c\x8b\xd8\xe2\x19\x0a\x10\xc5\x7b\x70\x75\x2c\x68\x51\x89\x8a\x7c\xda\x6d\x10\x8c\xf8\x26\x7f\x08\x20\xea\x9b\x69\x7a\xdc\x6d\x91\x26\x47\x31\xc3\x6f\x3f\xfd\x72\x95\x5c\x93\x58\x64\xa9\xd0\xc0\x0f\x43\x14\x77\x25\x4a\x0c\x18\x77\x17\x35\x31\xbd\x89\x60\x8b\x24\x7d\xe7\x07\xab\x06\x8a\xa1\xcc\xe1\xac\x82\x5c\x0f\xb0\x40\x7b\x5b\xfe\x37\xaa\x8f\x6b\xd5\x17\x8d\x61\x93\x48\x6a\x83\xe0\x08\xa0\xfe\x88\x23\x79\xeb\xb0\x1d\xe9\x61\x4b\xba\x46\x46\x56\xd4\x35\x32\x32\x15\xb7\xae\x94\x51\x8f\xc6\xbd\x12\x5e\xcb\xea\x73\x50\xab\xc6\x09\xc4\xb8\xcf\x26\xcc\x4b\x64\xcf\x04\xaf\xc8\x97\x48\xb9\xd3\xac\x14\xb5\x8a\xb7\x51\x84\xb1\xe8\x28\x5b\xa5\xc9\x6d\x2f\x26\xb7\xbd\xab\xfb\x0d\x79\x97\xa6\x49\x2a\xd1\x0a\x5f\xb2\x94\xc6\x4b\x45\x7d\xe8\xc2\x26\x96\x05\xa6\x92\xaf\x55\xdb\xdf\x0c\x60\x1c\xc8\xb6\x08\xec\xac\x89\x62\xa6\x68\x37\xca\x74\xa5\x84\x6f\x56\x7a\xd3\x44\x09\x1e\x9d\x96\xdd\x52\x91\x93\x32\x62\x3a\x9a\x79\x72\xf1\xa1\x14\x27\x93\x8f\x62\x91\xd9\x09\xf4\xdc\x71\xda\xc7\xa9\x65\xd9\x29\x76\xe5\x5a\x88\xf0\x2f\x3e\x5b\x39\x6b\x1a\xdb\xf2\xc3\xbf\xb3\x53\xe4\x42\x44\xe5\x2a\x38\x8d\x07\x91\xde\x89\x47\xe5\x3e\xea\xe3\xe1\x68\x3c\x18\xf8\xa7\xf1\x98\xef\xdb\xcc\x09\x56\x7e\x7a\x9e\x84\xe4\x8c\xd9\xd1\xc0\x87\x7d\x9c\x99\x51\x3e\xac\xaa\xd0\xbb\x7a\x35\xc4\x9f\x0c\xe6\x46\x90\x79\x79\x68\x21\xe1\xa7\x0d\x4f\xcf\x50\x8c\xa7\x33\x0e\x0c\x83\x8f\x1a\x80\x04\xf3\xae\x95\x3c\x44\x72\x9a\x8e\x93\xc1\x00\xda\x14\x67\xd3\x64\x06\x9d\x90\x66\x9c\x92\x84\x79\x0e\x3e\xfd\x7a\xf5\xf7\xcf\x9f\x7e\xfb\x15\x60\x8c\xa9\xb9\x95\x31\x7f\xc9\x77\x39\xcb\xaa\xc5\x56\x25\x63\x67\xb3\xcd\x56\x36\xd5\x1b\x49\x2f\x2e\xd4\x47\x56\x01\x7f\x25\x81\xd7\x70\xf1\x0e\x4c\xf9\xee\xc4\x38\xfb\xd4\xec\x88\x84\x3a\x6e\x81\x3a\xfe\x8f\x41\x9d\x29\xa8\x9d\x1b\xce\xb7\x95\xb0\x13\x67\xbd\x8d\x18\xdd\x44\x64\x92\x79\x25\xc6\x64\x53\x77\xe6\x71\xd4\x2e\x28\xe6\x3f\x48\x22\x9b\xb9\x71\x31\x3f\x65\xd9\xef\x94\xad\xf2\xdc\x66\x26\x89\xe0\x5b\x00\xef\x32\xc1\x8f\x05\x62\x9a\xb1\xaa\x53\x6d\x44\x31\xb3\x09\x22\x88\x40\xcb\x62\xd5\xce\xa0\x07\x92\x16\x36\x44\x01\x7e\x2c\x1c\x96\xc8\xa6\x11\x9b\x30\xbb\x09\x05\x3f\xe7\x68\x30\x00\x7a\x14\x3d\xf3\x56\xcd\x5d\x09\xdd\xa6\x94\xe9\x1d\x0a\x7a\x7b\xba\x82\x57\x10\x19\x8c\x60\x9e\xdb\x46\xa8\x46\x48\x38\x8f\xc9\xe7\x96\x73\xb2\x3d\xca\xa7\x96\x40\xb1\x9b\x7e\xba\x8d\x75\x27\xd5\x72\x46\x0c\x8a\x49\xe1\xa3\xcf\xca\x71\xa7\x05\x44\xef\xaf\x7e\xf9\x20\xb9\x47\xb5\xc9\x58\x56\x7f\x27\xce\x00\xb4\x5e\xbd\x0d\x1a\x68\x04\xa0\x65\xb5\x8e\xb6\xbd\xa7\x52\xb4\x53\x0b\x12\x3b\xbd\x31\xa1\x9a\x69\xe7\x14\x72\x97\xe3\xf5\x82\x15\x09\xae\x49\x08\x60\x51\x48\xd2\xb1\xc1\x8f\xdb\x8c\xbc\x25\x0b\x7f\x1b\x31\xaf\x3f\x42\x5f\x33\xc2\xbc\x07\x8e\x78\xef\x93\xe4\x3a\x53\xe8\xef\x64\x84\x15\xe3\xd6\xe8\x76\x9a\xcd\x87\x67\xe3\x54\x55\x5b\xd6\x83\x4d\xa0\xc3\x0f\x64\xd5\x58\x00\xd4\x77\x21\xda\x38\xbc\x4d\xc7\xdf\x6c\xa2\x7b\x49\xbc\x2b\xee\xaa\x10\x40\x9e\x49\xbc\xde\x36\x31\x57\x2f\x69\x72\xdb\x7b\x77\x23\xf6\xfd\x60\xe5\xc7\x4b\xc2\xb7\x77\xb7\x42\xd4\xf2\xe8\x51\xd8\xb0\x3a\x8e\x5d\x4b\xd4\x40\x59\xb5\xf0\x63\x3c\x05\x21\xcd\x36\x91\x7f\xcf\x8f\xe5\xdb\x39\x23\x77\xe2\xd4\xcb\x37\xac\x0c\xcc\x50\xc2\xb9\x9a\x14\xbb\xe3\xf4\xb4\x24\x00\xa9\x66\xca\x23\x1c\x4f\xd3\x19\xf2\x31\x99\x46\x82\xd4\xf9\x96\x65\xfb\xd8\x2f\x97\x85\x0d\x51\x59\x3d\xc6\x38\x52\xc9\x29\xd9\x44\x7e\x40\xec\xe7\xa7\xd3\x7f\xbe\x99\x0d\xde\x3c\x5f\x22\x00\x20\x44\x99\x48\xbf\xe5\x84\x17\xc9\x6a\x7e\xdb\x6c\xf4\xd1\x03\x25\x18\xe8\x1d\x50\x90\x97\x89\x7b\x8a\xfd\xf2\x18\xc2\xa0\xe7\x1b\x6b\xc5\x66\x10\xc2\x79\x4a\xfc\x6b\xbd\x68\x93\x8a\xfa\x7d\x30\x98\xcc\x8d\x9f\x66\xe4\x22\xe6\xeb\x7d\xe4\xc2\x3c\x77\x8b\x07\x67\x11\x3b\x2c\xa5\xcb\x25\x49\x3f\xfa\x8c\x9a\x2c\x86\xe6\xf8\x39\x85\x58\x51\x4e\x87\xc6\x94\x53\xb1\x0d\x1f\x79\x31\x23\x13\x7b\x3b\x61\xb8\x9a\x20\x82\x1e\xe7\xdb\xf9\x3c\x22\x99\x5c\xdf\x36\xdb\x61\xe1\xe4\x44\x8a\x1f\x00\xa1\x43\x63\xca\x74\xd9\xbe\xab\x58\x90\x5a\x1b\xbc\x73\x1e\x75\x16\x34\x25\xaa\xcd\x8e\x5a\xe5\x7a\xb3\x21\x74\x08\x0f\xf2\xed\x1a\x13\x64\x94\xb4\x41\x12\x83\x01\x47\x63\xe8\x89\x15\xa4\xfa\xcd\x19\x6e\x81\x88\x0b\xfc\x08\xfe\xbc\x0b\x5c\xe0\x81\x33\x80\xf8\xe7\xa8\xfa\x3c\xac\x3e\x8f\xaa\xcf\x17\xd5\xe7\x71\xf9\x49\x78\x0d\xbe\xfc\x1c\x55\x9f\x87\xd5\xe7\x51\xf5\xf9\xa2\xfa\x3c\x2e\x3f\x83\x57\xc0\x03\xe7\x32\x96\x7f\x06\xe2\x33\xe4\xf5\xbe\x15\x9f\x0b\xfe\x19\xca\xbc\x27\xc0\x03\xef\xe4\xe7\xeb\xea\xd3\xaf\x3e\xe7\xe5\x27\xe1\x79\x89\xfc\x7c\x5d\x7d\xfa\xd5\xe7\xbc\xfc\x0c\x02\xe0\x81\x0b\xf9\x19\x56\x9f\xa4\xfa\x5c\x94\x9f\x84\xe7\xa5\xf2\x33\xac\x3e\x49\xf5\xb9\x28\x3f\x43\x3e\x24\x1f\x65\x2f\xf8\x67\x2c\x63\xf9\xe8\x7c\x92\x9f\x47\xd5\xe7\x8b\xea\xf3\xb8\xfa\x7c\x59\x7d\x9e\x94\x9f\x0b\x5e\x43\x22\x3f\x8f\xaa\xcf\x17\xd5\xe7\x71\xf5\xf9\xb2\xfa\x3c\x29\x3f\x43\x3e\x24\xbf\xc9\x4f\xbf\xfa\x9c\x57\x9f\x41\xf9\xb9\xe0\x79\xb7\xf2\xd3\xaf\x3e\xe7\xd5\x67\x50\x7e\x86\x7c\x48\xfe\x90\xb1\xfc\xf3\x5e\x7e\x2e\xca\xcf\x80\x83\x73\xa6\x66\x80\x7f\xfb\xf2\x3b\xe4\x03\x78\xb5\x92\xd9\xf9\x37\x93\xdf\x21\x2f\x2a\x04\x90\x7f\x6e\xdd\x91\x5b\x22\x2c\x0f\x1c\x9a\x81\x17\x66\xa0\x44\x45\x1e\x38\x32\x03\xc7\x66\xe0\xa5\x46\x3d\x1e\x38\x31\x03\xbe\x19\x08\xcc\x40\x89\xa3\x3c\xf0\xda\x0c\xcc\xcd\x40\x68\x06\x88\x46\xe6\xad\x3b\x1a\xb9\x46\xc0\x5d\x68\xdc\xe6\x29\x23\x33\x70\xa8\x31\x99\x07\x5e\x98\x81\x97\x66\xe0\xc4\x0c\xf8\x66\xe0\x48\xa3\x37\x0f\x1c\x9b\x81\x57\x66\xe0\xb5\x19\x98\x9b\x01\xde\xed\xbf\xeb\x00\x31\x02\x87\xae\x19\x38\x34\xb3\xf1\x6e\x2f\x75\x60\x61\x04\x0e\x47\x66\xe0\xc8\x0c\xf0\xce\xbd\xd7\x81\x97\x66\x80\x43\xbd\xd2\x81\x57\x66\xe0\x44\xaf\x47\x1e\xf0\xcd\x40\x60\x06\x88\x11\x38\x72\xcd\x94\xd7\x7a\x91\xf2\xc0\xdc\x0c\x84\x66\x60\x61\x04\x8e\x46\x66\x80\x43\xfd\xdf\x3a\xc0\x01\xfd\x4b\x07\x78\x17\x7e\xd6\x01\x0e\xf5\xb5\x0e\x9c\x98\x01\x0e\xc1\x07\x1d\x98\x9b\x81\xd0\x0c\x2c\x8c\xc0\x8b\x91\x99\xc2\xbb\x1d\xe9\x40\x60\x06\x88\x11\x78\xe1\x9a\x81\x43\x33\x70\xa4\xa9\x13\x0f\x1c\x9b\x81\x57\x66\xc0\x37\x03\x2f\x34\x19\xe3\x81\x97\x66\xe0\xc4\x0c\xcc\xcd\x40\xa0\x09\x17\x0f\x10\x23\x70\xec\x9a\x29\xa1\xa6\x4e\x3c\xb0\x30\x02\xc7\x23\x33\xc0\x21\xf8\xac\x03\x2f\xcd\xc0\x89\x19\xe0\xfd\x49\x75\xe0\x95\x19\x78\x6d\x06\x78\xe7\x2e\x75\x20\x30\x03\xc4\x08\xbc\x74\xcd\x14\xde\x39\x4d\x91\x8e\x43\x33\xb0\x30\x02\x2f\x47\x66\x80\x0f\xfc\x95\x0e\xbc\x30\x03\x2f\xcd\x00\x9f\x12\xa6\x03\xc7\x66\xe0\x95\x19\x38\xd1\xc4\x99\x07\x7c\x33\x10\x98\x01\x62\x04\x5e\xb9\x66\xe0\xd0\xcc\x56\xd2\x77\x1e\x98\x9b\x81\xd0\x0c\x2c\x8c\xc0\xab\x91\x19\x38\x32\x03\xbc\x73\xbf\xeb\x00\xef\xc2\xad\x0e\xbc\xd4\x7b\x03\x0f\xbc\xd2\x5b\x02\x0f\x9c\x98\x29\x1c\x9c\x7f\xe8\xc0\xdc\x0c\x84\x66\x80\x77\xfb\x41\x07\x02\x33\x40\x8c\xc0\x11\xef\xe9\x45\xb9\x50\x39\xa4\x54\xaf\xd4\x63\xb1\x11\x6b\x7a\x77\x2c\x76\x52\x1d\x7a\xc1\xa1\x38\xd0\x28\xfc\x4a\x4e\x6c\x81\xd6\xf8\xf9\x94\xf3\x4d\x43\xbe\x35\xf3\x3d\x79\xc8\x77\x58\xbe\xb5\xf2\x8f\x85\xdc\xa3\x86\xb2\xc8\xec\xf9\x12\x2d\xb1\x94\xb4\xd8\x60\xfa\xe7\x9f\x5b\xf7\x88\x27\xf2\xdf\x97\x8b\x3f\xff\xdc\x2e\xc8\xa1\x08\x2e\xc8\x21\x0f\x1e\xba\xa1\x08\x1e\xba\x0b\x1e\x1c\xf9\x73\x11\x1c\xf9\x32\x18\x06\x32\x18\x2e\x16\x33\x80\xc0\x12\x18\x47\x80\xb9\xc1\xf9\x2e\xa6\x64\x56\xf1\xc4\xb7\x55\x8a\x4d\x30\x31\x58\x78\x68\x59\xa4\x64\xd9\xd7\x68\x0e\xcb\x80\x60\xdb\x0b\xce\x26\x5e\xa0\x3b\x74\x8f\x9e\xa1\x4b\x6c\x5f\xe0\x47\x60\x01\x0f\x58\xfe\x7a\x33\x06\x08\x9c\xf2\xef\x88\xf1\xcf\x37\xfc\x73\xc9\x3f\x0f\xc0\x81\x07\xac\x7f\x6d\x13\x11\x7f\xc0\xe3\xff\xeb\xee\xf0\x15\x0f\xfc\xbf\x32\xf0\xd2\x1d\x83\x02\xdd\x61\x60\x4f\x3c\x30\x30\xef\x3f\x2e\xb4\x04\x3a\x07\x70\x00\x20\x40\xf7\x7a\xec\xee\x20\x7a\x56\x7e\x8b\x9e\xb7\xdd\xdc\x12\x2c\xa5\x62\x64\x02\x80\x07\x38\xf7\x7b\xef\x30\x92\x31\x9b\xc0\x49\xd5\xd1\x67\xe8\x1d\xf4\x48\x61\x8c\xdd\x3b\xa3\x8e\x0b\x3e\x76\xbc\xe3\xe7\xf8\xf1\xe8\xd0\x03\x3d\x80\x5e\x9c\x78\xc0\x05\xe8\xc5\x6b\x0f\x8c\x00\x3a\x76\x3d\x70\x08\xd0\xf1\xc8\x03\x47\x00\x1d\x1f\x7a\xe0\x05\x40\xc7\x47\x1e\x38\x06\xe8\xf8\x85\x07\x5e\x02\x74\x7c\xec\x81\x57\x00\x1d\xbf\xf4\xc0\x09\x40\xc7\xaf\x3c\xf0\x1a\xa0\xe3\xd7\x1e\x18\x03\xf4\xf2\x58\x30\x29\x2f\x5f\x7a\xe0\x47\x80\x5e\xbe\x12\x7c\xc5\xcb\x13\xc1\x10\xbc\x7c\x2d\x36\xef\x57\xae\x07\x7e\x02\xe8\xd5\x48\xec\xaa\xaf\x0e\xc5\x76\xf8\xea\x48\x6c\x5d\xaf\x5e\x88\x3d\xe7\xd5\xb1\xd8\x60\x5e\xbd\x14\x9b\xc1\xab\x57\x1e\xf8\x05\xa0\x57\x27\x82\x4a\xbf\x7a\x2d\x28\xea\x89\xeb\x81\x5f\x01\x3a\x19\x79\xe0\x7f\x00\x3a\x39\x14\x84\xf1\xe4\x48\x10\xb1\x93\x17\x82\xe0\x9c\x1c\x0b\x12\x70\xf2\xd2\x03\x5f\x00\x3a\x79\x25\x56\xed\xc9\x89\x07\xfe\x1f\x80\x4e\x5e\x8b\xf5\xf8\xda\x15\xeb\xed\xf5\x4b\x31\x04\xaf\x5f\x89\x21\x78\x7d\x22\x86\xe0\xf5\x6b\x31\x04\x23\xd7\x15\x63\x30\x72\x47\x62\x10\x46\xee\xa1\x18\x85\x91\x7b\x24\x86\x61\xe4\xbe\x10\xe3\x30\x72\x8f\xf9\x40\x14\xe8\x23\x3e\x7c\x85\xde\xe2\xd1\x11\x7a\x8f\x8f\x0e\xd1\xef\xf8\x35\xfa\x11\x1f\x9d\xa0\x5f\xf0\x0b\x17\x7d\xc6\x8f\xd9\x36\x08\x48\x96\xf1\x13\xfc\xda\xff\x2b\x49\x79\x2b\x52\xc2\xff\xd9\x59\xf0\x09\xb6\xc5\x41\x2e\x4c\x93\x4d\x98\xdc\xc6\xce\x79\x12\x67\x2c\xdd\x06\x2c\x49\x9d\x2f\xef\x3e\x5f\x5e\x7c\xfa\x98\xe7\x00\x40\x27\xdb\x44\x94\x89\xfb\x8c\xa9\x3b\xd3\x21\x07\x40\xf4\xd9\x11\x15\x63\x59\xdf\xd4\x9d\xa1\xcf\x8e\x6a\x15\x9b\xa7\x6d\x81\x08\xbf\x61\x17\xfd\x85\x81\x33\xd7\x92\x02\x80\xbe\xe0\xc7\xb7\x17\x97\x67\x3f\x7e\x78\xf7\xd6\x03\x5a\xd4\x05\xd0\xdb\x8b\x2f\x17\x6f\xdf\x7d\xe6\x51\x37\x34\x24\x29\x40\x97\xef\x3f\xfd\xee\x81\x64\x43\x62\x80\xde\x7e\xfe\xf4\xeb\x6f\xbf\x7a\x80\xc3\xbd\xdd\x00\xf4\xcb\xbb\x8f\xbf\xc9\x10\xef\xc5\x70\x4d\xe2\xad\x8c\xfc\x7c\xf1\xf7\xf7\x57\x8d\x94\xa1\xd0\xd1\x90\xe9\x1f\xde\xfd\xb4\x93\x1c\x91\x05\x03\xe8\xc7\xdf\xae\xae\x3e\x7d\x3c\xff\x70\x76\x79\xe9\x81\x39\x8b\x87\xa1\x94\x58\x00\xf4\xeb\xa7\x5f\x3f\x7d\x79\xf7\xf9\xfd\xbb\x33\x01\xe0\x26\xd9\x24\x37\x24\x1d\xaa\x3b\xf0\x8b\xf3\x4f\x1f\x7f\x3c\xbb\x7c\xe7\x81\x65\x74\xbf\x59\xd1\x20\x89\x01\xba\xba\x38\xff\x99\x27\x18\x91\xc3\xe4\x1a\x14\xe8\x27\xfc\x28\x61\x77\xc0\xe0\x8b\xc3\x3f\x0b\xf4\x15\x3f\x8a\x0b\xf1\xae\x4b\x0c\x9e\x08\x20\xa2\x9d\x19\x28\x80\x48\x49\x29\xba\x2b\x59\xfb\x51\x04\x20\xf2\x3b\x73\xf8\x00\xa2\xa8\xbb\x91\x88\xb7\x22\x34\x28\xb2\x8d\x1f\x90\x66\xbe\x2b\x72\x27\xa4\x97\x36\xf8\xf3\xce\x77\x39\x8d\x49\xfd\x25\x4f\x6f\x66\x7c\xab\x82\x3f\xa9\x74\x1b\x16\xe3\xaf\x8e\xef\x64\x84\x55\xf7\x96\x5a\x3b\x22\x11\xf2\x2d\x00\x11\x78\x01\x30\xc6\x0a\xf7\x2c\xcb\xe6\x25\xc4\x05\xc4\x47\x7f\x4d\x70\x35\xa1\x94\x91\x35\x80\xe8\xab\xa3\xc6\xc3\xcc\xc4\xc3\xc3\xf5\x56\x08\x9c\xbe\x3a\x3c\x84\xbf\x3a\x7c\x70\x9d\x20\x4a\x62\x22\xa0\xef\x8f\xa0\x4a\x6b\x96\xe4\x65\x84\xd8\xec\x17\x3f\xbd\x6e\x2d\x28\x44\x03\x7f\x17\x52\x0e\x45\x6e\x7f\x1c\x80\x1c\x0c\x7e\x81\xe8\x5f\x66\x2c\xf8\x27\x18\xfc\x3e\x00\xcf\x72\x30\xf8\x08\xd1\xcf\xf8\x31\xa2\x5e\x53\x4e\x29\x6f\x9e\xbe\x3a\x11\x6d\xb4\xa1\xc9\xb5\x65\xd9\x23\x8c\x95\xfe\xc0\xd5\xfd\x86\xe4\xf9\xa8\x1e\x31\xc9\x1c\x7f\xb3\x21\x71\x28\x2f\x78\x09\xf4\x32\x87\xc6\x31\x49\xdf\x5f\xfd\xf2\x01\x13\x88\xca\x2b\x3d\x66\x59\x00\xc8\x5f\x3b\x33\xfa\xcd\x20\xe2\x9b\x42\x1f\x53\xcb\xca\x9a\x57\x68\xc9\x86\x2d\xd3\x64\xbb\x19\x82\x01\x85\x28\x2b\x90\xdf\xd9\x09\xdf\xec\x83\x5b\xef\xc3\xb7\xc0\x9c\x91\x94\x9d\x85\x7f\xf9\x01\x89\x19\x07\xde\x06\x73\xb2\x48\x52\x42\xe2\x10\x20\x02\xdb\x7b\xd2\x00\x58\xc9\x15\x8d\x58\xc4\x0c\x22\x07\x91\xe8\x63\x1d\x09\x95\x42\x8e\xec\x9e\x58\x5d\x0d\x05\x2b\x79\x49\x80\x62\x5c\xa2\x8c\x39\x55\x74\x61\x13\x71\x3d\x45\x62\x06\x63\x73\xec\x75\xec\x98\x44\x19\x11\x37\x52\xa2\xfc\xb9\x8c\xe5\x7c\x06\xb9\x63\x88\x38\x9c\x6e\xe8\xeb\xb4\xaf\x4e\xb5\xf8\x1a\x0d\xd9\x19\xb6\xfb\x2e\xc6\x98\x4d\xbe\x3a\xd4\x93\xb8\x09\xeb\x99\xa0\x39\xaf\x2b\x9a\x39\x72\x69\x65\xa2\x8d\x1f\xfd\x8c\x0c\x40\x0f\x0c\x64\x93\xe8\xab\xa3\x57\x6f\x6d\x3a\x32\xd8\x95\x92\xc0\x82\xe8\x25\x67\x59\xb6\x4d\xb1\xb9\x02\x6b\x60\xd4\x3b\xaa\x32\xa1\xb8\x56\x1d\x85\xb0\xa0\x0b\xd5\x27\xa1\x48\x31\x76\x4f\x63\x27\xe0\x89\xbc\xa6\xf2\xae\x08\x76\xc0\x63\xe6\x9d\xba\x33\x28\x06\xba\xd7\x95\xb9\xc4\xc8\x2a\x43\x81\x22\x7f\x4e\x22\xaf\x45\xc6\x89\xb2\xce\xe9\xae\x2d\x30\x47\xc9\x78\x6b\xf3\x18\xef\x9d\x47\xda\x4a\x57\xfe\x13\x53\x47\x3b\xa7\x2e\x86\x45\x79\xad\x64\xcc\x20\xfb\xde\x19\xac\xaf\x5c\x06\x3b\x1b\xac\x61\x51\x51\xa0\x3f\x70\xcb\xaa\x12\x1d\x1d\x9b\x37\x07\x79\x6e\xb7\xdf\x3b\xc8\x7b\x03\x64\xe6\xc5\x7d\x17\x22\x31\x54\xcf\x88\xdc\xbf\xf0\x83\x4d\x74\x54\x4c\x6e\xd5\xae\x26\xef\x13\x64\xec\x7c\xcb\x58\x12\x9b\x31\x9c\x39\x30\xc2\x6a\xd4\x31\x93\x41\x09\xc1\x86\x06\xd7\x24\xc5\x8f\x6b\x9f\xc6\xde\x63\x81\x32\xe2\xa7\xc1\x8a\x7f\x05\xdb\x34\xe5\xdb\xdf\x63\x81\x6e\x28\xb9\xe5\xbf\x34\xbb\x14\xc9\x34\x5e\x72\x06\xed\x9a\xdc\xf3\x3d\xcb\x7b\xbc\x26\xf7\xef\x69\xc6\x92\xf4\xde\x03\x00\xa5\x24\x23\xec\xe7\x2a\xea\x51\xc8\xef\x5b\x2e\x77\x32\xc2\xae\xe8\x9a\x24\x5b\x66\x1b\x89\xb4\x06\x99\xa3\x1a\x71\xaa\x36\x30\x00\x05\x3a\x71\x5d\x58\x14\x45\xa1\xfa\x42\x1f\xc8\x45\xbc\x48\xf0\x63\x81\xe4\x31\xa0\x8e\x68\x82\xd5\xb1\x2c\x7b\x37\x12\xd7\x46\x59\x68\x36\xd9\x4a\x3b\x10\xca\x0d\x31\xab\x57\x25\x75\x37\x7e\xf5\xc3\x90\xc6\xcb\x31\x90\x3a\x23\x95\xaa\x57\xd6\x6c\xa5\x96\x1f\x4f\x33\x24\xfe\x66\x6a\x2e\x6f\xfc\x08\xff\x61\xdc\xb6\xdd\xf8\x6a\xb2\x52\x12\x87\x24\xad\xa5\xc9\x28\x9d\xbc\x48\x49\xb6\x6a\xa4\x8b\x38\x3d\xbb\xec\x92\x53\xff\x5a\x0e\x1d\x69\x22\xc0\x59\x14\x35\xf2\xa8\x58\x99\x29\x24\xed\xd9\x8c\xf8\x32\x23\x4b\x93\xfb\x66\x26\x1e\xa7\x41\x16\x7a\x1d\x7f\xec\xa8\x7a\x28\x68\x56\xc9\x6d\x1d\x90\x55\x72\x2b\x93\x56\x34\xac\x97\xe3\x11\x32\x89\xc6\x54\x30\x60\xe5\x71\xee\x1f\x9a\xd0\x45\xc8\xc7\x95\x6e\x48\x80\x09\xa7\x6f\xd3\x99\x93\xad\xe8\x42\x5f\xd2\xf9\x10\xf5\x4b\xe6\x5f\x5e\xc5\xfd\x87\x4e\x19\xd5\x31\xe2\x0f\xa7\xd4\xdf\xfe\x42\xd2\x8c\x26\xf1\x44\xb5\xb1\x9b\xd2\x59\x9d\x67\xab\x32\x53\xc5\x41\x22\x7e\x22\x03\x2e\x98\xa1\x20\x89\xb3\x24\x22\xce\xad\x9f\xc6\x36\xb8\x5a\x91\x94\xf4\x6e\xfd\xac\xe7\xc7\x3d\x9a\x65\x5b\xd2\x4b\x09\x4b\x29\xb9\xa1\xf1\xb2\xd2\x24\x3f\xc8\x7a\x37\xaa\xc9\xde\xbb\x38\xdb\xa6\xa4\x4a\xeb\xd1\xac\x37\x27\x3c\x7b\x94\xf8\x21\x09\x7b\x92\x57\xe9\x35\xf5\xb8\x7b\x7e\x1c\xf6\x98\x68\x8f\x66\xbd\x38\xe9\xc5\xfe\x5a\xee\x0a\xbd\x20\x89\x22\x2a\x6b\xbf\x58\x88\x6a\x6a\xad\xf7\xfc\xec\x3e\x0e\x56\x69\x12\x27\xdb\x2c\xba\x47\xbc\x16\x0d\x4f\x6f\xed\xdf\xf7\x62\x42\xc2\x1e\x4b\x7a\x73\xd2\x5b\xfb\xf1\xd6\x8f\xa2\xfb\x5e\xb6\x21\x01\x5d\x50\x12\xf6\x6e\xa8\xdf\x7b\xc6\xa7\xa7\x46\x27\xcc\x29\xda\x19\x57\xc1\x65\x15\x4f\x9c\xfc\xe8\xc2\xae\x71\xe9\x5a\x0d\x70\x3a\x1b\xff\xe1\xbc\x7d\xf7\xd3\xd9\x6f\x1f\xae\x2e\x1d\xc1\x53\x61\x8c\xbf\x38\xc6\x81\xcb\xb2\x98\xbc\x6b\x7f\xe4\x83\xe0\x69\xc6\xab\xdc\xf6\x3c\x60\x64\x06\x05\x44\x46\x85\x7a\x13\x14\x75\xea\xc3\x58\xb3\x42\x9d\xa9\x56\xa7\xce\xdc\xa8\x90\xd1\xe0\xfa\x22\x48\x62\x51\xa1\x3e\xc8\x35\x2b\xd4\x99\x6a\x15\xea\xcc\xbc\xc2\x2f\x8e\x3a\xd1\x1a\x87\x93\xf2\x68\xfb\xc5\xe1\x87\x5b\x0c\xf8\x1a\xe5\x21\xa3\x77\x58\x9c\x3d\x23\x79\x62\xfd\xe2\xd4\xce\x9e\xb8\x3c\x7b\xae\x88\xaf\x2a\xd2\x9d\xc0\x80\x87\x34\x04\x18\xcc\xb3\x61\x72\x5d\x9e\x61\x4b\x65\x1f\x8a\xdd\x31\x3d\xd5\x1a\x49\x63\x3a\x18\xc0\x47\x82\xd9\x94\x9a\x93\x34\x25\x0e\xef\xe5\x0c\x7f\x99\x92\x8a\xf7\x98\x15\x85\x41\xcf\x89\x1f\xac\xec\x86\xb6\x26\x29\xb5\x35\x05\xeb\x4b\x33\x7d\xc5\xaf\x95\xc2\x38\xbf\x60\x5e\xfd\x4b\xdc\xe3\x67\x5b\xbc\x63\x32\x10\x58\x56\xa0\x14\x85\xe8\xc2\xa6\xa5\x3a\x6d\x26\xb4\x37\x76\x14\x37\xec\x0c\xf2\xad\x43\xef\x1b\xd3\x6c\x86\xe9\x34\x9b\x49\xb5\x57\xc5\x7a\xa9\xc6\x2b\x15\xe2\x84\xd7\x15\xc3\xb8\x59\x57\x02\x2d\xab\xa1\x7d\x9d\xa0\x10\x5a\x56\x48\x22\xc2\x48\x2f\x9e\x26\xb3\xb1\x54\xb1\x7c\x70\xc8\x1d\x23\x71\x68\x3f\x16\x06\x12\xa1\x87\x9d\x05\xa6\xa6\x22\xcb\x73\xbe\xc9\x22\x0a\xc7\xa9\xc3\xc8\x7a\x13\xf9\x8c\x74\xd4\x52\xa6\xef\xa9\x6e\xd2\x9d\x54\x16\xe7\xbc\x47\x5c\x55\x46\xcb\x4f\x88\x5a\xe7\x03\xc9\xab\xfa\x3f\xa4\x16\x46\x0a\x61\x01\x32\x21\xf6\xac\xcd\x8e\x1d\x61\x36\x0d\x66\x86\x56\xf8\x4f\x0a\x1d\x26\x3c\x5e\x2b\x72\x20\x1f\x7a\xd5\xb4\x04\x33\xa1\x72\xa2\x38\x98\xf2\xdc\x16\x4d\x22\x2f\x2b\xfe\xd0\x9b\x03\x06\xca\x44\x07\x18\xa3\x81\x1f\xe3\x24\x26\x97\x4a\x67\xe4\x8a\x1f\xc7\xc0\xc7\x84\x71\x6e\xaa\x57\x69\x92\xf0\x3c\x9f\x49\xc6\xfb\xaf\xb3\xf4\x52\x19\xee\x09\x85\x74\x12\xf6\x1e\xdd\x02\xa0\x20\xd9\xc6\xac\x56\x5b\xbb\xd2\xa1\x10\x89\x3e\xba\x45\x8f\x32\xb2\xae\x1a\xf2\xca\xb8\xac\x8a\x2c\xd0\xda\xbf\x53\x3a\x39\x9d\x55\x4e\x65\x95\x1f\xe8\x9a\xb2\x5e\xca\xd7\x11\x09\x7b\xf6\x63\xac\x5a\x58\xfb\x77\x10\x78\x5d\xc9\x99\x4c\x47\x23\x7e\xd8\x03\x7f\xe7\x07\xf0\x5e\xb4\xbf\xa6\xbd\x99\x54\x7d\x33\xce\xb9\x2a\x7e\x44\x0e\x9b\x1c\x99\xde\x59\x14\x01\x64\x30\x2b\x32\xf1\xad\x8a\x50\xc9\x49\x4c\x7e\x14\xdc\x33\xe7\x6a\xab\x90\xcc\x7b\x1e\x25\x9c\xee\x6a\x8d\xb5\x4b\xb2\xf1\x53\x9f\x25\xa9\x07\x50\x0f\x20\x41\xe9\x39\x65\x16\x32\x37\x15\xf6\x6a\x14\x11\x71\xee\xd4\x03\xfe\x96\x25\x00\x09\xd6\x52\x68\xb9\xa1\xcc\x98\xbb\x9f\x92\x74\xed\x33\x0f\x08\x6d\xb2\x0c\x20\x61\x97\xc2\xa1\x51\xba\x31\x24\xe5\x01\xce\xf7\xbc\x55\x12\x47\x1e\xe6\xe4\xf7\x52\xc9\xcd\x54\x90\xd3\x74\xaf\xef\x8a\x6f\x75\xc6\xe1\x41\x29\x77\x3c\xdb\xb2\x84\x87\x24\xf5\xe5\x45\x22\x7a\x43\x24\x4f\x5f\x0f\xfd\x1a\xf9\x01\x59\x25\x11\xcf\x26\x80\xad\x92\x3e\x72\x50\x23\xde\xa5\x5a\x09\xc1\x58\x7a\x95\x2e\x0f\xf2\x05\xe2\x64\x3f\x26\x77\x3c\xa3\xde\xc0\xbc\x8a\xe2\x23\xbd\x07\x79\x15\xdd\x17\x70\x5f\xd1\xe0\x9a\x97\xa9\x96\x7f\xe0\xa7\x84\x79\x07\xa7\xfc\x6c\xd9\x13\x04\x1d\x03\x11\x07\xde\x9c\x3e\xe7\x91\x6f\x0e\x4c\xd4\x15\xd2\xe3\x64\x4e\x23\x01\xa3\x1c\xe7\x4f\xf1\x95\x3f\x17\xf3\xab\x76\xb3\xb3\x88\x2e\xe3\xcf\x7c\x9f\xe2\xb1\x35\x36\xdd\x73\xd1\x0d\x4d\xd9\xd6\x8f\x2e\x83\x34\x89\x22\xef\xa5\xeb\x22\x75\x1a\x16\x35\x2a\xdb\x2b\x31\xd0\xa5\x1d\x96\x1c\xa8\xd2\x46\xcb\x23\x9c\x14\x96\x4c\x2b\x7e\x0c\x2a\x0e\xc5\xfb\x03\x71\xce\xd5\xdb\xb1\x16\x90\x3a\xbf\xad\x87\x12\x1a\x02\x38\xfe\x6d\x30\x30\x99\xf7\x8b\x50\x6c\x94\x32\x30\x04\x83\xdf\xea\x87\x46\xce\x44\x36\xa4\x5e\x55\xee\x15\x0d\x43\x12\x03\x75\x10\xd1\xe8\xdd\x68\x7a\x93\x26\x1b\x1b\xe8\x44\x9d\x99\xa3\xf2\x22\x09\xb6\x59\x6b\xee\x32\x15\xc0\x7d\xe0\x54\x4a\xd7\x7c\xce\x87\x1c\x19\x00\x6c\x9e\x9e\x34\x3a\x18\x07\x62\xe3\xf4\x2b\xf5\xa7\xa5\x54\x56\xcd\xaa\xad\xb2\xcd\xb7\x34\x0a\xdf\x8a\xdd\x12\x35\x46\x73\xc1\x48\x6a\x37\xeb\x82\xce\x26\x25\xfc\x8c\x7f\x95\xec\xa6\xd5\x4f\xd8\xcd\x64\x29\xaa\x49\x49\x6c\x03\x99\xa3\xec\xb7\x38\x80\x77\x67\xff\x49\xc8\xcf\xcd\xcc\x17\x71\x4c\x52\x5c\x85\x8d\xaa\xa5\x64\xa6\xac\x5a\x9e\xd3\xe7\xc9\x9d\x99\x7b\x41\xe3\xd0\x06\x34\xde\x6c\xd9\xfe\xb1\x97\x07\xaf\x56\x6c\x90\x32\x2b\x73\x0e\x76\x17\x8c\x65\x55\x8d\xee\xe2\x98\xbc\x16\x10\x17\x19\x86\x64\x93\xe8\x42\x72\x8c\x14\x52\xf3\xcd\x7b\x48\x85\x14\x54\xc2\x2b\x24\xd4\x9a\xc6\xe9\xb9\x0b\x22\x1a\x5c\xf3\xfa\x49\x4c\x52\x1d\xa9\xc1\xab\x48\xd0\x44\xce\x5c\x15\xd1\x2c\x22\xb0\x92\x84\xbf\x0a\x55\x65\xdc\x18\xc8\xa9\x3b\x53\xda\x72\x6d\xd9\xca\xd9\xe1\xc7\x86\xda\x09\x5b\x57\x2e\x8f\xe7\x3a\x94\x11\xf6\x3b\xa7\xe1\x4d\x68\x4b\x7a\x3e\x31\x96\xf1\xaf\x49\x46\x25\x19\xf0\xea\xb8\x9a\xc4\x36\xe0\x44\x1f\x0c\xfe\x42\xa6\x88\x64\x61\x53\x87\x66\x5f\x24\x8d\xb2\xa1\xe6\x5e\x69\x13\x4e\x4c\x9c\x05\x4d\x33\x26\x04\x57\x0d\x61\x5d\x79\x29\xaa\x04\x5e\xc8\xcc\xcb\x19\xab\x4c\xd0\xbe\xab\x64\x83\xdd\xa2\x30\xb1\x54\xb1\x5c\x3c\x02\x88\xe8\xdd\xa5\xd9\x95\x45\x0f\x83\xa4\xce\x0a\x25\x64\xc0\x6e\xa9\x25\x89\xed\x47\x31\x00\xce\xbc\xc2\x43\x50\x13\x6a\xd2\x6a\xb4\xb4\x96\x63\x39\x64\x04\x16\x08\x48\xcc\xfe\xee\x0a\xf8\x6a\xd0\x55\x70\x2a\xf4\x7d\x15\x88\x83\x91\x59\xfc\x3b\x01\x10\x45\x54\x05\x05\x44\xb4\xb6\x8e\x57\x7e\x66\xde\x34\x49\x93\xe3\x90\x13\xcf\x5d\xec\xa1\xf1\x8d\x1f\xd1\xb0\x89\x40\x7a\x19\xb6\xef\x10\xba\x90\xd9\xb0\xa8\x4d\x83\x35\xe0\xe4\x48\xe6\x69\x54\xab\x73\xdf\xf8\x91\xdd\x08\x42\xe8\x24\x8b\x45\x6b\x25\xb0\x80\xa2\x7e\xb9\x86\x48\x13\x5c\x2d\x2a\xa3\x21\x65\xf7\xf2\xc3\xb2\x3a\xfa\x60\x90\xb6\xf6\x6e\x70\x10\x8c\x76\xa0\x1c\x5e\x45\x95\x38\x10\xf3\x68\x9b\xee\x8e\xd7\xce\x14\xa9\x2d\xae\x8a\x10\xe5\x6a\x95\xf1\xa6\x54\x6d\xb0\xe0\x0d\x75\x49\x3c\xc5\x6e\xc5\xc1\xb7\x6b\xb0\x96\x55\x4b\x49\x8c\xaa\x06\xd5\x77\xbc\x26\x13\xa1\xb6\x70\xbd\x67\xe7\x79\xeb\x86\x3a\x01\xbd\x6a\xdb\xf5\x00\x3f\x32\xd5\x8a\x4d\x0e\x7a\xc2\x8a\x5b\x84\x25\x95\x12\xd6\x70\x80\xa5\x5b\x02\x0e\x78\x09\xca\x8f\xec\x8a\x05\x28\xf7\xfc\x09\xe8\x55\xfb\xbf\x07\xc0\x58\x09\x53\x4e\x5f\x34\xb1\x53\x1a\x8c\xd8\xb0\x32\x07\x94\x3b\xd7\x50\xdc\xca\x09\x56\x80\x62\xd0\x33\xe2\x86\x9c\xc3\x96\x42\xd9\x18\x25\xbc\xf5\x94\xff\x8b\xf8\x3f\x1f\x03\x30\x36\x8d\x06\x74\x87\x25\xaf\x6b\x59\x76\x82\x0f\x4e\x43\x7a\xa3\xd9\xc7\x83\x41\x43\x38\x31\x38\x00\x6f\x4e\xe5\xcc\xf5\x04\xc3\xa6\x77\xf3\x92\xe1\x14\x07\x01\x39\x2c\x92\x40\xa8\xd1\x78\x63\x31\xba\x26\xd9\xf8\xf4\xb9\x2c\xf1\xe6\x60\xd0\x02\xc2\x00\x9c\x3e\x0f\xe9\xcd\x1b\xd0\xb9\x7f\x09\x53\xa8\x1a\x90\x62\x7f\x56\xfb\x13\x78\x73\x2a\xc6\x42\x01\x27\xe3\x4b\xe0\x16\x49\xba\x1e\xf2\xcd\x25\x4d\x22\x39\x05\x41\xb2\xde\xf0\xc3\x3e\x06\xc9\x62\x01\x0e\x06\x76\x9b\x60\xbc\x95\xe3\x9f\x00\xe0\x1d\xf4\x36\x55\x04\x1f\xad\x4b\xfb\xe9\x82\x70\x70\x00\x0e\xe0\xe0\xa0\x97\x26\x1c\x55\x82\x64\x3d\x4f\x38\xe4\x72\xcc\xc4\x85\x14\x06\x97\x0a\x70\x11\xa7\x20\x16\xf3\x51\xe3\x6b\x07\x07\x2a\x47\xbd\x2b\x11\xcd\x04\xcf\xcf\x07\xf2\xa0\xc1\xba\x2a\x04\xd3\x10\x56\xe7\x0f\x71\xd4\x6f\x8e\xab\x4a\x96\x03\x6b\x26\xb1\x58\x62\x5b\xaf\xfc\x1a\x66\x6b\x11\x98\x47\x49\x70\xfd\x04\x92\xa8\x6a\x39\xaa\xf6\x2a\xee\xca\x8f\x22\x5e\x43\x8f\x23\x9d\x71\x32\xe4\x28\xd7\xc0\x95\xda\x41\x75\x70\x50\xa2\xd4\xf7\x34\xaa\x8f\xbb\xdf\xd1\x6c\xe3\x84\xcc\x71\x55\x37\x2c\xc6\xba\x8e\xba\x1d\x23\x5e\x9d\x9c\x85\xc5\x47\x73\xc4\x79\xb2\x02\x7c\xcf\x88\x7f\xcb\x20\x8b\x7e\xb2\x98\xcf\xcb\x37\x74\xad\x76\x9e\xef\xee\x59\x5c\x07\x58\xef\xd5\x3b\x72\xef\x83\x01\x19\xd0\x27\x89\x45\x73\x5a\xb5\x98\x60\x70\xd0\x2b\xc5\xa9\xd2\x56\x14\xf4\x0e\x06\x36\xc8\x98\xcf\x68\x00\x76\x78\x6f\x79\xe8\x9c\x1c\x08\x46\x59\x85\xb0\xce\xcd\xc9\x30\x1c\xc8\x34\x65\x78\x5a\xc2\x0d\x0e\x06\x59\xd7\x4a\x4c\x6e\xe3\x3d\x2b\x6e\xe5\x67\x9b\x64\xb3\xdd\xc8\xd5\x56\x95\x22\x77\x1b\x3f\x0e\x49\x88\xc1\xc2\x8f\x32\x52\x9f\x44\xe9\x79\x61\xa8\xf4\x5d\xba\x93\x86\xf2\x18\xf3\x54\x86\x32\x9b\x31\x47\x3d\xb5\xec\x07\x75\x31\xbd\xa0\x56\x35\xc9\xc0\x3c\x1b\x2a\xe1\x40\x63\x16\xb4\x40\xc1\x11\xc9\x1c\x15\x84\xec\x80\x8f\x61\x85\x15\x8d\x3d\x82\x9f\x65\xc4\xfd\xf4\x6e\xab\x52\x0a\x0e\x25\xce\x25\x83\x74\x10\x0d\x6a\x28\x24\xfa\x20\x30\x94\xe7\xe3\xe3\x2b\x67\xa3\x1c\x56\x1a\xb6\xce\x82\x76\xa9\x82\xc1\x70\xc4\xb1\x83\x0d\x0e\xde\x9c\x6e\xa3\x16\xa8\x64\x0b\x3b\xb0\xc9\x06\x25\x72\xe8\x46\x37\x29\xc9\x48\xcc\xf1\x46\xcc\xcf\xf3\x6d\xa4\xc9\xe8\xc0\xd7\x5b\x93\x5a\x0b\xe8\xc1\x8e\x61\xc1\x99\x15\x7d\x30\xe1\x07\x69\xaf\xc9\x90\xd5\x64\xb6\x37\x94\xdc\x3a\x81\x1f\xbf\xa7\xcb\x95\x10\xfe\xe3\xe9\xac\x14\x53\x2b\x96\x64\xb7\x40\x46\x1f\x08\x76\xc7\xe4\x74\x37\x5d\x5d\x3f\x8b\x83\x84\x96\xf6\x13\x6d\xa2\xc6\x5a\x2a\x34\x0b\x4c\xc9\x0c\x51\xdc\x77\xc7\xa5\x36\x1e\x5f\x58\x0e\x5f\xa9\x13\x9b\xe2\xfe\x08\x31\x47\xba\x7c\xc1\xb5\xfb\x63\x47\x65\x7f\x2f\xd2\xa0\x57\x29\x09\x89\xcd\xeb\x9b\x6b\x51\x6b\xf0\xbd\xd8\xf8\x75\x65\x1d\x99\x23\x2a\x33\x20\x56\x1a\xce\x0a\xd6\xa7\x3f\x82\xbb\x17\xf5\xbb\xe3\xac\xed\x82\x11\xd5\xb2\x93\xf6\x61\x1e\x0c\x10\x73\x36\x49\x46\xe3\x8c\xb4\x0d\x5f\x99\x11\xaa\x7c\x7c\xaa\xb1\x2d\xdc\x2f\x4d\x5c\xef\xa9\xf1\x1e\x8e\x66\x65\x29\x38\xd0\x5d\x2d\x0a\x54\x1e\x53\x77\x15\x00\xfa\xa3\x7e\x83\xdc\xd5\xa4\x6e\x6a\x77\xa9\x35\xba\xf6\x69\xec\x28\x0e\x52\x2b\xd1\xbc\xd9\x53\x47\x9e\xef\xca\x33\x6a\x19\x34\x37\xfd\x85\x92\xdb\x0a\xc2\x33\x54\x6a\x72\x7c\x40\x1f\xd1\x5b\x29\x97\xa3\xd8\x45\xef\xb5\xf9\xf7\x0e\x68\x86\x72\x04\x3e\x6b\x99\x39\x35\x5e\xf8\x6c\xb2\x9b\xa6\xb4\x2e\xda\xfb\x5b\x0a\x14\xcc\xd5\x68\x43\x44\x84\xb5\x3b\xa4\x2d\x12\x8a\xea\xec\x2e\x15\x87\xe8\xc2\xee\xbf\x2d\xb7\xed\xd2\x2f\x84\x79\xaa\x44\x31\xb6\xb3\xea\xf6\xa9\x34\x17\xbf\xe0\x94\x68\x96\xe7\x8f\x05\x74\x22\x2a\x42\xbc\xff\x3b\x8a\x0f\xb1\x65\x89\xf9\x7c\x5b\xed\x79\x1c\x99\xb4\x2f\x85\x96\x69\x14\x88\x13\xcf\x50\x8a\x13\xcb\x4a\x4a\xec\x19\xa7\x02\xfd\xd3\xa1\xfd\xb6\x5a\x24\x65\xe7\xe4\x5a\x19\xbc\xdd\x5d\x3f\xf0\xf9\xa1\xe9\x2b\x21\x6a\xa8\xb8\xa1\x04\xa5\x28\x42\x3e\x0a\x50\xd8\x04\x47\xa3\x72\x03\xb1\xd0\x0a\x4f\x67\x68\x83\xfb\x2e\xda\xe2\xb7\xa6\xc0\x65\xfc\xb6\x6d\xf1\x94\xf2\x12\x82\xa8\xf4\x64\x10\x10\x1a\xed\xe9\xc6\xf3\x96\x6e\xfc\x30\x72\x8e\x21\xca\x64\x79\xe1\xce\xcb\x0e\x9f\x53\x98\xe7\x95\xc3\x83\x05\x76\xc7\x8b\xd3\x6c\xbc\xa8\xdc\xe8\xd8\x8b\xc1\x08\xfe\x40\xf9\xcc\x2c\x30\xc6\xd9\x70\x64\x59\xf6\x1a\x87\x10\xad\xa6\x8b\x19\x9e\x2e\x7e\xa0\x03\x7b\x31\x19\x79\x2e\x44\xeb\x19\xea\x87\xd2\x8a\x76\x5c\xba\x58\x4b\x2d\x8b\x0c\x47\xa7\x5d\x23\x23\xe6\x6a\x6d\x2e\xf2\x61\x77\xb7\xc4\x39\x66\x21\x34\xe0\xcc\xfa\x85\x9f\x07\x14\xe1\x69\xdb\xe0\xe9\x6a\x5d\xb4\x2f\x75\xc4\xd1\xb4\x74\x0d\xe1\xa2\x74\x38\x82\x28\xa9\xbc\x46\x64\xc3\x11\x4a\x07\x23\xb8\xb7\x12\x17\xf7\x47\x18\xe3\xed\xc4\xf5\x8c\xaa\x56\xd3\x78\x36\x75\x67\x30\xcf\x9f\x00\x41\x97\x0e\xbd\xb2\xd9\x10\xad\xa6\xc9\x6c\x3a\x92\xa5\x7d\x1c\x4d\xdd\x99\x58\x0a\x7b\x80\xc8\xf3\x68\x3a\x7a\x2a\xd7\xa8\x92\x9f\xbe\x15\x07\x9a\x1b\x22\x56\xa0\x65\xd9\x1f\x5b\xd7\x94\xc6\xe0\xe9\x5b\x67\x93\x92\x9b\xb3\xaa\xc8\x0c\xbd\x7f\xaa\x84\x6f\xe6\xfe\xf0\x54\xee\x3a\x81\x40\x7c\xd6\x6b\x55\x18\x5d\x53\x99\x2c\xeb\xad\x13\x12\x21\x1a\xb8\x60\x64\x6d\xbf\xe7\xf3\x64\x94\xc0\xb2\xb3\x8d\x58\x5e\xea\xbb\xaa\xfd\x60\x2a\xd4\xee\x8c\x03\xcf\xdd\x88\xda\x1d\xdd\xf6\x2c\x7b\x5b\xfd\x08\x91\xcd\xf2\xdc\x87\x96\x65\x07\xad\x93\x7a\x43\x33\x3a\x8f\xb4\x42\x7a\x36\xf9\x86\x3c\xd2\x55\x93\x0d\xbd\xe9\xac\x15\x29\x1b\xd9\x35\x6a\x3e\x41\xde\xbc\xa7\xc8\x9f\x6c\xf5\x7f\xb1\x4c\xe5\x02\x64\xf2\x3a\xee\x92\xf9\x6c\x9b\xd9\x10\xd9\x67\x79\x2e\x21\xb4\x2c\xc6\xc7\x69\x83\x4d\x8f\x8f\xb4\xb2\xe5\x51\x04\x58\xb8\x1b\x91\x9f\x96\x45\x1c\x72\x43\xd2\xfb\x76\xe7\x6e\x84\x67\x9d\xb2\x59\x01\x0b\x3b\xf8\x96\xa1\x82\x72\xba\x04\x77\xb0\x85\x96\xb5\x51\x42\xf4\x1b\xb4\x44\x73\xbe\x33\xd6\x04\xe9\xb7\x4d\x3b\xfc\x5d\x23\x00\x74\x81\xe7\x9d\xc2\x76\x74\xf7\x2d\x18\x81\xae\x39\x73\x31\xaf\xcb\xe5\x2f\x90\x59\x2d\x1c\x0b\xe2\xaf\x36\x82\x7b\x7c\xa7\x59\xe3\xc5\xe9\x7d\xb5\x1f\x3c\x43\x97\xe8\x1d\xbe\x9b\x2e\x66\x63\x63\x3b\x56\x17\x95\x96\x65\x3f\xc3\xef\x9c\xc8\xd7\x55\x5a\x96\x7d\xb9\x97\xee\x2f\x06\x7b\x51\x61\x06\x2d\xeb\x52\x6b\x84\x5b\x56\xff\xb2\x6c\x8a\x33\xb2\xd7\x92\x41\x7d\x06\x91\x11\x2f\xae\xf3\x6e\x6b\x5a\xb5\xef\xc4\x7e\xd1\x06\xee\x75\x89\x02\xbf\xda\xd7\xa8\xca\x51\xde\xb4\xa2\xdd\x52\x3f\xc5\xea\x26\x6b\x3b\xb1\x6f\x30\xff\xd8\xdb\x85\x89\xdb\xb5\x26\xc4\x00\xec\x2d\x6b\x6e\x8a\x68\xb9\x9f\x9c\xbf\x09\x87\xa3\x27\xda\x0a\x9b\x9b\xec\xbf\x05\xd5\xa8\x06\x55\x0d\x31\x85\x28\xc2\x59\xfb\xe9\x92\xc6\x9c\x59\xb9\x19\x80\xcd\x1d\xe8\xce\xf3\x63\xc2\x58\xb2\xc6\x4b\x91\x0d\x7a\xf6\xde\xca\xdc\x27\xeb\x71\x61\x3d\x8b\x89\x03\xb7\x7a\xd2\x38\x71\x2d\xb9\x8b\x95\x9f\x49\x5e\xfd\x47\x5f\xe9\xe4\x9d\xd7\x97\x5a\xb2\x58\xe8\xeb\x36\xc1\x9a\x5b\xd6\xf9\x69\x1b\x77\x22\xfe\x89\x6c\xb5\xfa\x59\xc2\xfc\xe8\x17\x12\x6f\x45\xd2\x1b\x23\x45\x8e\xaf\x88\x86\x6d\xfd\xa2\xb1\x48\xc3\xfb\x1b\x13\x03\x57\xf2\xe1\xe7\x6f\xf6\xe7\x86\x8f\x6f\xab\x8b\xd5\x46\x3b\xae\x90\x7d\x7f\xea\xee\xfd\xa7\x27\x2a\x17\x7b\xf4\xbe\x0c\xf8\x53\xeb\x04\x7e\x47\x47\x39\xe9\xef\x82\x1f\x80\xa2\x90\xab\xbc\xb1\xbd\xd6\xf7\x5f\x63\x3d\x57\x62\x66\x71\xeb\x79\xc6\x37\x0e\x81\x02\x0f\xe8\x0a\xbb\xad\x6c\xb8\x79\x38\x9e\x5e\xcd\xf2\xdc\xbe\xc2\xa3\x56\x0a\x56\x3b\x46\xcb\x3d\x6f\x04\x4b\xd7\x33\x7d\x17\x42\xf4\xf0\x2d\x74\x7b\x7a\xc5\x77\x67\x93\x19\x68\x6d\x4d\xae\x5d\xd9\xeb\x26\xe7\xd3\x2c\x51\x5b\xe8\x57\xea\xf8\x45\xd5\xe0\x54\xed\x3c\x68\x17\x84\xe6\x86\xb5\x73\x45\x55\x44\x36\x45\x95\x02\x45\x95\x51\x5e\xc7\x89\xb5\xe5\x54\xc7\x60\x20\xef\xe2\x76\xe3\x51\x7d\xeb\x7d\xeb\xc4\x89\x3e\x65\x47\xea\x40\xac\x8f\x40\x88\xf1\x1e\xea\x74\xdc\x1f\x15\x10\x3d\x68\xbf\x87\xfa\x0e\x8e\xe3\x92\xbc\x07\x04\x0d\x21\x18\xd8\x05\xe7\x9b\xb3\x9b\xf7\x76\x6f\x6b\xb7\xc9\xe5\x45\x93\x12\xdc\x59\x16\x3f\x9c\x75\x1c\x9c\xc5\x4d\x5b\x39\xd2\x3b\xa6\x61\x74\x61\x13\xf8\xc8\x30\x53\xf7\x6b\x1d\xc7\x5b\x79\x39\x66\xf0\xd5\xca\xa4\xc0\xbc\x6b\x1f\x67\xc2\x6c\xad\x6e\xba\x25\x24\xae\x19\x61\xa2\xd3\x5d\x72\x20\x29\xae\x69\x2d\xaa\x25\x3d\xc0\x90\xfa\x70\xea\xda\xc7\xb8\x14\x14\xd5\xf4\x1c\xda\x2a\x91\x80\x87\x24\x0b\x48\x1c\xfa\x31\x03\x28\x73\xa8\xd0\x0d\x68\xdc\x19\xcb\x8c\x80\xc3\xd2\x91\x02\x61\x51\x20\x63\x89\xd4\x6e\xc0\x39\x4f\x42\x5a\xee\x70\xcb\x6a\xcd\xe1\xe2\x9c\x60\x8d\xcf\xea\x2a\xa5\x44\x98\x86\x26\xdb\xce\x4d\x69\x7f\x54\xca\x72\x1a\xa6\x22\xfd\xda\x45\x47\xa7\xc4\x53\xe4\x96\x6c\x6e\x9e\x77\x89\xdf\x8c\x4c\x9d\x8e\x3e\xb5\xa1\x28\x44\x04\xf7\xdd\xb1\x21\xe4\x34\x65\x34\x42\xe8\x98\xe1\xfe\xd3\x0d\x19\xfe\xf8\x84\x61\x17\xfc\x86\x22\x86\xc1\xe8\x3c\x93\xe6\xc1\x5a\xa0\xdf\x85\x81\x66\x71\xa1\xbb\x88\xc5\xc5\x70\x79\xec\x7f\x30\x34\x9a\x59\xe3\xc8\x08\x95\x0e\x50\xa9\x7d\x0a\xb5\x9b\x77\x2d\xb1\x2b\xef\x8a\x1b\xee\xd6\xe0\xb8\x9f\x59\x56\x99\x6d\x3f\x58\x82\x6a\xe6\x39\x53\x76\x98\x3f\x0a\x6b\x86\x6f\x98\x2a\xc4\x6a\xfa\x30\x62\xd5\xd4\x7b\x80\xdd\xca\xf4\xac\x40\xa5\xc6\x59\x13\xcd\x36\xf8\xc0\x8b\x13\x66\x4f\xe5\xa5\xf1\x0c\xca\x90\xb8\xb5\xa9\xdd\x23\xcf\xe0\x01\xda\xe2\xe9\x0c\x2d\xb0\x8b\x4a\x99\xb9\x89\xc1\x36\x9c\x8c\x3c\x77\x5c\xbf\x5d\x36\x94\x42\xf9\x99\x6a\x80\x81\x68\xc0\xd3\xd2\x64\xa8\xee\xcc\x77\xf1\xa9\xc5\xb3\x9e\xd2\x8b\x7d\xd3\xfb\x01\x0c\x36\x86\x9b\x84\x75\x69\x40\x88\xb7\xd3\xad\xe2\xc8\x87\xa3\xd9\x98\x59\xd6\xae\x90\x5d\x6b\xb2\x5f\xbc\xcd\x73\x22\x3f\x60\x9e\xdb\x36\xc1\x44\x6e\x65\xf2\xce\xac\xb4\x27\xd8\xca\x43\x02\x81\xb0\x68\xba\x19\xa7\x0b\xdb\x16\x64\x96\x17\x53\x05\xd4\x78\x09\xcb\xdb\x65\x8d\x6c\xa9\x6b\x32\x59\x2d\x14\x22\x75\xf1\x0d\xd7\xf6\xa3\x00\xc3\x53\x70\x15\xd2\xa4\x52\xa9\x5d\x6e\x2b\x0f\xa7\x44\x31\x2d\x41\x26\x34\xa0\x51\x8c\xb3\xc9\xa5\x9d\x41\x0f\x00\x94\x60\xdb\xb0\x27\x10\x16\x40\x03\xd9\x51\x71\x49\x20\x36\x17\x11\x3b\x56\xad\x08\xb5\x03\xbe\xb6\x7b\x60\x90\x70\x70\xe4\xa4\x89\x8c\x38\xe1\x1b\xf5\xda\xe6\xd1\x34\x8e\x68\x4c\xa4\xc1\x16\x47\x3c\x61\xdb\x4d\x4c\x43\x45\xc4\xf4\x09\xab\xbd\xcf\x2a\x51\xf4\x59\xba\xf9\x6b\xcf\xa7\x5c\x00\xf2\x6c\xca\xf0\xb1\x3d\x9f\x76\x1a\x28\xa0\x0b\x92\xb8\x3d\x97\x70\x16\xc0\xc9\xb3\x92\x0c\x63\x2a\x6f\x31\xc4\x2d\x65\x09\x31\x5f\x7c\xc2\xc4\x92\xa9\x79\x2f\x89\x8a\x5c\x9c\xa2\x94\x8c\xc3\x04\x55\x8b\x0c\xf7\xfb\x95\xdb\x52\xe3\x7a\x04\x33\xc3\xc5\x28\xcf\xa3\x43\x1a\x8f\x98\x29\x08\xa6\x35\xab\xcc\x29\x99\xa1\x8c\xff\x0c\x85\x2c\x91\x4d\xc9\x60\x34\x43\x09\xa6\x2d\x6b\x41\x81\xc9\xd7\x01\x5d\xd8\x89\xc2\x11\xe5\x9b\x1a\x45\xc8\xc7\x8f\x5a\x29\xf8\xd2\xa6\x8e\xb8\x22\xaa\x1c\x19\xd0\xfd\xc3\xca\x87\xae\x3d\x8f\x1a\x54\x3e\x54\x3b\x17\x50\xa8\x86\x6b\x9e\xb8\x97\xa4\x0d\x94\x2c\xc6\x8b\xc1\x00\x65\x96\x55\xe2\xfc\xa2\x80\xc8\x97\x18\x89\x17\x7a\x90\xfc\xca\x8c\x24\xc0\x2e\x0a\xb1\xee\xe0\x38\x38\x0d\xc7\x81\x96\x25\xac\x70\x32\x0d\x66\x63\x4e\x6c\xa5\xcd\x84\x9d\xe2\x6a\xfd\xc3\x41\x08\xd1\x8d\xbd\x42\x8f\x52\xc9\x45\x60\x81\x97\xa2\xc8\xcf\x98\xfc\x8e\x90\x84\x41\xb5\xdf\xe8\x80\x5f\x5f\x3c\x48\xcf\xa4\x47\xcb\x49\x2d\x60\x11\xd7\xfb\x52\x14\x95\x0b\xfd\xd2\x12\x88\x9c\x66\xd5\xfd\xa0\x98\xd8\xd9\xb8\x72\x5c\xdb\xc7\x38\xd6\xde\x6a\x27\x37\x76\x8c\x1e\x0b\xe8\x49\xcc\x28\xf6\xf0\x6f\x4f\x5c\x33\xe2\xad\x22\xfb\x42\xdf\xbb\x41\xf6\xb3\x9a\x32\x77\x7b\xfd\xc2\x9b\x30\x4a\xb0\x5b\x11\x5a\x66\x7a\xa3\x74\xc7\xd9\x2d\x15\x86\x84\x62\xe5\xc0\xc7\xc0\xcf\x48\x49\x36\x3d\x86\x7f\x76\x22\x6a\xf7\x47\x95\xb5\x16\x52\xe4\x76\xa2\x7e\x07\x3c\x37\x50\x0e\x98\xe1\x58\x4a\xfd\x45\x2d\x0a\xbb\x3d\x5b\xd5\xf2\xb3\xe3\xdb\x3f\x2b\xbb\x70\x3f\x8a\xec\x0c\x09\xf3\x1a\x83\x62\x21\x62\x12\x2a\x88\x00\xd0\xad\x41\x58\x63\xd0\x6c\x73\xeb\x74\x68\x88\x4d\x5e\x7d\x28\x4c\xbd\x39\x6e\x34\xc1\x31\x11\xdd\x2b\xa1\x12\x61\x03\xa4\xca\x1c\x4d\x19\x91\x0d\x48\x03\x89\x34\x50\x45\xac\xc9\x01\x2a\x8d\xd9\x2d\xcb\xa6\x83\xca\xb6\x5d\xaf\x69\x64\xda\x8e\x8b\x0c\xda\x76\xbc\xca\xc0\xd7\xa5\x4c\x1d\x41\x94\x9c\x52\x41\xdc\x29\x6a\x63\x23\x6e\x69\x48\x32\x25\xfe\xc4\xf1\x34\xae\xf6\x4b\x58\xf4\x77\x95\xf1\x2c\xab\x6f\x2a\xec\x19\x7e\x3a\x0c\x36\x2e\xcf\x6d\x33\xe1\x5b\x4c\xea\x4d\xae\x56\x9a\x5a\x0c\x40\x4f\x54\x31\x5c\xfb\xe9\x35\x40\x5f\x1d\xbf\x26\x80\x31\x1a\x30\x1e\xd7\xa0\xe5\x9b\x14\xca\x67\x2c\x95\xce\x62\x99\x4d\xa6\xe9\xac\x73\x01\x69\x71\xf2\x9e\x45\x54\x66\x89\x0b\xb4\xa0\x31\x5f\x47\x5d\x4e\x80\x8d\x63\xab\x54\x73\x97\x0a\xf1\xbd\x37\xbd\x88\x02\xf1\x00\x42\xdc\xc6\xe2\x2b\xbd\x46\x44\x77\x99\xe9\xac\x9d\xc3\x92\x8c\x25\xad\xb3\x7a\x28\xc6\x9f\x6c\x8a\x3a\x19\x2f\x88\x92\xd2\x95\x36\xd2\x1a\xfc\xa5\x7a\x2a\x8a\x70\x5a\xdf\x5f\x6c\xe0\x74\x6b\xbd\x40\xe4\x37\xcf\x09\xa5\x77\x9a\xba\x16\x75\xd3\x26\x09\xa2\x00\x1b\x5e\x0b\xea\x32\xe8\x50\x1d\x77\xd2\x16\xaf\xf8\xf3\x6c\x68\xe8\xfb\xf1\x9d\xb9\x54\x04\xed\x27\x5e\xff\xca\xa6\x28\x86\x4a\x80\xc0\xfc\xb9\x18\x13\x1b\xa2\x2e\xb5\xa5\x5d\xd3\x26\x18\x60\x93\xb8\x88\x39\x79\x14\xfb\xe4\xee\xe9\xab\x40\x7d\x17\x56\x17\xd7\x23\x8c\xb1\xdd\xd0\x3b\x1b\xee\xa8\x0e\xec\x36\x59\x4a\x72\x80\x30\x95\xe3\xc7\x8b\xd1\x69\x62\x59\xf6\xe8\xd4\x26\x4f\x16\x56\xc6\xd7\x6f\x00\x84\xa5\xfc\x39\x79\x43\xa6\xa3\x59\x9e\x4b\x9f\x2f\x3a\xf6\xf0\x14\x27\x10\x0a\x4e\xb5\x6f\x38\x61\x5e\x61\x77\xbc\xe2\xed\xad\x4e\x8f\xdd\xf1\x4a\x6f\x4d\x1b\x1c\x4f\x57\x33\xb4\xdd\xb7\x2d\x4c\x37\x9a\xa1\xe2\x67\x81\xc7\x62\xdc\xe8\xbd\x7b\xba\xb2\xac\xa0\xb6\x72\xfd\x86\x93\x0b\xb4\x91\x63\x39\x59\x48\x66\x52\x05\xbd\xad\x65\xd9\xdb\x4a\x58\xcf\x6a\xb4\x48\xf1\x9a\x13\x7b\x51\x32\x9b\x65\x5e\xd3\xfb\xb3\x90\xdf\x7b\x76\xbd\xf0\x85\x24\x8f\x0b\xc9\x2e\x6e\xa5\xcf\x10\x54\xcf\x73\xa9\x69\x6c\x9f\x19\xdd\xd9\x1a\xb4\x77\x51\xb2\xa5\x9c\x80\x95\x29\x10\x55\xfd\xa8\x98\x62\xc5\x40\x43\x88\xea\x83\xb1\x83\x69\x0b\x8e\x51\xb0\x78\xf1\x9a\xcf\x47\x3d\x6f\xa7\x1b\x28\xc7\x71\x80\x7e\xe2\x43\xdd\xb2\x7f\xf3\x39\xce\x48\xa9\x1f\x59\x66\xf0\x60\xef\xe9\x6d\xdd\x79\x7a\xbb\xf9\xae\xd3\x9b\x66\x5d\xd7\x03\x80\x7a\x7a\x77\x14\xc1\x2a\x49\xe3\x35\x5a\xe2\x96\x37\x8b\x1a\x56\x2b\x0d\x5b\xd3\xc9\xfe\x64\x3b\x41\x37\xd0\xdb\x9f\x67\xdc\x49\x11\x96\xa5\xbb\x3e\x20\x2c\x5d\x13\xd3\x6d\xa1\x91\x36\x2a\x00\xba\x31\xd3\x04\xe1\x28\x6a\x0f\x64\xfc\x6f\x5d\x80\xa0\x60\xd7\x6b\x4f\x9e\xdb\x9d\xb0\x57\x9e\x9c\x76\xda\x99\xec\x46\xd5\x87\xa8\x69\x1e\x2c\xba\x03\x51\xaa\xc0\x0c\x6a\xa8\x5f\xf7\xb6\xfe\xc3\x9b\x89\x74\xb7\x5e\x9e\x29\x6b\xe4\xad\xbc\x4b\x0b\x2d\xeb\x57\x7b\x1a\xcc\x8c\x75\x59\x5d\xa3\xb1\xd6\x6b\xb4\xc8\xf0\x09\x04\x00\x8a\x6a\xab\x27\x28\xfd\xe9\x55\x0a\xfe\x95\xc4\xb5\xcb\xe0\x6f\x9e\x1d\x0d\x57\x7e\x36\xf4\xc3\x50\xc8\xbc\xc4\xf2\x9a\x77\xef\x91\x52\xf3\x14\x40\x74\x8b\xa3\x86\x2b\xae\x5b\x53\x6a\x55\xcf\x8e\xe6\x93\xb4\x7e\x97\x7a\x8b\xe6\xd0\x4b\x1b\x57\x4e\x45\x7d\xfa\x4b\xe1\x79\xcd\x46\x04\x69\x6b\xaf\x76\x0f\x5a\x3b\x1b\xfe\xae\x95\x20\x8f\x4d\xf0\xae\x52\xb0\x9a\xb0\x71\x1b\x12\xca\xc7\xf7\xe0\xee\xc0\x56\x6f\x3f\xed\x29\x56\xa1\x88\xb9\xcb\xe4\xd2\xda\x6a\x18\x92\x1b\x1a\x90\xbc\x69\x01\x98\x0b\x93\x19\x9f\x91\x3f\xa7\xce\x0f\x7f\xce\x9e\x2f\xa9\x74\xe2\x6f\xcc\xb2\x1d\xef\x9a\x0c\x1d\x01\x88\x4c\x99\x63\xeb\xb4\x37\xcd\x3b\x62\x71\x0d\x44\x93\x6d\xa6\xba\x75\x49\xe7\x11\x8d\x97\x79\x1e\x3b\x31\xb9\x63\xf5\xd8\x7f\xa3\xc4\x53\x50\x68\x04\xb4\xac\xb6\x2e\xd5\x50\x14\x51\x4c\x26\x7a\xb2\xbc\x04\x01\x3f\x0c\x39\xb1\x9c\xec\xba\x96\x6b\xf1\xd4\x46\x4d\x4f\x6d\x1e\x90\x92\xea\xb6\xe2\x32\xe5\xc9\x1a\xec\xe4\x1b\x8b\x25\xbb\x2e\xe2\xbe\x0b\x56\x58\x20\xad\x98\xe6\x35\x9e\x4c\x23\x79\xbe\xab\xc0\x99\x09\x2a\xd3\xaf\x3d\x6c\x67\x6a\xbb\xc2\x9a\x10\x65\xf7\x79\x03\x2d\x0e\xe7\x87\x56\xb8\xe7\x5d\x2c\x99\x9e\x3d\x91\xde\x2d\x6e\xdf\x46\x80\xb3\xec\x7b\x7d\x45\xa6\xfb\x93\xa3\xfd\xc9\x3b\x2c\x7c\xcd\x4f\x65\xd0\x99\xaa\x9c\x65\x86\xb8\xd5\x8a\xc9\x3d\x35\xcc\x30\xd5\x39\x08\x34\x2d\x99\xf4\x20\x4f\xbe\x25\xaf\xa4\xd1\x06\x49\x95\x66\xec\xab\x2e\x3b\xa1\xc9\xde\x21\x97\x85\x37\xb8\xd3\x12\x67\x87\x93\xdd\x85\xb1\x6e\x9c\xb3\xa7\x33\x8d\x8c\x1d\x3d\xd9\xe2\x4e\x23\x95\x6f\x03\xc6\xb0\x5b\xd9\x0f\x8c\x99\xb1\x03\x98\x45\x83\xd9\x90\x85\xf5\xe5\x8f\x78\x78\x64\x51\x5f\x31\xa6\x12\x42\xdb\xa6\x62\xde\xff\x73\x66\xa5\xe9\xf4\xb3\xdb\xcd\x68\x0f\x0c\xec\xc5\x64\xe1\x18\x0e\x80\x84\xb8\xb7\x21\x59\x28\x2f\xf3\x9b\xd4\x9d\x67\x11\x62\x06\x79\x9d\x8a\x98\xda\xd0\x6e\xa5\xae\x02\x92\x1e\x2f\x9a\x47\xc3\x5b\xa5\x87\x40\x77\x94\x1b\xca\x37\xd9\x44\xdb\x86\xe5\x85\xaa\xdf\xf4\xec\x09\xb4\xe5\x83\x4a\x8b\xdb\x4a\x3e\x69\x1d\x81\x92\x5a\x31\x2d\x42\x4b\x5b\x87\x4c\xbb\x2c\xfa\xc6\xe3\xc3\x9f\xdb\x43\xd7\x9d\x73\xaa\xeb\x37\x39\xa6\x3a\x0b\xe5\x73\x0e\xaf\x96\xa3\x8e\x38\x9d\x56\x00\xa6\x90\x89\xef\x60\x35\xf7\x85\x4f\x17\x69\xb6\x52\xaf\x20\x6a\x46\x24\xcd\x88\x14\x22\x61\x85\x5b\x1b\x0d\x88\x56\x5a\x25\xb9\xd3\xd9\xaf\x74\x4f\x30\x5e\x35\xee\x1b\x2b\x43\x47\xb4\xae\x31\x75\xa6\x65\x23\x5a\xd5\xda\x5b\x8b\x77\x30\x8d\x88\x15\x2c\x36\x4d\xa0\x36\xb0\xe1\xdb\x31\x6e\x96\xca\x20\xda\x36\x4b\x6d\xf9\x52\x68\x78\xa0\x2c\xbb\x34\x4f\xc2\xfb\x86\xbb\xc8\xb1\xd2\x1e\xc4\x91\x5a\x91\xca\xaa\x63\x8e\xd3\x49\x5a\x8b\xf2\x5c\x74\x8b\xc3\x49\xd8\x8c\xbc\xc0\xab\xc9\xaa\x19\x79\x87\x37\x93\x4d\x33\xf2\x1a\x6f\x27\xdb\x66\xe4\x3d\x7e\xb0\x13\xe8\x24\x5b\xa6\xf5\xb0\xf9\xbc\xa2\x67\xb8\xdf\x97\x7a\x17\xce\x92\xb0\xf3\x64\xbd\xd9\x32\x12\x0a\x5e\xd6\xb2\x3a\x12\x78\x5f\x2f\x31\xad\x91\x96\x77\xf8\xd9\x84\x93\x30\xef\x81\xa7\x9e\xe3\xc7\x1b\x92\x32\xef\x83\xfd\x6c\xf2\xcc\xd9\x48\xb7\x29\x57\xc9\xc6\x7b\xe7\x04\x59\x66\x83\x2a\x06\x40\x38\xa8\xe5\x92\x7a\x60\x8d\x8c\x32\xb2\xca\x3b\x4f\xd2\x90\xa4\x57\xc9\x46\x34\xae\x33\xd7\x63\x9b\xb9\x65\x1d\x2d\x05\x8c\x04\xbe\x1e\x57\x49\x4a\x1f\xea\x90\x7f\x20\x0b\xd6\x80\x88\x47\xed\xc0\x2e\xdd\xc5\xd4\x33\x8a\xb8\x26\x2c\xbc\x74\x0b\x24\x65\x74\x33\xbf\xa8\xa4\xa5\x40\x15\x2f\xf4\x19\x3e\xa9\x61\x3f\x77\xf8\x8f\xaa\xa1\xd4\xbe\xd3\x45\xcb\x88\xaa\x15\x53\x05\xaf\x9e\xad\x1a\xf9\x43\x35\x32\xe7\x8e\xf8\xad\x95\x34\x07\xa8\x8a\x69\xd6\x5f\x1b\x1e\x23\x4a\xd4\x5e\x8c\xf5\x89\x27\xb9\x21\xe9\x22\x4a\x6e\xff\xc0\x4a\xc9\x08\xa0\x9b\x3a\xb6\x0d\x2f\x1b\x6b\xcd\x7c\xe9\x57\xfb\x63\xd9\x31\x9d\xc0\x4b\xf4\xb4\x1d\x16\x9e\x37\x32\xad\xcc\xc4\x5b\xd4\xdc\x7c\x39\x51\x52\x89\x17\x8d\x44\xc5\x7c\xa8\xd4\xbb\x66\xe3\x25\xa3\xa1\x32\x5c\x37\x33\x98\xb6\x66\xf8\xbe\x91\xca\x77\x5d\xed\x35\xf4\xbc\x25\xed\xdd\x1d\x4b\xfd\x0c\x7f\x6a\x49\x92\xfb\xe9\x65\x4b\x4a\x53\xdb\xef\x72\xa8\x26\xbb\x91\xb7\xae\x12\x89\x3b\x9a\x68\x8e\x95\x56\xd2\x94\x85\x6e\x76\x86\x92\xef\x46\xef\x0d\x13\xb8\x56\x46\x46\x1b\xc3\xb5\xda\x3c\x15\x05\x5a\x12\x25\x45\xd2\x29\x6d\x22\xff\x52\xd5\xac\x94\xfb\x9b\x4e\x45\x44\x3b\x36\x3f\x3e\x3c\xd8\x0d\x19\x95\x72\xce\x02\xc7\xed\xf1\xfc\xfc\xa4\xc5\xbe\xfd\x4c\x38\x08\xe4\xe8\x09\xe0\xc4\xb6\x09\xce\xca\xaa\xa1\xc3\x92\xcd\x00\x97\xaf\xd0\x65\x1d\xf4\x0b\x11\x27\x22\x0b\xd6\x95\xd3\x24\x17\xd0\x23\xf8\x91\x25\x1b\xcf\x45\xbc\x88\xe7\xca\x97\xdc\xe2\x7d\xde\x69\xdb\x26\xe0\x93\x00\xf1\x2a\xd9\x60\xca\x81\x1c\x12\xf1\x9f\x55\x8a\x6f\x76\x73\x79\x99\xe5\x7e\x4c\x18\xd6\xc6\x7d\x36\x1c\xee\x6d\xa0\x35\x55\x4e\xaf\x6a\x35\x9e\x1e\xce\xf6\x34\xc6\xbb\x2f\x0c\x00\x16\xbc\x80\xf8\xd1\x70\xf2\xa4\xbd\x80\x7e\x96\x68\x26\xd9\xcd\xbd\x90\x7e\x10\xf5\x76\xb1\xdc\xba\xe1\x78\x3a\xda\x07\x2a\xef\x2e\x8e\x2b\xdf\x40\x9d\x0d\xe1\x78\x7a\x24\x9c\xd5\x31\xbe\xbc\x2e\xe9\x43\xfd\xcd\x6e\x51\x7a\x07\xc5\x6d\xfd\x54\x74\xc3\x92\x0d\x77\x82\xad\xcf\x8f\xbb\x56\xa6\xcd\xd5\x6c\x92\x3f\x7d\x70\x6b\x25\x7f\xe5\x95\x45\x2b\xf9\xd3\x27\x9b\x4e\xf2\x87\xd6\xfb\x4c\x6d\xd1\x4d\x0b\x91\x51\x68\x8c\x96\xd8\xdd\x51\xd3\xab\x1c\xda\x09\xa5\x84\xf0\x87\xa7\x2f\x19\xd5\xca\x1d\xdc\x88\xcd\x13\xf9\xad\xa3\xb7\x1f\x7b\xcb\x45\xf0\xa6\x8b\x20\x8b\xba\x2d\x2b\x1a\xec\xcd\x30\x38\x76\xdf\xec\xad\xdf\x70\xc3\xd5\x65\x64\x2a\x7c\x2c\xec\xe6\x90\x03\xd3\xe2\x4b\xc9\x7c\xa5\xfd\x8b\x23\x1f\x24\x41\x7e\xdb\xe9\x42\x56\x81\x7d\xd8\x71\x84\x13\x6a\xa7\x31\x3e\xda\x63\xb5\xdd\x18\xf3\xc9\xd1\x0f\xed\xe8\xb8\x7f\x98\x86\x87\x9e\xab\x29\x78\xd7\x48\x0d\xf7\xd6\x80\x32\x1c\x0f\x56\x83\xcd\x60\x3b\x58\xa0\xb4\x32\x1d\x8c\x87\x0a\x0b\x5a\xdc\xca\x99\x7a\xc2\x72\x98\xa4\x2f\x9a\xef\x41\x97\x06\x18\x10\x31\x6c\x27\x98\xc2\xe1\x6a\xb8\x19\x6e\x87\x0b\xd5\x7c\x79\xc9\xd9\x22\x44\x93\x63\xdf\x6f\x93\xaf\x7d\xf3\xb8\xbf\xd9\x9d\xb7\xf2\x7e\x72\x8e\xdd\xf1\xfc\x74\x27\xc3\x78\x3e\x18\xc0\x9a\x46\xdf\x5e\x15\x98\xe9\x7c\xa6\x34\xfe\x96\x83\xc1\x98\x61\x9b\xea\xb5\x68\x56\x3a\x58\xfe\xb0\x56\xeb\x0e\xea\x91\x4f\x30\x2d\x67\x26\xc3\x29\x06\xa0\x30\xa4\x2a\x7c\x17\x7c\x04\x6b\xff\x6e\x28\x37\x1b\xe0\x25\xd2\x4e\x45\x33\x8f\x9e\x76\xd2\x85\xc0\x9a\xc6\x65\xae\x4c\xe4\x2a\x76\x75\xdd\x77\x2b\x64\xb2\x42\xa0\x6b\x1c\xde\x03\xed\xa5\xb3\x56\x65\x5a\xab\xb2\xcb\xe8\xb5\xc2\x2d\x86\x5a\x2d\xf7\x5b\x1c\x1a\xec\x9b\xc9\x4a\x7d\xfc\xa9\x5a\x4c\x53\x9f\x16\xba\x54\x37\xcc\x6d\x10\x7f\xc3\xb4\x06\xf7\xdd\x7f\x8b\x0b\x1c\xec\xe3\x02\xbb\x48\xc8\x7e\x9f\x82\x0d\x5a\x55\xba\x13\x7c\x62\x73\xdd\x43\x52\xcd\x26\xba\x33\x9c\xee\xeb\xff\x30\x50\xb3\xaa\x61\x57\xd5\x95\x4e\xe2\xbf\x6e\x92\xcd\x86\xf3\x88\xad\xd1\xce\x76\x13\xfa\x8c\xd8\xea\x2e\xa9\xb9\xf1\x6b\x1a\xa0\x09\x63\xf9\xda\x43\x53\x02\xdc\x58\x23\xfa\x14\x3a\x64\xc9\x06\x70\x6a\xd6\x2e\x93\x37\xdd\x66\x20\x5a\xf1\xc7\x63\xcd\x67\x6b\x6e\xa4\xdb\xbb\xa2\x6e\xba\x94\xd3\x48\x33\x0e\x21\xce\x31\x6b\xe8\x6d\x94\x17\x6a\xf9\x86\xb5\x99\xa4\x4c\x39\xbe\xab\x08\x6a\xd1\x07\xaa\x03\x5c\xec\xdb\xa7\x26\xf4\x5b\xac\x4d\x4c\x58\xd4\x91\xf4\xe9\x9c\xdf\x64\x98\xf2\xef\xd5\xfc\x2d\x9d\xf6\xfe\x8f\xed\x19\x96\xf5\xff\xe7\x20\x4a\xaa\x5f\x19\xf0\xd8\xfd\x11\xea\xbb\xc2\x73\xa2\x16\x07\xb4\xfb\xc9\x1d\xef\x11\x30\x4f\x52\xf2\xaf\x2d\xc9\xd8\x59\x4c\xd7\xc2\xe5\xcd\x4f\xa9\xbf\x26\x75\xa7\x7b\xc6\x22\xe2\xe4\x5e\x94\x13\x8f\x2a\xec\xb8\x3d\x2c\xdd\xef\xd5\x7d\x02\x96\x6b\x95\x17\xa8\xcd\xce\xb8\xf4\xc7\x69\xfa\x7d\x8d\x92\x98\xd8\x50\xc9\x0d\xaf\x12\x7d\x76\x14\x6e\x3a\x05\x18\x1a\x04\xd1\x2f\xd8\xe2\x57\x56\x4a\xf7\x94\x63\xd1\xb1\x7e\x40\x43\xb6\xdf\x76\x5f\x50\xee\x49\xb4\x8b\xaa\x21\x26\x7a\x6b\x82\x69\x40\xd2\x5e\xad\xb4\xf3\x2b\x38\x0e\x82\x05\x65\x1d\x33\x60\x37\x29\x95\x39\xc8\xa0\x85\xed\xaa\x0d\x01\x80\xd5\x1d\x37\x6f\x44\x95\x84\x0d\xb4\xff\x0f\x34\xb5\x5b\x1f\x84\xde\xff\x16\xf8\x7d\x5c\xa5\xd9\x1d\xcb\x12\x23\xd8\x24\xd9\xea\x92\xa4\x4d\x4a\xb2\x6b\xef\x64\x54\xa7\xbd\xa0\xb7\xc9\x46\x64\x65\xf3\xec\x5c\x0b\x33\xf0\x83\xdd\x74\xd1\x56\x0a\x3a\x40\xef\xf9\x9b\x03\xc3\xfc\x83\x54\xe6\x1f\x8f\x05\xa2\x38\x6d\x7a\x28\xcb\xf3\x7e\xbf\xfb\xcd\x14\xf5\xac\x91\x65\x3d\x99\x45\xd7\x37\x4e\x6b\xb0\x56\xc8\x40\xba\x94\x1c\xcc\x7b\x82\xbc\x1c\x13\xad\xc0\xd0\x7e\xfc\x21\x2d\xbc\x3e\x14\x46\x20\xa5\x90\x28\x32\xa5\x3c\x71\x43\x04\xe3\xd9\x76\x5c\x0a\xfc\x77\xc5\x3e\x51\x87\xd8\x67\x18\xd5\xc4\x2d\x71\x53\x08\x14\x75\x09\x81\xca\x82\x52\xfe\x01\x51\x82\xdb\xba\x30\x71\x3d\xb2\x23\x51\xb3\x4b\x15\x8e\x3c\x37\xf4\x36\xa9\x7c\x76\x82\x25\x1b\x9c\x49\xa1\x8c\xe8\x44\x82\x98\x80\x0a\x67\x4a\xfe\x21\x7e\x20\x52\x12\x15\x4c\x9a\x57\x8f\x8d\xf9\xe2\x1d\x60\xf2\x15\x59\x25\xb2\x90\xbc\x47\xf4\x94\xcc\xcd\xf4\xc4\x2a\xfc\x3e\x9b\x8e\x73\x05\xdb\x3b\xf4\x37\xb4\xbe\x43\x3a\x34\xab\x5c\x46\xe7\xb9\x4d\xec\xb4\xee\xbf\xbb\x89\x4c\x9a\x02\xa7\x2d\x60\x34\x30\x45\x5c\x2f\xf6\xd3\x12\xae\xa6\x11\xa9\xa6\xe7\xbc\x49\x4e\x2e\x20\xfc\x4e\x53\xd7\x7d\x7b\xe5\x37\x70\x18\xfb\x8a\xd7\x07\x69\xbf\x29\x6c\x73\xcc\x8a\xa6\xdf\xf4\x0e\x5f\xd4\x69\xcd\x27\xb4\x3a\x33\x21\x1d\xab\x25\x85\x3b\x53\x10\x12\xe6\x07\x2b\x5b\x58\xd9\x36\x3c\x85\xec\xbe\x59\x27\xb7\x7b\xce\x22\x9b\x26\xc5\xa5\xa5\xd0\x1e\xd3\x6c\xcb\xfa\x86\x4c\x5a\x27\xa5\xf9\xc6\xcc\xb7\x16\x94\xaf\xd0\x68\x7b\x94\xee\xd3\x1c\x1d\xb4\xd5\x59\xf9\xd1\x40\x31\xd6\xeb\x62\x1c\x5b\x96\xdd\xd7\x7e\xd3\x39\x73\x51\x22\x78\xa6\xec\xc0\xb3\xd2\x42\x46\x18\x73\x91\x52\xb9\xb2\xcc\x11\x97\x7a\xcd\xc2\xfa\xd6\xc8\xd2\xf9\x0e\xe4\x2e\x97\x58\x77\xfb\x43\x4a\x25\xb6\x0e\xcd\x65\x32\x43\x29\xae\x2b\x41\x9a\x96\xfd\xd1\x4e\x14\xc6\x98\xe4\x39\x6b\x5a\xdc\x5a\x56\x3f\x1d\x27\x95\x29\x98\x90\xe4\x18\x56\x25\x88\xd5\xdd\xdd\x69\x93\x4c\x02\x51\xdc\xa2\xdf\x5e\x9a\x8e\x72\x7e\x27\x9a\x18\xf6\xcf\xc2\x8e\x3e\x46\x49\xb7\xb3\x3d\xd3\x6e\x1f\x2b\x6f\x6c\x66\x07\x88\xe2\x49\x4c\x07\x00\x31\x44\x59\xf5\x18\x69\x37\x28\x6c\xd2\x61\xf8\xad\xf3\xf4\x5d\x55\x7b\xa9\x8f\xff\x54\x81\x11\xf4\xf4\x63\x6b\x9d\xb9\x20\x44\x51\x9e\xa7\x79\xde\x67\x79\x5e\xb7\xb9\x6d\x78\x66\xc8\xf3\x52\xf2\xd5\x8d\x15\x6d\x05\x67\x68\x67\x50\xa8\x32\xc9\x2e\x9f\x30\xd9\xa3\x4b\xb9\x17\x07\xc7\xfb\x31\xd0\x30\x14\x44\x14\x67\x26\xd6\xb4\x4c\xc8\x17\x47\x3f\xe7\x2c\x78\x61\xcb\xb2\x1b\x6f\xf5\xd2\xa7\xca\xb0\x89\x4d\xdb\x26\xa5\x7a\x1c\x9a\xa9\x13\x82\x91\x43\x3b\xf9\x04\x68\x38\xe2\x0c\x27\xed\x98\xb3\xb2\x92\x7d\x55\xb8\x42\x53\xaf\xda\x05\x3b\x8d\x68\x0c\xed\xf1\xd2\xbe\x0e\xd5\xde\x5c\xd8\x61\x19\xcd\xbd\x75\xb2\xf3\x36\x46\xdb\xf3\x0f\x7a\x6c\xea\xef\x66\x54\x2c\x9c\x91\x43\x6b\x5b\x9b\x83\xa1\xe2\x1a\x43\xd8\x77\x2b\xb6\xbc\xcd\xef\x7b\xa9\xe0\x69\xd4\xae\x49\xc4\x53\x2c\x74\x27\xc8\xea\xda\xb9\x03\xea\x26\x84\x23\x08\xd1\x70\xd4\xc7\xf5\x5e\xd7\x3b\x08\x95\xbf\x88\x86\x69\xfb\x6e\x7a\x0d\x82\xb3\x46\x25\xb0\x40\xda\x0a\x67\x97\xc5\xef\xaa\xb9\xdf\xb4\xaa\x6f\x82\x66\x59\xc3\xd7\x27\xdf\x90\x0b\x0c\x5f\x9f\x80\x6f\xc8\x67\xef\x85\xa7\xf9\x2c\x4b\xb3\x8b\x68\xdf\x30\xa2\xfd\x5d\x85\x3b\x8f\xbe\x34\xd1\xec\xf5\x09\x2c\x50\xed\x5d\x91\xa6\x90\xe1\x5c\x32\xac\x0c\x3f\x94\x4a\x5e\xb5\x03\x11\x7c\x3c\x6f\x53\x8f\x3c\x37\x25\x64\x4d\x4f\x2c\xde\xf9\x5e\x3f\x2d\x86\xcd\x32\xaf\xbd\x12\x34\x9e\xb7\x48\x19\x77\xe3\x1c\xce\xd5\x13\x87\x66\xe7\x42\x98\x12\x4e\x88\x0d\xbd\x76\x41\x08\x85\x45\xe9\x55\x61\xe3\x07\xca\xec\x40\xe0\x30\xda\x61\xc3\xaf\xc9\xfd\x76\x63\x7a\x7f\x81\x8f\xcf\xed\xa3\x43\xf8\xdc\x61\x24\x63\x36\x71\xae\xc9\xfd\x79\x12\x92\xca\x14\x63\xe4\x42\xc8\xf9\x96\xdd\x16\xa0\x70\xf5\xc1\xf7\x0a\x12\x33\x75\xe6\x13\x26\xf0\xed\xc0\x54\x6c\x68\xfd\x55\x90\xdd\x27\x39\x4c\x9e\xf4\xe8\xb4\x24\xde\x7d\x73\x18\xed\x2a\x80\xcf\xcb\x2e\xca\x96\xcd\xba\x20\x32\x07\x57\xbc\xe2\x73\x5e\xea\x41\x56\x20\x7d\xff\x49\xe5\x7c\x2f\x13\x9e\xe7\xe7\x8e\x92\x0d\xdb\xed\xfc\x77\xf9\x2c\x48\xbd\xce\x76\x0f\x36\x7d\x8c\xcf\xf7\xb9\x29\x15\xe3\xd1\x51\x76\x7f\x49\x88\xca\x11\xee\x10\xb4\x51\xe8\x99\x7d\x30\x5c\x0e\xc5\x36\x58\x27\xdb\x8c\x90\x98\x91\x14\x20\x10\xd1\x9e\x5f\xc7\x2d\xc3\x57\x85\x54\x39\x55\xe3\x85\x28\x3e\x37\x9d\xb0\x4e\xda\x60\x2c\xb9\x69\xcf\x45\x19\x16\x4f\x1f\x1a\x0f\xc7\x2a\x8b\x40\x65\xb5\x53\xaf\xbe\x14\xb7\xf1\xdd\x3a\x6e\x0e\x40\x8d\x91\xcf\x06\x74\x36\x3e\x37\xf8\x47\x86\x62\x61\x7a\xd4\xde\x5f\x81\x1c\x2d\x5d\xad\xdc\x12\xa8\x67\x27\x51\xc6\xf1\xac\xe6\x93\xf7\xfb\x7a\x9c\xec\x05\x9b\x56\x6f\x6e\x50\x69\xc0\x39\x90\xee\x77\xd5\x79\x21\xc2\x57\x76\x06\x91\x8f\x1b\x4c\x35\x0a\x70\xe5\x19\xd8\x9f\x09\xf3\x3f\x7e\x1a\x3b\x37\xb8\xf5\x91\xc0\xb6\xd2\x30\xb5\x7c\x8a\xcd\xb2\x88\x93\xb1\x64\xf3\x6b\x9a\x6c\xfc\xa5\x2f\x71\x16\xb5\x50\x80\xfe\x79\x8d\xd3\xb0\xac\x3e\x6d\x79\x22\xc4\xd8\x84\x4b\xf7\x04\xaa\x55\xb4\xc1\x0f\xf6\x0a\xa2\x2d\x5e\x55\x4e\x23\x16\x78\xa3\x6b\x29\x2d\xcc\x01\x44\x6b\xbc\x68\xa8\x5e\xa3\x9b\x56\xf0\xd1\x12\x2f\x14\x81\xa8\x22\xf9\x26\xad\x4c\x69\x31\xef\x75\xdd\x31\xab\x7a\xbc\x3b\xcf\xed\xf3\x1d\x37\x67\xb5\xbc\xe8\xbc\xd5\xf9\xe9\xb9\xe1\x75\x88\x2e\xec\x95\xe1\x0e\x63\x8b\xce\x6b\x07\xbd\x14\xf5\xb7\x9c\x2d\x6c\x3e\x41\x23\xee\x80\x6e\x94\x7d\xc6\x52\x9b\x57\xdd\x9c\x7e\xb2\xb3\xd2\xe8\xef\x16\x2f\x4f\xeb\x83\xe0\x55\xc7\x83\xf2\x60\xbb\xb0\x6f\x2c\x6b\x9e\xe7\x4b\xcb\xba\x85\x32\x34\xc2\xf8\x06\x36\x8f\x5d\xc3\x11\x32\x01\x75\x25\xa0\x35\xff\x9f\xa6\x4d\xef\x52\x54\xb3\xac\x2e\xa3\x2f\xb0\x3b\xbe\x38\x5d\xeb\x76\x2f\xf4\x81\xfa\x0e\xaf\xa7\x17\xb3\xf1\x9d\x51\xf7\xa8\x31\x08\x77\xda\x56\x96\xef\x17\x45\x0b\x14\xe6\x70\xb9\xc6\x7b\xaa\xd7\x78\xe7\x55\xd0\x36\x14\x10\xe6\x8e\xd3\xae\x14\xd4\x95\x30\xf3\x3a\x8b\xdc\xb7\xd9\x5b\x5e\x4f\xae\xed\x1b\xb4\x84\xde\x35\x7a\x86\xef\x39\x31\xae\x0c\x1d\xe3\x02\xa0\x1b\x88\x2e\xf1\xfd\x74\xd4\x8c\x5f\x42\xf4\xae\x29\xc9\x8d\x13\x46\x17\xf7\xd5\x3b\x29\xe3\xfb\xe9\xe1\x4c\xf8\xfd\x7c\x66\x94\xbe\xf1\xd3\x02\x20\x9e\x34\x1d\x9d\xde\x4c\x5c\x6f\x34\xe3\x6d\x5c\x76\x65\x59\xca\x2c\xb0\x36\xd3\x7c\x36\xa4\xa8\x47\x89\xc1\xde\x41\xc4\x51\xc6\xb2\xec\x77\x3a\xea\xc1\x06\xa7\xe2\x61\x81\xc1\xb3\xea\x11\x1c\x69\x8f\x8e\xce\x5b\x2c\xef\xd6\xfe\xdd\x67\xf9\xce\x27\x18\xfc\x05\x21\xe2\xc8\xd7\x5e\xdf\xe5\x77\xd6\xf7\xf7\x74\x23\xab\x6c\x7f\x88\x69\x07\x5d\x46\xb0\x40\x23\x17\xa2\x77\x2d\xef\x64\x2d\xfc\x90\x7c\x12\x0f\xed\xb5\x57\xf6\xae\xbc\x9d\xe1\x75\x1c\x8b\xe7\xea\x05\xfe\x07\x96\x65\x07\xe6\x10\xc2\xb6\xb5\x53\xc7\xda\x71\xff\xdc\x70\x10\x51\x23\xbc\xb8\x9d\xf0\x4e\x2a\x06\xa7\x85\x0b\x6d\xbb\xde\xdd\xcb\xb9\xa2\xd0\xb2\xec\xbe\xd9\xb0\x8f\x71\x73\xa3\xc8\x73\xfb\x0c\x4f\x57\x6a\x3b\xd9\xa8\xf7\x20\x2b\xb2\x82\xa2\x59\xcb\x04\x7d\xf4\x39\x11\xb4\x81\xbc\xfc\x95\xae\xe2\x6a\xcf\xcd\xd5\xf7\x50\x61\xc3\xa4\x77\x81\x01\xe8\xf9\xa8\xb7\x6b\xd6\x34\x00\xed\xb1\x3d\x61\x5c\xed\x88\xa7\x9c\x60\x9d\xed\x20\x7a\xb3\xbc\xf2\xd3\x25\x61\xf2\x78\xd3\xce\xad\xb6\x6d\x69\xed\x63\xda\x7f\xb0\x89\xc3\x44\x85\xe6\x43\x57\xf2\x29\x29\xf8\xf4\x61\xa1\x63\x06\x9f\xe2\x34\xb4\x72\x1d\xea\x39\x3b\xf6\x2b\xf5\x3e\xff\xdb\x7d\xfb\xb7\x61\xef\x9e\xdb\xd6\x09\x93\x73\xd5\xe4\xa2\x9b\x75\xcb\x2a\xaa\xba\x0d\x25\x85\xaa\x81\x7a\xcf\x77\xba\xb9\x07\x30\xe3\x81\xa5\x7a\x35\xff\xd1\x91\x69\x63\x8b\xda\xa6\x43\x71\x8b\x06\x3e\xd5\x5e\x9a\x02\x15\x9f\x78\x16\x45\x36\x6f\xd1\x78\xde\xa9\xe3\x64\xa1\x16\x5f\xf3\x68\x51\x3e\x6a\xd9\x46\x57\x65\x19\x71\x2f\x7e\x06\xd1\x19\x8e\xb7\x51\xa4\x1e\xf2\x93\x3d\x6a\xd6\x56\x7f\xfd\x91\xd3\xb1\x6e\x24\x29\xd0\xee\x3b\x9e\xcd\x23\xba\xd4\x48\x45\x8b\xbd\x96\x98\xff\xf6\xb5\xd2\xd6\x9c\x46\xf1\x80\xa1\x65\xed\xc4\x81\xfd\x48\xd7\xda\x4a\x4f\x74\xb2\x3d\x89\x25\xdb\x60\x45\xe2\xf0\x29\xf0\x9e\x40\xe1\x3a\x28\xf2\xdd\xb8\xba\x92\x4d\xad\xab\x52\x5d\x61\xb7\xc3\x9c\x01\xdc\xd6\x4f\x14\x32\x47\xe5\xb9\x67\x3a\x43\xed\x39\x84\x83\xac\xe9\x0c\x95\x27\xba\xe9\x0c\x51\x4c\x1c\x96\xfc\xb6\xd9\x90\xf4\xdc\xcf\x88\xd0\xc1\x7f\x2c\xb4\x17\xac\xad\xf3\x35\xab\xde\x92\xb6\x21\x4a\xf1\xb6\x65\x7d\x95\x0f\x50\xab\x97\x42\x6e\x6d\x6a\x38\x2b\x8a\xb0\x3b\x8e\x4e\xb7\x1d\xd2\x61\xcd\x60\x46\x9a\xc1\xf4\x71\x57\xd6\x69\x34\x1b\x67\xd3\x68\x96\xe7\x36\xff\xc1\xd7\xb6\x8f\x28\x4a\x50\xca\xd9\x87\x69\x34\xd3\xce\x2a\xfb\x18\xfb\x8e\xe1\xfd\xcc\xb2\x86\x7c\x4f\x8e\x4b\xf7\x33\xb5\x54\x68\x59\xb6\x7b\xda\x28\x60\x67\xd3\x5a\xcc\x70\x34\xe3\x7c\x80\x72\x5f\xd5\x48\x12\xcd\xd7\xe2\x3a\x33\xcb\x9c\xa5\x2f\xb6\x81\xa8\x56\x43\xdf\xf4\xb8\x25\xfa\x21\x55\x39\x55\x5d\x11\x2c\x22\xc3\xdb\x7c\x50\x7a\x38\x1a\x47\xa7\x41\x35\x84\x21\x8e\xa7\xd1\x0c\xad\xf8\xcf\x70\x34\x43\x1b\x6c\xef\x19\xd5\x70\x07\x5f\xaa\xb4\xd5\x0c\x8e\xed\x52\xf7\xb4\x04\x28\xcf\x4d\x7d\x54\x0d\xe4\xc6\x70\x3c\xd9\xc7\x78\xa3\xa2\x03\xe1\x1a\x28\x82\xc2\xcf\x4d\x17\x5e\x6a\x0f\x78\x48\x3d\x9f\xd4\x06\x51\x79\xdf\x10\xce\x20\x2c\xb6\x2d\x67\x35\xb4\x35\xae\x23\x79\xc8\xd8\x8d\xab\xfb\x7d\x17\x76\x2c\x90\xca\x3f\x16\xda\xd6\xf4\x9f\xf8\xf1\xb1\x72\x35\xb2\x30\x8d\x21\xe3\x64\x98\x92\x6c\x1b\xb1\x0c\xa0\x85\xe1\x98\x63\x5b\x73\x22\xf2\x59\x66\xe1\x87\x8d\x86\x2b\x95\x03\xf1\x92\x23\x91\x0f\x35\xd6\x20\xe6\x1c\x6e\x87\x5b\xf6\x05\x94\xa7\xa6\xde\xbe\x1e\xd6\x14\xb8\x04\xfd\x36\x17\x73\xd7\x85\xc4\xee\xea\x16\xd9\xf3\xbc\x7a\x48\xbe\x40\x37\xe6\xe3\x51\x75\x01\x91\x21\x2c\x19\x9b\xcf\xcd\x10\xd8\x76\xed\xa1\x88\x9a\x94\xb9\x5c\xd9\x4c\xd0\xb7\x33\x3c\x15\xa6\xd9\xe2\x1f\x9d\xa1\xdd\x12\x04\x76\xed\x79\xdd\x4a\x3e\x5a\x3b\x40\xeb\x75\x96\x67\x7a\xad\x70\xd9\x7a\x36\x56\x97\xc8\x7b\x9c\xe6\xd6\x1f\x62\xda\x79\x85\x29\xab\x6e\x45\xab\x13\xc4\xee\x35\x29\x32\x1e\x17\x33\xee\x8d\xa5\x97\x24\x73\xe4\xca\xfd\x5f\xee\xee\xf5\xc1\x29\x90\x1c\x8d\xb3\x28\x6a\xd5\x65\x35\xde\x9b\x2a\xe7\x85\x33\xd4\x9c\x08\x75\x7b\x38\x76\x51\x86\x5d\x14\xcb\xf9\x61\x4f\xbf\x5f\x5f\xd2\xa7\x04\xbb\xda\xf5\x5a\xa7\x0c\x4c\x78\x62\x53\x44\x2c\x39\x8d\xc6\x49\xb5\x0f\xa4\xd3\x64\x86\x02\xec\xeb\x1b\xf8\xc0\xb2\xfa\xbe\xf1\x24\xdb\x2e\x5d\xb2\x2c\xdb\x2f\xc7\xd5\xb2\xe8\x60\x20\xcd\x36\xcc\xd3\x1d\x81\x96\x95\x0d\x06\xb0\x60\xdf\xf6\xfc\x3a\xed\x63\x6c\x4e\x62\xe3\x09\x95\x1a\xb2\xc6\x4d\x64\xed\x3a\x50\x15\x5a\x2f\xac\x36\x53\xf5\x95\x58\x4e\x25\x47\xc6\x02\x19\x1c\xe3\x37\x94\xe0\xe7\x64\x79\x35\x5a\x43\x04\xe9\xef\x57\x19\x19\x0b\xc6\x16\xb6\xcb\x03\x6b\x2c\x5a\x9d\xa9\x6f\x67\x84\x60\x81\xae\xc9\x7d\xfd\xf9\xe3\xca\x6f\xa6\xb6\x92\x2a\xe5\xaa\x7c\xd6\x2b\x6e\xb9\xf9\x00\x27\x44\x3e\xb6\xa3\x49\x2a\x8f\x1a\x19\xb3\x81\x71\x15\xe0\x55\xd1\xea\xbd\x7e\x68\x3e\x6e\x0e\x05\xca\x28\xdf\x85\xb6\x12\x42\xac\x30\x71\x6e\x57\x34\x58\x61\x8c\x7f\xb7\xac\x7e\x24\x50\xa9\xee\x6c\xee\x53\x7c\xe5\xcf\xd1\x06\xff\x5d\x5f\xa2\x88\x02\x30\xcf\x57\x68\x8b\xfd\x2e\xb9\x3c\x5a\x60\x81\x62\x7e\x4d\x2c\xec\xef\x17\x0b\x73\x2a\xd7\xb7\x47\xa3\xc3\x53\x0d\x17\x9f\x05\xf1\x71\x8a\x47\x87\x47\x82\x4a\xf5\x6d\xca\x9b\xdd\x47\xcb\x2c\xcb\xde\xe4\xf9\x8b\x93\xb6\x6a\x8e\x5f\xe5\xf9\xeb\x97\xad\x0d\xb8\xc7\x79\xfe\xf2\xb8\x2d\xe9\xb5\x0b\xc5\x1a\xfa\xae\x99\x47\x7e\xdb\xeb\x10\xd0\xdf\x77\xe8\x92\xc4\x95\x2e\xec\x6a\x5e\x3e\x5a\x16\x6d\x3f\xdd\x7f\x27\x3c\xbb\x87\x73\xb4\x91\xce\x00\x03\xad\x58\x24\x57\xcd\x58\x70\x26\x36\xc3\x76\x86\xfd\x7d\x0c\x87\x5f\x7b\x31\x00\x4e\xf6\xde\x62\x64\x5d\xb7\x18\x19\xf4\x86\x23\x68\x59\x7e\x4d\x07\x23\xe3\x47\x4a\x3d\x06\x3f\x4e\x6c\xe9\x48\xd1\xb2\xd8\x70\x88\xd8\x60\x71\xea\x72\xda\x33\xc0\x25\xe8\xa8\x0d\xb7\x6a\x4f\x6c\xb0\xc1\x62\x96\xe7\x43\xe9\xa5\xb1\xd9\xb1\xae\x77\x36\x5c\xde\x18\xac\x58\x53\xf9\xde\xc6\x70\x21\x6e\x8d\xcb\xd6\x85\x66\x84\x02\xb7\x8f\xf1\x2f\x96\xd5\xe7\x1c\xd1\x60\xc0\x06\x8b\x37\x4f\x37\xa5\x8d\x6c\x6c\x86\xdd\x6f\xee\x88\xcd\x30\x1b\x8c\x06\xdf\xd8\x0f\x36\x58\x0c\x1a\x6f\x86\xb4\x1d\xe3\xc7\xd2\x25\xc6\x62\xc0\xc6\xe6\xe8\xf3\x95\xbc\xb0\x2c\x86\xb1\xd1\xe5\x89\xdd\xb9\xfa\x3b\xe8\x42\x69\x60\xe9\x7f\x93\x82\xff\x70\x04\xbd\x10\xdb\x09\xb6\xe3\xae\x12\xf2\xb9\xbf\x19\xac\x1e\x22\x8a\x95\x2a\x1f\x3c\xdd\xb6\xcc\x88\xd0\x1e\x9a\xac\xbb\xe9\x16\x76\xbd\x10\x6f\x4f\xbf\xb3\x51\xbf\xd3\x92\x49\x68\xe9\x3e\xd1\xdd\xe9\x7a\x86\x6a\x4b\xe9\x89\x86\x95\xd8\xd2\x77\x6a\xab\xa5\x15\x11\x6a\x2a\x61\x99\x90\x8d\x76\x77\x3d\x69\x27\x59\x93\xbd\x14\xcb\x6b\x51\x0f\x28\x3d\xa2\xa6\x42\x27\x59\x39\x52\xb1\xac\xfe\xbf\x9a\x7b\x48\x85\x64\xef\x39\x01\xa8\xf5\x40\xed\x9b\xfc\xf7\x3d\xcd\x58\x92\xde\x9b\x2f\x9f\x4d\x67\xe3\x56\x92\xf8\x44\x15\x03\x7c\x3e\x55\x8d\xce\xba\x72\xa7\x24\x23\xec\xe7\xb2\x08\x5f\x4e\x01\x89\x2c\x2b\x88\x88\x9f\x6a\x01\xfa\x77\x95\xed\x04\xac\x3d\x7b\x73\xfe\xbb\x72\x67\xcc\x4f\x79\xaf\x97\x5d\x05\xaa\x7e\xa3\xe7\xff\xb4\x1d\xf8\xe7\x68\xf0\x4c\x29\x43\x08\x37\x75\x4b\xbc\xe4\xdc\x51\x7a\xc6\x6c\xd7\x10\x4d\xdc\x62\x77\x7c\x7b\xba\x07\x09\x35\x67\x7a\xab\xb9\xd2\x8b\xbd\x28\x7b\x3b\x1b\x5f\xdb\x17\x68\x29\xdc\xf0\xa6\x2c\xfb\x9d\xb2\x95\x50\x94\xda\x99\xf3\x5d\x62\x77\x3b\xb3\xac\xb9\x3c\xfc\x5e\x28\x6f\xdc\x05\x5d\xd8\xf3\x9a\x7f\xb6\x3b\xec\x8e\x83\x9a\x12\x54\xf9\xb8\x8a\xba\x39\xf4\x85\x06\x7e\x4b\x3a\xe2\xfb\xc1\xb2\x22\xc1\x72\x7f\xb8\xc3\x73\x43\x2c\x62\x2c\x4d\x08\xf3\xfc\x0e\x63\x3c\xaf\xc8\xe0\x1d\x76\xbd\xbb\xc1\x00\xa2\x1b\x3c\x9f\xde\xcd\x50\x88\xdd\xd3\xed\x70\x97\x7c\x54\xe2\x83\x1b\x83\x76\x4c\xec\x04\xc7\xbb\xe4\x4b\xe8\x53\xd6\x93\xba\x89\x0c\xaa\x72\xbd\xd9\x0e\xf6\x10\xa3\x5d\x5a\x54\xdf\xd0\x6f\x9a\x84\x48\x74\xa8\x41\x68\x32\xcb\x32\x55\x15\x65\xa2\x0d\x9f\x26\x2e\x2d\x94\xa2\x90\xac\x8d\x41\x04\xfa\x4f\xa2\xb2\x49\x35\xde\x9a\x81\xdf\x39\x3a\xb5\x30\xaf\xb0\x6a\xa3\x2f\xda\x68\x25\x1c\x6d\xb7\x22\x06\x64\x79\x5e\xeb\x9c\xf6\xa6\x26\x07\xab\xe7\x9b\xec\x95\x92\xc6\xf7\xdd\xb6\x2e\xb7\xb6\x94\xe7\x6d\xfc\x9d\xa1\xcf\xd5\xaa\x75\xc4\xf7\x70\x7e\x64\x92\x52\xea\x4e\xfd\xba\x96\x2b\xc1\x9a\x6b\x4d\xe9\x94\x7c\x91\x92\x6c\xc7\x7e\x8d\xe0\x07\x87\xdc\x31\x12\x87\xf6\x63\x51\x33\x40\x6a\xd3\x6b\x83\x75\x6f\x24\x98\x20\x75\xfc\x32\xb4\x36\x6d\xe3\x38\xaf\x84\xa7\xf5\xf3\xbb\x08\x95\x4f\xd4\xd4\x22\x38\xfc\x46\x79\x65\x5d\x56\xd5\x47\x1f\x88\x5d\xbd\x1e\xd6\xe2\x27\x55\xf4\x51\x3b\x4a\x5d\xd1\xb0\x65\xcc\xcc\x63\x05\x0d\xa5\x15\xec\x2a\xb9\xdd\x9b\x91\x67\xb0\xc5\x20\x72\xfa\xb2\x37\x6b\x69\x07\x57\x87\xb1\xba\x80\x0d\x49\xc6\xd2\xe4\x7e\x6f\x25\x73\xe3\x65\x20\x5d\x05\x6c\x56\x6d\xd8\x30\x4c\x76\x62\xca\xcc\x9e\x71\x91\xd4\x01\x5b\xb2\x58\xd8\x7f\xe9\xea\xdf\x1a\xef\x1b\xc9\x05\xda\xa4\xab\x4d\x51\x41\xaf\x9e\xb9\x61\x71\xd2\x66\x4a\x52\x48\x87\x31\xff\x8d\x85\x0d\x96\x59\x7c\xbc\x13\x83\xff\x81\x76\xe2\x4c\x6b\x2d\xfc\x47\x4b\x7a\x9c\x9c\x27\xf1\x22\xa2\x01\xc3\xbb\x12\x83\xdd\x26\xfe\x5b\x0c\x88\x04\xea\x7f\x70\xb7\x61\xd8\x57\xbe\x08\xce\x36\xf4\x67\x49\xb1\xde\xfb\x71\x18\x91\x34\xcf\xbb\x4b\x54\x27\x25\x45\xe5\xc6\xe6\x92\x17\x16\x39\x9a\xfc\x75\x1c\xe9\x94\xd6\x63\x77\x16\x24\x5d\x81\x3b\xf3\x24\x61\x19\x4b\xfd\x8d\x9a\x1d\xd8\x7b\xd3\x9b\xaa\x87\x76\x96\xcb\xc8\xf0\x1f\x08\x66\x07\xe8\x7f\xbe\xa5\x66\xd0\x5d\x73\x75\x99\xcb\x31\x0b\x34\xea\x03\x83\xbf\xd0\xc1\x4e\xb9\x4e\x70\x50\xaf\x25\x6f\x9a\xf0\x4c\x11\xcd\xd8\x3c\xb9\x6b\xcf\xe3\x98\xbe\xfa\x7a\x82\x09\x3d\x40\x7f\xec\x0e\xba\x71\x11\x48\x63\x67\x9d\x84\x7e\x04\xfe\xaf\xc1\xf7\xf4\xbd\x99\xb1\x80\x94\xc5\xaf\x34\xa9\x6a\xbd\x1a\x7c\xb0\x81\xd3\x58\xad\xc4\x0f\x56\xf6\x2e\x9d\x97\x02\xa8\xf1\x3f\xe4\x61\x9d\x20\xa2\x69\x7a\xc1\xff\x6c\x02\x0b\x38\xfe\xdb\xf3\xe7\xff\xd5\xcb\x92\x6d\x1a\x90\x5f\xfc\xcd\x86\xc6\xcb\xdf\x3e\x7f\xc0\xcd\x8e\x39\x6b\x1a\x3b\x7f\x65\xce\xda\xdf\xfc\x7f\x01\x00\x00\xff\xff\xf7\xf3\x12\xa0\x19\xcc\x00\x00")
+var _libBootstrapSelect11314MinJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\xbd\x7d\x77\xdb\x36\xd2\x38\xfa\xff\x7e\x0a\x09\x4f\x0e\x4d\x54\x10\x43\xd9\x71\x5e\x28\x23\x3a\xa9\x9d\x6e\xfd\x34\x4d\xfa\xd4\x6e\xf7\xf6\x51\xb5\xb9\x94\x08\x49\x68\x28\x52\x4b\x42\x7e\x89\xa5\xef\x7e\xcf\xe0\x85\x04\x29\x52\x76\xf6\xb7\xf7\xde\xe3\x73\x2c\x02\x18\x00\x83\x01\x30\x18\x0c\x66\x80\xe7\xdf\x75\xff\xd6\xf9\xae\xf3\x7d\x9a\x8a\x5c\x64\xe1\xba\x9f\xb3\x98\xcd\x44\xe7\x66\xe0\x0d\x4e\xbc\xc1\x8b\x8e\xbb\x14\x62\x9d\x07\xcf\x9f\x47\xec\x86\xc5\xe9\x9a\x65\x5e\x9e\x84\xeb\x70\xbd\x4e\x79\x22\x56\x2c\x11\xb9\x37\x4b\x57\xcf\xa7\xb5\x12\xf0\xdf\x3a\xdf\x41\xc9\xe7\xe9\xfa\x3e\xe3\x8b\xa5\xe8\x1c\xfb\x83\xe3\xfe\xb1\x7f\xec\x77\xae\x92\x70\xfd\xce\x2a\x80\x74\x3e\x7c\x38\x07\xe0\x0f\x7c\xc6\x92\x9c\x45\x9d\x4d\x12\xb1\xac\xf3\xf3\xe5\x75\x59\xff\x82\x8b\xe5\x66\x2a\xeb\xaa\x23\xb0\x57\xf9\xf3\x69\x9c\x4e\x9f\xaf\xc2\x5c\xb0\xec\xf9\x87\xcb\xf3\xf7\x1f\xaf\xde\x03\x42\xcf\xff\xf6\xb7\xee\x7c\x93\xcc\x04\x4f\x13\x97\x11\x81\x1f\x6e\x52\x1e\x75\x7c\x4a\x29\x73\x1c\xf5\xdd\xa5\xf4\x96\x27\x51\x7a\xeb\x38\x2e\xd3\x9f\x98\x20\x93\x0d\x51\x2a\xee\xd7\x2c\x9d\x77\x22\x36\xe7\x09\x73\x1c\xf5\xeb\x85\xab\x68\xa4\x3e\xdd\x31\xfa\xeb\x5f\x1b\x96\xdd\xa3\x09\x29\x6b\xc3\x0f\x19\x13\x9b\x2c\xe9\x08\x97\xe1\x1d\x0e\x50\x3a\xfd\x8b\xcd\x44\x59\xde\x2a\x8d\x36\x31\x73\x1c\xf5\xeb\xb1\xbb\x75\x9a\x89\x7c\x54\x0d\x52\xe1\x66\xec\x5f\x1b\x9e\x31\xd7\x54\x82\x71\x20\x5c\xe6\xfd\xf5\x3f\x50\x25\xde\xb9\x62\xc9\xf3\x4a\xbd\x65\x93\xbf\xe2\x07\xb4\xc9\x59\x27\x17\x19\x9f\x09\x34\xbc\x09\xb3\x4e\x44\xc7\x28\x0f\x13\x2e\xf8\x57\x86\x08\xba\x5d\x72\xc1\x3e\xf0\x5c\x20\x52\x44\xff\x90\xa0\x09\xc9\xe8\x18\x4d\xc3\xd9\x97\x45\x96\x6e\x92\x08\x11\x34\xe3\x02\x32\x2c\x33\x36\x47\x04\x71\xc1\x56\x40\x18\x44\x50\x9c\x26\x8b\x88\xe5\x33\x44\xd0\x3a\x85\x2e\x80\xa2\x32\x08\xde\xc5\x3c\xf9\x12\xc8\x1c\x13\xc2\xe8\x03\xfa\x0e\x05\x63\x34\x8b\xc3\x3c\x47\x04\x45\x3c\x83\x82\xa0\xec\x38\x4c\x16\x88\xa0\x2c\x8d\xa1\x0a\x11\x4e\x79\x12\xb1\x3b\x28\x47\xdc\x43\xd4\xf3\x7f\x86\x19\x0f\xfb\xe3\x3f\x6f\xfb\x93\xef\x9e\x3d\xe7\x13\x12\x06\x63\x24\xc2\x6c\xc1\x44\x89\x93\xe0\x02\x80\x51\xc6\x62\x34\x21\x61\xc6\xc2\x60\x3c\x21\x53\xf9\x2f\x83\xff\xb3\x34\x56\x3f\x11\x83\xdf\x88\xdf\xc0\x0f\x5b\xc1\xff\xa5\x84\x58\x0e\xe4\xff\x63\xf9\xff\x44\xfe\x7f\x21\xff\x9f\xca\xff\x2f\xe1\x3f\x97\xff\x56\x8b\x60\xac\x9b\x19\xc6\xc2\xaa\xfd\x96\x47\x62\x09\x48\x31\x98\x06\x68\x42\x62\x99\x41\x55\xbd\x96\xff\x32\x59\x7d\x2e\xff\xad\xc2\x58\xa6\xe4\xeb\x30\x91\xbf\x1b\x89\x70\xbe\x91\xa0\xb9\xc8\xd2\x64\x01\x5f\x1b\xf9\x0f\x40\x77\x24\xa6\xcf\xff\xe9\x8e\x02\x77\x14\xc8\xd9\x32\xda\xae\x42\x1e\x8b\x74\x3b\x17\xeb\xad\x60\xf1\x76\xce\x63\x86\x83\xed\xf8\x9f\x4e\xf0\x7c\xf4\x5f\x93\xef\xdc\x51\x30\x86\x8f\xed\x33\x8c\x9f\x2f\x38\x09\xe9\xf3\x7f\x46\xa1\x08\xa1\x04\xbe\x0a\x17\xec\xcf\xe7\xee\x28\x98\xae\xd6\xdb\x05\x9f\x6f\xff\x5a\xb3\xc5\xf6\xaf\xf5\x62\xbb\x4e\x16\x5b\xc1\xe7\xf3\xed\x2d\x9b\xae\xf1\xf6\x86\x47\x2c\x95\x90\x2b\x80\x58\xad\x5f\x6c\xd3\xc5\x02\x12\x57\x78\x1b\x6e\x22\x6e\x12\x4f\xb6\xe9\x22\x94\x69\xe9\x7a\x93\x63\x3c\x9c\x86\x39\x7b\xf9\x82\x8c\xc3\xfe\x57\xbf\xff\xa6\xf7\x7c\xd2\xa3\xd0\x8b\x43\x33\x50\x3b\x37\x7a\x76\x86\x59\x87\x53\xe6\x25\x69\xc4\x3e\x86\x2b\xe6\x89\xf4\x43\x7a\xcb\xb2\xf3\x30\x67\x2e\x1e\xf2\xb9\xdb\x1f\x74\x29\xfd\xea\xf1\xe4\x5d\x96\x85\xf7\x2e\x27\x02\x63\x35\xcf\xfa\x03\x5a\x4d\xc9\xf0\x76\xfb\x7d\x9a\xc6\x2c\x4c\x5c\x55\xe4\xef\x61\xbc\x61\xde\x2a\x14\xb3\xa5\x1b\xe3\xed\x76\x3f\x36\xc4\x78\x38\x4f\x33\x17\x26\x4a\x4e\xbf\xba\x02\x7b\x73\x1e\x0b\x96\xb9\xc5\x9c\x92\x88\x9a\xa9\xdd\xe1\x49\x2e\xc2\x64\x06\xf3\xf9\x57\xb6\x78\x7f\xb7\xde\x61\x92\x50\x9f\xa4\x34\xf7\x62\x96\x2c\xc4\x72\x98\x9c\xa5\xc3\xa4\xd7\xc3\x7c\xee\x72\x5d\x4d\x3e\x4e\x26\x06\xef\xae\x3f\xd4\x1f\x83\x5d\x41\x8f\x5f\x80\x1e\x84\xe3\x07\xc8\xe4\x38\x0d\xdc\x88\xeb\xec\x1d\xee\x32\x1b\xe7\x4f\x92\xcb\x78\x5f\xd8\x7d\xee\x0a\x83\x0b\xab\xe3\x62\xe0\x33\xca\xc6\xc9\xc4\x93\x8c\xe5\x4a\xb2\xd2\x34\x7b\x17\xc7\x2e\xfa\x0e\x61\x12\x53\x9f\x84\x34\x33\x79\xe3\xb3\x70\x18\xf7\x7a\xaa\x97\x66\x34\x1b\xc7\x13\x12\xd1\xd9\x63\x9d\x95\x7b\x72\x2e\x7f\x9a\xbb\x11\x2e\xea\x5d\xd2\xf1\xc4\xcb\x63\x3e\x63\xde\x2c\x8c\x63\x77\xe6\x85\x42\x64\x7c\xba\x11\x2c\xc7\x64\x0d\xa9\xb3\x34\x99\x85\xc2\x15\x63\xf4\x1d\x9a\x6c\xb7\xe3\x09\x11\xe3\x48\x7e\x60\xb2\xa1\x3e\x99\xd3\xa5\xc1\x6c\x73\x36\x1f\x6e\x0c\x66\x2b\xba\x1c\x6f\x26\xc3\x1b\x77\x45\xd6\x78\xbb\x9d\x79\x19\x5b\xa5\x37\xec\x9d\x29\xdf\x5d\x15\x18\xe3\x1d\x8b\x73\xd6\x99\x79\xeb\x30\x63\x89\xf8\x98\x46\x4c\x43\x9f\x2f\x79\x1c\xb9\x33\xbc\xdb\x29\x6e\x25\x19\x24\x4f\x3a\x51\x3a\xdb\xc0\x12\xe6\xcd\x32\x16\x0a\xf6\x3e\x66\x10\x72\xd1\x67\x84\xb7\x5b\xd3\x49\xc0\xfb\xf9\xdc\x45\x3a\x15\xf1\xa4\xc3\x14\x6e\x82\x5a\xc5\x11\x4e\xd1\x3a\x4b\x45\x0a\x3d\x8a\x48\x4e\x99\xa7\x73\x8c\xf9\x84\x24\xba\x27\x49\x4a\x8b\x72\xcd\x04\xf9\x2a\x79\x3e\xd6\xe3\xe6\x21\x8c\xa2\xc0\xae\x5b\x0f\x0c\x46\xe5\x4c\xf0\x8a\x3a\x6c\x82\x87\xd9\x42\x36\x24\xc7\xde\x5f\x29\x4f\x5c\xd4\x41\x98\x70\x2f\x8c\xa2\x73\x40\x10\x96\x2c\xa2\x48\xf1\x9f\x2a\x5a\x13\xb6\x28\x5d\xa4\x8b\x45\xcc\x82\xc6\x79\xc5\x3d\x95\xaa\x71\x21\x02\xef\xc8\x2c\x4d\x44\xc8\x93\xbc\x09\x1f\xee\x2d\xc3\xbc\x40\x7c\xb7\x03\x4e\x91\x78\x6a\x75\xfe\x25\x03\x21\x46\xdc\x2b\xe2\x65\xf4\x61\xc1\x44\x90\x12\x96\x6c\x56\x2c\x0b\xa7\x31\x0b\xba\x3e\x14\x3e\xe7\x8b\x8d\x09\xef\x86\x22\xbb\x7f\xa8\x97\xe0\xe6\x44\x90\x0c\xef\x66\x72\x0e\x33\x23\x4c\x74\x29\x70\xac\xcd\x6a\xca\x32\xc7\xe9\x1f\x0f\x5e\xbc\x7c\x7d\x7c\x72\x7c\x7a\x6c\xc5\x6f\xb7\x6e\xe6\x95\x35\xd2\xee\x80\xb4\x14\x8e\x77\x6a\x4c\x26\x63\x3e\xf1\x3e\x7f\x56\x30\x7f\x67\x42\xb0\xec\xf3\x67\xc7\xc9\xf7\xe2\x5c\x41\x52\xbc\xdb\xb9\x5a\x70\x91\x4b\xbc\x20\x33\xc2\xe9\x81\xb1\x0a\xf4\xe1\x5e\x31\x14\xa1\xdb\x5d\x34\x1b\xc0\x22\x7f\x8c\x30\xe9\xda\x69\x86\xee\xae\x4c\x53\x54\xcc\xe9\xc5\xa7\x9f\xaf\xd3\x2f\x2c\x81\x91\x6c\x0d\x83\x30\x8a\x48\xd2\x96\xa8\x46\xc0\xb0\x3d\xab\x3d\xd2\xeb\x03\x6c\x9e\x66\xef\xc3\xd9\xb2\x36\xc4\x48\xee\x81\xa8\xa0\xe6\x03\xde\x91\x83\x35\xff\x1b\xc5\x27\x95\xe2\x77\x35\xb2\xa9\x41\xea\xa2\xd9\x09\x22\xdd\x01\x26\x76\x9a\x45\xb6\x13\x43\xb6\xb4\x8d\x32\xaa\xa0\x36\xca\xa8\x54\xda\x38\x53\x06\x1d\x9e\x74\x0a\x7c\x1d\xa7\x0b\xa8\x96\x95\x33\x4c\x69\x57\x8c\x44\x90\x2a\xc2\x41\x2a\x81\x29\x62\x0a\xeb\x2c\x35\xb7\x4a\x36\x71\x4c\x29\xa4\x63\xb1\xcc\xd2\xdb\x4e\xc2\x6e\x3b\xd7\xf7\x6b\xf6\x3e\xcb\xd2\x4c\x0d\x2b\x7a\x25\x32\x9e\x2c\x34\xf7\xe1\x73\x97\x39\x0e\x1a\x2b\xb9\x56\x2f\x7f\x13\x44\xe9\x4c\xd5\xc5\x70\x6b\x49\x9c\x0a\xcd\xbb\x49\x6e\x0a\x65\xb0\x58\x99\x45\x93\xa4\x74\x70\x56\x34\x4b\x47\x8e\x8a\x88\xf1\x60\x12\x28\xe9\x9d\x64\x34\x1d\x7d\x94\x93\xcc\x4d\x71\xe0\x0f\xb3\x2e\xcd\x1c\xc7\xcd\xa8\x8f\x65\x55\x31\xfd\x39\x14\x4b\x6f\xc5\x13\x57\x7d\x84\x77\x6e\x46\x7c\x4c\xb8\x5c\xa2\xf8\x59\xd2\x8b\xcd\x4a\x3c\x28\xd6\xd1\x90\xf6\x07\xc3\x5e\x2f\x3c\x4b\x86\xb0\x6e\x0b\x6f\xb6\x0c\xb3\xf3\x34\x62\xef\x84\x1b\xf7\x42\xdc\xa5\xb9\x1d\x15\x16\x8b\xf9\xc0\x2c\xe6\x7e\x49\xe2\x4f\x96\x70\x23\xd9\xbc\xda\x73\xb1\xe8\xd3\x1a\xba\x20\x27\x09\x1d\x4f\x00\x19\x81\x1f\x0c\x02\x29\xf5\x49\x56\x90\x63\x98\x9e\x65\xc3\xb4\xd7\xc3\x2e\xa7\xf9\x38\x9d\x60\x2f\xe2\x39\x70\x92\x68\xbb\x45\x9f\x7e\xb9\xfe\xfb\xaf\x9f\x7e\xfb\x05\x51\x4a\xb9\xbd\x94\x89\x70\x01\x42\x94\xe3\x54\x62\xcb\x9c\x89\xb7\xde\xe4\x4b\x97\x9b\x85\xa4\x93\xec\xf4\x47\x5e\x22\x7f\xad\x90\x37\x78\x71\x92\xd3\x31\xac\x4e\x62\xbb\xdd\x6f\x88\xc2\x3a\x69\xc0\x3a\xf9\x8f\x61\x9d\x6b\xac\xbd\x1b\x90\xe6\x0a\xdc\x99\xb7\xda\xc4\x82\xaf\x63\x36\xca\x03\x43\xb7\x51\x3e\xf6\x27\x01\x0c\xed\x1d\xa7\xf0\x43\xd4\x60\xb3\x26\x57\x2e\xc2\x4c\xe4\xff\xe0\x62\xb9\xdd\xba\xc2\x66\x11\xb0\x04\x40\x93\x19\x7d\xd8\x11\x61\x04\xab\x2a\xd7\x26\x9c\x0a\x97\x11\x46\x18\x76\x1c\x51\xae\x0c\x86\x90\x7c\xe7\x62\x32\xa3\x0f\x3b\x4f\xa4\xaa\x6a\x22\x46\xc2\xad\x63\x41\x50\x89\x06\x22\x0f\xb2\x65\xc1\xb2\xbe\x2a\x91\xdb\x8c\x0b\xb3\x42\xe1\xe0\x40\x53\xe8\x12\x13\x4b\x10\xdc\x6e\x5d\x2b\x54\x61\x24\x20\x63\x42\xdf\x0a\xe0\x23\x1c\xba\x96\x61\xb9\x9a\x7e\xba\x4d\x4c\x23\xf5\x74\x26\x02\xcb\x4e\x01\xea\x8b\x82\xee\x7c\x87\xc9\x8f\xd7\x3f\x7f\x50\xd2\xa3\x5e\x64\x1c\xa7\xbb\x17\x67\x21\x5a\x2d\xde\x45\xb5\x61\x84\xb0\xe3\x34\x52\xdb\x3d\x50\x28\xd9\x2b\x85\xc8\x95\xde\xea\x50\x8d\x31\x70\xaf\x06\x89\x37\x98\x2d\xd9\xec\x0b\x8b\x10\xde\xed\x14\xeb\x58\xd3\x87\x4d\xce\x2e\xd8\x3c\xdc\xc4\x22\xe8\x0e\xc8\xe7\x9c\x89\xe0\x2b\x0c\xbc\x1f\xd3\xf4\x4b\xae\x87\xbf\x97\x33\xb1\x1b\x36\x46\x37\xf3\x6c\xe1\x38\xdd\xb5\x57\x16\xed\x38\x5f\x5d\x86\x3d\xd8\x90\x95\xb4\x40\xa4\xeb\x63\xb2\xf6\xa0\x4e\x2f\x5c\xaf\xe3\x7b\xc9\x76\x49\xc1\x03\xf1\x4e\x22\xf9\x4e\x8d\xeb\x4d\x59\x95\x1a\xb9\xba\x2e\xe0\xe2\xef\x6f\xa4\x8c\x3a\x5b\x86\xc9\x82\x21\x4c\xba\x7e\x39\x50\x0d\xdb\xda\xed\x5c\x5c\x6e\xc7\xbe\xc0\xa4\x27\x9c\xe4\xe5\xc4\x4f\xe8\x18\x45\x3c\x5f\xc7\xe1\x3d\x6c\xcb\x37\x53\xc1\xee\xe4\xae\x17\x96\xdb\x1c\x4d\x48\x0a\x52\x4d\x46\xfd\x61\x76\x56\x30\x80\xcc\x08\xe5\x31\x4d\xc6\xd9\x84\x84\x94\x8d\x63\xc9\xea\x42\xc7\x71\x43\x1a\x16\xd3\xc2\xc5\xa4\x28\x9e\x52\x1a\xeb\xe4\x8c\xad\xe3\x70\xc6\xdc\xe7\x67\xe3\x7f\xbe\x9d\xf4\xde\x3e\x5f\x10\x84\x30\x26\xb9\x4c\xbf\x75\x43\x8c\x89\x2a\xe6\xb7\xf5\xda\x6c\x3d\x48\x4a\x91\x59\x01\x25\x7b\x19\xf9\x67\x34\x2c\xb6\x21\x02\x07\xa1\x35\x57\x5c\x81\x31\x9e\x66\x2c\xfc\x62\x26\x6d\x5a\x72\xbf\x0f\x96\x90\xb9\x0e\xb3\x9c\x5d\x26\x30\xdf\x07\x3e\xde\x6e\xfd\xdd\x57\x6f\x9e\x78\x22\xe3\x8b\x05\xcb\x3e\x86\x82\xdb\x22\x86\x91\xf8\x81\x43\x2c\x39\xf0\xa1\x21\x07\x2e\xb6\x06\xca\xcb\x1e\x19\xb9\x9b\x91\xa0\x65\x07\x31\xf2\x30\xdd\x4c\xa7\x31\xcb\xd5\xfc\x76\xc5\x9e\x08\x07\xd9\x5c\x24\xc1\x11\xc6\x1e\x4f\xb8\x30\x79\xbb\xbe\x16\x41\x2a\x75\x40\xe3\x02\xee\xcd\x79\xc6\x74\x9d\x2d\xa5\xaa\xf9\xe6\x62\xec\x31\x80\x83\x85\x9f\x32\x62\xe5\x74\x51\x9a\xa0\x1e\x0c\x63\x1c\xc0\x40\x34\xed\x06\x59\x5e\x0e\xc4\x39\x7d\x40\x7f\xde\xcd\x7c\x14\xa0\x77\x88\xc0\xe7\xa0\xfc\x3c\x2e\x3f\x4f\xca\xcf\x17\xe5\xe7\x69\xf1\xc9\xa0\x84\x50\x7d\x0e\xca\xcf\xe3\xf2\xf3\xa4\xfc\x7c\x51\x7e\x9e\x16\x9f\xb3\x57\x28\x40\xe7\xaa\x04\xf8\x9c\xc9\xcf\x08\xca\xbd\x90\x9f\x73\xf8\x8c\xe4\xe7\xec\x35\x0a\xd0\x7b\xf5\xf9\xa6\xfc\x0c\xcb\xcf\x69\xf1\xc9\x00\x96\x49\x58\xf6\xa6\xfc\x0c\xcb\xcf\x69\xf1\x39\x9b\xa1\x00\x5d\x4a\xd8\x59\x54\x7e\xb2\xf2\x73\x5e\x7c\x32\x80\xe5\xea\x33\x2a\x3f\x59\xf9\x39\x2f\x3e\x23\x20\xc9\x47\x09\x3b\x87\xcf\x44\x7e\x46\x40\x9d\x4f\xea\xf3\xa4\xfc\x7c\x51\x7e\x9e\x96\x9f\x2f\xcb\xcf\xd7\xc5\xe7\x1c\x4a\x48\x65\xec\xfc\xa4\xfc\x7c\x51\x7e\x9e\x96\x9f\x2f\xcb\xcf\xd7\xc5\x67\x04\x24\xf9\x4d\x96\x10\x85\xe5\xe7\xb4\xfc\x9c\x15\x9f\x73\x80\xdd\x48\xd8\x79\x58\x7e\x4e\xcb\xcf\x59\xf1\x19\x01\x49\xfe\x50\xb0\xf0\x79\xaf\x3e\xe7\xc5\xe7\x0c\xd0\x79\xa7\x7b\x00\xbe\x43\xf5\x1d\x01\x01\xaf\x41\x13\xf7\xe7\xdd\x1c\xbe\x85\xfa\x8e\x20\xab\x54\x40\xfe\xb9\xf1\x07\x7e\x31\x60\x21\x50\x8c\x53\x08\x14\xc3\x13\x02\xc5\x50\x84\x40\x31\x02\x21\x50\x0c\x3c\x08\xbc\x34\x43\x0f\x02\xaf\xed\x40\x68\x07\x66\x76\xa0\x18\xa3\x90\xe7\x8d\x1d\x98\xda\x81\xc8\x0e\x30\x33\x98\x37\xfe\x60\x50\x8c\x6c\x28\x60\x6e\xc6\x36\xa4\x0c\xec\xc0\xb1\x19\xc9\x90\xf2\xc2\x0e\xbc\xb4\x03\xc5\x8c\x00\xb0\x62\x22\x40\xe0\xc4\x0c\x6f\x08\x9c\xda\x81\x57\x76\xa0\x98\x1b\x00\x56\x4c\x09\x08\x40\xb3\xff\x6e\x02\xcc\x0a\x1c\xfb\x76\xe0\xd8\x0a\x0c\xa0\xd9\x0b\x13\x98\x5b\x81\xe3\x81\x1d\x38\xb1\x03\xd0\xb8\x1f\x4d\xe0\xa5\x1d\x00\xac\x97\x1a\xeb\xe3\x57\x76\xe0\xb5\x99\x8f\x90\x27\xb4\x03\xc5\x54\x86\x94\x62\x06\x6f\xfc\xc1\x89\x6f\xa7\xbc\x31\x93\x14\xc0\xa6\x76\xa0\x98\xd4\x90\x52\xcc\x65\x28\x60\x60\x07\x00\xeb\xff\x36\x01\x40\xf4\x2f\x13\x80\x26\xfc\x64\x02\x80\xf5\x17\x13\x78\x6d\x07\x00\x83\x0f\x26\x30\xb5\x03\x91\x1d\x98\x5b\x81\x17\x03\x2b\x70\x02\xcd\x8e\x4d\x60\x66\x07\x98\x15\x78\xe1\xdb\x81\x63\x3b\x70\x62\xb8\xd3\xc6\x1f\xbc\x38\xb5\x03\xaf\xec\x40\x68\x07\x5e\x18\x36\x06\x79\x5e\xda\x81\xd7\x76\x60\x6a\x07\x66\x86\x71\x41\x1e\x66\x05\x4e\x7d\x2b\xf0\x22\x32\xdc\x09\xc0\xe6\x56\xe0\x74\x60\x07\x00\x83\x5f\x4d\xe0\xa5\x1d\x78\x6d\x07\xa0\x3d\x99\x6e\xcf\xe9\x2b\x3b\xf0\xc6\x0e\x40\xe3\xae\x4c\x60\x66\x07\x98\x15\x78\xe9\x5b\x81\x53\x68\x9c\xe1\x48\xa7\x91\x1d\x98\x5b\x81\x97\x03\x3b\x00\x84\xbf\x36\x81\x17\x76\xe0\xa5\x1d\x80\x2e\x11\xba\x4b\x5e\x9e\xda\x81\x57\x76\xe0\xb5\x61\xce\x50\x5a\xc1\xbf\x21\x50\xb0\x6d\x08\x30\x2b\xf0\xca\xb7\x03\xc7\x56\xe0\x65\xc1\xdf\x21\x4f\xc1\xd6\x21\x10\xd9\x81\xb9\x15\x78\x35\xb0\x03\x27\x76\x00\x1a\xf7\x0f\x13\x80\x26\xdc\x9a\xc0\x4b\xb3\x36\x40\xe0\x95\x59\x12\x20\xf0\xda\x4e\x01\x74\xfe\xd7\x04\xa6\x76\x20\xb2\x03\xd0\xec\xaf\x26\x30\xb3\x03\xcc\x0a\x9c\x40\x4b\x2f\x8b\x89\x0a\x98\x72\x33\x53\x4f\x21\xed\x93\xe1\x77\xa7\x90\x96\x9a\xd0\x0b\xc0\xe2\xc8\x0c\xe1\x57\xd0\xf6\x1c\xed\xc8\x8a\x3e\x1f\x83\xdc\xd4\x87\xa5\xf9\xcf\xbb\xe8\x75\x1f\x56\x58\x58\x5a\xe1\x63\x0e\xb0\xbe\xdf\x87\x9f\x57\xf3\xc9\xf3\x05\x59\x50\xa5\x69\x71\xd1\xf8\xcf\x3f\x37\xfe\x09\x24\xc2\xef\xcb\xf9\x9f\x7f\x6e\xe6\xec\x58\x06\xe7\xec\x18\x82\xc7\x7e\x24\x83\xc7\xfe\x1c\x82\x83\x70\x2a\x83\x83\x50\x05\xa3\x99\x0a\x46\xf3\xf9\x04\x11\xb4\x40\xd6\x16\x60\x6a\x49\xbe\xf3\x31\x9b\x94\x32\xf1\x6d\x99\xe2\x32\xca\x2c\x11\x1e\x3b\x0e\x2b\x44\xf6\x15\x99\xe2\x22\x20\xc5\xf6\x1d\x88\x89\x97\xe4\x8e\xdc\x93\x67\xe4\x8a\xba\x97\xf4\x01\x39\x28\x40\x4e\xb8\x5a\x0f\x11\x41\x67\xf0\x1d\x0b\xf8\x7c\x0b\x9f\x0b\xf8\x3c\x42\x47\x01\x72\xfe\xb5\x49\x21\x80\x8e\x20\xfe\xbf\xee\x8e\x5f\x41\xe0\xff\x56\x81\x97\xfe\x10\xed\xc8\x1d\x45\xee\x28\x40\x3d\x6b\xa3\xeb\x5e\x1a\x0d\xf4\x16\xe1\x1e\xc2\x88\xdc\x1b\xda\xdd\x61\xf2\xac\xf8\x96\x2d\xaf\x9c\xa0\xea\x76\x33\xb9\xb7\xa2\x94\x8d\x10\x0a\x10\x48\xbf\xf7\x9e\x60\x39\x1c\xe8\x8e\xca\x86\x3e\x23\xef\x71\xc0\x76\x16\xed\xde\x5b\x65\x5c\x02\xed\xa0\xe1\xe7\xf4\xe1\xe4\x38\x40\x1d\x44\x5e\xbc\x0e\x90\x8f\xc8\x8b\x37\x01\x1a\x20\x72\xea\x07\xe8\x18\x91\xd3\x41\x80\x4e\x10\x39\x3d\x0e\xd0\x0b\x44\x4e\x4f\x02\x74\x8a\xc8\xe9\x8b\x00\xbd\x44\xe4\xf4\x34\x40\xaf\x10\x39\x7d\x19\xa0\xd7\x88\x9c\xbe\x0a\xd0\x1b\x44\x4e\xdf\x04\x68\x88\xc8\xcb\x53\x29\xa4\xbc\x7c\x19\xa0\xef\x11\x79\xf9\x4a\xca\x15\x2f\x5f\x4b\x81\xe0\xe5\x1b\xb9\x78\xbf\xf2\x03\xf4\x03\x22\xaf\x06\x72\x55\x7d\x75\x2c\x97\xc3\x57\x27\x72\xe9\x7a\xf5\x42\xae\x39\xaf\x4e\xe5\x02\xf3\xea\xa5\x5c\x0c\x5e\xbd\x0a\xd0\xcf\x88\xbc\x7a\x2d\xb9\xf4\xab\x37\x92\xa3\xbe\xf6\x03\xf4\x0b\x22\xaf\x07\x01\xfa\x1f\x44\x5e\x1f\x4b\xc6\xf8\xfa\x44\x32\xb1\xd7\x2f\x24\xc3\x79\x7d\x2a\x59\xc0\xeb\x97\x01\xfa\x1d\x91\xd7\xaf\xe4\xac\x7d\xfd\x3a\x40\xff\x17\x22\xaf\xdf\xc8\xf9\xf8\xc6\x97\xf3\xed\xcd\x4b\x49\x82\x37\xaf\x24\x09\xde\xbc\x96\x24\x78\xf3\x46\x92\x60\xe0\xfb\x92\x06\x03\x7f\x20\x89\x30\xf0\x8f\x25\x15\x06\xfe\x89\x24\xc3\xc0\x7f\x21\xe9\x30\xf0\x4f\x81\x10\x3b\xf2\x91\x1e\xbf\x22\x17\x74\x70\x42\x7e\xa4\x27\xc7\xe4\x1f\xf4\x0d\xf9\x9e\x9e\xbc\x26\x3f\xd3\x17\x3e\xf9\x95\x3e\xe4\x9b\xd9\x8c\xe5\x39\xec\xe0\x57\xe1\x5f\x69\x06\xb5\x28\x0d\xff\xaf\xde\x1c\x3a\xd8\x95\x1b\xb9\x28\x4b\xd7\x51\x7a\x9b\x78\xe7\x69\x92\x8b\x6c\x03\x9a\x01\xef\xf7\xf7\xbf\x5e\x5d\x7e\xfa\xb8\xdd\x22\x84\xbd\x7c\x1d\x73\x21\xcf\x33\xc6\xfe\xc4\x84\x3c\x84\xc9\xaf\x9e\x2c\x98\xaa\xf2\xc6\xfe\x84\xfc\xea\xe9\x5a\xa9\xbd\xdb\x96\x33\xe0\x37\xea\x93\xbf\x28\xf2\xa6\x46\x53\x80\xc8\xef\xf4\xe1\xe2\xf2\xea\xdd\xf7\x1f\xde\x5f\x04\xc8\xa8\xba\x10\xb9\xb8\xfc\xfd\xf2\xe2\xfd\xaf\x10\x05\x67\xaf\x19\x22\x57\x3f\x7e\xfa\x47\x80\xd2\x35\x4b\x10\xb9\xf8\xf5\xd3\x2f\xbf\xfd\x12\x20\xc0\x7b\xb3\x46\xe4\xe7\xf7\x1f\x7f\x53\x21\x68\x45\x7f\xc5\x92\x8d\x8a\xfc\xf5\xf2\xef\x3f\x5e\xd7\x52\xfa\xd2\x46\x43\xa5\x7f\x78\xff\xc3\x5e\x72\xcc\xe6\x02\x91\xef\x7f\xbb\xbe\xfe\xf4\xf1\xfc\xc3\xbb\xab\xab\x00\x4d\x45\xd2\x8f\x94\xc6\x02\x91\x5f\x3e\xfd\xf2\xe9\xf7\xf7\xbf\xfe\xf8\xfe\x9d\x44\x70\x9d\xae\xd3\x1b\x96\xf5\xf5\x19\xf8\xe5\xf9\xa7\x8f\xdf\xbf\xbb\x7a\x1f\xa0\x45\x7c\xbf\x5e\xf2\x59\x9a\x20\x72\x7d\x79\xfe\x13\x24\x58\x91\xfd\xf4\x0b\xda\x91\x1f\xe8\x03\xa0\x11\x20\x0f\xf5\x7e\xf7\xe0\x73\x47\x3e\xd3\x07\x79\x20\xde\x76\x88\x01\x89\x08\x13\xde\x0a\xc0\x11\x26\x5a\x4b\xd1\x0a\x23\xcf\xde\x11\x26\x61\x2b\x44\x08\xc7\xa0\xed\x95\xc4\x50\x8b\xb4\xa0\xc8\xd7\xe1\x8c\xd5\xe1\xae\xd9\x9d\xd4\x5e\xba\xe8\xcf\xbb\xd0\x47\x98\xcc\xb3\x70\x01\x39\xeb\x80\x17\x3a\xf8\x83\x4e\x77\xf1\x6e\xf8\xd9\x0b\x41\xb3\x54\x9e\x5b\x1a\xeb\x88\x54\xea\xb7\x10\x26\xe8\x05\x68\x37\xf4\xd8\x73\x1c\x17\x72\xc8\x03\x08\xd0\x9f\xd2\xb2\x43\xc1\x54\x03\x61\xf2\xd9\xd3\xf4\xb0\x81\x40\x8b\xd3\x5f\x6d\x04\x0c\xb7\xcf\x1e\x84\xe8\x67\x0f\x88\xeb\xcd\xe2\x34\x61\x12\xfb\xee\x00\xeb\xb4\x7a\x4e\xc8\x23\xd5\x66\x3f\x87\xd9\x97\xc6\x8c\x52\x35\xf0\x77\xa9\xe5\xd0\xec\xf6\xfb\x1e\xda\xa2\xde\xcf\x98\xfc\xcb\x8e\x45\xff\x44\xbd\x7f\xf4\xd0\xb3\x2d\xea\x7d\xc4\xe4\x27\xfa\x10\xf3\xca\xd1\x20\xe8\x29\xa1\xa8\x9c\x7e\xf6\x62\x5e\xab\xc3\xb0\x6b\xc7\x71\x07\x60\xda\x23\x0f\x78\x41\x85\xb1\xdd\x0e\xaa\x11\xa3\x1c\x74\x69\x2c\x89\xd4\x01\x2f\xc3\x01\x1c\x4f\x27\x2c\x03\xcd\x22\x65\x98\x14\x47\x7a\xc2\x71\x10\x52\xbf\x6e\x6e\xb5\x1b\xce\xd4\x37\x71\xdc\xa5\x1c\x4e\xe3\xca\xf3\x1e\x79\x84\x96\xae\x05\x98\xcb\xac\xfb\xa8\xc7\x31\xc9\x77\x24\x6c\x6d\x44\x68\xb7\xc1\xaf\xb6\xe1\x29\x38\xe7\x2c\x13\xef\xa2\xbf\xc2\x19\x4b\x04\x20\xef\xa2\x29\x9b\xa7\x19\x63\x60\xab\xc3\x70\x73\x4b\x6a\x08\x6b\xbd\xa2\x15\x4b\x84\xc5\xe4\x30\x91\x6d\xac\x0e\x42\x6d\x90\xa3\x9a\x07\x63\xa0\xd2\xc2\xe2\x94\x83\x24\xb4\x18\x32\x76\x57\xf1\xb9\xcb\xe4\xf1\x14\x4b\x04\x4e\x6c\xda\x9b\xd8\x21\x9c\x7f\x82\xdd\x43\x22\xf3\x9f\xab\x58\x90\x33\xd8\x9d\x20\xcc\x03\x66\x62\x8e\xd3\x3e\x7b\xe5\xe4\xab\x55\xe4\xe6\xd4\xed\x82\xa1\x97\x18\x7d\xf6\x78\xa0\xc6\x26\xae\x02\x61\xbb\x5f\x97\x3c\xf7\xd4\xd4\xca\x65\x1d\xdf\x87\x39\xeb\xa1\x0e\xea\xa9\x2a\xc9\x67\xcf\xcc\xde\x4a\x77\xe4\xb8\x2d\x25\xc5\x3b\x66\xa6\x9c\xe3\xb8\x2e\xa7\xf6\x0c\xac\xa0\x51\x6d\xa8\x06\x22\x49\xa5\x38\x8e\x31\x9c\x3f\xaa\x36\x61\x50\xcb\x0e\xfd\xb3\xc4\x9b\x41\x22\x34\xa8\x38\x2b\xc2\x2d\xf8\xd8\xb0\x63\x7f\x82\x25\xa1\x3b\x6d\xc0\xc5\x88\x2c\x01\x76\x24\x0e\xa7\x2c\x0e\x1a\x74\x9c\x24\x6f\xed\xee\xca\x04\xf3\xb4\x8e\xb7\xd2\x8f\xc9\xc1\x7e\xe4\x8d\x7c\xe5\x3f\xd1\x75\xbc\xb5\xeb\x12\x6c\x74\xc1\x95\x1e\x14\xdf\xda\x83\xd5\x99\x2b\x30\x7e\xd2\x28\xda\xed\xc8\x1f\xb4\x61\x56\x49\x45\xf2\xd0\x3e\x39\xd8\x6e\xdd\xe6\x73\x07\x75\x6e\x40\x6c\x58\x0a\xe7\x09\x50\x82\xf7\x8c\xa9\xf5\x8b\xc2\xb1\x83\x8e\x4a\xd8\xad\x5e\xd5\xa4\xcc\xab\x63\xa7\x1b\x21\xd2\xc4\x8e\x59\xb1\x64\x63\x85\x35\xd5\xa9\x50\x19\x94\x3c\xb3\xe6\xb3\x2f\x2c\xa3\x0f\xab\x90\x27\xc1\xc3\x8e\xe4\x2c\xcc\x66\x4b\xf8\x9a\x6d\x32\x38\xd1\x83\xcf\x1b\xce\x6e\xe1\x97\xe7\x57\x32\x99\x27\x0b\x10\xd0\xbe\xb0\x7b\x90\x51\x82\x87\x2f\xec\xfe\x47\x9e\x8b\x34\xbb\x0f\x10\x22\x19\xcb\x99\xf8\xa9\x8c\x7a\x90\xfa\xfb\x72\x24\x16\xe2\x76\xce\xc4\x35\x5f\xb1\x74\x23\x4a\x8b\x2d\xfc\xc0\x2b\x98\x79\xba\x12\xaf\xac\x83\x22\xb4\x23\xaf\x7d\x1f\xef\x76\xbb\x9d\x6e\x0b\xff\xca\x2e\x93\x79\x0a\xa7\x7f\xd0\x60\x98\x76\x56\x93\x3d\x29\xea\x38\x8e\xbb\x1f\x49\x2b\x54\x96\x96\x4d\xae\xb6\x0e\xc4\xea\x64\x29\xaf\x16\xa5\x6c\x37\x7e\x09\xa3\x88\x27\x8b\x21\x4a\xe4\x71\x76\x69\xea\x95\xd7\x6b\xa9\xc0\xd3\x71\x4e\xe4\xdf\x44\xf7\xe5\x4d\x18\xd3\x3f\xac\xd3\xb6\x9b\x50\x77\x56\xc6\xc0\xca\xb6\x92\xa6\xa2\x4c\xf2\x3c\x63\xf9\xb2\x96\x2e\xe3\x4c\xef\x8a\x2b\xe0\xfe\x15\x08\x13\x69\x40\x60\x00\xbc\x8b\xab\x18\x14\xb1\x0a\x28\x62\xcd\x60\x11\xdb\x07\x14\x59\x7a\x5f\x07\x82\x38\x83\x32\x58\x94\x54\xd2\x55\x94\xc6\x66\x99\xde\x56\x12\xf3\x65\x7a\xab\x92\x96\x3c\xaa\xe6\x83\x08\x95\x04\xa7\x2a\x20\x80\x99\xf1\xd3\xf9\x5f\xc3\xe8\x62\x38\x6b\x32\xe7\x6f\x64\x46\x19\xf0\x37\x30\x64\x5b\xf2\xb9\x39\xa4\x0b\x31\xe9\x16\xc2\x3f\x7e\xf8\x0f\xee\x32\xca\x6d\xc4\x1f\x5e\x61\xbf\xfd\x3b\xcb\x72\x9e\x26\x23\x5d\xc7\x7e\x4a\x6b\x71\x81\xab\xf3\x8c\xb5\x04\x49\x60\x47\x86\x7c\x04\xf6\xb1\x49\x9e\xc6\xcc\xbb\x0d\xb3\xc4\x45\xd7\x4b\x96\xb1\xce\x6d\x98\x77\xc2\xa4\xc3\xf3\x7c\xc3\x3a\x19\x13\x19\x67\x37\x3c\x59\x94\x96\xe4\x47\x79\xe7\x46\x57\xd9\x79\x9f\xe4\x9b\x8c\x95\x69\x1d\x9e\x77\xa6\x0c\xc0\xe3\x34\x8c\x58\xd4\x51\xb2\x4a\xa7\x6e\xc7\xdd\x09\x93\xa8\x23\x64\x7d\x3c\xef\x24\x69\x27\x09\x57\x6a\x55\xe8\xcc\xd2\x38\xe6\xaa\xf4\xcb\xb9\x2c\xa6\x52\x7b\x27\xcc\xef\x93\xd9\x32\x4b\x93\x74\x93\xc7\xf7\x04\x4a\x31\xf8\x74\x56\xe1\x7d\x27\x61\x2c\xea\x88\xb4\x33\x65\x9d\x55\x98\x6c\xc2\x38\xbe\xef\xe4\x6b\x36\xe3\x73\xce\xa2\xce\x0d\x0f\x3b\xcf\x60\x13\x58\xe1\x13\x76\x17\xed\xd1\x55\x4a\x59\xbb\x47\x76\x7e\x60\x06\x68\x4b\xe9\x7a\xc1\x04\x7b\x8f\x3f\xbc\x8b\xf7\x3f\xbc\xfb\xed\xc3\xf5\x95\x27\x65\x2a\x4a\xe9\xef\x9e\xb5\xe1\x72\x1c\xa1\x2c\x1d\x1e\x80\x08\x81\x11\xbc\x8a\x65\x2f\x40\x16\x30\xda\x61\x62\x15\x68\x16\x41\x59\xa6\xd9\x8c\xd5\x0b\x34\x40\x95\x32\x0d\x70\xad\x40\xc1\x67\x5f\x2e\x67\x69\x22\x0b\x34\x1b\xb9\x7a\x81\x06\xa8\x52\xa0\x01\x86\x02\x7f\xf7\xf4\x8e\xd6\xda\x9c\x14\x5b\xdb\xdf\x3d\xd8\xdc\x52\x04\x73\x14\x91\x0a\x29\xa8\xdc\x7b\xc6\x6a\xc7\xfa\xbb\x57\xd9\x7b\xd2\x62\xef\xb9\x64\xa1\x2e\xc8\x34\x82\x22\x44\x4a\x74\x29\x9a\xe6\xfd\xf4\x4b\xb1\x87\x2d\x8c\x7d\x38\xf5\x87\xfc\xcc\x58\x24\x0d\x39\x1c\x5a\x33\x2a\xc6\xdc\xee\xa4\x31\xf3\xa0\x95\x13\xfa\xfb\x98\x95\xb2\xc7\x64\xb7\xb3\xf8\x39\x0b\x67\x4b\x7b\xd9\x81\x24\x56\x58\x6b\x4a\xd1\x97\xe7\xe6\x88\xdf\x18\x85\x81\xbc\x60\x1f\xfd\xab\xb1\x07\x7b\x5b\xba\xe7\x32\x30\x73\x9c\x99\x36\x14\x02\xdb\xa5\xc2\x9c\x36\x97\xd6\x1b\x7b\x86\x1b\x6e\x8e\x61\xe9\x30\xeb\xc6\x38\x9f\x50\x3e\xce\x27\xca\xec\x55\x8b\x5e\xba\xf2\xd2\x84\x38\x85\xb2\x12\x9c\xd4\xcb\x4a\xb1\xe3\xd4\xac\xaf\x53\x12\x61\xf0\x87\x88\x99\x00\x8b\xc5\x74\x22\x17\xb7\x8c\x7e\xf5\xd8\x9d\x60\x49\xe4\x3e\xec\xac\x41\x44\xbe\xee\x4d\x30\xdd\x15\xf9\x76\x0b\x8b\x2c\x98\x64\x65\x9e\x60\xab\x75\x1c\x0a\xd6\x52\x4a\x91\x7e\xa0\xb8\x51\x7b\x52\x91\x1d\x64\x8f\xa4\x2c\x8c\x17\x9f\x98\x34\xf6\x07\x51\x47\xf5\x7f\xc8\xce\x94\x86\x9a\x08\xbc\x2a\x92\x45\xa5\x77\xdc\x98\x8a\xf1\x6c\x62\x59\x85\xff\xa0\x87\xc3\x08\xe2\xf5\x1a\x21\x48\x88\x83\xb2\x5b\x66\x13\x69\x72\xa2\x25\x98\x62\xdf\x16\x8f\xe2\x20\xdf\xfd\x61\x16\x07\x8a\xb4\x8b\x0e\xb2\xa8\x41\x1f\x92\x34\x61\x57\xda\x66\x04\xf4\x0d\x01\xfa\x98\x8a\x25\xf0\xc6\xd2\x92\x04\x60\x7e\x65\x39\xb4\xdf\x80\x74\x32\x15\xee\x48\x83\x74\x16\x75\x1e\xfc\x1d\x22\xb3\x74\x93\x88\x4a\x69\x8d\x06\x2c\x03\xa9\x12\x7d\xf0\x77\x1d\x50\x2d\x94\x15\x05\x45\x5c\x5e\x46\xee\xc8\x2a\xbc\xd3\x36\x39\xad\x45\x8e\x55\x91\x1f\xf8\x8a\x8b\x4e\x06\xf3\x88\x45\x1d\xf7\x21\xd1\x35\xac\xc2\x3b\x8c\x82\xb6\xe4\x5c\xa5\x93\x01\x6c\xf6\xd0\xdf\x61\x03\xde\x89\x0f\x97\x74\x10\x48\x97\x37\xd9\x91\x42\x1e\x91\x88\x23\x45\x99\xce\xbb\x38\x46\xc4\x12\x56\x54\xe2\x05\xcb\xed\xe4\x34\x61\xdf\x4b\xe9\x19\xa4\xda\x32\xa4\x60\xcf\xe3\x14\xf8\xae\xb1\x58\xbb\x62\xeb\x30\x0b\x05\x68\x26\x49\x07\x11\xc9\xe9\x81\x33\x4b\x9d\x9b\x0e\x07\x15\x8e\x48\x72\xfe\x95\x05\x28\xdc\x88\x14\x11\x29\x5a\x4a\x2b\x37\x8d\xb0\xea\xbb\x1f\xd2\x6c\x15\x8a\x00\x49\x6b\xb2\x1c\x11\xe9\x97\x02\xd8\x68\xdb\x18\x96\x41\x00\xe4\x9e\x0b\xad\x71\x84\x30\xb0\xdf\x2b\xad\x37\xd3\x41\xe0\xe9\x60\x24\x0d\x49\x7a\x8f\x03\x41\x60\xe1\x9b\xf5\xbb\x8d\x48\x21\xa4\xb8\x2f\x94\x10\xf3\x1b\xa6\x64\xfa\x6a\xe8\x17\x38\x09\x58\xa6\x71\xc4\x32\x85\x6c\x99\xf4\x11\x50\x8d\xa1\x49\x95\x1c\x52\xda\x0c\x4a\x5b\x1e\x12\xca\x81\x93\x7f\x9f\xde\x41\xd1\x66\x01\x0b\x4a\x8e\x4f\xcc\x1a\x14\x94\x7c\x5f\xb6\xe1\x9a\xcf\xbe\x40\x1e\x33\xcb\x83\x87\x59\x98\x31\x11\x1c\x9d\xc1\xde\xb2\x23\x19\x3a\x45\x32\x0e\xbd\x3d\x7b\x0e\x91\x6f\x8f\xec\xa1\x0b\x99\x57\xe9\x94\x83\xf5\xdd\x40\xd3\xf9\x53\x72\x1d\x4e\x21\x68\x56\xb3\x77\x31\x5f\x24\xbf\xc2\x3a\x05\xb1\x15\x31\x3d\xf0\xc9\x0d\xcf\xc4\x26\x8c\xaf\x66\x59\x1a\xc7\xc1\x4b\xdf\x27\x7a\x37\x0c\xc0\xc6\xf7\x0a\x68\x69\xbe\x7f\x48\x14\xa1\x0a\x1f\xad\x80\xed\x88\x25\xb4\xd2\x87\x59\x29\xa1\x04\x7f\x10\x90\x5c\xed\x3d\x51\xb9\x6b\x24\xcd\x9b\x12\x1e\x21\x3c\xfc\xad\xd7\xb3\x85\xf7\xcb\x48\x2e\x94\x2a\xd0\x47\xbd\xdf\x48\x25\x27\x68\xbe\x2b\x4a\x24\xd7\x82\x5e\xf2\x28\x62\x09\xd2\x1b\x11\x33\xbc\x6b\x55\xaf\xb3\x74\xed\x22\x93\x68\x80\x61\x28\xcf\xd3\xd9\x26\x6f\x84\x2e\x52\x0d\x78\x33\x3a\x66\xa4\xb8\x52\x8a\xe8\xc3\x60\x40\xb8\xbe\x7b\x32\xc3\xc1\xda\x10\x5b\xbb\x5f\x09\xaa\xb5\xb2\xba\x57\x5d\x0d\x36\xdd\xf0\x38\xba\x80\xa5\xba\x86\x84\x17\xce\xc1\x45\xa8\x5e\x16\xf6\xd6\x19\x83\xed\xff\x75\xba\x9f\x56\xdd\x61\xd7\x93\x95\xaa\x26\x63\x89\x8b\xd4\x1e\xbc\x68\xb7\xdc\x80\xb7\x83\xff\x20\xf5\xe7\x36\xf0\x25\xa8\xd8\x74\x05\x10\xb6\x8a\x56\x9a\x99\xa2\x68\xb5\x4f\x9f\xa6\x77\x36\xf4\x1c\xec\xdf\x11\x4f\xd6\x1b\x81\x6a\xcd\xae\xd2\x5e\x6d\xbc\x1a\x47\x83\xd2\x59\xd9\x7d\xb0\x3f\x61\x1c\xa7\xac\xb4\x5a\x30\x8c\x31\x75\x2c\x20\x0f\x32\x2c\xcd\x26\x33\x99\x14\x8d\xf4\xa0\x86\xc5\xbb\xcf\xa5\x16\x54\xe1\x2b\x35\xd4\x86\xc7\x99\xbe\x9b\xc5\x7c\xf6\x05\xac\xf8\x59\xc2\x32\x13\x69\xd0\x2b\x59\xd0\x48\xf5\x5c\x19\x51\xcf\x22\x47\x25\x8b\x7e\x91\x66\xd7\xb4\x46\x48\x50\xb3\x05\xad\x60\x45\xef\xc0\xb6\x41\xcf\x40\xb5\x99\x36\x85\xab\xed\xb9\x09\xe5\x4c\xfc\x83\x47\x62\x59\xc7\x56\x8f\x7a\x96\x8d\x34\x18\xcc\xc5\x5f\xd2\x9c\x43\xb2\x8b\x83\x4a\xa7\x79\x69\xe2\x22\x60\xfa\xa8\xf7\x57\x79\xe6\x29\x5d\x00\xb8\xc7\xf3\xdf\x15\x8f\x72\xb5\x70\xca\x28\xaf\xe3\x49\x99\x37\xe7\x59\x2e\xa4\x4e\xab\xaa\x06\x1b\x16\x87\xa2\x5a\xe1\x45\x6c\x58\x10\xac\x72\xc9\xfb\xae\xd3\x35\xf5\x77\x3b\xdd\x0c\x59\xbe\x16\xb9\x20\x02\xc9\x68\x4c\xf6\x06\x79\x0b\x88\x21\x83\xe2\xce\x7a\x48\xa8\x80\xdb\x50\x4a\x9a\xb8\x0f\x92\x00\xde\xb4\x1c\x87\xc8\x92\x4a\x40\x5d\x54\x50\xcb\x58\x39\x16\x24\x63\x78\x47\x20\x10\xb1\xe4\x9b\x0b\x00\xde\x68\x8a\x00\x2e\xf4\x6d\x05\x40\x8e\x4a\xf6\x6f\x44\x00\xf2\x9b\xfa\x77\x98\x94\x20\x30\xdd\x96\x61\x6e\x29\xf9\xb5\xcb\x71\x04\xcc\x73\x7f\xf4\xf0\xe4\x26\x8c\x79\x54\x1f\x40\x66\x1a\xee\xcf\x5e\xe0\x09\x26\x93\x5d\xb1\x1c\x8b\x06\xad\x1e\xf2\x0c\x4c\xad\x58\x03\x7d\x13\xc6\x6e\x2d\x88\xb1\x97\xce\xe7\x8d\x85\xe0\x1d\x96\xe5\xab\x39\xc4\xea\xe8\x1a\x55\x19\x8f\xb8\xb8\x57\x1f\x8e\xd3\xd2\x06\x8b\xb5\x35\x37\x63\x3e\xaf\xd4\x83\x15\x79\x35\x57\x02\x24\xa6\xf1\x26\xdb\xa7\xd7\x5e\x17\xe9\x25\xae\x8c\x90\xf9\x2a\x85\xcd\xe7\x45\x69\x78\x07\x15\xb5\x69\x3c\xe5\x6a\x05\xe8\xbb\x98\x34\xd5\x05\x2a\x14\x83\xed\x8e\x54\x57\xbc\x60\x6f\x13\x5b\x59\xd0\xb7\xdb\xc6\x05\x75\x84\x3a\xe5\xb2\x0b\x5a\x5b\x51\xcd\x36\x3a\xea\x48\x2f\x6e\xb9\xf4\x2b\x89\x01\xc4\x4e\x8a\x44\xb6\x61\x60\xe6\x81\x60\xcf\x0b\x9e\x90\x55\x89\x60\x84\x3a\xe5\xfa\x1f\x20\x34\xd4\xca\x94\xb3\x17\xf5\xd1\xa9\x1c\x46\x5c\x5c\xba\x03\xaa\x95\xab\x2f\x4f\xe5\x60\x34\xbb\x9c\xa2\x8e\x15\xd7\x07\x09\x5b\x29\x65\x13\xb0\x1b\x47\x24\x83\x7f\x31\xfc\x0b\x29\x42\xe6\x14\xa4\xd2\x60\x25\xeb\x3a\x8e\x9b\xd2\xa3\xb3\x88\xdf\x18\xf1\xf1\xa8\x57\x53\x4e\xf4\x8e\xd0\xdb\x33\x35\x0c\x3a\xb0\x85\xa7\x66\x35\x2f\x04\x4e\xb9\x11\x50\x64\x51\x0c\x42\x53\xe3\xad\x23\xf8\x8a\xe5\xc3\xb3\xe7\x2a\xc7\xdb\xa3\x5e\x03\x0a\x3d\x74\xf6\x3c\xe2\x37\x6f\xcd\xca\xbc\xbf\x7e\x49\x57\xa8\x0a\x92\x72\x7d\xd6\xeb\x13\x7a\x7b\x26\x69\xa1\x91\x53\x02\x40\x81\xdc\x3c\xcd\x56\x7d\x58\x5c\xb2\x34\x56\x5d\x30\x4b\x57\x6b\xd8\xec\x53\x94\xce\xe7\xe8\xa8\xe7\x36\x29\xc6\x1b\x25\xfe\x11\x42\xc1\x51\x67\x5d\x46\x00\xb5\xae\xdc\xc7\x33\xe2\xde\x11\x3a\xc2\xbd\xa3\x0e\x9c\x7e\x83\x5d\xff\x6a\x9a\x02\xe6\x8a\x66\xf2\x40\x8a\xa2\x2b\x8d\xb8\x8c\xd3\x18\xe7\x50\x83\xb5\x20\x5e\x46\xbd\x23\x0d\x51\x6d\x4a\xcc\x73\x29\xf3\x03\x21\x8f\x30\xa9\x0c\x59\xc7\xa9\x60\x58\xee\x3f\x1c\xc7\x8d\xab\x9d\x3f\xcd\xfb\x3a\x19\xd0\x7b\x5b\x49\x12\x89\x1a\x81\x9d\xe2\xab\x9f\xaf\x64\x60\x1a\xa7\xb3\x2f\x8f\x0c\x12\x5d\x2c\x0c\xd5\x4e\x29\x5d\x85\x71\x0c\x25\x74\x8e\x7a\x95\x9d\x21\x0c\xb9\xda\x58\xa9\x6c\x54\x7b\x47\xc5\x90\xfa\x96\x4a\x23\xf6\xcd\xd5\xd6\x76\xc8\x3d\x54\x56\x2c\x07\x6d\x75\xe8\xb6\x50\xbc\xdc\x39\x4b\x8f\x8f\x3a\xc5\x21\x59\x23\x7e\x80\xe2\x4f\x21\x32\x90\x12\x3a\x24\x5f\x3d\xa5\x69\x95\xfd\x7c\x7b\xcb\x92\xea\x10\x31\x6b\xf5\x9e\xde\xfb\xa8\xc7\x7a\xfc\x51\x66\x51\x43\xa2\x50\x13\xf4\x8e\x3a\xa6\xe4\xbe\xf2\x15\x45\x9d\xa3\x9e\x0b\x3e\x65\x82\xcf\xd0\x9e\xec\xad\x36\x9d\xa3\x23\x90\xa7\xfa\x3a\x44\x0d\x34\xb0\x61\xdc\x53\x69\xda\xf1\xb4\xc0\x1b\x1d\xf5\xf2\xb6\x99\x98\xde\x26\x07\x66\xdc\x32\xcc\xd7\xa0\x2d\x50\xb3\xad\xcc\xc5\xee\xd6\x61\x12\xb1\x88\xa2\x79\x18\xe7\xac\x3a\x6d\xd4\xcd\x0b\x7d\x6d\xef\xd2\x9e\xd4\x57\xdb\x98\xc7\x00\x0a\x30\xab\x8f\x3a\x7a\xda\xf7\xaa\x6a\x7a\xc9\xad\x2a\x9a\x81\x69\xde\xd7\xca\x81\x5a\x2f\x18\x85\x82\x27\x93\x61\x28\x40\xb6\xb7\x08\xdb\x13\xcd\x42\xec\x48\x9b\x38\x49\xd3\x82\xfd\x5a\x95\x16\x1c\x98\xde\xdb\xa3\x5e\xda\xcb\x7a\x71\xaf\x32\x84\x64\x1b\xe4\x08\x05\x38\xa0\xaf\xe2\x8b\x05\x59\x79\xd4\xd8\x0b\xe6\x4a\x15\x8a\xfa\x03\x18\x1d\xa2\x77\xf4\xf6\x6c\x13\x37\x60\xa5\x6a\xd8\xc3\x4d\x21\xa6\x06\x87\xa9\x74\x0d\xc7\xb2\x09\x8c\x1b\xd9\x3f\xcf\x37\xb1\x26\xeb\x51\x2f\xec\xa1\xca\x5c\x20\x5f\xdd\x04\x83\x42\xad\xd8\x98\xc0\x46\xda\x96\x33\x2c\x94\xb5\xce\x16\x8e\x88\xbd\x59\x98\xfc\xc8\x17\x4b\xa9\xfc\x87\x63\x13\xa3\xa6\x66\xb4\x25\x03\x68\xc6\xa8\x3f\x64\x67\xfb\xe9\xfa\xf8\x59\xee\x35\x8c\xb6\x9f\x19\x17\x35\x41\x0f\x67\x18\xb3\x09\xe1\xb4\xeb\x0f\x0b\x6b\x3c\x98\x58\x1e\xcc\xd4\x91\xcb\xc1\xf9\x4d\x78\xea\xca\x17\x5a\x39\x3f\xf6\x34\xf8\x8f\x32\x0d\x07\xa5\x91\x90\x5c\xbc\x9e\x5c\x8a\x9e\x83\x3f\xb2\xd0\x2a\xac\x05\x38\xe6\xaa\x36\x22\x0a\xc7\x59\x29\xfa\x80\x25\xd7\x13\xe8\x6c\xfc\x82\xc1\xfe\xc7\x6d\x81\x87\xba\x40\x71\xe4\xad\xd3\x1c\xac\x91\x04\x3d\x00\x88\x35\x1c\x0c\x14\xea\x82\x36\x80\x8d\xfc\xe0\x31\x7a\xf7\x07\x93\x22\x17\xee\x99\xa6\xee\x76\xa4\xd8\xa6\xda\xe3\xc7\x78\x32\x76\x6b\xec\xae\xa2\x75\x73\x9c\xfd\x4a\xc1\x4c\xc1\xd3\x12\xa4\x31\xa2\x79\x7b\xa0\x8c\xed\x76\x5f\x9f\x51\x01\x30\xd2\xf4\xef\x60\xe2\x50\x60\xf8\x8e\x14\x96\x1c\x1f\xc8\x47\x72\x21\x0b\x20\x9c\xfa\xe4\x47\xe3\xfe\xbd\x87\x9a\x65\x1c\x41\xdf\x91\x56\x7a\xd1\x77\xa3\xfd\x34\x6d\x75\xb1\x9f\x00\xed\x35\x65\x55\x66\xa3\x8b\x09\x93\xde\xee\x98\x37\x68\x28\xca\xbd\xbb\x32\x1c\x02\x5b\xa4\x8b\x62\xd9\x36\x86\x6d\x17\x85\x30\x0e\x4a\x8d\x84\xba\x05\x8d\xc6\x06\x0d\x16\x5d\x02\x27\x9a\xc0\xa1\x0f\xf6\x62\x2e\x43\x70\xaa\xb5\x67\xf8\x90\x38\x8e\xec\xcf\x8b\x82\xce\x30\xea\x8c\xf1\xd7\xc5\x7e\xb3\xd4\xc0\x49\xe0\xf2\xaa\xd4\x71\xd2\x62\xf4\x0c\xe1\x4a\x00\x4e\xb3\xbe\x7b\x51\x4e\x92\xa2\x71\x6a\xae\xf4\x2e\xf6\xe7\x0f\x7e\x7e\x6c\xdf\x95\x10\xd7\x4c\xdc\x48\x4a\x32\x12\x93\x90\xcc\x48\x44\x2f\x1a\xbb\xa6\x3e\xb0\x08\xdc\x7c\x43\xd6\xb4\xeb\x93\x0d\xbd\xb0\x15\x2e\xc3\x5a\x01\x6a\xf2\x14\xfa\x12\x46\xb8\xba\xc9\x60\xc6\x78\x7c\xa0\x19\xcf\x1b\x9a\xf1\xdd\xc0\x3b\xc5\x24\x57\xf9\xe5\x75\x5e\x6e\xf4\x9c\xe3\xed\xb6\xbc\xf0\x60\x4e\xfd\xe1\xfc\x2c\x1f\xce\x0d\x3b\x5c\x51\x77\xde\x1b\xe0\xef\x38\xf4\xcc\x9c\x52\x9a\xf7\x07\x8e\xe3\xae\x68\x84\xc9\x72\x3c\x9f\xd0\xf1\xfc\x3b\xde\x73\xe7\xa3\x41\xe0\x63\xb2\x9a\x90\x6e\xa4\xbc\x68\x87\xc5\x15\x6b\x99\xe3\xb0\xfe\xe0\xac\x8d\x32\xb2\xaf\x56\xf6\x24\xef\xb7\x37\x4b\xee\x63\xe6\xd2\x02\xce\x2e\x5f\xde\xf3\x40\x62\x3a\x6e\x22\x9e\x29\xd6\x27\x87\x52\x07\x30\x4c\x8b\xab\x21\x7c\x92\xf5\x07\x98\xa4\xe5\xad\x11\x79\x7f\x40\xb2\xde\x00\x1f\x2c\xc4\xa7\x5d\x30\xda\xdc\x8c\xfc\xc0\x2a\x6a\x39\x4e\x26\xa0\x02\xdc\x6e\x1f\x41\xc1\xe4\x8e\x74\x6e\x9e\xb8\x11\x59\x8e\xd3\xc9\x78\xa0\x72\x87\x34\x1e\xfb\x13\x39\x15\x0e\x20\xb1\xdd\xc6\xe3\xc1\x63\x50\x83\x52\x7f\x7a\xe1\xc1\xd6\xe2\x86\xc9\x19\xe8\x38\xee\x47\x7a\x71\x80\x35\x8e\x2f\xbc\x75\xc6\x6e\xde\x95\x59\x26\xe4\xc7\xc7\x72\x58\x15\x4c\xc8\x87\xc7\xa0\xab\x0c\x82\x80\xd1\x5d\xa5\x08\xab\x69\x1a\xc8\x71\x2e\xe0\xd8\x17\xd4\x04\x97\x82\xad\xdc\x1f\x31\xa9\xe4\xa0\xaa\xb1\xb5\x58\xc7\xa9\x04\x1f\x2f\xf6\x83\x6d\x50\xbb\x47\x07\xc7\xd9\x8b\xda\xa7\x6e\x33\xc8\xc1\x5a\x3f\x62\xe2\x8a\xed\x36\x04\xbd\xc5\xac\x4e\x3b\x39\xfe\x6e\x78\xce\xa7\xb1\x31\x48\xcf\x47\x4f\x80\x51\xb7\x40\xb9\x18\xae\x93\x7b\x02\xb8\x19\x9a\x2d\x93\xd8\x74\x5e\xf0\x48\xba\xae\xf5\xd0\xc0\x7c\x64\x8e\x40\x17\xe6\x4c\xa8\x93\xe4\x2b\x11\x8a\x4d\xee\x62\xe2\xbe\xdb\x6e\x15\x86\x8e\x03\xf7\x53\xb8\x6b\x5a\x5e\x7f\xc8\x08\x37\x72\x41\xc7\x5c\x81\x06\xf7\x01\xe8\x4f\xf0\x56\x62\x37\x2c\xbb\x77\x9b\x8e\xa4\x3b\x0c\x40\xc7\x62\xb2\xc3\x3b\x77\xf6\x14\x52\x61\xd5\x5d\x52\x3a\xd8\x60\xc7\x59\x6b\x25\xfa\x0d\x59\x90\x29\xbd\xa8\x29\xd2\x6f\xe9\xa3\x4e\x00\xe4\x92\x4e\x5b\x95\xed\xe4\xee\x29\x23\x82\x7c\x01\xe1\x62\x5a\xd5\xcb\x5f\x12\xbb\x58\x3c\x94\xcc\x5f\x2f\x04\xf7\xf4\x4e\x93\x67\x38\x3f\xbb\x2f\xd7\x83\x67\xe4\x8a\xbc\xa7\x77\xe3\xf9\x64\x68\x2d\xc7\xfa\xa0\xd2\x71\xdc\x67\xf4\xbd\x17\x87\xa6\x48\xc7\x71\xaf\x68\xcb\x90\x90\x7c\x7f\xde\x3b\xd4\xd9\xfe\x04\x3b\xce\x95\xb1\x08\x77\x9c\xee\x55\x51\x55\xe4\x38\xee\x17\x25\xa0\x3e\xc3\xc4\x8a\x97\xc7\x79\xb7\x15\xab\xda\xf7\x72\xbd\x68\x42\xf7\x4b\x31\x04\x7e\x71\xbf\x90\x12\xa2\x38\x69\x25\xfb\xb9\x7e\x48\xf4\x49\xd6\x66\xe4\xde\x50\xe8\xe4\x83\x4d\x18\xf9\x6d\x73\x42\x12\xe0\x60\x5e\x7b\x51\x24\x8b\x83\xf5\x0c\xde\x46\xfd\xc1\x23\x75\x45\x76\x79\xfd\x43\x90\x07\x6b\xaa\x60\x55\x19\x98\x52\x15\xe1\xad\xc2\x6c\xc1\x13\x38\xdc\xb9\xe9\xa1\xf5\x1d\xaa\x8c\xb2\x0a\xcc\xf7\xa9\x10\xe9\x8a\x2e\x24\x18\x0e\xdc\x83\x85\xf9\x8f\x96\xe3\xe3\x2a\x88\x3d\x06\x6e\x4d\xa7\x39\x8e\x25\x5d\x2c\xc3\x5c\x1d\xc3\x7f\x1f\x6a\x9b\xbc\xf3\xea\x54\x4b\xe7\x73\x73\xdc\x06\x02\x90\x70\x9c\xf3\xb3\x26\xe9\x44\x4e\x67\x79\x2a\x57\x29\x5f\xa4\x22\x8c\x7f\x66\xc9\x46\x26\xbd\xb5\x52\x14\x7d\x65\x34\x6e\x6a\x17\x4f\x64\x1a\x3d\x5c\x99\x24\x5c\x21\x87\x9f\xbf\x3d\x0c\x8d\x1f\x34\xf3\x91\x62\x7c\xb5\x1e\x5f\xea\xbe\x3f\xb5\xb7\xfe\xd3\x23\x85\xcb\x35\xfa\x10\x00\xfd\x44\xfe\x0f\x1b\x8a\x49\x3b\xfe\x08\xed\x76\x6a\x96\xd7\x96\xd7\xea\xfa\x4b\x2e\x1a\xd4\xcc\xf2\xd4\xf3\x1d\x2c\x1c\x72\x08\x7c\x25\xd7\xd4\x1f\x5e\x3c\xb2\x39\x1e\x5f\x4f\xb6\x5b\xf7\x9a\x0e\x7a\x8f\x41\xea\x35\x6f\x80\x8b\xab\x67\xba\x3e\xc6\xe4\xeb\x53\xf8\xf6\xf8\x7a\x42\xaa\xc2\x40\x63\x6d\x6a\x95\x55\xad\xae\x4b\x3e\xee\xa1\x89\x7e\xad\xb7\x5f\x5c\x13\xa7\xac\xe7\xab\xb9\x82\x50\xd3\x5c\x76\xc8\xde\x11\xd5\x2e\x76\x39\x29\x0d\x28\x4a\x40\x75\x1c\x27\xe7\x96\x5e\xd7\x60\x1b\x8c\xd4\x59\xdc\x7e\x7c\x79\x22\x26\x97\xde\x0b\x2f\x49\xcd\x2e\x3b\xd6\x1b\x62\xb3\x05\x22\x02\x93\x32\x9d\x76\x07\x3b\x4c\xbe\x9a\x7b\x0f\xcd\x19\x1c\x0c\x44\x75\x0e\x88\x6a\x4a\x30\xb4\x8f\xce\x93\xc1\xed\x73\xbb\x8b\xca\x69\x72\x71\xd0\xa4\x15\x77\x8e\x13\xbb\x36\xe1\x2a\x1b\x67\x38\xb0\x23\x05\xa5\xf7\x5c\xc3\xc0\x40\x14\x3f\x08\x2a\xf4\xf9\x5a\xcb\xf6\x56\xa6\x59\x1d\x3d\xd1\x2e\x05\xf6\x59\xfb\x10\x7c\x06\xea\xae\x5b\x52\x13\x9b\x33\x21\x1b\x4d\x0e\xaa\x6b\x1a\xb3\x1a\x4d\x0f\xb2\xb4\x3e\xc0\x5d\xbb\x94\x16\x8a\xa2\x8a\x9d\x43\x53\x21\x0a\x71\xb8\x2e\x9a\x25\x51\x98\x08\x44\x72\x8f\x47\x98\xb0\xfa\x99\xb1\x02\x04\x2f\xce\xb6\x14\xb8\x91\x91\x44\xac\x89\xa0\xf8\x81\x39\x8e\xcb\x1a\xce\x70\x8b\x62\x6d\x72\x39\x8e\x1d\x3a\x90\x4b\xab\x30\xcb\xe3\xa9\xfd\x93\xd2\xee\xa0\xd0\xe5\xd4\x5c\x45\xba\x95\x83\x8e\x56\x8d\xa7\x84\x56\x62\x2e\x5c\x49\xf7\x28\x10\x6d\xf3\x4e\x35\x8e\xa2\x98\x30\xd0\x5b\x5a\x4a\x4e\x5b\x47\x23\x95\x8e\x39\xed\x3e\x5e\x91\x3e\x5e\x05\x6b\x0f\x68\x62\x8e\x9f\x90\xa5\xb0\x94\x96\x07\x64\xb2\x30\xad\x94\x47\xe4\x09\xd9\xa5\xed\x22\x9c\xc5\x72\xbd\x91\x83\x3b\x9e\x2d\x8b\x66\x51\xdd\x40\x4d\xb0\xb6\x01\x32\xb1\x70\xe4\x5b\x64\xac\x9e\x15\xdb\x36\xbe\x00\x38\xec\xe6\x8e\x53\x80\x1d\x46\x4b\x72\xcd\xed\x56\x78\x30\x07\x32\xf1\xbd\xf4\x66\x78\x42\x57\x11\x61\x0d\x33\xe9\x5e\xe9\xd6\x5a\x40\xfd\xd2\xf5\x6c\x47\x0a\x8b\xb3\xfa\x30\x5b\xd3\xa3\x20\x49\x85\x3b\x56\x87\xc6\x13\xac\x42\xd0\xa6\xea\x39\xf2\x04\x1f\x91\x0d\x1d\x4f\xc8\x9c\xfa\xc6\x08\xb0\x3a\x82\x5d\x0c\x2a\x9c\x61\x65\xc0\xda\x46\xa1\xb0\xa7\xea\x51\x24\x2b\x08\x8c\x36\x19\x23\xdc\x32\x9e\x1a\x6e\xd6\xd3\x76\xb1\x6f\x3b\xdf\xa1\xde\xda\xba\x26\x61\x65\xfc\x6a\x04\xdd\x8c\x37\x5a\x22\xef\x0f\x26\x43\xf0\xbd\xdd\x53\xb2\x1b\x4b\xf6\xcb\x0b\xb8\x79\x52\x7e\xe0\xed\xd6\x85\x2b\x28\xd4\x52\x06\x4a\x43\x5a\xe4\x23\x1b\xb5\x49\x00\x27\x8d\xfa\x35\xe3\x7c\xee\xba\x92\xcd\x42\x36\x9d\x41\xd3\x0b\x54\xd3\xde\xa2\xc2\xb6\xf4\x31\x99\x84\x42\xa0\xc9\xd6\xdf\x78\xe5\x3e\x48\x34\x02\x8d\xd7\x4e\xb9\x54\x6a\xb3\x4b\xd3\x20\x75\xe3\xa7\x14\x5a\x66\x79\x7e\x0d\x6e\x81\x09\xcd\x47\x57\x6e\x8e\xc1\xe2\x21\xa5\x86\x4d\x81\x3f\x81\xbc\x67\xa0\xa7\x1a\x2a\x0f\x09\xa4\x15\x83\x8c\x1d\xea\x5a\xa4\xd9\x01\x1c\x22\x74\x50\x2f\x05\x74\xd4\x74\x92\x80\x34\x85\x85\x7a\x05\x66\x4f\x1e\x4f\x62\x9e\x30\x69\x4e\x46\x13\x22\xa4\xfb\x2c\x65\xb6\xa3\x22\x11\x66\x87\xd5\xdc\x66\x9d\x28\xdb\xac\xae\xf9\x6b\x86\x53\x69\x12\x4c\x3b\x3e\x36\xc3\xe9\x44\x09\x08\xb6\xbb\xcd\x50\x90\x22\xd9\xb3\xd6\x0c\x53\x2e\x49\xae\x4e\x29\x0b\x8c\x61\xf2\x41\x8b\x00\x35\xd9\xef\x05\x53\x51\xc2\x96\xcc\xa5\xe2\x28\x23\xe5\x24\xa3\xdd\x6e\x79\x6d\xa9\x75\x3c\x42\xcb\xcf\xed\x16\x60\x4c\xc8\x8c\x23\x61\x2b\x82\x79\xc5\x2b\x13\x4e\x86\x72\x2a\xc6\xac\x2f\x75\x89\x62\xcc\x7a\x03\xb8\x0f\x91\x37\xcc\x05\x8d\x26\xcc\x03\x3e\x77\x53\x3d\x46\xf4\xdd\xd4\xa0\x4b\xa6\x0f\xba\xad\xc1\x95\xcb\x3d\x79\x44\x54\x5e\x64\xc0\x0f\x93\x15\x48\x17\xf0\x03\x44\x85\x29\xb2\x77\x00\x45\x2a\x63\x0d\x6e\x28\xe9\xb9\xbc\x36\x24\x77\xa0\x08\x80\x5b\x17\x8b\x31\x3f\xdf\x61\x12\xaa\x11\x49\xe7\x86\x48\x61\xe9\x46\x32\xa3\x3e\x89\xa8\x69\xe0\x70\x76\x16\x0d\x67\x46\x97\xb0\xa4\xe9\x78\x36\x19\x02\xb3\x55\x3e\x13\x6e\x46\xcb\xf9\x8f\x7b\x11\x26\x37\xee\x92\x3c\x28\x3b\x1b\x39\x0a\x82\x8c\x80\x76\x41\x7d\xc7\x80\xf2\xe5\x45\xa0\xeb\xaf\x35\x20\xac\x4e\x1e\x62\x7a\x32\xe0\x45\xa7\xee\xf0\x2e\xa9\xb6\x65\xb7\x33\x88\xe7\xc5\xdd\xc4\x43\x76\x96\x97\xe7\x83\x09\xf4\xf0\x64\x58\x5e\x5c\xdb\xa5\x34\x31\xb7\xd5\x8e\x6e\xdc\x84\x3c\xec\x70\xa0\x46\xc6\xee\x80\xfc\xd6\xb0\xbe\xd8\x92\x39\xdd\x68\xb6\x0f\xf2\x47\x9d\xed\xe7\x15\x63\xee\xe6\xf2\x49\x02\xec\x3e\x05\x95\x8e\x19\xae\xa2\x60\xb4\x70\xde\x34\xcc\x6f\xb9\xbc\xc0\x5c\xce\x1c\xfc\x30\x0b\x73\x56\xb0\xcd\x40\xd0\x9f\xbc\x98\xbb\xdd\x41\xe9\xad\x45\x34\xbb\x1d\xe9\xdf\x1e\x40\x23\x7d\x01\x33\x1e\x2a\xad\xbf\x2c\x45\x8f\xee\xc0\xd5\xa5\xfc\xe4\x85\xee\x4f\xda\x2f\x1c\xee\x90\xcd\xc1\x26\x97\xd9\x1c\x8b\x30\x9b\x51\x61\x82\x90\xa9\x0d\x63\x6b\xad\x94\xcc\xbf\x0c\x7a\x3c\xa2\xa6\xfd\x20\xda\xf7\xa5\xab\x37\x8c\x8d\x3a\x3a\xf6\x40\x37\x6d\xfb\x49\x4d\x2b\x0b\xa5\xc2\xc2\xc1\x38\x91\xf5\x58\x6d\x10\x19\xa4\x76\x89\x61\x07\xe0\x26\xa4\x66\x2a\x9c\x2d\xf5\x4a\xdf\x76\x3d\x7a\xa0\xa1\x7a\x6a\x1a\x00\x1d\xb4\x00\x60\x5e\xaa\x54\x38\x74\x38\x03\x81\x20\xa5\x9c\xd4\xfa\x56\x8a\x45\xb7\x3c\x62\xb9\x56\x7f\xd2\x64\x6c\xee\x58\xed\x0f\x26\x78\xd7\xdd\x37\xc6\x73\x9c\x6e\x29\x6b\x6e\xb7\xd6\x3d\x1d\x96\x18\xb7\xdd\xba\x76\x42\x31\xe7\xab\x87\x9b\xc6\xcf\x42\x9a\x2c\x94\xd1\xc6\xd5\xa2\x87\x3a\xb2\x88\xfe\x2a\xcc\xbe\xc0\x8d\x20\x61\x45\x01\x63\x55\x60\x3d\xae\xc1\x8b\x37\x29\xf4\x9d\xb1\x5c\x5d\x16\x2b\x5c\x36\xce\x26\xad\x13\x48\xcb\x19\x39\xdd\x4f\x37\x93\xa8\x00\x49\x76\x04\x8c\xd7\x3f\x70\xeb\xc9\x80\x42\xb9\x5b\xdf\xb6\x02\xa4\x31\x88\xef\xbc\xed\xc0\xc5\x2e\x3b\xa2\x4c\x3a\xeb\x93\x90\x69\xbb\x46\xc2\xf7\x84\x1f\x92\x37\x4b\x58\x4a\xb0\xe4\x55\x51\x0f\x9e\x78\x80\x07\x4c\xda\x04\x2f\x38\x85\x32\xbd\x4c\x8c\x05\x7f\x61\x9e\x4a\x62\x9a\x55\xd7\x17\x17\x79\xed\x56\x2f\x70\x4d\x6e\xdb\xed\x34\x15\x0c\xf6\x7c\x92\xe0\xfe\xea\xf2\x6e\x82\x9a\x0e\x3a\xd2\xdb\x9d\xac\xe1\x56\xfc\x69\xde\xb7\xec\xfd\x60\x65\x36\x65\x8f\xba\x69\xd0\xbd\x76\x39\x49\xb0\x56\x20\x88\x70\x2a\x69\xe2\x62\xd2\x66\xb6\xb4\xef\xda\x84\x67\xd4\x66\x2e\x50\x10\x79\x80\x70\x50\xc9\x28\xe5\xef\x1d\x28\x2b\xca\x83\x6b\x38\x31\x70\x6b\x76\x67\xfd\x41\xf7\xd1\x2a\x0b\x4d\x0e\x92\xae\x72\xb0\xbd\x18\x9c\xa5\x70\x67\xcb\x99\xcb\x1e\xcd\xac\x9d\xaf\xdf\xc2\xdd\xbd\x46\xff\x9c\xbe\x65\xe3\xc1\x64\xbb\x05\x8c\xcc\x94\x70\x9c\xe3\x33\x9a\x62\x2c\x25\xd5\xae\x75\x09\x33\xa8\xeb\x96\x50\xdf\xf2\xec\xd4\x1f\x2e\xcd\xd2\xb4\xa6\xc9\x78\x39\x21\x9b\x86\x59\x51\x2c\x0b\xe3\xb5\x39\x6a\x87\xbd\xc0\xc3\x6e\x58\x6b\xbd\x7f\xb6\x74\x9c\x59\x65\xe6\x86\xd5\xce\x86\xdb\xa8\x25\x2d\x47\x73\x49\x75\xaa\x83\xc1\xc6\x71\xdc\x8d\x11\xcc\xc0\x29\xb8\x20\x41\xe9\x30\x36\x72\xe7\x06\x82\x16\xb0\xf6\xed\xcf\x52\x7f\x1f\x94\x5b\x3b\xcf\x78\x9e\x39\x8e\x3b\x97\x2c\x88\x6e\xe4\x4f\x29\xf2\xe6\x9e\xe5\xac\x06\x4f\x1f\x58\xcd\xd9\x58\xbc\x77\x6e\xbe\x29\x30\xb0\x22\x05\x93\xb2\x1d\xa5\x50\xac\x05\x68\x8c\x49\x95\x18\x7b\x23\x6d\x0e\x23\x0a\xef\x5e\xbc\x39\x4b\xeb\x84\x6b\x9b\x68\xc8\xf3\x3c\x84\xb5\x7e\x4d\x9f\xb2\x3f\x79\x1f\x67\xa5\x54\xb7\x2c\x13\x7c\x74\x70\xf7\xb6\x6a\xdd\xbd\xdd\xec\x31\xb0\x43\xbb\x37\x23\xba\xae\x7a\x88\x74\xcc\xea\x28\x83\x65\x92\x19\xd7\x64\x41\x1b\xde\x2c\xaa\x60\xb9\xe7\x6b\x3a\x3a\x9c\xec\xa6\xe4\x06\x07\x87\x61\x86\xad\x1c\x61\x61\x4e\xc0\x5c\xf0\x51\x45\x24\xb5\xaf\x2d\xb4\xd2\x06\x3b\x44\x6e\xec\x34\xc9\x38\x76\x95\x07\x32\xea\x7a\x9d\x06\x65\x4f\x95\xb0\xb5\x2b\x40\xc8\x6c\xff\xd6\x9e\xed\xd6\x6d\xc5\xbd\x38\x78\xde\xaf\xa7\x4a\x33\x59\x75\x95\x44\x75\xf7\x60\xd9\x1c\x4c\x32\x8d\xe6\xac\x32\xf4\x0d\x19\xd4\x6d\xeb\xdf\xbd\x1d\xa9\xeb\xd6\x8b\x3d\xa5\x5d\x70\x79\x96\x16\x39\xce\x2f\xee\x78\x36\x21\xa2\xe1\x18\x4d\xec\x65\x80\x63\xb4\xd8\xba\x13\x08\xac\xed\x2b\xb3\x67\x86\xc9\x9e\x81\x7f\xa9\x71\xad\x7a\x66\x68\xd7\xa7\x1c\x7c\x33\x4e\xfa\xcb\x30\xef\x87\x51\x04\x5b\x1d\xc5\x18\xa7\xed\x6b\xa4\xb2\x3c\x85\xeb\xdb\x68\x6c\xf3\x39\x1f\x0f\x6f\x2d\x41\x08\x55\xc1\xc9\x74\x94\x99\xd1\xa2\xcf\x97\xc8\x14\x07\x59\xa5\x01\xb7\x78\x57\xed\xfe\x42\x79\x5e\xf1\x11\x21\xc6\xdb\xab\x14\x30\x6c\xf3\xa2\xbd\x05\x7f\xdf\x4b\x10\xc4\x80\xb4\xb6\xea\x80\x18\xad\x3b\x6c\xd8\x34\x08\x65\xd3\x10\xde\x27\x6c\xf9\xf6\xd3\x81\x6c\xc5\x4c\x79\x6e\xaf\x32\x5b\xe5\x6d\xd5\x8f\xd8\x0d\x9f\xb1\x6d\xdd\x03\x70\x2b\x5d\x66\x42\xc1\xfe\x1c\x7b\xdf\xfd\x39\x81\x07\xe8\xe4\x25\xfe\x56\x2f\xbb\x49\x5d\xc9\x3b\xcd\x4f\x10\x26\x89\x25\xac\x36\x76\x7b\xdd\xbd\x23\x91\xc7\x40\x3c\xdd\xe4\xba\x59\x57\x7c\x1a\xf3\x64\xb1\xdd\x26\x5e\xc2\xee\x44\x35\xf6\xdf\xc8\xf1\x18\x16\x66\x00\x3a\x4e\x53\x93\x2a\x43\x94\x70\xca\x46\xa6\xb3\x82\x94\xa0\x30\x8a\x10\xf8\xb0\xef\x5f\x2d\xa7\x2f\x0e\xb0\x62\x09\x37\xe2\x44\x07\x61\x1c\x20\xe5\xa3\xd4\x94\x5d\xa5\x3c\x5a\x82\x9b\x3e\x31\x5b\x6a\x67\x23\xdf\x8e\x2b\xde\x11\x63\x98\x66\x8d\x7c\x29\xeb\x30\x30\xe6\xd8\x93\xc2\xa4\x81\x41\xd7\xbe\xd8\xb5\x62\xed\x6a\xd6\x1c\xbd\xc5\xad\x8b\xb9\xba\xff\x5c\xb9\x69\xc5\x07\xde\xc5\x52\xe9\xf9\x23\xe9\x49\x6b\xfa\x26\x46\x20\xb2\xb7\x25\xc3\x96\x82\x64\x87\x93\xe3\xc3\xc9\x61\x6b\x32\xdc\x53\x39\xa3\x8f\x5c\x96\x19\xd1\x46\x2f\x26\xff\xac\xdc\x0c\x99\x7d\x10\xaa\x7b\x32\x19\x22\x8f\x9e\x02\xab\x78\xb4\xc5\x52\x95\x1b\xfb\x92\xb6\xb8\xfb\x8c\xda\x10\x97\x5d\xa2\x32\xaf\xab\x99\xb5\x7b\x8a\xf4\xc4\xd9\x93\x64\xf7\x71\xac\x3a\xe7\x1c\x68\x4c\x0d\xb0\xa5\x25\x9b\x2a\x32\xb6\x93\xca\xd3\x90\xb1\xfc\x56\x0e\x23\x63\x03\xb6\x20\x33\xa7\x55\x86\xad\x32\x6b\x91\x0c\x2e\x7d\x2a\x6d\x8b\x1b\x8c\x10\x9a\x16\x15\xfb\xfc\x9f\xcc\xec\xd5\x10\xa4\x05\x44\xda\xaf\x19\x05\xdd\xe1\x7c\x34\x2f\xd3\xc1\x61\x80\xd8\xf7\x86\x96\xad\x84\xe6\xd4\xb9\x3b\x80\x48\x35\x83\x3a\x4e\x05\xc5\xad\x5c\xd0\xe4\xdd\x15\xd4\x27\xea\xc6\x8b\xfa\xd6\xf0\x56\xdb\x21\xf0\xba\x71\x80\x5f\xbc\xc9\x06\x05\x53\xcb\xf3\x42\x97\xaf\x99\x94\x4c\x45\xc6\xf3\x41\xa7\x25\x4d\x39\x1f\xf5\x8e\x20\x69\x25\x9b\x51\xa1\x65\x8d\x24\xd3\xda\xa6\xda\x56\xa3\x75\xfb\x00\x17\x7a\xfb\x53\x58\x39\xaa\x2a\x96\x19\xae\x89\x50\x21\x48\x78\x15\x88\xea\xc0\xc1\x64\x7f\xa7\xb8\xa7\x64\x82\x15\xcc\x2e\xe3\x09\x59\xea\xb5\x54\x0b\x88\xeb\x11\x69\x3d\x22\xc3\x44\x7a\xe1\xda\x71\x60\x6f\x6c\x4c\x92\xdb\xf8\x84\xbe\x9e\x60\xb8\xb4\xa9\x5c\x71\x74\x24\x2b\x3b\xa9\xe2\xd9\x48\x96\x95\xfa\x56\x98\x54\x11\x58\xe2\xdd\xba\x8e\xd4\x1a\xd7\xee\x76\x4c\xea\xb9\x72\x4c\x36\xf5\x5c\x1b\xd8\xbb\xda\x11\x1c\x93\xa2\x49\xd3\x34\xba\xaf\x24\x0a\xbd\x49\x23\x0b\x1a\xeb\x19\xa9\xbd\x3a\xa6\x34\x1b\x65\x95\xa8\xc0\x27\xb7\x34\x1a\x45\xf5\xc8\x4b\xba\x1c\x2d\xeb\x91\x77\x74\x3d\x5a\xd7\x23\xbf\xd0\xcd\x68\x53\x8f\xbc\xa7\x5f\xdd\x14\x7b\xe9\x46\x18\x3b\x6c\xe8\x57\xf2\x8c\x76\xbb\xca\xee\x02\xce\x1a\xce\xd3\xd5\x1a\x2e\x10\x96\xb2\xac\xe3\xb4\x24\xb8\x1c\x93\x2b\xca\x75\x0d\x72\x7a\x92\xf7\xf4\xd9\x08\x58\x58\xf0\x15\x52\xcf\xe9\xc3\x0d\xcb\x44\xf0\xc1\x7d\x36\x7a\xe6\xad\xd5\xb5\x29\xd7\xe9\x3a\x78\xef\xcd\xf2\xdc\x45\x65\x0c\xc2\xb8\x57\x81\x52\x76\x60\x35\x40\x15\x59\xc2\x4e\xd3\x2c\x62\xd9\x75\xba\x96\x95\x1b\xe0\x6a\x6c\x1d\x5a\x95\xd1\x90\xc1\x4a\x80\xf9\xb8\x4c\x33\xfe\xb5\x8a\xf9\x07\x36\x17\x35\x8c\x20\xaa\xac\x41\x47\xaa\xeb\x62\xaa\x80\x32\xae\x8e\x0b\xe4\x6e\xc0\xa4\x88\xae\xc3\xcb\x42\x1a\x32\x94\xf1\xa0\x8b\x20\x9f\x34\xd9\xcf\x3d\xf8\xd1\x25\x14\xd6\x77\x26\x6b\x11\x51\xd6\x62\x9b\xe0\x55\xc1\x4a\xca\x1f\x6b\xca\x9c\x7b\xf2\xb7\x92\xd3\x26\x50\x19\x53\x2f\xbf\x42\x1e\x2b\x0a\xe0\x8e\x77\x43\xb3\xe3\x81\xdb\xc7\xe7\x71\x7a\xfb\x07\xd5\x46\x46\x88\xdc\x54\x47\x5b\xff\xaa\x36\xd7\xf4\x83\xb4\x7a\xae\x91\xea\x0a\x69\x24\x54\xba\xa8\x25\x34\xf9\x61\xd1\x69\x0d\x68\x69\x27\xde\xd6\x12\x15\x53\xd2\x89\x97\xb5\x44\x2d\x7c\xe8\xd4\xbb\x5a\x6a\x29\x68\x68\x80\x2f\xe4\x80\xaf\x19\xbd\xaf\xa5\xc2\xaa\x6b\x6e\x0d\x3d\x6f\x48\x7b\x7f\x27\xb2\x30\xa7\x9f\x1a\x92\xe4\x38\xa2\x57\x0d\x29\x75\x6b\xbf\xab\xbe\xee\xec\x1a\x6c\xd5\x24\x92\x56\x13\x8b\x2a\x6a\x99\x72\x63\xa4\x29\x13\xe9\x4d\x3d\x59\xae\x46\xba\xb5\xed\x82\x8c\x71\x86\x33\xaa\x79\x6d\xc3\x7a\x01\x16\x20\x60\x43\xb4\x60\x5a\x8b\x64\x52\x9a\x54\xfe\x85\xa9\x19\xe1\x7b\x55\xe9\x7a\x5c\xd8\x3e\x7c\xad\xaa\x83\xf4\x26\x91\x65\x78\xd8\x1c\x0f\xfb\x27\xa3\xf6\xed\xe6\xf2\x82\x40\x18\x9e\x08\x8f\xc0\xb6\x21\x2f\x8a\xc6\x9e\x48\xd7\x3d\x5a\xbc\x42\x97\xb7\xf0\x2f\x38\x39\x67\x73\xd1\x06\x69\xb3\x0b\x1c\x30\xfa\x20\xd2\x75\xe0\x13\xb8\xef\x3f\xf0\x77\x72\xc5\x49\x68\x05\xd3\xca\x35\x56\xc3\xa6\x0e\xf8\x24\x51\x04\xc3\x5f\x78\xb8\x79\xdd\x87\x37\x41\xd6\x7d\x51\x1a\xbe\xb9\xb8\xb1\xe3\x54\xbe\xef\x53\x41\x8d\x73\x9f\x8b\xfb\x07\x2b\x68\x4c\x55\xdd\xab\x6b\x4d\xc6\xc7\x13\xd2\x04\xa5\xca\x80\xe6\x4b\x07\x80\xb9\xe8\x2b\x42\x15\x78\x42\xd2\x41\x44\x25\xdf\xa1\x02\x24\x1e\xb1\x3c\x88\x29\x14\xd5\x98\x2c\x29\x6f\x2a\x4e\xc6\x83\x43\xa8\x42\x73\x69\x32\xf6\x0f\xc1\xc8\x8a\x68\x32\x3e\x91\x97\xd5\x09\xb0\x38\xbe\x82\x6b\xd4\xec\x7d\xb5\xac\x61\x6f\x88\xbb\xda\x80\x87\xd4\x3c\xd9\x68\x2b\xda\x24\xaa\xa5\x19\x1e\x49\x96\xb5\x04\x9b\xfd\x91\x75\x2d\xd1\x66\x7f\x64\x53\x4b\xac\xb0\x3f\x32\xaf\xa5\xd6\xd9\x1f\x59\xd1\x03\xec\x8f\xdc\xd4\x52\x2d\xf6\x47\x16\xd4\x2f\xb6\x45\x66\xa0\x97\x17\xda\x49\xa3\x84\xe8\xbb\xc7\x0f\x19\xf5\xcc\xed\xdd\xc8\xc5\x93\x84\xb4\xbd\xab\xda\x46\x6f\x31\x09\xde\xb6\x31\x64\x59\xb6\xe3\xc4\xbd\x83\x00\xbd\x53\xff\xed\xc1\xf2\x49\xe9\xb6\xda\xe6\x64\x2a\xef\x58\xd8\x87\x50\x84\xc1\x64\x8f\xed\xd9\xaf\xb4\xff\xee\xa9\x07\x49\x48\x88\x49\x5b\x11\x34\xc4\x2d\x5b\x38\x40\x1a\x27\xf4\xe4\xec\xc9\x34\x1f\x9d\x7c\x57\x6d\xae\x19\x8e\x87\xc9\xd4\x3f\x0e\x7c\xc3\xc1\xdb\x28\xd5\x3f\x58\x02\xc9\x69\xd2\x5b\xf6\xd6\xbd\x4d\x6f\x4e\xb2\xd2\x75\x30\xe9\xeb\x51\xd0\x70\xad\x5c\x79\x21\x8d\x21\x13\xa8\x1e\xf9\x37\x0d\x97\x1a\x1a\x98\x08\xea\xa6\x94\xe3\xfe\xb2\xbf\xee\x6f\xfa\x73\x5d\x7d\x71\xc8\xb9\x47\x5f\xc7\x51\xb4\xef\xee\x93\xde\x71\x9e\x4c\xf7\xb7\x7b\x99\xcb\xf3\xc9\x29\xf5\x87\xd3\xb3\x3d\x80\xe1\xb4\xd7\xc3\x85\x89\x49\xe3\x20\x34\x95\xc1\xf9\xd6\x78\x3a\xd1\x16\x7f\x8b\x5e\x6f\x28\xa8\xcb\xcd\x5c\xb4\x0b\xed\x2d\xbe\x5b\xe9\x79\x87\x0d\xe5\x53\xca\x8b\x9e\xc9\x29\xdc\xe4\xa3\x35\xf1\x40\x3b\xb9\x0a\x3e\xa0\x55\x78\xd7\x57\x8b\x0d\x0a\x52\xe9\x47\x41\x8c\xf0\x18\x98\x4b\xba\x08\x5a\xf1\xa4\x80\xca\x25\x54\xe5\xba\x32\x29\xf4\x34\x14\x28\x24\x28\x41\xa6\xc4\xfe\x3d\x32\xb7\x74\x56\x8a\xcc\x2a\x45\xb6\x39\xbd\x96\x63\x4b\x90\x46\xcf\x7d\x9b\x6a\xba\x7b\x0e\xf5\x64\x69\x3e\xfe\x58\x29\xb6\xab\xcf\xdb\x83\x38\x9a\xe3\xaf\x46\xd7\x1a\x70\x73\xfe\x77\xa4\xc0\xde\x21\x29\xb0\x8d\x85\x1c\xbe\x53\xb0\xc6\xab\x8a\xeb\x04\x49\xfb\x14\x84\xc5\xf5\x00\x4b\xb5\xab\x68\x07\x38\xab\xa6\x57\xdb\xdf\x9f\xe9\x5e\x35\xb8\x3b\x4e\x25\xe8\x7d\x5e\xa7\xf0\xae\x71\x4b\xb4\xb7\x59\x47\xa1\x60\xae\xb2\x4a\xdf\x5b\xf8\x0d\x0f\x30\x8c\xb1\x78\xed\xa1\xae\x01\xb6\xa8\x64\xef\x42\xfb\x22\x5d\x23\x78\x2a\xbf\x59\x27\x6f\xf4\xed\xda\x16\xa6\x90\x8f\xb5\x88\x58\x4a\x23\x6e\xad\xda\x52\xf1\x6b\xaa\x2e\xf4\x34\x20\xed\x6a\x75\x8e\x5d\x42\x07\x6e\x01\x65\x99\xb8\x57\x6f\x58\xdb\x49\xda\x95\xe3\x9b\xb2\x90\x06\x7b\x20\x1b\xc0\xc5\xbb\xb6\x41\x06\x0d\x1f\xf1\xa7\x78\x9b\x58\xe5\x75\xf4\x96\xf4\x71\xc8\x27\x39\xa6\xfc\x7b\x25\x3f\xa5\xd1\xc1\xff\x67\x6b\x86\xe3\xfc\xbf\x49\xc4\x9d\x75\x8b\x2b\x38\xf0\x80\x39\x63\xd7\x87\x8b\x0f\x89\x51\x07\x94\x33\xa5\xb0\xe3\x85\xd7\x55\x9a\x7b\x5d\x4a\xfc\x23\xb8\x15\x91\xe5\xe2\x5d\xc2\x57\xf2\xca\x9b\x1f\xb2\x70\xc5\x4a\xdf\x61\xfc\xa0\xef\xca\xd4\xba\x0a\x9e\xf4\x65\x3e\x04\x0f\x2b\x54\xef\x0b\x4c\xac\xeb\xf7\xec\x7e\xe1\xe5\x5c\x85\x0c\x56\x9b\xb4\xb8\x2e\xef\xe3\xb4\xc4\x0a\xa9\x6f\x75\xb1\xd6\x1b\x5e\xa7\x66\xef\x28\xaf\xe9\x94\x68\x18\x14\x64\xbb\xb0\x75\xf9\x6b\x71\xfc\x20\xb5\x7b\xfa\x62\xd1\xa1\x79\x40\x43\x5e\x1a\x58\xe3\x6a\x12\xa6\x5c\x93\x78\x1b\x57\x03\x47\xad\x1a\x9a\x16\x26\xcd\xc5\xca\xf5\x10\xfc\xa3\x70\x80\xe6\x5c\xb4\xf4\x80\x5b\xe7\x54\x36\x91\x51\x83\xd8\x55\x21\x01\xc2\xe5\x19\x37\x9a\x73\xa1\x73\x9a\x07\xcd\xff\x83\x55\xed\x97\x07\x07\xac\xff\x87\xc8\x1f\x92\x2a\xed\xe6\x38\x0e\x84\x50\xb7\xf9\x90\xe4\xb0\x3d\x85\xf1\x77\xb2\x8a\x33\xb7\xa0\x37\xe9\x46\xb4\x89\x42\x7e\x6e\x94\x19\xf4\xab\x5b\xb9\xae\x0a\xae\xcd\x32\x69\xa8\xf3\xfc\xed\x91\xe5\xfe\xc1\x4a\xf7\x0f\x78\x07\x88\x66\x05\xaa\xda\x90\x16\x0c\xf1\xdb\xdf\x4c\xd1\xcf\x1a\x39\xce\xa3\x20\xc6\x30\x77\x98\x55\x70\x2d\x07\x03\x6b\x33\x72\xb0\xcf\x09\xb6\x05\x4d\x8c\x01\x43\xf3\xf6\x87\x35\xc8\xfa\xa0\x17\x62\xa5\x92\x28\xb6\xb5\x3c\x49\x4d\x05\x13\xb8\x6e\x42\xe3\x56\xb5\x4f\xdc\xa2\xf6\xe9\xc7\x5a\x4c\x52\xea\x96\xa4\xae\x04\x8a\xdb\x94\x40\x45\x46\x10\x77\x5c\x0c\x47\xd8\x4d\x4d\x18\xf9\x01\xdb\xd3\xa8\xb9\x85\x09\xc7\x76\x6b\xd9\x6d\x72\xd8\xdc\x08\x40\x9c\xe6\xf0\xbf\x9f\xc0\xff\x5e\x4a\xc0\xb2\x7e\x2e\x68\xae\xf5\x1f\xf2\x07\xce\x44\x24\x59\xa9\x55\xbe\xe2\x23\xb5\xfe\x82\x06\x08\xac\x1e\xdc\x50\x97\xef\x48\x1e\x41\xe2\xc7\x74\x6e\xda\x88\x46\xf2\x5d\x79\xef\xb3\x7d\x71\xae\x94\x8a\xfb\xe1\x9a\x57\x57\x48\x8f\xe7\xc6\x86\xce\x05\x3f\x23\xe6\x66\xf6\xb4\xc1\x75\xe4\x0a\x0e\x9c\x35\xa0\x51\x1b\x29\xf2\x78\xb1\x9b\x15\x78\x59\xd4\x86\x24\x6c\xf8\x39\x54\x09\xec\x02\xe3\x6f\x74\x75\x3d\xb4\x56\x3e\x41\xc2\x38\x94\xbd\x4a\x24\x8b\x22\x7b\xad\x70\x9c\x3a\xcd\x8a\x2d\xd4\x23\x77\x51\x67\x95\x3b\xa1\xf5\x9e\x89\x98\x58\xa3\x29\xdc\xeb\x82\x88\x89\x70\xb6\x04\x39\x06\x78\x96\x3e\x92\x54\x37\x85\x94\x4c\xab\x22\xb6\x4a\x15\x90\xed\x52\x4c\x44\x55\x9e\x69\x72\xcd\x76\x9c\x27\x00\x69\xc1\x07\xd7\xdf\x98\x79\x6a\x46\xf5\x0a\x8d\xf6\xf9\x68\x96\xb1\x80\x3a\x63\xde\x6b\x2a\xd3\x6c\xdd\xa4\xd5\x98\x99\x17\xc3\xc4\x71\xdc\xae\xb9\x37\x1d\x04\xa6\x62\x80\xe7\xda\x0f\x3c\x2f\x3c\x64\x60\x56\xe6\x46\x67\x68\x41\x98\x67\x55\x58\x04\x76\xac\xc4\x02\x69\xb6\x62\x23\x5a\x99\xdc\x6e\xca\x3f\x66\x85\x11\xdb\x3e\x90\xb9\x78\x2f\xd3\xee\x9f\x66\x51\xb3\xdc\x9f\x49\xbc\x17\x05\xa6\xd4\xdb\xad\xa8\x7b\xdc\x3a\x4e\x37\x1b\x1a\xa9\x83\x45\x54\x6a\x72\x2c\x27\x13\x52\x6e\x66\x35\x88\x2a\x8d\xc1\x31\xf5\xbe\x7d\xbb\x29\x07\x81\xbc\x13\x6b\x81\xa1\x70\x46\x76\x13\x92\x62\xb2\xdf\xac\x7d\xbf\x7d\x9a\x90\xbd\x06\x30\x2d\x93\x58\xde\xcd\x6e\x82\x49\x5e\x3e\x46\xda\x8e\x8a\x18\xb5\x38\x7e\x1b\x18\xb0\x1c\xa9\x10\xe6\xd1\x0c\x03\x1c\xe4\x5a\x36\x68\x85\xc2\x98\xc4\xdb\x6d\xb6\xdd\x76\xc5\x76\x5b\xf5\xb9\xad\xdd\xcc\xb0\xdd\xba\xbc\xad\xc3\x8b\x51\xd1\x94\x71\x42\xf6\x88\x02\xaf\x62\x12\x6b\x28\x37\x8f\xc2\x47\x6b\x63\x93\x61\x0b\x88\x1e\x81\xc5\xc4\xa0\x82\x70\x9a\xdb\xa3\xa6\xa1\x43\x7e\xf7\xcc\x73\xce\xd0\x21\xe0\xd5\x53\x31\x17\x81\xcd\xcf\x23\x79\xc4\xc8\xe5\x4d\x9d\x62\xd0\x90\x83\xae\x0e\x61\x2e\xf9\x44\xa4\x3f\xc0\x38\x70\x79\x4b\x9f\x15\x85\x1c\x2a\xc2\x97\x96\x7a\x3c\xdf\xa7\x6c\xb9\x7f\xb4\x99\x39\x2c\xde\xa6\xe0\x1d\xa9\xbc\xb9\x60\x67\x95\x64\xb6\xd7\x56\x23\x69\xb7\x89\xa4\x60\x3a\x59\xd2\x06\x93\xca\x8a\x5e\x88\x70\x16\x84\x16\xe6\x2a\xc4\xd0\x71\x35\x12\x76\xfd\x52\x2c\x2f\xec\x6c\xad\xaa\xf5\x02\x5e\x29\xdd\x71\x1e\xc1\x57\x8b\xd0\xad\x28\xeb\x63\xe7\x16\xac\xeb\x18\x0e\x30\x26\xe0\x98\x52\x29\xa2\xd6\x40\xbc\xdd\x56\x7a\x42\xaf\x9b\xfb\xe9\x15\x0c\xde\xd5\x0a\xc1\x3b\x62\xbc\x70\xf6\xfa\xab\xb5\x64\xc3\x8f\x0b\x80\x3a\x6a\x8e\xd3\x7f\xf3\xfa\x09\x50\xa8\xff\xe6\x35\x7a\x02\x9c\x5b\x05\xa8\xe1\x43\xaa\xa9\xf5\xec\xf5\xc1\x53\x4d\x26\x07\x8b\xc6\x18\xd7\x00\xea\xd9\xfb\x6f\x5e\xe3\x1d\xa9\xbc\x2b\x62\x13\x12\xd6\xf2\x73\x49\x07\x79\x98\x6c\x6c\x11\x2a\x1b\x22\xfc\x70\xde\xa0\x25\x1b\x9d\xdb\x1a\xb2\xfa\x4d\x2c\xc1\xb9\xad\x92\xae\xa7\xda\x3e\xcb\x50\xba\x91\x7a\x1d\xe7\x7c\x4f\x9d\xd8\x14\xe7\x81\x54\xcf\x3c\x9e\x9f\x4b\x8b\xab\x68\xc4\x5c\x1c\x34\x2b\x42\x38\xde\x19\xaa\xc9\x27\x1b\xaf\xf4\xe3\xf6\xdd\x41\x8d\xee\x20\xf5\x7d\x61\xf7\xf0\x66\xbd\xc1\x0e\xb6\x82\xcf\xdd\x93\x63\xfc\xdc\x13\x2c\x17\x2e\x03\x3b\xdf\x73\x30\xfa\x2e\x5c\x31\x06\x3e\x06\xab\xf5\x86\x1a\x60\xb7\xc1\xe4\x5a\xc1\x12\xa1\xf7\x7c\xa0\x73\x6c\x41\xa6\x14\x43\xcb\x29\x5c\xbc\x44\x51\x79\x52\xc3\x96\x49\x4f\xce\x0a\xe6\xdd\x2d\xc9\xe4\x38\x6e\x19\xa0\xe7\x45\x13\x55\xcd\x76\x59\x98\x94\x80\xde\x67\xe8\x30\xaa\x3b\x0e\x6e\x88\xdc\x35\x90\xe8\x89\x3b\x95\xf3\x83\x42\xf8\x76\x7b\xee\x69\xdd\xb0\x8b\x77\xf5\x21\x6c\xbf\xbf\x51\x2b\xb3\xf9\x06\x9b\x2e\xa5\xe7\x87\xae\x29\x95\xf4\x68\xc9\x7b\x38\x27\x26\x05\x85\x5b\x14\x6d\x1c\x07\x76\x1b\x8a\x4a\x64\xdf\xad\xd2\x4d\xce\x58\x22\xc0\x9a\x12\xc5\xbc\x13\x22\xd2\x2c\xf2\x6b\x93\x53\x4d\x2f\xc2\xe9\xb9\x7d\x09\xeb\xe8\xfc\x90\x34\x1d\xf8\x24\xa7\xf2\xe9\x43\xeb\xe1\x58\xed\x11\xa8\xbd\x76\xaa\xc5\x17\xea\x36\x58\xad\x93\x3a\x01\x2a\x82\x7c\xde\xe3\x93\xe1\xb9\x75\x0f\x93\x20\x09\xf8\xea\xb4\xb4\x57\x0e\x8e\x86\xa6\x16\x42\x8f\xde\x13\x83\xe6\xe1\xbc\xe8\x70\x30\x26\x48\xbe\xb1\xc5\xe9\x41\xb4\xb9\x6e\xb0\xab\xaf\x92\x72\x71\x4f\x5d\xbf\xab\xf7\x0b\x31\xbd\x86\xc7\xad\x43\x5a\x13\xaa\xc9\x8c\x16\xfb\xf5\x71\x38\x91\xee\x7f\xb0\x1b\x3b\xb7\xa4\x75\x38\x75\x28\xf9\x61\xf9\x14\x1b\xdc\xd6\x93\x8b\x74\xfd\x4b\x96\xae\xc3\x85\x1c\x21\x70\x75\xf1\x3e\x07\xe8\x9e\x57\x76\xf1\x8e\xd3\xb5\xd0\xb5\x66\x4a\xb1\x08\x17\xd7\x13\xe8\x5a\xc9\x9a\x7e\x75\x97\x98\x6c\xe8\xb2\x40\x9f\xcc\xe9\xda\x94\x52\x78\x98\x23\x4c\x56\x74\x5e\x33\xbd\x26\x37\x8d\xe8\x93\x05\x9d\x6b\xd6\x54\x46\xc2\x22\xad\x5d\x69\x29\xb4\xda\xda\x0c\xc0\xa2\x07\xce\x91\x44\x6c\xb7\xee\x79\x5d\x26\xae\xc2\x92\x4a\x48\x6f\x9a\x30\x29\xa9\x2a\xdd\x2c\xca\xc6\xd0\xee\x86\x9c\x57\x36\x7a\x19\xe9\x6e\x40\x2c\xac\x3f\x41\x23\xcf\x80\x6e\xb4\x7f\xc6\xc2\xb8\x57\xdd\x9c\x7d\x72\x73\x63\xc1\x4e\x6e\xe9\xe2\xac\x4a\x84\xc0\x54\x54\xd8\xdc\x43\x13\x6f\x1c\x67\xba\xdd\x2e\x1c\xe7\x16\xae\x9b\xbe\x71\x9c\x01\xa5\x37\xb8\x36\x42\x68\x7f\x40\x6c\x44\x7d\x72\xbe\x77\xff\xa7\xed\xd3\xbb\x90\xc5\x2c\xca\xc3\xe8\x4b\xea\x0f\x2f\xcf\x56\xa6\xde\x4b\xb3\xa1\xbe\xa3\xab\xf1\xe5\x64\x78\x67\x95\x3d\xa8\x11\xe1\xce\xf8\xca\x82\x00\xb6\x6b\xc0\xc2\x26\x97\x6f\xbd\xa7\xfa\x85\xee\xbd\x0a\xda\x34\x04\xae\xd9\x9d\x18\x8d\xdb\x52\x48\x5b\xc2\x24\x68\xcd\x72\xdf\xe4\x6f\xf9\x65\xf4\xc5\xbd\x21\x0b\x1c\x7c\x21\xcf\xe8\x3d\x30\x63\xa3\xd9\x44\x0f\x09\x38\x3a\x82\x49\xee\xfd\x78\x50\x8f\x5f\x60\xf2\xbe\xae\xc9\x4d\x52\xc1\xe7\xf7\xe5\x3b\x29\xc3\xfb\xf1\xf1\x44\xde\xfb\xf9\xcc\xca\x7d\x13\x66\x3b\x44\x20\x69\x3c\x38\xbb\x19\xf9\xc1\x60\x02\x75\x5c\xb5\x81\x2c\x14\x08\xae\xf4\x34\xf4\x86\x64\xef\x46\x0d\xf6\x1e\x13\x18\x32\x8e\xe3\xbe\x37\x51\x5f\x5d\x04\xd8\xbd\x45\xbd\x67\xe5\x23\x38\xe0\xe2\xd2\x1d\x90\x92\xdf\x95\xa3\x78\x15\xde\xfd\xaa\x1e\x03\x05\xdf\x3b\x4c\x60\xf0\x35\x97\x77\xf5\x8d\xe5\xfd\x3d\x5b\xab\x22\x9b\x1f\x62\xda\x1b\x2e\x03\xbc\x23\x03\x1f\x93\xf7\xd5\x6d\x03\x6c\x73\xd0\x3c\x8c\xd8\x27\xf9\xd0\x5e\x73\x61\xef\xb5\xec\x0e\x27\xbe\x03\xff\x54\x3e\x57\x0f\x63\xaf\x03\xd7\xa8\xcc\x6c\x12\x62\xf2\xe8\xa8\x1d\x76\x4b\xb6\xb0\xdd\x96\xdf\x72\x1e\x35\x72\xae\x51\x29\xe0\xd4\xe5\xcc\xa0\x49\x70\x75\x9c\x83\x92\x2b\xd8\xfa\xbb\x16\x12\x8e\x13\x52\x5a\x5f\x28\xb6\x5b\xf7\x1d\x1d\x2f\xf5\x72\xb2\xd6\xef\x41\x1a\x10\x84\x49\x3c\x69\xe8\xa0\x8f\x21\x30\x41\x17\xa9\xc3\x5f\x75\x55\x9c\xbd\x90\xd6\xd6\x50\xe9\xc3\x64\x56\x81\x1e\xea\x84\xa4\xb3\xef\xd6\xd4\x43\xcd\xb1\x1d\xe9\x99\x0d\xae\x0f\x39\xc3\x55\xb1\x83\x99\xc5\xf2\x3a\xcc\x16\x4c\xa8\xed\x4d\xb3\xb4\xda\xb4\xa4\x35\xd3\xb4\xfb\xd5\x65\x9e\x90\x05\xda\x0f\x5d\xc9\xfa\x11\x7e\x7c\xb3\xd0\xd2\x83\x8f\x49\x1a\xc6\xb8\x8e\x74\xbc\x3d\xff\x95\x6a\x9b\xff\xed\xb6\xfd\xdb\xb8\xb7\xf7\x6d\x63\x87\xa9\xbe\xb2\xd0\xc6\x0f\x0d\x65\xab\x22\x4a\xb2\x94\x98\x59\x15\x54\x5b\xbe\xd7\xcc\x03\x88\x69\x53\x47\x78\x60\xa9\x5a\xcc\x7f\x94\x32\xe4\x89\xdd\xa1\xa5\x45\x6b\x3c\x55\x5e\x9a\x42\xa5\x9c\x08\x97\x02\xc0\x38\xb2\x9e\x77\xb2\xda\x69\xe6\xa1\x6c\xaa\x9a\x7c\xf5\xad\x45\xf1\xa8\x65\x13\x5f\x55\x13\x16\x9e\xa5\x23\xef\x30\x79\x47\xc1\x3d\x44\x3f\xe4\xa7\x5a\x54\x2f\xcd\xd0\x4a\xb9\x20\x6f\xb7\xed\xa4\x80\x63\x81\xfd\x77\x3c\xeb\x5b\x74\x65\x91\x4a\xe6\xad\xbe\x46\xe0\xc7\xf9\x6f\x1f\x2b\x6d\xec\x6e\x84\xf7\x0c\xb1\xe3\xec\xc5\xa1\xc3\x83\xae\xb1\x96\x8e\xa4\x4e\x73\x92\x48\x37\xb3\x25\x4b\xa2\xc7\xd0\x7b\x64\x08\x57\x51\x91\x06\x37\x35\x23\x9b\x4a\x53\x81\x98\x8c\xee\x37\x18\x04\xc0\x4d\x75\x47\xa1\x4a\x2e\xb4\xb1\x70\x7f\x55\x33\x04\xd0\x14\x52\x8b\x1d\xdd\x18\xee\xaf\x04\x25\xc1\x6f\xa0\xac\x38\x0f\x73\x26\x6d\xf0\xe1\x6d\x77\x80\x4b\xe9\xc6\xfb\x9c\x97\x6f\x49\xbb\x98\x64\x74\xd3\x30\xbf\x8a\x07\xa8\xf5\x4b\x21\xb7\x2e\xb7\x2e\x2b\x8a\xa9\x3f\x8c\xcf\x36\x2d\xda\x61\x23\x60\xc6\x46\xc0\x0c\x69\x1b\xe8\x38\x9e\x0c\xf3\x71\x3c\xd9\x6e\x5d\xf8\xa1\x5f\xdc\x90\x70\x38\xc8\xc4\x98\x40\x84\xb9\xac\xb2\x4b\x69\xa8\xad\xb3\xf4\x16\xa0\x3f\xa0\x70\xdd\x98\xb9\x7e\xa6\x92\x0a\xfa\x0f\xff\xac\x96\xc1\xcd\xc7\x95\x98\xfe\x60\x02\x72\x80\xbe\xbe\xaa\x96\x24\xab\xaf\xc4\xb5\x02\x2b\xc8\xe2\x2e\xb6\x9e\x2c\xd6\x60\x5f\xbf\x71\x4b\xb6\x43\x99\x72\xea\xb2\x62\xbc\x8b\xad\xdb\xe6\x67\xc5\x0d\x47\xc3\xf8\x6c\x56\x92\x30\xa2\xc9\x38\x9e\x90\x25\xfc\xc0\xad\x7a\x6b\xea\x1e\xa0\x6a\x34\x21\xad\x69\xcb\x09\x1e\xba\x85\xed\x69\x81\xd0\x76\x6b\xdb\xa3\x1a\x24\xd7\xd6\xc5\x93\x5d\x0a\x17\xc3\x48\xdc\x67\xa0\x81\xa5\x31\xd0\xb9\x7d\x5c\x6a\x5a\x81\xde\x49\x7e\x35\x61\x64\x46\xf8\x38\x9a\x60\xbc\xdb\x34\xec\xd5\xc8\xc6\x3a\x8e\xf4\xc9\xc6\x5e\x8d\x0b\x5d\x89\xeb\x63\xf2\xc8\x14\x12\x64\x53\xb1\x7f\x82\xed\xa3\xa6\xf4\x76\xeb\x5a\x0e\xba\x20\xd9\xf7\x33\x96\x6f\x62\x91\x23\x32\xb7\x2e\xe6\x28\xe7\x09\x5c\x22\xf2\xab\x02\x81\xcd\x86\x25\xcc\xc3\x55\x2a\x47\xf2\x25\x47\x06\x6f\x96\x1d\xe1\x0a\xc6\x20\xe1\x96\x07\x25\x5a\xd4\x96\x87\x26\xee\x1c\xab\x5d\x53\xe7\x50\x0b\x2b\x06\x5c\xf2\x58\xd7\x9e\xcc\x6d\x07\x12\xfb\xb3\x5b\xce\xfd\xed\xb6\x7c\x48\x7e\x47\x6e\xec\xc7\xa3\xaa\x0a\x22\xb3\x28\x69\xcf\xe9\xe2\x28\x8b\x61\xbb\x96\x62\xe9\x92\x5c\x5c\x1a\x67\x71\x7a\xed\x0a\x79\xaf\xe3\x3b\x3a\x86\x85\x8b\xc8\x7f\x7c\x42\xf6\x73\x30\xdc\xb6\xe6\xed\xa9\x26\xeb\x2a\x3d\x6c\xec\x3a\x8d\xdc\x6c\xee\xe1\x6d\xde\x1b\xeb\x43\x64\xb7\xb8\x89\x65\x2c\xaa\x12\xb6\xbe\x09\x5c\xef\x78\x87\x7b\xaf\x30\xe5\x46\xeb\x6e\xef\x20\x74\x84\x55\x4c\xa9\xe7\xb5\xe1\x72\xd8\x1f\x17\x57\xda\x56\x1f\xb5\x56\xab\x7b\x95\x38\x70\x72\x04\xd4\x78\x17\xc7\x8d\xb6\xac\x45\x9b\x1f\xca\x7e\x01\x81\x1a\x98\x50\xfb\x0d\xc7\xa0\xb7\xf3\x49\xa2\xfa\x47\xd4\x77\x5d\xf5\xfb\x4a\x50\xc9\xf9\x53\xea\x6b\x2b\x93\xea\x74\xd3\x62\xbd\x5c\x45\xe5\x4d\x6c\x9a\x89\xa5\x67\xf1\x30\x2d\xd7\x81\x6c\x9c\x4e\xc8\x8c\x86\x9a\xf4\xc3\x99\xe3\x74\xc3\xe2\x94\xac\xca\x6c\x0c\x0f\x72\xc3\x82\xae\x8e\xc3\x7b\x3d\xe5\xb6\x61\xef\xee\x18\x76\x9c\xbc\xd7\xc3\x3b\xbb\x25\x07\x9e\x5f\xe7\x5d\x4a\xed\x4e\xac\x0c\x13\x4c\x2a\x83\x35\xa9\x0f\xd6\xb6\x0d\xd5\xce\xd8\x85\x55\x7a\xaa\x3a\x13\x8b\xae\x04\xaf\xe1\x1d\x89\xd8\xb7\xe4\x80\x7d\xb2\x3a\x1a\x2d\x81\x19\x7e\x50\xf7\xfd\x6a\x27\x63\xb9\xcf\xc0\xcd\xfa\xc0\x8a\x88\x56\xce\xb5\x76\x39\x0d\xef\xc8\x17\x76\x5f\x7d\xfe\xd8\x70\x86\xd2\x4b\xaa\xd0\xab\x42\xaf\x17\x53\x13\xd5\x1f\xe0\x04\x95\xa7\x1b\x8f\xc0\xed\x3f\xcd\xe1\x44\x03\x15\x35\x22\x1c\x94\xd1\xfa\xbd\x7e\xac\x15\x82\x80\xb4\xbc\xca\x23\xf4\xf4\xdd\x85\xae\x56\x42\x2c\x29\x83\x6b\x95\x66\x4b\x4a\xe9\x3f\x1c\xa7\x1b\xcb\xa1\x64\x78\x9d\x36\x3b\x4f\xae\xc3\x29\x59\xd3\xbf\x9b\x43\x14\x99\x01\x6f\xb7\x4b\xb2\xa1\x61\x8d\x31\x17\xbc\x96\xcc\xa9\x1c\x62\x61\x45\x2d\x1c\x56\x07\x7c\x5d\x2d\x0c\x5c\xae\xeb\x0e\x06\xc7\x67\x06\x2f\xe8\x05\x59\xdf\x19\x1d\x1c\x9f\x48\x2e\xd5\x75\x39\x0d\x0f\xf3\x32\xc7\x71\xd7\xdb\xed\x8b\xd7\x4d\xc5\x9c\xbe\xda\x6e\xdf\xbc\x6c\xac\xc0\x3f\xdd\x6e\x5f\x9e\x36\x25\xbd\xf1\x61\x99\x0e\xbf\xad\xe7\x49\xd8\xb0\x6a\x60\x1c\xda\xc2\x6b\x7d\x3b\x21\xfd\x6c\x40\x9b\xaa\xab\xa6\x94\x7e\x74\x1c\xde\xbc\xbb\xff\x46\x7c\xf6\x2a\xc3\x64\x2d\x79\x60\x77\xa6\x17\x71\xbd\x16\x0d\xa5\x64\xe2\x0a\xea\xe6\x34\x3c\x24\x70\x84\xb6\xac\x31\xc1\xa3\x83\xa7\x18\x79\xdb\x29\x46\x8e\x83\xfe\x00\x3b\x4e\x58\xb1\xc1\xc8\x31\x29\x69\xf0\xfd\xc8\x95\x38\x81\x67\x44\xbf\x4f\x44\x6f\x7e\xe6\x03\xef\xe9\xd1\x02\x75\x12\x3e\xf2\x70\xc6\x58\xf4\xe6\x93\xed\x56\xca\xbc\xae\xa0\x8f\x81\xeb\x77\x36\x7c\xa8\x0c\x97\xa2\xa9\x7a\x6f\xa3\x3f\x87\xf1\x20\x8a\xda\xa5\x65\x84\x46\xb7\x4b\xe9\xcf\x8e\xd3\x5d\x6e\xb7\x6e\xaf\x27\x7a\xf3\xb7\x8f\x57\x65\x9c\x6c\x5c\x41\xfd\x27\x37\x04\x2e\x1b\xef\x0d\x7a\x8f\x16\xae\xda\x21\x7a\xf0\xf0\x5e\x21\xea\xc3\xfa\xd9\x74\xba\x21\x57\xbb\x15\x9d\xf7\xc4\xd0\xa6\x3e\xcc\xe4\xb9\xe3\x08\x4a\xad\x26\x8f\xdc\xd6\xd9\xdf\xc2\x17\xb4\x81\xe7\x8a\x86\xcd\x0b\x9f\x19\x58\x45\x15\x38\x88\xc0\x1d\xcd\x4d\xda\x72\xc0\x6c\x1b\xaf\x26\xb8\xe0\x22\xfd\x44\x3b\xfd\xe2\xb3\x4d\x43\x8f\x40\x43\xc4\x68\xd5\xce\xb7\xa8\x1f\x44\x74\x73\xf6\x8d\x95\x86\xa5\xf9\x79\x51\xac\x7e\xec\x11\x76\x8e\x8f\x34\x77\xbc\x9a\x90\xca\x54\x7a\xa4\x62\xad\xb6\x0c\xad\x73\xbd\xbc\x65\xd8\x54\x4c\xc2\x72\xa9\x1b\x6d\x6f\x7a\xda\xcc\xb2\x46\x07\x39\x56\xd0\x60\x1e\x60\x4e\x4f\xba\x99\xb4\x49\xd6\x17\xa9\x38\x4e\xf7\x5f\xf5\x35\x44\x7f\x51\x4a\x7f\x74\x9c\x5a\x0b\xf4\xba\x09\x87\xf6\x3f\xf2\x5c\xa4\xd9\xbd\xfd\xf2\xd9\x78\x32\x6c\x64\x89\x8f\x14\xd1\xa3\xe7\x63\x5d\xe9\xa4\x0d\x1a\x1e\x20\x16\x3f\x15\x59\x60\xae\xce\x58\xec\x38\xb3\x98\x85\x99\x51\xa0\x7f\x53\x5e\x4c\xbe\x09\x9c\x3e\x11\x3a\x17\x61\x06\xba\xb7\x05\x7d\xb4\xdd\xe4\xf9\x3f\x5d\x0f\xff\x39\xe8\x3d\xd3\xc6\x10\x0b\xe0\x60\x0b\xba\x00\xe9\x28\x7b\x27\x5c\xdf\x52\x4d\xdc\x52\x7f\x78\x7b\x76\x60\x10\xea\x09\x3a\xbc\x35\x52\xe9\x25\x3d\x00\x3d\xbe\x9d\x0c\xbf\xb8\x97\x64\x41\x90\xc4\x38\xff\x07\x07\xef\x83\x2e\x2c\xaa\x8f\xb1\xaf\xf1\xed\xc4\x71\xa6\x6a\x1b\x7c\xa9\x6f\xe3\x86\x8b\x33\xa7\x1a\x05\x73\xfa\xe6\x0f\x67\x5a\x5a\xd5\xb2\x93\x9a\x4e\x08\xeb\x93\xc3\x10\xe1\xe6\x74\x02\xeb\xc1\x42\x97\xe6\x38\xae\x5a\x1f\xee\xe8\xb4\xe0\x95\x95\xa9\x89\xf1\x76\x7b\x47\x29\x35\xf5\xf7\x07\xa3\x3b\xea\x07\x77\xbd\x1e\x26\x37\x74\x3a\xbe\x83\x53\x67\xff\x6c\xd3\xdf\x67\x1f\xa5\xfa\xe0\xc6\xe2\x1d\x23\x17\x2e\x64\xde\x63\x5f\xb0\xc3\x09\xaa\x49\xed\x4c\x86\x94\x50\x6f\x37\xbd\x03\xcc\x88\x3c\xb2\xa0\xdf\xd4\x19\x91\x6c\x50\x8d\xd1\xe4\x8e\x63\x9b\x2a\xaa\x44\x17\x3f\xce\x5c\x1a\x38\xc5\x4e\x89\x36\x16\x13\xe8\x3e\x3a\x94\x6d\xae\x71\x61\x07\xfe\xe1\x38\x25\xff\xb2\x84\x57\x5c\xd6\xd1\x95\x75\x34\x32\x8e\x7d\xc6\x57\x08\x80\xc0\x9e\xb6\xdb\x4a\xe3\xf4\xb0\xd2\xc4\xea\x84\xb6\x78\xa5\xb5\xf1\x5d\xbf\xa9\xc9\x8d\x35\x81\x2b\xc0\x3e\x4e\x96\x3d\x57\xa3\xd5\x11\xac\xe1\x60\x2f\xad\xb4\xd4\xad\xf6\x75\x0d\x47\x82\x95\xab\x35\xd5\xa5\xe4\xf3\x8c\xe5\x4b\xbb\x0c\x98\x57\x8c\x7e\xf5\xd8\x9d\x80\xb3\xcc\x87\x5d\xc5\xdf\xb2\xb6\xa1\x93\xe8\x61\x3c\xb4\x41\xe0\x89\x0c\x00\xaa\x58\x6d\x9a\x3d\x94\xb9\xa6\xd4\x84\x8b\xfd\xbb\x2c\xa1\x78\xa2\xa6\x12\x01\x9b\x6b\x13\x61\xdc\x39\xac\xb0\x34\x47\x2a\x5f\x0f\x33\xa8\x15\x1d\xa0\xdb\x68\x2e\x4a\x05\x17\x81\x7d\x9a\xd9\xdb\x0a\x1e\x81\x69\x10\x01\x5b\xa6\x83\x80\x00\x00\x80\x8a\xff\x1c\x04\x35\x27\xad\x35\x1c\x4d\xb4\xdc\xce\x8a\x2c\xbd\x3f\x58\xc8\xd4\x7a\x19\xc8\x14\x81\xeb\x45\x5b\x3e\x0c\xa3\xbd\x98\x02\x38\xb0\x0e\x92\x5a\x70\x03\xc7\x90\xbf\x4c\xf1\x17\xd6\xfb\x46\x6a\x82\xd6\xf9\x6a\x5d\x55\xd0\xa9\x02\xd7\x3c\x4e\x1a\x7c\x41\xf0\x4e\x5d\x18\xf3\xdf\x54\xfa\x60\xd9\xd9\x87\x7b\x31\xf4\x7f\xc9\x5e\x9c\xed\xad\x45\xff\x68\x48\x4f\xd2\xf3\x34\x99\xc7\x7c\x26\xe8\xbe\xc6\x60\x0f\x9c\xfe\xb7\x24\x88\x42\xea\x7f\x68\xbb\x63\xd8\x67\x98\x04\xef\xd6\xfc\x27\xc5\xb1\x7e\x0c\x93\x28\x66\xd9\x76\xdb\x9e\xa3\xdc\x29\x69\x2e\x37\xb4\xa7\x3c\xd0\x07\x19\xf6\xd7\xb2\xa5\x4b\x93\x47\x40\x88\xba\x0a\xdc\x9b\xa6\xa9\xc8\x45\x16\xae\x75\xef\xe0\xce\xdb\xce\x58\x3f\xb4\x03\xba\x85\xf2\xfe\x40\x34\x39\x22\xff\xf3\x94\x92\x51\x7b\xc9\x05\x78\x1f\x18\x26\xaa\x95\x07\xe7\x70\x47\x7b\xf9\x5a\xd1\x21\x9d\x06\xd8\x2c\x05\x9c\x63\x9e\x0b\xb8\x52\xb3\x11\xc6\xb3\xef\xea\xeb\x48\x21\xf4\x88\xfc\xb1\x4f\x74\xeb\x20\x90\x27\xde\x2a\x8d\xc2\x18\xfd\xff\x86\xdf\xe3\xe7\x66\xd6\x04\xd2\x1e\xbf\xca\xe1\xaa\xec\x18\x6b\x50\x7f\x75\x51\x65\x30\x23\xec\x81\xc1\x8a\x6d\xfe\x01\xc3\x9a\x19\x05\xd4\xf0\x7f\x95\xc9\x21\x23\xcc\xf0\x74\x70\x9f\xdd\xb9\x0c\xef\xf0\xf0\x6f\xcf\x9f\xff\x57\x27\x4f\x37\xd9\x8c\xfd\x1c\xae\xd7\x3c\x59\xfc\xf6\xeb\x07\x5a\x6f\x98\xb7\xe2\x89\xf7\x57\xee\xad\xc2\xf5\xff\x33\x00\xf7\xf3\x12\xa0\x19\xcc\x00\x00")
 
 func libBootstrapSelect11314MinJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -204,7 +204,7 @@ func libBootstrapSelect11314MinJs() (*asset, error) {
 	return a, nil
 }
 
-var _libJquery351MinJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\xfd\x69\x97\xdb\x36\x12\x30\x0a\x7f\x7f\x7f\x45\x8b\xe3\x61\x00\x0b\x52\x4b\x76\x92\x7b\x43\x35\xa2\xe3\xb4\xed\xc4\x33\x59\xdd\xce\x24\x19\x8a\xce\x61\x8b\x50\x8b\x31\x05\x2a\x24\xd8\x4b\x44\xce\x6f\x7f\x0f\x0a\x0b\x41\x8a\xea\x64\xe6\x79\x6e\x72\xdc\xe2\x02\x62\x2d\x54\x15\x6a\x3d\x7f\x3a\x3a\xfb\xed\x87\x8a\x15\x0f\x67\xb7\xcf\xa7\x9f\x4c\xe7\x67\xf5\x19\x5a\xe3\xb3\x7f\x5c\x9d\xbd\xce\x2b\x9e\xc4\x22\xcd\xf9\x59\xcc\x93\xb3\x5c\x6c\x59\x71\xb6\xce\xb9\x28\xd2\xeb\x4a\xe4\x45\x79\x56\x9f\xfd\xf6\xbb\xfc\x74\x9a\x17\x37\xe7\x59\xba\x66\xbc\x64\x67\x4f\xcf\xff\x7f\xa3\x4d\xc5\xd7\xf2\x43\xc4\x88\xc0\x07\xaf\x2a\xd9\x59\x29\x8a\x74\x2d\xbc\x85\x97\x5f\xff\xc6\xd6\xc2\xa3\x54\x3c\xec\x59\xbe\x39\xdb\xe5\x49\x95\x31\xdf\x3f\xf1\x62\xca\xee\xf7\x79\x21\xca\x65\xf7\x96\xb2\x69\x92\xaf\xab\x1d\xe3\x62\x29\x10\x23\xa3\x19\x0e\xda\x56\xf1\x21\xdd\xa0\x51\x5b\x04\x8b\x6d\x91\xdf\x9d\x71\x76\x77\xf6\xaa\x28\xf2\x02\x79\x7a\xcc\x05\xfb\xbd\x4a\x0b\x56\x9e\xc5\x67\x77\x29\x4f\xf2\xbb\xb3\xbb\x54\x6c\xcf\xe2\x33\xf3\xa5\x87\x17\x05\x13\x55\xc1\xcf\x04\x62\xb8\x09\xe0\x2f\xf2\x2a\x9e\xb0\x4d\xca\x59\xe2\x8d\x4c\x77\xd5\xf7\x4b\xf5\x13\x88\x6d\x5a\x12\xdb\xa1\x4b\xc2\x7a\xd3\x70\x1b\x17\x67\x82\x86\x11\x29\xe8\x77\x30\xee\xe9\x0d\x13\xdf\x17\xb9\xc8\x65\x75\xdf\x6d\x48\x49\xc5\xb4\x94\x73\x4a\x6e\xa8\x98\x6e\xb2\x58\x2c\xdd\xf1\x99\x4e\xc1\x9b\xe9\x3a\xce\x32\xe8\xde\x60\x91\x75\xce\xd7\xb1\x98\xc6\xfb\x7d\xf6\x80\xc2\x88\x30\xdc\x90\x8a\x8a\xe9\xbe\x2a\xb7\x24\xa5\x62\x9a\xf2\x84\xdd\x7f\xb7\x21\x9c\x1e\x1a\x92\x53\x3e\x15\xf9\x95\x28\x52\x7e\x43\x6e\x29\x9f\x6e\xe3\xf2\xbb\x3b\xfe\x7d\x91\xef\x59\x21\x1e\x48\x4c\x6f\xdb\xf7\x19\x8d\x55\xe3\x6a\x10\x98\x3c\xc8\x2a\x76\xf4\xb8\x1f\x9e\x79\xd4\x2e\xb0\x5c\x74\x5e\xed\xae\x59\xd1\xce\x22\x9b\xf2\x3c\x61\xef\x1e\xf6\xac\x21\xf7\x03\xd5\x9c\xf1\x2a\xcb\x46\x94\xf9\x3e\xa3\x94\xb2\xa9\x9a\xee\x86\xbc\xa2\x97\x76\xb5\xc9\x9a\x1e\x64\x75\xc1\x68\x46\xca\x62\x2d\x7f\x78\xce\xd7\x4c\x5d\x7c\x03\x70\x14\x8c\x66\xcd\xc2\x54\x7f\x76\x2d\x01\x95\x70\x7c\x90\xcb\x52\x90\x94\xe4\x14\x71\xca\xeb\xfa\x15\x9e\xae\x0b\x16\x0b\xf6\x2a\x63\xb2\x6a\xe4\x95\xeb\x22\xdd\x4b\xb0\x48\x37\x28\x9f\x0a\x76\x2f\xa8\x04\xf2\x4d\x5e\xa0\xe2\x2c\xe5\x67\x6b\x8c\x52\x2a\xc2\x22\xaa\x6b\x58\xd3\x17\x42\xed\x17\xe6\xfb\xdd\x7b\x54\x60\xec\xfb\xf9\xb4\xec\x3c\x23\x29\x5e\xf0\xe9\x96\xc5\x89\x5c\x2f\xc6\x93\xcb\x6d\x9a\x25\x28\xc7\xd3\x7d\x5c\x30\x2e\xbe\xcd\x13\x36\x2d\xd8\x2e\xbf\x65\xe6\x4d\x63\x87\x71\xd7\x9b\x27\x4a\xd9\x92\x8d\x3d\x2f\x38\xda\x5b\xac\xae\x87\x16\x64\xc9\xc3\xdc\x00\x53\x54\xd7\xe6\xb3\xc0\xbc\x6f\xe4\xfc\x6c\xa8\x07\x98\xc2\x23\x57\xb4\xbb\xd1\x4d\xd3\xec\xee\xec\x6a\xba\xe1\xd3\x94\xa7\x02\xde\x38\x53\xbd\x97\x7d\x54\xd0\x3f\x1a\x49\x08\xc8\x18\xbf\x11\x5b\x2f\xe5\x12\x1e\xd8\x54\xdd\x12\x4e\xe5\x60\xf4\xde\x1b\xed\x10\xc3\xbe\x3f\xba\x87\x1f\xe4\xc5\x45\x11\x3f\x78\x94\xca\x15\x9a\x51\x4a\x45\x5d\x1b\x40\xb2\x63\x11\xbe\x3f\xbb\x10\xbe\x2f\x26\x73\xb9\x2c\x0c\x37\xb2\x4b\xf4\x6a\xba\x37\x9b\x8c\x1e\x14\xf6\x0a\x36\x64\x9d\xf3\x52\x14\xd5\x5a\xe4\x45\x70\x45\x54\x17\x82\x19\x11\xf9\x0b\xd9\x52\xbb\xab\xec\x10\x4b\x35\x4b\x72\x97\xe3\x86\xdc\x30\x31\xb4\xf3\xcc\x12\xb8\x85\x03\x76\x31\x5b\xca\xab\x90\x8d\xe5\x8f\x1e\x6f\x14\xa8\x67\x51\x43\xe4\xae\xbc\x12\xf1\xfa\x43\xa7\x4a\x35\x63\x57\xd3\x1d\x2b\x6e\x18\x54\x35\x75\x3a\x8d\x30\x61\x2d\xa2\x9a\xee\x0b\x76\xab\x36\x24\x05\x3c\x24\x1a\xc2\xe2\xf5\x76\xa8\x8f\x57\x53\xf9\x06\x2a\x04\xbc\xb0\x8b\xf7\x6d\x31\xde\x22\x11\xd9\xa0\xed\x19\xba\x9a\xee\xe2\x3d\xea\xe2\xb8\x0e\x04\x68\x20\x22\x42\x56\x8a\x71\x43\x00\x95\x0d\x4c\x64\xaf\xe2\x52\x63\x29\xa8\x3a\x2e\x6e\x60\x3f\x97\xb2\x82\x4d\x5a\x94\xe2\x54\x05\xec\x77\x34\xc3\x0d\xc9\xe2\x47\x8b\x4c\xe6\xb8\x21\xec\x96\xf1\x3f\xef\xc7\xd5\xf4\xa6\x60\x8f\x8c\x10\x89\xf1\x1c\xff\xfd\x19\x0c\x2d\x4f\x92\xff\xf3\x0a\xcf\x84\xae\x8d\xfd\x3e\xb0\xee\x0e\xa4\x10\x4e\xc7\x6c\x8c\x00\x8c\x82\x59\xbb\xe8\xdd\xe6\x66\x17\x94\xfb\x3e\xbf\x10\xcb\x10\x00\x8b\x47\x51\x10\x46\xb2\x7a\x7e\xba\xb3\x16\x6a\xea\xfa\x18\xc0\x14\x60\x06\x15\x29\xf3\x42\x04\x62\x2a\x7f\x48\xb9\x87\x65\x15\x53\x75\xd1\x90\xab\x29\xbb\x17\x8c\x27\x14\xf6\xbf\xbe\x76\xda\x93\xc3\x01\x54\x4b\x00\xcb\x92\x98\xda\x45\x0e\x67\x51\x5d\x1f\x1a\x52\xd2\x39\xa9\xda\xc7\x66\xd8\x19\x1d\xcd\x17\x12\xcb\x7a\xd7\x79\x9e\xb1\xd8\x41\x5b\xb1\xef\xa3\x8c\xc6\x9d\xca\x4a\x5d\xd9\x78\x8c\xc9\x11\xf6\x8b\xeb\x7a\x87\x62\x5c\xd7\x28\xa6\x87\x06\x93\x92\x52\x5a\xf9\x3e\x8a\xd5\x76\x29\x27\x13\xbc\x28\x2f\xaa\x85\xfc\x3a\xdd\x20\x45\x72\x10\xeb\x54\x8f\x01\xe5\x0b\x85\x5b\x0a\xca\x42\x11\x11\xef\xd7\x5f\x01\xbb\xfc\xfa\xab\x37\xa2\x54\xf8\x7e\x3c\xa2\xb4\x90\xbd\xf3\x7d\xf9\x73\x35\x4d\xcb\xef\xb3\x38\xe5\x6a\x9a\x51\x21\xbb\x90\x52\x40\x32\xd3\xb4\x84\x5f\x49\x16\xf0\x12\x71\x1a\xcb\x1a\x73\x9a\xfa\xfe\xa8\x5b\x80\xe3\x65\x18\x05\x69\x5d\xf7\xab\xe3\x78\xc9\x83\x43\x43\x52\x3a\x9a\x13\xf9\x39\x35\xcb\x81\x32\x92\x93\x02\xe3\xe0\x36\x4f\x93\xb3\x99\xee\x15\x14\x29\xb0\x85\xa1\xb8\x5d\x3f\x74\x60\xf7\xfb\x98\x27\x79\xa0\x39\x25\x6f\x8c\x36\xe3\x6f\x62\xb1\x9d\x16\xf2\xf1\x0e\x61\x3c\x2d\xd8\x3e\x8b\xd7\x0c\x9d\xaf\x5e\x9e\xdf\x10\xcf\xc3\x24\x2d\xdf\xb2\x38\x79\x90\x84\x96\x49\x3e\xab\x03\xca\x7d\x1e\x4c\xa2\x1b\x9e\xe7\x7b\x17\x1e\x1b\xd2\x19\xd2\xf1\x56\x20\xdc\xd0\x04\x34\x92\x44\x2c\x54\x6b\x7b\xa6\xca\x47\x72\xde\x2d\x05\x93\xb4\x62\x84\x04\x95\x4d\xe1\x21\x82\x87\x38\xbd\xd5\xb8\x99\x78\x0e\xb4\x7b\x58\x12\x6b\xe7\x01\xf6\x7d\xcd\xe7\x70\x4c\x29\xcd\xb0\xec\xe7\xab\xdd\x5e\x3c\x9c\xea\xe7\xc2\x85\x0e\xd5\xe1\xb9\xe9\xf9\xac\x21\x37\x59\x7e\x1d\x67\xaf\x6e\xe3\x2c\x70\xb1\x81\x64\x41\x24\x2f\x72\x50\xfc\x8a\x24\x5f\x53\xb8\x6c\x08\xc7\x47\x48\x5c\x62\x0f\xd9\x18\x27\x05\x9d\x49\x7e\x44\xd2\x56\x7c\x90\x2d\x73\x6a\x28\xe9\xa2\xb8\xe0\x8b\x42\x01\xf2\x68\x2e\x89\xa5\x9e\x9e\xb0\x88\x48\x41\xe4\x0f\xc6\xd7\x05\x8b\x3f\x34\x2c\x2b\xd9\x99\x65\x64\xd8\x9f\x7f\x61\x00\x87\x49\xba\xf1\x81\xf5\x88\x65\xdb\x3f\x49\xa0\xc3\x68\xd1\xe7\xe0\xd0\x5e\xb3\x8d\xb2\xdb\x4b\x43\xdb\x38\xf1\x4a\x60\x2e\x5d\xbe\x24\x64\x51\xc0\x70\x50\xe9\x45\x20\x0c\x63\xc2\x1b\x92\xf2\xe3\x36\x09\xef\x11\x60\xb1\x9c\xcc\x83\xd4\xac\x33\x83\x99\x84\xa6\x7a\x5d\x95\x23\x57\xdd\x1d\x0b\x83\x75\x0a\x3a\x23\xe9\xf1\x5c\xb2\x30\x1d\x8f\x23\x60\xf3\xec\x1c\xe8\x32\x34\x25\xac\x21\x12\xd9\x1f\xf5\xca\x34\x50\x48\xbe\x3f\xa5\x33\x92\xdb\x9a\x49\x4c\x47\x7c\x91\x5e\xe4\x8b\x74\x3c\xc6\x23\x81\x58\x98\x46\x24\xc5\x23\x4a\x63\xdf\x2f\x00\xb3\xc3\x33\xbb\x59\x8b\x1e\xad\x3e\x62\x60\x67\x24\xa6\x61\x64\xc1\x02\xd6\xb5\x1d\x49\x7e\x51\x2c\xf2\xf1\x18\x6b\xdc\x96\x52\xd9\x64\x1e\x91\x9c\x70\x0c\xd0\x0e\x2d\xa6\x78\x61\x81\x22\x57\x40\xf1\xa7\x1f\xe8\xfe\xdd\xa0\x58\x72\x46\x55\x9a\x04\x73\x52\x56\x7b\x79\x6a\x0b\x1e\x1a\x4c\x06\xf8\xce\xab\x87\xdd\x75\x9e\x01\x82\xdc\xf0\x50\xdd\x4d\x53\xc1\x8a\x58\xe4\x85\x9c\xe6\xfe\x23\x4c\x34\xdf\xe2\x7d\xa1\x88\xc1\xd9\xb7\xc0\xfe\x9d\xa9\x63\xc9\xd9\x6b\xc3\x6d\x02\x78\x9c\xbd\x8c\x05\x3b\x7b\xcb\x6e\x5e\xdd\xef\x35\xa2\x50\x28\x48\x37\xec\x01\xf9\x12\xc8\x3b\xf3\x70\x8f\x38\xf3\xd0\x62\x18\x6f\x2c\xc6\x5e\xe4\x45\x54\x4c\x45\xfe\x75\x7e\xc7\x8a\xcb\xb8\x64\x08\x37\x18\x0e\x73\x0e\x99\xe3\x86\xce\x25\xe4\x9a\xe4\x24\x25\x5b\xb2\x21\x37\xe4\x8e\x54\x24\x23\xef\xc8\x25\x89\xc9\x2b\x72\x4b\x4a\xb2\x26\x0f\xe4\x8a\x7a\x65\xfa\xc7\x1f\x19\xf3\xc6\xf3\xa7\x12\x39\xca\xce\x92\x3d\xe5\xed\x71\xe6\x03\x9d\x01\x20\xee\x68\xc5\x10\x26\xf7\xea\xe7\x85\xfa\xf9\x56\xfd\xbc\x1c\x66\xc5\xe5\x21\x49\x00\x79\x1c\xcd\x30\x99\x35\xe4\x37\x7a\x68\xfa\x27\x3a\x38\x87\xfe\x2e\x0f\x84\xf9\x9e\x7c\x6d\x0e\x86\x5f\x99\x8b\xef\xec\x49\xf4\x7b\x7a\x6a\xc7\xc8\x0e\x5a\xd8\xe2\x17\xc5\x82\x2b\x8c\xc3\x42\x1e\xc9\x2e\x60\xb3\x23\x35\x78\x4c\xe6\x0d\x79\x4b\xbd\xf5\x96\xad\x3f\xb0\xa4\x2e\x59\xc6\xd6\x82\x25\x75\x5c\x3e\xf0\x75\x1d\x57\x22\xdf\xe4\xeb\xaa\x84\xab\x7d\x16\x3f\xd4\x20\x77\xc8\xb3\xb2\x4e\xd8\x86\x15\x75\x92\x96\xf1\x75\xc6\x92\x7a\x9b\x26\x09\xe3\x75\x5a\xee\xe2\x7d\x9d\xe5\xf9\xbe\xde\x55\x99\x48\xf7\x19\xab\xf3\x3d\xe3\x75\xc1\xe2\x24\xe7\xd9\x43\xad\x4f\xfa\x49\x5d\xae\xf3\x3d\x4b\x3c\xf2\x0d\xf5\xc2\xd5\xea\xfe\xd9\x6c\xb5\x12\xab\x55\xb1\x5a\xf1\xd5\x6a\x13\x79\xe4\x0d\xf5\xd0\x32\x58\xad\x56\xab\x70\xb5\x4a\xe2\xc9\xe6\xc5\xe4\x75\x74\x98\x93\x4f\x1b\x6f\xfc\xcd\xd8\x5b\xd6\xf0\xea\x7d\xfb\x49\x1d\xae\x56\x77\x93\xa8\x0e\xdf\xaf\x66\x93\xd5\xea\xfe\xff\xd9\x44\x78\xec\x91\x9f\xa8\xb7\x5a\x85\xf0\xcd\x53\xe4\x8d\xdf\x8c\x3d\x8c\x96\x81\xbe\x0f\x9f\xbe\x7f\x52\x8f\xfe\x13\x2d\x29\xd6\x4f\x96\xc1\x47\x48\xb7\x3b\x95\x55\xad\x56\xab\x8f\x22\xfc\x14\x7f\x54\xaf\xbc\xfe\x8b\x95\x27\xdf\xac\xbc\x5a\xd7\x8b\x6b\x5d\xcb\x6a\x15\x79\xe4\x35\xf5\x82\xb6\xc1\xd5\x0a\x21\xf4\xdf\x57\x8d\xeb\xfe\x1b\x84\xc3\xd5\x2a\x8a\x6a\x6f\xfc\xd3\xd8\xc3\x4f\x71\x3d\x7d\x8a\x57\x2b\xd9\x34\xf9\x82\x4a\xc0\x55\x1b\x0c\x7d\x33\xf6\xc6\x1e\xf1\x6e\x3c\x4c\x9e\xb8\xcf\xbd\xf7\xd0\xc7\x31\x54\xfc\x5e\x57\x1a\x61\xd3\x0a\x7e\xaa\xc6\x30\x7e\xa2\x3f\xfe\x75\xe0\xe3\xa7\x44\xfd\x78\x98\xfc\x31\xf4\x1a\x85\x9f\x8f\xff\x23\xbb\xf8\xcd\xd8\xc3\xb6\xe8\x8f\xbd\xee\xd5\x9f\x7b\x98\xfc\xec\x3e\x7c\x8d\xc9\xbf\xfa\xf5\xbd\x19\x7b\x4f\x3c\x4c\xbe\xa4\x87\x37\x2f\x83\xce\xbb\xbf\xe9\xd9\xf5\x30\xb9\xfc\xfa\xc5\xd5\x55\xf7\xed\x6a\x35\x6d\xdf\xbf\x7b\xf1\x65\xf7\xad\x7a\x55\x87\x4f\x23\xf9\xfa\xc5\xbb\x77\x6f\x83\x5e\xbb\x3f\x61\xf2\xfd\xd5\xab\x1f\x5f\x7e\xd7\x7f\xf1\x1a\x93\xcb\xaf\xde\x7c\xdd\xeb\x4c\x80\x00\xbc\xe1\x78\x54\xcb\x03\x50\xcd\xc5\x56\xfe\x9b\xc8\x1b\x3c\x41\xeb\x6d\x9a\x25\x75\xbe\x99\x48\x64\xab\x21\x42\xcf\x96\x3c\x0b\xd5\x79\x92\xd4\x08\x85\xe3\x49\x54\x63\xb4\x5a\x25\x4f\x31\xaf\x5b\xa0\xd4\x2f\xf4\xfd\x6a\x95\x8c\x71\x8d\x2d\xb4\xc1\xea\x7b\xa9\x87\x89\x64\xca\x7b\x23\x95\xc0\xfe\x76\xec\xe1\x27\xba\x08\x67\x2c\x29\x2f\x73\x2e\xd8\xbd\xe8\x8f\x4d\x56\xa7\xd6\x2e\x68\x7b\xc5\x7e\xaf\x6f\x44\x9d\xa9\x11\xb5\x03\xec\x8e\x01\x2d\x83\xc9\x6a\x95\xe0\x25\x74\xdd\xe9\x18\x5a\xd2\xf0\xfd\x24\xaa\x9f\xe8\x2e\x36\xe4\x17\x7a\xfe\xd5\xbb\x6f\xbe\x7e\x72\x9e\x92\x1f\xe8\xb9\xec\x60\xca\xf7\x95\xd0\xd8\xa7\x96\xfd\x8a\x0b\x16\xd7\xd7\x95\x10\x39\xc7\xb2\xdc\x3f\xe8\xf9\xfb\xed\x2a\x91\x97\xff\xa4\xe7\xef\xc3\xf7\x87\x68\xbc\x3a\xac\xca\xa7\xab\x90\xc7\x22\xbd\x65\x67\xab\xbb\x73\xf2\x6f\x55\xdb\xdf\x50\x28\x11\xc1\x18\xd7\x68\x75\x37\xc6\xf5\x6a\x6a\x1e\xe0\x27\xe7\x84\x31\x7a\x1e\x8e\xff\x13\x9d\x13\xc1\x3a\xb0\xf6\x27\xa8\x06\xb9\xb8\x06\xeb\xcd\xc1\x19\x1d\xe2\xb3\xbc\xd9\xbd\x37\x66\x0a\x5b\xa3\x39\x9e\x7c\xfa\xc9\x27\xcf\x3f\xb5\x47\xc4\xba\x46\xfc\x62\xb6\x54\x34\x72\xba\x29\xf2\xdd\xe5\x36\x2e\x2e\xf3\x84\x21\x3e\x86\xa2\x38\x18\x7c\xf9\xf9\xe7\xf3\x59\xfd\xc9\x27\xcf\x3e\xfb\x94\xcc\x67\xcf\x9e\xfb\xbc\xfe\xe4\xd3\xe7\xcf\x66\xf2\xb8\x5a\x30\x7a\x8e\x42\x89\xf8\xee\xe7\x1b\xc0\x7d\xf5\xfb\xc9\x72\x95\xe0\xfa\xfd\xe4\x89\x46\x89\xfa\xcd\x64\x55\xbd\x7e\xfd\xfa\xb5\x9c\x91\xf3\x1b\x92\xf6\x47\x60\x7a\xb9\xf4\x56\x33\x8f\x52\xca\x96\xde\xaa\xda\x6c\x36\x89\x17\x98\x11\xcd\xc8\x64\x8e\xc7\xde\x6a\x25\x07\xb9\xd6\xdd\x7b\x21\x90\xa1\x3c\x93\x39\xb6\xa2\x49\x34\xff\x14\x8f\xbd\x33\x2f\x50\xc5\x1b\x92\x33\xf7\x20\xfa\x4e\x9e\x68\x63\x46\xaf\x19\x3a\x96\x8a\x8c\x66\x20\x5b\x34\x44\xc6\xf7\xbd\x4d\xca\xb2\xa4\x64\x02\x3a\x06\x22\xca\x6f\xe3\x1d\xeb\x31\x02\xe4\x90\xa4\x45\xe0\xb5\x82\x3a\x8f\x70\x09\xeb\x5e\xc6\x6e\x18\x4f\xbc\x06\x2f\x44\xf1\x70\xf8\xca\xc8\x38\xe8\x77\x8a\x29\xdd\x4f\x61\x8f\xca\x2f\x4a\x4c\xba\x77\x22\x74\xef\x8d\xb4\xa8\x15\x92\xae\x63\xb1\xde\xca\x9e\x7f\x45\x0f\x50\x6d\x60\x78\xd7\x65\x77\x7a\xbf\xd6\xad\x32\xa2\x5b\x15\xb8\x23\x2f\x6e\x81\x88\x39\xcc\xef\xe2\x6e\x9b\x66\x4c\x92\x71\xcd\xef\x8e\xc7\x11\x5e\x58\x5e\x57\xd2\xf1\xa6\x95\x3f\x96\x4c\x31\xd8\xa4\x50\x75\xc1\x09\x9f\x94\xc0\xf7\xac\xc9\x06\xa4\xb6\xd3\xfc\x8e\xb3\xe2\xa5\xe1\x6d\xf6\x94\x2d\x5b\x99\x6f\xf0\x99\xe4\x57\x41\xf2\x1a\x46\xf6\x1c\x60\x85\xc3\xa2\xae\x47\xa2\xae\xe7\x23\x4a\xf7\xbe\xff\x99\xfa\x99\xc3\x6d\xcb\x60\xc8\x53\x8b\x3c\xdd\xbe\x43\x0c\x13\x46\x59\x5d\x5f\x92\x57\x18\x34\x02\x73\xfd\x25\xaa\xe8\xbf\xa7\xec\x9e\xad\xe5\x24\x48\x36\x25\xa5\x55\x38\x8f\xa0\xcc\x67\x54\xd6\x06\xfa\x03\x14\x53\x36\xbd\x61\x42\xcb\x7e\xbf\x78\x78\x93\xa0\x14\xe3\x4e\x53\xf1\x34\x4d\x28\xa5\xa9\x7d\xa8\xf8\xe0\x58\x1e\x4f\x80\x75\x4e\x37\x68\x03\xa2\x85\xcd\x40\x55\xbe\x2f\x17\x24\x06\xfe\xf9\xf1\x7a\x64\x87\xaa\xf0\x59\x64\xde\x1b\x20\xe2\xc4\xed\x62\xf9\xc5\xc3\xbb\xf8\x46\x82\xa6\x1c\x19\x81\x1e\xc2\xe0\x9e\x47\xd8\xf7\x93\x6e\xc9\xcb\x2c\x2e\x4b\x59\x56\xae\xca\xf0\x9b\x3f\x6d\xcd\x96\x94\xa3\x21\xbc\x49\x37\x28\x99\xfe\x5e\xc6\xbe\x3f\xfa\x36\x14\x72\xff\x45\xf2\x10\x7e\x5b\xd7\xa3\xdb\xa9\x60\xa5\x90\xfd\xf2\x7d\x04\x0b\xd1\x0a\x98\x47\xa7\x77\x95\x5a\xb9\x35\x15\x12\x7e\x88\x3c\x8e\xca\x05\xfc\xd1\x54\x56\xd7\x7f\xd8\x7a\xf1\x01\x6d\x28\x63\xe6\xde\xf7\x1f\x18\x62\x8e\xdc\x1c\xd7\x35\x93\xc7\x77\x26\xa7\x02\xd8\xc0\xba\x46\xa8\x54\x8b\xdc\xca\xe0\xbd\x34\xf1\x30\x5e\x96\xb4\xb4\x12\x8e\x82\x91\x94\x61\x89\x87\xfa\x05\x49\x49\xaf\x30\x26\x39\x45\x19\xdd\xca\x4e\x18\x26\x58\x6d\x9c\x7c\x32\xc1\x59\x98\x47\x14\x95\x4b\xef\x6f\xde\xb8\x0c\xbc\x00\x5a\xf6\x00\x39\x8d\xef\x19\x92\xaf\xf1\x62\x4d\xb3\xe9\x6f\x79\xca\x91\x47\x3c\xdc\x48\x34\x71\x34\xf5\x9b\x29\x88\xab\xaf\x80\x5a\xe5\xc5\x8b\x2c\x43\x6b\x98\x74\x8b\x03\xbe\x45\x82\x8c\x66\xb8\xd9\xa4\x3c\xce\xb2\x87\x43\x49\x29\xbd\x92\xab\xab\x14\x06\xbd\x21\x36\x4d\x63\xcf\x6a\xc2\x0e\xf5\x09\xf1\x9e\xcc\x3d\xac\xb7\x71\xbb\xb7\xe5\x29\xe3\x60\x4e\xaf\x86\xa4\xd8\xb7\x72\xe3\xb7\xe7\x6e\x7d\x5e\x85\xf5\xc7\x9f\x5f\x4f\xd7\xf1\x7a\xcb\xbe\x86\x79\xf1\xfd\x84\x65\x4c\xb0\x33\x16\x16\xd3\x72\x9b\x6e\x04\xc2\x11\x61\x1a\x56\x28\x77\xb0\x89\xc4\x3b\xed\x41\x26\xbc\x8a\xe8\x68\x46\x58\xfb\x7e\xcd\x5a\x01\xe9\x65\x5f\x4d\x63\x11\xb6\xc2\xb9\x1a\xaf\x8f\xe4\xbe\x68\xe7\xcb\x08\x66\xec\x84\x09\x07\x5a\x7c\x5f\x9c\xd2\xb9\x08\x4c\x04\x95\x07\x61\xa7\xb7\x1b\xd6\xc5\xa1\xfa\x48\x59\x7b\x98\x14\x94\x77\xa1\xa2\x98\x4c\xf0\xf5\x34\x16\xa2\xf8\x2a\xe6\x49\xc6\x42\x1e\x16\x51\x44\x45\x5b\xdb\xbe\x53\x9b\xf0\x7d\x26\x6b\xf1\xfd\xb9\xa5\x3f\x12\x5d\xaa\x7b\xe1\xdc\xb3\x69\x99\x57\xc5\x9a\xbd\xe1\x09\xbb\x9f\x08\xf7\x4e\xe2\x82\xc2\x6c\xe8\x02\xf0\x2c\x56\xdd\xe1\x94\x4f\x25\xa1\xba\x4a\xaf\xb3\x94\xdf\x80\xc8\xd3\x39\xb4\x4d\xe6\x56\xc6\xb1\x9c\x07\x93\x79\xdb\xcb\x44\x4e\xe7\xa1\x07\x0b\x8e\xca\x0f\xd8\xab\xc7\x48\xa6\xec\x30\xa8\x62\x28\x15\xce\x5c\x6e\x99\xa3\x7a\x38\x96\x86\x9f\xaa\x4d\x77\x13\xb5\xed\x8a\xba\xf6\x14\x37\x07\x77\x6e\x7b\x2e\xa4\xdd\xfc\xc9\x38\x36\x79\xb1\x03\x05\xd5\x92\x75\x00\x64\x34\xef\xf0\x09\x4b\x2f\x8b\xaf\x59\xa6\x4a\x3a\xd7\xce\x37\x9d\x0a\xec\x87\xb2\x6f\xc1\xd1\x6d\x5a\xbe\x74\x1e\xd4\xb5\xfb\x64\x44\xe9\x48\xf8\x7e\x2c\xb7\xc0\xd0\xd7\x4e\xeb\x72\xcc\xee\x3b\x67\xdc\xb7\x0c\xc5\x76\xdc\x99\xc3\x09\xe5\xf6\x69\x4e\xc7\x39\x71\x5f\x75\xa4\x8e\x31\xa8\x93\x0d\xbf\x90\x63\x92\xd2\xa2\x0b\xea\xe9\x64\x82\x59\xc8\x69\x11\xa6\x91\x24\x05\x20\x10\x18\x21\x21\x7f\xe4\x35\xc6\x8d\xfc\xdf\x76\xe9\xa1\xb3\xe9\x7d\x7f\x48\xd3\x3e\x4c\xf1\x7c\x9f\x35\x9b\xbc\x40\xec\x2c\xe5\x67\x09\x2d\xd9\x54\x4b\x9d\x28\x88\xc4\x4b\x39\x7d\x3f\x7f\xf3\x35\x1d\x84\xa7\x78\xc7\xca\x7d\xbc\x66\x3f\xbe\x7d\x43\x38\x45\x3d\x2e\x45\xd2\x0d\x2b\x8e\xd1\x0d\x1b\x61\xee\x2f\x9a\xdc\xd4\x35\xf7\x7d\x6e\x21\xb3\xae\x3d\x79\xd6\x90\xc7\x8e\x77\xd0\x17\x26\x4c\x6d\xc7\x3d\x90\xa8\x16\xf8\xa0\x7e\xab\xc1\xde\x8a\xfb\x46\xf4\xd2\xf7\x25\x73\x52\x38\xdb\xbd\xe8\xf7\x0a\x38\x0d\x74\x49\x8b\xa3\xfe\x92\x57\x74\x94\xa2\x4b\x4c\xf6\x50\x13\xe2\xf4\x72\x9a\xb0\x4d\x5c\x65\xe2\x5f\x29\xbb\xc3\xb2\xf3\x22\xdf\x8f\xa8\x44\x33\x88\x4f\xe3\x24\x79\x75\xcb\xb8\xf8\x3a\x2d\x05\xe3\xac\x58\x1e\x3f\x42\x5e\xc5\xb3\x3c\x4e\x3c\x92\x33\x32\x9a\xe3\x80\x4b\x9c\x16\xaf\xb7\x50\x4a\x56\xe8\xdc\x22\x2f\xe7\x6d\x71\x8c\x89\x26\xc1\x74\x3d\xc4\x81\x9f\xc5\x1d\xf5\x37\xc3\x9d\xdb\x23\x6c\x9f\xa4\xb7\x1e\xc6\x64\x18\x58\xfa\x54\xd3\xf7\x47\xc7\x0f\x91\xa6\xcb\x67\x86\x70\x9c\x41\x9d\x1a\x98\x1b\xd9\xdd\xd8\x90\xcf\xf2\x44\x9f\xd9\x74\x6d\xd8\x21\xea\xa5\x1e\x19\xf5\x19\x0b\xfb\xda\xc3\x50\xe3\x10\x18\x9f\xac\x7b\x68\x02\x2e\xf3\x9d\x9a\x00\x39\xfa\xd1\x09\x4e\xd0\x7b\xda\x1d\xc7\x30\x03\x47\xff\xa9\x00\xf9\xf2\x14\x2b\xa8\xbe\x94\x9c\xeb\x5f\x5d\xb2\x34\xa1\x57\x64\xd4\xab\x50\x6d\x8e\xa1\xa7\xe8\xaa\xdf\x4d\xd9\xd8\x12\x5d\x4f\x37\x69\x26\x58\x31\x7d\xf3\x72\x70\xf3\x1a\xce\x45\x30\xc2\x5b\x2d\xf8\xe0\x1c\x1e\xf3\x79\x0a\x23\x12\xd9\x06\x4f\xba\x2d\x48\x44\x97\x6e\x06\x6d\x7d\x44\x8f\x97\xf7\xfd\x57\x96\x56\xf7\xd9\xfc\xb6\x4b\x7c\x19\xf2\x28\x08\xa3\xa6\xc1\xc1\xa3\xa3\xe2\x7f\x69\x54\x6a\xfc\x27\x11\xa4\x1d\xa8\xa2\x55\xc7\xcf\xd4\x04\x58\xe9\x80\xe4\x77\x6e\xe3\xac\xd2\xc4\xf1\xff\xde\x94\x28\x55\xef\xe0\xc4\xa4\x1b\x49\x6a\xe4\x21\x85\xd3\xfc\x44\x07\x01\x33\x99\x8e\x99\x13\x49\x98\x47\x8b\xb4\x53\xa5\x01\x22\x86\x9d\x73\x6b\x4e\x53\x75\x64\xfd\xdf\x9a\xd0\xfc\x31\xac\x98\x99\x8f\x77\x2f\xbe\xa4\xc3\x3b\x77\x39\x24\xcf\xf8\xb3\xa9\x72\x3e\x1f\x7e\x8c\x18\x0e\xe0\x48\xb5\x14\xc7\x48\x8b\x19\x8d\xee\xe0\x49\x9e\x38\x8a\xa6\x93\x95\xcb\x35\xf0\x9e\x02\x93\x86\x0f\x86\x1f\xcc\x41\xbf\x85\x25\x7b\xc3\x3b\xe4\x06\xf8\x7a\xee\x28\xa1\x0c\x9b\x60\xe1\x05\x24\xa2\xfd\x19\x72\x8e\x9a\xff\x35\x30\x75\x3f\x7f\x85\xad\x95\xcb\x89\x83\x28\xc3\x0d\x29\xe5\xc0\x6f\xe5\x1f\x75\x1c\x6d\x91\x5b\x7f\x0a\xe1\x34\xda\xc3\x67\x4a\x81\x7b\x8c\xce\x38\x67\x85\x24\xea\xd4\xbb\x88\xcf\xd2\x84\x7e\xe4\x8d\xaf\xc6\xde\x47\x9f\x5f\x9c\xc7\x9f\x5f\x28\x21\x62\xfb\x78\xb2\x2a\x56\xab\x8f\xce\x76\x65\x9c\x65\xf9\xdd\x3a\xde\x8b\xaa\x60\xf4\xa3\x8f\x3e\xbf\xc8\xf7\x5a\x58\xa2\x74\x1e\xf0\xec\x5c\x3d\xfc\xfc\xe2\x5c\x3d\xfe\xdc\x23\x43\x34\x2a\xec\x56\xf7\x9e\x7e\xf4\x51\x64\x91\xbb\xef\xdf\xaa\xf5\xf1\xc2\xa7\xef\x9f\x44\xb4\x55\x2c\x7c\x54\xaf\xbc\x15\xc8\xa3\x07\x2b\x35\x3d\x69\xab\xaa\x6b\x53\x55\xab\xc2\x58\x06\xb0\x43\x6a\x25\xd4\x3d\x55\x57\x9a\xfc\x87\xaa\xf1\x0f\xd5\xf6\x1f\xea\x61\x82\x06\x8e\x6b\x8a\x63\xc7\xb8\x77\xc0\x96\x7c\x99\x07\x96\x05\x5d\xfa\x27\x4e\xb4\x2e\xcb\x77\xe6\x64\x60\x20\x50\x27\x5c\xfd\xe5\x19\x0a\xb4\x9e\x6a\xa0\xda\xf6\xd5\xe0\x97\xf1\xdf\x60\x32\xc6\x4f\x07\x3e\x9d\xfe\x6d\x3a\x0e\xc7\xff\x89\x4e\x7c\xba\x5a\xad\x36\x1e\x26\x76\x4d\x1d\x1d\x95\xe4\x1e\x7a\x30\xcb\x7a\xe0\xb9\x2d\xd8\x86\x7e\xf4\xd1\x99\xe5\xfc\x3f\x32\x57\x5d\x78\x1d\x7c\xaf\x80\xf1\xdc\x81\xc6\xc5\x89\x63\xb6\x5e\xb7\x85\xe8\x2d\x9c\xdc\xc7\x1e\xf1\x94\x76\x6e\x60\xf9\x86\xd7\xf9\xe5\x29\xa8\x82\x75\x4d\x86\x40\xbd\x5d\xcd\x56\xa5\xe6\x61\xf2\x0c\x24\x4a\x03\x2b\xc9\x38\x0c\x72\xa0\x26\xfb\x8a\x78\x81\x99\x0b\x0f\x93\x23\x34\x60\x67\x6c\x34\x3b\xdd\x4c\x5b\xc1\x5f\x6d\x67\xa8\x9a\xa7\x24\xb8\x77\x40\x80\x4c\x9f\x06\x72\xed\xb1\xc4\x6a\xbb\x58\xac\xb7\xac\x34\xe5\x0d\x86\x5b\xd3\xd8\xbc\xaa\xeb\x78\x7a\xc7\xae\x3f\xa4\xe2\x9b\x6e\x59\xf9\x62\x97\xff\x31\xf0\x34\x1f\x2a\x59\xf6\x1e\x4a\x94\xd9\x83\xbe\x44\xce\xca\x3a\xe7\x1c\xf0\x08\x94\xa7\x6b\x63\x03\x08\x3a\xb8\xf6\x2e\x2c\x47\x72\x8b\xc2\xc8\x4a\x3d\xb2\x11\xf5\xc8\x6b\x09\xd5\xb7\xf4\xd6\x4e\x98\xa3\x23\xb9\xd5\xb2\xb1\x5a\xf2\xb9\x25\x2d\x87\xca\x94\x6e\x19\x61\xe6\x23\x9e\xae\xf3\x9d\x3c\x72\x9b\x53\xd5\xf7\x79\x99\xca\x6e\x63\xf2\x20\x0f\xd5\x4e\x31\x2e\xe2\x94\x97\x78\x39\x24\x13\xff\xac\x23\x77\x59\xb2\xfe\xe9\x2a\x60\xa4\xa0\xa2\x2b\x32\x5a\x38\x3a\xf8\xa2\xae\x47\x68\x54\x28\xd9\x75\x7b\x82\x93\x4f\xb9\x6d\x7a\xd9\x5e\xa2\x02\x07\xec\x54\xd7\x7d\x7f\xfe\xa9\x7f\xf2\x2d\x18\x90\xf5\xf9\x81\x74\x83\x84\x96\xf6\x08\xea\x76\x52\x72\x46\xc2\xe1\x7a\x46\xb3\x85\x95\x8a\x91\x97\x54\x2c\x8f\xea\x61\xae\x3e\x3f\x93\xbb\x60\xb6\x50\x93\x34\x3a\xd9\xa7\xc9\x48\x9c\x7a\x65\x99\xe3\xba\x46\x73\x79\x2c\x1d\x3a\x7c\x53\x8a\x44\xff\xa9\xc0\xcb\xd3\x73\x20\x70\x30\xc7\x75\x3d\x4a\xc0\x4e\xf1\x25\x93\xc7\x50\x96\x28\xab\xae\xe1\x2f\x40\x8e\xc2\x97\x8c\xd2\xcb\xba\xee\x75\x01\x64\xce\x0f\x68\x4f\x18\x5e\x4e\xe6\x81\x80\x32\xe2\x44\x19\x81\x97\xf3\xa0\x5a\x7e\x8f\x2a\xc2\xf0\x44\xfe\x08\x1c\xcc\x82\x8f\x7d\x2e\xbf\x9d\x0f\x2d\xcd\xc9\x29\xb5\xf6\x47\xed\x82\x01\x2f\xe7\xdc\xc6\x34\x64\x91\x64\x78\x04\xd8\xfa\x8c\xd2\xba\x1e\xe5\xb8\x05\xbd\x4b\xd3\xe3\xe5\x3c\x48\xe5\x75\x3e\xd4\xbd\x05\x28\x41\x28\xb5\x5f\x6a\x01\xe4\x82\x53\xb6\x68\xa5\x84\x0e\xdc\xc4\xd3\x8a\x2b\xf9\x2d\x97\xa5\xc4\x70\xa9\xd2\x2d\xa5\x4a\xc4\x61\x11\x51\x4a\xcb\xb0\x88\x70\x31\x1e\x5b\x56\x72\xb9\x57\xef\x08\xbc\x09\x54\xb1\xbd\xec\x71\xa9\x2f\xe7\xc1\xac\xc1\xe4\xb2\x21\x25\x33\x38\x6e\x58\x87\x58\xca\xce\xf3\x2a\xcb\xd4\x1f\x81\xdd\x4f\x2c\xc6\x3c\x5a\x06\xd0\x18\x1d\x61\x56\xdf\x7f\xd5\xd3\x66\x94\x75\x3d\x2a\x5d\x6d\x46\x4f\xbf\x81\x45\xf1\xa0\xf1\x86\x45\x7b\x02\x18\x6d\x5e\xd7\x03\xa8\x52\xc2\x9b\xc1\x27\x5a\x9f\xd5\x3e\xb0\xb8\xc2\x6a\x86\x8e\xe5\xfd\xfa\xcd\xec\x02\x14\x70\x97\x6a\xd4\x21\x8b\xec\x79\x5a\x0e\xdf\xa0\x96\xc1\x29\x1b\xda\x74\x20\x27\x82\x39\x79\x50\x46\xfc\xb2\x96\x58\x88\xfe\xcc\x3d\xfa\xad\x46\x0d\x1d\x69\x77\xcf\x80\x2a\x52\xa2\xed\xdf\xd4\x54\xb9\x25\x49\xaf\x24\x5e\x2a\x3b\xb7\xd1\x2b\x73\xee\x31\xd0\xd3\xda\xb5\x2e\x8b\xc0\x15\xd5\xd4\xf5\xe8\xd5\xb2\x77\xf0\x17\x38\x00\x53\xb8\xa3\xc3\x20\xac\x66\x31\x2d\xf7\x6c\x9d\x6e\x52\x96\x2c\x0b\x75\x2a\x0c\x40\xd6\x2f\x87\xcf\xca\x75\xbc\x67\x03\x7e\x29\x88\x8d\x3d\x0f\xf7\x14\x46\xea\x93\xa2\xe8\x00\xdb\xb1\x21\xac\x77\xf5\xc0\x45\x7c\x7f\x06\x25\xc9\x59\xc5\x0b\xb6\xce\x6f\x78\xfa\x07\x4b\xce\xd8\xfd\xbe\x60\x65\x99\xe6\x3c\x38\xf3\xc6\xba\xca\x8a\xa7\xbf\x57\xec\x2a\x2f\x06\x65\x89\xca\xab\x48\xe2\x0d\xd8\xd6\x19\x1d\x25\xd3\x84\x09\xb6\x16\x2f\xab\x7d\x96\xae\x63\xc1\x4a\x52\x51\x8d\x1b\xaf\x84\xe4\x40\x40\x73\xa0\x74\xec\x92\x15\x91\x2f\xd0\x4b\x4c\x32\x73\x24\x14\x54\x99\x3c\x62\xa0\x15\x61\x2a\xf7\x41\x41\xb9\x31\xfa\xc3\x8e\x62\x83\x69\x63\x70\xc4\xe5\x66\x9e\x5b\xe8\xac\x40\x63\x42\x58\x43\x72\x5a\xc2\xe4\xbf\x63\xf7\xc3\x03\xf0\x3c\x8b\xf8\x0c\xf4\x03\x82\x52\x4a\x5c\x4a\x69\x5a\xd7\x9f\xa9\x9f\x39\xdc\xaa\x83\xe4\x91\xd9\x28\xb8\xe7\x80\xc5\x09\xb7\xe8\xb5\xf3\x10\xac\x74\x19\x65\x53\xb0\x2e\x01\x16\x6f\xc1\x16\xf2\x81\xab\x0f\xe1\x63\x9a\xcb\x63\xa5\xd1\xe7\x3e\x57\x4d\x7f\xec\xea\x6b\x55\x4f\xff\x25\xa1\x45\x95\x6b\xe7\x0d\x04\x10\x50\x87\x68\x45\x41\x0d\x41\xd7\x4a\x2e\xac\xb0\x4c\x49\x0f\x8e\x96\x2c\xf8\x64\x46\x14\xa7\xfd\x7d\xc9\xaa\x24\x0f\x32\x46\x00\x2d\x05\x5f\x92\x76\x7b\x04\x87\x86\xc8\x33\xb7\xfc\x2d\x58\x06\xf6\x28\xc1\xc1\xfb\xdc\x0b\x8e\x0d\x11\x94\xfb\xc4\x68\xd6\x10\xef\x6c\xe0\x7d\x43\xbc\xb1\x7d\x5c\xb0\xdb\x34\xaf\x4a\x3d\xfc\xce\xb7\xff\x39\x55\xa8\x69\xc8\xbe\x60\xaf\x41\xa4\x15\x1c\xc0\xae\x69\x48\x02\x17\xce\x23\x2a\xff\xf4\xc4\x5b\x84\x85\xcf\x23\x8a\xe4\xdf\xba\x66\xe1\xc7\xf0\xf7\x93\xa8\xae\xdd\x3d\xa5\x8b\xca\x43\x24\x00\xe1\x33\xa5\x4f\x78\x1e\x51\x4f\x6e\x8d\xf0\x79\x04\xfa\x46\xd2\x5a\x8b\x7c\x8c\x1b\x6d\x32\xf5\x68\x5f\x3a\x38\x86\x78\x5c\x6c\x55\x03\xf3\xc8\xd6\xf4\x1c\x2f\x75\xef\xcc\x8e\x46\x2c\x9c\x45\xb2\xe3\x1f\x47\x74\x8c\xe4\xcf\x52\x76\x59\x5e\x7e\x1a\xd5\xf5\x1c\x07\xcf\x9e\x22\x8f\xdd\x32\xae\x2a\x7b\x0e\xfe\x54\x49\x62\xee\xb0\xfc\xf6\x13\xf5\xed\xff\x13\x8d\x59\xf8\xff\x1e\x15\x08\xe4\x8f\xef\xf7\x5b\x6c\x8c\x7d\xd8\xd0\xd6\x19\xc9\xe6\x7d\x5f\xce\x8e\x81\xb5\x2f\xa7\x30\x07\x8a\x3c\x41\x1d\x4b\xb9\x13\x03\x18\xd0\x52\x96\xa4\xdd\x29\x0f\xb8\xef\xff\xac\x8a\x73\x49\xde\x04\xdd\x22\x2e\x09\x8d\xba\xe1\xc6\x5f\x10\x79\xd8\x23\x46\xb5\x39\x11\x78\x62\xae\x31\x2c\xcc\x4c\xd6\x3b\x6b\xe7\x50\x1e\xda\x65\x63\xdc\x79\xe2\xae\xd6\x73\x8c\x1b\x09\xd0\x0a\x84\xde\xbd\xf8\x72\xc0\x11\xa6\x2f\x17\x1d\x54\x00\x6a\x71\xd6\xf2\xc8\xcf\x65\x34\x1b\xf4\x90\x6c\xf5\x89\x12\x0d\x0e\xeb\x16\xb5\x9c\x58\xd9\xfb\x1d\xf7\x6b\x17\x32\x60\x0f\x5c\x5b\x2b\xd1\xb1\xf2\x42\xef\xad\x59\x22\x1b\x7b\xca\x7a\xad\x7e\x82\x41\xf8\xb8\x43\x8c\x0c\x7a\x6e\xc2\x1a\x0c\xe0\xb5\xb5\x6b\xba\x61\x6f\xea\xfa\xcf\x85\xc1\x7d\x41\xb0\x56\x4a\x78\x18\xf6\x5a\x83\x1b\xd2\xdd\xbb\x20\xbd\x7d\x44\x17\xab\x59\x02\xc9\x6f\xe1\x45\xcf\xec\x5e\x1e\xeb\xe4\xd9\x27\x90\x07\x1f\x24\xc6\x12\xaf\x7b\xea\xd1\x52\xd2\x91\x34\x30\x25\x96\x62\x04\xb7\xef\xf5\x6d\xea\xfb\xe0\xd7\x67\x21\x2d\xc5\x81\xf7\xb4\x7d\x39\x99\x5f\x74\xdf\x3d\x69\xdf\x69\x63\x65\x34\x49\x0d\x34\xaa\xa6\xfe\xa3\x8b\x4c\xe6\x17\x48\x22\x8c\xd6\xe4\xe1\x0b\x89\x13\xc1\x1e\x03\x77\x2a\xad\xd5\x17\x00\xf4\x80\xf1\x85\x85\x55\x53\xf7\x78\x0e\xb5\x8f\xbd\x89\x07\xd0\xdb\xc3\x36\x5b\x22\xd9\x95\x1b\x72\xab\xa6\xeb\x81\x02\x72\x19\x51\xba\x75\xa0\x9e\xec\xa8\x97\xc5\xa5\x70\x9f\x4f\x3e\xc6\xe4\x9e\x7a\xda\x6a\x13\xc0\xd9\xcc\xae\x24\x78\x37\x6a\x7e\x6e\x07\x5c\x82\x47\x23\xf7\xb4\xd0\x9c\x76\x10\x30\x96\x59\xf4\x61\x44\xe9\x6e\xe9\x39\x14\xcf\x1b\x20\x02\xeb\xee\x29\x64\x43\xef\x4f\x6f\x16\xb2\xa7\x23\xee\xfb\xa3\x7b\x92\xd0\xd1\x5c\x92\xee\x35\x50\xe8\x07\xc3\x4e\x64\xf8\x10\xdb\x83\x45\x4c\xe3\x30\x03\x11\xfd\xfd\x32\x3e\xbd\xfd\x36\x81\x1c\x79\xdc\xe7\x87\x47\xf3\x45\x45\x33\xea\xe5\x3c\x03\x97\xd0\xad\xef\x8f\x2a\xdf\xef\x0c\xa7\xb1\xdb\x3f\xdd\xa0\x8a\x86\xbb\xe5\xda\xa1\xf8\xc1\x7a\x2a\xa7\x1f\xae\x23\xb2\xf3\xfd\x3d\x3e\x24\x14\x95\x14\x15\x14\xa5\x14\xe5\x14\xc5\x74\x8d\xc3\xab\xa8\xae\x51\x1c\x5e\x45\xf4\xd0\x60\x1c\xc6\x9a\x0d\x7b\xf3\x52\x3e\xcf\xdd\x7b\x55\x60\x1b\xd5\x75\x18\x61\x89\x07\x29\xfd\xe0\xfb\x45\x38\x8f\x24\x7f\x19\x3e\x8b\x48\x4c\x4b\xdf\x5f\x3b\x96\x7d\x61\x19\xd9\xe9\x18\x8f\x4b\xdf\x8f\x7d\x5f\x4e\x4b\x5d\xa3\x84\x96\x74\x86\xeb\xba\x9a\xee\xf3\x3d\x02\x7b\xb5\xee\x4c\xf8\xfe\x78\x9c\xf8\x7e\xac\x64\xf8\x69\xb8\x8d\x68\xf8\x81\x94\x24\x89\x16\xca\xb1\xc7\x72\x2e\x7b\xdf\x87\xea\xdc\x91\xb1\xff\x4b\x23\x23\x60\x0d\x91\xe0\xbf\x3e\x8a\xff\x72\xbd\xf5\x30\x61\x10\xaa\xf7\xf1\xff\xd0\x73\x39\x35\x49\x84\x89\x9a\xad\x8e\x1f\x13\x4a\x26\xf4\x56\x36\x7d\x53\xd7\xc9\xdf\x6f\x28\x9d\xf9\xfe\xec\x82\x26\xe7\x37\x4d\x33\x40\x74\x49\x6e\xc8\x6e\x4c\xaf\xa7\x7b\x60\xd4\xca\x90\x45\x75\x7d\x3d\x2d\x99\x50\xbc\x50\x19\xf6\x46\xe6\xb2\x10\x5e\xc5\xb5\x8d\x02\x4b\xce\x54\x05\x8a\xcb\xb7\x1e\x79\xe1\x55\xb4\x8c\x51\x8e\x83\xf9\x45\x6c\xec\x3a\x91\xa0\x21\x23\x8c\x78\x1e\xc9\x23\xe2\xb6\xd5\x73\xec\x40\x7d\x53\xba\xe5\x23\x86\x1c\xec\x51\xf3\x8d\xef\x11\x23\x45\x98\x46\xd8\x1a\x6f\xc0\x5d\x83\x87\xe8\xa9\xac\x4c\x12\xf7\x06\x07\xb1\xe4\x0b\xd5\xcc\x04\x07\x9e\x8b\x20\x3b\x56\xb5\x68\x2d\x55\x18\x91\x92\x6e\x10\xeb\x5b\xa2\xd9\xc9\x28\xe5\x64\xf4\x46\xd0\xb1\x35\xa5\xa5\x39\xf6\x17\x24\x84\x25\x66\xdd\xd1\xc4\x93\x09\x46\x29\xcd\xc3\x38\x52\x2c\x4a\xac\x86\x13\x47\x34\xc5\xdd\xc1\x74\xfc\xc8\x0a\xe0\x65\x48\x89\x0a\x2d\x55\x20\x29\x26\xf0\x10\x6e\x47\xa9\x82\xe9\xa6\xc1\x64\x1b\x97\x9d\x31\x3e\x66\x57\x64\x0e\xec\xcc\x9e\xd3\x1b\x4c\xcc\x31\xfd\x44\x2d\x82\x8a\x3e\x43\x7c\x5c\xb1\x24\x71\x9d\x73\x4e\x5d\xcb\x03\x4c\x4b\xdd\x04\xf4\x35\x8b\xf9\x4d\xa7\x99\x76\xc4\xff\xd2\xec\x1f\x70\x05\xa7\x20\x16\xbe\x3f\xf3\xc6\x1c\x13\x4e\xf9\xa3\x9c\x19\x39\xd6\xaf\x25\x39\x48\x25\xa9\x3c\x9e\x43\x4d\x7d\xc6\xe4\x7e\x97\x05\xf2\x85\xec\x40\xff\x9d\x7a\x8e\x8d\x33\x76\xdf\x27\x0b\x3b\xb1\x01\xec\xa0\x39\x50\xe9\x46\x81\x02\x9c\xfa\x1c\x41\x55\xdf\xb4\x0e\xb7\x62\xd0\x06\x13\x11\x17\x7d\x1f\x7f\xc5\xfe\xf0\x69\x96\xaf\x63\x25\x94\x6d\xaf\xe5\x3e\xdc\x76\x14\xeb\xc6\x22\x1f\xda\x48\x93\x86\x14\x79\x3e\x18\x33\x80\x49\x9c\xd7\x10\xf0\x7e\x3a\xf5\xfe\x72\x1a\xaf\xe5\x59\xaf\xb5\xf9\x19\x5d\xca\x26\x5f\x83\xcb\x54\xdd\x5e\x23\xc9\x5c\x8e\x46\x48\x19\xbd\xc9\x59\xdc\x16\x6c\x53\xd7\xff\x61\x53\x11\x5f\x83\x55\x20\xb8\x84\x83\x0e\x22\xb8\x61\x68\x34\xc7\xc4\xe8\x24\xe0\x7e\x86\x89\x56\x70\x0d\x32\xe3\x8f\xda\xe1\x39\x66\x78\xb2\x17\x6c\x6a\xbc\xbd\x6a\x4f\x29\x98\x9c\x57\x46\x05\xd9\x10\x73\x35\xcc\xa8\xbb\xd6\x77\x1d\x53\x3a\xf3\x19\x0c\x8a\x68\x03\xfe\xb6\x56\xb6\xdb\x8b\x87\x4e\x95\x7f\xe9\xe4\x9f\x4a\x7c\x64\x40\xe2\xe2\xd3\x21\x4f\x5e\xd5\x87\x81\xde\x8e\x2c\x4d\x98\x42\xeb\xa0\x98\xde\xb2\x38\x61\xc5\xd0\xd8\xfe\xa1\xcf\x67\x76\x4e\x71\x43\x60\x02\x87\x0a\xff\x30\x50\x58\xd9\x39\xfe\x1f\x2e\x93\x63\x2d\x69\x80\xc6\x35\xa0\x6c\x08\x38\xef\x1c\x6f\xe6\xbf\x6e\xf0\xe9\xc9\x1a\xda\xfa\x7d\x1f\xa9\xe3\x02\x12\x47\x76\xd8\xc0\xfa\x82\xe7\xb6\xf9\xa6\xbf\xcd\x4d\x58\x88\x5b\x07\x89\x99\x49\x0a\x67\x11\xe0\xb8\xde\x6b\x47\xe8\x19\x8a\xc9\x5c\x96\x61\xbf\xf7\x4b\xb4\xb8\x3f\xe4\x17\xb3\x25\x1f\x8b\x80\x43\xc9\x5b\xc6\x8f\x6b\x73\xdc\x20\x17\xfc\x42\x2c\xf8\x98\x3e\xc3\xac\x6f\x2b\xc1\x1a\x0c\xa1\x22\x1e\xf9\x7c\xfe\x27\x9f\x67\x47\x43\xe9\xba\x15\x9b\xbe\x8a\x0b\xbe\x14\x01\x5f\xcc\x2e\xe8\x64\x52\x2c\x4c\x65\x45\xa7\xb2\x9b\xbf\x58\x19\x5f\x8c\xc7\xc5\x85\x18\xae\xa5\x69\xb0\x85\x72\x2e\xb6\xd4\x81\xf9\xdf\xc9\xa1\x88\x93\x34\x0f\x46\x33\x85\x43\xae\xf3\x7b\x79\xbd\x49\x21\xfa\x0e\xd9\xc7\x65\x79\x97\x17\x89\xbc\x4e\x77\xf1\x0d\x84\xe4\xc1\x2e\x23\x45\x13\x30\x50\x31\x46\x9d\x87\xb2\xba\xde\xa5\x42\x96\x2f\x58\xc9\xc4\x71\xf9\xad\x2a\x6f\x6c\x49\x77\x0c\xe1\x43\x6b\x5a\x7a\xcf\xcc\xbe\x57\xfb\x62\x46\x3a\xbe\x2e\x9e\xb7\x10\x17\x7c\x21\xc6\x63\x5c\x8c\x21\x9e\x83\x12\xfe\xb6\xb6\x2e\xb6\xa6\x6b\x86\x4a\x62\xf9\xa7\x0a\x4c\x80\x0b\x92\x69\xe4\x41\xd6\x34\xab\xeb\x8a\x6c\x60\x43\x39\x42\x36\x4a\xe9\x9a\xec\xa9\xa3\xf4\xd0\xe8\x67\xd9\x5f\x05\xed\x75\x43\x59\x58\x45\x86\xdf\x67\x8e\xd6\x70\x63\xc4\x8e\xa5\xfe\xa2\xa5\x56\x8f\x1d\xf8\x24\xef\xbb\x8f\x94\xe9\xf7\x51\x1b\x03\x8d\xf8\xbe\xa9\xdf\x6a\x07\x5d\xe1\xe6\x63\xfd\x03\x7d\x12\xca\x29\x53\x2c\x3a\xb3\x2c\x3a\xeb\xb1\xe8\xac\xcb\xa2\x93\xcc\xf7\xb3\x47\x10\x08\x56\x8d\xd6\x35\x5b\x18\xb7\x18\x54\xd0\x34\x5c\xab\xa3\x95\x7b\x22\xa1\x8e\x5b\x50\x1c\x3e\x93\x2c\xeb\xb3\x48\x79\xc4\x84\xeb\x88\xc6\x58\x3e\x3b\x1e\x60\x4b\xf7\xed\x7a\xdf\x31\xd4\xca\x40\xe6\x17\xe9\x90\x87\x95\x9d\x6a\x9a\x1e\x1b\xfb\x83\x62\x2e\x2c\xa2\x5e\x63\x0e\x1d\x09\x52\x89\xaf\x6c\x8b\xef\x18\xb2\x61\x52\x5a\x98\x85\x25\x94\x6c\xf2\x8c\x54\x2d\xe8\x66\x54\xf9\xe4\x0b\x1b\xb3\x04\xe6\xbd\x04\x1e\x57\x1e\xc3\x39\xca\xa1\x1e\x79\x54\x52\x7b\x38\x87\x79\x56\xfe\xdd\xa8\xc4\x6e\x08\x10\xdb\x85\x4b\x86\x12\xb2\x25\x37\xe4\x96\x3c\x90\x96\xf4\xdc\xfa\xfe\xe8\x36\xbc\x8a\x7c\x1f\xdd\xd2\x4b\x86\x6e\x31\x26\x0f\xbe\x3f\x7a\x50\xcf\x1e\xe4\xb3\x07\x88\xcf\xf0\x18\xab\x4e\x62\x65\xa3\x55\xc9\x3f\x19\xb5\xf1\x16\xd6\x54\x42\xcf\x49\x9c\x34\x83\x70\x65\x36\x18\x43\x0a\xc1\x18\x40\xdb\x27\xc2\x22\x72\x45\x50\x0d\xda\xd6\xb5\xf7\xd4\x23\xad\xfd\x1a\x58\x5f\x72\x38\x19\x6c\xe8\x28\xa9\xeb\x11\xf3\xfd\xed\x72\x1d\xbc\x63\x68\x2d\x8f\xcb\xd0\x43\xb2\xa7\x37\xcb\x07\x09\xb2\xcb\x24\xc8\xea\xfa\x16\xa2\xad\x88\x60\x23\x21\xe7\xc6\xf7\x6f\xd0\x86\xec\x55\xc9\x5b\x7c\x48\xe9\x3b\x86\xf6\xa4\xc2\xe4\x16\xa5\x24\x8c\xd4\x8b\xbc\x0f\x03\xb9\x3c\x78\xc4\x34\x0d\x73\x58\x94\x7d\x58\x85\x79\x24\xcf\x1e\x1b\x7d\x15\x63\xdc\x48\x66\x43\x89\x49\xea\x3a\x31\xf2\x12\x38\x17\xe5\x74\x3f\x58\xdf\x5e\xd5\x97\xaa\x75\xdc\x84\xb9\xac\x68\xf1\x00\x14\x95\xec\xe1\x58\x45\x0a\xdc\xfc\xc9\xe7\xf2\xb4\x90\xd2\x87\xe5\xf7\xe0\x8a\x16\x94\xba\x93\x2c\x4c\xd5\xe9\x28\x55\xdd\x53\x3b\x7f\x0f\x03\x96\xa4\x78\xb9\x37\x8a\x9e\x8c\x98\xfa\x71\xb0\xc7\xe4\x61\xa9\x7b\x20\xc8\x9e\x14\x38\xb0\xfe\x8e\x64\xdf\x31\xf5\x7f\xd5\xc1\xc7\x29\xd1\x66\xf0\xd6\x9b\x80\x5e\x4f\x8d\x56\x23\x04\x41\xb2\xe4\x07\x22\x12\xd3\x5c\x9e\xb0\xed\x2b\xef\xcc\x93\x5b\x22\x5f\xce\x03\xb9\x2d\x06\x1d\x3a\x81\x6d\x4e\x1b\x12\x13\xc9\xd1\x66\xc3\x85\x26\xf3\x8b\xef\x51\x0a\x51\xb0\x54\xb9\x35\x0d\x87\x77\xf8\x28\x97\x3b\xab\xae\xc5\x88\xd2\x3b\xb9\xad\x50\x4a\x05\x6e\x01\xad\xd2\xc5\x83\xcc\x6c\x78\x03\x97\xa9\x3a\x31\x16\x4d\xb4\x28\x2f\x0a\x13\x60\x48\x74\x87\x5a\xea\xa1\xe2\x35\x0d\xaf\x19\xba\x63\x68\x8d\x89\xc0\x51\x8b\xf1\xe4\x07\x4a\x50\xee\x14\x37\x8e\x62\xa0\x65\x93\x4f\xb5\x22\x1b\xe3\xf0\x2a\x32\x61\x61\xc6\xe3\xd2\x8d\xcf\xd0\x69\x97\x9b\x76\x3b\xc1\x5d\x2e\x19\x9a\x5f\x94\xbe\xaf\xba\x01\x97\x92\x92\x5a\x01\x66\x39\x99\x63\x1d\x62\x10\x1d\x94\xba\xd4\x3b\x53\x4a\x8c\x72\xf2\x4c\x55\xb9\xf4\x9e\x7a\x81\xe7\x35\x4e\xd0\x20\xe3\x67\x26\x48\x79\xc1\x7d\xff\x55\x5b\x65\x29\x27\x8c\xf0\x8b\x42\x3d\xa5\xe6\xb9\x7d\x0a\x84\x1c\x37\x6b\xed\x65\x66\x35\x8c\xd0\x43\x73\xb3\x63\x4e\xa4\x37\x33\x5b\x65\xcb\xa0\x74\x04\x27\x20\xa9\xdf\x31\xb2\xa5\xa5\xa4\x37\x1f\x18\x4f\xff\x18\xf4\xb0\x26\x5d\xd1\xe9\xbd\x11\xfb\xa7\x1b\x94\x59\xcb\xd4\xe5\x2c\xc8\xac\x36\x75\x11\x53\x66\x70\xdd\xf5\xd4\xea\xc8\x8c\x44\x42\xad\x0b\xc4\x56\x91\xa8\x1a\x15\xf4\x57\xe5\x99\x1a\x4b\xc6\x17\x41\x98\x26\x1a\xeb\xda\x24\x7d\x33\x3b\xad\xae\x63\x6b\x3f\x25\x91\x84\x9c\x1c\x3a\x9a\x13\x54\xd0\x3f\x6c\x0d\xe0\xe7\x61\xfd\xec\x88\x46\x14\x7a\x95\x38\x81\x20\x89\x50\xa9\xb3\x2a\x67\x60\x5e\xd8\x36\x6a\x95\x3a\xca\x04\x5a\x76\x1e\xcb\x7e\x7e\x19\xe6\x91\xd3\x55\x89\xc7\xd4\x00\xe4\x15\x2a\xa0\xfb\x7f\xda\x78\x4e\x34\x90\x06\xc5\x89\x46\xc1\xa8\x85\xeb\x08\x45\x76\x86\x8d\x30\x2c\x88\x97\xad\x7a\x0c\x07\xf7\x88\x91\x12\xdb\xb9\x6f\xc8\x86\x96\xca\x48\x28\xcd\x86\xd7\x53\x12\xb7\x1d\xb9\x97\xeb\xaa\x30\x6d\x28\x31\xcc\x0b\x67\x5d\x47\x31\x3e\x28\x75\xce\x16\x41\xd8\xa1\x96\x02\x69\x93\x17\x85\x4d\x5f\x31\x10\x8c\xc1\x6e\x5b\xa6\xc6\x7d\x37\xc8\xcd\xd5\x02\xc5\xf4\x05\x62\x04\xdd\xd2\x9c\xec\xe8\xec\x02\x3d\xd0\xd4\x48\x7e\xc8\x3d\x9d\x5d\xdc\xb6\xcc\x68\x9f\x70\x4a\x2e\x40\x73\x00\x12\xf4\xe8\x8c\x64\xd4\x9b\x81\x54\xde\xf7\xc3\x88\x6c\x64\xbf\xf7\xf4\x8e\x24\x92\x80\xde\xfb\x7e\x6b\xad\x8e\x24\x15\x4c\x31\xd9\xd2\x0f\x63\xaa\xce\x5a\xfb\xe5\x3c\xe8\xc4\xf2\xaa\xeb\xe9\x9c\xdc\xd0\xc4\x0c\x4c\xc2\x64\xea\xfb\xe8\x8e\x6a\x5b\xa7\xba\x4e\xf1\x22\x1b\x81\xe6\x41\x47\x00\xca\x69\x12\x66\x11\x5e\x64\xe3\x31\x10\xab\x7b\xdf\xcf\xf1\x21\xa6\x33\x22\xea\x3a\xef\x1b\x47\x5d\xd6\x35\x7a\x27\xf9\x0e\x4e\x47\xaf\x8c\xb9\x40\x49\x6f\xc3\x58\x9b\xe7\x97\x28\x97\x1f\x5e\xca\xcd\x7f\x28\x0c\x9b\xa2\x05\xd8\xb2\x2f\x1f\xe8\x16\x37\x3b\xdf\x47\x28\xa7\xa3\x52\x36\xe6\xfb\xd5\x64\x42\x98\xef\xaf\x4d\x71\x20\xa2\xd5\x98\x66\x64\xe7\xfb\xb2\xbb\x15\xf4\xc8\x36\xf7\xa0\x9a\x2b\xd1\x9a\x6c\x14\xdf\x6c\x89\xee\xec\xa2\xd2\xa2\xeb\x6c\x32\xc1\x6b\x10\x5a\x6f\x94\xe8\x5a\xfe\xd0\xdf\x95\x79\x4a\x81\xf1\x62\x23\x69\xe0\x06\x37\x86\xb0\x15\x64\x83\x49\xea\xfb\x92\x9f\x98\x5d\x6c\xac\x71\xe2\xfc\xa2\x1a\x3f\xd8\xbb\x8e\xdd\x06\x2a\x2c\xa2\xd2\x63\x23\x77\x74\x8f\xc9\xba\x21\xbb\xa5\x64\x1a\x71\x50\x60\x30\x87\xd6\xc6\x4a\xac\xb1\x1c\x1a\xb9\x69\x0d\x07\x8e\x00\xe5\xc8\xf1\x9e\x0e\x07\x5e\x65\x64\x4d\x47\x85\xef\x6f\x11\xa3\x99\x6d\xa6\xae\x95\x43\x80\xf1\xc0\x97\x7c\xfd\xda\x6c\x45\x39\x4b\xcf\x2e\x50\x4e\xd7\x92\xcd\x5e\x3b\xaa\x5d\xdc\x5a\xb9\x7a\x6f\x5e\x4a\xdc\x8f\x62\x9a\x87\xb3\x08\xeb\x33\xfe\x67\x3d\x37\xd5\x57\x12\x40\x2d\xe5\xc9\x41\x09\x0f\x94\x47\xf9\xdd\x0b\x8a\xac\xf7\x09\xb2\xf6\xac\x2e\x9e\xd2\xa2\x4f\x81\x8d\x22\xc2\xf1\xca\xcf\x40\x61\xd7\x31\x72\x24\x2d\x15\xc9\x0d\x42\x52\xa7\x3b\x33\xb8\x26\xa5\x5f\x4e\xdd\x08\x25\x5a\xc2\x82\x97\xb3\x20\x3f\x96\x89\xcb\x7e\xca\x21\xa6\x11\x71\x06\x52\xd2\xb8\x43\x41\x25\xa9\xae\xa8\x1a\x8a\xe6\xc7\x0b\x5a\x3d\x3a\x22\xe3\xbe\x9e\x1b\x86\x47\xb9\xb1\x8b\xae\x1b\xbb\xd0\x6e\xf1\xb9\x61\xc1\x52\x32\xc7\x64\x84\x98\x95\xdf\x03\x95\xcc\xdb\x60\x05\xad\x1b\x79\x81\x09\x37\x7a\x21\x0d\x54\x28\xab\xeb\x0d\x62\x64\x8d\x31\x2a\xc0\xbe\x8a\x70\x32\x12\x75\x6d\x7a\x73\xaa\x17\x84\x37\xc4\x35\x23\xa2\x57\xc6\xef\xd9\xc3\xc6\x88\x48\x9b\xe5\x82\xab\xd5\x15\x39\x36\x45\xa2\xa3\x51\x46\xde\x21\x4c\xba\xb6\x9a\x27\x9c\xcc\xe6\x8f\x18\xbe\x3e\xe2\x04\x3e\x60\x31\x6f\x8f\xe0\x43\x86\xf3\x7f\x53\x56\xf2\x1e\xf1\xfe\xa6\x64\x55\xad\x98\xb0\x27\xa4\x92\xe5\x25\xc5\xac\xeb\x8d\x16\x59\xd5\x20\x64\xdd\xb2\xf4\x66\x2b\xea\xbb\x34\x11\x5b\x8f\xf4\xf9\x48\x45\xd4\x86\xfd\xd2\x04\xf1\x8c\xd2\xb7\x27\xef\x5a\xce\x83\x67\xb8\xe7\x8b\x78\x64\x8b\x3d\x38\x2e\x10\xcc\x9d\x83\x57\x89\x33\x92\xae\x11\x3e\xec\x07\xe5\x6d\xe1\xfd\xc9\xa0\x55\x51\x3b\x6a\xfd\xe5\xe0\x20\x7d\xff\xcf\xa5\x82\xed\x44\x18\x8f\x54\xb0\xa3\x3a\xb5\x64\x3a\xae\x6e\xaf\x4f\xad\x2d\xbd\xee\xd6\xdb\xa3\x0e\x01\xf7\xbe\x70\xe7\x5e\x09\x8a\x43\x11\x2d\x7b\x53\xfd\x3f\x59\x08\x62\x52\xb2\x06\x5d\xe2\xc5\x15\x6c\x79\x9a\x40\x3c\xcd\x7d\x41\x93\xd6\xce\x4b\x3f\x0a\xbd\xc0\x53\xf1\x39\xf7\x85\x65\x49\xaf\x5c\xcb\x3e\x73\x43\x13\xe7\x29\xb9\x52\x21\xa7\x13\x63\x3f\x47\xae\x94\x5b\xf3\xcb\x7c\x4d\x13\x75\x49\xae\x5a\x5b\xcf\xc4\x5e\xca\x76\xc1\x78\xd1\xda\xbf\x26\xfa\x01\x98\x68\x6e\xe9\xf0\x51\x07\x54\x73\xd6\xba\x92\x2f\x5a\xed\x49\x28\x24\x42\xfb\x6c\xd4\xd1\x9a\x1c\xc9\x82\x00\x0a\x52\xdf\xbf\x02\x0f\xaa\x52\x32\xf1\xfa\x74\xa1\x63\x1a\x5a\x3a\x58\x34\xe4\xdd\xc9\xf8\x72\x61\x34\x20\x9d\xef\x07\x43\x60\x2a\xe8\x2a\x37\x35\x3b\xc6\x76\x1f\xcc\x54\x03\xe6\xed\x20\xfa\x56\x5e\xf8\xa2\x1b\x3a\xef\x2f\x5a\x05\xf5\x82\x00\xc9\xfe\x7e\x4b\xcf\xdf\x5f\xa0\x30\x9e\xfc\x11\x85\xef\x57\xe7\xab\xd9\xe7\x01\x04\x9a\x13\xab\x62\xc5\x57\x9b\xe8\x29\x0e\xbb\xf7\xab\xf3\xe5\xe7\x68\x19\x5c\xac\xce\x57\xf3\xcf\x6b\xfc\xe4\x3c\x6d\x7b\xf5\x12\xe9\x88\x3a\xe6\x60\x83\x38\x5e\xea\x70\xc2\x6c\x30\x96\xf0\x68\xd4\x89\xbf\x3c\xa2\xb4\x68\x70\xe0\x08\x42\x06\xbe\xee\x9c\x94\xb9\xfe\xe4\x28\x00\x0f\x7f\xec\xd3\xc9\xfc\x22\x6d\xe3\x73\xea\x2a\xae\xf4\x91\x41\x3e\x23\x05\x04\xe1\x86\xfb\x13\xe0\x26\xc2\x59\x1b\x27\xd4\xf7\x11\xa3\x5e\xc0\x73\x81\xc0\x68\x0a\x7b\x98\xa8\x80\x17\x96\xc3\xea\xf8\xc3\x2f\xd5\xb6\xeb\x1b\x70\xa3\x82\x30\xbc\x0c\x8b\x28\x08\xa3\xa0\x5b\x04\x31\x62\x02\x33\x0f\xcd\x45\x17\xc0\x20\x3c\xb3\x13\xd7\x18\x1d\xc0\xf8\x72\xd8\x9b\xdf\x8d\xd8\x9c\xc2\xdd\xc2\x31\x51\x6d\x8d\xb3\xec\xa1\xb1\x17\x2e\x5a\x6e\x17\x3d\x73\x8e\x66\x03\x82\xca\xd2\xd9\x42\x5c\x14\x20\xa8\x4e\x37\xa8\xdd\xec\x28\x0d\x45\x44\x20\xca\x78\x2b\xcd\xc4\x4a\x7e\xce\x69\xaf\x81\x30\xc2\xc4\xad\x49\xcd\x0b\x62\x04\x2a\x69\x05\x69\xf3\x8b\x62\xe9\xa2\x25\xc4\x71\xc0\xad\x95\xde\x90\xd1\x5a\xb7\x9d\x97\x3a\xb4\x38\xb0\x93\xa3\x39\x86\x98\xbf\x83\x3a\xad\x47\x3f\x84\x58\x63\xe9\x90\x46\x74\x34\xd2\x25\x8f\xcd\xe4\x7c\xff\x83\xe5\xe2\xe4\x84\x06\xb6\x17\xad\xbe\x1d\x90\xdf\x6f\xe4\x77\x15\xc1\x6d\x55\x3e\x45\x17\xe1\xea\x6e\xf5\x53\x34\xfe\x1c\x87\xef\x3f\x8f\x9e\xd6\x7f\x73\x82\xb8\x2d\x90\x8d\x6b\x3f\x0c\xc0\x24\x05\xf2\xd2\x59\x56\xcb\x50\xff\x36\xd0\x47\xcd\xf1\x16\xd4\xbb\x50\x32\x93\x59\xe4\xfb\xde\xe7\xea\xba\x8d\x6b\x16\xf9\xfe\xf3\x0b\x2b\x1b\x5b\x86\x4a\xc8\x03\xd6\x08\x51\xf0\xbb\x3a\x86\x43\x90\xe3\x51\x11\xca\xc2\xc6\xdc\x59\x32\x71\x62\xaa\xe2\xdc\x2f\x21\x48\x05\xd6\x0b\x8d\x83\xa3\x18\xdf\xc2\xbe\x83\x58\x31\x26\x22\x96\xa0\xe2\x2c\xe5\xa5\x88\xf9\x1a\x82\xb5\x2e\xe5\x26\x0d\x24\xe5\x69\x83\xd1\x93\x2b\xc9\x1a\x96\x4c\xb2\x1b\xf0\x25\xd1\xb1\x8b\xf5\xce\x3c\xf6\xde\x09\x5e\xc1\xaa\x92\x6f\xd5\x12\x69\xe3\xa8\x7e\x48\x6b\x81\xdb\xcc\x0a\x02\xef\xa0\xad\xb0\x88\xf0\x52\x5f\x20\x01\xce\x22\x30\x14\xb0\x43\x2c\x40\x12\xdc\x09\x8b\x6e\x98\xdc\x94\xbe\xea\xbb\xa0\x17\xe1\xb3\x48\x59\xb5\xca\xea\x66\x11\x4d\x89\xb3\x71\xe9\x1c\x13\xa7\x02\x27\x37\xc5\xd2\x7e\xc0\xba\x1f\xa8\xed\x17\xec\x24\xc8\xb5\x54\x73\x5a\xb0\x38\x79\x58\xea\x5f\x80\x44\x74\x25\x71\xa3\x8d\x9a\x8c\x54\x3d\xb8\xc1\x8e\xd4\x4a\xc2\x1a\xf9\x8d\x5e\xa1\x57\x0a\x4c\xbf\x56\x40\xaa\x78\xf0\xb2\xde\x17\xec\x16\x2d\x83\x1f\xb9\x48\xb3\x1a\x5c\x99\xcf\xc9\x57\xf4\x00\x36\x65\x05\xe3\xa0\x77\x53\xe6\x1f\x25\x64\xbe\x60\xf7\xa0\x3b\x93\x9f\x75\xb3\x5f\x7c\xa7\x88\x47\x9f\xb4\xcf\x47\x43\x06\x11\x67\xac\xe9\xe0\xc0\x6d\x5c\x0e\x25\x2a\x30\x03\x72\xc5\x25\xee\x46\x1f\xc6\x6d\x10\x36\x97\xce\x16\xec\x82\x2f\xd8\x11\x7e\x53\xb9\x0c\x42\x16\xb9\xf8\xad\x21\xeb\x2c\x2f\x99\x1b\xf8\xbf\x1b\x18\x5b\xa3\xdf\x56\xac\x0c\x12\x9e\x63\x4c\xac\x78\x14\xd8\xbe\x16\x6d\x00\xf0\x59\x45\x43\x8b\x48\xc3\x22\x5a\x70\xdf\x97\x74\x52\x2c\x7a\x2e\x4f\x72\xb7\xb7\x36\x03\xf3\xb9\xef\xa3\x78\x39\x99\x5f\xc4\xca\x10\x45\x22\xd0\xbe\x5f\xfd\x09\xba\x05\x61\xae\xf1\x21\xb7\xaa\xe0\xae\x10\xac\x8b\x2f\xe7\x17\xe6\x98\xda\xc5\xd7\x39\x0e\x72\x30\x25\x48\xd8\xfd\xa0\x4d\xc5\x72\x20\xdc\xb6\xa6\xe3\x72\x42\x88\x86\x74\x6c\x43\x68\x03\x5a\x36\x38\x45\x22\xac\x40\x23\x13\xc0\x5d\xfa\xc2\x8d\x79\xa4\x17\xbc\x28\xe5\xa9\x5b\x82\xdf\x8b\x2c\x43\x06\x07\x07\x93\x79\x43\x62\x37\x85\x42\x27\x1a\x64\x3f\x8b\x82\x33\xb2\x4e\x32\x8c\x1b\x26\x10\x26\x00\x76\x18\x4b\x62\x11\x27\xc9\x17\xfd\x24\x1a\x6e\xa5\x71\x92\x20\x93\xa0\xa3\x97\xfe\x20\xe8\xdd\x1b\x60\x65\x18\xcc\xa6\x74\xe8\xe9\xc3\x80\xe5\x87\x31\xb8\x38\x76\x02\x35\x8e\x5d\x2e\x4e\xd4\x6e\x45\x7a\x3f\x0f\xf5\x74\x8b\x18\x71\x35\xc8\xd8\x96\x86\x5d\x7f\xca\x5e\xad\xff\x19\x84\x3c\xe7\x7d\xcb\x0d\x5d\x58\xee\xfe\x8e\xb5\x2c\x6e\x14\x8e\x38\x55\xb6\x6f\x1a\xac\xeb\x7e\x91\x65\x27\x87\x30\x50\xfd\x63\xc5\x4f\xb4\xf0\xe7\x63\x76\xdb\x81\x41\xcb\x9a\xfe\xc2\x54\xf5\x8d\x9d\xe5\xa7\xa5\xba\x19\x5c\x97\x77\xa8\x13\x58\xb0\xae\x0f\x0d\x76\x4e\xcb\xa0\x43\xb2\x78\x78\xf0\x7b\xf7\x70\x2d\x0b\x1b\x44\x7d\xe2\xb8\x3b\xa2\xca\x81\x8f\x71\x1b\x46\xca\xf7\x0b\x74\xf4\x50\xf9\xc4\x76\x1e\x05\x48\x9e\x6b\x3c\xc1\x76\xfb\x2c\x16\xcc\x03\xdd\x5e\x5b\x5d\x5d\x33\x6c\x49\x39\x44\xf3\x72\xc2\x8f\x82\x43\x86\xe9\x11\x08\xa1\x21\x42\x7b\x11\x0d\x46\x9f\x75\xb2\xc2\xa4\x6d\x3e\x1a\x0f\xe6\xdf\x03\xbf\x67\x6d\xdc\xfe\x89\x72\x24\x91\xc8\xc5\xf7\x8f\xd0\x8f\x00\xad\x85\x3d\x38\x80\xfe\x8c\xcc\x2f\x1c\x24\xee\xfb\xe8\x2b\xc8\xb0\xd4\xe3\x4c\xc9\xd7\x9a\x99\x80\x80\x2e\x05\xbb\x65\x05\x88\x17\x48\x0f\x8d\x70\x6c\x38\xbf\xef\xe9\x79\xf8\xbe\x73\x16\x1b\x9f\xdf\xb4\x94\xf1\xad\x8b\x28\x5b\xcd\xe5\x37\xad\x17\x9f\xf3\xf4\x4d\x4f\x06\x0b\x81\x07\x99\xef\xef\x10\x78\xf0\x16\xf9\x2e\x2d\x19\x36\xb8\x15\x22\x8a\x71\x06\x4c\x57\x9c\x66\x92\x2c\xd8\xb2\x62\xcb\x78\x5b\x50\xa9\x12\x4d\xfe\x2e\xc5\x55\x90\x90\x19\xe9\x6b\x81\x9d\x88\x86\xfc\xa8\x14\x6e\x9a\xab\xe9\x65\x9c\x65\xd7\xf1\xfa\x83\xe3\xfc\x59\x98\x20\xf5\x7c\x51\xd0\xa3\x35\x28\x96\x88\xd1\x42\x25\x04\xd3\xf8\x4e\x3b\xd0\xa2\xef\x41\xe6\x7a\x14\x27\x5f\x44\x54\x92\x63\xd9\xd5\x36\x89\x48\x43\x0a\x35\xcf\x29\x11\x47\x4a\xff\xc9\x9c\xac\x69\x8f\x01\x88\x69\x5c\xd7\xc5\x34\xe7\x6b\x46\x72\x9a\xd2\xd1\x6c\x51\x19\xf6\x41\x7e\x81\x0f\x82\x56\x46\x82\xab\x85\x11\xe3\x71\x76\x61\x20\x03\x83\x11\x7a\x19\x66\x46\x1b\x2a\x79\x55\x22\xb4\xe5\xfd\xb4\x14\xf9\xfe\x3b\xfe\x3a\xce\x4a\x06\x41\xf1\x2d\x57\x20\xe8\x68\x8e\x9b\x62\xba\x63\xbb\xbc\x78\x00\x6d\xcf\x68\x8e\x75\x26\x15\xdf\x47\x25\x15\xcb\x30\x0a\x3c\x0f\x74\x4a\x87\x78\x30\xe5\x4f\x29\xa1\xda\xf7\x47\x69\xa7\xee\xc9\x9c\x54\x46\x53\xd9\xda\xc1\x9e\xc1\x0e\x37\x53\xdb\x9b\xcd\x1d\x12\x78\x59\x68\xd8\xf6\xfd\xcd\x74\x1b\x97\x10\xeb\xb4\x34\x15\xa9\xd4\x20\x56\xe8\x6e\x99\x19\x7a\x07\x81\x4f\x39\x12\xb8\xc1\x0d\x6a\x33\x28\x11\xdd\xb1\xb5\xd9\x0e\x0d\x51\x11\x2d\x07\xc6\xa1\x7b\x65\x3f\x26\x03\x9b\x5d\xcf\xfd\x64\x7e\x01\x7b\x55\x27\xe2\x40\x82\x80\xf6\x16\x97\xd6\x09\x94\xcc\x31\xe1\x17\x34\xf3\xfd\x6c\x32\x69\x4c\xdb\x7d\xae\xd1\x32\x23\x93\xf9\x45\x5b\x1b\x23\x25\x0e\x66\x76\x71\x8f\xcc\x38\x3b\x33\x2f\x41\xcb\x54\xaf\x65\x81\x03\x25\x63\x5a\x29\x13\x1a\x41\x3d\xaf\x5b\x7a\x60\x49\x47\x65\x43\xb2\xdc\xe5\x22\xfa\x15\x89\xba\x4e\xeb\x1a\xa9\xfa\x4c\xf3\xf2\x93\xc1\xea\x46\x31\x58\x2c\xb2\x9f\x52\xd1\x4f\xe4\x62\xaa\x05\xd8\x0b\x19\x91\xc7\x2e\x50\x6e\xa8\x6d\xbe\x34\x36\xc4\x38\x10\x51\x0b\x50\x24\xad\x6b\x67\x49\x65\xdd\x03\x7d\xdd\x4c\x4d\xa3\xfd\xc4\x5a\xce\x77\x83\xfd\xcd\x9b\xc6\x86\x1e\x73\xb3\x03\xbd\x64\x1b\x56\x14\x03\x56\xc1\x39\x0d\x43\x8f\xe7\x22\xdd\x3c\x78\x92\xb0\xe6\x37\x05\x2b\x4b\x8f\x38\x38\x08\x79\x6a\x97\x79\xf8\xc4\xd3\x67\x11\x09\xbd\x82\x95\x79\x76\xcb\x3c\xe2\x49\x34\xd9\xab\x40\xe2\x87\xb3\xe1\x5a\xba\xaf\x66\xc4\x54\x94\x78\xaa\x56\x88\x37\x4c\x3c\x89\x73\xff\xd7\x4a\xe7\x44\xd7\x23\x2b\x8d\x48\x4a\xbd\x3d\xe3\x09\x30\x0e\x31\x3d\x94\x22\x16\x43\x8b\x90\x36\x24\xce\xee\xe2\x87\x72\x30\x9d\x1c\xd0\x82\x76\x5d\x14\x4d\x38\x5a\x27\x0f\x10\xbd\x37\xe8\x74\x01\x54\x43\x5b\x7f\x48\xce\x27\xdd\x77\x7a\x01\x58\xb8\xcd\x60\xb5\xb0\x7b\xdd\x2c\x25\x72\x49\x83\x46\x01\xf9\xd0\xd6\xa7\x3b\x94\x86\x22\xfc\x38\x02\xdb\x23\x75\xb5\x28\x43\x89\x64\x23\xd4\x6b\x91\x41\x1a\xb2\xe1\xa4\x6e\x0b\xa0\x77\x0e\x65\xb4\x97\x70\x4c\x50\x90\x83\x8a\xa9\x82\x26\x4d\x2e\x8b\xa9\x5e\x4f\x3d\x45\xf2\x1e\x32\x4f\x06\x45\x28\x71\xfd\xd8\x93\x60\xee\x45\xaa\x31\x0e\x89\x83\xda\x26\x1b\xdc\x48\xa4\xae\x84\xf5\x6d\x6b\x0d\x91\x73\xd7\xce\x96\x43\xc5\x2b\x3a\x6b\xd9\x81\x0c\x69\xc5\xea\xb1\x53\x87\x0d\xff\x27\x9b\x2d\xda\x79\x26\x6c\x20\xfd\x19\x1c\x32\x51\x7a\x51\x29\x2d\x1e\x62\x34\x76\xd4\x73\x98\x52\x9a\xb7\x9d\x73\x72\x97\xca\x63\x83\x0e\x19\xf0\x6e\xab\xcc\xf6\xcf\x4a\x96\x6d\x26\x30\x27\x15\x28\x79\xf1\x42\x40\x6e\xa5\xbf\x9a\xf2\x51\xc5\xc4\xdd\x32\x4e\x80\xea\x94\x4b\x93\xed\x89\x64\xa8\x22\x39\x79\x4b\x4a\xac\x2f\xbf\x21\x25\xc6\x01\xaa\xc6\x63\xf2\x78\x21\xfb\x34\xd7\x8b\x27\xd7\x04\xcb\x6f\xe3\x11\xa5\x6f\x81\xbf\xd3\x1c\x4a\x41\x25\x8f\x42\x50\x59\xd7\xb9\x59\x5a\x28\xad\xa6\xa2\x69\x88\xa0\xe5\xb2\x03\xc9\xc0\x56\x21\x87\xe9\x69\x61\x78\xca\xee\xd7\x0c\x7c\x0b\xbe\xca\xf3\x0f\xf2\x60\x3d\xfc\x46\x42\xf3\xb4\x94\xbc\xe0\xbb\x22\x5e\x33\x4c\xaa\x0b\x9a\x8e\xe1\xa8\x3e\xa2\xf4\x9b\x81\x0e\xe6\x1a\xce\x00\x89\xea\xae\x2d\xd2\xa5\x40\x38\x40\x4e\x2b\x37\x4c\x00\x8b\xa9\x9a\x47\x6e\x23\xf4\x44\x31\x89\xbd\x2f\xa7\x25\x13\xef\xd2\x1d\xcb\x2b\x90\x79\xd9\xc8\xdc\x43\xdb\x93\xe1\x43\x1e\xce\xa2\xf0\x79\x04\x87\xd8\x0c\xcd\x08\x23\x3b\x54\xe0\x65\x11\xbc\x25\xac\x33\xe5\x24\x0f\xe7\x47\x25\x05\x5e\x8a\xe0\x2d\xbc\x7c\x76\xf4\x12\x92\xc8\x7d\x83\x71\x77\x7f\xe8\xcb\x47\x8e\x27\x4b\x4b\x1c\xc0\x8a\x30\x6e\x1a\x52\xd2\x43\xb3\xe8\xf2\x14\xc3\x08\x45\x84\xcf\x22\x52\x50\x11\x7e\x12\x2d\x62\x85\x48\x28\x84\x9b\x25\x05\x60\x8f\x24\x71\xf1\x4a\x4a\x8b\x86\xe4\xe1\xf3\x09\x8b\xc2\x67\x91\x89\xc7\x65\x9e\x3c\x77\x9f\xcc\xa0\x84\x24\xc7\xc4\x4c\x99\xbc\xc1\x44\x55\x2a\xe4\x03\x49\xfb\x30\x29\x01\x7b\x44\x74\x00\x37\x1f\xe3\x15\xc9\x62\x6a\xf1\x5e\x30\x4c\x50\xbb\x1f\x51\x6e\x09\x70\x83\x49\x6c\x27\xb6\xc4\x44\x79\x70\xcb\xbd\x54\xca\x8d\x53\x36\xe4\x6e\xcb\x8e\xbd\x38\xf8\x71\x42\x44\x41\x39\x29\xa8\x66\xbb\x24\x56\xd3\x69\x46\x9d\xbe\xe4\x0e\xcc\x21\x4c\x62\xfa\x67\x6e\x69\x92\x8b\x57\xe7\x37\x79\x35\xbf\xe8\x37\xba\x3c\x6a\x23\x60\x64\x32\xe1\xbd\xcd\x0b\x47\x45\xb9\x3f\x36\x88\x5f\x50\xb9\xad\xe4\xd9\x28\xd7\x44\x0e\xa2\xe4\xeb\xd2\x76\x5f\x91\x11\xc7\xc4\xd2\x51\xc0\x80\x40\x48\x11\xae\x6b\xa0\x37\x11\xd0\x9a\x48\x9d\x8d\xac\xc6\x38\x57\x54\xcf\x1c\x05\xc4\x64\x82\xdf\x28\x3d\x88\x6c\xc6\xd6\x6e\x85\x97\x0e\x5e\x35\x47\xc0\x9f\xe8\xf9\x7b\xf4\xea\x36\xce\xea\x37\x5c\xb0\x82\xc7\x59\xfd\x36\xe6\x37\xac\x7e\x2b\x67\x8e\xf1\x35\xab\x55\x7c\x96\x1a\x6c\xdb\x7f\x7c\xfb\x06\x03\x0e\x7e\x72\xbe\x38\x85\x5e\x7a\xa7\xe3\x4b\x90\xb2\xe7\x19\xf3\x7d\x7b\x39\xbd\x8b\x0b\xee\xfb\xcc\xf7\x7f\xb2\xbe\x3c\xf1\x4e\x62\xe3\x6e\x11\x93\xa9\xda\xb6\x74\x66\x5b\x02\x57\xd1\xe9\x8e\x95\x65\x7c\xc3\x08\x53\xa8\x06\xe2\xf4\x5c\x29\x41\xf3\x2b\x53\xb2\x13\x64\xa5\x83\x6b\x5c\xb4\xaa\xcf\xb3\xb8\x81\x69\x79\xdd\x81\x9d\x96\x06\x7e\x81\xf0\xe1\x95\x8e\x86\xdf\x8b\x06\xfd\xf2\xbb\x6f\xb4\xbf\xe1\xd7\x79\x9c\xb0\xc4\x23\x5f\x48\xd4\x36\x58\x56\x05\x82\xfe\x02\x9b\xbe\x22\x95\x9f\x57\xdd\x0c\xe5\x7d\x7e\xad\x56\x9a\xe1\x50\x33\x41\x51\x07\x23\xf6\x87\x8c\x18\xb6\x87\x8d\x96\x6d\xb5\xf9\x29\xe7\x04\x8a\xff\x14\xa7\x22\xd0\xd7\x9d\x3d\x87\x94\x61\xc0\x72\x32\xd1\x15\x43\xc9\xab\xa9\xae\x00\xd7\x35\xb2\x37\x74\x34\xc3\x23\xc8\x2a\x31\xbb\xe8\x94\xaf\xeb\xd7\x9d\x5d\xf1\x8a\x84\x57\x91\x16\x21\x42\x21\x18\x12\x55\x23\x23\xde\x3a\xdf\xed\x33\x26\xc0\xf0\xe3\x95\x2a\x70\x25\xb7\x40\x5d\xc3\x6c\xe9\x03\x9e\xfb\xc6\xf7\x47\xaf\xfa\x91\xe8\xa6\x49\x7e\xb5\x2e\xf2\x2c\x5b\x76\x16\x5a\xb7\x88\x03\xf4\x6a\x20\x8e\xf7\x89\x95\x3b\x2e\x68\x96\x4d\x6d\x9d\x27\x03\x76\x8a\x92\x41\x52\x38\x6b\xd0\x4d\x81\x52\x48\x4a\xd2\x72\x27\xf4\x0e\x71\x25\x67\x2f\xcf\x52\x7e\x26\x8f\xfc\x84\xe3\x27\x50\x61\x49\x78\x58\x46\x64\x34\x83\x4a\x17\xc6\x8b\xbd\x93\xc4\x14\x3e\xd8\xa9\x54\xaa\x31\x64\xb9\xcb\x40\xe2\x8e\x2c\x8b\x52\x98\x6c\x0d\x38\x40\x19\x15\xe4\x58\x75\xa7\x41\x2c\x73\xa4\xde\x1c\xe2\x2c\x6a\xf5\x93\x75\xac\x10\x88\xc9\x0e\x71\x12\x2f\x8b\xa0\x30\x69\x31\xcb\x88\x94\xc4\xbc\x72\x9c\x29\xd2\x25\x0b\x32\xcb\x4f\xe1\xa0\x5a\xaa\xf8\x2b\x84\xe3\x20\x6f\xc8\xaf\xf4\xfc\xfd\x64\x57\x4e\xce\xc9\x1f\xf4\x7c\xa2\xcc\x05\xb0\x2b\x7d\xfa\xb1\x2b\x0a\x9f\x8a\xfc\xc7\xfd\xde\x1a\x1a\xd8\x62\x3f\x77\xac\x7e\x8c\x3d\xd9\xaf\xc4\xdb\x95\x13\x27\x7c\xce\x1f\xe4\x47\x65\x9d\xf0\xaf\xa1\xed\xd5\xf7\xe0\xf9\xac\x77\x3f\x1a\x3b\x8a\xf0\xb6\x8b\x5f\x02\xe2\x48\xcb\xa9\x4e\x17\xab\xcc\x2c\xe4\xd5\xf8\xcb\x69\x95\x26\xe3\x71\x03\xbf\x74\x4e\xbe\x74\x33\x6f\x43\xac\xa3\x21\xd1\x79\xe8\xd6\xd6\x0b\xa7\x72\x68\xc8\xbf\x54\x06\x70\x37\x14\x63\xf7\x0b\x2a\x02\x2d\xb4\x57\x81\x50\x5a\x5f\x78\xe2\x96\x23\xda\x0c\x59\x90\x75\xce\x37\xe9\x4d\x55\x80\xbc\x00\x14\xe6\x98\x88\x86\x94\x4c\x9c\xf2\xa4\x52\xea\x24\x18\x81\x89\x9f\x7c\x24\xc2\xc4\x69\xf8\x33\x12\x38\xa2\x7c\xd1\xcd\xaa\xaa\xde\x14\xb8\x9b\x3c\x34\xed\xe7\x10\x77\xd6\x5d\x41\x3b\x78\x5c\x74\x1a\x0e\x7a\x23\xf7\xfd\xde\x03\xd5\x83\x86\xc4\xeb\x35\x2b\xcb\x53\x02\xf0\xb6\xfa\xba\x3e\x21\x8d\xb5\x45\xf8\xd2\xea\x5a\x64\x0f\x03\xa5\x7a\x29\xd5\x2d\xe1\x98\xb4\x2a\xcf\x25\x0f\x04\x3e\x96\x31\x75\x54\x73\xfd\xc5\xee\x6c\x6d\x38\x19\xd9\x5b\x81\x0f\x9c\x22\xd1\x4b\x95\x2c\x19\x59\x90\x39\xff\x2c\xfb\x42\xe5\x78\x71\xca\xcf\x8a\x65\x28\xa2\x40\x74\xe4\x95\xf8\xd8\x66\x5b\x27\xa2\x91\xe7\x46\x1e\x45\x0d\x72\x67\x42\x22\x77\x27\xbf\xaf\xca\xd5\xff\x18\xd8\x69\x6e\xd0\x26\xb7\xe9\xbc\x95\xc7\x98\x6d\x5c\xbe\x8c\x45\xfc\xd7\x61\xbe\x1d\xbb\xef\x8f\xfa\xfd\x11\x92\xbd\x92\x9f\xff\x02\xae\x0b\x5f\x92\x1f\xf4\xef\x3f\xb4\x21\xc3\x41\x59\x31\x3c\x5d\x35\xf5\x2a\x34\xd7\x11\x7e\x72\x4e\xfe\x49\xcf\xc3\x17\x93\x7f\x47\x2e\xa6\xf9\xf7\x80\x11\x43\xbb\xea\x47\xbe\xf1\xe9\x06\x15\xd4\x4b\x62\x11\x4f\xdc\x38\x3a\xff\x24\xde\xe4\x89\xef\xf5\x5d\xff\xfb\x20\x05\xc9\x89\x3b\xb6\x7b\x05\xc6\xea\x58\xc7\xa9\x27\x8a\x0a\x28\x20\x4a\x29\x87\xa4\xcd\x71\x56\x32\x49\xf8\x52\x79\xa8\x95\x68\x5c\xbe\x4d\x55\x08\xab\x94\x52\x3a\x4e\xc7\x9e\xb7\x1c\xa7\x81\xf6\xa0\x4e\xf1\xf2\x1f\x57\xdf\x7d\xab\xec\x11\x50\x8a\x83\xd4\x39\x2a\x36\x3f\x38\xe0\xaa\x3c\x9a\xcc\x49\xaf\xb5\x16\xbb\x72\x95\xdb\x47\x8b\x66\x5d\xb0\xf5\x4b\xc4\x70\x5d\xff\xe2\xdc\x35\x24\xe9\x7e\xd3\xd9\x6f\x3f\x4c\xd5\x7e\x34\x7d\xd0\x5b\xe4\x65\xff\x13\x7c\xf8\x41\x73\x4e\x3a\xf8\xe2\xaf\x8f\xd5\xfa\x4b\xbf\xd6\x5f\x4f\x56\xfb\x4b\xa7\x5a\x60\x46\x1c\x75\x7e\xb7\x11\x4e\xac\x51\x93\x0e\xe2\xaf\xb4\xbb\x24\xa6\xb9\xef\xe7\x8e\x19\x6a\x17\x62\x94\xdd\x48\x47\x61\x93\xd2\x1f\x00\x71\xe4\xca\x86\x2b\x77\xd4\xdf\xa3\x5f\xd4\x1b\xe2\xe9\x49\x94\x90\x51\x7a\x00\x14\x34\xee\x6e\x5c\xc9\xe1\xc7\x70\x1a\x90\x0d\xa1\x02\xd2\xa4\x2b\xd6\xb9\x0d\x4b\xa6\x20\x53\x99\x5b\xff\x8c\x8c\xda\xe9\x13\x8c\xc9\xbf\x95\x2b\x24\xa4\xb0\x5e\xfc\x02\xb0\xd0\x6f\xd6\x8d\xa8\x99\xea\x8b\x23\x61\x8a\xc6\x84\x70\xa6\x75\x78\x68\x05\x5d\x4a\xf4\x84\x1b\x1c\x3c\xe9\x27\xfc\x37\xfe\xf2\xe9\x06\xe5\x0e\x62\xb5\xf6\x42\x76\xd3\x23\x61\xe6\x4b\xb2\x14\x4b\x11\xb8\x6f\xfe\xdd\x7b\xba\xf8\xd3\xce\x10\x06\xb1\xbd\xb4\xd9\xd0\xf1\xc1\x4e\xbd\x91\x23\x1f\x86\xc7\xae\xee\xfc\xb8\x25\x0d\x52\xca\x36\x00\x37\x46\x4f\xae\x81\xea\xf7\x8a\x55\x6c\x98\x9c\x2a\x07\x0c\x53\x39\x45\x42\x6e\xf9\x7b\x0f\x8f\x3d\xf8\xc8\x23\x05\xfd\xc5\x12\x1c\xc2\x7d\x1f\xa2\x28\x1f\x25\xc4\x97\xa5\x9c\x1d\xe0\x5a\xd6\x70\x8c\x03\x9b\xb8\x00\x93\x42\x52\x83\x86\x24\xec\xb8\x53\x12\xdc\x74\xfb\x0b\xa3\xd1\x84\x52\xaa\xf1\x36\x8d\x17\x49\x29\xb7\x7e\x4d\x92\xe7\xf9\x15\x8a\xc9\xa3\x5f\xa9\x62\xbd\x7a\x29\xb7\xb2\x6f\xaa\x70\x97\xfb\x4d\x31\x99\x80\xc7\x08\x92\x6d\x51\x6d\xb5\x6a\x22\xe5\xba\xdf\x62\xa2\x29\x4a\x0e\xba\x2b\x62\x75\x82\xce\xec\x5f\x4d\xf5\x60\x34\xa2\xc8\x31\x26\xa3\xc2\xf7\x61\x87\x82\xc6\x04\xa4\x0f\x48\x62\x85\xb6\x9f\xc3\x79\xda\xf5\xb4\x43\x09\x6f\x61\x91\x8b\x5a\x00\x0e\x88\xce\x4e\x33\x27\x07\xa5\x8f\x39\x2d\x2a\xef\x8b\x6e\x1c\xdc\x13\x0a\xbb\xc4\x3c\x52\x49\xa0\xfa\xb8\xa8\xb7\x42\x16\x68\x18\x7d\x66\x74\xcb\x47\x19\x22\x41\x74\x27\x79\x7c\x39\xb3\x84\xc9\x79\xee\xc3\xfa\x05\x5b\x9a\x75\x35\xe8\x4c\x98\x78\xb2\x96\xc9\x09\x06\x01\x5d\xb5\xee\x7e\xad\xbc\x88\xba\x10\xa0\x9e\x63\xd2\x2e\xae\xbb\xa4\x23\x63\xe5\xd7\xae\x9b\xfe\x40\xee\xd0\x63\xb8\x7c\x7c\xeb\xf5\x2a\x51\xdb\x7c\x9d\xb1\xb8\xf8\xe1\xd1\x7a\x34\xc0\x28\x68\x27\x61\x34\x28\xe3\x73\x99\xb5\x39\x49\xbb\xd2\x24\x45\x0c\x48\xdc\xb1\xae\x2a\x5d\x01\xda\x64\x52\xd4\x75\xda\x39\x02\xe7\x24\x94\xec\xd0\x62\xc8\xfc\x0a\x09\xca\x08\xd3\xd4\x58\xa7\xee\x84\xbd\xe8\x04\x6f\xe2\x1a\x1b\xe4\x61\x1c\x11\xd6\x81\x56\x95\xc5\x05\x20\x52\x22\xfe\xf1\x98\xe8\x3b\x00\xc2\xd2\x89\x23\xa5\x9c\x11\xb5\x1c\x48\x58\x41\x90\x4a\xd0\x3b\x89\x96\x92\x7f\x4a\x9e\xae\xa6\x35\x5e\x25\x63\xb4\x0c\x42\xf6\x2a\x82\x17\xab\x64\x5c\xe3\x73\x9d\x54\xaf\x9f\xc6\xf7\xbd\xc9\x96\x8c\x69\x8d\x91\x37\x66\x6c\xec\x61\x38\xd5\xfd\x3d\x7a\xea\x24\x41\xa6\xa1\xf7\x2e\xdf\x7b\xc4\x7b\x9b\xde\x6c\x85\x47\xbc\x2f\x72\x21\xf2\x9d\x47\xbc\xaf\xd9\x46\x78\x11\x29\x18\x3d\x3a\xd5\x77\xd3\xe5\x3a\x9a\x5a\x6b\x1c\xd7\x4f\xac\x2a\xf9\x90\x5c\x9e\xb4\xf2\xdd\x3e\x2f\x59\x02\x26\x7f\x05\x30\x5e\x6f\xf3\x5c\x87\xd0\x41\xff\x43\xb5\x3a\x18\x93\xa9\x04\xe5\x2a\xa7\x66\xb7\x9c\x9e\xd2\x78\x38\xc9\xaf\xc7\x73\xae\x98\x3c\x46\x55\x72\xb5\x52\x3c\x64\x90\xa6\x0e\x92\xbd\xd7\xda\xff\xa4\xf3\xd4\xf7\x53\x06\xa7\x3f\xfb\xf5\xd5\x74\x0d\x88\xc8\xd3\x25\x3c\xec\x9c\x4c\x4b\x36\xe0\x12\x47\x9f\xcd\x48\x49\x8b\xe3\xc8\xa0\x67\xc5\x74\x5d\x15\xc8\x0d\xce\xee\xce\x86\x26\x2b\xa0\xfe\xaf\xa8\x04\xa0\x0c\x14\x5b\x10\xd5\x15\x41\x81\x6f\xab\xdd\x35\x2b\x42\x11\x2d\x3d\x2f\xf0\xf6\xf7\x1e\x86\x90\x89\x2d\x97\xd3\x2b\x56\xd7\xb2\xd0\x88\xd2\xcc\xf7\xc7\x15\xf6\x7d\xc1\x94\x19\xaf\x6d\x4e\xf9\xbe\xae\x7d\x7f\x1d\x3e\x8f\x64\x41\x7c\xa8\xce\xe9\x33\x92\xd1\xac\xae\xe5\x33\xb2\xa6\xe3\xaa\xae\xe7\xce\x06\xb9\x52\x73\x06\xbd\x5d\x8f\x33\x4c\xd0\x7c\x92\xe3\xa7\x68\x3e\x41\xb9\xec\xf7\x79\x55\xd7\xd3\x4f\x30\xbe\xa0\x33\xf0\x2c\x9e\x61\xb2\x3e\xa7\xf9\x62\xfd\x94\x3e\x23\x47\x1f\x6b\x37\xc0\xc6\x31\xc8\x5f\xd3\xf1\xba\xae\x65\xb3\x33\x49\x05\xc3\x79\xb4\x5c\x8f\x91\xfc\x1d\xcf\xf1\x53\x1e\x3e\x8b\x82\x31\x07\x71\xbe\xdc\x84\xd3\x8a\xa7\x82\x66\xa4\x98\x96\x22\x2e\x04\x5d\x93\x62\xca\x78\x42\x53\x8c\x49\x0a\xc2\x88\x8a\xd1\x83\xb3\x6a\x19\xeb\x79\x7e\xf4\x1c\xae\x43\x39\xe8\x19\xd9\xb4\x91\xdd\xd6\x17\x9b\xc5\x7a\x3c\xc6\x48\x9e\x27\xd7\x91\x06\x25\xe3\xf8\xec\x02\x10\x11\x4b\x64\x61\x47\x8e\x25\x0b\xd7\x91\xc6\x27\x85\x03\x44\x75\x0d\xcc\x90\x7c\x5b\xd7\xa8\x57\x09\x85\x54\x6b\x00\x9f\x45\x1f\x3e\x63\x06\x96\x47\xaa\x5e\x54\xd1\x98\xe6\x46\x8b\x14\xcb\xf3\x4c\x81\x7b\x9b\xa9\xa4\xa9\x75\x2c\x21\xa8\xa2\x15\x44\xed\xa8\x6b\x94\xd3\x78\x7a\x9d\x27\x0f\x9d\xdc\x23\x71\xcf\xbd\xad\xc4\x98\x54\x7a\x13\xe4\x4e\xff\x49\x7e\x2a\x6f\x69\x8e\x89\x9d\x80\x0a\xd2\x20\x7b\xd7\x59\xbe\xfe\xe0\x61\x02\x4d\xd3\x0a\x63\x8c\x03\x55\x66\xe4\x4c\x92\x7a\x42\x14\xc3\xec\xcc\x95\x12\x77\xc9\xc5\x5a\xd3\x99\x5d\x0a\xa5\x11\x92\x1f\x42\x18\x89\x75\xd4\x9b\x42\xf9\xe6\x94\x39\x71\xb9\xcd\xef\x06\xf6\x60\xa6\xe9\x1b\x70\xa8\xdb\x34\x19\x52\xb0\xeb\x32\xb8\x21\x22\xbf\xb9\xc9\x86\x68\x9f\x77\x9d\xe7\x19\x8b\x5d\xfd\xe7\x52\x9b\x7f\xca\x86\x91\xb6\x24\x97\x0d\x98\xeb\x3e\xc1\x8d\x75\x2b\xcb\x2b\xf5\x6b\x3e\x34\xb7\xea\xdb\xc6\x52\x95\x35\x23\x1b\x46\xf6\x4c\x9d\xcb\x4d\x20\xa4\x1a\x42\x23\x41\x8a\xf9\x84\xd1\xf3\xae\xb3\x50\xcf\x57\xe8\x3c\x25\x5b\xf9\xf9\x93\xfa\xfd\x2e\x4f\xaa\x8c\x3d\xa9\x57\xe7\x68\x19\xfc\x16\xdf\xc6\x35\x5b\xef\x62\x5c\xae\x8b\x74\x2f\xce\xd3\xc5\x5a\x92\x0e\x05\x25\x06\xc8\x5e\x17\xf1\x0d\x80\x4b\x37\x85\xe2\xab\x13\x29\x14\xd1\xa6\xad\xe2\xcf\xb2\x33\xe9\x24\x3f\x30\x14\x0f\x93\x4d\x3f\x3d\xb2\x49\x8d\x44\xbc\x36\x49\xd2\x51\x21\x9d\xfa\x47\x48\x7c\xd9\xcd\x12\xb4\x61\x98\x3c\xa8\xa0\x71\x97\x59\xce\x19\x5d\xb3\xe9\x5a\x5e\x00\xd9\x19\xcd\x70\xef\xce\x06\x71\x35\x81\xe6\x64\x85\xae\x13\xa4\x49\xf0\xff\xf9\xfd\xc5\xb9\xbd\xf6\xc8\xc3\x94\xe7\xd0\xc0\xa5\xfa\x8c\x8e\x46\x47\x2d\xb5\x75\xbb\x1e\x8a\xfd\x06\x6c\x12\x2f\x7d\x21\xeb\x56\x97\xaa\x4e\x5b\x0b\x40\xc6\x0d\xa3\x07\xb1\x65\x71\x12\x84\x73\xe2\x5d\x80\x03\xed\xe7\x1e\xf1\x2e\xce\xf5\x65\x44\xd6\x79\x16\x84\xcf\xec\xcb\x8b\x75\x9e\xdd\x14\x79\xb5\x57\xc5\xec\x9d\xf3\x85\x28\x3a\x1f\x08\x89\x45\x74\xa5\x70\xe9\x16\x4d\x82\xf0\x79\xbf\xe8\x85\x28\x74\xf1\xe2\xf3\x81\x6f\x7e\xd5\xc3\x0f\xc2\x19\xf1\x3c\xe2\x79\x91\x83\xbc\x6f\xdd\xa4\xc5\x56\x9c\x72\x3a\x43\xe1\x71\x0a\xbc\x53\xb9\xcc\x21\x94\x0f\x0e\xfe\x5a\x7e\xcf\xe5\x40\x2a\x25\x53\x43\x18\x91\x9e\xc0\x53\x39\xfd\x2d\xad\x05\x2c\x03\xf1\x3c\xef\x66\xa4\xed\x45\x79\x73\xc2\xd4\xd8\x60\x2a\x0a\x37\xb2\x90\x47\xc4\xbb\xc9\xf2\xeb\x38\x7b\x75\x1b\x67\x1e\xb8\x51\x2b\x1a\x23\xfa\xef\x30\x6e\x6e\xd8\x14\xe6\x98\xca\x8b\x4d\x9e\x0b\x79\x61\xd6\x15\xae\x63\x05\x3f\x37\x60\xa4\x11\x27\x04\x2e\xe0\x36\xb1\xd0\x55\xd7\xe8\x86\xc9\x6b\xfb\x99\x86\x3a\x00\x2c\x9d\xe9\x6b\x57\x65\x22\xdd\x67\x8c\x7e\x64\xae\x3e\x52\x2b\x6d\x72\x7c\x45\x0a\x61\xed\x24\x3e\xaa\xfd\xbf\x2d\x57\x77\xe3\xc5\x79\xbb\xba\xf7\xa7\x42\x52\x39\x09\xfe\xc5\x49\xe4\xa3\xc2\x11\x25\x74\x46\xb6\xed\xdc\x25\x17\xdb\x45\xa2\xdc\x3c\x20\x64\x55\x22\x89\xdf\x0c\xd2\xd0\xf4\x75\x44\x39\xc6\x66\x89\xf6\x24\x77\x62\x3a\xe5\x51\x90\xb7\xaa\xa1\x9d\x71\x9f\xc7\xf8\x00\xb6\xa4\x9b\x6e\xe2\xb1\x13\x58\xaf\xa4\x28\xd1\xec\x57\x8e\xeb\x3a\x54\xa0\x8d\x8f\x93\x03\x54\xf4\x46\x12\xca\xba\xbe\x61\x53\xb3\x17\x48\xec\x20\x81\x4a\x72\x42\x57\xd3\xad\xd8\x65\xdf\x17\x4c\x9b\x30\xe7\x78\x5c\x49\x9e\x68\x4d\xab\x70\x66\xe2\x3c\xaf\x27\x13\x1c\xd3\xd8\x41\x0a\xed\x00\x63\xd7\x08\x9b\xa0\x98\x6e\x5c\x73\x71\x37\xb2\x2b\xf5\x3c\x1d\x98\x49\x9b\x04\xea\x11\xbe\x63\xf7\x9a\x3d\x97\x04\xba\xf7\x85\x5c\x07\x9b\x21\x73\x1f\x26\x3a\x04\x47\x01\xb1\xa0\x5a\xf3\xcb\x9c\x14\x18\xa7\x36\xbe\x94\x33\xcd\x19\x4d\x65\xcd\x24\xa6\xb7\x0c\x75\xa7\x58\xf2\x18\x8a\x1e\x79\xa0\x8a\x7b\x60\x28\xc6\xf2\xe4\xbe\x76\xda\x8c\xc3\xb5\x6c\x73\x6b\x56\xcb\x84\x9a\xf4\xb0\x75\xdf\xcd\xad\x10\x6e\x03\x8c\xe2\xb5\x24\x81\x1f\xd8\xc3\x39\xb9\xd3\xb4\x74\x97\x57\x25\xab\xf7\x79\xca\x05\x2b\xea\xb5\xf2\xe6\xdd\x31\x5e\xd5\x49\x11\xdf\xd4\x49\x91\xef\x71\xbd\xce\xd2\xf5\x87\x73\xf2\x0e\xbe\x09\xdf\x4f\xa3\xa7\x58\x1e\xef\xa6\x68\x3a\xc6\x35\x76\xc0\xfb\x92\xb9\x69\x02\xec\xe3\x57\xce\x63\x27\xf3\xf9\x15\xeb\xfa\x0a\x53\x4a\x7b\x16\x4a\xfa\xcd\xab\x6e\xac\x56\x47\x12\xdd\x20\x4c\x29\xf2\x20\xd2\xab\xca\x4e\xde\x56\xff\x81\xb9\x0a\x55\x85\x51\x63\x52\x76\x35\xa7\x56\xd7\x74\x30\xda\xd3\x63\x7f\x5d\x10\x81\x16\x75\xcd\x09\xb7\x07\x6b\x81\xa1\xfa\x12\xaa\x17\x61\x19\x91\xdc\xe1\xc6\xd2\x8d\x76\x8b\x29\x54\xa4\x17\x4a\xd3\x25\x4a\xc1\xd6\xc4\x56\x11\xe8\x17\xbe\x7f\xac\xfa\xe2\xb2\x74\x41\x0a\x5b\x56\xdf\x3a\x3d\xd0\xa1\xc2\x53\x9c\xd2\x57\xcc\xc2\xd4\xa8\xcd\xa5\xe2\x86\xba\xcf\xe1\x9c\x92\x12\x94\x0e\x9e\x54\x11\x9e\xe6\x9b\x0d\x62\x3a\x51\xdf\xb1\x95\x61\x83\xa7\x37\x55\x9a\xd0\x18\x7e\x20\x04\x1e\xdc\x5f\xc1\xcf\x78\x0c\x29\x28\x8e\xa5\x2a\xec\x96\x71\xa1\x8c\x84\x94\x94\x27\x25\x05\xc8\x74\xdb\x45\x7a\x21\x67\x11\x96\x27\x5f\x22\x8d\xfe\x49\x4a\x46\x73\x10\x7d\xda\xef\xe5\xb3\x83\x4d\x30\x1e\x8c\xe6\x64\x0b\xd9\x5b\x8a\x13\x9e\xc3\x9a\x58\x80\x15\x0e\x9c\x00\xe7\x3e\x9b\xa6\xe5\xbb\x22\xbd\xb9\x61\x85\x76\xb0\x4a\x95\x6b\xa6\x89\xf6\x81\x91\x69\x11\x22\x13\xc4\x59\x98\x46\xca\x29\x25\x61\x19\xbb\x91\xf8\x40\x79\xc8\x83\x14\xf1\xfb\x22\xdf\xc7\x37\xb1\x1a\xab\x9d\xff\x62\xc0\x80\xe8\x97\x56\x84\x9c\x2a\x3d\x7a\x6e\x7a\x46\x74\x3f\x10\x26\xc5\x88\x52\x2b\x99\xd1\xaf\x71\x5d\x8b\x65\xe7\x73\x48\x10\x4e\x0f\x0d\x14\x77\x62\x5f\xc8\x1e\xbd\xd9\xed\x58\x92\xc6\x82\x75\xba\x46\x18\x38\x61\x31\x2e\x5e\x2a\x4c\x8b\x30\xd1\x79\x7a\x15\xce\x6b\x83\x9d\xa0\x4e\x53\x5a\xb9\x6b\xe6\x44\xa8\xa9\x43\x56\x20\x5d\x84\xb3\x88\x5c\x4d\xc1\xb6\xa1\xd5\x4a\x63\x52\xd8\x38\xcd\xc6\x1b\x93\x3c\xd6\x3f\xc9\xe3\x3b\x82\x43\x23\x26\x4d\xc1\x8f\xb5\x0b\x00\x97\x0c\x37\xfa\x19\x3d\x28\x16\x20\x38\xf4\xfc\xde\x04\x61\x03\xa1\x9e\x14\x65\x25\x7b\xa2\x83\x31\x9a\x69\x06\xd0\xf8\x17\x12\x18\x1f\xf8\x54\x43\x14\x9c\x7a\x51\x4e\x39\x36\x4f\x48\x4a\x73\x1b\x7b\x02\x44\xce\xa7\x7c\xe4\x65\xbf\x09\x37\x9b\x84\xf7\x36\x89\x3c\x9d\xde\xaa\xfe\x97\xf2\x80\xda\xde\x51\xad\x6e\x57\x64\x07\x70\x07\x06\x92\x75\xab\xfb\xa0\x0c\x33\xcc\xdd\xc0\x26\x1e\xe2\xea\xae\xda\x29\xd4\xab\xc7\x92\x91\x89\x19\xbc\x34\xaf\xe4\x81\x12\xe2\x39\x98\x00\x83\x8e\x5d\x9a\xce\x8b\x8c\x49\x46\x11\xa3\x48\x51\x17\x57\x25\x2c\x09\x7c\x57\xb7\x94\x4d\x26\x38\xa1\x37\x14\x95\xf4\x9d\xe6\x06\x58\x98\x45\x3a\xc6\xd0\x3c\x22\x5b\x8a\xca\xf0\x99\x4e\x4b\xa4\x03\xdd\x4c\x4d\xa4\x1b\x4c\x12\xdf\x47\x1b\xda\xdf\x89\x09\xec\x44\x92\x50\x94\x2e\x37\x9d\xfd\x18\x6c\xa6\xd7\x29\x4f\x40\xa5\x5a\xd7\x09\x39\xf9\xed\x9a\xb6\xba\x14\x08\x9b\x96\x90\xbc\x48\x6f\xa0\x8e\x1b\xa5\x6d\x2c\x2c\x56\xe1\x44\xae\x59\xa0\x56\x90\x98\xb5\x0f\x52\xe2\x06\xbd\x08\x00\x12\x4e\x44\xc4\x30\x7a\x54\xd2\x62\xad\xad\x8e\xe2\x33\xf5\x40\xc1\x40\xd0\x9e\x56\x8a\x5d\x43\xfa\x92\x86\x51\x8b\x6d\x2e\xf3\x8a\x0b\x3a\x23\x1b\xb9\x2b\xab\xbd\xef\x8f\xe6\x23\x4a\xf5\x9d\x76\x23\x25\x05\xd9\x92\x58\xe2\x89\x23\x3b\x23\xdf\x3f\x7e\x86\x12\x12\x63\x4c\x36\xf2\x85\x9c\x67\xf9\x6b\x6a\x5a\x63\xb2\x36\x10\x6f\x20\xb8\xfb\x80\xaa\xe9\xc0\x98\xa4\x6d\xdc\xca\x7d\xb7\xbf\xe3\x31\x99\x91\x35\x0e\x34\x27\xb5\x6e\xd1\xb8\xda\xb0\x72\x90\xa3\x19\x6e\x06\xad\x1a\xfe\xda\xce\x6d\x95\xc4\xbe\xaf\xd1\x05\xec\xe3\x5b\x10\xc6\xd8\x0d\x86\x0f\x19\x45\x5a\x19\xf6\x57\x80\x36\xdd\xa0\x1e\xdc\x8a\xff\x0a\x6e\xf1\xe1\x24\xe8\xc1\xe2\x52\x54\x3c\x0e\xba\x90\x0f\x84\x94\xb4\x84\xa4\x5c\xbd\xb4\x47\xab\xd5\x14\x7b\x63\x03\x41\xab\xd5\x14\x2d\x83\xe9\xd3\xd5\x6a\x5a\x63\x0f\x8f\x3d\x24\xaf\x9e\x60\x4f\x72\x93\x83\x91\x4a\xd7\x10\xa8\x94\x8c\x52\xdf\xbf\x19\x51\xba\x9e\x1a\xd8\xaf\x6b\x70\x2e\x90\x0b\x0b\xcf\xd5\xca\x97\xbe\x6f\xb2\x33\xae\xa7\x16\x82\x71\x5d\x17\xbe\x5f\x40\xb9\xd2\x66\x76\x44\xde\xd3\xa7\xe0\x99\x58\xd7\xa3\xf6\xb9\x84\x6a\x0b\x24\x39\x99\x4b\xe8\x6a\xbf\xe9\x41\xcd\x64\x42\x36\x5a\xc8\xe6\xfb\xe6\xca\x68\xe9\xd6\x18\x2f\x62\xdf\x1f\xed\x5b\x42\x25\x79\xf1\xb8\x48\xf2\x3b\x6e\x77\x85\x79\x60\xbe\xda\x12\x07\x77\x5e\xb9\xf6\x93\x88\x91\xa4\x7d\x69\x74\x83\xb0\x13\x1b\x6b\x67\x94\x9c\xa5\xfc\xac\xc2\x66\x41\xad\xc6\x2d\x19\x4b\xa8\x00\x40\x1d\xcd\xf0\xa2\x6f\x52\x52\x01\x4c\xda\xd2\x9e\x6a\xe4\x4c\x81\xa4\x27\xc1\xde\xa0\xdb\x61\xc6\xc5\xc8\x69\x41\x29\xa2\x0e\x10\x7d\xcd\x9b\x92\x57\xaa\x6e\x6d\xd2\x7b\xc9\xb5\x65\x14\x39\x7c\x83\x67\x5a\xab\xeb\x21\xb2\x12\x56\x2a\xbc\x1a\x40\xdb\xfa\x08\x64\xed\xdb\x43\x03\x32\x49\x08\xd0\x50\x11\x41\xe7\x0b\x71\xa4\xf1\x86\xa8\x27\x65\x28\xa2\xd6\xb2\x5a\xa7\x50\xad\x5a\x48\x87\xb4\x0f\x4a\xcf\x35\x5a\x4b\x66\xe4\xa5\x9e\x82\xba\x86\xb5\xeb\x3c\x73\xdc\xe3\x2b\x38\x7e\x9a\xfe\x69\x44\x54\xba\x05\x48\xa6\xe2\xaf\xe8\xa0\x0b\x29\x8d\x43\x21\x8f\x44\xbe\x3f\xaa\xa6\x69\xe9\xf0\x19\x57\x22\xdf\xef\x59\x82\x30\x3e\x54\xd3\x75\x55\x14\x8c\x0b\xdd\xb1\x74\xca\x32\xb6\x23\xbc\xad\x27\xa7\xa9\x6d\x2e\xe4\x4e\x85\x43\x1c\x4c\x5b\x73\x35\x2d\xec\x4e\xd1\x60\x99\x4f\xdd\x27\x6e\x01\x73\x66\x73\xf7\x16\xaa\x74\xb3\xdf\x5d\xff\x46\x73\x52\x4d\x25\x51\xa2\x39\xfc\xb4\x56\x61\xa8\xa0\xe8\x88\x53\xcd\xed\x7e\xd6\x2c\xab\xaa\xa8\xae\x73\x33\x12\xac\xe9\xbb\x1e\x6e\x09\x59\x2d\xe4\xd9\x00\x55\xd3\x82\x95\x55\x26\x28\xc8\xdd\xab\x63\x76\xb1\x3a\x66\x78\xb1\x3d\x58\xae\xa7\xfb\xbc\x14\x66\xf9\x20\x8c\xa4\x73\xdf\x59\x4e\x62\x5a\x02\x4b\x2e\x35\xbf\xc3\x8a\x52\xd2\x89\x6e\x2d\xba\xc8\x02\x62\xc4\xab\x64\x22\x00\x69\xbe\x9f\xb9\x76\x30\xc8\x83\xe3\xaa\x9b\x1d\x61\x7e\x41\xd9\x54\x65\x60\xd0\xb6\xa8\xd9\x88\xaa\x78\x42\x19\xcd\x3a\x3e\xe8\xc0\xb2\xea\x00\x5c\x6e\xb5\xa6\xd6\x51\x9b\xd3\x61\x24\x57\x23\xb3\xa9\xcc\xb1\x8e\x42\xab\x42\x63\x1c\x1a\xa2\x32\x19\x54\x20\xd8\xb2\xfc\x6d\x1c\xa6\x72\x05\x21\xc8\xa9\xc5\x87\x26\x29\x6e\x1c\xa6\x11\x2d\x3a\x6c\x04\xf8\x72\x22\x15\x51\x45\x1b\xe7\xa0\x0c\xeb\x08\x4c\xfa\xb9\x4e\x55\x9b\x59\xaa\x86\x49\x0c\xd9\x45\x73\x9b\x6c\x20\x6f\x23\x68\xea\x08\xb2\x12\x0a\x82\xac\x5d\x87\xbc\xb1\x4b\x9a\xa9\xcd\x5a\x5d\x88\x3f\xfd\xca\x38\x54\x56\x92\xcd\x2f\x81\x17\x97\x70\xd2\xe1\xc7\xf1\x61\xd8\x98\xf4\xe8\xec\x40\x04\x39\x30\x5e\xed\x98\xb1\x23\xed\xdb\x95\x82\x7d\x27\x84\x85\x71\x3d\x59\xb4\x91\x94\xdc\x00\x29\x8f\x33\xa8\xd4\x9e\x8c\x86\xde\x75\xf4\x93\x8f\x7e\x7e\xfc\x26\x14\x51\xdf\xae\xf5\xd4\xf8\xf4\x99\xf7\x4f\x86\x74\x57\xa4\xc2\x5c\xab\x13\x97\xca\x08\xd1\x90\x4d\x3a\x1c\x6b\x24\xb4\xe6\xc1\xd1\x92\x05\x92\x50\xe8\x99\x04\xb3\x39\x8d\x11\x82\x43\x96\xc7\x49\x70\xe0\xf9\x17\xd5\xb5\xb6\xca\x25\x00\xc2\xc1\x01\x18\xc8\x01\x1b\x4a\xd9\xe1\xba\xb6\x82\x83\xbd\x46\x52\xc2\x84\xd0\x14\x53\xa8\xc0\xf7\x5f\x20\x41\x8c\x3e\xc3\xf7\x5f\x40\xb4\x45\xb5\x3d\xe4\x19\x8d\x8c\xe6\x0d\xd1\x07\x8e\xff\x6f\x5a\xc1\x44\x8e\xc6\x8a\xd1\x07\xac\x41\x0d\x7e\xf8\x2f\x1b\xd1\x54\xd4\xb6\x53\xd7\xf0\x3e\x06\xca\x7d\xcd\x36\x79\xc1\x2a\xae\x26\xd6\xc5\x72\xdd\x1e\x18\x44\xcd\x34\xb6\xf3\x7d\xd6\x85\x21\x30\x82\xed\x3c\x99\xaa\x7e\x82\x3e\xc4\x7e\x87\x9b\xa6\x51\x6e\x28\x96\x75\x39\x32\xba\x67\x43\x8e\x21\xb2\xc1\x21\x7f\x11\x65\xd4\xa8\x81\xe5\x38\x73\xf7\x08\x20\xb6\x13\xa5\x4a\x15\x6d\xc3\xc2\xba\xb0\x46\x04\x38\x9f\xea\xd3\xe4\xc0\x26\x32\x61\x9d\xc0\x58\x5d\x15\x53\x0f\xd2\x52\xd3\x96\xef\x15\xa5\x61\x09\xb5\x41\x2b\xed\xa3\xba\x6e\x0d\xfb\x8e\x5e\x6a\xd2\xc5\xdc\x79\x5b\x5e\xb2\xe0\x95\x69\x52\x91\x76\x03\x06\xbe\xff\x5c\x51\x03\xb8\x73\xec\x8f\xcd\x93\x96\x02\x04\xe6\x99\xaa\xa8\xcb\x2a\xb0\xee\xbd\x2a\x02\x81\x6b\x59\x62\x8b\x74\xee\xb5\xfe\x53\x4d\x01\x11\xea\xbc\x08\x67\x50\x63\xdf\xa4\xde\xa7\x3b\x76\x25\xe2\xdd\x9e\xaa\x19\x35\xb7\x75\xfd\x32\x16\x6c\xca\xf3\x3b\xa4\x65\x45\xed\xde\xa7\x72\x0f\x1c\xe1\x50\x7a\x70\x82\x91\x05\xfa\x35\x39\x9e\x71\x39\x55\x43\x1c\x92\x7a\xfe\x08\xa3\xa3\x0a\x5c\xa5\xbb\x0a\x86\x19\x8c\xe6\xa4\xcb\x30\xf4\xfd\xa5\x19\x3d\x06\x8d\xc5\x29\x38\xb8\x64\x44\xd2\x70\xfd\xda\xb6\x02\x79\xaa\x7a\x6c\x49\x43\x7a\x5c\xc9\x7f\xd3\xf0\xf1\xb8\x1e\x6b\xfa\x88\xfd\x51\x6d\x0f\xcd\xd2\x7f\xd3\x89\x47\x66\xf9\xcf\x7a\x73\x42\xd6\xa7\x94\xf0\x47\xbd\xb5\xe1\x47\x0e\x71\x26\xfe\xc9\x1e\x24\xad\xb9\x06\xb2\x00\x41\xcd\xd6\x72\xbb\x67\x96\x40\x6d\x63\x7e\xc3\x92\x77\x79\x05\x21\xe5\xe5\x13\x51\x64\xfa\xab\x84\x89\x38\xcd\xe4\x15\x2c\xc6\xf7\xdb\xb8\x84\x8f\x76\x4c\xc4\xba\xc8\x3e\xbe\x61\x3f\x9b\x8b\x5f\xe4\x05\x58\x64\xea\xb7\xb7\x29\xbb\x93\xbf\xde\x7a\x1b\x17\x9e\x22\x88\x89\x69\xb7\xb8\xd4\xd7\x1f\x54\xe1\x0f\xec\xc1\x3c\xd1\x89\xb9\xec\x95\xea\x58\x96\x32\x2e\x7e\x6e\x2f\xa1\xb9\x7c\xb3\x29\x99\x7a\xaa\x2e\xe1\xa9\x56\x71\xbc\x49\x9c\x1b\x38\x84\xcb\x0e\xae\x0b\xc6\xf8\xcf\xed\x25\x7c\xa1\xf0\x80\x33\x0f\x22\xd7\x0a\x08\x75\x63\x9f\xdf\x6d\xd3\xa1\xf3\x9c\xe5\x39\x17\xbd\x90\xbb\x50\xde\xf7\xaf\x4d\x5c\x68\x45\x94\x96\x36\x44\x91\x9e\x88\x65\x7b\x19\xb0\xa9\x9d\x0b\xfb\xbd\xeb\xdb\x70\xd7\xab\x6b\xee\x8b\xe5\x3c\x78\xe6\x8b\xe5\xf3\xe0\x63\x5f\x2c\x9f\x05\xb3\x40\x7f\xa8\xa0\xc1\x08\x56\x25\xa0\xb4\xd1\xb8\x54\xc6\x3c\xa5\x4e\x49\xb9\x47\xae\xb3\xaa\xd0\xb7\x79\x25\xbc\xa6\xe7\x78\xdc\x3f\x85\xb0\x88\xf6\x99\x0b\xcb\xb6\xbc\x30\xc6\x95\xe4\xea\x14\x8b\x70\x5c\x56\x11\xfa\x8e\xcc\x44\xb8\xd1\xc3\x40\x7b\x25\x31\x47\x11\x78\x70\x9d\xdf\xb2\xc2\x23\x70\x99\xb1\xf8\x96\x99\xc7\x95\xf0\xcc\xa2\xeb\xe2\xfa\x4e\x7d\xa0\x6f\xf4\x27\xe6\x55\x7f\xc4\xe9\xf0\x88\x3b\xdd\x4b\x89\x11\xe9\x04\xa9\x66\x93\x07\x53\x8c\xf7\xe8\x04\x28\xc3\xed\xe1\xcf\x0d\xde\xca\x29\xd5\x2c\x53\x3f\x88\x1f\x87\xec\x10\x6a\xc5\x69\x61\x0f\x80\x44\xd0\xc2\x8a\xed\x06\xb5\x39\x44\x7f\x93\x62\x22\x9a\x23\x83\xe1\x4e\xfa\x3b\x63\xfc\xa7\x3b\xf4\xc1\x2e\xa2\x7a\xde\x90\x9c\x1f\x8b\xf0\x4e\x15\x27\x73\xf9\xc1\x66\x73\xca\x27\x0c\xcc\xd8\x8f\x51\xbd\x7c\x62\xa7\xc6\xb0\x22\x9d\xf9\x22\x57\x88\xf5\xe4\x0d\x4a\xa3\x55\xb4\xe7\xeb\x65\x3b\x43\x63\x6f\xea\x8d\x9d\x57\x81\x33\x79\x85\x3d\x94\x11\x3b\x8b\x0a\xaf\x0e\xea\x0d\x75\xee\x9d\xf4\x2c\xe5\x67\x0c\x2b\x54\xbf\xd9\xc8\x73\x19\x61\x61\x3a\x18\x42\x13\x04\x03\xc2\xf7\xdb\x38\x12\xad\xe1\x35\x64\xfb\x10\x44\x58\x35\x23\xb0\x39\x0a\x0c\x5e\xe9\x30\x82\x27\xf5\x6c\x1d\xf7\x01\xc2\x95\x2d\xb4\x36\xa0\xfa\x96\xd1\xf3\x0b\xa5\x51\xae\x2f\xc0\x90\xac\xbe\xc8\x52\xfe\xe1\x3c\x25\x2f\x19\x3d\xd7\xb6\x3e\xab\xf2\x29\x5a\x06\xe1\x7b\x1a\xd5\x74\x55\x3e\x35\x26\x40\x53\x7c\x9e\x92\xdf\x18\x3d\x7f\xbf\x2a\x9f\x5e\x8c\xd0\x32\x58\x85\x97\x2f\x5f\xbc\x7b\xb1\x0a\xeb\xc9\x04\xd7\xf2\x41\xb4\x8a\xe4\xf5\xe7\xab\xf2\xe9\x13\xd7\x29\xea\xf7\xae\x8e\x57\x45\x51\x93\xa4\x06\x78\x7a\x74\x1c\xce\x4f\xb8\x21\xe0\x3c\x51\xc8\x72\x10\xc8\xd7\x84\x82\x43\x1e\x18\x7b\x78\x38\x9c\x45\x75\xed\x84\x0e\xfb\x9a\x75\x7c\x38\x01\xc4\x91\x42\xaa\xa7\xb2\x22\x8e\xbd\x73\x6f\xac\x59\x53\xa7\xa6\xaf\x9c\x9a\xc0\x97\xea\x5c\xd9\xd9\xb6\x1a\x76\x9b\xb8\xe7\x13\xbc\x64\x2e\x87\x6b\xdd\x64\x02\xc3\x83\x1f\xb5\xea\xb6\xf4\x1d\x1b\x94\x7b\x2c\xb4\x04\x42\x74\x43\x80\x77\x65\xdf\xa8\x34\xda\x32\x6c\x65\xde\x16\x18\xad\x10\x52\x1c\x09\x21\x49\xa9\x83\x70\xce\x48\x41\xcb\x30\x8d\xfa\x56\x38\x1d\xc5\x2d\x49\x89\x2c\x13\xf2\x08\x2f\x7e\xe8\xb6\x9f\xd3\xd6\x0f\x0b\x93\x98\xba\x21\xcb\x72\x4c\xb4\xeb\x0c\x81\xbc\x57\x76\xc4\xdf\x33\x64\x35\xf2\x05\xbd\x41\x3a\xb6\x99\xc4\x04\x56\xe0\x0f\xc6\xa9\xd6\x4f\x64\x4f\x37\x93\x39\x49\x28\x28\x1c\xb7\x74\x87\x12\x10\xf7\x6f\xeb\x7a\x7e\xb1\x19\x70\xb6\x94\xa7\x03\xd7\xe4\xcd\xf7\x5f\x6a\xea\x98\xb4\x27\x99\xde\x2e\x72\x72\xd1\xb2\xdf\x11\xc3\x8b\xad\xef\x83\x86\x93\x26\x6e\xc0\x4e\x22\xc0\x28\x05\x61\x8c\xc9\xf7\x0c\x69\x87\x2e\xdc\x40\x87\x36\x60\x7d\x8f\x18\xbd\x67\xa8\x20\x3c\x9c\x45\x3d\x4b\xd5\xd1\x9c\x70\x92\xe3\x4e\x90\x43\x75\x8c\x69\x0d\x55\x5a\x19\x37\xa3\xf2\x74\x50\xd7\xb9\x16\x2c\x95\x14\xc5\x3a\x3a\xa0\x92\x2d\x5b\x03\x91\xaf\x99\xcd\x1d\x62\xcd\x47\x2b\xca\xc8\x7a\x44\xe9\x1e\x94\x22\x57\xca\x12\x0f\x55\x64\x34\x83\xac\x5c\xa5\x3c\x89\x28\x43\x99\x98\xdc\xca\x17\xb6\x3a\x8c\x8d\x83\x0c\x0f\xd7\x11\xa9\xc8\x1a\x46\xa7\x80\x26\xa3\x71\x18\xb7\x01\x95\x7b\x03\x54\xbd\x8b\xc9\x57\x0c\x13\x65\xcb\x5a\xea\xce\xc4\xb2\x2a\x63\xa9\x52\xb9\x96\x2a\x23\xeb\x0c\x53\x75\xed\xba\x64\x0f\x2d\xc1\xcb\x48\xa5\x84\x93\x65\xb1\xf6\x7d\x4f\x99\x70\x7a\x23\x90\x5e\xb6\x9b\xb2\x9b\xe7\xe1\x6a\xfa\x2b\xbb\x8d\xb3\x1f\x8b\x0c\x64\xaf\x3c\xff\x06\xbe\x92\xf5\x9a\x17\xaa\x42\x72\xe0\x39\x5f\xb3\x40\x96\xe1\x6b\x56\xd7\x55\x0f\x5b\xc0\x63\x0f\x37\x24\xc3\xc1\xb5\x6c\xb1\x35\x04\xb2\x7e\x9b\xbf\x31\x48\xfa\x50\x91\xac\x95\x8d\x3a\x06\x70\x6f\xd9\x51\x22\x3e\x92\x52\xb1\x74\x22\x34\x32\x1c\x30\x92\xd3\xd9\x42\xe7\x06\x2a\x54\xaa\xbb\x45\x3e\x1e\x63\x5e\xd7\xf3\x91\x1b\xa6\x1d\xd8\x81\x8c\xc5\x1c\xb6\xe3\x2d\xb8\x7f\x92\xa2\x93\xda\x17\x71\xb0\xf6\x2f\x54\xda\x11\x59\xc4\x59\xe4\x4e\xd9\x8e\x7d\x73\x81\x3b\x66\xc5\xd6\x8b\xd3\x35\xc6\x1a\x94\x4b\x41\x10\xe1\x23\x76\xa0\x93\x3b\xd3\x6e\xf0\x9e\x69\x28\xd9\xd0\xd4\xf8\x81\x8f\x50\xdf\x96\x54\x8d\xdd\x75\x03\x98\x77\x1f\x28\x57\x63\x95\xc0\x01\x31\xac\xd0\x20\x98\x55\xad\xb1\x8e\x5e\x8c\x72\x79\xeb\x6c\x14\x9b\xf8\x90\xe6\x61\x11\x11\x09\xa2\x85\xb1\x6b\x24\x6d\xb6\x0d\x94\xd1\xea\x54\xc2\x0d\xdf\x37\x72\xa5\x52\xb3\xdd\x95\x21\x99\xb4\x34\x57\x81\xae\x4b\x39\x29\x78\xc6\x64\x16\xee\x41\xe4\xef\xda\xc2\xd2\xb2\x73\x0b\xf3\x21\x20\x18\x32\x56\xb2\xe5\xbc\xae\x61\x18\x04\xec\xf2\xdc\x01\xe6\xfd\x81\x7d\xc7\x10\x8c\x2c\x86\x50\xde\xa0\xd8\x02\x6a\xb3\xb6\xcb\x3b\xbb\xd0\x93\xe4\xc0\x85\xc5\x3f\x0f\x12\x33\x8c\x36\xbe\xdf\x45\x36\x90\x5d\xc9\x02\xde\x51\x32\x68\x47\x91\xd5\xd7\x2c\x01\x68\xb7\x5a\x0c\x4e\x99\x85\x6e\x30\xc2\xe0\x58\x07\x4a\xe7\xe1\x2f\xad\xd3\x37\x3c\x32\xe4\xad\x0d\x00\x60\x9e\xa4\x61\x11\x2d\x7b\x0c\x90\xe4\x40\x83\xae\xc6\x0f\x8c\xb4\x8c\xc6\x6f\xe1\x36\xa0\x39\xad\x86\x87\x3f\x38\x41\x00\x90\x7b\x6b\x98\xb1\x63\x56\x39\x81\x34\x3b\x43\x9b\xe1\xad\xe5\xc3\x1c\x77\xd0\x47\x0b\xe2\x81\xb4\xd0\xba\xcc\x80\x03\x6c\x3f\xee\x00\x5b\xaa\x9c\x26\xca\x7e\x5e\x1b\xdd\x43\xc2\x6c\x7c\xc4\x2f\xce\xb5\xee\xa3\xbf\x9f\x7a\xcf\x3e\xeb\x3f\xaa\x6b\x25\xe4\x73\xcd\x20\x3b\x1e\xb1\x47\x9a\xca\x86\x28\xa3\xc6\x81\x23\xde\xf7\xac\x77\x2a\xe9\x0c\xef\x7f\xef\xe2\xef\x76\x3e\x3b\x06\x95\xaa\x9f\xfb\x82\xfd\x4f\xdd\x31\xdc\x58\xb7\xa9\xf9\xc0\xb3\xcf\xfa\x8f\x0c\x5f\xd1\xf6\x6b\x21\xa6\x29\x2f\x59\x21\xbe\x00\x79\x32\x44\x51\x73\xc3\x18\xcb\x8e\x2a\x51\xf3\x7f\xdd\x4f\x68\xd9\x25\x01\xbd\x07\x47\x0d\x2b\xfb\xac\x86\xc4\x1b\x31\x78\x10\xff\xbf\xdf\x5c\x27\xf7\x8c\x6c\xfa\x28\x1a\xa9\x0d\x61\x0f\xaa\x5d\x4d\x0b\x95\x18\x2d\x14\x11\x06\x95\x73\x3f\x69\x0d\xea\x11\x43\x06\x59\x32\xe4\x39\xb7\x63\xb2\xdb\x3d\x8e\x0d\x90\x2b\xc7\x16\x55\x4b\x63\x7c\x9f\xe9\xc0\x37\x94\x8a\x25\x0b\xb4\xd0\x57\xf2\x39\x83\x4e\x6e\xc0\x66\x19\x66\x11\x06\x28\x09\xe7\x5f\xdc\xd5\xad\x82\x04\xce\x36\x4a\x87\xd8\xcd\x83\xd2\x09\x11\xc0\x54\x50\x09\xe7\x94\x60\xc3\xdc\x58\x63\xea\xc1\xd0\x2a\xcc\xf7\x47\xdf\x3a\x69\xc9\x46\x37\x2c\xb4\xc6\xdb\xec\x31\xe3\xed\x08\x1f\x18\xed\x9b\x66\x4b\xa0\x2e\x1e\x60\xe5\xec\x21\x02\x54\xcb\x7a\x30\x5c\x2b\xa5\x4f\x2f\x98\x50\x0b\xe6\xf4\x9b\xca\x4a\xe9\xcc\xb5\xf5\x15\x1a\xc2\x0c\x62\x53\x1b\x1c\xb4\x5e\xa7\x31\x90\xe6\xcf\xba\xc1\x62\x8d\xab\x76\x68\x03\x85\x3c\x0e\xe9\xed\xc2\xb8\x51\xe9\x9d\x88\xbd\x4a\x1e\x73\x31\x1b\x18\x19\xa4\x92\x51\x99\x3c\x74\x5f\x34\x4a\xd2\x59\x66\x1a\xc2\x3b\x11\xf1\xd5\xa8\xde\xe5\x81\xa7\xae\x3c\x83\xb6\xe4\x23\x7d\xe9\x11\x77\x6b\x05\x9e\xc2\x17\xe6\xe9\x0b\xd8\xcd\x1e\x6c\x6a\xcf\x4c\xc0\x8b\x2c\x0b\x3c\x67\x32\xba\xa2\xad\x58\x07\x25\x67\x11\x3d\x41\xd2\x69\x18\x91\x82\x42\x58\xa7\xd4\x26\xc8\x9b\xcc\x81\xaa\xe7\x17\x34\x05\x42\x2e\x68\x0e\x71\x45\x5a\xe7\x6f\xb5\x21\x24\x8b\x77\x85\x0a\x49\xf0\xc3\x38\x42\x02\x93\xca\xc6\xea\x14\x2a\xed\x40\x67\x6f\x0e\x86\x19\xff\xa6\xef\x2a\x6c\xdc\x83\x97\xa3\xfd\x3d\x56\x3e\xc2\x63\xe3\x22\xfc\xa6\x6b\x24\x69\x04\xb2\x9d\xf3\x8a\xe5\xb5\x52\x76\xe7\xa4\x18\x10\xd3\x7c\xcf\x38\x2b\x20\x5c\xd2\x25\x56\x1d\xbc\xcc\x77\xfb\x4a\xb0\xe4\x4a\x39\x77\xe2\x86\xfc\xc4\x4e\x65\xaf\x21\x39\xd5\xc6\x33\xa9\x8a\x56\x94\x87\x69\x64\x3c\x71\xc3\x34\x22\xed\x25\x15\x61\x1a\xb5\x45\x0b\x6a\xec\x97\x30\x11\xd8\x29\x26\x6b\x68\x73\xe8\x93\xd7\x9d\xa9\xe0\x4c\x9b\x85\xd5\x1e\x86\xd1\x3b\x81\xe6\xd8\x20\x17\x6e\x3a\xa3\xab\x54\x99\x75\xde\x00\x7f\xec\xfb\xc8\x83\x83\x55\x4c\xb9\x1c\xb8\x51\x67\x03\x43\x0a\xf1\xb3\xb9\x44\xc3\x75\x0d\x0c\x3b\x98\xa5\x3a\x2e\xaf\x18\x93\xd1\xc3\x74\x9f\xde\xb3\xec\x8b\xfc\x1e\x26\xab\x44\xd8\xf7\xbf\xd1\xc8\x26\xc6\xbe\xff\xda\x28\x63\x55\x6c\x91\x72\x0a\xe9\xfd\x20\xf6\xe2\x2e\xe5\x3f\xc1\x4d\x2e\x6f\xe2\x7b\x75\xd3\x3e\x77\x9e\x9a\xef\x68\x4c\x64\x4f\xef\x74\x49\xf5\xac\x70\xbf\x49\x89\xf3\x55\x8e\x9d\x00\x4b\xf1\x32\x1e\x7b\x5e\xe0\xe4\x6b\x7f\xd2\x11\x57\x1d\x3a\xf1\xa4\x94\x92\xb4\xcd\xb4\x67\x73\x66\x50\x81\x4f\x04\xe7\xd5\xb6\x65\xa6\x60\xd3\x34\x23\x97\xd2\x99\x56\x99\xaa\x3b\xc3\x87\x4a\x7b\x79\xae\xcb\xf2\x1d\xbb\x17\xd4\xdb\xeb\xbc\x8c\x41\x7c\x0d\xd1\x69\xd9\x22\x63\x1b\x11\x4c\xe6\xf2\xbf\xfd\xfd\x02\xc6\x1b\x7c\x3a\xdb\xdf\x2f\x76\x71\x71\x93\xf2\x89\xc8\xf7\x81\x7c\xb3\x8f\x93\x24\xe5\x37\xc1\x6c\x71\x9d\x17\x09\x2b\x82\x99\x47\xb2\x93\xd5\x9b\xf4\x9b\x0b\xed\x5f\x1a\x80\x4f\xeb\xe2\x3a\xbf\x9f\x94\xe9\x1f\xb2\x1e\x55\xcb\xe4\x3a\xbf\x5f\xe4\xb7\xac\xd8\x64\xf9\x5d\x50\x42\x90\x3c\xdd\x72\x10\x57\x22\x37\x8d\xb9\x3d\x70\xfb\xf9\xf7\x05\xf4\xef\xef\x12\x2f\x75\x38\xb4\xaa\xcb\xb0\x65\x5a\x2c\x44\x2f\x8f\x77\x5f\x86\x17\x9c\x7a\xf3\xbf\x6b\x63\x9c\x7c\x4f\x4a\x3a\x7f\x26\x09\x21\xc4\xd7\x97\x7d\xf9\x9a\x6d\x04\xb6\xc3\x2d\xd2\x9b\xad\xa0\xde\xa7\xb3\xbf\x7b\x24\xa7\xcf\x3f\xd5\x45\xe1\xb1\x3c\x3f\xd9\x27\xd0\xcb\xf6\x3b\x33\x3b\xd4\x33\xb3\xef\x91\xd4\x34\x95\x4d\x95\x76\x09\xe0\xea\xfc\x39\x96\x03\x72\xcf\xd1\x15\xd6\xd1\xfa\x1c\xe1\x97\x70\xe8\xbf\xca\x1d\x9c\x57\x3c\x41\x10\x65\xe9\x75\x96\xc7\x90\x2e\xa7\x39\xf2\xd9\x3e\x76\x26\x05\x97\x2b\x92\x9d\x78\xb1\xc8\xac\x03\xb7\x19\xca\x75\xbc\xfe\x70\x03\xad\x5d\x66\xe9\x9e\x7a\x3a\x71\x85\x5c\x4e\x09\x16\x5d\x47\xcd\xe1\x4f\x3c\xf2\x00\xa4\xad\x80\xb3\x39\x2c\x45\xb7\x1e\x30\x99\x1a\xfa\xb6\x8d\x62\xf3\x40\x0e\xd7\xf9\xfd\x15\x40\xd4\x5b\x96\xa5\x27\x02\xcc\x33\x84\x49\xd1\x90\x2e\x1e\x39\x51\x2e\xd7\xe5\x4c\x06\xd3\x13\xc5\xb8\x64\x05\x54\x83\xdf\x58\x10\x39\x51\xb6\x6c\x88\x82\x6c\xd5\xd7\x53\x3d\x4c\xdb\x2a\xdf\x15\x2f\xd3\x1d\xe3\x65\x9a\xf3\xf2\x48\x05\xac\xb4\x1f\x5d\x2d\x60\x0c\x12\xbe\xa3\xe5\xd3\xa2\x71\x22\x06\x5e\x15\x1e\x26\xfc\x14\x28\xb0\xff\x12\x71\x78\x10\xbb\x59\x7e\xa1\x72\xac\x52\x0f\x1e\xf2\xde\xc3\xcf\xe4\xc3\xde\x4e\xed\x1d\xad\x44\xf7\x96\xcb\x0d\x35\xb0\x69\x05\x26\x31\x7d\x7e\x01\xa0\xfe\x86\x0b\x54\xe8\x36\x8e\xf6\x0d\xc3\x98\xc4\x4d\x83\x71\x83\x14\x0e\xf8\x95\xd1\xd0\xfb\x89\x5d\x7f\x48\x85\x47\xbc\x6f\xf2\x3f\x3c\xe2\xed\x4a\x2f\x22\x7f\x0c\x4c\x20\xcc\x86\x1a\x04\xf9\xb1\x1b\x16\xe1\x67\xe6\xa4\xd6\x92\x13\x25\x49\x5b\x19\xb2\xa8\xae\x7f\x64\x21\xeb\xc4\x49\x64\x92\x1a\xff\xc1\x96\x2c\x80\x57\x43\xbc\x04\xe4\x16\x76\x83\x49\x8e\x59\xeb\xf9\xc2\xe9\xaf\xec\x38\x58\x9e\x0a\x53\xfe\x2b\x0b\x79\x34\x16\x18\x5a\xb0\x86\x6a\x0d\x50\x53\xa6\xa3\x4b\x82\xa7\x1d\xcf\x39\xab\x01\x22\xd0\x72\x34\x59\x87\x2c\x8e\xf0\x74\x8c\xcf\xc9\x97\xf2\xf5\x64\x72\x4e\x7e\x61\xf4\x60\x17\xda\x41\x52\xb7\x69\x99\x5e\xa7\x59\x2a\x1e\x02\x6f\x9b\x26\x09\xe3\x1e\x31\x88\x5d\x47\x2b\x68\xc8\x0f\x8c\x1e\x32\x26\x04\x2b\xae\xf6\xf1\x5a\x22\x6a\x6f\xe6\x91\x4d\xce\xc5\x4f\xb0\x32\x81\xf7\xf1\x6c\xe6\x39\x13\xf8\x0f\xd6\xcb\x33\x69\xec\xe4\x2d\xef\x56\x2c\x01\xab\xed\xe2\x7b\x34\x23\x45\xf8\x2c\x9a\x20\x5e\xd7\x33\x8c\xc7\xa8\x80\x30\x1f\x10\xd3\x23\x10\x2d\x46\xfc\xe7\x90\x93\x1e\xf5\x54\xc6\x5f\x88\xd7\x38\x0f\x66\x44\x45\x41\x9d\xa9\x24\x80\x94\xa2\x62\xe9\x29\x3a\xe3\x05\x06\x03\x79\x56\xb6\x3f\x03\x7e\x6a\x11\x5f\x7c\xbc\x88\xc7\xf4\x19\xf6\x14\x49\x30\x01\x2b\xaa\xb1\x8d\x79\xc2\xc7\x9c\x85\x31\xc4\x46\x4d\x31\x26\xc5\x12\xd9\xda\x4c\xe1\x49\x1b\x20\x45\x93\x33\xaf\xfb\x91\xa9\x7d\x74\xfc\x81\xee\xa2\x2a\x3f\xf6\x7e\x52\x59\x8c\xd5\x77\x10\x02\xfe\xf1\xba\xdb\xa7\x10\x0b\xd2\x2d\xfe\x48\xcd\x41\xf9\xd7\xca\x99\x35\x1b\x15\xbe\x3f\xbb\x00\x0f\xc1\x6a\x4c\x9d\xe5\x83\xcb\x35\x4b\x33\xc4\x42\x4f\x91\x3a\x6f\x2c\x8e\xa1\x5e\x58\xa8\x8f\x26\xf9\xa4\x9a\x94\x93\xe9\x27\x18\xcb\x55\x27\x55\xbb\xce\xff\xee\xc1\x0e\x30\x9b\x24\xa5\x68\xf4\x30\x3d\xa2\x08\x48\x72\x99\xd8\xf7\xbd\x96\xe7\xe8\x84\xaa\xb1\x1f\x78\x64\x34\x27\x05\x26\x39\x4d\x49\x4c\x35\xb3\x5b\x60\x52\xd2\xbf\xd6\x63\x09\x50\x2d\x6b\xda\xcb\x28\x1d\x2f\x62\xea\x49\x9e\xc6\x33\xc9\x0f\x87\xfb\xea\xfb\x69\x5d\x8f\x1e\xa6\x43\xb4\x00\x61\xe5\x1c\x0f\xd8\xbb\xae\x55\x75\x94\xd2\xb8\xae\x47\x0e\xd5\x97\x6c\xb1\x97\xf2\x2c\x3d\x11\x93\x47\x0d\x13\xb2\x0c\x48\xc4\x0a\xb6\x2e\x6f\xd9\x5a\x94\x08\xbb\xc1\x01\xff\xf2\x7c\xa1\x9c\x96\x4a\x65\x0d\x8e\xa1\x10\x31\x05\xfc\xcd\x3a\x7d\x92\x6b\x38\x36\x1b\xb4\xae\x51\x3a\xb4\xeb\x48\x4e\x0a\x12\xe3\xb1\xdc\xd9\xed\x72\x9b\xf0\x90\xca\x9f\xc7\x31\x1f\x64\x8e\xad\x1a\xa4\x00\x75\xca\x39\xda\x86\x75\x59\xaa\x00\x6b\x87\x5c\xe2\x26\xf1\x10\x1c\x8e\x63\xbb\x82\x70\x5c\x9f\xe4\x61\xed\x3d\x5d\xd8\x0a\x7a\x20\xc4\x0c\x5f\x7a\x73\x2f\xe0\x60\x4d\x69\x83\x07\x05\x87\x98\xa7\x3b\x30\x90\x7a\x23\x58\x01\x17\x60\x5d\xae\x4c\x92\xb2\x6a\xd7\xde\x6e\xd2\x2c\xfb\x4e\x77\x43\xde\x66\xec\xfe\xcb\x22\xbf\x33\xd7\x57\xdb\x22\xe5\x1f\xe0\xae\xc5\x9d\xa3\x19\xb9\x29\xd2\xe4\x45\xc1\x62\x73\x7d\x09\xb5\x76\xef\x5e\xf1\xa4\xfb\xe0\x4a\xc4\x85\xfd\xfa\xad\x6a\x44\x5f\x3a\x65\xdf\xe6\x77\xb6\xa0\x04\x9a\xaf\x6c\xa3\x79\xdb\x4f\xc5\x88\xc3\xc5\x7e\x1b\x2b\x8b\xa9\xbb\x34\xc9\xef\xe0\xea\x8f\x37\x90\xcc\x50\x5e\xe5\xf9\x4e\x99\x07\x6b\x92\x18\x1c\x1a\x02\x14\x74\xc0\xb2\x43\x99\x68\x3c\xef\xe9\x63\xfe\xdf\xde\xbd\xe6\x46\x9c\xa0\x51\xa4\x84\xc8\xb2\xa4\xa2\x5f\xda\xe3\x1f\x18\xf2\xab\x73\x68\xba\x41\x15\x9c\xb7\x7f\x66\x10\x92\x27\x56\xc0\x0b\x30\x00\x71\x9e\x9c\xdb\xd2\x09\x3f\xd1\x6e\x56\xdf\xf7\x6e\x98\xf0\x52\xb8\x6c\xd5\x0c\x29\x8d\xb5\x47\xa9\xda\x42\xcb\x34\xc8\x42\x11\x2d\x5a\x91\x18\x45\xb9\x75\xbb\xc6\xb0\x8d\x0c\x5d\xe3\x18\x72\xb3\xcc\x41\x21\x40\x75\x2c\xac\x54\x62\x1b\x8f\x03\x10\x49\x86\x0c\xc4\x84\xdc\xf7\xb9\xc2\xff\xe6\xcd\x88\xd2\xbc\xae\xe5\x98\xf8\x98\xa6\xb2\x9a\xa3\x28\x57\x65\x1b\xe5\x0a\x1f\xb3\xd6\x75\x0d\xc7\x70\x49\x3e\xc1\x44\xc2\xc6\xe5\x6c\x59\x6b\x89\x51\x90\x1c\x0d\xf5\x52\xbe\x65\x45\x2a\xb7\xa3\x9c\x88\xb2\x37\x11\x14\xf4\x2d\xb1\xf6\xae\x86\xc4\x1b\x75\x8d\xaa\x65\x26\x9f\xb4\xf6\xea\x84\x63\x98\x1c\xca\x21\x67\xc8\xfa\x28\xde\x71\x27\x0c\x98\x5e\x51\x43\xfa\xdb\x65\x75\xd7\x11\x3d\xbe\x90\xb8\xb3\x6a\xee\x62\xcd\x20\x61\x9d\xed\xbe\x8a\xfd\x68\x11\x3c\x84\x60\x2a\x76\x71\xa6\xe3\x42\x0a\x89\xcc\x7e\x60\x2a\x42\x2a\x64\x5e\x55\xd1\xa5\x78\x5d\xf3\x25\xca\x5d\xb4\x96\x62\x02\x61\xe9\x79\x5d\xa7\xe5\x6b\x89\x81\x18\xca\xf1\x32\xaf\xeb\x59\x90\xe2\x20\x75\x44\x71\xa1\xa7\x58\x54\x8f\x68\x7e\xa4\x93\xb0\xad\xc2\x07\x67\x2c\x55\x44\x8f\x30\x94\x4e\xdf\x6f\xd3\x08\xff\x4b\x4f\xd1\x71\xc8\x35\x13\x0f\x6e\x18\xb3\xc3\xab\x2f\xe4\x8a\xa7\xfc\xa6\x2d\x82\xb0\x3a\xaf\x2e\x81\xb6\x56\x72\xf5\x7e\x92\x57\xbf\x74\x02\x5b\xea\xe5\xb1\x65\x1a\xfc\x78\x5c\x6e\x45\x98\x73\x3a\x7a\x98\x76\xce\x40\x92\x92\xb5\x1c\xa6\x9c\x76\x7b\x3c\x26\x31\x45\xf9\x7f\x43\xb1\x53\x49\xa1\xf9\xf2\x9f\xba\x4f\x24\x96\x7c\x8b\x8d\x1d\x1c\x43\xd4\x4d\x54\x4e\xe8\x20\x0b\x52\x1d\x13\xf4\xca\x61\x41\xdc\xa5\x0e\xab\x08\x4f\x74\x2b\x86\x74\xa9\xf6\x81\x47\x21\x25\x08\xa0\x5a\x36\x56\x0e\x40\xc5\x93\x73\xd9\x55\x30\xd7\x57\x92\xb8\x2a\x02\xeb\x2c\x33\xac\x0a\x63\xf2\x0f\x86\x66\x44\x90\xd2\xa8\x07\x0d\x44\x38\x82\x08\xfa\x84\xa1\x96\x3d\x68\x4f\x9f\x64\x80\x9c\x69\x4e\xc3\x19\x85\xa2\x6c\x6d\x6d\x2d\xb4\x0c\x82\x84\x3c\xe5\x4d\x00\x0e\x0e\xed\x37\xc1\xac\x19\x80\x89\xc7\x2b\x69\xb0\x26\xea\x8e\xb9\xa5\x92\xf3\x78\x1e\x31\xa2\x1d\xcf\x23\x5a\xe0\xa3\x99\x4b\xa7\x1d\xe0\xe7\x9d\x2d\x92\x8e\xf3\x88\x1e\x94\x56\x75\x58\x71\x4c\x67\x2a\x59\xe2\x40\x2e\x45\xb6\x64\xc6\xe9\xf6\xcc\xc3\x81\x3c\xae\x89\x8b\x8f\x41\x19\xc4\xc3\x54\x32\xb8\x22\x92\xf5\xab\xa8\x80\x45\x28\x26\xcf\xe0\xd7\xc9\x84\xdf\x34\x2e\xb3\x9e\x9a\x60\x82\x6d\xe7\x24\x36\xa4\xff\x80\x9c\x17\x1d\x35\x6f\x1f\x11\x9e\x56\xe1\x1c\x8b\x81\x49\xac\x8e\x2d\xfd\x98\xeb\x6a\xd4\x2d\x1f\x6c\xf3\x3b\xc7\x17\xe9\x22\x1e\x8f\x71\x1e\x8a\x30\x8e\x22\x0b\x6b\x02\xce\x05\x92\x86\xd9\x34\x2c\x4d\x3f\xde\x31\x5f\xba\xd1\x07\x21\x89\xa4\x09\x83\xd8\x80\x11\xe6\x71\xcc\x62\x50\x3d\x20\x74\x35\x7d\x77\xc7\x18\xa7\x4c\xe0\x53\xbe\x04\x4c\x10\x89\x2d\x07\x1c\xb6\xe5\x3a\x2b\xc5\x4c\xc6\x76\xc6\xeb\x63\x5f\xe4\x7b\xca\x8d\xc5\x62\x99\xf2\x1b\x9a\x4a\xec\xaf\xae\xdb\xb8\x3f\xca\x66\x12\x22\x2d\x95\x54\x18\x0b\xf6\xb8\x10\x46\x8f\x7a\x47\x8d\x27\x86\x31\x70\x67\x3c\xa1\x85\xba\x84\xd0\x88\x79\x8f\xb0\xf2\x96\xb0\x36\x64\x5d\x15\xc7\x36\xf9\x8a\x0b\xdd\x6b\xaa\x64\xba\x6b\x41\x85\x69\x9c\xab\x42\x69\x69\x9d\xba\xfb\x8d\xed\x7e\xfb\xbe\x21\x45\x75\x9c\x72\x08\x4c\x7d\x1f\x6f\xcc\x9d\x80\x69\x52\x29\x56\x54\x67\x53\xce\x4b\x2a\xb1\x8d\x9a\xb3\xd0\x99\xcb\xc8\xa8\x53\xfb\x1f\x3e\x65\x64\x46\xe6\xc3\xef\xb4\x5d\x80\xaa\xd5\xa8\x63\xf3\x3b\x8a\xcc\xac\x4e\xda\xd9\xc7\x4f\xc5\xb8\xbd\xeb\xd6\x57\x0a\xb6\xd7\xaa\x38\xf7\x51\x6b\x28\xa7\x5c\x4c\x4d\xfd\x26\x87\xb9\xef\x73\xb9\xc3\x96\xdc\x46\x19\x39\x35\xa9\xf6\xbd\xca\x3d\xd3\x60\x38\x29\x38\x80\xe9\x9e\x22\x08\x72\x2b\xa1\x07\xeb\x16\xd6\xa3\xc7\x26\x6a\xb8\x09\x8b\x03\x2c\xab\xec\xa8\xa3\xbe\x37\x26\xf8\xf2\x71\xc8\xd4\x3a\x99\x08\x3e\xba\xb0\x22\x04\xfa\xdd\xb2\x5b\x34\x40\x96\x36\xa8\x29\x50\xcf\x89\x07\x71\x75\xd5\xe1\x6f\x04\x39\x28\x24\x42\xee\x7b\x11\x5e\x4d\x37\xf7\x30\x91\xb6\xf6\xa3\x27\x88\xe1\x60\xb0\xe3\x23\x07\x95\x3d\xd6\xef\x9f\x99\xca\x15\xb8\xc7\xfd\xbe\x03\xff\x7e\x17\x58\x04\xd2\x19\x00\xbc\x1b\x33\xd8\x6e\x40\xe8\x34\x6f\xf0\x2e\xdf\x77\xc0\x5b\x3f\x06\xa2\x77\xe8\x8f\xaf\xd7\x69\xb9\xc7\xe0\x41\xc7\x66\x6d\xa8\x53\xc6\x81\x05\x90\xc8\x41\x9e\x77\xe2\x53\x16\x68\xe5\x9d\xa4\x4a\xc7\xef\xa6\x9f\x4c\x14\x33\x91\x97\x88\x3d\x85\xcb\xef\xdf\xe0\xf3\x67\x8e\x17\xa1\x07\xdf\x7a\xb2\xa9\xcd\x3d\x3d\x3a\xa7\x12\xbb\x18\xf4\xa0\xb2\x46\x08\x41\xb8\x20\x85\x20\xa9\x20\xb9\x50\xe1\xb4\x54\xd0\xcc\xba\xdc\xe6\x77\xf5\x36\x4d\x18\x7e\x72\x4e\x62\x41\xcf\xdb\x10\xcb\x4f\x9c\x70\x59\xa5\x40\xf8\x00\xfe\x80\x60\xe7\xfd\x6a\xaa\x64\x75\xbe\x7f\x39\x2d\xd8\xef\x15\x2b\xc5\x0b\x73\x48\x7d\x5d\xc4\x3b\xb6\x3c\xf1\x1c\x95\x02\x07\x9d\x4c\x45\xa5\xee\x2f\x38\x32\xdc\xc6\x19\x56\xb7\x22\x5d\x7f\x40\xd8\x09\xbf\x54\x89\x96\x1d\x38\x99\xd3\xca\x98\xa3\x37\x98\x08\x41\x5b\x8f\xb4\xb6\x9a\x4c\x74\xe3\x5c\xcf\x48\x4a\x0f\x8a\x79\x0e\x98\x52\x85\x0a\x25\xca\x5b\x14\x17\x1f\x2f\x8a\x31\x7d\x36\x11\x38\x0d\x0d\x3d\x1e\x23\x4e\x39\x0b\x8b\x08\x47\x34\x0d\x1d\x69\x58\x44\xdd\xe4\xef\x28\x9d\xea\xf3\x2d\x4d\xb5\xa6\x4f\x12\xcf\xb6\x1f\x6b\x31\x64\x65\x89\x36\x62\x2a\x24\x79\x63\xff\x7f\xde\xde\xb7\xb9\x71\x1b\xdb\x13\x7e\xff\x7c\x0a\x0b\x4f\x2f\x0b\x68\xc1\xb2\xdc\x49\x6d\xdd\xa5\x1a\x97\x95\xe9\x74\x4f\x32\x95\x4e\x67\x62\x27\xd3\x53\x6a\x4e\x8a\x96\x20\x9b\x09\x45\x6a\x40\x48\xb6\xc7\xd4\x77\xdf\xc2\x39\x00\x08\x50\x74\x27\x73\xef\xee\xbe\xe8\xb6\x08\x82\x20\x88\x3f\x07\x07\x07\xe7\xfc\x7e\x0a\xf7\x20\xcb\x9c\xcd\x56\x4d\xbd\x2a\x74\x74\x8b\xbc\x24\xb9\x51\x7d\xe7\xbc\x10\xa5\x5b\x8f\x9b\xd7\x85\xf3\x51\x43\x87\x4c\xeb\x1b\xcb\x35\x97\xde\xe4\xa8\xfa\x2a\x6c\x34\x6d\x78\xd0\x18\x85\x6d\x8e\x8d\x19\x50\xd6\x61\x22\x40\xfa\x0e\xd1\xc0\x67\x48\x6d\x1a\xb6\xbd\x03\xa7\x80\x59\x71\x34\xdb\xe7\xf8\x38\xb2\x70\x7b\x8b\xcb\x85\xf7\x9b\x11\x5a\x77\x9d\xe9\x58\xae\x43\x7b\x5e\x85\xa2\xdc\x74\xf0\xb4\xf2\x6b\xc2\xb9\x64\xbc\x16\x97\xe7\x54\x5f\xf4\x89\x60\xbc\xc3\x6a\x57\xd8\x3c\xed\xd0\xbb\xd0\xa5\x2f\x55\x3e\x53\xfb\x9a\xd6\x3d\x32\x78\xc0\x9d\x48\x1b\xbe\xac\x78\xcd\xcd\x86\xac\x7e\x7d\x99\x24\x65\xa6\x53\x5a\x76\xdd\x69\xa6\x4b\x3e\xcf\x19\x6f\x87\x40\xe7\x55\xce\xf8\xe4\x92\x1d\x79\x25\x5a\x0f\x37\x8e\x61\xe8\x0d\xdf\x81\xb5\x22\x74\x3b\x37\x5b\x97\x9d\x0e\xd2\x26\x73\xfe\x64\x1d\x10\xdf\x82\x00\x49\x4d\x2e\xfc\x75\xa2\x8c\x1c\xb9\x66\xdc\x85\xea\xd9\x7d\x71\x29\xdb\x54\xfa\xc4\x0f\xb8\xde\xa5\x9a\xfb\xc6\x4c\x7d\x73\xbb\xe6\x4b\xb5\x6f\x49\x8e\xad\x94\x2e\x73\x6e\x21\x05\xcd\xf5\x28\x6d\x80\x55\xc1\x68\xc3\x2b\xb3\xac\x62\xd4\x0c\xfe\x9c\x45\x5f\x00\x27\x16\xf6\x06\x7e\x80\x6f\x7b\xdf\x5b\x96\xa9\x81\xd7\x18\xa9\x38\x12\x9c\x66\x54\x6d\x99\x0d\xba\x37\x05\x5d\xb5\x08\xc3\xdf\x17\xe8\x4b\x3b\x99\x2f\xf4\xeb\x1a\xf4\x6d\xdf\xf5\x1a\xbb\xfe\xb2\xf7\x17\xce\xe8\x1f\xef\x58\x2e\x73\xc6\xd2\x36\xa4\x04\x75\xc9\x6e\xd9\xe7\x2b\x51\xc1\x42\x80\xb5\x98\x7c\x06\x2b\x02\x32\xd4\x68\xd3\x2c\x37\xb4\x14\x7a\xa9\xc4\x47\x5a\xb3\x9c\x37\x02\x00\x45\x63\x0d\xbc\x41\x53\x4f\xb3\xbc\x74\x19\x44\xb3\x34\x15\xad\x2d\x25\x9a\x91\x46\xa2\xe1\x9e\x8b\xa8\xce\x87\xd6\x0c\x05\xe6\x0b\xdc\xcf\x80\x05\x83\xf9\x3a\x34\xa2\xb0\xee\xa3\xb4\x61\x7d\x19\x2a\xe7\x0d\xc3\x4a\x76\x1d\xb5\x2f\xad\x73\xae\xcd\xaf\xd2\x3a\xe9\x6a\xf3\xe2\xf2\x48\x57\xa3\x9d\xcf\xfc\x0c\x84\xb3\x90\x48\xaa\x98\xb9\x08\xc2\xa9\xe2\x0d\x77\x8f\x7b\x19\xb5\xa5\x35\x84\x86\x32\xd8\xf8\x84\x54\x0b\x15\xae\xf5\xf6\x75\x70\x83\x41\x56\x81\x4f\x00\x4a\x11\xf0\x7e\xd4\x3d\x13\xe9\xb6\xd8\xd1\x15\x5f\x69\x5e\x31\xbe\xa5\xae\xaa\xa0\x3a\x26\x49\x78\x89\x55\x6a\x4c\xbe\xaa\x67\x24\xb6\x39\xdc\xb5\x25\x26\xb6\xa9\xe6\xb7\xab\x8e\x23\xf4\xb3\x54\xc5\x36\xd5\xfc\xf6\xf2\xd2\xa6\xe1\x95\x5f\xe9\xb6\x21\x58\xdd\x9e\x3b\x99\x51\xd4\xe5\x36\xad\x38\x12\x44\x84\x9f\x7c\x64\x8c\x57\xc7\xab\x99\x5f\x5a\xfb\x78\x96\x8d\xe6\x4f\x6e\x95\x48\x9f\xc8\x4b\x92\x2e\xc7\x66\x30\xee\x53\xfa\x69\x8e\x31\xf2\x4e\x2c\x4a\x5a\x5b\x9d\x8a\xf7\x16\x07\x0e\x73\x34\x3f\x72\x5b\xfc\x40\x32\x00\xd6\x85\xa5\x74\xc0\xe5\x29\x95\x42\x7a\x2c\xab\x45\x80\xf0\x0e\xd2\x5a\x0e\xc5\x74\x2d\x60\xd4\x85\xab\x5c\x9d\x8b\xf8\x12\x80\x80\xe2\x24\x4f\xa3\xa2\xd1\x6b\xd6\x0e\xb0\xf8\xb3\x9f\xf1\xed\xe7\x1b\x77\x7c\x57\xc2\x59\xaa\x33\xa2\x99\x2b\xbe\x43\x68\x91\xb5\xd9\x19\xdf\x39\xeb\x2f\xbf\x8d\x8c\xc7\x05\x6c\x89\x0f\x1e\x0a\x90\x6c\x1e\x8c\x3e\x45\xf0\x73\xc1\xff\xbb\xc6\x2e\xeb\x3a\x0b\xb3\x09\x13\x33\xe6\x8f\x01\x02\x1c\x36\xdb\xd7\x90\xba\x4e\x12\x5a\xf8\x0b\x31\xe7\xad\x99\xa1\x9e\xd6\x85\x87\x17\xe1\x12\xdb\x3f\xd3\x75\x2d\x65\x40\x3c\xeb\x52\xa6\x53\xbe\x1b\x59\xb2\xc7\xd2\xfa\x87\xce\xcf\x79\xcf\x87\x03\x75\xb4\x5d\xd6\x75\x45\xcc\x33\x63\xb9\x13\x9d\x30\xcb\x79\xe3\x31\xe5\x60\xe9\x77\xbe\x45\x70\xcb\xec\x81\x09\x2a\x9f\x60\x9b\xe3\xa5\x10\x82\xde\x66\xc4\x28\xa1\x24\x25\xd8\x80\xf0\x1c\xfe\x9e\x08\xb3\x1d\x9f\x1c\x02\x98\x85\x83\x91\x68\xab\xa6\xd6\x65\xbd\x97\x8b\x5b\x31\x99\x1f\xd7\x46\x16\x1d\x92\xc4\xdc\x32\x9b\x77\x67\x5c\x50\xec\x58\x6e\x28\xdd\x8b\x11\x4e\x35\x06\xbb\x91\x38\x75\xcd\x7a\xdf\xfd\xcd\x90\x09\x2d\x49\x68\x3d\x73\x2e\x45\x62\x79\xe7\x7f\xf3\xfe\xe7\xc7\xe0\xf7\xdf\x73\x6e\x7b\xbd\x82\xba\x39\x48\x7c\x60\x0d\xe8\x47\x4d\x6f\x5c\xed\xf1\xfa\xe9\x6a\x84\xef\x02\x9e\xcb\x56\xa2\x4a\x69\x85\xd8\xd5\x40\x80\x39\xa4\xce\xe8\xba\x8a\x8f\x3d\xce\xf1\x21\xc6\x18\xa7\xc1\x19\xde\xaa\xeb\xec\xd5\x39\x1e\xb8\x9b\x34\xdc\x93\x4d\x44\x35\xca\xc0\xb1\xa9\x9a\x02\x60\x48\xe0\x2c\x64\x87\x22\x31\x18\x45\x77\x3d\xf8\xff\x91\xd9\x36\xa8\x80\x4e\xc2\xdf\xe1\x95\xf0\xe5\xae\x32\x42\xd2\x15\x63\xbc\x7f\x2e\xae\x91\x11\xe8\xbe\x55\x93\x84\xf6\x4d\x2c\xbc\xd7\xc0\xd8\x68\x0e\xf2\xf5\xcf\x43\x70\x5e\xdf\x5f\xe1\x9d\xcb\xf0\xce\xdf\xc3\x3b\xaf\xf2\x23\xf0\x2f\x4c\x2e\xf9\x9a\x99\x8f\x3e\x64\xee\xcd\x65\x7d\x76\x48\x12\x7a\x2b\x0e\x76\x57\xc4\xd2\x43\xc8\x4f\xe5\xa4\x02\x7f\x72\x8e\x0d\xa6\x51\x9a\x24\xa1\xee\x01\x31\xb9\x65\xfc\x36\x49\x82\x4e\x3d\x6d\x53\x3f\x2c\x6f\xbb\xce\x76\x24\x0f\x01\xdc\x9c\xec\xe1\xeb\x80\x16\x44\x71\x33\x31\x18\xd6\x7d\xa5\xe9\x6d\x66\x26\x48\x3a\xe7\x8a\xef\x18\x87\xe2\x0e\xe6\x63\xcc\xec\xd9\x5b\x0b\xca\x2d\x84\xaf\xc9\x7a\xed\x53\xec\x5f\x31\x67\xec\x98\xf7\x52\x76\xc8\xa6\x95\xc5\x3b\x07\x27\x99\x25\x4b\xe3\x1b\xa0\xf2\x49\xeb\x4a\xdc\xee\xa4\x5c\x8f\xfb\xa7\x0a\x99\x24\xa7\x71\xcc\x59\xac\x45\xa7\x4f\x6e\xdd\x4d\xeb\xae\x9b\xd4\x49\xa2\xbb\x6e\x0b\xbe\xe2\xb2\xd7\x73\xa5\xd3\xa4\xf1\xbe\x4e\x92\xc9\x16\xdc\x3a\x75\x40\x59\xbe\x79\x98\x35\x9b\x4d\xa6\xbc\x4e\x2c\xe6\x69\x7f\x4a\x66\xdf\xdf\xdf\x05\xc6\x13\x77\x61\x5a\x12\xf7\xdd\xe6\x7b\xda\xb0\x90\x20\x79\xd9\x27\xe7\xe9\x78\x16\xaf\xe2\xbb\x13\x3b\x85\x42\x38\x49\x00\xc5\x4b\xf9\xf5\xc4\xfe\x02\xe6\x2b\xc6\xd5\xac\xa9\xd6\x42\x79\x25\x84\xf7\x3f\xc3\x55\x62\x4b\x21\x23\x4b\x12\xf8\xdb\x1b\xc2\x4c\x09\xf6\x3d\x03\x8e\x29\x9b\xce\x8e\x5c\x1d\x63\x6b\xf3\xa6\x58\xcb\xeb\xe6\xf9\xa0\x7a\xd0\x32\xac\xe3\x7d\x21\x19\x08\x0f\x7f\xf0\xcd\xe7\x8e\x4b\xc3\x0c\x35\xb3\xa5\x04\x6d\x46\x52\x7f\x8e\xae\x8f\x88\x90\xcb\x8e\xdc\xde\x3b\x01\xcf\xb5\x87\x7c\xe2\x54\xb6\x03\x4f\x1b\xb4\xa8\x3d\x47\x0c\xe9\xd0\x9d\x71\x75\x63\x71\x08\xc3\x11\x65\x1e\x65\x0b\xb3\xf3\x0b\x81\x0a\x37\x65\x5d\xb6\x77\x04\x1d\x1b\x8c\xa6\x49\x27\x73\xe6\x87\x4e\x31\xc3\xfb\xa2\xe0\x66\xa9\x42\xc2\x43\x68\xb5\x80\x37\xb0\xb0\x46\x4d\x6c\x5a\x7b\x9f\x17\x6c\xb8\xef\x29\xb9\xec\xdd\x8b\xc6\x3c\xc3\x4d\x76\xe7\xaf\x8b\x57\x5c\xd3\xc6\xd7\xe6\x04\x15\xbc\x84\x00\x68\xa3\x98\x99\xc5\xd6\xf3\x6d\x59\xbb\x28\x56\xa7\xec\x99\xc1\xc6\x43\xf7\xb1\xc1\x26\x73\x1b\x62\x32\x81\x93\xe2\x88\x90\x8b\x9b\x61\x0c\xca\x6c\x1b\xc1\x5a\xdb\xd0\x40\x05\x2c\x8e\xe6\x7f\xa8\x72\x92\x14\x54\x41\x94\x8c\x07\xb2\x84\xa3\x51\x35\x92\x51\xf7\x5e\xd8\xee\x21\xb4\xd3\xd4\x1e\xdc\xf1\xfc\x7c\xc1\x6a\xf3\x88\xd1\x5b\x27\x0e\x87\xc2\xd7\x14\x6e\x41\x5d\x51\x9b\xa0\x90\x60\x46\x15\x76\x66\xc3\xb8\x34\xa2\xbd\x76\x30\xa0\x9a\x5f\x32\xb6\x98\xc8\x24\x69\x8c\x36\x11\x4d\x88\x92\x21\xe6\x99\xe9\xf1\xbe\xdb\x8a\x1e\x37\x7e\x82\x4e\x93\x10\xf8\x88\xb3\xf3\xf9\x26\xe5\x3a\x6c\x2a\x5e\x0b\xbd\x2c\x1c\x53\x5e\xce\x55\x70\x89\xad\x9c\x43\xb8\xa2\x6d\xe6\x46\xd4\x59\xdd\x6f\x88\xa1\x51\xdc\x50\x9c\xcc\x79\x44\x5c\x57\x40\xdf\x2a\x33\xf1\xb1\x59\xf1\x6f\x10\x33\x3e\x01\x16\x36\x6f\x4e\x92\xa6\x4d\xcb\xa5\xc4\x36\xb5\xd1\x62\x49\x02\x29\x28\x79\xf0\x33\x21\xa1\x6f\x4a\x53\x4a\xe9\x9a\x51\x42\x33\x9a\x7a\x49\x31\x5f\xc8\xd7\xcd\x42\xc2\x31\x98\xcc\x4d\x9f\xc8\xdc\x56\x36\xba\x08\x64\x92\xf7\x4a\xb7\xb7\x8e\x2c\x3a\xf3\xb6\x5a\x24\x47\x3d\x91\xa3\x06\x19\x9d\x7c\x07\x02\x62\x53\x2f\x55\xbe\xb0\x7f\x9f\xa3\x04\xb7\x76\xe8\xae\x1b\x63\x0e\x2a\xc7\x5d\xe7\x71\x56\x3b\xe9\x55\x69\xaa\xb0\x25\x91\x99\x35\x38\x93\x6c\xab\x72\x2d\xbf\x6e\xee\xeb\xb4\xd2\x56\xb7\x65\x1c\x12\x7f\xda\x41\x12\xd4\xdf\x26\x5d\x23\xa7\x91\x49\xb6\x9f\xc9\xb8\x91\xb7\xdf\xd6\xbd\xa3\x11\x96\x71\x84\xf4\x0f\x7b\x1d\xdc\x80\x92\xf0\x86\x2d\xa8\xbf\x67\x8b\x3b\x1e\x07\x0d\x75\x1a\x55\x13\x35\x4d\xf4\x95\x2a\xfa\x3c\x1c\x8d\x62\x99\xf7\xa6\xdb\x13\x59\xcb\xd1\x8e\xe3\x32\xe3\x58\x0d\x4d\xb4\x0b\xfd\xba\x0e\xb1\x5a\xa9\x14\x10\xbe\x41\x6d\x1c\xc7\x04\xfa\xa5\x9f\xa0\xe7\xe7\xfc\x92\x2d\x6a\xbf\x27\xb1\x46\xef\x66\x47\xc1\xf6\x6b\xed\xc0\xc1\x16\x5b\xc4\x27\x18\x58\x0f\xa7\x8b\x38\x9b\x79\xa1\x00\xaf\x2b\xb2\x48\x8b\xcb\x2f\x82\xdb\xe1\x97\xd5\x00\x83\xa2\xcd\x5c\x6b\x35\x65\xee\x41\x30\x48\x44\xd9\xd0\xbb\x9e\x07\xcb\xbc\x78\x6a\xab\xe6\x3e\xfd\x9f\xf3\x39\xdf\x14\xad\x4e\x5f\xcd\xe7\xbd\x81\xff\xcb\xf9\xdc\x2e\xb5\x6b\x69\x94\x61\x5f\x96\xe2\xfd\x19\x82\x02\xad\x01\x10\xde\x7b\xf5\x22\xef\x3a\xd5\x53\x29\xf2\x40\xc2\x4b\x3e\x66\x08\x88\x2c\xe9\x66\x18\x2c\xf4\x49\xf5\xdf\xa0\xc3\x8f\xcb\x55\x63\xe0\xa7\x1a\x71\xfc\xb6\xe8\x84\xbc\x1c\xb9\x87\x60\x35\xe4\x77\x68\xa9\xf0\x94\x0e\xa2\xfe\x11\x01\x51\x10\xc7\xa1\x45\x1c\x3b\xd4\x87\x5a\x80\xc3\x91\xd2\x48\x0e\x80\xbc\x38\x08\x6d\x2f\xd7\xa2\xd4\x16\x18\x47\xae\x39\xfd\x4c\x2d\x19\x3e\x2d\x88\x26\xfd\xcb\x90\xdc\x8a\x3f\xce\xe0\xc7\xcf\x2e\x83\xe8\xdf\x06\x07\x2a\x3b\xcd\xd7\x5a\x58\x44\xf5\x42\x6b\xf5\x0d\x04\x6c\x2f\x22\xf5\xc8\xa4\x7f\xf6\x34\xfe\x0a\x1e\x7d\xf6\xb8\x9b\xf7\xa8\x2c\xff\x06\x3f\x68\xff\xd0\x73\xf4\xbc\x27\xf5\x8a\x3d\x01\xfa\xed\xae\x59\xb8\xbf\x98\x00\x27\xc7\x7f\xe0\x9f\x57\xe6\x0f\x3b\xc1\xf1\x17\x11\xcf\x93\x87\xa3\xc8\xae\xc0\x24\xea\x8e\xf8\xa9\xe5\xf7\x88\x10\x10\xba\x8e\x1a\xe9\x0c\x6d\x88\xc7\xce\x83\x28\x4e\x38\x31\x0f\x90\xeb\x8d\x60\x76\xfa\x40\xb6\x73\x94\xc8\x2c\x26\xa3\x07\x31\x2e\xea\x0c\xd2\xa2\x36\x01\x22\xfb\xb2\xf7\x3c\x2b\x43\x17\x3c\x25\x4a\xef\x79\xa6\x19\xcb\x54\x4a\x07\xfc\x65\x9a\xd7\x53\x42\x98\xf9\x9c\xb2\xf7\x08\x2b\xdd\x6e\x19\x8b\x70\xfc\xc5\xa6\x00\xbb\xff\x87\xc9\x5a\xd6\x6b\xf8\x50\x7b\xd3\x72\xc8\x83\x7e\xeb\x3e\x3f\x45\xc0\xff\xa7\xa1\xdf\x13\x3a\xfc\x86\xc3\x32\x49\xec\x60\x45\xca\x59\x70\xdd\x75\x23\xdb\x4e\x6e\x69\x47\x6c\xcf\xbd\x31\xc2\xe7\x66\x69\x96\x6d\x5e\x51\x23\xd2\xd6\x33\x83\x6f\x78\x72\x06\x31\x88\xde\xc6\x57\x6e\x68\x79\x42\x1e\x6f\x63\x0a\x44\xb9\x54\xd3\x69\xce\x4e\xb1\x86\x70\x15\xd9\x9d\x1c\xc3\x86\xab\x0f\xa8\xd4\x3a\x1b\xf4\x64\xcd\xd2\xc1\x37\xd5\x68\xa5\xf4\x28\x82\xa7\x23\x07\xc9\x5c\x6d\x9d\x2f\x3e\xdd\x4f\x2f\x6e\xd9\x88\x64\x2c\xc4\x5a\x5b\x27\x40\xdf\x6d\x0b\x48\xfa\x4c\x28\xe5\x60\xe8\x7a\x77\x1e\x18\xe3\x6b\xbd\x6c\x72\x0e\xff\x23\x41\x0e\x0c\x98\xc2\x96\x92\x35\x30\x52\xec\xfd\x92\x71\xe5\x62\x49\xef\xec\x79\x2d\xf4\x6d\x87\x92\xad\x73\xd8\x1c\x9d\x85\x8a\x7e\x71\x51\xf2\x5b\x9b\xb1\xe8\xcc\x1d\x93\x14\x50\xb5\x05\xe1\x5c\x54\x86\x0c\x03\x39\xb3\x11\x99\x67\x24\x38\x25\x7d\xd4\x11\x40\x55\x38\xa9\xad\xc3\x49\xc8\xfb\x57\x15\x2d\x60\xb8\x93\xc0\xad\x7a\x1b\x96\x10\x1f\x68\x48\x96\xc9\x74\x2c\xd0\x3b\xae\x58\x4c\x14\xb9\x8b\xb0\x9a\x47\x85\x29\x9e\xff\x7f\x5e\x98\xc6\x90\xcf\xbf\x23\x4c\x83\x98\xc8\x25\x16\xff\xae\x7c\x80\x33\x2d\x99\x0f\x85\xea\x49\xfd\xfe\x6b\x42\xf5\xec\x19\x19\x69\x16\x1b\x57\x01\x33\x2c\x35\x6c\x03\x02\x67\x9d\x3c\x12\x80\x7f\x5c\xc0\x49\xf0\xa2\xfd\x83\xc2\x4c\x02\xa6\xb4\x7f\x6b\xfa\xa4\x8b\x1b\x74\xce\x1e\xf7\x60\x19\x08\x3d\xa2\x8b\x1b\x70\x0e\x0e\xe0\x0d\x32\x1f\x66\xa5\xf9\xe5\x9c\xa5\x77\xda\xc1\x43\x3a\x28\x1b\xd6\x75\xb7\xa7\x89\x00\xb5\xa7\xe4\x26\x9b\xa7\xe7\x97\x46\x5e\xd9\xd6\x49\x9f\xc8\xa6\x51\x24\x25\x77\x7a\x5b\xbd\x6b\x14\xe1\x76\x7c\xa6\xf8\xd7\x3c\x4c\x4c\xd7\x45\x4a\x03\x2c\x32\x81\x6f\x88\xd3\x25\x9e\xf9\x2c\x19\xc6\xd6\x87\xe1\xd8\x11\xc4\x44\x88\x2f\xe1\x4a\x84\xd6\xe2\xa8\x0d\x0e\xbd\x4e\x46\xca\xd6\x49\x42\xf5\xe0\xe1\x3f\xfa\x96\xc1\x5e\xc9\xf6\x14\xe1\x44\xc9\x62\xfd\xa1\xae\x1e\x09\x27\xdb\xe2\xe1\x3b\x98\x20\xa6\x99\x64\x55\xd9\xf0\x2a\x7b\xf5\x83\x75\x6e\xe0\x44\x35\xf7\x57\xbb\xa2\x36\xe9\x4d\x65\x7f\xed\x5b\xf9\xbe\xd8\x11\x4e\x36\xaa\xd8\xca\x3f\x59\x9f\x55\x17\x6e\xf1\x76\x8d\x60\xe1\xe1\x7e\xcc\xa8\x27\x7e\x10\x03\x3e\x4b\xb4\xd2\xc3\x06\x73\xe8\xd1\x58\xac\xd7\x6f\x4c\xbf\x05\xa6\x1f\xb7\xa3\x88\x03\x4f\xe1\x3c\x78\x4b\x7b\x08\xe8\x91\x29\x6d\x14\x7f\xcb\xdb\xea\xca\xa5\x3a\x42\x4d\x7b\xb4\x1b\x71\x66\x91\xd2\xa8\x14\x5b\x38\x2a\x70\x82\xc4\x2d\x67\x20\x14\xf6\x96\x49\xaf\x14\x8f\x1a\xc3\x09\x01\x04\x31\x38\x2f\x20\x67\x64\x7a\xd0\xb4\x64\x53\x23\x60\x9f\x8a\x80\x14\x4f\x2e\x0b\xf3\xb8\xea\xfd\xe5\xcf\xc8\xb4\x81\x7c\x80\xce\xa0\xa6\x02\xaf\x16\xa5\x99\x8b\xad\x38\x68\xaa\x90\xf5\xbc\x1d\x91\xbf\xbc\xf5\x60\x5e\x88\x19\x62\x23\x15\xff\x6f\x34\x5e\x50\xf4\xef\xb4\xdf\xe4\x44\x12\x47\xdb\x49\x23\x17\x5c\xfd\x09\xf9\x7f\xd4\xe2\x78\x79\x7e\xf9\x7a\xac\xe9\x99\x12\xca\x23\xa5\xf9\x64\xfe\xdf\xe8\x07\xdc\x6f\x0f\xfa\xa1\x74\x8a\x86\x8f\xe8\x28\x79\x11\xf8\x12\x8b\xa6\xeb\xe2\x55\xb3\xf4\x91\x42\x27\xa6\x09\x9d\x24\x45\xa6\xd1\xd4\xe8\xc7\x75\x69\x0d\x13\x61\x5f\x95\x2c\xdd\xd2\x92\x65\x9f\xef\xdd\xa0\xc2\xb4\x1c\xed\x5d\x40\x03\x67\xc7\x67\x28\x93\xc3\x48\x62\xf0\xd1\x78\xb2\x7b\x7f\x67\x68\x36\x1d\x5c\x32\xdb\x2d\x52\x28\xa4\x42\xa9\x67\x77\x45\x8b\x6f\x95\x2c\xab\xa3\x8a\x4b\x96\xd6\xfd\xa7\x49\xcb\x7e\xe3\xd7\xb5\x12\xdc\xf9\xb1\x55\x30\x9c\xc5\x0c\x23\x3f\x16\x93\x24\xa0\x8b\x23\xbf\xfc\xe2\x17\x82\x5f\x7e\x21\xdc\x61\x95\xb6\x91\x8e\x73\x92\xe4\xbb\x57\x5a\x03\x6f\x99\x11\x92\x86\x26\xe2\xb8\x5c\x50\x87\x18\xc2\xea\xd8\xcf\x7a\x86\x00\x70\xbe\xd0\xc2\x0c\x34\x69\x06\xda\x22\x1a\xed\xca\x8e\xf6\xe1\x18\x3f\xbf\x7c\x4d\xed\x38\x87\x69\x80\x63\xdd\x8f\x66\x3f\x8d\x27\x2e\x52\x61\x72\xe9\xd4\xc9\x07\x2d\x2e\x3e\xa9\x8b\xdb\x78\xab\x7a\x28\x02\xe0\x1f\xaf\xb8\x48\x5e\x72\x0f\xf2\xe3\x6d\xdd\x83\x09\x9d\xd1\x52\x6c\xcd\x54\x1c\x1f\x54\xe8\xc9\x7a\x82\x3f\x95\x24\xee\x3c\x5c\x8b\x32\xab\xa3\x51\xe6\xc6\xe1\xa1\xa8\x28\x63\x69\xcd\x32\x2d\x08\xf1\x27\x31\xfd\xb0\xcf\xf4\xd4\xdc\x18\x3a\xac\x03\xb8\x25\xba\x7d\xe8\x31\x28\x32\x6b\xe4\x33\xfd\x27\xa7\x84\x1c\x19\xe3\xb0\x3f\x3b\x14\x55\xe0\xf9\x6c\xb9\x85\x86\xc9\xe3\x00\x7b\xe0\x5f\x63\x15\x2e\x15\x28\x5c\xaa\x1f\x77\x9a\x13\xd8\x61\x41\x28\x14\x14\x85\x1b\x2e\x6d\x06\x09\x4b\x75\x36\xa8\xc3\x58\x05\x3e\xf7\xf6\xdb\xd3\xb7\x9b\xb9\xe5\x68\x22\xec\xbb\xc7\x54\x6f\x2a\x85\xb5\x6d\xb0\x4c\x7a\xd9\xf7\xa0\x8d\x44\x4e\xc3\xc6\x72\x24\x7b\x91\xe2\xeb\x6a\x97\x3e\xa1\xed\xe6\x0f\xaa\x82\xb6\x3e\x21\xd4\xc0\x04\xdc\x8d\x0f\x9a\x5a\x80\x38\x09\xb1\x5d\xa8\xd3\x3c\x53\xaa\xc5\xef\x73\x04\xc8\x2d\x68\xda\xb1\xba\x64\xa4\x2a\x24\x9c\xdb\xc3\x66\x0b\xa2\xdb\x8a\x02\xec\x04\x29\x10\xf7\x14\x59\x33\xbd\x4c\xbd\xed\x1b\x23\x1e\x9a\xd7\xf3\x6c\x9f\x16\x59\x03\xce\xa0\x7b\xe7\xcb\x44\x71\x37\xeb\x59\x71\x8c\xfa\xa8\x80\xc5\x38\x49\x26\xb5\x67\xd9\x49\x12\x3a\xa9\x43\xed\xcc\xdd\xe8\xba\xc9\x57\x34\xbc\xc3\x89\xe3\x72\x26\xcc\x41\x0b\x5e\xd1\xda\xce\x00\xde\x3b\xb9\x2d\xac\xbd\x52\xfb\x25\xa6\x3d\x0d\x91\x0a\xdc\xcd\xa2\x86\x31\x33\xe2\x37\xe9\x26\x49\xe8\x38\x8a\x32\xa7\x38\x3f\x67\x14\x3c\x47\x8b\xe0\xd3\x44\x44\x11\xdc\x0f\x46\x5b\x30\x0c\x2f\xc5\x78\x03\xbe\x09\xb5\x98\xcc\xa3\x6d\xef\xa0\x33\xc4\xf9\x25\xe3\xcd\xf1\x18\x69\xa6\xd6\xe4\xd6\xdb\xf9\x06\x1a\x63\x34\xff\xf2\x53\x2b\x01\x34\xd8\x49\xd0\x8a\xdf\xbb\x3a\x98\xcb\xf8\x3b\xa8\x74\xad\xab\xcd\x20\xf3\x96\x45\xd8\x08\xc4\x6f\x04\xdc\x9c\x67\xc5\xc8\x09\x5e\xb3\x1d\xd8\x19\x69\x6a\x92\x5a\xab\xca\x91\xc1\x86\xc3\x82\xd5\x0b\xd2\xd4\x0e\xb7\xbe\xac\xcf\xde\x80\x5c\xbe\xb1\xbb\x77\x7b\x03\xfe\x74\x0e\xce\xfe\xa6\xda\x2b\xf6\xe2\x82\xdf\xc7\x15\x19\xa3\x7b\x58\xf8\x99\x69\xb1\x26\xf9\xd3\x29\xc1\xcd\x48\x84\x64\xcf\x5f\x28\x96\x75\xd7\xbd\xcd\xf9\x5a\x1c\x1c\x43\x9d\x85\x83\xb6\xf8\xd1\xa9\xe4\x77\xc1\x3d\x0f\x4e\x0e\x19\x7a\xda\xb0\x9e\x78\x30\x5d\x02\xd1\x5a\x23\x36\xa2\x10\x00\xe0\xf4\x96\x9b\x2d\x70\x3d\x88\xcb\x0d\xaf\x27\x37\x76\xcf\xb7\x9e\x9e\x30\x83\x9a\xa1\x76\x7e\xf9\x7a\xdd\xab\x6f\x33\xf0\x45\x59\x0b\x7a\x27\xd6\xc1\x8b\xd9\x0c\x3d\x10\x18\xbf\xb3\xe4\x87\x8c\xef\x45\xf0\x5c\x8a\xba\xb6\xe9\xab\xe9\x9a\x53\x29\x3e\x43\x59\xb4\xe6\xa7\xbe\x08\x49\x22\x19\xeb\xd9\x7a\x85\xca\x5e\xa5\x5f\xf0\xa0\x15\x44\xc0\xa4\xc9\x65\xc0\xaa\x26\x82\x4c\xd9\x7f\x91\x41\x11\x4d\x46\x8e\x84\xc7\x1e\x79\x70\x47\x0b\x83\x88\xfb\xc8\xf5\x62\x96\x67\x0f\x0d\xb8\x94\x79\x1a\x09\x03\x0e\xbe\x24\xa7\x04\x7b\x96\x13\x12\xd9\x12\x6d\xeb\x7b\x26\x3c\x7b\xdd\x83\xa3\x59\xd1\x35\x51\x49\x32\x59\xcd\x1c\x9b\x53\x92\x4c\x1e\x00\x30\x15\x81\xa1\x57\x11\xad\x64\xd7\xad\xb9\xeb\xe7\x76\xba\x36\x2b\x63\x23\x9a\x40\x32\xb2\x45\xb3\x18\xa4\xec\x1c\x5f\x38\x2f\x44\xb3\x28\x20\x60\x38\x06\x4b\xeb\xba\xb7\x2c\x49\x6c\xbe\x22\x84\x4e\xeb\xba\xc2\x16\xf5\xb7\xb2\x5e\x37\xf7\x5d\xf7\x86\x1d\xcb\x90\x4e\x6f\xb7\x2c\x2d\x8b\x9e\x7c\x8e\x96\x6f\x23\x1a\x47\x4b\x70\xf9\xba\xcc\xda\x74\xe5\xb9\x31\xcd\xf7\x50\x4f\x71\xd8\xfc\x2e\xbf\x21\x16\x93\x3b\x36\xa7\xc6\x01\xa0\x83\x9f\x41\x65\x9b\xb6\x31\xda\x36\xad\xc4\x3e\x49\x9a\xe5\x3e\xef\xef\x24\xc9\xcf\xe8\x1c\xec\x47\x40\xf4\x88\xe7\x1d\x72\xcc\x4e\x43\x5a\x9a\xde\x19\x1a\xbf\x66\x6d\x7a\x5a\x8e\x70\xdd\x50\xd6\x75\x2b\xef\x97\x62\x09\x03\xfb\x04\xfb\xd2\xdd\x6c\x87\x87\x6f\xac\xeb\x26\x3f\xd3\x9a\x75\xdd\x3e\x49\xb6\xb4\x5e\xae\xa1\x41\xcd\x30\x48\x12\x4a\x0b\x51\xe3\x67\x50\xf3\x17\x46\x65\x4f\xbd\xea\xe7\xb8\x58\xf3\xe7\x7a\x20\x49\x36\x63\x74\xb1\xf7\x9a\x71\xf3\x2e\x20\x92\x7e\xfe\xc9\x31\xba\x29\x7c\xf8\xb4\x0e\x76\x46\x15\xae\xae\x05\x20\x75\x7a\x66\xc0\xd6\x72\xdd\x8c\xdb\xfd\x7a\xa7\xdc\x40\x8a\xf0\x9a\x3f\x59\x19\x1a\x51\x13\xcd\x8f\x6c\x31\xe4\xaf\x56\x88\x15\xa9\xd9\x09\x9a\xef\x98\x44\xff\xbd\x73\xa1\xb8\x6c\x53\x51\xed\x30\x55\x6d\xe2\x37\x27\x3c\xe5\xb1\xc3\xb0\xd9\x04\x00\xc0\xb3\x77\x98\x3a\x2d\xb3\x06\x14\xe1\x70\xb5\xc3\x48\xca\x7f\x8f\xb2\x25\xf0\xec\x89\x0f\x6d\xad\x7c\xb2\xed\x46\x95\x17\x76\x3c\xe6\x19\xc5\x75\x30\x12\x66\x6a\x8c\xf1\x25\xe4\x3d\x8a\x45\x08\xa4\xad\xa3\x4b\x70\xd5\xf0\x5e\x7d\x8a\x2d\xb4\x99\x2e\x27\x23\xb1\xe6\x25\x38\x00\x84\x79\x39\xd5\x00\x51\x72\x09\xd8\xc9\x48\xff\xfa\x7f\xac\x1e\xe7\x97\x0b\x9d\x45\x6f\xd3\x2c\xa5\xe3\xc4\x6a\x7d\xe5\xbc\xe3\xa0\x62\x10\x27\x07\x5a\xc8\xb5\x16\x6f\x66\x55\xb3\xc2\x88\x90\x37\x5a\x3c\x01\xb9\x74\x10\x48\xc5\xdf\x9a\x0d\x64\x76\xb1\xb8\x9a\x81\xe5\xf6\xe3\xfb\xef\x4e\x9d\x94\xc0\xf2\x23\xbb\xee\xc4\x15\xc9\x83\x48\x99\x91\x0d\x48\xac\x5a\xc0\xf4\x78\x33\xfb\xfa\xc3\xfb\x1f\x4c\x81\x8a\x61\xc1\xef\x54\xb3\xbd\x82\xc7\x41\x01\x91\x0f\xfa\xe2\x61\x5b\x11\xd6\xc3\xac\xfa\x43\xfe\xde\x02\x3b\x81\x30\x57\x7b\xd2\xdb\xfe\xe9\xf1\xba\xb8\x35\xdb\x25\x4a\xa0\x48\x25\x95\x6a\x54\xe0\xc7\x7c\x35\x83\x14\x4a\xbe\xad\x0f\x45\x55\xae\xcf\x3e\xbe\xff\x2e\x35\x5b\x71\xc6\x35\x46\xce\x5d\x99\xaf\x5d\x7e\xca\x5f\x5c\xf0\xdf\x60\xe7\x9c\x7d\xaa\x2f\x6e\xf9\x57\x56\x5b\x6b\xf7\x37\xdb\x52\xdb\x53\x98\xae\xdc\x16\xb7\xb2\x53\xb2\x95\xba\xdb\x94\x95\x84\x63\x99\xef\x3f\x7b\x7e\xf3\x9b\x7c\xbc\x95\x35\x0b\xcf\x6a\xbe\xd6\xb4\xe6\xb2\xa7\x97\xd6\xa7\x41\xd9\x92\x31\x3b\xa9\x86\xa7\xfa\xaa\xeb\xae\xec\x82\x5a\xb3\xac\x84\x75\x39\x35\x25\x4e\xc9\x92\x4c\x4f\x59\x61\xb4\x33\xf6\x6b\xb3\x31\x34\xda\x45\x4e\xb8\x46\x8c\x1b\xeb\x9a\x55\x6e\xa8\xea\x3a\xf7\xe4\x44\x88\x7b\xf3\x7e\x53\xb4\x1c\x3a\x6f\x49\xe6\x5f\xa5\xa1\x24\xb9\xd4\xb9\x03\xcc\xd9\x15\xaa\xd8\x8a\xf1\xb3\xc4\x65\xce\xcb\xb1\x5b\x62\x4b\x35\xcb\x34\x65\xa9\x5e\xa8\xa5\xc3\x72\xcd\x85\xac\x57\xcd\x5a\xfe\xf4\xe3\xb7\x6f\x9a\xed\xae\xa9\x91\x51\x72\x4a\x04\x99\x8e\xdc\x41\xbd\xa7\x36\x5b\xd8\x9a\x1d\x41\x84\xe1\xa6\xd6\x1d\x64\x93\xb1\x16\x36\x73\xfb\xd7\x7f\xee\xa5\x7a\x4c\x12\xf0\x12\xff\xa1\x2a\xca\xda\xfa\x17\x8e\x76\x00\x7b\x2a\x71\x63\x6f\x14\x3b\xde\x6f\xf1\x7d\x4b\x06\x81\x3f\xd8\xc9\x10\x51\xc3\xcb\x1e\x94\xcc\xaa\x7c\x09\x61\x03\x57\xcb\x56\xaa\xb2\xa8\xc6\x01\xfe\x6c\xd3\x52\x6b\xb3\xb2\x19\xf1\x3b\x18\x60\x68\x84\x49\x23\x05\xe8\x11\x9c\x68\x94\x48\xf6\x00\x1f\x0d\x5c\xd2\x4e\x2b\x12\x44\x51\x85\x7a\xa4\x44\x7b\xe0\x91\x39\x9f\xcf\x71\x01\x67\x46\x5e\x14\x51\x6e\x9a\xcb\xb4\xb1\x35\xfb\x94\x2d\x25\xa9\xdb\x2b\x1b\xbd\xfe\x7b\xe7\xff\x17\xda\x5f\x8c\x46\xf1\x95\xee\x71\xa1\xf1\xee\xca\xd1\x30\x4c\x76\x1e\x33\x9a\x1d\x59\xfc\x71\x61\x24\x5b\x68\x6a\x8a\x61\x0f\xd1\x8b\x60\x60\x5c\xaa\x59\x86\x86\xa5\x7a\xc4\xb0\xf4\x64\x3e\x24\xd5\xd8\xfd\x96\x20\xd5\x9b\x53\x7e\xd3\x9c\x7c\x52\x9f\x6a\x62\x56\xc7\x74\x24\x6b\x3d\x9e\x15\x21\x87\x9d\x7c\xfe\x55\x8b\x8b\xff\xf1\x6a\x7e\x71\xcb\xff\xa9\xc5\xc5\xff\x3f\x7b\xf9\xe2\x82\x7f\xa7\xc5\x05\x5d\x66\x49\xce\x7e\x11\xcb\x7f\x24\xf9\xcb\x0b\xfe\x0d\xc8\x9c\xd9\xcb\x8c\xa5\xcb\xb3\x4f\x3a\x7f\x49\x97\xff\x30\x25\xe6\x2f\xd9\x8b\x8b\xdb\x2d\xff\x60\x65\xd2\x9f\xdf\x5e\x77\xdf\xbc\xfd\xea\x6b\xb3\xb7\xfc\xc1\xa4\x7d\xba\xf8\x74\x71\xc1\x7f\xd4\xe2\xe9\xc8\xdf\xc3\xff\xdf\x6a\x41\x5e\x5e\x10\x17\x84\x4a\x5e\x12\xc6\xff\x36\xe2\x54\x53\x84\x58\xbe\xef\x34\x6d\xfc\xe8\x8a\xdb\xfd\x74\x49\x00\x73\x9d\xe4\x52\x98\xb2\x17\x71\x8c\x50\x64\xe6\x8a\x4e\x87\xfd\x81\xc5\xc0\xbf\x80\x4c\x01\x4d\x67\x39\xcf\x33\x5a\x8b\xda\xc3\xad\x74\x1d\x79\x49\x38\x6d\x5c\x24\x1b\x1e\x7d\xf7\xc1\x43\x2c\x1d\xde\x73\x96\x96\xfe\x4c\xfb\x4f\x1a\x48\x86\x1a\x5e\xe0\xf8\x69\x4d\x03\xed\x85\x16\x42\xbc\xd7\xfd\xd7\x57\x6e\x1d\xf4\x40\x9a\xed\x52\xe6\xe8\x90\x09\x32\x43\x2f\x2d\xa7\xf9\xa8\xa6\x45\xf1\x0d\xcf\x79\xf3\xd6\x80\xd5\xd4\x2e\xeb\x3c\xdb\x67\x13\xaa\x44\xcd\xac\x5d\x2e\xa5\x25\x10\x70\x9b\x9d\x48\xef\x7d\x5f\x33\x5e\x99\xff\x26\x97\xec\xc8\xb8\xf2\xcc\xc9\x61\xe6\xe5\x3c\x37\x4a\x3b\xc6\x15\x27\x49\x05\xdd\x1c\x40\x0d\xeb\xa1\x5d\xe9\x6a\x56\xfc\x5a\x3c\x5c\x49\xad\xcb\xfa\xb6\x9d\x6d\xaa\x42\xdb\x78\x53\xcf\xc6\x5e\x23\xa0\x74\x4f\xfd\xb7\xac\x73\xa3\xfe\x97\xa6\xe6\x32\x55\x5d\x47\x95\x78\x3a\x32\x66\x5a\x1d\x48\xa6\xbd\x14\x0c\xb8\x47\x27\x73\xb3\x14\x32\x2e\x8f\x7f\xd3\x70\xde\x2b\xae\xf1\x6f\xe8\xcf\xb4\xd2\xe5\x41\xa6\x73\x5e\x15\xad\x7e\xdf\xac\xcb\x4d\x29\xd7\x10\x3c\xab\x0b\x08\xa2\x0d\xeb\x9a\x3e\xed\x55\x95\xba\x42\x40\x15\x27\x7f\x7e\x7b\x4d\x78\xd9\x7e\xd7\xac\x8a\x2a\x45\x1f\x8a\x9b\x66\xaf\xbb\x62\xb7\x33\xff\xce\x5b\xdd\x28\xb3\xb2\xcf\xa6\xe7\xf0\xce\xb6\x6c\x6a\x58\xe0\xcd\x5a\xdf\xdd\x97\x6b\xa0\x4f\x7d\x71\x81\x12\xe7\xda\xc6\xe3\xaf\x9a\x8a\x71\xa4\x09\x02\x22\x47\xd5\x18\xfd\x0c\xb8\x48\x26\x73\x5e\xb4\x8f\xf5\xca\xb2\x2c\x6b\x59\x6b\xe0\xe1\x23\x66\x27\x55\xa2\xf6\x75\xf1\x70\x7e\x7f\x7f\x7f\xbe\x69\xd4\xf6\x7c\xaf\x2a\x5c\xd7\xd6\x8b\xb3\xd5\x9d\x51\x65\xb4\xf8\xe9\xfa\xdd\xf9\x7f\x10\x6e\xb4\xbe\x9d\xb6\xb1\x7e\xdf\x6a\x64\xe6\x40\x75\x69\x67\x16\x2c\x82\xa8\xfe\x98\x62\x7e\x12\xfe\x60\xae\xa3\x37\x6d\x2b\x7e\xe6\x35\x2c\xfe\x6b\x0b\x10\x9d\x41\x06\x93\x62\x73\xfc\x5a\x1c\x0a\xcb\xb0\x72\x74\x75\x6f\xd3\x27\x53\xe6\xc5\xa7\x9b\x87\x6d\xf5\xe9\xe6\x02\x5f\x79\xf1\xe9\xc6\xfc\xbd\xc0\xf2\x2e\x3e\xdd\x98\xbf\x9f\x6e\x2e\x8e\x5c\xc9\x76\xd7\xd4\xad\x7c\x57\xca\x6a\x6d\x1f\x26\x2e\xf1\xe3\xfb\xef\x88\xfd\x0a\x97\x74\x2d\x1f\xb4\xab\x96\x4b\xfb\xcb\xd5\x87\xef\xb1\x06\x07\xa9\xb4\x8d\x76\x84\x2a\x92\x14\xd5\x46\x54\x1a\xcf\xe0\x9b\x81\xc7\x13\x2e\x4d\x29\x24\x35\x4f\xa3\x9a\x69\x93\xcd\x87\xa7\xbd\x4a\x7b\xe4\xc1\x90\xc6\x21\xe3\xba\xea\x41\x9b\xdd\x9a\x1f\x54\xfb\x67\xbc\x52\x74\xf6\x42\x53\x98\x38\xf1\x5c\x31\xfb\xe3\xf4\x85\xa6\x71\x2a\x90\xaa\x98\x84\x9e\xb7\xe8\x9d\xa6\x3f\x6a\x06\x89\xd7\xaa\xa8\xdb\x5d\xa3\xb4\x49\x7c\x6f\x13\x07\xaf\x1d\xb3\x4d\x59\xb9\xea\x3c\xfe\xb5\xd0\x30\x3b\xcd\x34\x06\x4b\x1f\xaf\xf9\x9a\x2b\x7e\xc7\x6f\x41\xaa\x1d\xfa\x69\xbd\xdf\xd9\x50\x88\x47\x71\x98\xd9\xcf\xee\xba\x03\xdf\xf6\x97\x49\x02\xec\x47\x0e\xd6\xe3\xd1\x2a\x4b\x2c\xbb\xa2\x8f\x2c\x75\xc6\xc7\x87\x08\x36\x80\xdf\x88\xab\xd9\x9b\xa2\xaa\x6e\x8a\xd5\x6f\x2d\x25\x4d\xbd\x92\x67\x5b\xb9\x6d\xd4\x23\x61\xfc\x5e\x1c\x66\xad\x2e\xf4\xbe\x7d\x03\x04\xf7\x00\x49\xf4\x74\xe4\x56\xcc\x12\x24\x7f\x95\x6b\xc2\xaf\xc5\x93\x92\xc5\xfa\xf1\x4a\x9b\xdd\x37\x90\xad\xff\x68\xc7\xc5\x37\xb2\x58\x8f\x91\x79\x03\x0f\x9b\x83\xcd\x7c\xaa\xc5\xd3\xd1\x1a\x7d\xb4\xf8\x46\x63\x8c\xeb\x8e\xb1\x7a\xa9\x4f\x78\x24\x80\xf2\x5e\xd0\x67\x6f\x45\x20\x0d\x7a\xf9\x2a\x67\x47\x2d\xea\xa5\x1c\xc9\x7a\x8c\x34\x0c\x8d\x1a\x86\xb6\x4a\x1f\x3f\x33\x5a\xdf\xad\xd4\x5f\x55\x55\xfc\x35\x63\x78\xd2\x77\xd9\x2e\xf5\x6e\x24\x3f\x22\xd4\xc6\xc9\xb7\x07\xe3\x11\x5f\x89\x6c\x6d\xed\xb0\x72\xf9\x48\x52\xd7\x49\x5e\x98\x75\xcb\x32\x3f\x1f\x79\x73\x90\x4a\x95\x6b\xf9\xbe\xdc\x22\x83\xe8\xb3\x46\xf1\x3b\x88\x6d\xdb\xda\x7c\x42\xba\x12\xfa\xde\x1d\xef\x20\x08\x88\xbf\x63\xd7\x2e\x92\x4f\x2e\xaf\xed\x88\x38\x89\x13\x91\xec\x7e\xa9\x73\xb1\x34\xff\xc3\x36\x23\x8f\x19\x53\x8a\x1b\x33\x5f\x46\xdc\x6b\xba\x6e\xef\x72\xae\x92\x64\x35\x83\x8c\x00\xf1\x48\xe7\x8e\xe5\xfa\x08\xbb\x84\x07\x8f\xe2\x70\xcd\xf8\x61\xb6\x57\x95\xa0\x54\x76\x1d\xfc\xec\x3a\xbb\x86\xb0\x29\x21\xcc\xeb\x6e\x3f\x68\x1e\xc8\xff\x29\xb9\xb8\x20\xe6\x59\xb0\xaf\xe9\xd9\x56\xea\xbb\x66\xdd\x75\xda\x32\xc1\x1d\x7c\x0a\x66\xe1\x87\x7e\x4d\x16\xb4\xbf\x00\xe5\x85\x3d\xaf\x0d\x11\xe2\x02\x4d\x0f\xb3\x95\x6a\xda\xf6\xeb\x66\x5b\x94\x35\x7b\x52\xe3\x8a\x9a\xd9\x78\x2b\x5c\x4e\xe1\x63\xb8\xbd\xc0\x3f\x3c\x2a\x44\xfc\x6d\xf0\x3d\x53\xb3\x12\x37\xad\x9e\x08\x35\xb8\xa1\x20\xbd\xdf\xa0\xc7\xe5\x18\xa9\x59\x6e\xec\x57\x25\xc9\x61\x16\xac\x88\x3d\x37\xa2\xd7\x73\x5c\x3e\xfb\x80\x70\xbb\x1c\xbc\x34\x6d\xaa\x8a\x35\x80\xfa\x15\x15\x63\xfc\x4f\x46\x5c\xf2\x03\xd7\xfc\x9a\x71\xef\x65\x72\xed\x09\x2b\xe8\xad\x33\x6f\x9b\x57\xe3\xb2\xcc\x92\x64\x2e\x8c\xd0\x03\x05\x62\x3a\x05\xbd\x23\x32\x69\x11\x90\x87\xba\x50\xba\xef\x46\xfc\x13\xe3\xfa\xf1\x03\x38\x2f\x58\xfa\x9e\xc9\x07\xbb\x31\xc1\xac\x8c\xdb\x66\xf6\xa3\xe4\x9f\x70\xb6\x1a\x3d\x94\x3d\xd3\x2e\x80\x88\x69\x85\x2e\x6a\x09\x48\x20\xe8\x8f\x30\x7e\x57\x65\x80\xa3\x11\xdb\x88\xf8\xa7\x67\x02\xd4\x9c\x4c\x09\xe8\xbe\xb6\x86\xa8\x2e\x6f\x9c\xef\x0d\x0f\x7a\x21\xa8\x56\x6f\xd9\x11\x71\x77\x99\x57\x6d\xa6\x82\xbe\xb5\x0d\xb0\x61\x19\x49\x48\x4a\x32\xc2\xa6\xb6\xe3\xac\x53\xa5\xcd\x8f\x56\xea\xc3\x6c\x55\xac\xee\xcc\x8a\xb5\x11\x1b\x5f\xbb\xef\x34\x27\x2f\x2e\x09\xe3\xcd\x78\x81\xe4\x17\x41\xa6\x6f\xf4\xec\x76\x5f\xae\xa7\xd3\x69\xe3\x26\xe9\x06\x7f\x96\x1b\xa7\x0b\x02\x8c\x44\xa8\x1c\x2e\x37\x79\x92\x5c\xcf\x86\x92\x93\x92\x6f\x37\xe7\x2e\xcf\xf9\x55\x59\xaf\x24\xe1\x27\x4f\x82\xa1\x58\x17\xb7\x9f\x2b\xe4\xfb\xa6\x96\xe7\xef\xcd\x3c\x20\x7d\x6e\xc6\x78\x30\xfa\xfb\xae\xb7\x06\xf5\x41\x27\xeb\xf0\x92\x8d\xbf\xc9\x16\x70\x7e\x0d\x9e\xdc\x51\x01\x8c\x8f\x3d\xf0\x15\x68\x8b\x24\x94\x33\xcb\x79\x6e\xaa\x63\xf5\xc8\x65\x7c\x27\xcf\x9e\xbd\x33\x35\x9b\x04\xa8\x76\x98\x9c\x99\x65\x6c\xfa\xad\x9e\x92\xc5\xd9\x3f\xc5\x7c\x36\xbf\x24\x29\x21\x2c\xed\x8b\x41\xe8\xa2\xc3\xec\x0e\x97\x36\x36\x52\xcd\xb2\xbf\x0d\xcc\xc4\x20\x35\x90\x38\xe8\x4a\xd6\x6b\x07\x42\x15\xa6\xe1\x69\xe4\x23\xbf\xe6\x07\xd6\x75\x77\xfe\xfc\xf7\xda\xca\x77\x28\x64\x2f\x08\x5c\x11\x7e\x03\x8c\xb1\x87\x1e\xb2\x83\x5f\x63\xbc\xf5\x61\xd6\xee\xc1\x8e\x6a\x52\x00\xc6\xe3\x80\x36\x42\xc6\x57\xe2\x4f\x46\xfb\xb2\x32\x06\x54\x89\xeb\x59\xaf\x86\x88\x4b\x7e\x9b\x24\xdb\x81\xe8\x00\x06\xa4\xe5\x35\x3f\xe4\x91\x54\x3a\xcc\x40\xff\x4f\x92\xf9\xeb\x03\x44\x22\x35\x7b\x0d\x07\x98\xcf\x21\x5b\xb9\xef\x20\x36\xb3\x51\x15\xfc\x93\x0c\x25\xfa\x9d\x98\x5c\xf2\xd5\xac\x35\x9b\xa2\x82\x57\x81\xa5\x14\x56\x55\x7d\xa7\x9a\xfb\x33\xb9\xa8\xe8\xf9\xa5\xd1\x37\xd1\x87\x0a\xae\xc8\xf7\xcd\x99\x57\x32\xc3\x8d\x7c\xf5\xec\x59\xb1\xd0\x8b\xbb\xae\xa3\x10\x67\xb8\x4e\x92\x41\x8c\xd0\xda\x34\x97\x3d\x3d\xd9\x09\x65\x44\x16\x8f\x1a\x6b\xfe\x5a\x66\x5f\xa6\x66\x93\xff\x6a\x3e\x7f\x0d\xe4\x65\xaf\xbf\x98\xcf\xbb\xee\x8b\xf9\x97\x42\x08\x09\x31\x09\xed\xf3\xbe\xf6\x96\x1a\xc8\x6d\x40\xf8\x5e\xc8\x7e\x1c\x5a\xcd\x8e\xbc\x24\x42\x88\xbd\xd9\xdc\xee\xfd\x41\xb0\xc7\x90\x50\x80\xa8\x2a\xbd\x96\x62\x66\xdc\x89\x1e\x39\x98\x62\x0c\x86\x91\xea\xb9\x8b\x5b\xa3\xb0\x98\x41\x9a\x24\x40\x4f\x0c\x32\x4a\x31\xf6\xb4\xf7\xfb\xef\x92\x2d\x6e\x94\x2c\x7e\x33\x6b\x9f\xa9\x4b\x59\x9f\xd5\xac\x81\x6a\x81\x4a\xd3\xb3\x72\x23\x06\xef\x64\x8f\x6c\xd1\xb3\x7e\x6b\xb3\x2c\x8d\xf6\x08\x70\xb2\x39\x7b\x6a\x44\x69\x4b\x2c\x80\xd3\xa8\x64\x47\x00\xd5\x28\xcc\x1b\xbc\x1f\x79\x33\xc1\x4f\x4f\x92\xbe\x2a\x0d\xe3\xf5\xb2\xc9\x8f\xf4\xc0\xaf\x01\xb4\x78\x52\x82\x2f\x59\xef\x17\xe1\xc8\x33\x43\x01\x81\xcb\x47\x50\x1b\xdc\x31\xd9\x9c\x79\x18\x29\x76\x64\x7c\xd8\x67\x63\x43\xe7\xe9\x08\xbc\xaa\xbd\xad\x02\x57\x1d\x68\xdc\xd5\xf2\x32\x47\x52\x54\xd0\xf2\x82\xf7\xb2\x6a\x59\x0c\xd5\xd6\xa8\x95\x8a\x7c\xd1\x88\x95\xeb\x69\xe7\xf5\x69\x7a\x08\xce\xec\x82\x2d\xe7\xd2\x32\x67\x8e\xa4\x83\xbe\x3b\xd9\x27\x89\x4a\x12\xac\xe2\x3b\xd8\x8f\xe1\x6e\x2a\x48\xa0\x9a\xf7\x9f\x00\x7e\x05\x0d\x0f\x5e\x6f\x5e\x8b\x03\xb0\x31\xbd\xed\x4d\xe9\x28\x34\xf7\x49\xb2\x07\xaf\x7f\xe8\x71\x5a\x88\x6a\xb9\x87\x3e\x6e\xf2\xae\xab\x96\xe4\x25\xfc\x0c\x48\xb2\x2b\x70\x3c\x6a\x45\x19\x40\xcb\xb2\xe5\x65\x8e\xd1\x02\x41\x01\x20\x9b\x7d\x19\x70\xc5\xd8\x13\xe0\x48\x17\x99\xc9\x56\xe6\x29\x00\x09\x54\x30\x6a\x69\x23\x4c\x1e\xbe\xf2\x83\xa4\x35\x1d\x10\x8c\x59\xc8\x5c\x98\xd7\x17\x49\x22\x97\x04\xc4\x48\x4b\x72\xa6\x45\x41\xb5\x43\x82\x82\x93\x1c\x73\xdd\x0b\x1d\x6b\x14\x6d\x61\x8f\x16\x1d\xc0\x70\xf8\x93\x16\x99\x4c\x8d\xe4\xc1\x2e\x6c\x01\x92\x4e\x35\x5b\x33\xd2\xa7\xe4\x4c\x37\xa6\x0d\x8e\xc7\x63\x5c\x8e\x15\xcf\x84\x9b\xa6\x4f\xf5\xd1\x0c\xe6\x96\x5f\xf3\x92\xf1\x32\xa3\x83\x55\x9f\xee\xc5\xf5\xd8\xa4\xfe\xae\x68\xb5\x5f\xe8\x11\x56\xe5\x64\x99\x17\x7b\xc6\x9f\x7b\xde\x2c\xe8\xee\x31\xbb\xb8\x8b\x3d\x63\xfc\x15\x0a\xaf\xae\x23\xdf\xbc\xfd\xea\x6b\x02\x6b\x94\xd1\x8f\x32\xc0\x3a\x71\x44\x04\xa9\x95\x71\x98\xaa\xb7\xae\x1e\x29\xad\x04\x20\x51\x69\x09\xac\x62\xa0\x2e\x95\xc2\x0c\x8f\xd6\x2e\x42\x46\x55\x2b\x44\xc5\x27\x32\x49\xaa\xae\xa3\x95\x20\xae\x49\xc1\x67\x5c\x8a\x39\x63\xb0\xf4\xc3\xae\x49\x48\xff\x13\xa8\x76\xa8\xee\xba\xca\x6c\x58\x78\x99\x3d\x44\x30\x67\x8f\x7c\xd9\xf0\x8a\x5f\xe7\x2c\x7d\x08\x71\xce\x1e\xcd\xe2\x55\xf1\x22\xef\x0b\x35\xdb\x37\x7a\x0f\xdb\x75\x14\xf0\xd1\xb2\x57\x66\xb8\xf0\xd9\x6e\x4a\xe1\xea\x2d\xd6\xd1\xac\x83\xbc\xcc\x9a\xd4\x14\x77\x03\xe8\x41\xc1\x4b\x72\x80\x40\xa1\x83\x15\xf4\x8d\x5d\xa1\xfd\x2a\x7a\x7e\xee\x14\x76\x38\xbf\x1b\x53\xd7\x1b\xf0\xa3\x73\x9b\xed\x6b\xd8\x54\xff\xe5\xea\xc3\xf7\xcf\x04\x97\x9d\x5d\xb9\x20\x17\x5e\x73\x02\x06\x22\xdc\x88\x5f\x81\x88\x1b\xdf\x4f\xbb\x67\x6c\x1b\xe8\x9e\x77\x38\x72\x6e\xbb\x95\x9a\x70\xb2\x6b\x5a\x1d\x07\xa6\x97\xec\xe9\x6a\x59\x0e\x83\xc9\x02\xec\x8c\xad\x23\x9d\x53\x5d\x57\xf3\x1a\xc0\xbc\x9d\x21\x07\xcd\x34\x3d\x64\x18\xd8\xa7\x24\x1a\x33\x4b\xee\x64\x52\xaa\xec\x1c\xe1\x76\xce\xa4\xf5\x91\x9f\x1e\x64\x81\x0b\x13\xd6\x39\x32\x40\x8d\x78\xf1\xf6\x9b\x6f\xaf\xbd\x79\x56\x2d\xf0\x10\x03\x86\xcf\x48\x44\x83\x77\x4a\xa0\x96\x0a\xff\x28\x84\x31\x11\x82\x10\xd6\x8e\xe5\xfc\xb9\xe0\x73\xfb\xc5\xe1\x87\xa2\xd5\xd6\x7f\xac\x5f\xb9\x60\x27\x11\x58\x58\x2f\xbd\x0d\xf6\x32\xb6\x16\x86\xab\x58\x68\x60\x39\x1e\x79\x2f\xe5\x07\xb0\xb7\x3d\xeb\x3b\xf5\x01\xf0\x83\x93\xba\x7b\x55\xec\xbe\xaa\xaa\xe7\xe1\x7c\xad\x2b\x85\x19\xea\x78\x72\x65\x1a\xc5\xfb\x3f\x1b\x6d\x85\x71\x2d\xae\x2c\x6c\xf2\x09\x37\x3f\x9b\xc9\x7f\xd2\x39\x0b\xe8\x2a\x5d\xb6\x38\x2c\x28\x62\xb4\x75\x25\x73\x3d\x7e\xce\x07\xf0\x89\xd6\x1f\x1f\x29\x7e\xad\xbd\x00\x99\x7e\x4d\x0d\x4f\x52\x7b\xea\x75\xcf\x6f\xaa\x2d\x83\x28\x58\x60\x4c\x43\x7c\x5b\x47\x48\x71\x75\x30\xbc\xeb\xdf\x0b\x43\xf0\xcf\xd3\xc8\x3d\x9c\x7d\x3e\xee\xc0\x47\x19\xe8\x40\x3d\xa4\x6c\xe1\x50\xcb\x33\x3d\xb3\x3d\x44\x21\x7c\xd4\xd6\x1c\xd9\x06\xcc\x9d\x93\x58\x19\x3c\x84\x5e\xfc\xa1\xc0\x18\x5f\x74\x16\x85\xc5\xb0\x14\x09\x76\xf7\x75\xfc\x86\x41\xfc\x21\x76\x20\x95\x6c\x56\x37\x9a\x92\x9b\x66\xfd\x48\x4e\x49\xb1\xfb\x20\x1c\xcf\x90\xea\x0e\x3f\xcb\x6a\x6d\xba\xbf\x35\x93\xca\x01\x53\xda\x10\xd8\x5d\x2b\xf7\xeb\xa6\x75\x80\x53\xa7\x55\x98\x0c\x32\x02\x31\x97\x25\x10\x1d\xbf\x35\x56\xc8\x84\xca\x90\x6c\xd0\xe8\xb1\x78\x89\xbc\x2f\x9f\x21\x8f\x80\xd7\x44\xa7\x4a\x0f\x77\x2a\x54\x2e\xc1\x68\xd5\xd3\xf3\xbc\x99\x7d\x7c\xff\xdd\x37\x5a\xef\xec\xde\x31\xa4\xdf\x45\x32\x36\x2d\x9e\xe6\x80\xab\x70\xf9\xea\xd5\x17\xe9\xab\xf9\x97\x47\xfe\x2f\x3d\x3c\xba\x7a\xb8\x53\x94\x2d\x1e\x67\xab\x46\xb5\x62\x32\xf9\x97\x4e\x12\x72\x5f\xea\xbb\x37\x4a\xae\x65\xad\xcb\xa2\x6a\x49\x59\x9f\xfd\x4b\xf3\x47\x78\x50\xfc\x4b\x43\x36\x5b\x59\xbf\x6b\xea\x7b\xc8\xba\x6d\x34\xbc\x30\xfa\x2c\x96\xdc\x75\xa6\xe0\x49\x19\x59\xe7\x9c\x62\x13\xf1\x7c\x47\x6e\x11\xa5\xad\x9e\xd9\x74\x00\xd5\x2b\x2d\xd1\x4e\x58\x82\xd5\xae\xc4\x5d\xa4\xb9\x6a\x25\xb8\x96\xf2\x72\xb6\x2b\xda\xf6\xbe\x51\x6b\xc6\xe1\x69\x54\x6c\x7b\xbc\xcf\x30\x51\x01\x98\x67\x9f\xb0\xac\xf3\x45\x90\xd1\xed\x8c\x00\x2b\x6a\x60\xfb\x1d\x4b\xa3\xfd\x23\xe6\xe5\xc1\xa7\x76\x9d\x5c\x92\x8f\xe7\xb6\xa7\xe4\xfa\x1c\x68\x7d\x73\x40\x16\x1d\x49\x17\x24\xee\x5a\xc2\xb8\x64\xea\xd4\x4e\x80\x1e\x14\x6c\xd1\x8c\xb9\x67\x07\x23\xa7\x01\x3d\xb7\x10\x6a\xd6\xd4\x55\x53\xac\xe1\x07\xe8\x4d\xf0\x0b\x76\xd6\xf0\xcb\xee\xa7\xe1\x37\x6c\x56\x41\x19\x5b\xdd\x15\xf5\x2d\xd2\x6c\x73\x6b\x40\x00\xf5\x4d\x39\xdb\x42\x6a\x95\x30\x48\x1d\x01\x0c\x43\xbd\x29\xd3\x74\xce\x6d\x4e\x96\x6a\xea\xd2\xb9\x0a\x54\x34\x73\xe3\x17\xbd\x74\x49\x79\xd7\x8d\x66\xc3\x53\x2b\xa4\xff\xf0\x7b\x18\x6b\x1c\x84\x3b\x6c\xc4\xf9\x2a\xc8\x29\x1f\x74\xf6\x74\x53\xd6\x85\x7a\x4c\xfb\xe4\x63\xfa\x04\xe7\x5b\x71\xc6\x23\x87\x20\x92\xd3\x53\x09\xca\x20\x40\xc3\xb7\x6a\x43\x19\x2f\x06\x6d\xeb\x5a\xb4\xa1\xee\xcb\x79\x10\x1c\x63\xdb\x3e\xeb\x7b\xa1\x48\x47\xdb\x3e\xe8\x4c\xa3\x3a\xab\xc0\x94\x90\x24\xcf\xd9\x4c\x9a\x24\x29\x00\x9d\x92\x37\xe6\xfd\xd8\x73\xce\xf8\x0d\x56\x92\x32\xb2\xc2\xe1\x81\x38\xa2\x46\xc5\xc6\x93\xc6\x19\x4f\x8e\x27\x07\x0b\xf0\x9a\x86\x3a\x4e\x95\xe7\x55\x28\x19\x4e\x88\x50\x2d\x02\x28\xfa\x72\xa7\x05\x9e\xd0\x47\x67\x70\xfe\x74\x17\xb3\xd8\x63\xdc\xfe\x0c\x96\x9f\x45\xc7\xb4\xcf\xa4\xcb\xd5\x76\x34\xfd\xe1\xbc\xbf\x13\x9d\xe6\xda\xb7\x5d\x7c\xba\xa1\x59\x6a\x4a\xed\x4c\x46\x86\xc9\x70\x84\xfb\x07\x34\x29\x19\xea\xca\x81\xde\xc4\xb8\x1c\x6d\x2c\xaf\xc1\x45\x9a\x93\x27\x90\xf7\x26\x62\xfb\xcb\x34\x17\x3f\x6d\x54\x84\x71\x31\xba\x61\xdf\x98\xbd\x90\x3e\x7d\x47\x6f\x54\x02\xc7\xb0\x58\x6a\xd5\xb6\x6b\xbe\xd2\x5a\xb5\x9f\x91\xd7\x4a\x5c\x51\xf2\x1a\xf3\xfe\x27\x61\x18\xe4\x14\x3d\x8c\xac\xf2\xe0\x4c\xf5\x64\x4f\xf0\x53\x97\xe1\x0d\x5e\xf3\x56\xad\xd2\xda\x08\xf6\x23\x9b\x35\x35\x25\x66\x52\x9d\xd9\xed\x5d\xec\x08\xab\x9c\xf7\x26\xe3\x25\xca\x25\xa3\xf2\xd1\x40\x0c\xe1\xb6\xf3\xcb\xf9\x97\xb0\x00\xe2\xa5\x69\x90\xb7\xa0\x7e\x47\x18\x3c\xca\x28\x86\x23\xe3\xba\x4c\x92\x92\xf6\xae\xa1\x3f\x69\xfe\x51\x8b\x65\xce\x7f\xd6\xe2\x82\x0a\xf6\x29\xa3\x99\x48\xba\x17\xac\xfb\x94\xa1\x3f\x68\x30\x6e\xcd\x26\x6a\x97\x92\x95\x3d\xea\xc5\xc3\xfb\x9d\x3b\xf9\x3d\xf5\x7c\xfd\xa8\xd1\x79\x1c\xb6\x74\x18\x7e\x31\x25\xbf\x04\xd6\xfd\x48\x79\x46\x67\x1a\x39\x3a\x88\xcc\x8b\xe0\x90\x7f\x47\x9e\x67\xbb\xe1\x85\x98\x20\x39\x05\xe4\x4c\x12\xfa\xb3\x47\x18\xd8\xab\x8a\x65\x64\xaf\x2a\x32\x82\x51\x61\xad\xf7\x70\x28\x23\xff\xbb\x87\x32\xfd\x3b\xed\xd1\x09\x31\x7f\x31\x0a\xba\xe8\x3a\x82\x5f\x01\xbd\x19\x79\xea\x78\xfc\x27\x5b\x7d\xd7\xac\x62\x4b\x07\x29\x2c\x1b\x24\x50\xa3\xf2\x46\x29\xbc\xc8\xe4\xb2\xc8\x85\xf9\xcf\x9f\xbc\xfc\x8c\x27\x2f\x53\xc5\xd2\x41\x3b\x41\xfb\x04\x07\x3c\xae\xbd\xdc\x99\x8c\xcd\x09\xae\x98\x0a\xe6\x67\x60\x37\xc4\xe1\x8e\x2e\x18\x91\xdd\xd0\xd9\x2c\x7b\x8f\x5c\x35\x25\x67\xf7\x45\x7b\x56\x37\xfa\xcc\x8c\x22\xd3\x62\xbc\x59\xce\xf3\x23\x8f\x5b\x43\xe0\x86\x9d\x97\xe2\xcd\x52\xe5\xfc\x4d\x04\xee\xc6\x9e\x1a\xe1\xe3\x55\x8f\xbc\x1e\x41\x88\xed\x79\xdb\xb2\x2b\xfa\xc6\x85\xb6\xff\x60\x26\xaa\x62\x29\x14\x57\x72\xb9\x54\x39\x7c\x7c\xdc\xde\x7a\xd0\x94\x66\x14\xef\xdb\x3b\xaa\x18\xc0\xbb\x6e\x69\xc9\xcc\x1c\x42\xb4\xf6\x46\x94\x3d\x39\x85\x93\x43\xe0\x28\x8f\x07\xb4\xdf\x5c\x03\xdc\x07\xd4\x55\x50\xfa\x93\x16\x6f\x67\xe5\x76\x87\x1b\x2e\x18\x49\x23\x19\xa9\x19\x75\x66\x9f\x60\xc6\x5e\x2d\x95\xb9\x27\xc8\x6b\x33\xd8\xfe\xf3\xf5\x05\xfe\x09\x2f\x08\x7f\x25\x84\xf8\x49\x07\x7b\x05\x7f\xd4\x67\xbd\x5f\xa0\x88\xf1\x6d\xf8\xa9\xef\x5e\xb6\xcc\x53\x3a\x1a\x90\x4e\xd1\x64\x61\x84\xb4\xee\x3a\x3a\xf6\x95\x19\xa5\x4a\xd0\x3f\xfc\x9d\x6c\x78\x92\x7d\x53\xb4\xd2\x24\xc3\xd1\xf5\x5b\xef\xb6\x6e\x3d\xbb\x46\x04\x1d\x63\xa9\x16\x6f\x81\x32\xae\x4e\x92\x65\xce\x69\x29\xbe\x47\xdf\x0f\xc9\x58\xb6\xd4\x83\x37\x94\xcb\xcb\x9c\xe5\x29\x2d\xc5\x03\x02\xf3\x6a\xde\x40\xcf\x36\x9e\xf0\xee\x8a\x36\xac\x17\xc6\x57\xb3\xad\x54\xb7\x92\x2e\x73\xa3\xff\xf6\xdb\x31\x86\x22\x14\x64\x8f\x35\x17\x80\xc6\xf4\x39\x01\x05\x3b\xc7\x56\xc8\x10\xa3\xc0\xed\x42\xcf\x2f\x5f\x23\x21\xdc\xc1\xcc\x40\xb4\x9e\x9b\x8d\xb7\xd9\xa7\xe3\xd5\x9c\x9b\x6b\xf0\x9e\xa6\x91\xf1\x28\xd5\x23\x98\xba\x1a\x39\x59\x7f\xf8\x70\x75\x4d\x18\x9f\xbf\x2e\xfa\xef\x3b\x35\xbc\x94\x5d\x37\xb4\xbd\xa0\x5f\x99\xb5\xc8\xb2\x01\x72\xb1\x8c\x66\x21\x2f\x66\x26\x37\x55\x99\x59\x32\xd7\xe5\xe1\x3f\x3d\x24\x1c\x0d\x46\xa0\xe9\x10\x08\x18\x36\xb3\xd0\xac\x5c\x6e\xea\xd6\x49\x12\xaf\xbd\xc5\xc9\x06\xb9\x0e\x71\x1a\x9b\xae\x0b\xcc\xfc\xa0\x3b\x6b\x2e\x73\x00\x2d\xb7\x86\x8a\xc1\xee\xd6\x62\x1c\x06\xbd\x13\x59\xfd\x94\xdc\x51\x0f\xc0\x39\xb6\x93\x17\x8e\xc8\xe8\xe8\xb6\xb6\xe6\x15\xb8\x0b\x86\x10\x94\x0f\xf0\xf3\x79\xb0\x20\x7f\x4e\xd2\x73\x2e\x5b\xfa\x42\x02\x51\x7a\x14\x3c\x0a\x9e\x8e\x0b\x62\x54\xe4\x72\x05\x6c\xf6\x3d\xf5\x9f\x27\x3b\x14\x44\xc9\xaa\xd0\xe5\x01\x40\x25\xc5\xca\xd6\x81\x22\x36\xae\x2d\x1a\x8c\xa5\x7c\xdf\x27\x54\x40\xdc\xc7\x69\xc4\xa0\x58\x75\x1d\xd9\x94\x0f\x00\xf5\x06\x90\xe0\xe7\x97\xaf\x69\x33\xdd\x87\x4b\xde\x5e\x37\x84\x65\xb4\x10\x54\x89\x95\xaf\x04\x65\x6c\xa6\x9b\x1d\x2f\x85\x02\xae\x3e\x30\x60\x07\x9c\x81\x0d\xeb\xba\x39\x2f\xc3\xa4\x3d\x52\x32\x6f\x5d\x28\xbf\x76\xa1\xa6\x75\x04\xd4\x6b\xa6\x95\x85\x48\xd6\x33\x40\x34\xa5\x1b\xf3\x17\xaf\xce\x5b\xf3\xff\xb4\xe8\xb3\x98\xb7\x43\x1e\xf3\xc3\x5e\x9f\xb7\xf0\x67\x5a\x32\x4e\xf6\xad\x91\x6d\x65\x7d\xa6\x33\x3d\x83\x0b\xf7\xde\x0d\x4b\xe9\x09\xfa\xc0\x26\x7c\xe7\x54\x00\x87\x1b\x1f\xc9\x15\xbd\xd6\xe5\x5b\x41\x6b\x6f\x18\xf2\x45\x05\xd6\xc3\x66\x30\x36\x30\xba\xf9\x39\x10\x15\xbf\x78\x21\xf8\xc7\x98\x59\x0c\xcc\x96\x58\xea\xcc\x8f\x3d\x87\x48\x00\xa1\x09\x1e\x0f\x66\x88\xf4\xa0\x32\xf5\x9c\xb1\x26\x73\xd0\x02\x63\x9c\x8c\xbc\x36\x5b\xbb\xd0\x66\x19\xc6\x60\xf2\x27\xdd\xec\x52\x09\xad\x56\xcf\x76\xc5\xad\xfc\x3b\x56\x8a\x03\xeb\xbf\xc4\x76\xc2\x3b\x1f\xf1\xce\x91\xa5\xf0\xd0\x1c\xb3\xcc\x8f\x0e\x88\x80\x7b\x46\xf9\x50\x6f\xdd\x78\x73\x67\x08\x45\xe2\xbe\x8e\x97\x22\x2e\xcc\xa8\x5b\xfd\xe0\xc6\x79\xa0\xc2\x39\xc7\xf4\xf3\xdf\x8a\x47\xb8\x96\x01\xd0\xcf\xaf\x93\x06\xe0\xa6\xb9\xf0\xf6\x0f\x60\xe4\x33\x7b\x0b\x17\xd5\x65\x97\x1b\x67\x7f\x05\x8b\xb0\x10\x35\xac\xeb\x5d\x87\xbf\x07\x79\x01\x6e\xc2\x4f\xfe\x11\x39\x01\x26\xdb\x00\x48\x4a\x26\x89\x44\xba\x98\x13\x46\x03\x5a\x82\x48\xf1\xb5\x67\x38\xa0\x07\xcc\xec\xd7\xcd\xce\x73\xb2\x33\x5e\xda\xd1\x3c\xc8\xf4\x9d\xdc\xe8\x3e\x97\x3b\x81\x81\xe6\xc6\x49\x59\xc2\xff\xbe\x8d\x91\x53\xeb\xba\xd9\x61\xa9\xd0\x21\x76\x62\xe2\x1b\x86\x59\x81\x55\x14\x43\x0c\x8f\x3c\x6c\xcf\x7f\x2b\xaa\x25\xe8\x2c\x7c\xba\x6f\xfa\x3f\xd0\xaa\xd1\x83\xce\x06\xde\x75\x4a\x1e\x63\xb4\x5f\xcf\x2f\x97\x92\x60\x2c\x13\xee\xf9\xe8\x30\xdd\x8e\xfe\x30\xfc\x51\xbb\xd0\xaf\x46\x44\x59\x8c\x96\x8a\x08\xc6\x11\x0c\xe1\x1f\x22\xf9\x04\x87\x46\x2a\x59\xa6\x84\x4c\xff\xd7\x70\x0c\x98\xbd\x44\x30\x47\xd9\x08\x6d\xb5\xca\xd4\xb2\xcc\x01\x02\x6e\x61\x24\x83\xfb\x0c\xda\x64\x2a\x9c\xac\x69\xcd\x9b\xac\x4e\x55\x38\xb5\x99\x85\x99\x3b\x1a\x99\xc3\x47\x89\x3d\x03\x50\xe7\x1d\xb1\xab\x50\x74\x62\x56\x47\x0c\xad\x75\x8e\x54\xb5\xbb\xf2\x41\x56\x3f\x38\x76\xdf\x13\x58\x08\xed\xa1\x33\x90\x91\xb9\x66\xfc\xbd\x67\x7f\xce\x60\xd8\xf7\x0b\xd5\xb2\xce\x81\x46\xd6\xe8\x30\x41\x47\x5a\xc6\x72\xcf\xb0\x0c\x03\x3c\xb5\xc4\x31\x41\xaf\x15\xbc\x85\x10\x55\x78\xc8\xb3\xce\x82\x5a\x4e\xa6\x85\xb3\xae\xa4\x2d\x27\x24\x25\xcd\x5e\x43\x72\xf0\xbc\x42\x12\xda\x4d\xbd\x6c\xf2\xd1\x58\xb8\x61\xbb\x99\x7e\x0b\x90\xaa\x83\x20\x4b\xb3\xda\x76\x1d\x85\x53\x7f\xd9\x75\x13\x5c\x22\x1c\x85\x5d\xea\x08\x86\xfd\xa1\xc6\x67\x07\x8e\xcd\x03\x83\x67\x0e\xc8\xf6\x7e\xcd\xc7\xaf\x60\x99\x5c\xfa\xef\xcc\x53\xe9\x85\xd5\x50\x6a\x2d\xc9\x0a\x44\x27\x64\x8b\xc7\x60\x86\x43\x30\xce\xcf\x3d\x27\x9c\x04\x61\x08\x3b\xc8\xa6\xaa\xcc\xf3\x5c\x45\x57\x2e\x83\xa3\x5e\xc6\x0c\xf1\x55\xff\x72\xe6\x42\x66\x04\x32\xd1\x5a\xea\x59\x5e\xb2\x34\xa2\xa5\xe5\x25\x3b\xf2\x96\xd7\x99\x83\xbe\xb1\x27\x7e\xc1\x70\xed\x1d\x44\x79\x7f\xfa\xcc\x07\x47\xd7\xe1\x21\x78\x74\x3c\xce\x7b\x2f\xb1\x61\x34\xd0\x67\xe6\x39\xca\x2f\x20\x41\x38\x8d\x0d\xbf\x29\x07\xe6\xa9\x21\x86\x37\xce\x26\x88\x2c\x37\x9f\xc3\xf7\xf5\xc9\x23\x83\x07\x36\x1b\xff\x04\x3b\x72\x07\xe0\xf0\x3b\xbc\x0f\x01\x49\x83\x79\xc7\x33\x4f\xf9\x67\xcc\xb2\x74\x02\x2d\x15\xbc\x9e\xbc\x7c\x49\xec\xb9\x9f\x49\xd0\x1c\x9c\xa2\x5f\x12\xf8\x84\xbb\xe6\xf0\xd9\x68\xf8\x6d\xb3\x6f\xa5\xac\xb5\x54\x66\xc6\xc3\x55\x25\x8b\x83\xa4\xba\xeb\x64\x28\x7e\xc8\x4d\xb5\x57\x67\x10\x92\x7e\x66\xe3\xd4\xcf\x5c\x80\xfa\x99\x92\x6d\xf9\x2f\x79\x86\xa3\xee\x6c\x55\x95\xab\xdf\xce\xd6\x37\x15\xfe\x80\x42\xd7\xcd\x7d\x8d\xbf\xf6\x3b\xfc\x6b\x36\x86\xf8\xcb\x54\xd1\xfe\xda\xeb\xb3\xbe\x46\x67\x7d\x75\xce\xd0\xda\x7d\x86\x41\xc2\x67\x18\x5c\x7c\xf6\x9b\x7c\x84\x72\x7f\x93\x8f\x3b\x25\xdb\xd6\xfc\xd8\xef\xce\x6c\x18\xc6\x56\xd6\x7b\x12\x38\x05\x9d\x88\xcc\x4d\x0d\x3c\x5a\x63\x6d\x33\x3f\xc1\x49\xcd\x5c\xd7\xd5\xd8\xdf\x80\x96\x8c\x51\x9b\xd6\xc5\xa2\xf6\xb1\x88\x7f\xd6\xe2\xe2\x1f\xcb\x4f\xed\xa7\xfd\xbb\xb7\xef\xde\x7d\x7a\xf8\x6a\x9e\x4f\xbb\xc1\xf5\x0b\x00\x1a\xdb\xa9\xe6\xe1\x71\x3c\xf2\x17\xcd\xae\x27\x86\x36\x34\x20\x40\xf4\x30\xc6\xb0\xd4\x66\x3f\x10\xb0\x58\x8a\x16\x95\xf3\x7e\x5f\xf9\x8a\x99\xed\xfc\xa9\x1a\x20\xdd\x6e\xd0\x06\xcf\x2b\x17\xaa\x31\x2c\x01\x30\x0b\xc1\xdc\x28\x64\xf8\xa7\xeb\xae\xac\x11\x92\x97\x46\x5b\xbf\x6b\xaa\xf5\x8f\xb2\x58\x3f\xc6\x58\x3c\x00\x4e\x5c\xac\x1f\xff\x56\x94\x7a\x3a\x4d\xed\x15\xd0\x84\x80\xd7\x05\x38\xd8\x89\x28\x9c\xd4\x99\x5c\xfe\x72\xf5\xe1\x7b\x11\x84\x24\x5d\xf9\x28\x57\xf1\x15\x3c\xfb\xce\xbe\x48\x6c\xe1\x12\xc1\x4b\xc4\x03\xbf\x9a\xad\x8a\xad\xac\xde\x14\xad\x14\x1f\xf9\x15\x1a\xbf\xef\xe1\xf9\x7b\x0f\xad\x0f\x8f\x7c\xbf\xdf\x4a\x55\xae\x46\xf8\x44\xf0\x29\x2a\xdd\x62\x10\xec\x74\x84\x0e\x1d\xbd\x85\xd1\x3a\x27\x65\xfb\x7d\xf1\x3d\x95\x21\x79\xbd\x44\xe0\x7b\xad\xca\xed\xf3\x38\x49\x80\x20\x46\x65\x14\x2c\xf1\x67\x70\x83\x3f\x72\xe2\x9e\xea\x07\x00\x22\x8b\x27\x09\xfe\x9d\x15\xdb\xb5\xfb\x4d\x09\x06\x1a\x11\xbe\xcc\x47\x98\xe2\xaf\xec\xe8\xfc\xbb\x16\x6f\x66\xbf\xfe\xd5\xe4\xe4\x7f\x35\xbf\x5f\xf4\x3c\x3f\x75\xf3\xa6\xa9\x37\x55\xb9\x1a\xc5\x75\x7a\x33\x7b\x61\xb4\xbf\x24\xa1\xe6\xd7\x5f\x35\x50\xa5\xb8\xb2\xfc\x1d\x7b\xf9\x77\xcd\xf8\xd5\x91\x8f\x62\xa1\x87\xf9\x4c\x51\x57\x26\x2b\x5b\xfc\x7f\xff\x3b\x00\x00\xff\xff\x85\x8b\x1d\x3d\x84\x5d\x01\x00")
+var _libJquery351MinJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\xbd\x6b\x97\xdb\xb6\xf5\x2f\xfc\xfe\xf9\x14\x23\xd6\x65\x00\x0b\xd2\x48\x4e\xd3\x73\x4a\x0d\xa2\xe5\xd8\xce\xa5\xff\xa4\xb9\x8c\xd3\x24\xe5\xc8\x59\xb4\x04\xcd\x30\xe6\x80\x0a\x09\xcd\x25\x43\xf5\xb3\x3f\xeb\x87\x1b\x41\x8a\xe3\xa4\x3d\xe7\x24\xcb\x23\x12\xc4\x1d\x1b\x7b\x6f\xec\x1b\x4e\x9f\x8e\x4e\x7e\xf9\x76\x2f\xaa\xfb\x93\x9b\x0f\xa7\x1f\x4d\xe7\x27\xcd\x09\x59\xd3\x93\xbf\x9f\x9f\x7c\x5a\xee\xe5\x26\x53\x79\x29\x4f\x32\xb9\x39\x29\xd5\x95\xa8\x4e\xd6\xa5\x54\x55\xfe\x76\xaf\xca\xaa\x3e\x69\x4e\x7e\xf9\x15\x45\xa7\x65\x75\x79\x5a\xe4\x6b\x21\x6b\x71\xf2\xf4\xf4\xff\x1b\x6d\xf7\x72\x8d\x82\x44\x30\x45\x1f\xa2\x7d\x2d\x4e\x6a\x55\xe5\x6b\x15\x2d\xa2\xf2\xed\x2f\x62\xad\x22\xce\xd5\xfd\x4e\x94\xdb\x93\xeb\x72\xb3\x2f\x44\x1c\x3f\xf2\x61\x2a\xee\x76\x65\xa5\xea\x65\xf7\x95\x8b\xe9\xa6\x5c\xef\xaf\x85\x54\x4b\x45\x04\x1b\xcd\x68\xd2\xb6\x4a\x1f\xf2\x2d\x19\xb5\x59\xa8\xba\xaa\xca\xdb\x13\x29\x6e\x4f\x5e\x55\x55\x59\x91\xc8\x8e\xb9\x12\xbf\xee\xf3\x4a\xd4\x27\xd9\xc9\x6d\x2e\x37\xe5\xed\xc9\x6d\xae\xae\x4e\xb2\x13\x57\x32\xa2\x8b\x4a\xa8\x7d\x25\x4f\x14\x11\xf4\x90\xe8\xbf\x24\xda\xcb\x8d\xd8\xe6\x52\x6c\xa2\x91\xeb\xae\x29\xbf\x34\x3f\x89\xba\xca\x6b\xe6\x3b\xf4\x82\x89\xde\x34\xdc\x64\xd5\x89\xe2\xe9\x8a\x55\xfc\x6b\x3d\xee\xe9\xa5\x50\xdf\x54\xa5\x2a\x51\xdd\xd7\x5b\x56\x73\x35\xad\x31\xa7\xec\x92\xab\xe9\xb6\xc8\xd4\x32\x1c\x9f\xeb\x94\xfe\x32\x5d\x67\x45\x81\x8e\x25\x83\x59\xd6\xa5\x5c\x67\x6a\x9a\xed\x76\xc5\x3d\x49\x57\x4c\xd0\x03\xdb\x73\x35\xdd\xed\xeb\x2b\x96\x73\x35\xcd\xe5\x46\xdc\x7d\xbd\x65\x92\x3f\x1c\x58\xc9\xe5\x54\x95\xe7\xaa\xca\xe5\x25\xbb\xe1\x72\x7a\x95\xd5\x5f\xdf\xca\x6f\xaa\x72\x27\x2a\x75\xcf\x32\x7e\xd3\x7e\x2f\x78\x66\x1a\x37\x83\xa0\xec\x1e\x55\x5c\xf3\xe3\x7e\x44\x2e\xa9\x5d\x60\x2c\xba\xdc\x5f\xbf\x15\x55\x3b\x8b\x62\x2a\xcb\x8d\x78\x7d\xbf\x13\x07\x76\x37\x50\xcd\x89\xdc\x17\xc5\x88\x8b\x38\x16\x9c\x73\x31\x35\xd3\x7d\x60\xaf\xf8\x0b\xbf\xda\x6c\xcd\x1f\x30\x8b\xc9\x68\xc6\xea\x6a\x8d\x1f\x59\xca\xb5\x30\x0f\x5f\x69\x38\x4a\x46\xb3\xc3\xc2\x55\x7f\xf2\x16\x80\xca\x24\x7d\xc0\xb2\x54\x2c\x67\x25\x27\x92\xcb\xa6\x79\x45\xa7\xeb\x4a\x64\x4a\xbc\x2a\x04\xc0\x81\x44\xf5\xba\xca\x77\x2a\xa2\x8b\x7c\x4b\xca\xa9\x12\x77\x8a\x03\xc8\xb7\x65\x45\xaa\x93\x5c\x9e\xac\x29\xc9\xb9\x4a\xab\x55\xd3\xe8\x35\x7d\xae\xcc\x7e\x11\x71\xdc\x7d\x27\x15\xa5\x71\x5c\x4e\xeb\x4e\x1a\xcb\xe9\x42\x4e\xaf\x44\xb6\xc1\x7a\x09\xb9\x79\x71\x95\x17\x1b\x52\xd2\xe9\x2e\xab\x84\x54\xff\x28\x37\x62\x5a\x89\xeb\xf2\x46\xb8\x2f\x07\x3f\x8c\xdb\xde\x3c\x71\x2e\x96\x62\x1c\x45\xc9\xd1\xde\x12\x4d\x33\xb4\x20\x4b\x99\x96\x0e\x98\x56\x4d\xe3\x8a\x25\xee\xfb\x01\xf3\xb3\xe5\x91\xc6\x14\x11\x3b\x0f\x16\x88\xa9\xb6\x69\x71\x7b\x72\x3e\xdd\xca\x69\x2e\x73\xec\x4d\x45\x83\xa9\xde\xa1\x8f\xa8\x46\xf1\xd1\x08\x10\x50\x08\x79\xa9\xae\xa2\x5c\x9e\x60\x51\xa7\xe6\x95\x49\x8e\xc1\xd8\xbd\x37\xba\x26\x82\xc6\xf1\xe8\x4e\xff\x90\x28\xab\xaa\xec\x3e\xe2\x1c\x2b\x34\xe3\x9c\xab\xa6\x71\x80\xe4\xc7\xa2\xe2\x78\x76\xa6\xe2\x58\x4d\xe6\x58\x16\x41\x0f\xe8\x12\x3f\x9f\xee\xdc\x26\xe3\x0f\x06\x7b\x25\x5b\xb6\x2e\x65\xad\xaa\xfd\x5a\x95\x55\x72\xce\x4c\x17\x92\x19\x53\xe5\x73\xb4\xd4\xee\x2a\x3f\xc4\xda\xcc\x12\x76\x39\x3d\xb0\x4b\xa1\x86\x76\x9e\x5b\x82\x30\x73\x22\xce\x66\x4b\x14\x4b\xc5\x18\x3f\x76\xbc\x2b\x8d\x30\x52\xb1\x3a\x30\xec\xca\x73\x95\xad\xdf\x75\xaa\x34\x33\x76\x3e\xbd\x16\xd5\xa5\xd0\x55\x4d\x83\x4e\x13\xca\xfc\x64\x9d\xa8\xe9\xae\x12\x37\x66\x43\x72\xe4\x64\xea\xc0\x44\xb6\xbe\x1a\xea\xe3\xf9\x14\x5f\x74\x85\x1a\x2f\x5c\x67\xbb\x36\x9b\xf4\xd9\xf0\x7d\xea\x7b\x46\xce\xa7\xd7\xd9\x8e\x74\x71\x5c\x07\x02\x2c\x10\x31\x85\x4a\x29\x3d\x30\x8d\xca\xda\x9a\x1f\xab\xb8\xb6\x58\x4a\x57\x9d\x55\x97\x7a\x3f\xd7\xa8\x60\x9b\x57\xb5\x7a\xac\x02\xf1\x2b\x99\xd1\x03\x2b\xb2\xf7\x66\x99\xcc\xe9\x81\x89\x1b\x21\x7f\xbf\x1f\xe7\xd3\xcb\x4a\xbc\x67\x84\x44\x8d\xe7\xf4\xcf\xcf\x0e\xe8\x59\xb9\xd9\xfc\x9f\x57\x78\xa2\x6c\x6d\xe2\xd7\x81\x75\x0f\x20\x85\x49\x3e\x16\x63\xa2\xc1\x28\x99\xd1\xc5\x70\x73\xb3\x33\x2e\xe3\x58\x9e\xa9\x65\x8a\xb2\xa9\x5c\xad\x92\x74\x85\xea\xe5\xe3\x9d\xf5\x50\xd3\x34\xc7\x00\x66\x00\x33\xd9\xb3\xba\xac\x54\xa2\xa6\xf8\x61\xf5\x4e\x2f\xab\x9a\x9a\x87\x03\x3b\x9f\x8a\x3b\x25\xe4\x86\x63\xb3\xb9\xe7\xa0\x3d\x80\xb1\x46\xb5\x4c\x63\x59\x96\x71\xbf\xc8\xe9\x6c\xd5\x34\x0f\x07\x56\xf3\x39\xdb\xb7\xc9\x6e\xd8\x05\x1f\xcd\x17\xc0\xb2\xd1\xdb\xb2\x2c\x44\x16\xa0\xad\x2c\x8e\x49\xc1\xb3\x4e\x65\xb5\xad\x6c\x3c\xa6\xec\x08\xfb\x65\x4d\x73\x4d\x32\xda\x34\x24\xe3\x0f\x07\xca\x6a\xce\xf9\x3e\x8e\x49\x66\xb6\x4b\x3d\x99\xd0\x45\x7d\xb6\x5f\xd4\xe3\x31\xcd\xb7\x04\xfb\x78\xc4\x89\xe8\x54\x4f\x29\x3a\xa3\x0c\x6e\xa9\xb8\x48\xd5\x8a\x45\x3f\xff\xac\xb1\xcb\xcf\x3f\x47\x23\xce\x55\x1c\x67\x23\xce\x2b\xf4\x2e\x8e\xf1\x73\x3e\xcd\xeb\x6f\x8a\x2c\x97\x66\x73\x92\x0a\x5d\xc8\xb9\x46\x32\xd3\xbc\xd6\xbf\x20\x0b\x74\x49\x24\xcf\x50\x63\xc9\xf3\x38\x1e\x75\x33\x48\xba\x4c\x57\x49\xde\x34\xfd\xea\x24\x5d\xca\xe4\xe1\xc0\x72\x3e\x9a\x33\x14\xe7\x6e\x39\x48\xc1\x4a\x56\x51\x9a\xdc\x94\xf9\xe6\x64\x66\x7b\xa5\xb3\x54\xd4\xc3\x50\xd6\xae\x1f\x79\x10\x77\xbb\x4c\x6e\xca\xc4\x72\x4a\xd1\x98\x6c\xc7\x5f\x65\xea\x6a\x5a\x21\xf9\x9a\x50\x3a\xad\xc4\xae\xc8\xd6\x82\x9c\x5e\xbc\x3c\xbd\x64\x51\x44\x59\x5e\x7f\x27\xb2\xcd\x3d\x08\xad\x00\x9f\xd5\x01\xe5\x3e\x0f\x06\x74\x23\xcb\x32\xc0\x37\x14\x9d\x0f\x87\xd4\x29\x0f\xd8\x51\x4c\xda\xde\x8e\xc8\x08\x44\x2c\x35\x6b\x7b\x62\xf2\xaf\x30\xef\x9e\x82\x81\x56\x8c\x88\xe2\x68\x8a\x0e\x11\x3c\x22\xf9\x8d\xc5\xcd\x2c\x0a\xa0\x3d\xa2\x71\xac\x42\xf0\xa7\x71\x6c\xf9\x1c\x49\x39\xe7\x05\x45\x3f\x5f\x5d\xef\xd4\xfd\x63\xfd\x5c\x84\xd0\x61\x3a\x3c\x77\x3d\x9f\x1d\xd8\x65\x51\xbe\xcd\x8a\x57\x37\x59\x11\x14\xc5\xbe\xa0\x0f\xe0\x45\x1e\x0c\xbf\x02\xf2\x35\xd5\x8f\x07\x26\xe9\x11\x12\x07\xf6\xc0\xa4\x48\x56\xf1\x19\xf8\x11\xd0\x56\xfa\x80\x96\x25\x77\x94\x74\x51\x9d\xc9\x45\x65\x00\x79\x34\x07\xb1\xb4\xd3\x93\x56\x2b\x56\x31\x91\x56\x2b\x4a\xdf\x56\x22\x7b\x77\x10\x45\x2d\x4e\x3c\x23\x23\x7e\xbf\x84\x1d\xd0\x89\x38\xb0\xeb\xec\x9d\xe8\x11\xcb\xb6\x7f\x20\xd0\xe9\x6a\xd1\xe7\xe0\xc8\xce\xb2\x8d\xe8\xf6\xd2\xd1\x36\xc9\x22\x9c\x15\xe4\x65\xbb\x5f\xc5\x32\x15\xab\x44\xd0\x64\x6f\x17\x81\x09\x4a\x99\x3c\xb0\x5c\x1e\xb7\xc9\x5a\xaa\x85\x96\x38\x57\xcb\xc9\x3c\xc9\xdd\x3a\x0b\x3d\x93\xba\xa9\x5e\x57\x31\x72\x3d\x9d\x7c\xac\x1c\xd6\xa9\xf8\x8c\xe5\xc7\x73\x29\xd2\x7c\x3c\x5e\x69\x36\xcf\xcf\x81\xcd\xc3\x73\x26\x0e\x0c\xc8\xfe\xa8\x57\xae\x81\x0a\x7c\x7f\xce\x67\xac\xf4\x35\xb3\x8c\x8f\xe4\x22\x3f\x2b\x17\xf9\x78\x4c\x47\x8a\x88\x34\x5f\xb1\x9c\x8e\x38\xcf\xe2\xb8\xd2\xa4\x57\xa7\xf9\xcd\x5a\xf5\x68\xf5\x11\x03\x3b\x63\x19\x4f\x57\x1e\x2c\xd0\x78\xd5\x8e\xa4\x3c\xab\x16\xe5\x78\x4c\x2d\x6e\xcb\x39\x9a\x2c\x57\xac\x64\x12\x6c\x69\x66\x5a\xcc\xe9\xc2\x03\x45\x69\x80\xf9\x77\x0b\xd8\xfe\x5d\x92\x0c\x9c\xd1\x3e\xdf\x24\x73\x56\xef\x77\x38\xc4\x25\xf7\x07\xca\x06\xf8\xce\xf3\xfb\xeb\xb7\x65\xa1\x11\xe4\x56\xa6\xe6\x6d\x9a\x2b\x51\x65\xaa\xac\x30\xcd\xfd\x24\xca\x2c\xdf\x12\x7d\x62\x88\xc1\xc9\x3f\x34\xfb\x77\x62\x8e\x2d\x27\x9f\xda\x26\x4e\x34\x78\x9c\xbc\xcc\x94\x38\xf9\x4e\x5c\xbe\xba\xdb\x59\x44\x61\x8e\x81\x27\xa6\xde\x48\x93\x2f\x45\xa2\x93\x88\xf6\x88\xb3\x4c\x3d\x86\x89\xc6\x6a\x1c\xad\xa2\x15\x57\x53\x55\x7e\x59\xde\x8a\xea\x45\x56\x0b\x42\x0f\x74\x81\x45\x0d\xc8\x9c\x5d\x06\xc1\x36\xec\x2d\x2b\x59\xce\xae\xd8\x96\x5d\xb2\x5b\xb6\x67\x05\x7b\xcd\x5e\xb0\x8c\xbd\x62\x37\xac\x66\x6b\x76\xcf\xce\x79\x54\xe7\xbf\xfd\x56\x88\x68\x3c\x7f\x0a\xe4\x88\xce\xb2\x1d\x97\xed\x71\xe6\x1d\x9f\x61\x87\xb3\x6b\xbe\x17\x84\xb2\x3b\xf3\xf3\xdc\xfc\xfc\xc3\xfc\xbc\xe4\x83\x5c\x05\x0e\x49\x0a\x04\x88\x8f\x66\x94\xcd\x0e\xec\x17\xfe\x70\xe8\x9f\xe8\xf4\x39\xf4\x57\x1c\x08\xcb\x1d\xfb\xd2\x1d\x0c\x3f\x77\x0f\x5f\xfb\x93\xe8\x37\xfc\xb1\x1d\x33\x63\x01\x6c\xc9\xb3\x6a\x21\x0d\xc6\x11\xa9\x5c\xa1\x0b\x16\x07\x9e\x38\xec\x3d\x99\x1f\xd8\x77\x3c\x5a\x5f\x89\xf5\x3b\xb1\x69\x6a\x51\x88\xb5\x12\x9b\x26\xab\xef\xe5\xba\xc9\xf6\xaa\xdc\x96\xeb\x7d\xad\x9f\x76\x45\x76\xdf\x68\xb9\x43\x59\xd4\xcd\x46\x6c\x45\xd5\x6c\xf2\x3a\x7b\x5b\x88\x4d\x73\x95\x6f\x36\x42\x36\x79\x7d\x9d\xed\x9a\xa2\x2c\x77\xcd\xf5\xbe\x50\xf9\xae\x10\x4d\xb9\x13\xb2\xa9\x44\xb6\x29\x65\x71\xdf\xd8\x93\xfe\xa6\xa9\xd7\xe5\x4e\x6c\x22\xf6\x15\x8f\xd2\x8b\x8b\xbb\x67\xb3\x8b\x0b\x75\x71\x51\x5d\x5c\xc8\x8b\x8b\xed\x2a\x62\x5f\xf0\x88\x2c\x93\x8b\x8b\x8b\x8b\xf4\xe2\x62\x93\x4d\xb6\xcf\x27\x9f\xae\x1e\xe6\xec\xaf\x87\x68\xfc\xd5\x38\x5a\x36\xfa\xd3\x9b\xb6\x48\x93\x5e\x5c\xdc\x4e\x56\x4d\xfa\xe6\x62\x36\xb9\xb8\xb8\xfb\x5f\xdb\x15\x1d\x47\xec\x07\x1e\x5d\x5c\xa4\xba\xcc\x53\x12\x8d\xbf\x18\x47\x94\x2c\x13\xfb\x9e\x3e\x7d\xf3\xa4\x19\xfd\x7b\xb5\xe4\xd4\xa6\x2c\x93\x0f\x88\x6d\x77\x8a\xaa\x2e\x2e\x2e\x3e\x58\xd1\xa7\xf4\x83\xe6\x22\xea\x7f\xb8\x88\xf0\xe5\x22\x6a\x6c\xbd\xb4\xb1\xb5\x5c\x5c\xac\x22\xf6\x29\x8f\x92\xb6\xc1\x8b\x0b\x42\xc8\x7f\x5e\x35\x6d\xfa\x5f\x08\x4d\x2f\x2e\x56\xab\x26\x1a\xff\x30\x8e\xe8\x53\xda\x4c\x9f\xd2\x8b\x0b\x34\xcd\x3e\xe1\x00\x5c\xb3\xc1\xc8\x57\xe3\x68\x1c\xb1\xe8\x32\xa2\xec\x49\x98\x1e\xbd\xd1\x7d\x1c\xeb\x8a\xdf\xd8\x4a\x57\xd4\xb5\x42\x9f\x9a\x31\x8c\x9f\xd8\xc2\x3f\x0f\x14\x7e\xca\xcc\x4f\x44\xd9\x6f\x43\x9f\x49\xfa\xf1\xf8\xdf\xe8\xe2\x57\xe3\xc8\x4e\x49\x44\xd9\xf7\x61\xd6\xaf\xc6\x51\xf3\x71\x44\xd9\x8f\x61\xe2\xa7\x94\xfd\x33\x7c\x47\x7d\x5f\x8c\xa3\x27\x11\x65\x9f\xf1\x87\x2f\x5e\x26\x9d\x6f\x7f\xb2\xb3\x1b\x51\xf6\xe2\xcb\xe7\xe7\xe7\xdd\xaf\x17\x17\xd3\xf6\xfb\xeb\xe7\x9f\x75\xbf\x9a\x4f\x4d\xfa\x74\x85\xcf\xcf\x5f\xbf\xfe\xae\xfb\x3d\x1a\xff\x40\xd9\x37\xe7\xaf\xbe\x7f\xf9\x75\xff\xc3\xa7\x94\xbd\xf8\xfc\x8b\x2f\x7b\x9d\x49\x88\x06\x6f\x7d\x3c\x6a\x70\x00\x6a\xa4\xba\xc2\xbf\x09\x5e\xe8\x84\xac\x21\x26\x68\xca\xed\x04\xc8\xd6\x42\x84\x9d\x2d\x9c\x85\x9a\x72\xb3\x69\x08\x49\xc7\x93\x55\x43\xc9\xc5\xc5\xe6\x29\x95\x0e\x9c\xc8\x32\xb1\x1f\xec\xfb\xc5\xc5\x66\x4c\x1b\x6a\x3f\xdb\xd5\x8f\xf2\x88\x32\x30\xe5\xdd\x8e\x69\x60\xff\x6e\x1c\xd1\x27\x36\x8b\x14\x62\x53\xbf\x28\x25\x64\x26\xdd\xac\xa6\x3a\xb3\x76\x49\xdb\x2b\xf1\x6b\x73\xa9\x9a\x42\x61\x34\xc1\x00\xbb\x63\x20\xcb\x64\x72\x71\xb1\xa1\x4b\xdd\xf5\xa0\x63\x64\xc9\xd3\x37\x93\x55\xf3\xc4\x76\xf1\xc0\x7e\xe2\xa7\x9f\xbf\xfe\xea\xcb\x27\xa7\x39\xfb\x96\x9f\xbe\x21\xcb\x24\x97\xbb\xbd\xb2\xd8\xa7\x41\xbf\xb2\x4a\x64\xcd\xdb\xbd\x52\xa5\xa4\xc8\xf7\x77\x7e\xfa\xe6\xea\x62\x83\xc7\xff\xe1\xa7\x6f\xd2\x37\x0f\xab\xf1\xc5\xc3\x45\xfd\xf4\x22\x95\x99\xca\x6f\xc4\xc9\xc5\xed\x29\xfb\x97\xa9\xed\x4f\x24\x05\x22\x18\xd3\x86\x5c\xdc\x8e\x69\x73\x31\x75\x09\xf4\xc9\x29\x13\x82\x9f\xa6\xe3\x7f\xaf\x4e\x99\x12\x1d\x58\xfb\x1d\x54\x43\x42\x5c\x43\xed\xe6\x90\xa2\x87\x8a\x41\x82\x24\x8f\x66\x77\xd1\x58\x18\x6c\x4d\xe6\x74\xf2\xd7\x8f\x3e\xfa\xf0\xaf\x8e\x63\x50\x4d\x43\xe4\xd9\x6c\x69\x68\xe4\x74\x5b\x95\xd7\x2f\xae\xb2\xea\x45\xb9\x11\x44\x8e\x75\x56\x9a\x0c\x7e\xfc\xf8\xe3\xf9\xac\xf9\xe8\xa3\x67\x7f\xfb\x2b\x9b\xcf\x9e\x7d\x18\xcb\xe6\xa3\xbf\x7e\xf8\x6c\x86\xc3\x6f\x25\xf8\x29\x49\x81\xf8\xee\xe6\x5b\x8d\xfb\x9a\x37\x93\xe5\xc5\x86\x36\x6f\x26\x4f\x2c\x4a\xb4\x5f\x26\x17\xfb\x4f\x3f\xfd\xf4\x53\xcc\xc8\xe9\x25\xcb\xfb\x23\x70\xbd\x5c\x46\x17\x33\xc8\x76\xc4\x32\xba\xd8\x6f\xb7\xdb\x4d\x94\xb8\x11\xcd\xd8\x64\x4e\xc7\xd1\xc5\x05\x06\xb9\xb6\xdd\x7b\xae\x88\xa3\x3c\x93\x39\xf5\xa2\x49\x32\xff\x2b\x1d\x47\x27\x51\x62\xb2\x1f\x58\x19\x34\x48\x1f\x5e\xe3\x44\x9b\x09\xfe\x56\x90\xb6\x1b\xae\x13\x23\x08\x95\xc4\xd4\x11\x99\x38\x8e\xb6\xb9\x28\x36\xb5\xc0\x19\x92\x1b\x11\xe5\x3f\xb2\x6b\xd1\x63\x04\xd8\xc3\x26\xaf\x92\xa8\x15\xd4\x45\x4c\x02\xd6\xa3\x42\x5c\x0a\xb9\x89\x0e\x74\xa1\xaa\xfb\x87\xcf\x9d\x8c\x83\x7f\x6d\x98\xd2\xdd\x54\xef\x51\x94\xa8\x29\xeb\xbe\xa9\x34\x7c\xb7\xe3\x5c\xb5\x42\xd2\x75\xa6\xd6\x57\xe8\xf9\xe7\xfc\x41\x57\x9b\x38\xde\x35\x10\x17\x63\x7a\xbf\xb4\xad\x0a\x66\x5b\x55\x94\x1e\x06\x99\x75\x37\x9b\xe0\x39\x16\xb7\x57\x79\x21\x88\x48\xa5\xe5\x77\xc7\xe3\x15\x5d\xb8\x1c\x5c\x4e\xe6\x87\x43\x2b\x7f\xac\x85\x61\xb0\x59\x65\x38\x20\x7d\xc2\x67\xb5\xe6\x7b\xd6\x6c\xab\xa5\xb6\xd3\xf2\x56\x8a\xea\xa5\xe3\x6d\x76\x10\x51\xfa\xe1\x24\x7f\x03\xbf\xaa\x25\xaf\xe9\xca\x9f\x03\xbc\x70\x58\x35\xcd\x48\x35\xcd\x7c\xc4\xf9\x2e\x8e\xff\x66\x7e\xe6\xfa\xb5\x65\x30\x70\x6a\xc1\x61\xfb\x35\x11\x94\x09\x2e\x9a\xe6\x05\x7b\x45\xb5\x46\x60\x6e\x4b\x92\x3d\xff\xd7\x54\xdc\x89\x35\x51\x94\x82\x4d\xc9\xf9\x3e\x9d\xaf\x74\x9e\xbf\x71\xd4\x86\xa7\x11\xc9\xb8\x80\xc8\xd6\xca\x7e\x3f\xb9\xff\x62\x43\x72\x4a\x3b\x4d\x65\xd3\x7c\xc3\x39\xcf\x7d\xa2\xe6\x99\x48\x46\x99\x34\xe7\xa9\x7c\x4b\xb6\x5a\xb4\xb0\x1d\xa8\x2a\x8e\xef\x89\x60\x99\xe6\x9f\xdf\x5f\x0f\x3a\xb4\x4f\x9f\xad\xdc\x77\x07\x44\x92\x85\x5d\xac\x3f\xb9\x7f\x9d\x5d\x02\x34\x31\x32\xa6\x7b\xa8\x07\xf7\xe1\x8a\xc6\xf1\xa6\x9b\xf3\x45\x91\xd5\x35\xf2\xc6\x71\xaf\x0e\xff\xe5\x77\x5b\xf3\x39\x31\x31\x4c\x1e\xf2\x2d\xd9\x4c\x7f\xad\xb3\x38\x1e\xfd\x23\x55\xd8\x7f\xab\x38\x26\xa3\x9b\xa6\x19\xdd\x4c\x95\xa8\x15\xfa\x15\xc7\x44\x2f\x44\x2b\x60\x1e\x3d\xbe\xab\xcc\xca\xad\xb9\x02\xfc\x30\x1c\x60\x77\x71\x4c\xbe\x77\x95\x35\xcd\x6f\xbe\x5e\xfa\x40\xb6\x5c\x08\xf7\x1e\xc7\xf7\x82\x88\x40\x6e\x4e\x9b\x46\xe0\xf8\x2e\x30\x15\x9a\x0d\x6c\x1a\x42\x6a\x2e\xba\x72\xf9\x28\xdf\x44\x94\x2e\x6b\x5e\x7b\x09\x47\x25\x58\x2e\x28\xf0\x50\x3f\x23\xab\xf9\x39\xa5\xd0\x17\x14\xfc\x0a\x83\xb3\x5b\xc3\x6e\x9c\x72\x32\xa1\x45\x5a\xae\x38\xa9\x97\xd1\x9f\xa2\x71\x9d\x44\x89\x6e\x39\xd2\xc8\x69\x7c\x27\x08\x3e\xd3\xc5\x9a\x17\xd3\x5f\xca\x5c\x92\x88\x45\xf4\x00\x34\x71\x34\xf5\xdb\xa9\x16\x57\x9f\x6b\x6a\x55\x56\xcf\x8b\x82\xac\xf5\xa4\x7b\x1c\xf0\x0f\xa2\xa0\x08\x3b\x6c\x73\x99\x15\xc5\xfd\x03\xe4\x5a\xe7\x58\x5d\xa3\x30\xe8\x0d\xf1\x70\x38\xd8\x36\x2e\x89\xf2\x43\x7d\xc2\xa2\x27\xf3\x88\xda\x6d\xdc\xee\x6d\x9c\x32\x1e\xdc\xe9\xd5\x91\x14\xff\x55\x90\xf0\xdc\x6d\xcf\xab\x7a\xfd\xe9\xc7\x6f\xa7\xeb\x6c\x7d\x25\xbe\xd4\xf3\x12\xc7\x1b\x51\x08\x25\x4e\x44\x5a\x4d\xeb\xab\x7c\xab\x08\x5d\x31\x61\x61\x85\xcb\x00\x9b\x14\xa2\xc5\xc8\x27\x22\x3d\x5f\x71\x88\x95\xda\xef\x6b\xe1\xa5\x2d\xfc\x45\x5f\x4d\xe3\x11\xb6\xc1\xb9\x16\xaf\x8f\x04\x51\xb4\xc5\x99\x36\x75\xee\x27\x4c\x05\xd0\x12\xc7\xe1\x5b\x47\xe7\xa2\x28\x53\x1c\x07\xe1\xa0\xb7\x5b\xd1\xc5\xa1\xf6\x48\xd9\x44\x94\x55\x5c\x76\xa1\xa2\x9a\x4c\xe8\xdb\x69\xa6\x54\xf5\x79\x26\x37\x85\x48\x65\x5a\xad\x56\x5c\xb5\xb5\xed\x3a\xb5\xa9\x38\x16\xa8\x25\x8e\xe7\x9e\xfe\x40\x45\x66\xde\x55\xf0\x2e\xa6\x75\xb9\xaf\xd6\xe2\x0b\x68\xf4\x26\x2a\x7c\x03\x2e\xa8\xdc\x86\xae\xf0\x26\xa9\xe9\x8e\xe4\x72\x0a\x42\x75\x9e\xbf\x2d\x72\x79\x09\x84\x28\x83\x43\xdb\xc4\x09\xae\x4e\xc4\x72\x9e\x4c\xe6\x6d\x2f\x37\x98\xce\x87\x1e\x2c\xb4\x13\x1b\x69\xf6\xea\x7d\x24\x13\xb0\x09\xdc\x8e\xd6\x82\xb9\xbc\x12\x44\x0e\xd6\x8b\xc9\x55\x8f\xd6\x66\xbb\x49\xda\x76\xa1\x18\x32\xdc\x9c\x7e\x0b\xdb\x0b\x21\xed\xf2\x77\xc6\xb1\x2d\xab\x6b\xad\xa0\x5a\x86\xe8\x24\x8e\x47\xf3\x0e\x9f\xb0\x8c\x8a\xec\xad\x28\x4c\xce\xe0\x39\x28\xd3\xa9\xc0\x17\x44\xdf\x92\xa3\xd7\xbc\x7e\x19\x24\x34\x4d\x98\x32\xe2\x7c\x04\xf9\x32\xb6\xc0\x50\xe9\xa0\xf5\x38\xee\x7e\x0b\xc6\x7d\x23\x48\xe6\xc7\x5d\x04\x9c\x50\xe9\x53\x4b\x3e\x2e\x59\xf8\xa9\x05\x4b\x56\xf1\x4c\xab\x93\x2d\x70\xb3\x92\xb2\x9c\x57\x5d\x50\xcf\x27\x13\x2a\x52\xc9\xab\x34\x5f\x81\x14\x68\x81\xc0\x88\x28\xfc\xe0\x99\xd2\x03\xfe\x77\xd5\x9f\xdc\x77\x36\x7d\x1c\x0f\x69\xda\x87\x29\x5e\x1c\x8b\x03\x84\x5c\x02\x72\xaa\x0d\xaf\xc5\xd4\x4a\x9d\xa0\x91\xce\xf1\x9e\xd7\x3f\x7e\xf5\x25\x1f\x84\xa7\xec\x5a\xd4\xbb\x6c\x2d\xbe\xff\xee\x0b\x26\x39\xe9\x71\x29\xa0\x1b\x5e\x1c\x63\x1b\xb6\xc0\x36\xfa\xc9\x92\x9b\xa6\x91\x71\x2c\x3d\x64\x36\x4d\x84\xb3\x46\x44\x0f\xec\x35\xda\xae\x85\x72\xb5\x1d\xf7\x00\xa8\x56\xf3\x41\xfd\x56\x93\x9d\x6d\xe6\xa4\x1a\xf1\x17\x71\x0c\xe6\xa4\x0a\xb6\x7b\xd5\xef\x95\xe6\x34\xc8\x0b\x5e\x1d\xf5\x97\xbd\xe2\xa3\x9c\xbc\xa0\x6c\xa7\x6b\x22\x12\x0a\x73\xb1\xcd\xf6\x85\xfa\x67\x2e\x6e\x29\x3a\xaf\xca\xdd\x88\x03\xcd\x10\x39\xcd\x36\x9b\x57\x37\x42\xaa\x2f\xf3\x5a\x09\x29\xaa\xe5\x71\x12\x89\xf6\xb2\x28\xb3\x4d\xc4\x4a\xc1\x46\x73\x9a\x48\xe0\xb4\x6c\x7d\xa5\x0b\xc6\x71\xe7\x95\x44\xa5\x6c\xb3\x53\xca\x2c\x09\xe6\xeb\x10\xb8\xfc\xd2\x67\x1d\xf5\xb7\xa0\x9d\xd7\x23\x6c\xbf\xc9\x6f\x22\x4a\xd9\x30\xb0\xf4\xa9\x66\x1c\x8f\x8e\x13\x89\xa5\xcb\x27\x8e\x70\x9c\xe8\x3a\x2d\x30\x1f\xd0\xdd\xcc\x91\xcf\xfa\x91\x3e\x8b\xe9\xda\xb1\x43\x3c\xca\x23\x36\xea\x33\x16\xfe\x73\x44\x75\x8d\x43\x60\xfc\x68\xdd\x43\x13\xf0\xa2\xbc\x36\x13\x80\xd1\x8f\x86\xf7\x05\x89\x9e\x76\xc7\x31\xcc\xc0\xf1\xff\x31\x80\xfc\xe2\x91\xef\xb6\x24\x98\xe0\x3f\xba\x64\xf9\x86\x9f\xb3\x51\xaf\x42\xb4\xd5\x34\x43\xa9\xe4\xbc\xdf\x4d\x34\xb6\x24\x6f\xa7\xdb\xbc\x50\xa2\x9a\x7e\xf1\xf2\x78\xeb\x70\xe1\x39\x17\x25\x98\x6c\xb5\xe0\x03\x1d\x1c\xe2\xf3\x0c\x46\x64\x68\x43\x6e\xba\x2d\x00\xd1\xe5\xdb\x41\x5b\x1f\x15\xf4\x1e\x9d\x8c\xe3\x57\x9e\x56\xf7\x3e\xb5\x56\x0c\x27\x72\x99\x4a\xa8\x61\x0f\x07\x9a\xbc\x77\x54\xf2\x0f\x8d\x0a\xed\x29\x3e\x0c\xf3\xe1\x40\x0d\xad\x3a\x4e\x33\x13\xe0\x6a\xd6\xba\xa6\x9b\xac\xd8\x5b\xe2\xf8\x7f\x6f\x4a\x8c\xaa\x77\x70\x62\x60\x43\xa3\x27\x99\x48\x5e\x3e\xd2\x41\x8d\x99\x5c\xc7\x9c\x32\x2d\x2d\x57\x8b\x9c\xab\x01\x20\x12\x34\x38\xb7\x96\x3c\x37\x47\xd6\xff\xae\x09\xcb\x1f\xeb\x15\x73\xf3\xf1\xfa\xf9\x67\x7c\x78\xe7\xf6\x0e\xdc\x96\x77\xf8\x9d\xa9\x0a\x8a\x0f\x27\x13\x41\x13\x7d\xa4\x5a\xaa\x63\xa4\x25\x9c\x46\x77\xf0\x24\xcf\x02\x45\xd3\xa3\x95\x83\x11\x8c\x9e\x82\x3d\x12\xf4\xc1\xf1\x83\xa5\xd6\x6f\x51\xb0\x37\xb2\x43\x6e\x34\x5f\x2f\x3d\xd0\x54\xee\x04\x51\xfa\x2d\xa4\x25\xa2\x7c\xf3\x08\x16\x89\xe3\xff\x18\x98\xba\xc5\x5f\xd9\xd5\x79\x34\x0b\xcc\xe0\x58\x8d\x81\xdf\xe0\x8f\x39\x8e\xb6\xc8\xad\x3f\x85\xfa\x34\xda\xc3\x67\x98\x3c\xb5\x38\x46\x67\x52\x8a\x0a\x44\x9d\x47\x67\xd9\x49\xbe\xe1\x1f\x44\xe3\xf3\x71\xf4\xc1\xc7\x67\xa7\xd9\xc7\x67\x46\x88\xd8\x26\x4f\xa0\x1f\xf8\xe0\xe4\xba\xce\x8a\xa2\xbc\x5d\x67\x3b\xb5\xaf\x04\xff\xe0\x83\x8f\xcf\xca\x9d\x15\x96\xa0\x80\xd8\xe8\xb4\x53\x93\xf8\xf1\xd9\xa9\xa9\xe7\xe3\x88\x0d\xd1\xa8\xb4\x5b\xdd\x1b\xfe\xc1\x07\x2b\x8f\xdc\xe3\xf8\xc6\x9c\xbb\x22\x68\x17\x56\xdc\x0a\x48\x97\xc9\x07\x10\xf4\x5f\x44\x10\x38\x0f\x56\xea\x7a\xd2\x56\xd5\x34\xae\xaa\x56\x85\xb1\x4c\xf4\x26\x6c\xa2\xf1\x77\xe3\xc7\xeb\xca\x37\xff\xe6\x7a\x5a\x26\x43\xb5\xfd\x9b\x47\x94\x91\x81\xe3\x9a\xe1\xd8\x29\xed\x1d\xb0\x25\x68\xa5\xb6\x2c\xe8\xd2\x3f\xf5\x48\xeb\xc8\xdf\x99\x93\x81\x81\x20\x8f\x99\x9a\x3f\x3c\x43\x89\xd5\x53\x0d\x54\xdb\x7e\x1a\x2c\x99\xfd\x49\x4f\xc6\xf8\xe9\x40\xd1\xe9\x9f\xa6\x63\x08\x84\x1f\x29\x7a\x71\x71\xb1\x8d\x28\x73\xb9\xd3\x56\x08\xac\xb9\x87\x1e\xcc\x8a\x1e\x78\x5e\x55\x62\xcb\x3f\xf8\xe0\xc4\x73\xfe\x1f\xb8\xa7\x2e\xbc\x0e\x7e\x37\xc0\x78\x1a\x40\xe3\xe2\x91\x63\xb6\x5d\xb7\x85\xea\x2d\x1c\xf6\x71\xc4\x22\xa3\x9d\x1b\x58\xbe\xe1\x75\x7e\xf9\xc8\x54\xa4\x58\x33\xbe\x19\x02\xf5\x76\x35\x5b\x95\x5a\x44\xd9\x33\x2d\x51\x3a\xae\x29\x11\x52\x1f\x92\x06\x6a\xf2\x9f\x58\x94\xb8\xb9\x88\x28\x3b\x42\x03\xee\x1b\xa4\x11\x8f\x36\xd3\x56\xf0\x47\xdb\x19\xaa\xe6\x29\x4b\xee\x02\x10\x60\xd3\xa7\x09\xd6\x9e\x32\xb2\x99\x5e\x43\x88\x21\x6a\x97\xdf\x61\xb8\x35\xcf\xdc\xa7\xa6\xc9\xa6\xb7\xe2\xed\xbb\x5c\x7d\xd5\xcd\x8b\x0f\xd7\xe5\x6f\x03\xa9\xe5\x50\xce\xba\x97\x08\x94\xd9\x83\xbe\x0d\x4e\x9f\xeb\x52\x4a\x8d\xd1\x74\x7e\xbe\xb6\x56\x23\x0c\xfc\x27\x6b\xdf\xd2\x7a\x84\x2d\xaa\x47\x56\xdb\x91\x8d\x78\xc4\x3e\x05\x54\xdf\xf0\x1b\x3f\x61\x81\x8e\xe4\xc6\xca\xc6\x1a\xf0\xb9\x10\xcc\x0d\xe4\xa9\xc3\x3c\xca\xcd\x47\x36\x5d\x97\xd7\x38\x7f\xbb\x53\xd5\x37\x65\x9d\x03\xba\x61\xaa\xac\x9a\x26\xc8\x26\x55\x96\xcb\x9a\xf6\xc8\x38\x00\x5f\xf2\xbf\x75\xe4\x2e\xcb\xd6\xb2\xdc\xee\x84\x04\xf2\x19\xd5\x15\x19\x39\x22\x09\xbe\xa5\x6a\x9a\x11\x19\x55\x46\x76\xdd\x9e\xe0\x90\x2a\x7d\xd3\xcb\xf6\x91\x54\x90\x36\x3e\xd2\xf5\x38\x9e\xff\x35\x7e\xf4\xab\x36\x20\xeb\xf3\x03\xf9\x96\x28\x2b\xed\x51\x3c\xec\x24\x84\x3d\x2a\xe0\x7a\x46\x33\xdb\xed\xd1\xfc\xc0\x5e\x72\xb5\x3c\xaa\x47\x84\xfa\xfc\x82\x8f\x66\x6c\xa6\xb1\x83\xe4\xa3\x47\xfb\x34\x19\xa9\xc7\x3e\xb9\x59\x92\x4d\x43\xe6\x31\x19\x3e\x7c\x73\x4e\x54\x3f\x55\xd1\xe5\xe3\x73\xa0\x68\x32\xa7\x4d\x33\xda\x68\x3b\xc5\x97\x02\xc7\x50\x28\x72\x1e\xed\x86\x91\xa3\xc8\xa5\xe0\xfc\x45\xd3\xf4\xba\xa0\x65\xce\xf7\x64\xc7\x04\x85\x2d\x91\xd2\x79\xd4\x23\x79\x14\x5d\xce\x93\xfd\xf2\x1b\xb2\x67\x82\x4e\xf0\xa3\x68\x32\x4b\xfe\x12\x4b\x94\x9d\x1f\x29\x5d\xde\x37\xa5\xde\xfe\xa8\x5d\x30\x56\x76\xd6\x0f\x46\x3e\x62\x05\x86\x47\x69\x5b\x9f\x51\xde\x34\xa3\xd2\xd5\x85\xd1\xb8\x1e\x2f\xe7\x49\x8e\xe7\x72\xa8\x7b\x28\x9a\x73\xce\x7d\x49\x2b\x80\x5c\x48\x2e\x2c\x2f\x0d\x29\x61\xdb\x2e\xcd\xa6\x7b\x69\xe4\xb7\x92\x2e\x24\x57\xc3\xb9\xea\x30\x97\xc9\x91\xa5\x15\x4c\x42\x6a\x98\x91\x55\xe3\xb1\x5b\xff\x6a\xb9\x33\xdf\x98\xfe\x92\xe0\x91\xf3\x1d\x7a\x5c\xdb\xc7\x79\x32\x3b\x50\xf6\xe2\xc0\x6a\xe1\x70\x5c\xef\x6c\x62\xeb\xaa\xd1\x79\xc8\x67\xcd\x1f\x45\xc3\x22\x0e\x8d\x1d\x1f\x6b\xb4\xc6\xe8\x08\xb3\xc6\xf1\xab\x9e\x36\xa3\x6e\x9a\x51\xed\xb4\x0c\xf4\x58\xbf\x41\x21\x71\x36\x5b\xc2\xa3\x3d\xa5\x1d\x06\x64\xd3\x0c\xa0\xca\xa6\x69\xf1\x89\xd5\x67\xb5\x09\x1e\x57\xb8\x95\x19\x90\xf7\xdb\x2f\xb3\x33\xad\x80\x7b\x61\x46\x9d\x8a\x95\x23\x3e\x7a\xf8\x0e\xb5\x0c\x4e\xd9\xd0\xa6\xd3\x72\x22\x3d\x27\x50\x52\xd9\x49\x84\x10\xa4\x57\xc3\x7b\xcb\x5a\xd4\xd0\x91\x76\xf7\x0c\xa8\xe0\x03\x23\xe3\xf8\x17\x33\x55\x61\x4e\xd6\xcb\x49\x97\xba\x49\x36\x7a\xe5\xce\x3d\x0e\x7a\x5a\xbb\xd6\x65\x95\x84\xa2\x9a\xa6\x19\xbd\x5a\x76\xcf\xbe\x44\xd1\x84\x54\x3d\xad\x0f\x60\x1a\xea\x1b\x1c\x72\xea\x9d\x58\xe7\xdb\x5c\x6c\x96\x95\x39\x15\x26\x98\x50\x3d\x7c\x51\xaf\xb3\x5d\xa8\xb9\x6e\x27\x70\x1c\x45\xad\x49\xac\x51\x18\x99\x22\xb0\x34\xe3\xef\x33\x84\x8d\xce\xef\xa5\xca\xee\x4e\xb4\xc9\x2c\x3b\xd9\xcb\x4a\xac\xcb\x4b\x99\xff\x26\x36\x27\xe2\x6e\x57\x89\xba\xce\x4b\x99\x9c\x44\x63\x5b\xe5\x5e\xe6\xbf\xee\xc5\x39\x64\x9b\x03\xb2\x44\x1c\x7b\x0c\xde\xd0\x46\xa1\x05\x1f\x6d\xa6\x1b\xa1\xc4\x5a\xbd\xdc\xc3\x4c\x3b\x53\xa2\x66\x7b\x6e\x71\xe3\xb9\x02\x07\x12\xc7\x5e\xc7\x0e\x56\x04\x1f\xc8\x4b\xca\x0a\x77\x24\x54\xdc\x98\x3c\x52\x4d\x2b\xd2\x1c\xfb\x00\x6a\x0d\x6b\xf4\xe7\xf6\x76\x05\x69\xaf\x35\x06\x27\x50\x6a\xb0\xb9\x87\xce\xbd\xd6\x98\xc0\x38\xb2\x84\x40\xf4\x52\xa8\xd7\xe2\x6e\x78\x00\x51\xe4\x11\x9f\x83\x7e\x8c\x24\xd7\xf4\x07\x87\xd2\xbc\x69\x40\x91\xf3\xa6\x99\xeb\x57\xfd\xe1\xd8\x6c\x54\xbb\xe7\x68\x8b\x13\xe9\xd1\x6b\x27\x51\x5b\xe9\x0a\x2e\xa6\xda\xba\x44\xb3\x78\x0b\xb1\x40\x42\xa8\x0f\x91\x63\x5e\xe2\x58\xe9\xf4\xb9\x1f\xa2\xc9\xa6\xf9\x4b\xa8\xaf\x35\xcc\xc1\x3f\x01\x2d\x46\xef\xdb\xce\x9b\x16\x40\xe8\x3a\x94\x3f\x38\xcb\x03\x23\x6f\x31\x0d\xb5\xc5\x32\x35\x7f\x08\xb4\x64\xc9\x47\x33\x66\x4e\x48\xdf\xd4\x62\xbf\x29\x93\x42\x30\x8d\x96\x92\xcf\x58\xbb\x3d\x60\xeb\x8d\x33\x37\x7e\x2b\x51\x68\x7b\x94\xe4\x21\xfa\x38\x4a\x8e\x0d\x11\xf4\x00\xe1\xf4\xc4\xa2\x93\x81\xef\x07\x16\x8d\x7d\x72\x25\x6e\xf2\x72\x5f\xdb\xe1\x77\xca\xfe\xfb\xb1\x4c\x87\x03\xdb\x55\xe2\x53\x2d\xa8\x4b\x1e\xb4\x5d\x53\xb8\xb2\x6e\x96\xd2\x39\xc4\xfe\xf3\x55\x4f\xbc\xc5\x44\xfa\xe1\x8a\x13\xfc\x6d\x1a\x91\xfe\x45\xff\xfd\x08\x1e\x48\x11\xed\x67\x8d\xfe\xcd\xb5\xb4\x22\x7d\x06\x20\x44\x11\x0e\x4d\x2a\x1e\x80\xa1\x29\x6b\xad\x45\xfe\x42\x0f\xd6\x64\xea\xbd\x7d\xe9\xe0\x18\x16\x49\x75\x65\x1a\x98\xaf\x7c\x4d\x1f\xd2\xa5\xed\x9d\xdb\xd1\x44\xa4\xb3\x15\x65\xe8\x2c\x1f\x13\xfc\x2c\xd1\x65\x3c\xfe\x75\xd5\x34\x73\x9a\x3c\x7b\x4a\x22\xd8\x32\x99\xca\x30\xb2\xa8\xdc\x6c\xdc\x1b\x45\xd9\x8f\x4c\xd9\xff\xb5\x1a\x8b\xf4\x7f\x1f\x65\x48\x90\x2f\x8e\xfb\x2d\x1e\x9c\x7d\xd8\xd0\xd6\x19\xa1\xf9\x38\x16\xe9\x33\x6f\x6f\xfd\xd9\x54\xcf\x81\x21\x4f\xba\xd7\x4b\xec\xc4\x44\x0f\x68\x89\x9c\xbc\x3b\xe5\x89\x8c\xe3\x1f\x4d\x76\x09\xf2\xa6\xf8\x15\x91\x50\x2c\x9b\x17\xe9\xfc\x05\x49\x44\x23\xe6\x54\x9b\x13\x45\x27\xee\x19\xf9\xd0\x10\xc7\x1f\x3f\x87\x38\xb4\xa3\x31\x19\xa4\x84\xab\xf5\x21\xa5\x07\x00\xb4\x01\x21\x58\xce\xfd\xbe\xb4\xb7\x4b\x1f\xec\x88\xad\x38\xab\x65\x5d\xdd\x9a\x8f\x66\x87\x41\x48\xf0\x5a\x9b\x38\x6e\x9f\xbb\x55\x5b\x39\xb1\x96\x6e\x0d\xf4\xeb\x3a\x15\x00\x3e\x3f\xe7\xb0\xb5\x52\x1d\x2b\x2f\xf2\xc6\x9b\x25\x8a\x71\x64\xac\xd7\x60\x9b\x06\x92\x73\x4d\x04\x1b\xe8\x97\x32\x6b\x30\x80\xd7\xbc\x22\x21\x8e\x83\x97\xa6\x19\x92\xa5\x75\xe9\x5c\x1c\x0f\x2a\x25\x22\xf8\x47\xe0\x6c\x79\x60\xdd\xbd\xab\xa5\xb7\xbe\x47\x61\x27\xcd\x82\x58\x96\x00\xfc\x56\x8b\xdc\xac\xd9\x7d\x34\xd2\x3b\xb5\x4a\x70\xf0\x21\x6a\xcc\xa3\x88\x45\x26\x69\x09\x3a\x92\x27\x2e\xc7\x52\x8d\xf4\xeb\x1b\xfb\x9a\xc7\x31\x4c\xb0\xbc\x67\x2a\xc9\x69\x12\x3d\x6d\x3f\x4e\xe6\x67\xdd\x6f\x4f\xda\x6f\xd6\x58\x99\x4c\x72\x07\x8d\xa6\xa9\x7f\xdb\xa6\x26\xf3\x33\x58\x7a\x8f\x5b\x93\x87\x4f\x80\x13\xb5\x3d\x06\xed\x54\xda\x98\x12\x1a\xe8\x35\xc6\x77\x55\xcf\x98\xab\x7b\x3c\xc7\xb1\x21\x1f\x47\x93\x48\x43\x6f\x0f\xdb\x5c\x31\xb0\x2b\x97\xec\xc6\x4c\xd7\x3d\xd7\xc8\x65\xc4\xf9\x95\xaf\xe9\x43\xca\xae\x79\x04\xbb\xc6\x30\x7d\xf2\x17\xd8\x79\x47\xd6\x6a\x13\xdd\x10\x6e\x76\x41\xf0\x2e\xcd\xfc\xdc\x2c\x8f\xa1\x66\x34\x0a\x4f\x0b\x87\xc7\x1d\x04\x9c\x65\x16\xbf\x1f\x71\x7e\xbd\x8c\x02\x8a\x17\x0d\x10\x81\x75\xf7\x14\xb2\xe5\x77\x8f\x6f\x16\xb6\xe3\x23\x09\x7f\x4d\xb6\x81\xd7\x56\xbe\x25\x6b\x4d\xa1\xef\x1d\x3b\x51\xd0\x87\xcc\x1f\x2c\x32\x9e\xa5\x85\x16\xd1\xdf\x2d\xb3\xc7\xb7\xdf\x36\xc1\xc8\xb3\x3e\x3f\x3c\x9a\x2f\xf6\xbc\xe0\x11\xac\x60\x31\x4f\x57\x71\x3c\xda\xc7\x71\x67\x38\x96\x05\x19\xcd\x60\x9f\xb4\xe7\xe9\xf5\x72\x1d\x50\xfc\x64\x3d\xc5\xf4\xeb\xe7\x15\xbb\x8e\xe3\x1d\x7d\xd8\x70\x52\x73\x52\x71\x92\x73\x52\x72\x92\xf1\x35\x4d\xcf\x57\x4d\x43\x32\xd8\xa1\x3c\x1c\x28\x4d\x71\x00\x02\x1b\xf6\xc5\x4b\xa4\x97\xe1\x3b\x9c\xca\x68\x7a\xb5\x82\xd7\x0b\x4d\x67\x38\xee\xbc\x8b\xe3\x0a\x26\x66\xf8\x79\xb6\x62\x19\xaf\xe3\x78\x1d\x58\xf6\xa5\xf5\xca\x4f\xc7\x78\x5c\xc7\x71\x16\xc7\x98\x96\xa6\x21\x1b\x5e\xf3\x19\x6d\x9a\x3d\xec\xf3\x09\xa5\x96\x07\x6a\x67\x22\x8e\xc7\xe3\x4d\x1c\x67\x80\x12\xfa\x90\xa7\x57\x2b\x9e\xbe\x63\x35\xdb\xac\x16\xc6\xb1\xc7\x73\x2e\x30\x9d\xda\xf0\xee\xc8\xc4\xff\xa5\x91\x31\x6d\x0d\xb1\xa1\x7f\x7c\x14\xff\xe1\x7a\xdb\x61\xea\x41\x98\x75\xc9\xfe\x8b\x9e\x63\x6a\x36\x2b\xca\xcc\x6c\x75\xfc\x98\xc8\x66\xc2\x6f\x00\x6a\x97\x4d\xb3\xf9\xf3\x25\xe7\x33\xf8\x10\xf3\xcd\xe9\xe5\xe1\x30\x40\x74\x59\x69\x91\x20\xcb\xf8\xdb\xe9\x4e\x33\x6a\x75\x2a\x56\x4d\xf3\x16\xe2\x4d\xc3\x0b\xd5\x69\x6f\x64\x21\x0b\x11\xed\xa5\xb5\x51\x10\x9b\x13\x53\x81\xe1\xf2\xdd\x5e\xc7\x00\x97\x19\x29\x69\x32\x3f\xcb\x2c\xca\x59\x12\xc5\x53\xc1\x04\x8b\x22\x56\xae\x58\xd8\x56\xcf\xb1\x83\xf4\x9a\xa6\xcb\xf7\x18\x72\x88\xf7\x9a\x6f\x7c\x43\x04\x83\x09\x07\xf5\xc6\x1b\xfa\xed\xd0\x0d\xba\xe0\x7a\x4d\x04\x9b\x31\x45\x0f\x34\xc9\xc0\x17\xea\x81\xd5\xc9\x83\x2c\x55\xd2\xe9\x41\x6b\x50\xc6\x72\x9c\x5a\x6a\xbe\x25\xa2\x6f\x89\xe6\x27\xa3\xc6\x64\xf4\x46\xd0\xb1\x35\xe5\xb5\x3b\xf6\x57\x2c\xd5\x4b\x2c\xba\xa3\xc9\x26\x13\x78\xe7\x97\x69\x86\x8d\x48\x44\x9a\x99\xe1\x64\x2b\x9e\xd3\xee\x60\x3a\x7e\x64\x15\xf6\xb0\x60\x35\xa9\xac\x54\x81\xe5\x94\xe9\x44\xfd\x3a\xca\xcd\xce\x84\x96\xf0\x2a\xab\x3b\x63\xf4\x27\xec\x21\x85\xb4\x3d\xb0\x0b\x7f\x4e\x87\x5c\xdf\x1e\xd3\x1f\xa9\x45\xf1\x96\x6e\x59\x86\xf8\xb8\x62\x90\xb8\xce\x39\xa7\x69\x70\x80\x69\xa9\x9b\xd2\x7d\x2d\x32\x79\xd9\x69\xa6\x1d\xf1\x3f\x2d\xfb\xa7\xb9\x82\xc7\x20\x56\x97\x3f\x89\xc6\x92\x32\x08\x87\xba\xdd\xea\x82\x5e\x87\xbd\xc1\x72\xa9\xc5\xa6\x04\x49\x50\x1c\xc7\x73\x5d\x53\x9f\x31\xb9\xbb\x2e\x12\x7c\x00\x6f\xd2\xff\x66\xd2\x2d\x09\x20\x98\x94\x2e\xa4\x07\xb1\x01\xfc\xa0\xa5\xa6\xd2\x07\x83\x9f\x88\xe8\xd0\x33\xda\x37\xad\x73\x50\x37\x9a\x63\xa6\x54\x56\xf5\x7d\xfc\xf5\x20\x60\xd8\x57\xae\x75\x8c\x94\x38\x6e\x9f\xb1\x0f\xaf\x1c\xd8\xaa\x96\x25\xd1\xcc\x82\x98\xe6\x9b\x03\xab\xca\xb2\x5b\x9f\xcd\x0d\xe9\x6a\x76\x60\xda\xfb\xe9\xb1\xef\x2f\xa6\xd9\x1a\x67\x3d\x2b\x7b\x86\x00\xea\x05\x9a\xfc\x14\x85\x9a\xa6\x7d\x26\x60\x2e\x47\x23\xe0\x01\x2d\x6c\x16\x53\xa8\x85\x9a\xe6\xdf\x62\xaa\xb2\xb7\xda\x2a\x10\x5e\xa5\x46\xef\x91\x5c\x0a\x32\x9a\x53\xe6\x74\x12\xfa\x7d\x46\x99\x55\x70\x0d\x32\xe3\xc3\xc8\xdb\x8e\x3c\x30\xc3\x43\x2f\x60\xb1\xaf\x6b\xc2\x31\x67\x67\x1d\x00\xdd\x27\xa7\x82\x3c\x30\xf7\x34\x38\xf8\x60\xc5\xe2\x38\x7c\xf3\x15\xe8\x41\x31\x6b\xc0\xef\x12\x0f\x4c\xc0\x57\xb7\x53\xe5\x1f\x3a\xf9\x43\x36\xeb\x41\xe2\xec\xaf\x2d\xcb\x61\x1f\x66\x07\x66\xfa\x30\xd0\xdb\x91\xa7\x09\x53\xdd\x3a\x24\x08\x0c\x81\x3d\x44\x35\x34\xb6\xbf\xdb\xf3\x99\x27\x88\xf0\x31\xc6\x04\x0e\x65\xfe\x76\x20\xb3\xb1\x73\xfc\x3f\x5c\xa6\xc0\x5a\xd2\x01\x4d\x68\x40\x79\x60\x40\x2a\xc7\x6d\x1c\x55\xf5\x58\x9b\x71\x1c\xa1\x86\xb6\x7e\xd8\x9a\xe9\xe3\x02\x51\x47\x76\xd8\xf8\x1e\x51\x9c\x4c\x5c\x99\xfe\x36\x77\x61\x21\x6e\x02\x24\xe6\x26\x29\x9d\xad\x34\x8e\xeb\x7d\x0e\x84\x9e\xa9\x9a\xcc\x91\x47\xfc\xda\xcf\xd1\xe2\xfe\x14\x1e\x33\x72\xac\x12\xa9\x73\xde\x08\x79\x5c\x5b\xe0\x06\xb9\x90\x67\x6a\x21\xc7\xfc\x19\x15\x7d\x5b\x09\x71\xa0\x3a\x54\xc4\x7b\x8a\xcf\x7f\xa7\x78\x71\x34\x94\xae\x5b\xb1\xeb\xab\x3a\x93\x4b\x95\xc8\xc5\xec\x8c\x4f\x26\xd5\xc2\x55\x56\x75\x2a\xbb\xfc\x83\x95\xc9\xc5\x78\x5c\x9d\xa9\xe1\x5a\x0e\x07\xea\xa1\x5c\xaa\xab\x96\x0f\x9a\x8a\x5f\xd9\x43\x95\x6d\xf2\x12\xc1\x00\xf4\xce\x7f\x5b\xde\xe1\x79\x9b\x17\x3a\x12\xcf\x2e\xab\xeb\xdb\xb2\xda\xe0\x39\xbf\xce\x2e\x91\x78\xa0\xbe\x82\x54\xac\xf8\x06\xe6\x3c\x0b\x67\xd4\xf9\x50\xef\xdf\x5e\xe7\x10\x44\xb1\x4a\xd4\x42\x1d\xe7\xbf\x32\xf9\xed\x90\x4e\xae\x61\xb2\xde\x9a\x96\xde\x09\xb7\xef\x31\x5b\x8a\xcf\x58\xc7\xd7\x25\x8a\x16\xea\x4c\x2e\xd4\x78\x4c\xab\xb1\x8e\xe7\x60\x84\xbf\x6e\xb4\x55\x5b\xd3\x5b\x41\x6a\xe6\xf9\xa7\xbd\x36\x01\xae\x58\x61\x91\x07\x5b\xf3\xa2\x69\xf6\x6c\x0b\xe4\x16\x0a\xd9\x38\xe7\x6b\xb6\xe3\x81\xd2\xc3\xa2\x9f\xe0\x18\x67\x60\xcf\x7a\xdd\x70\x91\xee\x57\x8e\xdf\x6f\x11\x51\xd3\x6c\x2d\x26\x3a\xa9\x6d\x09\x5b\xe1\x68\xfe\xde\x03\x1f\x78\xdf\x9d\x56\x13\x0d\xb4\x31\xd0\x48\x1c\xbb\xfa\x1d\xe6\x9b\x85\xc2\xcd\xf7\xf5\x0f\xe2\x5a\xb0\xe8\xc2\xb0\xe8\xc2\xb3\xe8\xa2\xc7\xa2\x07\xef\xc8\xc0\x8a\x38\x2e\xde\x83\x40\xa8\x69\xb4\x69\xc4\xc2\xb9\xc5\x90\x8a\xe7\xe9\x1a\x1c\x5d\xd5\x39\x91\xf0\xc0\x2d\x28\x83\xe0\x09\x27\x2f\x0c\x9e\x20\x3b\xcf\x28\xd2\x8e\x07\x68\x1f\xe6\x81\x9d\xf4\xad\x20\xad\x0c\x64\x7e\xe6\x0e\xff\x47\xcb\xa6\xa7\x9a\xe7\x5d\xa6\x13\x32\x71\xad\x98\x4b\xab\x55\xaf\xb1\x80\x8e\x24\x39\xf0\x95\x6f\xf1\xb5\x70\xdc\x2d\xcb\x5b\x98\xd5\x4b\x08\x36\x79\xc6\xf6\x2d\xe8\x16\x5a\xb0\x3e\xe2\xca\xc7\x2c\xd1\xf3\x5e\x63\x46\x08\x8e\xe1\x92\x94\x5a\x8a\xd3\x34\xc4\xba\xe9\x97\x7a\x9e\x95\x79\xa9\x69\x18\x02\xc4\x77\xe1\x85\x20\x1b\x76\xc5\x2e\xd9\x0d\xbb\x67\x2d\xe9\xb9\x89\xe3\xd1\x4d\x7a\x0e\xd9\xeb\x0d\x7f\x21\xc8\x0d\xa5\xec\x3e\x8e\x47\xf7\x26\xed\x1e\x69\xc8\x4f\xd9\xfb\x58\x75\x08\x1f\xc0\xf1\xef\xf1\xa7\xe0\x3e\xde\xc2\x1a\xfe\x5a\xdd\x52\xed\xf0\x8d\x4e\xc0\xe5\x5d\x54\x67\xb9\x0e\x6c\xa1\xb5\x7d\x08\xc5\x10\x8a\xa0\x0e\xe4\xaa\x69\xa2\xa7\x90\x4f\x3a\xb0\xd4\xd6\x97\x52\x9f\x0c\xb6\x7c\xb4\x69\x1a\x84\x9f\xba\x5a\xae\x93\xd7\x82\xac\x71\x5c\xd6\x3d\x64\x3b\x7e\xb9\xbc\x07\xc8\x2e\x37\x49\xd1\x34\x37\x3a\xda\x8a\x4a\xb6\x80\x9c\xcb\x38\xbe\x24\x5b\xb6\x33\x39\x6f\xe8\x43\xce\x5f\x0b\xb2\x63\x7b\xca\x6e\x48\xce\xd2\x95\xf9\x50\xf6\x61\x00\x6e\x40\x24\xe3\x39\x5c\x7d\xe2\x98\xec\xd2\x7d\x5a\xae\x70\xf6\xd8\xda\xa7\x8c\x52\xc8\x26\x84\x11\x93\x34\xcd\xc6\xc9\x4b\xf4\xb9\xa8\xe4\xbb\xc1\xfa\x76\xa6\xbe\xdc\xac\xe3\x36\x2d\x57\x3c\xa3\x8b\x7b\x4d\x51\xd9\x0e\x25\x73\xf8\xf0\xfc\x4e\x71\x9c\x16\x72\x7e\xbf\xc4\x19\x2f\xa3\x49\x6d\x3b\x89\x68\x13\xfa\x74\x94\xa3\x56\x6a\x85\x08\x3b\x3d\x60\xf0\x02\xcb\x9d\x53\xf4\x14\xcc\xd5\x4f\x93\x1d\x65\xf7\x4b\xdb\x03\xc5\x76\xac\xa2\x89\xf3\x60\x52\x6c\xd7\x31\xf5\x7f\xd5\xc1\xc7\x39\xb3\x66\xf0\xb6\x2a\x56\xf2\xb7\x53\xa7\xd5\x48\xb5\x20\x19\xfc\x00\x44\x27\x65\xd3\x04\x9f\xa2\x93\x08\x5b\x02\x6a\x6d\x6c\x8b\x41\x87\x4e\xcd\x36\xe7\x07\x96\x41\x8a\xcd\x8a\xe1\x4c\x93\xf9\xd9\x37\x24\x47\xc0\x2a\x9b\x6f\xcd\xd3\xe1\x1d\x3e\x2a\xb1\xb3\x9a\x06\x52\xcb\x5b\xc4\xf0\x41\x38\x0c\xda\x02\xda\xde\x66\x4f\x0a\xfb\xe0\xe1\x32\xd7\x7b\x95\x55\x87\xd5\xa2\x3e\xab\x5c\x80\x21\xd5\x1d\x6a\x6d\x87\x4a\xd7\x3c\x7d\x2b\xc8\xad\x20\x6b\xca\x14\x5d\xb5\x18\x0f\x05\x8c\xa0\x3c\xc8\xee\x1c\xc5\xd0\x80\x4e\xb5\x8a\x6c\x0a\x01\x8f\x0b\x0b\x33\x1e\xd7\x61\x7c\x86\x4e\xbb\xd2\xb5\xdb\x09\xee\xf2\x42\x90\xf9\x59\x1d\xc7\xa6\x1b\xfa\x11\x94\xd4\x1e\x69\x66\xac\x86\xd7\xad\x09\x31\x48\x1e\x34\xc5\x4c\xa2\x13\x30\x6b\x22\xad\x27\xcf\x4c\x95\xcb\xe8\x69\x94\x44\xd1\x81\xd2\xfe\xe9\x9e\x29\x56\x9f\xc9\x38\x7e\xd5\x56\x59\x03\x43\x32\x79\x56\x99\x54\xee\xd2\x7d\x2a\x9a\xa7\xf4\xb0\xb6\x5e\x66\x5e\xc3\xa8\x7b\xe8\x5e\xae\x45\x10\xe9\xcd\xcd\x56\xdd\x32\x28\x1d\xc1\x89\x96\xd4\x5f\x0b\x76\x05\x95\x9c\x2a\xdf\x09\x99\xff\x26\xf8\xa0\xa8\xa4\x23\x3a\xbd\x73\x62\x7f\xe8\x59\x2d\x56\x3f\x51\xcb\x59\x52\xd8\x3e\xcf\xe8\x22\xe3\xc2\xe1\xba\xb7\x53\xaf\x23\xb3\x3b\x31\x33\xeb\xa2\x63\xab\x00\x55\x93\x8a\xff\x6c\x3c\x53\x33\x30\xbe\x04\x8e\xac\x19\xcf\x6c\x6d\xa0\x6f\x6e\xa7\x35\x4d\xe6\xed\xa7\x80\x24\x30\x39\x88\xfb\x44\x2a\xfe\x9b\xaf\x01\x60\xca\xbd\x9f\x1d\xb3\x88\xc2\xae\x92\x64\x98\x9b\x44\x57\x1a\xac\xca\x09\xd4\x00\xac\x6d\xd4\x2b\x75\x28\x73\xd3\x48\x47\xa4\xe2\x9f\xa5\xe5\x2a\xe8\x2a\xf0\x98\x19\x00\x9e\x60\x84\xd4\x34\xbf\xdf\x78\xc9\x2c\x90\x26\xd5\x23\x8d\x02\xed\x8e\xa4\x8d\x50\xe4\x67\xd8\x09\xc3\x92\x6c\xe9\x65\x13\x82\x26\x77\x44\xb0\x9a\xda\x3a\x66\x88\x1a\x87\x05\x85\xc9\x4f\x5e\x0c\xaf\x27\x88\xdb\x35\xbb\x03\x7d\xc4\x1a\x95\xf8\x93\xf1\xe7\xc1\xba\x8e\x32\xfa\x60\xd4\x39\xf0\xb1\xc4\x2c\x7b\x0a\x64\x4d\x5e\x0c\x36\x7d\x25\xb4\x60\x4c\xef\xb6\xa5\x1d\x6c\x46\x93\xd2\x3d\x2d\x48\xc6\x9f\x13\xc1\xc8\x0d\x2f\xd9\x35\x9f\x9d\x91\x7b\x9e\x3b\xc9\x0f\xbb\xe3\xb3\x33\x67\xfa\xc6\xba\x16\x16\x8e\x0b\xb0\x1c\x00\x40\x8f\xcf\x58\xc1\xa3\x59\xc4\xd6\xf0\xa9\x4e\x57\x6c\x8b\x7e\xef\xf8\x2d\xdb\x80\x80\xde\xc5\x71\x6b\xad\x4e\x40\x05\x73\xca\xae\xf8\xbb\xb1\xc6\x3e\xc6\x94\xa6\x13\xcb\xab\x69\xa6\x73\x76\xc9\x37\xb6\x03\x9a\xe5\xce\xe3\x98\xdc\x72\x6b\xeb\xd4\x34\x39\x5d\x14\x23\xad\x79\xb0\x11\x80\x4a\xbe\x81\xd8\x7e\x51\x8c\xc7\x9a\x58\xdd\xc5\x71\x09\xb1\xfe\x8c\xa9\xa6\x29\xbb\x26\x21\xba\x12\xf2\x9a\x94\x98\xbe\xd1\x2b\x67\x2e\x50\xf3\x9b\x34\xb3\xe6\xf9\x35\x29\x51\xf0\x05\x36\xff\x83\x35\x34\x2f\xa9\x15\x60\xa3\x2f\xef\xf8\x15\x3d\x5c\xc7\x31\x21\x25\x1f\xd5\x68\x2c\x8e\xf7\x93\x09\x13\x10\xa4\xdb\xec\x9a\x88\xee\xc7\xbc\x80\x18\x1f\xdd\xdd\xeb\x1e\xf9\xe6\xee\x4d\x73\x35\x59\xb3\x2d\x26\x56\x1b\xe5\x18\xa2\x3b\x3b\xdb\x5b\xd1\x75\x31\x99\xd0\xb5\x16\x5a\x6f\xf5\x5f\x82\x1f\xfe\xab\x31\x4f\x41\xb8\xb4\x2d\x68\xe0\x96\x1e\x1c\x61\xab\xd8\x96\x32\xc4\x69\x13\x90\x17\x6f\xed\x24\xc6\xf1\xfc\x6c\x3f\xbe\xf7\x6f\x1d\xbb\x0d\x52\x79\xac\x65\xc7\xc6\x6e\xf9\x8e\xb2\xf5\x81\x5d\x43\x4a\x5b\xd1\x04\x66\x7c\x56\xac\x51\x56\x5c\xb8\xec\xd9\x81\x5d\xb6\x86\x03\xfc\xbd\x1c\x96\xc6\x51\x03\xf1\x96\xe0\x28\xca\xd6\x7c\x54\xc5\xf1\x15\x11\xbc\xf0\xcd\xc0\x74\x27\xf4\xc0\x07\x5f\xbf\xb6\x23\xd0\xb3\xf4\xec\x8c\x94\x7c\x0d\x36\x7b\x1d\xa8\x76\xbd\xd7\x73\x1c\x47\x5f\xbc\x04\xee\x27\x19\x2f\xa1\x69\xb6\x67\xfc\xbf\xf5\xdc\x54\x5f\xc5\x71\x40\x79\xca\x74\xee\x28\x0f\xda\x40\xc8\x36\x62\xe1\xf7\x8b\x97\xc4\xdb\xb3\x86\x78\xca\x8a\x3e\xe1\xff\x6d\x14\x11\x8e\xa7\x3e\x91\x0b\x04\x92\xea\x19\x39\xb2\x96\x8a\x94\x0e\x21\x99\xd3\x9d\x1b\xdc\x21\xe7\x9f\x4d\xc3\x08\x25\x56\xc2\x42\x97\xb3\xa4\xb4\x99\x2c\x18\xc1\xa5\x11\xfd\xc4\x10\xf3\x15\x0b\x06\x52\xf3\xac\x43\x41\x41\xaa\xf7\x9a\x54\xcb\x0d\x42\x06\x6a\xa3\x99\xfd\x7b\x47\xe4\xdc\xd7\x4b\xc7\xf0\x18\x37\xf6\xce\x68\x60\xfb\x68\xdc\xe2\x4b\xc7\x82\xe5\x6c\x4e\xd9\x88\x08\x2f\xbf\x8f\xe3\x3b\x81\x0d\xe1\xa6\xc5\xc1\x2a\x40\x84\x49\xa7\x17\xb2\x40\x45\x8a\xa6\xd9\x12\xc1\xd6\x94\x92\x8a\x29\x36\x7a\xc5\x24\x43\xa4\x05\xd7\x9b\xc7\x7a\x81\x18\x6c\xa1\x19\x11\x3f\x77\x7e\xcf\x11\x75\x46\x44\xd6\x2c\x37\x82\xec\xf3\x9c\x1d\x9b\x22\xf1\xd1\xa8\x60\xaf\x09\x65\x5d\x5b\xcd\x47\x9c\xcc\xe6\xef\x31\x7c\x7d\x8f\x13\xf8\x80\xc5\xbc\xad\x70\xd8\x70\xfe\x4f\xc6\x4a\x3e\x62\xd1\x9f\x00\xce\xa1\x98\xb0\x27\xa4\x42\x7e\x50\xcc\xa6\xd9\x5a\x91\x55\x83\xa4\xe6\x4a\xe4\x97\x57\xaa\xb9\xcd\x37\xea\x2a\x0a\xc4\xdf\x1a\xed\x60\xed\x46\xd2\xad\x4d\x4f\xee\xa5\x98\xae\x66\x40\xde\xb5\x9c\x27\xcf\x68\xcf\x17\x31\x8e\xff\xc8\xb8\xb4\x60\xee\x54\x7b\x95\x04\x23\xe9\x1a\xe1\xeb\xfd\x60\xbc\x2d\xa2\xdf\x19\xb4\xc9\xea\x47\x6d\x4b\x0e\x0e\x32\x8e\x7f\x5f\x2a\xd8\x4e\x84\xf3\x48\x45\x85\x8f\x2e\x99\x8d\xab\xdb\xeb\x53\x6b\x4b\x6f\xbb\xf5\xdd\xd1\xac\x83\x82\x56\x8b\x70\xee\x8d\xa0\x38\x55\xab\x65\x6f\xaa\xff\x2b\x0b\x41\xca\x6a\x71\x20\x2f\xe8\xe2\x5c\x63\x2f\xbe\x41\x98\xba\xbb\x5d\xc5\x37\x1e\xc3\xd6\x36\x29\x8d\x92\xc8\xc4\xe7\xdc\x55\x9e\x25\x3d\x0f\x28\x04\x77\x2f\x7c\x13\xa4\xb2\x73\xad\xd6\x31\xfe\x55\xb0\x9f\x63\x08\x02\xfa\xe3\x57\x5f\xbe\x2c\xd7\x7c\x63\x1e\xd9\xb9\x37\x23\xe7\x1b\xff\x88\x76\xb5\xf1\xa2\xb7\x7f\xdd\xd8\x04\x6d\xa2\x79\xc5\x07\x27\x0b\x6c\x45\xce\xbd\x75\xa5\xb4\x28\x10\x5c\x79\xaa\x80\xd0\xfe\xe6\x43\x77\x00\xb3\x1f\xcb\x82\x34\x14\xe4\x71\x7c\xae\x1d\x42\x6b\x22\xbd\xca\xd5\xc6\x34\xf4\x74\xb0\x82\xa7\x74\xd8\x89\xf6\x6c\x28\x79\xba\x1a\x90\xce\x77\x5b\x8a\x63\x61\x82\xae\x5a\xab\xc4\x56\x8b\x2a\x0f\xec\x9d\x9b\x6a\x4d\x4b\x3a\x88\xbe\x95\x17\x3e\xef\x18\x11\xff\x51\xab\xa0\x6e\xca\x01\xfd\xfd\x07\x3f\x7d\x73\x46\xd2\x6c\xf2\xdb\x2a\x7d\x73\x71\x7a\x31\xfb\x38\xd1\x81\xe6\xd4\x45\x75\x21\x2f\xb6\xab\xa7\x34\xed\xbe\x5f\x9c\x2e\x3f\x26\xcb\xe4\xec\xe2\xf4\x62\xfe\x71\x83\xc8\x53\x6d\xaf\x5e\x12\x1b\x51\xc7\xf6\xeb\x9a\x48\xba\xb4\xe1\x84\x05\x1b\x92\x6b\x8f\x46\xd2\x9b\x1d\x33\xd8\xe2\xf2\xea\x00\xe7\x6c\x37\x53\x43\xa5\x7d\xf5\x38\x29\x4b\x5b\xc4\x59\x1e\x79\x5b\x22\xf9\xbe\xa2\x13\x48\xc6\x7c\x7c\x4e\x5b\xc5\xb9\x3d\x32\x20\x0d\xb2\x08\xf7\xfe\x08\xb8\xa9\x74\xe6\x6d\xa8\x24\x84\x10\x3c\x4a\x64\xa9\x48\x34\x16\x30\x9d\xa2\x0c\x6b\xee\xf8\x6f\xa3\xb3\x6b\xbd\x29\x96\xa8\x5c\x1e\x19\x70\x93\x0a\x76\xfb\x69\x05\xd7\xdb\xa4\x9b\x85\x08\x66\x07\xa4\x86\xe6\xa2\x0b\x60\x3a\x3c\x73\x10\xd7\x98\x3c\xa0\xaa\x63\x65\x08\x56\xab\x13\xb1\x39\xd7\x6f\x8b\xc0\x44\xb5\x35\xce\xa2\xc3\xf1\x9b\xf5\x76\xb1\x33\xe7\x1b\x30\x1b\x42\xf1\xd9\x42\x9d\x55\x5a\x50\x9d\x6f\x49\xbb\xd9\x49\x8e\x88\xc1\xa8\xc8\x21\x54\x08\xc8\xa9\x66\xe6\x25\xef\x35\x00\x29\x58\x58\x93\x99\x17\x22\x98\xae\xa4\x15\xa4\xcd\xcf\xaa\x65\x88\x96\x88\xa4\x89\xf4\x56\x7a\xe1\xd8\x87\x07\xf2\xd2\x86\x16\x07\x9f\x86\x18\x01\x3a\xe6\xaf\xfa\x8f\x0b\xce\x30\xf7\x79\x3d\x50\x6e\x34\xb2\x39\xdd\xdc\x86\x5c\xee\x3b\xcf\xc5\x61\x42\x13\x5b\xd9\xdc\xb1\xab\x07\x1b\xbb\xf3\x17\xf6\xab\x89\xe0\x76\x51\x3f\x25\x67\xe9\xc5\xed\xc5\x0f\xab\xf1\xc7\x34\x7d\xf3\xf1\xea\x69\xe3\xa3\xba\xd1\x27\xa7\x0b\xe2\xe3\xda\x0f\x03\x30\xcb\xb1\xcc\xa3\xce\xb2\x7a\x86\xfa\x97\x81\x3e\xda\x48\x53\x15\x8f\xce\x34\x85\x4c\x67\xab\x38\x8e\x3e\x36\xcf\x8e\x3b\x9d\xcc\x57\x71\xfc\xe1\x99\x97\x8d\x2d\x53\x23\xe4\xd1\xd6\x08\xab\xe4\x57\x73\x0c\xd7\x41\x8e\x47\x90\x7c\xc7\xb1\x33\x77\x06\x13\xa7\xa6\xbf\x68\xd7\xb2\x25\x51\x4d\x23\xa9\x5d\x68\x9a\x1c\xc5\xf8\x56\xfe\x1b\xba\x5c\xb9\x88\x58\x8a\x23\xca\x75\xad\x32\xb9\x46\x97\xcf\x97\xd8\xa4\x89\x62\x61\x30\x7a\x76\x0e\xd6\xb0\x16\xf0\x3f\xd4\x25\x99\x8d\x5d\x6c\x77\xe6\xb1\xf7\x4e\xf2\x4a\xaf\x2a\xfb\x87\x59\x22\x6b\x1c\xd5\x0f\x69\xad\x6c\xe0\x5a\xc8\x47\x14\xbd\xd6\x2b\x0d\xf7\x90\xa5\x7d\x20\x90\xfb\xda\xa1\x80\x27\x02\xfb\x8a\x14\x07\xbe\xf8\xe0\x98\xdc\x9c\xbf\x0a\x7c\x86\xe1\xa3\x4e\xa0\x11\x00\x45\x37\xf5\xce\x56\x3c\x67\xc1\xc6\xe5\x73\xca\x82\x0a\x2c\x45\xd0\xe3\xf1\x05\x44\xb7\x80\x7e\xa3\x09\xee\x2b\x58\xb6\x54\x73\x8a\x28\xa4\xf7\x4b\xfb\xab\x21\x91\x9c\xd3\xe4\x7c\xea\xa3\x26\x03\x7d\xa3\xe4\x81\x06\x52\x2b\xc0\x1a\xfb\x85\x9f\x93\x57\x06\x4c\xbf\x34\x40\x0a\xde\x57\xaa\xba\x81\x89\x1e\x59\x26\xdf\x4b\x95\x17\x8d\x76\x65\x3e\x65\x9f\xf3\x07\x6d\x53\x56\x09\xa9\xf5\x6e\x38\xcb\x48\x55\xe3\x19\xf6\x7d\xf8\x45\xb1\xee\xed\x17\x5f\xa3\x75\xfa\xd0\x27\xed\xf3\x2e\x69\x77\x53\x2a\x0e\x1d\x1c\x78\x95\xd5\x43\x17\x15\xb8\x01\x85\xe2\x12\x5b\x01\xa6\xc8\x51\x05\x5f\xb2\x25\xf6\x82\xcf\x16\xe2\x4c\x2e\xc4\x11\x7e\x43\x41\xa6\xe0\xd7\xe2\xa0\x7b\x06\x83\xd5\x75\x51\xd6\x22\x0c\xfc\x1f\x88\x77\xac\xca\xa0\x5d\x22\x27\xe1\x39\xc6\xc4\x86\x47\x01\xe4\x8f\x3c\xda\xd0\xc0\xe7\x15\x0d\x2d\x22\x45\xdc\x67\x84\x7b\x01\xc3\xb1\xe8\xb9\x3c\x61\xb7\xfb\x59\x3b\x9b\xcf\x21\xc1\x5b\x4e\x60\xbf\xa5\x0d\x51\x80\x40\xfb\x7e\xf5\x8f\xd0\x2d\x09\x35\x0a\x7d\xb0\x12\x24\xe9\x84\x21\xc3\xf8\x72\x7e\xe6\x8e\xa9\x5d\x7c\x5d\xd2\xa4\x04\xee\x44\xdb\x9d\x85\xb2\xd5\x88\xe5\x31\x52\x5a\x5a\x3a\x8e\x09\x61\x16\xd2\xa9\x0f\xa1\x8d\x55\x10\x0e\xa7\x40\x30\x9f\x08\xb3\x03\x35\xee\xb2\x0f\xc1\x8c\x2c\xed\x82\x57\xb5\x22\x00\x6f\x71\x03\xa7\x5e\x87\x83\x11\x50\x8a\x65\xe1\x15\x0a\x21\x13\xd6\x1b\x64\x67\x64\x21\xfe\xc1\xc6\x26\x94\x69\xb0\xa3\xf0\x7f\x44\x95\x9f\xf4\x2f\xd1\x08\x2b\xcd\x36\x1b\xe2\x2e\xe8\xe8\x5d\x7f\x90\xf4\xde\x1d\xb0\x0a\x98\xf8\xfa\xd0\xd3\x0f\x03\x96\x1f\xce\xe0\xa2\x1d\xbc\x07\x7a\xeb\xd8\x15\xe2\x44\xeb\x56\x64\xf7\xf3\x50\x4f\xaf\xe0\x30\xdb\x56\x16\x51\x9f\x5b\xef\xfa\x64\xd8\x66\xe1\xa8\x98\x0e\x79\x2e\xfb\x96\x1b\x36\x33\x76\x7f\xc7\x5a\x16\x8d\x00\x47\x3c\x96\xb7\x6f\x1a\x6c\xeb\x7e\x5e\x14\x43\x45\xae\x1e\xa9\xfe\x7d\xd9\x1f\x69\xe1\xf7\xc7\x1c\xb6\xa3\x07\x8d\x9a\xfe\xc0\x54\xf5\xda\xd3\x45\x6b\xf3\x32\xc4\x73\x9c\xbc\x26\x9d\xc0\x82\xb8\x6a\x82\x06\xa7\x65\xad\x43\xf2\x78\x78\xb0\x7c\x78\xb8\xa6\x87\x16\x51\x3f\x7e\xe3\xd1\xd4\xe6\x71\x34\x34\x8e\x2b\x72\x94\x48\x97\x47\x49\x09\xc1\xb9\x26\x52\xe2\x7a\x57\x64\x4a\xe0\x6e\x03\xe0\x78\x97\x0d\x22\x3f\x4f\xca\x75\x34\xaf\xd6\x28\x19\xf0\xde\xf2\xc5\x50\x21\x3f\x00\xf7\xc3\x1d\x73\x00\xd9\xf2\xe0\x56\x98\xbc\xbd\x8f\x26\xd2\xf3\x0f\xe3\xf6\xca\x8a\xe0\x26\x1f\xa1\x0f\x8a\x03\xb9\xc4\xf1\x11\xfa\x41\xbc\x72\xc9\xfd\xc1\x01\x80\x4d\xd9\xfc\x2c\xa0\xb3\x71\x4c\x3e\xd7\x37\x2c\x75\xf0\x81\xa4\xec\x4b\xcb\x4c\xe8\x80\x2e\x95\xb8\x11\x15\xce\x64\x94\xf5\xd0\x88\xa4\x8e\xf3\xfb\x86\x9f\xa6\x6f\x3a\x67\xb1\xf1\xe9\x65\x4b\x19\xbf\x0b\x96\x21\x88\x3c\xf8\x55\xeb\xc5\x17\xa4\x7e\xd1\x93\xc1\x2e\xe0\x06\x2a\xe2\xf8\x9a\xe0\x06\x81\x5d\x55\x5e\xe7\xb5\xa0\x0e\xb7\x22\x92\x40\x29\x11\x78\x6e\xba\xcd\xf2\x02\x64\xc1\xe7\x55\x57\x42\xb6\x19\x51\x29\x4d\xdc\xfd\x5d\x86\xab\x60\xa9\x70\xd2\xd7\x8a\x06\x11\x0d\xe5\x51\x2e\x7a\x38\x9c\x4f\x5f\x64\x45\xf1\x36\x5b\xbf\x0b\x9c\x3f\x6d\x2f\x05\x2e\xd4\xe0\x47\x6b\x50\x2d\x21\x4b\x34\x17\x82\x59\x7c\x67\x1d\x68\xc9\x37\x5a\xe6\x1a\x1c\x97\x00\x00\x12\x57\x89\xe0\xb8\x81\xae\xb6\x97\x88\x1c\x58\x65\x58\x17\x68\x77\xfb\x4a\xff\xc9\x9c\xad\xdb\xfe\x18\x06\x20\xe3\x59\xd3\x54\x53\x5c\x79\xc1\x4a\x9e\xf3\xd1\x6c\xb1\xb7\x8b\xbe\x40\x09\xfa\xa0\xf8\xde\x49\x70\xad\x30\x62\x3c\x2e\xce\x1c\x64\x50\x6d\x84\x5e\xa7\xc5\xca\x4e\x04\x78\x55\xa6\xac\xe5\xfd\xb4\x56\xe5\xee\x6b\xf9\x69\x56\xd4\x42\x07\xc5\x77\xc5\x98\xe2\x23\x78\x2a\x4e\xaf\xc5\x75\x59\x41\xfb\xaf\x13\xec\x4d\x2a\x71\x4c\x6a\xae\x60\x06\x10\x01\x7b\x6d\xf9\x43\x87\x5e\x79\x08\xa9\x01\xd5\x71\x3c\xca\x3b\x75\x4f\xe6\x6c\xef\x34\x95\xad\x1d\xec\x89\x46\xc1\x6e\x6a\x7b\xb3\x79\x4d\x14\x5d\x56\x16\xb6\xe3\x78\x3b\xbd\xca\x6a\x02\x59\xb7\xd5\xf7\x29\x9a\x68\xf6\xda\xed\x06\xb7\x7c\x50\x47\x13\x05\xe0\x47\x98\x4b\x7a\x20\xfe\xc2\x1a\xbd\xd3\xd0\xb1\xb5\xdb\x0e\x70\x19\x44\x50\xd0\x81\x71\xd8\x5e\xf9\xc2\xbd\xde\x61\x3d\x9d\x20\x08\xb6\x03\xd8\xab\xf6\x22\x0e\xa2\x58\x8d\xed\x4a\x6b\x27\x98\x96\x10\x4c\xcb\x33\x0e\xb3\x9e\xc9\xe4\xe0\xda\xee\x73\x8d\xb6\x65\x01\x46\xa9\xad\x0d\xca\xbc\x64\xe6\x17\xf7\xc8\x8c\xd3\x97\xc3\xcc\x03\xb4\x5c\xf5\x56\x16\x38\x90\x33\xe3\xda\xe4\xa4\xe6\x0a\xde\x48\x9d\xdc\x03\x4b\x3a\xaa\x0f\xac\x28\xd7\xef\x8e\xbf\xb8\x8a\x54\xd3\xe4\x4d\x03\xf8\xe0\x10\x9c\x9a\x0a\x8b\xb2\x6b\x31\xeb\xab\x1b\xc1\xb4\x37\xaf\xc4\x0f\xb9\xea\x5f\xe4\xe2\xaa\xd5\xb0\x97\x0a\x44\xc9\xd1\xb7\xa6\x58\x6d\xe6\xd2\xf9\x1e\xd1\x44\xad\x5a\x80\x62\x79\xd3\x04\x4b\xba\xcd\xab\xa1\x05\xdd\x4e\x5d\xa3\xfd\x8b\xb5\x82\x72\x83\xfd\x2d\x0f\x07\xc7\xc1\x6c\xc3\xdb\x81\x5e\x8a\xad\xa8\x3a\x65\x2c\xf7\x53\xf2\x34\x8d\x64\xa9\xf2\xed\x7d\x04\xc2\x5a\x5e\xc2\x7d\x39\x62\x01\x0e\x22\x91\xd9\x65\x11\x7d\x24\xf5\xd9\x8a\xa5\x51\x25\xea\xb2\xb8\x41\x54\x98\x4d\x29\x45\xaf\x02\xe0\x87\x93\xe1\x5a\xba\x9f\x66\xcc\x55\xb4\x89\x4c\xad\x60\xe8\x22\x16\x01\xe7\xfe\xb7\x95\xce\x99\xad\x47\x6c\xa2\xd5\x8a\xe5\x3c\xda\x09\xb9\xc1\x06\x64\x19\x7f\xa8\x55\xa6\x86\x16\x01\xe6\x27\xc5\x6d\x76\x5f\x0f\x7c\xab\x0d\x2d\x68\xd7\xc5\xd0\x84\xa3\x75\x8a\xb4\xeb\x7f\x34\xb4\x75\x32\x4d\x35\xac\xf5\x07\x38\x9f\x7c\xd7\xe9\x05\x76\x6d\xde\xde\x60\xe5\x56\xf5\x7c\xea\x96\xb2\x3d\x8b\x55\x1e\x31\x95\x43\x5b\x9f\x5f\x43\xd2\x94\xfe\x65\x05\x9c\x6a\x9f\x16\x75\x0a\x24\xbb\x0a\x0f\x74\x68\x51\x20\x9a\xa4\x23\x4a\x3d\xd8\x5b\x68\x7a\x17\x50\x46\xff\xa8\x8f\x09\x06\x72\x08\xc4\x7b\x80\x26\x4b\x2e\xab\xa9\x5d\x4f\x3b\x45\x78\xc7\x52\xd0\xa4\x4a\x81\xeb\xc7\x11\xf6\x56\xb4\x32\x80\x2e\xf5\xc5\x41\x6d\x93\x88\x35\xca\x8c\x0d\x8e\x39\x69\x83\x24\x13\x7a\x60\x98\xbb\x76\xb6\x02\x2a\xbe\xe7\xb3\x96\x1d\x28\x88\x55\xac\xfa\x59\xf7\x45\xdc\xdc\xe8\x66\xab\x76\x9e\x99\xe0\xfd\x29\x61\x4a\x1f\x32\x49\x7e\xb6\x37\x5a\x3c\xdc\x2c\x66\xa7\x08\xed\x42\x51\x56\xb6\x9d\x0b\xee\x2e\xc5\xb1\xc1\x86\x0c\x78\x7d\x65\xc2\x08\x21\x8c\xd8\x76\xa2\xe7\x64\x8f\x46\x22\xba\x50\xb0\x09\x20\x7f\xf4\xca\x47\x13\x13\xf7\x4a\x48\xa6\xa9\x4e\xbd\x74\xb7\x3d\xb1\x82\xec\x59\xc9\xbe\x63\x35\xb5\x8f\x5f\xb1\x9a\xd2\x84\xec\xc7\x63\xf6\xfe\x4c\x3e\xb5\xb4\x8b\x87\x35\xa1\x28\x8b\x0b\xd1\xbe\xd3\xfc\x9d\xe5\x50\x2a\x84\x2f\xa1\x8c\xd4\xb0\x19\xb0\x4b\xab\x73\x9b\xa9\x38\x1c\x98\xe2\xf5\xb2\x03\xc9\x9a\xad\x22\x01\xd3\xd3\xc2\xf0\x54\xdc\xad\x85\xf6\x2d\xf8\xbc\x2c\xdf\xc5\xf1\x63\x5f\x80\x72\xa7\x35\x78\xc1\xd7\x55\xb6\x16\x94\xed\xcf\x78\x3e\xd6\x47\xf5\x11\xe7\x5f\x0d\x74\xb0\xb4\x70\x86\xbe\xb9\xae\x2d\xf2\xa5\x22\x34\x21\x41\x2b\x97\x42\xe9\xe3\x38\x1a\x01\x37\x10\x34\xc2\x1f\xc9\x06\xec\xfd\x02\xb6\x48\xaf\xf3\x6b\x51\xee\xb5\xcc\xeb\x70\x78\xcf\xf6\x14\xf4\x01\xda\xe1\xf4\xc3\x15\x22\xb2\x92\x82\xcc\x98\x60\xd7\xa4\xa2\xcb\x2a\xf9\x8e\x89\xce\x94\x33\xe8\xd6\xfb\x39\x15\x5d\xaa\xe4\x3b\xfd\xf1\xd9\xd1\x47\x7d\x89\xdc\x57\x94\x76\xf7\x87\x7d\x1c\x42\x3c\xf6\x78\xb2\xf4\xc4\x41\x5b\x11\xc2\xf1\xab\xe6\x0f\x87\x45\x97\xa7\x18\x46\x28\x2a\x7d\x86\x90\x15\x2a\xfd\x68\xb5\xc8\x0c\x22\xe1\x3a\xdc\x2c\xab\x34\xf6\xd8\x04\xa3\x87\x49\x64\x75\x60\x65\xfa\xe1\x44\xac\xd2\x67\x2b\x17\xdb\xd8\xa5\x7c\x18\xa6\xcc\x74\x0e\x90\x63\xe6\xa6\x0c\x2f\x94\x99\x4a\x15\x12\x40\x1a\x29\x03\xfe\x9a\xad\x82\x73\x8d\x1f\x5f\x7d\x8c\x57\xc0\x62\x5a\xf1\x5e\x32\x4c\x50\xbb\x85\xb8\xf4\x04\x18\x56\x4e\x7e\x62\x6b\x0a\xeb\x15\x61\xf6\x52\x8d\x3d\x54\x1f\xd8\x6d\x07\x15\x59\xb2\x2a\x5b\x8c\x62\x99\x21\x44\x20\x67\x15\xb7\x6c\x17\x65\x39\xb7\xd7\x8c\x06\x7d\x29\x03\x98\x23\x94\x65\xed\xe8\x5a\x86\x23\x6c\xa9\x02\x17\xaf\x07\x04\x2d\x00\x9f\x9f\xf5\x1b\x5d\x1e\xb5\x91\x08\x36\x99\xc8\xde\xe6\xd5\x47\x45\xec\x8f\x2d\x91\x67\x1c\xdb\x0a\x67\xa3\xd2\x12\x39\x00\xb8\xcb\xed\xf7\x15\x1b\x49\xca\x3c\x1d\xd5\x18\x50\x13\x52\x42\x71\x57\x23\xba\xa3\x69\xcd\x4a\xa3\xaa\xd6\xac\xa1\xd4\xef\xfe\x28\xa0\x26\x13\xfa\x05\xa8\xd3\x8a\x65\x44\xb5\xbb\xd6\xcb\x83\x03\xbc\xea\x8e\x80\x3f\x40\xaa\x8a\x9b\xf8\x9a\x2f\xa4\x12\x95\xcc\x8a\xe6\xbb\x4c\x5e\x8a\xe6\x3b\xcc\x9c\x90\x6b\xd1\x98\xf8\x2c\x0d\xd0\x6f\xf3\xfd\x77\x5f\x50\x8d\x83\x9f\x9c\x2e\x1e\x43\x2f\xed\x3c\x6b\xaa\xf9\x02\x67\xed\xba\x44\x94\x15\xff\x38\xbd\xcd\x2a\x19\xc7\x22\x8e\x7f\xb0\xc2\x46\x1d\x84\x9a\xf6\xb3\xb8\x9b\xaa\x7d\x4b\x27\xbe\x25\xed\x2a\x3a\xbd\x16\x75\x9d\x5d\x0a\xc4\x51\x00\xaa\x81\xdb\x25\x3b\x37\x82\xe6\x57\x2e\x67\xd0\x1f\xfa\xd0\xc1\x35\xfe\x43\x7b\x9e\xa5\x07\x7d\x62\xfb\xb4\x03\x3b\x2d\x0d\xfc\x84\xd0\x87\x57\x36\x1a\x7e\x2f\x1a\xf4\xcb\xaf\xbf\xb2\xfe\x86\x5f\x96\xd9\x46\x6c\x22\xf6\x09\x50\xdb\x60\x5e\x13\x08\xfa\x13\xea\xfa\x4a\xb4\x6a\xd0\x4a\xc8\x3b\xfd\xb5\x2b\xf7\xa9\x59\x69\x41\x53\xcb\x04\x05\xbc\x86\xa6\x01\xdd\x21\xc3\xdb\xca\x6d\xc6\x96\x6d\xf5\xf7\x53\xce\x99\xce\xfe\x43\x96\xab\xc4\x3e\x77\xf6\x1c\x31\x86\x01\xcb\xc9\xc4\x56\xac\x73\x42\x3b\xa1\x2b\x80\x69\xa6\x7f\xc1\xd5\x6a\x10\x95\xc7\xf1\xec\xac\x93\xbf\x69\x3e\xed\xec\x8a\x57\x0c\x86\xb8\x87\x83\x1f\xb4\x1e\x12\x37\x23\xc3\x05\x94\xd7\x3b\x5c\x8d\x00\xdd\x0f\x66\x38\xdb\xdc\x9f\x63\x0b\x34\x8d\x9e\x2d\x7b\xc0\x0b\xbf\xc4\xf1\xe8\x95\x0f\x14\x65\xf5\x1a\xd3\x4d\x79\xbe\xae\xca\xa2\x58\x76\x16\xda\xb6\x48\x13\xf2\x6a\x20\x8e\xf7\x23\x2b\x77\x9c\xd1\x2d\x9b\xd9\x3a\x4f\x06\xec\x14\xc1\x20\x19\x9c\x35\xe8\xa6\x00\x1b\x82\x7c\x1b\x70\x27\xfc\x16\x36\x01\x38\xfb\xd7\x50\xf2\xe0\xc8\xcf\x24\x7d\x02\xf2\x80\xb3\x64\x5a\xaf\xd8\x68\xa6\x2b\x5d\x38\x2f\x76\xaf\x58\x81\x75\xac\x2e\x00\xea\xa7\x6f\x73\xd5\xe6\xdd\x20\xe3\x4b\xe2\x59\x94\xca\xdd\xd6\x40\x13\x52\x70\xc5\x8e\x55\x77\x16\xc4\x8a\x40\xea\x2d\x75\x9c\x45\xab\x7e\xf2\x8e\x15\x8a\xc0\xb0\x9a\x49\x96\x2d\xab\xa4\xb2\x0d\x20\xa5\x66\xee\x53\xe0\x4c\x91\x2f\x45\x52\x78\x7e\x8a\x26\xfb\x25\x32\xcd\x56\x90\x91\x94\x07\xf6\x33\x3f\x7d\x33\xb9\xae\x27\xa7\xec\x37\x7e\x3a\x31\xe6\x02\x34\x94\x3e\x7d\xdf\x15\x85\x4f\x55\xf9\xfd\x6e\xe7\x0d\x0d\x7c\xb6\x1f\x83\x5d\xd2\x7a\x4f\xff\xcc\xa2\xeb\x7a\x12\x84\xcf\xf9\x8d\x7d\x6f\xac\x13\xfe\x19\x8c\xff\x11\x55\xb7\x09\xaf\x14\xbe\x8f\xc6\xed\x5b\xa0\x3a\xfa\x0c\xfc\x18\x04\x73\xf6\xba\x58\x63\x66\x01\xab\xd2\xf1\x67\xd3\x7d\xbe\x19\x8f\x0f\xfa\x97\xcf\xd9\x67\x81\x66\xcb\xc4\x3a\x3a\xa2\x72\x0a\xaa\xa7\xa0\xb6\x5e\x38\x95\x87\x03\xfb\xa7\xb9\x01\xbc\xed\xcb\xb2\x57\x82\xab\xc4\x0a\xed\x4d\x50\xe1\xd6\x17\x9e\x85\xf9\x98\x35\x43\x56\x90\xbc\x6e\xf3\xcb\x7d\x05\x1e\x02\x6a\x31\x8a\x65\x87\x1b\xaa\x4a\x1e\xd1\xf0\x1a\x55\xba\x1e\x81\x55\x95\x1e\x89\xcf\x14\xcd\xd3\x1f\x89\xa2\x2b\x2e\xdb\x0b\x34\xf5\xad\xaa\xe6\x4b\x45\xbb\x97\x87\xe6\xfd\x3b\xc4\x83\x75\x37\xd0\x0e\xb3\x87\x65\xa7\xe1\xa4\x37\xf2\x38\xee\x25\x98\x1e\x1c\x58\xb6\x5e\x8b\xba\x4e\x1e\x01\xfa\xb6\xfa\xa6\x79\x44\x1a\xeb\xb3\xc8\xa5\xd7\xb5\x00\x32\x13\xcd\x0c\x01\xcb\xd8\x3a\x59\xab\xf2\x5c\xca\x04\x94\xa8\x2f\x63\x6a\x99\x3e\xb8\x7a\x74\xfb\xbb\xe8\x6c\x6d\xfa\x10\xbe\x2a\xfa\x20\x39\x51\xbc\x7b\x13\x32\x18\x59\x2d\x73\xfe\x91\x26\x44\x71\x8c\x97\xe6\x08\x25\x98\xaa\x55\xa2\x3a\xf2\x4a\xa7\x6e\xb2\x8c\x82\x9c\x4c\xa8\xbd\x88\x06\xe7\x46\xb9\x5a\x1d\x6c\x63\x66\x26\xce\xa7\x9d\xfb\x7d\x61\xce\xfe\x7e\xb0\xb3\xdc\xa0\xbf\xdc\xa6\xf3\x15\x62\xf4\xab\xac\x7e\x99\xa9\xec\x8f\xc3\x7c\x3b\xf6\x38\x1e\xf5\xfb\x03\x07\x7e\x8d\x82\x7f\xd2\xae\x0b\x9f\xb1\x6f\xed\xef\xdf\xad\x21\xc3\x83\xb1\x62\x78\x7a\x71\x68\x2e\x52\xf7\xbc\xa2\x4f\x4e\xd9\xff\xf0\xd3\xf4\xf9\xe4\x5f\xab\x10\xd3\xfc\xab\x07\xe2\xed\x52\x70\x7e\x7c\xed\x0c\xd4\xb2\x15\x8f\x36\x99\xca\x26\x61\x1c\x9d\xff\x61\xd1\xe4\x49\x1c\xf5\x5d\xff\xfb\x20\x45\x64\xdf\x76\x0f\xa6\xce\xfa\x58\x27\x79\xa4\xaa\xbd\x00\x59\x20\x39\x97\x70\xfd\xdd\x42\x58\x0b\xed\x01\xa4\xaa\x11\xd0\x38\xbe\xe6\x26\x84\x15\x42\x56\x8e\xf3\x71\x14\x2d\xc7\x79\x62\x3d\xa8\x73\xba\xfc\xfb\xf9\xd7\xff\x80\x7a\xa6\x86\xdf\x5e\x92\x07\x47\xc5\xc3\xb7\x01\xb8\x1a\x8f\x26\x77\xd2\x73\xf3\x2e\x0f\x2d\xbf\x30\xb4\x68\x36\xdb\xb7\x53\xfb\x91\xc0\x14\xf6\xa7\xe0\xed\xc0\x36\xdd\x32\x9d\xfd\xf6\xed\xd4\xec\x47\xd7\x07\xbb\x45\x7a\xcd\x60\x9b\x7c\x6b\x39\x27\x1b\x7c\xf1\xe7\xf7\xd5\xfa\x53\xbf\xd6\x9f\x1f\xad\xf6\xa7\x4e\xb5\x07\xda\x35\x69\xea\x36\x22\x99\x37\x6a\xb2\x41\xfc\x8d\x76\x97\x65\xbc\x8c\xe3\x32\x30\x43\xed\x42\x8c\xde\xbc\x5d\x85\x4d\xce\xbf\xc5\xb1\x17\x2e\x00\x80\xa6\xd2\x43\x53\x1c\x8f\x7e\x32\x5f\x58\x64\x27\x11\x56\x9d\x75\xa4\x81\x82\x67\xdd\x8d\x0b\x0e\x3f\xd3\xa7\x01\x30\x68\xa4\xd2\xd7\xa4\x1b\xd6\xd9\x07\x7a\xb0\x90\x09\x42\x51\xf1\x1f\x89\x53\x3b\x7d\x44\x29\xfb\x97\x71\x85\x84\x89\x08\x5d\xfc\x34\xad\x07\x9a\x0d\x23\x6a\xe6\xf6\xe1\x48\x98\x62\x31\xa1\x16\x92\xf9\xd9\xc2\x92\xa1\x46\x7c\xc2\xd2\xd2\xe4\x49\xff\xc2\x7f\x3b\x9b\x98\xad\x32\x40\xac\x82\xf6\x37\x3d\x51\x6e\xbe\xc0\x52\x2c\x95\x8d\x77\x69\xbe\xfc\xab\x97\xba\xf8\xdd\xce\x40\x23\x09\x2d\xaa\x96\x0e\xb2\xe3\x83\x9d\xf9\x32\x9a\x3d\x06\x8f\x1e\xd0\x1e\x69\xc9\x82\x14\xbe\x9a\xa6\x34\x5c\x39\xa0\xfa\x75\x2f\xf6\x62\x98\x9c\x62\x26\x5a\x6b\x29\x0e\x13\xa5\x68\x7b\x87\xdb\xd1\x74\x21\x04\x82\xfc\xc9\x13\x1c\x26\x11\xeb\xa2\x6a\x9a\x2e\x19\x90\x74\x59\xf1\xce\x0e\x08\x2d\x6b\x24\xa5\x89\xbf\xb8\x80\xb2\x0a\x42\xf5\x03\xdb\x88\xe3\x4e\x01\xdc\x6c\xfb\x36\x6c\xe9\x39\x82\x72\xef\xcd\x56\x09\xae\xf1\x82\x08\xd1\x29\xa1\xf4\x21\xfb\x67\x9d\x0d\x47\x3f\xbd\x03\xe9\x02\x36\xcf\x4e\xf6\x0d\x74\xa5\x19\xd5\xb6\x0c\x7c\x82\xe1\x31\x42\x30\x56\x6e\xad\x56\x5d\xa4\xdc\xb0\x2c\x65\x96\xa2\x94\x5a\x77\xc5\xbc\x4e\xd0\xf7\x1b\x47\x1f\x3b\x18\x8b\x28\x4a\xdc\x05\x53\xc5\xb1\xde\xa1\x5a\x63\xa2\xa5\x0f\x10\xe3\x04\xfd\x4c\x06\x05\x31\x76\xda\x75\x8e\xc8\x61\x44\xb7\x00\xc0\xc7\xc1\x34\x4b\xf6\xa0\x6b\x4f\x1e\x17\x95\xf7\x45\x37\x01\xee\x49\x5d\x5b\x11\x93\x2b\x7a\xf0\x30\xb3\x95\x8f\x81\x8d\x07\x1a\xc1\x9f\xd9\xae\x1d\x59\xf1\x18\xdd\x31\x78\x7c\xcc\x2c\x13\x98\xe7\x3e\xac\x9f\x89\xa5\x5b\x57\x6b\xac\x02\x5e\xa6\x45\x5e\x7a\x6b\x27\x83\x80\x0e\xa8\x10\x3c\x2c\xad\x41\x79\xd1\x85\x00\x93\x4e\x59\xbb\xb8\xe1\x92\x8e\x9c\x95\x5f\xbb\x6e\xb6\x00\x8c\x99\x36\xa2\x37\xea\xdf\xd9\x7a\xbd\x4a\xf4\xde\x63\xeb\x42\x64\xd5\xb7\xef\xad\xc7\x02\x8c\x81\x76\xf8\x44\x0f\xc9\xf8\x42\x66\x6d\xce\xf2\xae\x34\xc9\x10\x03\x96\x75\xac\xab\x02\x05\x33\x7d\x98\x4c\xaa\xa6\xc9\x3b\x47\xe0\x92\xc1\xbb\xf8\xb0\x38\x5a\x38\x88\xac\x15\x17\x4c\x58\x6a\x6c\xaf\xee\x44\xef\x2c\xe6\xd7\xc1\x9b\xa4\xc5\x06\x08\xe1\xc4\x44\x07\x5a\xc1\xa1\xc9\xa9\x86\x48\x20\xfe\xf1\x98\xd9\x37\x0d\x84\x75\x7b\x2c\xab\x8d\x33\xa2\x95\x03\x29\x6f\x0b\x60\x2e\xe8\x9d\xac\x96\xb8\x5f\x78\xf3\xf4\x62\xda\xd0\x8b\xcd\x98\x2c\x93\x54\xbc\x5a\xe9\x0f\x17\x9b\x71\x43\x4f\xed\xa5\x7a\xfd\x6b\x7c\xdf\xb8\xdb\x92\x29\x6f\x28\x0c\x96\x61\xb1\xac\x4f\x75\x7f\x5e\x3d\x0d\x2e\x41\xe6\x69\xf4\xba\xdc\x45\x2c\xfa\x0e\x0e\x22\x11\x8b\x3e\x29\x95\x2a\xaf\x23\x16\x7d\x29\xb6\x2a\x5a\xb1\x4a\xf0\xa3\x53\x7d\xf7\xba\x5c\xbf\x94\x81\x71\x5c\x2f\x08\x33\xb0\x30\xae\xbc\x7d\x80\x54\xa1\xac\x05\xc2\x6c\x40\x18\x8b\xd9\xfb\xae\x2c\x6d\x08\x1d\xf2\x5f\x54\xdb\x34\x9d\x4a\x48\xa9\x23\xa7\xf7\xf2\xd9\x29\xcd\x04\x1f\x3a\xd3\x44\x12\x2a\x3d\xd0\x6f\x01\x66\x5b\xd0\x69\xad\xee\x0b\x01\xc1\xed\xae\xc8\xee\x11\x74\x4a\x57\xd9\x49\x8d\xe3\x1c\xc7\x9e\x38\xf6\xa5\xcf\xa7\x6b\x8d\x88\x22\x9b\x23\xa2\xc1\xc9\xb4\x16\x3d\x73\x0c\xc8\x10\xf8\xb3\x19\xab\x79\xd5\x06\x6e\xf0\x43\xae\xa6\xeb\x7d\x45\xc2\xe0\xec\xfe\x93\x6b\x46\xc1\x35\xe6\xc0\xf6\x1c\x00\x54\x80\x2d\x96\x3a\xaa\x2b\x4c\xb0\xeb\xfa\x1f\xfb\xeb\xb7\x02\x02\xd4\x65\x84\xf0\x89\xb8\xdd\x60\x1d\xf0\xcc\x71\xdc\xcb\xd6\x34\xc8\x34\xe2\xd0\x8c\x8f\xf7\x34\x8e\x95\x30\x66\xbc\xbe\x39\xaa\xcf\x96\xeb\x38\x5e\xa7\x1f\xae\x90\x91\x3e\xec\x4f\xf9\x33\x56\x20\xbc\x08\xd2\xd8\x9a\x8f\xf7\x4d\x33\x0f\x36\xc8\xb9\x99\x49\x3d\xf4\xf5\xb8\xa0\x8c\xcc\x27\x25\x7d\x4a\xe6\x13\x52\xa2\xdf\xa7\xfb\xa6\x99\x7e\x44\xe9\x19\x62\xdb\x91\x8c\xcf\x28\x5b\x9f\xf2\x72\xb1\x7e\xca\x9f\xb1\xa3\xc2\xd6\x0d\xd0\x71\x43\xa0\xbe\x6b\x3e\x5e\x37\x0d\x9a\x85\x61\xa5\x4c\xe7\xab\xe5\x7a\x4c\xf0\x3b\x9e\xd3\xa7\x32\x7d\xb6\x4a\xc6\xf8\xcb\x20\x9b\xd1\x26\x0c\x8a\x17\xac\x82\x70\xb2\x52\x7c\xcd\xaa\xa9\x90\x1b\x44\x5b\x63\xb9\x16\x46\xec\x05\x94\x04\x6e\xd2\x4f\x0a\xd1\xf3\xfc\xe8\x39\x5c\xa7\x2b\xb6\xe6\x33\x5c\x05\x6b\x31\xce\x62\x7d\xb6\x5d\xac\x11\xf3\x02\xe7\xc9\xf5\xca\x82\x92\x73\x7c\x0e\x01\x88\xa9\x25\xf1\xb0\x83\xb1\x14\x08\x03\x62\xf0\x49\x15\x00\x51\xd3\x68\x66\x08\x5f\xe1\x81\xdd\xad\x04\x5a\x7e\xeb\x1f\xd5\xfb\xa2\x6f\x63\xac\xa8\xab\x97\xec\x79\xc6\x4b\x8b\xcd\x58\x86\x78\x8b\x15\xed\x6d\xa6\x9a\xe7\xde\xb1\x84\x91\x3d\xdf\x43\x84\x04\xd9\x62\xc9\xb3\xe9\xdb\x72\x73\xdf\xb9\x7b\x24\xeb\xb9\xb7\xd5\x94\xb2\x3d\x37\xe0\x52\x06\xfd\x67\x65\x60\x9a\x66\x09\xae\x0e\x6e\x09\xde\xdb\x4f\xc0\x5e\x5f\x83\x1c\xbd\x85\x3e\x24\xa2\x4c\x37\xcd\xf7\xb0\x9d\x4c\x4c\x1e\x7b\x13\xa0\x1e\x8c\x49\x61\x86\x61\x0e\xe6\x4a\xdb\x7a\x68\x07\x83\x35\x9f\xf9\xa5\xc0\xf4\x8d\x38\x0a\xe2\xcc\x9c\xae\x57\xbd\x29\xc4\x97\xc7\xcc\x89\xeb\xab\xf2\x76\x60\x0f\x16\x96\xbe\x69\x0e\xf5\x2a\xdf\x88\xc7\xf3\x40\x9b\x5b\x5e\x5e\x86\xd6\x1f\x1e\xb3\x45\xb8\xa6\x5e\x64\xa1\xfe\x73\x69\xcd\x3f\xd1\x30\xb1\x96\xe4\x68\x80\xd0\x61\x16\x20\xb3\xad\x2c\xcf\xcd\xaf\x2b\xe8\x5e\x4d\xd9\x83\xa7\x2a\x6b\xc1\xb6\x82\xed\x84\x39\x97\xbb\x40\x48\x8d\x0e\x8d\x04\x47\x1f\xb6\x11\xfc\xb4\xeb\x2c\xd4\xb1\x47\x7b\x4a\x4f\x73\x76\x85\xe2\x4f\x9a\x37\xd7\xe5\x66\x5f\x88\x27\xcd\xc5\x29\x59\x26\xbf\x64\x37\x59\x23\xd6\xd7\x19\xad\xd7\x55\xbe\x53\xa7\xf9\x62\x0d\xd2\x61\xa0\xc4\x01\xd9\xa7\x55\x76\x89\x5f\xd2\xbd\x42\xf1\x55\x0f\x98\xdc\x15\x8a\x64\x2b\xf8\xd1\xb7\x47\x6e\x67\xb2\x97\xfc\xe8\xa1\x44\x94\x6d\xfb\xd7\x23\xdb\x78\x6f\x11\xf3\x4f\x03\x99\xec\xd5\x3f\x0a\xf8\xb2\x7b\x4b\xd0\x16\x37\x0f\x98\xa0\x71\x2f\x8a\x52\x0a\xbe\x46\xac\xe3\x52\x9a\xbb\xe1\x46\x33\xda\x7b\xf3\x41\x5c\xa7\xb6\x39\xb6\xee\x3a\x41\xba\x0b\xfe\x3f\xbe\x3b\x3b\xf5\xcf\x11\xbb\x9f\xca\x52\x37\xf0\xc2\x14\xe3\xa3\xd1\x5a\x3c\x5a\x77\xe8\xa1\xd8\x6f\xc0\x5f\xe2\x65\x1f\x50\xb7\x79\x34\x75\xfa\x5a\x34\x64\x5c\x0a\xfe\xa0\x10\x10\x2e\x49\xe7\x2c\x3a\xd3\x71\xf8\x3f\x8e\x58\x74\x76\x6a\x1f\x57\x6c\x5d\x16\x49\xfa\xcc\x7f\x3c\x5b\x97\xc5\x65\x55\xee\x77\x26\x9b\x7f\x0b\x4a\xa8\xaa\x53\x40\x01\x8b\xd8\x4a\xf5\x63\x98\x75\x93\xa4\x1f\xf6\xb3\x9e\xa9\xca\x66\xaf\x3e\x1e\x28\xf3\xb3\x1d\x7e\x92\xce\x10\x7f\x34\x8a\x56\x01\xf2\xb6\x32\x05\xcd\xa3\x4b\xb7\xbf\xe5\xe3\x37\x14\x5a\xf8\x6a\xaf\xa3\x5b\x0e\x27\xeb\x43\xe1\xd3\x88\x26\xc3\x35\xf5\xef\x4d\x5a\x1e\x27\xf9\x1a\x70\x2d\x9c\x63\xf4\xe1\x85\x12\xc7\xcf\x75\x9f\x97\xde\x02\x56\x68\xf1\xbc\x6c\xcd\x3a\xef\xfb\x24\x89\xcf\x82\x30\x35\x3e\x98\x8a\xc1\x8d\x22\x95\x2b\x16\x5d\x16\xe5\xdb\xac\x80\x4e\x31\x62\xf0\xc0\x31\x34\x46\xf5\xbf\x51\x7a\xb8\x14\x53\x3d\xc7\x1c\x0f\xdb\xb2\x54\x78\x70\xeb\xaa\x9f\x33\x03\x3f\xf8\x0e\x58\x61\xfa\x01\x5f\xd4\xc6\x43\x57\xd3\x90\x4b\x81\x67\x5f\xcc\x42\x9d\x06\x2c\x7b\xd3\xd7\xf5\xbe\x50\xf9\xae\x10\xfc\x03\xf7\xf4\x81\x59\x69\x77\xc7\xd7\xca\xf0\x6c\xd7\xc0\x47\x4d\xfc\xa7\xe5\xc5\xed\x78\x71\xda\xae\xee\x9d\x08\x83\x51\xb8\xd9\xf0\xa4\x59\x5f\xf0\xaf\x1e\x45\x3e\x26\x1c\xd1\x86\xcf\xd8\x55\x3b\x77\x9b\xb3\xab\xc5\xc6\xb8\x79\xe8\x90\x55\x9b\x15\x35\x41\x3b\x4b\xda\xd7\x11\x95\x94\xba\x25\xda\xb1\x56\x78\xb4\x4c\xcb\x55\x52\xb6\xaa\xa1\x6b\xe7\x3e\x4f\x11\xf8\x21\x6b\x9a\x6d\x07\xa5\xb8\xfe\xf5\xb1\x5e\xcd\xc9\xc6\xb2\x5f\x25\xc4\xc5\x06\xb4\xe9\xf1\xe5\x00\x7b\x7e\x09\x42\xd9\x34\x97\x62\xea\xf6\x02\xcb\x02\x24\xb0\x07\x27\x74\x3e\xbd\x52\xd7\xc5\x37\x95\xb0\x26\xcc\x25\x1d\xef\xc1\x13\xad\xf9\x1e\xfe\x8d\x86\x5d\x5b\x43\x92\xc5\xb3\x00\x29\xb4\x03\xcc\x82\xc8\xd0\x94\x91\x8c\x6f\x43\x73\xf1\x30\xb2\x2b\x8f\x22\x1b\x98\xc9\x9a\x04\xda\x11\xbe\x16\x77\x96\x3d\x07\x81\xee\x95\x60\x9b\xe0\x86\xcc\x5d\xba\xb1\x21\x38\xaa\x38\xee\x98\x5f\x96\x30\x73\xc9\x7d\x7c\xa9\x60\x9a\x0b\x9e\xa3\x66\x96\x71\x84\x10\xec\x4c\x31\x78\x0c\x43\x8f\x22\xad\x8a\xbb\x17\x24\xa3\x38\xb9\xaf\x83\x36\xb3\x74\x8d\x36\xaf\xdc\x6a\xb9\x50\x93\x11\xf5\xee\xbb\xa5\x17\xc2\x6d\x35\xa3\xf8\x16\x24\xf0\x9d\xb8\x3f\x65\xb7\x96\x96\x5e\x97\xfb\x5a\x34\xbb\x32\x87\xda\xbe\xc1\x89\x45\xdc\xa9\x6b\x21\xf7\xcd\xa6\xca\x2e\x9b\x4d\x55\xee\x68\xb3\x2e\xf2\xf5\xbb\x53\xf6\x5a\x97\x49\xdf\x4c\x57\x4f\x29\x8e\x77\x53\x32\x1d\xd3\x86\x06\xe0\xfd\x42\x78\x4e\x62\x34\x6b\x77\xff\xab\x20\x39\xb8\xf9\xfc\xdc\x22\x05\xdb\x45\x38\xcd\xf6\x2c\x94\xec\x97\x57\xdd\x58\xad\x81\x24\xfa\x00\xf7\x61\x12\xe9\x48\xaf\x10\x69\xab\x40\xa5\xf7\x2e\xd8\x6b\x2e\xcc\x74\xc6\xea\xae\xe6\xd4\x22\x40\x8b\x9a\xa0\x3d\x3d\x3a\x55\x83\xcb\xad\x78\xd5\x34\x92\x39\x31\x37\x65\x8a\xea\xea\x6b\x5d\xbd\x82\xf6\xb2\x0c\xb8\xb1\x7c\x6b\xdd\x62\xaa\x38\x36\x0f\xf9\x12\xb2\x2b\x88\xbf\x5c\x15\x89\xfd\x00\x01\x96\x6d\xd2\x77\x47\x22\x77\xc5\x2a\x9f\xd7\xbe\x06\x3d\xb0\xa1\xc2\x73\x9a\xf3\x57\xc2\xc3\xd4\xa8\xbd\x4b\xc5\xf5\x06\xb9\x4a\x7d\x4e\xc9\x19\xc9\xf9\x31\x3f\x77\x72\x4e\xe8\xb4\xdc\x42\x84\xc8\xb2\x61\x2b\xc3\x03\x9d\x5e\x42\x01\x99\xe9\x1f\x1d\x02\x0f\x0f\xfc\x5c\xbf\x8f\xc7\xb8\x87\x63\x40\xaa\x82\x8b\x3b\x94\x96\x1c\x58\x29\x4f\xce\x2a\x2d\xd3\x6d\x17\xe9\x39\x66\x51\x2f\x4f\xb9\x24\x16\xfd\xb3\x1c\x4e\xae\x2c\x2c\x8f\x3c\x0f\xe0\x72\xf4\x05\xe3\xc9\x68\xce\xae\xf4\xa5\xff\xd5\x23\x9e\xc3\x96\x58\xa0\xd5\x5c\x9f\x00\x11\xf2\x22\xaf\x5f\x57\xf9\xe5\xa5\xa8\xac\x83\x55\xbe\xd2\x22\x76\x17\xed\x83\x12\xd7\x62\xbd\x13\xeb\x3c\x2b\xd2\x7c\x65\x9c\x52\x20\x3e\xbc\xcc\x94\x3d\x83\xc2\x48\xa4\xdc\x41\x27\x9a\x5d\xea\xa8\xc5\xa4\xdd\xd3\xd5\x80\x01\xd1\x4f\xad\x08\x19\x91\xe4\x98\xc2\x25\x36\xa6\x67\xcc\xf6\x83\x50\x56\x41\x2a\xed\x24\x33\xf6\x33\x6d\x1a\xb5\xec\x14\xd7\x17\x84\xc3\x9f\x00\xd9\x5d\xfc\x85\x13\xd3\xa3\x2f\xae\xaf\xc5\x26\xcf\x94\xe8\x74\x8d\xc1\x20\x54\x0f\xeb\xa5\xc1\xb4\x04\x86\x5b\x5a\x71\x6b\x70\x5e\x1b\xec\x84\x74\x9a\xb2\xca\x5d\x37\x27\xca\x4c\x1d\xf1\x02\xe9\x0a\x32\xbf\xf3\xa9\x36\x82\x68\xb5\xd2\x94\x39\x4d\x81\xf5\xfb\x84\x05\xc9\xfb\xfa\x07\x1e\x3f\x10\x1c\x3a\x31\x69\xae\xfd\x58\xbb\x00\xf0\x42\xc0\xbe\x45\xa7\xf1\x07\xc3\x02\xe0\xd6\x9e\x8e\x1f\x81\x62\x62\x20\xd4\x93\xa1\xac\x6c\xc7\x6c\x30\x46\x37\xcd\x1a\x34\xfe\x09\x8d\xe7\x83\x9c\x5a\x88\xd2\xa7\x5e\x52\x72\x49\x5d\x0a\xcb\x61\x48\x65\x19\x21\x1d\xa4\xe8\x11\x5f\x43\x5c\x58\x85\xd9\xb5\x9b\x44\xf6\x36\x09\x4e\xa7\x37\x66\x3a\x6b\x1c\x50\xdb\x37\x6e\xd5\xed\x86\xec\x68\xdc\x81\x63\x44\xc6\x6f\x6c\x1f\x90\xbd\x7d\x1b\xd8\xc4\x43\x5c\xdd\x79\x1c\xf7\x56\x4f\x6c\x46\x2e\x66\xf0\xd2\x7d\xc2\x91\x17\xe3\x70\x3b\x3f\xd8\xf6\x76\x59\x10\x50\x14\x52\x26\x62\xa8\x4b\xa8\x12\x8e\x22\x7f\x3f\x9a\xa5\x4b\x08\xfc\xb4\xe1\x97\xb8\xfa\xe1\xb5\xe5\x06\x04\x42\x5c\x41\x63\x00\x5e\x80\x5d\x71\x52\xa7\xcf\xec\xb5\x44\x36\xd0\xcd\xd4\x45\xba\xa1\x0c\x97\x13\x6c\x79\x7f\x27\x6e\xf4\x4e\x64\x1b\x4e\xf2\xe5\xb6\xb3\x1f\x93\xed\xf4\x6d\x2e\x37\xd8\x9a\xb4\x69\x36\xec\xd1\xb2\x6b\xde\xea\x52\x00\xa9\xc9\x86\x95\x55\x7e\x89\x82\xc9\xa5\xd1\x36\x56\x1e\xab\x48\x86\xa5\x4b\xcc\x0a\xda\x70\xda\x65\x95\xe4\x2c\x0c\x7a\x91\x68\x48\x78\x24\x22\x86\xd3\xa3\xb2\x16\x6b\x5d\xd9\x28\x3e\x53\x48\xc0\x4a\xca\xc8\x8e\xef\x0d\xbb\x46\xec\x23\xbc\x2f\xfc\xe8\x5e\x94\x7b\x89\x00\xbb\x5b\xec\xca\xfd\x2e\x8e\x47\x70\x26\xb6\x6f\xd6\x8d\x94\x55\xec\x8a\x65\xc0\x13\x47\x06\x49\x71\x7c\x9c\x46\x36\x2c\xa3\x94\x6d\x91\x19\xf3\x8c\x5f\x57\xd3\x9a\xb2\xb5\x85\xaf\xca\x41\x70\x37\x81\x9b\xe9\x80\xd8\xa9\x8d\x5b\xb9\xeb\xf6\x77\x3c\x66\x33\xb6\xa6\x89\xe5\xa4\xd6\x2d\x1a\x37\x1b\x16\x83\x84\xfc\xc1\xa9\xc8\x02\xfc\xcd\xd4\x1f\xdb\xb9\x56\xd1\xa8\xc5\x98\x16\x5d\xe8\x7d\x7c\x13\xc7\xc1\x96\xa2\xf4\xa1\x80\x9a\x8f\xa8\x3f\x08\xb4\xf9\x96\xf4\xe0\x56\xfd\x47\x70\x4b\x1f\x1e\x05\x3d\xbd\xb8\x9c\x54\xef\x07\x5d\x7d\xd3\x09\xab\x39\x5a\xe9\x5c\xca\x1a\x91\x37\xcd\xc5\xc5\x94\x46\x63\x07\x41\x17\x17\x53\xb2\x4c\xa6\x4f\x2f\x20\x5f\x87\x9e\x8f\xe0\x09\x17\x21\x41\xa9\x3c\x14\xa9\x74\xcd\x77\x69\xb9\x62\xa3\x3c\x8e\x2f\x47\x88\x47\xe6\x60\xbf\x69\x20\x83\xd5\x0b\xab\xd3\xf1\xd0\x34\x75\x1c\xbb\xdb\x19\xd7\x53\x0f\xc1\xb4\x69\xaa\x38\x06\xe9\x59\x7b\x7c\x08\xf6\xe5\xe9\x53\xd8\x16\xe0\x7a\xd6\x36\x1d\x50\xed\x81\xa4\x84\x03\x53\x58\xa6\x07\x35\x93\x09\xdb\x5a\x21\x1b\x3c\xb5\xcc\x93\xd3\xd2\xad\x29\x5d\x64\x71\x3c\x72\xa3\xd2\x80\xab\x44\x56\x6d\xca\x5b\xe9\x77\x85\x4b\x70\xa5\xae\x58\x80\x3b\xcf\x6d\x95\x7a\x83\x10\xc1\x36\xed\x47\xa7\x1b\xd4\x3b\xf1\xe0\xed\x8c\x36\xb0\x33\xda\x53\xb7\xa0\x5e\xe3\xb6\x19\x03\x2a\x34\xa0\x8e\x66\x74\xd1\x37\x29\x81\x0c\x3a\xd0\xcf\x45\xa6\x07\x27\xba\x92\x3a\x02\xd8\x3b\x74\x3b\xcc\xb8\x38\x39\xad\x36\x44\x31\xaa\x57\x8f\x8e\xed\xf0\x71\x7e\x72\xdd\xda\xe6\x77\xe0\xda\x0a\x4e\x02\xbe\x21\x72\xad\x35\xcd\x10\x59\x49\xf7\x1a\xff\x1b\x68\x5b\x1f\x81\xac\xff\x0a\x89\x32\x58\x62\xc4\xa9\xdb\x33\xc5\xe7\x0b\x75\xa4\xf1\xd6\xf1\x53\x6a\x98\x39\xfb\x2f\xf6\x0a\xd5\x7d\x0b\xe9\xfa\xda\x07\xad\xe0\x62\xa3\x35\x98\x91\x97\x76\x0a\x9a\x46\x63\xb4\x4e\x9a\xc5\x46\xc8\x0c\xc7\x8e\xcc\xf7\xcf\x22\x22\xcb\x5e\xe9\xda\xf6\xac\x80\x55\xa2\x3b\x11\x91\x1c\x76\x0c\x38\x12\xe1\xf6\x22\xc4\xc0\x68\xf9\x8c\x73\x55\xee\x76\x62\x43\x28\x7d\xd8\x43\x49\x01\xaf\x6e\xdb\xb1\x7c\x2a\x0a\x71\xcd\x64\x5b\x0f\x22\x0f\xbb\xe6\x52\x19\x54\x38\xc4\xc1\xb4\x35\xef\xa7\x95\xdf\x29\x16\x2c\xcb\x76\xef\xe8\x2a\xda\x0c\xee\xcc\xe6\x13\xb0\x5f\xf6\xb6\xd9\xaf\xdf\xfe\xc2\x4b\xb6\x9f\x82\x28\xf1\x52\xff\xb4\x56\x61\xb8\x76\xe8\x88\x53\x2d\xfd\x7e\xb6\x2c\xab\xa9\x08\x26\xe5\x76\x24\xd4\xd2\x77\x3b\xdc\x1a\x9a\x3e\x7d\x82\x20\x7b\x28\x17\xf7\x85\xe2\x5a\xee\xbe\x3f\x66\x17\xf7\xc7\x0c\x2f\xf5\x07\xcb\xf5\x74\x57\xd6\xca\x2d\x5f\x1c\x77\xdf\x3b\xcb\xc9\x5c\x4b\xda\x92\x4b\xf7\xaa\x1e\x56\x94\xfa\x6d\xa0\x1d\x5d\x55\x17\x59\xe8\x18\xf1\xe6\x32\x11\xa0\xfd\x7d\x1c\x17\x81\x86\x68\x44\x22\x7d\x5c\x0d\x6f\x47\x98\x23\xea\x8b\xb9\x81\xc1\xda\xa2\x22\xac\x25\x7a\xb5\x28\x78\xd1\xf1\x41\x47\xa2\x0b\xc0\x15\x56\xeb\x6a\xf5\xfc\x53\xd3\x8c\xb0\x1a\x85\x73\x94\xd8\x50\x1b\x85\x16\x22\x9a\x0c\x2c\x3a\x20\x4a\x9e\xed\xb5\x60\xcb\xf3\xb7\x59\x9a\x73\x02\x2f\x0d\xb9\x6a\x03\x53\xba\x4b\x71\x33\x44\x8b\xae\x3a\x6c\x84\xf6\xe5\x24\x26\xa2\x8a\x35\xce\x21\x05\xb5\x11\x98\x6c\xba\xbd\xaa\xb6\xf0\x54\x8d\x32\xd4\x04\xcb\x05\x77\xd9\x80\x0b\x6b\x11\xc7\xd6\xfb\xf5\x01\x50\x90\x14\xed\x3a\x94\x07\xbf\xa4\x85\xd9\xac\xfb\x33\xf5\xbb\xa5\x9c\x43\xe5\x1e\x6c\x7e\xad\x79\x71\x9c\x3e\xda\x55\x55\x30\x7e\x1a\x36\x26\x3d\x3a\x3b\x30\xc5\x1e\x84\xdc\x5f\x0b\x67\x47\xda\xb7\x2b\xd5\xf6\x9d\x3a\x2c\x8c\xaf\xbf\x35\x92\xc2\x06\xc8\x65\x56\xe8\x4a\xfd\xc9\x68\xe8\xdb\x21\xf9\xa3\xc5\x8f\xbf\xa4\x6a\xd5\xb7\x6b\x7d\x6c\x7c\xf6\xcc\xfb\x3b\x43\xba\xad\x72\xe5\x9e\xcd\x89\x0b\xde\x03\x30\x40\xd8\xe6\x77\x9d\x56\xdc\x90\x52\x6f\x1e\xbc\x5a\x8a\x04\x84\xc2\xce\xa4\x36\x9b\xb3\x18\x21\x79\x28\xca\x6c\x83\xeb\xa2\x3e\xd9\xbf\xb5\x56\xb9\x4c\x83\x70\xf2\xa0\x19\xc8\x4e\xd5\xd8\x76\x4a\xaf\x3a\xee\x04\xb0\x0d\xed\x2c\x92\x52\x2e\x84\xa6\x9a\xea\x0a\x20\xee\x55\xcc\x06\x24\xa4\x71\xfc\x5c\x10\xc5\xec\xf6\x60\x2f\x04\x04\x13\x07\x66\x0f\x1c\xff\x6f\x5a\xa1\x0c\xa3\xf1\x62\xf4\xe3\x36\x3c\x7e\xf8\x0f\x1b\xb1\x54\xd4\xb7\xd3\x34\xba\x13\x99\xa6\xdc\x6f\xc5\xb6\xac\xc4\x5e\x9a\x89\x0d\xb1\x5c\x77\x94\x0e\x51\x0b\x8b\xed\xe0\x9c\xd4\x81\x21\x28\xf4\xba\x29\x53\xd3\x4f\x7d\xf3\xad\x2f\x47\x0f\xb8\x31\xad\xc3\xba\x04\x07\x3f\x70\xcb\xf4\x41\x84\x5f\x5b\xce\x7f\x30\x99\xa0\xc4\xc1\x1d\xd9\x3b\x55\xd9\x00\x5a\x00\x80\x4e\x94\x2a\x93\xd5\x3b\x0a\x75\x60\x8d\x29\xba\xd0\xd2\x10\xac\xdb\x72\x60\x13\x71\x6b\x2d\x8e\xef\x16\x69\x9a\x84\xbc\xb6\xb4\xe5\x1b\x43\x69\xc4\x86\xfb\xa0\x95\x3e\xa9\x69\x3c\xce\x3c\xfe\x68\x49\x97\x08\xe7\x6d\xf9\x42\x24\xaf\x5c\x93\x9a\x3a\x78\x30\x88\x63\xdc\x36\xec\xde\x3c\x56\x5f\xfa\x94\x96\x02\x24\x2e\xcd\x54\xd4\x65\x15\x44\xf7\xdd\x64\xd1\x81\x6b\xc5\xc6\x67\xe9\xbc\x5b\xfd\xa7\x99\x02\x84\x0f\xf0\x67\x50\x67\xdf\x84\xdf\xa9\xca\xaf\xc5\xb9\xca\xae\x77\x70\x80\x11\xed\x6b\xd3\xbc\xcc\x14\xec\x1f\x6e\x89\x95\x15\xb5\x7b\x9f\x8f\x66\x7e\x31\x5b\x1c\xca\x1f\x82\x60\x64\x89\xfd\xcc\x8e\x67\x1c\x53\x35\xc4\x21\x99\xf4\xf7\x30\x3a\x26\xc3\x79\x7e\xbd\xd7\xc3\x84\x28\xae\xcb\x30\x24\x47\xa6\x5e\xc7\xa0\xb1\x78\x0c\x0e\x5e\x08\x06\x1a\x6e\x3f\xfb\x56\x30\x29\x7d\xb6\xe4\xc0\x7a\x5c\xc9\x7f\xd2\xf0\xf1\xb8\xde\xd7\xf4\x11\xfb\x63\xda\x1e\x9a\xa5\xff\xa4\x13\xef\x99\xe5\xdf\xeb\xcd\x50\x51\x0b\x22\xc7\xcc\xda\xc1\x87\x1f\x79\xc8\x0a\xf5\x3f\xe2\x1e\x11\xcc\xde\x6a\xb2\xa0\x83\x9a\xad\x11\x94\xae\x70\x44\x68\x7d\x05\xc7\xbd\xcd\xeb\x72\x0f\xd9\x96\x4e\x51\x55\x61\x4b\x6d\x84\xca\xf2\x02\x89\x7a\x31\xbe\xb9\xca\x6a\x90\x16\x76\x2d\x54\x66\xb3\xec\xb2\x4b\xf1\xa3\x7b\xf8\x09\x0f\xda\x22\xd3\x7e\xbd\xc9\xc5\x2d\xd2\xa2\xf5\x55\x56\x45\x78\x5a\x97\x1b\xd7\x6e\xf5\xc2\x3e\xbf\x33\x99\xdf\x89\x7b\x97\x62\x2f\xe6\xf2\x4f\xa6\xe3\x45\x2e\xa4\xfa\xb1\x7d\xd4\xcd\x95\xdb\x6d\x2d\x4c\xaa\x79\xd4\xa9\x56\xc5\xf1\xc5\x26\x78\x01\x6b\x87\xd7\x7a\x5d\x09\x21\x7f\x6c\x1f\x75\x09\x83\x07\x82\x79\x50\xa5\x55\x40\xe8\xaf\x6d\xfa\xed\x55\x3e\x74\x9e\xf3\x3c\xe7\xa2\x17\x72\x57\xe7\x8f\xe3\xb7\x96\x28\x19\xd4\x48\x97\x3e\x44\x91\x9d\x88\x65\xfb\x98\x88\xa9\x9f\x0b\x5f\xde\x13\x1a\x85\x7b\x25\xba\x75\xcd\x63\x85\x38\xc7\xb1\x5a\x7e\x98\xfc\x25\x56\xcb\x67\xc9\x2c\xb1\x05\x0f\x87\x50\xb2\x0e\xb0\x6e\xa3\x71\x69\x3d\x4a\x62\xd4\x29\xb9\x8c\xd8\xdb\x62\x5f\xd9\xd7\x72\xaf\xa2\x20\x8e\x91\x26\x1a\xfd\x53\x88\x58\xf1\x3e\x73\xe1\xd9\x96\xe7\xce\xb8\x92\x9d\x3f\xc6\x22\x1c\xe7\x35\x84\xde\x9f\x24\xb1\x5c\x2a\x8c\x1e\xa6\xb5\x57\x20\x17\x55\x12\xe9\xe7\xf2\x46\x54\x11\xd3\x8f\x85\xc8\x6e\x84\x4b\xde\xab\xc8\x2d\xba\xcd\x6e\xdf\x4c\x01\xfb\x62\x8b\xb8\x4f\xfd\x11\xe7\xc3\x23\xee\x74\x2f\x67\x4e\xa4\x93\xe4\x96\x4d\x3e\x86\x0c\x26\xfb\x74\x42\x2b\xc3\xfd\xe1\xcf\x03\x8c\x96\x45\x73\xcb\x32\x05\x06\x85\xd6\x38\x1e\x67\x46\xb3\xe2\xbc\xf2\x07\x40\xa6\x78\xe5\x0e\x7d\xc3\xda\x1c\x66\xcb\x40\xff\x70\x38\x32\x18\x0e\x71\x98\xd5\x9f\xf9\x85\x79\xe7\x17\xd1\xa4\x1f\x58\x29\xc5\x1f\xce\xce\xe6\xf4\xc0\xca\xed\x76\xd8\x88\xdd\x44\xfd\x14\xc7\xa8\x3e\x8e\x83\xa9\x71\xac\x48\x67\xbe\x10\xb2\xae\x27\x6f\x30\x1a\xad\xaa\x3d\x5f\x2f\xdb\x19\x1a\x47\xd3\x68\x1c\x7c\x4a\x82\xc9\xab\xfc\xa1\x8c\xf9\x59\x34\x78\x75\x50\x6f\x68\xef\xde\xc9\x21\x2d\x12\x14\xd9\x74\xbb\x88\xd4\x84\xab\x7f\x86\x42\x68\x6a\xc1\x00\x9c\xc4\xdc\x2c\xb4\x02\x7b\xdc\x90\x61\x0c\xaf\xad\x92\xcf\xe8\xf8\xa0\x80\x94\xfc\x95\x0d\x23\xf8\xa8\x9e\xcd\x4a\x9d\xec\x8c\x4b\xa6\x02\x03\xaa\x7f\xc0\x1e\xc1\x68\x94\x9b\x33\x6d\x48\xd6\x9c\x15\xb9\x7c\x77\x9a\xb3\x97\x82\x9f\x5a\x5b\x9f\x8b\xfa\x29\x2c\x75\xdf\xf0\x55\xc3\x2f\xea\xa7\xce\x04\x68\x0a\xeb\xa9\x5f\x04\x3f\x7d\x73\x51\x3f\x3d\x1b\x41\xf5\x9b\xbe\x78\xf9\xfc\xf5\xf3\x8b\xb4\x99\x4c\x68\x83\x84\xd5\xc5\x0a\xcf\x1f\x5f\xd4\x4f\x9f\x84\x4e\x51\xbf\x76\x75\xbc\xcf\x21\x14\xd3\xe7\x1d\xcd\xd3\x93\xe3\x70\x7e\x2a\x50\xd2\xb3\x48\x55\xc8\x07\x67\xd2\xa9\x0b\x05\x47\x22\x6d\xec\x11\xd1\x74\xb6\x6a\x9a\x20\x74\xd8\x97\x22\x3c\x25\x19\x10\xd7\xaa\x94\x11\x7f\xec\x56\xc4\x71\x74\x0a\xf7\x6f\x64\x65\x41\x4d\x9f\x07\x35\x69\x5f\xaa\x53\x48\x13\xec\x56\xb3\x02\x60\x7b\x71\xcf\x47\x74\x69\x77\x93\xf9\xb1\x1f\x3e\xa2\x89\xe3\xc1\x8f\x5a\x0d\x5b\xfa\x5a\x0c\xca\x3d\x00\x6b\xf3\xce\x75\x0f\x9a\x43\xef\xca\xbe\x49\x6d\xd5\x58\x82\x7a\x99\xb7\x07\x46\x2f\x84\x54\x47\x42\x48\x56\x6b\x19\x88\x31\xc1\x81\xf6\xb1\x6f\x85\xe3\x00\x0a\x7a\x37\xc5\x72\x86\x3c\xb8\x94\x65\x11\xba\x6b\xc5\x31\x29\x79\xeb\x87\x85\xc0\x08\x9e\xb7\x7d\xd0\xea\x0d\xeb\x3a\xc3\xf4\xbd\x57\x7e\xc4\xdf\x08\x62\x07\x4a\x1f\x2a\x7e\x49\x6c\x6c\x33\x60\x02\x2f\xf0\x87\xd2\xa3\xf5\x13\xd9\xf1\xed\x64\xce\x36\x5c\x2b\x1c\xaf\xf8\x35\xd9\x68\x71\xff\x55\xd3\xcc\xcf\xb6\x03\xce\x96\x1b\x5c\xa4\x16\x98\xbc\xc5\xf1\x4b\x4b\x1d\x37\xed\x49\xa6\xb7\x8b\x3c\xcd\x96\x53\xf1\x2b\x1c\x52\xe1\x6d\x85\x06\xb9\xd3\x8f\x58\x3c\xa6\x8d\x52\x20\xff\x62\xdf\xe0\xd8\x6b\x4c\x0b\x0e\xba\x43\x5b\x6d\x7d\x4f\x04\xbf\x13\xb8\xe3\x18\xf1\x3b\xbb\x96\xaa\xa3\x39\x93\xac\xa4\x9d\x20\x87\x58\xe5\x30\x5a\xa0\x1d\x34\x8e\x88\x5c\x51\x5c\xe6\x52\x5a\xc1\x52\x8d\x7b\xc6\xcf\xb5\xa7\xa6\x91\x2d\x7b\x03\x91\x2f\x05\xa5\x7d\x4b\xde\x3d\x17\x6c\x3d\xe2\x1c\xf7\x7c\x43\x5e\xac\xad\xfc\xc8\x1e\x6e\xe0\x70\xef\xae\x71\x12\x31\x86\x32\x19\xbb\x11\x64\xdf\x56\x07\xf5\x8e\x8d\x40\x9e\xae\x57\x6c\xcf\xd6\x7a\x74\x06\x68\x0a\x9e\xa5\xce\x99\x6c\x32\xef\x0f\xd0\xf4\x2e\x63\x9f\x0b\xd8\x6d\xc3\x96\xb5\xb6\x9d\xc9\x50\x95\xb3\x54\xd9\x87\x96\x2a\x23\xef\x0c\xb3\xef\xda\x75\xc5\x71\x40\xf0\x0a\x06\xc9\x3a\xd9\x4f\xeb\x6a\x1d\xc7\x91\x31\xe1\x84\xd2\x21\xac\xac\x6b\x66\xb4\x3c\x9f\xfe\x2c\x6e\xb2\xe2\xfb\xaa\xd0\xb2\x57\x59\x7e\xa5\x4b\xc5\x71\xfb\xc1\x54\xc8\x1e\x24\x62\x53\x25\xfb\xa9\xfe\xc5\xf5\xea\x5d\x6c\xa1\x93\xa1\xb4\x2b\x68\xf2\x96\xec\x43\x43\x20\xef\xb7\xf9\x0b\xee\x12\xa7\xb0\xaf\x6e\x65\xa3\x81\x01\xdc\x77\xd6\x18\xa5\x35\xfa\xd2\x4e\xd0\xcb\x20\x42\x23\xbc\x91\x59\x09\xa1\xa1\xc6\x59\xfa\x46\xc6\x72\x45\x17\x25\xac\x81\x9b\x66\x3e\x0a\xc3\xb4\x6b\x76\xa0\x10\x99\x84\x73\x22\xb9\xd1\x11\x0c\x59\x15\x88\x32\x41\x33\xb4\xb5\x3f\xa4\xba\xf7\x42\x67\x09\x16\xb9\x93\xd7\xe2\x0a\x6d\x55\x89\x8a\x1c\xd5\x12\xa1\x17\x67\x68\x8c\x15\x50\xf0\x16\xe3\xea\x20\xc2\x52\x3c\x46\xdd\x5b\x03\x74\x5c\xb8\xd4\x35\x0d\x65\x5b\xae\xfd\x12\x00\x68\x23\xd2\xb7\x25\x35\x63\x17\x7e\xec\x36\x0a\x6c\x9b\xd0\x34\xed\x05\x0e\x08\x38\xac\x41\x55\x9b\x55\xad\xa9\x8d\x5e\x4c\x4a\xbc\x06\x1b\xc5\x5f\x7c\xc8\x4b\xdc\x7a\xb8\xe7\x19\x7e\xac\xa5\xb9\x95\x08\x01\xe9\x17\x7c\xff\xc8\xbd\x05\x34\x8e\x9d\x5c\xa9\xb6\x6c\xf7\xde\x91\x4c\x98\x81\xd8\x2b\x9f\x6d\x5d\x90\x15\xdb\x8b\x7b\x61\x32\x0b\xd8\x2d\xb4\xc8\xdf\xca\x36\xb4\x0c\x83\xd7\x4e\xd4\xa1\x5f\xf5\xc6\x53\x10\x47\x4b\x3d\xa4\x92\x97\x4d\xa3\x87\x81\x5b\xbe\x9a\x26\x1c\xa0\x93\xf0\xfa\x40\xcb\x5f\x0b\xa2\x47\x86\x71\x59\x03\x15\x4d\x6d\xd6\x7e\x79\x67\x67\x76\x92\x02\xb8\xb0\xb5\x68\x90\xc9\xd8\x68\x1b\xc7\x5d\x64\xa3\x6f\x57\xf2\x80\xd7\x81\x03\x07\xd8\x56\x91\xd5\xd7\x2c\x69\xd0\xf6\x67\x16\xf8\x2f\x7b\xe8\xd6\x46\x18\xb8\xb2\x0a\xc3\xe5\x32\xfd\xc9\x0b\x39\x4c\x92\x23\x6f\x6d\x00\x00\x97\x92\xa7\xd5\x6a\xd9\x63\x80\xc0\x98\x26\x5d\x8d\x1f\x3a\xe4\x54\x48\x74\x11\x36\x60\x39\xad\x83\x4c\xbf\xf5\x69\xd8\x3a\xe9\xb7\xfd\x2c\xf4\x98\x55\xc6\x69\x78\x7d\x35\xb4\x19\xbe\xf3\x7c\x58\xe0\x0e\xfa\xde\x8c\x74\xe0\x5a\x68\x9b\x67\xc0\x01\xd6\x7e\x69\x45\x64\x4b\x73\xa7\x89\xce\xe9\x8c\xee\xe1\xc5\x45\xe8\x11\xbf\x38\xb7\xba\x8f\xfe\x7e\xea\xa5\xfd\xad\x9f\x84\xf0\x11\xc8\x12\x9a\x41\x76\x3c\x62\xfd\x09\xc3\x02\x11\x02\x48\x6b\xa3\xc6\x76\x50\xbe\xe7\xdf\x88\xde\xa9\xa4\x33\xbc\xff\xbe\x8b\xbf\xfa\xf9\xec\x18\x54\x9a\x7e\xee\x2a\xf1\x5f\x75\xc7\x71\x63\xdd\xa6\xe6\x03\x69\x7f\xeb\x27\x39\xbe\xa2\xed\xd7\x42\x4d\x73\x59\x8b\x4a\x7d\xa2\xe5\xc9\x20\x0b\x01\x43\x00\x8e\xdd\x89\x9a\xff\xe3\x7e\xe2\x53\x87\x04\xf4\x12\x8e\x1a\x06\xb0\xa0\xbd\x6c\xdb\x41\xea\xff\x0f\x9b\xeb\xdc\x3d\x73\xa0\x03\xd1\x48\x1d\x1a\x11\x4c\xb5\xb4\xd0\x88\xd1\x10\x52\x5f\xab\x9c\xbb\xa1\x14\x8c\x5b\x58\x48\x0c\x05\x0c\x08\x61\x77\x16\xc2\x6a\x14\x79\xcc\x87\xca\x86\xc8\x95\x1f\xb8\xb0\xd7\x01\xe3\x38\x6a\x03\xdf\x20\x76\x88\x48\xac\xd0\x17\x7c\x4e\xd0\x67\x5b\xca\xb1\x59\x76\xeb\xeb\x03\x18\x03\xc3\x18\x34\xf2\xbe\x5d\xdd\x2a\x48\xf4\xd9\xc6\xe8\x10\xbb\xf7\xa0\x74\x42\x04\x60\x5b\x74\x4f\x09\xb6\x6e\xd5\x1a\x53\x0f\x86\x56\x81\x68\xf1\x1f\x96\x76\x81\x95\x1f\x5d\x8a\xd4\x1b\x6f\x8b\xf7\x19\x6f\xaf\xe8\x83\xe0\x7d\xd3\x6c\x00\x75\x75\xaf\x39\x1b\x7f\x88\xc0\x12\x11\x3b\x18\x69\x95\xd2\x8f\x2f\x98\x32\x0b\x16\xf4\x9b\xa3\x52\x3e\x0b\x6d\x7d\x55\x1c\x77\x10\x9b\xd9\xe0\x44\xbc\x17\x03\x59\xfe\xac\x1b\x2c\xd6\x1e\xb8\x70\x23\xd2\x1f\x82\xf4\x76\x61\x02\xf0\x5e\xb4\x46\xde\x56\x1e\x73\x36\x1b\x18\x19\xf6\x98\x8e\x1c\xec\x79\x45\x8b\x92\xec\x2d\x33\x07\x26\x3b\x11\xf1\xcd\xa8\x5e\x97\x49\x64\x9e\x22\x87\xb6\x90\x64\x1f\x23\x16\x6e\xad\x24\x32\xf8\xc2\xa5\x3e\xd7\xbb\x39\xd2\x9b\x3a\x62\xb6\xd1\xe7\x45\x91\x44\xf6\x19\x93\xd1\x15\x6d\x65\x36\x28\xb9\x58\xf1\x47\x48\x3a\xd4\xda\x15\xc7\x49\x9c\xe5\xdc\xd9\x8c\x4e\xe6\x9a\xaa\x97\x67\x3c\xd7\x84\x5c\xf1\x12\x8e\xfa\x81\xf3\xb7\xd9\x10\x38\x72\x9c\x93\x0a\x04\x3f\xcd\x56\x44\xc1\xa6\xc0\xc5\xea\x54\xfa\x0c\xdb\x32\x9d\x8f\x86\x19\xff\xaa\xef\x2a\xec\xdc\x83\x97\xa3\xdd\x1d\x85\xef\xd7\x9f\x57\x63\xe7\x22\xfc\x85\xe0\x43\x02\xd9\xce\x79\xc5\xf3\x5a\xb9\xb8\xf5\xad\x43\x37\x58\xee\x84\x14\x15\xe8\x1d\x7f\x41\x4d\x07\x5f\x94\xd7\xbb\xbd\x12\x9b\x73\xc8\x4e\x34\xc8\xfd\x20\xf8\xa3\x9c\xae\x76\xc7\x74\x47\x71\x45\x71\xc5\xa1\xf3\xc4\x4d\xf3\x15\x6b\x1f\xb9\x4a\xf3\x55\x9b\x15\xd1\x11\x6c\xa0\x2b\xa6\x68\x90\x0d\x35\xb8\x2e\x56\x07\xf6\x69\x67\x2a\xa4\xb0\x66\x61\x0d\x6e\x9d\xcb\xa3\xe0\xf6\xfe\x4f\xc4\x51\xdf\xc0\x85\xbb\xce\xd8\x2a\x89\xf6\x51\xfd\x02\x8c\x25\x4e\x59\x11\x98\x53\x92\xc1\xdc\x4f\x28\xa7\xce\xd6\x0c\xa9\x8e\x9f\x2d\x81\x86\x9b\x46\x33\xec\xda\x2c\x35\x70\x79\x45\xd4\x84\xfb\xe9\x2e\xbf\x13\xc5\x27\xe5\x9d\x9e\xac\x9a\xd0\x38\xfe\xca\x22\x9b\x8c\xc6\xf1\xa7\xf6\x19\x31\xb6\x49\xc5\xeb\xe9\x6d\xbe\xd1\x17\x3c\xd5\xd3\xeb\x5c\xfe\xa0\x5f\x4a\x5e\x4f\xaf\xb3\x3b\xf3\xd2\xa6\x07\xa9\xae\x1c\xcf\x18\x7a\x7a\x6b\x73\xea\x5f\x5e\x85\x65\x72\x16\x94\x42\x5c\x07\xcf\x84\x66\xcb\x6c\x1c\x45\x49\x70\x5f\xfb\x93\x8e\xb8\xea\xa1\x13\x4f\x4a\xf3\xa2\xa3\xf6\xa6\x3d\x7f\x67\x06\xae\xad\x1e\x14\xb4\x2e\xac\x6d\x99\xcb\x78\x38\x1c\x46\x41\x75\xee\xf1\x04\xee\x11\x70\x08\x81\x71\x92\x9e\x4a\xb8\xa3\xc2\xf9\x84\x47\x3b\x7b\x2f\x63\x92\xbd\xd5\xd1\x69\xc5\xa2\x10\x5b\x95\x4c\xe6\xf8\x6f\x77\xb7\xd0\xe3\x4d\xfe\x3a\xdb\xdd\x2d\xae\xb3\xea\x32\x97\x13\x55\xee\x92\xf9\xee\x6e\xb1\xcb\x36\x08\x03\x99\xcc\x16\x6f\xcb\x6a\x23\xaa\x64\x16\xb1\xe2\xd1\xea\xdd\xf5\x9b\x0b\xeb\x5f\x9a\x68\x9f\xd6\xc5\xdb\xf2\x6e\x52\xe7\xbf\xa1\x1e\x53\xcb\xe4\x6d\x79\xb7\x80\xd0\x7c\x5b\x94\xb7\x49\xad\x83\xe4\xd9\x96\x93\x6c\xaf\x4a\xd7\x58\xd8\x83\xb0\x9f\x7f\x5e\xe8\xfe\xfd\x19\x78\xa9\xc3\xa1\xed\xbb\xae\x95\x85\x15\x0b\xf1\x17\xc7\xbb\xaf\xa0\x0b\xc9\xa3\xf9\x9f\xad\x31\x4e\xb9\x63\x35\x9f\x3f\x03\x21\xd4\xf1\xf5\x31\x0b\x5f\x8a\xad\xa2\x7e\xb8\x15\x82\x04\xf0\xe8\xaf\xb3\x3f\x47\xac\xe4\x1f\xfe\xd5\x66\xd5\xc9\x38\x3f\xf9\x14\xdd\xcb\xb6\x9c\x9b\x1d\x1e\xb9\xd9\x8f\x58\xee\x9a\x2a\x20\xe9\xad\x85\xd2\x70\x75\xfa\x21\x65\x55\xf7\x1c\xbd\xa7\x36\x5a\x5f\x20\xfc\x52\x01\xfd\x37\x77\x07\x97\x7b\xb9\x21\x3a\xca\xd2\xa7\x45\x99\xe1\x3b\x3d\xf4\x84\x81\x6c\xcf\x87\x1d\x4d\x59\xf1\xc8\x87\x85\x1d\x00\x3c\x8f\xed\x50\x10\x58\x04\x8e\x6d\x72\xf3\xa2\xc8\x77\x3c\x5a\x1b\xf1\x05\x96\x13\x60\xd1\x75\xd4\x1c\x2e\x02\x47\x4c\x90\xb6\x4a\x9f\xcd\xf5\x52\x74\xeb\xe1\x9c\x0f\x37\xd7\x46\xb1\xb9\x67\x0f\x6f\xcb\xbb\x73\x0d\x51\xdf\x89\x22\x7f\x24\xc0\x3c\xbc\xc4\xaa\x03\xeb\xe2\x91\x47\xf2\x95\x36\x9f\xbb\xc1\xf4\x91\x6c\xf2\xc0\x2a\xdb\xe0\x57\x1e\x44\x1e\xc9\x8b\xd8\xb3\x1a\xb2\x4d\x5f\x1f\xeb\x61\xde\x56\xf9\xba\x7a\x99\x5f\x0b\x59\xe7\xa5\xec\xf4\xd3\x89\x36\x25\xab\x1c\xfa\x06\x48\x70\x9e\x69\x09\xdf\xd1\xf2\x59\xd1\x38\x53\xc7\x2b\x0b\x51\x38\x93\x8f\xac\x38\x13\x8f\xee\xec\x61\xc4\x11\x31\x65\x4b\x98\x3b\x56\x79\xa4\x13\x65\x2f\xf1\x6f\xbb\xbb\xa3\x9d\xda\x3b\x5a\xa9\xee\xab\xc4\x86\x1a\xd8\xb4\x0a\x12\xe2\x0f\xcf\x34\xa8\x7f\x21\x15\xa9\x6c\x1b\x47\xfb\x06\xd7\x83\x67\x70\x0a\x39\x10\x83\x03\x7e\x16\x3c\x8d\x7e\x10\x6f\xdf\xe5\x2a\x62\xd1\x57\xe5\x6f\x11\xa2\x32\x46\x2b\xf6\xdb\xc0\x04\xea\xd9\x30\x83\x60\xdf\x77\xc3\x22\xfc\x28\x5a\x4e\x40\x3b\xfc\x83\xb4\xd5\xa9\x58\x35\xcd\xf7\x22\x15\x9e\xbe\x42\x0b\x23\x40\x8d\x7f\x13\x4b\x91\xe8\x4f\x43\xbc\x04\xe4\xbb\x9d\x60\x92\x63\x27\xfc\x9f\xe3\x82\xee\x9f\xc5\x71\xb0\x3c\x5c\x65\x2c\xf8\xcf\xf0\x6f\x1d\x2b\x04\xd9\xfb\xcd\x73\xec\xe2\xa0\xa9\xa9\xb0\xd1\x25\xa1\x6c\x21\x88\x17\xd0\x68\x88\x20\xcb\xd1\x64\x9d\x8a\x6c\x45\xa7\x63\x7a\xca\x3e\xc3\xe7\xc9\xe4\x94\xfd\x24\xf8\x83\x5f\xe8\x00\x49\xdd\xe4\x75\xfe\x36\x2f\x72\x75\x9f\x44\x57\xf9\x66\x23\x64\xc4\x1c\x62\xb7\xd1\x0a\x0e\xec\x5b\xc1\x1f\x0a\xa1\x94\xa8\xce\x77\xd9\x1a\x88\x1a\x97\xa1\x6f\x4b\xa9\x7e\xd0\x2b\x93\x44\x7f\x99\xcd\xa2\x60\x02\xff\xde\x65\x26\xb8\x0b\xb6\xd1\x46\x06\xae\x96\x1a\xab\x5d\x67\x77\x64\xc6\x70\x2f\xdc\x84\xc8\xa6\x99\x51\x3a\x26\x95\x0e\xf3\x81\x70\x1d\x34\x51\x2d\x46\xfc\x1f\x5b\xa7\x53\x09\x60\xf4\x19\x8f\x34\x22\x86\xd2\x05\x6a\xec\x19\x33\x51\x50\x67\x38\xcb\x48\x9c\x2b\xaa\x65\x64\xe8\x4c\x94\x38\x0c\x14\x79\xd9\xfe\x4c\xf3\x53\x8b\xec\xec\x2f\x8b\x6c\xcc\x9f\xd1\xc8\x90\x04\x17\xb0\x62\x3f\xb6\xe1\x1e\x04\x93\x63\x29\xd2\x4c\xc7\x46\xcd\x29\x65\xd5\x92\xf8\xda\x5c\xe6\x89\xcf\x1c\x59\x72\x16\x75\x0b\xb9\xda\x47\xc7\x05\x6c\x17\x4d\x7e\x84\xb1\xc6\x98\x6c\x39\x1d\x02\xfe\xfd\x75\xb7\xa9\xa8\x7b\xb9\x1f\xff\xa1\x9a\x93\xfa\x8f\xe5\x73\x6b\x86\xfb\xcb\x67\x67\x88\x44\x87\x0e\x05\xcb\xa7\x1f\xd7\x22\x47\x70\xd6\xc8\x90\xba\x68\xac\x8e\xa1\xde\x99\x80\xce\xe9\x6a\x52\x4e\xf6\x93\x7a\x82\xd8\x28\x58\x75\xb6\x6f\xd7\xf9\x5f\x3d\xd8\xd1\xcc\x26\xcb\x39\x19\xdd\x4f\x8f\x28\x02\xe2\x55\x4b\x1a\xc7\xb6\xff\x8e\xb8\x04\xc3\xb2\x05\x22\x36\x9a\xc3\xa5\xae\xe4\x39\xcb\xb8\x65\x76\x2b\xb8\x22\xff\xb1\x1e\x03\xa0\x5a\xd6\xd4\x5e\xb6\xec\xe0\x28\x5b\x64\x3c\x02\x4f\x13\x59\x79\xfe\x23\x7d\x8d\xe3\xbc\x69\x46\xf7\xd3\x21\x5a\x00\xde\xd7\xe8\x3c\x2b\xd8\xe3\x9b\xea\x60\x06\xdc\x34\xa3\x80\xea\x83\x2d\x8e\x72\x59\xe4\x8f\xc4\xe4\x31\xc3\xd4\xb7\x0c\x00\xb1\x6a\x5b\x97\xef\xc4\x5a\xd5\xfe\x06\x38\x1d\x1b\xed\x0f\xcf\x17\xe2\xd8\x00\xc3\xe1\xec\x4f\x32\x0e\x6f\x6c\xe3\x6f\xd6\xe9\x13\xd6\x70\xec\x36\x68\xd3\x90\x7c\x68\xd7\xb1\x92\x55\x2c\xa3\x63\xec\xec\x76\xb9\x5d\x78\x48\x6c\x6b\x4f\x33\x71\x64\x11\x81\xad\x9a\xbe\x02\x34\xc8\x17\x68\x1b\xd6\x75\xad\xc3\x80\x25\x0f\x25\x70\x93\xba\x4f\xba\xbc\xb8\xb3\x59\x74\xaa\x53\xb3\xf6\x91\xcd\xec\x05\x3d\x3a\xc4\x8c\x5c\x46\xf3\x28\x91\xda\x9a\xd2\x07\x0f\x4a\x1e\x32\x99\x5f\x6b\x73\xae\x2f\x94\xa8\xf4\x83\x76\x45\x81\x41\xcf\xba\x2c\xf6\xd7\xed\xeb\x36\x2f\x8a\xaf\x6d\x37\xf0\x5a\x88\xbb\xcf\xaa\x52\x9b\x31\x6d\x0b\x71\x77\x7e\x55\xe5\xf2\x9d\x7e\x6b\x71\x27\x8c\x95\xab\x7c\xf3\xbc\x12\x99\x7b\x7e\xa1\x6b\xed\xbe\xbd\x92\x9b\x6e\xc2\x39\x42\xfc\xb8\xa4\xef\xca\xdb\xe0\x31\xc8\xfb\x5d\x79\xeb\x33\x02\x68\x3e\xf7\x8d\xba\xe9\xc2\x23\x96\x0a\xe5\xcb\x6a\x77\x95\x49\x6d\xc2\x74\x9b\x6f\xca\x5b\xfd\xf4\xdb\x17\x08\xf4\xa8\x9f\xca\xf2\x1a\x81\xad\x98\x23\x89\xc9\x03\xec\xdb\xee\x8b\x21\xcb\x0e\x44\x1a\x8c\xe3\x0f\x7b\xfa\x98\xff\xdd\x7b\xb7\xdc\x48\x10\x34\x8a\xd5\x3a\xb2\x2c\xdb\xf3\xcf\xfc\xf1\x4f\x1b\xf2\xeb\x8c\xd8\x87\x7b\x7d\xde\xfe\x51\x10\x88\x4b\x70\xa8\x74\x30\xa0\xe3\x3c\x05\xaf\x75\x10\x7e\xc2\xbb\xc0\x66\x71\x1c\x5d\x0a\x15\xe5\xf2\x24\x0b\x4c\xa3\xe0\x13\x02\x5e\x84\x08\xbb\x85\x96\x79\x52\xc0\x45\xa5\x15\x89\x71\x52\x3a\xb1\x18\x70\x0e\xc9\x3d\x5d\x93\xf0\x91\xc8\xf5\x55\xb0\x44\x72\x1b\x0b\x2b\x07\xb6\x89\xa4\x06\x22\x30\x64\x5a\x4c\x08\x17\x2a\x83\xff\xdd\x17\x38\x7f\x34\x0d\xc6\x24\xc7\x88\x5c\x98\x1f\x47\xb9\xaa\xdb\x28\x57\xf4\x98\xb5\x6e\x1a\x7d\x0c\x07\xf9\x84\x94\xbb\x8d\xcb\xd9\xb2\xd6\xc0\x28\x04\xa3\xe1\x51\x2e\xaf\x44\x95\x23\xfe\x0b\x26\xa2\xee\x4d\x04\x06\x29\x79\x66\xbd\xab\xb1\x8e\x28\xb9\x5f\x16\xd3\xba\x3d\xe0\x13\x60\x67\x3d\x39\x5c\x42\x63\x0f\x70\x18\x00\x80\xfe\x8a\xda\x9d\x76\xd2\x2e\x6b\xb8\x8e\xe4\xfd\x0b\x49\x3b\xab\x16\x2e\xd6\x8c\x49\x7f\x52\xf7\xb1\x1f\x3d\x82\xd7\x21\x98\xaa\xeb\xac\xb0\x71\x21\x71\xb7\xee\xc9\xb7\x3a\x16\x1b\xff\x56\x40\x3e\x61\xa2\x4b\xc9\xa6\x91\x4b\x52\x86\x68\x2d\x87\x4d\x19\x20\xa7\x69\xf2\xfa\x53\x60\x20\x04\x61\x58\x96\x4d\x33\x4b\x10\xe7\x36\x10\xc5\xa5\x91\x61\x51\x23\x66\xf9\x91\x55\x28\x32\xdb\xd3\x87\x60\x2c\xfb\x15\x3f\xc2\x50\xf6\xfa\x7e\xe7\x4d\x30\xfa\xa7\x9d\xa2\x23\xf4\x0e\x72\xf9\x1e\xcc\xae\x3f\x7d\x82\x15\xcf\xe5\x65\x9b\x85\x50\x23\x01\x59\x6a\xda\xba\xc7\xea\xfd\x80\xa7\x9f\x3a\x81\x2d\xed\xf2\xf8\x3c\x07\xda\xf7\x5f\x08\xc8\x32\xcb\x2d\x61\x2e\xf9\xe8\x7e\xda\x39\x03\x81\x92\xb5\x1c\x26\xa6\xdd\x1f\x8f\x11\x98\xab\xfc\x4f\x28\x76\x0e\x0a\x2d\x97\xff\x63\xfb\xc4\x32\xf0\x2d\x3e\x76\x70\xa6\xa3\x6e\x92\x7a\xc2\x07\x59\x90\xfd\x31\x41\xdf\x07\x2c\x48\xb8\xd4\xe9\x7e\x45\x27\xb6\x15\x47\xba\x4c\xfb\x9a\x47\x61\xb5\x16\x40\xb5\x6c\x2c\x86\x68\xe2\xc9\x85\xec\x2a\x4e\x6a\x56\x12\xb7\x5f\x69\xeb\x2c\x37\xac\x3d\xa5\xec\xef\x82\xcc\x10\x65\xdf\xa9\x07\x1d\x44\x04\x82\x08\xfe\x44\x90\xfb\xe9\xf1\xe9\x93\x0d\x90\x33\xcb\x69\x04\xa3\xd0\x40\x6f\xb9\x4c\x94\x6a\xa1\x65\x10\x24\x0a\xb1\x55\x13\x0d\x07\x0f\x6d\x99\x64\x76\x18\x80\x89\xf7\x57\x72\xa0\x96\xa8\xb7\xa2\x69\x2b\xe7\x89\x22\x66\x39\xd3\x24\x8a\x98\x15\xf8\x58\xe6\x32\x68\x07\x96\x31\xe1\x16\xc9\xc7\xe5\x8a\x3f\x18\x25\x6b\x32\x28\x65\xe6\x33\x73\x59\xe2\xc0\x5d\x8a\x62\x29\x9c\xd3\xed\x09\x42\x10\x89\xd5\x42\x9d\xfd\x45\x2b\x83\x64\x9a\x83\xc1\x55\x2b\xd4\x8f\xe0\x81\x4d\x53\xa5\x6a\x02\x57\xdd\x2a\xbc\x09\xff\x70\x08\x99\xf5\xdc\x05\x13\x6c\x3b\x07\x6c\xc8\xff\x2e\x68\x5f\xcd\xdb\x47\x84\x8f\xab\x70\x3a\x5b\x89\x95\x18\x4b\x66\x8e\x2d\xdd\x60\xbb\xca\xda\xf4\xb4\x7c\xb0\x73\xb5\x5a\x64\x67\xf9\x22\x1b\x8f\x69\x99\xaa\x34\x5b\xad\x3c\xac\xe1\x0d\xb0\x5b\x79\x8c\x5b\x1e\xfa\xf1\x8e\xe5\x32\x8c\x3e\xa8\x2f\x91\xb4\x85\xa1\x68\x63\x6a\x20\x66\x31\xa4\xeb\x8c\x90\xf3\xe9\xeb\x5b\x21\x24\x17\x8a\x3e\xe6\x4b\x20\x14\x03\xb6\xec\xcc\x44\x7b\x6e\xd3\x22\x4e\x78\x87\x39\xaf\x8f\x5d\x55\xee\xb8\x74\x16\x8b\x75\x2e\x2f\x79\x0e\xec\x6f\x9e\xdb\xb8\x3f\x3a\x83\x89\xb4\x54\x73\xfb\x6a\xe2\x1c\x5a\x3d\xea\xad\xbd\x2a\x00\x91\x25\x6d\x75\x72\xc3\x2b\xf3\xa8\x43\x23\x96\x3d\xc2\x2a\x5b\xc2\x7a\x60\xeb\x7d\x47\xb1\x89\xb5\x11\xdc\x70\xa1\x3b\x4b\x95\x5c\x77\x3d\xa8\x08\x8b\x73\x4d\x28\x2d\xab\x53\x0f\xcb\xf8\xee\xb7\xdf\x0f\xac\xda\xcb\xe4\x48\x4e\xc0\xe4\xef\x35\x16\x4e\xc0\x74\xb3\x37\xac\xa8\xd6\xa0\x00\xc1\x72\x60\x1b\x33\x67\x36\xc2\xbd\x7e\x5e\x11\xd1\x99\x39\x5f\xf0\xa9\x60\x33\x36\x1f\xfe\x66\xed\x02\x4c\xad\xb6\xbc\x2c\x6f\x39\x71\xb3\x3a\x69\x67\x9f\x3e\x55\xe3\xf6\xad\x5b\x5f\xad\xc4\x2e\x8e\x8f\x92\x5a\x43\x39\xe3\x62\xea\xea\x77\x77\x98\xc3\xcd\xbc\x16\x6a\x29\xa7\xf5\xef\x4c\xaa\xff\xae\x8b\x1e\x0e\x70\x7c\xcc\x83\x83\x03\x17\xa1\xd7\x20\x09\x2b\xe1\x0f\xae\x96\xfe\x89\xc1\x2e\x88\x9b\x76\x98\x13\x08\xdd\x51\xcf\xb7\x9a\x98\x95\x23\x9b\x9c\xc2\xe5\xa4\xdc\xad\x5c\x04\x1f\x9b\x59\xef\x2f\xf7\x6d\xd9\xcd\x8a\xfb\x13\xec\x96\xd3\xe9\xcc\xa4\x33\x84\xbb\x04\xf5\xc4\xe1\x0f\xcc\xdc\x52\x25\xb3\x3e\x15\x06\x37\xb1\xbd\xd3\x13\xe9\x6b\x3f\x4a\xc1\xad\xf7\x83\x1d\x1f\x05\xa8\xec\x7d\xfd\x86\x0c\x4c\x7f\xa6\xfd\xbe\x6b\xfe\xfd\x36\xf1\x08\xa4\x33\x00\xfd\x6d\x2c\x74\x24\x52\x4d\xe8\x2c\x6f\xf0\xba\xdc\x75\xc0\xdb\x26\x83\xe4\xf0\x87\xfe\xf8\x7a\x9d\xc6\x1e\xd3\x09\xad\x46\x35\x8e\xc9\x50\xa7\x9c\x03\x0b\x00\x9f\x3f\xe0\xbc\x93\x55\xc9\x80\x35\x8c\x38\xb0\xfa\x16\x54\xe9\xf8\xdb\xf4\xa3\x89\x61\x26\xca\x9a\x88\xa7\xfa\xf1\x9b\x2f\xe8\xe9\xb3\xc0\x8b\x30\xd2\x65\x23\x34\xb5\xbd\xeb\x40\x98\x86\x3e\xe6\x17\x03\x42\x45\x0d\x4a\x8a\x49\xc5\x2a\xc5\x72\xc5\x4a\x05\x81\xdd\x32\x31\x41\x33\x9b\xfa\xaa\xbc\x6d\x10\xc4\x92\x3e\x39\x65\x99\xe2\xa7\x6d\x88\xe5\x27\xa7\xad\x40\xad\x56\x84\x3e\xe0\x12\x6a\xa2\xed\xbc\x5f\x4d\x8d\xac\x2e\x8e\x71\xdf\xd3\xaf\x7b\x51\xab\xe7\xee\x90\xfa\x69\x95\x5d\x8b\xe5\x23\xe9\xa4\x56\x34\xe9\xdc\x54\x54\xc3\x18\x73\x7b\x37\xd5\x8e\x0c\x37\x59\x01\x1a\xbe\xbd\x9b\xaa\x7c\xfd\x0e\x21\x77\x7c\x0f\xf6\xaa\x65\x07\x3a\x15\xb8\x1c\x08\xc4\xe5\xcc\xd1\x71\xf1\xac\xe2\xad\x47\x5a\x5b\x4d\xa1\x3a\x66\xcc\xda\xe2\xee\xc1\x30\xcf\x89\x30\xaa\x50\x65\x44\x79\x8b\xea\xec\x2f\x8b\x6a\xcc\x9f\x4d\x14\xcd\x53\x47\x8f\xc7\x44\x72\x29\x60\xa8\xb6\xe2\x79\x1a\x48\xc3\x56\xdc\x3b\x8a\x62\x9a\xf2\xa9\x3d\xdf\xf2\xdc\x6a\xfa\xa0\x8e\x6e\xfb\xb1\xb6\xe2\x84\x96\xa7\x80\x21\x1a\xd9\xaa\xa9\x02\x79\x13\x95\x39\x83\xa4\x2b\x3a\x5d\x97\x72\x9d\xa9\xce\xa7\xe8\x69\x84\x4b\x0a\xf9\x8c\x65\x3c\x77\xf4\xb8\x3c\xcb\x9c\x8d\x9a\x31\xc8\xb4\xb6\xb1\x4c\x31\xe1\x45\x8e\x55\xdb\x85\xad\x22\x25\x0b\x26\x23\xb3\xd3\xb1\x05\x40\x59\x83\x09\x47\x7c\x59\xdd\x89\x06\x3e\x35\x57\x9b\x86\x86\x25\x2e\x38\x85\xde\x3a\x07\x84\x7e\x08\x3e\xe6\x5b\x92\xd9\x1e\x8c\xe6\x0b\x37\x62\xc1\x95\x6a\x1a\x2c\x2c\x53\xa1\x3c\x0f\xaa\x99\xac\xd2\x0b\x3c\x2e\x3c\x4d\x98\xe0\x9a\x26\x3e\x9f\x10\x75\xda\x26\x42\xf0\x63\xbb\x5d\x98\x99\xab\xfb\xd6\x85\x2e\x3d\xad\x56\xd3\x6a\x2f\x89\xf4\x6c\x49\x1d\xdc\x9d\x48\x4a\x96\x16\x4c\x32\x1c\xc8\xe4\xd9\x3c\x8e\xf3\xa5\x4a\x48\xde\x34\xc7\x99\xe6\x6c\xb6\xa2\xac\xee\x07\x3a\x2f\x56\x70\x16\x82\xe9\x2d\xd7\x44\x5a\x87\x1b\x37\xce\xeb\x25\x82\xad\xef\xea\xce\x4d\xd9\x82\xb2\x72\xa7\x82\xb4\xd1\x8c\x3d\x58\x03\xc4\x57\x1a\x81\x40\xb8\x61\x50\x49\xe2\x70\x8a\x27\x3c\x07\x38\x64\x3a\x57\x3d\x7b\x2e\xce\x45\x9d\x08\x9f\xf8\xb5\xa1\x77\x89\x62\x7e\x32\x13\x3f\xdd\x6e\xfa\x12\xe5\x67\x92\x99\x59\x4a\x10\x72\x59\xab\x6d\x34\x9b\x15\x20\x28\x0f\x28\xdc\xb2\x60\xa4\x64\x05\xc8\xaa\xb6\x36\xb7\x8f\xce\x84\xd2\x8c\x40\x6b\x2c\xec\x07\x33\x12\x3f\xf7\x7e\xb5\xec\x4d\x0d\x4c\x42\x92\x53\xee\x8e\xc9\xa0\x66\xb5\xc5\xd2\x17\x30\xcb\x9b\xcc\x16\x01\x50\x69\xaf\x15\x0d\x57\x19\xee\x10\x57\x67\x52\xf3\xdb\xae\x0c\xee\x0c\xc1\xd2\xcf\x7d\xf3\x62\x49\xfe\xf8\xc2\x32\xb1\xa2\x34\xa9\xed\xe5\x82\x9d\x64\x47\xf6\xd9\x9a\x17\x58\xf4\x9d\xe9\xc5\x68\x60\xda\x9c\x9a\x54\x67\x90\x10\x03\x08\x6c\xd5\x1c\x37\x43\xf1\x1f\x89\xa4\x2b\x86\xa0\x98\x72\xc5\xba\x1c\x78\x69\x44\x3d\xb8\xd9\xd3\x66\xe0\xb8\xdc\x92\x32\x69\xaf\x44\x03\x36\xe2\xa5\x8b\x0e\x83\x1a\xfa\xd2\x8c\x0a\x71\x41\x22\x73\x9e\xd1\x72\x27\xea\xfb\x80\xd0\xd3\xe6\x03\x02\x3c\xfa\x3a\xaa\x15\x2b\xa9\xe9\x24\x74\x4c\xa6\x51\xb9\x62\x88\x06\xc1\x73\x6b\xa4\x8b\x2b\xad\x78\x7e\x20\xeb\xc1\xc5\xa7\x7e\x07\x6a\x5d\x48\x07\xab\x60\x2f\x6a\xe4\x54\xb0\x92\xb9\xe2\x1e\x47\x5d\x13\x28\xf7\xca\x1d\x06\xde\xbd\x6a\xa1\xd0\xd8\xc5\x35\xa7\x49\x15\x74\xc0\xe0\xdf\xf5\x8f\x0e\xb0\xa5\xef\xfd\xf0\x01\x69\x8d\xa5\xff\x9a\xad\x15\x62\xb0\x5c\x13\xd7\x55\x6c\x0a\x1a\xc7\xe1\xab\xc1\x97\x25\xf2\x15\xed\x8d\xc4\x36\x87\x7b\xb7\x17\x13\xdb\x54\x3c\xbb\xee\xb8\x0b\xfd\xec\x55\xc5\x36\x15\xcf\x1e\x5f\xda\x34\xf3\xe6\x29\xdd\x75\x18\xac\x6e\xcf\x1c\xce\x80\xd8\x37\x29\x98\x1e\x65\x62\x4b\xea\x17\x5c\x55\x57\x1c\xce\xa7\x9e\xb4\xb6\xfe\x2c\x5b\xc5\x1e\x1c\x95\x48\x1e\xa2\xa7\x51\x92\x0e\x80\xa2\x3d\xa7\xb4\xdb\x5c\x7f\x73\x33\x56\x0b\x22\x2d\x4f\xc5\x5a\x89\x03\xee\xfb\x92\x87\xd5\x81\xd9\xea\x7b\x98\x41\xc7\xba\xb0\x57\x3a\x18\xf2\x94\xc0\xd1\xc8\xc5\xb2\xf2\x38\xdf\xd1\x5c\xd1\x47\xd3\xb0\xb2\xae\x56\x2c\xa4\x72\x72\xc5\xbb\xaf\xa0\x87\xbd\x1c\xfe\x1a\x15\x5c\x3f\xd6\x02\x58\x92\x3e\x7a\xd4\x6d\x6d\xfb\xd9\xd6\xa9\xef\x60\xa8\xdd\x34\x4e\x88\x86\x37\xb6\xd3\x93\xc4\x36\x38\x19\x5f\x39\xe9\x2f\xbb\xec\x08\x8f\x33\x7d\x24\xbe\x71\xce\x4d\xb8\x01\x04\xfc\x14\xcc\x9a\x9c\xfd\xb7\x34\x4b\x06\xe9\xaa\xe6\x71\xf5\xc6\x0c\x41\x5a\x97\x82\x39\xfb\x5e\xea\x54\x44\x95\xcb\xfc\x0b\x9f\xb1\x1a\x3b\xd4\x5f\xeb\xc2\xc2\x97\x90\xc4\xb6\x65\x9a\xa6\x26\x90\x0c\xb4\x29\xe3\x31\xdb\x0d\x90\xec\xa1\xb4\xb6\xd0\x64\xc2\xdc\xcd\x27\xb6\x8f\x76\xc9\x9a\x26\xec\x03\x9a\x02\x40\x2a\x8f\xcc\x56\xac\xf4\x31\xe5\xb4\xac\xc8\x62\x15\xa5\xb1\x0a\x84\xcd\x91\x61\x3e\x21\xf7\xcc\x19\x2e\xf5\x22\x97\x4b\x68\x7f\x45\x94\x44\x66\x02\x75\x39\xf3\x8c\xbb\xc0\x9a\x66\x74\x13\x84\x59\xb8\x01\xff\x05\xfd\x67\x2e\xf7\x62\x71\x89\xf8\x02\x1b\xe0\xa2\x9b\x38\xc6\xa7\xa6\xf1\x67\x03\x56\xd1\x03\x74\xd9\x7b\x3e\x70\xa7\x1a\x6d\x9a\xa3\xd4\x0d\x6d\x6d\xf7\xb7\xfd\x9b\xd0\x60\x6e\x3f\x75\x26\x45\x3c\xbd\xf2\xcf\xac\x7d\xfc\x31\x78\xfe\x69\xc5\xec\xaa\x17\xba\x6f\x53\x2b\x46\x05\x62\x2b\x5a\xa8\x69\x85\xab\x6d\xbc\x7e\xb2\xe6\x03\xc2\x57\x94\x5b\xae\x79\x91\x90\x42\x90\x54\x40\x1f\x1c\xe8\x26\x5c\xf5\x4d\x53\xb0\xa1\xe2\xcc\x14\x82\x4b\x18\x09\x74\x78\xeb\xa6\xb1\x6f\x13\xa3\x70\x47\x9a\x39\x4b\x8e\x78\x31\x78\x03\xc7\x16\xf2\x3e\x2d\x6f\x84\xde\x60\x67\x50\x62\x00\x45\x57\xae\x2b\xbc\x38\x50\x3b\x07\x85\xbe\x4e\xc2\x7f\x61\x05\xf7\xf5\xae\x21\x05\x59\x53\xca\xda\x72\xdd\x1e\x01\xa1\xfb\x59\x8d\x63\xd2\x4e\x31\xf7\x56\x03\x43\xd0\x1c\xe4\x6b\xcb\x6b\xe7\x3c\xff\xf6\x63\xf8\x65\x1e\x7e\xf9\x29\xfc\xf2\x6c\x05\x20\xdf\xf3\xd1\x9c\x6d\x28\x06\x7d\xb3\x74\x2d\xe7\xf2\x04\x01\xfd\x2e\x11\xf4\x52\xf7\x85\x26\x37\xe1\xfd\x54\x0e\x2b\xb0\x07\x3b\xb8\x04\x93\x52\xc6\x31\x71\x05\xf8\xe8\x92\xb2\xcb\x38\x0e\x16\xf5\x78\x4e\x3d\x58\x5e\x36\x8d\x5d\x48\xe6\x7d\x27\xdb\x56\x10\xdf\x2f\x00\x7f\x86\x8d\xa1\x2f\x37\xdd\xf3\xb5\x22\x97\x4b\x6c\x90\x64\xc6\x2a\xb6\xa3\x4c\x57\x77\x83\xc1\x60\xf7\xec\xad\x04\xe5\x12\xcb\xaa\xef\xed\x70\x29\xf6\x97\xcf\x28\x3d\xac\x5a\x2c\xdb\x23\x01\x6a\xd9\x3d\x39\x38\xcc\x2c\x68\xd2\xfd\xa0\x59\x3e\x61\x4d\x89\xeb\x9d\x10\x1b\x3e\x88\xad\x11\x62\xe4\xd8\x8f\xb9\xbd\x79\x1c\xfc\x31\x4d\x1e\x1c\xdd\x4d\x64\xd3\x8c\x64\x1c\x2b\x5c\x21\x0d\x7d\xb1\x68\xf9\x5c\xe1\x38\x69\xf3\x5d\xc5\xf1\x08\x17\xa3\xc7\xb1\x0a\xae\x2c\xdf\xde\xc1\x50\x6e\x59\x79\x9e\x98\xcf\x92\x56\x4b\x66\xdb\x6f\xbf\x42\x24\xef\x5f\x30\x93\x9a\x9c\xeb\xf1\xd4\x61\x25\x41\x72\xda\x26\xaf\x92\xe1\x2c\x9e\xc5\x77\x1a\xbb\xca\x20\xe1\x38\xd6\x51\xbc\xec\x9b\xbe\xa9\x44\x3f\x71\x8d\x9a\x59\x35\x2d\x8b\x0d\xaf\x3c\x13\xc2\xda\xc7\x90\x4a\x5c\x13\x9d\x91\xc6\xb1\xfe\x6d\x05\x61\x94\xf9\x76\x7a\x77\x4c\xd9\x74\x18\xa8\x1f\xba\xd2\xe6\x6d\xb6\x11\xaf\xcb\xc7\x9d\xea\x51\xdc\x39\x0d\x66\xf0\x8b\xae\x6b\xe2\x15\xdf\x6c\xe6\xee\xd2\x00\xa8\xe1\xba\x0a\xcd\xcd\x08\xe2\xf5\xe8\x0a\x92\x60\xd4\x77\x60\xf6\xdb\x51\xf0\x5c\x43\xd6\x73\xde\xc7\xe2\x38\x1c\x71\x0b\x5b\x56\x8f\x18\x5e\x87\xee\x84\xab\x5b\x1b\x87\x30\x84\x28\x14\xa5\x0b\x9c\xfc\x6c\x88\x25\x70\x01\xd1\x36\x97\x79\x7d\xa5\x31\x30\x90\x6d\xb9\x83\x2f\xa0\x07\x9d\x0c\x41\x76\xf3\xfa\x8a\x67\x0c\x06\x16\x20\x1a\xa5\x99\xb5\xa5\x95\x84\xae\xaf\x48\x66\x85\x9a\x3a\x9d\xd8\xef\x2c\xb3\xd1\x61\xda\xa1\xe5\x4c\xb4\xe6\x45\xc7\xa7\x21\xd3\xbc\xb3\xd7\x35\x6f\x4c\x91\xd2\xf7\xc6\x29\x24\x3c\xc4\x42\x7f\x50\x6a\xc6\x0c\xc4\xd6\xf9\xeb\x3b\x27\x18\xdd\x0d\x92\xb7\x37\x83\x39\x41\x78\xd7\x15\x0b\x8d\x0b\x8e\x68\x22\xd6\xdf\x04\x9a\xe2\xce\x85\x5c\x0c\x90\x8e\x78\x40\x55\xdd\x09\x6b\x6d\x5d\x03\xa1\xee\x88\x63\xfc\xd5\x5d\x8e\xe3\x8c\xe0\xc5\x1e\x25\x80\xdc\xb4\x6a\xb4\x1a\xc8\x68\xb9\x09\x45\xdb\x42\x3a\xbf\x77\xbc\x5e\xa8\xc9\x64\x41\x25\xea\x06\xdf\x3a\x72\x71\x28\x7c\x4f\xf5\x27\xdd\x57\xc3\x4d\x10\x9d\x00\xa8\xd2\x6d\xe0\xe8\x23\x80\xda\xa5\x0b\x03\xaa\xd8\x9c\xd2\xc5\x48\xc4\x71\xd9\x34\xbd\x0d\x91\x83\xe3\x61\x66\xc5\xdb\x65\xcb\x1c\xd8\xeb\xa8\x09\x19\xb8\x38\xd8\xce\x60\x56\xdf\x37\xa5\x4c\x85\x53\xc5\x24\x57\x69\x66\xa7\x35\x82\x1b\x43\xfb\xaa\xd9\xc5\x68\xc5\xf2\x76\x9a\x4b\x5c\xbc\x6b\xe7\x20\x31\xc6\x64\xca\x81\xe2\x68\xe6\xd9\x37\xb4\xce\x32\xbd\xb6\x08\x8a\x6a\xa7\x15\xf7\x19\x95\xbb\x16\x03\x68\xb2\x23\x5a\x71\x92\xc0\x9c\xe6\xa9\x30\x73\x6a\xbd\xc5\x60\x1e\x20\xec\x54\xc2\x38\x08\x47\xd3\x54\x84\x53\x89\x5a\x72\x37\x8d\x42\x4f\x23\xfa\x25\xf8\x6c\x21\xce\xca\x85\x00\xab\x9f\x0a\xc8\x7c\x51\xce\x74\xb6\xf3\x12\xe0\x24\x6f\x95\x6e\x3f\xf9\x8b\x26\x31\x93\xa9\xe3\x22\x99\xe1\x13\x19\xe8\xb1\xe8\x6a\xbe\x9d\x15\x00\x10\xac\x4c\xab\xd5\xc2\xfe\xf2\x47\xee\x55\xb5\x72\xe8\xa6\x19\xba\x39\x28\x1f\x36\x9d\x37\xbb\xda\x61\xaf\x02\x17\x26\x61\x0e\xec\xcd\xac\x81\x4e\xb2\x2e\xf2\x8d\x78\x59\xde\xca\xa4\x50\xc4\xf2\xb9\x4c\x27\x7e\xbf\xd3\x49\xba\xff\x36\xe9\xb5\x1e\x9b\x4e\xb6\xc3\xa4\x0c\xf8\xf6\x0b\xd9\x1a\x1a\x99\x3a\x0e\x3a\xfd\xeb\xbd\x0a\x3e\xe8\x9a\xcc\x07\x5b\x51\xfb\xcd\x56\x77\x08\xf4\x9f\x82\x55\x43\x5e\x35\x9d\xa9\xe9\x8c\xb2\xea\x0c\xcf\x6c\x7a\x9e\xae\x5a\xd1\x2d\x1f\x80\xf3\x59\x80\x21\x0c\xac\x86\x22\xda\x85\x3a\x6b\xb7\x33\x2e\xf8\x12\x1c\x9b\x94\x12\xeb\xc7\x01\x5d\x42\xd3\xb4\x1b\x74\x32\x61\x73\xba\x70\x45\xb0\x49\x41\x65\x01\x81\x94\xb5\x72\xe0\xe0\x88\x1d\x0c\x0c\x83\xd5\x69\x9e\x17\x71\x32\xf3\xac\xd2\xf1\xba\x3a\x12\x69\x3e\xff\x30\xf8\x1c\x8e\x4c\xc2\x00\x57\x2a\xa0\x45\x08\xc8\x5d\x41\xec\xab\x6e\x36\x8d\x33\xdd\x57\xcd\x09\xf0\x87\x1a\x6e\x09\x7f\x9d\xcd\xd8\x36\xab\x55\xf2\x6c\x36\x6b\x05\xfc\x7f\x99\xcd\x2c\xa9\xdd\x08\x30\xc3\xbe\xae\x8a\xb5\x3a\x84\x0a\x40\x7d\x17\xc7\x41\xa5\xfa\xec\x53\xb5\x57\x29\xb2\x00\xc3\x0b\x36\x24\x08\xe8\x48\xd2\x01\x06\x0b\x75\xd4\xfd\x17\xc6\xe0\xc7\xe5\xd2\x40\x7d\x60\xd5\x80\xe1\xb7\x8d\x4e\xc8\xf2\x81\x6f\x26\x58\x4d\xd4\x35\xc1\x3e\xca\x55\x6a\xa9\x25\xcc\x8c\x2a\x13\x66\x91\x47\xee\x0e\xad\xc8\xdd\x0e\xf5\xb5\xe4\xda\xe0\xa8\x52\x53\x1d\x86\xd2\xdc\x8b\x63\x42\xdb\x8b\x0d\xcf\x95\x0d\x8c\x23\x36\x8c\xbc\xa7\x97\xd4\x94\xe6\x91\x8a\xda\xc6\xcc\xe5\x56\xec\x7e\xaa\x1f\xfe\xe9\x32\xf0\xb6\x35\x6d\xe8\xbd\x53\x6c\x03\x05\x9a\x8e\xa8\x8e\x5b\x91\x3f\xd7\x81\x4b\x16\x1d\xf6\x08\xe9\xef\xd5\xc6\x9f\xeb\x0b\x95\x1f\x55\x77\xb3\x36\x2a\x4b\x50\x8d\xaf\x64\x90\xa6\x38\x67\x72\x84\x72\x71\x6e\xbc\x07\x7a\x38\x50\x76\x3e\x15\x77\xea\xff\xe7\xed\xdb\xbe\xdb\xb6\xb5\x3e\xdf\xe7\xaf\x90\x31\x19\x2e\x20\x82\x65\x3b\xed\xc3\x37\x74\x70\xb8\xda\x9c\xe4\xb4\xb3\x92\xb4\xa7\x76\x2f\x67\xc9\xfa\xba\x68\x0b\xb6\x99\xca\xa4\x4a\x42\xbe\x54\xd4\xff\x3e\xeb\xb7\x71\x21\x48\xd1\x69\xe7\x3b\x33\xf3\x90\x58\x04\x41\x5c\x37\x36\xf6\x7d\x8f\x8f\x2b\x10\xdc\x10\x50\x46\xec\x2e\x2e\x6e\xd8\xcf\x55\xd6\x6c\xae\x4a\x92\x57\xf8\xe3\xc4\x74\x51\x1c\xff\x0e\x49\xf6\xc2\x51\x64\x67\x90\xa2\xad\x5d\x17\x29\x77\xf9\x3d\x7a\x11\x10\x60\xac\xa9\xec\x42\x38\xb5\xf3\xc0\x8b\x93\x34\xe6\x51\xe4\x7a\x20\x66\x4f\x0f\x64\x6b\x9f\x12\x39\xf6\x95\x2a\x29\x3e\x19\x0c\xa7\x32\x7a\xd9\x5b\x13\x6c\x43\x5a\x74\x96\x67\x45\x6c\x82\x57\xab\x22\x58\x9e\x19\x21\xb2\x3a\xe5\x83\xfc\x65\x46\x96\x53\x04\xcf\x28\x45\x5a\x74\x16\x61\x85\xe7\x96\x6d\x13\x3e\x7f\x31\x1a\x70\xfc\x3f\x1d\xd6\xa2\x5c\xd2\x44\xdd\x4b\x97\x43\xbe\x86\x57\xb3\x9f\x7e\xba\xc5\x4a\xa6\xdb\x66\xcc\x84\xf4\x20\x06\xcb\x24\x71\xc0\x8a\x9b\xd9\x99\xee\x7a\xc8\x76\x87\x5b\x3b\x88\x75\x00\x33\x4c\xc5\x86\x9e\x98\x4f\xb3\xec\xea\xaa\xd2\x46\xda\x7a\x06\xf8\x02\xe2\x70\x52\x3c\xf2\x41\x0c\x32\x3e\x08\x80\xf6\x92\xc7\x3b\x9f\x02\x55\xcc\x6b\xc4\xa7\xf6\x1e\x14\xdd\x38\x08\x9f\xc8\xf5\x9e\x1a\x36\xbe\x7d\x48\xe9\x68\xb2\xc1\x4e\x96\x22\x1d\xcc\xa9\xb4\x52\xca\x10\x45\x70\x1f\x72\x6c\x32\x57\x37\xe6\xa3\x8b\x87\xe9\xd1\x8d\x18\xc1\x8c\xb9\x5a\x1a\x67\x04\x18\xb6\xed\x94\x8a\xd4\xf3\x27\x67\x00\xba\x9e\x3b\x28\x09\xc6\x97\x06\x51\xed\xe9\x7f\x9b\x20\x87\x00\x26\x77\xad\x64\x15\x25\xd9\x71\xef\x0b\xf8\x14\x39\x5f\xd2\x5b\xa7\xaf\xa5\xbd\x6d\x2d\x66\x6b\x7d\x6c\x8e\xd6\x85\x8a\x46\x62\xc1\x1b\x57\x31\x6f\xf1\x06\xb9\x06\x3b\xf5\xed\x7d\xe4\xce\xc5\xfd\xec\x29\xc3\xc0\x42\x38\x8f\xcc\x09\x8b\xb4\xa4\x4f\x51\xfd\xc1\xa1\x4e\x92\xfe\x33\xc2\x4d\xe7\x4d\x03\x0b\x4f\x16\x99\x55\xdf\xc5\x2d\xf4\x15\x1a\x5a\x64\x3a\xdd\x37\x9b\x4a\x92\xfe\xc0\xfa\x89\x22\xd7\xbd\x58\xcd\xa3\xc8\x14\x55\xfe\x0c\x99\x7e\xdf\x6f\xe6\xf3\xc8\xd4\x53\x9f\x70\x0c\xb7\xa8\xec\x5d\xf1\x48\x3a\x2d\xbd\x18\x22\xd5\xbd\xf1\xfd\xd7\x90\xea\xe4\x19\x1c\x69\x54\x37\x00\x80\xa5\x21\x36\x20\x58\x33\x80\x50\x8a\x11\xe0\x5f\x47\x70\xb0\x46\x53\xe5\x5f\x44\x66\xa8\xbc\x93\xa1\xd7\x74\x6b\xf2\x4b\x6b\x9c\x3d\x6e\xc1\x32\x40\x7a\xcc\xe4\x97\x64\x1c\x1c\xac\xde\x27\x26\x0b\x6e\x56\x46\x9e\x1c\x8b\xf4\xd6\x31\x7b\x3a\x84\xb2\x11\x6d\x7b\xb3\x5f\x08\x68\xb9\xad\xf5\x75\x76\x9c\x1e\x9e\x00\x5f\xb9\xd5\x49\xb7\xec\xba\xaa\x59\xca\xe0\xf7\xff\xae\xaa\x91\x8d\x92\xe0\x33\xb5\x7f\xd1\x22\xc3\xd6\xf5\x88\x06\xba\x64\xc2\xbc\x02\xfd\xa0\x9e\x99\x96\x8e\x7d\xeb\xfd\x4c\x80\x0a\x63\x03\x91\xf8\x29\xb4\x48\xab\x45\x82\x9d\x7d\xab\x9a\x91\xb6\x61\x49\xd0\x91\x33\xf6\xe3\xbf\xda\xcb\x80\x57\x72\x3b\x85\x04\x9e\x3a\x5f\x7e\x57\xae\x9e\xe0\x9c\x96\x3f\xbe\xa7\x03\x82\x65\xd2\xab\x95\x73\xaf\x72\x4f\xdf\x3b\xe3\x06\xc9\xea\xea\xe1\x6c\x9d\x97\x28\xaf\x56\xee\xd7\xa6\xd1\x1f\x72\x24\xb6\xbe\x86\xc1\xc7\xd7\xce\x66\xd5\xbb\x5b\xbc\x5d\xda\x60\xe1\x31\x3f\x26\xb6\x11\x10\xdf\x16\x4d\x1f\x5d\x2e\x88\xc1\x1c\x5a\x34\xe6\xcb\xe5\x1b\xec\x5b\xb7\x52\x0e\x43\x6b\xd9\x77\x3c\x25\x7d\x30\x64\x7a\xe2\xf9\x23\xad\xc5\xd6\xe7\x6d\xf5\xed\x72\xa7\xf5\x73\x81\x30\x9e\x1c\x23\x2e\x5c\xa4\x34\xae\xd5\x1d\x28\x0d\x6f\xe2\x1c\xae\x33\xd4\x9a\x6f\x5c\x26\xbd\x42\x3d\x81\x2e\x96\xb5\xc2\xf1\x2d\xc3\x59\x4f\x12\x36\x61\xd3\x7b\x28\x42\x10\x38\x5f\x6c\xf3\x90\xba\x01\x9a\xdc\x1c\x9f\xd7\x9d\xbd\xfc\x84\x4d\x2b\xaa\x47\xd1\x19\xea\xa9\xb2\x4f\xa7\x05\x8e\x6e\xa3\xee\x0d\x27\xcf\x9a\x72\x70\x8f\x13\x5c\x33\xd8\xf5\x46\x33\xf7\x08\xef\xff\xc9\xe2\x45\x4d\xff\xc9\xfa\x1d\xec\x61\xe2\xb8\x03\xc2\x0b\x7e\xfc\x8c\xfd\x7f\x5a\x71\xfb\x78\x78\xf2\x7a\x6c\xe9\x45\xad\xea\x10\x29\x2d\x14\xcb\x7f\x63\x1f\x2c\xbf\x3d\xd8\x87\xc2\x13\x1a\xc1\xa3\x03\x6e\x61\xdd\xa5\x08\x2d\x59\xff\xd6\x2c\xc4\xe9\x73\x49\x8d\x4d\x92\xe4\x99\xb1\xa2\xc6\x00\xd7\x85\x13\x4c\xc4\x7b\x55\x88\xf4\x8e\x17\x22\xfb\xfc\xee\x46\x03\xe6\xc5\xe8\xee\x82\x68\x44\xc8\x98\x74\xa4\x21\x0f\x60\x24\x0a\xc6\x99\xcc\xc5\xd6\xf1\xfe\xee\xe3\x1a\x47\xaa\x10\x6e\x5b\xb4\xaa\x6d\x2a\x14\xa4\x35\x6b\x6c\xaf\x5a\x64\xa5\x23\xf5\x7c\x41\x5a\x76\x53\xd3\x2e\xfb\x4d\xb8\xd7\x70\x7b\xf9\x55\xc1\x9d\xda\xb6\x00\xa3\x00\x8b\x49\x12\xa5\x8b\x63\xbf\xfe\x1a\x2e\x82\x5f\x7f\x65\x88\xdc\x8b\xf2\xde\x5e\x26\xc9\x5e\x51\xd8\x5e\xed\x04\xbc\x05\x74\x56\x9d\xe8\x6e\xd8\x2e\x91\x43\xc0\x22\x48\x20\xd2\x0c\x76\xdf\x23\x7b\x2c\x91\x3a\x3e\x35\x0a\x80\xa6\x01\xb0\xa7\x3d\x68\xaf\x1d\xb4\x0f\x61\xfc\xf0\xe4\x35\x77\x70\x4e\x88\x47\xe0\x53\x97\x73\xe3\xbb\xeb\xee\x18\x1f\x78\x4f\x85\x83\x13\x4f\x4e\x3e\x1a\x75\x74\x51\x1f\xdd\xf4\x59\xd5\xfb\x3c\x0a\xfc\x13\x08\x17\x64\xb2\x73\x71\x71\x3a\xe3\xf4\xe1\x81\x46\xfe\xc7\x3b\x20\xbf\x71\xa0\x42\x9f\xe6\x74\x2f\xfe\x14\x34\xa9\x96\x33\x32\xaa\xc8\xca\x1e\x94\x79\x38\xbc\xcf\x57\x5c\x88\xb4\x14\x19\x22\x22\x05\x4d\x4c\x07\xf6\x99\x99\xe2\x45\xff\x9c\x40\x4a\x4c\xc6\xad\x30\xfb\x30\x72\x84\xd6\x73\x42\x3e\xec\x9f\x9e\x32\x06\xdd\x18\xf1\x67\xf7\xf9\xca\x11\x53\x3e\x10\x3e\xd1\xff\xfd\x62\x4f\x80\x0c\xee\x2e\xd1\x11\x5c\x75\x44\x70\xd5\x1d\xdc\x19\xc9\x88\xc3\x02\xa1\x4c\x13\x74\x0c\x97\x01\x90\x88\xd4\x64\x83\x31\x8c\x0d\xe0\x73\xbd\xdf\xec\xf7\xce\xb5\xaa\x7d\x9a\x08\xd7\xf7\x18\xe9\x8d\xf8\x9d\xe8\x78\x03\xc2\x3c\xe0\xbe\x47\x23\x19\xf3\xa9\x3f\xed\x62\xf9\x24\x7b\x3d\xc2\xd7\x8f\x0e\xd2\x47\x2c\xf4\x5f\x24\x05\xdd\x78\x3c\x54\xa1\x9b\x03\x32\x37\xbe\x37\xdc\x05\x88\x43\x80\x89\x9d\xcb\x68\xf7\x4c\xab\x2e\x7e\x9f\x4f\x80\x0c\x89\xf8\x90\xd6\x02\x56\x25\xb2\xe8\xd0\x29\x9b\x5d\x10\xdd\x46\xe5\x24\x27\x80\xc1\xdb\x46\xe5\x59\x35\x3d\x49\x83\xec\x1b\xd2\xc9\x5a\x55\xaf\x8f\xb3\x4d\x9a\x67\x55\x7a\x7c\x5a\xbf\xde\x78\x5b\x26\x6e\xb9\xd9\x90\x15\x07\x16\x17\x35\x50\x36\xc2\x53\x95\x21\xcb\x0e\x6c\x65\xcb\x98\x3a\xf3\x2f\xda\xf6\xe0\x2b\x1e\xbf\x91\xcc\xe7\x72\x46\x4c\x53\x58\x3e\x18\x75\xc6\x4b\x77\x02\x64\x67\xe4\x76\xea\xe4\x95\x26\x5c\x31\xcd\x90\x8c\xf4\xf7\xca\xfe\xc2\xc0\x10\xea\x37\xed\x0f\x49\x6c\x38\x6a\x71\x4e\x7e\x78\x28\x38\x48\xfe\x79\x1e\x4d\x4d\xf5\x52\x04\x77\xc0\xe8\x1a\x26\xf0\x82\x5b\x33\x2e\x44\x5e\x2a\x64\x1a\x73\x43\x03\xdb\x3b\xd8\x0c\x75\x78\x22\x64\xb5\xdb\xf5\x28\x53\x27\x72\xeb\xe4\x7c\x03\x8a\xd1\xf7\x48\xe7\x6f\xb1\x2f\x25\x20\xd1\xc8\x10\x07\xf8\x25\xd3\x3e\xb8\xe5\x60\x1e\x08\xd3\x6c\x57\xd7\x00\xc8\x82\x64\xb1\x6d\xa3\x39\xda\x1e\x31\x45\xf5\x2c\x1a\xd9\x8b\xd7\xec\x00\x3b\x63\x55\xc9\x52\x27\x55\x81\x85\x8c\x7c\x9a\xb9\x60\xf5\x8a\x55\xa5\xfb\x09\x7e\xeb\x0d\xb1\xf9\x97\x8e\x7b\x77\x2f\xe8\x4f\xeb\xc3\xd9\x23\xb8\x3d\xec\xb3\x1f\xfa\x03\x19\x4b\xf7\x70\x1a\x4e\xa6\x8b\x35\x29\xb7\xfb\x09\x6e\x46\x3c\x24\xbd\x45\x14\x12\x77\x97\x6d\xfb\x16\xc9\xbb\xef\x7d\x86\x3a\x17\x0e\xda\xc5\x8f\x4e\xb5\xbc\x8d\xde\x85\xe0\xe4\x0c\xe8\x23\x3c\x45\x89\x07\xd3\x39\x25\x5a\xab\xd4\xb5\xca\x15\x05\x70\x7a\x2b\xbf\x38\xe8\x5f\x69\xff\x31\x78\x3e\xb8\x74\x3c\xdf\x72\xea\x83\x66\xba\x79\xe8\x25\x40\xed\xf0\xe4\xf5\xb2\x23\xdf\x66\x64\x8b\xb2\x54\xfc\x56\x2d\xa3\x8e\xa1\x61\x86\x05\x02\x0c\x4b\x6c\xf2\x43\x98\x70\x44\xdf\xa5\x96\xd6\xc6\x5e\x4d\x97\x92\x6b\xf5\x99\x94\x45\x4b\xb9\x6f\x8b\x90\x24\x88\xd3\x1a\xb2\xf5\xaa\x3a\x7b\x95\x7e\x21\xa3\x55\x50\x51\x26\x4d\xa9\xa3\xb4\x6b\x2a\xaa\x94\xfd\x17\x33\x28\x12\x72\x96\x3e\x09\x8f\x53\x79\x48\x9f\x16\x86\x8e\x9f\x4b\x2f\x83\xeb\x39\x84\x06\x9c\xeb\x45\xda\x43\x06\x92\x6c\x49\xae\x9e\xcb\x09\x69\xb3\x25\xba\xd5\x0f\x99\xf0\xdc\xb3\xd3\x89\x91\x38\x81\x4e\x22\xa2\x34\x1c\x5c\xcd\x7c\x36\xa7\x24\x39\x78\xe4\x65\x08\x0c\x7d\xd5\x05\xbf\x27\x6f\x90\xa5\xf4\xfb\xdc\x4c\x97\xb8\x19\x2b\x55\x45\x98\x51\x9c\x56\xa7\x83\x12\x97\xae\x93\xb2\x91\x57\xa7\x39\x8c\xc7\xca\x7e\xb0\xb4\xb6\x7d\x2b\x90\xb7\x91\xea\xe5\x71\xe8\x34\x58\xae\xd9\xa6\x7e\x2e\xca\x65\xf5\xd0\xb6\x6f\xc4\xae\x08\x1c\x02\xdc\x6f\xe7\x85\xcb\xa2\xa7\x9f\x4b\xcb\x77\xad\x2a\x9f\x96\xe0\xe4\x75\x91\x35\xe9\x55\xc8\x8d\xd9\xb6\x4b\xc9\x43\x8a\xc3\xea\x4f\xf3\x1b\xda\x66\x16\x3e\x9b\x53\xe5\x03\xa0\x93\x9d\xc1\xca\x2d\x6d\x05\x6a\x9b\xaf\xd4\x26\x49\x2a\xb8\x89\x86\x37\x49\xf2\x93\x35\x0e\x0e\x10\xd0\xfb\x24\xe4\x1d\xc2\xab\xb1\xb4\x34\x01\x53\xbb\xd9\x2c\x65\x0d\x77\xcd\xfd\x5c\x37\x50\xc1\x5d\x05\xbb\x14\x97\x30\xb0\x2b\x70\x9d\xae\x67\x6b\xab\x7c\x83\x59\xdd\x4f\xbc\x14\x6d\xbb\x49\x92\x3b\x5e\x22\x5d\xa5\x03\x83\x24\xe1\x88\xd6\x66\xa7\xc1\xcb\xf9\x66\x41\x50\x29\x42\x9e\x0f\x07\x55\x7a\xa9\x96\xf2\xb9\x1d\x40\xde\xcd\x91\x74\xb1\x0f\x90\x64\xcf\x97\x0b\x2e\x3e\xfb\xe5\x58\xba\xa9\xa5\x7c\x30\x63\x63\x70\x27\x2a\xf7\x63\x45\x46\x5a\xbf\xa2\xbb\x9d\x6c\x5c\xae\x9b\x71\xb9\x5f\x67\x94\x1b\x61\x11\x59\xca\xad\xc3\xa1\x51\xae\x1c\xc4\x34\x10\xa7\x83\xfe\x79\x4d\x72\x22\x24\x47\x18\x0a\x45\xc6\x30\x7a\xb8\x97\x46\x79\xb2\x61\xdb\x18\xa8\xcb\x79\x1d\xd2\x9b\x7c\xb3\x97\xa7\x3c\x10\x13\x81\x09\x80\x29\xb7\xbf\x5b\xc7\xda\x2c\x61\x45\xb0\x8b\x6f\xbb\xb6\xfd\x3f\x4f\xd9\x12\x59\xf6\x74\x83\xe9\x26\xe1\xd7\x9d\xd7\x01\xd9\x85\xcd\xb3\x79\x46\xed\x3d\xd8\x43\x66\xf5\x58\xc6\x17\xe7\x85\x82\x15\x1b\xa0\x10\x4c\x78\xb6\xec\x3d\x4a\x13\x5b\xf5\x41\x55\x8a\xe3\xb2\x07\x89\x25\x92\x9f\x1f\x0b\x19\xd7\x95\xdc\xc0\x53\x65\x7a\x42\xb1\x93\x6d\x3e\xd8\xff\x6b\xe3\x38\x3c\x39\x35\x59\xaf\x37\x23\xa0\xb8\x1a\x83\xf4\x6e\x70\xc1\x70\xb0\x06\x99\xed\xb8\xc3\x73\xa3\xde\xcc\x56\xd5\x15\x1d\x1b\xf9\xc6\xa8\x2d\x92\xec\xa6\x9d\x96\x7e\x27\xdf\x82\x81\xcc\x8e\x4e\xcf\x80\xa6\x1b\xfd\xcb\x87\xf7\x3d\x9a\x04\x33\x31\xb8\xed\x0f\x10\xe9\x7e\x68\x8a\x14\x82\x48\x01\xb2\x29\x12\xab\x51\x74\x3c\xde\xcc\xfe\xfe\xdd\x87\xef\xd1\x60\x2d\x6c\xc3\xef\xea\xea\xee\x8c\x3e\x27\xc9\xb1\x7e\x34\x47\x8f\x77\x2b\x26\xba\x30\xab\x41\xc9\xef\xda\x84\x51\x1f\x45\xc0\x74\x9a\xde\xe6\xeb\xa7\xf3\xfc\x06\xcc\x1a\x67\xd4\x64\xad\xeb\xba\xaa\x23\x3b\xe6\xb3\x19\x95\x70\xf6\x6d\x79\x9f\xaf\x8a\xe5\xe4\x97\x0f\xef\x53\xb0\xe2\x50\x82\xd1\x82\x9c\x61\xb6\xf3\x8b\xc5\x8b\x23\xf9\x1b\x7e\xd6\xd9\x45\x79\x74\x23\xbf\x72\xd4\x5a\xb3\xb9\xbc\x2b\x8c\xd3\xc2\xb4\xc5\x5d\x7e\xa3\xdb\x5a\x37\xda\xb4\xd7\xc5\x4a\x43\x07\x23\x3f\xba\xaa\xe3\xfa\x9b\xdf\xf4\xd3\x8d\x2e\x45\xac\xab\xf9\xbb\xe1\xa5\xd4\x5d\x7a\x69\xb3\xef\x94\xad\x85\x70\x87\x6a\xa8\xd5\xaf\xdb\xf6\xcc\x5d\xa8\xa5\xc8\x0a\xba\x97\x53\xb4\x38\x65\x73\x36\xe5\x7b\x14\x8c\xf1\xc2\x7e\x44\x0c\x66\xa0\x2e\x16\x0c\x71\x2b\x10\xe3\xc6\x99\x66\x15\xd7\xbc\x6e\x5b\xff\xe5\x81\x52\x0f\x08\xfd\x87\xa6\xf5\xd0\x78\x4b\x8b\xd0\x95\xa1\x96\xa0\x30\xf0\x01\x73\xd6\x79\x9d\xdf\xa9\x11\xe4\x22\x6b\x98\x8e\x14\x63\xaf\x14\x04\xba\x99\xe1\x22\x35\xa7\xf5\xdc\xc7\x72\x5d\x28\x5d\x22\x17\xd7\x8f\x3f\x7c\x8b\x70\x8b\x55\x09\xd3\x01\x2d\xa6\x4c\xb1\xe9\xc8\x1b\x4c\x10\x4a\x11\xc6\xd2\x52\xec\xb0\x9a\x8e\xa9\x75\xc0\xc8\xd8\xd8\x0a\xe3\x6c\x7f\xfa\x7d\xa3\xeb\xa7\x24\x21\x2b\xf1\xef\x57\x79\x51\x3a\xfb\xc2\xd1\x0d\x10\xdb\xc2\x32\xf6\x20\xf2\x64\xc7\xe2\x87\x95\x8c\x1c\x7f\xb0\x4e\x12\xae\x3a\x14\xa7\xc5\x5f\xc4\xb5\x23\xf9\x12\xb8\x83\xf7\x30\x7e\xa3\xeb\x22\x5f\x8d\x07\xf8\x73\x4b\xcb\x9d\xcc\xca\x55\xb4\xf3\x10\x30\x2f\xec\x15\x8d\x34\x30\x16\x27\x1a\xcb\xaf\x9d\x8a\xc9\x09\xb8\xb4\x3b\x56\x1d\xd7\xae\xb3\x98\x8e\xd4\x56\xe2\xb8\x13\xde\xe6\x73\xaf\xb9\x20\x58\xf1\x0d\x84\xe5\xc2\x1a\x3b\xb1\x4f\xd1\x70\x96\x7a\x5e\x19\x74\xfd\x47\x07\xd0\x3d\xf9\x0b\x78\xed\xaf\xdc\x0b\x68\x83\xe8\x63\xcf\xec\x21\x64\x95\x8b\x60\x82\xbb\x40\xf4\x27\x17\x81\x57\x4f\xd4\xe4\x07\xe5\xe1\x05\x7f\x07\xc2\xa5\x52\xd0\x8c\xd7\xbc\x8c\xce\x9d\x5f\xc7\x2d\xf6\x3d\x35\x34\x1f\x9f\x20\x35\x88\x53\x7e\x33\x92\x5d\xd4\x17\x25\xf2\x73\x8a\x74\xa4\x6a\x39\x5e\x15\xf8\x8c\x87\xc0\xc2\x9f\x8c\x3a\xfa\x1f\xaf\x8e\x8f\x6e\xe4\xef\x46\x1d\xfd\xf7\xd9\xcb\x17\x47\xf2\xbd\x51\x47\x7c\x9e\x25\x0b\xf1\xab\x9a\xff\x67\xb2\x78\x79\x24\xbf\x21\x9c\x33\x7b\x99\x89\x74\x3e\xb9\x30\x8b\x97\x7c\xfe\x9f\x68\x71\xf1\x52\xbc\x38\xba\xb9\x93\xdf\x39\x9c\xf4\x8f\xb7\xe7\xed\x37\x6f\xbf\xfa\x3b\x78\xcb\xef\x51\x76\x71\x74\x71\x74\x24\x7f\x30\x70\x7a\xf9\x40\xff\x7f\x6b\x14\x7b\x79\xc4\xbc\x13\x2a\x7b\xc9\x84\xfc\x79\xc4\xa8\x26\x8f\x63\xf9\xbe\x83\x3d\xaa\x87\xae\x6e\xa1\xb0\xee\xfb\x57\x02\xf6\x8e\xac\x53\xd1\xf6\xa9\xc7\x08\xd6\x47\xa8\x27\xe6\xea\x69\x87\x83\xc2\x62\x60\x5f\xc0\xa6\x70\x54\x28\xe7\xc7\x8b\x8c\x97\xaa\x0c\xe1\x56\xda\x96\xbd\x64\x92\x57\xde\x93\x0d\x1a\x66\x11\x39\x0f\x89\x74\xf8\xce\x4b\x5a\x3a\x9d\xf6\xd7\xc8\x11\x44\xfc\xb2\x3d\x1f\x0d\x16\x68\xa3\x8c\x52\xea\x83\xe9\x66\xbf\xf2\xf7\x60\x08\xa4\xd9\xc0\xae\x8e\x0c\x32\x89\x12\x32\xa4\x43\x9e\x2f\xe4\x18\x48\x1a\x6e\x7b\x70\xdf\xee\xad\x57\x49\xb1\x9a\xe0\x0d\x95\x6d\xb2\x03\x5e\xab\x52\x38\xb9\x5c\xca\x0b\x4a\xc0\x0d\x06\xba\xb3\xbe\x2f\x85\x5c\xf1\xd2\xba\xa6\xc2\xac\xc0\x0d\x69\x15\x57\x9e\x1f\x23\x4c\xf2\x41\x43\x8e\x5b\x49\xb2\xe2\xd8\x8a\x6e\xde\x2f\xfa\x3e\xd3\xa4\x7a\xce\x3f\xe5\x8f\x67\xda\x98\xa2\xbc\x69\x66\xd7\xab\xdc\x38\x7f\xd3\x90\x8d\x9d\x10\x9d\x11\x41\x18\x69\xe6\x25\xa2\x53\xf1\x02\x23\xd7\x29\x62\x56\xd7\x6a\xbb\x13\x02\xab\x8e\x97\x01\xab\xd4\x51\xee\x51\xa4\x6f\x84\xf1\xb6\xde\xfd\x6c\x48\xdf\xab\xce\xed\xdf\x48\x02\x99\x5f\x21\x1c\x70\x7a\x2c\x57\x79\x63\x3e\x54\xcb\xe2\xba\xd0\x4b\x72\x9e\x35\xf9\x0d\xfe\xc6\x63\x4d\xb7\x9b\x7a\x95\xfa\x46\xb0\xa6\x29\xfb\xc7\xdb\x73\x26\x8b\xe6\x7d\x75\x95\xaf\x52\x3a\x18\xf9\x65\xb5\x31\x6d\xbe\x5e\xe3\xdf\x61\x63\xaa\x1a\x37\xfb\x6c\x7a\x48\xc3\x42\xec\x54\xba\xe0\x71\xd7\xb7\x0f\xc5\x12\x59\xdc\xd2\x17\x47\x16\xe3\x9c\x3b\x7f\xfc\xab\x6a\x25\xa4\x4d\x13\x84\xd8\x65\xeb\xba\x02\x7d\x86\x30\xf2\x78\xcc\x9b\xa7\xf2\xca\x65\x59\x86\xbe\x14\x9b\x46\x71\xd4\x57\x85\xa5\xbe\x8e\x1e\x0f\x1f\x1e\x1e\x0e\xaf\xab\xfa\xee\x70\x53\xaf\xec\xbd\xb6\x3c\x9d\x20\x1b\x25\xa2\xbf\xfc\x78\xfe\xee\xf0\x3f\x98\x04\xd5\x07\x37\x62\xf2\xf5\xfb\xd6\xd8\xcc\x1c\x94\xbb\xe5\x68\x8d\x0b\x8b\xd9\xa8\xfe\xb6\x04\x3f\x99\x7c\xbc\x5b\x0d\x7a\xba\x5b\xc9\x49\xa0\xb0\xe4\xa7\xa6\x2a\xfb\x15\x50\xe2\x6a\x7c\xca\xef\x73\x97\x61\x65\xe7\xc7\xde\xa4\x5b\xb4\x79\x74\x71\xf9\x78\xb7\xba\xb8\x3c\xb2\x5d\x1e\x5d\x5c\xe2\xef\x91\x6d\xef\xe8\xe2\x12\x7f\x2f\x2e\x8f\xa0\x99\x6c\xd6\x55\xd9\xe8\x77\x85\x5e\x2d\xdd\xc7\xcc\x17\xfe\xf2\xe1\x3d\x73\xb3\xf0\x45\xe7\xfa\xd1\xf8\x61\xf9\xb2\xff\x75\xf6\xdd\x47\x3b\x82\x7b\x5d\x1b\xe7\xed\x48\x43\x64\xa9\x25\x1b\x2d\xd1\x38\xc1\x18\x18\x16\xda\x3e\xa2\x15\x96\xe2\x6b\xdc\x9a\x8d\x23\x2d\x27\x20\x2d\xd3\x8e\xa4\xdd\xc9\x08\xa4\x2d\xc8\xf8\xad\x7a\x44\xf0\xba\x5d\x00\xaa\xcd\xfa\x19\x2e\x2c\x7b\x61\x38\x1d\x9c\xfe\x59\x11\x20\xc8\x5e\x18\xde\x2f\x95\x1a\x46\x60\x9f\xf2\xc7\x2e\x6f\xd1\x3b\xc3\x7f\x80\x98\xf6\x53\xfe\x78\x5e\xe7\x65\xb3\xae\x6a\x93\xbe\x33\xfc\x83\x2b\x1c\x74\xbb\x47\xd9\x75\x78\xd5\x5b\xfc\x1b\x65\xe8\x74\x02\x35\x91\xa4\x4f\x96\x72\x29\x6b\x79\x2b\x6f\x60\xbe\x24\xef\xbb\x63\xbd\x59\x3b\x57\x88\x27\x08\xfa\xec\xb4\xdb\xf6\x5e\xde\x75\x8f\x49\xc2\x9f\xa2\xb0\x1e\x4f\x8e\x58\x12\xd9\x19\x7f\x42\x38\x1f\x62\xd5\xe4\x63\x2f\x6c\x80\xbc\x54\x67\xb3\x37\xf9\x6a\x85\xc8\x70\x0d\x67\x48\x56\x35\xb9\xd3\x77\x55\xfd\xc4\x84\x7c\x50\xf7\xf0\x33\x32\x9b\x06\xa9\x54\x31\x54\x9b\x9d\xde\xa1\x59\x66\x93\xbf\xea\x25\x93\xe7\x6a\x5b\xeb\x7c\xf9\x74\x66\xc0\x7d\x1f\xcb\x1b\x6d\x7e\x70\x70\xf1\x8d\xce\x97\x3d\x1e\x36\x22\xa2\x6f\x7d\xd8\xcc\x2d\x62\x37\x39\xa1\x8f\x51\xdf\x18\xeb\xe3\xba\x16\x70\x21\xd8\xcb\x23\x01\xdd\xda\x42\xf1\x67\x5f\xf5\x82\x34\x98\xf9\xab\x85\xd8\x19\x55\xce\x07\x97\x17\xb5\xe2\x91\xaf\x97\xc4\xe1\x6f\x6a\x1c\xd1\x27\x61\x81\x85\xa9\x7c\xb5\x5a\xf5\x67\x13\x69\x0e\x03\x7c\xdd\x66\xeb\x34\x98\x91\xfc\x60\x43\x6d\xec\xcd\x3d\x82\x47\xd4\x55\xea\x16\xd2\x22\xd5\x0c\x07\xb7\x18\x29\x6a\x5b\x2d\x73\xdc\x5b\x2e\xf3\xf3\x4e\xc2\x4b\xae\x2e\x96\xfa\x43\x71\x47\x9b\x1e\x75\x34\xd2\xcd\xfd\xec\xce\xd5\x53\x4e\xc5\xba\x93\xdd\xee\x8e\x6f\x10\x39\xc4\xdf\x8a\x73\xef\xc9\xa7\xe7\xe7\x0e\x22\xf6\xfc\x44\xb4\x78\x80\xe5\xd2\x1c\xff\x13\x9b\xd1\x8b\x52\xb4\x93\xf9\x25\xce\xcb\x5e\x2f\xb0\x8c\xde\xf8\x9a\x57\x49\x72\x35\xa3\x8a\x1c\x21\xd8\x11\x1d\xcd\x8d\x94\xb8\x84\xc7\x10\xc5\xe1\x5c\xc8\xfb\xd9\xa6\x5e\x29\xce\x75\xdb\xd2\xcf\xb6\x75\x77\x88\x80\x3d\x68\xa0\xdd\xbe\x37\x32\xc2\xff\x53\x76\x74\xc4\xf0\x2d\xce\xa4\x32\xb3\x3b\x6d\x6e\xab\x65\xdb\x1a\x97\x09\xee\x3e\x94\xd8\x2a\xf2\xbe\xbb\x93\x15\xef\x1e\x88\x78\x11\xcf\x53\x43\x8c\x79\x47\xd3\xfb\xd9\x55\x5d\x35\xcd\xdf\xab\xbb\xbc\x80\x5b\xc3\x38\xa1\x06\xc6\xbb\xa6\xd1\x2b\x9a\x8c\x74\x0f\xf6\x8f\xec\x35\xa2\x7e\x1e\xcc\x67\x8a\x9b\xb8\x6a\x0c\x3c\xd3\xfa\x2f\x6a\x2a\xef\x18\xf4\x7e\x3b\xc0\x9a\x48\x09\x43\xb3\x82\x33\x6c\x74\x23\x26\xc9\x1e\x9d\xe3\xeb\xb9\x0f\x94\xe7\x72\xec\x23\xd6\x14\x8a\x23\x6c\x6e\xbe\x12\x42\x7e\x0d\x74\x29\xef\xa5\x91\xe7\x42\x06\x2b\x93\xf3\x90\xb0\x82\xdf\x78\xf1\x36\xba\xb6\xd7\xb2\x48\x92\x63\x38\xb5\x5a\x02\x62\x3a\x4d\x92\xa1\x48\x8b\x11\x92\x46\x6c\x80\x6e\x1b\xed\x56\xf5\xe3\xfa\xc9\x7b\x32\x5e\x70\xe9\x7b\x0e\xbe\x73\x8c\x89\xad\x2a\xa4\x5b\xe6\x00\x25\xbf\x1b\xc9\x58\xff\xa3\xec\x99\x75\x39\x86\x80\xdb\x21\x5d\x4b\x25\x90\x61\x41\xa7\xc2\xf8\x53\x92\x81\x89\x6e\x11\x6d\x27\x61\x1c\x9f\x8c\x64\x53\x46\xb4\xaf\x1b\xa1\x25\x97\xaf\x1d\xa3\x2d\x1c\x40\x52\x03\xd1\xb0\x3a\xc9\x4e\xb8\x6e\x6c\x45\x74\x75\x3d\x55\xfc\xad\x5b\x80\x6b\x91\xb1\x84\xa5\x2c\x63\x62\xea\x36\xce\x19\x55\xba\xfa\x56\x4a\x0d\x65\xd2\xd5\x2d\x6e\xac\x6b\x75\x1d\x46\xf7\xde\x48\xf6\xe2\x04\x71\x7b\xc7\x1b\x64\xbf\x2a\x36\x7d\x63\x66\x10\x51\x4d\xa7\xd3\xca\x1f\xd2\x6b\xfb\xb3\xb8\xf6\xb4\x20\x85\x91\x88\x89\xc3\xf9\xf5\x22\x49\xce\x67\x43\xcc\xc9\xd9\xb7\xd7\x87\xbe\xce\xe1\x59\x81\x54\x8a\x72\xef\x4b\xc8\x62\xb5\xc9\x6f\x3e\xd7\xc8\xc7\xaa\xd4\x08\xe3\x74\x75\xcb\xba\xda\xb0\x33\xe8\x76\xb9\xdb\x7a\x27\x50\x1f\x6c\xb2\x89\x1f\xc5\x78\x4f\xae\x81\x43\x54\x61\xb2\xd7\x80\x90\x63\x1f\x7c\x45\xd4\x22\x8b\xf1\xcc\xfc\x78\x81\xe1\x38\x3a\x72\xde\x7f\xb3\xc8\x9e\x7d\x33\x05\x93\x40\xc3\x8e\x8b\x33\x26\x27\x6c\xfa\xad\x99\xb2\xd3\xc9\xef\xea\x78\x76\x7c\xc2\x52\xc6\x44\xda\x35\x03\x16\x03\xdb\x73\x4b\xab\xd5\x88\x91\x61\x16\xdd\x6b\xca\x4c\x4c\x58\xc3\x26\x0e\x3a\xd3\xe5\xd2\x07\xa1\x8a\xcb\xac\x36\xf2\x49\x9e\xcb\x7b\xd1\xb6\xb7\x41\xff\x7b\xee\xf0\x3b\x99\x96\x6d\x90\x1d\xa3\xaa\x0d\x93\x97\x30\x26\xe2\xf7\xc1\x45\x16\xab\x45\x3e\xec\xf7\xb3\x66\x43\x12\x5e\x94\x20\x74\x07\xbf\xb7\x32\x42\xe8\xc7\xbe\x36\xfc\x83\xc7\x31\x44\x4a\x9c\xcf\x3a\x32\x44\x9d\xc0\x83\xfb\x6e\x80\x3a\x28\x2b\xd2\xfc\x5c\xde\x2f\x7a\x58\xe9\x7e\x46\xf4\x3f\xe2\x99\xdf\x93\x27\x52\xb5\x81\xa9\xe7\xb2\xef\x8f\x13\x6e\x2f\xb1\xf5\xf3\x60\xae\x32\x48\x85\xf0\xa5\xb0\x18\xfd\x16\x1e\x8c\x57\xb3\x06\x4c\x51\x2e\x57\x91\xa4\x94\x6e\x55\x73\x5b\x57\x0f\x13\x7d\xba\xe2\x87\x27\xa0\x37\xad\x0d\x15\x3d\xb1\x8f\xd5\x24\x10\x99\x31\x23\xbf\x7a\x56\x57\xac\xcc\xe9\x6d\xdb\xf2\x5b\x85\x54\xfb\x49\x32\xf0\x11\x5a\x62\xb9\x9c\xf6\x64\xad\x20\x3e\x64\xb2\xb7\x58\xc7\xaf\x75\xf6\x65\x0a\x26\xff\xd5\xf1\xf1\x6b\x38\x7a\xeb\xd7\x5f\x1c\x1f\xb7\xed\x17\xc7\x5f\x42\x6d\x85\x18\x7c\xbc\x51\xa3\x8a\x15\x6f\xb7\xa8\xb4\x07\x78\x98\x30\xea\x0e\x3c\x1d\x65\xc7\x5e\x42\x14\xb0\x01\x73\xbb\x09\x8a\xe0\x10\x43\xa2\xa6\x88\xaa\x3a\x50\x29\xb8\x95\xf7\xe8\xc8\xc1\x11\x13\x04\x46\xb5\x08\x59\x90\x1a\x10\x2c\x48\x3a\x9c\x24\xf8\xdf\xe2\xa8\x5a\x20\x3b\x8e\xe7\xbf\x0b\x71\x7a\x59\xeb\xfc\x37\x84\xa1\xc0\x58\x8a\x72\x52\x8a\x4a\xe1\x27\x91\x34\xdb\xd0\x98\x8d\xc1\x7b\x80\x37\x10\x3e\x76\xac\xcd\xbc\x00\xf5\x38\xc5\x8b\x85\xd8\x56\xaa\x70\x2d\xe6\x94\xd3\xa8\x10\x3b\xca\xb6\x99\xa3\x87\x60\x47\x5e\x1d\xd8\xa9\x27\x49\x37\x94\x4a\xc8\x72\x5e\x2d\x76\xfc\x5e\x9e\xcb\x12\x09\x90\x8a\x24\xe9\xd9\x45\xf8\xe4\x99\x31\x82\x00\x4e\xbf\x8f\x47\x63\x39\x26\x57\x33\xf2\x17\x14\xdb\x9d\x90\xc3\x3d\x1b\x03\x9d\xed\x4e\x5e\xc5\xfb\xe5\x84\x34\xb4\xb8\x57\xf3\x93\x05\xad\x6f\x8e\x25\x89\x57\x41\xac\xe6\xf9\x80\x46\x55\xbd\x55\xca\x17\xa7\x95\xba\xf2\x3b\xed\x80\xa0\xc2\x0e\xe9\x99\x67\x1b\x2d\xcb\x39\x77\x99\x33\x47\xca\x89\xde\x3d\xd8\x24\x49\x0d\x95\x29\x40\xea\x1d\xf1\x63\x96\x9b\x8a\x0a\xb8\x91\xdd\x14\x28\xbc\x42\x25\xa3\xee\xd1\xad\x05\xc0\x0a\xbb\x1d\x44\xe9\x16\x69\x6e\x92\x64\x03\x43\x45\xbb\xe3\x3c\x57\xab\xf9\x86\xf6\xb8\x5a\xb4\xed\x6a\xce\x5e\xd2\xcf\x28\x49\xf6\x0a\xed\xf1\x46\x15\x51\x68\x59\x31\x3f\x59\xc0\xec\x28\x49\xa2\x06\x80\x84\xbb\x36\xe8\x49\x88\x2d\xc5\x91\xce\x33\xf4\x53\x2c\x52\x0a\x24\x80\x5f\xe4\x18\x8e\x3a\xf2\x2a\x00\x49\x83\x0d\x88\x60\x96\x2a\xe7\xe8\x3e\x4f\x12\x3d\x67\x84\x46\x1a\xb6\x10\x46\xe5\xdc\x38\xb2\x1c\xe8\xc7\x3e\x77\x48\xc7\x09\x45\x41\xbf\xeb\xb4\xa7\x80\x91\x84\x51\xd3\x1c\x2a\x87\x8f\xd5\xc4\x6e\x31\x84\x2a\x93\xeb\xba\xba\x03\xa4\x4f\xd9\xc4\x54\x58\x83\xdd\xce\xf1\x4c\xbe\x1d\x87\x9e\x99\xc4\xd2\xa7\x66\x07\x60\x6e\xe4\xb9\x2c\x84\x2c\x32\x3e\xb8\xf5\xf9\x46\x9d\x8f\x1d\xea\xf7\x79\x63\xc2\x45\x4f\xca\xf6\x7d\x02\x41\x6d\x84\x7c\xee\x7b\x5c\xff\xfe\x33\x77\xb9\x2b\x44\x58\x7e\x65\x91\x57\xdb\x32\x48\x55\xb1\xf9\x96\x02\xcc\x28\xd6\x89\x43\x56\x2c\x75\x38\xce\x96\x9a\x3b\x3f\x8e\x94\xaf\x14\x42\x96\xe6\x46\xcb\x0a\xf9\x75\x73\x93\xcb\x42\x1d\xf0\x5c\x35\xee\x12\x02\xa9\x96\xab\x95\x84\x63\x3a\x72\xf2\xae\x14\xf3\x4b\x0a\x3b\x16\xae\x11\xa2\x03\x57\x17\x5a\xd9\x34\x4a\x87\x9f\x90\xa1\x28\x68\x21\x57\x60\x58\x64\x91\x3d\xf6\xc2\x9c\x3d\xc9\x79\x25\x57\xf2\x7c\x21\xd2\xc7\x38\xce\xd9\x93\x9c\x9f\xcb\x95\xcc\x17\x5d\xa3\x60\xdf\xf8\x03\xb1\xeb\x84\x4b\xfb\xd7\x5e\x91\x59\x9a\xd9\x6d\x53\x4a\x4f\x6f\xed\x18\x71\x0f\xca\x22\xab\x52\x34\x77\x49\xe1\x8c\xa2\x4e\x16\x14\x02\x85\x0f\x6e\xd0\x37\xee\x86\x0e\xb7\xe8\xe1\xa1\x27\xd8\xdb\xf6\x19\x72\xbd\x22\x3b\x3a\xcf\x6c\x9f\x13\x53\x0d\x89\xcf\x33\xce\x65\x93\x33\xef\xe4\x22\x4b\xc9\x48\x40\x64\x19\xf1\x33\x42\x71\xbd\xaf\xf6\xbe\x71\x97\x9c\xe9\xf2\x0e\xf7\x8c\xdb\x60\x95\x29\x91\x1a\xcd\xc4\x66\x6d\x1a\xa9\x26\xce\x90\x6a\xef\xd9\xd8\x19\x90\x6d\xd3\x0d\x55\xb7\x6d\x89\xb0\x00\xd2\xab\x3b\xd1\x3a\x16\xb5\x0b\x19\x46\xf2\x29\x2d\x01\x69\x69\x21\x3d\x4e\x4a\x6b\x77\x46\xa4\x3b\x33\x69\xb9\x93\xfb\x8a\x2c\x32\x61\xb2\x63\xee\x09\xa0\xf8\x1e\xef\x7c\xda\x31\xdf\x9e\x3c\x13\xde\xdd\xe3\x10\x9d\x03\xe0\x07\x3e\x70\x80\xc9\x98\x2c\x55\xe1\x53\xf2\xae\x63\x0c\x42\xe9\x2e\xcb\xf9\x60\x3d\xa2\xd5\xc6\xd8\x7a\x13\xb5\x52\xdb\x30\xd9\x70\x73\x11\x27\x11\x49\x58\x4f\x82\x0c\xf6\xa4\x2f\x2d\x8c\x6f\xb1\x6e\x8f\x91\xbd\x53\x76\x58\x3e\xda\x7c\xd8\x1f\x74\x59\xdf\xdd\x00\x77\xbb\xa1\xa6\xee\xa1\xce\xd7\x5f\xad\x56\xcf\x87\xf3\x75\xa6\x14\x49\xc2\xef\x68\xf9\x61\x75\xd6\xd9\x3f\x83\x5a\x81\xa8\xee\xcc\x85\x4d\xde\xcb\xcd\x2f\x66\xfa\x77\x7e\x2c\x5c\xfe\x56\xe8\xf2\x7d\xb5\xce\x48\x0a\x6e\x41\xbd\x8c\xb6\xae\x0a\x32\x44\x8e\xea\xf9\xf0\xde\x5d\x98\x1a\xe7\xb2\x31\x4e\x5e\x40\x6e\xda\x42\xab\x91\x52\x3f\x1f\xbd\xf3\x3e\xdd\xce\xdc\xde\x09\x7b\xb0\x10\xdf\x96\xbd\x48\x71\xdd\x91\xbb\xe3\xe5\x9f\xb9\x21\x84\xef\x79\xcf\x3c\x5c\x7c\xde\xef\x20\x78\x19\x98\x88\x3c\x44\x74\x01\xc7\xd2\x66\x66\xe6\x76\x88\x93\xfb\xa8\x1b\x79\x49\x86\x30\x78\xd3\x8d\x36\xa8\x64\xee\xa2\xe4\x11\x7f\x3e\x66\x6a\x3a\xeb\xb9\xc5\x88\x14\xde\x12\x3b\xb9\x29\xfb\x3d\x74\xc6\xa4\x51\xc2\x56\x98\xa6\x96\x95\xe1\xec\xb2\x5a\x3e\xb1\xfd\xa4\xd8\xbe\x2f\xc7\x26\x13\x06\x75\xca\xcf\x62\xb5\xc4\xf6\x37\x62\xd7\x05\xa6\x74\x2e\xb0\xeb\x46\x6f\x96\x55\xe3\x03\x4e\xed\x0f\xe1\x60\x50\x91\x12\x73\x01\x1e\xc4\x6e\xd8\x86\x7b\x35\xd6\xc8\x01\xd7\x71\xb2\x41\xd0\xb1\xf6\xf1\x1b\x0a\xad\xf7\x99\xe4\x11\xd4\x4d\x2c\x13\x9f\x3d\xde\xd6\x5d\x17\x62\x0b\x1a\xc3\xad\x96\xb5\x18\xf9\xe5\xc3\xfb\x6f\x8c\x59\x3b\xde\xb1\x23\x3c\x76\x3b\xd2\x22\xfe\x6a\xd4\xf6\x98\xe2\x2a\x9c\xbc\x7a\xf5\x45\xfa\xea\xf8\xcb\x9d\xfc\xc3\xa8\xfd\x4e\xb8\x38\x7d\x9a\x5d\x55\x75\xa3\x0e\x0e\xfe\x30\x49\xc2\x1e\x0a\x73\xfb\xa6\xd6\x4b\x5d\x9a\x22\x5f\x35\x30\xd2\xfd\xc3\xc8\x27\x12\x8e\xab\x3f\x0c\x55\x73\x83\x0d\x5c\x53\xb7\x43\xce\x6c\xa3\x92\x39\xe8\x59\xdb\x72\xdb\xa2\xe1\x83\x22\x16\x88\x39\x4a\x7d\x0b\x7e\x2d\x1d\xd1\x04\x4a\x38\x6b\xda\xe1\x81\xe9\x98\x55\x6b\x5d\xf2\x82\xe8\x09\x59\x40\x58\x23\x8b\x19\xe1\x38\x3c\x35\x9a\x4c\x4b\x65\x31\x5b\xe7\x4d\xf3\x50\xd5\x4b\x44\x61\x79\xbc\xad\x2d\x61\xdb\xc5\xfb\x8c\x0b\x6b\xa8\xde\xa2\x82\x79\xb9\xe8\x54\x77\x45\xe0\x8c\x10\x32\x66\x28\xfb\x1d\x2b\xe3\xdd\x27\x42\xf6\xa6\xda\xb6\x7a\xce\x7e\x39\x74\x3b\xa5\x97\x87\x00\x5a\x48\xce\xf9\x68\xb9\x62\xfd\xad\x65\x42\x6a\x51\xef\xcb\x09\xac\x05\x85\x38\xad\x46\x20\xb1\x53\x3b\x8b\x2d\x42\xad\x55\x2a\x57\xf5\xac\x2a\x57\x55\x8e\x30\x59\x55\x49\x74\x13\xfd\x22\xce\x9a\x7e\x39\x7e\x9a\x7e\x13\x67\x0f\x8a\x47\x5f\xdd\xe6\xe5\x8d\x4d\xb3\x2d\x9d\x00\x01\x54\x5e\x56\x7b\xd9\x42\xea\x88\x30\x2a\x1d\x09\x18\x86\x56\x36\x4d\x66\xf8\xb1\x74\x35\x45\x8a\xb4\x82\xb6\x5c\xfa\x1f\x20\xd1\xf0\xe2\x57\x33\xf7\x45\x08\xe7\x31\x56\xcd\x6a\xad\xc0\x49\xf0\x3a\xf0\x30\x4e\x38\x48\x6f\xc4\x88\xf1\x55\x54\x53\x3f\x9a\x6c\x7b\x59\x94\x79\xfd\x94\x76\xc5\xbb\x74\x8b\x8f\xa3\x12\xf4\xb5\x93\xf5\x6c\x54\x2b\xc1\x41\x64\xed\x64\x58\xd5\x8a\xc3\x26\xb7\xbf\xb6\x7e\x45\x2b\xee\x67\xde\xb9\x29\x87\xb5\xcf\xba\x5d\xc8\xd3\xd1\xb5\x8f\x36\x13\xa4\x73\x1d\x89\x12\x92\xe4\x39\x99\x49\x95\x24\x39\x45\xa7\x94\x95\xaa\xb8\xdb\x39\x2f\xfc\xc6\xa9\xe3\x45\x4f\x0a\x67\x15\xe2\x36\xb4\x7e\xc4\xc7\x10\x57\xed\x84\x27\xbb\x3d\xc5\x02\x75\x53\x71\xb1\xfb\x33\x12\x4a\xc7\x07\x22\x26\x8b\x28\x14\x7d\xb1\x36\xca\x6a\xe8\x03\x7e\xda\xac\xf9\xd6\x09\xcd\xd2\xad\xad\x92\xb2\x81\x0e\x56\x4e\x7a\x6a\xda\x67\xca\xf5\xd5\xdd\x68\xf9\xe3\x61\xf7\xa6\xa7\xcd\x75\xbd\x1d\x5d\x5c\xf2\x2c\x85\xc6\xb7\x45\x45\x61\x8b\x49\x85\xfb\x17\x28\xa9\xee\x28\xf6\xe9\x26\x28\xf3\xc7\x16\x2b\x50\x70\x71\x0b\x41\x5c\xa3\x83\x88\xd8\xfd\xc2\x72\xc9\xfd\x45\x05\xac\x2b\xa2\x0d\xbb\xc5\xec\x90\xf4\x7e\x1f\x9d\x50\x09\xd8\xba\x8c\xdb\xa3\x68\x43\x54\x1f\xee\x1d\xcd\x67\xf0\x75\xad\xce\x38\x7b\x6d\xdb\xfe\x1b\x13\xd6\xc9\xa9\xf7\x31\xb4\x99\x94\xaf\x64\xcd\xb7\x4e\x83\x9f\xfa\x0a\x6f\xec\xb3\x6c\xea\xab\xb4\x04\x62\xdf\x89\x59\x55\x72\x86\x43\x35\x71\xec\x5d\xdf\x10\xb6\xf6\xd6\x9b\xc8\xc7\x02\x70\x95\x3a\x49\x8c\x3f\x62\xc1\xc7\x29\xfb\xf2\xf8\x4b\xba\x00\xed\xb2\x60\x41\xde\x12\xf9\xed\xe8\x35\x22\xe3\x78\x0d\xc2\x70\x04\xae\x11\xe0\x8d\x77\xa6\xa1\x3f\x1a\xf9\x8b\x81\xa1\xde\x4f\x46\x1d\x71\x25\x2e\x32\x9e\xa9\xa4\x7d\x21\xda\x8b\xcc\xda\x83\x46\x70\x0b\x26\x6a\x9d\xb2\x2b\xa7\xea\xb5\xca\xfb\xb5\xd7\xfc\xc6\xbd\xe0\xa6\xd6\xea\x17\x63\x8d\xc7\x89\xa5\xa3\x68\xd4\xd5\x94\xfd\x1a\x49\xf7\x63\xf2\xcb\x19\xd3\xe8\x51\x20\x42\x47\x13\xfc\xb7\x66\xf2\x33\xd2\x43\x45\x22\x77\x3d\xa3\x9a\x49\xc2\x7f\x72\xfa\x05\x8d\xf5\x17\x19\xdb\xd4\x2b\x36\x12\xa3\xc2\x49\xef\x21\x4d\xe1\xfa\xdf\x55\xca\x74\x7d\x3a\xd5\x09\xc3\x5f\xf8\x19\x5f\x73\x44\x8e\xa3\xb1\x81\xa7\x8a\x44\x65\xd8\x2b\xb7\x14\x10\x5e\xf6\x96\x55\xdd\xf1\x41\x89\xc8\x06\x05\x5c\xa4\x83\x12\x99\x67\xc8\xb8\x09\x6f\xf4\x45\xd0\xbc\xfc\x64\x35\x2f\xd3\x5a\xa4\x83\x75\xa2\xf5\x89\x14\x3c\x7e\xbd\xbc\x4e\xc6\xd5\x24\x53\x4c\x98\xed\xc4\xf2\x39\x77\xf8\x68\x77\xfa\x72\x43\x37\xa3\xaa\xb3\xc8\xad\xa7\x6c\xf2\x90\x37\x93\xb2\x32\x13\x40\x11\x56\x4c\x22\xec\xf9\x2e\x92\xba\x41\x72\xa5\x2c\xc3\x2e\x0b\xf5\x06\xe1\x85\xdf\xf4\x82\xbb\x41\x58\x1a\xfc\x55\x77\xb2\x1c\x89\x10\x1b\x10\x4c\x91\x9d\xf1\x37\xc2\x1d\x2c\x38\x0f\xf0\x5a\xa4\xd4\x5c\x21\x11\x99\x9a\x26\xdf\x5b\x3a\x65\x06\x4b\x09\x28\xde\x34\xb7\xf0\x0e\x97\x15\x5c\x1f\x0a\x64\xe5\xe3\x18\x36\x94\x58\x3e\xf6\xe2\x4e\x04\x61\x01\x19\xca\x5b\x4b\xba\x6f\xce\x3f\xbc\xf7\x66\xdf\x8a\xf3\x1f\x61\x63\x57\x40\xf6\x81\x02\xc2\xef\x23\x15\x39\xa0\x0e\x7c\x02\x60\xaf\xd4\x35\x1a\x51\xec\x35\x34\x80\x7f\x7b\x7d\x64\xff\xc4\x0f\x4c\x22\x23\xf8\x8f\x26\xe2\x15\x3c\xf9\x2d\x9d\xf5\x0b\x35\x31\x3c\x39\xcf\xd8\xa2\xe9\x6c\xbe\x48\xf9\xa8\x43\x3a\xb7\x22\x0b\x20\x69\x04\x49\x1b\x9b\x65\x06\x6f\x43\xfe\x97\xe7\x29\x5c\xb9\xe3\x41\x91\x5e\xb0\x81\x47\x2b\xe9\xac\xd5\xdb\x60\xb6\xee\x2c\xbb\x46\x10\x9d\x10\xa9\x51\x6f\xb1\x15\x07\x65\x92\xcc\x17\x92\x17\xea\xa3\xb5\xfd\xd0\x42\x64\x73\x33\xe8\x01\x09\x15\xc5\x22\xe5\x85\x7a\xa4\x78\xbb\xd2\xc8\x8a\x02\xf7\x56\x6e\xd1\x92\xe4\x8c\x57\x1e\x66\x38\xb0\xd1\x9d\xae\x6f\x34\x87\x39\x73\xcc\x8e\x09\x6b\xbd\x49\xb8\xc7\x89\x0b\x80\xdc\xf7\x96\x39\x46\x50\xc0\x74\xa4\xe3\x08\x48\x65\x12\x0c\x6f\x0f\x4f\x5e\x37\x24\x1c\x42\x5c\x1f\x27\x3d\x07\xe3\x0d\x3e\xdd\x3e\x1d\x4b\x3c\x83\x71\xcd\x78\x4f\x78\x94\x9a\x91\x98\xba\xd0\x3d\x15\x8a\x7d\xff\xdd\xd9\x39\x13\xf2\xf8\x75\xde\xcd\x6f\x5f\xf0\x82\x50\xa1\x03\xd9\x0b\xd9\x58\x79\x89\xac\x0b\x90\xdf\xcd\xad\x77\x0a\x65\x3e\x43\x6d\x5e\x67\xb8\x32\x97\xc5\xfd\xdf\x42\x48\x38\x1e\x41\x20\x4c\xcc\x29\x76\x0c\x4e\x21\x42\x98\xf9\xa3\x5b\x26\x49\xbc\x6a\x08\x58\x3e\x64\x90\xcb\x38\x4e\x63\xd5\xb6\x91\x98\x1f\xf4\x2c\xd2\xa1\x2c\xc4\x2e\xf0\xc8\x43\xee\xd6\xc5\x38\x8c\x76\xa7\xb3\xa2\x39\x9b\xdd\xd4\x7a\xcd\x7d\xf4\xc0\xe8\x86\x09\x55\x60\x0d\xaa\x5d\x12\x14\xb7\x8c\xd8\x72\xcb\x05\x93\x0b\xca\x77\xf4\x33\xfd\xcc\xe6\x3b\x3d\x49\x08\xb7\xed\xd3\x17\x32\xa8\xd5\xce\x40\x42\x5d\xc3\x80\x89\x81\x21\x28\xae\x70\x45\xac\xba\xd4\x7f\x21\xd9\xa1\x62\xb5\x5e\xe5\x90\x94\x32\xe8\x65\xae\xdc\x18\x90\x88\xbe\x8b\xe4\x4d\xc2\x52\xb9\xe9\x0a\x90\x50\x8f\x21\x40\x78\x9c\x41\x71\x85\x78\x82\xc5\x23\x85\x7a\xa3\x90\xe0\x88\x40\x50\x4d\x37\xf1\x95\x87\x94\x51\x22\xe3\xb9\xe2\xb5\xba\x0a\x83\xe0\x42\xcc\x4c\xb5\x96\x85\xaa\x67\x68\x9a\x04\xd8\x51\xce\xc0\x4a\xb4\x2d\xd4\x80\x51\xd1\x06\x45\x16\x78\x49\xeb\xe2\x44\x29\x5a\x96\x91\xd1\xe7\x0e\x00\xee\x43\x24\x43\xe8\xb8\x86\xf5\x00\xfe\xda\xa7\x43\x44\x97\x59\x4f\xf3\xae\x0a\x7a\xa7\x3a\xf8\xe1\x9e\x0f\x11\xc5\xe0\xda\x4c\x0b\x81\x78\x36\xc0\x6d\x30\x5a\xcd\xcc\x8c\x1e\x7c\xbf\xd7\x22\xe5\x7b\xd1\x07\xae\xe3\x3e\xa7\x0a\x49\x85\x85\x1c\xa9\xd5\xeb\xd6\xd7\xbb\xa2\xd5\xbe\x26\xfe\xa9\x27\x3d\xac\x06\xb0\x61\x93\x2d\x86\xd3\xe3\x00\xca\x93\x00\xe1\xf2\xb2\xc1\x3f\xc6\xc4\x62\x10\xa8\xb9\x8d\x9f\x05\xd8\xf3\x11\x09\x70\xb0\x4e\x43\x3c\x98\xe0\xe4\xe5\x5a\xaf\xb3\xfa\x39\x61\x4d\xe6\x43\x0b\x8c\xe5\x64\x94\xa5\xaa\xfb\x32\xcb\xd8\x07\x53\x6e\x11\xed\x18\x06\x68\xeb\x29\x9c\xe0\x6f\xf4\xbf\xec\xa0\x24\x56\x2a\x45\x5e\x88\x6b\xe3\xde\xfc\x62\xdf\xec\x44\x4a\x1f\x1d\xdb\x2a\xc7\x3b\x1f\x88\x40\x7a\x28\xeb\x16\x8c\xd6\xcb\x8b\x3b\xe3\x50\x24\x7e\x76\xc8\xf7\xd4\x6b\x0c\xe4\x56\x07\xdc\xf6\x1c\xd4\xf1\x99\x13\xe6\xf9\xb9\x92\xfa\x6b\xeb\x32\x00\x86\xf3\xb5\xb7\x00\x12\xcb\x65\x5f\x7f\x4f\x42\x3e\xf0\x16\xde\xab\xcb\x5d\x37\x5e\xfe\x8a\x93\xac\x94\x2a\xe9\x5e\x6f\x5b\xfb\x7b\x50\x17\xc4\x62\x77\xf8\xc3\xd9\x8d\xc6\xac\xfb\x81\xa4\xa0\x60\x47\x3c\x8a\x91\x8c\x06\x88\xbf\x08\x99\xa3\x1f\x3d\x9d\xd7\xbd\xcc\xec\xe7\xd5\x3a\xe4\x64\x87\x74\x07\x8b\xb7\x57\x09\xd9\xd4\xba\x5a\x5e\x03\x43\x7b\x67\x0f\x65\x41\xff\x87\x35\xb6\x39\xb5\xce\xab\xb5\x6d\x15\x6d\xa6\xee\x60\xda\x1e\x86\x55\xd1\x01\xd5\x45\xc4\xaf\x78\x3d\x63\x00\xf8\x53\xaf\x96\x68\xb3\xec\xd7\xdd\xd2\xff\x85\x55\xed\x7d\xe8\xfa\xd2\x6d\x5b\xeb\x10\x12\x0e\xf7\xd1\xb6\xcb\x2f\x97\xb2\x08\x96\x99\x0c\xf9\xe8\x52\x16\x41\x7f\xec\xfe\x68\xa4\x97\x21\xaa\x5e\x15\x50\xa9\x14\xb7\xa5\x1f\x86\x70\x18\x09\x6f\xf4\x62\x01\x94\xc3\x33\x32\xab\x95\x4e\xff\xe7\x10\x06\xc0\x4b\x44\x67\x34\x32\x93\x08\xde\x9e\x75\x56\xcf\x8b\x45\x0a\x53\xcb\x53\x60\x06\x3f\x0d\x5e\x65\x75\x7c\x58\xd3\x52\x56\x59\x99\xd6\xf1\xd1\x16\x2e\xcc\xdc\x0e\x77\xaf\xdc\xc3\x66\xd1\xb2\x21\xa8\x33\x62\x8a\x61\xf3\xfb\x1a\xb3\xb2\x97\xa1\xb5\x5c\xd8\x54\xb5\xeb\xe2\x51\xaf\xbe\x77\x1b\xf4\x7c\x96\xda\x89\xb1\x69\xd7\x4b\x21\x7d\x12\x7d\x03\x4b\x65\x2d\xa2\x8b\x6a\x5e\x2e\x28\x8d\x6c\x6a\x76\xa2\x1b\xd1\xd6\x4a\xae\x53\x9f\x1c\x46\x12\x80\xa7\x2e\x71\x4c\xb4\x6b\xb9\x6c\x30\x44\x9a\xc6\x36\x64\x9d\x25\xb2\x9c\x4d\x73\x2f\x5d\x49\x1b\x89\x58\x36\xd5\xc6\xe8\x9a\x4d\xf3\xe8\xfb\x1a\x51\xdf\x69\x77\xab\x85\x1a\x91\x07\xab\xe1\xba\x81\x02\x8c\x22\x55\x77\x54\x39\xa4\x02\xf0\xaf\x20\xad\x3f\xe2\x15\xe1\xaf\xc9\x7c\x0a\xbb\xd4\x9d\xd6\xce\x8f\xeb\xb3\x80\xe3\xea\x10\xf0\xa0\xa1\xaa\xbb\xf3\xed\x2c\x44\xa6\xe7\x61\x9e\x8b\x54\x07\xc4\x36\xc4\x70\x73\x76\x45\x77\x09\x55\xeb\xc3\x20\xa2\xdf\xe8\x61\x7d\x19\x72\xc2\x69\x42\x86\x73\xb0\x4d\xd5\x6a\x85\xef\x65\xdd\x7b\xf2\x15\x7c\xea\x65\x5b\xa1\xff\xd4\x75\x2e\xbc\xcb\x0c\x00\x3c\xf3\x07\x1d\xc7\xae\xcb\x2a\x89\xb3\x03\xc7\x45\xd9\xc8\x32\xd3\xae\xbe\xd3\xf8\x45\xe0\xda\x19\x88\xca\x4e\xfb\x2c\x07\xaa\xeb\x58\x09\xde\x53\x8f\xbb\x27\x58\x89\xf5\x80\xdd\x88\xed\x67\xce\x39\x36\x0b\x12\x22\xba\xc0\x87\xbe\xe1\x97\xc5\x40\x3c\x25\xcb\xbd\x0f\x35\x11\x45\x98\x21\x29\xa5\xf6\x3e\x19\x7c\x70\x7d\x1d\xbe\x10\x3b\xe9\x03\x38\xa4\xcf\xea\xae\xa3\xf1\xb9\xfc\x0d\x9b\xf2\x99\xaf\xc2\x37\xb8\x96\x86\x00\x9e\x45\xdd\xb3\x97\x2f\x99\xd3\xfb\xa1\xc0\x48\xb8\x17\xbf\x7c\xc9\x68\x0a\xb7\x50\x45\xf4\x5a\xee\x8f\xe5\xae\xda\x34\x5a\x23\x9c\x37\x2e\x3a\x7a\x5a\xe9\xfc\x5e\x73\xd3\xb6\x7e\x09\x69\x3f\x19\x7c\xd2\x27\xe4\x92\x3e\x71\x7e\xea\x13\xef\xa0\x3e\xa9\x75\x53\xfc\xa1\xa1\x32\xae\x56\xab\xc9\xd5\xaa\xb8\xfa\x6d\xb2\xbc\x5c\xd9\x1f\xd4\xe8\xb2\x7a\x28\xed\xaf\xcd\xda\xfe\x05\x63\x68\x7f\x61\x88\xee\xd7\xc6\x4c\xba\x11\x4d\xba\xe1\xc0\xb7\xa7\xbc\xd1\x13\x3c\x5e\x99\x89\x75\x2e\x9e\xfc\xa6\x9f\xa8\xdd\xdf\xf4\xd3\x1a\x09\xc8\x50\xb0\x59\x4f\x9c\x1b\xc6\x9d\x2e\x37\x2c\x32\x0a\x8a\xa1\xa8\x74\x50\x54\x2e\xd4\xe8\xda\x1c\xbf\x7e\x66\xc5\x4b\x5e\xda\xfd\x46\x6d\xbb\xe8\xde\xc4\xa2\x0c\xbe\x88\xff\x80\xc3\xe0\xfc\xa2\xb9\xd8\xbc\x7b\xfb\xee\xdd\xc5\xe3\x57\xc7\x8b\x69\x3b\x78\x7e\x41\x81\xc6\xd6\x75\xf5\xf8\x34\x86\xd2\xa4\x13\xbb\xee\x09\xda\xc0\x90\x22\x21\x98\x59\x48\xeb\xc3\x52\x82\x1f\x88\xb2\x58\xaa\xc6\x12\xe7\x61\x02\xf2\x95\x00\x3b\x1f\x3a\x09\x93\xd4\x9e\x1b\x74\xce\xf3\xb5\x77\xd5\x18\xb6\x00\x6d\x86\x20\x71\xa3\xd2\xf1\x1f\x08\xa5\xf0\x77\x3a\x95\x05\x38\xb9\xdb\x6a\xb5\xfc\x01\xca\xa1\x68\x46\x62\xab\x29\x38\x71\xbe\x7c\xfa\x39\x2f\xcc\x74\x9a\xba\x27\x4a\x13\x22\xcf\xbc\x03\xa9\xea\xb9\x93\x7a\x91\x0b\x6c\x53\x54\xe4\x92\x74\x16\xbc\x5c\xd5\x57\xf4\xed\x3b\xd7\x91\xba\xa3\x47\x1b\xbc\x44\x3d\xca\xb3\xd9\x55\x7e\xa7\x57\xb0\x86\x53\xbf\x20\x10\x3f\x84\xdf\x0f\xf4\xfd\x43\x08\xad\x4f\x9f\x7c\xdc\xdc\xe9\xba\xb8\x52\x63\x71\xb9\xb0\xe6\x5c\xfb\xcb\x20\xe2\x74\xe0\x2f\xd4\x6d\x8d\x02\x3b\x76\x50\x34\x1f\xf3\x8f\x5c\x1f\x46\xfc\x1a\xec\x47\xd0\x79\x5d\xdc\xf5\xda\x77\x1b\x10\x45\x10\xe3\xba\xe7\x2c\xf1\x0f\x32\x83\xdf\x49\xe6\xbf\xea\x00\x60\xa9\xaf\x8b\x52\x27\x89\xfd\x3b\xcb\xef\x96\xfe\x37\x67\xd6\xd1\x88\xc9\xd8\x81\x32\xf4\x76\xe6\xa0\xf3\x5f\x88\x64\xf0\xe9\x9f\xa8\x29\xff\x89\xdf\x2f\xfc\x65\x87\xd5\x79\x53\x95\xd7\xab\xe2\xaa\x1f\x4e\xc9\xbd\x7f\x33\x7b\x01\xea\x2f\x49\x38\x7e\xfd\xd3\x50\xaa\x14\xdf\x56\x78\xe3\x1e\xff\x85\xb8\x21\x3b\x39\x1a\x0b\x3d\xae\x87\xa6\xce\x84\x3c\xdb\x89\xd3\xff\xf6\xbf\x07\x00\x85\x8b\x1d\x3d\x84\x5d\x01\x00")
 
 func libJquery351MinJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -224,7 +224,7 @@ func libJquery351MinJs() (*asset, error) {
 	return a, nil
 }
 
-var _libPopper1129MinJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x7c\x6b\x97\xdb\xb6\xb5\xe8\xf7\xfe\x0a\xea\x9c\x96\x00\x2c\x88\x23\xa5\x37\xf7\xdc\x4b\x19\xd1\x72\x9c\x71\xe3\xd4\xf6\xb8\xf6\xb8\x4e\x4a\x73\xc5\x1c\x11\x1a\x21\xa6\x08\x16\x80\xe6\x51\x49\xff\xfd\x2c\xbc\x48\x50\xe2\x8c\xd3\x7e\xf0\x88\xc4\x63\x63\xef\x8d\xfd\x06\xe8\xb3\x27\x7f\x88\x9e\xf3\xe6\x5e\xb0\xeb\xb5\x8a\xe0\x73\x14\xbd\xa0\x25\x15\x6c\xc9\xa3\x7f\xb0\x1b\x5e\xf1\xe8\x9b\xe9\xec\x7f\xfe\x10\xfd\xc0\xa4\x12\xec\x6a\xab\x68\x19\x6d\xeb\x92\x8a\x48\xad\x69\xf4\xfa\xe5\x65\xf4\x8a\x2d\x69\x2d\x69\x04\x2b\xf7\xa0\xa8\xd8\xc8\xa8\x10\x34\x2a\x54\xb4\x56\xaa\x49\xcf\xce\x78\x43\x6b\xc9\xb7\x62\x49\x13\x2e\xae\xcf\xdc\x50\x79\xf6\xfa\xe5\x25\x4a\xfe\x10\x3d\x39\x83\xab\x6d\xbd\x54\x8c\xd7\x90\x62\x85\x76\x80\x5f\xfd\x46\x97\x0a\x10\xa2\xee\x1b\xca\x57\x11\xbd\x6b\xb8\x50\x32\x8e\x81\x5e\x7d\xc5\x6a\x5a\x82\x91\xef\xdc\xf0\x72\x5b\xd1\x85\xfd\x49\xdc\x50\xa2\x20\x4a\x81\x07\xdb\x41\xb2\xb3\xe3\xd8\xfe\x26\xc5\xa6\x5c\xd8\x47\xa8\x50\x4a\x93\xb7\xbc\x69\xa8\xd0\x93\x0f\x08\xaa\x35\x93\xb8\xc5\x0c\xed\xc0\x56\xd2\x48\x33\x62\xa9\xc0\xdc\xb7\x47\x14\x52\xb4\x13\x54\x6d\x45\x1d\xd1\x38\x06\x99\x45\x3e\x7a\xe1\x06\xe4\x80\x10\xb2\x3b\x24\x8a\xbf\x57\x82\xd5\xd7\xc9\xb2\xa8\x2a\x48\xd1\xa1\x85\xa0\x2c\xd5\x6c\x05\x67\x23\x42\x68\x52\xf3\x92\x5e\xde\x37\x14\x59\xa8\x59\x3e\xbf\x29\x44\xc4\xc9\x35\x55\xcf\xf9\xa6\xd1\xbb\xf0\x5e\xdd\x57\x14\x52\x5c\x6f\xab\x0a\xcd\xdd\xea\x6a\xc1\x33\x95\xa7\xbc\x83\xcc\x3b\xdc\xc0\x8f\x97\xaf\x5f\x69\x54\x2c\xfc\x37\xc5\x86\x2e\x68\x4a\x93\xa6\x10\xb4\x56\x6f\x78\x49\xf7\x7b\x9a\xac\xb9\x54\xdd\xf4\x5a\x4f\x67\x2b\x38\xf2\xa8\x44\x25\x5f\x6e\x37\xb4\x56\xc9\x15\x2f\xef\xe7\xf2\x96\xa9\xe5\x1a\x76\x10\xd1\x6e\x59\x48\x6a\x97\x4a\xcd\xe3\xf7\x17\x3f\xfc\x02\x52\xcf\x9e\x84\xdf\xd6\x54\xfc\xd0\x03\x62\x86\xfd\xb7\x07\x1c\x8c\x35\xbd\x07\x4d\x3a\x23\x0a\x52\x84\x05\x61\x09\xbf\xa1\x62\x55\xf1\x5b\xdc\x04\x2f\x3f\x63\x19\xbc\xfd\xe2\xf9\x71\x06\x8b\xad\xe2\x7b\xb9\x14\xbc\xaa\xd0\x59\xa2\xa8\x54\x50\x8c\xe5\xb8\x41\x0b\x9a\xd6\x50\x73\x27\xd8\x06\xa1\xa9\xb5\x9c\xa6\x71\x4c\x13\xbe\x5a\x49\xaa\xde\x1a\xfe\x60\x46\x78\x1c\xf3\x96\x50\xbf\x04\x8b\x63\x4b\xe2\x88\x10\xfd\x6c\x28\xd7\xcf\x8b\x89\xde\xcb\x0c\x5c\xfe\x00\x30\xb8\x7c\xf6\xfd\xab\x73\x90\x27\xac\x2e\xe9\xdd\xc5\x0a\x76\x70\x50\x1c\x03\xa9\x0a\xc5\x96\x7a\x6b\x14\xe4\x18\x34\x5c\x32\x23\xb3\x68\x21\x20\x47\x29\x4f\xe9\xe2\x98\x71\x9e\x5b\xe7\x15\xd5\x3f\x69\xf9\x40\x47\x47\x5c\xe3\x89\x53\x81\x00\x38\x2a\x5a\x0a\x54\x1c\xc3\x56\x4e\xd4\x7e\x2f\x20\x4d\x56\x4c\x48\x0f\xee\xf9\x9a\x55\x25\xd2\x32\x14\xb0\x4d\x06\xf2\xaf\xc5\xd1\x88\x58\x27\x55\x0b\x9a\x4a\x18\x36\x04\x53\xcb\x56\xf0\x47\x74\xbf\x1f\x75\x92\xbf\xdf\x8f\x94\xfe\x77\xac\x0a\xd1\x43\x84\x3a\x0d\xa1\xc9\x92\x6f\xf4\x52\x9e\x53\x6f\x1d\x33\xa1\x42\xb1\x5e\x3c\xf9\xe1\xe2\xf9\x87\xd7\xe7\x6f\x2e\x7f\x7d\x7b\xf1\xfe\xe5\xe5\xcb\x8b\x37\xbf\xbe\xb8\x78\xf5\xea\xe2\xe3\xcb\x37\x7f\xd1\x7b\xbc\xa0\xa9\xc2\x35\xe1\x0b\x95\x52\x5c\x90\x76\xb9\xa5\xa0\x85\xa2\xef\x8a\xfa\x9a\x42\x34\x2f\x12\x49\xd5\x7b\x55\x08\x05\x19\x9e\x22\x6c\xde\xcf\xeb\x12\xd6\x78\x8a\x0c\x2a\x15\x29\x34\x2a\x1b\x5e\x3f\xab\x97\x54\x2a\x2e\x9e\xf3\x5a\x15\xac\xa6\x62\xce\x56\x90\x8e\x08\xa9\xe2\x58\xe9\x9f\xfd\x9e\x25\x4b\xdb\x29\x61\x8d\x3c\xa5\x0d\xac\xd0\xa2\x4a\x05\xac\x2c\xc4\x15\xd1\x8c\xf6\x72\xb7\x32\x9a\xba\x28\xa1\x7d\xc0\x0a\xa5\x9a\x99\x12\x2a\x64\x1a\x02\x26\x17\xdd\xce\xcf\x9e\x16\xe2\xda\x10\x24\x93\x8a\xd6\xd7\x6a\x1d\xc7\x37\x9c\x95\xd1\x74\x44\x48\xdb\x95\xcd\xf2\x45\xf8\x92\x02\xc5\x1b\x80\x39\x31\xbf\x5a\x32\x16\xc0\xea\xd4\x25\x6f\x40\xea\x9e\x5f\xd1\x95\x02\x98\x85\xd2\xc5\x56\xd0\x8a\x16\x21\x84\xed\xf7\xad\x64\x31\x8b\x4f\x4d\xbe\x22\xd6\x58\x9c\x8c\xb0\x6b\xb1\xfa\xda\x0d\xd9\xef\x6b\xcf\x12\x91\xf1\xfc\xe0\x8d\x87\x7e\x6e\x39\x50\x59\x31\xb3\x22\xf2\xcd\xef\xe3\xc1\x37\x79\x1c\x87\x6f\x98\x91\x02\x2a\x6c\x58\x80\x70\x6d\x5f\x2a\x4d\xb3\xb6\x4b\x7c\x31\x99\xa5\xb3\x79\x6b\xba\x14\x6f\xc6\x84\x3d\x11\x58\x5b\x31\xa5\xf8\xc6\xbf\xe9\x19\x63\x52\x9b\x67\xe3\x74\xdd\x4b\x87\xec\x2a\x44\x16\xdc\x39\x7e\x1b\xee\xa6\x40\x33\x1c\x33\x62\x5f\x09\xe1\x0b\xf0\x4e\x03\x01\x29\xf8\xde\x2c\x03\x3c\x0a\x4d\x21\x24\x7d\x51\xf1\x42\x41\x9a\x81\x2b\x2e\x4a\x2a\xc0\x98\x8f\xc1\x47\x56\xaa\x35\xc8\xf1\x6c\x8a\xc6\xc3\x83\x58\x6f\x50\x87\xd8\x46\x23\x86\x39\x66\xad\xb6\xff\x04\x55\x06\xac\x95\x04\x63\x9a\x63\x95\x39\x59\x30\x6f\x3c\x03\xcb\x8a\x69\x93\xee\xde\x82\x91\xbc\x37\x92\x51\x88\x16\xbd\x01\x63\x96\x81\x4d\x21\xae\x59\x0d\xc6\x10\xfc\x48\x0d\x91\xda\xb0\x2c\x80\x95\x39\xc3\x00\xf4\xd8\x38\xc7\x90\xd4\x71\x08\xe5\x69\x48\xcc\x1a\x5a\x16\x53\xd2\xf3\x68\x58\x91\x87\x2c\x0c\xe6\x44\xe3\x19\xc7\x27\x5e\x58\x79\xad\xdc\xad\x0d\x02\xe9\xa6\x45\x05\x1b\x96\x21\x7c\xab\x19\xaa\xdb\x2d\x67\x5d\xf3\xa1\xc3\x67\x19\x18\x51\x49\xe1\xee\x80\x29\xde\x19\x01\x49\x9d\xd0\xd0\xc4\x00\xc1\x56\x9e\x52\x2b\x63\x34\xb1\x4b\x1e\x02\xd2\xae\x3b\x37\xb6\x3b\x68\x1d\xd4\x68\x23\x25\xee\x77\xda\x3e\x5e\x53\xf5\x3d\xdf\xd6\x25\xab\xaf\x9f\x9b\xdd\x79\x47\x97\x0a\x5a\x13\xa3\x45\x9c\x86\x22\x4e\xbd\x88\xcf\xb9\x13\x69\xcc\xbd\x08\x63\xde\x89\x36\xe6\xad\x30\x1f\x96\x85\x09\x09\xd0\xee\x40\x2b\x49\xa3\xaf\xad\x29\xc8\x4e\x03\x4c\x2d\x5c\xac\x78\x93\x9a\xb5\x1c\xcb\x1c\xe0\x89\xeb\x76\x0c\xf6\x4b\x4f\xcc\xd0\x03\x6e\xc8\x50\x74\xb3\x86\x28\xdd\x1d\xb0\x24\x8d\x65\x9d\x8e\x6f\xac\x44\x7e\xb4\xaf\xc2\x01\x17\x16\x78\x49\x1a\xc7\xce\x6e\xe4\x8f\xee\x5d\xf8\x15\x85\x41\xae\x22\x3e\x3c\x30\xa0\x26\x12\x6f\xda\x16\x3b\x65\x52\x6a\xd6\x57\xfb\xfd\xc6\x6e\xc6\xb5\x09\x61\xe6\xd5\x84\xac\xe0\x35\x06\x77\x00\xe1\x8d\x7b\xbe\xd7\x26\xc4\xa2\x38\x21\x15\x16\x0e\x89\x09\xd9\x78\x73\xb6\x84\x22\xd8\xe0\x2d\xa4\x98\x5b\xa8\xcc\xc8\x24\x16\x1d\xfd\x5d\x68\x81\x1b\xa2\x45\x01\x4b\x72\x0d\x39\xc2\x25\xd1\xd1\x1c\x2e\x74\x90\x81\xf0\x8a\x04\xca\x5f\x24\x56\xf7\x2f\x79\x63\xc8\xd1\x7a\x8f\x37\x43\x23\xb4\xda\x75\x43\xd6\x64\x09\x77\x7a\xc7\x1a\xcd\x94\x89\x34\x7f\x57\xd8\xec\x67\x63\x78\x3a\x91\xf6\x67\xe3\xb6\xd3\xed\x84\xdf\xc7\xa6\x15\x5f\xcd\xac\x75\x62\x55\xf9\x92\x37\x64\x8a\xfd\x9b\x5e\x92\x4c\xf1\x88\xc5\xb1\xb0\x44\x6f\xfb\x98\xb5\x93\x0c\x4e\x57\x43\x9d\x1a\x86\xee\x9d\xaf\x0d\x8a\x64\x35\xd9\xe2\xb5\xdf\x51\xf7\x66\xf0\x24\x9b\xc9\x15\x5e\x3b\xb1\x70\x2f\x1d\x52\xdb\x3e\x52\x57\x6e\x7b\x20\x5b\xf0\xce\x83\x97\x28\xe5\x84\x90\x32\x08\x0c\xcb\x30\xdc\x83\x6b\x52\xc1\x35\xe6\x08\xe1\x75\xb7\xa7\x57\x61\x78\xf6\xb8\x53\xe4\x64\x6b\x5c\x04\x66\xe4\x27\xa8\x42\x89\xc6\xb7\xac\x2e\xf9\x6d\xc2\xea\x9a\x0a\x27\xe3\x53\xad\xc9\xdd\x38\x2b\x9c\xbd\x81\x5e\xc4\xa7\x5a\x8e\x0a\xa8\x10\x6e\x7a\xde\x4d\x12\xb3\xc7\xc2\x2a\xdb\x98\x07\x0c\xb7\x3b\xed\x94\xb3\xed\x31\xdc\xb6\xfb\xcd\xfc\x4e\xd7\x87\x79\x2b\xcb\x32\x90\xe5\x5b\x4f\x37\x7b\x28\x2c\x3d\x8e\x1d\x16\xa3\x59\x0a\x56\xec\x8e\x96\x36\x62\xa6\x61\xc4\xbc\xdf\xdf\x1e\x47\xf5\xf7\xc6\x6d\x31\xec\x84\xa7\xb1\xd4\x4c\x2d\xee\x53\x6d\x1e\x6c\x14\x6a\xa2\x95\x1b\x46\x6f\x75\xfa\xa8\x41\x0b\xd4\x90\x2b\x28\xd1\x5c\xdb\x31\x33\xb7\x98\x3b\xaf\x65\x73\x02\x33\x68\x01\x0b\xa2\x33\x09\x85\x10\x6e\x11\x2e\x5a\x52\xe2\x18\x16\x5f\xdb\x51\x84\x52\x60\x37\xc4\x42\xfc\xea\x84\xb4\x20\xc2\x45\x99\x5b\x58\x60\x69\x71\xf7\x1c\x0a\x17\x1f\xdd\x42\x89\x2c\xe1\x2b\xb2\x86\x5a\xab\x57\x4e\xe7\xf0\x92\xac\xac\x36\xce\x1b\x6b\xdc\x2b\xa3\x1c\x55\xb0\xbf\x8d\xd3\x11\xb2\x19\x9b\x4e\xdc\x38\xeb\x5f\x59\x75\xa9\xc2\x1d\x6f\xac\xda\x90\xe5\xd8\xf6\x5a\xfb\xdf\x90\xca\x5b\x31\x3f\x99\xe1\xc6\x7b\x93\xc6\xab\x1a\x6b\xd7\x32\xcf\xdd\xf6\x9d\x87\x8a\x61\x8d\x87\x76\x29\x96\x86\x36\xf1\x7d\x12\xe4\xbc\x37\x3e\x4e\xc1\xb5\x9d\x29\xc8\xb7\xbf\x2f\xe6\xfb\x36\x8c\x7b\xbf\xcd\xd3\xa9\xe6\xeb\x64\x66\xbc\x8a\x4f\xdd\x80\x4e\x29\x41\x1b\xa5\xd3\xb9\x15\xaa\x7b\xa8\x17\x14\xb8\x6e\xf5\x65\x37\x68\xf1\x94\xe1\x71\x63\xdd\x96\xf5\xef\xed\x40\xcb\x0a\x65\x7f\x4f\x4c\xa4\x77\xfc\xc3\x70\x5b\xee\x29\xf7\x70\xb0\x02\xee\x46\x2b\xbb\x5f\x4d\xcf\x8b\xb6\xa0\x0f\xb8\x24\x17\xa6\x68\x91\x7c\xa1\xf7\x12\x4a\x94\x6c\x8a\x26\xa8\xc9\xf4\x42\x93\x2f\xf4\x3e\xa5\x07\x2c\x33\x9a\xe3\x5d\x21\x68\x91\x9e\x43\xfd\x82\x0e\xe8\x80\x12\xc9\x85\x3a\xaa\xe6\xf8\x4d\x4a\xf4\xe0\x09\x35\x3f\x07\xed\x89\xca\x64\xc5\x2a\x45\x45\x6f\xa5\xfe\x5e\xb3\xd3\xbd\xfe\x8e\xf0\xd0\xe8\xc5\x31\xeb\x5a\x7e\x74\xee\x04\x57\x64\xfa\xb4\x70\x7b\xbd\x28\xb2\x69\xae\x29\x4b\x4b\xf7\x80\x57\x84\x26\xb2\xa9\x98\x82\x60\x02\x50\x36\xcb\x3d\xf4\x6a\x0c\x57\x0b\x30\x01\xe3\x55\x0a\x40\x60\x45\x2e\xac\x50\x79\x5b\x55\x42\xfd\xe2\x27\x6d\xf5\xe6\x07\x83\x5f\x75\x74\x9c\x96\x6c\x10\xe6\xa1\x8b\x52\x9d\xba\xf5\x02\x72\xdf\x6e\xc3\x58\x6d\xec\x07\x3a\xb5\xde\x0d\xce\x32\x31\xaf\xb6\xfc\x6e\xff\x7b\xd1\xca\xb8\xb5\xca\xfd\x90\x65\xcc\x5b\x23\x5d\x77\xc4\xdc\x75\xc4\xd8\x48\x0d\x08\x1b\xda\x5a\xe9\xb5\x5e\xc2\x0b\xa7\xcd\x14\xb5\xf8\x03\xdb\x02\x0e\x5d\x52\x24\x68\x53\x15\x4b\x0a\xcf\xf4\x94\xbd\x99\xbe\xb7\xa3\xf6\x8a\x37\x67\xd7\x78\x40\xe0\x54\x46\xf3\x30\xb8\x7d\xef\xf7\x81\x13\x1e\xee\xe0\x34\x77\x11\xac\xe6\x7d\x47\x37\xeb\x6b\x09\x6b\x95\x49\x10\x57\x9f\x71\xc4\x58\x32\x82\x0a\x8d\xf6\x82\x62\x61\xe8\xf1\x34\x4a\xdd\x50\xd9\x44\xcc\xd0\x59\xea\x06\x0b\x11\x68\x13\x6e\x42\xfb\x42\x37\xda\xe7\xd4\x77\xb6\x6c\xcd\x9a\x9c\xa8\xac\xc9\xc7\x2a\x2b\xf3\xb3\x6f\x26\xcc\xfc\xe0\x3a\x93\x39\xd1\x51\x83\x5c\xa8\x4c\xe6\x13\x96\x15\x79\xaa\xb2\x3b\x28\x51\x8e\x83\xbd\xb8\xec\x29\xd4\x33\x21\x8a\xfb\xa4\x11\x5c\x71\x75\xdf\xd0\x64\xc5\xea\x72\x61\x7f\x6c\x31\xd3\x69\x97\xd2\xec\xe9\x80\xfc\xe0\x59\xc8\x56\x70\x08\xc4\x4b\xcd\x82\xd6\xbe\x75\x4d\x43\xf6\x80\x66\x2a\xd7\x41\xe7\xc1\x27\x10\x1a\xc3\xc7\xc7\xb5\x80\x3d\xab\x43\xcd\x79\x0e\x5d\x86\x69\xeb\x03\xd6\x46\x1b\xaf\xaf\x4d\xa7\xac\xd8\x92\xc2\x29\xfe\x41\x47\x27\x75\xb1\xa1\x00\x33\xd4\x42\xac\x93\x15\x17\xe7\xc5\x72\xdd\x21\xaa\xd0\x4e\x65\x5d\x11\x38\x8f\xe3\x25\xaf\x25\xaf\x68\x72\x5b\x88\x1a\x82\xcf\x1b\x5e\xb2\x15\xa3\x22\xf1\x63\x3e\x47\x4c\x46\x25\x6d\x04\x5d\x16\x8a\x96\x38\xda\x4a\x1a\x05\xc3\xea\xcf\x23\xe0\x69\xed\x81\xde\xef\x55\xb2\xaa\xe7\x2a\xa1\x75\x71\x55\xd1\x32\x8e\x29\x64\x3a\xdc\xe3\x4e\xc7\x64\xd2\xd8\xda\xf2\xf2\xa4\x09\xe1\xae\x45\xd0\x15\x15\xb4\x5e\xd2\xde\xb8\xb6\x55\x5b\x0f\x06\x39\x56\x08\x1d\x10\x0e\xbc\xde\x1b\x68\xeb\x68\x6a\xcd\x64\x22\x55\xa1\x68\xc2\xe4\x0f\x54\x2a\xc1\xef\x69\xe9\x93\xdd\x1d\xab\xa5\x2a\xea\x25\x4d\x4d\x6d\x5b\x6a\x93\x24\x75\x92\x54\x08\xc1\x6f\xdf\x77\xaf\xca\x95\xfb\xcd\xdb\xaa\x62\x4d\x43\xcb\x74\x34\xc3\x0e\xa1\x74\x77\x38\xcc\xe9\x00\xd2\x17\xb0\x43\x00\x9b\x47\x4b\xa2\x7d\x0e\xc8\xa0\x89\x31\x06\xda\xd7\x92\x1b\x3b\x89\x37\x9a\x12\xd9\x75\xe0\x81\x15\x1e\x01\x8a\x7b\x50\xfc\x9e\xc9\x44\xa3\x9f\x5c\xe9\x04\xb4\x10\x8c\x4a\x1f\x47\x3f\x36\xba\x29\x4a\x9d\xad\x6a\x34\xb9\x60\xd7\xac\x2e\xaa\xb7\x2d\xba\x74\x10\x43\xb7\xbb\xef\x61\x88\xe0\x10\x01\xc1\x74\x74\x32\x3f\xf1\xb1\x2c\x01\xc5\x95\xe4\xd5\x56\x51\x80\x29\x79\x6e\xa1\xb6\x58\x62\x8a\x70\x6f\xa7\x9f\x9b\xfa\x64\xb9\xe8\x11\xc5\xeb\x0f\x4d\x59\x28\xed\x74\x52\x38\x34\x9c\x8c\xa6\xf8\x68\x86\xed\x31\x21\x74\x27\x5c\x5f\x7a\x76\x87\x26\x92\x6f\xe8\x89\xd3\xd6\x61\x99\x56\x4a\xe3\xb3\x9d\x16\x04\x65\x72\x6d\xde\x7a\x75\x8a\x8f\x7a\xe2\x8a\x0b\x68\x9d\x4b\x36\x9a\x61\xb0\x91\x00\x83\x8f\xf4\xea\x0b\xd3\x36\xf9\x35\xff\x17\xc0\xe0\x02\xe4\x26\xe4\x5b\xae\x0b\xf1\x4c\xc1\x29\x4a\x14\xff\xa0\x79\xf5\xbc\x90\x14\xa2\x31\x75\x76\x61\xa6\xed\xfe\x74\x5e\x3f\x55\xce\xef\x4f\x66\xf3\x7a\x3c\xf6\x2e\x5b\x65\x75\x8e\x05\x61\x0b\x00\xc6\x6c\xcc\x53\x5b\x9e\x1c\x38\x4e\xea\x15\x84\x12\xa3\x22\x99\xc8\xbd\x41\x14\x87\xa0\xd4\xdd\x11\xf3\x16\x76\x2e\x6b\x50\x03\x35\xab\xbf\x1c\xef\x22\x28\x9a\xa6\xba\x37\x6a\x07\xb4\xad\x08\x44\x27\x11\x74\xc3\x6f\xe8\x33\xaf\x88\x10\xdc\x4d\x5a\xb9\x01\x28\x54\x03\x8b\xa3\x09\xed\x08\x00\x03\x3d\x9d\x4c\x0d\xf5\x2a\xde\x0c\x76\x64\x1f\x21\x50\xa2\xa8\xe5\x8a\x8b\x0d\x40\x39\x01\x7e\xd9\x92\x49\xbd\xbb\xe7\x37\xb4\x56\xaf\x98\x54\xb4\xa6\x42\x42\xd4\x17\x25\x8b\xff\x45\xed\x38\x10\xc7\xe1\x02\xdd\x69\x80\x1b\x67\x4e\x16\x42\xfa\x2d\xb4\x8e\xc1\xdf\x3f\x98\x20\x77\xa7\x5f\x2a\x29\xe9\xaa\xd8\x56\xea\xef\x8c\xde\xa6\x36\xa5\xea\x20\xfc\xd8\xd5\x2f\x6d\x56\xd0\xa6\x6b\x34\xac\x99\x88\xd3\xc3\x96\x00\x28\x9d\x37\x49\x51\x96\x3d\xd2\x8d\xcf\xda\x35\x85\x94\xec\x86\xa6\xa3\xe9\x01\x61\xb1\xdf\xff\x08\x6b\xd8\x84\xc7\x1e\x6e\x71\xcc\x92\x66\x2b\xd7\xb0\x09\x54\xe1\x59\x87\x1a\x4f\xb6\x46\x67\x4d\xc1\x8c\x30\xac\xe9\x3e\x5d\x11\x08\x2a\xd9\xbf\x28\xc0\xbd\xe1\x7d\x24\x5c\x79\xad\x0e\x4e\x0d\x7e\x84\x02\xfb\xc2\xeb\xd1\x54\x9e\x84\xa9\xad\x44\x6d\x83\x33\x97\x44\x60\x9e\x50\x8d\x84\x3c\xb7\xba\xad\x25\x3a\x70\x40\x2f\x21\xda\x05\xa2\xdf\x1b\xba\xdf\x07\xe6\x87\x3c\x83\x8f\xd8\x6d\x7c\xec\x3f\xe4\x72\x4d\xcb\x6d\x45\xad\x29\x0b\x33\xfb\xd7\x3d\xab\x64\x18\x65\x85\xe9\x01\x5e\xa9\x90\x60\x84\x55\x9f\xe2\xd3\xe8\x81\xa2\x1d\x1d\x86\xe8\x59\xd8\x87\x78\x40\xfd\x06\xa2\x4d\xc4\xf1\x32\x24\xcb\xdb\x26\xcf\x5a\x37\xee\x88\xbb\x33\x1c\x1c\xdf\xbd\x7b\x84\xbb\x71\x0c\x97\xda\xa7\x57\xcf\x6a\xb6\x29\xf4\xf0\x17\xa2\xd8\x50\x38\xc4\xbe\x80\xbd\xe4\xf5\xe0\x3e\x98\xbe\x90\xcd\x1f\x82\x33\x64\x30\x22\x84\xc6\xf1\x88\xc9\x37\xc5\x1b\x18\x9e\x1f\x20\x14\xc7\x4c\xbe\x60\x35\x33\xde\xa3\x9b\xfe\x8b\xdd\xa5\x30\xbf\x54\xe8\x94\xd9\x6d\x6a\x05\xc0\xdc\x85\xe6\x2e\xa0\xf6\x41\xb4\x2d\x4c\xe3\x36\x62\x77\x09\xc6\x40\xe8\x1e\xc7\x1f\xa0\xca\x78\xae\x4d\x2a\x23\xa0\xb9\x03\xda\xd1\x5a\xa3\xc6\x75\x00\xce\xf3\x31\x0b\x5d\xd1\x6f\xff\x26\x8e\x1a\xc2\x7c\x34\x33\x81\x29\x3d\xb1\xd4\x5c\xc7\xde\x92\xaa\xa0\x05\x1b\x74\xc2\x25\x5f\xf4\x13\xca\xe3\xb8\x39\x74\xa8\x5e\x7b\x9d\x07\xc5\x35\x19\x8d\x58\x1c\x0f\xb8\xe1\xd6\x41\xeb\x69\x3a\xde\xd6\xa3\xba\x80\x34\x31\x35\xdc\xa7\xcc\xfe\xda\xa2\xeb\xa8\x2d\x92\x80\xcf\x60\xac\xc6\xe0\x33\x98\x1f\x05\xc9\xe6\xdc\xe8\x33\x18\x83\xc8\x7b\x2e\x1d\x24\x0b\xfa\xcf\x2d\x13\xb4\x8c\xae\xee\x23\x30\x16\xbd\xde\x3a\x32\x2b\x44\x8a\x47\xb7\x5c\x7c\xc1\xd1\x15\x8d\xe4\x56\x50\xdd\xc0\xea\x65\xb5\x2d\x69\xc4\x54\x74\x45\x57\x5c\x50\x3b\x7b\x04\xd0\xe1\x34\xf9\xfc\x6b\x20\x7b\xb4\x2e\xdd\xd1\x8e\x54\x85\xd0\x19\x97\xfd\xb5\x6d\xba\x37\x0d\x8e\xd1\xfe\xf9\x1f\x9c\x7a\x86\x27\x7e\x33\x1d\x74\x14\x5d\x96\x42\x75\x0a\x5e\xf8\x58\x83\x8f\x67\x28\x59\xf2\x7a\x59\x28\xd8\x36\x4e\x31\x47\xc1\x5d\x0c\x96\x08\x7a\x43\x85\x8e\x51\x52\xd6\x21\xf6\xf7\xbe\x1b\xaa\x09\x4d\x36\xe6\xc8\xe4\x0c\xc2\x45\xfa\x69\xb2\xff\x34\x46\x8b\x4f\xe5\x93\x4f\x89\xfe\x8b\x60\xf2\x04\x9d\x21\x2c\xc8\xb8\xd6\x38\x35\xa4\xce\xbe\xc9\xcd\xc6\x89\xae\x06\xc5\x56\x50\x67\x49\x4d\x57\xa5\xfa\x13\x70\x65\x3f\xe9\x2f\x69\x34\xee\x6e\xc6\x9f\x1a\x90\x4a\xc2\xe7\x57\x82\x16\x5f\xec\xe5\x8b\x3f\xb9\xbb\x1a\x7f\x12\x20\x75\xce\x2e\x95\x84\xd9\xbb\x17\x25\x59\x42\xd9\x92\x55\x66\x2a\x3f\x9b\x4d\xa7\x4f\xc4\xc1\xd4\x4b\xd7\x9a\xfd\xcd\x7e\x0f\x6e\x4c\xf1\xb2\x41\xae\x50\xea\x86\x17\xc4\x0f\x59\xfc\x04\x1f\x3a\x59\xfb\x3d\xa5\xe9\xf4\xab\xd3\x1f\xab\x80\x17\x0e\xe5\x36\x86\x6b\x77\xe3\x5f\xc7\xbb\x91\x4d\xf1\x34\xff\x6a\x79\x80\x21\xdc\xb4\x25\xa4\x33\xf8\x69\xbc\xff\x34\x41\x67\x0f\x96\xcd\x68\xa2\x04\xdb\x40\xed\x21\x64\xb0\x4b\x97\xb0\x19\x48\x96\x27\xf6\x12\x90\xa4\x85\xd0\x52\x81\xf7\x9f\xe4\x19\x3a\x20\x34\x6f\x32\x99\xc7\xb1\xa9\x47\xea\xc7\x6e\xb3\xb1\x0e\x1e\xfb\x4a\x7b\x61\xb3\x8a\x48\xd2\xa6\x10\x3a\xd4\xd7\x6a\x7a\xbb\x66\x8a\x46\xb2\x29\x96\x14\x4a\x64\xee\x68\x1d\xa7\xba\x45\xb4\xe4\x9b\x4d\x11\x41\x8c\x22\x9d\x2b\xd2\xa2\x4c\x5c\xc2\x5b\x92\xb3\x4f\xf2\x09\xfe\x24\x9f\xec\x3f\xc9\xf1\x19\x2e\x88\x41\x45\x2e\xb2\x26\x4f\xb3\xa6\x55\x02\xd9\x6a\x46\x66\xd0\xb4\x4c\x2a\x51\x36\xcd\x73\x84\x8f\xda\x66\x79\xee\x47\x7b\x08\x72\x3c\x43\x28\xef\x24\xa8\x38\xe2\x6a\xb7\x57\xd0\x5a\xe0\x91\x48\x05\x3a\x2d\xc4\x34\x64\x34\x0b\x8b\x4f\xe5\x76\x49\x07\x2b\x93\xc0\x98\x90\x8c\xb6\x69\x83\xe1\xb2\xd9\xfe\x31\xc0\x60\x12\xec\xbb\x42\x0b\xd8\x1b\x49\x94\x5e\x67\x8a\x29\x4a\x9b\xa3\xae\xb1\xed\x9b\xe9\x3e\xea\x89\x54\xe8\x80\xb3\xfc\x41\x41\xd1\xc6\xa1\xb6\x67\xc3\x5a\x58\x8a\x81\xa0\x44\xe3\x4d\x07\x7c\x93\x66\xcb\x07\xe3\xfc\x60\x9d\xa9\x7c\x4c\xf8\x13\x08\x26\x96\x34\x36\x99\xe5\xe6\x86\x02\xb2\x70\x03\x0b\xfb\x97\xe0\xba\x01\x66\x44\xb9\x7c\x14\xd7\xbd\x34\x57\xb4\xa7\x9b\x52\x87\xc8\x3e\xbd\x95\x44\x04\xe1\x43\x49\xea\xa3\x92\x5c\xeb\xb8\x3e\xc0\x31\x43\x8b\x6c\xcc\xf0\x34\x4f\xff\x05\x19\x6e\xb0\xc4\x25\x72\x7a\x45\x08\x29\x17\xd0\x1d\x0a\xf0\x6c\x9a\xbb\x73\x86\x09\xe1\xd9\x2c\x47\xbe\xe2\xf8\xd0\xb0\xb1\x1f\xe6\xae\xa7\x98\x41\xbe\xc3\x8c\x32\x87\x7e\x6e\x90\x0b\x1b\xec\x11\xdd\xc0\x40\x07\x4d\xa7\xe9\x36\xa3\x6f\x30\x3d\xf8\x34\xf5\x57\xf2\xba\x50\xeb\x64\xc3\x6a\xfc\xb3\x7d\x5c\x55\x9c\x0b\xfc\x93\x6b\x2f\xee\xf0\xdf\xc8\x50\x52\x69\xed\xd2\xf0\xfd\x45\x6f\xcf\xf0\x3f\x48\x06\xce\xcb\x6b\x0a\x30\xb8\x14\xac\xd4\x89\x1e\x06\x2f\x98\xa0\x2b\x7e\x07\x72\xfc\x47\x32\xc5\x94\x92\xe9\x9c\xd2\xa7\xff\x70\x72\x36\xa7\x74\x4c\x66\x88\xad\xe0\xdf\xe2\x78\xfa\x94\xd4\xc5\x0d\xbb\x2e\x14\x17\xc9\x56\x52\xf1\xec\x5a\xdb\x47\x2f\xbc\xff\xc8\x28\xcd\x11\xda\xfd\x91\xcc\xac\xf5\xb7\x77\xeb\xb0\xa2\xe4\x6f\x71\xec\x2c\xe7\x5b\xc1\x37\x4c\x52\xcc\x29\x51\x74\x71\x5a\x98\xef\x74\x2a\xb8\x18\xa9\x74\x80\xaf\xd5\xa0\x0f\x24\x11\x54\xf2\xea\x86\x42\x94\xa8\x35\xad\x61\x38\xc3\x28\x86\xb6\x88\xe8\x70\x48\xff\xdd\x65\x24\x55\x97\x6c\x43\xf9\x56\x0d\xc2\xc4\x7f\xd4\x50\x31\xa3\x24\xe8\x75\xe0\x7c\x49\x91\x99\xd8\xd0\xa8\x79\xc7\xb3\xa2\x69\xfe\x4e\x85\x64\xbc\xee\x8c\xeb\xeb\xf7\x2f\xcf\xa3\xd9\x14\x20\x84\xd9\x01\xd7\x01\xcc\xf6\xee\x1a\xa4\x91\xaf\xab\xf1\x55\xa4\x10\x52\x6b\xc1\x6f\xa3\x9a\xde\x46\x97\xf7\x0d\x3d\x17\x82\x0b\x08\x9e\x17\x75\xcd\x55\xb4\x2c\xaa\x4a\x9b\xd9\xaa\x90\x32\x2a\x64\x54\xb4\x14\x02\x74\xc0\xa2\x87\x73\x78\xc7\x54\x2f\xe6\xc5\x90\x1f\x55\x3a\x4c\x9d\x83\xdb\x12\x07\x4f\x68\xbd\xdd\x50\xa1\x83\x3e\x12\xbe\xec\xf7\xa3\x19\x36\x07\xd5\x2b\x76\xbd\xb5\xfd\xa3\x29\x06\x37\x45\xb5\xa5\x40\xc7\x6c\xa6\x5a\x79\x2b\x98\x72\x7d\x08\xbb\x88\xd8\x8a\xec\x5b\xc1\x1b\x2a\xd4\x3d\xa4\x98\x9b\x53\x14\xde\x86\x6c\x5d\xc5\xb5\x77\x41\x48\xc7\x9f\x50\x75\xf5\x65\xcc\x11\x66\xa6\x4d\x27\xc2\xea\x70\x80\x08\x37\x7d\x8e\xea\x90\xd8\x87\x50\x3a\x90\xa4\x8b\x87\x70\x50\x78\x67\x50\x4f\x39\xee\x88\x4c\x47\x53\x1c\x52\xa8\xdf\x3d\x45\x26\x2b\x4e\x4d\x29\x9a\x63\x7a\xc0\x92\xfa\x53\x2f\x9d\x35\x5f\xd7\xfb\x7d\x28\x87\x6d\x65\x0a\x73\x32\x9b\xf3\x93\x18\x72\xce\xc7\x63\xe4\x07\x31\x8d\xaa\x0a\x82\x49\x9e\x63\x85\x1c\xf4\xae\xbc\xbe\x2e\xe4\xc5\x6d\xed\x69\xb0\x17\x81\x35\x2b\xe2\x18\xd2\x8c\xe9\xcc\x84\xe5\x5d\x85\xfc\x80\x4b\x4a\x32\x73\xe0\x38\xb1\xa1\x2e\xb6\xa7\x8f\xf6\x67\xa2\x43\x5e\x93\x14\xb5\xbd\x36\x41\xd2\x2d\xb6\xcf\x9e\x28\xfa\x5e\x1f\xcb\xd8\x56\x3b\xc2\x9d\x1a\x86\x2f\x5d\xab\x9f\x68\x6c\xb1\x1d\x62\x4f\x42\x6c\x8b\xed\xce\x71\x41\x49\xe9\x63\xe0\x3f\x23\x5c\x51\xb2\x7b\xf1\xea\xe5\xdb\x14\xac\x2a\xd6\x00\xfc\xfc\xd5\xc5\xf3\xbf\x7e\x7c\xf9\xfe\x3c\x05\xcb\x8a\x2f\xbf\xdc\x32\x49\x01\x7e\x7e\xf1\xe1\xcd\xe5\xf9\xbb\xb0\x93\x6f\x6b\x45\x45\x37\xe6\x80\x57\xc3\xda\xa0\x60\x10\xb0\x99\x42\xb6\xf8\xfd\xd7\xfa\x16\xe1\x4b\xba\x3b\xcc\x6b\x9b\x29\x63\x85\x86\x0a\x0e\x03\x26\x44\x67\x3e\x54\xaa\xa3\x6c\xbb\x76\xa9\x3f\x3a\x58\x30\xf6\x8d\x70\x97\x87\xdb\xd7\xe4\xca\x1c\xce\xac\x99\x44\xfd\x72\x19\xb1\x97\xbe\x54\xf2\x83\x8d\xbf\x25\x16\xbd\x5c\x7d\x17\x94\x12\xd3\xd1\x0c\xb7\x25\x5c\xfd\xd2\x2b\x30\xa4\x59\x7e\x38\x2a\x8c\xdb\xab\xcb\xbf\xfd\x73\x4b\xc5\xfd\x42\x7b\xba\x94\x87\xe5\x3e\x6d\x0b\x99\xef\x66\xba\x9b\x3d\x50\x1c\x27\xbb\x03\xee\x1d\x14\x1f\x21\x1d\x94\x36\x45\xf7\x8c\x06\x52\x69\x8a\x76\xf5\x29\xfc\x8c\xe6\x27\x8c\xe8\xf5\xee\xf7\xbb\x43\x08\x7a\x21\x7a\xdd\xe9\xce\x84\x37\xfd\x2a\x6b\xef\x68\x7b\x98\xae\x47\xcf\xbb\x75\x2e\x9d\xd2\x03\x1e\xc6\xf7\xf1\x33\x6f\x97\x72\x4f\x94\x4f\xb9\x8f\x70\x1b\x3e\xb2\x0a\xcf\x90\x54\xc2\xeb\x57\xbc\x28\x51\x1c\xfb\x47\x58\x07\x41\x57\xed\x63\xb1\xba\xab\xa0\xe1\xda\x55\x71\xfc\x7a\x56\xf8\xdc\x8d\xbb\x86\xf4\x98\xd0\x2b\x25\xcd\x1b\x57\xa7\xb5\x18\x0c\x57\x78\x07\x4a\x50\xa4\x69\x73\x2b\x6d\xdc\x33\x73\x49\x00\xd8\x65\x01\xb6\x66\xfa\x54\x91\xde\x38\x0b\xa8\xd5\xe1\x70\xc0\x76\x52\x69\xc5\xfc\xe1\x59\x6f\x87\x66\x0d\xe1\xfb\x30\x88\x97\x83\x0b\x0f\x55\xb5\x1f\x86\xf1\xae\x07\x23\xd7\xee\x0c\x76\xd7\xb3\x69\xf2\x41\xb1\x4a\x92\xf0\x84\x81\xf4\x83\xc1\xc5\x75\xc5\xaf\x8a\xca\xd5\xa9\x91\xfb\xf4\xc4\x4c\xc3\xab\x20\xf8\x96\xa4\xa4\xba\xc1\x2b\x04\xd9\xb5\x5d\x6d\x34\x8b\x7b\xbb\xa1\x9d\xde\x51\xf9\xdd\x9c\xdd\xb9\xc3\x9d\x90\x98\x03\xf6\x87\x44\xfd\xd6\x56\x42\xd3\x9d\x5c\xb3\x95\x4a\x77\x46\x80\xd3\xd9\x74\x8a\x69\xb7\xca\xaa\x1e\x88\xdd\xc2\xc4\x81\x13\xd5\xcf\x09\x4c\x8e\xd1\xbf\x7a\xc1\x74\x3e\xdd\xdd\x08\x77\x69\x86\x20\xa1\x98\x37\xa4\xee\x92\x0e\x97\x9c\xb5\xee\x4a\xbb\xbd\xde\xd1\x7d\x49\x64\xff\xa4\xbe\xd0\x0d\x47\x87\xf2\xb8\x22\x3b\xe3\xc2\xd2\xc6\x98\x9c\x12\x8b\xac\xd4\x81\x7f\x5d\xf6\x5a\xc6\x22\x2b\xf2\x49\x93\x15\x39\x0a\xcf\x3c\x9d\xed\xb4\xe6\xaa\xc1\x95\x76\xdd\xed\xf5\xf3\xc3\xc1\x9d\x94\x7a\xbe\x7d\x73\xc2\xb7\xbf\xf8\x11\xd3\x03\x6e\x84\xd9\xbf\x0b\xf7\x01\x8b\x9f\xf4\xe7\x47\x98\xdd\xdd\x11\x57\xa7\x67\x9b\xf6\xcb\x0d\x1f\x93\xfa\x13\x95\x79\xd0\xd4\xf9\x06\x53\x25\x84\x9c\x08\xc8\x91\x3f\xd9\xbe\x3f\x9d\x8d\x87\x26\x63\xe5\x4f\x4a\x31\x47\xf3\x10\x11\xc2\xe6\xce\x3d\x27\x8d\x60\x5c\x30\x75\x6f\x2a\x26\x7d\xee\x61\x2d\xcc\x82\x6d\x0a\x71\x7f\x22\x47\x9c\x34\x19\x6d\x53\x48\xfd\xfc\x94\x65\x34\x8f\xe3\x91\x4a\xa8\x5c\x16\x0d\xfd\xc8\xd4\xfa\x9d\x47\xc5\xd0\xf0\x13\xd4\xe3\xb0\x1e\x87\x74\x64\x69\xef\x51\x73\xa4\x63\xbd\x25\xd7\xa8\x0d\xad\xd3\xa5\x97\xb4\x2f\x35\x35\x69\x32\xde\x43\xe1\xbb\xaf\xa0\x50\x93\x5f\xa1\x9e\x63\x50\x98\xc0\x10\xb2\xbb\xb0\xd5\xde\xba\x42\x2d\x86\x1c\xd7\xe8\x70\x78\xe4\x1a\x43\xab\x59\xa6\xe0\x92\xf9\x20\xac\x2f\xf6\x14\x2d\x40\x4b\x25\x48\x81\xe3\x2b\x98\x37\xad\x8c\xca\x4c\xe5\xe6\x64\xf7\xf4\xdc\xd9\x64\xb9\xd8\x6f\x55\xda\xae\x21\x7a\xa5\x77\xa7\x71\x39\x76\xbb\x9e\x7e\x8b\x4f\x64\x2f\xed\xdf\x91\x3c\xe0\x2f\x94\x36\x97\xfc\x9a\xaa\x35\x15\x5e\xb2\xff\xcf\xef\x32\x23\xde\x14\x68\x19\x77\x02\xa3\xad\x47\xe0\xfc\x42\x5b\x73\x64\x67\x04\xf9\x19\x37\xde\x54\xf4\xd1\x6f\x79\x66\x6e\xeb\x35\x0b\x47\x65\x67\x4d\x4b\xdd\xd8\xb7\x1f\xcd\x83\x97\x7a\x78\x26\xf3\xa7\x02\x32\x6d\x3c\x74\x20\x7f\xc4\xd9\xac\xcc\x89\xeb\x9d\x70\x6d\x44\x30\xcf\xca\xfc\x3b\xdd\x24\x1f\x9d\x20\xb5\x0c\x6b\x4b\x62\xee\x66\x78\xce\x7d\xfb\x98\x4d\xf0\xc7\x09\xa6\x3e\xfc\x22\x54\xe2\xf0\xcc\x59\x83\x03\x18\x84\xfb\x72\x7c\xa1\xb1\xd6\x99\xa3\xfb\xb2\x8a\xad\x20\x90\xe6\xcb\xc5\xce\x83\xd5\x26\xf1\xad\xc9\x89\x99\x48\x4c\x14\xf9\x9e\x56\x74\xa9\xb8\xd0\x0c\x1e\xd5\x1d\x68\x7b\x25\xd4\x7c\xee\x75\x32\x71\xe0\x13\xa8\x7e\x49\xf3\xe3\xb3\x77\x6f\x5e\xbe\xf9\x4b\x1a\x7d\x36\x14\x78\xfc\x3e\x47\x9b\xad\x54\xd1\x15\x8d\x96\x6b\x56\x95\x11\x5f\x45\x4c\xc9\xc8\x42\x8d\xdc\xa0\x11\x40\x98\xba\x03\xd1\x87\x45\x26\x30\x50\xa6\x4e\xeb\x64\xae\x24\x4d\x20\x73\x85\x97\xa9\x9e\x86\x74\x32\x25\x10\xae\x48\x71\x5a\x8e\x5c\xe9\xc6\xe0\xe3\x15\x73\x15\x57\xf1\x57\xfc\xd6\xdf\x64\xc0\x6b\x3d\x24\x14\xbb\x6b\xdd\x70\xe5\x3f\x64\x71\xba\xb8\x25\xaf\x60\x8d\xb2\x2a\x9f\x97\xd9\x75\x3e\xd9\x3e\x95\xd9\x26\x1f\x92\xa3\x4d\x3e\x21\xba\x6f\x02\xed\x40\x84\x70\x99\x6d\xf2\xf1\xf6\x3b\x99\x5d\x3f\x30\x63\x4c\xec\x90\x89\x1e\x32\x60\x26\x96\x27\x93\xac\xcf\xb8\xb2\xf3\xca\xac\xca\xcf\xbe\x99\x6c\xcf\xbe\xc1\xb7\x44\x0d\xf8\x20\x7c\x1f\xde\x63\xbc\xed\xbe\xde\x59\x99\xaf\x8d\xf0\xf9\x51\xb7\xff\x28\x69\xd5\xfb\x28\x09\xdf\x90\xab\xc9\x10\xbd\xf7\x93\x73\xaf\x96\x37\xe4\x27\xf8\x2b\x94\x59\x95\x4f\xb6\xf8\x06\xe1\xa9\xa6\xc6\x48\x4e\x7b\xec\x1a\x90\x67\x3a\x08\x64\x3a\xc3\x69\x28\x64\x78\x83\x4d\x45\x4f\x68\xf3\x06\x6f\xac\xad\x66\x78\x8d\x01\x40\x98\x69\xbd\xc4\xd4\xdb\xbb\xec\x6e\x62\xa6\xe7\xc0\x5e\x95\xf2\xaa\xfa\x7f\xbf\xe2\xbe\xd9\x0a\x7e\x79\x40\x47\xcd\xf9\x45\xa8\x94\x6c\x05\x69\xe2\xee\x61\xc5\x71\x78\x79\x8a\x90\x81\x3b\x4a\x7d\x6d\xe6\xff\x89\x43\x1f\x08\x2b\x90\xb9\xda\xf3\x90\xf6\xd4\xe4\x0e\x32\x84\x1f\x54\xb0\x59\xbe\xdf\x03\x80\x1b\x92\xe5\xfe\x4c\x4a\x25\x57\x74\x5d\xdc\x30\x2e\xec\xe1\x54\x54\xd1\xc4\x94\x0e\x1a\x92\x31\x5c\xe7\xc1\x21\x95\xee\xea\x8a\x05\x0d\xf9\x27\x64\xe8\xb8\xfb\xb8\xa4\x60\x46\xe1\xd1\xd4\x0f\xf4\x67\x5b\x0d\xe9\x16\x9e\x77\xb7\xd3\x4f\xfc\xae\xc4\xa5\xd9\x25\x36\x22\x44\xee\xf7\x8d\x2b\x2c\x10\x42\xca\xf1\x2c\xd8\x9b\xaf\x32\xc5\xec\x42\x71\x1a\xfe\x54\x64\xe8\x82\xd8\x8a\xfc\x8c\x37\xa4\x2d\x8d\xb3\x38\x5e\xc1\xc2\xde\x0a\x47\xdf\xad\xa0\xbd\x5d\x8f\xf6\xfb\x2e\xba\x70\x43\x4c\xfb\x53\x3d\xc2\x0e\xde\xef\x7d\x49\xdc\x0d\xb0\xa6\xc4\x02\x51\xbc\xd1\x03\xba\x72\xb8\x1b\xa3\xdb\x0d\x0c\x37\x18\xaf\x49\x08\xdb\x7e\xeb\x81\xf0\x92\xf4\x90\x72\xdf\x69\x21\x7c\x4d\x02\x20\xe6\x8b\x11\x84\xb7\xa4\xbf\x38\x6f\x61\x5f\xf5\xc8\x5c\x1f\xd1\xb4\xec\x11\x70\x7d\x8c\xed\x16\xdf\x7e\xc5\xbf\x33\x6d\x6f\x46\x23\x65\x34\xe7\xef\x85\x60\xa6\x5e\x23\xe3\x18\xde\xda\xef\xae\xdd\x97\x1e\x66\x69\xdd\xe4\xce\x98\x85\x59\x7b\x74\x3c\xe8\xda\xb5\x75\xa3\xb6\x68\x0e\x37\xfb\xfd\xd5\x7e\x7f\x6f\x5d\xb9\x53\x51\x32\x9a\x62\xd3\x61\xaf\x20\x34\x59\x39\x9e\xe5\x08\xdf\xc7\x31\x14\xe4\xaf\x50\xa0\xfe\x05\x48\x36\x86\xc2\xdc\x3a\x17\x29\x00\x03\x56\xd7\x7d\x11\x78\x2c\x3e\xef\x07\xb5\xfa\xf1\x1b\x87\xc8\xdc\x26\x1c\xb4\x3a\x14\xdb\x8a\x9d\x0d\x10\x0f\xd8\xab\x88\x2f\xe4\x3d\x1a\xf4\xb5\x5f\xce\x1c\xb0\x31\x5e\xde\x08\xfe\x4f\x68\x04\x67\xff\x59\xc2\xd8\x79\xe5\x9a\x30\x4f\xa8\x20\xac\x97\x1f\x3e\xee\x95\x39\x32\x79\x23\xe9\x84\xe5\xe4\xd6\x48\x70\x49\xbb\xe7\x85\x73\x22\x32\x9e\x4f\xa0\x5c\x98\x9e\xa3\x28\x30\x4f\xa7\xfd\xbd\xbc\x83\x03\xd7\x3a\xc9\x52\x87\x42\x3a\x98\x5b\xb3\x92\x7a\xde\xfc\xbf\x47\xa3\xe0\xc7\x62\x38\x0d\x05\x60\x70\x94\x37\x1e\x47\x71\x6a\xd0\xbc\x70\x72\x39\x0c\xf5\xb4\xfe\x75\xb2\x80\xbd\x2c\x57\x6c\xe8\x01\x05\xee\x41\x7b\x27\xff\xdd\xc9\x53\xa3\xf3\xfb\xbd\xfd\xe2\xe4\x3b\x67\x17\xf4\xbb\xe2\xcd\x77\x5e\xf3\xf5\xbb\xe9\x78\xea\xec\x89\xa1\x76\x6a\xc0\x6b\xda\x3a\x3a\xec\xbb\x39\x62\x4d\xba\x5b\xc9\x19\xb8\x9b\xf0\xad\x9a\xf0\xd5\xa4\x43\x03\xe4\x04\x00\x13\x62\x1a\x68\xb3\x47\xa0\xcd\x7e\x17\xb4\xd1\x2c\x4c\xe8\x97\xf6\x3b\x0e\x73\x5b\xb3\xdd\xc1\x6f\x7f\x5f\x86\x7e\x67\x12\x97\x7b\x5c\x9f\xfa\x80\xe6\xdf\xd8\x8e\xe0\xba\x68\xb8\x13\xd7\xcd\xf6\xd9\x72\x49\x2b\x2a\x8c\x7d\x9b\xb7\x75\xef\xe6\xf8\x5a\x40\x17\x43\x1f\xcd\xf9\x1c\xd9\x7a\x60\xb4\xe1\x37\xb4\x8c\x14\x8f\x3e\x87\xf4\x7e\xee\x2e\xf7\x14\x75\x19\xdd\xb2\xaa\x8a\x6a\xae\xec\xbd\x9e\x46\xeb\x3d\x2d\x23\x56\x47\xab\xad\xda\x0a\x1a\xdd\xd8\x43\x34\xa9\x83\x71\x5b\xd6\x4a\x7e\x93\xfe\xe2\xbc\xc4\x25\x2e\xba\xab\xfd\xcd\x42\x1d\x13\x90\x36\xb8\x22\x43\x15\x0c\xbc\x22\xd7\xb0\x42\x78\x43\x76\xfe\x4e\x6b\x5a\xb7\xd7\x5b\x0f\x78\xed\x3e\x51\xf9\x19\xd6\xce\x4d\x29\xde\x98\x37\xe3\x87\xdc\x47\x2a\xfa\xdd\x3b\x20\xfb\x11\x8b\x6e\xb1\xfe\xeb\x80\x97\x24\xf0\x32\xdc\x7f\x01\xe2\x33\xc3\x6d\x50\x2d\x60\xad\x99\x70\x91\xf9\x15\xe9\x5f\x99\xd5\x7a\x51\x06\xe0\x96\x8b\x89\xff\xfa\x6e\xec\x3f\x3f\x4d\xcd\x27\xa9\x58\x76\x70\xb7\x7a\x94\xb1\x32\x63\xf7\x25\x6a\x6a\xbf\x4e\xc5\x45\x1c\x5f\xa1\x4d\x76\x95\x13\xbb\x4a\x55\x28\xfa\xe7\x12\x82\xb1\x1c\x83\xe6\x0e\x47\x60\x5c\xda\x87\x29\x02\x78\x93\x2d\x73\x32\xc5\x9b\x6c\x6b\x7e\x12\xbd\x67\xcf\xd7\x45\x7d\x4d\x49\x80\x63\xf7\x71\xe3\x6d\x1f\xd1\x59\x3a\xc3\xf7\x3d\xa4\x66\xe9\x6c\x6e\x80\x96\x4f\x6e\x2d\x58\xf9\xe4\xbe\x0f\x78\x39\x06\x1a\x8b\xad\x39\x58\x3e\x27\xbb\xff\x0a\xee\x2c\xff\x57\x1a\x58\xc9\xe0\xab\xa0\x4e\x0d\x9d\x9b\x3b\xef\xe9\x66\x7b\x73\xcf\x77\x6f\xda\x86\x36\xa0\x7f\x1f\xf6\x1f\xc5\xf4\xfd\x31\xc6\xaf\x1d\x4b\xdb\x68\x8a\xef\xba\x1d\xbe\xf7\xdb\x79\xc0\x9d\xba\x79\x7d\xff\xff\x8f\x5a\x6c\x47\xd3\x2f\x83\x6e\xd9\xe3\xfc\xdb\x60\x6f\x9f\xe0\x30\x4d\x89\xe3\xf0\xfc\xa2\x4f\x4d\x7b\xd2\xf5\x0b\xec\x4f\x1a\xa0\xda\x9e\x1d\xa4\xfd\xb3\xd6\xf0\x23\xc9\x0b\x58\x63\x85\x29\xc2\x0d\xb9\x81\x3c\xbc\xfc\xa1\x9b\x31\xff\xfa\x17\x15\x27\x43\xfc\x67\x14\xed\xa5\xb8\xfe\xb5\xc8\xde\x95\x76\xdc\x20\xfc\x0b\x54\xb8\xd3\xec\xee\x0b\x08\xf3\xa9\xcb\xc9\xbe\x59\x13\x72\x38\x1c\xf0\x8a\x1e\xd0\xfc\x0f\x67\x67\xff\x1d\xd9\xff\x65\xea\x75\xd1\x34\xac\xbe\xfe\xf0\xee\x15\x71\xd5\x85\x0d\xab\x93\xdf\x64\xb2\x29\x9a\x3f\xfc\x6f\x00\x00\x00\xff\xff\x10\xa0\xcc\x28\xf4\x4a\x00\x00")
+var _libPopper1129MinJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\xbc\x6b\x9b\xdb\xb6\xb5\x28\xfc\x3d\xbf\x82\xda\xbb\x25\x00\x0b\xe2\x48\xe9\x9b\x77\x9f\x43\x19\xd1\xe3\x38\x76\xe3\xd4\xf6\xb8\xb1\xdd\x24\xa5\xf9\xc4\x1c\x11\x1a\x21\xa6\x08\x16\x80\x66\x3c\x95\xf4\xdf\xcf\xb3\x70\xe1\x45\xd2\x8c\xd3\xfd\xc1\x16\x89\xcb\xc2\xba\x63\xad\x05\x70\x2e\x1e\x7d\x15\x3d\x95\xcd\x9d\x12\xd7\x6b\x13\xe1\xa7\x24\x7a\xce\x4b\xae\xc4\x52\x46\xff\x14\x37\xb2\x92\xd1\xd7\xd3\xd9\xff\x7c\x15\x7d\x2f\xb4\x51\xe2\x6a\x6b\x78\x19\x6d\xeb\x92\xab\xc8\xac\x79\xf4\xea\xc5\xbb\xe8\xa5\x58\xf2\x5a\xf3\x08\x57\xfe\xc1\x70\xb5\xd1\x51\xa1\x78\x54\x98\x68\x6d\x4c\x93\x5e\x5c\xc8\x86\xd7\x5a\x6e\xd5\x92\x27\x52\x5d\x5f\xf8\xa1\xfa\xe2\xd5\x8b\x77\x24\xf9\x2a\x7a\x74\x81\x57\xdb\x7a\x69\x84\xac\x31\xa7\x86\xec\x90\xbc\xfa\x9d\x2f\x0d\x62\xcc\xdc\x35\x5c\xae\x22\xfe\xb9\x91\xca\xe8\x38\x46\xb0\xfa\x4a\xd4\xbc\x44\xa3\xd0\xb9\x91\xe5\xb6\xe2\x0b\xf7\x93\xf8\xa1\xcc\x60\x92\xa2\x00\xb6\x83\xe4\x66\xc7\xb1\xfb\x4d\x8a\x4d\xb9\x70\x8f\xd8\x90\x94\x27\x6f\x64\xd3\x70\x05\x93\x0f\x04\x9b\xb5\xd0\xb4\xc5\x8c\xec\xd0\x56\xf3\x08\x18\xb1\x34\x68\x1e\xda\x23\x8e\x39\xd9\x29\x6e\xb6\xaa\x8e\x78\x1c\xa3\xcc\x21\x1f\x3d\xf7\x03\x72\xc4\x18\xdb\x1d\x12\x23\xdf\x1a\x25\xea\xeb\x64\x59\x54\x15\xe6\xe4\xd0\x42\x30\x8e\x6a\xb1\xc2\xb3\x11\x63\x3c\xa9\x65\xc9\xdf\xdd\x35\x9c\x38\xa8\x59\x3e\xbf\x29\x54\x24\xd9\x35\x37\x4f\xe5\xa6\x01\x29\xbc\x35\x77\x15\xc7\x9c\xd6\xdb\xaa\x22\x73\xbf\xba\x59\xc8\xcc\xe4\xa9\xec\x20\xcb\x0e\x37\xf4\xc3\xbb\x57\x2f\x01\x15\x07\xff\x75\xb1\xe1\x0b\x9e\xf2\xa4\x29\x14\xaf\xcd\x6b\x59\xf2\xfd\x9e\x27\x6b\xa9\x4d\x37\xbd\x86\xe9\x62\x85\x47\x01\x95\xa8\x94\xcb\xed\x86\xd7\x26\xb9\x92\xe5\xdd\x5c\xdf\x0a\xb3\x5c\xe3\x0e\x22\xd9\x2d\x0b\xcd\xdd\x52\xa9\x7d\xfc\xee\xf2\xfb\x5f\x51\xea\x67\xf3\x44\xde\xd6\x5c\x7d\x3f\x00\x62\x87\xfd\x77\x00\xdc\x1b\x6b\x7b\x0f\x40\xba\x60\x06\x73\x42\x15\x13\x89\xbc\xe1\x6a\x55\xc9\x5b\xda\xf4\x5e\x7e\xa1\xba\xf7\xf6\x6b\xe0\xc7\x05\x2e\xb6\x46\xee\xf5\x52\xc9\xaa\x22\x17\x89\xe1\xda\x60\x35\xd6\xe3\x86\x2c\x78\x5a\x63\xe0\x4e\x4f\x0c\x0a\xa8\x85\xe5\x24\xe3\x71\xcc\x13\xb9\x5a\x69\x6e\xde\x58\xfe\x50\xc1\x64\x1c\xcb\x96\xd0\xb0\x84\x88\x63\x47\xe2\x88\x31\x78\xb6\x94\xc3\xf3\x62\x02\xb2\xcc\xd0\xbb\xef\x11\x45\xef\x9e\x7c\xf7\xf2\x19\xca\x13\x51\x97\xfc\xf3\xe5\x0a\x77\x70\x48\x1c\x23\x6d\x0a\x23\x96\x20\x1a\x83\x25\x45\x8d\xd4\x02\xf0\x41\x64\xa1\xb0\x24\xa9\x4c\xf9\xe2\x98\x71\x81\x5b\xcf\x2a\x0e\x3f\x69\x79\x4f\x47\x47\x5c\x13\x88\x33\x8c\x1f\x53\xd1\x52\x60\xe2\x18\xb7\x7a\x62\xf6\x7b\x85\x79\xb2\x12\x4a\x07\x70\x4f\xd7\xa2\x2a\x09\xe8\x50\x8f\x6d\xba\xa7\xff\xa0\x8e\xd0\xdd\xd3\xaa\x05\x4f\x35\xee\x37\xf4\xa6\x96\xad\xe2\x8f\xf8\x7e\x3f\xea\x34\x7f\xbf\x1f\x19\xf8\x77\x6c\x0a\xd1\x7d\x84\x7a\x0b\xe1\xc9\x52\x6e\x60\xa9\xc0\xa9\x37\x9e\x99\xd8\x90\x18\x16\x4f\xbe\xbf\x7c\xfa\xfe\xd5\xb3\xd7\xef\x7e\x7b\x73\xf9\xf6\xc5\xbb\x17\x97\xaf\x7f\x7b\x7e\xf9\xf2\xe5\xe5\xcf\x2f\x5e\xff\x15\x64\xbc\xe0\xa9\xa1\x35\x93\x0b\x93\x72\x5a\xb0\x76\xb9\xa5\xe2\x85\xe1\x3f\x15\xf5\x35\xc7\x64\x5e\x24\x9a\x9b\xb7\xa6\x50\x06\x0b\x3a\x25\xd4\xbe\x3f\xab\x4b\x5c\xd3\x29\xb1\xa8\x54\xac\x00\x54\x36\xb2\x7e\x52\x2f\xb9\x36\x52\x3d\x95\xb5\x29\x44\xcd\xd5\x5c\xac\x30\x1f\x31\x56\xc5\xb1\x81\x9f\xfd\x5e\x24\x4b\xd7\xa9\x71\x4d\x02\xa5\x0d\xae\xc8\xa2\x4a\x15\xae\x1c\xc4\x15\x03\x46\x07\xbd\x5b\x59\x4b\x5d\x94\xd8\x3d\x50\x43\x52\x60\xa6\xc6\x86\xd8\x86\x1e\x93\x8b\x4e\xf2\xb3\xc7\x85\xba\xb6\xfc\xd3\x49\xc5\xeb\x6b\xb3\x8e\xe3\x1b\x29\xca\x68\x3a\x62\xac\xed\xca\x66\xf9\xa2\xff\x92\x22\x23\x1b\x44\x25\xb3\xbf\xa0\x19\x0b\xe4\x6c\xea\x9d\x6c\x50\xea\x9f\x5f\xf2\x95\x41\x54\xf4\xb5\x4b\xac\xb0\x53\x2d\xc6\x98\xd8\xef\x5b\xcd\x12\x0e\x9f\x9a\x7d\x41\xad\xa9\x3a\x19\xe1\xd6\x12\xf5\xb5\x1f\xb2\xdf\xd7\x81\x25\x2a\x93\xf9\xc1\x3f\x73\x78\x6e\x39\x50\x39\x35\x83\x35\x25\xfb\xfa\x8f\xf1\xe0\xeb\x3c\x8e\xfb\x6f\x54\xb0\x02\x1b\x6a\x59\x40\x68\xed\x5e\x2a\xa0\x19\xfc\x92\x5c\x4c\x66\xe9\x2c\x60\xc2\x13\x23\x9b\x31\x13\x8f\x14\xe5\xc9\x95\x34\x46\x6e\xc2\x1b\xcc\x18\xb3\xda\xf6\xd8\x4d\xd7\xbf\x74\xc8\xae\xfa\xc8\xa2\xcf\xd6\x2d\x2c\x90\xe5\x6e\x8a\x80\xe1\x54\x30\xf7\xca\x98\x5c\xa0\x9f\x00\x08\x4a\xd1\x77\x76\x19\x14\x50\x68\x0a\xa5\xf9\xf3\x4a\x16\x06\xf3\x0c\x5d\x49\x55\x72\x85\xc6\x72\x8c\x7e\x16\xa5\x59\xa3\x9c\xce\xa6\x64\x7c\x7e\x90\x18\x0c\xea\x10\xdb\x00\x62\x54\x52\xd1\x5a\xfb\x8f\xd8\x64\xc8\x79\x49\x34\xe6\x39\x35\x99\xd7\x05\xfb\x26\x33\xb4\xac\x04\xaf\x4d\x78\xeb\x8d\x94\x83\x91\x82\x63\xb2\x18\x0c\x18\x8b\x0c\x6d\x0a\x75\x2d\x6a\x34\xc6\xe8\x07\x6e\x89\x04\xc7\xb2\xb0\x2c\x48\x1d\x03\xc8\x43\xe3\x3c\x43\x52\xcf\x21\x92\xa7\x7d\x62\xd6\xd8\xb1\x98\x77\x26\x0e\xdb\x0d\x35\xdd\xfb\xb1\x32\x4a\x06\x78\xc6\xf1\xc9\x2e\x6c\x82\x55\xee\xd6\x16\xd1\x74\xd3\xa2\x42\x2d\xcb\x08\xbd\x05\xae\x43\xbb\xe3\xac\x6f\x3e\x74\xf8\x2c\x7b\x4e\x54\x73\xbc\x3b\x50\x4e\x77\x56\x41\x52\xaf\x34\x3c\xb1\x40\xa8\xd3\xa7\xd4\xe9\x18\x4f\xdc\x92\x87\x1e\x69\xd7\xc1\xde\x25\xdb\x1d\xc0\xd9\x00\xda\xc4\xa8\xbb\x9d\x64\x3c\xb9\xe6\xe6\x3b\xb9\xad\x4b\x51\x5f\x3f\xb5\xd2\xf9\x89\x2f\x0d\x76\x2e\x06\x54\x9c\xf7\x55\x9c\x07\x15\x9f\x4b\xaf\xd2\x54\x06\x15\xa6\xb2\x53\x6d\x2a\x5b\x65\x3e\x2c\x0b\x1b\x12\x90\xdd\x81\x57\x9a\x47\x5f\x5a\x53\xb1\x1d\x00\x4c\x1d\x5c\x6a\x64\x93\xda\xb5\x3c\xcb\x3c\xe0\x89\xef\xf6\x0c\x0e\x4b\x4f\xec\xd0\x03\x6d\x58\xeb\x5b\x3a\xf7\xb3\x58\x63\x92\xee\x0e\x54\xb3\xc6\xb1\x0e\xe2\x1b\xa7\x91\x56\x0a\xfb\xbd\xf2\xc0\x95\x03\x5e\xb2\xc6\xb3\xb3\x1b\xf9\x83\x7f\x57\x61\x45\x05\x2b\xd2\x8a\x85\xf0\xc0\x82\x9a\x68\xba\x69\x5b\xdc\x94\x49\x09\xac\xaf\xf6\xfb\x8d\x13\xc6\xb5\x0d\x61\xe6\xd5\x84\xad\xf0\x35\x45\x9f\x11\xa1\x1b\xff\x7c\x87\x08\x55\x0e\xc5\x09\xab\xa8\xf2\x48\x4c\xd8\x26\xb8\xb3\x25\x56\x3d\x01\x6f\x31\xa7\xd2\x41\x15\x56\x27\xa9\xea\xe8\xef\x42\x0b\xda\x30\x50\x05\xaa\xd9\x35\x96\x84\x96\x0c\xa2\x39\x5a\x30\x03\x6f\x2b\xd6\x33\xfe\x22\x71\xb6\xff\x4e\x36\x96\x1c\x70\x0e\x74\x73\x6e\x04\x98\x5d\x37\x64\xcd\x96\x78\x07\x12\x6b\x80\x29\x13\x6d\xff\x5f\x51\x60\x66\xda\x58\x9e\x4e\x60\x9f\x59\x99\xc9\xc6\x8b\xd3\x4b\x22\xc8\x31\xf0\xfb\x40\x80\x59\xeb\xc4\x99\xf2\x3b\xd9\xb0\x29\x0d\x6f\xb0\x24\x9b\xd2\x91\x88\x63\xe5\x88\xde\x0e\x31\x6b\x27\x59\xb4\xaf\xce\x75\x02\x0c\xe8\x9d\xaf\x2d\x8a\x6c\x35\xd9\xd2\x75\x90\xa8\x7f\xb3\x78\xb2\xcd\xe4\x8a\xae\xbd\x5a\xf8\x97\x0e\xa9\xed\x10\xa9\x2b\x2f\x1e\x2c\x16\xb2\xdb\xc1\x4b\x92\x4a\xc6\x58\xd9\x0b\x0c\xcb\x56\x26\x24\x8e\xf1\x9a\x55\x78\x4d\x25\x21\x74\xdd\xc9\xf4\xaa\xdb\xa4\xbf\xb4\x29\x4a\x06\x0a\x60\x08\x15\xec\x47\x6c\xfa\x1a\x4d\x6f\x45\x5d\xca\xdb\x44\xd4\x35\x57\x5e\xc7\xa7\x60\xc9\xdd\x38\xa7\x9c\x83\x81\x41\xc5\xa7\xb0\x91\x15\xd8\x10\xda\x0c\x76\x37\xcd\xac\x8c\x95\x33\xb6\xb1\xf4\x2c\x00\x86\xc3\x88\xb4\xf1\xc6\xd9\xf6\x00\x73\xbc\xbc\x45\x90\x74\x7d\x08\x3b\xd3\x12\xeb\x9e\x2e\xdf\x06\xba\xc5\x7d\x61\xe9\x71\xec\xb0\x18\xcd\x52\xb4\x12\x9f\x79\x09\xea\x0e\xa9\x53\x2f\x62\xde\xef\x6f\x8f\xa3\xfa\x3b\x60\x16\x15\xd4\x2b\x4f\xe3\xa8\x99\x3a\x2d\x9d\x82\x7b\x80\xc0\xc9\x58\x0d\x44\x37\x82\xdf\x42\xfa\x08\xa0\x15\x69\xd8\x15\xd6\x64\x0e\x7e\xcc\xce\x2d\xe6\x7e\xd7\x72\x39\x81\x1d\xb4\xc0\x05\x83\x4c\xc2\x10\x42\x5b\x84\x8b\x96\x94\x38\xc6\xc5\x97\x24\x4a\x48\x8a\x9c\x40\x1c\xc4\x2f\x4e\x48\x0b\xa6\x7c\x94\xb9\xc5\x05\xd5\x0e\xf7\xc0\xa1\xfe\xe2\xa3\x5b\xac\x89\x23\x7c\xc5\xd6\x98\xd0\x0d\x5b\x79\x9b\xa3\x4b\xb6\x72\xd6\x38\xb7\xe6\x3b\x66\x15\xfc\x4c\xaa\x9e\x7c\x1b\x6f\x23\x6c\x33\xb6\x9d\xb4\xf1\xde\xbf\xb2\xbf\xed\x58\x2b\xf1\xc6\x99\x0d\x5b\x8e\x5d\xaf\xf3\xff\x0d\xab\x82\x17\x0b\x93\x05\xf5\x0b\xc2\x83\x37\x35\x78\x0c\xf6\x28\x68\xd3\x89\xef\x59\xdf\x30\x2c\xba\x14\xb6\x14\x47\x43\xd0\x29\xf3\xa8\x97\xf3\xde\x84\x38\x85\xd6\x6e\xa6\x62\xdf\xfc\xb1\x98\xef\x9b\x7e\xdc\xfb\x4d\x9e\x4e\x81\xaf\x93\x99\xdd\x55\x42\xea\x86\x20\xa5\x44\x6d\x94\xce\xad\x1c\x1a\x76\x87\x25\xe8\x18\xad\x5b\x7b\xd9\x9d\xf5\x78\x06\x28\x9f\x58\xfa\x0f\xd4\xd2\xde\x0d\xb4\xaf\x13\xe3\xd8\x78\xe2\x22\xc3\xc6\x7f\x1e\x6e\xcb\x3d\xe3\x1f\x0e\x4e\xc1\xfd\x68\x63\x59\x3f\x69\x06\xbb\x68\x0b\xfa\x40\x4b\x76\x69\x8b\x16\xc9\x27\x7e\xa7\xb1\x26\xc9\xa6\x68\x7a\x35\x99\x41\x68\xf2\x89\xdf\xa5\xfc\x40\x75\xc6\x73\xba\x2b\x14\x2f\xd2\x67\x18\x5e\xc8\x81\x1c\x48\xa2\xa5\x32\x47\xd5\x1c\x3f\xd7\x24\x30\x78\xc2\xed\xcf\x81\x40\x76\x95\xac\x44\x65\xb8\x1a\xac\x34\x94\xb5\x38\x95\xf5\xb7\x4c\xf6\x9d\x5e\x1c\x8b\xae\xe5\x07\xbf\x9d\xd0\x8a\x4d\x1f\x17\x5e\xd6\x8b\x22\x9b\xe6\x40\x59\x5a\xfa\x07\xba\x62\x3c\xd1\x4d\x25\x0c\x46\x13\x44\xb2\x59\x1e\xa0\x57\x63\xbc\x5a\xa0\x09\x1a\xaf\x52\x84\x7a\x5e\xe4\xd2\x29\x55\xf0\x55\x25\x36\x54\xb6\xc9\xd8\x16\x43\x54\xdc\x0d\x7e\xd9\xd1\x71\x5a\xb2\x21\x54\xf6\xb7\x28\xd3\x99\x1b\x19\x9f\x69\x77\x61\x2c\x38\xfb\x33\x9d\x60\x77\x67\x67\xd9\x98\x17\x3c\xbf\x97\xff\x20\x5a\x19\xb7\x5e\x79\x18\xb2\x8c\x65\xeb\xa4\xeb\x8e\x98\xcf\x1d\x31\x2e\x52\x43\x56\x3f\x91\xd7\x5e\x17\x20\x06\xe5\xb4\xb1\xa3\x0d\xe2\x90\x6b\x41\x2d\x48\x9e\x28\xde\x54\xc5\x92\xe3\x0b\x98\xb2\xb7\xd3\xf7\x6e\xd4\xde\xc8\xe6\xe2\xba\x2b\xb5\x75\xb1\xb0\xc9\x78\xde\x0f\x6e\xdf\x06\x39\x48\x26\xfb\x12\x9c\xe6\x3e\x82\x05\xde\x77\x74\x8b\xa1\x95\x88\xa0\xf1\x54\x31\x5f\x9f\xf1\xc4\x38\x32\x7a\x15\x1a\xd8\x05\xd5\xc2\xd2\x13\x68\xd4\x4c\x2d\xdc\xa3\xa7\xb3\x84\x06\x07\x11\x81\x0b\xb7\xa1\x7d\x01\x8d\xee\x39\x0d\x9d\x2d\x5b\xb3\x26\x67\x26\x6b\xf2\xb1\xc9\xca\xfc\xe2\xeb\x89\xb0\x3f\xb4\xce\x74\xce\x20\x6a\xd0\x0b\x93\xe9\x7c\x22\xb2\x22\x4f\x4d\xf6\x19\x6b\x92\xd3\x9e\x2c\xde\x0d\x0c\xea\x89\x52\xc5\x5d\xd2\x28\x69\x24\x54\x42\x93\x95\xa8\xcb\x05\x14\x6c\xea\xd2\x15\x33\xbd\x75\x19\x60\x4f\x07\xe4\xfb\xc0\x42\xb1\xc2\xe7\x40\xbc\x00\x4f\xd7\xfa\xb7\xae\x69\x60\xa5\xa1\x3b\x33\x39\x63\x4c\x1e\x42\x02\x01\x18\x3e\x3c\x2e\xb4\xb4\xac\xee\x5b\xce\x53\xec\x33\x4c\x80\x56\x33\x57\x9b\xb0\xbb\xbe\x49\x4d\xa2\xa1\x70\x8c\xa7\xf4\x7b\x88\x4e\xea\x62\xc3\x11\x15\xa4\x85\x58\x27\x2b\xa9\x9e\x15\xcb\x75\x87\xa8\x21\x3b\x93\x75\x45\xe0\x3c\x8e\x97\xb2\xd6\xb2\xe2\xc9\x6d\xa1\x6a\x8c\x3e\x6e\x64\x29\x56\x82\xab\x24\x8c\xf9\x18\x09\x1d\x95\xbc\x51\x7c\x59\x18\x5e\xd2\x08\x4a\xbe\xbd\x61\xf5\xc7\x11\x0a\xb4\x0e\x40\xef\xf7\x26\x59\xd5\x73\x93\xf0\xba\xb8\xaa\x78\x19\xc7\x1c\x0b\x08\xf7\xa4\xb7\x31\x9d\x34\xae\xb6\xbc\x3c\x69\x22\xb4\x6b\x51\x7c\xc5\x15\xaf\x97\x7c\x30\xae\x6d\x05\xef\x21\xb0\xa4\x86\x90\x03\xa1\xbd\x5d\xef\x35\xb6\x02\x1d\x41\xcd\x3a\x81\x8a\x22\x4f\x84\xfe\x9e\x6b\xa3\xe4\x1d\x2f\x43\xb2\xbb\x13\xb5\x36\x45\xbd\xe4\x29\x8c\xa3\x1a\x5c\x92\x86\x24\xa9\x50\x4a\xde\xbe\xed\x5e\x8d\x2f\xf7\xdb\xce\x55\x25\x9a\x86\x97\xe9\x68\x46\x3d\x42\xe9\xee\x70\x98\xf3\x53\xf4\xd8\x25\xee\x10\xa0\xf6\xd1\x51\xed\x9e\x7b\x64\xf0\xc4\x3a\x03\xd8\x6b\xd9\x8d\x9b\x24\x1b\xa0\x44\x77\x1d\xf4\xcc\x0a\x0f\x00\xa5\x03\x28\x41\x66\x3a\x01\xf4\x93\x2b\x48\x7a\x0b\x25\xb8\xf6\x41\xd4\x83\xa3\x9b\xa2\x84\x6c\x95\x00\x06\x4a\x5c\x8b\xba\xa8\xde\xb4\xe8\xf2\xb3\x18\x7a\xe9\xbe\xc5\x7d\x04\xcf\x11\xd0\x9b\x4e\x4e\xe6\x27\x21\x96\x65\xa8\xb8\xd2\xb2\xda\x1a\x8e\x28\x67\x4f\x1d\xd4\x16\x4b\xca\x09\x1d\x48\xfa\xa9\xad\x4f\x96\x8b\x01\x51\xb2\x7e\xdf\x94\x85\x81\x03\x8a\x14\x9f\x1b\xce\x46\xd3\x21\x1b\x64\xed\x7a\x6c\x08\xdd\x29\xd7\xa7\x81\xdf\xe1\x89\x96\x1b\x3e\x70\x07\xa1\xfa\x0a\x46\x69\xf7\x6c\x6f\x05\xc1\x36\x45\x1c\x83\x7b\x1b\xd4\x29\x7e\x06\xff\xb0\x92\x0a\xc3\x64\xc3\xb2\xd1\x8c\xa2\x8d\x46\x14\xfd\xcc\xaf\x3e\x09\xf0\xc9\xaf\xe4\xbf\x11\x45\x97\x28\xb7\x21\xdf\x72\x5d\xa8\x27\x06\x4f\x49\x62\xe4\x7b\xe0\xd5\xd3\x42\x73\x4c\xc6\xdc\xfb\x85\x19\xec\x77\xd3\x79\xfd\xd8\xf8\x7d\x7f\x32\x9b\xd7\xe3\x71\xd8\xb2\x4d\x56\xe7\x70\x82\xb0\x40\x50\xe2\x92\x29\x87\xe0\xee\xdc\x71\x52\x08\xb6\xed\x11\x47\xa2\xc1\x26\x32\x95\x07\x87\xa8\x42\x34\x0b\xa5\xee\x8e\x98\x37\xb8\x65\xcf\x79\x0b\x04\x56\x7f\x3a\x96\x22\x2a\x9a\xa6\xba\xb3\x66\x87\xc0\x57\xf4\x54\x27\x51\x7c\x23\x6f\xf8\x93\x60\x88\x18\x7d\x9e\xb4\x7a\x83\x08\xed\x0f\xb5\x38\xda\xd0\x8e\x21\x74\xa6\xa7\xd3\xa9\x73\xbd\x46\x36\x67\x3b\xb2\x9f\x31\x32\xaa\xa8\xf5\x4a\xaa\x0d\x22\x39\x43\x61\xd9\x52\x68\x90\xee\xb3\x1b\x5e\x9b\x97\x42\x1b\x5e\x73\xa5\x31\x19\xaa\x92\xc3\xff\xb2\xf6\x1c\x88\xe3\xfe\x02\xdd\x69\x80\xa7\xd3\x9e\x2c\xf4\xe9\x77\xd0\x3a\x06\x7f\xd7\x85\x21\x47\xd9\x51\xd0\x31\xb3\x30\x49\xc9\x57\xc5\xb6\x32\xff\x10\xfc\x36\x75\x29\x55\x07\xe1\x87\xae\x7e\x09\x70\x14\x6b\xd3\xb5\x2e\xf3\xb4\x9b\xfe\x49\xf6\xd5\x03\xca\xe7\x4d\x52\x94\xe5\x80\x74\xbb\x67\xed\x9a\x42\x6b\x71\xc3\xd3\xd1\xf4\x40\xa8\xda\xef\x7f\xc0\x35\x6e\xfa\xc7\x1e\x7e\x71\x2a\x92\x66\xab\xd7\xb8\xe9\x99\xc2\x93\x0e\x35\x99\x6c\xad\xcd\x7e\x07\xfe\x8a\x09\x0a\x74\x9f\xae\x88\x14\xd7\xe2\xdf\x1c\xd1\xc1\xf0\x21\x12\xbe\xbc\x56\xf7\x4e\x0d\x7e\xc0\x8a\x86\xc2\xeb\xd1\x54\x99\xf4\x53\x5b\x0d\x5b\x92\x6b\xf0\xee\x92\x29\x2a\x13\x0e\x64\xeb\x67\xce\xb6\x41\xa3\x7b\x1b\xd0\x0b\x4c\x76\x3d\xd5\x1f\x0c\xdd\xef\x7b\xee\x87\x3d\xc1\x0f\xf8\xed\x9e\x5b\xf3\x8f\xcb\x35\x87\x83\x5d\xe7\xca\xfa\x99\xfd\xab\x81\x57\xb2\x8c\x72\xca\x74\x0f\xaf\x4c\x9f\x60\x42\xcd\x90\xe2\xd3\xe8\x81\x93\x1d\x3f\x0f\x31\xb0\x70\x08\xf1\x40\x86\x0d\x0c\x5c\xc4\xf1\x32\x2c\xcb\xa9\x39\x62\xad\x1f\x77\xc4\xdd\x19\xed\x1d\xdf\xfd\xf4\x00\x77\xe3\x18\x2f\x61\x4f\xaf\x9e\xd4\x62\x53\xc0\xf0\xe7\xaa\xd8\x70\x7c\x8e\x7d\x3d\xf6\xb2\x57\x67\xe5\xa0\xcd\x11\x9b\xdf\x77\x71\x1c\x42\x70\x3e\x1d\xc7\x23\xa1\x5f\x17\xaf\x71\x2f\xf1\xe0\x84\xc4\xb1\xd0\xcf\x45\x2d\x0c\x1f\x1c\x6e\xff\xea\xa4\xd4\xcf\x2f\x0d\x39\x65\x76\x9b\x5a\x21\x34\xf7\xa1\xb9\x0f\xa8\x43\x10\x4d\x5d\xd0\x1d\xd2\x8f\x90\x60\x9c\x09\xdd\xe3\xf8\x3d\x36\x99\xcc\xc1\xa5\x0a\x86\x1a\x28\xb6\x72\xef\xd4\x24\x04\xe0\x32\x1f\x8b\xfe\x56\xf4\xfb\x7f\x88\x23\x40\x98\x8f\xa0\x30\x20\x16\xfc\xc4\x53\x4b\x88\xbd\x35\x37\xbd\x16\x0a\x33\x48\x7f\xc9\xe7\x21\x0a\x3f\x1f\x37\xf7\x37\xd4\x60\xbd\x7e\x07\xa5\x35\x1b\x41\x35\xf4\xcc\x36\xec\x07\xba\x69\x10\x97\xc7\x71\xbb\x15\xc3\xa3\xad\xf2\x3e\x16\xee\xd7\x15\x5d\x47\x6d\x91\x04\x7d\x44\x63\x33\x46\x1f\xd1\xfc\x28\x48\xb6\xe7\x46\x1f\xd1\x18\x45\x61\xe7\x82\x20\x59\xf1\x7f\x6d\x85\xe2\x65\x74\x75\x17\xa1\xb1\x1a\xf4\xd6\x91\x5d\x21\x32\x32\xba\x95\xea\x13\x8d\xae\x78\xa4\xb7\x8a\x43\x83\xa8\x97\xd5\xb6\xe4\x91\x30\xd1\x15\x5f\x49\xc5\xdd\xec\x11\x22\xed\xce\xda\x71\xe9\x6f\x3d\xdd\xe3\x75\xe9\x8f\x76\x34\x9c\xbc\xc2\xc1\xa3\xfd\x75\x6d\xd0\x9b\xf2\x6e\xe6\xbf\xfe\x17\xa7\x9e\xfd\x13\xbf\x19\x04\x1d\x45\x97\xa5\x70\x48\xc1\x8b\x10\x6b\xc8\xf1\x8c\x40\xc9\x77\x09\xd5\xf3\xd0\x38\x85\xa2\x6e\x10\x96\x59\x88\x44\xf1\x1b\xae\x20\x46\x49\x45\x87\xd8\x3f\x86\xdb\x10\x1c\x82\x6e\xec\x91\xc9\x05\xc6\x8b\xf4\xc3\x64\xff\x61\x4c\x16\x1f\xca\x47\x1f\x12\xf8\x9f\xe0\xe4\x11\xb9\x80\xca\xec\xb8\xce\x66\x39\x6d\x58\x9d\x7d\x9d\x5b\xc1\xa9\x36\x47\x83\xd7\x29\x63\xac\x69\xb1\x45\x7f\x46\xbe\xec\xa7\xc3\x25\x8d\xc6\xdf\xcd\xf8\x73\x83\x52\xcd\xe4\xfc\x4a\xf1\xe2\xd3\xdc\x35\xf9\xbb\x1a\x7f\x56\x28\xf5\x3b\x68\xaa\x99\x70\x77\x2f\x4a\x06\x15\xdb\x40\x56\x99\x99\xfc\x62\x36\x9d\x3e\x52\x07\x08\x9f\x6e\xd6\x20\x92\x66\xbf\x47\x37\xb7\xf6\x89\xf8\x42\xa9\x1f\x5e\xb0\x30\x64\xf1\x23\x6e\x03\xab\xf0\xe0\xfd\xdf\x1f\x29\x4d\xa7\x5f\x9c\xfe\x50\x05\xbc\xf0\x28\x7b\xac\x54\x27\x8d\x7f\x1f\x4b\x23\x9b\xd2\x69\xfe\xc5\xf2\x80\x80\xf2\x40\x28\x21\x5d\xe0\x0f\xe3\xfd\x87\x09\xb9\xb8\xb7\x6c\xc6\x13\xa3\xc4\x06\xee\x14\x51\xdd\x93\xd2\x3b\xdc\x9c\x49\x96\xed\xca\xe0\x40\x0a\x05\x5a\x41\xf7\x1f\xf4\x05\x39\x10\x32\x6f\x32\x9d\xc7\xb1\xad\x47\xc2\x63\x0b\x06\x51\x44\x8e\x33\xdb\x4b\x97\x55\x44\x9a\x37\x85\x82\xcc\x00\xcc\xf4\x76\x2d\x0c\x8f\x74\x03\x45\x18\x4d\xec\x1d\xad\xe3\x54\xb7\x88\xe0\xd6\x42\x11\x61\x4a\x22\xc8\x15\x79\x51\x26\x3e\xe1\x2d\xd9\xc5\x07\xfd\x88\x7e\xd0\x8f\xf6\x1f\xf4\xf8\x82\x16\xcc\xa2\xa2\x17\x59\x93\xa7\x59\xd3\x1a\x81\x6e\x2d\x23\xb3\x68\x3a\x26\x95\x50\x84\xc8\x09\x3d\x6a\x9b\xe5\x79\x18\x1d\x20\xe8\xf1\x8c\x90\xb6\x20\x57\xb0\xe2\x88\xab\x9d\xac\x30\xac\x2f\x16\x23\x95\x2a\x72\x5a\x88\x69\xd8\xa8\x77\x22\xaf\x78\xb9\x5d\xf6\xbd\x65\x17\x3a\x20\xd0\x5c\x9e\xf1\x36\x6d\xb0\x5c\xb6\xe2\x1f\x23\x8a\x26\x3d\xb9\x1b\xb2\xc0\x83\x91\xcc\xd0\x06\x02\x21\x4e\xd2\xe6\xa8\x6b\xec\xfa\x66\x94\xc3\x7e\xe0\x89\x34\xe4\x40\xb3\xfc\x5e\x45\x01\xe7\x50\x83\x42\xc2\x5e\x01\xf7\x4a\x4e\xf6\x20\xbb\x51\xf1\x33\x7b\x13\xb0\xe5\xbd\xdd\xfc\x70\x9d\x99\x7c\xcc\xe4\x23\x28\x8d\x59\xd2\xc4\x64\x96\xdb\x1b\x0a\x50\x35\x38\x90\x7e\x49\xe9\xaf\xbd\xeb\x06\x54\x30\xe3\xf3\x51\x5a\xb3\x5e\x9e\xea\x2e\x62\xd8\x0e\x0d\x21\xb2\x8f\xd1\xa9\x66\xaa\x17\x3e\x94\xac\x3e\x2a\xc9\x79\xaa\x24\x7b\x8f\xc7\x82\x2c\xb2\xb1\xa0\xd3\x3c\xfd\x37\x16\xb4\xa1\x9a\x96\xc4\xdb\x15\x1c\x97\x2d\xb0\x3f\x14\x90\xd9\x34\xf7\xe7\x0c\x13\x26\xb3\x59\x4e\x42\xc5\xf1\xbe\x61\xe3\x30\xcc\x5f\x4f\x29\x17\xb8\xeb\xb0\xc0\xa0\xe6\x1e\x06\xf9\xb0\x01\xc6\xc5\xf1\x99\x81\x1e\x1a\xe5\x9e\x48\xd6\x50\x7e\x08\x69\xea\x6f\xec\x55\x61\xd6\xc9\x46\xd4\xf4\x17\xf7\xb8\xaa\xa4\x54\xf4\x47\xdf\x5e\x7c\xa6\x7f\x67\xe7\x92\x4a\xe7\x97\xce\xdf\x5f\x0c\xfe\x8c\xfe\x93\x65\xe8\x59\x79\xcd\xe1\xc6\x98\x12\x25\x24\x7a\x14\x3d\x17\x8a\xaf\xe4\x67\x94\xd3\x3f\xb1\x29\xe5\x9c\x4d\xe7\x9c\x3f\xfe\xa7\xd7\xb3\x39\xe7\x63\x36\x23\x62\x85\xff\x1e\xc7\xd3\xc7\xac\x2e\x6e\xc4\x75\x61\xa4\x4a\xb6\x9a\xab\x27\xd7\xe0\x5e\x83\xf2\xfe\x33\xe3\x3c\x27\x64\xf7\x27\x36\x73\xde\xdf\xfa\x77\x41\x0d\x67\x7f\x8f\x63\xef\x39\xdf\x28\xb9\x11\x9a\x53\xc9\x99\xe1\x8b\xe3\x90\xc4\xf4\x6c\xaa\xed\x23\x3b\x03\x01\x3e\x98\xc1\x10\x48\xa2\xb8\x96\xd5\x0d\xc7\x24\x31\x6b\x5e\x77\xca\x4a\x76\x00\x88\x72\xf0\x88\xe4\x70\x48\xff\xd3\x65\x34\x37\xef\xc4\x86\xcb\xad\x39\x0b\x93\xfe\x09\xaa\x17\x54\x70\xd6\xeb\xf5\xe0\x42\x49\x51\xd8\xd8\xd0\xfa\xda\x8e\x67\x45\xd3\xfc\x83\x2b\x2d\x64\xdd\x32\x0d\xbd\x7a\xfb\xe2\x59\x34\x9b\x22\x42\xa8\x38\xd0\xba\x07\xb3\xbd\xbb\x86\x79\x14\xea\x6a\x72\x15\x19\x42\xcc\x5a\xc9\xdb\xa8\xe6\xb7\x11\x5c\x66\x7b\xa6\x94\x54\x18\x3d\x2d\xea\x5a\x9a\x68\x59\x54\x55\x54\x44\xcb\xaa\xd0\x3a\x2a\x74\x54\xb4\x14\x22\x72\xa0\x6a\x80\x73\x78\x84\x3b\xa6\xd4\x74\xd5\x12\x79\x54\xe9\xb0\x75\x0e\xe9\x4a\x1c\x32\xe1\xf5\x76\xc3\x15\x04\x7d\xac\xff\xb2\xdf\x43\xfd\x0e\xbc\xed\x4a\x5c\x6f\x5d\xff\x68\x4a\xd1\x4d\x51\x6d\x39\x12\x75\x24\x6d\xb5\xf2\x56\x09\xe3\xfb\x08\xf5\x11\xb1\xbb\x21\xfb\x46\xc9\x86\x2b\x03\x07\xaa\x12\x82\x64\xf0\x52\xc7\x42\xf2\x7b\xa9\x6f\x96\x50\x05\x35\x5d\x7d\x99\x4a\x42\x21\x72\xc5\x86\x0a\x42\xcd\xe1\x80\x09\x6d\x86\x1c\xa5\xb2\x9d\x6d\x22\x51\x47\x7c\x71\x1f\x0e\x86\xee\x2c\xea\xa9\xa4\x1d\x91\xe9\x68\x4a\xfb\x14\xc2\x7b\xa0\xc8\x66\xc5\xa9\x2d\x45\x4b\x0a\x27\x58\x3c\x9c\x7a\x41\xd6\x7c\x5d\xef\xf7\x1d\x22\xbd\xca\x14\x95\x6c\x36\x97\x27\x31\xe4\x5c\x8e\xc7\x24\x0c\x12\x80\xaa\xe9\x05\x93\x32\xa7\x86\x78\xe8\x5d\x79\x7d\x5d\xe8\xcb\xdb\x3a\xd0\xe0\x2e\x02\x03\x2b\xe2\x18\xf3\x4c\x40\x66\x22\xf2\x36\xd4\xe2\x07\x5a\x72\x96\xd9\x03\xc7\x89\x0b\x79\xa9\x7d\xf1\x3f\x13\x08\x79\x6d\x52\xd4\xf6\x82\x03\x74\x2d\xae\xcf\xfa\xcd\xb6\x37\xc4\x32\xf6\xd7\x8f\x70\xde\x70\xf0\xd2\xb5\x86\x89\xe0\x4b\xfd\x10\x78\x0c\x2d\xae\x3b\xa7\x05\x67\x65\x88\x81\xff\x42\x68\xc5\xd9\xee\xf9\xcb\x17\x6f\x52\x04\xa5\x54\x44\x9f\xbe\xbc\x7c\xfa\xb7\x9f\x5f\xbc\x7d\x96\xa2\x65\x25\x97\x9f\x6e\x85\xe6\x88\x3e\xbd\x7c\xff\xfa\xdd\xb3\x9f\xfa\x9d\x72\x5b\x1b\xae\xba\x31\x07\xba\x3a\x6f\x0d\x06\xf7\x02\x36\x48\x5a\xa9\xfa\xe3\xd7\xfa\x7a\x47\xbc\x5f\xe7\xe9\xee\x30\x87\xab\xdf\x00\xc3\x10\x7a\x26\x63\xee\x23\xe0\x05\x03\x99\x0f\xd7\xe6\x28\xdb\xae\x7d\xea\x4f\x0e\x0e\x8c\x7b\x63\xd2\xe7\xe1\xee\x35\xb9\xb2\x87\x33\x6b\xa1\xc9\xb0\x5c\xc6\xdc\xa5\x2f\x93\x7c\xef\xe2\x6f\x4d\xd5\x20\x57\xdf\xf5\x4a\x89\x50\x87\x6f\x4b\xb8\xf0\x32\x28\x30\xa4\x59\x7e\xa0\xc3\xc4\x1e\xf2\x40\x99\xfc\xfe\xaf\x2d\x57\x77\x0b\xd8\x12\x53\xd9\x2f\xf7\xc1\xc5\x65\x11\xba\x05\x74\x8b\x01\x6e\x5d\xc1\x92\xed\x0e\xc1\x25\xb8\x83\xe2\x23\xa4\x7b\xa5\x4d\xd5\x3d\x93\x33\xa9\x34\x27\xbb\xfa\x14\x7e\xc6\xf3\x13\x46\x0c\x7a\xf7\xfb\xdd\xa1\x0f\x7a\xd1\x7b\xce\x38\x88\x13\xc2\xa6\x61\x95\x75\x70\xb4\x7d\x9e\xae\x7b\xe3\x31\x40\x06\x72\x69\x38\xf0\x3e\x8f\xef\xc3\x67\xde\x3e\xe5\x9e\x98\x90\x72\x1f\xe1\x76\xca\x18\x38\xb2\xea\xa5\xec\xd8\x24\xb2\x7e\x29\x8b\x92\xc4\x71\x78\xc4\x75\x2f\xe8\xaa\xbd\x10\x3b\xf4\xa8\xa1\xb5\x2b\xc2\xb5\xbc\x70\xca\xe7\x6f\xdc\x35\x6c\xc0\x84\x41\x29\x69\xde\xf8\x3a\xad\xc3\xe0\x7c\x85\xf7\x4c\x09\x8a\x35\x61\x27\x50\x1c\x1b\x9a\xed\xe0\xb4\x1d\xb9\x65\x11\x75\x6e\xba\x25\xb1\xe5\xce\x6b\xef\x01\xc1\x1c\x0e\x07\xea\x26\x95\x4e\xcd\xef\x9f\xf5\xe6\xdc\xac\x73\xf8\xde\x0f\xe2\xc5\xd9\x85\x85\xfe\x4f\x60\xfc\x34\x80\x91\xc3\x76\x86\x5b\xdf\xbd\xe2\xc9\x7b\x23\x2a\xcd\xfa\x27\x0c\xed\x37\x28\x2e\x4a\x5a\x5c\x57\xf2\xaa\xa8\x7c\x9d\x9a\xf8\x4f\x4f\xec\x34\xba\xea\x05\xdf\x9a\x95\x1c\x1a\x82\x41\xb0\x5d\xdb\xd5\x46\xb3\x74\x20\x0d\xd8\xf4\x8e\xca\xef\xe0\x26\xc2\xe1\x4e\x9f\x98\x03\x0d\x87\x44\xc3\xd6\x56\x43\xd3\x9d\x5e\x8b\x95\x49\x77\x56\x81\xd3\xd9\x74\x4a\xbd\x76\xc2\x2a\xab\xfa\x4c\xec\xd6\x4f\x1c\x24\x33\xc3\x9c\x80\x8a\x61\xcb\xcc\x16\x33\x5a\x6f\xde\xa5\x19\x8a\xf5\xd5\xbc\x61\xad\xa2\xeb\x90\x9b\xb7\xdb\x15\x6c\x7b\x5d\x8e\x66\xef\x3a\xea\xe1\x49\x7d\xc1\xf4\xc9\xa1\x3c\xad\xd8\xce\x6e\x61\x69\x63\x5d\x4e\x49\x55\x56\xe6\x84\xf2\xba\x1c\xb4\x8c\x55\x56\xe4\x93\x26\x2b\x72\xd2\x3f\xf3\xf4\xbe\x13\x3c\xc4\x81\x36\xb4\xca\x44\xde\x86\x43\xfc\x70\xf0\x27\xa5\x81\x6f\x5f\x9f\xf0\xed\xaf\x61\xc4\xf4\x40\x1b\xa8\x14\xd5\xe6\xd2\x7f\xc0\x12\x26\xfd\xe5\x01\x66\xb7\x49\x1b\x33\xa7\x67\x9b\xee\xcb\x8d\x10\x93\x7a\x54\xc9\xbc\xd7\xd4\x72\xd6\x55\x09\xb1\x64\x0a\x43\xf5\x0a\x40\x0a\x76\x77\x3a\x9b\x9e\x9b\x4c\x4d\x38\x29\x85\xdb\x30\x7d\x44\x98\x98\xfb\xed\x39\x69\x94\x90\x4a\x98\x3b\xda\x74\xd2\x0d\x40\x35\xdb\x35\x4a\x6c\x0a\x75\x77\xa2\x47\x92\x35\x19\x6f\x53\x48\x78\x7e\x2c\x32\x9e\xc7\xf1\xc8\x24\x5c\x2f\x8b\x86\xff\x2c\xcc\xfa\xa7\x80\x8a\xa5\xe1\x47\x0c\xe3\x28\x8c\x23\x10\x59\x82\x68\xe0\xae\x2c\xc4\x7a\x4b\x59\x97\xe7\xd7\xe9\xd2\x4b\x3e\xd4\x9a\x9a\x35\x99\x1c\xa0\xf0\xed\x17\x50\xa8\xd9\x6f\x18\xe6\x58\x14\x26\xb8\x0f\xd9\x5f\xd8\x6a\x6f\x5d\x91\x16\x43\x49\x6b\x72\xe8\xae\xde\x9c\xdd\x2c\x01\x53\xe3\x0a\x2e\x99\xc3\xf1\x58\xed\x39\x59\xa0\x96\x4a\x94\x22\xcf\x57\x34\x6f\xfc\x96\xda\x50\x9d\x99\xdc\x9e\xec\x9e\x9e\x3b\xdb\x2c\x97\x06\x51\xa5\xed\x1a\x21\x66\x84\xb5\xda\x00\x31\xa7\x5e\xea\xe9\x37\xf4\x44\xf7\xd2\xe1\x1d\xc9\x03\xfd\xc4\x79\xf3\x4e\x5e\x73\xb3\xe6\x2a\x68\xf6\xff\xf7\x87\xdc\x88\x47\xd1\x3a\x11\xaf\x30\xe0\x3d\x3a\xf5\x1b\x14\x29\x8e\xfc\x8c\x62\xbf\xd0\x26\xb8\x8a\x21\xfa\x2d\xcf\xec\x6d\xbd\x66\xe1\xc5\xd4\x79\xd3\x92\x35\xc7\xfe\xa3\xb9\xf7\x52\x8f\xcc\x74\xfe\x58\x61\xb8\xca\x03\xc5\x97\x63\xce\x66\x65\xce\x7c\xef\x44\x82\x13\xa1\x32\x2b\xf3\x6f\xa1\x49\x3f\x38\x41\xe7\x84\x50\xf0\x24\xf6\x6e\x46\xe0\xdc\x37\x0f\xf9\x84\x70\x9c\x00\x2e\x75\xf4\xbc\x6f\xc4\xad\x3f\xa7\xc8\x82\x43\x14\xf5\xe5\x72\x7c\xa1\xb1\x86\xcc\xd1\x7f\x59\x05\x75\x5e\xf8\xe8\xb1\xbe\xee\x76\xb0\xda\x5e\x36\xa9\x59\x6f\x05\x7f\xb2\x6b\xa3\xc8\xb7\xbc\xe2\x4b\x23\x15\xae\x09\x1d\xd5\x1d\x68\x77\x25\x14\x90\x3b\x9d\x78\xe6\x13\xa8\x61\x49\xf3\xe7\x27\x3f\xbd\x7e\xf1\xfa\xaf\x69\xf4\xd1\x52\x10\xf0\xfb\x18\x6d\xb6\x1a\xce\x12\xa2\x25\x9c\x21\x47\x72\x15\x09\xa3\x23\x87\x4e\xe4\x07\x8d\xe0\x04\xc8\x1f\x88\xde\xaf\x32\x3d\x07\x05\x7a\xe1\x31\xa3\x25\x6b\x7a\x3a\x57\x04\x9d\x1a\x58\x48\xa7\x53\x8a\xd0\x8a\x15\xa7\xe5\xc8\x15\x2b\x06\x1f\xaf\xd8\xab\xb8\x46\xbe\x94\xb7\xe1\x26\x03\x5d\xb3\x62\xa8\x76\xd7\xd0\xe0\x95\xb2\xbd\x77\xb7\x65\x2f\x71\x4d\xb2\x2a\x9f\x97\xd9\x75\x3e\xd9\x3e\xd6\xd9\x26\x3f\xa7\x47\x9b\x7c\xc2\xa0\x6f\x82\xdd\x40\x42\x68\x99\x6d\xf2\xf1\xf6\x5b\x9d\x5d\xdf\x33\x63\xcc\xdc\x90\x09\x0c\x39\xe3\x26\x96\x27\x93\xdc\x9e\x71\xe5\xe6\x95\x59\x05\xd7\xda\xb6\x17\x5f\xd3\x5b\xf8\xe0\xe1\x58\xca\x84\xde\xf5\xef\x31\xde\x76\x5f\xef\xac\xec\xd7\x46\xf4\xd9\x51\x77\xf8\x28\x69\x35\xf8\x28\x89\xde\xb0\xab\xc9\x31\x22\x40\xe9\xdd\xe4\x59\x30\xcb\x1b\xf6\x23\xfe\x0d\xeb\xac\xca\x27\x5b\x7a\x43\xe0\x33\x3d\xb8\x7a\xaa\xe4\xad\x77\x54\xac\xee\x91\x67\x3b\x18\x16\x90\xe1\x34\x1c\x0b\xba\xa1\xb6\xa2\xa7\xc0\xbd\xe1\x1b\xe7\xab\x05\x5d\x53\x84\x08\xd4\x2e\xf8\x81\x7a\xd5\x4a\x51\xf6\x79\x62\xa7\xe7\xe8\x40\x21\xe5\x0d\xa6\xfa\xff\x3f\x64\xaa\xae\x74\xf4\xe9\x1e\x1b\xb5\x17\xf3\xfb\x46\x09\xdf\x0c\x26\xfe\x1e\x56\x1c\xf7\x54\x18\xea\xbb\xa7\x77\x94\xba\x89\x20\x1c\xf9\xbf\xd9\xd0\xcf\x84\x15\x70\x04\x76\xbf\xf5\xd4\xec\x33\x16\x70\x52\x75\xcf\x90\x59\xbe\xdf\x23\x28\xcb\x67\x79\x38\x93\x32\xc9\x15\x5f\x17\x37\x42\x2a\x77\x38\x15\x55\x3c\xb1\xa5\x83\x86\x65\x82\xd6\x79\xef\x90\x0a\xba\xba\x62\x41\xc3\xfe\x85\x05\x39\xee\x3e\x2e\x29\xd8\x51\x74\x34\x0d\x03\xc3\xd9\x56\xc3\xba\x85\xe7\x21\x66\x6b\x4e\xf7\x5d\x28\x51\x83\x94\xc4\x88\x31\xbd\xdf\x37\xbe\xb0\xc0\x18\x2b\xc7\xb3\x9e\x6c\xbe\xc8\x14\x6b\x22\x05\x3b\xd6\xd8\xde\x67\x44\xfd\x63\xf8\x15\xfb\x85\x6e\x58\x5b\x1a\x17\x71\xbc\xc2\x85\xbb\x15\x4e\xbe\x5d\x61\x77\xbb\x9e\xec\xf7\xde\x21\x74\x43\x6c\xfb\x63\x18\x61\x7b\x60\x88\x2f\x89\xfb\x01\xce\x95\x38\x20\x46\x36\x30\xc0\x7b\x97\x6e\x0c\xb4\x5b\x18\x7e\x30\x5d\xb3\x3e\x6c\xf7\xad\x07\x81\xaf\x07\xfa\x48\xf9\xef\xb4\x08\xbd\x66\x3d\x20\xf6\x8b\x11\x42\xb7\x6c\xb8\x78\xf8\x7c\x8b\xd0\xab\x01\x99\xeb\x23\x9a\x96\x03\x02\xae\x8f\xb1\xdd\xd2\xdb\x2f\xec\xef\x02\xfc\xcd\x68\x64\xac\xe5\xfc\xa3\x50\xc2\xd6\x6b\x74\x1c\xe3\x5b\xf7\xdd\xb5\xff\xd2\x23\x8e\xd7\xfb\x3d\x34\xf9\x33\x66\x15\xc7\xcb\xfd\x7e\x74\x3c\xe8\xda\xb7\x75\xa3\xb6\x64\x8e\x37\xfb\xfd\xd5\x7e\x7f\xe7\xb6\x72\x6f\xa2\x50\xa4\xb6\x1d\xd0\x2a\x58\x93\x95\x63\x38\x53\xb8\x8b\x63\xac\xd8\xdf\xb0\x22\xc3\x0b\x90\x62\x8c\x95\xbd\x75\xae\xe0\xd6\xf9\xa9\xd7\xf5\x5f\x04\x1e\x35\xd3\xb7\x67\xad\xfa\x54\xa1\x7a\x4b\xc1\xc2\xec\xe9\x79\xaf\xc3\xa9\xab\xd8\xb9\x00\xf1\x40\x83\x89\x84\x42\xde\x83\x41\x5f\xfb\xe5\xcc\x81\x5a\xe7\x15\x9c\xe0\xff\xf4\x9d\xe0\xec\xbe\x48\xef\xe1\x84\xb1\xa5\x89\xd6\x4c\x04\x42\xe1\x8f\x01\x74\x24\xb6\x91\xde\x3d\xbb\xb2\x84\x48\xcf\x87\xcf\x4e\x59\x4e\x6e\x8d\x84\x8d\xa3\xce\x86\xc1\x5f\xce\xe0\xcb\xe2\x09\xd6\x8b\x3a\x3b\x8d\x02\xe1\xb3\xd2\x81\x2c\x3f\xc3\x17\x54\x27\x12\x5c\x42\x28\x04\xc1\xdc\x5a\x94\x3c\xf0\xe6\xff\x3c\xb0\x41\x90\xdd\x43\x31\x1c\x40\x41\x14\x1d\xe5\x8d\xc7\x51\x9c\x61\xe7\xb4\x41\xb2\x77\xe7\xa1\xf6\x17\xf7\x27\xa3\xc7\x0b\xd8\xdd\x06\x0a\x62\x07\xd2\xdb\x1e\x20\xdc\x0c\xdf\x9d\x3c\xb6\x36\xbf\xdf\xc3\x25\xcc\x95\xf9\xd6\xfb\x05\x78\x37\xb2\xf9\x36\x58\x3e\xbc\xdb\x8e\xc7\xde\x9f\x58\x6a\xe1\x0e\x03\x4f\x80\xb6\x8e\x0e\xf7\x0e\xf6\xc4\x93\x22\x5c\xa8\xd1\x19\xfa\x3c\x91\x5b\x33\x91\xab\x49\x87\x06\x82\x2b\x8a\x36\xc4\xb4\xd0\x66\x0f\x40\x9b\xfd\x21\x68\xa3\x59\x3f\xa1\x87\xbf\x2c\xb0\x35\xdc\xde\xd6\x6c\x25\xf8\xcd\x1f\xcb\xd0\x3f\xdb\xb2\xc7\x1d\xad\xd9\xb1\x66\xd0\xe6\x3f\x10\x47\xef\xba\x68\x5f\x12\xd7\xcd\xf6\xc9\x72\xc9\x2b\xae\xac\x7f\x9b\xb7\x75\xef\x26\x8e\xef\x8b\xa1\x8f\xe6\x7c\x8c\x5c\x3d\x30\x82\xaa\x51\x09\xf7\x74\x3e\xf6\xe9\xfd\xd8\x5d\xee\x29\xea\x32\xba\x15\x55\x15\xc1\x61\x96\xbd\xd7\xd3\x80\xdd\xf3\x12\x8e\x40\x56\x5b\x03\xd7\x7c\xe0\xda\x0b\x38\x5a\x08\xc6\x5d\x59\x2b\xf9\x5d\x87\x8b\xf3\x9a\x96\xb4\xe8\xae\xf6\x37\x0b\x73\x4c\x40\xda\xd0\x8a\x9d\xab\x60\xd0\x15\xbb\xc6\x15\x7c\xc5\xb6\x0b\x77\x5a\xd3\xba\xbd\x32\x7d\xa0\x6b\xff\x89\xca\x2f\xb8\xf6\xdb\x14\x7c\x8a\x02\x6f\x76\x1f\xf2\x1f\xa9\xc0\x7b\xd8\x80\xac\x12\xda\x16\xfb\x44\x0e\x74\xc9\x7a\xbb\x8c\x0c\x5f\x80\xf8\x26\xba\xed\x55\x0b\x44\xeb\x26\x7c\x64\x7e\xc5\x86\x57\x66\xc1\x2e\xca\x1e\xb8\xe5\x62\x12\xbe\xbe\x1b\x87\xcf\x4f\xd3\x35\xd8\x0b\xd5\x1d\xdc\x2d\x8c\xb2\xb9\xe6\xd8\x7f\x89\x9a\xae\x2d\x35\xb4\x88\xe3\x2b\xb2\xc9\xae\x72\xe6\x56\xa9\x0a\xc3\xff\x52\x62\x34\xd6\x63\xd4\x7c\xa6\x11\x1a\x97\xee\x61\x4a\x10\xdd\x64\xcb\x9c\x4d\xe9\x26\xdb\xda\x9f\x04\x64\xf6\x74\x0d\x7f\xc0\xc2\xcf\xb6\x38\x76\x1f\x37\xde\x0e\x11\x9d\xa5\x33\x7a\x37\x40\x6a\x96\xce\xe6\x16\x68\xf9\xe8\xd6\x81\xd5\x8f\xee\x86\x80\x97\x63\x04\x58\x6c\xed\xc1\xf2\x33\xb6\xfb\xaf\xde\x9d\xe5\xff\x4a\x7b\x5e\xb2\xad\x76\xf4\xcd\xd0\x6f\x73\xcf\x06\xb6\xd9\xde\xdc\x0b\xdd\x9b\xb6\xa1\x0d\xe8\xdf\xf6\xfb\x3b\x03\xb3\x7d\xc3\x31\xe0\x83\xe9\xb1\xb6\x8d\xa6\xf4\x73\x27\xe1\xbb\x20\xce\x03\xed\xcc\x2d\xd8\xfb\xff\x7d\xd0\x63\x7b\x9a\x7e\x3d\xd5\xdc\x1e\xce\xbf\x9f\xed\x1d\x12\xdc\x4f\x53\xe2\xb8\x7f\x7e\x31\xa4\xa6\x3d\xe9\xfa\x15\x0f\x27\x9d\xa1\xda\x9d\x1d\x0c\xfc\xd3\xf0\x23\xc9\x4b\x0c\x37\x4f\x38\xdc\x6c\xba\xc1\xb2\x93\x15\x55\xd0\x4c\xe5\x97\xbf\xa8\x38\x19\xe2\x23\x86\xb6\x2c\x6e\x86\xd7\x22\x07\x57\xda\x69\x43\xe8\xaf\xd8\xd0\xce\xb2\xbb\x2f\x20\xec\xa7\x2e\x27\x72\x73\x2e\xe4\x70\x80\x73\xc2\x03\x99\x7f\x75\x71\xf1\xdf\x91\xfb\x2b\x53\xaf\x8a\xa6\x11\xf5\xf5\xfb\x9f\x5e\x32\x5f\x5d\xd8\x88\x3a\xf9\x5d\x27\x9b\xa2\xf9\xea\xff\x0d\x00\x10\xa0\xcc\x28\xf4\x4a\x00\x00")
 
 func libPopper1129MinJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -310,11 +310,13 @@ var _bindata = map[string]func() (*asset, error){
 // directory embedded in the file by go-bindata.
 // For example if you run go-bindata on data/... and data contains the
 // following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
+//
+//	data/
+//	  foo.txt
+//	  img/
+//	    a.png
+//	    b.png
+//
 // then AssetDir("data") would return []string{"foo.txt", "img"}
 // AssetDir("data/img") would return []string{"a.png", "b.png"}
 // AssetDir("foo.txt") and AssetDir("notexist") would return an error
@@ -0,0 +1,349 @@
+package portal
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/validate"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+)
+
+const (
+	// clusterLiveTimeout bounds the time spent fetching live status from a
+	// cluster, which may well be unhealthy
+	clusterLiveTimeout = 10 * time.Second
+
+	maxClusterAsyncOperations = 10
+)
+
+type clusterDetail struct {
+	ResourceID string `json:"resourceId"`
+	Name       string `json:"name"`
+	Location   string `json:"location"`
+
+	ProvisioningState       string    `json:"provisioningState"`
+	LastProvisioningState   string    `json:"lastProvisioningState,omitempty"`
+	FailedProvisioningState string    `json:"failedProvisioningState,omitempty"`
+	LastAdminUpdateError    string    `json:"lastAdminUpdateError,omitempty"`
+	CreatedAt               time.Time `json:"createdAt,omitempty"`
+
+	Version         string `json:"version,omitempty"`
+	Domain          string `json:"domain,omitempty"`
+	ResourceGroupID string `json:"resourceGroupId,omitempty"`
+	ConsoleURL      string `json:"consoleUrl,omitempty"`
+
+	APIServerVisibility string `json:"apiServerVisibility,omitempty"`
+	APIServerURL        string `json:"apiServerUrl,omitempty"`
+	APIServerIP         string `json:"apiServerIp,omitempty"`
+
+	PodCIDR     string `json:"podCidr,omitempty"`
+	ServiceCIDR string `json:"serviceCidr,omitempty"`
+
+	MasterVMSize   string `json:"masterVmSize,omitempty"`
+	MasterSubnetID string `json:"masterSubnetId,omitempty"`
+
+	WorkerProfiles  []clusterWorkerProfile  `json:"workerProfiles"`
+	IngressProfiles []clusterIngressProfile `json:"ingressProfiles"`
+
+	ClusterOperators      []clusterOperator `json:"clusterOperators"`
+	ClusterOperatorsError string            `json:"clusterOperatorsError,omitempty"`
+
+	Nodes      []clusterNode `json:"nodes"`
+	NodesError string        `json:"nodesError,omitempty"`
+
+	AsyncOperations []clusterAsyncOperation `json:"asyncOperations"`
+}
+
+type clusterWorkerProfile struct {
+	Name       string `json:"name"`
+	VMSize     string `json:"vmSize"`
+	DiskSizeGB int    `json:"diskSizeGB"`
+	SubnetID   string `json:"subnetId"`
+	Count      int    `json:"count"`
+}
+
+type clusterIngressProfile struct {
+	Name       string `json:"name"`
+	Visibility string `json:"visibility"`
+	IP         string `json:"ip"`
+}
+
+type clusterOperator struct {
+	Name        string `json:"name"`
+	Version     string `json:"version,omitempty"`
+	Available   string `json:"available"`
+	Progressing string `json:"progressing"`
+	Degraded    string `json:"degraded"`
+	Message     string `json:"message,omitempty"`
+}
+
+type clusterNode struct {
+	Name           string `json:"name"`
+	Roles          string `json:"roles"`
+	Ready          string `json:"ready"`
+	KubeletVersion string `json:"kubeletVersion,omitempty"`
+	InternalIP     string `json:"internalIp,omitempty"`
+	Unschedulable  bool   `json:"unschedulable,omitempty"`
+}
+
+type clusterAsyncOperation struct {
+	ID            string     `json:"id"`
+	InitialStatus string     `json:"initialStatus"`
+	Status        string     `json:"status"`
+	StartTime     time.Time  `json:"startTime"`
+	EndTime       *time.Time `json:"endTime,omitempty"`
+	Error         string     `json:"error,omitempty"`
+}
+
+// cluster returns the details of a cluster: the fields of its document which
+// are useful to an SRE (but no secrets), the live status of its
+// ClusterOperators and nodes and its recent asynchronous operations.  Live
+// status is best effort, as the cluster may not be reachable.
+func (p *portal) cluster(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resourceID := strings.TrimPrefix(r.URL.Path, "/api/clusters")
+	if !validate.RxClusterID.MatchString(resourceID) {
+		http.Error(w, fmt.Sprintf("invalid resourceId %q", resourceID), http.StatusBadRequest)
+		return
+	}
+
+	doc, err := p.dbOpenShiftClusters.Get(ctx, strings.ToLower(resourceID))
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	case err != nil:
+		p.internalServerError(w, err)
+		return
+	}
+
+	oc := doc.OpenShiftCluster
+
+	detail := &clusterDetail{
+		ResourceID: oc.ID,
+		Name:       oc.Name,
+		Location:   oc.Location,
+
+		ProvisioningState:       string(oc.Properties.ProvisioningState),
+		LastProvisioningState:   string(oc.Properties.LastProvisioningState),
+		FailedProvisioningState: string(oc.Properties.FailedProvisioningState),
+		LastAdminUpdateError:    oc.Properties.LastAdminUpdateError,
+		CreatedAt:               oc.Properties.CreatedAt,
+
+		Version:         oc.Properties.ClusterProfile.Version,
+		Domain:          oc.Properties.ClusterProfile.Domain,
+		ResourceGroupID: oc.Properties.ClusterProfile.ResourceGroupID,
+		ConsoleURL:      oc.Properties.ConsoleProfile.URL,
+
+		APIServerVisibility: string(oc.Properties.APIServerProfile.Visibility),
+		APIServerURL:        oc.Properties.APIServerProfile.URL,
+		APIServerIP:         oc.Properties.APIServerProfile.IP,
+
+		PodCIDR:     oc.Properties.NetworkProfile.PodCIDR,
+		ServiceCIDR: oc.Properties.NetworkProfile.ServiceCIDR,
+
+		MasterVMSize:   string(oc.Properties.MasterProfile.VMSize),
+		MasterSubnetID: oc.Properties.MasterProfile.SubnetID,
+
+		WorkerProfiles:   []clusterWorkerProfile{},
+		IngressProfiles:  []clusterIngressProfile{},
+		ClusterOperators: []clusterOperator{},
+		Nodes:            []clusterNode{},
+		AsyncOperations:  []clusterAsyncOperation{},
+	}
+
+	for _, wp := range oc.Properties.WorkerProfiles {
+		detail.WorkerProfiles = append(detail.WorkerProfiles, clusterWorkerProfile{
+			Name:       wp.Name,
+			VMSize:     string(wp.VMSize),
+			DiskSizeGB: wp.DiskSizeGB,
+			SubnetID:   wp.SubnetID,
+			Count:      wp.Count,
+		})
+	}
+
+	for _, ip := range oc.Properties.IngressProfiles {
+		detail.IngressProfiles = append(detail.IngressProfiles, clusterIngressProfile{
+			Name:       ip.Name,
+			Visibility: string(ip.Visibility),
+			IP:         ip.IP,
+		})
+	}
+
+	asyncOperations, err := p.dbAsyncOperations.ListByClusterKey(ctx, doc.Key)
+	if err != nil {
+		p.internalServerError(w, err)
+		return
+	}
+
+	sort.Slice(asyncOperations.AsyncOperationDocuments, func(i, j int) bool {
+		return asyncOperations.AsyncOperationDocuments[i].AsyncOperation.StartTime.After(asyncOperations.AsyncOperationDocuments[j].AsyncOperation.StartTime)
+	})
+
+	for i, doc := range asyncOperations.AsyncOperationDocuments {
+		if i == maxClusterAsyncOperations {
+			break
+		}
+
+		operation := clusterAsyncOperation{
+			ID:            doc.AsyncOperation.ID,
+			InitialStatus: string(doc.AsyncOperation.InitialProvisioningState),
+			Status:        string(doc.AsyncOperation.ProvisioningState),
+			StartTime:     doc.AsyncOperation.StartTime,
+			EndTime:       doc.AsyncOperation.EndTime,
+		}
+		if doc.AsyncOperation.Error != nil {
+			operation.Error = doc.AsyncOperation.Error.Code + ": " + doc.AsyncOperation.Error.Message
+		}
+
+		detail.AsyncOperations = append(detail.AsyncOperations, operation)
+	}
+
+	liveCtx, cancel := context.WithTimeout(ctx, clusterLiveTimeout)
+	defer cancel()
+
+	detail.ClusterOperators, err = p.clusterOperators(liveCtx, oc)
+	if err != nil {
+		detail.ClusterOperatorsError = err.Error()
+	}
+
+	detail.Nodes, err = p.clusterNodes(liveCtx, oc)
+	if err != nil {
+		detail.NodesError = err.Error()
+	}
+
+	b, err := json.MarshalIndent(detail, "", "    ")
+	if err != nil {
+		p.internalServerError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(b)
+}
+
+func (p *portal) clusterOperators(ctx context.Context, oc *api.OpenShiftCluster) ([]clusterOperator, error) {
+	configcli, err := p.newConfig(oc)
+	if err != nil {
+		return []clusterOperator{}, err
+	}
+
+	cos, err := configcli.ConfigV1().ClusterOperators().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return []clusterOperator{}, err
+	}
+
+	operators := make([]clusterOperator, 0, len(cos.Items))
+	for _, co := range cos.Items {
+		operator := clusterOperator{
+			Name:        co.Name,
+			Available:   string(configv1.ConditionUnknown),
+			Progressing: string(configv1.ConditionUnknown),
+			Degraded:    string(configv1.ConditionUnknown),
+		}
+
+		for _, v := range co.Status.Versions {
+			if v.Name == "operator" {
+				operator.Version = v.Version
+			}
+		}
+
+		// report the message of the most significant abnormal condition
+		var available, progressing, degraded string
+		for _, c := range co.Status.Conditions {
+			switch c.Type {
+			case configv1.OperatorAvailable:
+				operator.Available = string(c.Status)
+				if c.Status != configv1.ConditionTrue {
+					available = c.Message
+				}
+			case configv1.OperatorProgressing:
+				operator.Progressing = string(c.Status)
+				if c.Status == configv1.ConditionTrue {
+					progressing = c.Message
+				}
+			case configv1.OperatorDegraded:
+				operator.Degraded = string(c.Status)
+				if c.Status == configv1.ConditionTrue {
+					degraded = c.Message
+				}
+			}
+		}
+
+		for _, message := range []string{available, degraded, progressing} {
+			if message != "" {
+				operator.Message = message
+				break
+			}
+		}
+
+		operators = append(operators, operator)
+	}
+
+	sort.Slice(operators, func(i, j int) bool { return operators[i].Name < operators[j].Name })
+
+	return operators, nil
+}
+
+func (p *portal) clusterNodes(ctx context.Context, oc *api.OpenShiftCluster) ([]clusterNode, error) {
+	cli, err := p.newKubernetes(oc)
+	if err != nil {
+		return []clusterNode{}, err
+	}
+
+	ns, err := cli.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return []clusterNode{}, err
+	}
+
+	nodes := make([]clusterNode, 0, len(ns.Items))
+	for _, n := range ns.Items {
+		node := clusterNode{
+			Name:           n.Name,
+			Ready:          string(corev1.ConditionUnknown),
+			KubeletVersion: n.Status.NodeInfo.KubeletVersion,
+			Unschedulable:  n.Spec.Unschedulable,
+		}
+
+		var roles []string
+		for label := range n.Labels {
+			if strings.HasPrefix(label, "node-role.kubernetes.io/") {
+				roles = append(roles, strings.TrimPrefix(label, "node-role.kubernetes.io/"))
+			}
+		}
+		sort.Strings(roles)
+		node.Roles = strings.Join(roles, ",")
+
+		for _, c := range n.Status.Conditions {
+			if c.Type == corev1.NodeReady {
+				node.Ready = string(c.Status)
+			}
+		}
+
+		for _, address := range n.Status.Addresses {
+			if address.Type == corev1.NodeInternalIP {
+				node.InternalIP = address.Address
+			}
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+
+	return nodes, nil
+}
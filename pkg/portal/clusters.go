@@ -0,0 +1,263 @@
+package portal
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/gofrs/uuid"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+)
+
+const (
+	defaultClustersPageSize = 50
+	maxClustersPageSize     = 200
+)
+
+var rxResourceGroupName = regexp.MustCompile(`(?i)^[-a-z0-9_().]{0,89}[-a-z0-9_()]$`)
+
+type clusterSummary struct {
+	ResourceID              string            `json:"resourceId"`
+	Name                    string            `json:"name"`
+	SubscriptionID          string            `json:"subscriptionId"`
+	ResourceGroup           string            `json:"resourceGroup"`
+	Location                string            `json:"location"`
+	Version                 string            `json:"version,omitempty"`
+	ProvisioningState       string            `json:"provisioningState"`
+	FailedProvisioningState string            `json:"failedProvisioningState,omitempty"`
+	Tags                    map[string]string `json:"tags,omitempty"`
+}
+
+type clusterSearchResult struct {
+	Clusters []*clusterSummary `json:"clusters"`
+	NextPage string            `json:"nextPage,omitempty"`
+}
+
+// clusterSearch is a parsed cluster search request
+type clusterSearch struct {
+	partitionKey string
+	q            *database.OpenShiftClustersQuery
+	pageSize     int
+	continuation string
+
+	// hideUnusable is set if clusters which are being created or deleted, or
+	// which failed to be, should not be returned
+	hideUnusable bool
+}
+
+// parseClusterSearch parses the query parameters of a cluster search.  All
+// filtering is done by the database; the errors returned are suitable for
+// returning to the user.
+func parseClusterSearch(query url.Values) (*clusterSearch, error) {
+	s := &clusterSearch{
+		q:        &database.OpenShiftClustersQuery{},
+		pageSize: defaultClustersPageSize,
+	}
+
+	if subscriptionID := strings.TrimSpace(query.Get("subscriptionId")); subscriptionID != "" {
+		if _, err := uuid.FromString(subscriptionID); err != nil {
+			return nil, fmt.Errorf("invalid subscriptionId %q", subscriptionID)
+		}
+
+		s.partitionKey = strings.ToLower(subscriptionID)
+		s.q.Prefix = "/subscriptions/" + s.partitionKey + "/"
+	}
+
+	if resourceGroup := strings.TrimSpace(query.Get("resourceGroup")); resourceGroup != "" {
+		if s.partitionKey == "" {
+			return nil, fmt.Errorf("resourceGroup requires subscriptionId")
+		}
+
+		if !rxResourceGroupName.MatchString(resourceGroup) {
+			return nil, fmt.Errorf("invalid resourceGroup %q", resourceGroup)
+		}
+
+		s.q.Prefix += "resourcegroups/" + strings.ToLower(resourceGroup) + "/"
+	}
+
+	if name := strings.TrimSpace(query.Get("name")); name != "" {
+		s.q.Conditions = append(s.q.Conditions, database.OpenShiftClustersQueryCondition{
+			Field:    "name",
+			Operator: database.OpenShiftClustersQueryOperatorContains,
+			Value:    name,
+		})
+	}
+
+	if version := strings.TrimSpace(query.Get("version")); version != "" {
+		s.q.Conditions = append(s.q.Conditions, database.OpenShiftClustersQueryCondition{
+			Field:    "version",
+			Operator: database.OpenShiftClustersQueryOperatorStartsWith,
+			Value:    version,
+		})
+	}
+
+	if state := strings.TrimSpace(query.Get("provisioningState")); state != "" {
+		var ps api.ProvisioningState
+		for _, known := range []api.ProvisioningState{
+			api.ProvisioningStateCreating,
+			api.ProvisioningStateUpdating,
+			api.ProvisioningStateAdminUpdating,
+			api.ProvisioningStateDeleting,
+			api.ProvisioningStateSucceeded,
+			api.ProvisioningStateFailed,
+		} {
+			if strings.EqualFold(state, string(known)) {
+				ps = known
+			}
+		}
+		if ps == "" {
+			return nil, fmt.Errorf("invalid provisioningState %q", state)
+		}
+
+		s.q.Conditions = append(s.q.Conditions, database.OpenShiftClustersQueryCondition{
+			Field:    "provisioningState",
+			Operator: database.OpenShiftClustersQueryOperatorEq,
+			Value:    string(ps),
+		})
+
+	} else {
+		// by default, don't offer clusters which are being created or deleted:
+		// the portal can't be used on them
+		s.hideUnusable = true
+		for _, ps := range []api.ProvisioningState{
+			api.ProvisioningStateCreating,
+			api.ProvisioningStateDeleting,
+		} {
+			s.q.Conditions = append(s.q.Conditions, database.OpenShiftClustersQueryCondition{
+				Field:    "provisioningState",
+				Operator: database.OpenShiftClustersQueryOperatorNe,
+				Value:    string(ps),
+			})
+		}
+	}
+
+	for _, tag := range query["tag"] {
+		parts := strings.SplitN(tag, "=", 2)
+		if len(parts) != 2 || !database.IsValidOpenShiftClustersQueryField("tags/"+parts[0]) {
+			return nil, fmt.Errorf("invalid tag %q: must be name=value", tag)
+		}
+
+		s.q.Conditions = append(s.q.Conditions, database.OpenShiftClustersQueryCondition{
+			Field:    "tags/" + parts[0],
+			Operator: database.OpenShiftClustersQueryOperatorEq,
+			Value:    parts[1],
+		})
+	}
+
+	if pageSize := query.Get("pageSize"); pageSize != "" {
+		var err error
+		s.pageSize, err = strconv.Atoi(pageSize)
+		if err != nil || s.pageSize < 1 || s.pageSize > maxClustersPageSize {
+			return nil, fmt.Errorf("invalid pageSize %q: must be between 1 and %d", pageSize, maxClustersPageSize)
+		}
+	}
+
+	if page := query.Get("page"); page != "" {
+		b, err := base64.RawURLEncoding.DecodeString(page)
+		if err != nil {
+			return nil, fmt.Errorf("invalid page")
+		}
+		s.continuation = string(b)
+	}
+
+	return s, nil
+}
+
+// clusters returns a page of the clusters matching the search given in the
+// query parameters: name (substring, case-insensitive), subscriptionId,
+// resourceGroup, version (prefix), provisioningState and tag (name=value, may
+// be repeated).  The nextPage token in the result is passed back as the page
+// parameter, together with the same search, to fetch the next page.
+func (p *portal) clusters(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	s, err := parseClusterSearch(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	i, err := p.dbOpenShiftClusters.ListByQuery(s.partitionKey, s.q, s.continuation)
+	if err != nil {
+		p.internalServerError(w, err)
+		return
+	}
+
+	docs, err := i.Next(ctx, s.pageSize)
+	if err != nil {
+		p.internalServerError(w, err)
+		return
+	}
+
+	result := &clusterSearchResult{
+		Clusters: []*clusterSummary{},
+	}
+
+	if continuation := i.Continuation(); continuation != "" {
+		result.NextPage = base64.RawURLEncoding.EncodeToString([]byte(continuation))
+	}
+
+	if docs != nil {
+		for _, doc := range docs.OpenShiftClusterDocuments {
+			ps := doc.OpenShiftCluster.Properties.ProvisioningState
+			fps := doc.OpenShiftCluster.Properties.FailedProvisioningState
+
+			// failed creations and deletions can't be filtered out by the
+			// database, so pages may be short
+			if s.hideUnusable && ps == api.ProvisioningStateFailed &&
+				(fps == api.ProvisioningStateCreating ||
+					fps == api.ProvisioningStateDeleting) {
+				continue
+			}
+
+			result.Clusters = append(result.Clusters, newClusterSummary(doc.OpenShiftCluster))
+		}
+	}
+
+	sort.Slice(result.Clusters, func(i, j int) bool {
+		return result.Clusters[i].ResourceID < result.Clusters[j].ResourceID
+	})
+
+	b, err := json.MarshalIndent(result, "", "    ")
+	if err != nil {
+		p.internalServerError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(b)
+}
+
+func newClusterSummary(oc *api.OpenShiftCluster) *clusterSummary {
+	cs := &clusterSummary{
+		ResourceID:              oc.ID,
+		Name:                    oc.Name,
+		Location:                oc.Location,
+		Version:                 oc.Properties.ClusterProfile.Version,
+		ProvisioningState:       string(oc.Properties.ProvisioningState),
+		FailedProvisioningState: string(oc.Properties.FailedProvisioningState),
+		Tags:                    oc.Tags,
+	}
+
+	if r, err := azure.ParseResourceID(oc.ID); err == nil {
+		cs.SubscriptionID = r.SubscriptionID
+		cs.ResourceGroup = r.ResourceGroup
+		if cs.Name == "" {
+			cs.Name = r.ResourceName
+		}
+	}
+
+	return cs
+}
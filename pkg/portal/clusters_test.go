@@ -0,0 +1,274 @@
+package portal
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+// defaultClusterSearchQuery is the database query run by a cluster search with
+// no parameters
+const defaultClusterSearchQuery = "SELECT * FROM OpenShiftClusters doc WHERE doc.openShiftCluster.properties.provisioningState != @p0 AND doc.openShiftCluster.properties.provisioningState != @p1"
+
+func TestClusters(t *testing.T) {
+	const subscriptionID = "00000000-0000-0000-0000-000000000000"
+
+	defaultParameters := []cosmosdb.Parameter{
+		{Name: "@p0", Value: "Creating"},
+		{Name: "@p1", Value: "Deleting"},
+	}
+
+	newDoc := func(name string, ps, fps api.ProvisioningState) *api.OpenShiftClusterDocument {
+		return &api.OpenShiftClusterDocument{
+			Key: "/subscriptions/" + subscriptionID + "/resourcegroups/resourcegroupname/providers/microsoft.redhatopenshift/openshiftclusters/" + name,
+			OpenShiftCluster: &api.OpenShiftCluster{
+				ID:       "/subscriptions/" + subscriptionID + "/resourcegroups/resourceGroupName/providers/microsoft.redhatopenshift/openshiftclusters/" + name,
+				Name:     name,
+				Location: "eastus",
+				Tags: map[string]string{
+					"env": name,
+				},
+				Properties: api.OpenShiftClusterProperties{
+					ProvisioningState:       ps,
+					FailedProvisioningState: fps,
+					ClusterProfile: api.ClusterProfile{
+						Version: "4.6.26",
+					},
+				},
+			},
+		}
+	}
+
+	// in the fake database, the queries below match documents by
+	// provisioning state only
+	docs := []*api.OpenShiftClusterDocument{
+		newDoc("creating", api.ProvisioningStateCreating, ""),
+		newDoc("failedcreate", api.ProvisioningStateFailed, api.ProvisioningStateCreating),
+		newDoc("failedupdate", api.ProvisioningStateFailed, api.ProvisioningStateUpdating),
+		newDoc("succeeded", api.ProvisioningStateSucceeded, ""),
+	}
+
+	for _, tt := range []struct {
+		name           string
+		query          string
+		dbError        error
+		wantQuery      string
+		wantParameters []cosmosdb.Parameter
+		matches        func(*api.OpenShiftClusterDocument) bool
+		wantStatusCode int
+		wantClusters   []string
+		wantNextPage   string
+		wantBody       string
+	}{
+		{
+			name:           "default hides clusters being created or deleted",
+			wantQuery:      defaultClusterSearchQuery,
+			wantParameters: defaultParameters,
+			matches: func(doc *api.OpenShiftClusterDocument) bool {
+				ps := doc.OpenShiftCluster.Properties.ProvisioningState
+				return ps != api.ProvisioningStateCreating && ps != api.ProvisioningStateDeleting
+			},
+			wantStatusCode: http.StatusOK,
+			wantClusters:   []string{"failedupdate", "succeeded"},
+		},
+		{
+			name:      "search",
+			query:     "name=Succ&subscriptionId=" + strings.ToUpper(subscriptionID) + "&resourceGroup=resourceGroupName&version=4.6&provisioningState=succeeded&tag=env%3Dsucceeded",
+			wantQuery: `SELECT * FROM OpenShiftClusters doc WHERE STARTSWITH(doc.key, @prefix) AND CONTAINS(doc.openShiftCluster.name, @p0, true) AND STARTSWITH(doc.openShiftCluster.properties.clusterProfile.version, @p1) AND doc.openShiftCluster.properties.provisioningState = @p2 AND doc.openShiftCluster.tags["env"] = @p3`,
+			wantParameters: []cosmosdb.Parameter{
+				{Name: "@prefix", Value: "/subscriptions/" + subscriptionID + "/resourcegroups/resourcegroupname/"},
+				{Name: "@p0", Value: "Succ"},
+				{Name: "@p1", Value: "4.6"},
+				{Name: "@p2", Value: "Succeeded"},
+				{Name: "@p3", Value: "succeeded"},
+			},
+			matches: func(doc *api.OpenShiftClusterDocument) bool {
+				return doc.OpenShiftCluster.Properties.ProvisioningState == api.ProvisioningStateSucceeded
+			},
+			wantStatusCode: http.StatusOK,
+			wantClusters:   []string{"succeeded"},
+		},
+		{
+			name:           "explicitly failed clusters are not hidden",
+			query:          "provisioningState=Failed",
+			wantQuery:      "SELECT * FROM OpenShiftClusters doc WHERE doc.openShiftCluster.properties.provisioningState = @p0",
+			wantParameters: []cosmosdb.Parameter{{Name: "@p0", Value: "Failed"}},
+			matches: func(doc *api.OpenShiftClusterDocument) bool {
+				return doc.OpenShiftCluster.Properties.ProvisioningState == api.ProvisioningStateFailed
+			},
+			wantStatusCode: http.StatusOK,
+			wantClusters:   []string{"failedcreate", "failedupdate"},
+		},
+		{
+			name:           "first page",
+			query:          "pageSize=1&provisioningState=Failed",
+			wantQuery:      "SELECT * FROM OpenShiftClusters doc WHERE doc.openShiftCluster.properties.provisioningState = @p0",
+			wantParameters: []cosmosdb.Parameter{{Name: "@p0", Value: "Failed"}},
+			matches: func(doc *api.OpenShiftClusterDocument) bool {
+				return doc.OpenShiftCluster.Properties.ProvisioningState == api.ProvisioningStateFailed
+			},
+			wantStatusCode: http.StatusOK,
+			wantClusters:   []string{"failedcreate"},
+			wantNextPage:   "MQ", // "1"
+		},
+		{
+			name:           "last page",
+			query:          "pageSize=1&provisioningState=Failed&page=MQ",
+			wantQuery:      "SELECT * FROM OpenShiftClusters doc WHERE doc.openShiftCluster.properties.provisioningState = @p0",
+			wantParameters: []cosmosdb.Parameter{{Name: "@p0", Value: "Failed"}},
+			matches: func(doc *api.OpenShiftClusterDocument) bool {
+				return doc.OpenShiftCluster.Properties.ProvisioningState == api.ProvisioningStateFailed
+			},
+			wantStatusCode: http.StatusOK,
+			wantClusters:   []string{"failedupdate"},
+		},
+		{
+			name:           "resource group without subscription",
+			query:          "resourceGroup=resourceGroupName",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "resourceGroup requires subscriptionId\n",
+		},
+		{
+			name:           "invalid subscription",
+			query:          "subscriptionId=foo",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "invalid subscriptionId \"foo\"\n",
+		},
+		{
+			name:           "invalid tag",
+			query:          "tag=env",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "invalid tag \"env\": must be name=value\n",
+		},
+		{
+			name:           "invalid provisioning state",
+			query:          "provisioningState=sad",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "invalid provisioningState \"sad\"\n",
+		},
+		{
+			name:           "invalid page size",
+			query:          "pageSize=1000",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "invalid pageSize \"1000\": must be between 1 and 200\n",
+		},
+		{
+			name:           "invalid page",
+			query:          "page=%21",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "invalid page\n",
+		},
+		{
+			name:           "sad database",
+			dbError:        fmt.Errorf("sad"),
+			wantStatusCode: http.StatusInternalServerError,
+			wantBody:       "Internal Server Error\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dbOpenShiftClusters, client := testdatabase.NewFakeOpenShiftClusters()
+
+			fixture := testdatabase.NewFixture().
+				WithOpenShiftClusters(dbOpenShiftClusters)
+			fixture.AddOpenShiftClusterDocuments(docs...)
+
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantQuery != "" {
+				client.SetQueryHandler(tt.wantQuery, func(client cosmosdb.OpenShiftClusterDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.OpenShiftClusterDocumentRawIterator {
+					if !reflect.DeepEqual(query.Parameters, tt.wantParameters) {
+						t.Error(query.Parameters)
+					}
+
+					var continuation int
+					if options != nil && options.Continuation != "" {
+						continuation, _ = strconv.Atoi(options.Continuation)
+					}
+
+					var results []*api.OpenShiftClusterDocument
+					for _, doc := range docs {
+						if tt.matches(doc) {
+							results = append(results, doc)
+						}
+					}
+
+					return cosmosdb.NewFakeOpenShiftClusterDocumentIterator(results, continuation)
+				})
+			}
+
+			client.SetError(tt.dbError)
+
+			p := &portal{
+				log:                 logrus.NewEntry(logrus.StandardLogger()),
+				dbOpenShiftClusters: dbOpenShiftClusters,
+			}
+
+			r, err := http.NewRequest(http.MethodGet, "https://server/api/clusters?"+tt.query, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			w := httptest.NewRecorder()
+
+			p.clusters(w, r)
+
+			if w.Code != tt.wantStatusCode {
+				t.Fatal(w.Code, w.Body.String())
+			}
+
+			if tt.wantStatusCode != http.StatusOK {
+				if w.Body.String() != tt.wantBody {
+					t.Error(w.Body.String())
+				}
+				return
+			}
+
+			if w.Header().Get("Content-Type") != "application/json" {
+				t.Error(w.Header().Get("Content-Type"))
+			}
+
+			var result *clusterSearchResult
+			err = json.NewDecoder(w.Body).Decode(&result)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var names []string
+			for _, cluster := range result.Clusters {
+				names = append(names, cluster.Name)
+
+				if cluster.SubscriptionID != subscriptionID ||
+					cluster.ResourceGroup != "resourceGroupName" ||
+					cluster.Version != "4.6.26" ||
+					cluster.Tags["env"] != cluster.Name {
+					t.Errorf("%#v", cluster)
+				}
+			}
+
+			if !reflect.DeepEqual(names, tt.wantClusters) {
+				t.Error(names)
+			}
+
+			if result.NextPage != tt.wantNextPage {
+				t.Error(result.NextPage)
+			}
+		})
+	}
+}
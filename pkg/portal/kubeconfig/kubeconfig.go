@@ -11,6 +11,7 @@ import (
 	"log"
 	"net/http"
 	"net/http/httputil"
+	"os/exec"
 	"strings"
 	"time"
 
@@ -23,8 +24,10 @@ import (
 	"github.com/Azure/ARO-RP/pkg/api/validate"
 	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/portal/access"
 	"github.com/Azure/ARO-RP/pkg/portal/middleware"
 	"github.com/Azure/ARO-RP/pkg/portal/util/clientcache"
+	"github.com/Azure/ARO-RP/pkg/portal/util/revocation"
 	"github.com/Azure/ARO-RP/pkg/proxy"
 	"github.com/Azure/ARO-RP/pkg/util/roundtripper"
 )
@@ -34,7 +37,9 @@ const (
 )
 
 type kubeconfig struct {
+	env           env.Core
 	log           *logrus.Entry
+	audit         *logrus.Entry
 	baseAccessLog *logrus.Entry
 
 	servingCert      *x509.Certificate
@@ -47,6 +52,10 @@ type kubeconfig struct {
 	clientCache clientcache.ClientCache
 
 	newToken func() string
+	now      func() time.Time
+	ocPath   func() (string, error)
+
+	revocationInterval time.Duration
 }
 
 func New(baseLog *logrus.Entry,
@@ -61,7 +70,9 @@ func New(baseLog *logrus.Entry,
 	aadAuthenticatedRouter,
 	unauthenticatedRouter *mux.Router) *kubeconfig {
 	k := &kubeconfig{
+		env:           env,
 		log:           baseLog,
+		audit:         audit,
 		baseAccessLog: baseAccessLog,
 
 		servingCert:      servingCert,
@@ -74,6 +85,10 @@ func New(baseLog *logrus.Entry,
 		clientCache: clientcache.New(time.Hour),
 
 		newToken: func() string { return uuid.Must(uuid.NewV4()).String() },
+		now:      time.Now,
+		ocPath:   func() (string, error) { return exec.LookPath("oc") },
+
+		revocationInterval: revocation.PollInterval,
 	}
 
 	rp := &httputil.ReverseProxy{
@@ -83,6 +98,7 @@ func New(baseLog *logrus.Entry,
 	}
 
 	aadAuthenticatedRouter.NewRoute().Methods(http.MethodPost).Path("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/microsoft.redhatopenshift/openshiftclusters/{resourceName}/kubeconfig/new").HandlerFunc(k.new)
+	aadAuthenticatedRouter.NewRoute().Methods(http.MethodGet).Path("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/microsoft.redhatopenshift/openshiftclusters/{resourceName}/kubeconfig/terminal").HandlerFunc(k.terminal)
 
 	bearerAuthenticatedRouter := unauthenticatedRouter.NewRoute().Subrouter()
 	bearerAuthenticatedRouter.Use(middleware.Bearer(k.dbPortal))
@@ -94,46 +110,29 @@ func New(baseLog *logrus.Entry,
 }
 
 // new creates a new PortalDocument allowing kubeconfig access to a cluster for
-// 6 hours and returns a kubeconfig with the temporary credentials
+// 6 hours and returns a kubeconfig with the temporary credentials.  Elevated
+// access requires an approved access request, and lasts no longer than it.
 func (k *kubeconfig) new(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
 	resourceID := strings.Join(strings.Split(r.URL.Path, "/")[:9], "/")
 	if !validate.RxClusterID.MatchString(resourceID) {
 		http.Error(w, fmt.Sprintf("invalid resourceId %q", resourceID), http.StatusBadRequest)
 		return
 	}
 
-	elevated := len(middleware.GroupsIntersect(k.elevatedGroupIDs, ctx.Value(middleware.ContextKeyGroups).([]string))) > 0
-
-	token := k.newToken()
-
-	portalDoc := &api.PortalDocument{
-		ID:  token,
-		TTL: int(kubeconfigNewTimeout / time.Second),
-		Portal: &api.Portal{
-			Username: ctx.Value(middleware.ContextKeyUsername).(string),
-			ID:       resourceID,
-			Kubeconfig: &api.Kubeconfig{
-				Elevated: elevated,
-			},
-		},
-	}
-
-	_, err := k.dbPortal.Create(ctx, portalDoc)
+	portalDoc, err := k.newPortalDoc(r, resourceID, kubeconfigNewTimeout)
 	if err != nil {
 		k.internalServerError(w, err)
 		return
 	}
 
-	b, err := k.makeKubeconfig("https://"+r.Host+resourceID+"/kubeconfig/proxy", token)
+	b, err := k.makeKubeconfig("https://"+r.Host+resourceID+"/kubeconfig/proxy", portalDoc.ID)
 	if err != nil {
 		k.internalServerError(w, err)
 		return
 	}
 
 	filename := strings.Split(r.URL.Path, "/")[8]
-	if elevated {
+	if portalDoc.Portal.Kubeconfig.Elevated {
 		filename += "-elevated"
 	}
 
@@ -142,6 +141,43 @@ func (k *kubeconfig) new(w http.ResponseWriter, r *http.Request) {
 	_, _ = w.Write(b)
 }
 
+// newPortalDoc creates a new PortalDocument allowing the requesting user
+// kubeconfig access to a cluster for up to timeout.  Access is elevated if the
+// user is in an elevated group and has an approved access request, in which
+// case it lasts no longer than the request.
+func (k *kubeconfig) newPortalDoc(r *http.Request, resourceID string, timeout time.Duration) (*api.PortalDocument, error) {
+	ctx := r.Context()
+
+	username := ctx.Value(middleware.ContextKeyUsername).(string)
+
+	elevated := len(middleware.GroupsIntersect(k.elevatedGroupIDs, ctx.Value(middleware.ContextKeyGroups).([]string))) > 0
+	if elevated {
+		now := k.now()
+
+		expiry, err := access.Expiry(ctx, k.dbPortal, now, username, resourceID)
+		if err != nil {
+			return nil, err
+		}
+
+		elevated = !expiry.IsZero()
+		if elevated && expiry.Sub(now) < timeout {
+			timeout = expiry.Sub(now)
+		}
+	}
+
+	return k.dbPortal.Create(ctx, &api.PortalDocument{
+		ID:  k.newToken(),
+		TTL: int(timeout / time.Second),
+		Portal: &api.Portal{
+			Username: username,
+			ID:       resourceID,
+			Kubeconfig: &api.Kubeconfig{
+				Elevated: elevated,
+			},
+		},
+	})
+}
+
 func (k *kubeconfig) internalServerError(w http.ResponseWriter, err error) {
 	k.log.Warn(err)
 	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/golang/mock/gomock"
@@ -33,6 +34,28 @@ func TestNew(t *testing.T) {
 
 	servingCert := &x509.Certificate{}
 
+	now := time.Unix(1600000000, 0)
+
+	approval := func(expiryTime int64) *api.PortalDocument {
+		return &api.PortalDocument{
+			ID:  "00000000-0000-0000-0000-000000000000",
+			TTL: 14400,
+			Portal: &api.Portal{
+				Username: username,
+				ID:       resourceID,
+				AccessRequest: &api.AccessRequest{
+					Justification: "investigation",
+					TicketID:      "IcM-1",
+					State:         api.AccessRequestStateApproved,
+					RequestTime:   now.Add(-time.Hour).Unix(),
+					Approver:      "approver",
+					DecisionTime:  now.Add(-time.Hour).Unix(),
+					ExpiryTime:    expiryTime,
+				},
+			},
+		}
+	}
+
 	for _, tt := range []struct {
 		name           string
 		r              func(*http.Request)
@@ -66,9 +89,11 @@ func TestNew(t *testing.T) {
 			name:     "success - elevated",
 			elevated: true,
 			fixtureChecker: func(fixture *testdatabase.Fixture, checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				fixture.AddPortalDocuments(approval(now.Add(3 * time.Hour).Unix()))
+				checker.AddPortalDocuments(approval(now.Add(3 * time.Hour).Unix()))
 				portalDocument := &api.PortalDocument{
 					ID:  password,
-					TTL: 21600,
+					TTL: 10800,
 					Portal: &api.Portal{
 						Username: username,
 						ID:       resourceID,
@@ -85,6 +110,73 @@ func TestNew(t *testing.T) {
 			},
 			wantBody: "{\n    \"kind\": \"Config\",\n    \"apiVersion\": \"v1\",\n    \"preferences\": {},\n    \"clusters\": [\n        {\n            \"name\": \"cluster\",\n            \"cluster\": {\n                \"server\": \"https://localhost:8444/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster/kubeconfig/proxy\",\n                \"certificate-authority-data\": \"LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCi0tLS0tRU5EIENFUlRJRklDQVRFLS0tLS0K\"\n            }\n        }\n    ],\n    \"users\": [\n        {\n            \"name\": \"user\",\n            \"user\": {\n                \"token\": \"password\"\n            }\n        }\n    ],\n    \"contexts\": [\n        {\n            \"name\": \"context\",\n            \"context\": {\n                \"cluster\": \"cluster\",\n                \"user\": \"user\",\n                \"namespace\": \"default\"\n            }\n        }\n    ],\n    \"current-context\": \"context\"\n}",
 		},
+		{
+			name:     "success - elevated, approval expired",
+			elevated: true,
+			fixtureChecker: func(fixture *testdatabase.Fixture, checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				fixture.AddPortalDocuments(approval(now.Add(-time.Minute).Unix()))
+				checker.AddPortalDocuments(approval(now.Add(-time.Minute).Unix()))
+				portalDocument := &api.PortalDocument{
+					ID:  password,
+					TTL: 21600,
+					Portal: &api.Portal{
+						Username:   username,
+						ID:         resourceID,
+						Kubeconfig: &api.Kubeconfig{},
+					},
+				}
+				checker.AddPortalDocuments(portalDocument)
+			},
+			wantStatusCode: http.StatusOK,
+			wantHeaders: http.Header{
+				"Content-Disposition": []string{`attachment; filename="cluster.kubeconfig"`},
+			},
+			wantBody: "{\n    \"kind\": \"Config\",\n    \"apiVersion\": \"v1\",\n    \"preferences\": {},\n    \"clusters\": [\n        {\n            \"name\": \"cluster\",\n            \"cluster\": {\n                \"server\": \"https://localhost:8444/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster/kubeconfig/proxy\",\n                \"certificate-authority-data\": \"LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCi0tLS0tRU5EIENFUlRJRklDQVRFLS0tLS0K\"\n            }\n        }\n    ],\n    \"users\": [\n        {\n            \"name\": \"user\",\n            \"user\": {\n                \"token\": \"password\"\n            }\n        }\n    ],\n    \"contexts\": [\n        {\n            \"name\": \"context\",\n            \"context\": {\n                \"cluster\": \"cluster\",\n                \"user\": \"user\",\n                \"namespace\": \"default\"\n            }\n        }\n    ],\n    \"current-context\": \"context\"\n}",
+		},
+		{
+			name:     "success - elevated, approval nearly expired",
+			elevated: true,
+			fixtureChecker: func(fixture *testdatabase.Fixture, checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				fixture.AddPortalDocuments(approval(now.Add(30 * time.Second).Unix()))
+				checker.AddPortalDocuments(approval(now.Add(30 * time.Second).Unix()))
+				portalDocument := &api.PortalDocument{
+					ID:  password,
+					TTL: 21600,
+					Portal: &api.Portal{
+						Username:   username,
+						ID:         resourceID,
+						Kubeconfig: &api.Kubeconfig{},
+					},
+				}
+				checker.AddPortalDocuments(portalDocument)
+			},
+			wantStatusCode: http.StatusOK,
+			wantHeaders: http.Header{
+				"Content-Disposition": []string{`attachment; filename="cluster.kubeconfig"`},
+			},
+			wantBody: "{\n    \"kind\": \"Config\",\n    \"apiVersion\": \"v1\",\n    \"preferences\": {},\n    \"clusters\": [\n        {\n            \"name\": \"cluster\",\n            \"cluster\": {\n                \"server\": \"https://localhost:8444/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster/kubeconfig/proxy\",\n                \"certificate-authority-data\": \"LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCi0tLS0tRU5EIENFUlRJRklDQVRFLS0tLS0K\"\n            }\n        }\n    ],\n    \"users\": [\n        {\n            \"name\": \"user\",\n            \"user\": {\n                \"token\": \"password\"\n            }\n        }\n    ],\n    \"contexts\": [\n        {\n            \"name\": \"context\",\n            \"context\": {\n                \"cluster\": \"cluster\",\n                \"user\": \"user\",\n                \"namespace\": \"default\"\n            }\n        }\n    ],\n    \"current-context\": \"context\"\n}",
+		},
+		{
+			name:     "success - elevated, not approved",
+			elevated: true,
+			fixtureChecker: func(fixture *testdatabase.Fixture, checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				portalDocument := &api.PortalDocument{
+					ID:  password,
+					TTL: 21600,
+					Portal: &api.Portal{
+						Username:   username,
+						ID:         resourceID,
+						Kubeconfig: &api.Kubeconfig{},
+					},
+				}
+				checker.AddPortalDocuments(portalDocument)
+			},
+			wantStatusCode: http.StatusOK,
+			wantHeaders: http.Header{
+				"Content-Disposition": []string{`attachment; filename="cluster.kubeconfig"`},
+			},
+			wantBody: "{\n    \"kind\": \"Config\",\n    \"apiVersion\": \"v1\",\n    \"preferences\": {},\n    \"clusters\": [\n        {\n            \"name\": \"cluster\",\n            \"cluster\": {\n                \"server\": \"https://localhost:8444/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster/kubeconfig/proxy\",\n                \"certificate-authority-data\": \"LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCi0tLS0tRU5EIENFUlRJRklDQVRFLS0tLS0K\"\n            }\n        }\n    ],\n    \"users\": [\n        {\n            \"name\": \"user\",\n            \"user\": {\n                \"token\": \"password\"\n            }\n        }\n    ],\n    \"contexts\": [\n        {\n            \"name\": \"context\",\n            \"context\": {\n                \"cluster\": \"cluster\",\n                \"user\": \"user\",\n                \"namespace\": \"default\"\n            }\n        }\n    ],\n    \"current-context\": \"context\"\n}",
+		},
 		{
 			name: "bad path",
 			r: func(r *http.Request) {
@@ -151,6 +243,7 @@ func TestNew(t *testing.T) {
 			k := New(baseLog, audit, _env, baseAccessLog, servingCert, elevatedGroupIDs, nil, dbPortal, nil, aadAuthenticatedRouter, &mux.Router{})
 
 			k.newToken = func() string { return password }
+			k.now = func() time.Time { return now }
 
 			if tt.r != nil {
 				tt.r(r)
@@ -9,23 +9,48 @@ import (
 	"crypto/x509"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ghodss/yaml"
+	"github.com/sirupsen/logrus"
 	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/api/validate"
 	"github.com/Azure/ARO-RP/pkg/portal/middleware"
 	"github.com/Azure/ARO-RP/pkg/portal/util/responsewriter"
+	"github.com/Azure/ARO-RP/pkg/portal/util/revocation"
 	utilpem "github.com/Azure/ARO-RP/pkg/util/pem"
 	"github.com/Azure/ARO-RP/pkg/util/restconfig"
 )
 
 const (
 	kubeconfigTimeout = time.Hour
+
+	// Requests are made to the cluster as the proxy's own identity, placed in
+	// a group for the SRE tier to which the operator binds the appropriate
+	// cluster RBAC.  The portal user is attributed in the cluster audit log by
+	// an impersonated user extra, so the proxy never needs to be able to
+	// impersonate arbitrary users.
+	impersonateUser          = "system:aro-sre"
+	impersonateGroup         = "system:aro-sre"
+	impersonateUserElevated  = "system:aro-service"
+	impersonateGroupElevated = "system:aro-sre-elevated"
+	impersonateExtraUsername = "Impersonate-Extra-Aro-Portal-Username"
+
+	// The operator ships the RBAC which allows impersonation in these
+	// ClusterRoleBindings.  Until a cluster has them, requests are made
+	// without impersonation.
+	impersonatorBinding         = "system:aro-sre-impersonator"
+	impersonatorBindingElevated = "system:aro-sre-elevated"
+
+	// impersonationCheckInterval is how often a cluster without the RBAC
+	// is checked again
+	impersonationCheckInterval = 10 * time.Minute
 )
 
 type contextKey int
@@ -85,6 +110,14 @@ func (k *kubeconfig) director(r *http.Request) {
 	r.Header.Del("Authorization")
 	r.Host = r.URL.Host
 
+	// clients must not be able to choose who they are impersonating.  The
+	// client's impersonator sets the impersonation headers.
+	for h := range r.Header {
+		if strings.HasPrefix(h, "Impersonate-") {
+			r.Header.Del(h)
+		}
+	}
+
 	// http.Request.WithContext returns a copy of the original Request with the
 	// new context, but we have no way to return it, so we overwrite our
 	// existing request.
@@ -134,39 +167,138 @@ func (k *kubeconfig) cli(ctx context.Context, resourceID string, elevated bool)
 	}
 
 	return &http.Client{
-		Transport: &http.Transport{
-			DialContext: restconfig.DialContext(k.dialer, openShiftDoc.OpenShiftCluster),
-			TLSClientConfig: &tls.Config{
-				Certificates: []tls.Certificate{
-					{
-						Certificate: [][]byte{
-							clientCerts[0].Raw,
+		Transport: &impersonator{
+			RoundTripper: &http.Transport{
+				DialContext: restconfig.DialContext(k.dialer, openShiftDoc.OpenShiftCluster),
+				TLSClientConfig: &tls.Config{
+					Certificates: []tls.Certificate{
+						{
+							Certificate: [][]byte{
+								clientCerts[0].Raw,
+							},
+							PrivateKey: clientKey,
 						},
-						PrivateKey: clientKey,
 					},
+					RootCAs: pool,
 				},
-				RootCAs: pool,
 			},
+			log:      k.log,
+			now:      k.now,
+			elevated: elevated,
 		},
 	}, nil
 }
 
+// impersonator adds the impersonation headers for the portal user to requests
+// to a cluster, once the cluster has the RBAC which allows them.  The RBAC
+// only reaches a cluster when its operator is updated; until then, requests are
+// made as the proxy's own identity.
+type impersonator struct {
+	http.RoundTripper
+	log      *logrus.Entry
+	now      func() time.Time
+	elevated bool
+
+	mu        sync.Mutex
+	supported bool
+	checked   time.Time
+}
+
+func (i *impersonator) RoundTrip(r *http.Request) (*http.Response, error) {
+	portalDoc, _ := r.Context().Value(middleware.ContextKeyPortalDoc).(*api.PortalDocument)
+	if portalDoc == nil || !i.isSupported(r.Context()) {
+		return i.RoundTripper.RoundTrip(r)
+	}
+
+	r = r.Clone(r.Context())
+	if i.elevated {
+		r.Header.Set("Impersonate-User", impersonateUserElevated)
+		r.Header.Set("Impersonate-Group", impersonateGroupElevated)
+	} else {
+		r.Header.Set("Impersonate-User", impersonateUser)
+		r.Header.Set("Impersonate-Group", impersonateGroup)
+	}
+	r.Header.Set(impersonateExtraUsername, portalDoc.Portal.Username)
+
+	return i.RoundTripper.RoundTrip(r)
+}
+
+// isSupported returns true if the cluster has the ClusterRoleBinding which
+// allows impersonation.  A cluster without it is checked again after
+// impersonationCheckInterval.
+func (i *impersonator) isSupported(ctx context.Context) bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.supported || i.now().Before(i.checked.Add(impersonationCheckInterval)) {
+		return i.supported
+	}
+
+	binding := impersonatorBinding
+	if i.elevated {
+		binding = impersonatorBindingElevated
+	}
+
+	supported, err := i.hasClusterRoleBinding(ctx, binding)
+	if err != nil {
+		// try again on the next request
+		i.log.Warn(err)
+		return false
+	}
+
+	i.supported = supported
+	i.checked = i.now()
+
+	return i.supported
+}
+
+func (i *impersonator) hasClusterRoleBinding(ctx context.Context, name string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://kubernetes:6443/apis/rbac.authorization.k8s.io/v1/clusterrolebindings/"+name, nil)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := i.RoundTripper.RoundTrip(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected status code %d checking for clusterrolebinding %s", resp.StatusCode, name)
+	}
+}
+
 // roundTripper is called by ReverseProxy to make the onward request happen.  We
 // check if we had an error earlier and return that if we did.  Otherwise we dig
-// out the client and call it.
+// out the client and call it.  Long-running requests (watches, logs, exec and
+// port-forward) are terminated if the session is revoked.
 func (k *kubeconfig) roundTripper(r *http.Request) (*http.Response, error) {
 	if resp, ok := r.Context().Value(contextKeyResponse).(*http.Response); ok {
 		return resp, nil
 	}
 
+	portalDoc := r.Context().Value(middleware.ContextKeyPortalDoc).(*api.PortalDocument)
+	ctx, cancel := revocation.Watch(r.Context(), k.log, k.dbPortal, portalDoc.ID, k.revocationInterval)
+
 	cli := r.Context().Value(contextKeyClient).(*http.Client)
-	resp, err := cli.Do(r)
+	resp, err := cli.Do(r.WithContext(ctx))
 	if err != nil {
+		cancel()
 		return nil, err
 	}
 
 	if resp.StatusCode == http.StatusSwitchingProtocols {
-		resp.Body = newCancelBody(resp.Body.(io.ReadWriteCloser), kubeconfigTimeout)
+		resp.Body = newCancelBody(ctx, cancel, resp.Body.(io.ReadWriteCloser), kubeconfigTimeout)
+	} else {
+		resp.Body = &watchedBody{ReadCloser: resp.Body, cancel: cancel}
 	}
 
 	return resp, err
@@ -183,19 +315,37 @@ func (k *kubeconfig) error(r *http.Request, statusCode int, err error) {
 	*r = *r.WithContext(context.WithValue(r.Context(), contextKeyResponse, w.Response()))
 }
 
+// watchedBody stops watching for revocation of the session once the response
+// body is closed.  Until then, revocation cancels the request context, which
+// aborts the body.
+type watchedBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *watchedBody) Close() error {
+	b.cancel()
+	return b.ReadCloser.Close()
+}
+
 // cancelBody is a workaround for the fact that http timeouts are incompatible
 // with hijacked connections (https://github.com/golang/go/issues/31391):
-// net/http.cancelTimerBody does not implement Writer.
+// net/http.cancelTimerBody does not implement Writer.  It also closes the
+// connection if ctx is cancelled, e.g. because the session has been revoked.
 type cancelBody struct {
 	io.ReadWriteCloser
-	t *time.Timer
-	c chan struct{}
+	ctx    context.Context
+	cancel context.CancelFunc
+	t      *time.Timer
+	c      chan struct{}
 }
 
 func (b *cancelBody) wait() {
 	select {
 	case <-b.t.C:
 		b.ReadWriteCloser.Close()
+	case <-b.ctx.Done():
+		b.ReadWriteCloser.Close()
 	case <-b.c:
 		b.t.Stop()
 	}
@@ -207,12 +357,16 @@ func (b *cancelBody) Close() error {
 	default:
 	}
 
+	b.cancel()
+
 	return b.ReadWriteCloser.Close()
 }
 
-func newCancelBody(rwc io.ReadWriteCloser, d time.Duration) io.ReadWriteCloser {
+func newCancelBody(ctx context.Context, cancel context.CancelFunc, rwc io.ReadWriteCloser, d time.Duration) io.ReadWriteCloser {
 	b := &cancelBody{
 		ReadWriteCloser: rwc,
+		ctx:             ctx,
+		cancel:          cancel,
 		t:               time.NewTimer(d),
 		c:               make(chan struct{}),
 	}
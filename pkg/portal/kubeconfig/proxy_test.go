@@ -13,7 +13,11 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httputil"
+	"path"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/golang/mock/gomock"
@@ -22,9 +26,11 @@ import (
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/portal/middleware"
 	"github.com/Azure/ARO-RP/pkg/portal/util/responsewriter"
 	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
 	mock_proxy "github.com/Azure/ARO-RP/pkg/util/mocks/proxy"
+	"github.com/Azure/ARO-RP/pkg/util/roundtripper"
 	utiltls "github.com/Azure/ARO-RP/pkg/util/tls"
 	testdatabase "github.com/Azure/ARO-RP/test/database"
 	"github.com/Azure/ARO-RP/test/util/listener"
@@ -174,7 +180,7 @@ func TestProxy(t *testing.T) {
 				dialer.EXPECT().DialContext(gomock.Any(), "tcp", apiServerPrivateEndpointIP+":6443").Return(l.DialContext(ctx, "", ""))
 			},
 			wantStatusCode: http.StatusOK,
-			wantBody:       "GET /test HTTP/1.1\r\nHost: kubernetes:6443\r\nAccept-Encoding: gzip\r\nUser-Agent: Go-http-client/1.1\r\nX-Authenticated-Name: system:aro-service\r\n\r\n",
+			wantBody:       "GET /test HTTP/1.1\r\nHost: kubernetes:6443\r\nAccept-Encoding: gzip\r\nImpersonate-Extra-Aro-Portal-Username: username\r\nImpersonate-Group: system:aro-sre-elevated\r\nImpersonate-User: system:aro-service\r\nUser-Agent: Go-http-client/1.1\r\nX-Authenticated-Name: system:aro-service\r\n\r\n",
 		},
 		{
 			name: "success - not elevated",
@@ -210,7 +216,48 @@ func TestProxy(t *testing.T) {
 				dialer.EXPECT().DialContext(gomock.Any(), "tcp", apiServerPrivateEndpointIP+":6443").Return(l.DialContext(ctx, "", ""))
 			},
 			wantStatusCode: http.StatusOK,
-			wantBody:       "GET /test HTTP/1.1\r\nHost: kubernetes:6443\r\nAccept-Encoding: gzip\r\nUser-Agent: Go-http-client/1.1\r\nX-Authenticated-Name: system:aro-sre\r\n\r\n",
+			wantBody:       "GET /test HTTP/1.1\r\nHost: kubernetes:6443\r\nAccept-Encoding: gzip\r\nImpersonate-Extra-Aro-Portal-Username: username\r\nImpersonate-Group: system:aro-sre\r\nImpersonate-User: system:aro-sre\r\nUser-Agent: Go-http-client/1.1\r\nX-Authenticated-Name: system:aro-sre\r\n\r\n",
+		},
+		{
+			name: "success - client impersonation headers are replaced",
+			r: func(r *http.Request) {
+				r.Header.Set("Impersonate-User", "system:admin")
+				r.Header.Set("Impersonate-Group", "system:masters")
+				r.Header.Set("Impersonate-Extra-Scopes", "user:full")
+			},
+			fixtureChecker: func(fixture *testdatabase.Fixture, checker *testdatabase.Checker, openShiftClustersClient *cosmosdb.FakeOpenShiftClusterDocumentClient, portalClient *cosmosdb.FakePortalDocumentClient) {
+				portalDocument := &api.PortalDocument{
+					ID:  token,
+					TTL: 21600,
+					Portal: &api.Portal{
+						Username:   username,
+						ID:         resourceID,
+						Kubeconfig: &api.Kubeconfig{},
+					},
+				}
+				fixture.AddPortalDocuments(portalDocument)
+				checker.AddPortalDocuments(portalDocument)
+				openShiftClusterDocument := &api.OpenShiftClusterDocument{
+					ID:  resourceID,
+					Key: resourceID,
+					OpenShiftCluster: &api.OpenShiftCluster{
+						Properties: api.OpenShiftClusterProperties{
+							NetworkProfile: api.NetworkProfile{
+								APIServerPrivateEndpointIP: apiServerPrivateEndpointIP,
+							},
+							AROServiceKubeconfig: api.SecureBytes(serviceKubeconfig),
+							AROSREKubeconfig:     api.SecureBytes(sreKubeconfig),
+						},
+					},
+				}
+				fixture.AddOpenShiftClusterDocuments(openShiftClusterDocument)
+				checker.AddOpenShiftClusterDocuments(openShiftClusterDocument)
+			},
+			mocks: func(dialer *mock_proxy.MockDialer) {
+				dialer.EXPECT().DialContext(gomock.Any(), "tcp", apiServerPrivateEndpointIP+":6443").Return(l.DialContext(ctx, "", ""))
+			},
+			wantStatusCode: http.StatusOK,
+			wantBody:       "GET /test HTTP/1.1\r\nHost: kubernetes:6443\r\nAccept-Encoding: gzip\r\nImpersonate-Extra-Aro-Portal-Username: username\r\nImpersonate-Group: system:aro-sre\r\nImpersonate-User: system:aro-sre\r\nUser-Agent: Go-http-client/1.1\r\nX-Authenticated-Name: system:aro-sre\r\n\r\n",
 		},
 		{
 			name: "no auth",
@@ -442,3 +489,103 @@ func TestProxy(t *testing.T) {
 		})
 	}
 }
+
+func TestImpersonator(t *testing.T) {
+	for _, tt := range []struct {
+		name              string
+		elevated          bool
+		bindingStatusCode int
+		wantBinding       string
+		wantHeaders       http.Header
+	}{
+		{
+			name:              "cluster has the rbac",
+			bindingStatusCode: http.StatusOK,
+			wantBinding:       "system:aro-sre-impersonator",
+			wantHeaders: http.Header{
+				"Impersonate-User":                      []string{"system:aro-sre"},
+				"Impersonate-Group":                     []string{"system:aro-sre"},
+				"Impersonate-Extra-Aro-Portal-Username": []string{"username"},
+			},
+		},
+		{
+			name:              "elevated, cluster has the rbac",
+			elevated:          true,
+			bindingStatusCode: http.StatusOK,
+			wantBinding:       "system:aro-sre-elevated",
+			wantHeaders: http.Header{
+				"Impersonate-User":                      []string{"system:aro-service"},
+				"Impersonate-Group":                     []string{"system:aro-sre-elevated"},
+				"Impersonate-Extra-Aro-Portal-Username": []string{"username"},
+			},
+		},
+		{
+			name:              "cluster does not have the rbac",
+			bindingStatusCode: http.StatusNotFound,
+			wantBinding:       "system:aro-sre-impersonator",
+			wantHeaders:       http.Header{},
+		},
+		{
+			name:              "rbac check fails",
+			bindingStatusCode: http.StatusInternalServerError,
+			wantBinding:       "system:aro-sre-impersonator",
+			wantHeaders:       http.Header{},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotBinding string
+			var gotHeaders http.Header
+
+			_, log := testlog.New()
+			i := &impersonator{
+				RoundTripper: roundtripper.RoundTripperFunc(func(r *http.Request) (*http.Response, error) {
+					if strings.HasPrefix(r.URL.Path, "/apis/rbac.authorization.k8s.io/v1/clusterrolebindings/") {
+						gotBinding = path.Base(r.URL.Path)
+						return &http.Response{
+							StatusCode: tt.bindingStatusCode,
+							Body:       ioutil.NopCloser(strings.NewReader("")),
+						}, nil
+					}
+
+					gotHeaders = r.Header
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(strings.NewReader("")),
+					}, nil
+				}),
+				log:      log,
+				now:      time.Now,
+				elevated: tt.elevated,
+			}
+
+			ctx := context.WithValue(context.Background(), middleware.ContextKeyPortalDoc, &api.PortalDocument{
+				Portal: &api.Portal{
+					Username: "username",
+				},
+			})
+
+			r, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://kubernetes:6443/test", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := i.RoundTrip(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if gotBinding != tt.wantBinding {
+				t.Error(gotBinding)
+			}
+
+			if !reflect.DeepEqual(gotHeaders, tt.wantHeaders) {
+				t.Error(gotHeaders)
+			}
+
+			if len(r.Header) != 0 {
+				t.Error("request was modified")
+			}
+		})
+	}
+}
@@ -0,0 +1,846 @@
+package kubeconfig
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/validate"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/portal/middleware"
+	"github.com/Azure/ARO-RP/pkg/portal/util/recorder"
+	"github.com/Azure/ARO-RP/pkg/portal/util/revocation"
+	"github.com/Azure/ARO-RP/pkg/util/log/audit"
+	"github.com/Azure/ARO-RP/pkg/util/recover"
+)
+
+// This file implements the portal web terminal: a WebSocket on which the SRE
+// sends oc command lines and receives their output.  Commands are run by the
+// portal against the kubeconfig proxy, using a temporary kubeconfig with the
+// same elevated/non-elevated access as a downloaded one.  There is no shell:
+// command lines are parsed by the portal and only a subset of oc commands and
+// flags is allowed, so that a session cannot reach beyond its cluster or the
+// portal's filesystem.  Sessions are recorded and each command is audited.
+
+const (
+	terminalTimeout        = time.Hour
+	terminalCommandTimeout = 10 * time.Minute
+
+	terminalPrompt = "$ "
+
+	// terminalMaxMessageSize is the largest message which will be read from
+	// the client
+	terminalMaxMessageSize = 64 * 1024
+
+	terminalCloseTimeout = 5 * time.Second
+)
+
+var terminalUpgrader = websocket.Upgrader{
+	CheckOrigin: sameOrigin,
+}
+
+// terminalFlag is a flag allowed in the web terminal
+type terminalFlag struct {
+	short byte // short name, or 0 if none
+	value bool // true if the flag takes a separate value, e.g. -n foo
+}
+
+// terminalFlags are allowed flags, by long name
+type terminalFlags map[string]terminalFlag
+
+func mergeTerminalFlags(flagSets ...terminalFlags) terminalFlags {
+	flags := terminalFlags{}
+	for _, flagSet := range flagSets {
+		for name, flag := range flagSet {
+			flags[name] = flag
+		}
+	}
+
+	return flags
+}
+
+// terminalGlobalFlags are the flags allowed for all commands
+var terminalGlobalFlags = terminalFlags{
+	"help":            {short: 'h'},
+	"namespace":       {short: 'n', value: true},
+	"request-timeout": {value: true},
+}
+
+var (
+	terminalDryRunFlags = terminalFlags{
+		"dry-run": {},
+	}
+
+	terminalOutputFlags = terminalFlags{
+		"label-columns": {short: 'L', value: true},
+		"no-headers":    {},
+		"output":        {short: 'o', value: true},
+		"show-kind":     {},
+		"show-labels":   {},
+		"sort-by":       {value: true},
+		"template":      {value: true},
+	}
+
+	terminalSelectorFlags = terminalFlags{
+		"all-namespaces": {short: 'A'},
+		"field-selector": {value: true},
+		"selector":       {short: 'l', value: true},
+	}
+
+	terminalAnnotateFlags = mergeTerminalFlags(terminalDryRunFlags, terminalSelectorFlags, terminalFlags{
+		"all":              {},
+		"list":             {},
+		"output":           {short: 'o', value: true},
+		"overwrite":        {},
+		"resource-version": {value: true},
+	})
+
+	terminalCordonFlags = mergeTerminalFlags(terminalDryRunFlags, terminalFlags{
+		"selector": {short: 'l', value: true},
+	})
+
+	terminalDrainFlags = mergeTerminalFlags(terminalDryRunFlags, terminalFlags{
+		"delete-local-data":            {},
+		"disable-eviction":             {},
+		"force":                        {},
+		"grace-period":                 {value: true},
+		"ignore-daemonsets":            {},
+		"pod-selector":                 {value: true},
+		"selector":                     {short: 'l', value: true},
+		"skip-wait-for-delete-timeout": {value: true},
+		"timeout":                      {value: true},
+	})
+
+	terminalTaintFlags = mergeTerminalFlags(terminalDryRunFlags, terminalFlags{
+		"all":       {},
+		"output":    {short: 'o', value: true},
+		"overwrite": {},
+		"selector":  {short: 'l', value: true},
+	})
+
+	terminalTopFlags = terminalFlags{
+		"all-namespaces": {short: 'A'},
+		"containers":     {},
+		"no-headers":     {},
+		"selector":       {short: 'l', value: true},
+		"sort-by":        {value: true},
+	}
+
+	terminalRolloutFlags = mergeTerminalFlags(terminalDryRunFlags, terminalFlags{
+		"output":   {short: 'o', value: true},
+		"selector": {short: 'l', value: true},
+	})
+)
+
+// terminalCommands are the oc commands allowed in the web terminal, with the
+// flags allowed for each in addition to terminalGlobalFlags.  Subcommands of
+// the commands in terminalSubcommands are listed separately, e.g. "adm drain".
+// Flags are allowed rather than denied so that new oc flags, which might
+// change the cluster or credentials used, reveal credentials or read or write
+// files on the portal, are not allowed by default.  Everything listed must be
+// supported by the oc client shipped in the RP image (see OC_VERSION in
+// Dockerfile.aro).
+var terminalCommands = map[string]terminalFlags{
+	"adm cordon": terminalCordonFlags,
+	"adm drain":  terminalDrainFlags,
+	"adm node-logs": {
+		"boot":           {value: true},
+		"case-sensitive": {},
+		"grep":           {short: 'g', value: true},
+		"path":           {value: true},
+		"raw":            {},
+		"role":           {value: true},
+		"selector":       {short: 'l', value: true},
+		"since":          {value: true},
+		"tail":           {value: true},
+		"unit":           {short: 'u', value: true},
+		"until":          {value: true},
+	},
+	"adm taint":    terminalTaintFlags,
+	"adm top":      terminalTopFlags,
+	"adm uncordon": terminalCordonFlags,
+	"annotate":     terminalAnnotateFlags,
+	"api-resources": {
+		"api-group":  {value: true},
+		"cached":     {},
+		"namespaced": {},
+		"no-headers": {},
+		"output":     {short: 'o', value: true},
+		"sort-by":    {value: true},
+		"verbs":      {value: true},
+	},
+	"api-versions": {},
+	"auth can-i": {
+		"all-namespaces": {short: 'A'},
+		"list":           {},
+		"no-headers":     {},
+		"quiet":          {short: 'q'},
+		"subresource":    {value: true},
+	},
+	"cluster-info": {},
+	"cordon":       terminalCordonFlags,
+	"delete": mergeTerminalFlags(terminalDryRunFlags, terminalSelectorFlags, terminalFlags{
+		"all":              {},
+		"cascade":          {},
+		"force":            {},
+		"grace-period":     {value: true},
+		"ignore-not-found": {},
+		"now":              {},
+		"output":           {short: 'o', value: true},
+		"timeout":          {value: true},
+		"wait":             {},
+	}),
+	"describe": mergeTerminalFlags(terminalSelectorFlags, terminalFlags{
+		"show-events": {},
+	}),
+	"drain": terminalDrainFlags,
+	"exec": {
+		"container": {short: 'c', value: true},
+		"quiet":     {short: 'q'},
+		"stdin":     {short: 'i'},
+		"tty":       {short: 't'},
+	},
+	"explain": {
+		"api-version": {value: true},
+		"recursive":   {},
+	},
+	"get": mergeTerminalFlags(terminalOutputFlags, terminalSelectorFlags, terminalFlags{
+		"chunk-size":          {value: true},
+		"ignore-not-found":    {},
+		"output-watch-events": {},
+		"watch":               {short: 'w'},
+		"watch-only":          {},
+	}),
+	"label": terminalAnnotateFlags,
+	"logs": {
+		"all-containers":      {},
+		"container":           {short: 'c', value: true},
+		"follow":              {short: 'f'},
+		"ignore-errors":       {},
+		"limit-bytes":         {value: true},
+		"max-log-requests":    {value: true},
+		"pod-running-timeout": {value: true},
+		"prefix":              {},
+		"previous":            {short: 'p'},
+		"selector":            {short: 'l', value: true},
+		"since":               {value: true},
+		"since-time":          {value: true},
+		"tail":                {value: true},
+		"timestamps":          {},
+	},
+	"patch": mergeTerminalFlags(terminalDryRunFlags, terminalFlags{
+		"output": {short: 'o', value: true},
+		"patch":  {short: 'p', value: true},
+		"type":   {value: true},
+	}),
+	"rollout history": {
+		"output":   {short: 'o', value: true},
+		"revision": {value: true},
+		"selector": {short: 'l', value: true},
+	},
+	"rollout pause":   terminalRolloutFlags,
+	"rollout restart": terminalRolloutFlags,
+	"rollout resume":  terminalRolloutFlags,
+	"rollout status": {
+		"revision": {value: true},
+		"selector": {short: 'l', value: true},
+		"timeout":  {value: true},
+		"watch":    {short: 'w'},
+	},
+	"rollout undo": mergeTerminalFlags(terminalRolloutFlags, terminalFlags{
+		"to-revision": {value: true},
+	}),
+	"scale": mergeTerminalFlags(terminalDryRunFlags, terminalFlags{
+		"all":              {},
+		"current-replicas": {value: true},
+		"output":           {short: 'o', value: true},
+		"replicas":         {value: true},
+		"resource-version": {value: true},
+		"selector":         {short: 'l', value: true},
+		"timeout":          {value: true},
+	}),
+	"status": {
+		"all-namespaces": {short: 'A'},
+		"suggest":        {},
+	},
+	"taint":    terminalTaintFlags,
+	"top":      terminalTopFlags,
+	"uncordon": terminalCordonFlags,
+	"version": {
+		"client": {},
+		"output": {short: 'o', value: true},
+	},
+	"whoami": {
+		"show-console": {},
+		"show-server":  {},
+	},
+}
+
+// terminalSubcommands are the commands in terminalCommands which are listed by
+// subcommand
+var terminalSubcommands = map[string]bool{
+	"adm":     true,
+	"auth":    true,
+	"rollout": true,
+}
+
+type terminalMessage struct {
+	// Type is "command" or "interrupt"
+	Type string `json:"type"`
+	Data string `json:"data,omitempty"`
+}
+
+type terminalSession struct {
+	k   *kubeconfig
+	log *logrus.Entry
+	r   *http.Request
+
+	portalDoc *api.PortalDocument
+	conn      *websocket.Conn
+	rec       *recorder.Recorder
+	out       io.Writer
+
+	oc  string
+	dir string
+}
+
+// terminal starts a web terminal session on a cluster
+func (k *kubeconfig) terminal(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resourceID := strings.Join(strings.Split(r.URL.Path, "/")[:9], "/")
+	if !validate.RxClusterID.MatchString(resourceID) {
+		http.Error(w, fmt.Sprintf("invalid resourceId %q", resourceID), http.StatusBadRequest)
+		return
+	}
+
+	if !sameOrigin(r) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	if !websocket.IsWebSocketUpgrade(r) {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	oc, err := k.ocPath()
+	if err != nil {
+		k.log.Warn(err)
+		http.Error(w, "oc is not available", http.StatusServiceUnavailable)
+		return
+	}
+
+	portalDoc, err := k.newPortalDoc(r, resourceID, terminalTimeout)
+	if err != nil {
+		k.internalServerError(w, err)
+		return
+	}
+
+	// the session's credentials are revoked when it ends
+	defer func() {
+		err := k.dbPortal.Delete(context.Background(), portalDoc)
+		if err != nil && !cosmosdb.IsErrorStatusCode(err, http.StatusNotFound) {
+			k.log.Warn(err)
+		}
+	}()
+
+	dir, err := ioutil.TempDir("", "terminal")
+	if err != nil {
+		k.internalServerError(w, err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	b, err := k.makeKubeconfig("https://"+r.Host+resourceID+"/kubeconfig/proxy", portalDoc.ID)
+	if err != nil {
+		k.internalServerError(w, err)
+		return
+	}
+
+	err = ioutil.WriteFile(filepath.Join(dir, "kubeconfig"), b, 0600)
+	if err != nil {
+		k.internalServerError(w, err)
+		return
+	}
+
+	rec, err := recorder.New(ctx, k.dbPortal, k.now, portalDoc.Portal.Username, resourceID,
+		fmt.Sprintf("%s terminal", resourceID),
+		&api.SSHRecording{
+			SessionID: portalDoc.ID,
+			Terminal:  true,
+		})
+	if err != nil {
+		k.internalServerError(w, err)
+		return
+	}
+	rec.SetCommand("oc")
+
+	conn, err := terminalUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		k.log.Warn(err)
+		_ = rec.Close(ctx)
+		return
+	}
+	defer conn.Close()
+
+	conn.SetReadLimit(terminalMaxMessageSize)
+
+	s := &terminalSession{
+		k: k,
+		log: k.log.WithFields(logrus.Fields{
+			"resource_id":  resourceID,
+			"username":     portalDoc.Portal.Username,
+			"recording_id": rec.ID(),
+		}),
+		r: r,
+
+		portalDoc: portalDoc,
+		conn:      conn,
+		rec:       rec,
+
+		oc:  oc,
+		dir: dir,
+	}
+	s.out = &terminalWriter{ctx: ctx, s: s}
+
+	s.log.Print("terminal session started")
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(portalDoc.TTL)*time.Second)
+	defer cancel()
+
+	ctx, cancelWatch := revocation.Watch(ctx, s.log, k.dbPortal, portalDoc.ID, k.revocationInterval)
+	defer cancelWatch()
+
+	err = s.run(ctx)
+	if err != nil {
+		s.log.Warn(err)
+	}
+
+	err = rec.Close(r.Context())
+	if err != nil {
+		s.log.Warnf("recording failed: %v", err)
+	}
+
+	_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(terminalCloseTimeout))
+
+	s.log.Print("terminal session ended")
+}
+
+// run reads and runs commands until the client goes away or the session
+// expires
+func (s *terminalSession) run(ctx context.Context) error {
+	messages := make(chan *terminalMessage)
+
+	go func() {
+		defer recover.Panic(s.log)
+		defer close(messages)
+
+		for {
+			typ, b, err := s.conn.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			var m *terminalMessage
+			if typ != websocket.TextMessage || json.Unmarshal(b, &m) != nil || m == nil {
+				continue
+			}
+
+			select {
+			case messages <- m:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	_, err := io.WriteString(s.out, terminalPrompt)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			if ctx.Err() == context.DeadlineExceeded {
+				_, _ = io.WriteString(s.out, "\nsession expired\n")
+			} else {
+				_, _ = io.WriteString(s.out, "\nsession revoked\n")
+			}
+			return nil
+
+		case m, ok := <-messages:
+			if !ok {
+				return nil
+			}
+
+			if m.Type != "command" {
+				continue
+			}
+
+			err = s.command(ctx, m.Data, messages)
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			_, err = io.WriteString(s.out, terminalPrompt)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// command runs a single command line, until it completes, it is interrupted
+// or it times out.  If the client goes away, io.EOF is returned.
+func (s *terminalSession) command(ctx context.Context, line string, messages <-chan *terminalMessage) error {
+	line = strings.TrimSpace(line)
+
+	_, err := s.rec.Writer(ctx, "i").Write([]byte(line + "\n"))
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(s.out, line+"\n")
+	if err != nil {
+		return err
+	}
+
+	args, err := parseTerminalCommand(line)
+	if err != nil {
+		_, err = fmt.Fprintf(s.out, "error: %v\n", err)
+		return err
+	}
+
+	if args == nil {
+		return nil
+	}
+
+	s.auditLog(line)
+
+	ctx, cancel := context.WithTimeout(ctx, terminalCommandTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, s.oc, args...)
+	cmd.Dir = s.dir
+	cmd.Env = []string{
+		"HOME=" + s.dir,
+		"KUBECONFIG=" + filepath.Join(s.dir, "kubeconfig"),
+	}
+	cmd.Stdout = s.out
+	cmd.Stderr = s.out
+
+	err = cmd.Start()
+	if err != nil {
+		_, err = fmt.Fprintf(s.out, "error: %v\n", err)
+		return err
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		defer recover.Panic(s.log)
+
+		done <- cmd.Wait()
+	}()
+
+	for {
+		select {
+		case err = <-done:
+			if ctx.Err() == context.DeadlineExceeded {
+				_, err = io.WriteString(s.out, "error: command timed out\n")
+				return err
+			}
+
+			// oc reports its own errors
+			if _, ok := err.(*exec.ExitError); err != nil && !ok {
+				_, err = fmt.Fprintf(s.out, "error: %v\n", err)
+				return err
+			}
+
+			return nil
+
+		case m, ok := <-messages:
+			if !ok {
+				cancel()
+				<-done
+				return io.EOF
+			}
+
+			if m.Type == "interrupt" {
+				cancel()
+				_, _ = io.WriteString(s.out, "^C\n")
+			}
+		}
+	}
+}
+
+func (s *terminalSession) auditLog(line string) {
+	middleware.Audit(s.k.env, s.k.audit, s.r, &middleware.AuditRecord{
+		Category:           audit.CategoryResourceManagement,
+		OperationName:      "TerminalCommand",
+		Username:           s.portalDoc.Portal.Username,
+		TargetResourceName: s.portalDoc.Portal.ID,
+		TargetResourceType: "terminal",
+		Description:        line,
+	})
+}
+
+// terminalWriter sends output to the client and records it.  Newlines are
+// translated for the benefit of terminal emulators replaying the recording.
+// It is safe to call Write concurrently.
+type terminalWriter struct {
+	ctx context.Context
+	s   *terminalSession
+
+	mu sync.Mutex
+}
+
+func (w *terminalWriter) Write(b []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	out := bytes.ReplaceAll(b, []byte("\n"), []byte("\r\n"))
+
+	_, err := w.s.rec.Writer(w.ctx, "o").Write(out)
+	if err != nil {
+		return 0, err
+	}
+
+	err = w.s.conn.WriteMessage(websocket.BinaryMessage, out)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(b), nil
+}
+
+// sameOrigin returns true if the Origin of r is the host to which r was sent.
+// Browsers do not apply the same-origin policy to WebSockets, so the origin
+// must be checked to prevent cross-site WebSocket hijacking.
+func sameOrigin(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Origin"), "https://"+r.Host)
+}
+
+// parseTerminalCommand parses an oc or kubectl command line and returns the
+// arguments to pass to oc, or an error if the command is not allowed in the
+// web terminal.  An empty line returns no arguments.
+func parseTerminalCommand(line string) ([]string, error) {
+	args, err := splitArgs(line)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(args) == 0 {
+		return nil, nil
+	}
+
+	if args[0] != "oc" && args[0] != "kubectl" {
+		return nil, fmt.Errorf("only oc and kubectl commands are supported")
+	}
+	args = args[1:]
+
+	if len(args) == 0 || !terminalCommandNames("")[args[0]] {
+		return nil, fmt.Errorf("supported commands are: %s", strings.Join(sortedKeys(terminalCommandNames("")), ", "))
+	}
+	command := args[0]
+
+	if terminalSubcommands[command] {
+		if len(args) < 2 || !terminalCommandNames(command)[args[1]] {
+			return nil, fmt.Errorf("supported %s commands are: %s", command, strings.Join(sortedKeys(terminalCommandNames(command)), ", "))
+		}
+		command += " " + args[1]
+	}
+
+	flags := mergeTerminalFlags(terminalGlobalFlags, terminalCommands[command])
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+
+		// arguments after "--" are for the command run by oc exec
+		if arg == "--" {
+			break
+		}
+
+		var output string
+
+		switch {
+		case strings.HasPrefix(arg, "--"):
+			parts := strings.SplitN(arg[2:], "=", 2)
+
+			flag, found := flags[parts[0]]
+			if !found {
+				return nil, fmt.Errorf("flag --%s is not supported", parts[0])
+			}
+
+			var value string
+			switch {
+			case len(parts) == 2:
+				value = parts[1]
+			case flag.value && i+1 < len(args):
+				// the next argument is the value, whatever it looks like
+				i++
+				value = args[i]
+			}
+
+			if parts[0] == "output" {
+				output = value
+			}
+
+		case strings.HasPrefix(arg, "-") && len(arg) >= 2:
+			// short flags may be combined, e.g. -it, and the last may have
+			// its value attached, e.g. -oyaml or -o=yaml
+			for j := 1; j < len(arg); j++ {
+				name, flag, found := flags.short(arg[j])
+				if !found {
+					return nil, fmt.Errorf("flag -%c is not supported", arg[j])
+				}
+
+				if !flag.value {
+					continue
+				}
+
+				value := strings.TrimPrefix(arg[j+1:], "=")
+				if value == "" && i+1 < len(args) {
+					i++
+					value = args[i]
+				}
+
+				if name == "output" {
+					output = value
+				}
+
+				break
+			}
+		}
+
+		// output formats reading templates from files
+		if strings.Contains(output, "-file") {
+			return nil, fmt.Errorf("output format %q is not supported", output)
+		}
+	}
+
+	return args, nil
+}
+
+// short returns the flag with the given short name
+func (flags terminalFlags) short(c byte) (string, terminalFlag, bool) {
+	for name, flag := range flags {
+		if flag.short == c {
+			return name, flag, true
+		}
+	}
+
+	return "", terminalFlag{}, false
+}
+
+// terminalCommandNames returns the names of the commands allowed in the web
+// terminal, or of the subcommands of command
+func terminalCommandNames(command string) map[string]bool {
+	names := map[string]bool{}
+	for c := range terminalCommands {
+		if command == "" {
+			names[strings.SplitN(c, " ", 2)[0]] = true
+		} else if strings.HasPrefix(c, command+" ") {
+			names[strings.TrimPrefix(c, command+" ")] = true
+		}
+	}
+
+	return names
+}
+
+// splitArgs splits a command line into arguments, following the quoting rules
+// of the shell.  Other shell syntax is not supported.
+func splitArgs(line string) ([]string, error) {
+	var args []string
+	var arg strings.Builder
+	var quote rune
+	var inArg, escaped bool
+
+	for _, c := range line {
+		switch {
+		case escaped:
+			arg.WriteRune(c)
+			escaped = false
+
+		case quote == '\'':
+			if c == '\'' {
+				quote = 0
+			} else {
+				arg.WriteRune(c)
+			}
+
+		case quote == '"':
+			switch c {
+			case '"':
+				quote = 0
+			case '\\':
+				escaped = true
+			default:
+				arg.WriteRune(c)
+			}
+
+		case c == '\\':
+			escaped, inArg = true, true
+
+		case c == '\'' || c == '"':
+			quote, inArg = c, true
+
+		case unicode.IsSpace(c):
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+
+		case strings.ContainsRune("|&;<>()$`", c):
+			return nil, fmt.Errorf("shell syntax is not supported")
+
+		default:
+			arg.WriteRune(c)
+			inArg = true
+		}
+	}
+
+	if quote != 0 || escaped {
+		return nil, fmt.Errorf("unterminated quote or escape")
+	}
+
+	if inArg {
+		args = append(args, arg.String())
+	}
+
+	return args, nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
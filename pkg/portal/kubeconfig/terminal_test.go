@@ -0,0 +1,535 @@
+package kubeconfig
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/portal/middleware"
+	"github.com/Azure/ARO-RP/pkg/util/log/audit"
+	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+func TestParseTerminalCommand(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		line     string
+		wantArgs []string
+		wantErr  string
+	}{
+		{
+			name: "empty",
+			line: "  ",
+		},
+		{
+			name:     "oc",
+			line:     "oc get pods -n openshift-azure-operator",
+			wantArgs: []string{"get", "pods", "-n", "openshift-azure-operator"},
+		},
+		{
+			name:     "kubectl, quoted",
+			line:     `kubectl get pods -o 'jsonpath={.items[*].metadata.name}' -l "app=a b" c\ d`,
+			wantArgs: []string{"get", "pods", "-o", "jsonpath={.items[*].metadata.name}", "-l", "app=a b", "c d"},
+		},
+		{
+			name:     "adm",
+			line:     "oc adm top nodes",
+			wantArgs: []string{"adm", "top", "nodes"},
+		},
+		{
+			name:     "logs follow",
+			line:     "oc logs -f pod",
+			wantArgs: []string{"logs", "-f", "pod"},
+		},
+		{
+			name:     "combined short flags",
+			line:     "oc exec -it pod -c container -- sh",
+			wantArgs: []string{"exec", "-it", "pod", "-c", "container", "--", "sh"},
+		},
+		{
+			name:     "flag values not checked",
+			line:     "oc logs pod --tail -1 -n -x",
+			wantArgs: []string{"logs", "pod", "--tail", "-1", "-n", "-x"},
+		},
+		{
+			name:     "subcommand",
+			line:     "oc rollout restart deployment/foo --dry-run=client",
+			wantArgs: []string{"rollout", "restart", "deployment/foo", "--dry-run=client"},
+		},
+		{
+			name:     "exec, flags after -- not checked",
+			line:     "oc exec pod -- ls -f --token",
+			wantArgs: []string{"exec", "pod", "--", "ls", "-f", "--token"},
+		},
+		{
+			name:    "not oc",
+			line:    "cat /etc/passwd",
+			wantErr: "only oc and kubectl commands are supported",
+		},
+		{
+			name:    "no command",
+			line:    "oc",
+			wantErr: "supported commands are: adm, annotate, api-resources, api-versions, auth, cluster-info, cordon, delete, describe, drain, exec, explain, get, label, logs, patch, rollout, scale, status, taint, top, uncordon, version, whoami",
+		},
+		{
+			name:    "denied command",
+			line:    "oc config view",
+			wantErr: "supported commands are: adm, annotate, api-resources, api-versions, auth, cluster-info, cordon, delete, describe, drain, exec, explain, get, label, logs, patch, rollout, scale, status, taint, top, uncordon, version, whoami",
+		},
+		{
+			name:    "denied adm command",
+			line:    "oc adm must-gather",
+			wantErr: "supported adm commands are: cordon, drain, node-logs, taint, top, uncordon",
+		},
+		{
+			name:    "denied flag",
+			line:    "oc get pods --kubeconfig=/tmp/kubeconfig",
+			wantErr: "flag --kubeconfig is not supported",
+		},
+		{
+			name:    "denied impersonation",
+			line:    "oc get secrets --as system:admin",
+			wantErr: "flag --as is not supported",
+		},
+		{
+			name:    "denied patch file",
+			line:    "oc patch node foo --patch-file=/etc/passwd",
+			wantErr: "flag --patch-file is not supported",
+		},
+		{
+			name:    "denied profile output",
+			line:    "oc get pods --profile=cpu --profile-output /tmp/profile",
+			wantErr: "flag --profile is not supported",
+		},
+		{
+			name:    "denied flag of another command",
+			line:    "oc get pods --since 1h",
+			wantErr: "flag --since is not supported",
+		},
+		{
+			name:    "denied rollout command",
+			line:    "oc rollout latest dc/foo",
+			wantErr: "supported rollout commands are: history, pause, restart, resume, status, undo",
+		},
+		{
+			name:    "denied combined short flag",
+			line:    "oc exec -itk pod",
+			wantErr: "flag -k is not supported",
+		},
+		{
+			name:    "denied short flag",
+			line:    "oc delete -f /etc/passwd",
+			wantErr: "flag -f is not supported",
+		},
+		{
+			name:    "denied short flag with attached value",
+			line:    "oc get pods -shttps://example.com",
+			wantErr: "flag -s is not supported",
+		},
+		{
+			name:    "denied show token",
+			line:    "oc whoami -t",
+			wantErr: "flag -t is not supported",
+		},
+		{
+			name:    "denied template file",
+			line:    "oc get pods -o go-template-file=/etc/passwd",
+			wantErr: `output format "go-template-file=/etc/passwd" is not supported`,
+		},
+		{
+			name:    "denied template file, long",
+			line:    "oc get pods --output jsonpath-file=/etc/passwd",
+			wantErr: `output format "jsonpath-file=/etc/passwd" is not supported`,
+		},
+		{
+			name:    "denied template file, attached",
+			line:    "oc get pods -ojsonpath-file=/etc/passwd",
+			wantErr: `output format "jsonpath-file=/etc/passwd" is not supported`,
+		},
+		{
+			name:    "pipe",
+			line:    "oc get pods | grep foo",
+			wantErr: "shell syntax is not supported",
+		},
+		{
+			name:    "command substitution",
+			line:    "oc get pods $(whoami)",
+			wantErr: "shell syntax is not supported",
+		},
+		{
+			name:    "unterminated quote",
+			line:    "oc get pods -l 'app",
+			wantErr: "unterminated quote or escape",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			args, err := parseTerminalCommand(tt.line)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("%#v", args)
+			}
+		})
+	}
+}
+
+// readUntilPrompt reads output messages until the terminal prompt is received
+func readUntilPrompt(c *websocket.Conn) (string, error) {
+	var out string
+
+	for !strings.HasSuffix(out, terminalPrompt) {
+		_, b, err := c.ReadMessage()
+		if err != nil {
+			return out, err
+		}
+
+		out += string(b)
+	}
+
+	return out, nil
+}
+
+func TestTerminal(t *testing.T) {
+	ctx := context.Background()
+	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster"
+	now := time.Unix(1600000000, 0)
+
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// the fake oc prints its arguments and environment, or waits to be
+	// interrupted
+	oc := filepath.Join(dir, "oc")
+	err = ioutil.WriteFile(oc, []byte(`#!/bin/sh
+case "$*" in
+*-w*)
+	exec sleep 60
+	;;
+esac
+echo "args: $*"
+echo "env: HOME=${HOME##*/} KUBECONFIG=${KUBECONFIG##*/}"
+echo "pwd: ${PWD##*/}"
+`), 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dbPortal, _ := testdatabase.NewFakePortal()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_env := mock_env.NewMockInterface(ctrl)
+	_env.EXPECT().Environment().AnyTimes().Return(&azure.PublicCloud)
+	_env.EXPECT().Hostname().AnyTimes().Return("testhost")
+	_env.EXPECT().Location().AnyTimes().Return("eastus")
+
+	auditHook, auditLog := testlog.NewAudit()
+	_, baseLog := testlog.New()
+	_, baseAccessLog := testlog.New()
+
+	aadAuthenticatedRouter := &mux.Router{}
+	k := New(baseLog, auditLog, _env, baseAccessLog, &x509.Certificate{}, nil, nil, dbPortal, nil, aadAuthenticatedRouter, &mux.Router{})
+	k.newToken = func() string { return "token" }
+	k.now = func() time.Time { return now }
+	k.ocPath = func() (string, error) { return oc, nil }
+
+	done := make(chan struct{})
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer close(done)
+
+		ctx := context.WithValue(r.Context(), middleware.ContextKeyUsername, "username")
+		ctx = context.WithValue(ctx, middleware.ContextKeyGroups, []string(nil))
+		aadAuthenticatedRouter.ServeHTTP(w, r.WithContext(ctx))
+	}))
+	defer s.Close()
+
+	c, resp, err := websocket.DefaultDialer.Dial("ws://"+s.Listener.Addr().String()+resourceID+"/kubeconfig/terminal", http.Header{
+		"Origin": []string{"https://" + s.Listener.Addr().String()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatal(resp.StatusCode)
+	}
+
+	out, err := readUntilPrompt(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != terminalPrompt {
+		t.Error(out)
+	}
+
+	for _, tt := range []struct {
+		messages []string
+		wantOut  string
+	}{
+		{
+			messages: []string{`{"type":"command","data":"oc get pods -n 'my namespace'"}`},
+			wantOut:  "oc get pods -n 'my namespace'\r\nargs: get pods -n my namespace\r\nenv: HOME=" + "%s" + " KUBECONFIG=kubeconfig\r\npwd: %s\r\n$ ",
+		},
+		{
+			messages: []string{`{"type":"command","data":"oc config view"}`},
+			wantOut:  "oc config view\r\nerror: supported commands are: adm, annotate, api-resources, api-versions, auth, cluster-info, cordon, delete, describe, drain, exec, explain, get, label, logs, patch, rollout, scale, status, taint, top, uncordon, version, whoami\r\n$ ",
+		},
+		{
+			messages: []string{`{"type":"command","data":"oc get pods -w"}`, `{"type":"interrupt"}`},
+			wantOut:  "oc get pods -w\r\n^C\r\n$ ",
+		},
+	} {
+		for _, m := range tt.messages {
+			err = c.WriteMessage(websocket.TextMessage, []byte(m))
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		out, err := readUntilPrompt(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the session directory name is random
+		if strings.Contains(tt.wantOut, "%s") {
+			lines := strings.Split(out, "\r\n")
+			if len(lines) > 2 && strings.HasPrefix(lines[2], "env: HOME=terminal") {
+				session := strings.Fields(strings.TrimPrefix(lines[2], "env: HOME="))[0]
+				tt.wantOut = strings.Replace(tt.wantOut, "%s", session, 2)
+			}
+		}
+
+		if out != tt.wantOut {
+			t.Errorf("%q", out)
+		}
+	}
+
+	err = c.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("session did not end")
+	}
+
+	// the session's credentials are deleted when it ends
+	_, err = dbPortal.Get(ctx, "token")
+	if !cosmosdb.IsErrorStatusCode(err, http.StatusNotFound) {
+		t.Error(err)
+	}
+
+	recordings, err := dbPortal.ListSSHRecordings(ctx, resourceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recordings.PortalDocuments) != 1 {
+		t.Fatal(len(recordings.PortalDocuments))
+	}
+
+	recording := recordings.PortalDocuments[0]
+	if recording.Portal.Username != "username" ||
+		recording.Portal.SSHRecording.SessionID != "token" ||
+		!recording.Portal.SSHRecording.Terminal ||
+		recording.Portal.SSHRecording.EndTime != now.Unix() {
+		t.Error(recording.Portal)
+	}
+
+	chunk, err := dbPortal.Get(ctx, recording.ID+"-0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(chunk.Portal.SSHRecordingChunk.Data), `"command":"oc"`) ||
+		!strings.Contains(string(chunk.Portal.SSHRecordingChunk.Data), `[0,"i","oc get pods -n 'my namespace'\n"]`) ||
+		!strings.Contains(string(chunk.Portal.SSHRecordingChunk.Data), `args: get pods -n my namespace\r\n`) {
+		t.Error(string(chunk.Portal.SSHRecordingChunk.Data))
+	}
+
+	var commands []string
+	for _, entry := range auditHook.AllEntries() {
+		var payload audit.Payload
+		err = json.Unmarshal([]byte(entry.Data[audit.MetadataPayload].(string)), &payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if payload.OperationName != "TerminalCommand" ||
+			payload.TargetResources[0].TargetResourceName != resourceID ||
+			payload.CallerIdentities[0].CallerIdentityValue != "username" {
+			t.Error(payload)
+		}
+
+		commands = append(commands, payload.Result.ResultDescription)
+	}
+	if !reflect.DeepEqual(commands, []string{"oc get pods -n 'my namespace'", "oc get pods -w"}) {
+		t.Error(commands)
+	}
+}
+
+func TestTerminalRejected(t *testing.T) {
+	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster"
+
+	for _, tt := range []struct {
+		name           string
+		origin         string
+		upgrade        bool
+		ocErr          error
+		wantStatusCode int
+	}{
+		{
+			name:           "cross-site",
+			origin:         "https://evil",
+			upgrade:        true,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "not a websocket",
+			origin:         "https://portal",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "oc not available",
+			origin:         "https://portal",
+			upgrade:        true,
+			ocErr:          errors.New(`exec: "oc": executable file not found in $PATH`),
+			wantStatusCode: http.StatusServiceUnavailable,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dbPortal, _ := testdatabase.NewFakePortal()
+
+			_, baseLog := testlog.New()
+			k := &kubeconfig{
+				log:      baseLog,
+				dbPortal: dbPortal,
+				ocPath:   func() (string, error) { return "oc", tt.ocErr },
+			}
+
+			r := httptest.NewRequest(http.MethodGet, "https://portal"+resourceID+"/kubeconfig/terminal", nil)
+			r.Header.Set("Origin", tt.origin)
+			if tt.upgrade {
+				r.Header.Set("Connection", "Upgrade")
+				r.Header.Set("Upgrade", "websocket")
+				r.Header.Set("Sec-WebSocket-Version", "13")
+				r.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+			}
+
+			w := httptest.NewRecorder()
+
+			k.terminal(w, r)
+
+			if w.Code != tt.wantStatusCode {
+				t.Error(w.Code)
+			}
+		})
+	}
+}
+
+// TestTerminalCommandsSupported checks that the allowed commands and flags
+// exist in the oc client shipped in the RP image.  It is skipped unless that
+// version of oc is installed.
+func TestTerminalCommandsSupported(t *testing.T) {
+	dockerfile, err := ioutil.ReadFile("../../../Dockerfile.aro")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := regexp.MustCompile(`(?m)^ARG OC_VERSION=(\S+)$`).FindSubmatch(dockerfile)
+	if m == nil {
+		t.Fatal("OC_VERSION not found in Dockerfile.aro")
+	}
+
+	out, err := exec.Command("oc", "version", "--client").CombinedOutput()
+	if err != nil || !strings.Contains(string(out), "Client Version: "+string(m[1])) {
+		t.Skipf("oc %s not installed", m[1])
+	}
+
+	// help returns the help of the command and of any subcommands, as e.g.
+	// the flags of "adm top" are on "adm top pods"
+	var help func(args ...string) string
+	help = func(args ...string) string {
+		out, err := exec.Command("oc", append(args, "--help")...).CombinedOutput()
+		if err != nil {
+			t.Fatalf("%s: %v", strings.Join(args, " "), err)
+		}
+
+		s := string(out)
+		if i := strings.Index(s, "Available Commands:\n"); i != -1 {
+			for _, line := range strings.Split(s[i+len("Available Commands:\n"):], "\n") {
+				fields := strings.Fields(line)
+				if len(fields) == 0 {
+					break
+				}
+				s += help(append(args, fields[0])...)
+			}
+		}
+
+		return s
+	}
+
+	check := func(command, out string, flags terminalFlags) {
+		for name, flag := range flags {
+			want := "--" + name
+			if flag.short != 0 {
+				want = fmt.Sprintf("-%c, --%s", flag.short, name)
+			}
+
+			if !strings.Contains(out, want) {
+				t.Errorf("oc %s: %s not supported", command, want)
+			}
+		}
+	}
+
+	out, err = exec.Command("oc", "options").CombinedOutput()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	globalFlags := terminalFlags{}
+	for name, flag := range terminalGlobalFlags {
+		// cobra adds --help to every command
+		if name != "help" {
+			globalFlags[name] = flag
+		}
+	}
+	check("options", string(out), globalFlags)
+
+	for command, flags := range terminalCommands {
+		check(command, help(strings.Fields(command)...), flags)
+	}
+}
@@ -0,0 +1,72 @@
+package middleware
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/util/log/audit"
+)
+
+// AuditRecord describes an operation to be recorded by Audit
+type AuditRecord struct {
+	Category           string
+	OperationName      string
+	Username           string // defaults to the user making the request
+	TargetResourceName string
+	TargetResourceType string
+	Description        string
+	Fields             logrus.Fields // additional fields of the record
+}
+
+// Audit records a successful operation carried out on behalf of r in the audit
+// log, in addition to the audit log record of the HTTP request made by Log
+func Audit(env env.Core, auditLog *logrus.Entry, r *http.Request, rec *AuditRecord) {
+	username := rec.Username
+	if username == "" {
+		username, _ = r.Context().Value(ContextKeyUsername).(string)
+	}
+
+	auditEntry(env, auditLog, r, username, rec.Category, rec.OperationName, rec.TargetResourceName, rec.TargetResourceType).
+		WithFields(rec.Fields).
+		WithField(audit.PayloadKeyResult, audit.Result{
+			ResultType:        audit.ResultTypeSuccess,
+			ResultDescription: rec.Description,
+		}).Info(audit.DefaultLogMessage)
+}
+
+// auditEntry returns an entry with the fields common to all the portal's
+// audit log records
+func auditEntry(env env.Core, auditLog *logrus.Entry, r *http.Request, username, category, operationName, targetResourceName, targetResourceType string) *logrus.Entry {
+	return auditLog.WithFields(logrus.Fields{
+		audit.MetadataAdminOperation:  true,
+		audit.MetadataCreatedTime:     time.Now().UTC().Format(time.RFC3339),
+		audit.MetadataLogKind:         audit.IFXAuditLogKind,
+		audit.MetadataSource:          audit.SourceAdminPortal,
+		audit.EnvKeyAppID:             audit.SourceAdminPortal,
+		audit.EnvKeyCloudRole:         audit.CloudRoleRP,
+		audit.EnvKeyEnvironment:       env.Environment().Name,
+		audit.EnvKeyHostname:          env.Hostname(),
+		audit.EnvKeyLocation:          env.Location(),
+		audit.PayloadKeyCategory:      category,
+		audit.PayloadKeyOperationName: operationName,
+		audit.PayloadKeyCallerIdentities: []audit.CallerIdentity{
+			{
+				CallerIdentityType:  audit.CallerIdentityTypeUsername,
+				CallerIdentityValue: username,
+				CallerIPAddress:     r.RemoteAddr,
+			},
+		},
+		audit.PayloadKeyTargetResources: []audit.TargetResource{
+			{
+				TargetResourceName: targetResourceName,
+				TargetResourceType: targetResourceType,
+			},
+		},
+	})
+}
@@ -0,0 +1,104 @@
+package middleware
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/util/log/audit"
+	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+func TestAudit(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	_env := mock_env.NewMockInterface(controller)
+	_env.EXPECT().Environment().AnyTimes().Return(&azure.PublicCloud)
+	_env.EXPECT().Hostname().AnyTimes().Return("testhost")
+	_env.EXPECT().Location().AnyTimes().Return("eastus")
+
+	ctx := context.WithValue(context.Background(), ContextKeyUsername, "username")
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://localhost/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.RemoteAddr = "127.0.0.1:1234"
+
+	for _, tt := range []struct {
+		name         string
+		username     string
+		wantUsername string
+	}{
+		{
+			name:         "user making the request",
+			wantUsername: "username",
+		},
+		{
+			name:         "other user",
+			username:     "other",
+			wantUsername: "other",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ah, auditLog := testlog.NewAudit()
+
+			Audit(_env, auditLog, r, &AuditRecord{
+				Category:           audit.CategoryAuthorization,
+				OperationName:      "Operation",
+				Username:           tt.username,
+				TargetResourceName: "/resource",
+				TargetResourceType: "type",
+				Description:        "description",
+				Fields: logrus.Fields{
+					"extra": "field",
+				},
+			})
+
+			testlog.AssertAuditPayloads(t, ah, []*audit.Payload{
+				{
+					EnvVer:               audit.IFXAuditVersion,
+					EnvName:              audit.IFXAuditName,
+					EnvFlags:             257,
+					EnvAppID:             audit.SourceAdminPortal,
+					EnvCloudName:         _env.Environment().Name,
+					EnvCloudRole:         audit.CloudRoleRP,
+					EnvCloudRoleInstance: _env.Hostname(),
+					EnvCloudEnvironment:  _env.Environment().Name,
+					EnvCloudLocation:     _env.Location(),
+					EnvCloudVer:          audit.IFXAuditCloudVer,
+					CallerIdentities: []audit.CallerIdentity{
+						{
+							CallerIdentityType:  audit.CallerIdentityTypeUsername,
+							CallerIdentityValue: tt.wantUsername,
+							CallerIPAddress:     "127.0.0.1:1234",
+						},
+					},
+					Category:      audit.CategoryAuthorization,
+					OperationName: "Operation",
+					Result: audit.Result{
+						ResultType:        audit.ResultTypeSuccess,
+						ResultDescription: "description",
+					},
+					TargetResources: []audit.TargetResource{
+						{
+							TargetResourceType: "type",
+							TargetResourceName: "/resource",
+						},
+					},
+				},
+			})
+
+			if ah.LastEntry().Data["extra"] != "field" {
+				t.Error(ah.LastEntry().Data["extra"])
+			}
+		})
+	}
+}
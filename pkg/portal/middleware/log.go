@@ -76,32 +76,7 @@ func Log(env env.Core, auditLog, baseLog *logrus.Entry) func(http.Handler) http.
 			})
 			log.Print("read request")
 
-			auditEntry := auditLog.WithFields(logrus.Fields{
-				audit.MetadataAdminOperation:  true,
-				audit.MetadataCreatedTime:     time.Now().UTC().Format(time.RFC3339),
-				audit.MetadataLogKind:         audit.IFXAuditLogKind,
-				audit.MetadataSource:          audit.SourceAdminPortal,
-				audit.EnvKeyAppID:             audit.SourceAdminPortal,
-				audit.EnvKeyCloudRole:         audit.CloudRoleRP,
-				audit.EnvKeyEnvironment:       env.Environment().Name,
-				audit.EnvKeyHostname:          env.Hostname(),
-				audit.EnvKeyLocation:          env.Location(),
-				audit.PayloadKeyCategory:      audit.CategoryResourceManagement,
-				audit.PayloadKeyOperationName: fmt.Sprintf("%s %s", r.Method, r.URL.Path),
-				audit.PayloadKeyCallerIdentities: []audit.CallerIdentity{
-					{
-						CallerIdentityType:  audit.CallerIdentityTypeUsername,
-						CallerIdentityValue: username,
-						CallerIPAddress:     r.RemoteAddr,
-					},
-				},
-				audit.PayloadKeyTargetResources: []audit.TargetResource{
-					{
-						TargetResourceName: r.URL.Path,
-						TargetResourceType: auditTargetResourceType(r),
-					},
-				},
-			})
+			auditEntry := auditEntry(env, auditLog, r, username, audit.CategoryResourceManagement, fmt.Sprintf("%s %s", r.Method, r.URL.Path), r.URL.Path, auditTargetResourceType(r))
 
 			defer func() {
 				statusCode := w.(*logResponseWriter).statusCode
@@ -9,31 +9,46 @@ import (
 	"crypto/rsa"
 	"crypto/tls"
 	"crypto/x509"
-	"encoding/json"
 	"html/template"
 	"log"
 	"net"
 	"net/http"
-	"sort"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/gorilla/csrf"
 	"github.com/gorilla/mux"
+	configclient "github.com/openshift/client-go/config/clientset/versioned"
 	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/env"
 	frontendmiddleware "github.com/Azure/ARO-RP/pkg/frontend/middleware"
+	"github.com/Azure/ARO-RP/pkg/portal/access"
+	"github.com/Azure/ARO-RP/pkg/portal/auditlog"
 	"github.com/Azure/ARO-RP/pkg/portal/kubeconfig"
 	"github.com/Azure/ARO-RP/pkg/portal/middleware"
 	"github.com/Azure/ARO-RP/pkg/portal/prometheus"
+	"github.com/Azure/ARO-RP/pkg/portal/sessions"
 	"github.com/Azure/ARO-RP/pkg/portal/ssh"
 	"github.com/Azure/ARO-RP/pkg/proxy"
+	"github.com/Azure/ARO-RP/pkg/util/recover"
+	"github.com/Azure/ARO-RP/pkg/util/restconfig"
 )
 
+// rxRecordingsPath matches the paths at which SSH session recordings are
+// listed and downloaded
+var rxRecordingsPath = regexp.MustCompile(`(?i)^/subscriptions/[^/]+/resourcegroups/[^/]+/providers/microsoft\.redhatopenshift/openshiftclusters/[^/]+/ssh/recordings(/[^/]+)?$`)
+
+// shutdownTimeout is how long requests in flight are given to complete when
+// the portal is stopped
+const shutdownTimeout = 10 * time.Second
+
 type Runnable interface {
-	Run(context.Context) error
+	Run(context.Context, <-chan struct{}, chan<- struct{}) error
 }
 
 type portal struct {
@@ -56,12 +71,17 @@ type portal struct {
 
 	groupIDs         []string
 	elevatedGroupIDs []string
+	auditorGroupIDs  []string
 
 	dbPortal            database.Portal
 	dbOpenShiftClusters database.OpenShiftClusters
+	dbAsyncOperations   database.AsyncOperations
 
 	dialer proxy.Dialer
 
+	newKubernetes func(*api.OpenShiftCluster) (kubernetes.Interface, error)
+	newConfig     func(*api.OpenShiftCluster) (configclient.Interface, error)
+
 	t *template.Template
 
 	aad middleware.AAD
@@ -84,10 +104,12 @@ func NewPortal(env env.Core,
 	sshKey *rsa.PrivateKey,
 	groupIDs []string,
 	elevatedGroupIDs []string,
+	auditorGroupIDs []string,
 	dbOpenShiftClusters database.OpenShiftClusters,
 	dbPortal database.Portal,
+	dbAsyncOperations database.AsyncOperations,
 	dialer proxy.Dialer) Runnable {
-	return &portal{
+	p := &portal{
 		env:           env,
 		audit:         audit,
 		log:           log,
@@ -107,15 +129,39 @@ func NewPortal(env env.Core,
 
 		groupIDs:         groupIDs,
 		elevatedGroupIDs: elevatedGroupIDs,
+		auditorGroupIDs:  auditorGroupIDs,
 
 		dbOpenShiftClusters: dbOpenShiftClusters,
 		dbPortal:            dbPortal,
+		dbAsyncOperations:   dbAsyncOperations,
 
 		dialer: dialer,
 	}
+
+	p.newKubernetes = func(oc *api.OpenShiftCluster) (kubernetes.Interface, error) {
+		restConfig, err := restconfig.RestConfig(p.dialer, oc)
+		if err != nil {
+			return nil, err
+		}
+
+		return kubernetes.NewForConfig(restConfig)
+	}
+
+	p.newConfig = func(oc *api.OpenShiftCluster) (configclient.Interface, error) {
+		restConfig, err := restconfig.RestConfig(p.dialer, oc)
+		if err != nil {
+			return nil, err
+		}
+
+		return configclient.NewForConfig(restConfig)
+	}
+
+	return p
 }
 
-func (p *portal) Run(ctx context.Context) error {
+// Run serves the portal.  When stop is closed, the portal stops serving,
+// writes the audit records still queued for the local store and closes done.
+func (p *portal) Run(ctx context.Context, stop <-chan struct{}, done chan<- struct{}) error {
 	asset, err := Asset("index.html")
 	if err != nil {
 		return err
@@ -162,6 +208,7 @@ func (p *portal) Run(ctx context.Context) error {
 
 	allGroups := append([]string{}, p.groupIDs...)
 	allGroups = append(allGroups, p.elevatedGroupIDs...)
+	allGroups = append(allGroups, p.auditorGroupIDs...)
 
 	p.aad, err = middleware.NewAAD(p.log, p.audit, p.env, p.baseAccessLog, p.hostname, p.sessionKey, p.clientID, p.clientKey, p.clientCerts, allGroups, unauthenticatedRouter, p.verifier)
 	if err != nil {
@@ -173,10 +220,15 @@ func (p *portal) Run(ctx context.Context) error {
 	aadAuthenticatedRouter.Use(middleware.Log(p.env, p.audit, p.baseAccessLog))
 	aadAuthenticatedRouter.Use(p.aad.Redirect)
 	aadAuthenticatedRouter.Use(csrf.Protect(p.sessionKey, csrf.SameSite(csrf.SameSiteStrictMode), csrf.MaxAge(0)))
+	aadAuthenticatedRouter.Use(p.restrictAuditors)
 
 	p.aadAuthenticatedRoutes(aadAuthenticatedRouter)
 
-	ssh, err := ssh.New(p.env, p.log, p.baseAccessLog, p.sshl, p.sshKey, p.elevatedGroupIDs, p.dbOpenShiftClusters, p.dbPortal, p.dialer, aadAuthenticatedRouter)
+	access.New(p.env, p.log, p.audit, p.elevatedGroupIDs, p.dbPortal, aadAuthenticatedRouter)
+
+	sessions.New(p.env, p.log, p.audit, p.elevatedGroupIDs, p.dbPortal, aadAuthenticatedRouter)
+
+	ssh, err := ssh.New(p.env, p.log, p.baseAccessLog, p.sshl, p.sshKey, p.elevatedGroupIDs, p.auditorGroupIDs, p.dbOpenShiftClusters, p.dbPortal, p.dialer, aadAuthenticatedRouter)
 	if err != nil {
 		return err
 	}
@@ -190,6 +242,15 @@ func (p *portal) Run(ctx context.Context) error {
 
 	prometheus.New(p.log, p.dbOpenShiftClusters, p.dialer, aadAuthenticatedRouter)
 
+	auditlog.New(p.log, p.auditorGroupIDs, p.dbPortal, aadAuthenticatedRouter)
+
+	store := auditlog.NewStore(p.log, p.dbPortal)
+	p.audit.Logger.AddHook(store)
+
+	storeStop := make(chan struct{})
+	storeDone := make(chan struct{})
+	go store.Run(ctx, storeStop, storeDone)
+
 	s := &http.Server{
 		Handler:     frontendmiddleware.Lowercase(r),
 		ReadTimeout: 10 * time.Second,
@@ -198,7 +259,35 @@ func (p *portal) Run(ctx context.Context) error {
 		BaseContext: func(net.Listener) context.Context { return ctx },
 	}
 
-	return s.Serve(tls.NewListener(p.l, config))
+	if stop != nil {
+		go func() {
+			defer recover.Panic(p.log)
+
+			<-stop
+
+			p.log.Print("shutting down")
+			shutdownCtx, cancel := context.WithTimeout(ctx, shutdownTimeout)
+			defer cancel()
+
+			err := s.Shutdown(shutdownCtx)
+			if err != nil {
+				p.log.Warn(err)
+			}
+
+			close(storeStop)
+			<-storeDone
+
+			p.log.Print("exiting")
+			close(done)
+		}()
+	}
+
+	err = s.Serve(tls.NewListener(p.l, config))
+	if err == http.ErrServerClosed {
+		return nil
+	}
+
+	return err
 }
 
 func (p *portal) unauthenticatedRoutes(r *mux.Router) {
@@ -219,9 +308,44 @@ func (p *portal) aadAuthenticatedRoutes(r *mux.Router) {
 	r.NewRoute().Methods(http.MethodGet).Path("/").HandlerFunc(p.index)
 
 	r.NewRoute().Methods(http.MethodGet).Path("/api/clusters").HandlerFunc(p.clusters)
+	r.NewRoute().Methods(http.MethodGet).Path("/api/clusters/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/microsoft.redhatopenshift/openshiftclusters/{resourceName}").HandlerFunc(p.cluster)
 	r.NewRoute().Methods(http.MethodPost).Path("/api/logout").Handler(p.aad.Logout("/"))
 }
 
+// restrictAuditors limits users who are only members of the auditor groups to
+// the portal assets, the audit log and SSH session recordings
+func (p *portal) restrictAuditors(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		groups, _ := r.Context().Value(middleware.ContextKeyGroups).([]string)
+
+		if len(middleware.GroupsIntersect(p.auditorGroupIDs, groups)) == 0 ||
+			len(middleware.GroupsIntersect(p.groupIDs, groups)) > 0 ||
+			len(middleware.GroupsIntersect(p.elevatedGroupIDs, groups)) > 0 {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		switch {
+		case r.URL.Path == "/",
+			r.URL.Path == "/api/logout",
+			r.URL.Path == "/api/audit",
+			strings.HasPrefix(r.URL.Path, "/api/audit/"),
+			rxRecordingsPath.MatchString(r.URL.Path):
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		for _, name := range AssetNames() {
+			if r.URL.Path == "/"+name {
+				h.ServeHTTP(w, r)
+				return
+			}
+		}
+
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+	})
+}
+
 func (p *portal) serve(path string) func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
 		b, err := Asset(path)
@@ -249,43 +373,6 @@ func (p *portal) index(w http.ResponseWriter, r *http.Request) {
 	http.ServeContent(w, r, "index.html", time.Time{}, bytes.NewReader(buf.Bytes()))
 }
 
-func (p *portal) clusters(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	docs, err := p.dbOpenShiftClusters.ListAll(ctx)
-	if err != nil {
-		p.internalServerError(w, err)
-		return
-	}
-
-	clusters := make([]string, 0, len(docs.OpenShiftClusterDocuments))
-	for _, doc := range docs.OpenShiftClusterDocuments {
-		ps := doc.OpenShiftCluster.Properties.ProvisioningState
-		fps := doc.OpenShiftCluster.Properties.FailedProvisioningState
-
-		switch {
-		case ps == api.ProvisioningStateCreating,
-			ps == api.ProvisioningStateDeleting,
-			ps == api.ProvisioningStateFailed &&
-				(fps == api.ProvisioningStateCreating ||
-					fps == api.ProvisioningStateDeleting):
-		default:
-			clusters = append(clusters, doc.OpenShiftCluster.ID)
-		}
-	}
-
-	sort.Strings(clusters)
-
-	b, err := json.MarshalIndent(clusters, "", "    ")
-	if err != nil {
-		p.internalServerError(w, err)
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	_, _ = w.Write(b)
-}
-
 func (p *portal) internalServerError(w http.ResponseWriter, err error) {
 	p.log.Warn(err)
 	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
@@ -4,64 +4,491 @@ package portal
 // Licensed under the Apache License 2.0.
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
-	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+	"github.com/gorilla/mux"
+	configv1 "github.com/openshift/api/config/v1"
+	configclient "github.com/openshift/client-go/config/clientset/versioned"
+	configfake "github.com/openshift/client-go/config/clientset/versioned/fake"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	frontendmiddleware "github.com/Azure/ARO-RP/pkg/frontend/middleware"
+	"github.com/Azure/ARO-RP/pkg/portal/middleware"
 	testdatabase "github.com/Azure/ARO-RP/test/database"
 )
 
-func TestClusters(t *testing.T) {
-	dbOpenShiftClusters, _ := testdatabase.NewFakeOpenShiftClusters()
+func TestCluster(t *testing.T) {
+	ctx := context.Background()
+
+	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName"
+	key := strings.ToLower(resourceID)
 
-	fixture := testdatabase.NewFixture().
-		WithOpenShiftClusters(dbOpenShiftClusters)
+	startTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(time.Hour)
 
-	fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
-		Key: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroupname/providers/microsoft.redhatopenshift/openshiftclusters/succeeded",
-		OpenShiftCluster: &api.OpenShiftCluster{
-			ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroupName/providers/microsoft.redhatopenshift/openshiftclusters/succeeded",
-			Properties: api.OpenShiftClusterProperties{
-				ProvisioningState: api.ProvisioningStateSucceeded,
+	for _, tt := range []struct {
+		name           string
+		r              func(*http.Request)
+		fixture        func(*testdatabase.Fixture)
+		kubernetesErr  error
+		wantStatusCode int
+		wantBody       string
+		wantDetail     *clusterDetail
+	}{
+		{
+			name: "good",
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: key,
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID:       resourceID,
+						Name:     "resourceName",
+						Location: "eastus",
+						Properties: api.OpenShiftClusterProperties{
+							ProvisioningState:    api.ProvisioningStateSucceeded,
+							LastAdminUpdateError: "error",
+							ClusterProfile: api.ClusterProfile{
+								Version: "4.6.18",
+								Domain:  "example.com",
+							},
+							WorkerProfiles: []api.WorkerProfile{
+								{
+									Name:       "worker",
+									VMSize:     api.VMSizeStandardD4sV3,
+									DiskSizeGB: 128,
+									Count:      3,
+								},
+							},
+						},
+					},
+				})
+				for i := 0; i < maxClusterAsyncOperations+1; i++ {
+					f.AddAsyncOperationDocuments(&api.AsyncOperationDocument{
+						ID:                  fmt.Sprintf("%08d-0000-0000-0000-000000000000", i),
+						OpenShiftClusterKey: key,
+						AsyncOperation: &api.AsyncOperation{
+							ID:                       fmt.Sprintf("%08d-0000-0000-0000-000000000000", i),
+							InitialProvisioningState: api.ProvisioningStateAdminUpdating,
+							ProvisioningState:        api.ProvisioningStateSucceeded,
+							StartTime:                startTime.Add(time.Duration(i) * time.Hour),
+						},
+					})
+				}
+				f.AddAsyncOperationDocuments(&api.AsyncOperationDocument{
+					ID:                  "other",
+					OpenShiftClusterKey: "other",
+					AsyncOperation: &api.AsyncOperation{
+						ID:        "other",
+						StartTime: endTime.Add(24 * time.Hour),
+					},
+				})
 			},
+			wantStatusCode: http.StatusOK,
+			wantDetail: func() *clusterDetail {
+				detail := &clusterDetail{
+					ResourceID:           resourceID,
+					Name:                 "resourceName",
+					Location:             "eastus",
+					ProvisioningState:    "Succeeded",
+					LastAdminUpdateError: "error",
+					Version:              "4.6.18",
+					Domain:               "example.com",
+					WorkerProfiles: []clusterWorkerProfile{
+						{
+							Name:       "worker",
+							VMSize:     "Standard_D4s_v3",
+							DiskSizeGB: 128,
+							Count:      3,
+						},
+					},
+					IngressProfiles: []clusterIngressProfile{},
+					ClusterOperators: []clusterOperator{
+						{
+							Name:        "authentication",
+							Version:     "4.6.18",
+							Available:   "True",
+							Progressing: "False",
+							Degraded:    "Unknown",
+						},
+						{
+							Name:        "console",
+							Available:   "False",
+							Progressing: "True",
+							Degraded:    "True",
+							Message:     "unavailable",
+						},
+					},
+					Nodes: []clusterNode{
+						{
+							Name:           "aro-master-0",
+							Roles:          "master",
+							Ready:          "True",
+							KubeletVersion: "v1.19.0",
+							InternalIP:     "10.0.0.4",
+						},
+						{
+							Name:          "aro-worker-eastus1-abcde",
+							Roles:         "worker",
+							Ready:         "False",
+							Unschedulable: true,
+						},
+					},
+				}
+				for i := maxClusterAsyncOperations; i > 0; i-- {
+					detail.AsyncOperations = append(detail.AsyncOperations, clusterAsyncOperation{
+						ID:            fmt.Sprintf("%08d-0000-0000-0000-000000000000", i),
+						InitialStatus: "AdminUpdating",
+						Status:        "Succeeded",
+						StartTime:     startTime.Add(time.Duration(i) * time.Hour),
+					})
+				}
+				return detail
+			}(),
 		},
-	}, &api.OpenShiftClusterDocument{
-		Key: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroupname/providers/microsoft.redhatopenshift/openshiftclusters/creating",
-		OpenShiftCluster: &api.OpenShiftCluster{
-			ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroupName/providers/microsoft.redhatopenshift/openshiftclusters/creating",
-			Properties: api.OpenShiftClusterProperties{
-				ProvisioningState: api.ProvisioningStateCreating,
+		{
+			name: "cluster unreachable",
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: key,
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID: resourceID,
+						Properties: api.OpenShiftClusterProperties{
+							ProvisioningState:       api.ProvisioningStateFailed,
+							FailedProvisioningState: api.ProvisioningStateUpdating,
+						},
+					},
+				})
+				f.AddAsyncOperationDocuments(&api.AsyncOperationDocument{
+					ID:                  "00000000-0000-0000-0000-000000000000",
+					OpenShiftClusterKey: key,
+					AsyncOperation: &api.AsyncOperation{
+						ID:                       "00000000-0000-0000-0000-000000000000",
+						InitialProvisioningState: api.ProvisioningStateUpdating,
+						ProvisioningState:        api.ProvisioningStateFailed,
+						StartTime:                startTime,
+						EndTime:                  &endTime,
+						Error: &api.CloudErrorBody{
+							Code:    api.CloudErrorCodeInternalServerError,
+							Message: "Internal server error.",
+						},
+					},
+				})
+			},
+			kubernetesErr:  fmt.Errorf("dial tcp: i/o timeout"),
+			wantStatusCode: http.StatusOK,
+			wantDetail: &clusterDetail{
+				ResourceID:              resourceID,
+				ProvisioningState:       "Failed",
+				FailedProvisioningState: "Updating",
+				WorkerProfiles:          []clusterWorkerProfile{},
+				IngressProfiles:         []clusterIngressProfile{},
+				ClusterOperators:        []clusterOperator{},
+				ClusterOperatorsError:   "dial tcp: i/o timeout",
+				Nodes:                   []clusterNode{},
+				NodesError:              "dial tcp: i/o timeout",
+				AsyncOperations: []clusterAsyncOperation{
+					{
+						ID:            "00000000-0000-0000-0000-000000000000",
+						InitialStatus: "Updating",
+						Status:        "Failed",
+						StartTime:     startTime,
+						EndTime:       &endTime,
+						Error:         "InternalServerError: Internal server error.",
+					},
+				},
 			},
 		},
-	})
+		{
+			name:           "cluster not found",
+			wantStatusCode: http.StatusNotFound,
+			wantBody:       "Not Found\n",
+		},
+		{
+			name: "bad resource id",
+			r: func(r *http.Request) {
+				r.URL.Path = "/api/clusters/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroupName/providers/microsoft.redhatopenshift/openshiftclusters/resourcename."
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "invalid resourceId \"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroupname/providers/microsoft.redhatopenshift/openshiftclusters/resourcename.\"\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dbOpenShiftClusters, _ := testdatabase.NewFakeOpenShiftClusters()
+			dbAsyncOperations, _ := testdatabase.NewFakeAsyncOperations()
 
-	err := fixture.Create()
-	if err != nil {
-		t.Fatal(err)
-	}
+			fixture := testdatabase.NewFixture().
+				WithOpenShiftClusters(dbOpenShiftClusters).
+				WithAsyncOperations(dbAsyncOperations)
 
-	p := &portal{
-		dbOpenShiftClusters: dbOpenShiftClusters,
-	}
+			if tt.fixture != nil {
+				tt.fixture(fixture)
+			}
 
-	w := httptest.NewRecorder()
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
 
-	p.clusters(w, &http.Request{})
+			p := &portal{
+				log:                 logrus.NewEntry(logrus.StandardLogger()),
+				dbOpenShiftClusters: dbOpenShiftClusters,
+				dbAsyncOperations:   dbAsyncOperations,
+				newKubernetes: func(*api.OpenShiftCluster) (kubernetes.Interface, error) {
+					if tt.kubernetesErr != nil {
+						return nil, tt.kubernetesErr
+					}
 
-	if w.Header().Get("Content-Type") != "application/json" {
-		t.Error(w.Header().Get("Content-Type"))
-	}
+					return fake.NewSimpleClientset(&corev1.Node{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "aro-worker-eastus1-abcde",
+							Labels: map[string]string{
+								"node-role.kubernetes.io/worker": "",
+							},
+						},
+						Spec: corev1.NodeSpec{
+							Unschedulable: true,
+						},
+						Status: corev1.NodeStatus{
+							Conditions: []corev1.NodeCondition{
+								{
+									Type:   corev1.NodeReady,
+									Status: corev1.ConditionFalse,
+								},
+							},
+						},
+					}, &corev1.Node{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "aro-master-0",
+							Labels: map[string]string{
+								"node-role.kubernetes.io/master": "",
+							},
+						},
+						Status: corev1.NodeStatus{
+							Conditions: []corev1.NodeCondition{
+								{
+									Type:   corev1.NodeReady,
+									Status: corev1.ConditionTrue,
+								},
+							},
+							Addresses: []corev1.NodeAddress{
+								{
+									Type:    corev1.NodeInternalIP,
+									Address: "10.0.0.4",
+								},
+							},
+							NodeInfo: corev1.NodeSystemInfo{
+								KubeletVersion: "v1.19.0",
+							},
+						},
+					}), nil
+				},
+				newConfig: func(*api.OpenShiftCluster) (configclient.Interface, error) {
+					if tt.kubernetesErr != nil {
+						return nil, tt.kubernetesErr
+					}
+
+					return configfake.NewSimpleClientset(&configv1.ClusterOperator{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "console",
+						},
+						Status: configv1.ClusterOperatorStatus{
+							Conditions: []configv1.ClusterOperatorStatusCondition{
+								{
+									Type:    configv1.OperatorAvailable,
+									Status:  configv1.ConditionFalse,
+									Message: "unavailable",
+								},
+								{
+									Type:    configv1.OperatorProgressing,
+									Status:  configv1.ConditionTrue,
+									Message: "progressing",
+								},
+								{
+									Type:    configv1.OperatorDegraded,
+									Status:  configv1.ConditionTrue,
+									Message: "degraded",
+								},
+							},
+						},
+					}, &configv1.ClusterOperator{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "authentication",
+						},
+						Status: configv1.ClusterOperatorStatus{
+							Conditions: []configv1.ClusterOperatorStatusCondition{
+								{
+									Type:   configv1.OperatorAvailable,
+									Status: configv1.ConditionTrue,
+								},
+								{
+									Type:   configv1.OperatorProgressing,
+									Status: configv1.ConditionFalse,
+								},
+							},
+							Versions: []configv1.OperandVersion{
+								{
+									Name:    "operator",
+									Version: "4.6.18",
+								},
+							},
+						},
+					}), nil
+				},
+			}
+
+			router := &mux.Router{}
+			router.NewRoute().Methods(http.MethodGet).Path("/api/clusters/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/microsoft.redhatopenshift/openshiftclusters/{resourceName}").HandlerFunc(p.cluster)
+
+			r, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://localhost:8444/api/clusters"+resourceID, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.r != nil {
+				tt.r(r)
+			}
+
+			w := httptest.NewRecorder()
+
+			frontendmiddleware.Lowercase(router).ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatusCode {
+				t.Error(w.Code)
+			}
+
+			if tt.wantDetail == nil {
+				if w.Body.String() != tt.wantBody {
+					t.Error(w.Body.String())
+				}
+				return
+			}
+
+			if w.Header().Get("Content-Type") != "application/json" {
+				t.Error(w.Header().Get("Content-Type"))
+			}
 
-	var r []string
-	err = json.NewDecoder(w.Body).Decode(&r)
-	if err != nil {
-		t.Fatal(err)
+			var detail *clusterDetail
+			err = json.NewDecoder(w.Body).Decode(&detail)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, diff := range deep.Equal(detail, tt.wantDetail) {
+				t.Error(diff)
+			}
+		})
 	}
+}
+
+func TestRestrictAuditors(t *testing.T) {
+	groupIDs := []string{"00000000-0000-0000-0000-000000000001"}
+	auditorGroupIDs := []string{"00000000-0000-0000-0000-000000000002"}
+
+	for _, tt := range []struct {
+		name           string
+		path           string
+		groups         []string
+		wantStatusCode int
+	}{
+		{
+			name:           "auditor - index",
+			path:           "/",
+			groups:         auditorGroupIDs,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "auditor - asset",
+			path:           "/index.js",
+			groups:         auditorGroupIDs,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "auditor - audit log",
+			path:           "/api/audit",
+			groups:         auditorGroupIDs,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "auditor - audit log export",
+			path:           "/api/audit/export",
+			groups:         auditorGroupIDs,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "auditor - ssh recordings",
+			path:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroupname/providers/microsoft.redhatopenshift/openshiftclusters/resourcename/ssh/recordings",
+			groups:         auditorGroupIDs,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "auditor - ssh recording",
+			path:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroupname/providers/microsoft.redhatopenshift/openshiftclusters/resourcename/ssh/recordings/00000000-0000-0000-0000-000000000000",
+			groups:         auditorGroupIDs,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "auditor - ssh",
+			path:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroupname/providers/microsoft.redhatopenshift/openshiftclusters/resourcename/ssh/new",
+			groups:         auditorGroupIDs,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "auditor - clusters",
+			path:           "/api/clusters",
+			groups:         auditorGroupIDs,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "auditor - kubeconfig",
+			path:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroupname/providers/microsoft.redhatopenshift/openshiftclusters/resourcename/kubeconfig/new",
+			groups:         auditorGroupIDs,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "auditor with access - clusters",
+			path:           "/api/clusters",
+			groups:         append(append([]string{}, auditorGroupIDs...), groupIDs...),
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "not auditor - clusters",
+			path:           "/api/clusters",
+			groups:         groupIDs,
+			wantStatusCode: http.StatusOK,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &portal{
+				groupIDs:        groupIDs,
+				auditorGroupIDs: auditorGroupIDs,
+			}
+
+			r, err := http.NewRequest(http.MethodGet, "https://server"+tt.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r = r.WithContext(context.WithValue(r.Context(), middleware.ContextKeyGroups, tt.groups))
+
+			w := httptest.NewRecorder()
+
+			p.restrictAuditors(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})).ServeHTTP(w, r)
 
-	if !reflect.DeepEqual(r, []string{"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroupName/providers/microsoft.redhatopenshift/openshiftclusters/succeeded"}) {
-		t.Error(r)
+			if w.Code != tt.wantStatusCode {
+				t.Error(w.Code)
+			}
+		})
 	}
 }
@@ -0,0 +1,239 @@
+package prometheus
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type unit int
+
+const (
+	unitNone unit = iota
+	unitSeconds
+	unitBytes
+	unitRatio
+	unitPerSecond
+)
+
+// format returns v formatted for display as a value of unit u
+func (u unit) format(v float64) string {
+	switch u {
+	case unitSeconds:
+		switch a := math.Abs(v); {
+		case a == 0:
+			return "0s"
+		case a < 1e-3:
+			return formatFloat(v*1e6) + "µs"
+		case a < 1:
+			return formatFloat(v*1e3) + "ms"
+		}
+		return formatFloat(v) + "s"
+
+	case unitBytes:
+		return humanize(v, 1024, []string{"B", "KiB", "MiB", "GiB", "TiB"})
+
+	case unitRatio:
+		return formatFloat(v*100) + "%"
+
+	case unitPerSecond:
+		return humanize(v, 1000, []string{"", "k", "M", "G"}) + "/s"
+	}
+
+	return humanize(v, 1000, []string{"", "k", "M", "G"})
+}
+
+func humanize(v, base float64, suffixes []string) string {
+	i := 0
+	for math.Abs(v) >= base && i < len(suffixes)-1 {
+		v /= base
+		i++
+	}
+
+	return formatFloat(v) + suffixes[i]
+}
+
+// formatFloat formats v with at most two decimal places
+func formatFloat(v float64) string {
+	s := strconv.FormatFloat(v, 'f', 2, 64)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	if s == "-0" {
+		s = "0"
+	}
+	return s
+}
+
+const (
+	chartWidth  = 720
+	chartHeight = 240
+
+	plotLeft   = 70
+	plotRight  = chartWidth - 10
+	plotTop    = 10
+	plotBottom = chartHeight - 30
+
+	gridlines = 4
+)
+
+// palette is the set of colours used for the series of a chart
+var palette = []string{
+	"#1f77b4", "#ff7f0e", "#2ca02c", "#d62728", "#9467bd",
+	"#8c564b", "#e377c2", "#7f7f7f", "#bcbd22", "#17becf",
+}
+
+// chartTemplate renders a chart as an inline SVG followed by its legend.
+// html/template escapes the series names, which come from the cluster.
+var chartTemplate = template.Must(template.New("chart").Parse(`<svg xmlns="http://www.w3.org/2000/svg" class="chart" viewBox="0 0 {{.Width}} {{.Height}}" preserveAspectRatio="xMinYMin meet">
+{{- range .Gridlines}}
+<line x1="{{$.Left}}" y1="{{.Y}}" x2="{{$.Right}}" y2="{{.Y}}" stroke="#dee2e6" stroke-width="1"/>
+<text x="{{$.LabelX}}" y="{{.Y}}" font-size="11" text-anchor="end" dominant-baseline="middle">{{.Label}}</text>
+{{- end}}
+{{- range .Series}}{{$color := .Color}}{{range .Points}}
+<polyline fill="none" stroke="{{$color}}" stroke-width="1.5" points="{{.}}"/>
+{{- end}}{{end}}
+<text x="{{.Left}}" y="{{.TimeY}}" font-size="11" text-anchor="start">{{.Start}}</text>
+<text x="{{.Right}}" y="{{.TimeY}}" font-size="11" text-anchor="end">{{.End}}</text>
+</svg>
+{{- if .Series}}
+<ul class="list-unstyled small chart-legend">
+{{- range .Series}}
+<li><svg width="10" height="10"><rect width="10" height="10" fill="{{.Color}}"/></svg> {{.Name}}</li>
+{{- end}}
+</ul>
+{{- else}}
+<p class="text-muted small">No data</p>
+{{- end}}
+{{- if .Omitted}}
+<p class="text-muted small">{{.Omitted}} more series not shown</p>
+{{- end}}
+`))
+
+type chartGridline struct {
+	Y     int
+	Label string
+}
+
+type chartSeries struct {
+	Name   string
+	Color  string
+	Points []string
+}
+
+type chartData struct {
+	Width, Height       int
+	Left, Right, LabelX int
+	TimeY               int
+	Start, End          string
+	Gridlines           []chartGridline
+	Series              []chartSeries
+	Omitted             int
+}
+
+// renderChart renders the given series between start and end into HTML.  At
+// most maxSeries series are drawn: those with the highest peak values.  Lines
+// are broken where samples are missing.
+func renderChart(s []series, u unit, start, end time.Time, step time.Duration) (string, error) {
+	data := &chartData{
+		Width:  chartWidth,
+		Height: chartHeight,
+		Left:   plotLeft,
+		Right:  plotRight,
+		LabelX: plotLeft - 5,
+		TimeY:  chartHeight - 10,
+		Start:  formatTime(start, end.Sub(start)),
+		End:    formatTime(end, end.Sub(start)),
+	}
+
+	s = topSeries(s)
+	if len(s) > maxSeries {
+		data.Omitted = len(s) - maxSeries
+		s = s[:maxSeries]
+	}
+	sort.Slice(s, func(i, j int) bool { return s[i].Name < s[j].Name })
+
+	min, max := 0., 0.
+	for _, ss := range s {
+		for _, sample := range ss.Samples {
+			min = math.Min(min, sample.Value)
+			max = math.Max(max, sample.Value)
+		}
+	}
+	if max == min {
+		max = min + 1
+	}
+
+	for i := 0; i <= gridlines; i++ {
+		v := min + (max-min)*float64(i)/gridlines
+		data.Gridlines = append(data.Gridlines, chartGridline{
+			Y:     scaleY(v, min, max),
+			Label: u.format(v),
+		})
+	}
+
+	duration := end.Sub(start).Seconds()
+	for i, ss := range s {
+		cs := chartSeries{
+			Name:  ss.Name,
+			Color: palette[i%len(palette)],
+		}
+
+		var points []string
+		for j, sample := range ss.Samples {
+			if j > 0 && sample.Time.Sub(ss.Samples[j-1].Time) > 2*step {
+				cs.Points = append(cs.Points, strings.Join(points, " "))
+				points = nil
+			}
+
+			x := plotLeft + int(math.Round(sample.Time.Sub(start).Seconds()/duration*(plotRight-plotLeft)))
+			points = append(points, fmt.Sprintf("%d,%d", x, scaleY(sample.Value, min, max)))
+		}
+		if len(points) > 0 {
+			cs.Points = append(cs.Points, strings.Join(points, " "))
+		}
+
+		data.Series = append(data.Series, cs)
+	}
+
+	buf := &bytes.Buffer{}
+	err := chartTemplate.Execute(buf, data)
+	if err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// topSeries returns s ordered by descending peak value
+func topSeries(s []series) []series {
+	peak := func(ss series) float64 {
+		p := math.Inf(-1)
+		for _, sample := range ss.Samples {
+			p = math.Max(p, sample.Value)
+		}
+		return p
+	}
+
+	s = append([]series(nil), s...)
+	sort.SliceStable(s, func(i, j int) bool { return peak(s[i]) > peak(s[j]) })
+
+	return s
+}
+
+func scaleY(v, min, max float64) int {
+	return plotBottom - int(math.Round((v-min)/(max-min)*(plotBottom-plotTop)))
+}
+
+func formatTime(t time.Time, duration time.Duration) string {
+	if duration > 24*time.Hour {
+		return t.Format("2006-01-02 15:04 MST")
+	}
+	return t.Format("15:04 MST")
+}
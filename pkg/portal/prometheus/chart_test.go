@@ -0,0 +1,73 @@
+package prometheus
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUnitFormat(t *testing.T) {
+	for _, tt := range []struct {
+		u    unit
+		v    float64
+		want string
+	}{
+		{u: unitNone, v: 0, want: "0"},
+		{u: unitNone, v: 1500, want: "1.5k"},
+		{u: unitSeconds, v: 0.0000125, want: "12.5µs"},
+		{u: unitSeconds, v: 0.25, want: "250ms"},
+		{u: unitSeconds, v: 2, want: "2s"},
+		{u: unitBytes, v: 3 * 1024 * 1024 * 1024, want: "3GiB"},
+		{u: unitRatio, v: 0.1234, want: "12.34%"},
+		{u: unitPerSecond, v: 12.345, want: "12.35/s"},
+	} {
+		if got := tt.u.format(tt.v); got != tt.want {
+			t.Errorf("%d %v: got %q, want %q", tt.u, tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestRenderChart(t *testing.T) {
+	start := time.Date(2021, 1, 1, 11, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	step := time.Minute
+
+	s := []series{
+		{
+			Name: "gap",
+			Samples: []sample{
+				{Time: start, Value: 1},
+				{Time: start.Add(time.Minute), Value: 2},
+				{Time: start.Add(30 * time.Minute), Value: 3},
+			},
+		},
+	}
+	for i := 0; i < maxSeries; i++ {
+		s = append(s, series{
+			Name:    "low",
+			Samples: []sample{{Time: start, Value: 0}},
+		})
+	}
+
+	html, err := renderChart(s, unitNone, start, end, step)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the series with the lowest peak is omitted, and the series with a gap
+	// is drawn as two lines
+	if !strings.Contains(html, "1 more series not shown") {
+		t.Error(html)
+	}
+
+	if !strings.Contains(html, `points="70,143 81,77"`) || !strings.Contains(html, `points="390,10"`) {
+		t.Error(html)
+	}
+
+	if strings.Count(html, "<polyline") != maxSeries+1 {
+		t.Error(strings.Count(html, "<polyline"))
+	}
+}
@@ -0,0 +1,419 @@
+package prometheus
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Azure/ARO-RP/pkg/api/validate"
+	"github.com/Azure/ARO-RP/pkg/util/recover"
+)
+
+// Dashboards are sets of predefined PromQL queries which are run against the
+// cluster's Prometheus over a selectable time range.  The results are rendered
+// into charts on the server, so the portal needs no charting library and
+// metric labels, which are under the control of the cluster, are always
+// escaped.
+
+type dashboard struct {
+	Name   string  `json:"name"`
+	Title  string  `json:"title"`
+	Panels []panel `json:"-"`
+}
+
+type panel struct {
+	Title string
+	Query string
+	Unit  unit
+}
+
+var dashboards = []dashboard{
+	{
+		Name:  "apiserver",
+		Title: "API server",
+		Panels: []panel{
+			{
+				Title: "Request latency (99th percentile) by verb",
+				Query: `histogram_quantile(0.99, sum by (verb, le) (rate(apiserver_request_duration_seconds_bucket{job="apiserver", verb!~"WATCH|CONNECT"}[5m])))`,
+				Unit:  unitSeconds,
+			},
+			{
+				Title: "Request rate by code",
+				Query: `sum by (code) (rate(apiserver_request_total{job="apiserver"}[5m]))`,
+				Unit:  unitPerSecond,
+			},
+			{
+				Title: "Error ratio (5xx)",
+				Query: `sum(rate(apiserver_request_total{job="apiserver", code=~"5.."}[5m])) / sum(rate(apiserver_request_total{job="apiserver"}[5m]))`,
+				Unit:  unitRatio,
+			},
+			{
+				Title: "Requests in flight",
+				Query: `sum by (instance, request_kind) (apiserver_current_inflight_requests)`,
+				Unit:  unitNone,
+			},
+		},
+	},
+	{
+		Name:  "etcd",
+		Title: "etcd",
+		Panels: []panel{
+			{
+				Title: "WAL fsync latency (99th percentile)",
+				Query: `histogram_quantile(0.99, sum by (instance, le) (rate(etcd_disk_wal_fsync_duration_seconds_bucket[5m])))`,
+				Unit:  unitSeconds,
+			},
+			{
+				Title: "Backend commit latency (99th percentile)",
+				Query: `histogram_quantile(0.99, sum by (instance, le) (rate(etcd_disk_backend_commit_duration_seconds_bucket[5m])))`,
+				Unit:  unitSeconds,
+			},
+			{
+				Title: "Leader changes",
+				Query: `sum(increase(etcd_server_leader_changes_seen_total[15m]))`,
+				Unit:  unitNone,
+			},
+			{
+				Title: "Database size",
+				Query: `etcd_mvcc_db_total_size_in_bytes`,
+				Unit:  unitBytes,
+			},
+		},
+	},
+	{
+		Name:  "nodes",
+		Title: "Node resources",
+		Panels: []panel{
+			{
+				Title: "CPU utilisation",
+				Query: `1 - avg by (instance) (rate(node_cpu_seconds_total{mode="idle"}[5m]))`,
+				Unit:  unitRatio,
+			},
+			{
+				Title: "Memory utilisation",
+				Query: `1 - node_memory_MemAvailable_bytes / node_memory_MemTotal_bytes`,
+				Unit:  unitRatio,
+			},
+			{
+				Title: "Filesystem utilisation",
+				Query: `max by (instance) (1 - node_filesystem_avail_bytes{fstype=~"xfs|ext4"} / node_filesystem_size_bytes{fstype=~"xfs|ext4"})`,
+				Unit:  unitRatio,
+			},
+			{
+				Title: "Not ready nodes",
+				Query: `sum(kube_node_status_condition{condition="Ready", status!="true"})`,
+				Unit:  unitNone,
+			},
+		},
+	},
+	{
+		Name:  "ingress",
+		Title: "Ingress",
+		Panels: []panel{
+			{
+				Title: "Error ratio (5xx)",
+				Query: `sum(rate(haproxy_server_http_responses_total{code="5xx"}[5m])) / sum(rate(haproxy_server_http_responses_total[5m]))`,
+				Unit:  unitRatio,
+			},
+			{
+				Title: "5xx responses by route",
+				Query: `sum by (namespace, route) (rate(haproxy_server_http_responses_total{code="5xx"}[5m])) > 0`,
+				Unit:  unitPerSecond,
+			},
+			{
+				Title: "Request rate",
+				Query: `sum(rate(haproxy_frontend_http_requests_total[5m]))`,
+				Unit:  unitPerSecond,
+			},
+			{
+				Title: "Active connections by router",
+				Query: `sum by (pod) (haproxy_frontend_current_sessions)`,
+				Unit:  unitNone,
+			},
+		},
+	},
+}
+
+// timeRanges are the selectable dashboard time ranges
+var timeRanges = map[string]time.Duration{
+	"1h":  time.Hour,
+	"3h":  3 * time.Hour,
+	"6h":  6 * time.Hour,
+	"12h": 12 * time.Hour,
+	"24h": 24 * time.Hour,
+	"7d":  7 * 24 * time.Hour,
+}
+
+const (
+	defaultTimeRange = "1h"
+
+	// points is the number of samples requested per series
+	points = 240
+
+	// maxSeries is the maximum number of series drawn on a chart
+	maxSeries = 10
+)
+
+type dashboardResponse struct {
+	Name   string          `json:"name"`
+	Title  string          `json:"title"`
+	Range  string          `json:"range"`
+	Start  int64           `json:"start"`
+	End    int64           `json:"end"`
+	Panels []panelResponse `json:"panels"`
+}
+
+type panelResponse struct {
+	Title string `json:"title"`
+	Query string `json:"query"`
+	HTML  string `json:"html,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+func (p *prometheus) addDashboardRoutes(aadAuthenticatedRouter *mux.Router) {
+	aadAuthenticatedRouter.NewRoute().Methods(http.MethodGet).Path("/api/dashboards").HandlerFunc(p.listDashboards)
+	aadAuthenticatedRouter.NewRoute().Methods(http.MethodGet).Path("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/microsoft.redhatopenshift/openshiftclusters/{resourceName}/dashboards/{dashboard}").HandlerFunc(p.dashboard)
+}
+
+func (p *prometheus) listDashboards(w http.ResponseWriter, r *http.Request) {
+	p.sendResponse(w, dashboards)
+}
+
+// dashboard runs the queries of a dashboard against the cluster's Prometheus
+// over the time range given by the range query parameter and returns the
+// rendered panels.  A failing query is reported on its panel and does not fail
+// the whole dashboard.
+func (p *prometheus) dashboard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resourceID := strings.Join(strings.Split(r.URL.Path, "/")[:9], "/")
+	if !validate.RxClusterID.MatchString(resourceID) {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var d *dashboard
+	for i := range dashboards {
+		if dashboards[i].Name == mux.Vars(r)["dashboard"] {
+			d = &dashboards[i]
+			break
+		}
+	}
+	if d == nil {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	timeRange := r.URL.Query().Get("range")
+	if timeRange == "" {
+		timeRange = defaultTimeRange
+	}
+
+	duration, ok := timeRanges[timeRange]
+	if !ok {
+		http.Error(w, fmt.Sprintf("invalid range %q", timeRange), http.StatusBadRequest)
+		return
+	}
+
+	cli, err := p.cachedCli(ctx, resourceID)
+	if err != nil {
+		p.internalServerError(w, err)
+		return
+	}
+
+	end := p.now().UTC().Truncate(time.Minute)
+	start := end.Add(-duration)
+	step := duration / points
+
+	resp := &dashboardResponse{
+		Name:   d.Name,
+		Title:  d.Title,
+		Range:  timeRange,
+		Start:  start.Unix(),
+		End:    end.Unix(),
+		Panels: make([]panelResponse, len(d.Panels)),
+	}
+
+	var wg sync.WaitGroup
+	for i, panel := range d.Panels {
+		wg.Add(1)
+
+		go func(i int, panel panelResponse, unit unit) {
+			defer recover.Panic(p.log)
+			defer wg.Done()
+
+			series, err := queryRange(ctx, cli, panel.Query, start, end, step)
+			if err != nil {
+				p.log.Warn(err)
+				panel.Error = err.Error()
+			} else {
+				panel.HTML, err = renderChart(series, unit, start, end, step)
+				if err != nil {
+					p.log.Warn(err)
+					panel.Error = "internal error rendering chart"
+				}
+			}
+
+			resp.Panels[i] = panel
+		}(i, panelResponse{Title: panel.Title, Query: panel.Query}, panel.Unit)
+	}
+	wg.Wait()
+
+	p.sendResponse(w, resp)
+}
+
+// series is a single time series returned by a range query
+type series struct {
+	Name    string
+	Samples []sample
+}
+
+type sample struct {
+	Time  time.Time
+	Value float64
+}
+
+// queryRange runs query against the Prometheus API and returns the resulting
+// series, ordered by name
+func queryRange(ctx context.Context, cli *http.Client, query string, start, end time.Time, step time.Duration) ([]series, error) {
+	v := url.Values{
+		"query": []string{query},
+		"start": []string{strconv.FormatInt(start.Unix(), 10)},
+		"end":   []string{strconv.FormatInt(end.Unix(), 10)},
+		"step":  []string{strconv.FormatFloat(step.Seconds(), 'f', -1, 64)},
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://prometheus-k8s-0:9090/api/v1/query_range?"+v.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	resp, err := cli.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var qr struct {
+		Status string `json:"status"`
+		Error  string `json:"error"`
+		Data   struct {
+			ResultType string `json:"resultType"`
+			Result     []struct {
+				Metric map[string]string `json:"metric"`
+				Values [][2]interface{}  `json:"values"`
+			} `json:"result"`
+		} `json:"data"`
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&qr)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected response from Prometheus (status %d)", resp.StatusCode)
+	}
+
+	if qr.Status != "success" {
+		return nil, fmt.Errorf("query failed: %s", qr.Error)
+	}
+
+	if qr.Data.ResultType != "matrix" {
+		return nil, fmt.Errorf("unexpected result type %q", qr.Data.ResultType)
+	}
+
+	s := make([]series, 0, len(qr.Data.Result))
+	for _, result := range qr.Data.Result {
+		ss := series{
+			Name:    seriesName(result.Metric),
+			Samples: make([]sample, 0, len(result.Values)),
+		}
+
+		for _, value := range result.Values {
+			t, ok := value[0].(float64)
+			if !ok {
+				return nil, fmt.Errorf("invalid sample time %v", value[0])
+			}
+
+			s, ok := value[1].(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid sample value %v", value[1])
+			}
+
+			f, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return nil, err
+			}
+
+			if math.IsNaN(f) || math.IsInf(f, 0) {
+				continue
+			}
+
+			sec, frac := math.Modf(t)
+			ss.Samples = append(ss.Samples, sample{
+				Time:  time.Unix(int64(sec), int64(frac*1e9)).UTC(),
+				Value: f,
+			})
+		}
+
+		s = append(s, ss)
+	}
+
+	sort.Slice(s, func(i, j int) bool { return s[i].Name < s[j].Name })
+
+	return s, nil
+}
+
+// seriesName returns a legend for a series in the form
+// {label1="value1", label2="value2"}, omitting the metric name
+func seriesName(metric map[string]string) string {
+	keys := make([]string, 0, len(metric))
+	for k := range metric {
+		if k != "__name__" {
+			keys = append(keys, k)
+		}
+	}
+
+	if len(keys) == 0 {
+		if name, ok := metric["__name__"]; ok {
+			return name
+		}
+		return "value"
+	}
+
+	sort.Strings(keys)
+
+	labels := make([]string, 0, len(keys))
+	for _, k := range keys {
+		labels = append(labels, fmt.Sprintf("%s=%q", k, metric[k]))
+	}
+
+	return "{" + strings.Join(labels, ", ") + "}"
+}
+
+func (p *prometheus) sendResponse(w http.ResponseWriter, resp interface{}) {
+	b, err := json.MarshalIndent(resp, "", "    ")
+	if err != nil {
+		p.internalServerError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(b)
+}
+
+func (p *prometheus) internalServerError(w http.ResponseWriter, err error) {
+	p.log.Warn(err)
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
@@ -0,0 +1,288 @@
+package prometheus
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/portal/util/clientcache"
+	"github.com/Azure/ARO-RP/pkg/portal/util/responsewriter"
+	"github.com/Azure/ARO-RP/pkg/util/roundtripper"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestDashboard(t *testing.T) {
+	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroup/providers/microsoft.redhatopenshift/openshiftclusters/cluster"
+	now := time.Date(2021, 1, 1, 12, 0, 30, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name           string
+		r              func(*http.Request)
+		prometheus     func(*http.Request) (*http.Response, error)
+		noCli          bool
+		wantStatusCode int
+		wantQuery      map[string]string
+		wantRange      string
+		wantPanel      func(*testing.T, *panelResponse)
+		wantBody       string
+	}{
+		{
+			name: "success",
+			r: func(r *http.Request) {
+				r.URL.RawQuery = "range=6h"
+			},
+			prometheus: func(r *http.Request) (*http.Response, error) {
+				return jsonResponse(http.StatusOK, `{"status":"success","data":{"resultType":"matrix","result":[{"metric":{"instance":"<master-0>"},"values":[[1609480800,"0.5"],[1609481700,"NaN"],[1609502400,"0.75"]]}]}}`), nil
+			},
+			wantStatusCode: http.StatusOK,
+			wantQuery: map[string]string{
+				"start": "1609480800",
+				"end":   "1609502400",
+				"step":  "90",
+			},
+			wantRange: "6h",
+			wantPanel: func(t *testing.T, panel *panelResponse) {
+				if panel.Error != "" {
+					t.Fatal(panel.Error)
+				}
+				for _, want := range []string{
+					"<polyline",
+					`{instance=&#34;&lt;master-0&gt;&#34;}`,
+				} {
+					if !strings.Contains(panel.HTML, want) {
+						t.Errorf("%q not found in %s", want, panel.HTML)
+					}
+				}
+				if strings.Contains(panel.HTML, "<master-0>") {
+					t.Error("series name was not escaped")
+				}
+			},
+		},
+		{
+			name:           "success - default range",
+			prometheus:     fakeEmptyPrometheus,
+			wantStatusCode: http.StatusOK,
+			wantQuery: map[string]string{
+				"start": "1609498800",
+				"end":   "1609502400",
+				"step":  "15",
+			},
+			wantRange: "1h",
+			wantPanel: func(t *testing.T, panel *panelResponse) {
+				if !strings.Contains(panel.HTML, "No data") {
+					t.Error(panel.HTML)
+				}
+			},
+		},
+		{
+			name: "query error is reported on the panel",
+			prometheus: func(r *http.Request) (*http.Response, error) {
+				return jsonResponse(http.StatusBadRequest, `{"status":"error","errorType":"bad_data","error":"parse error"}`), nil
+			},
+			wantStatusCode: http.StatusOK,
+			wantRange:      "1h",
+			wantPanel: func(t *testing.T, panel *panelResponse) {
+				if panel.Error != "query failed: parse error" {
+					t.Error(panel.Error)
+				}
+				if panel.HTML != "" {
+					t.Error(panel.HTML)
+				}
+			},
+		},
+		{
+			name: "unreachable prometheus is reported on the panel",
+			prometheus: func(r *http.Request) (*http.Response, error) {
+				return nil, fmt.Errorf("sad")
+			},
+			wantStatusCode: http.StatusOK,
+			wantRange:      "1h",
+			wantPanel: func(t *testing.T, panel *panelResponse) {
+				if !strings.HasSuffix(panel.Error, "sad") {
+					t.Error(panel.Error)
+				}
+			},
+		},
+		{
+			name: "invalid range",
+			r: func(r *http.Request) {
+				r.URL.RawQuery = "range=1y"
+			},
+			prometheus:     fakeEmptyPrometheus,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "invalid range \"1y\"\n",
+		},
+		{
+			name: "unknown dashboard",
+			r: func(r *http.Request) {
+				r.URL.Path = resourceID + "/dashboards/unknown"
+			},
+			prometheus:     fakeEmptyPrometheus,
+			wantStatusCode: http.StatusNotFound,
+			wantBody:       "Not Found\n",
+		},
+		{
+			name:           "no client",
+			noCli:          true,
+			wantStatusCode: http.StatusInternalServerError,
+			wantBody:       "Internal Server Error\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var mu sync.Mutex
+			var queries []*http.Request
+			cli := &http.Client{
+				Transport: roundtripper.RoundTripperFunc(func(r *http.Request) (*http.Response, error) {
+					mu.Lock()
+					queries = append(queries, r)
+					mu.Unlock()
+					return tt.prometheus(r)
+				}),
+			}
+
+			dbOpenShiftClusters, _ := testdatabase.NewFakeOpenShiftClusters()
+
+			p := &prometheus{
+				log:                 logrus.NewEntry(logrus.StandardLogger()),
+				now:                 func() time.Time { return now },
+				dbOpenShiftClusters: dbOpenShiftClusters,
+				clientCache:         clientcache.New(time.Hour),
+			}
+			if !tt.noCli {
+				p.clientCache.Put(resourceID, cli)
+			}
+
+			router := mux.NewRouter()
+			p.addDashboardRoutes(router)
+
+			r, err := http.NewRequest(http.MethodGet, "https://server"+resourceID+"/dashboards/etcd", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r = r.WithContext(ctx)
+
+			if tt.r != nil {
+				tt.r(r)
+			}
+
+			w := responsewriter.New(r)
+			router.ServeHTTP(w, r)
+
+			resp := w.Response()
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Fatal(resp.StatusCode)
+			}
+
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantStatusCode != http.StatusOK {
+				if string(b) != tt.wantBody {
+					t.Error(string(b))
+				}
+				return
+			}
+
+			var dr *dashboardResponse
+			err = json.Unmarshal(b, &dr)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if dr.Name != "etcd" || dr.Range != tt.wantRange {
+				t.Error(dr.Name, dr.Range)
+			}
+
+			if len(dr.Panels) != len(dashboards[1].Panels) || len(queries) != len(dashboards[1].Panels) {
+				t.Fatal(len(dr.Panels), len(queries))
+			}
+
+			for i := range dr.Panels {
+				if dr.Panels[i].Title != dashboards[1].Panels[i].Title {
+					t.Error(dr.Panels[i].Title)
+				}
+				tt.wantPanel(t, &dr.Panels[i])
+			}
+
+			for _, query := range queries {
+				if query.URL.Host != "prometheus-k8s-0:9090" || query.URL.Path != "/api/v1/query_range" {
+					t.Error(query.URL)
+				}
+				for k, v := range tt.wantQuery {
+					if query.URL.Query().Get(k) != v {
+						t.Error(k, query.URL.Query().Get(k))
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestListDashboards(t *testing.T) {
+	p := &prometheus{
+		log: logrus.NewEntry(logrus.StandardLogger()),
+	}
+
+	router := mux.NewRouter()
+	p.addDashboardRoutes(router)
+
+	r, err := http.NewRequest(http.MethodGet, "https://server/api/dashboards", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := responsewriter.New(r)
+	router.ServeHTTP(w, r)
+
+	b, err := ioutil.ReadAll(w.Response().Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ds []dashboard
+	err = json.Unmarshal(b, &ds)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ds) != len(dashboards) {
+		t.Fatal(len(ds))
+	}
+
+	for i := range ds {
+		if ds[i].Name != dashboards[i].Name || ds[i].Title != dashboards[i].Title {
+			t.Error(ds[i])
+		}
+	}
+}
+
+func fakeEmptyPrometheus(r *http.Request) (*http.Response, error) {
+	return jsonResponse(http.StatusOK, `{"status":"success","data":{"resultType":"matrix","result":[]}}`), nil
+}
+
+func jsonResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Header: http.Header{
+			"Content-Type": []string{"application/json"},
+		},
+		Body: ioutil.NopCloser(strings.NewReader(body)),
+	}
+}
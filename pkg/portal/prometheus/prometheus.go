@@ -20,6 +20,7 @@ import (
 
 type prometheus struct {
 	log *logrus.Entry
+	now func() time.Time
 
 	dbOpenShiftClusters database.OpenShiftClusters
 
@@ -33,6 +34,7 @@ func New(baseLog *logrus.Entry,
 	aadAuthenticatedRouter *mux.Router) *prometheus {
 	p := &prometheus{
 		log: baseLog,
+		now: time.Now,
 
 		dbOpenShiftClusters: dbOpenShiftClusters,
 
@@ -53,5 +55,7 @@ func New(baseLog *logrus.Entry,
 	})
 	aadAuthenticatedRouter.NewRoute().PathPrefix("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/microsoft.redhatopenshift/openshiftclusters/{resourceName}/prometheus/").Handler(rp)
 
+	p.addDashboardRoutes(aadAuthenticatedRouter)
+
 	return p
 }
@@ -42,16 +42,10 @@ func (p *prometheus) director(r *http.Request) {
 		return
 	}
 
-	cli := p.clientCache.Get(resourceID)
-	if cli == nil {
-		var err error
-		cli, err = p.cli(ctx, resourceID)
-		if err != nil {
-			p.error(r, http.StatusInternalServerError, err)
-			return
-		}
-
-		p.clientCache.Put(resourceID, cli)
+	cli, err := p.cachedCli(ctx, resourceID)
+	if err != nil {
+		p.error(r, http.StatusInternalServerError, err)
+		return
 	}
 
 	r.RequestURI = ""
@@ -68,6 +62,24 @@ func (p *prometheus) director(r *http.Request) {
 	*r = *r.WithContext(context.WithValue(ctx, contextKeyClient, cli))
 }
 
+// cachedCli returns a cached client for the cluster's Prometheus, creating one
+// if necessary
+func (p *prometheus) cachedCli(ctx context.Context, resourceID string) (*http.Client, error) {
+	cli := p.clientCache.Get(resourceID)
+	if cli != nil {
+		return cli, nil
+	}
+
+	cli, err := p.cli(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	p.clientCache.Put(resourceID, cli)
+
+	return cli, nil
+}
+
 func (p *prometheus) cli(ctx context.Context, resourceID string) (*http.Client, error) {
 	openShiftDoc, err := p.dbOpenShiftClusters.Get(ctx, resourceID)
 	if err != nil {
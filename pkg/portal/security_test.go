@@ -21,6 +21,7 @@ import (
 	"github.com/gorilla/sessions"
 	"github.com/sirupsen/logrus"
 
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
 	"github.com/Azure/ARO-RP/pkg/portal/middleware"
 	"github.com/Azure/ARO-RP/pkg/util/log/audit"
 	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
@@ -32,6 +33,7 @@ import (
 
 var (
 	elevatedGroupIDs = []string{"00000000-0000-0000-0000-000000000000"}
+	auditorGroupIDs  = []string{"00000000-0000-0000-0000-000000000002"}
 )
 
 func TestSecurity(t *testing.T) {
@@ -68,8 +70,12 @@ func TestSecurity(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	dbOpenShiftClusters, _ := testdatabase.NewFakeOpenShiftClusters()
+	dbOpenShiftClusters, openShiftClustersClient := testdatabase.NewFakeOpenShiftClusters()
+	openShiftClustersClient.SetQueryHandler(defaultClusterSearchQuery, func(cosmosdb.OpenShiftClusterDocumentClient, *cosmosdb.Query, *cosmosdb.Options) cosmosdb.OpenShiftClusterDocumentRawIterator {
+		return cosmosdb.NewFakeOpenShiftClusterDocumentIterator(nil, 0)
+	})
 	dbPortal, _ := testdatabase.NewFakePortal()
+	dbAsyncOperations, _ := testdatabase.NewFakeAsyncOperations()
 
 	pool := x509.NewCertPool()
 	pool.AddCert(servercerts[0])
@@ -86,9 +92,9 @@ func TestSecurity(t *testing.T) {
 		},
 	}
 
-	p := NewPortal(_env, portalAuditLog, portalLog, portalAccessLog, l, sshl, nil, "", serverkey, servercerts, "", nil, nil, make([]byte, 32), sshkey, nil, elevatedGroupIDs, dbOpenShiftClusters, dbPortal, nil)
+	p := NewPortal(_env, portalAuditLog, portalLog, portalAccessLog, l, sshl, nil, "", serverkey, servercerts, "", nil, nil, make([]byte, 32), sshkey, nil, elevatedGroupIDs, auditorGroupIDs, dbOpenShiftClusters, dbPortal, dbAsyncOperations, nil)
 	go func() {
-		err := p.Run(ctx)
+		err := p.Run(ctx, nil, nil)
 		if err != nil {
 			log.Error(err)
 		}
@@ -142,6 +148,34 @@ func TestSecurity(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "/api/audit",
+			request: func() (*http.Request, error) {
+				return http.NewRequest(http.MethodGet, "https://server/api/audit", nil)
+			},
+			authenticatedWantStatusCode: http.StatusForbidden,
+			wantAuditOperation:          "GET /api/audit",
+			wantAuditTargetResources: []audit.TargetResource{
+				{
+					TargetResourceType: "",
+					TargetResourceName: "/api/audit",
+				},
+			},
+		},
+		{
+			name: "/api/audit/export",
+			request: func() (*http.Request, error) {
+				return http.NewRequest(http.MethodGet, "https://server/api/audit/export", nil)
+			},
+			authenticatedWantStatusCode: http.StatusForbidden,
+			wantAuditOperation:          "GET /api/audit/export",
+			wantAuditTargetResources: []audit.TargetResource{
+				{
+					TargetResourceType: "",
+					TargetResourceName: "/api/audit/export",
+				},
+			},
+		},
 		{
 			name: "/api/logout",
 			request: func() (*http.Request, error) {
@@ -345,6 +379,9 @@ func TestSecurity(t *testing.T) {
 				payload.OperationName = tt.wantAuditOperation
 				payload.TargetResources = tt.wantAuditTargetResources
 				payload.Result.ResultDescription = fmt.Sprintf("Status code: %d", tt2.wantStatusCode)
+				if tt2.wantStatusCode >= http.StatusBadRequest {
+					payload.Result.ResultType = audit.ResultTypeFail
+				}
 				if tt2.authenticated && tt.name != "/callback" && tt.name != "/healthz/ready" {
 					payload.CallerIdentities[0].CallerIdentityValue = "username"
 				}
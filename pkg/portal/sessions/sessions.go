@@ -0,0 +1,311 @@
+package sessions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/portal/middleware"
+	"github.com/Azure/ARO-RP/pkg/util/log/audit"
+)
+
+// This file lists and revokes portal sessions: the kubeconfig and SSH
+// PortalDocuments which grant access to clusters.  A session is revoked by
+// deleting its document; live connections using it notice and are closed (see
+// pkg/portal/util/revocation).  The ID of a PortalDocument is the bearer token
+// or SSH password of the session, so sessions are identified by a hash of it
+// instead.
+
+type sessions struct {
+	env   env.Core
+	log   *logrus.Entry
+	audit *logrus.Entry
+
+	elevatedGroupIDs []string
+
+	dbPortal database.Portal
+}
+
+func New(env env.Core,
+	log *logrus.Entry,
+	audit *logrus.Entry,
+	elevatedGroupIDs []string,
+	dbPortal database.Portal,
+	aadAuthenticatedRouter *mux.Router) *sessions {
+	s := &sessions{
+		env:   env,
+		log:   log,
+		audit: audit,
+
+		elevatedGroupIDs: elevatedGroupIDs,
+
+		dbPortal: dbPortal,
+	}
+
+	aadAuthenticatedRouter.NewRoute().Methods(http.MethodGet).Path("/api/sessions").HandlerFunc(s.list)
+	aadAuthenticatedRouter.NewRoute().Methods(http.MethodPost).Path("/api/sessions/revoke").HandlerFunc(s.revokeAll)
+	aadAuthenticatedRouter.NewRoute().Methods(http.MethodPost).Path("/api/sessions/{id}/revoke").HandlerFunc(s.revoke)
+
+	return s
+}
+
+type session struct {
+	ID            string `json:"id"`
+	Username      string `json:"username"`
+	ResourceID    string `json:"resourceId"`
+	Type          string `json:"type"`
+	Elevated      bool   `json:"elevated"`
+	Node          string `json:"node,omitempty"`
+	Authenticated bool   `json:"authenticated,omitempty"`
+	ExpiryTime    int64  `json:"expiryTime,omitempty"`
+}
+
+type filter struct {
+	Username   string `json:"username,omitempty"`
+	ResourceID string `json:"resourceId,omitempty"`
+}
+
+func (f *filter) matches(doc *api.PortalDocument) bool {
+	return (f.Username == "" || strings.EqualFold(doc.Portal.Username, f.Username)) &&
+		(f.ResourceID == "" || strings.EqualFold(doc.Portal.ID, f.ResourceID))
+}
+
+// sessionID returns the public identifier of the session held in doc
+func sessionID(doc *api.PortalDocument) string {
+	h := sha256.Sum256([]byte(doc.ID))
+	return hex.EncodeToString(h[:16])
+}
+
+func newSession(doc *api.PortalDocument) *session {
+	s := &session{
+		ID:         sessionID(doc),
+		Username:   doc.Portal.Username,
+		ResourceID: doc.Portal.ID,
+	}
+
+	if doc.Timestamp != 0 && doc.TTL != 0 {
+		s.ExpiryTime = int64(doc.Timestamp + doc.TTL)
+	}
+
+	switch {
+	case doc.Portal.SSH != nil:
+		s.Type = "ssh"
+		s.Elevated = true
+		s.Node = doc.Portal.SSH.Node
+		if s.Node == "" {
+			s.Node = fmt.Sprintf("master-%d", doc.Portal.SSH.Master)
+		}
+		s.Authenticated = doc.Portal.SSH.Authenticated
+
+	case doc.Portal.Kubeconfig != nil:
+		s.Type = "kubeconfig"
+		s.Elevated = doc.Portal.Kubeconfig.Elevated
+	}
+
+	return s
+}
+
+func (s *sessions) eligible(r *http.Request) bool {
+	return len(middleware.GroupsIntersect(s.elevatedGroupIDs, r.Context().Value(middleware.ContextKeyGroups).([]string))) > 0
+}
+
+// listDocs returns the session documents matching f
+func (s *sessions) listDocs(r *http.Request, f *filter) ([]*api.PortalDocument, error) {
+	docs, err := s.dbPortal.ListSessions(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	var matching []*api.PortalDocument
+	for _, doc := range docs.PortalDocuments {
+		if f.matches(doc) {
+			matching = append(matching, doc)
+		}
+	}
+
+	return matching, nil
+}
+
+// list returns the active sessions, optionally filtered by the username and
+// resourceId query parameters, ordered by user and cluster
+func (s *sessions) list(w http.ResponseWriter, r *http.Request) {
+	if !s.eligible(r) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	docs, err := s.listDocs(r, &filter{
+		Username:   r.URL.Query().Get("username"),
+		ResourceID: r.URL.Query().Get("resourceId"),
+	})
+	if err != nil {
+		s.internalServerError(w, err)
+		return
+	}
+
+	sessions := make([]*session, 0, len(docs))
+	for _, doc := range docs {
+		sessions = append(sessions, newSession(doc))
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		if sessions[i].Username != sessions[j].Username {
+			return sessions[i].Username < sessions[j].Username
+		}
+		if sessions[i].ResourceID != sessions[j].ResourceID {
+			return sessions[i].ResourceID < sessions[j].ResourceID
+		}
+		return sessions[i].ID < sessions[j].ID
+	})
+
+	s.sendResponse(w, sessions)
+}
+
+// revoke revokes a single session
+func (s *sessions) revoke(w http.ResponseWriter, r *http.Request) {
+	if !s.eligible(r) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	docs, err := s.listDocs(r, &filter{})
+	if err != nil {
+		s.internalServerError(w, err)
+		return
+	}
+
+	id := mux.Vars(r)["id"]
+	for _, doc := range docs {
+		if sessionID(doc) != id {
+			continue
+		}
+
+		revoked, err := s.revokeDocs(r, []*api.PortalDocument{doc})
+		if err != nil {
+			s.internalServerError(w, err)
+			return
+		}
+
+		if len(revoked) == 0 {
+			break
+		}
+
+		s.sendResponse(w, revoked[0])
+		return
+	}
+
+	http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+}
+
+// revokeAll revokes all the sessions of a user and/or cluster, given in the
+// request body
+func (s *sessions) revokeAll(w http.ResponseWriter, r *http.Request) {
+	mediatype, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediatype != "application/json" {
+		http.Error(w, http.StatusText(http.StatusUnsupportedMediaType), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	var f *filter
+	err := json.NewDecoder(r.Body).Decode(&f)
+	if err != nil || f == nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	f.Username = strings.TrimSpace(f.Username)
+	f.ResourceID = strings.TrimSpace(f.ResourceID)
+	if f.Username == "" && f.ResourceID == "" {
+		http.Error(w, "username or resourceId is required", http.StatusBadRequest)
+		return
+	}
+
+	if !s.eligible(r) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	docs, err := s.listDocs(r, f)
+	if err != nil {
+		s.internalServerError(w, err)
+		return
+	}
+
+	revoked, err := s.revokeDocs(r, docs)
+	if err != nil {
+		s.internalServerError(w, err)
+		return
+	}
+
+	s.sendResponse(w, revoked)
+}
+
+// revokeDocs deletes the given session documents, skipping any which have
+// already gone, and returns the sessions revoked
+func (s *sessions) revokeDocs(r *http.Request, docs []*api.PortalDocument) ([]*session, error) {
+	revoked := make([]*session, 0, len(docs))
+
+	for _, doc := range docs {
+		err := s.dbPortal.Delete(r.Context(), doc)
+		switch {
+		case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+			continue
+		case err != nil:
+			return nil, err
+		}
+
+		session := newSession(doc)
+		s.auditLog(r, session)
+		revoked = append(revoked, session)
+	}
+
+	return revoked, nil
+}
+
+// auditLog records the revocation of a session in the audit log, in addition
+// to the audit log record of the HTTP request made by the log middleware
+func (s *sessions) auditLog(r *http.Request, session *session) {
+	middleware.Audit(s.env, s.audit, r, &middleware.AuditRecord{
+		Category:           audit.CategoryAuthorization,
+		OperationName:      "RevokeSession",
+		TargetResourceName: session.ResourceID,
+		TargetResourceType: "session",
+		Description:        fmt.Sprintf("Revoked %s session %s of %s", session.Type, session.ID, session.Username),
+		Fields: logrus.Fields{
+			"session_id":   session.ID,
+			"session_type": session.Type,
+			"session_user": session.Username,
+		},
+	})
+}
+
+func (s *sessions) sendResponse(w http.ResponseWriter, resp interface{}) {
+	b, err := json.MarshalIndent(resp, "", "    ")
+	if err != nil {
+		s.internalServerError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(b)
+}
+
+func (s *sessions) internalServerError(w http.ResponseWriter, err error) {
+	s.log.Warn(err)
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
@@ -0,0 +1,367 @@
+package sessions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/portal/middleware"
+	"github.com/Azure/ARO-RP/pkg/portal/util/responsewriter"
+	"github.com/Azure/ARO-RP/pkg/util/log/audit"
+	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+func TestSessions(t *testing.T) {
+	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster"
+	otherResourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/other"
+	elevatedGroupIDs := []string{"10000000-0000-0000-0000-000000000000"}
+
+	aliceKubeconfig := func() *api.PortalDocument {
+		return &api.PortalDocument{
+			ID:  "00000000-0000-0000-0000-000000000001",
+			TTL: 21600,
+			Portal: &api.Portal{
+				Username:   "alice",
+				ID:         resourceID,
+				Kubeconfig: &api.Kubeconfig{},
+			},
+		}
+	}
+
+	aliceSSH := func() *api.PortalDocument {
+		return &api.PortalDocument{
+			ID:  "00000000-0000-0000-0000-000000000002",
+			TTL: 3600,
+			Portal: &api.Portal{
+				Username: "alice",
+				ID:       otherResourceID,
+				SSH: &api.SSH{
+					Master:        1,
+					Authenticated: true,
+				},
+			},
+		}
+	}
+
+	bobKubeconfig := func() *api.PortalDocument {
+		return &api.PortalDocument{
+			ID:  "00000000-0000-0000-0000-000000000003",
+			TTL: 14400,
+			Portal: &api.Portal{
+				Username: "bob",
+				ID:       resourceID,
+				Kubeconfig: &api.Kubeconfig{
+					Elevated: true,
+				},
+			},
+		}
+	}
+
+	accessRequest := func() *api.PortalDocument {
+		return &api.PortalDocument{
+			ID: "00000000-0000-0000-0000-000000000004",
+			Portal: &api.Portal{
+				Username: "bob",
+				ID:       resourceID,
+				AccessRequest: &api.AccessRequest{
+					State: api.AccessRequestStateApproved,
+				},
+			},
+		}
+	}
+
+	aliceKubeconfigSession := &session{
+		ID:         sessionID(aliceKubeconfig()),
+		Username:   "alice",
+		ResourceID: resourceID,
+		Type:       "kubeconfig",
+	}
+
+	aliceSSHSession := &session{
+		ID:            sessionID(aliceSSH()),
+		Username:      "alice",
+		ResourceID:    otherResourceID,
+		Type:          "ssh",
+		Elevated:      true,
+		Node:          "master-1",
+		Authenticated: true,
+	}
+
+	bobKubeconfigSession := &session{
+		ID:         sessionID(bobKubeconfig()),
+		Username:   "bob",
+		ResourceID: resourceID,
+		Type:       "kubeconfig",
+		Elevated:   true,
+	}
+
+	for _, tt := range []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		groups         []string
+		checker        func(*testdatabase.Checker, *cosmosdb.FakePortalDocumentClient)
+		wantStatusCode int
+		wantSessions   interface{}
+		wantBody       string
+		wantAudits     int
+	}{
+		{
+			name:           "list",
+			method:         http.MethodGet,
+			path:           "/api/sessions",
+			wantStatusCode: http.StatusOK,
+			wantSessions:   []*session{aliceKubeconfigSession, aliceSSHSession, bobKubeconfigSession},
+		},
+		{
+			name:           "list by user",
+			method:         http.MethodGet,
+			path:           "/api/sessions?username=Bob",
+			wantStatusCode: http.StatusOK,
+			wantSessions:   []*session{bobKubeconfigSession},
+		},
+		{
+			name:           "list by cluster",
+			method:         http.MethodGet,
+			path:           "/api/sessions?resourceId=" + strings.ToUpper(resourceID),
+			wantStatusCode: http.StatusOK,
+			wantSessions:   []*session{aliceKubeconfigSession, bobKubeconfigSession},
+		},
+		{
+			name:           "list not eligible",
+			method:         http.MethodGet,
+			path:           "/api/sessions",
+			groups:         []string{},
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       "Forbidden\n",
+		},
+		{
+			name:   "list sad database",
+			method: http.MethodGet,
+			path:   "/api/sessions",
+			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				checker.AddPortalDocuments(aliceKubeconfig(), aliceSSH(), bobKubeconfig(), accessRequest())
+				portalClient.SetError(fmt.Errorf("sad"))
+			},
+			wantStatusCode: http.StatusInternalServerError,
+			wantBody:       "Internal Server Error\n",
+		},
+		{
+			name:   "revoke",
+			method: http.MethodPost,
+			path:   "/api/sessions/" + aliceSSHSession.ID + "/revoke",
+			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				checker.AddPortalDocuments(aliceKubeconfig(), bobKubeconfig(), accessRequest())
+			},
+			wantStatusCode: http.StatusOK,
+			wantSessions:   aliceSSHSession,
+			wantAudits:     1,
+		},
+		{
+			name:   "revoke unknown",
+			method: http.MethodPost,
+			path:   "/api/sessions/" + sessionID(accessRequest()) + "/revoke",
+			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				checker.AddPortalDocuments(aliceKubeconfig(), aliceSSH(), bobKubeconfig(), accessRequest())
+			},
+			wantStatusCode: http.StatusNotFound,
+			wantBody:       "Not Found\n",
+		},
+		{
+			name:   "revoke not eligible",
+			method: http.MethodPost,
+			path:   "/api/sessions/" + aliceSSHSession.ID + "/revoke",
+			groups: []string{},
+			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				checker.AddPortalDocuments(aliceKubeconfig(), aliceSSH(), bobKubeconfig(), accessRequest())
+			},
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       "Forbidden\n",
+		},
+		{
+			name:   "revoke all of user",
+			method: http.MethodPost,
+			path:   "/api/sessions/revoke",
+			body:   `{"username":"alice"}`,
+			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				checker.AddPortalDocuments(bobKubeconfig(), accessRequest())
+			},
+			wantStatusCode: http.StatusOK,
+			wantSessions:   []*session{aliceKubeconfigSession, aliceSSHSession},
+			wantAudits:     2,
+		},
+		{
+			name:   "revoke all of cluster",
+			method: http.MethodPost,
+			path:   "/api/sessions/revoke",
+			body:   `{"resourceId":"` + resourceID + `"}`,
+			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				checker.AddPortalDocuments(aliceSSH(), accessRequest())
+			},
+			wantStatusCode: http.StatusOK,
+			wantSessions:   []*session{aliceKubeconfigSession, bobKubeconfigSession},
+			wantAudits:     2,
+		},
+		{
+			name:   "revoke all without filter",
+			method: http.MethodPost,
+			path:   "/api/sessions/revoke",
+			body:   `{"username":" "}`,
+			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				checker.AddPortalDocuments(aliceKubeconfig(), aliceSSH(), bobKubeconfig(), accessRequest())
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "username or resourceId is required\n",
+		},
+		{
+			name:           "revoke all junk",
+			method:         http.MethodPost,
+			path:           "/api/sessions/revoke",
+			body:           "{{",
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "Bad Request\n",
+		},
+		{
+			name:   "revoke all not eligible",
+			method: http.MethodPost,
+			path:   "/api/sessions/revoke",
+			body:   `{"username":"alice"}`,
+			groups: []string{},
+			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				checker.AddPortalDocuments(aliceKubeconfig(), aliceSSH(), bobKubeconfig(), accessRequest())
+			},
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       "Forbidden\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			dbPortal, portalClient := testdatabase.NewFakePortal()
+
+			fixture := testdatabase.NewFixture().
+				WithPortal(dbPortal)
+			fixture.AddPortalDocuments(aliceKubeconfig(), aliceSSH(), bobKubeconfig(), accessRequest())
+
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			checker := testdatabase.NewChecker()
+
+			if tt.checker != nil {
+				tt.checker(checker, portalClient)
+			} else {
+				checker.AddPortalDocuments(aliceKubeconfig(), aliceSSH(), bobKubeconfig(), accessRequest())
+			}
+
+			groups := elevatedGroupIDs
+			if tt.groups != nil {
+				groups = tt.groups
+			}
+
+			ctx = context.WithValue(ctx, middleware.ContextKeyUsername, "admin")
+			ctx = context.WithValue(ctx, middleware.ContextKeyGroups, groups)
+			r, err := http.NewRequestWithContext(ctx, tt.method, "https://localhost:8444"+tt.path, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r.Header.Set("Content-Type", "application/json")
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			_env := mock_env.NewMockCore(ctrl)
+			_env.EXPECT().Environment().AnyTimes().Return(&azure.PublicCloud)
+			_env.EXPECT().Hostname().AnyTimes().Return("testhost")
+			_env.EXPECT().Location().AnyTimes().Return("eastus")
+
+			aadAuthenticatedRouter := &mux.Router{}
+
+			auditHook, auditLog := testlog.NewAudit()
+			_, log := testlog.New()
+
+			New(_env, log, auditLog, elevatedGroupIDs, dbPortal, aadAuthenticatedRouter)
+
+			w := responsewriter.New(r)
+
+			aadAuthenticatedRouter.ServeHTTP(w, r)
+
+			portalClient.SetError(nil)
+
+			for _, err = range checker.CheckPortals(portalClient) {
+				t.Error(err)
+			}
+
+			resp := w.Response()
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Error(resp.StatusCode)
+			}
+
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantSessions != nil {
+				if resp.Header.Get("Content-Type") != "application/json" {
+					t.Error(resp.Header.Get("Content-Type"))
+				}
+
+				got := reflect.New(reflect.TypeOf(tt.wantSessions))
+				err = json.Unmarshal(b, got.Interface())
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !reflect.DeepEqual(got.Elem().Interface(), tt.wantSessions) {
+					t.Error(string(b))
+				}
+			} else if string(b) != tt.wantBody {
+				t.Errorf("%q", string(b))
+			}
+
+			if len(auditHook.AllEntries()) != tt.wantAudits {
+				t.Fatal(len(auditHook.AllEntries()))
+			}
+
+			for _, entry := range auditHook.AllEntries() {
+				var payload audit.Payload
+				err = json.Unmarshal([]byte(entry.Data[audit.MetadataPayload].(string)), &payload)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if payload.OperationName != "RevokeSession" {
+					t.Error(payload.OperationName)
+				}
+				if len(payload.CallerIdentities) != 1 || payload.CallerIdentities[0].CallerIdentityValue != "admin" {
+					t.Error(payload.CallerIdentities)
+				}
+				if len(payload.TargetResources) != 1 || payload.TargetResources[0].TargetResourceType != "session" {
+					t.Error(payload.TargetResources)
+				}
+			}
+		})
+	}
+}
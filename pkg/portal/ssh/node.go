@@ -0,0 +1,118 @@
+package ssh
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+
+	cryptossh "golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+// This file handles SSH access to nodes requested by name.  Masters are
+// reachable directly on their NAT port on the API server private endpoint;
+// other nodes are not reachable from the portal at all, so the portal->cluster
+// connection leg is made through a master, the same way an SRE would hop.
+
+var rxMasterNodeName = regexp.MustCompile(`-master-([0-2])$`)
+
+// resolveNode looks up the named node in the cluster and returns how to reach
+// it: master nodes by their index, other nodes by their internal IP address
+func (s *ssh) resolveNode(ctx context.Context, oc *api.OpenShiftCluster, name string) (*api.SSH, error) {
+	cli, err := s.newKubernetes(oc)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := cli.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, isMaster := node.Labels["node-role.kubernetes.io/master"]; isMaster {
+		if m := rxMasterNodeName.FindStringSubmatch(node.Name); m != nil {
+			master, _ := strconv.Atoi(m[1])
+			return &api.SSH{
+				Master: master,
+				Node:   node.Name,
+			}, nil
+		}
+	}
+
+	for _, address := range node.Status.Addresses {
+		if address.Type == corev1.NodeInternalIP {
+			return &api.SSH{
+				Node:    node.Name,
+				Address: address.Address,
+			}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("node %q has no internal IP address", node.Name)
+}
+
+// hostname returns the name of the node accessed in an SSH session
+func hostname(ssh *api.SSH) string {
+	if ssh.Node != "" {
+		return ssh.Node
+	}
+
+	return fmt.Sprintf("master-%d", ssh.Master)
+}
+
+// jumpConn is a connection to a node made through a master.  Closing it closes
+// the connection to the master as well.
+type jumpConn struct {
+	net.Conn
+	client *cryptossh.Client
+}
+
+func (c *jumpConn) Close() error {
+	err := c.Conn.Close()
+	_ = c.client.Close()
+	return err
+}
+
+// dialJump connects to port 22 on address through the first master which
+// accepts an SSH connection
+func (s *ssh) dialJump(ctx context.Context, oc *api.OpenShiftCluster, config *cryptossh.ClientConfig, address string) (net.Conn, error) {
+	var err error
+
+	for master := 0; master < 3; master++ {
+		var c net.Conn
+		c, err = s.dialer.DialContext(ctx, "tcp", fmt.Sprintf("%s:%d", oc.Properties.NetworkProfile.APIServerPrivateEndpointIP, 2200+master))
+		if err != nil {
+			continue
+		}
+
+		var conn cryptossh.Conn
+		var newchannels <-chan cryptossh.NewChannel
+		var requests <-chan *cryptossh.Request
+		conn, newchannels, requests, err = cryptossh.NewClientConn(c, "", config)
+		if err != nil {
+			c.Close()
+			continue
+		}
+
+		client := cryptossh.NewClient(conn, newchannels, requests)
+
+		// if a master can't reach the node, the others won't either
+		nc, err := client.Dial("tcp", net.JoinHostPort(address, "22"))
+		if err != nil {
+			client.Close()
+			return nil, err
+		}
+
+		return &jumpConn{Conn: nc, client: client}, nil
+	}
+
+	return nil, err
+}
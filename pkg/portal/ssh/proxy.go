@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"net/http"
 	"strings"
 	"sync"
 	"time"
@@ -19,6 +20,9 @@ import (
 	"golang.org/x/crypto/ssh/agent"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/portal/util/recorder"
+	"github.com/Azure/ARO-RP/pkg/portal/util/revocation"
 	utillog "github.com/Azure/ARO-RP/pkg/util/log"
 	"github.com/Azure/ARO-RP/pkg/util/recover"
 )
@@ -100,8 +104,17 @@ func (s *ssh) newConn(ctx context.Context, c1 net.Conn) error {
 				return fmt.Errorf("invalid username")
 			}
 
+			timeout := s.sessionTimeout(portalDoc.Portal.SSH)
+			if timeout <= 0 {
+				return fmt.Errorf("access expired")
+			}
+
 			portalDoc.Portal.SSH.Authenticated = true
 
+			// the document now represents the live session, and must outlast
+			// it: the session is revoked if the document is deleted
+			portalDoc.TTL = int((timeout + time.Second - 1) / time.Second)
+
 			return nil
 		})
 		if err != nil {
@@ -127,27 +140,26 @@ func (s *ssh) newConn(ctx context.Context, c1 net.Conn) error {
 	// Log the incoming connection attempt.
 	accessLog := utillog.EnrichWithPath(s.baseAccessLog, portalDoc.Portal.ID)
 	accessLog = accessLog.WithFields(logrus.Fields{
-		"hostname":    fmt.Sprintf("master-%d", portalDoc.Portal.SSH.Master),
+		"hostname":    hostname(portalDoc.Portal.SSH),
 		"remote_addr": c1.RemoteAddr().String(),
 		"username":    portalDoc.Portal.Username,
 	})
 
 	accessLog.Print("authentication succeeded")
 
-	openShiftDoc, err := s.dbOpenShiftClusters.Get(ctx, strings.ToLower(portalDoc.Portal.ID))
-	if err != nil {
-		return err
-	}
-
-	address := fmt.Sprintf("%s:%d", openShiftDoc.OpenShiftCluster.Properties.NetworkProfile.APIServerPrivateEndpointIP, 2200+portalDoc.Portal.SSH.Master)
+	// end the session when the connection closes
+	defer func() {
+		err := s.dbPortal.Delete(ctx, portalDoc)
+		if err != nil && !cosmosdb.IsErrorStatusCode(err, http.StatusNotFound) {
+			s.log.Warn(err)
+		}
+	}()
 
-	c2, err := s.dialer.DialContext(ctx, "tcp", address)
+	openShiftDoc, err := s.dbOpenShiftClusters.Get(ctx, strings.ToLower(portalDoc.Portal.ID))
 	if err != nil {
 		return err
 	}
 
-	defer c2.Close()
-
 	key, err := x509.ParsePKCS1PrivateKey(openShiftDoc.OpenShiftCluster.Properties.SSHKey)
 	if err != nil {
 		return err
@@ -158,14 +170,28 @@ func (s *ssh) newConn(ctx context.Context, c1 net.Conn) error {
 		return err
 	}
 
-	// Connect the second connection leg (portal->cluster).
-	conn2, newchannels2, requests2, err := cryptossh.NewClientConn(c2, "", &cryptossh.ClientConfig{
+	clientConfig := &cryptossh.ClientConfig{
 		User: "core",
 		Auth: []cryptossh.AuthMethod{
 			cryptossh.PublicKeys(signer),
 		},
 		HostKeyCallback: cryptossh.InsecureIgnoreHostKey(),
-	})
+	}
+
+	var c2 net.Conn
+	if portalDoc.Portal.SSH.Address != "" {
+		c2, err = s.dialJump(ctx, openShiftDoc.OpenShiftCluster, clientConfig, portalDoc.Portal.SSH.Address)
+	} else {
+		c2, err = s.dialer.DialContext(ctx, "tcp", fmt.Sprintf("%s:%d", openShiftDoc.OpenShiftCluster.Properties.NetworkProfile.APIServerPrivateEndpointIP, 2200+portalDoc.Portal.SSH.Master))
+	}
+	if err != nil {
+		return err
+	}
+
+	defer c2.Close()
+
+	// Connect the second connection leg (portal->cluster).
+	conn2, newchannels2, requests2, err := cryptossh.NewClientConn(c2, "", clientConfig)
 	if err != nil {
 		return err
 	}
@@ -185,15 +211,20 @@ func (s *ssh) newConn(ctx context.Context, c1 net.Conn) error {
 	}
 
 	// Proxy channels and requests between the two connections.
-	return s.proxyConn(accessLog, keyring, conn1, conn2, newchannels1, newchannels2, requests1, requests2)
+	return s.proxyConn(ctx, accessLog, keyring, portalDoc, conn1, conn2, newchannels1, newchannels2, requests1, requests2)
 }
 
 // proxyConn handles incoming new channel and administrative requests.  It calls
-// newChannel to handle new channels, each on a new goroutine.
-func (s *ssh) proxyConn(accessLog *logrus.Entry, keyring agent.Agent, conn1, conn2 cryptossh.Conn, newchannels1, newchannels2 <-chan cryptossh.NewChannel, requests1, requests2 <-chan *cryptossh.Request) error {
-	timer := time.NewTimer(sshTimeout)
+// newChannel to handle new channels, each on a new goroutine.  SRE->cluster
+// session channels are recorded.  If the session is revoked or its elevated
+// access expires, proxyConn returns, closing the connection.
+func (s *ssh) proxyConn(ctx context.Context, accessLog *logrus.Entry, keyring agent.Agent, portalDoc *api.PortalDocument, conn1, conn2 cryptossh.Conn, newchannels1, newchannels2 <-chan cryptossh.NewChannel, requests1, requests2 <-chan *cryptossh.Request) error {
+	timer := time.NewTimer(s.sessionTimeout(portalDoc.Portal.SSH))
 	defer timer.Stop()
 
+	revokedCtx, cancel := revocation.Watch(ctx, s.log, s.dbPortal, portalDoc.ID, s.revocationInterval)
+	defer cancel()
+
 	var sessionOpened bool
 
 	for {
@@ -201,6 +232,10 @@ func (s *ssh) proxyConn(accessLog *logrus.Entry, keyring agent.Agent, conn1, con
 		case <-timer.C:
 			return nil
 
+		case <-revokedCtx.Done():
+			accessLog.Print("session revoked")
+			return nil
+
 		case nc := <-newchannels1:
 			if nc == nil {
 				return nil
@@ -215,7 +250,7 @@ func (s *ssh) proxyConn(accessLog *logrus.Entry, keyring agent.Agent, conn1, con
 			}
 
 			go func() {
-				_ = s.newChannel(accessLog, nc, conn1, conn2, firstSession)
+				_ = s.newChannel(ctx, accessLog, portalDoc, nc, conn1, conn2, firstSession)
 			}()
 
 		case nc := <-newchannels2:
@@ -230,7 +265,7 @@ func (s *ssh) proxyConn(accessLog *logrus.Entry, keyring agent.Agent, conn1, con
 				}()
 			} else {
 				go func() {
-					_ = s.newChannel(accessLog, nc, conn2, conn1, false)
+					_ = s.newChannel(ctx, accessLog, nil, nc, conn2, conn1, false)
 				}()
 			}
 
@@ -251,6 +286,21 @@ func (s *ssh) proxyConn(accessLog *logrus.Entry, keyring agent.Agent, conn1, con
 	}
 }
 
+// sessionTimeout returns how much longer a session may live: no longer than
+// sshTimeout, nor beyond the expiry of the elevated access it was created
+// under
+func (s *ssh) sessionTimeout(sshDoc *api.SSH) time.Duration {
+	timeout := sshTimeout
+
+	if sshDoc.Expiry != 0 {
+		if d := time.Unix(sshDoc.Expiry, 0).Sub(s.now()); d < timeout {
+			timeout = d
+		}
+	}
+
+	return timeout
+}
+
 func (s *ssh) handleAgent(accessLog *logrus.Entry, nc cryptossh.NewChannel, keyring agent.Agent) error {
 	ch, rs, err := nc.Accept()
 	if err != nil {
@@ -271,10 +321,27 @@ func (s *ssh) handleAgent(accessLog *logrus.Entry, nc cryptossh.NewChannel, keyr
 
 // newChannel handles an incoming request to create a new channel.  If the
 // channel creation is successful, it calls proxyChannel to proxy the channel
-// between SRE and cluster.
-func (s *ssh) newChannel(accessLog *logrus.Entry, nc cryptossh.NewChannel, conn1, conn2 cryptossh.Conn, firstSession bool) error {
+// between SRE and cluster.  If portalDoc is set, session channels are
+// recorded; if the recording cannot be made, the channel is rejected.
+func (s *ssh) newChannel(ctx context.Context, accessLog *logrus.Entry, portalDoc *api.PortalDocument, nc cryptossh.NewChannel, conn1, conn2 cryptossh.Conn, firstSession bool) error {
 	defer recover.Panic(s.log)
 
+	channelLog := accessLog.WithFields(logrus.Fields{
+		"channel": nc.ChannelType(),
+	})
+
+	var rec *recorder.Recorder
+	if portalDoc != nil && nc.ChannelType() == "session" {
+		var err error
+		rec, err = s.newRecorder(ctx, portalDoc)
+		if err != nil {
+			channelLog.Warnf("recording failed: %v", err)
+			return nc.Reject(cryptossh.ResourceShortage, "session recording is unavailable")
+		}
+
+		channelLog = channelLog.WithField("recording_id", rec.ID())
+	}
+
 	ch2, rs2, err := conn2.OpenChannel(nc.ChannelType(), nc.ExtraData())
 	if err, ok := err.(*cryptossh.OpenChannelError); ok {
 		return nc.Reject(err.Reason, err.Message)
@@ -287,9 +354,6 @@ func (s *ssh) newChannel(accessLog *logrus.Entry, nc cryptossh.NewChannel, conn1
 		return err
 	}
 
-	channelLog := accessLog.WithFields(logrus.Fields{
-		"channel": nc.ChannelType(),
-	})
 	channelLog.Printf("opened")
 	defer channelLog.Printf("closed")
 
@@ -300,7 +364,20 @@ func (s *ssh) newChannel(accessLog *logrus.Entry, nc cryptossh.NewChannel, conn1
 		}
 	}
 
-	return s.proxyChannel(ch1, ch2, rs1, rs2)
+	err = s.proxyChannel(ctx, rec, ch1, ch2, rs1, rs2)
+	if err != nil {
+		return err
+	}
+
+	if rec != nil {
+		err = rec.Close(ctx)
+		if err != nil {
+			channelLog.Warnf("recording failed: %v", err)
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (s *ssh) proxyGlobalRequest(r *cryptossh.Request, c cryptossh.Conn) error {
@@ -321,7 +398,16 @@ func (s *ssh) proxyRequest(r *cryptossh.Request, ch cryptossh.Channel) error {
 	return r.Reply(ok, nil)
 }
 
-func (s *ssh) proxyChannel(ch1, ch2 cryptossh.Channel, rs1, rs2 <-chan *cryptossh.Request) error {
+// proxyChannel proxies data and requests between ch1 (SRE) and ch2 (cluster).
+// If rec is set, the data and requests are recorded; should recording fail,
+// the channel is closed.
+func (s *ssh) proxyChannel(ctx context.Context, rec *recorder.Recorder, ch1, ch2 cryptossh.Channel, rs1, rs2 <-chan *cryptossh.Request) error {
+	var r1, r2 io.Reader = ch1, ch2
+	if rec != nil {
+		r1 = io.TeeReader(ch1, rec.Writer(ctx, "i"))
+		r2 = io.TeeReader(ch2, rec.Writer(ctx, "o"))
+	}
+
 	var wg sync.WaitGroup
 	wg.Add(4)
 
@@ -332,7 +418,10 @@ func (s *ssh) proxyChannel(ch1, ch2 cryptossh.Channel, rs1, rs2 <-chan *cryptoss
 		defer func() {
 			_ = ch1.CloseWrite()
 		}()
-		_, _ = io.Copy(ch1, ch2)
+		_, err := io.Copy(ch1, r2)
+		if err != nil {
+			_ = ch2.Close()
+		}
 	}()
 
 	go func() {
@@ -342,7 +431,10 @@ func (s *ssh) proxyChannel(ch1, ch2 cryptossh.Channel, rs1, rs2 <-chan *cryptoss
 		defer func() {
 			_ = ch2.CloseWrite()
 		}()
-		_, _ = io.Copy(ch2, ch1)
+		_, err := io.Copy(ch2, r1)
+		if err != nil {
+			_ = ch1.Close()
+		}
 	}()
 
 	go func() {
@@ -350,6 +442,13 @@ func (s *ssh) proxyChannel(ch1, ch2 cryptossh.Channel, rs1, rs2 <-chan *cryptoss
 
 		defer wg.Done()
 		for r := range rs1 {
+			if rec != nil {
+				err := recordRequest(ctx, rec, r)
+				if err != nil {
+					break
+				}
+			}
+
 			err := s.proxyRequest(r, ch2)
 			if err != nil {
 				break
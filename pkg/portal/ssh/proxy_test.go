@@ -12,6 +12,7 @@ import (
 	"net"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/gorilla/mux"
@@ -62,9 +63,22 @@ func fakeClient(c net.Conn, serverKey *rsa.PublicKey, user string, password stri
 	return conn.Close()
 }
 
+// channelConn adapts a Channel to a net.Conn, so that an SSH server can be run
+// on a forwarded connection
+type channelConn struct {
+	cryptossh.Channel
+}
+
+func (*channelConn) LocalAddr() net.Addr                { return nil }
+func (*channelConn) RemoteAddr() net.Addr               { return nil }
+func (*channelConn) SetDeadline(t time.Time) error      { return nil }
+func (*channelConn) SetReadDeadline(t time.Time) error  { return nil }
+func (*channelConn) SetWriteDeadline(t time.Time) error { return nil }
+
 // fakeServer returns a test listener for an SSH server which validates the
-// client key, reads ping request(s) and writes pong replies
-func fakeServer(clientKey *rsa.PublicKey) (*listener.Listener, error) {
+// client key, reads ping request(s) and writes pong replies.  Connections
+// forwarded to port 22 on workerIP are served in the same way.
+func fakeServer(clientKey *rsa.PublicKey, workerIP string) (*listener.Listener, error) {
 	l := listener.NewListener()
 
 	clientPublicKey, err := cryptossh.NewPublicKey(clientKey)
@@ -96,6 +110,57 @@ func fakeServer(clientKey *rsa.PublicKey) (*listener.Listener, error) {
 
 	config.AddHostKey(signer)
 
+	var serve func(net.Conn)
+	serve = func(c net.Conn) {
+		conn, newchannels, requests, err := cryptossh.NewServerConn(c, config)
+		if err != nil {
+			return
+		}
+
+		go func() {
+			for nc := range newchannels {
+				var m struct {
+					Addr     string
+					Port     uint32
+					OrigAddr string
+					OrigPort uint32
+				}
+				if nc.ChannelType() != "direct-tcpip" ||
+					cryptossh.Unmarshal(nc.ExtraData(), &m) != nil ||
+					m.Addr != workerIP || m.Port != 22 {
+					_ = nc.Reject(cryptossh.ConnectionFailed, "")
+					continue
+				}
+
+				ch, rs, err := nc.Accept()
+				if err != nil {
+					continue
+				}
+
+				go cryptossh.DiscardRequests(rs)
+				go serve(&channelConn{Channel: ch})
+			}
+		}()
+
+		go func() {
+			for request := range requests {
+				if request.Type == "ping" && request.WantReply {
+					err := request.Reply(true, []byte("pong"))
+					if err != nil {
+						break
+					}
+				} else {
+					err := request.Reply(false, nil)
+					if err != nil {
+						break
+					}
+				}
+			}
+		}()
+
+		_ = conn.Wait()
+	}
+
 	go func() {
 		for {
 			c, err := l.Accept()
@@ -103,30 +168,7 @@ func fakeServer(clientKey *rsa.PublicKey) (*listener.Listener, error) {
 				return
 			}
 
-			go func() {
-				conn, _, requests, err := cryptossh.NewServerConn(c, config)
-				if err != nil {
-					return
-				}
-
-				go func() {
-					for request := range requests {
-						if request.Type == "ping" && request.WantReply {
-							err := request.Reply(true, []byte("pong"))
-							if err != nil {
-								break
-							}
-						} else {
-							err := request.Reply(false, nil)
-							if err != nil {
-								break
-							}
-						}
-					}
-				}()
-
-				_ = conn.Wait()
-			}()
+			go serve(c)
 		}
 	}()
 
@@ -142,6 +184,7 @@ func TestProxy(t *testing.T) {
 	resourceName := "cluster"
 	resourceID := "/subscriptions/" + subscriptionID + "/resourcegroups/" + resourceGroup + "/providers/microsoft.redhatopenshift/openshiftclusters/" + resourceName
 	apiServerPrivateEndpointIP := "1.2.3.4"
+	workerIP := "10.0.0.10"
 
 	hostKey, _, err := utiltls.GenerateKeyAndCertificate("proxy", nil, nil, false, false)
 	if err != nil {
@@ -153,7 +196,7 @@ func TestProxy(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	l, err := fakeServer(&clusterKey.PublicKey)
+	l, err := fakeServer(&clusterKey.PublicKey, workerIP)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -187,6 +230,20 @@ func TestProxy(t *testing.T) {
 		}
 	}
 
+	workerPortalDocument := func(id string) *api.PortalDocument {
+		return &api.PortalDocument{
+			ID: id,
+			Portal: &api.Portal{
+				ID:       resourceID,
+				Username: username,
+				SSH: &api.SSH{
+					Node:    "cluster-worker-1",
+					Address: workerIP,
+				},
+			},
+		}
+	}
+
 	type test struct {
 		name           string
 		username       string
@@ -207,9 +264,6 @@ func TestProxy(t *testing.T) {
 				fixture.AddPortalDocuments(portalDocument)
 				openShiftClusterDocument := goodOpenShiftClusterDocument()
 				fixture.AddOpenShiftClusterDocuments(openShiftClusterDocument)
-				portalDocument = goodPortalDocument(tt.password)
-				portalDocument.Portal.SSH.Authenticated = true
-				checker.AddPortalDocuments(portalDocument)
 				checker.AddOpenShiftClusterDocuments(openShiftClusterDocument)
 			},
 			mocks: func(dialer *mock_proxy.MockDialer) {
@@ -245,6 +299,78 @@ func TestProxy(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "good, worker",
+			username: username,
+			password: password,
+			fixtureChecker: func(tt *test, fixture *testdatabase.Fixture, checker *testdatabase.Checker, openShiftClustersClient *cosmosdb.FakeOpenShiftClusterDocumentClient, portalClient *cosmosdb.FakePortalDocumentClient) {
+				portalDocument := workerPortalDocument(tt.password)
+				fixture.AddPortalDocuments(portalDocument)
+				openShiftClusterDocument := goodOpenShiftClusterDocument()
+				fixture.AddOpenShiftClusterDocuments(openShiftClusterDocument)
+				checker.AddOpenShiftClusterDocuments(openShiftClusterDocument)
+			},
+			mocks: func(dialer *mock_proxy.MockDialer) {
+				gomock.InOrder(
+					dialer.EXPECT().DialContext(gomock.Any(), "tcp", apiServerPrivateEndpointIP+":2200").Return(nil, fmt.Errorf("sad")),
+					dialer.EXPECT().DialContext(gomock.Any(), "tcp", apiServerPrivateEndpointIP+":2201").Return(l.DialContext(ctx, "", "")),
+				)
+			},
+			wantLogs: []map[string]types.GomegaMatcher{
+				{
+					"level":       gomega.Equal(logrus.InfoLevel),
+					"msg":         gomega.Equal("authentication succeeded"),
+					"remote_addr": gomega.Not(gomega.BeEmpty()),
+					"username":    gomega.Equal(username),
+				},
+				{
+					"level":           gomega.Equal(logrus.InfoLevel),
+					"msg":             gomega.Equal("connected"),
+					"hostname":        gomega.Equal("cluster-worker-1"),
+					"resource_group":  gomega.Equal(resourceGroup),
+					"resource_id":     gomega.Equal(resourceID),
+					"resource_name":   gomega.Equal(resourceName),
+					"subscription_id": gomega.Equal(subscriptionID),
+					"username":        gomega.Equal(username),
+				},
+				{
+					"level":           gomega.Equal(logrus.InfoLevel),
+					"msg":             gomega.Equal("disconnected"),
+					"duration":        gomega.BeNumerically(">", 0),
+					"hostname":        gomega.Equal("cluster-worker-1"),
+					"resource_group":  gomega.Equal(resourceGroup),
+					"resource_id":     gomega.Equal(resourceID),
+					"resource_name":   gomega.Equal(resourceName),
+					"subscription_id": gomega.Equal(subscriptionID),
+					"username":        gomega.Equal(username),
+				},
+			},
+		},
+		{
+			name:     "worker unreachable",
+			username: username,
+			password: password,
+			fixtureChecker: func(tt *test, fixture *testdatabase.Fixture, checker *testdatabase.Checker, openShiftClustersClient *cosmosdb.FakeOpenShiftClusterDocumentClient, portalClient *cosmosdb.FakePortalDocumentClient) {
+				portalDocument := workerPortalDocument(tt.password)
+				portalDocument.Portal.SSH.Address = "10.0.0.11"
+				fixture.AddPortalDocuments(portalDocument)
+				openShiftClusterDocument := goodOpenShiftClusterDocument()
+				fixture.AddOpenShiftClusterDocuments(openShiftClusterDocument)
+				checker.AddOpenShiftClusterDocuments(openShiftClusterDocument)
+			},
+			mocks: func(dialer *mock_proxy.MockDialer) {
+				dialer.EXPECT().DialContext(gomock.Any(), "tcp", apiServerPrivateEndpointIP+":2200").Return(l.DialContext(ctx, "", ""))
+			},
+			wantErrPrefix: "EOF",
+			wantLogs: []map[string]types.GomegaMatcher{
+				{
+					"level":       gomega.Equal(logrus.InfoLevel),
+					"msg":         gomega.Equal("authentication succeeded"),
+					"remote_addr": gomega.Not(gomega.BeEmpty()),
+					"username":    gomega.Equal(username),
+				},
+			},
+		},
 		{
 			name:     "bad username",
 			username: "bad",
@@ -319,9 +445,6 @@ func TestProxy(t *testing.T) {
 			fixtureChecker: func(tt *test, fixture *testdatabase.Fixture, checker *testdatabase.Checker, openShiftClustersClient *cosmosdb.FakeOpenShiftClusterDocumentClient, portalClient *cosmosdb.FakePortalDocumentClient) {
 				portalDocument := goodPortalDocument(tt.password)
 				fixture.AddPortalDocuments(portalDocument)
-				portalDocument = goodPortalDocument(tt.password)
-				portalDocument.Portal.SSH.Authenticated = true
-				checker.AddPortalDocuments(portalDocument)
 
 				openShiftClustersClient.SetError(fmt.Errorf("sad"))
 			},
@@ -361,9 +484,6 @@ func TestProxy(t *testing.T) {
 				fixture.AddPortalDocuments(portalDocument)
 				openShiftClusterDocument := goodOpenShiftClusterDocument()
 				fixture.AddOpenShiftClusterDocuments(openShiftClusterDocument)
-				portalDocument = goodPortalDocument(tt.password)
-				portalDocument.Portal.SSH.Authenticated = true
-				checker.AddPortalDocuments(portalDocument)
 				checker.AddOpenShiftClusterDocuments(openShiftClusterDocument)
 			},
 			mocks: func(dialer *mock_proxy.MockDialer) {
@@ -412,7 +532,7 @@ func TestProxy(t *testing.T) {
 
 			hook, log := testlog.New()
 
-			s, err := New(nil, nil, log, nil, hostKey, nil, dbOpenShiftClusters, dbPortal, dialer, &mux.Router{})
+			s, err := New(nil, nil, log, nil, hostKey, nil, nil, dbOpenShiftClusters, dbPortal, dialer, &mux.Router{})
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -450,3 +570,289 @@ func TestProxy(t *testing.T) {
 		})
 	}
 }
+
+func TestProxyRevoked(t *testing.T) {
+	ctx := context.Background()
+	username := "test"
+	password := "00000000-0000-0000-0000-000000000000"
+	resourceID := "/subscriptions/10000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster"
+	apiServerPrivateEndpointIP := "1.2.3.4"
+
+	hostKey, _, err := utiltls.GenerateKeyAndCertificate("proxy", nil, nil, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clusterKey, _, err := utiltls.GenerateKeyAndCertificate("cluster", nil, nil, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := fakeServer(&clusterKey.PublicKey, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	dbPortal, portalClient := testdatabase.NewFakePortal()
+	dbOpenShiftClusters, _ := testdatabase.NewFakeOpenShiftClusters()
+
+	fixture := testdatabase.NewFixture().
+		WithOpenShiftClusters(dbOpenShiftClusters).
+		WithPortal(dbPortal)
+
+	fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+		ID:  resourceID,
+		Key: resourceID,
+		OpenShiftCluster: &api.OpenShiftCluster{
+			Properties: api.OpenShiftClusterProperties{
+				NetworkProfile: api.NetworkProfile{
+					APIServerPrivateEndpointIP: apiServerPrivateEndpointIP,
+				},
+				SSHKey: api.SecureBytes(x509.MarshalPKCS1PrivateKey(clusterKey)),
+			},
+		},
+	})
+	fixture.AddPortalDocuments(&api.PortalDocument{
+		ID: password,
+		Portal: &api.Portal{
+			ID:       resourceID,
+			Username: username,
+			SSH: &api.SSH{
+				Master: 1,
+			},
+		},
+	})
+
+	err = fixture.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dialer := mock_proxy.NewMockDialer(ctrl)
+	dialer.EXPECT().DialContext(gomock.Any(), "tcp", apiServerPrivateEndpointIP+":2201").Return(l.DialContext(ctx, "", ""))
+
+	hook, log := testlog.New()
+
+	s, err := New(nil, nil, log, nil, hostKey, nil, nil, dbOpenShiftClusters, dbPortal, dialer, &mux.Router{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.revocationInterval = time.Millisecond
+
+	client, client1 := bufferedpipe.New()
+
+	done := make(chan struct{})
+
+	go func() {
+		_ = s.newConn(ctx, client1)
+		close(done)
+	}()
+
+	publicKey, err := cryptossh.NewPublicKey(&hostKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn, _, _, err := cryptossh.NewClientConn(client, "", &cryptossh.ClientConfig{
+		HostKeyCallback: cryptossh.FixedHostKey(publicKey),
+		User:            username,
+		Auth: []cryptossh.AuthMethod{
+			cryptossh.Password(password),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = conn.SendRequest("ping", true, []byte("ping"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := dbPortal.Get(ctx, password)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if doc.TTL != int(sshTimeout/time.Second) {
+		t.Error(doc.TTL)
+	}
+
+	err = dbPortal.Delete(ctx, doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("connection was not closed")
+	}
+
+	err = conn.Wait()
+	if err == nil {
+		t.Error("expected error")
+	}
+
+	for _, err = range testdatabase.NewChecker().CheckPortals(portalClient) {
+		t.Error(err)
+	}
+
+	var revoked bool
+	for _, e := range hook.AllEntries() {
+		if e.Message == "session revoked" {
+			revoked = true
+		}
+	}
+	if !revoked {
+		t.Error("session revoked was not logged")
+	}
+}
+
+func TestProxyExpired(t *testing.T) {
+	ctx := context.Background()
+	username := "test"
+	password := "00000000-0000-0000-0000-000000000000"
+	resourceID := "/subscriptions/10000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster"
+	apiServerPrivateEndpointIP := "1.2.3.4"
+	expiry := time.Unix(1600000000, 0)
+
+	hostKey, _, err := utiltls.GenerateKeyAndCertificate("proxy", nil, nil, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clusterKey, _, err := utiltls.GenerateKeyAndCertificate("cluster", nil, nil, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name        string
+		now         time.Time
+		wantTTL     int
+		wantAuthErr bool
+	}{
+		{
+			name:    "session closed at expiry",
+			now:     expiry.Add(-100 * time.Millisecond),
+			wantTTL: 1,
+		},
+		{
+			name:        "access already expired",
+			now:         expiry,
+			wantAuthErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := fakeServer(&clusterKey.PublicKey, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer l.Close()
+
+			dbPortal, _ := testdatabase.NewFakePortal()
+			dbOpenShiftClusters, _ := testdatabase.NewFakeOpenShiftClusters()
+
+			fixture := testdatabase.NewFixture().
+				WithOpenShiftClusters(dbOpenShiftClusters).
+				WithPortal(dbPortal)
+
+			fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				ID:  resourceID,
+				Key: resourceID,
+				OpenShiftCluster: &api.OpenShiftCluster{
+					Properties: api.OpenShiftClusterProperties{
+						NetworkProfile: api.NetworkProfile{
+							APIServerPrivateEndpointIP: apiServerPrivateEndpointIP,
+						},
+						SSHKey: api.SecureBytes(x509.MarshalPKCS1PrivateKey(clusterKey)),
+					},
+				},
+			})
+			fixture.AddPortalDocuments(&api.PortalDocument{
+				ID: password,
+				Portal: &api.Portal{
+					ID:       resourceID,
+					Username: username,
+					SSH: &api.SSH{
+						Master: 1,
+						Expiry: expiry.Unix(),
+					},
+				},
+			})
+
+			err = fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			dialer := mock_proxy.NewMockDialer(ctrl)
+			if !tt.wantAuthErr {
+				dialer.EXPECT().DialContext(gomock.Any(), "tcp", apiServerPrivateEndpointIP+":2201").Return(l.DialContext(ctx, "", ""))
+			}
+
+			_, log := testlog.New()
+
+			s, err := New(nil, nil, log, nil, hostKey, nil, nil, dbOpenShiftClusters, dbPortal, dialer, &mux.Router{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			s.now = func() time.Time { return tt.now }
+
+			client, client1 := bufferedpipe.New()
+
+			done := make(chan struct{})
+
+			go func() {
+				_ = s.newConn(ctx, client1)
+				close(done)
+			}()
+
+			publicKey, err := cryptossh.NewPublicKey(&hostKey.PublicKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			conn, _, _, err := cryptossh.NewClientConn(client, "", &cryptossh.ClientConfig{
+				HostKeyCallback: cryptossh.FixedHostKey(publicKey),
+				User:            username,
+				Auth: []cryptossh.AuthMethod{
+					cryptossh.Password(password),
+				},
+			})
+			if tt.wantAuthErr {
+				if err == nil {
+					t.Error("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err := dbPortal.Get(ctx, password)
+			if err == nil && doc.TTL != tt.wantTTL {
+				t.Error(doc.TTL)
+			}
+
+			select {
+			case <-done:
+			case <-time.After(5 * time.Second):
+				t.Fatal("connection was not closed")
+			}
+
+			err = conn.Wait()
+			if err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package ssh
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+
+	cryptossh "golang.org/x/crypto/ssh"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/portal/util/recorder"
+)
+
+// This file handles recording of SRE->cluster SSH session channels, so that
+// elevated node access can be reviewed after the fact.
+
+// newRecorder creates the recording document for a new session channel
+// authenticated by portalDoc
+func (s *ssh) newRecorder(ctx context.Context, portalDoc *api.PortalDocument) (*recorder.Recorder, error) {
+	return recorder.New(ctx, s.dbPortal, s.now, portalDoc.Portal.Username, portalDoc.Portal.ID,
+		fmt.Sprintf("%s %s", portalDoc.Portal.ID, hostname(portalDoc.Portal.SSH)),
+		&api.SSHRecording{
+			SessionID: portalDoc.ID,
+			Master:    portalDoc.Portal.SSH.Master,
+			Node:      portalDoc.Portal.SSH.Node,
+		})
+}
+
+// recordRequest records the effect of a SRE->cluster channel request: the
+// terminal type and size, resizes and the command run
+func recordRequest(ctx context.Context, rec *recorder.Recorder, req *cryptossh.Request) error {
+	switch req.Type {
+	case "pty-req":
+		var m struct {
+			Term          string
+			Columns, Rows uint32
+			Width, Height uint32
+			Modes         string
+		}
+		if cryptossh.Unmarshal(req.Payload, &m) != nil {
+			return nil
+		}
+
+		rec.SetTerminal(m.Term, int(m.Columns), int(m.Rows))
+
+	case "window-change":
+		var m struct {
+			Columns, Rows uint32
+			Width, Height uint32
+		}
+		if cryptossh.Unmarshal(req.Payload, &m) != nil {
+			return nil
+		}
+
+		return rec.Resize(ctx, int(m.Columns), int(m.Rows))
+
+	case "exec":
+		var m struct {
+			Command string
+		}
+		if cryptossh.Unmarshal(req.Payload, &m) != nil {
+			return nil
+		}
+
+		rec.SetCommand(m.Command)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package ssh
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	cryptossh "golang.org/x/crypto/ssh"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestRecorder(t *testing.T) {
+	ctx := context.Background()
+	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster"
+
+	dbPortal, _ := testdatabase.NewFakePortal()
+
+	// the clock advances a second each time it is read
+	start := time.Unix(1600000000, 0)
+	var ticks time.Duration
+	s := &ssh{
+		dbPortal: dbPortal,
+		now: func() time.Time {
+			defer func() { ticks += time.Second }()
+			return start.Add(ticks)
+		},
+	}
+
+	rec, err := s.newRecorder(ctx, &api.PortalDocument{
+		ID: "session",
+		Portal: &api.Portal{
+			Username: "username",
+			ID:       resourceID,
+			SSH: &api.SSH{
+				Master: 1,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = recordRequest(ctx, rec, &cryptossh.Request{
+		Type: "pty-req",
+		Payload: cryptossh.Marshal(struct {
+			Term          string
+			Columns, Rows uint32
+			Width, Height uint32
+			Modes         string
+		}{
+			Term:    "xterm",
+			Columns: 100,
+			Rows:    30,
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// "é" is split across two writes
+	for _, b := range []string{"h\xc3", "\xa9llo"} {
+		_, err = rec.Writer(ctx, "o").Write([]byte(b))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err = rec.Writer(ctx, "i").Write([]byte("ls\r"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = recordRequest(ctx, rec, &cryptossh.Request{
+		Type: "window-change",
+		Payload: cryptossh.Marshal(struct {
+			Columns, Rows uint32
+			Width, Height uint32
+		}{
+			Columns: 120,
+			Rows:    40,
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = rec.Close(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := dbPortal.Get(ctx, rec.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if doc.Portal.ID != resourceID ||
+		doc.Portal.Username != "username" ||
+		doc.Portal.SSHRecording.SessionID != "session" ||
+		doc.Portal.SSHRecording.Master != 1 ||
+		doc.Portal.SSHRecording.StartTime != start.Unix() ||
+		doc.Portal.SSHRecording.EndTime != start.Unix()+5 {
+		t.Error(doc)
+	}
+
+	chunk, err := dbPortal.Get(ctx, rec.ID()+"-0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantData := fmt.Sprintf(`{"version":2,"width":100,"height":30,"timestamp":%d,"title":"%s master-1","env":{"TERM":"xterm"}}
+[1,"o","h"]
+[2,"o","éllo"]
+[3,"i","ls\r"]
+[4,"r","120x40"]
+`, start.Unix(), resourceID)
+
+	if chunk.Portal.SSHRecordingChunk.RecordingID != rec.ID() ||
+		chunk.Portal.SSHRecordingChunk.Index != 0 ||
+		string(chunk.Portal.SSHRecordingChunk.Data) != wantData {
+		t.Error(string(chunk.Portal.SSHRecordingChunk.Data))
+	}
+
+	_, err = dbPortal.Get(ctx, rec.ID()+"-1")
+	if err == nil {
+		t.Error("unexpected second chunk")
+	}
+}
@@ -0,0 +1,137 @@
+package ssh
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Azure/ARO-RP/pkg/api/validate"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/portal/middleware"
+)
+
+type recording struct {
+	ID        string `json:"id"`
+	Username  string `json:"username"`
+	Master    int    `json:"master"`
+	Node      string `json:"node,omitempty"`
+	Terminal  bool   `json:"terminal,omitempty"`
+	StartTime int64  `json:"startTime"`
+	EndTime   int64  `json:"endTime,omitempty"`
+}
+
+// recordingsResourceID returns the cluster resource ID from a recordings
+// request path, or writes an error.  Only auditors may review recordings.
+func (s *ssh) recordingsResourceID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	parts := strings.Split(r.URL.Path, "/")
+	if len(parts) < 9 {
+		http.Error(w, "invalid resourceId", http.StatusBadRequest)
+		return "", false
+	}
+
+	resourceID := strings.Join(parts[:9], "/")
+	if !validate.RxClusterID.MatchString(resourceID) {
+		http.Error(w, fmt.Sprintf("invalid resourceId %q", resourceID), http.StatusBadRequest)
+		return "", false
+	}
+
+	auditor := len(middleware.GroupsIntersect(s.auditorGroupIDs, r.Context().Value(middleware.ContextKeyGroups).([]string))) > 0
+	if !auditor {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return "", false
+	}
+
+	return resourceID, true
+}
+
+// recordings lists the SSH session recordings of a cluster, most recent first
+func (s *ssh) recordings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resourceID, ok := s.recordingsResourceID(w, r)
+	if !ok {
+		return
+	}
+
+	docs, err := s.dbPortal.ListSSHRecordings(ctx, resourceID)
+	if err != nil {
+		s.internalServerError(w, err)
+		return
+	}
+
+	recordings := make([]*recording, 0, len(docs.PortalDocuments))
+	for _, doc := range docs.PortalDocuments {
+		recordings = append(recordings, &recording{
+			ID:        doc.ID,
+			Username:  doc.Portal.Username,
+			Master:    doc.Portal.SSHRecording.Master,
+			Node:      doc.Portal.SSHRecording.Node,
+			Terminal:  doc.Portal.SSHRecording.Terminal,
+			StartTime: doc.Portal.SSHRecording.StartTime,
+			EndTime:   doc.Portal.SSHRecording.EndTime,
+		})
+	}
+
+	sort.Slice(recordings, func(i, j int) bool { return recordings[i].StartTime > recordings[j].StartTime })
+
+	s.sendResponse(w, recordings)
+}
+
+// recording returns an SSH session recording in asciicast v2 format
+func (s *ssh) recording(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resourceID, ok := s.recordingsResourceID(w, r)
+	if !ok {
+		return
+	}
+
+	id := mux.Vars(r)["recordingId"]
+
+	doc, err := s.dbPortal.Get(ctx, id)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	case err != nil:
+		s.internalServerError(w, err)
+		return
+	}
+
+	if doc.Portal.SSHRecording == nil || doc.Portal.ID != resourceID {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	// chunks are numbered consecutively from 0; if the portal stopped before
+	// the session ended, the recording is complete up to the last chunk written
+	buf := &bytes.Buffer{}
+	for i := 0; ; i++ {
+		chunk, err := s.dbPortal.Get(ctx, fmt.Sprintf("%s-%d", id, i))
+		if cosmosdb.IsErrorStatusCode(err, http.StatusNotFound) {
+			break
+		}
+		if err != nil {
+			s.internalServerError(w, err)
+			return
+		}
+
+		if chunk.Portal.SSHRecordingChunk == nil || chunk.Portal.SSHRecordingChunk.RecordingID != id {
+			break
+		}
+
+		buf.Write(chunk.Portal.SSHRecordingChunk.Data)
+	}
+
+	w.Header().Set("Content-Type", "application/x-asciicast")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", id+".cast"))
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(buf.Bytes()))
+}
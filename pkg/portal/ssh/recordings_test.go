@@ -0,0 +1,252 @@
+package ssh
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/portal/middleware"
+	"github.com/Azure/ARO-RP/pkg/portal/util/responsewriter"
+	utiltls "github.com/Azure/ARO-RP/pkg/util/tls"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestRecordings(t *testing.T) {
+	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster"
+	otherResourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/other"
+	elevatedGroupIDs := []string{"10000000-0000-0000-0000-000000000000"}
+	auditorGroupIDs := []string{"30000000-0000-0000-0000-000000000000"}
+	recordingID := "20000000-0000-0000-0000-000000000000"
+
+	hostKey, _, err := utiltls.GenerateKeyAndCertificate("proxy", nil, nil, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recordingDocuments := func(fixture *testdatabase.Fixture) {
+		fixture.AddPortalDocuments(
+			&api.PortalDocument{
+				ID: recordingID,
+				Portal: &api.Portal{
+					Username: "old",
+					ID:       resourceID,
+					SSHRecording: &api.SSHRecording{
+						SessionID: "session1",
+						StartTime: 1600000000,
+						EndTime:   1600000060,
+					},
+				},
+			},
+			&api.PortalDocument{
+				ID: recordingID + "-0",
+				Portal: &api.Portal{
+					SSHRecordingChunk: &api.SSHRecordingChunk{
+						RecordingID: recordingID,
+						Index:       0,
+						Data:        api.SecureBytes("header\n"),
+					},
+				},
+			},
+			&api.PortalDocument{
+				ID: recordingID + "-1",
+				Portal: &api.Portal{
+					SSHRecordingChunk: &api.SSHRecordingChunk{
+						RecordingID: recordingID,
+						Index:       1,
+						Data:        api.SecureBytes("event\n"),
+					},
+				},
+			},
+			&api.PortalDocument{
+				ID: "30000000-0000-0000-0000-000000000000",
+				Portal: &api.Portal{
+					Username: "new",
+					ID:       resourceID,
+					SSHRecording: &api.SSHRecording{
+						SessionID: "session2",
+						Master:    2,
+						StartTime: 1600000100,
+					},
+				},
+			},
+			&api.PortalDocument{
+				ID: "40000000-0000-0000-0000-000000000000",
+				Portal: &api.Portal{
+					Username: "other",
+					ID:       otherResourceID,
+					SSHRecording: &api.SSHRecording{
+						SessionID: "session3",
+						StartTime: 1600000200,
+					},
+				},
+			},
+		)
+	}
+
+	for _, tt := range []struct {
+		name            string
+		path            string
+		fixture         func(*testdatabase.Fixture)
+		portalClient    func(*cosmosdb.FakePortalDocumentClient)
+		groups          []string
+		wantStatusCode  int
+		wantContentType string
+		wantBody        string
+	}{
+		{
+			name:            "list",
+			path:            resourceID + "/ssh/recordings",
+			fixture:         recordingDocuments,
+			wantStatusCode:  http.StatusOK,
+			wantContentType: "application/json",
+			wantBody:        "[\n    {\n        \"id\": \"30000000-0000-0000-0000-000000000000\",\n        \"username\": \"new\",\n        \"master\": 2,\n        \"startTime\": 1600000100\n    },\n    {\n        \"id\": \"20000000-0000-0000-0000-000000000000\",\n        \"username\": \"old\",\n        \"master\": 0,\n        \"startTime\": 1600000000,\n        \"endTime\": 1600000060\n    }\n]",
+		},
+		{
+			name:            "list empty",
+			path:            resourceID + "/ssh/recordings",
+			wantStatusCode:  http.StatusOK,
+			wantContentType: "application/json",
+			wantBody:        "[]",
+		},
+		{
+			name:            "list not auditor",
+			path:            resourceID + "/ssh/recordings",
+			fixture:         recordingDocuments,
+			groups:          []string{},
+			wantStatusCode:  http.StatusForbidden,
+			wantContentType: "text/plain; charset=utf-8",
+			wantBody:        "Forbidden\n",
+		},
+		{
+			name:            "list elevated, not auditor",
+			path:            resourceID + "/ssh/recordings",
+			fixture:         recordingDocuments,
+			groups:          elevatedGroupIDs,
+			wantStatusCode:  http.StatusForbidden,
+			wantContentType: "text/plain; charset=utf-8",
+			wantBody:        "Forbidden\n",
+		},
+		{
+			name: "list sad database",
+			path: resourceID + "/ssh/recordings",
+			portalClient: func(portalClient *cosmosdb.FakePortalDocumentClient) {
+				portalClient.SetError(fmt.Errorf("sad"))
+			},
+			wantStatusCode:  http.StatusInternalServerError,
+			wantContentType: "text/plain; charset=utf-8",
+			wantBody:        "Internal Server Error\n",
+		},
+		{
+			name:            "get",
+			path:            resourceID + "/ssh/recordings/" + recordingID,
+			fixture:         recordingDocuments,
+			wantStatusCode:  http.StatusOK,
+			wantContentType: "application/x-asciicast",
+			wantBody:        "header\nevent\n",
+		},
+		{
+			name:            "get from other cluster",
+			path:            otherResourceID + "/ssh/recordings/" + recordingID,
+			fixture:         recordingDocuments,
+			wantStatusCode:  http.StatusNotFound,
+			wantContentType: "text/plain; charset=utf-8",
+			wantBody:        "Not Found\n",
+		},
+		{
+			name:            "get chunk",
+			path:            resourceID + "/ssh/recordings/" + recordingID + "-0",
+			fixture:         recordingDocuments,
+			wantStatusCode:  http.StatusNotFound,
+			wantContentType: "text/plain; charset=utf-8",
+			wantBody:        "Not Found\n",
+		},
+		{
+			name:            "get not found",
+			path:            resourceID + "/ssh/recordings/50000000-0000-0000-0000-000000000000",
+			wantStatusCode:  http.StatusNotFound,
+			wantContentType: "text/plain; charset=utf-8",
+			wantBody:        "Not Found\n",
+		},
+		{
+			name:            "get not auditor",
+			path:            resourceID + "/ssh/recordings/" + recordingID,
+			fixture:         recordingDocuments,
+			groups:          []string{},
+			wantStatusCode:  http.StatusForbidden,
+			wantContentType: "text/plain; charset=utf-8",
+			wantBody:        "Forbidden\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			dbPortal, portalClient := testdatabase.NewFakePortal()
+
+			fixture := testdatabase.NewFixture().WithPortal(dbPortal)
+			if tt.fixture != nil {
+				tt.fixture(fixture)
+			}
+
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.portalClient != nil {
+				tt.portalClient(portalClient)
+			}
+
+			groups := auditorGroupIDs
+			if tt.groups != nil {
+				groups = tt.groups
+			}
+
+			ctx = context.WithValue(ctx, middleware.ContextKeyUsername, "username")
+			ctx = context.WithValue(ctx, middleware.ContextKeyGroups, groups)
+			r, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://localhost:8444"+tt.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			aadAuthenticatedRouter := &mux.Router{}
+
+			_, err = New(nil, logrus.NewEntry(logrus.StandardLogger()), nil, nil, hostKey, elevatedGroupIDs, auditorGroupIDs, nil, dbPortal, nil, aadAuthenticatedRouter)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			w := responsewriter.New(r)
+
+			aadAuthenticatedRouter.ServeHTTP(w, r)
+
+			resp := w.Response()
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Error(resp.StatusCode)
+			}
+
+			if resp.Header.Get("Content-Type") != tt.wantContentType {
+				t.Error(resp.Header.Get("Content-Type"))
+			}
+
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(b) != tt.wantBody {
+				t.Errorf("%q", string(b))
+			}
+		})
+	}
+}
@@ -17,13 +17,19 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
 	cryptossh "golang.org/x/crypto/ssh"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/api/validate"
 	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/portal/access"
 	"github.com/Azure/ARO-RP/pkg/portal/middleware"
+	"github.com/Azure/ARO-RP/pkg/portal/util/revocation"
 	"github.com/Azure/ARO-RP/pkg/proxy"
+	"github.com/Azure/ARO-RP/pkg/util/restconfig"
 )
 
 const (
@@ -37,6 +43,7 @@ type ssh struct {
 	l             net.Listener
 
 	elevatedGroupIDs []string
+	auditorGroupIDs  []string
 
 	dbOpenShiftClusters database.OpenShiftClusters
 	dbPortal            database.Portal
@@ -45,6 +52,10 @@ type ssh struct {
 
 	baseServerConfig *cryptossh.ServerConfig
 	newPassword      func() string
+	now              func() time.Time
+	newKubernetes    func(*api.OpenShiftCluster) (kubernetes.Interface, error)
+
+	revocationInterval time.Duration
 }
 
 func New(env env.Core,
@@ -53,6 +64,7 @@ func New(env env.Core,
 	l net.Listener,
 	hostKey *rsa.PrivateKey,
 	elevatedGroupIDs []string,
+	auditorGroupIDs []string,
 	dbOpenShiftClusters database.OpenShiftClusters,
 	dbPortal database.Portal,
 	dialer proxy.Dialer,
@@ -64,6 +76,7 @@ func New(env env.Core,
 		l:             l,
 
 		elevatedGroupIDs: elevatedGroupIDs,
+		auditorGroupIDs:  auditorGroupIDs,
 
 		dbOpenShiftClusters: dbOpenShiftClusters,
 		dbPortal:            dbPortal,
@@ -72,6 +85,18 @@ func New(env env.Core,
 
 		baseServerConfig: &cryptossh.ServerConfig{},
 		newPassword:      func() string { return uuid.Must(uuid.NewV4()).String() },
+		now:              time.Now,
+
+		revocationInterval: revocation.PollInterval,
+	}
+
+	s.newKubernetes = func(oc *api.OpenShiftCluster) (kubernetes.Interface, error) {
+		restConfig, err := restconfig.RestConfig(s.dialer, oc)
+		if err != nil {
+			return nil, err
+		}
+
+		return kubernetes.NewForConfig(restConfig)
 	}
 
 	signer, err := cryptossh.NewSignerFromSigner(hostKey)
@@ -82,12 +107,15 @@ func New(env env.Core,
 	s.baseServerConfig.AddHostKey(signer)
 
 	aadAuthenticatedRouter.NewRoute().Methods(http.MethodPost).Path("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/microsoft.redhatopenshift/openshiftclusters/{resourceName}/ssh/new").HandlerFunc(s.new)
+	aadAuthenticatedRouter.NewRoute().Methods(http.MethodGet).Path("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/microsoft.redhatopenshift/openshiftclusters/{resourceName}/ssh/recordings").HandlerFunc(s.recordings)
+	aadAuthenticatedRouter.NewRoute().Methods(http.MethodGet).Path("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/microsoft.redhatopenshift/openshiftclusters/{resourceName}/ssh/recordings/{recordingId}").HandlerFunc(s.recording)
 
 	return s, nil
 }
 
 type request struct {
-	Master int `json:"master,omitempty"`
+	Master int    `json:"master,omitempty"`
+	Node   string `json:"node,omitempty"`
 }
 
 type response struct {
@@ -119,7 +147,8 @@ func (s *ssh) new(w http.ResponseWriter, r *http.Request) {
 
 	var req *request
 	err := json.NewDecoder(r.Body).Decode(&req)
-	if err != nil || req.Master < 0 || req.Master > 2 {
+	if err != nil || req.Master < 0 || req.Master > 2 ||
+		req.Node != "" && len(validation.IsDNS1123Subdomain(req.Node)) > 0 {
 		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
 		return
 	}
@@ -132,6 +161,44 @@ func (s *ssh) new(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	expiry, err := access.Expiry(ctx, s.dbPortal, s.now(), ctx.Value(middleware.ContextKeyUsername).(string), resourceID)
+	if err != nil {
+		s.internalServerError(w, err)
+		return
+	}
+
+	if expiry.IsZero() {
+		s.sendResponse(w, &response{
+			Error: "Elevated access must be requested and approved.",
+		})
+		return
+	}
+
+	sshDoc := &api.SSH{
+		Master: req.Master,
+	}
+
+	if req.Node != "" {
+		openShiftDoc, err := s.dbOpenShiftClusters.Get(ctx, resourceID)
+		if err != nil {
+			s.internalServerError(w, err)
+			return
+		}
+
+		sshDoc, err = s.resolveNode(ctx, openShiftDoc.OpenShiftCluster, req.Node)
+		if kerrors.IsNotFound(err) {
+			s.sendResponse(w, &response{
+				Error: "Node not found.",
+			})
+			return
+		} else if err != nil {
+			s.internalServerError(w, err)
+			return
+		}
+	}
+
+	sshDoc.Expiry = expiry.Unix()
+
 	username := r.Context().Value(middleware.ContextKeyUsername).(string)
 	username = strings.SplitN(username, "@", 2)[0]
 
@@ -143,9 +210,7 @@ func (s *ssh) new(w http.ResponseWriter, r *http.Request) {
 		Portal: &api.Portal{
 			Username: ctx.Value(middleware.ContextKeyUsername).(string),
 			ID:       resourceID,
-			SSH: &api.SSH{
-				Master: req.Master,
-			},
+			SSH:      sshDoc,
 		},
 	}
 
@@ -175,7 +240,7 @@ func (s *ssh) new(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
-func (s *ssh) sendResponse(w http.ResponseWriter, resp *response) {
+func (s *ssh) sendResponse(w http.ResponseWriter, resp interface{}) {
 	b, err := json.MarshalIndent(resp, "", "    ")
 	if err != nil {
 		s.internalServerError(w, err)
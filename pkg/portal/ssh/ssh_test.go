@@ -11,10 +11,16 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
@@ -31,6 +37,7 @@ func TestNew(t *testing.T) {
 	username := "username"
 	password := "password"
 	master := 0
+	now := time.Unix(1600000000, 0)
 
 	hostKey, _, err := utiltls.GenerateKeyAndCertificate("proxy", nil, nil, false, false)
 	if err != nil {
@@ -40,6 +47,9 @@ func TestNew(t *testing.T) {
 	for _, tt := range []struct {
 		name           string
 		r              func(*http.Request)
+		request        string
+		nodes          []kruntime.Object
+		notApproved    bool
 		checker        func(*testdatabase.Checker, *cosmosdb.FakePortalDocumentClient)
 		wantStatusCode int
 		wantBody       string
@@ -55,6 +65,7 @@ func TestNew(t *testing.T) {
 						ID:       resourceID,
 						SSH: &api.SSH{
 							Master: master,
+							Expiry: now.Add(time.Hour).Unix(),
 						},
 					},
 				})
@@ -62,6 +73,105 @@ func TestNew(t *testing.T) {
 			wantStatusCode: http.StatusOK,
 			wantBody:       "{\n    \"command\": \"ssh username@localhost\",\n    \"password\": \"password\"\n}",
 		},
+		{
+			name:    "success, worker",
+			request: `{"node":"cluster-worker-1"}`,
+			nodes: []kruntime.Object{
+				&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cluster-worker-1",
+						Labels: map[string]string{
+							"node-role.kubernetes.io/worker": "",
+						},
+					},
+					Status: corev1.NodeStatus{
+						Addresses: []corev1.NodeAddress{
+							{
+								Type:    corev1.NodeHostName,
+								Address: "cluster-worker-1",
+							},
+							{
+								Type:    corev1.NodeInternalIP,
+								Address: "10.0.0.10",
+							},
+						},
+					},
+				},
+			},
+			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				checker.AddPortalDocuments(&api.PortalDocument{
+					ID:  password,
+					TTL: 60,
+					Portal: &api.Portal{
+						Username: username,
+						ID:       resourceID,
+						SSH: &api.SSH{
+							Node:    "cluster-worker-1",
+							Address: "10.0.0.10",
+							Expiry:  now.Add(time.Hour).Unix(),
+						},
+					},
+				})
+			},
+			wantStatusCode: http.StatusOK,
+			wantBody:       "{\n    \"command\": \"ssh username@localhost\",\n    \"password\": \"password\"\n}",
+		},
+		{
+			name:    "success, master by name",
+			request: `{"node":"cluster-master-2"}`,
+			nodes: []kruntime.Object{
+				&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cluster-master-2",
+						Labels: map[string]string{
+							"node-role.kubernetes.io/master": "",
+						},
+					},
+				},
+			},
+			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				checker.AddPortalDocuments(&api.PortalDocument{
+					ID:  password,
+					TTL: 60,
+					Portal: &api.Portal{
+						Username: username,
+						ID:       resourceID,
+						SSH: &api.SSH{
+							Master: 2,
+							Node:   "cluster-master-2",
+							Expiry: now.Add(time.Hour).Unix(),
+						},
+					},
+				})
+			},
+			wantStatusCode: http.StatusOK,
+			wantBody:       "{\n    \"command\": \"ssh username@localhost\",\n    \"password\": \"password\"\n}",
+		},
+		{
+			name:           "node not found",
+			request:        `{"node":"cluster-worker-1"}`,
+			wantStatusCode: http.StatusOK,
+			wantBody:       "{\n    \"error\": \"Node not found.\"\n}",
+		},
+		{
+			name:    "node without address",
+			request: `{"node":"cluster-worker-1"}`,
+			nodes: []kruntime.Object{
+				&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cluster-worker-1",
+					},
+				},
+			},
+			wantStatusCode: http.StatusInternalServerError,
+			wantBody:       "Internal Server Error\n",
+		},
+		{
+			name:           "bad node name",
+			request:        `{"node":"Bad_Node"}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "Bad Request\n",
+		},
 		{
 			name: "bad path",
 			r: func(r *http.Request) {
@@ -102,6 +212,12 @@ func TestNew(t *testing.T) {
 			wantStatusCode: http.StatusOK,
 			wantBody:       "{\n    \"error\": \"Elevated access is required.\"\n}",
 		},
+		{
+			name:           "not approved",
+			notApproved:    true,
+			wantStatusCode: http.StatusOK,
+			wantBody:       "{\n    \"error\": \"Elevated access must be requested and approved.\"\n}",
+		},
 		{
 			name: "sad database",
 			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
@@ -115,17 +231,61 @@ func TestNew(t *testing.T) {
 			ctx := context.Background()
 
 			dbPortal, portalClient := testdatabase.NewFakePortal()
+			dbOpenShiftClusters, _ := testdatabase.NewFakeOpenShiftClusters()
+
+			fixture := testdatabase.NewFixture().
+				WithOpenShiftClusters(dbOpenShiftClusters).
+				WithPortal(dbPortal)
+
+			fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: resourceID,
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID: resourceID,
+				},
+			})
 
 			checker := testdatabase.NewChecker()
 
+			if !tt.notApproved {
+				approval := &api.PortalDocument{
+					ID:  "20000000-0000-0000-0000-000000000000",
+					TTL: 14400,
+					Portal: &api.Portal{
+						Username: username,
+						ID:       resourceID,
+						AccessRequest: &api.AccessRequest{
+							Justification: "investigation",
+							TicketID:      "IcM-1",
+							State:         api.AccessRequestStateApproved,
+							RequestTime:   now.Add(-time.Hour).Unix(),
+							Approver:      "approver",
+							DecisionTime:  now.Add(-time.Hour).Unix(),
+							ExpiryTime:    now.Add(time.Hour).Unix(),
+						},
+					},
+				}
+				fixture.AddPortalDocuments(approval)
+				checker.AddPortalDocuments(approval)
+			}
+
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
 			if tt.checker != nil {
 				tt.checker(checker, portalClient)
 			}
 
 			ctx = context.WithValue(ctx, middleware.ContextKeyUsername, username)
 			ctx = context.WithValue(ctx, middleware.ContextKeyGroups, elevatedGroupIDs)
+			request := tt.request
+			if request == "" {
+				request = fmt.Sprintf(`{"master":%d}`, master)
+			}
+
 			r, err := http.NewRequestWithContext(ctx, http.MethodPost,
-				"https://localhost:8444"+resourceID+"/ssh/new", strings.NewReader(fmt.Sprintf(`{"master":%d}`, master)))
+				"https://localhost:8444"+resourceID+"/ssh/new", strings.NewReader(request))
 			if err != nil {
 				panic(err)
 			}
@@ -140,12 +300,16 @@ func TestNew(t *testing.T) {
 
 			aadAuthenticatedRouter := &mux.Router{}
 
-			s, err := New(env, logrus.NewEntry(logrus.StandardLogger()), nil, nil, hostKey, elevatedGroupIDs, nil, dbPortal, nil, aadAuthenticatedRouter)
+			s, err := New(env, logrus.NewEntry(logrus.StandardLogger()), nil, nil, hostKey, elevatedGroupIDs, nil, dbOpenShiftClusters, dbPortal, nil, aadAuthenticatedRouter)
 			if err != nil {
 				t.Fatal(err)
 			}
 
 			s.newPassword = func() string { return password }
+			s.now = func() time.Time { return now }
+			s.newKubernetes = func(*api.OpenShiftCluster) (kubernetes.Interface, error) {
+				return fake.NewSimpleClientset(tt.nodes...), nil
+			}
 
 			if tt.r != nil {
 				tt.r(r)
@@ -0,0 +1,282 @@
+package recorder
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+)
+
+// This package records SRE->cluster terminal sessions, so that elevated access
+// can be reviewed after the fact.  Recordings are made in asciicast v2 format
+// (https://github.com/asciinema/asciinema/blob/develop/doc/asciicast-v2.md)
+// and are stored in the portal database: a PortalDocument with SSHRecording
+// set describes the session and PortalDocuments with SSHRecordingChunk set
+// hold the recording, split to fit within the database document size limit.
+// The chunk data is a SecureBytes and so is encrypted at rest.
+
+const (
+	chunkSize = 512 * 1024
+	ttl       = 90 * 24 * time.Hour
+)
+
+// Recorder records a session
+type Recorder struct {
+	dbPortal database.Portal
+	now      func() time.Time
+
+	mu sync.Mutex
+
+	id    string
+	start time.Time
+	chunk int
+	buf   bytes.Buffer
+
+	headerWritten bool
+	header        header
+
+	// pending holds incomplete UTF-8 sequences at the end of the last
+	// input/output, as asciicast events are strings
+	pending map[string][]byte
+}
+
+type header struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Command   string            `json:"command,omitempty"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// New creates the recording document for a new session by username on the
+// cluster resourceID.  The StartTime of recording is set by New.
+func New(ctx context.Context, dbPortal database.Portal, now func() time.Time, username, resourceID, title string, recording *api.SSHRecording) (*Recorder, error) {
+	r := &Recorder{
+		dbPortal: dbPortal,
+		now:      now,
+
+		id:    uuid.Must(uuid.NewV4()).String(),
+		start: now(),
+
+		header: header{
+			Version: 2,
+			Width:   80,
+			Height:  24,
+			Title:   title,
+		},
+
+		pending: map[string][]byte{},
+	}
+
+	r.header.Timestamp = r.start.Unix()
+	recording.StartTime = r.start.Unix()
+
+	_, err := dbPortal.Create(ctx, &api.PortalDocument{
+		ID:  r.id,
+		TTL: int(ttl / time.Second),
+		Portal: &api.Portal{
+			Username:     username,
+			ID:           resourceID,
+			SSHRecording: recording,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// ID returns the ID of the recording document
+func (r *Recorder) ID() string {
+	return r.id
+}
+
+// SetTerminal records the terminal type and size
+func (r *Recorder) SetTerminal(term string, width, height int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.header.Env = map[string]string{"TERM": term}
+	r.header.Width, r.header.Height = width, height
+}
+
+// SetCommand records the command run in the session
+func (r *Recorder) SetCommand(command string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.header.Command = command
+}
+
+// Resize records a change of terminal size
+func (r *Recorder) Resize(ctx context.Context, width, height int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.headerWritten {
+		r.header.Width, r.header.Height = width, height
+		return nil
+	}
+
+	return r.event(ctx, "r", []byte(fmt.Sprintf("%dx%d", width, height)))
+}
+
+// Writer returns an io.Writer which records what is written to it as events
+// of the given type ("i" for input, "o" for output)
+func (r *Recorder) Writer(ctx context.Context, typ string) *Writer {
+	return &Writer{ctx: ctx, r: r, typ: typ}
+}
+
+// Writer records what is written to it
+type Writer struct {
+	ctx context.Context
+	r   *Recorder
+	typ string
+}
+
+func (w *Writer) Write(b []byte) (int, error) {
+	w.r.mu.Lock()
+	defer w.r.mu.Unlock()
+
+	data := append(w.r.pending[w.typ], b...)
+
+	// hold back any incomplete UTF-8 sequence at the end of data until the
+	// rest of it is written
+	i := len(data)
+	for j := len(data) - 1; j >= 0 && j >= len(data)-utf8.UTFMax; j-- {
+		if utf8.RuneStart(data[j]) {
+			if !utf8.FullRune(data[j:]) {
+				i = j
+			}
+			break
+		}
+	}
+
+	w.r.pending[w.typ] = append([]byte(nil), data[i:]...)
+
+	if i > 0 {
+		err := w.r.event(w.ctx, w.typ, data[:i])
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(b), nil
+}
+
+// event appends an event to the recording, writing out a chunk if enough has
+// been recorded.  r.mu must be held.
+func (r *Recorder) event(ctx context.Context, typ string, data []byte) error {
+	err := r.writeHeader()
+	if err != nil {
+		return err
+	}
+
+	t := float64(r.now().Sub(r.start)/time.Microsecond) / 1e6
+
+	b, err := json.Marshal([]interface{}{t, typ, string(data)})
+	if err != nil {
+		return err
+	}
+
+	r.buf.Write(b)
+	r.buf.WriteByte('\n')
+
+	if r.buf.Len() >= chunkSize {
+		return r.flush(ctx)
+	}
+
+	return nil
+}
+
+// writeHeader writes the header line once the terminal size and command are
+// known, before the first event.  r.mu must be held.
+func (r *Recorder) writeHeader() error {
+	if r.headerWritten {
+		return nil
+	}
+
+	b, err := json.Marshal(r.header)
+	if err != nil {
+		return err
+	}
+
+	r.buf.Write(b)
+	r.buf.WriteByte('\n')
+	r.headerWritten = true
+
+	return nil
+}
+
+// flush writes out the recording so far as a chunk.  r.mu must be held.
+func (r *Recorder) flush(ctx context.Context) error {
+	if r.buf.Len() == 0 {
+		return nil
+	}
+
+	_, err := r.dbPortal.Create(ctx, &api.PortalDocument{
+		ID:  fmt.Sprintf("%s-%d", r.id, r.chunk),
+		TTL: int(ttl / time.Second),
+		Portal: &api.Portal{
+			SSHRecordingChunk: &api.SSHRecordingChunk{
+				RecordingID: r.id,
+				Index:       r.chunk,
+				Data:        api.SecureBytes(r.buf.Bytes()),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	r.chunk++
+	r.buf.Reset()
+
+	return nil
+}
+
+// Close writes out the rest of the recording and marks it as ended
+func (r *Recorder) Close(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, typ := range []string{"i", "o"} {
+		if len(r.pending[typ]) > 0 {
+			err := r.event(ctx, typ, r.pending[typ])
+			if err != nil {
+				return err
+			}
+			delete(r.pending, typ)
+		}
+	}
+
+	err := r.writeHeader()
+	if err != nil {
+		return err
+	}
+
+	err = r.flush(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.dbPortal.Patch(ctx, r.id, func(doc *api.PortalDocument) error {
+		doc.Portal.SSHRecording.EndTime = r.now().Unix()
+		return nil
+	})
+	return err
+}
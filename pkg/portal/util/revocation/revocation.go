@@ -0,0 +1,59 @@
+package revocation
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/util/recover"
+)
+
+// A portal session lasts for as long as its PortalDocument exists.  Sessions
+// are revoked by deleting the document; as the portal may run on many hosts,
+// live connections poll for the document and are terminated once it has gone.
+
+// PollInterval is the interval at which live connections check whether their
+// session has been revoked
+const PollInterval = 10 * time.Second
+
+// Watch returns a copy of ctx which is cancelled when the session with the
+// given id has been revoked or has expired.  Errors other than the session
+// not being found are logged and ignored, so that a database outage does not
+// terminate every session.  The returned cancel function must be called once
+// the session's connection has finished.
+func Watch(ctx context.Context, log *logrus.Entry, dbPortal database.Portal, id string, interval time.Duration) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	go func() {
+		defer recover.Panic(log)
+
+		t := time.NewTicker(interval)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+			}
+
+			_, err := dbPortal.Get(ctx, id)
+			switch {
+			case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+				cancel()
+				return
+			case err != nil && ctx.Err() == nil:
+				log.Warn(err)
+			}
+		}
+	}()
+
+	return ctx, cancel
+}
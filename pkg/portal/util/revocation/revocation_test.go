@@ -0,0 +1,79 @@
+package revocation
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+func TestWatch(t *testing.T) {
+	ctx := context.Background()
+
+	_, log := testlog.New()
+
+	dbPortal, portalClient := testdatabase.NewFakePortal()
+
+	doc := &api.PortalDocument{
+		ID: "00000000-0000-0000-0000-000000000000",
+		Portal: &api.Portal{
+			Username:   "username",
+			Kubeconfig: &api.Kubeconfig{},
+		},
+	}
+
+	_, err := dbPortal.Create(ctx, doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	watchCtx, cancel := Watch(ctx, log, dbPortal, doc.ID, time.Millisecond)
+	defer cancel()
+
+	// the session survives while its document exists, and while the database
+	// is failing
+	time.Sleep(20 * time.Millisecond)
+
+	portalClient.SetError(fmt.Errorf("sad"))
+	time.Sleep(20 * time.Millisecond)
+	portalClient.SetError(nil)
+
+	if watchCtx.Err() != nil {
+		t.Fatal(watchCtx.Err())
+	}
+
+	err = dbPortal.Delete(ctx, doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-watchCtx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("session was not revoked")
+	}
+
+	if ctx.Err() != nil {
+		t.Error(ctx.Err())
+	}
+}
+
+func TestWatchCancel(t *testing.T) {
+	ctx := context.Background()
+
+	_, log := testlog.New()
+
+	dbPortal, _ := testdatabase.NewFakePortal()
+
+	watchCtx, cancel := Watch(ctx, log, dbPortal, "00000000-0000-0000-0000-000000000000", time.Hour)
+	cancel()
+
+	<-watchCtx.Done()
+}
@@ -15,6 +15,7 @@ import (
 type AccountsClient interface {
 	ListAccountSAS(ctx context.Context, resourceGroupName string, accountName string, parameters mgmtstorage.AccountSasParameters) (result mgmtstorage.ListAccountSasResponse, err error)
 	ListKeys(ctx context.Context, resourceGroupName string, accountName string, expand mgmtstorage.ListKeyExpand) (result mgmtstorage.AccountListKeysResult, err error)
+	ListServiceSAS(ctx context.Context, resourceGroupName string, accountName string, parameters mgmtstorage.ServiceSasParameters) (result mgmtstorage.ListServiceSasResponse, err error)
 	AccountsClientAddons
 }
 
@@ -42,20 +42,27 @@ type dynamicHelper struct {
 }
 
 func New(log *logrus.Entry, restconfig *rest.Config) (Interface, error) {
-	dh := &dynamicHelper{
-		log: log,
-	}
-
-	var err error
-	dh.GVRResolver, err = NewGVRResolver(log, restconfig)
+	gvrResolver, err := NewGVRResolver(log, restconfig)
 	if err != nil {
 		return nil, err
 	}
 
+	return NewWithGVRResolver(log, restconfig, gvrResolver)
+}
+
+// NewWithGVRResolver returns a dynamic helper which uses gvrResolver, so that
+// helpers with different rest configs can share the results of discovery
+func NewWithGVRResolver(log *logrus.Entry, restconfig *rest.Config, gvrResolver GVRResolver) (Interface, error) {
+	dh := &dynamicHelper{
+		GVRResolver: gvrResolver,
+		log:         log,
+	}
+
 	restconfig = rest.CopyConfig(restconfig)
 	restconfig.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
 	restconfig.GroupVersion = &schema.GroupVersion{}
 
+	var err error
 	dh.restcli, err = rest.RESTClientFor(restconfig)
 	if err != nil {
 		return nil, err
@@ -209,6 +216,7 @@ func merge(old, new runtime.Object) (runtime.Object, bool, string, error) {
 
 	case *arov1alpha1.Cluster:
 		old, new := old.(*arov1alpha1.Cluster), new.(*arov1alpha1.Cluster)
+		arov1alpha1.CopyClusterOwnedFields(&new.Spec, &old.Spec)
 		new.Status = old.Status
 	}
 
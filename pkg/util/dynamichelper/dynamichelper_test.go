@@ -12,6 +12,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
 	"github.com/Azure/ARO-RP/pkg/util/cmp"
 )
 
@@ -201,6 +202,49 @@ func TestMerge(t *testing.T) {
 			wantChanged:   true,
 			wantEmptyDiff: true,
 		},
+		{
+			name: "Cluster keeps fields set in the cluster",
+			old: &arov1alpha1.Cluster{
+				Spec: arov1alpha1.ClusterSpec{
+					ACRDomain: "old",
+					Features: arov1alpha1.FeaturesSpec{
+						ServiceMonitors: true,
+						Controllers: map[string]arov1alpha1.ControllerMode{
+							"Workaround": arov1alpha1.ControllerModeDisabled,
+						},
+					},
+					NodeRemediation: arov1alpha1.NodeRemediationSpec{
+						MaxUnhealthy: 2,
+					},
+				},
+				Status: arov1alpha1.ClusterStatus{
+					OperatorVersion: "version",
+				},
+			},
+			new: &arov1alpha1.Cluster{
+				Spec: arov1alpha1.ClusterSpec{
+					ACRDomain: "new",
+				},
+			},
+			want: &arov1alpha1.Cluster{
+				Spec: arov1alpha1.ClusterSpec{
+					ACRDomain: "new",
+					Features: arov1alpha1.FeaturesSpec{
+						ServiceMonitors: true,
+						Controllers: map[string]arov1alpha1.ControllerMode{
+							"Workaround": arov1alpha1.ControllerModeDisabled,
+						},
+					},
+					NodeRemediation: arov1alpha1.NodeRemediationSpec{
+						MaxUnhealthy: 2,
+					},
+				},
+				Status: arov1alpha1.ClusterStatus{
+					OperatorVersion: "version",
+				},
+			},
+			wantChanged: true,
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			got, changed, diff, err := merge(tt.old, tt.new)
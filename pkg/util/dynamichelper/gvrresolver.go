@@ -6,6 +6,7 @@ package dynamichelper
 import (
 	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -22,10 +23,14 @@ type GVRResolver interface {
 	Resolve(groupKind, optionalVersion string) (*schema.GroupVersionResource, error)
 }
 
+// gvrResolver is safe for concurrent use, so that one resolver can be shared
+// by many dynamic helpers
 type gvrResolver struct {
 	log *logrus.Entry
 
-	discovery    discovery.DiscoveryInterface
+	discovery discovery.DiscoveryInterface
+
+	mu           sync.RWMutex
 	apiresources []*metav1.APIResourceList
 }
 
@@ -45,8 +50,13 @@ func NewGVRResolver(log *logrus.Entry, restconfig *rest.Config) (GVRResolver, er
 	return r, nil
 }
 
-func (r *gvrResolver) Refresh() (err error) {
-	_, r.apiresources, err = r.discovery.ServerGroupsAndResources()
+func (r *gvrResolver) Refresh() error {
+	_, apiresources, err := r.discovery.ServerGroupsAndResources()
+	if err == nil || discovery.IsGroupDiscoveryFailedError(err) {
+		r.mu.Lock()
+		r.apiresources = apiresources
+		r.mu.Unlock()
+	}
 	if discovery.IsGroupDiscoveryFailedError(err) {
 		// Some group discovery failed; dh.apiresources will have all the ones
 		// that worked. This error can happen with a misconfigured apiservice,
@@ -58,15 +68,23 @@ func (r *gvrResolver) Refresh() (err error) {
 }
 
 func (r *gvrResolver) Resolve(groupKind, optionalVersion string) (*schema.GroupVersionResource, error) {
-	if r.apiresources == nil {
+	r.mu.RLock()
+	resources := r.apiresources
+	r.mu.RUnlock()
+
+	if resources == nil {
 		err := r.Refresh()
 		if err != nil {
 			return nil, err
 		}
+
+		r.mu.RLock()
+		resources = r.apiresources
+		r.mu.RUnlock()
 	}
 
 	var matches []*schema.GroupVersionResource
-	for _, apiresources := range r.apiresources {
+	for _, apiresources := range resources {
 		gv, err := schema.ParseGroupVersion(apiresources.GroupVersion)
 		if err != nil {
 			// this returns a fmt.Errorf which will result in a 500
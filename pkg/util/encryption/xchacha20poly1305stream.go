@@ -0,0 +1,189 @@
+package encryption
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bufio"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// streamChunkSize is the size of the plaintext chunks which an encrypted
+// stream is split into
+const streamChunkSize = 64 * 1024
+
+// A stream is encrypted as a sequence of chunks, each sealed with its own
+// random nonce, which precedes it.  Every chunk except the last holds
+// streamChunkSize bytes of plaintext.  The chunk's sequence number and whether
+// it is the last chunk are authenticated as additional data, so that a
+// truncated, reordered or extended stream fails to decrypt.
+func streamAdditionalData(seq uint64, last bool) []byte {
+	ad := make([]byte, 9)
+	binary.BigEndian.PutUint64(ad, seq)
+	if last {
+		ad[8] = 1
+	}
+	return ad
+}
+
+type xChaCha20Poly1305Writer struct {
+	w          io.Writer
+	aead       cipher.AEAD
+	randReader io.Reader
+
+	seq uint64
+	buf []byte
+}
+
+// NewXChaCha20Poly1305Writer returns a WriteCloser which encrypts everything
+// written to it onto w, without holding more than one chunk in memory.  Close
+// must be called to write the last chunk; it does not close w.
+func NewXChaCha20Poly1305Writer(w io.Writer, key []byte) (io.WriteCloser, error) {
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &xChaCha20Poly1305Writer{
+		w:          w,
+		aead:       aead,
+		randReader: rand.Reader,
+		buf:        make([]byte, 0, streamChunkSize),
+	}, nil
+}
+
+func (c *xChaCha20Poly1305Writer) Write(b []byte) (int, error) {
+	var n int
+
+	for len(b) > 0 {
+		// a full chunk is only sealed once more data arrives, as until then
+		// it may be the last
+		if len(c.buf) == streamChunkSize {
+			err := c.seal(false)
+			if err != nil {
+				return n, err
+			}
+		}
+
+		l := streamChunkSize - len(c.buf)
+		if l > len(b) {
+			l = len(b)
+		}
+
+		c.buf = append(c.buf, b[:l]...)
+		b = b[l:]
+		n += l
+	}
+
+	return n, nil
+}
+
+func (c *xChaCha20Poly1305Writer) Close() error {
+	return c.seal(true)
+}
+
+func (c *xChaCha20Poly1305Writer) seal(last bool) error {
+	nonce := make([]byte, c.aead.NonceSize())
+
+	_, err := io.ReadFull(c.randReader, nonce)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.w.Write(c.aead.Seal(nonce, nonce, c.buf, streamAdditionalData(c.seq, last)))
+	if err != nil {
+		return err
+	}
+
+	c.seq++
+	c.buf = c.buf[:0]
+
+	return nil
+}
+
+type xChaCha20Poly1305Reader struct {
+	r    *bufio.Reader
+	aead cipher.AEAD
+
+	seq  uint64
+	buf  []byte
+	done bool
+}
+
+// NewXChaCha20Poly1305Reader returns a Reader which decrypts a stream written
+// by an XChaCha20Poly1305Writer from r
+func NewXChaCha20Poly1305Reader(r io.Reader, key []byte) (io.Reader, error) {
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &xChaCha20Poly1305Reader{
+		r:    bufio.NewReader(r),
+		aead: aead,
+	}, nil
+}
+
+func (c *xChaCha20Poly1305Reader) Read(b []byte) (int, error) {
+	for len(c.buf) == 0 {
+		if c.done {
+			return 0, io.EOF
+		}
+
+		err := c.open()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(b, c.buf)
+	c.buf = c.buf[n:]
+
+	return n, nil
+}
+
+func (c *xChaCha20Poly1305Reader) open() error {
+	chunk := make([]byte, c.aead.NonceSize()+streamChunkSize+c.aead.Overhead())
+
+	var last bool
+	n, err := io.ReadFull(c.r, chunk)
+	switch err {
+	case nil:
+		// a full chunk is the last if nothing follows it
+		_, err = c.r.Peek(1)
+		switch err {
+		case nil:
+		case io.EOF:
+			last = true
+		default:
+			return err
+		}
+	case io.ErrUnexpectedEOF:
+		last = true
+		chunk = chunk[:n]
+	case io.EOF:
+		return fmt.Errorf("encrypted stream truncated")
+	default:
+		return err
+	}
+
+	if len(chunk) < c.aead.NonceSize()+c.aead.Overhead() {
+		return fmt.Errorf("encrypted stream truncated")
+	}
+
+	c.buf, err = c.aead.Open(nil, chunk[:c.aead.NonceSize()], chunk[c.aead.NonceSize():], streamAdditionalData(c.seq, last))
+	if err != nil {
+		return err
+	}
+
+	c.seq++
+	c.done = last
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package encryption
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io/ioutil"
+	"testing"
+)
+
+func TestXChaCha20Poly1305Stream(t *testing.T) {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seal := func(plaintext []byte) []byte {
+		buf := &bytes.Buffer{}
+
+		w, err := NewXChaCha20Poly1305Writer(buf, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// write in odd sized pieces to exercise chunking
+		for len(plaintext) > 0 {
+			l := 1000
+			if l > len(plaintext) {
+				l = len(plaintext)
+			}
+
+			_, err = w.Write(plaintext[:l])
+			if err != nil {
+				t.Fatal(err)
+			}
+			plaintext = plaintext[l:]
+		}
+
+		err = w.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return buf.Bytes()
+	}
+
+	open := func(key, sealed []byte) ([]byte, error) {
+		r, err := NewXChaCha20Poly1305Reader(bytes.NewReader(sealed), key)
+		if err != nil {
+			return nil, err
+		}
+
+		return ioutil.ReadAll(r)
+	}
+
+	chunk := streamChunkSize + 16 + 24
+
+	for _, size := range []int{0, 1, streamChunkSize, streamChunkSize + 1, 3 * streamChunkSize} {
+		plaintext := make([]byte, size)
+		_, err = rand.Read(plaintext)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		opened, err := open(key, seal(plaintext))
+		if err != nil {
+			t.Fatalf("%d: %v", size, err)
+		}
+
+		if !bytes.Equal(opened, plaintext) {
+			t.Errorf("%d: plaintext mismatch", size)
+		}
+	}
+
+	sealed := seal(make([]byte, 3*streamChunkSize))
+
+	for _, tt := range []struct {
+		name   string
+		key    []byte
+		sealed []byte
+	}{
+		{
+			name:   "wrong key",
+			key:    make([]byte, 32),
+			sealed: sealed,
+		},
+		{
+			name:   "truncated to whole chunks",
+			key:    key,
+			sealed: sealed[:2*chunk],
+		},
+		{
+			name:   "truncated mid chunk",
+			key:    key,
+			sealed: sealed[:2*chunk+100],
+		},
+		{
+			name:   "empty",
+			key:    key,
+			sealed: nil,
+		},
+		{
+			name:   "reordered",
+			key:    key,
+			sealed: append(append(append([]byte{}, sealed[chunk:2*chunk]...), sealed[:chunk]...), sealed[2*chunk:]...),
+		},
+		{
+			name:   "extended",
+			key:    key,
+			sealed: append(append([]byte{}, sealed...), sealed[:chunk]...),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := open(tt.key, tt.sealed)
+			if err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
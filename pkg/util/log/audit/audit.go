@@ -22,6 +22,7 @@ const (
 	MetadataLogKind        = "logKind"
 	MetadataAdminOperation = "adminOp"
 	MetadataSource         = "source"
+	MetadataChanges        = "changes"
 
 	SourceAdminPortal = "aro-admin"
 	SourceRP          = "aro-rp"
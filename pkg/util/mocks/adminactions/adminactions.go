@@ -6,12 +6,16 @@ package mock_adminactions
 
 import (
 	context "context"
+	io "io"
 	http "net/http"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	logrus "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
 	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	types "k8s.io/apimachinery/pkg/types"
 )
 
 // MockKubeActions is a mock of KubeActions interface
@@ -95,6 +99,63 @@ func (mr *MockKubeActionsMockRecorder) KubeList(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KubeList", reflect.TypeOf((*MockKubeActions)(nil).KubeList), arg0, arg1, arg2)
 }
 
+// KubeMustGather mocks base method
+func (m *MockKubeActions) KubeMustGather(arg0 context.Context, arg1 io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KubeMustGather", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// KubeMustGather indicates an expected call of KubeMustGather
+func (mr *MockKubeActionsMockRecorder) KubeMustGather(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KubeMustGather", reflect.TypeOf((*MockKubeActions)(nil).KubeMustGather), arg0, arg1)
+}
+
+// KubePatch mocks base method
+func (m *MockKubeActions) KubePatch(arg0 context.Context, arg1, arg2, arg3 string, arg4 types.PatchType, arg5 []byte, arg6 string, arg7 bool) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KubePatch", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// KubePatch indicates an expected call of KubePatch
+func (mr *MockKubeActionsMockRecorder) KubePatch(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KubePatch", reflect.TypeOf((*MockKubeActions)(nil).KubePatch), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+}
+
+// KubePodExec mocks base method
+func (m *MockKubeActions) KubePodExec(arg0 context.Context, arg1 http.ResponseWriter, arg2, arg3, arg4 string, arg5 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KubePodExec", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// KubePodExec indicates an expected call of KubePodExec
+func (mr *MockKubeActionsMockRecorder) KubePodExec(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KubePodExec", reflect.TypeOf((*MockKubeActions)(nil).KubePodExec), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// KubePodLogs mocks base method
+func (m *MockKubeActions) KubePodLogs(arg0 context.Context, arg1 http.ResponseWriter, arg2, arg3 string, arg4 *v1.PodLogOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KubePodLogs", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// KubePodLogs indicates an expected call of KubePodLogs
+func (mr *MockKubeActionsMockRecorder) KubePodLogs(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KubePodLogs", reflect.TypeOf((*MockKubeActions)(nil).KubePodLogs), arg0, arg1, arg2, arg3, arg4)
+}
+
 // Upgrade mocks base method
 func (m *MockKubeActions) Upgrade(arg0 context.Context, arg1 bool) error {
 	m.ctrl.T.Helper()
@@ -132,6 +193,35 @@ func (m *MockAzureActions) EXPECT() *MockAzureActionsMockRecorder {
 	return m.recorder
 }
 
+// MustGatherURL mocks base method
+func (m *MockAzureActions) MustGatherURL(arg0 context.Context, arg1 string, arg2 time.Time) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MustGatherURL", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MustGatherURL indicates an expected call of MustGatherURL
+func (mr *MockAzureActionsMockRecorder) MustGatherURL(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MustGatherURL", reflect.TypeOf((*MockAzureActions)(nil).MustGatherURL), arg0, arg1, arg2)
+}
+
+// MustGatherUpload mocks base method
+func (m *MockAzureActions) MustGatherUpload(arg0 context.Context, arg1 string, arg2 io.Reader) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MustGatherUpload", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MustGatherUpload indicates an expected call of MustGatherUpload
+func (mr *MockAzureActionsMockRecorder) MustGatherUpload(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MustGatherUpload", reflect.TypeOf((*MockAzureActions)(nil).MustGatherUpload), arg0, arg1, arg2)
+}
+
 // ResourcesList mocks base method
 func (m *MockAzureActions) ResourcesList(arg0 context.Context) ([]byte, error) {
 	m.ctrl.T.Helper()
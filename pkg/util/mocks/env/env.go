@@ -208,6 +208,20 @@ func (mr *MockInterfaceMockRecorder) ACRResourceID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ACRResourceID", reflect.TypeOf((*MockInterface)(nil).ACRResourceID))
 }
 
+// AROOperatorCanaryPercentage mocks base method
+func (m *MockInterface) AROOperatorCanaryPercentage() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AROOperatorCanaryPercentage")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// AROOperatorCanaryPercentage indicates an expected call of AROOperatorCanaryPercentage
+func (mr *MockInterfaceMockRecorder) AROOperatorCanaryPercentage() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AROOperatorCanaryPercentage", reflect.TypeOf((*MockInterface)(nil).AROOperatorCanaryPercentage))
+}
+
 // AROOperatorImage mocks base method
 func (m *MockInterface) AROOperatorImage() string {
 	m.ctrl.T.Helper()
@@ -307,6 +321,20 @@ func (mr *MockInterfaceMockRecorder) ClusterKeyvault() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterKeyvault", reflect.TypeOf((*MockInterface)(nil).ClusterKeyvault))
 }
 
+// ClusterSpecSigningKey mocks base method
+func (m *MockInterface) ClusterSpecSigningKey() *rsa.PrivateKey {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterSpecSigningKey")
+	ret0, _ := ret[0].(*rsa.PrivateKey)
+	return ret0
+}
+
+// ClusterSpecSigningKey indicates an expected call of ClusterSpecSigningKey
+func (mr *MockInterfaceMockRecorder) ClusterSpecSigningKey() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterSpecSigningKey", reflect.TypeOf((*MockInterface)(nil).ClusterSpecSigningKey))
+}
+
 // DialContext mocks base method
 func (m *MockInterface) DialContext(arg0 context.Context, arg1, arg2 string) (net.Conn, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,57 @@
+package mustgather
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"net/http"
+	"time"
+
+	azstorage "github.com/Azure/azure-sdk-for-go/storage"
+)
+
+const (
+	// Container is the container in the cluster storage account which holds
+	// encrypted must-gather tarballs
+	Container = "aro-mustgather"
+
+	// LinkValidity is how long the download link to a must-gather is valid
+	// for.  Once it has expired the blob can no longer be read and is deleted.
+	LinkValidity = 24 * time.Hour
+)
+
+// DeleteExpired deletes every must-gather in c whose download link expired
+// before now.  The cluster storage account doesn't support lifecycle
+// management policies, so this is called whenever a must-gather is uploaded
+// and on admin update.
+func DeleteExpired(c *azstorage.Container, now time.Time) error {
+	var marker string
+
+	for {
+		res, err := c.ListBlobs(azstorage.ListBlobsParameters{
+			Marker: marker,
+		})
+		if err, ok := err.(azstorage.AzureStorageServiceError); ok && err.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, blob := range res.Blobs {
+			if time.Time(blob.Properties.LastModified).Add(LinkValidity).After(now) {
+				continue
+			}
+
+			_, err = c.GetBlobReference(blob.Name).DeleteIfExists(nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		if res.NextMarker == "" {
+			return nil
+		}
+		marker = res.NextMarker
+	}
+}
@@ -34,6 +34,7 @@ try:
     from ._models_py3 import OpenShiftClusterCredentials
     from ._models_py3 import OpenShiftClusterUpdate
     from ._models_py3 import Operation
+    from ._models_py3 import ProxyProfile
     from ._models_py3 import ProxyResource
     from ._models_py3 import Resource
     from ._models_py3 import ServicePrincipalProfile
@@ -54,6 +55,7 @@ except (SyntaxError, ImportError):
     from ._models import OpenShiftClusterCredentials
     from ._models import OpenShiftClusterUpdate
     from ._models import Operation
+    from ._models import ProxyProfile
     from ._models import ProxyResource
     from ._models import Resource
     from ._models import ServicePrincipalProfile
@@ -80,6 +82,7 @@ __all__ = [
     'OpenShiftClusterCredentials',
     'OpenShiftClusterUpdate',
     'Operation',
+    'ProxyProfile',
     'ProxyResource',
     'Resource',
     'ServicePrincipalProfile',
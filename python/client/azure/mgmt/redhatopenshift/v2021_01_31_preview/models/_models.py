@@ -409,6 +409,9 @@ class OpenShiftCluster(TrackedResource):
     :param network_profile: The cluster network profile.
     :type network_profile:
      ~azure.mgmt.redhatopenshift.v2021_01_31_preview.models.NetworkProfile
+    :param proxy_profile: The cluster proxy profile.
+    :type proxy_profile:
+     ~azure.mgmt.redhatopenshift.v2021_01_31_preview.models.ProxyProfile
     :param master_profile: The cluster master profile.
     :type master_profile:
      ~azure.mgmt.redhatopenshift.v2021_01_31_preview.models.MasterProfile
@@ -441,6 +444,7 @@ class OpenShiftCluster(TrackedResource):
         'console_profile': {'key': 'properties.consoleProfile', 'type': 'ConsoleProfile'},
         'service_principal_profile': {'key': 'properties.servicePrincipalProfile', 'type': 'ServicePrincipalProfile'},
         'network_profile': {'key': 'properties.networkProfile', 'type': 'NetworkProfile'},
+        'proxy_profile': {'key': 'properties.proxyProfile', 'type': 'ProxyProfile'},
         'master_profile': {'key': 'properties.masterProfile', 'type': 'MasterProfile'},
         'worker_profiles': {'key': 'properties.workerProfiles', 'type': '[WorkerProfile]'},
         'apiserver_profile': {'key': 'properties.apiserverProfile', 'type': 'APIServerProfile'},
@@ -454,6 +458,7 @@ class OpenShiftCluster(TrackedResource):
         self.console_profile = kwargs.get('console_profile', None)
         self.service_principal_profile = kwargs.get('service_principal_profile', None)
         self.network_profile = kwargs.get('network_profile', None)
+        self.proxy_profile = kwargs.get('proxy_profile', None)
         self.master_profile = kwargs.get('master_profile', None)
         self.worker_profiles = kwargs.get('worker_profiles', None)
         self.apiserver_profile = kwargs.get('apiserver_profile', None)
@@ -502,6 +507,9 @@ class OpenShiftClusterUpdate(Model):
     :param network_profile: The cluster network profile.
     :type network_profile:
      ~azure.mgmt.redhatopenshift.v2021_01_31_preview.models.NetworkProfile
+    :param proxy_profile: The cluster proxy profile.
+    :type proxy_profile:
+     ~azure.mgmt.redhatopenshift.v2021_01_31_preview.models.ProxyProfile
     :param master_profile: The cluster master profile.
     :type master_profile:
      ~azure.mgmt.redhatopenshift.v2021_01_31_preview.models.MasterProfile
@@ -523,6 +531,7 @@ class OpenShiftClusterUpdate(Model):
         'console_profile': {'key': 'properties.consoleProfile', 'type': 'ConsoleProfile'},
         'service_principal_profile': {'key': 'properties.servicePrincipalProfile', 'type': 'ServicePrincipalProfile'},
         'network_profile': {'key': 'properties.networkProfile', 'type': 'NetworkProfile'},
+        'proxy_profile': {'key': 'properties.proxyProfile', 'type': 'ProxyProfile'},
         'master_profile': {'key': 'properties.masterProfile', 'type': 'MasterProfile'},
         'worker_profiles': {'key': 'properties.workerProfiles', 'type': '[WorkerProfile]'},
         'apiserver_profile': {'key': 'properties.apiserverProfile', 'type': 'APIServerProfile'},
@@ -537,6 +546,7 @@ class OpenShiftClusterUpdate(Model):
         self.console_profile = kwargs.get('console_profile', None)
         self.service_principal_profile = kwargs.get('service_principal_profile', None)
         self.network_profile = kwargs.get('network_profile', None)
+        self.proxy_profile = kwargs.get('proxy_profile', None)
         self.master_profile = kwargs.get('master_profile', None)
         self.worker_profiles = kwargs.get('worker_profiles', None)
         self.apiserver_profile = kwargs.get('apiserver_profile', None)
@@ -569,6 +579,36 @@ class Operation(Model):
         self.origin = kwargs.get('origin', None)
 
 
+class ProxyProfile(Model):
+    """ProxyProfile represents an outbound proxy profile.
+
+    :param http_proxy: The URL of the proxy for HTTP requests.
+    :type http_proxy: str
+    :param https_proxy: The URL of the proxy for HTTPS requests.
+    :type https_proxy: str
+    :param no_proxy: A comma-separated list of destination domain names,
+     domains, IP addresses or other network CIDRs which bypass the proxy.
+    :type no_proxy: str
+    :param trusted_ca: A PEM bundle of the CA certificates which an
+     inspecting proxy signs its certificates with.
+    :type trusted_ca: str
+    """
+
+    _attribute_map = {
+        'http_proxy': {'key': 'httpProxy', 'type': 'str'},
+        'https_proxy': {'key': 'httpsProxy', 'type': 'str'},
+        'no_proxy': {'key': 'noProxy', 'type': 'str'},
+        'trusted_ca': {'key': 'trustedCa', 'type': 'str'},
+    }
+
+    def __init__(self, **kwargs):
+        super(ProxyProfile, self).__init__(**kwargs)
+        self.http_proxy = kwargs.get('http_proxy', None)
+        self.https_proxy = kwargs.get('https_proxy', None)
+        self.no_proxy = kwargs.get('no_proxy', None)
+        self.trusted_ca = kwargs.get('trusted_ca', None)
+
+
 class ProxyResource(Resource):
     """The resource model definition for a ARM proxy resource. It will have
     everything other than required location and tags.
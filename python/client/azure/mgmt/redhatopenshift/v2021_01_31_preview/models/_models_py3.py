@@ -409,6 +409,9 @@ class OpenShiftCluster(TrackedResource):
     :param network_profile: The cluster network profile.
     :type network_profile:
      ~azure.mgmt.redhatopenshift.v2021_01_31_preview.models.NetworkProfile
+    :param proxy_profile: The cluster proxy profile.
+    :type proxy_profile:
+     ~azure.mgmt.redhatopenshift.v2021_01_31_preview.models.ProxyProfile
     :param master_profile: The cluster master profile.
     :type master_profile:
      ~azure.mgmt.redhatopenshift.v2021_01_31_preview.models.MasterProfile
@@ -441,19 +444,21 @@ class OpenShiftCluster(TrackedResource):
         'console_profile': {'key': 'properties.consoleProfile', 'type': 'ConsoleProfile'},
         'service_principal_profile': {'key': 'properties.servicePrincipalProfile', 'type': 'ServicePrincipalProfile'},
         'network_profile': {'key': 'properties.networkProfile', 'type': 'NetworkProfile'},
+        'proxy_profile': {'key': 'properties.proxyProfile', 'type': 'ProxyProfile'},
         'master_profile': {'key': 'properties.masterProfile', 'type': 'MasterProfile'},
         'worker_profiles': {'key': 'properties.workerProfiles', 'type': '[WorkerProfile]'},
         'apiserver_profile': {'key': 'properties.apiserverProfile', 'type': 'APIServerProfile'},
         'ingress_profiles': {'key': 'properties.ingressProfiles', 'type': '[IngressProfile]'},
     }
 
-    def __init__(self, *, location: str, tags=None, provisioning_state=None, cluster_profile=None, console_profile=None, service_principal_profile=None, network_profile=None, master_profile=None, worker_profiles=None, apiserver_profile=None, ingress_profiles=None, **kwargs) -> None:
+    def __init__(self, *, location: str, tags=None, provisioning_state=None, cluster_profile=None, console_profile=None, service_principal_profile=None, network_profile=None, proxy_profile=None, master_profile=None, worker_profiles=None, apiserver_profile=None, ingress_profiles=None, **kwargs) -> None:
         super(OpenShiftCluster, self).__init__(tags=tags, location=location, **kwargs)
         self.provisioning_state = provisioning_state
         self.cluster_profile = cluster_profile
         self.console_profile = console_profile
         self.service_principal_profile = service_principal_profile
         self.network_profile = network_profile
+        self.proxy_profile = proxy_profile
         self.master_profile = master_profile
         self.worker_profiles = worker_profiles
         self.apiserver_profile = apiserver_profile
@@ -502,6 +507,9 @@ class OpenShiftClusterUpdate(Model):
     :param network_profile: The cluster network profile.
     :type network_profile:
      ~azure.mgmt.redhatopenshift.v2021_01_31_preview.models.NetworkProfile
+    :param proxy_profile: The cluster proxy profile.
+    :type proxy_profile:
+     ~azure.mgmt.redhatopenshift.v2021_01_31_preview.models.ProxyProfile
     :param master_profile: The cluster master profile.
     :type master_profile:
      ~azure.mgmt.redhatopenshift.v2021_01_31_preview.models.MasterProfile
@@ -523,13 +531,14 @@ class OpenShiftClusterUpdate(Model):
         'console_profile': {'key': 'properties.consoleProfile', 'type': 'ConsoleProfile'},
         'service_principal_profile': {'key': 'properties.servicePrincipalProfile', 'type': 'ServicePrincipalProfile'},
         'network_profile': {'key': 'properties.networkProfile', 'type': 'NetworkProfile'},
+        'proxy_profile': {'key': 'properties.proxyProfile', 'type': 'ProxyProfile'},
         'master_profile': {'key': 'properties.masterProfile', 'type': 'MasterProfile'},
         'worker_profiles': {'key': 'properties.workerProfiles', 'type': '[WorkerProfile]'},
         'apiserver_profile': {'key': 'properties.apiserverProfile', 'type': 'APIServerProfile'},
         'ingress_profiles': {'key': 'properties.ingressProfiles', 'type': '[IngressProfile]'},
     }
 
-    def __init__(self, *, tags=None, provisioning_state=None, cluster_profile=None, console_profile=None, service_principal_profile=None, network_profile=None, master_profile=None, worker_profiles=None, apiserver_profile=None, ingress_profiles=None, **kwargs) -> None:
+    def __init__(self, *, tags=None, provisioning_state=None, cluster_profile=None, console_profile=None, service_principal_profile=None, network_profile=None, proxy_profile=None, master_profile=None, worker_profiles=None, apiserver_profile=None, ingress_profiles=None, **kwargs) -> None:
         super(OpenShiftClusterUpdate, self).__init__(**kwargs)
         self.tags = tags
         self.provisioning_state = provisioning_state
@@ -537,6 +546,7 @@ class OpenShiftClusterUpdate(Model):
         self.console_profile = console_profile
         self.service_principal_profile = service_principal_profile
         self.network_profile = network_profile
+        self.proxy_profile = proxy_profile
         self.master_profile = master_profile
         self.worker_profiles = worker_profiles
         self.apiserver_profile = apiserver_profile
@@ -569,6 +579,36 @@ class Operation(Model):
         self.origin = origin
 
 
+class ProxyProfile(Model):
+    """ProxyProfile represents an outbound proxy profile.
+
+    :param http_proxy: The URL of the proxy for HTTP requests.
+    :type http_proxy: str
+    :param https_proxy: The URL of the proxy for HTTPS requests.
+    :type https_proxy: str
+    :param no_proxy: A comma-separated list of destination domain names,
+     domains, IP addresses or other network CIDRs which bypass the proxy.
+    :type no_proxy: str
+    :param trusted_ca: A PEM bundle of the CA certificates which an
+     inspecting proxy signs its certificates with.
+    :type trusted_ca: str
+    """
+
+    _attribute_map = {
+        'http_proxy': {'key': 'httpProxy', 'type': 'str'},
+        'https_proxy': {'key': 'httpsProxy', 'type': 'str'},
+        'no_proxy': {'key': 'noProxy', 'type': 'str'},
+        'trusted_ca': {'key': 'trustedCa', 'type': 'str'},
+    }
+
+    def __init__(self, *, http_proxy: str=None, https_proxy: str=None, no_proxy: str=None, trusted_ca: str=None, **kwargs) -> None:
+        super(ProxyProfile, self).__init__(**kwargs)
+        self.http_proxy = http_proxy
+        self.https_proxy = https_proxy
+        self.no_proxy = no_proxy
+        self.trusted_ca = trusted_ca
+
+
 class ProxyResource(Resource):
     """The resource model definition for a ARM proxy resource. It will have
     everything other than required location and tags.
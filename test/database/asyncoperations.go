@@ -0,0 +1,32 @@
+package database
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+)
+
+func fakeAsyncOperationsListByClusterKeyQuery(client cosmosdb.AsyncOperationDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.AsyncOperationDocumentRawIterator {
+	docs, err := client.ListAll(context.Background(), nil)
+	if err != nil {
+		return cosmosdb.NewFakeAsyncOperationDocumentErroringRawIterator(err)
+	}
+
+	var results []*api.AsyncOperationDocument
+	for _, doc := range docs.AsyncOperationDocuments {
+		if doc.OpenShiftClusterKey == query.Parameters[0].Value {
+			results = append(results, doc)
+		}
+	}
+
+	return cosmosdb.NewFakeAsyncOperationDocumentIterator(results, 0)
+}
+
+func injectAsyncOperations(c *cosmosdb.FakeAsyncOperationDocumentClient) {
+	c.SetQueryHandler(database.AsyncOperationsListByClusterKeyQuery, fakeAsyncOperationsListByClusterKeyQuery)
+}
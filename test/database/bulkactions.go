@@ -0,0 +1,41 @@
+package database
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+)
+
+func fakeBulkActionsDequeueQuery(client cosmosdb.BulkActionDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.BulkActionDocumentRawIterator {
+	input, err := client.ListAll(context.Background(), nil)
+	if err != nil {
+		// TODO: should this never happen?
+		panic(err)
+	}
+
+	var results []*api.BulkActionDocument
+	for _, r := range input.BulkActionDocuments {
+		if !r.BulkAction.State.IsTerminal() && int64(r.LeaseExpires) < time.Now().Unix() {
+			results = append(results, r)
+		}
+	}
+
+	return cosmosdb.NewFakeBulkActionDocumentIterator(results, 0)
+}
+
+func fakeBulkActionsRenewLeaseTrigger(ctx context.Context, doc *api.BulkActionDocument) error {
+	doc.LeaseExpires = int(time.Now().Unix()) + 60
+	return nil
+}
+
+func injectBulkActions(c *cosmosdb.FakeBulkActionDocumentClient) {
+	c.SetQueryHandler(database.BulkActionsDequeueQuery, fakeBulkActionsDequeueQuery)
+
+	c.SetTriggerHandler("renewLease", fakeBulkActionsRenewLeaseTrigger)
+}
@@ -22,6 +22,7 @@ type Checker struct {
 	billingDocuments          []*api.BillingDocument
 	asyncOperationDocuments   []*api.AsyncOperationDocument
 	portalDocuments           []*api.PortalDocument
+	bulkActionDocuments       []*api.BulkActionDocument
 }
 
 func NewChecker() *Checker {
@@ -83,6 +84,17 @@ func (f *Checker) AddPortalDocuments(docs ...*api.PortalDocument) {
 	}
 }
 
+func (f *Checker) AddBulkActionDocuments(docs ...*api.BulkActionDocument) {
+	for _, doc := range docs {
+		docCopy, err := deepCopy(doc)
+		if err != nil {
+			panic(err)
+		}
+
+		f.bulkActionDocuments = append(f.bulkActionDocuments, docCopy.(*api.BulkActionDocument))
+	}
+}
+
 func (f *Checker) CheckOpenShiftClusters(openShiftClusters *cosmosdb.FakeOpenShiftClusterDocumentClient) (errs []error) {
 	ctx := context.Background()
 
@@ -194,3 +206,23 @@ func (f *Checker) CheckPortals(portals *cosmosdb.FakePortalDocumentClient) (errs
 
 	return errs
 }
+
+func (f *Checker) CheckBulkActions(bulkActions *cosmosdb.FakeBulkActionDocumentClient) (errs []error) {
+	ctx := context.Background()
+
+	all, err := bulkActions.ListAll(ctx, nil)
+	if err != nil {
+		return []error{err}
+	}
+
+	if len(f.bulkActionDocuments) != 0 && len(all.BulkActionDocuments) == len(f.bulkActionDocuments) {
+		diff := deep.Equal(all.BulkActionDocuments, f.bulkActionDocuments)
+		for _, i := range diff {
+			errs = append(errs, errors.New(i))
+		}
+	} else if len(all.BulkActionDocuments) != 0 || len(f.bulkActionDocuments) != 0 {
+		errs = append(errs, fmt.Errorf("bulkActions length different, %d vs %d", len(all.BulkActionDocuments), len(f.bulkActionDocuments)))
+	}
+
+	return errs
+}
@@ -18,12 +18,14 @@ type Fixture struct {
 	billingDocuments          []*api.BillingDocument
 	asyncOperationDocuments   []*api.AsyncOperationDocument
 	portalDocuments           []*api.PortalDocument
+	bulkActionDocuments       []*api.BulkActionDocument
 
 	openShiftClustersDatabase database.OpenShiftClusters
 	billingDatabase           database.Billing
 	subscriptionsDatabase     database.Subscriptions
 	asyncOperationsDatabase   database.AsyncOperations
 	portalDatabase            database.Portal
+	bulkActionsDatabase       database.BulkActions
 }
 
 func NewFixture() *Fixture {
@@ -55,6 +57,11 @@ func (f *Fixture) WithPortal(db database.Portal) *Fixture {
 	return f
 }
 
+func (f *Fixture) WithBulkActions(db database.BulkActions) *Fixture {
+	f.bulkActionsDatabase = db
+	return f
+}
+
 func (f *Fixture) AddOpenShiftClusterDocuments(docs ...*api.OpenShiftClusterDocument) {
 	for _, doc := range docs {
 		docCopy, err := deepCopy(doc)
@@ -110,6 +117,17 @@ func (f *Fixture) AddPortalDocuments(docs ...*api.PortalDocument) {
 	}
 }
 
+func (f *Fixture) AddBulkActionDocuments(docs ...*api.BulkActionDocument) {
+	for _, doc := range docs {
+		docCopy, err := deepCopy(doc)
+		if err != nil {
+			panic(err)
+		}
+
+		f.bulkActionDocuments = append(f.bulkActionDocuments, docCopy.(*api.BulkActionDocument))
+	}
+}
+
 func (f *Fixture) Create() error {
 	ctx := context.Background()
 
@@ -151,5 +169,12 @@ func (f *Fixture) Create() error {
 		}
 	}
 
+	for _, i := range f.bulkActionDocuments {
+		_, err := f.bulkActionsDatabase.Create(ctx, i)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -44,12 +44,21 @@ func NewFakeBilling() (db database.Billing, client *cosmosdb.FakeBillingDocument
 
 func NewFakeAsyncOperations() (db database.AsyncOperations, client *cosmosdb.FakeAsyncOperationDocumentClient) {
 	client = cosmosdb.NewFakeAsyncOperationDocumentClient(jsonHandle)
+	injectAsyncOperations(client)
 	db = database.NewAsyncOperationsWithProvidedClient(client)
 	return db, client
 }
 
 func NewFakePortal() (db database.Portal, client *cosmosdb.FakePortalDocumentClient) {
 	client = cosmosdb.NewFakePortalDocumentClient(jsonHandle)
+	injectPortal(client)
 	db = database.NewPortalWithProvidedClient(client)
 	return db, client
 }
+
+func NewFakeBulkActions() (db database.BulkActions, client *cosmosdb.FakeBulkActionDocumentClient) {
+	client = cosmosdb.NewFakeBulkActionDocumentClient(jsonHandle)
+	injectBulkActions(client)
+	db = database.NewBulkActionsWithProvidedClient(client)
+	return db, client
+}
@@ -0,0 +1,185 @@
+package database
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+)
+
+type ByPortalID []*api.PortalDocument
+
+func (a ByPortalID) Len() int           { return len(a) }
+func (a ByPortalID) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a ByPortalID) Less(i, j int) bool { return strings.Compare(a[i].ID, a[j].ID) < 0 }
+
+func fakePortalSSHRecordingsQuery(client cosmosdb.PortalDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.PortalDocumentRawIterator {
+	docs, err := client.ListAll(context.Background(), nil)
+	if err != nil {
+		return cosmosdb.NewFakePortalDocumentErroringRawIterator(err)
+	}
+
+	var results []*api.PortalDocument
+	for _, doc := range docs.PortalDocuments {
+		if doc.Portal.ID == query.Parameters[0].Value && doc.Portal.SSHRecording != nil {
+			results = append(results, doc)
+		}
+	}
+
+	return cosmosdb.NewFakePortalDocumentIterator(results, 0)
+}
+
+func fakePortalAccessRequestsQuery(client cosmosdb.PortalDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.PortalDocumentRawIterator {
+	docs, err := client.ListAll(context.Background(), nil)
+	if err != nil {
+		return cosmosdb.NewFakePortalDocumentErroringRawIterator(err)
+	}
+
+	parameters := map[string]string{}
+	for _, parameter := range query.Parameters {
+		parameters[parameter.Name] = parameter.Value
+	}
+
+	var results []*api.PortalDocument
+	for _, doc := range docs.PortalDocuments {
+		if doc.Portal.AccessRequest == nil {
+			continue
+		}
+
+		if username, ok := parameters["@username"]; ok && doc.Portal.Username != username {
+			continue
+		}
+
+		if id, ok := parameters["@id"]; ok && doc.Portal.ID != id {
+			continue
+		}
+
+		if state, ok := parameters["@state"]; ok && string(doc.Portal.AccessRequest.State) != state {
+			continue
+		}
+
+		if expiresAfter, ok := parameters["@expiresAfter"]; ok {
+			t, err := strconv.ParseInt(expiresAfter, 10, 64)
+			if err != nil {
+				return cosmosdb.NewFakePortalDocumentErroringRawIterator(err)
+			}
+
+			if doc.Portal.AccessRequest.ExpiryTime < t {
+				continue
+			}
+		}
+
+		results = append(results, doc)
+	}
+
+	return cosmosdb.NewFakePortalDocumentIterator(results, 0)
+}
+
+func fakePortalSessionsQuery(client cosmosdb.PortalDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.PortalDocumentRawIterator {
+	docs, err := client.ListAll(context.Background(), nil)
+	if err != nil {
+		return cosmosdb.NewFakePortalDocumentErroringRawIterator(err)
+	}
+
+	var results []*api.PortalDocument
+	for _, doc := range docs.PortalDocuments {
+		if doc.Portal.SSH != nil || doc.Portal.Kubeconfig != nil {
+			results = append(results, doc)
+		}
+	}
+
+	return cosmosdb.NewFakePortalDocumentIterator(results, 0)
+}
+
+func fakePortalAuditRecordsQuery(client cosmosdb.PortalDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.PortalDocumentRawIterator {
+	docs, err := client.ListAll(context.Background(), nil)
+	if err != nil {
+		return cosmosdb.NewFakePortalDocumentErroringRawIterator(err)
+	}
+
+	parameters := map[string]string{}
+	for _, parameter := range query.Parameters {
+		parameters[parameter.Name] = parameter.Value
+	}
+
+	var results []*api.PortalDocument
+	for _, doc := range docs.PortalDocuments {
+		record := doc.Portal.AuditRecord
+		if record == nil ||
+			record.Time < parameters["@start"] ||
+			record.Time >= parameters["@end"] {
+			continue
+		}
+
+		if username, ok := parameters["@username"]; ok && !strings.EqualFold(doc.Portal.Username, username) {
+			continue
+		}
+
+		if id, ok := parameters["@id"]; ok && doc.Portal.ID != id {
+			continue
+		}
+
+		if operationName, ok := parameters["@operationName"]; ok && !strings.Contains(strings.ToLower(record.OperationName), strings.ToLower(operationName)) {
+			continue
+		}
+
+		results = append(results, doc)
+	}
+
+	var continuation int
+	if options != nil && options.Continuation != "" {
+		continuation, err = strconv.Atoi(options.Continuation)
+		if err != nil {
+			return cosmosdb.NewFakePortalDocumentErroringRawIterator(err)
+		}
+	}
+
+	return cosmosdb.NewFakePortalDocumentIterator(results, continuation)
+}
+
+func injectPortal(c *cosmosdb.FakePortalDocumentClient) {
+	c.SetQueryHandler(database.PortalSSHRecordingsQuery, fakePortalSSHRecordingsQuery)
+	c.SetQueryHandler(database.PortalSessionsQuery, fakePortalSessionsQuery)
+
+	// the audit records query depends on which filters are set
+	for i := 0; i < 8; i++ {
+		f := &database.PortalAuditRecordsFilter{}
+		if i&1 != 0 {
+			f.Username = "username"
+		}
+		if i&2 != 0 {
+			f.ResourceID = "id"
+		}
+		if i&4 != 0 {
+			f.OperationName = "operationName"
+		}
+		c.SetQueryHandler(f.Query().Query, fakePortalAuditRecordsQuery)
+	}
+
+	// so does the access requests query
+	for i := 0; i < 16; i++ {
+		f := &database.PortalAccessRequestsFilter{}
+		if i&1 != 0 {
+			f.Username = "username"
+		}
+		if i&2 != 0 {
+			f.ResourceID = "id"
+		}
+		if i&4 != 0 {
+			f.State = api.AccessRequestStateApproved
+		}
+		if i&8 != 0 {
+			f.ExpiresAfter = 1
+		}
+		c.SetQueryHandler(f.Query().Query, fakePortalAccessRequestsQuery)
+	}
+
+	c.SetSorter(func(in []*api.PortalDocument) { sort.Sort(ByPortalID(in)) })
+}